@@ -65,7 +65,31 @@ func eval(binaryOp stmt.BinaryOP, left, right float64) float64 {
 			return 0
 		}
 		return left / right
+	case stmt.EQUAL:
+		return boolValue(left == right)
+	case stmt.NOTEQUAL:
+		return boolValue(left != right)
+	case stmt.GREATER:
+		return boolValue(left > right)
+	case stmt.GREATEREQUAL:
+		return boolValue(left >= right)
+	case stmt.LESS:
+		return boolValue(left < right)
+	case stmt.LESSEQUAL:
+		return boolValue(left <= right)
+	case stmt.AND:
+		return boolValue(left != 0 && right != 0)
+	case stmt.OR:
+		return boolValue(left != 0 || right != 0)
 	default:
 		return 0
 	}
 }
+
+// boolValue returns 1 if condition is true, else returns 0, used as condition of if function.
+func boolValue(cond bool) float64 {
+	if cond {
+		return 1
+	}
+	return 0
+}
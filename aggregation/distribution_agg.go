@@ -0,0 +1,150 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package aggregation
+
+import (
+	"math"
+
+	"github.com/cespare/xxhash/v2"
+
+	"github.com/lindb/lindb/pkg/stream"
+	"github.com/lindb/lindb/series"
+	"github.com/lindb/lindb/series/field"
+)
+
+// DistinctValue returns the value of tag value for distinct count(hash of tag value), which is never 0.
+func DistinctValue(tagValue string) float64 {
+	// keeps 53 bits of hash, float64 holds it without loss
+	return float64(xxhash.Sum64String(tagValue)>>11) + 1
+}
+
+// distributionValues represents the values collected from each series by time slot,
+// distribution function(e.g. percentile/median/distinct_count) evaluates on all values of time slot.
+type distributionValues struct {
+	distinct bool // values of time slot are unique(e.g. hash of tag value for distinct count)
+	size     int
+
+	values [][]float64
+	seen   []map[float64]struct{}
+}
+
+// newDistributionValues creates the values collection for distribution agg type.
+func newDistributionValues(aggType field.AggType, size int) *distributionValues {
+	return &distributionValues{
+		distinct: aggType == field.Distinct,
+		size:     size,
+	}
+}
+
+// add adds the value of series into time slot, drops NaN/inf value.
+func (d *distributionValues) add(pos int, value float64) {
+	if pos < 0 || pos >= d.size || math.IsNaN(value) || math.IsInf(value, 0) {
+		return
+	}
+	if d.values == nil {
+		d.values = make([][]float64, d.size)
+	}
+	if d.distinct {
+		if d.seen == nil {
+			d.seen = make([]map[float64]struct{}, d.size)
+		}
+		if d.seen[pos] == nil {
+			d.seen[pos] = make(map[float64]struct{})
+		}
+		if _, ok := d.seen[pos][value]; ok {
+			return
+		}
+		d.seen[pos][value] = struct{}{}
+	}
+	d.values[pos] = append(d.values[pos], value)
+}
+
+// reset resets the collected values for reusing.
+func (d *distributionValues) reset() {
+	for pos := range d.values {
+		d.values[pos] = d.values[pos][:0]
+	}
+	for pos := range d.seen {
+		d.seen[pos] = nil
+	}
+}
+
+// distributionPrimitiveIterator implements series.DistributionIterator interface using values of each time slot.
+type distributionPrimitiveIterator struct {
+	start   int
+	aggType field.AggType
+	values  [][]float64
+	idx     int
+}
+
+// newDistributionPrimitiveIterator creates a distribution primitive iterator.
+func newDistributionPrimitiveIterator(start int, aggType field.AggType, values [][]float64) series.DistributionIterator {
+	return &distributionPrimitiveIterator{
+		start:   start,
+		aggType: aggType,
+		values:  values,
+	}
+}
+
+// AggType returns the primitive field's agg type.
+func (it *distributionPrimitiveIterator) AggType() field.AggType {
+	return it.aggType
+}
+
+// HasNext returns if the iteration has more data points.
+func (it *distributionPrimitiveIterator) HasNext() bool {
+	for it.idx < len(it.values) {
+		if len(it.values[it.idx]) > 0 {
+			return true
+		}
+		it.idx++
+	}
+	return false
+}
+
+// Next returns the count of values in the iteration.
+func (it *distributionPrimitiveIterator) Next() (timeSlot int, value float64) {
+	timeSlot, values := it.NextValues()
+	return timeSlot, float64(len(values))
+}
+
+// NextValues returns the values of time slot in the iteration.
+func (it *distributionPrimitiveIterator) NextValues() (timeSlot int, values []float64) {
+	if it.idx >= len(it.values) {
+		return -1, nil
+	}
+	timeSlot = it.start + it.idx
+	values = it.values[it.idx]
+	it.idx++
+	return
+}
+
+// marshalDistribution marshals the values of distribution iterator,
+// format: (slot + count of values + values) of each time slot.
+func marshalDistribution(it series.DistributionIterator) ([]byte, error) {
+	writer := stream.NewBufferWriter(nil)
+	for it.HasNext() {
+		slot, values := it.NextValues()
+		writer.PutUvarint32(uint32(slot))
+		writer.PutUvarint32(uint32(len(values)))
+		for _, value := range values {
+			writer.PutUint64(math.Float64bits(value))
+		}
+	}
+	return writer.Bytes()
+}
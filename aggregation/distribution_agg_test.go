@@ -0,0 +1,86 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package aggregation
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/series"
+	"github.com/lindb/lindb/series/field"
+)
+
+func TestDistinctValue(t *testing.T) {
+	assert.Equal(t, DistinctValue("host1"), DistinctValue("host1"))
+	assert.NotEqual(t, DistinctValue("host1"), DistinctValue("host2"))
+	assert.NotZero(t, DistinctValue(""))
+}
+
+func TestDistributionValues(t *testing.T) {
+	values := newDistributionValues(field.Distribution, 3)
+	values.add(-1, 1)
+	values.add(3, 1)
+	values.add(0, math.NaN())
+	values.add(0, math.Inf(1))
+	assert.Nil(t, values.values)
+	values.add(0, 1)
+	values.add(0, 1)
+	values.add(2, 2)
+	assert.Equal(t, [][]float64{{1, 1}, nil, {2}}, values.values)
+	values.reset()
+	assert.Equal(t, [][]float64{{}, nil, {}}, values.values)
+
+	values = newDistributionValues(field.Distinct, 3)
+	values.add(1, 1)
+	values.add(1, 1)
+	values.add(1, 2)
+	assert.Equal(t, [][]float64{nil, {1, 2}, nil}, values.values)
+	values.reset()
+	values.add(1, 1)
+	assert.Equal(t, [][]float64{nil, {1}, nil}, values.values)
+}
+
+func TestDistributionPrimitiveIterator(t *testing.T) {
+	it := newDistributionPrimitiveIterator(10, field.Distribution, [][]float64{{1, 2}, nil, {3}})
+	assert.Equal(t, field.Distribution, it.AggType())
+	data, err := marshalDistribution(it)
+	assert.NoError(t, err)
+	assert.False(t, it.HasNext())
+	slot, values := it.NextValues()
+	assert.Equal(t, -1, slot)
+	assert.Nil(t, values)
+
+	it = newDistributionPrimitiveIterator(10, field.Distribution, [][]float64{{1, 2}, nil, {3}})
+	assert.True(t, it.HasNext())
+	slot, value := it.Next()
+	assert.Equal(t, 10, slot)
+	assert.Equal(t, 2.0, value)
+
+	dIt := series.NewDistributionIterator(field.Distribution, data)
+	assert.True(t, dIt.HasNext())
+	slot, values = dIt.NextValues()
+	assert.Equal(t, 10, slot)
+	assert.Equal(t, []float64{1, 2}, values)
+	assert.True(t, dIt.HasNext())
+	slot, values = dIt.NextValues()
+	assert.Equal(t, 12, slot)
+	assert.Equal(t, []float64{3}, values)
+	assert.False(t, dIt.HasNext())
+}
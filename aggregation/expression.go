@@ -18,6 +18,7 @@
 package aggregation
 
 import (
+	"sort"
 	"strconv"
 
 	"github.com/lindb/common/pkg/logger"
@@ -28,6 +29,7 @@ import (
 	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/series"
 	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/series/histogram"
 	"github.com/lindb/lindb/series/metric"
 	"github.com/lindb/lindb/sql/stmt"
 )
@@ -124,6 +126,10 @@ func (e *expression) eval(parentFunc *stmt.CallExpr, expr stmt.Expr) []*collecti
 		switch ex.FuncType {
 		case function.Quantile:
 			return e.quantile(ex)
+		case function.HistogramCount, function.HistogramSum:
+			return e.histogramCall(ex)
+		case function.Percentile, function.Median, function.DistinctCount:
+			return e.distributionCall(ex)
 		default:
 			return e.funcCall(ex)
 		}
@@ -140,6 +146,10 @@ func (e *expression) eval(parentFunc *stmt.CallExpr, expr stmt.Expr) []*collecti
 		return []*collections.FloatArray{values}
 	case *stmt.FieldExpr:
 		fieldName := ex.Name
+		if parentFunc != nil {
+			// field data of time offset function call is stored with offset field name
+			fieldName = stmt.OffsetFieldName(fieldName, parentFunc.Offset)
+		}
 		if fieldValues, ok := e.fieldStore[field.Name(fieldName)]; ok {
 			// tests if it has func with field
 			if parentFunc == nil {
@@ -163,6 +173,9 @@ func (e *expression) quantile(expr *stmt.CallExpr) []*collections.FloatArray {
 	if err != nil {
 		return nil
 	}
+	if histograms := e.mergeHistograms(nil); len(histograms) > 0 {
+		return e.nativeQuantile(quantileValue, histograms)
+	}
 	for fieldName, df := range e.fieldStore {
 		if df.Type() == field.HistogramField {
 			var upperBound float64
@@ -184,8 +197,190 @@ func (e *expression) quantile(expr *stmt.CallExpr) []*collections.FloatArray {
 	return []*collections.FloatArray{array}
 }
 
+// nativeQuantile calculates quantile based on merged native histogram of each point.
+func (e *expression) nativeQuantile(q float64, histograms []*histogram.Histogram) []*collections.FloatArray {
+	if q < 0 || q > 1 {
+		log.Warn("native histogram quantile call with illegal value", logger.Any("quantile", q))
+		return nil
+	}
+	legacyBuckets := e.legacyBuckets()
+	array := collections.NewFloatArray(e.pointCount)
+	for pos, h := range histograms {
+		if len(legacyBuckets) > 0 {
+			// merge data points which written before native histogram enabled
+			h = mergeLegacyBuckets(h, legacyBuckets, pos)
+		}
+		if h != nil {
+			array.SetValue(pos, h.Quantile(q))
+		}
+	}
+	return []*collections.FloatArray{array}
+}
+
+// histogramCall calls histogram_count/histogram_sum function based on native histogram.
+func (e *expression) histogramCall(expr *stmt.CallExpr) []*collections.FloatArray {
+	var fieldNames []field.Name
+	for _, param := range expr.Params {
+		fieldExpr, ok := param.(*stmt.FieldExpr)
+		if !ok {
+			return nil
+		}
+		fieldNames = append(fieldNames, field.Name(fieldExpr.Name))
+	}
+	histograms := e.mergeHistograms(fieldNames)
+	if len(histograms) == 0 {
+		return nil
+	}
+	array := collections.NewFloatArray(e.pointCount)
+	for pos, h := range histograms {
+		if h == nil {
+			continue
+		}
+		if expr.FuncType == function.HistogramCount {
+			array.SetValue(pos, h.Count)
+		} else {
+			array.SetValue(pos, h.Sum)
+		}
+	}
+	return []*collections.FloatArray{array}
+}
+
+// mergeHistograms merges native histogram fields by point, if field names is empty, merges all native histogram fields.
+func (e *expression) mergeHistograms(fieldNames []field.Name) (result []*histogram.Histogram) {
+	merge := func(df fields.Field) {
+		values := df.GetHistograms()
+		if len(values) == 0 {
+			return
+		}
+		if result == nil {
+			result = make([]*histogram.Histogram, e.pointCount)
+		}
+		for pos, h := range values {
+			if h == nil || pos >= e.pointCount {
+				continue
+			}
+			if result[pos] == nil {
+				result[pos] = h.Clone()
+			} else {
+				result[pos].Merge(h)
+			}
+		}
+	}
+	if len(fieldNames) == 0 {
+		for _, df := range e.fieldStore {
+			if df.Type() == field.NativeHistogramField {
+				merge(df)
+			}
+		}
+		return
+	}
+	for _, fieldName := range fieldNames {
+		if df, ok := e.fieldStore[fieldName]; ok && df.Type() == field.NativeHistogramField {
+			merge(df)
+		}
+	}
+	return
+}
+
+// distributionCall calls percentile/median/distinct_count function based on the values collected from each series,
+// distinct_count(tag) uses the hash of tag values which is stored with distinct field name.
+func (e *expression) distributionCall(expr *stmt.CallExpr) []*collections.FloatArray {
+	if len(expr.Params) == 0 {
+		return nil
+	}
+	fieldExpr, ok := expr.Params[0].(*stmt.FieldExpr)
+	if !ok {
+		return nil
+	}
+	fieldName := fieldExpr.Name
+	if expr.FuncType == function.DistinctCount {
+		fieldName = stmt.DistinctFieldName(fieldName)
+	}
+	df, ok := e.fieldStore[field.Name(fieldName)]
+	if !ok {
+		return nil
+	}
+	var args []float64
+	for _, param := range expr.Params[1:] {
+		literal, ok := param.(*stmt.NumberLiteral)
+		if !ok {
+			return nil
+		}
+		args = append(args, literal.Val)
+	}
+	result := function.DistributionCall(expr.FuncType, df.GetDistributions(), args...)
+	if result == nil {
+		return nil
+	}
+	return []*collections.FloatArray{result}
+}
+
+// legacyBuckets returns the histogram bucket fields(__bucket_${boundary}) sorted by upper bound.
+func (e *expression) legacyBuckets() (result []legacyBucket) {
+	for fieldName, df := range e.fieldStore {
+		if df.Type() != field.HistogramField {
+			continue
+		}
+		upperBound, err := metric.UpperBound(fieldName.String())
+		if err != nil {
+			continue
+		}
+		values := df.GetDefaultValues()
+		if len(values) == 0 {
+			continue
+		}
+		result = append(result, legacyBucket{upperBound: upperBound, values: values[0]})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].upperBound < result[j].upperBound })
+	return
+}
+
+// legacyBucket represents the histogram bucket field(__bucket_${boundary}).
+type legacyBucket struct {
+	values     *collections.FloatArray
+	upperBound float64
+}
+
+// mergeLegacyBuckets merges the bucket values of point into native histogram.
+func mergeLegacyBuckets(h *histogram.Histogram, buckets []legacyBucket, pos int) *histogram.Histogram {
+	bounds := make([]float64, 0, len(buckets))
+	values := make([]float64, 0, len(buckets))
+	count := 0.0
+	for _, b := range buckets {
+		bounds = append(bounds, b.upperBound)
+		v := 0.0
+		if b.values.HasValue(pos) {
+			v = b.values.GetValue(pos)
+		}
+		values = append(values, v)
+		count += v
+	}
+	if count == 0 {
+		return h
+	}
+	legacy := histogram.FromExplicitBounds(bounds, values, 0, count)
+	if h == nil {
+		return legacy
+	}
+	h.Merge(legacy)
+	return h
+}
+
 // funcCall calls the function
 func (e *expression) funcCall(expr *stmt.CallExpr) []*collections.FloatArray {
+	if function.IsTransform(expr.FuncType) {
+		return e.transformCall(expr)
+	}
+	if function.IsSelector(expr.FuncType) {
+		// top/bottom(k, expr) selects series after all series evaluated, just returns value of expr
+		if len(expr.Params) != 2 {
+			return nil
+		}
+		return e.eval(nil, expr.Params[1])
+	}
+	if function.IsScalar(expr.FuncType) {
+		return e.scalarCall(expr)
+	}
 	var params []*collections.FloatArray
 	for _, param := range expr.Params {
 		paramValues := e.eval(expr, param)
@@ -209,10 +404,52 @@ func (e *expression) funcCall(expr *stmt.CallExpr) []*collections.FloatArray {
 	return []*collections.FloatArray{result}
 }
 
+// transformCall evaluates series transform function,
+// first param is the series, others are constant params of function(e.g. moving_average(f, 5)).
+func (e *expression) transformCall(expr *stmt.CallExpr) []*collections.FloatArray {
+	if len(expr.Params) == 0 {
+		return nil
+	}
+	values := e.eval(expr, expr.Params[0])
+	if len(values) != 1 {
+		return nil
+	}
+	var args []float64
+	for _, param := range expr.Params[1:] {
+		literal, ok := param.(*stmt.NumberLiteral)
+		if !ok {
+			return nil
+		}
+		args = append(args, literal.Val)
+	}
+	result := function.TransformCall(expr.FuncType, e.interval, values[0], args...)
+	if result == nil {
+		return nil
+	}
+	return []*collections.FloatArray{result}
+}
+
+// scalarCall evaluates scalar function on the down sampling result of params.
+func (e *expression) scalarCall(expr *stmt.CallExpr) []*collections.FloatArray {
+	var params []*collections.FloatArray
+	for _, param := range expr.Params {
+		paramValues := e.eval(nil, param)
+		if len(paramValues) != 1 {
+			return nil
+		}
+		params = append(params, paramValues[0])
+	}
+	result := function.ScalarCall(expr.FuncType, params...)
+	if result == nil {
+		return nil
+	}
+	return []*collections.FloatArray{result}
+}
+
 // binaryEval evaluates binary operator
 func (e *expression) binaryEval(expr *stmt.BinaryExpr) []*collections.FloatArray {
 	binaryOP := expr.Operator
-	if binaryOP == stmt.ADD || binaryOP == stmt.SUB || binaryOP == stmt.DIV || binaryOP == stmt.MUL {
+	if binaryOP != stmt.LIKE {
 		left := e.eval(nil, expr.Left)
 		if len(left) != 1 {
 			return nil
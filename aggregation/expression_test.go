@@ -18,6 +18,7 @@
 package aggregation
 
 import (
+	"math"
 	"testing"
 
 	commontimeutil "github.com/lindb/common/pkg/timeutil"
@@ -241,7 +242,7 @@ func TestExpression_BinaryEval(t *testing.T) {
 		End:   now + commontimeutil.OneHour*2,
 	}, commontimeutil.OneMinute, []stmt.Expr{&stmt.SelectItem{Expr: &stmt.BinaryExpr{
 		Left:     &stmt.FieldExpr{Name: "f1"},
-		Operator: stmt.AND,
+		Operator: stmt.LIKE,
 		Right:    &stmt.FieldExpr{Name: "f2"},
 	}}})
 	gomock.InOrder(
@@ -314,6 +315,34 @@ func TestExpression_FuncCall_Sum(t *testing.T) {
 	assert.Equal(t, 0, len(resultSet))
 }
 
+func TestExpression_FuncCall_Offset(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	series1 := mockTimeSeries(ctrl, familyTime, "f1", field.SumField, field.Sum)
+	series2 := mockTimeSeries(ctrl, familyTime, field.Name(stmt.OffsetFieldName("f1", commontimeutil.OneDay)), field.SumField, field.Sum)
+	timeSeries := series.NewMockGroupedIterator(ctrl)
+
+	q, _ := sql.Parse("select sum(f1) offset 1d, sum(f1)+sum(f1) offset 1d from cpu")
+	query := q.(*stmt.Query)
+	expression := NewExpression(timeutil.TimeRange{
+		Start: now,
+		End:   now + commontimeutil.OneHour*2,
+	}, commontimeutil.OneMinute, query.SelectItems)
+	gomock.InOrder(
+		timeSeries.EXPECT().HasNext().Return(true),
+		timeSeries.EXPECT().Next().Return(series1),
+		timeSeries.EXPECT().HasNext().Return(true),
+		timeSeries.EXPECT().Next().Return(series2),
+		timeSeries.EXPECT().HasNext().Return(false),
+	)
+	expression.Eval(timeSeries)
+	resultSet := expression.ResultSet()
+	assert.Equal(t, 2, len(resultSet))
+	assert.Equal(t, 50.0, resultSet["sum(f1) offset 1d"].GetValue(50-10))
+	assert.Equal(t, 100.0, resultSet["sum(f1)+sum(f1) offset 1d"].GetValue(50-10))
+}
+
 func TestExpression_FuncCall_Rate(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -367,3 +396,125 @@ func TestExpression_NotSupport_Expr(t *testing.T) {
 	resultSet = expression.ResultSet()
 	assert.Equal(t, 0, len(resultSet))
 }
+
+func TestExpression_FuncCall_Transform(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	series1 := mockTimeSeries(ctrl, familyTime, "f1", field.SumField, field.Sum)
+	timeSeries := series.NewMockGroupedIterator(ctrl)
+
+	q, _ := sql.Parse("select cumulative_sum(f1), delta(f1), moving_average(f1, 1) from cpu")
+	query := q.(*stmt.Query)
+	expression := NewExpression(timeutil.TimeRange{
+		Start: now,
+		End:   now + commontimeutil.OneHour*2,
+	}, commontimeutil.OneMinute, query.SelectItems)
+	gomock.InOrder(
+		timeSeries.EXPECT().HasNext().Return(true),
+		timeSeries.EXPECT().Next().Return(series1),
+		timeSeries.EXPECT().HasNext().Return(false),
+	)
+	expression.Eval(timeSeries)
+	resultSet := expression.ResultSet()
+	assert.Equal(t, 50.0, resultSet["cumulative_sum(f1)"].GetValue(50-10))
+	assert.Equal(t, 50.0, resultSet["moving_average(f1,1.00)"].GetValue(50-10))
+	// delta needs two points at least
+	assert.Equal(t, 0, resultSet["delta(f1)"].Size())
+}
+
+func TestExpression_FuncCall_Scalar(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	series1 := mockTimeSeries(ctrl, familyTime, "f1", field.SumField, field.Sum)
+	timeSeries := series.NewMockGroupedIterator(ctrl)
+
+	q, _ := sql.Parse("select log10(f1) as l, if(f1 > 10 and f1 < 100, f1, 0) as c, if(f1 < 10, f1, 0) as c2, clamp_max(f1, 20) from cpu")
+	query := q.(*stmt.Query)
+	expression := NewExpression(timeutil.TimeRange{
+		Start: now,
+		End:   now + commontimeutil.OneHour*2,
+	}, commontimeutil.OneMinute, query.SelectItems)
+	gomock.InOrder(
+		timeSeries.EXPECT().HasNext().Return(true),
+		timeSeries.EXPECT().Next().Return(series1),
+		timeSeries.EXPECT().HasNext().Return(false),
+	)
+	expression.Eval(timeSeries)
+	resultSet := expression.ResultSet()
+	assert.InDelta(t, math.Log10(50), resultSet["l"].GetValue(50-10), 1e-9)
+	assert.Equal(t, 50.0, resultSet["c"].GetValue(50-10))
+	assert.Equal(t, 0.0, resultSet["c2"].GetValue(50-10))
+	assert.True(t, resultSet["c2"].HasValue(50-10))
+	assert.Equal(t, 20.0, resultSet["clamp_max(f1,20.00)"].GetValue(50-10))
+}
+
+func TestExpression_FuncCall_Selector(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	series1 := mockTimeSeries(ctrl, familyTime, "f1", field.SumField, field.Sum)
+	timeSeries := series.NewMockGroupedIterator(ctrl)
+
+	q, _ := sql.Parse("select top(1, f1*2) as t from cpu")
+	query := q.(*stmt.Query)
+	expression := NewExpression(timeutil.TimeRange{
+		Start: now,
+		End:   now + commontimeutil.OneHour*2,
+	}, commontimeutil.OneMinute, query.SelectItems)
+	gomock.InOrder(
+		timeSeries.EXPECT().HasNext().Return(true),
+		timeSeries.EXPECT().Next().Return(series1),
+		timeSeries.EXPECT().HasNext().Return(false),
+	)
+	expression.Eval(timeSeries)
+	resultSet := expression.ResultSet()
+	// series selection is done after all series evaluated
+	assert.Equal(t, 100.0, resultSet["t"].GetValue(50-10))
+}
+
+func TestExpression_FuncCall_Distribution(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDistributionSeries := func(fieldName field.Name, aggType field.AggType, values []float64) series.Iterator {
+		slots := make([][]float64, 51)
+		slots[50] = values
+		it := series.NewMockFieldIterator(ctrl)
+		it.EXPECT().HasNext().Return(true)
+		it.EXPECT().Next().Return(newDistributionPrimitiveIterator(0, aggType, slots))
+		it.EXPECT().HasNext().Return(false)
+		timeSeries := series.NewMockIterator(ctrl)
+		timeSeries.EXPECT().FieldType().Return(field.Unknown)
+		timeSeries.EXPECT().FieldName().Return(fieldName)
+		timeSeries.EXPECT().HasNext().Return(true)
+		timeSeries.EXPECT().Next().Return(familyTime, it)
+		timeSeries.EXPECT().HasNext().Return(false)
+		return timeSeries
+	}
+	series1 := mockDistributionSeries("f1", field.Distribution, []float64{4, 1, 3, 2, 5})
+	series2 := mockDistributionSeries(field.Name(stmt.DistinctFieldName("host")), field.Distinct, []float64{10, 20})
+	timeSeries := series.NewMockGroupedIterator(ctrl)
+
+	q, _ := sql.Parse("select percentile(f1, 90) as p90, median(f1), distinct_count(host) as hosts, median(f2) from cpu")
+	query := q.(*stmt.Query)
+	expression := NewExpression(timeutil.TimeRange{
+		Start: now,
+		End:   now + commontimeutil.OneHour*2,
+	}, commontimeutil.OneMinute, query.SelectItems)
+	gomock.InOrder(
+		timeSeries.EXPECT().HasNext().Return(true),
+		timeSeries.EXPECT().Next().Return(series1),
+		timeSeries.EXPECT().HasNext().Return(true),
+		timeSeries.EXPECT().Next().Return(series2),
+		timeSeries.EXPECT().HasNext().Return(false),
+	)
+	expression.Eval(timeSeries)
+	resultSet := expression.ResultSet()
+	assert.Equal(t, 3, len(resultSet))
+	assert.Equal(t, 4.6, resultSet["p90"].GetValue(50-10))
+	assert.Equal(t, 1, resultSet["p90"].Size())
+	assert.Equal(t, 3.0, resultSet["median(f1)"].GetValue(50-10))
+	assert.Equal(t, 2.0, resultSet["hosts"].GetValue(50-10))
+}
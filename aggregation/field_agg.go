@@ -43,10 +43,12 @@ type FieldAggregator interface {
 // fieldAggregator implements field aggregator interface, aggregator field series based on aggregator spec.
 type fieldAggregator struct {
 	aggTypes         []field.AggType
+	seriesAggType    field.AggType // merges values of same series for distribution agg type
 	segmentStartTime int64
 	start, end       int // slot range based on query interval and time range
 
 	fieldSeriesList []*collections.FloatArray
+	distribution    *distributionValues // values collected from each series, nil if no distribution agg type
 }
 
 // NewFieldAggregator creates a field aggregator,
@@ -54,6 +56,9 @@ type fieldAggregator struct {
 // e.g. segment start time = 20190905 10:00:00, start = 10, end = 50, interval = 10 seconds,
 // real query time range {20190905 10:01:40 ~ 20190905 10:08:20}
 func NewFieldAggregator(aggSpec AggregatorSpec, segmentStartTime int64, start, end int) FieldAggregator {
+	if aggSpec.GetFieldType() == field.NativeHistogramField {
+		return newHistogramFieldAggregator(segmentStartTime, start, end)
+	}
 	var aggTypes []field.AggType
 	for f := range aggSpec.Functions() {
 		aggTypes = append(aggTypes, aggSpec.GetFieldType().GetFuncFieldParams(f)...)
@@ -63,50 +68,107 @@ func NewFieldAggregator(aggSpec AggregatorSpec, segmentStartTime int64, start, e
 
 	agg := &fieldAggregator{
 		aggTypes:         aggTypes,
+		seriesAggType:    field.Last,
 		segmentStartTime: segmentStartTime,
 		start:            start,
 		end:              end,
 		fieldSeriesList:  make([]*collections.FloatArray, len(aggTypes)),
 	}
+	if fieldType := aggSpec.GetFieldType(); fieldType != field.Unknown {
+		agg.seriesAggType = fieldType.AggType()
+	}
+	for _, aggType := range aggTypes {
+		if aggType.IsDistribution() {
+			agg.distribution = newDistributionValues(aggType, end-start+1)
+		}
+	}
 	return agg
 }
 
 // ResultSet returns the result set of field aggregator
 func (a *fieldAggregator) ResultSet() (startTime int64, it series.FieldIterator) {
-	return a.segmentStartTime, newFieldIterator(a.start, a.aggTypes, a.fieldSeriesList)
+	return a.segmentStartTime, newFieldIterator(a.start, a.aggTypes, a.fieldSeriesList, a.distribution)
 }
 
-// Aggregate aggregates the field series into current aggregator
+// Aggregate aggregates the field series into current aggregator,
+// if agg type of primitive series matches aggregator's agg type, only aggregates it into same agg type.
 func (a *fieldAggregator) Aggregate(it series.FieldIterator) {
 	for it.HasNext() {
 		pIt := it.Next()
+		idx := a.getAggTypeIndex(pIt.AggType())
+		if idx >= 0 && a.aggTypes[idx].IsDistribution() {
+			a.aggregateDistribution(pIt)
+			continue
+		}
 		for pIt.HasNext() {
 			slot, value := pIt.Next()
-			a.AggregateBySlot(slot, value)
+			if idx < 0 {
+				a.AggregateBySlot(slot, value)
+			} else {
+				a.aggregateBySlot(idx, slot, value)
+			}
 		}
 	}
 }
 
+// aggregateDistribution collects the values of each series into distribution,
+// primitive series is the value of one series if it isn't distribution iterator.
+func (a *fieldAggregator) aggregateDistribution(it series.PrimitiveIterator) {
+	if dIt, ok := it.(series.DistributionIterator); ok {
+		for dIt.HasNext() {
+			slot, values := dIt.NextValues()
+			for _, value := range values {
+				a.distribution.add(slot-a.start, value)
+			}
+		}
+		return
+	}
+	for it.HasNext() {
+		slot, value := it.Next()
+		a.distribution.add(slot-a.start, value)
+	}
+}
+
+// getAggTypeIndex returns the index of agg type, returns -1 if not found.
+func (a *fieldAggregator) getAggTypeIndex(aggType field.AggType) int {
+	for idx, t := range a.aggTypes {
+		if t == aggType {
+			return idx
+		}
+	}
+	return -1
+}
+
 // AggregateBySlot aggregates the field series into current aggregator
 func (a *fieldAggregator) AggregateBySlot(slot int, value float64) {
+	for idx := range a.aggTypes {
+		a.aggregateBySlot(idx, slot, value)
+	}
+}
+
+// aggregateBySlot aggregates the value into the series of spec agg type.
+func (a *fieldAggregator) aggregateBySlot(idx, slot int, value float64) {
 	// drop inf value
 	if math.IsInf(value, 1) {
 		return
 	}
 	pos := slot - a.start
-	for idx, aggType := range a.aggTypes {
-		values := a.fieldSeriesList[idx]
-		if values == nil {
-			values = collections.NewFloatArray(a.end - a.start + 1)
-			values.SetValue(pos, value)
-			a.fieldSeriesList[idx] = values
-		} else {
-			// slot too large for last family
-			if values.HasValue(pos) {
-				values.SetValue(pos, aggType.Aggregate(values.GetValue(pos), value))
-			} else {
-				values.SetValue(pos, value)
+	values := a.fieldSeriesList[idx]
+	if values == nil {
+		values = collections.NewFloatArray(a.end - a.start + 1)
+		values.SetValue(pos, value)
+		a.fieldSeriesList[idx] = values
+	} else {
+		// slot too large for last family
+		if values.HasValue(pos) {
+			aggType := a.aggTypes[idx]
+			if aggType.IsDistribution() {
+				// value of one series, merges it by field's agg type
+				aggType = a.seriesAggType
 			}
+			values.SetValue(pos, aggType.Aggregate(values.GetValue(pos), value))
+		} else {
+			values.SetValue(pos, value)
 		}
 	}
 }
@@ -119,6 +181,9 @@ func (a *fieldAggregator) reset() {
 		}
 		a.fieldSeriesList[idx].Reset()
 	}
+	if a.distribution != nil {
+		a.distribution.reset()
+	}
 }
 
 // uniqueAggTypes removes duplicate elements from types
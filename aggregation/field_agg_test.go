@@ -25,6 +25,7 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/pkg/collections"
 	"github.com/lindb/lindb/series"
 	"github.com/lindb/lindb/series/field"
 )
@@ -47,6 +48,7 @@ func TestFieldAggregator_Aggregate(t *testing.T) {
 	it.EXPECT().HasNext().Return(false)
 	pIt := series.NewMockPrimitiveIterator(ctrl)
 	it.EXPECT().Next().Return(pIt)
+	pIt.EXPECT().AggType().Return(field.Sum)
 	pIt.EXPECT().HasNext().Return(true)
 	pIt.EXPECT().Next().Return(20, 10.0)
 	pIt.EXPECT().HasNext().Return(false)
@@ -59,6 +61,124 @@ func TestFieldAggregator_Aggregate(t *testing.T) {
 	agg.reset()
 }
 
+func TestFieldAggregator_Aggregate_by_agg_type(t *testing.T) {
+	aggSpec := NewAggregatorSpec("f", field.SumField)
+	aggSpec.AddFunctionType(function.Sum)
+	aggSpec.AddFunctionType(function.Max)
+	agg := NewFieldAggregator(aggSpec, 1, 10, 20)
+
+	sumValues := collections.NewFloatArray(11)
+	sumValues.SetValue(0, 10.0)
+	maxValues := collections.NewFloatArray(11)
+	maxValues.SetValue(0, 3.0)
+	agg.Aggregate(newFieldIterator(10, []field.AggType{field.Sum, field.Max},
+		[]*collections.FloatArray{sumValues, maxValues}, nil))
+	// unknown agg type aggregates into all agg types
+	minValues := collections.NewFloatArray(11)
+	minValues.SetValue(0, 1.0)
+	agg.Aggregate(newFieldIterator(10, []field.AggType{field.Min}, []*collections.FloatArray{minValues}, nil))
+
+	_, it := agg.ResultSet()
+	for it.HasNext() {
+		pIt := it.Next()
+		assert.True(t, pIt.HasNext())
+		slot, value := pIt.Next()
+		assert.Equal(t, 10, slot)
+		switch pIt.AggType() {
+		case field.Sum:
+			assert.Equal(t, 11.0, value)
+		case field.Max:
+			assert.Equal(t, 3.0, value)
+		default:
+			t.Fatalf("unexpected agg type %v", pIt.AggType())
+		}
+	}
+}
+
+func TestFieldAggregator_Distribution(t *testing.T) {
+	aggSpec := NewAggregatorSpec("f", field.MaxField)
+	aggSpec.AddFunctionType(function.Percentile)
+	aggSpec.AddFunctionType(function.Max)
+	// down sampling of one series merges values by field's agg type
+	series1 := NewFieldAggregator(aggSpec, 1, 10, 20)
+	series1.AggregateBySlot(10, 3.0)
+	series1.AggregateBySlot(10, 5.0)
+	series2 := NewFieldAggregator(aggSpec, 1, 10, 20)
+	series2.AggregateBySlot(10, 1.0)
+	series2.AggregateBySlot(12, 2.0)
+
+	// merges values of each series
+	agg := NewFieldAggregator(aggSpec, 1, 10, 20)
+	_, it := series1.ResultSet()
+	agg.Aggregate(it)
+	_, it = series2.ResultSet()
+	agg.Aggregate(it)
+	_, it = agg.ResultSet()
+	data, err := it.MarshalBinary()
+	assert.NoError(t, err)
+
+	// merges distribution of each node
+	agg2 := NewFieldAggregator(aggSpec, 1, 10, 20)
+	agg2.Aggregate(series.NewFieldIterator(data))
+	agg2.Aggregate(series.NewFieldIterator(data))
+	_, it = agg2.ResultSet()
+	for it.HasNext() {
+		pIt := it.Next()
+		switch pIt.AggType() {
+		case field.Distribution:
+			dIt, ok := pIt.(series.DistributionIterator)
+			assert.True(t, ok)
+			assert.True(t, dIt.HasNext())
+			slot, values := dIt.NextValues()
+			assert.Equal(t, 10, slot)
+			assert.Equal(t, []float64{5, 1, 5, 1}, values)
+			assert.True(t, dIt.HasNext())
+			slot, values = dIt.NextValues()
+			assert.Equal(t, 12, slot)
+			assert.Equal(t, []float64{2, 2}, values)
+			assert.False(t, dIt.HasNext())
+		case field.Max:
+			assert.True(t, pIt.HasNext())
+			slot, value := pIt.Next()
+			assert.Equal(t, 10, slot)
+			assert.Equal(t, 5.0, value)
+		default:
+			t.Fatalf("unexpected agg type %v", pIt.AggType())
+		}
+	}
+	agg2.reset()
+	_, it = agg2.ResultSet()
+	for it.HasNext() {
+		assert.False(t, it.Next().HasNext())
+	}
+}
+
+func TestFieldAggregator_Distinct(t *testing.T) {
+	aggSpec := NewAggregatorSpec("distinct_count(host)", field.Unknown)
+	aggSpec.AddFunctionType(function.DistinctCount)
+	series1 := NewFieldAggregator(aggSpec, 1, 10, 20)
+	series1.AggregateBySlot(10, 7.0)
+	series2 := NewFieldAggregator(aggSpec, 1, 10, 20)
+	series2.AggregateBySlot(10, 7.0)
+	series2.AggregateBySlot(11, 8.0)
+
+	agg := NewFieldAggregator(aggSpec, 1, 10, 20)
+	_, it := series1.ResultSet()
+	agg.Aggregate(it)
+	_, it = series2.ResultSet()
+	agg.Aggregate(it)
+	_, it = agg.ResultSet()
+	assert.True(t, it.HasNext())
+	pIt := it.Next()
+	assert.Equal(t, field.Distinct, pIt.AggType())
+	dIt := pIt.(series.DistributionIterator)
+	_, values := dIt.NextValues()
+	assert.Equal(t, []float64{7}, values)
+	_, values = dIt.NextValues()
+	assert.Equal(t, []float64{8}, values)
+	assert.False(t, dIt.HasNext())
+}
+
 func TestFieldAggregator_uniqueAggTypes(t *testing.T) {
 	testCases := []struct {
 		input    []field.AggType
@@ -39,6 +39,7 @@ type fieldIterator struct {
 	aggTypes  []field.AggType
 
 	fieldSeriesList []*collections.FloatArray
+	distribution    *distributionValues
 
 	length int
 	idx    int
@@ -49,11 +50,13 @@ func newFieldIterator(
 	startSlot int,
 	aggTypes []field.AggType,
 	fieldSeriesList []*collections.FloatArray,
+	distribution *distributionValues,
 ) series.FieldIterator {
 	return &fieldIterator{
 		startSlot:       startSlot,
 		aggTypes:        aggTypes,
 		fieldSeriesList: fieldSeriesList,
+		distribution:    distribution,
 		length:          len(fieldSeriesList),
 	}
 }
@@ -68,7 +71,14 @@ func (it *fieldIterator) Next() series.PrimitiveIterator {
 	if it.idx >= it.length {
 		return nil
 	}
-	primitiveIt := newPrimitiveIterator(it.startSlot, it.aggTypes[it.idx], it.fieldSeriesList[it.idx])
+	var primitiveIt series.PrimitiveIterator
+	aggType := it.aggTypes[it.idx]
+	if aggType.IsDistribution() && it.distribution != nil && it.distribution.values != nil {
+		// values collected from each series
+		primitiveIt = newDistributionPrimitiveIterator(it.startSlot, aggType, it.distribution.values)
+	} else {
+		primitiveIt = newPrimitiveIterator(it.startSlot, aggType, it.fieldSeriesList[it.idx])
+	}
 	it.idx++
 	return primitiveIt
 }
@@ -86,6 +96,16 @@ func (it *fieldIterator) MarshalBinary() ([]byte, error) {
 
 	for it.HasNext() {
 		primitiveIt := it.Next()
+		if dIt, ok := primitiveIt.(series.DistributionIterator); ok {
+			data, err := marshalDistribution(dIt)
+			if err != nil {
+				return nil, err
+			}
+			writer.PutByte(byte(primitiveIt.AggType()))
+			writer.PutVarint32(int32(len(data)))
+			writer.PutBytes(data)
+			continue
+		}
 		if encoder == nil {
 			encoder = encoding.TSDEncodeFunc(uint16(it.startSlot))
 		} else {
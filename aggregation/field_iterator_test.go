@@ -30,14 +30,14 @@ import (
 )
 
 func TestFieldIterator(t *testing.T) {
-	it := newFieldIterator(20, []field.AggType{field.Sum}, []*collections.FloatArray{generateFloatArray(nil)})
+	it := newFieldIterator(20, []field.AggType{field.Sum}, []*collections.FloatArray{generateFloatArray(nil)}, nil)
 	assert.True(t, it.HasNext())
 	assert.NotNil(t, it.Next())
 	data, err := it.MarshalBinary()
 	assert.NoError(t, err)
 	assert.NotNil(t, data)
 
-	it = newFieldIterator(20, []field.AggType{field.Min}, []*collections.FloatArray{generateFloatArray([]float64{0, 10, 10.0, 100.4, 50.0})})
+	it = newFieldIterator(20, []field.AggType{field.Min}, []*collections.FloatArray{generateFloatArray([]float64{0, 10, 10.0, 100.4, 50.0})}, nil)
 
 	expect := map[int]float64{20: 0, 21: 10, 22: 10.0, 23: 100.4, 24: 50.0}
 	AssertFieldIt(t, it, expect)
@@ -50,7 +50,7 @@ func TestFieldIterator(t *testing.T) {
 	assert.NotNil(t, data)
 
 	// test empty data
-	it = newFieldIterator(20, nil, nil)
+	it = newFieldIterator(20, nil, nil, nil)
 	assert.False(t, it.HasNext())
 	assert.Nil(t, it.Next())
 
@@ -64,7 +64,7 @@ func TestFieldIterator_MarshalBinary(t *testing.T) {
 		toBytesFn = toBytes
 	}()
 	pData := generateFloatArray([]float64{0, 10, 10.0, 100.4, 50.0})
-	it := newFieldIterator(10, []field.AggType{field.Sum}, []*collections.FloatArray{pData})
+	it := newFieldIterator(10, []field.AggType{field.Sum}, []*collections.FloatArray{pData}, nil)
 	data, err := it.MarshalBinary()
 	assert.NoError(t, err)
 	assert.True(t, len(data) > 0)
@@ -76,7 +76,7 @@ func TestFieldIterator_MarshalBinary(t *testing.T) {
 
 	floatArray := collections.NewFloatArray(4)
 	floatArray.SetValue(3, float64(3))
-	it = newFieldIterator(5, []field.AggType{field.Sum}, []*collections.FloatArray{floatArray})
+	it = newFieldIterator(5, []field.AggType{field.Sum}, []*collections.FloatArray{floatArray}, nil)
 	data, err = it.MarshalBinary()
 	assert.NoError(t, err)
 	assert.True(t, len(data) > 0)
@@ -86,7 +86,7 @@ func TestFieldIterator_MarshalBinary(t *testing.T) {
 	AssertFieldIt(t, fIt, expect)
 	assert.False(t, fIt.HasNext())
 
-	it = newFieldIterator(10, []field.AggType{field.Sum, field.Sum}, []*collections.FloatArray{pData, pData})
+	it = newFieldIterator(10, []field.AggType{field.Sum, field.Sum}, []*collections.FloatArray{pData, pData}, nil)
 	data, err = it.MarshalBinary()
 	assert.NoError(t, err)
 	assert.True(t, len(data) > 0)
@@ -94,7 +94,7 @@ func TestFieldIterator_MarshalBinary(t *testing.T) {
 	toBytesFn = func(e *encoding.TSDEncoder) ([]byte, error) {
 		return nil, fmt.Errorf("err")
 	}
-	it = newFieldIterator(10, []field.AggType{field.Sum, field.Sum}, []*collections.FloatArray{pData, pData})
+	it = newFieldIterator(10, []field.AggType{field.Sum, field.Sum}, []*collections.FloatArray{pData, pData}, nil)
 	data, err = it.MarshalBinary()
 	assert.Error(t, err)
 	assert.Nil(t, data)
@@ -22,6 +22,7 @@ import (
 	"github.com/lindb/lindb/pkg/collections"
 	"github.com/lindb/lindb/series"
 	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/series/histogram"
 )
 
 //go:generate mockgen -source=./field.go -destination=./field_mock.go -package=fields
@@ -36,13 +37,20 @@ type Field interface {
 	GetValues(funcType function.FuncType) (result []*collections.FloatArray)
 	// GetDefaultValues returns the field default values which aggregation need if user not input function type.
 	GetDefaultValues() (result []*collections.FloatArray)
+	// GetHistograms returns the native histogram values, if field isn't native histogram returns nil.
+	GetHistograms() []*histogram.Histogram
+	// GetDistributions returns the values collected from each series by time slot,
+	// if field isn't distribution returns nil.
+	GetDistributions() [][]float64
 	// Reset resets field's value for reusing.
 	Reset()
 }
 
 // dynamicField represents the dynamic field for storing multi-agg types.
 type dynamicField struct {
-	fields map[field.AggType]*collections.FloatArray
+	fields        map[field.AggType]*collections.FloatArray
+	histograms    []*histogram.Histogram
+	distributions [][]float64
 
 	fieldType field.Type
 	startTime int64
@@ -86,6 +94,14 @@ func (f *dynamicField) SetValue(fieldSeries series.Iterator) {
 				fieldValues = collections.NewFloatArray(f.capacity)
 				f.fields[aggType] = fieldValues
 			}
+			if hIt, isHistogram := pIt.(series.HistogramIterator); isHistogram {
+				f.setHistograms(startTime, fieldValues, hIt)
+				continue
+			}
+			if dIt, isDistribution := pIt.(series.DistributionIterator); isDistribution {
+				f.setDistributions(startTime, fieldValues, dIt)
+				continue
+			}
 			for pIt.HasNext() {
 				slot, val := pIt.Next()
 				idx := ((int64(slot)*f.interval + startTime) - f.startTime) / f.interval
@@ -95,6 +111,55 @@ func (f *dynamicField) SetValue(fieldSeries series.Iterator) {
 	}
 }
 
+// setHistograms sets the native histogram values and counts of histogram by time slot.
+func (f *dynamicField) setHistograms(startTime int64, counts *collections.FloatArray, it series.HistogramIterator) {
+	if f.histograms == nil {
+		f.histograms = make([]*histogram.Histogram, f.capacity)
+	}
+	for it.HasNext() {
+		slot, h := it.NextHistogram()
+		if h == nil {
+			continue
+		}
+		idx := int(((int64(slot)*f.interval + startTime) - f.startTime) / f.interval)
+		if idx < 0 || idx >= f.capacity {
+			continue
+		}
+		f.histograms[idx] = h
+		counts.SetValue(idx, h.Count)
+	}
+}
+
+// setDistributions sets the values collected from each series and counts of values by time slot.
+func (f *dynamicField) setDistributions(startTime int64, counts *collections.FloatArray, it series.DistributionIterator) {
+	if f.distributions == nil {
+		f.distributions = make([][]float64, f.capacity)
+	}
+	for it.HasNext() {
+		slot, values := it.NextValues()
+		if len(values) == 0 {
+			continue
+		}
+		idx := int(((int64(slot)*f.interval + startTime) - f.startTime) / f.interval)
+		if idx < 0 || idx >= f.capacity {
+			continue
+		}
+		f.distributions[idx] = values
+		counts.SetValue(idx, float64(len(values)))
+	}
+}
+
+// GetDistributions returns the values collected from each series by time slot,
+// if field isn't distribution returns nil.
+func (f *dynamicField) GetDistributions() [][]float64 {
+	return f.distributions
+}
+
+// GetHistograms returns the native histogram values, if field isn't native histogram returns nil.
+func (f *dynamicField) GetHistograms() []*histogram.Histogram {
+	return f.histograms
+}
+
 // GetValues returns the values which function call need by given function type and field type
 func (f *dynamicField) GetValues(funcType function.FuncType) (result []*collections.FloatArray) {
 	pFields := f.fieldType.GetFuncFieldParams(funcType)
@@ -110,6 +175,12 @@ func (f *dynamicField) Reset() {
 	for _, pField := range f.fields {
 		pField.Reset()
 	}
+	for idx := range f.histograms {
+		f.histograms[idx] = nil
+	}
+	for idx := range f.distributions {
+		f.distributions[idx] = nil
+	}
 }
 
 // getFieldValues returns the values by field name and agg type.
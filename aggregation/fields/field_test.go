@@ -18,14 +18,17 @@
 package fields
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
 	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/pkg/stream"
 	"github.com/lindb/lindb/series"
 	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/series/histogram"
 )
 
 func TestNewDynamicField(t *testing.T) {
@@ -83,3 +86,80 @@ func mockSingleIterator(ctrl *gomock.Controller) series.Iterator {
 	primitiveIt.EXPECT().HasNext().Return(false)
 	return fIt
 }
+
+func TestDynamicField_Histogram(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	encoder := histogram.NewBlockEncoder()
+	encoder.Append(1, &histogram.Histogram{Schema: 0, Count: 2, Sum: 3, Buckets: []histogram.Bucket{{Index: 1, Count: 2}}})
+	// out of range
+	encoder.Append(100, &histogram.Histogram{Schema: 0, Count: 1, Sum: 3, Buckets: []histogram.Bucket{{Index: 1, Count: 1}}})
+	block, err := encoder.Bytes()
+	assert.NoError(t, err)
+	writer := stream.NewBufferWriter(nil)
+	writer.PutByte(byte(field.Histogram))
+	writer.PutVarint32(int32(len(block)))
+	writer.PutBytes(block)
+	data, err := writer.Bytes()
+	assert.NoError(t, err)
+
+	f := NewDynamicField(field.NativeHistogramField, 10, 10, 10)
+	assert.Nil(t, f.GetHistograms())
+	fIt := series.NewMockIterator(ctrl)
+	fIt.EXPECT().HasNext().Return(true)
+	fIt.EXPECT().Next().Return(int64(10), series.NewFieldIterator(data))
+	fIt.EXPECT().HasNext().Return(false)
+	f.SetValue(fIt)
+	histograms := f.GetHistograms()
+	assert.Len(t, histograms, 10)
+	assert.Equal(t, 3.0, histograms[1].Sum)
+	values := f.GetDefaultValues()
+	assert.Len(t, values, 1)
+	assert.Equal(t, 2.0, values[0].GetValue(1))
+	assert.Equal(t, 1, values[0].Size())
+
+	f.Reset()
+	assert.Nil(t, f.GetHistograms()[1])
+}
+
+func TestDynamicField_Distribution(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	block := stream.NewBufferWriter(nil)
+	block.PutUvarint32(1)
+	block.PutUvarint32(2)
+	block.PutUint64(math.Float64bits(1.5))
+	block.PutUint64(math.Float64bits(3.0))
+	// out of range
+	block.PutUvarint32(100)
+	block.PutUvarint32(1)
+	block.PutUint64(math.Float64bits(4.0))
+	blockData, err := block.Bytes()
+	assert.NoError(t, err)
+	writer := stream.NewBufferWriter(nil)
+	writer.PutByte(byte(field.Distribution))
+	writer.PutVarint32(int32(len(blockData)))
+	writer.PutBytes(blockData)
+	data, err := writer.Bytes()
+	assert.NoError(t, err)
+
+	f := NewDynamicField(field.SumField, 10, 10, 10)
+	assert.Nil(t, f.GetDistributions())
+	fIt := series.NewMockIterator(ctrl)
+	fIt.EXPECT().HasNext().Return(true)
+	fIt.EXPECT().Next().Return(int64(10), series.NewFieldIterator(data))
+	fIt.EXPECT().HasNext().Return(false)
+	f.SetValue(fIt)
+	distributions := f.GetDistributions()
+	assert.Len(t, distributions, 10)
+	assert.Equal(t, []float64{1.5, 3.0}, distributions[1])
+	values := f.GetValues(function.Percentile)
+	assert.Len(t, values, 1)
+	assert.Equal(t, 2.0, values[0].GetValue(1))
+	assert.Equal(t, 1, values[0].Size())
+
+	f.Reset()
+	assert.Nil(t, f.GetDistributions()[1])
+}
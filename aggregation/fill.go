@@ -0,0 +1,92 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package aggregation
+
+import (
+	"math"
+
+	"github.com/lindb/lindb/pkg/collections"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+// Fill fills the empty slots of down sampling result based on fill type(in place).
+// NOTE: null fill keeps empty slots, because result points cannot hold null value.
+func Fill(values *collections.FloatArray, fillType stmt.FillType, fillValue float64) {
+	if values == nil || values.IsSingle() {
+		return
+	}
+	switch fillType {
+	case stmt.ValueFill:
+		fillConstant(values, fillValue)
+	case stmt.PreviousFill:
+		fillPrevious(values)
+	case stmt.LinearFill:
+		fillLinear(values)
+	default:
+		// no fill/null fill, nothing to do
+	}
+}
+
+// fillConstant sets the constant value for all empty slots.
+func fillConstant(values *collections.FloatArray, fillValue float64) {
+	for i := 0; i < values.Capacity(); i++ {
+		if !hasValue(values, i) {
+			values.SetValue(i, fillValue)
+		}
+	}
+}
+
+// fillPrevious carries the previous value forward, leading empty slots keep empty.
+func fillPrevious(values *collections.FloatArray) {
+	found := false
+	prev := 0.0
+	for i := 0; i < values.Capacity(); i++ {
+		if hasValue(values, i) {
+			found = true
+			prev = values.GetValue(i)
+			continue
+		}
+		if found {
+			values.SetValue(i, prev)
+		}
+	}
+}
+
+// fillLinear interpolates empty slots between two known values,
+// leading/trailing empty slots keep empty.
+func fillLinear(values *collections.FloatArray) {
+	prevPos := -1
+	for i := 0; i < values.Capacity(); i++ {
+		if !hasValue(values, i) {
+			continue
+		}
+		if prevPos >= 0 && i-prevPos > 1 {
+			start := values.GetValue(prevPos)
+			step := (values.GetValue(i) - start) / float64(i-prevPos)
+			for j := prevPos + 1; j < i; j++ {
+				values.SetValue(j, start+step*float64(j-prevPos))
+			}
+		}
+		prevPos = i
+	}
+}
+
+// hasValue checks if slot has a valid value.
+func hasValue(values *collections.FloatArray, pos int) bool {
+	return values.HasValue(pos) && !math.IsNaN(values.GetValue(pos))
+}
@@ -0,0 +1,98 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package aggregation
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/collections"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestFill(t *testing.T) {
+	newValues := func() *collections.FloatArray {
+		values := collections.NewFloatArray(7)
+		values.SetValue(1, 1)
+		values.SetValue(2, math.NaN())
+		values.SetValue(4, 4)
+		return values
+	}
+	toMap := func(values *collections.FloatArray) map[int]float64 {
+		rs := make(map[int]float64)
+		it := values.NewIterator()
+		for it.HasNext() {
+			slot, val := it.Next()
+			if !math.IsNaN(val) {
+				rs[slot] = val
+			}
+		}
+		return rs
+	}
+	cases := []struct {
+		name      string
+		fillType  stmt.FillType
+		fillValue float64
+		expect    map[int]float64
+	}{
+		{
+			name:     "no fill",
+			fillType: stmt.NoFill,
+			expect:   map[int]float64{1: 1, 4: 4},
+		},
+		{
+			name:     "null fill",
+			fillType: stmt.NullFill,
+			expect:   map[int]float64{1: 1, 4: 4},
+		},
+		{
+			name:      "value fill",
+			fillType:  stmt.ValueFill,
+			fillValue: 0,
+			expect:    map[int]float64{0: 0, 1: 1, 2: 0, 3: 0, 4: 4, 5: 0, 6: 0},
+		},
+		{
+			name:     "previous fill",
+			fillType: stmt.PreviousFill,
+			expect:   map[int]float64{1: 1, 2: 1, 3: 1, 4: 4, 5: 4, 6: 4},
+		},
+		{
+			name:     "linear fill",
+			fillType: stmt.LinearFill,
+			expect:   map[int]float64{1: 1, 2: 2, 3: 3, 4: 4},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			values := newValues()
+			Fill(values, tt.fillType, tt.fillValue)
+			assert.Equal(t, tt.expect, toMap(values))
+		})
+	}
+
+	// nil/single values
+	Fill(nil, stmt.ValueFill, 1)
+	single := collections.NewFloatArray(3)
+	single.SetSingle(true)
+	single.SetValue(0, 1)
+	Fill(single, stmt.ValueFill, 2)
+	assert.Equal(t, map[int]float64{0: 1}, toMap(single))
+}
@@ -0,0 +1,68 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package function
+
+import (
+	"math"
+	"sort"
+
+	"github.com/lindb/lindb/pkg/collections"
+)
+
+// DistributionCall evaluates distribution function based on the values collected from each series by time slot,
+// percentile(field, p) needs p in [0, 100], median is 50th percentile, distinct_count counts the values.
+func DistributionCall(funcType FuncType, values [][]float64, args ...float64) *collections.FloatArray {
+	if len(values) == 0 {
+		return nil
+	}
+	p := 50.0
+	switch funcType {
+	case Percentile:
+		if len(args) != 1 || args[0] < 0 || args[0] > 100 {
+			return nil
+		}
+		p = args[0]
+	case Median, DistinctCount:
+	default:
+		return nil
+	}
+	result := collections.NewFloatArray(len(values))
+	for pos, slotValues := range values {
+		if len(slotValues) == 0 {
+			continue
+		}
+		if funcType == DistinctCount {
+			result.SetValue(pos, float64(len(slotValues)))
+		} else {
+			result.SetValue(pos, PercentileOf(slotValues, p))
+		}
+	}
+	return result
+}
+
+// PercentileOf returns the percentile of values with linear interpolation between closest ranks, p in [0, 100].
+func PercentileOf(values []float64, p float64) float64 {
+	sort.Float64s(values)
+	rank := p / 100 * float64(len(values)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	if lower == upper {
+		return values[lower]
+	}
+	return values[lower] + (values[upper]-values[lower])*(rank-float64(lower))
+}
@@ -0,0 +1,53 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDistributionCall(t *testing.T) {
+	assert.Nil(t, DistributionCall(Percentile, nil, 90))
+	values := [][]float64{{4, 1, 3, 2, 5}, nil, {7}}
+	assert.Nil(t, DistributionCall(Percentile, values))
+	assert.Nil(t, DistributionCall(Percentile, values, 101))
+	assert.Nil(t, DistributionCall(Sum, values))
+
+	rs := DistributionCall(Percentile, values, 90)
+	assert.Equal(t, 4.6, rs.GetValue(0))
+	assert.False(t, rs.HasValue(1))
+	assert.Equal(t, 7.0, rs.GetValue(2))
+
+	rs = DistributionCall(Median, values)
+	assert.Equal(t, 3.0, rs.GetValue(0))
+	assert.Equal(t, 7.0, rs.GetValue(2))
+
+	rs = DistributionCall(DistinctCount, values)
+	assert.Equal(t, 5.0, rs.GetValue(0))
+	assert.False(t, rs.HasValue(1))
+	assert.Equal(t, 1.0, rs.GetValue(2))
+}
+
+func TestPercentileOf(t *testing.T) {
+	assert.Equal(t, 1.0, PercentileOf([]float64{3, 1, 2}, 0))
+	assert.Equal(t, 2.0, PercentileOf([]float64{3, 1, 2}, 50))
+	assert.Equal(t, 3.0, PercentileOf([]float64{3, 1, 2}, 100))
+	assert.Equal(t, 2.5, PercentileOf([]float64{1, 2, 3, 4}, 50))
+}
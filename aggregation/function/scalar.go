@@ -0,0 +1,156 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package function
+
+import (
+	"math"
+
+	"github.com/lindb/lindb/pkg/collections"
+)
+
+// ScalarCall calls the scalar function, which evaluates each point independently,
+// params are the series or constant(single float array) params of function.
+// Point without value or with non-finite result(e.g. log10(0), sqrt(-1)) is skipped.
+func ScalarCall(funcType FuncType, params ...*collections.FloatArray) *collections.FloatArray {
+	if len(params) == 0 {
+		return nil
+	}
+	for _, param := range params {
+		if param == nil {
+			return nil
+		}
+	}
+	switch funcType {
+	case Abs:
+		return unaryCall(params, math.Abs)
+	case Ceil:
+		return unaryCall(params, math.Ceil)
+	case Floor:
+		return unaryCall(params, math.Floor)
+	case Sqrt:
+		return unaryCall(params, math.Sqrt)
+	case Log:
+		return unaryCall(params, math.Log)
+	case Log2:
+		return unaryCall(params, math.Log2)
+	case Log10:
+		return unaryCall(params, math.Log10)
+	case Round:
+		if len(params) == 1 {
+			return unaryCall(params, math.Round)
+		}
+		return binaryCall(params, func(value, digits float64) float64 {
+			scale := math.Pow(10, math.Trunc(digits))
+			return math.Round(value*scale) / scale
+		})
+	case Pow:
+		return binaryCall(params, math.Pow)
+	case ClampMin:
+		return binaryCall(params, math.Max)
+	case ClampMax:
+		return binaryCall(params, math.Min)
+	case If:
+		return ifCall(params)
+	case Coalesce:
+		return coalesce(params)
+	default:
+		return nil
+	}
+}
+
+// unaryCall evaluates the function with one param for each point.
+func unaryCall(params []*collections.FloatArray, fn func(value float64) float64) *collections.FloatArray {
+	if len(params) != 1 {
+		return nil
+	}
+	values := params[0]
+	capacity := values.Capacity()
+	result := collections.NewFloatArray(capacity)
+	for i := 0; i < capacity; i++ {
+		if values.HasValue(i) {
+			setFinite(result, i, fn(values.GetValue(i)))
+		}
+	}
+	return result
+}
+
+// binaryCall evaluates the function with two params for each point, both params need value.
+func binaryCall(params []*collections.FloatArray, fn func(left, right float64) float64) *collections.FloatArray {
+	if len(params) != 2 {
+		return nil
+	}
+	left, right := params[0], params[1]
+	capacity := left.Capacity()
+	result := collections.NewFloatArray(capacity)
+	for i := 0; i < capacity; i++ {
+		if left.HasValue(i) && right.HasValue(i) {
+			setFinite(result, i, fn(left.GetValue(i), right.GetValue(i)))
+		}
+	}
+	return result
+}
+
+// ifCall returns value of second param if condition(first param) is non-zero, else returns value of third param.
+func ifCall(params []*collections.FloatArray) *collections.FloatArray {
+	if len(params) != 3 {
+		return nil
+	}
+	cond, trueValues, falseValues := params[0], params[1], params[2]
+	capacity := cond.Capacity()
+	result := collections.NewFloatArray(capacity)
+	for i := 0; i < capacity; i++ {
+		if !cond.HasValue(i) {
+			continue
+		}
+		values := falseValues
+		if cond.GetValue(i) != 0 {
+			values = trueValues
+		}
+		if values.HasValue(i) {
+			setFinite(result, i, values.GetValue(i))
+		}
+	}
+	return result
+}
+
+// coalesce returns the first param which has finite value for each point.
+func coalesce(params []*collections.FloatArray) *collections.FloatArray {
+	capacity := params[0].Capacity()
+	result := collections.NewFloatArray(capacity)
+	for i := 0; i < capacity; i++ {
+		for _, values := range params {
+			if values.HasValue(i) && isFinite(values.GetValue(i)) {
+				result.SetValue(i, values.GetValue(i))
+				break
+			}
+		}
+	}
+	return result
+}
+
+// setFinite sets the value of point if value is finite number.
+func setFinite(result *collections.FloatArray, pos int, value float64) {
+	if isFinite(value) {
+		result.SetValue(pos, value)
+	}
+}
+
+// isFinite checks if value is not NaN/Inf.
+func isFinite(value float64) bool {
+	return !math.IsNaN(value) && !math.IsInf(value, 0)
+}
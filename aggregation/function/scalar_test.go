@@ -0,0 +1,118 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package function
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/collections"
+)
+
+func TestScalarCall(t *testing.T) {
+	newValues := func(values ...float64) *collections.FloatArray {
+		array := collections.NewFloatArray(len(values))
+		for i, v := range values {
+			if v != -1 {
+				array.SetValue(i, v)
+			}
+		}
+		return array
+	}
+	single := func(v float64) *collections.FloatArray {
+		array := newValues(v, v, v, v)
+		array.SetSingle(true)
+		return array
+	}
+	toMap := func(values *collections.FloatArray) map[int]float64 {
+		if values == nil {
+			return nil
+		}
+		rs := make(map[int]float64)
+		itr := values.NewIterator()
+		for itr.HasNext() {
+			idx, val := itr.Next()
+			rs[idx] = val
+		}
+		return rs
+	}
+	// -1 means no value
+	series := newValues(100, -1, 0.5, 1000)
+	other := newValues(5, 6, -1, 7)
+	cases := []struct {
+		name     string
+		funcType FuncType
+		params   []*collections.FloatArray
+		expect   map[int]float64
+	}{
+		{name: "no params", funcType: Abs},
+		{name: "nil param", funcType: Abs, params: []*collections.FloatArray{nil}},
+		{name: "unknown func", funcType: Sum, params: []*collections.FloatArray{series}},
+		{name: "abs", funcType: Abs, params: []*collections.FloatArray{newValues(-1.5, 2, -1, -3)},
+			expect: map[int]float64{0: 1.5, 1: 2, 3: 3}},
+		{name: "abs with wrong params", funcType: Abs, params: []*collections.FloatArray{series, other}},
+		{name: "ceil", funcType: Ceil, params: []*collections.FloatArray{newValues(1.2, -1, 2.5, 3)},
+			expect: map[int]float64{0: 2, 2: 3, 3: 3}},
+		{name: "floor", funcType: Floor, params: []*collections.FloatArray{newValues(1.2, -1, 2.5, 3)},
+			expect: map[int]float64{0: 1, 2: 2, 3: 3}},
+		{name: "round", funcType: Round, params: []*collections.FloatArray{newValues(1.2, -1, 2.5, 3.456)},
+			expect: map[int]float64{0: 1, 2: 3, 3: 3}},
+		{name: "round with digits", funcType: Round, params: []*collections.FloatArray{newValues(1.2, -1, 2.5, 3.456), single(2)},
+			expect: map[int]float64{0: 1.2, 2: 2.5, 3: 3.46}},
+		{name: "sqrt", funcType: Sqrt, params: []*collections.FloatArray{newValues(4, -1, 0.25, 9)},
+			expect: map[int]float64{0: 2, 2: 0.5, 3: 3}},
+		{name: "log", funcType: Log, params: []*collections.FloatArray{newValues(1, -1, math.E, 0)},
+			expect: map[int]float64{0: 0, 2: 1}},
+		{name: "log2", funcType: Log2, params: []*collections.FloatArray{newValues(1, -1, 2, 8)},
+			expect: map[int]float64{0: 0, 2: 1, 3: 3}},
+		{name: "log10", funcType: Log10, params: []*collections.FloatArray{series},
+			expect: map[int]float64{0: 2, 2: math.Log10(0.5), 3: 3}},
+		{name: "pow", funcType: Pow, params: []*collections.FloatArray{series, single(2)},
+			expect: map[int]float64{0: 10000, 2: 0.25, 3: 1000000}},
+		{name: "pow with series", funcType: Pow, params: []*collections.FloatArray{newValues(2, 2, 2, 2), other},
+			expect: map[int]float64{0: 32, 1: 64, 3: 128}},
+		{name: "pow with wrong params", funcType: Pow, params: []*collections.FloatArray{series}},
+		{name: "clamp min", funcType: ClampMin, params: []*collections.FloatArray{series, single(10)},
+			expect: map[int]float64{0: 100, 2: 10, 3: 1000}},
+		{name: "clamp max", funcType: ClampMax, params: []*collections.FloatArray{series, single(10)},
+			expect: map[int]float64{0: 10, 2: 0.5, 3: 10}},
+		{name: "if", funcType: If, params: []*collections.FloatArray{newValues(1, 0, 1, -1), series, other},
+			expect: map[int]float64{0: 100, 1: 6, 2: 0.5}},
+		{name: "if with wrong params", funcType: If, params: []*collections.FloatArray{series, other}},
+		{name: "coalesce", funcType: Coalesce, params: []*collections.FloatArray{series, other, single(0)},
+			expect: map[int]float64{0: 100, 1: 6, 2: 0.5, 3: 1000}},
+		{name: "coalesce skip nan", funcType: Coalesce, params: []*collections.FloatArray{newValues(math.NaN(), -1, 1, -1), other},
+			expect: map[int]float64{0: 5, 1: 6, 2: 1, 3: 7}},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			rs := toMap(ScalarCall(tt.funcType, tt.params...))
+			if tt.expect == nil {
+				assert.Nil(t, rs)
+				return
+			}
+			assert.Len(t, rs, len(tt.expect))
+			for idx, val := range tt.expect {
+				assert.InDelta(t, val, rs[idx], 1e-9)
+			}
+		})
+	}
+}
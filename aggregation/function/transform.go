@@ -0,0 +1,164 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package function
+
+import (
+	"math"
+
+	"github.com/lindb/common/pkg/timeutil"
+
+	"github.com/lindb/lindb/pkg/collections"
+)
+
+// TransformCall calls the series transform function, which evaluates each point based on previous points of series,
+// interval is the time interval(ms) between two slots, args are the constant params of function.
+func TransformCall(funcType FuncType, interval int64, values *collections.FloatArray, args ...float64) *collections.FloatArray {
+	if values == nil || interval <= 0 {
+		return nil
+	}
+	switch funcType {
+	case MovingAverage:
+		if len(args) == 0 || int(args[0]) <= 0 {
+			return nil
+		}
+		return movingAverage(values, int(args[0]))
+	case Derivative:
+		unit := getUnit(args)
+		return difference(values, func(prev, cur float64, duration int64) (float64, bool) {
+			return (cur - prev) / (float64(duration) / unit), true
+		}, interval)
+	case NonNegativeDifference:
+		return difference(values, func(prev, cur float64, _ int64) (float64, bool) {
+			return cur - prev, cur >= prev
+		}, interval)
+	case Delta:
+		return difference(values, func(prev, cur float64, _ int64) (float64, bool) {
+			return cur - prev, true
+		}, interval)
+	case Increase:
+		return difference(values, func(prev, cur float64, _ int64) (float64, bool) {
+			if cur < prev {
+				// counter reset, counter restarts from zero
+				return cur, true
+			}
+			return cur - prev, true
+		}, interval)
+	case CumulativeSum:
+		return cumulativeSum(values)
+	case Integral:
+		return integral(values, interval, getUnit(args))
+	default:
+		return nil
+	}
+}
+
+// getUnit returns the time unit(ms) from function args, default unit is one second.
+func getUnit(args []float64) float64 {
+	if len(args) > 0 && args[0] > 0 {
+		return args[0]
+	}
+	return float64(timeutil.OneSecond)
+}
+
+// movingAverage returns the average of the latest n points for each point,
+// only returns the value after n points reached.
+func movingAverage(values *collections.FloatArray, n int) *collections.FloatArray {
+	result := collections.NewFloatArray(values.Capacity())
+	window := make([]float64, 0, n)
+	sum := 0.0
+	itr := values.NewIterator()
+	for itr.HasNext() {
+		idx, val := itr.Next()
+		if math.IsNaN(val) {
+			continue
+		}
+		if len(window) == n {
+			sum -= window[0]
+			window = window[1:]
+		}
+		window = append(window, val)
+		sum += val
+		if len(window) == n {
+			result.SetValue(idx, sum/float64(n))
+		}
+	}
+	return result
+}
+
+// difference evaluates each point with previous point, first point of series is ignored.
+func difference(values *collections.FloatArray,
+	fn func(prev, cur float64, duration int64) (float64, bool),
+	interval int64,
+) *collections.FloatArray {
+	result := collections.NewFloatArray(values.Capacity())
+	prevIdx := -1
+	prev := 0.0
+	itr := values.NewIterator()
+	for itr.HasNext() {
+		idx, val := itr.Next()
+		if math.IsNaN(val) {
+			continue
+		}
+		if prevIdx >= 0 {
+			if v, ok := fn(prev, val, int64(idx-prevIdx)*interval); ok {
+				result.SetValue(idx, v)
+			}
+		}
+		prevIdx = idx
+		prev = val
+	}
+	return result
+}
+
+// cumulativeSum returns the running total of series.
+func cumulativeSum(values *collections.FloatArray) *collections.FloatArray {
+	result := collections.NewFloatArray(values.Capacity())
+	sum := 0.0
+	itr := values.NewIterator()
+	for itr.HasNext() {
+		idx, val := itr.Next()
+		if math.IsNaN(val) {
+			continue
+		}
+		sum += val
+		result.SetValue(idx, sum)
+	}
+	return result
+}
+
+// integral returns the running area under the curve of series(trapezoidal rule) by time unit.
+func integral(values *collections.FloatArray, interval int64, unit float64) *collections.FloatArray {
+	result := collections.NewFloatArray(values.Capacity())
+	prevIdx := -1
+	prev := 0.0
+	area := 0.0
+	itr := values.NewIterator()
+	for itr.HasNext() {
+		idx, val := itr.Next()
+		if math.IsNaN(val) {
+			continue
+		}
+		if prevIdx >= 0 {
+			area += (prev + val) / 2 * (float64(int64(idx-prevIdx)*interval) / unit)
+		}
+		result.SetValue(idx, area)
+		prevIdx = idx
+		prev = val
+	}
+	return result
+}
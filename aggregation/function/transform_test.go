@@ -0,0 +1,80 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package function
+
+import (
+	"math"
+	"testing"
+
+	"github.com/lindb/common/pkg/timeutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/collections"
+)
+
+func TestTransformCall(t *testing.T) {
+	newValues := func() *collections.FloatArray {
+		values := collections.NewFloatArray(6)
+		values.SetValue(0, 10)
+		values.SetValue(1, 30)
+		values.SetValue(2, math.NaN())
+		values.SetValue(3, 20)
+		values.SetValue(5, 40)
+		return values
+	}
+	toMap := func(values *collections.FloatArray) map[int]float64 {
+		if values == nil {
+			return nil
+		}
+		rs := make(map[int]float64)
+		itr := values.NewIterator()
+		for itr.HasNext() {
+			idx, val := itr.Next()
+			rs[idx] = val
+		}
+		return rs
+	}
+	interval := 10 * timeutil.OneSecond
+	cases := []struct {
+		name     string
+		funcType FuncType
+		args     []float64
+		expect   map[int]float64
+	}{
+		{name: "moving average", funcType: MovingAverage, args: []float64{2}, expect: map[int]float64{1: 20, 3: 25, 5: 30}},
+		{name: "moving average without window", funcType: MovingAverage},
+		{name: "derivative", funcType: Derivative, expect: map[int]float64{1: 2, 3: -0.5, 5: 1}},
+		{name: "derivative by minute", funcType: Derivative, args: []float64{float64(timeutil.OneMinute)},
+			expect: map[int]float64{1: 120, 3: -30, 5: 60}},
+		{name: "non negative difference", funcType: NonNegativeDifference, expect: map[int]float64{1: 20, 5: 20}},
+		{name: "delta", funcType: Delta, expect: map[int]float64{1: 20, 3: -10, 5: 20}},
+		{name: "increase", funcType: Increase, expect: map[int]float64{1: 20, 3: 20, 5: 20}},
+		{name: "cumulative sum", funcType: CumulativeSum, expect: map[int]float64{0: 10, 1: 40, 3: 60, 5: 100}},
+		{name: "integral", funcType: Integral, expect: map[int]float64{0: 0, 1: 200, 3: 700, 5: 1300}},
+		{name: "not transform", funcType: Sum},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expect, toMap(TransformCall(tt.funcType, interval, newValues(), tt.args...)))
+		})
+	}
+
+	assert.Nil(t, TransformCall(Delta, interval, nil))
+	assert.Nil(t, TransformCall(Delta, 0, newValues()))
+}
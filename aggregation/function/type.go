@@ -32,6 +32,33 @@ const (
 	Quantile
 	Stddev
 	Rate
+	HistogramCount
+	HistogramSum
+	MovingAverage
+	Derivative
+	NonNegativeDifference
+	CumulativeSum
+	Delta
+	Increase
+	Integral
+	Top
+	Bottom
+	Abs
+	Ceil
+	Floor
+	Round
+	Log
+	Log2
+	Log10
+	Sqrt
+	Pow
+	ClampMin
+	ClampMax
+	If
+	Coalesce
+	Percentile
+	Median
+	DistinctCount
 )
 
 // String return the function's name
@@ -57,6 +84,60 @@ func (t FuncType) String() string {
 		return "stddev"
 	case Rate:
 		return "rate"
+	case HistogramCount:
+		return "histogram_count"
+	case HistogramSum:
+		return "histogram_sum"
+	case MovingAverage:
+		return "moving_average"
+	case Derivative:
+		return "derivative"
+	case NonNegativeDifference:
+		return "non_negative_difference"
+	case CumulativeSum:
+		return "cumulative_sum"
+	case Delta:
+		return "delta"
+	case Increase:
+		return "increase"
+	case Integral:
+		return "integral"
+	case Top:
+		return "top"
+	case Bottom:
+		return "bottom"
+	case Abs:
+		return "abs"
+	case Ceil:
+		return "ceil"
+	case Floor:
+		return "floor"
+	case Round:
+		return "round"
+	case Log:
+		return "log"
+	case Log2:
+		return "log2"
+	case Log10:
+		return "log10"
+	case Sqrt:
+		return "sqrt"
+	case Pow:
+		return "pow"
+	case ClampMin:
+		return "clamp_min"
+	case ClampMax:
+		return "clamp_max"
+	case If:
+		return "if"
+	case Coalesce:
+		return "coalesce"
+	case Percentile:
+		return "percentile"
+	case Median:
+		return "median"
+	case DistinctCount:
+		return "distinct_count"
 	default:
 		return "unknown"
 	}
@@ -66,3 +147,43 @@ func (t FuncType) String() string {
 func IsSupportOrderBy(t FuncType) bool {
 	return t == Sum || t == Min || t == Max || t == Count || t == Avg || t == Last || t == First || t == Stddev
 }
+
+// IsTransform checks if function is series transform function,
+// which evaluates each point based on previous points of series after aggregation.
+func IsTransform(t FuncType) bool {
+	switch t {
+	case MovingAverage, Derivative, NonNegativeDifference, CumulativeSum, Delta, Increase, Integral:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsSelector checks if function is series selector function,
+// which picks the top/bottom k series by value of each time slot.
+func IsSelector(t FuncType) bool {
+	return t == Top || t == Bottom
+}
+
+// IsScalar checks if function is scalar function,
+// which evaluates each point independently after aggregation(e.g. log10(f), if(f > 0, f, 0)).
+func IsScalar(t FuncType) bool {
+	switch t {
+	case Abs, Ceil, Floor, Round, Log, Log2, Log10, Sqrt, Pow, ClampMin, ClampMax, If, Coalesce:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsDistribution checks if function is distribution function,
+// which collects the value of each series in group rather than merging them(e.g. p95 of cpu across hosts).
+func IsDistribution(t FuncType) bool {
+	return t == Percentile || t == Median || t == DistinctCount
+}
+
+// IsPostAggregation checks if function evaluates on the aggregated result of series,
+// includes transform/selector/scalar function.
+func IsPostAggregation(t FuncType) bool {
+	return IsTransform(t) || IsSelector(t) || IsScalar(t)
+}
@@ -34,6 +34,33 @@ func TestFuncTypeString(t *testing.T) {
 	assert.Equal(t, "quantile", Quantile.String())
 	assert.Equal(t, "stddev", Stddev.String())
 	assert.Equal(t, "rate", Rate.String())
+	assert.Equal(t, "histogram_count", HistogramCount.String())
+	assert.Equal(t, "histogram_sum", HistogramSum.String())
+	assert.Equal(t, "moving_average", MovingAverage.String())
+	assert.Equal(t, "derivative", Derivative.String())
+	assert.Equal(t, "top", Top.String())
+	assert.Equal(t, "bottom", Bottom.String())
+	assert.Equal(t, "non_negative_difference", NonNegativeDifference.String())
+	assert.Equal(t, "cumulative_sum", CumulativeSum.String())
+	assert.Equal(t, "delta", Delta.String())
+	assert.Equal(t, "increase", Increase.String())
+	assert.Equal(t, "integral", Integral.String())
+	assert.Equal(t, "abs", Abs.String())
+	assert.Equal(t, "ceil", Ceil.String())
+	assert.Equal(t, "floor", Floor.String())
+	assert.Equal(t, "round", Round.String())
+	assert.Equal(t, "log", Log.String())
+	assert.Equal(t, "log2", Log2.String())
+	assert.Equal(t, "log10", Log10.String())
+	assert.Equal(t, "sqrt", Sqrt.String())
+	assert.Equal(t, "pow", Pow.String())
+	assert.Equal(t, "clamp_min", ClampMin.String())
+	assert.Equal(t, "clamp_max", ClampMax.String())
+	assert.Equal(t, "if", If.String())
+	assert.Equal(t, "coalesce", Coalesce.String())
+	assert.Equal(t, "percentile", Percentile.String())
+	assert.Equal(t, "median", Median.String())
+	assert.Equal(t, "distinct_count", DistinctCount.String())
 	assert.Equal(t, "unknown", Unknown.String())
 }
 
@@ -42,3 +69,41 @@ func TestIsSupportOrderBy(t *testing.T) {
 	assert.False(t, IsSupportOrderBy(Quantile))
 	assert.False(t, IsSupportOrderBy(Unknown))
 }
+
+func TestIsTransform(t *testing.T) {
+	assert.True(t, IsTransform(MovingAverage))
+	assert.True(t, IsTransform(Integral))
+	assert.False(t, IsTransform(Rate))
+	assert.False(t, IsTransform(Sum))
+}
+
+func TestIsSelector(t *testing.T) {
+	assert.True(t, IsSelector(Top))
+	assert.True(t, IsSelector(Bottom))
+	assert.False(t, IsSelector(Sum))
+	assert.False(t, IsSelector(MovingAverage))
+}
+
+func TestIsScalar(t *testing.T) {
+	assert.True(t, IsScalar(Abs))
+	assert.True(t, IsScalar(Log10))
+	assert.True(t, IsScalar(Coalesce))
+	assert.False(t, IsScalar(Sum))
+	assert.False(t, IsScalar(Top))
+}
+
+func TestIsDistribution(t *testing.T) {
+	assert.True(t, IsDistribution(Percentile))
+	assert.True(t, IsDistribution(Median))
+	assert.True(t, IsDistribution(DistinctCount))
+	assert.False(t, IsDistribution(Quantile))
+	assert.False(t, IsDistribution(Sum))
+}
+
+func TestIsPostAggregation(t *testing.T) {
+	assert.True(t, IsPostAggregation(MovingAverage))
+	assert.True(t, IsPostAggregation(Top))
+	assert.True(t, IsPostAggregation(If))
+	assert.False(t, IsPostAggregation(Sum))
+	assert.False(t, IsPostAggregation(Quantile))
+}
@@ -0,0 +1,257 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package aggregation
+
+import (
+	"github.com/lindb/lindb/pkg/stream"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series"
+	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/series/histogram"
+)
+
+// HistogramAggregator represents a field aggregator which aggregates native histogram values.
+type HistogramAggregator interface {
+	// AggregateHistogramBySlot aggregates the histogram value into current aggregator.
+	AggregateHistogramBySlot(slot int, value *histogram.Histogram)
+}
+
+// histogramFieldAggregator implements FieldAggregator/HistogramAggregator interface,
+// merges native histogram values by time slot.
+type histogramFieldAggregator struct {
+	segmentStartTime int64
+	start, end       int
+
+	values []*histogram.Histogram
+}
+
+// newHistogramFieldAggregator creates a native histogram field aggregator.
+func newHistogramFieldAggregator(segmentStartTime int64, start, end int) FieldAggregator {
+	return &histogramFieldAggregator{
+		segmentStartTime: segmentStartTime,
+		start:            start,
+		end:              end,
+	}
+}
+
+// Aggregate aggregates the field series into current aggregator.
+func (a *histogramFieldAggregator) Aggregate(it series.FieldIterator) {
+	for it.HasNext() {
+		hIt, ok := it.Next().(series.HistogramIterator)
+		if !ok {
+			continue
+		}
+		for hIt.HasNext() {
+			slot, value := hIt.NextHistogram()
+			a.AggregateHistogramBySlot(slot, value)
+		}
+	}
+}
+
+// AggregateBySlot ignores float value, native histogram field only aggregates histogram value.
+func (a *histogramFieldAggregator) AggregateBySlot(_ int, _ float64) {}
+
+// AggregateHistogramBySlot aggregates the histogram value into current aggregator.
+func (a *histogramFieldAggregator) AggregateHistogramBySlot(slot int, value *histogram.Histogram) {
+	pos := slot - a.start
+	if value == nil || pos < 0 || pos > a.end-a.start {
+		return
+	}
+	if a.values == nil {
+		a.values = make([]*histogram.Histogram, a.end-a.start+1)
+	}
+	if a.values[pos] == nil {
+		a.values[pos] = value.Clone()
+		return
+	}
+	a.values[pos].Merge(value)
+}
+
+// ResultSet returns the result set of field aggregator.
+func (a *histogramFieldAggregator) ResultSet() (startTime int64, it series.FieldIterator) {
+	return a.segmentStartTime, newHistogramFieldIterator(a.start, a.values)
+}
+
+// reset aggregator context for reusing.
+func (a *histogramFieldAggregator) reset() {
+	for idx := range a.values {
+		a.values[idx] = nil
+	}
+}
+
+// histogramFieldIterator implements series.FieldIterator interface for native histogram field.
+type histogramFieldIterator struct {
+	startSlot int
+	values    []*histogram.Histogram
+
+	consumed bool
+}
+
+// newHistogramFieldIterator creates a native histogram field iterator.
+func newHistogramFieldIterator(startSlot int, values []*histogram.Histogram) series.FieldIterator {
+	return &histogramFieldIterator{
+		startSlot: startSlot,
+		values:    values,
+	}
+}
+
+// HasNext returns if the iteration has more fields.
+func (it *histogramFieldIterator) HasNext() bool {
+	return !it.consumed
+}
+
+// Next returns the histogram primitive iterator.
+func (it *histogramFieldIterator) Next() series.PrimitiveIterator {
+	if it.consumed {
+		return nil
+	}
+	it.consumed = true
+	return newHistogramPrimitiveIterator(it.startSlot, it.values)
+}
+
+// MarshalBinary marshals the data, format: agg type + length of histogram block + histogram block.
+func (it *histogramFieldIterator) MarshalBinary() ([]byte, error) {
+	encoder := histogram.NewBlockEncoder()
+	for pos, value := range it.values {
+		if value != nil {
+			encoder.Append(uint16(it.startSlot+pos), value)
+		}
+	}
+	if encoder.Len() == 0 {
+		return nil, nil
+	}
+	data, err := encoder.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	writer := stream.NewBufferWriter(nil)
+	writer.PutByte(byte(field.Histogram))
+	writer.PutVarint32(int32(len(data)))
+	writer.PutBytes(data)
+	return writer.Bytes()
+}
+
+// histogramPrimitiveIterator implements series.HistogramIterator interface using histogram array.
+type histogramPrimitiveIterator struct {
+	start  int
+	values []*histogram.Histogram
+	idx    int
+}
+
+// newHistogramPrimitiveIterator creates a histogram primitive iterator.
+func newHistogramPrimitiveIterator(start int, values []*histogram.Histogram) series.HistogramIterator {
+	return &histogramPrimitiveIterator{
+		start:  start,
+		values: values,
+	}
+}
+
+// AggType returns the primitive field's agg type.
+func (it *histogramPrimitiveIterator) AggType() field.AggType {
+	return field.Histogram
+}
+
+// HasNext returns if the iteration has more data points.
+func (it *histogramPrimitiveIterator) HasNext() bool {
+	for it.idx < len(it.values) {
+		if it.values[it.idx] != nil {
+			return true
+		}
+		it.idx++
+	}
+	return false
+}
+
+// Next returns the count of histogram in the iteration.
+func (it *histogramPrimitiveIterator) Next() (timeSlot int, value float64) {
+	timeSlot, h := it.NextHistogram()
+	if h == nil {
+		return timeSlot, 0
+	}
+	return timeSlot, h.Count
+}
+
+// NextHistogram returns the histogram data point in the iteration.
+func (it *histogramPrimitiveIterator) NextHistogram() (timeSlot int, value *histogram.Histogram) {
+	if it.idx >= len(it.values) {
+		return -1, nil
+	}
+	timeSlot = it.start + it.idx
+	value = it.values[it.idx]
+	it.idx++
+	return
+}
+
+// DownSamplingHistogram merges histogram values from source time range => target time range,
+// for example: source range[5,182]=>target range[0,6], ratio:30, source interval:10s, target interval:5min.
+func DownSamplingHistogram(
+	source, target timeutil.SlotRange, ratio uint16, baseSlot int, getter histogram.Getter,
+	emitValue func(targetPos int, value *histogram.Histogram),
+) {
+	intervalRatio := int(ratio)
+	for movingSourceSlot := source.Start; movingSourceSlot <= source.End; movingSourceSlot++ {
+		if movingSourceSlot < target.Start {
+			continue
+		}
+		if movingSourceSlot > target.End {
+			break
+		}
+		value, ok := getter.GetHistogram(movingSourceSlot)
+		if !ok {
+			continue
+		}
+		emitValue((baseSlot+int(movingSourceSlot))/intervalRatio, value)
+	}
+}
+
+// DownSamplingMultiHistogramInto merges histogram blocks from source time range => target time range,
+// merged histogram of each target slot will be emitted in order.
+func DownSamplingMultiHistogramInto(
+	target timeutil.SlotRange, ratio uint16, baseSlot uint16,
+	decoders []*histogram.BlockDecoder,
+	emitValue func(targetSlot uint16, value *histogram.Histogram),
+) {
+	length := int(target.End-target.Start) + 1
+	targetValues := make([]*histogram.Histogram, length)
+	bs := int(baseSlot)
+	for _, decoder := range decoders {
+		if decoder == nil {
+			continue
+		}
+		for idx := 0; idx < decoder.Len(); idx++ {
+			targetPos := bs + int(decoder.SlotAt(idx)/ratio) - int(target.Start)
+			if targetPos < 0 {
+				continue
+			}
+			if targetPos >= length {
+				break
+			}
+			value := decoder.HistogramAt(idx)
+			if targetValues[targetPos] == nil {
+				targetValues[targetPos] = value.Clone()
+			} else {
+				targetValues[targetPos].Merge(value)
+			}
+		}
+	}
+	for pos, value := range targetValues {
+		if value != nil {
+			emitValue(target.Start+uint16(pos), value)
+		}
+	}
+}
@@ -0,0 +1,185 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package aggregation
+
+import (
+	"math"
+	"testing"
+
+	commontimeutil "github.com/lindb/common/pkg/timeutil"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series"
+	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/series/histogram"
+	"github.com/lindb/lindb/sql"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestHistogramFieldAggregator_Aggregate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	aggSpec := NewAggregatorSpec("f", field.NativeHistogramField)
+	aggSpec.AddFunctionType(function.HistogramCount)
+	agg := NewFieldAggregator(aggSpec, 10, 5, 10)
+	ts, it := agg.ResultSet()
+	assert.Equal(t, int64(10), ts)
+	data, err := it.MarshalBinary()
+	assert.NoError(t, err)
+	assert.Nil(t, data)
+
+	h1 := &histogram.Histogram{Schema: 0, Count: 1, Sum: 2, Buckets: []histogram.Bucket{{Index: 1, Count: 1}}}
+	h2 := &histogram.Histogram{Schema: 0, Count: 2, Sum: 6, Buckets: []histogram.Bucket{{Index: 2, Count: 2}}}
+	hAgg := agg.(HistogramAggregator)
+	hAgg.AggregateHistogramBySlot(5, h1)
+	hAgg.AggregateHistogramBySlot(5, h2)
+	hAgg.AggregateHistogramBySlot(8, h1)
+	// ignore out of range/nil value
+	hAgg.AggregateHistogramBySlot(4, h1)
+	hAgg.AggregateHistogramBySlot(11, h1)
+	hAgg.AggregateHistogramBySlot(6, nil)
+	agg.AggregateBySlot(6, 10)
+	// value is copied
+	assert.Equal(t, 1.0, h1.Count)
+
+	_, it = agg.ResultSet()
+	assert.True(t, it.HasNext())
+	pIt := it.Next()
+	assert.False(t, it.HasNext())
+	assert.Nil(t, it.Next())
+	assert.Equal(t, field.Histogram, pIt.AggType())
+	assert.True(t, pIt.HasNext())
+	slot, count := pIt.Next()
+	assert.Equal(t, 5, slot)
+	assert.Equal(t, 3.0, count)
+	hIt := pIt.(series.HistogramIterator)
+	assert.True(t, hIt.HasNext())
+	slot, h := hIt.NextHistogram()
+	assert.Equal(t, 8, slot)
+	assert.Equal(t, 2.0, h.Sum)
+	assert.False(t, hIt.HasNext())
+	slot, h = hIt.NextHistogram()
+	assert.Equal(t, -1, slot)
+	assert.Nil(t, h)
+
+	// marshal/unmarshal, then aggregate by binary iterator
+	data, err = it.MarshalBinary()
+	assert.NoError(t, err)
+	agg2 := NewFieldAggregator(aggSpec, 10, 5, 10)
+	agg2.Aggregate(series.NewFieldIterator(data))
+	_, it2 := agg2.ResultSet()
+	hIt = it2.Next().(series.HistogramIterator)
+	slot, h = hIt.NextHistogram()
+	assert.Equal(t, 5, slot)
+	assert.Equal(t, 8.0, h.Sum)
+	assert.Equal(t, []histogram.Bucket{{Index: 1, Count: 1}, {Index: 2, Count: 2}}, h.Buckets)
+
+	agg.reset()
+	_, it = agg.ResultSet()
+	assert.False(t, it.Next().HasNext())
+}
+
+func TestDownSamplingHistogram(t *testing.T) {
+	encoder := histogram.NewBlockEncoder()
+	for slot := uint16(0); slot < 10; slot++ {
+		encoder.Append(slot, &histogram.Histogram{Schema: 0, Count: 1, Buckets: []histogram.Bucket{{Index: 1, Count: 1}}})
+	}
+	data, err := encoder.Bytes()
+	assert.NoError(t, err)
+	decoder := histogram.NewBlockDecoder()
+	assert.NoError(t, decoder.Reset(data))
+
+	result := make(map[int]float64)
+	DownSamplingHistogram(timeutil.SlotRange{Start: 0, End: 9}, timeutil.SlotRange{Start: 2, End: 8}, 5, 0, decoder,
+		func(targetPos int, value *histogram.Histogram) {
+			result[targetPos] += value.Count
+		})
+	assert.Equal(t, map[int]float64{0: 3, 1: 4}, result)
+
+	merged := make(map[uint16]float64)
+	DownSamplingMultiHistogramInto(timeutil.SlotRange{Start: 1, End: 1}, 5, 0,
+		[]*histogram.BlockDecoder{decoder, nil, decoder},
+		func(targetSlot uint16, value *histogram.Histogram) {
+			merged[targetSlot] = value.Count
+		})
+	assert.Equal(t, map[uint16]float64{1: 10}, merged)
+}
+
+func TestExpression_NativeHistogram(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockHistogramSeries := func(name field.Name, values ...*histogram.Histogram) series.Iterator {
+		timeSeries := series.NewMockIterator(ctrl)
+		timeSeries.EXPECT().FieldType().Return(field.NativeHistogramField)
+		timeSeries.EXPECT().FieldName().Return(name)
+		timeSeries.EXPECT().HasNext().Return(true)
+		timeSeries.EXPECT().Next().Return(familyTime, newHistogramFieldIterator(10, values))
+		timeSeries.EXPECT().HasNext().Return(false)
+		return timeSeries
+	}
+	eval := func(sqlText string, fieldSeries ...series.Iterator) map[string][]float64 {
+		q, err := sql.Parse(sqlText)
+		assert.NoError(t, err)
+		expression := NewExpression(timeutil.TimeRange{
+			Start: now,
+			End:   now + commontimeutil.OneHour*2,
+		}, commontimeutil.OneMinute, q.(*stmt.Query).SelectItems)
+		timeSeries := series.NewMockGroupedIterator(ctrl)
+		for _, s := range fieldSeries {
+			timeSeries.EXPECT().HasNext().Return(true)
+			timeSeries.EXPECT().Next().Return(s)
+		}
+		timeSeries.EXPECT().HasNext().Return(false)
+		expression.Eval(timeSeries)
+		rs := make(map[string][]float64)
+		for name, values := range expression.ResultSet() {
+			it := values.NewIterator()
+			for it.HasNext() {
+				_, v := it.Next()
+				rs[name] = append(rs[name], v)
+			}
+		}
+		return rs
+	}
+	h1 := &histogram.Histogram{Schema: 0, Count: 2, Sum: 5, Buckets: []histogram.Bucket{{Index: 1, Count: 1}, {Index: 2, Count: 1}}}
+	h2 := &histogram.Histogram{Schema: 0, Count: 2, Sum: 7, Buckets: []histogram.Bucket{{Index: 2, Count: 2}}}
+
+	rs := eval("select quantile(0.5),histogram_count(),histogram_sum(f1) from cpu",
+		mockHistogramSeries("f1", h1), mockHistogramSeries("f2", h2))
+	// merged buckets: (1,2]:1, (2,4]:3
+	assert.InDelta(t, 2*math.Cbrt(2), rs["quantile(0.50)"][0], 1e-9)
+	assert.Equal(t, []float64{4}, rs["histogram_count()"])
+	assert.Equal(t, []float64{5}, rs["histogram_sum(f1)"])
+
+	// merge legacy histogram bucket fields
+	rs = eval("select quantile(0.99) from cpu",
+		mockHistogramSeries("f1", nil, h1),
+		mockTimeSeries(ctrl, familyTime, "__bucket_8", field.HistogramField, field.Sum))
+	assert.Len(t, rs["quantile(0.99)"], 2)
+
+	// field not found or illegal param
+	rs = eval("select histogram_count(f3),quantile(2) from cpu", mockHistogramSeries("f1", h1))
+	assert.Empty(t, rs)
+	rs = eval("select histogram_count(f1+f2) from cpu", mockHistogramSeries("f1", h1))
+	assert.Empty(t, rs)
+}
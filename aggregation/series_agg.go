@@ -20,6 +20,7 @@ package aggregation
 import (
 	"sync"
 
+	"github.com/lindb/lindb/aggregation/function"
 	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/series"
 	"github.com/lindb/lindb/series/field"
@@ -65,6 +66,9 @@ type SeriesAggregator interface {
 	GetFieldType() field.Type
 	// GetAggregator gets field aggregator by start time of query for the segment.
 	GetAggregator(segmentStartTime int64) FieldAggregator
+	// GetSeriesAggregator gets field aggregator of the series by start time of query for the segment,
+	// if it collects the value of each series(distribution function), data of same series use same aggregator.
+	GetSeriesAggregator(seriesIdx uint16, segmentStartTime int64) FieldAggregator
 	// getAggregator gets field aggregator by segment start time.
 	getAggregator(segmentStartTime int64) FieldAggregator
 	// GetAggregates returns all field aggregators.
@@ -88,11 +92,20 @@ type seriesAggregator struct {
 	aggSpec    AggregatorSpec
 	calc       timeutil.IntervalCalculator // query interval based
 
+	// series segment => field aggregator, if it collects the value of each series
+	seriesAggregates map[seriesSegment]FieldAggregator
+
 	startTime int64
 
 	mutex sync.Mutex
 }
 
+// seriesSegment represents the series in the segment.
+type seriesSegment struct {
+	seriesIdx        uint16
+	segmentStartTime int64
+}
+
 // NewMergeSeriesAggregator creates a merge series aggregator.
 func NewMergeSeriesAggregator(
 	queryInterval timeutil.Interval,
@@ -126,7 +139,7 @@ func NewSeriesAggregator(
 ) SeriesAggregator {
 	calc := queryInterval.Calculator()
 
-	return &seriesAggregator{
+	agg := &seriesAggregator{
 		fieldName:      aggSpec.FieldName(),
 		fieldType:      aggSpec.GetFieldType(),
 		startTime:      calc.CalcFamilyTime(queryTimeRange.Start),
@@ -136,6 +149,13 @@ func NewSeriesAggregator(
 		queryTimeRange: queryTimeRange,
 		aggSpec:        aggSpec,
 	}
+	for funcType := range aggSpec.Functions() {
+		if function.IsDistribution(funcType) {
+			agg.seriesAggregates = make(map[seriesSegment]FieldAggregator)
+			break
+		}
+	}
+	return agg
 }
 
 // FieldName returns field name.
@@ -181,6 +201,36 @@ func (a *seriesAggregator) getAggregator(segmentStartTime int64) FieldAggregator
 // GetAggregator gets field aggregator by start time of query for the segment.
 // segment start time = family time.
 func (a *seriesAggregator) GetAggregator(segmentStartTime int64) FieldAggregator {
+	agg := a.newSegmentAggregator(segmentStartTime)
+
+	a.mutex.Lock()
+	a.aggregates = append(a.aggregates, agg)
+	a.mutex.Unlock()
+	return agg
+}
+
+// GetSeriesAggregator gets field aggregator of the series by start time of query for the segment,
+// if it collects the value of each series(distribution function), data of same series use same aggregator.
+func (a *seriesAggregator) GetSeriesAggregator(seriesIdx uint16, segmentStartTime int64) FieldAggregator {
+	if a.seriesAggregates == nil {
+		return a.GetAggregator(segmentStartTime)
+	}
+	key := seriesSegment{seriesIdx: seriesIdx, segmentStartTime: segmentStartTime}
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	agg, ok := a.seriesAggregates[key]
+	if !ok {
+		agg = a.newSegmentAggregator(segmentStartTime)
+		a.aggregates = append(a.aggregates, agg)
+		a.seriesAggregates[key] = agg
+	}
+	return agg
+}
+
+// newSegmentAggregator creates field aggregator by start time of query for the segment.
+func (a *seriesAggregator) newSegmentAggregator(segmentStartTime int64) FieldAggregator {
 	// calc storage interval
 	storageInterval := timeutil.Interval(a.queryInterval.Int64() / int64(a.intervalRatio))
 	sourceRange := storageInterval.CalcSlotRange(segmentStartTime, a.queryTimeRange)
@@ -189,10 +239,5 @@ func (a *seriesAggregator) GetAggregator(segmentStartTime int64) FieldAggregator
 	targetStart := (baseSlot + int(sourceRange.Start)) / a.intervalRatio
 	targetEnd := (baseSlot + int(sourceRange.End)) / a.intervalRatio
 	// create field aggregator based on start time of query and query slot range(based on family range)
-	agg := NewFieldAggregator(a.aggSpec, a.queryTimeRange.Start, targetStart, targetEnd)
-
-	a.mutex.Lock()
-	a.aggregates = append(a.aggregates, agg)
-	a.mutex.Unlock()
-	return agg
+	return NewFieldAggregator(a.aggSpec, a.queryTimeRange.Start, targetStart, targetEnd)
 }
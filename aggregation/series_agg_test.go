@@ -23,6 +23,7 @@ import (
 	commontimeutil "github.com/lindb/common/pkg/timeutil"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/lindb/lindb/aggregation/function"
 	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/series/field"
 )
@@ -110,6 +111,28 @@ func TestNewSeriesAggregator(t *testing.T) {
 	agg.Reset()
 }
 
+func TestSeriesAggregator_GetSeriesAggregator(t *testing.T) {
+	now, _ := commontimeutil.ParseTimestamp("20190702 19:10:00", "20060102 15:04:05")
+	familyTime, _ := commontimeutil.ParseTimestamp("20190702 19:00:00", "20060102 15:04:05")
+	timeRange := timeutil.TimeRange{Start: now, End: now + 3*commontimeutil.OneHour}
+	// each series uses same aggregator in segment for distribution function
+	spec := NewAggregatorSpec("b", field.SumField)
+	spec.AddFunctionType(function.Median)
+	agg := NewSeriesAggregator(timeutil.Interval(commontimeutil.OneSecond), 1, timeRange, spec)
+	fAgg := agg.GetSeriesAggregator(1, familyTime)
+	assert.Equal(t, fAgg, agg.GetSeriesAggregator(1, familyTime))
+	assert.NotSame(t, fAgg, agg.GetSeriesAggregator(2, familyTime))
+	assert.NotSame(t, fAgg, agg.GetSeriesAggregator(1, familyTime+commontimeutil.OneHour))
+	assert.Len(t, agg.GetAggregates(), 3)
+
+	// creates aggregator for each data load if not distribution function
+	spec = NewAggregatorSpec("b", field.SumField)
+	spec.AddFunctionType(function.Sum)
+	agg = NewSeriesAggregator(timeutil.Interval(commontimeutil.OneSecond), 1, timeRange, spec)
+	assert.NotSame(t, agg.GetSeriesAggregator(1, familyTime), agg.GetSeriesAggregator(1, familyTime))
+	assert.Len(t, agg.GetAggregates(), 2)
+}
+
 func TestNewMergeSeriesAggregator(t *testing.T) {
 	now, _ := commontimeutil.ParseTimestamp("20190702 19:10:00", "20060102 15:04:05")
 	familyTime, _ := commontimeutil.ParseTimestamp("20190702 19:00:00", "20060102 15:04:05")
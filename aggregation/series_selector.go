@@ -0,0 +1,164 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package aggregation
+
+import (
+	"math"
+	"sort"
+
+	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/pkg/collections"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+// SeriesSelector represents the top/bottom k series selector of select field,
+// which picks k series by value of each time slot.
+type SeriesSelector struct {
+	Name   string // field name of select item
+	K      int
+	Bottom bool
+	Others bool // sums the remaining series into others series
+}
+
+// NewSeriesSelectors builds the series selectors based on top/bottom function of select items.
+func NewSeriesSelectors(selectItems []stmt.Expr) (selectors []*SeriesSelector) {
+	for _, item := range selectItems {
+		selectItem, ok := item.(*stmt.SelectItem)
+		if !ok {
+			continue
+		}
+		call, ok := selectItem.Expr.(*stmt.CallExpr)
+		if !ok || !function.IsSelector(call.FuncType) || len(call.Params) != 2 {
+			continue
+		}
+		k, ok := call.Params[0].(*stmt.NumberLiteral)
+		if !ok {
+			continue
+		}
+		name := selectItem.Alias
+		if name == "" {
+			name = selectItem.Rewrite()
+		}
+		selectors = append(selectors, &SeriesSelector{
+			Name:   name,
+			K:      int(k.Val),
+			Bottom: call.FuncType == function.Bottom,
+			Others: call.Others,
+		})
+	}
+	return
+}
+
+// slotValue represents the value of series in time slot.
+type slotValue struct {
+	row   int
+	value float64
+}
+
+// SelectSeries selects top/bottom k series of each time slot for selector fields(in place),
+// removes the series which are never selected, if need adds the others series with othersTags.
+func SelectSeries(rows []Row, selectors []*SeriesSelector, othersTags string) []Row {
+	if len(selectors) == 0 || len(rows) == 0 {
+		return rows
+	}
+	selected := make([]bool, len(rows))
+	othersFields := make(map[string]*collections.FloatArray)
+	for _, selector := range selectors {
+		others := selector.selectSeries(rows, selected)
+		if others != nil {
+			othersFields[selector.Name] = others
+		}
+	}
+	var result []Row
+	if len(othersFields) > 0 {
+		// put others series in front, make sure it cannot be dropped by limit
+		result = append(result, NewOrderByRow(othersTags, othersFields))
+	}
+	for idx, row := range rows {
+		if selected[idx] {
+			result = append(result, row)
+		}
+	}
+	return result
+}
+
+// selectSeries selects the series of each time slot for the selector field, returns the sum of remaining series if need.
+func (s *SeriesSelector) selectSeries(rows []Row, selected []bool) *collections.FloatArray {
+	capacity := 0
+	slots := make(map[int][]slotValue)
+	for idx, row := range rows {
+		_, fields := row.ResultSet()
+		values := fields[s.Name]
+		if values == nil {
+			continue
+		}
+		if values.Capacity() > capacity {
+			capacity = values.Capacity()
+		}
+		it := values.NewIterator()
+		for it.HasNext() {
+			slot, val := it.Next()
+			if math.IsNaN(val) {
+				continue
+			}
+			slots[slot] = append(slots[slot], slotValue{row: idx, value: val})
+		}
+	}
+	kept := make(map[int]*collections.FloatArray)
+	var others *collections.FloatArray
+	for slot, values := range slots {
+		sort.SliceStable(values, func(i, j int) bool {
+			if s.Bottom {
+				return values[i].value < values[j].value
+			}
+			return values[i].value > values[j].value
+		})
+		for idx, v := range values {
+			if idx < s.K {
+				arr, ok := kept[v.row]
+				if !ok {
+					arr = collections.NewFloatArray(capacity)
+					kept[v.row] = arr
+				}
+				arr.SetValue(slot, v.value)
+				selected[v.row] = true
+				continue
+			}
+			if !s.Others {
+				break
+			}
+			if others == nil {
+				others = collections.NewFloatArray(capacity)
+			}
+			others.SetValue(slot, others.GetValue(slot)+v.value)
+		}
+	}
+	// replace field values with selected values
+	for idx, row := range rows {
+		_, fields := row.ResultSet()
+		if _, ok := fields[s.Name]; !ok {
+			continue
+		}
+		if arr, ok := kept[idx]; ok {
+			fields[s.Name] = arr
+		} else {
+			delete(fields, s.Name)
+		}
+	}
+	return others
+}
@@ -0,0 +1,123 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package aggregation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/pkg/collections"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestNewSeriesSelectors(t *testing.T) {
+	selectors := NewSeriesSelectors([]stmt.Expr{
+		&stmt.SelectItem{Expr: &stmt.FieldExpr{Name: "f"}},
+		&stmt.SelectItem{Expr: &stmt.CallExpr{FuncType: function.Sum, Params: []stmt.Expr{&stmt.FieldExpr{Name: "f"}}}},
+		&stmt.SelectItem{Expr: &stmt.CallExpr{FuncType: function.Top, Params: []stmt.Expr{&stmt.FieldExpr{Name: "f"}}}},
+		&stmt.SelectItem{Expr: &stmt.CallExpr{FuncType: function.Top, Params: []stmt.Expr{&stmt.FieldExpr{Name: "f"}, &stmt.FieldExpr{Name: "f"}}}},
+		&stmt.SelectItem{Expr: &stmt.CallExpr{FuncType: function.Top, Params: []stmt.Expr{&stmt.NumberLiteral{Val: 2}, &stmt.FieldExpr{Name: "f"}}}},
+		&stmt.SelectItem{
+			Expr:  &stmt.CallExpr{FuncType: function.Bottom, Params: []stmt.Expr{&stmt.NumberLiteral{Val: 3}, &stmt.FieldExpr{Name: "f"}}, Others: true},
+			Alias: "b",
+		},
+		&stmt.FieldExpr{Name: "f"},
+	})
+	assert.Equal(t, []*SeriesSelector{
+		{Name: "top(2.00,f)", K: 2},
+		{Name: "b", K: 3, Bottom: true, Others: true},
+	}, selectors)
+}
+
+func TestSelectSeries(t *testing.T) {
+	newRow := func(tags string, values ...float64) Row {
+		arr := collections.NewFloatArray(len(values))
+		for idx, val := range values {
+			arr.SetValue(idx, val)
+		}
+		return NewOrderByRow(tags, map[string]*collections.FloatArray{"f": arr})
+	}
+	getValues := func(row Row) (tags string, values map[int]float64) {
+		tags, fields := row.ResultSet()
+		values = make(map[int]float64)
+		if fields["f"] == nil {
+			return
+		}
+		it := fields["f"].NewIterator()
+		for it.HasNext() {
+			slot, val := it.Next()
+			values[slot] = val
+		}
+		return
+	}
+	cases := []struct {
+		name     string
+		selector *SeriesSelector
+		expect   map[string]map[int]float64
+	}{
+		{
+			name:     "top",
+			selector: &SeriesSelector{Name: "f", K: 1},
+			expect: map[string]map[int]float64{
+				"a": {0: 3},
+				"c": {1: 5},
+			},
+		},
+		{
+			name:     "bottom",
+			selector: &SeriesSelector{Name: "f", K: 2, Bottom: true},
+			expect: map[string]map[int]float64{
+				"a": {1: 1},
+				"b": {0: 2, 1: 2},
+				"c": {0: 1},
+			},
+		},
+		{
+			name:     "top with others",
+			selector: &SeriesSelector{Name: "f", K: 1, Others: true},
+			expect: map[string]map[int]float64{
+				"a":      {0: 3},
+				"c":      {1: 5},
+				"others": {0: 3, 1: 3},
+			},
+		},
+		{
+			name:     "select field not exist",
+			selector: &SeriesSelector{Name: "g", K: 1},
+			expect:   map[string]map[int]float64{},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			rows := []Row{newRow("a", 3, 1), newRow("b", 2, 2), newRow("c", 1, 5)}
+			result := SelectSeries(rows, []*SeriesSelector{tt.selector}, "others")
+			actual := make(map[string]map[int]float64)
+			for _, row := range result {
+				tags, values := getValues(row)
+				actual[tags] = values
+			}
+			assert.Equal(t, tt.expect, actual)
+		})
+	}
+	assert.Empty(t, SelectSeries(nil, []*SeriesSelector{{Name: "f", K: 1}}, ""))
+	rows := []Row{newRow("a", 1)}
+	assert.Equal(t, rows, SelectSeries(rows, nil, ""))
+}
@@ -0,0 +1,349 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package aggregation
+
+import (
+	"fmt"
+	"math"
+
+	commonmodels "github.com/lindb/common/models"
+
+	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/pkg/collections"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series/tag"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+// SubQueryAggregator aggregates the result set of inner query in memory based on the select list of outer query,
+// series of inner query are grouped by group by tags, points are down sampled by interval of outer query.
+type SubQueryAggregator struct {
+	selectItems []stmt.Expr
+	groupBy     []string
+	interval    int64
+
+	timeRange  timeutil.TimeRange
+	pointCount int
+}
+
+// NewSubQueryAggregator creates the aggregator of outer query,
+// interval of inner query result set will be used if interval is 0.
+func NewSubQueryAggregator(selectItems []stmt.Expr, groupBy []string, interval int64) *SubQueryAggregator {
+	return &SubQueryAggregator{
+		selectItems: selectItems,
+		groupBy:     groupBy,
+		interval:    interval,
+	}
+}
+
+// TimeRange returns the time range of aggregated result.
+func (a *SubQueryAggregator) TimeRange() timeutil.TimeRange {
+	return a.timeRange
+}
+
+// Interval returns the interval of aggregated result.
+func (a *SubQueryAggregator) Interval() int64 {
+	return a.interval
+}
+
+// Aggregate aggregates the result set of inner query, returns the result rows which evaluated by select list.
+func (a *SubQueryAggregator) Aggregate(rs *commonmodels.ResultSet) ([]Row, error) {
+	if a.interval <= 0 {
+		a.interval = rs.Interval
+	}
+	if a.interval <= 0 {
+		return nil, fmt.Errorf("interval of sub query result not found")
+	}
+	a.timeRange = timeutil.TimeRange{
+		Start: rs.StartTime / a.interval * a.interval,
+		End:   rs.EndTime / a.interval * a.interval,
+	}
+	a.pointCount = int((a.timeRange.End-a.timeRange.Start)/a.interval) + 1
+
+	// group series by group by tags
+	var groupTags []string
+	groups := make(map[string][]*commonmodels.Series)
+	for _, series := range rs.Series {
+		tagValues := make([]string, len(a.groupBy))
+		for idx, tagKey := range a.groupBy {
+			tagValue, ok := series.Tags[tagKey]
+			if !ok {
+				return nil, fmt.Errorf("group by tag key not found in sub query result: %s", tagKey)
+			}
+			tagValues[idx] = tagValue
+		}
+		tags := tag.ConcatTagValues(tagValues)
+		if _, ok := groups[tags]; !ok {
+			groupTags = append(groupTags, tags)
+		}
+		groups[tags] = append(groups[tags], series)
+	}
+
+	rows := make([]Row, 0, len(groups))
+	for _, tags := range groupTags {
+		fields := make(map[string]*collections.FloatArray)
+		for _, item := range a.selectItems {
+			values := a.eval(groups[tags], item)
+			if values == nil {
+				continue
+			}
+			if selectItem, ok := item.(*stmt.SelectItem); ok && selectItem.Alias != "" {
+				fields[selectItem.Alias] = values
+			} else {
+				fields[item.Rewrite()] = values
+			}
+		}
+		rows = append(rows, NewOrderByRow(tags, fields))
+	}
+	return rows, nil
+}
+
+// eval evaluates the select item based on the series of group.
+func (a *SubQueryAggregator) eval(seriesList []*commonmodels.Series, expr stmt.Expr) *collections.FloatArray {
+	switch e := expr.(type) {
+	case *stmt.SelectItem:
+		return a.eval(seriesList, e.Expr)
+	case *stmt.ParenExpr:
+		return a.eval(seriesList, e.Expr)
+	case *stmt.NumberLiteral:
+		values := collections.NewFloatArray(a.pointCount)
+		for i := 0; i < a.pointCount; i++ {
+			values.SetValue(i, e.Val)
+		}
+		values.SetSingle(true)
+		return values
+	case *stmt.BinaryExpr:
+		return binaryEval(e.Operator, a.eval(seriesList, e.Left), a.eval(seriesList, e.Right))
+	case *stmt.CallExpr:
+		switch {
+		case function.IsSelector(e.FuncType):
+			// series selection is done after all groups evaluated
+			if len(e.Params) != 2 {
+				return nil
+			}
+			return a.eval(seriesList, e.Params[1])
+		case function.IsTransform(e.FuncType):
+			if len(e.Params) == 0 {
+				return nil
+			}
+			var args []float64
+			for _, param := range e.Params[1:] {
+				literal, ok := param.(*stmt.NumberLiteral)
+				if !ok {
+					return nil
+				}
+				args = append(args, literal.Val)
+			}
+			values := a.eval(seriesList, e.Params[0])
+			if values == nil {
+				return nil
+			}
+			return function.TransformCall(e.FuncType, a.interval, values, args...)
+		case function.IsScalar(e.FuncType):
+			var params []*collections.FloatArray
+			for _, param := range e.Params {
+				params = append(params, a.eval(seriesList, param))
+			}
+			return function.ScalarCall(e.FuncType, params...)
+		case function.IsDistribution(e.FuncType):
+			return a.distribution(seriesList, e)
+		}
+		if len(e.Params) != 1 {
+			return nil
+		}
+		fieldExpr, ok := e.Params[0].(*stmt.FieldExpr)
+		if !ok {
+			return nil
+		}
+		return a.aggregate(seriesList, e.FuncType, fieldExpr.Name)
+	}
+	return nil
+}
+
+// aggregate aggregates the points of field in all series by function, points in same time slot are aggregated.
+func (a *SubQueryAggregator) aggregate(seriesList []*commonmodels.Series, funcType function.FuncType, fieldName string) *collections.FloatArray {
+	slots := make(map[int]*slotAgg)
+	for _, series := range seriesList {
+		for timestamp, value := range series.Fields[fieldName] {
+			slot, ok := a.slotOf(timestamp, value)
+			if !ok {
+				continue
+			}
+			agg, ok := slots[slot]
+			if !ok {
+				agg = &slotAgg{}
+				slots[slot] = agg
+			}
+			agg.add(timestamp, value)
+		}
+	}
+	if len(slots) == 0 {
+		return nil
+	}
+	values := collections.NewFloatArray(a.pointCount)
+	for slot, agg := range slots {
+		values.SetValue(slot, agg.value(funcType))
+	}
+	return values
+}
+
+// distribution evaluates distribution function based on the value of each series in group.
+func (a *SubQueryAggregator) distribution(seriesList []*commonmodels.Series, expr *stmt.CallExpr) *collections.FloatArray {
+	if len(expr.Params) == 0 {
+		return nil
+	}
+	fieldExpr, ok := expr.Params[0].(*stmt.FieldExpr)
+	if !ok {
+		return nil
+	}
+	switch expr.FuncType {
+	case function.DistinctCount:
+		return a.distinctCount(seriesList, fieldExpr.Name)
+	case function.Median:
+		return a.percentile(seriesList, fieldExpr.Name, 50)
+	default:
+		if len(expr.Params) != 2 {
+			return nil
+		}
+		p, ok := expr.Params[1].(*stmt.NumberLiteral)
+		if !ok {
+			return nil
+		}
+		return a.percentile(seriesList, fieldExpr.Name, p.Val)
+	}
+}
+
+// percentile collects the points of field in all series by time slot, returns the percentile of each slot.
+func (a *SubQueryAggregator) percentile(seriesList []*commonmodels.Series, fieldName string, p float64) *collections.FloatArray {
+	slots := make(map[int][]float64)
+	for _, series := range seriesList {
+		for timestamp, value := range series.Fields[fieldName] {
+			if slot, ok := a.slotOf(timestamp, value); ok {
+				slots[slot] = append(slots[slot], value)
+			}
+		}
+	}
+	if len(slots) == 0 {
+		return nil
+	}
+	values := collections.NewFloatArray(a.pointCount)
+	for slot, points := range slots {
+		values.SetValue(slot, function.PercentileOf(points, p))
+	}
+	return values
+}
+
+// distinctCount counts the distinct values of tag key by time slot, series is counted if it has any field value in slot.
+func (a *SubQueryAggregator) distinctCount(seriesList []*commonmodels.Series, tagKey string) *collections.FloatArray {
+	slots := make(map[int]map[string]struct{})
+	for _, series := range seriesList {
+		tagValue, ok := series.Tags[tagKey]
+		if !ok {
+			continue
+		}
+		for _, points := range series.Fields {
+			for timestamp, value := range points {
+				slot, ok := a.slotOf(timestamp, value)
+				if !ok {
+					continue
+				}
+				tagValues, ok := slots[slot]
+				if !ok {
+					tagValues = make(map[string]struct{})
+					slots[slot] = tagValues
+				}
+				tagValues[tagValue] = struct{}{}
+			}
+		}
+	}
+	if len(slots) == 0 {
+		return nil
+	}
+	values := collections.NewFloatArray(a.pointCount)
+	for slot, tagValues := range slots {
+		values.SetValue(slot, float64(len(tagValues)))
+	}
+	return values
+}
+
+// slotOf returns the time slot of point, returns false if point is out of time range or value is NaN.
+func (a *SubQueryAggregator) slotOf(timestamp int64, value float64) (int, bool) {
+	if timestamp < a.timeRange.Start || math.IsNaN(value) {
+		return 0, false
+	}
+	slot := int((timestamp - a.timeRange.Start) / a.interval)
+	if slot >= a.pointCount {
+		return 0, false
+	}
+	return slot, true
+}
+
+// slotAgg represents the aggregation of points in time slot.
+type slotAgg struct {
+	count                   int
+	sum, min, max, mean, m2 float64
+	first, last             float64
+	firstTime, lastTime     int64
+}
+
+// add adds the point into aggregation.
+func (agg *slotAgg) add(timestamp int64, value float64) {
+	if agg.count == 0 {
+		agg.min, agg.max = value, value
+		agg.first, agg.firstTime = value, timestamp
+		agg.last, agg.lastTime = value, timestamp
+	}
+	agg.count++
+	agg.sum += value
+	agg.min = math.Min(agg.min, value)
+	agg.max = math.Max(agg.max, value)
+	if timestamp < agg.firstTime {
+		agg.first, agg.firstTime = value, timestamp
+	}
+	if timestamp > agg.lastTime {
+		agg.last, agg.lastTime = value, timestamp
+	}
+	// Welford's online algorithm for stddev
+	delta := value - agg.mean
+	agg.mean += delta / float64(agg.count)
+	agg.m2 += delta * (value - agg.mean)
+}
+
+// value returns the aggregated value of function.
+func (agg *slotAgg) value(funcType function.FuncType) float64 {
+	switch funcType {
+	case function.Sum:
+		return agg.sum
+	case function.Min:
+		return agg.min
+	case function.Max:
+		return agg.max
+	case function.Count:
+		return float64(agg.count)
+	case function.Avg:
+		return agg.sum / float64(agg.count)
+	case function.First:
+		return agg.first
+	case function.Last:
+		return agg.last
+	case function.Stddev:
+		return math.Sqrt(agg.m2 / float64(agg.count))
+	default:
+		return math.NaN()
+	}
+}
@@ -0,0 +1,155 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package aggregation
+
+import (
+	"math"
+	"testing"
+
+	commonmodels "github.com/lindb/common/models"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestSubQueryAggregator_Aggregate(t *testing.T) {
+	newSeries := func(host, region string, points map[int64]float64) *commonmodels.Series {
+		series := commonmodels.NewSeries(map[string]string{"host": host, "region": region}, host)
+		series.Fields["v"] = points
+		return series
+	}
+	rs := &commonmodels.ResultSet{
+		Series: []*commonmodels.Series{
+			newSeries("h1", "sh", map[int64]float64{0: 1, 10: 5, 20: 2, 30: 8}),
+			newSeries("h2", "sh", map[int64]float64{0: 3, 10: 1, 20: math.NaN(), 30: 4}),
+			newSeries("h3", "bj", map[int64]float64{0: 6, 40: 7}),
+		},
+		StartTime: 0,
+		EndTime:   40,
+		Interval:  10,
+	}
+	call := func(funcType function.FuncType) *stmt.CallExpr {
+		return &stmt.CallExpr{FuncType: funcType, Params: []stmt.Expr{&stmt.FieldExpr{Name: "v"}}}
+	}
+	agg := NewSubQueryAggregator([]stmt.Expr{
+		&stmt.SelectItem{Expr: call(function.Max), Alias: "max"},
+		&stmt.SelectItem{Expr: &stmt.BinaryExpr{Left: call(function.Sum), Operator: stmt.DIV, Right: call(function.Count)}},
+		&stmt.SelectItem{Expr: call(function.First), Alias: "first"},
+		&stmt.SelectItem{Expr: call(function.Last), Alias: "last"},
+		&stmt.SelectItem{Expr: call(function.Stddev), Alias: "stddev"},
+		&stmt.SelectItem{Expr: &stmt.CallExpr{FuncType: function.CumulativeSum, Params: []stmt.Expr{call(function.Min)}}, Alias: "cs"},
+		&stmt.SelectItem{Expr: &stmt.CallExpr{FuncType: function.Top, Params: []stmt.Expr{&stmt.NumberLiteral{Val: 1}, call(function.Avg)}}, Alias: "top"},
+		&stmt.SelectItem{Expr: &stmt.CallExpr{FuncType: function.ClampMax, Params: []stmt.Expr{call(function.Max), &stmt.NumberLiteral{Val: 6}}}, Alias: "clamp"},
+		&stmt.SelectItem{Expr: &stmt.ParenExpr{Expr: &stmt.BinaryExpr{Left: call(function.Avg), Operator: stmt.MUL, Right: &stmt.NumberLiteral{Val: 2}}}},
+		&stmt.SelectItem{Expr: call(function.Sum).Params[0]},
+		&stmt.SelectItem{Expr: &stmt.CallExpr{FuncType: function.Sum, Params: []stmt.Expr{&stmt.FieldExpr{Name: "not_exist"}}}},
+	}, []string{"region"}, 20)
+	rows, err := agg.Aggregate(rs)
+	assert.NoError(t, err)
+	assert.Equal(t, timeutil.TimeRange{Start: 0, End: 40}, agg.TimeRange())
+	assert.Equal(t, int64(20), agg.Interval())
+	assert.Len(t, rows, 2)
+
+	tags, fields := rows[0].ResultSet()
+	assert.Equal(t, "sh", tags)
+	assert.Len(t, fields, 9)
+	assert.Equal(t, 5.0, fields["max"].GetValue(0))
+	assert.Equal(t, 8.0, fields["max"].GetValue(1))
+	assert.False(t, fields["max"].HasValue(2))
+	assert.Equal(t, 2.5, fields["sum(v)/count(v)"].GetValue(0))
+	assert.Equal(t, 1.0, fields["first"].GetValue(0))
+	assert.Equal(t, 8.0, fields["last"].GetValue(1))
+	assert.InDelta(t, 1.658, fields["stddev"].GetValue(0), 0.001)
+	assert.Equal(t, 1.0, fields["cs"].GetValue(0))
+	assert.Equal(t, 3.0, fields["cs"].GetValue(1))
+	assert.Equal(t, 2.5, fields["top"].GetValue(0))
+	assert.Equal(t, 5.0, fields["clamp"].GetValue(0))
+	assert.Equal(t, 6.0, fields["clamp"].GetValue(1))
+	assert.Equal(t, 5.0, fields["(avg(v)*2.00)"].GetValue(0))
+
+	tags, fields = rows[1].ResultSet()
+	assert.Equal(t, "bj", tags)
+	assert.Equal(t, 6.0, fields["max"].GetValue(0))
+	assert.Equal(t, 7.0, fields["max"].GetValue(2))
+
+	// use interval of result set
+	agg = NewSubQueryAggregator([]stmt.Expr{&stmt.SelectItem{Expr: call(function.Sum)}}, nil, 0)
+	rows, err = agg.Aggregate(rs)
+	assert.NoError(t, err)
+	assert.Len(t, rows, 1)
+	assert.Equal(t, int64(10), agg.Interval())
+	_, fields = rows[0].ResultSet()
+	assert.Equal(t, 10.0, fields["sum(v)"].GetValue(0))
+	assert.Equal(t, 2.0, fields["sum(v)"].GetValue(2))
+}
+
+func TestSubQueryAggregator_Distribution(t *testing.T) {
+	newSeries := func(host string, points map[int64]float64) *commonmodels.Series {
+		series := commonmodels.NewSeries(map[string]string{"host": host, "region": "sh"}, host)
+		series.Fields["v"] = points
+		return series
+	}
+	rs := &commonmodels.ResultSet{
+		Series: []*commonmodels.Series{
+			newSeries("h1", map[int64]float64{0: 1, 10: 10}),
+			newSeries("h2", map[int64]float64{0: 2}),
+			newSeries("h3", map[int64]float64{0: 3}),
+			newSeries("h4", map[int64]float64{0: 4, 10: math.NaN()}),
+		},
+		StartTime: 0,
+		EndTime:   10,
+		Interval:  10,
+	}
+	v := &stmt.FieldExpr{Name: "v"}
+	agg := NewSubQueryAggregator([]stmt.Expr{
+		&stmt.SelectItem{Expr: &stmt.CallExpr{FuncType: function.Percentile, Params: []stmt.Expr{v, &stmt.NumberLiteral{Val: 95}}}, Alias: "p95"},
+		&stmt.SelectItem{Expr: &stmt.CallExpr{FuncType: function.Median, Params: []stmt.Expr{v}}, Alias: "median"},
+		&stmt.SelectItem{Expr: &stmt.CallExpr{FuncType: function.DistinctCount, Params: []stmt.Expr{&stmt.FieldExpr{Name: "host"}}}, Alias: "hosts"},
+		&stmt.SelectItem{Expr: &stmt.CallExpr{FuncType: function.DistinctCount, Params: []stmt.Expr{&stmt.FieldExpr{Name: "dc"}}}},
+		&stmt.SelectItem{Expr: &stmt.CallExpr{FuncType: function.Median, Params: []stmt.Expr{&stmt.FieldExpr{Name: "not_exist"}}}},
+		&stmt.SelectItem{Expr: &stmt.CallExpr{FuncType: function.Percentile, Params: []stmt.Expr{v}}},
+		&stmt.SelectItem{Expr: &stmt.CallExpr{FuncType: function.Percentile, Params: []stmt.Expr{v, v}}},
+		&stmt.SelectItem{Expr: &stmt.CallExpr{FuncType: function.Median, Params: []stmt.Expr{&stmt.NumberLiteral{Val: 1}}}},
+		&stmt.SelectItem{Expr: &stmt.CallExpr{FuncType: function.Median}},
+	}, []string{"region"}, 0)
+	rows, err := agg.Aggregate(rs)
+	assert.NoError(t, err)
+	assert.Len(t, rows, 1)
+	_, fields := rows[0].ResultSet()
+	assert.Len(t, fields, 3)
+	assert.InDelta(t, 3.85, fields["p95"].GetValue(0), 0.001)
+	assert.Equal(t, 10.0, fields["p95"].GetValue(1))
+	assert.Equal(t, 2.5, fields["median"].GetValue(0))
+	assert.Equal(t, 10.0, fields["median"].GetValue(1))
+	assert.Equal(t, 4.0, fields["hosts"].GetValue(0))
+	assert.Equal(t, 1.0, fields["hosts"].GetValue(1))
+}
+
+func TestSubQueryAggregator_Aggregate_Fail(t *testing.T) {
+	agg := NewSubQueryAggregator(nil, nil, 0)
+	_, err := agg.Aggregate(&commonmodels.ResultSet{})
+	assert.Error(t, err)
+
+	agg = NewSubQueryAggregator(nil, []string{"host"}, 10)
+	_, err = agg.Aggregate(&commonmodels.ResultSet{
+		Series: []*commonmodels.Series{commonmodels.NewSeries(map[string]string{"ip": "1.1.1.1"}, "1.1.1.1")},
+	})
+	assert.Error(t, err)
+}
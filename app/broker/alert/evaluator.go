@@ -0,0 +1,502 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package alert
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	commonmodels "github.com/lindb/common/models"
+	"github.com/lindb/common/pkg/encoding"
+	"github.com/lindb/common/pkg/logger"
+	commontimeutil "github.com/lindb/common/pkg/timeutil"
+	"github.com/prometheus/prometheus/promql"
+	"github.com/prometheus/prometheus/storage"
+
+	"github.com/lindb/lindb/app/broker/api/exec/command"
+	promapi "github.com/lindb/lindb/app/broker/api/prometheus"
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/state"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/prometheus"
+	sqlpkg "github.com/lindb/lindb/sql"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+// for testing
+var (
+	sqlParseFn  = sqlpkg.Parse
+	queryFn     = command.QueryCommand
+	newTickerFn = time.NewTicker
+	nowFn       = commontimeutil.Now
+	// newQueryableFn creates the queryable of PromQL engine which queries the database of alert rule.
+	newQueryableFn = func(deps *depspkg.HTTPDeps, database string) storage.Queryable {
+		return promapi.NewDatabaseQueryable(deps, database)
+	}
+)
+
+const (
+	// checkInterval represents the interval of checking which alert rules need to be evaluated.
+	checkInterval = time.Second
+	// resendDelay represents the minimum delay before resending notification of firing alert.
+	resendDelay = time.Minute
+	// resolvedRetention represents how long resolved alert is kept(for show alerts) before removed.
+	resolvedRetention = 15 * commontimeutil.OneMinute
+	// alertNameLabel represents the label name of alert rule name.
+	alertNameLabel = "alertname"
+	// metricNameLabel represents the metric name label of PromQL result, which is dropped from alert labels.
+	metricNameLabel = "__name__"
+	// repoTimeout represents the timeout of accessing state repo.
+	repoTimeout = 5 * time.Second
+)
+
+// Evaluator represents the alert rule evaluator, which evaluates alert rules periodically,
+// then tracks the state of alerts and sends notifications to receivers.
+type Evaluator interface {
+	// Start starts the evaluator in background goroutine.
+	Start()
+	// Stop stops the evaluator.
+	Stop()
+}
+
+// sample represents one series of alert rule expression's result.
+type sample struct {
+	labels map[string]string
+	value  float64
+}
+
+// ruleState represents the evaluation state of alert rule.
+type ruleState struct {
+	lastEval int64
+	// alerts represents the active/resolved alerts of rule(key: labels string).
+	alerts map[string]*models.Alert
+}
+
+// evaluator implements Evaluator interface.
+type evaluator struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	deps   *depspkg.HTTPDeps
+
+	engine   *promql.Engine
+	notifier Notifier
+
+	// rules represents the state of alert rules(key: database/name).
+	rules map[string]*ruleState
+	wait  sync.WaitGroup
+
+	statistics *metrics.AlertStatistics
+	logger     logger.Logger
+}
+
+// NewEvaluator creates an alert rule evaluator, only master broker node evaluates alert rules.
+func NewEvaluator(ctx context.Context, deps *depspkg.HTTPDeps) Evaluator {
+	c, cancel := context.WithCancel(ctx)
+	return &evaluator{
+		ctx:        c,
+		cancel:     cancel,
+		deps:       deps,
+		engine:     prometheus.NewEngine(),
+		notifier:   newHTTPNotifier(),
+		rules:      make(map[string]*ruleState),
+		statistics: metrics.NewAlertStatistics(),
+		logger:     logger.GetLogger("Broker", "Alert"),
+	}
+}
+
+// Start starts the evaluator in background goroutine.
+func (e *evaluator) Start() {
+	e.wait.Add(1)
+	go func() {
+		defer e.wait.Done()
+		e.run()
+	}()
+	e.logger.Info("alert rule evaluator started")
+}
+
+// Stop stops the evaluator, waits the evaluating alert rules completed.
+func (e *evaluator) Stop() {
+	e.cancel()
+	e.wait.Wait()
+	e.logger.Info("alert rule evaluator stopped")
+}
+
+// run checks alert rules periodically until evaluator stopped.
+func (e *evaluator) run() {
+	ticker := newTickerFn(checkInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-e.ctx.Done():
+			return
+		case <-ticker.C:
+			e.schedule()
+		}
+	}
+}
+
+// schedule evaluates all alert rules which reach the evaluation interval.
+func (e *evaluator) schedule() {
+	if !e.deps.Master.IsMaster() {
+		// reset alert state, because master role may be transferred to other node,
+		// the new master loads the persisted state.
+		if len(e.rules) > 0 {
+			e.rules = make(map[string]*ruleState)
+		}
+		return
+	}
+	rules, err := e.listRules()
+	if err != nil {
+		e.logger.Warn("list alert rules failure", logger.Error(err))
+		return
+	}
+	now := nowFn()
+	actives := make(map[string]struct{}, len(rules))
+	for _, rule := range rules {
+		key := rule.Key()
+		actives[key] = struct{}{}
+		rs, ok := e.rules[key]
+		if !ok {
+			rs = e.loadState(rule)
+			e.rules[key] = rs
+		}
+		if now-rs.lastEval < rule.Interval.Int64() {
+			continue
+		}
+		if e.ctx.Err() != nil {
+			return
+		}
+		rs.lastEval = now
+		e.evaluate(rule, rs, now)
+	}
+	// remove deleted alert rules
+	for key := range e.rules {
+		if _, ok := actives[key]; !ok {
+			delete(e.rules, key)
+		}
+	}
+}
+
+// listRules returns all alert rules from state repo.
+func (e *evaluator) listRules() ([]*models.AlertRule, error) {
+	ctx, cancel := context.WithTimeout(e.ctx, repoTimeout)
+	defer cancel()
+	data, err := e.deps.Repo.List(ctx, constants.AlertRulePath)
+	if err != nil {
+		return nil, err
+	}
+	var rules []*models.AlertRule
+	for _, val := range data {
+		rule := &models.AlertRule{}
+		if err0 := encoding.JSONUnmarshal(val.Value, rule); err0 != nil {
+			e.logger.Warn("unmarshal data error",
+				logger.String("data", string(val.Value)))
+			continue
+		}
+		rule.Default()
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// loadState loads the persisted alerts of rule, returns empty state if not exist.
+func (e *evaluator) loadState(rule *models.AlertRule) *ruleState {
+	rs := &ruleState{alerts: make(map[string]*models.Alert)}
+	ctx, cancel := context.WithTimeout(e.ctx, repoTimeout)
+	defer cancel()
+	data, err := e.deps.Repo.Get(ctx, constants.GetAlertStatePath(rule.Key()))
+	if err != nil {
+		if !errors.Is(err, state.ErrNotExist) {
+			e.logger.Warn("load alert state failure", logger.String("rule", rule.Name), logger.Error(err))
+		}
+		return rs
+	}
+	var alerts models.Alerts
+	if err := encoding.JSONUnmarshal(data, &alerts); err != nil {
+		e.logger.Warn("unmarshal alert state failure", logger.String("rule", rule.Name), logger.Error(err))
+		return rs
+	}
+	for idx := range alerts {
+		alert := alerts[idx]
+		rs.alerts[alert.LabelsString()] = &alert
+	}
+	return rs
+}
+
+// evaluate evaluates alert rule, then updates alert state, sends notifications and persists the state.
+func (e *evaluator) evaluate(rule *models.AlertRule, rs *ruleState, now int64) {
+	startTime := time.Now()
+	defer e.statistics.Duration.UpdateSince(startTime)
+
+	samples, err := e.query(rule, now)
+	if err != nil {
+		e.statistics.EvaluationFailures.Incr()
+		e.logger.Error("evaluate alert rule failure",
+			logger.String("rule", rule.Name), logger.Error(err))
+		return
+	}
+	e.statistics.Evaluations.Incr()
+
+	transition(rule, rs, samples, now)
+	e.notify(rule, rs, now)
+	e.saveState(rule, rs)
+}
+
+// query evaluates the expression of alert rule, returns the samples of result which match the condition.
+func (e *evaluator) query(rule *models.AlertRule, now int64) (samples []*sample, err error) {
+	switch rule.Type {
+	case models.LinQLAlertRule:
+		if rule.Condition == nil {
+			// each series of LinQL result would be an active alert without condition
+			return nil, fmt.Errorf("condition is required for LinQL alert rule")
+		}
+		samples, err = e.queryLinQL(rule, now)
+	case models.PromQLAlertRule:
+		samples, err = e.queryPromQL(rule, now)
+	default:
+		err = fmt.Errorf("unsupported alert rule type: %s", rule.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+	matched := samples[:0]
+	for _, s := range samples {
+		if rule.Condition != nil && !rule.Condition.Match(s.value) {
+			continue
+		}
+		for k, v := range rule.Labels {
+			s.labels[k] = v
+		}
+		s.labels[alertNameLabel] = rule.Name
+		matched = append(matched, s)
+	}
+	return matched, nil
+}
+
+// queryLinQL executes LinQL data query with time range [now-interval, now],
+// uses the latest value of series as sample value.
+func (e *evaluator) queryLinQL(rule *models.AlertRule, now int64) ([]*sample, error) {
+	statement, err := sqlParseFn(rule.Expr)
+	if err != nil {
+		return nil, err
+	}
+	query, ok := statement.(*stmtpkg.Query)
+	if !ok {
+		return nil, fmt.Errorf("alert rule expression must be data query statement")
+	}
+	query.TimeRange = timeutil.TimeRange{Start: now - rule.Interval.Int64(), End: now}
+	if query.Interval <= 0 || query.AutoGroupByTime {
+		query.Interval = rule.Interval
+		query.AutoGroupByTime = false
+	}
+	param := &models.ExecuteParam{Database: rule.Database, SQL: rule.Expr}
+	var rs interface{}
+	if err := e.deps.QueryLimiter.Do(func() error {
+		ctx, cancel := context.WithTimeout(e.ctx, e.deps.BrokerCfg.Query.Timeout.Duration())
+		defer cancel()
+		rs, err = queryFn(ctx, e.deps, param, query)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	resultSet, ok := rs.(*commonmodels.ResultSet)
+	if !ok || resultSet == nil {
+		return nil, nil
+	}
+	var samples []*sample
+	for _, series := range resultSet.Series {
+		value, ok := latestValue(series)
+		if !ok {
+			continue
+		}
+		lbs := make(map[string]string, len(series.Tags)+len(rule.Labels)+1)
+		for k, v := range series.Tags {
+			lbs[k] = v
+		}
+		samples = append(samples, &sample{labels: lbs, value: value})
+	}
+	return samples, nil
+}
+
+// queryPromQL executes PromQL instant query at now, queries the database of rule if set.
+func (e *evaluator) queryPromQL(rule *models.AlertRule, now int64) ([]*sample, error) {
+	var samples []*sample
+	err := e.deps.QueryLimiter.Do(func() error {
+		ctx, cancel := context.WithTimeout(e.ctx, e.deps.BrokerCfg.Query.Timeout.Duration())
+		defer cancel()
+		queryable := newQueryableFn(e.deps, rule.Database)
+		qry, err := e.engine.NewInstantQuery(ctx, queryable, nil, rule.Expr, time.UnixMilli(now))
+		if err != nil {
+			return err
+		}
+		defer qry.Close()
+		res := qry.Exec(ctx)
+		if res.Err != nil {
+			return res.Err
+		}
+		switch v := res.Value.(type) {
+		case promql.Vector:
+			for _, s := range v {
+				if s.H != nil {
+					// histogram sample cannot be used as alert value
+					continue
+				}
+				lbs := s.Metric.Map()
+				delete(lbs, metricNameLabel)
+				samples = append(samples, &sample{labels: lbs, value: s.F})
+			}
+		case promql.Scalar:
+			samples = append(samples, &sample{labels: make(map[string]string), value: v.V})
+		default:
+			return fmt.Errorf("unsupported result type of alert rule expression: %s", res.Value.Type())
+		}
+		return nil
+	})
+	return samples, err
+}
+
+// transition updates the state of alerts based on the samples of current evaluation.
+func transition(rule *models.AlertRule, rs *ruleState, samples []*sample, now int64) {
+	forDuration := rule.For.Int64()
+	actives := make(map[string]struct{}, len(samples))
+	for _, s := range samples {
+		alert := &models.Alert{Database: rule.Database, Rule: rule.Name, Labels: s.labels}
+		key := alert.LabelsString()
+		actives[key] = struct{}{}
+		if exist, ok := rs.alerts[key]; ok && exist.State != models.AlertResolved {
+			alert = exist
+		} else {
+			alert.State = models.AlertPending
+			alert.ActiveAt = now
+			rs.alerts[key] = alert
+		}
+		alert.Value = s.value
+		alert.Annotations = rule.Annotations
+		if alert.State == models.AlertPending && now-alert.ActiveAt >= forDuration {
+			alert.State = models.AlertFiring
+			alert.FiredAt = now
+		}
+	}
+	for key, alert := range rs.alerts {
+		if _, ok := actives[key]; ok {
+			continue
+		}
+		switch alert.State {
+		case models.AlertPending:
+			delete(rs.alerts, key)
+		case models.AlertFiring:
+			alert.State = models.AlertResolved
+			alert.ResolvedAt = now
+		case models.AlertResolved:
+			if now-alert.ResolvedAt >= resolvedRetention {
+				delete(rs.alerts, key)
+			}
+		}
+	}
+}
+
+// notify sends firing alerts(resends after delay) and new resolved alerts to receivers.
+func (e *evaluator) notify(rule *models.AlertRule, rs *ruleState, now int64) {
+	if len(rule.Receivers) == 0 {
+		return
+	}
+	var alerts []*models.Alert
+	for _, alert := range rs.alerts {
+		switch {
+		case alert.State == models.AlertFiring && now-alert.LastSentAt >= resendDelay.Milliseconds():
+			alerts = append(alerts, alert)
+		case alert.State == models.AlertResolved && alert.LastSentAt < alert.ResolvedAt && alert.FiredAt > 0:
+			alerts = append(alerts, alert)
+		}
+	}
+	if len(alerts) == 0 {
+		return
+	}
+	sortAlerts(alerts)
+	ctx, cancel := context.WithTimeout(e.ctx, notifyTimeout)
+	defer cancel()
+	if err := e.notifier.Notify(ctx, rule, alerts, now); err != nil {
+		// retries in next evaluation
+		e.statistics.NotificationFailures.Incr()
+		e.logger.Error("send alert notification failure",
+			logger.String("rule", rule.Name), logger.Error(err))
+		return
+	}
+	e.statistics.Notifications.Incr()
+	for _, alert := range alerts {
+		alert.LastSentAt = now
+	}
+}
+
+// saveState persists the alerts of rule, so that alerts can be shown and recovered after master changed.
+func (e *evaluator) saveState(rule *models.AlertRule, rs *ruleState) {
+	alerts := make([]*models.Alert, 0, len(rs.alerts))
+	for _, alert := range rs.alerts {
+		alerts = append(alerts, alert)
+	}
+	sortAlerts(alerts)
+	ctx, cancel := context.WithTimeout(e.ctx, repoTimeout)
+	defer cancel()
+	if err := e.deps.Repo.Put(ctx, constants.GetAlertStatePath(rule.Key()), encoding.JSONMarshal(alerts)); err != nil {
+		e.logger.Warn("save alert state failure", logger.String("rule", rule.Name), logger.Error(err))
+	}
+}
+
+// latestValue returns the latest non-NaN value of series, uses the first field which has value(sorted by name).
+func latestValue(series *commonmodels.Series) (float64, bool) {
+	fieldNames := make([]string, 0, len(series.Fields))
+	for name := range series.Fields {
+		fieldNames = append(fieldNames, name)
+	}
+	sort.Strings(fieldNames)
+	for _, name := range fieldNames {
+		var (
+			latest int64 = math.MinInt64
+			value  float64
+			found  bool
+		)
+		for timestamp, v := range series.Fields[name] {
+			if math.IsNaN(v) || timestamp < latest {
+				continue
+			}
+			latest = timestamp
+			value = v
+			found = true
+		}
+		if found {
+			return value, true
+		}
+	}
+	return 0, false
+}
+
+// sortAlerts sorts alerts by labels.
+func sortAlerts(alerts []*models.Alert) {
+	sort.Slice(alerts, func(i, j int) bool {
+		return alerts[i].LabelsString() < alerts[j].LabelsString()
+	})
+}
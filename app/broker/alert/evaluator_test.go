@@ -0,0 +1,415 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package alert
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	commonmodels "github.com/lindb/common/models"
+	"github.com/lindb/common/pkg/encoding"
+	"github.com/lindb/common/pkg/ltoml"
+	commontimeutil "github.com/lindb/common/pkg/timeutil"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql"
+	"github.com/prometheus/prometheus/storage"
+	"github.com/prometheus/prometheus/util/annotations"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/lindb/lindb/app/broker/api/exec/command"
+	promapi "github.com/lindb/lindb/app/broker/api/prometheus"
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/coordinator"
+	"github.com/lindb/lindb/internal/concurrent"
+	"github.com/lindb/lindb/internal/linmetric"
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/state"
+	"github.com/lindb/lindb/pkg/timeutil"
+	sqlpkg "github.com/lindb/lindb/sql"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+var testRule = models.AlertRule{
+	Name:     "cpu_high",
+	Type:     models.LinQLAlertRule,
+	Database: "test",
+	Expr:     "select max(usage) from cpu group by host",
+	Condition: &models.AlertCondition{
+		Operator:  models.AlertGreaterThan,
+		Threshold: 80,
+	},
+	Interval: timeutil.Interval(commontimeutil.OneMinute),
+	Labels:   map[string]string{"severity": "critical"},
+	Receivers: []models.AlertReceiver{
+		{Type: models.WebhookReceiver, URL: "http://127.0.0.1/hook"},
+	},
+}
+
+// mockQueryable returns the fixed series for testing.
+type mockQueryable struct {
+	series []storage.Series
+	err    error
+}
+
+func (q *mockQueryable) Querier(_, _ int64) (storage.Querier, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	return &mockQuerier{Querier: storage.NoopQuerier(), series: q.series}, nil
+}
+
+type mockQuerier struct {
+	storage.Querier
+	series []storage.Series
+}
+
+func (q *mockQuerier) Select(_ context.Context, _ bool, _ *storage.SelectHints, matchers ...*labels.Matcher) storage.SeriesSet {
+	var rs []storage.Series
+	for _, s := range q.series {
+		matched := true
+		for _, m := range matchers {
+			if !m.Matches(s.Labels().Get(m.Name)) {
+				matched = false
+			}
+		}
+		if matched {
+			rs = append(rs, s)
+		}
+	}
+	return &mockSeriesSet{series: rs, idx: -1}
+}
+
+type mockSeriesSet struct {
+	series []storage.Series
+	idx    int
+}
+
+func (s *mockSeriesSet) Next() bool {
+	s.idx++
+	return s.idx < len(s.series)
+}
+func (s *mockSeriesSet) At() storage.Series                { return s.series[s.idx] }
+func (s *mockSeriesSet) Err() error                        { return nil }
+func (s *mockSeriesSet) Warnings() annotations.Annotations { return nil }
+
+func newTestEvaluator(ctrl *gomock.Controller) (*evaluator, *coordinator.MockMasterController,
+	*state.MockRepository, *MockNotifier) {
+	master := coordinator.NewMockMasterController(ctrl)
+	repo := state.NewMockRepository(ctrl)
+	notifier := NewMockNotifier(ctrl)
+	e := NewEvaluator(context.TODO(), &depspkg.HTTPDeps{
+		BrokerCfg: &config.Broker{
+			Query: config.Query{Timeout: ltoml.Duration(time.Second)},
+		},
+		Master: master,
+		Repo:   repo,
+		QueryLimiter: concurrent.NewLimiter(
+			context.TODO(),
+			32,
+			time.Second,
+			metrics.NewLimitStatistics("alert_test", linmetric.BrokerRegistry)),
+	}).(*evaluator)
+	e.notifier = notifier
+	return e, master, repo, notifier
+}
+
+func newTestResultSet(hosts ...string) *commonmodels.ResultSet {
+	rs := commonmodels.NewResultSet()
+	for _, host := range hosts {
+		series := commonmodels.NewSeries(map[string]string{"host": host}, host)
+		series.AddField("max(usage)", &commonmodels.Points{Points: map[int64]float64{1000: 90, 2000: math.NaN()}})
+		rs.AddSeries(series)
+	}
+	return rs
+}
+
+func TestEvaluator_StartStop(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newTickerFn = time.NewTicker
+		ctrl.Finish()
+	}()
+	newTickerFn = func(_ time.Duration) *time.Ticker {
+		return time.NewTicker(time.Millisecond)
+	}
+	e, master, _, _ := newTestEvaluator(ctrl)
+	master.EXPECT().IsMaster().Return(false).AnyTimes()
+	e.Start()
+	time.Sleep(10 * time.Millisecond)
+	e.Stop()
+}
+
+func TestEvaluator_schedule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		queryFn = command.QueryCommand
+		nowFn = commontimeutil.Now
+		ctrl.Finish()
+	}()
+	now := int64(10 * commontimeutil.OneMinute)
+	nowFn = func() int64 { return now }
+	e, master, repo, notifier := newTestEvaluator(ctrl)
+
+	// not master, reset state
+	e.rules["deleted"] = &ruleState{}
+	master.EXPECT().IsMaster().Return(false)
+	e.schedule()
+	assert.Empty(t, e.rules)
+
+	master.EXPECT().IsMaster().Return(true).AnyTimes()
+	// list rules failure
+	repo.EXPECT().List(gomock.Any(), constants.AlertRulePath).Return(nil, fmt.Errorf("err"))
+	e.schedule()
+	assert.Empty(t, e.rules)
+
+	ruleData := encoding.JSONMarshal(&testRule)
+	repo.EXPECT().List(gomock.Any(), constants.AlertRulePath).Return([]state.KeyValue{
+		{Key: "bad", Value: []byte("bad")},
+		{Key: testRule.Name, Value: ruleData},
+	}, nil).AnyTimes()
+	// load state failure, query failure
+	repo.EXPECT().Get(gomock.Any(), constants.GetAlertStatePath("test/cpu_high")).Return(nil, fmt.Errorf("err"))
+	queryFn = func(_ context.Context, _ *depspkg.HTTPDeps, _ *models.ExecuteParam, _ stmtpkg.Statement) (interface{}, error) {
+		return nil, fmt.Errorf("err")
+	}
+	e.rules["deleted"] = &ruleState{}
+	e.schedule()
+	assert.Len(t, e.rules, 1)
+	assert.Equal(t, now, e.rules[testRule.Key()].lastEval)
+	// not reach evaluation interval
+	e.schedule()
+
+	// alert firing, send notification
+	now += testRule.Interval.Int64()
+	queryFn = func(_ context.Context, _ *depspkg.HTTPDeps, param *models.ExecuteParam, s stmtpkg.Statement) (interface{}, error) {
+		query := s.(*stmtpkg.Query)
+		assert.Equal(t, timeutil.TimeRange{Start: now - testRule.Interval.Int64(), End: now}, query.TimeRange)
+		assert.Equal(t, testRule.Database, param.Database)
+		return newTestResultSet("a"), nil
+	}
+	notifier.EXPECT().Notify(gomock.Any(), gomock.Any(), gomock.Any(), now).
+		DoAndReturn(func(_ context.Context, rule *models.AlertRule, alerts []*models.Alert, _ int64) error {
+			assert.Len(t, alerts, 1)
+			assert.Equal(t, models.AlertFiring, alerts[0].State)
+			assert.Equal(t, 90.0, alerts[0].Value)
+			assert.Equal(t, map[string]string{"host": "a", "severity": "critical", alertNameLabel: rule.Name}, alerts[0].Labels)
+			return nil
+		})
+	repo.EXPECT().Put(gomock.Any(), constants.GetAlertStatePath("test/cpu_high"), gomock.Any()).Return(nil)
+	e.schedule()
+	assert.Len(t, e.rules[testRule.Key()].alerts, 1)
+
+	// alert resolved, notification failure, save state failure
+	now += testRule.Interval.Int64()
+	queryFn = func(_ context.Context, _ *depspkg.HTTPDeps, _ *models.ExecuteParam, _ stmtpkg.Statement) (interface{}, error) {
+		return newTestResultSet(), nil
+	}
+	notifier.EXPECT().Notify(gomock.Any(), gomock.Any(), gomock.Any(), now).Return(fmt.Errorf("err"))
+	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+	e.schedule()
+	// retry notification of resolved alert
+	now += testRule.Interval.Int64()
+	notifier.EXPECT().Notify(gomock.Any(), gomock.Any(), gomock.Any(), now).Return(nil)
+	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	e.schedule()
+	// resolved alert already sent
+	now += testRule.Interval.Int64()
+	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	e.schedule()
+	assert.Equal(t, models.AlertResolved, e.rules[testRule.Key()].alerts[`{alertname="cpu_high",host="a",severity="critical"}`].State)
+}
+
+func TestEvaluator_schedule_SameRuleName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		queryFn = command.QueryCommand
+		ctrl.Finish()
+	}()
+	e, master, repo, _ := newTestEvaluator(ctrl)
+	master.EXPECT().IsMaster().Return(true).AnyTimes()
+
+	rule1 := testRule
+	rule1.Receivers = nil
+	rule2 := rule1
+	rule2.Database = "test2"
+	repo.EXPECT().List(gomock.Any(), constants.AlertRulePath).Return([]state.KeyValue{
+		{Key: "test/cpu_high", Value: encoding.JSONMarshal(&rule1)},
+		{Key: "test2/cpu_high", Value: encoding.JSONMarshal(&rule2)},
+	}, nil)
+	queryFn = func(_ context.Context, _ *depspkg.HTTPDeps, param *models.ExecuteParam, _ stmtpkg.Statement) (interface{}, error) {
+		if param.Database == "test" {
+			return newTestResultSet("a"), nil
+		}
+		return newTestResultSet("b"), nil
+	}
+	// state of rules with same name under different databases are stored separately
+	for _, key := range []string{"test/cpu_high", "test2/cpu_high"} {
+		repo.EXPECT().Get(gomock.Any(), constants.GetAlertStatePath(key)).Return(nil, state.ErrNotExist)
+		repo.EXPECT().Put(gomock.Any(), constants.GetAlertStatePath(key), gomock.Any()).Return(nil)
+	}
+	e.schedule()
+	assert.Len(t, e.rules, 2)
+	assert.Equal(t, "test", e.rules["test/cpu_high"].alerts[`{alertname="cpu_high",host="a",severity="critical"}`].Database)
+	assert.Equal(t, "test2", e.rules["test2/cpu_high"].alerts[`{alertname="cpu_high",host="b",severity="critical"}`].Database)
+}
+
+func TestEvaluator_loadState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	e, _, repo, _ := newTestEvaluator(ctrl)
+
+	repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, state.ErrNotExist)
+	assert.Empty(t, e.loadState(&testRule).alerts)
+	repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return([]byte("bad"), nil)
+	assert.Empty(t, e.loadState(&testRule).alerts)
+	repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(encoding.JSONMarshal(models.Alerts{
+		{Rule: testRule.Name, Labels: map[string]string{"host": "a"}, State: models.AlertFiring},
+	}), nil)
+	rs := e.loadState(&testRule)
+	assert.Equal(t, models.AlertFiring, rs.alerts[`{host="a"}`].State)
+}
+
+func TestEvaluator_query(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		sqlParseFn = sqlpkg.Parse
+		newQueryableFn = func(deps *depspkg.HTTPDeps, database string) storage.Queryable {
+			return promapi.NewDatabaseQueryable(deps, database)
+		}
+		ctrl.Finish()
+	}()
+	e, _, _, _ := newTestEvaluator(ctrl)
+	now := int64(10 * commontimeutil.OneMinute)
+
+	// unsupported type
+	_, err := e.query(&models.AlertRule{Type: "unknown"}, now)
+	assert.Error(t, err)
+	// condition required
+	_, err = e.query(&models.AlertRule{Type: models.LinQLAlertRule, Expr: "select usage from cpu"}, now)
+	assert.Error(t, err)
+	cond := &models.AlertCondition{Operator: models.AlertGreaterThan, Threshold: 80}
+	// parse failure
+	_, err = e.query(&models.AlertRule{Type: models.LinQLAlertRule, Expr: "bad sql", Condition: cond}, now)
+	assert.Error(t, err)
+	// not data query
+	_, err = e.query(&models.AlertRule{Type: models.LinQLAlertRule, Expr: "show databases", Condition: cond}, now)
+	assert.Error(t, err)
+
+	// promql
+	queryable := &mockQueryable{series: []storage.Series{
+		promql.NewStorageSeries(promql.Series{
+			Metric: labels.FromStrings(metricNameLabel, "cpu", "host", "a"),
+			Floats: []promql.FPoint{{T: now - 1000, F: 95}},
+		}),
+	}}
+	newQueryableFn = func(_ *depspkg.HTTPDeps, database string) storage.Queryable {
+		// query the database of rule
+		assert.Equal(t, "prom", database)
+		return queryable
+	}
+	rule := &models.AlertRule{Name: "cpu_high", Type: models.PromQLAlertRule, Database: "prom", Expr: "cpu > 90"}
+	samples, err := e.query(rule, now)
+	assert.NoError(t, err)
+	assert.Len(t, samples, 1)
+	assert.Equal(t, map[string]string{"host": "a", alertNameLabel: "cpu_high"}, samples[0].labels)
+	assert.Equal(t, 95.0, samples[0].value)
+	// not match condition
+	rule.Condition = &models.AlertCondition{Operator: models.AlertGreaterThan, Threshold: 99}
+	samples, err = e.query(rule, now)
+	assert.NoError(t, err)
+	assert.Empty(t, samples)
+	rule.Condition = nil
+	// scalar
+	rule.Expr = "1"
+	samples, err = e.query(rule, now)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{alertNameLabel: "cpu_high"}, samples[0].labels)
+	// unsupported result type
+	rule.Expr = "cpu[5m]"
+	_, err = e.query(rule, now)
+	assert.Error(t, err)
+	// bad expr
+	rule.Expr = "cpu{"
+	_, err = e.query(rule, now)
+	assert.Error(t, err)
+	// query failure
+	queryable.err = fmt.Errorf("err")
+	rule.Expr = "cpu"
+	_, err = e.query(rule, now)
+	assert.Error(t, err)
+}
+
+func TestTransition(t *testing.T) {
+	rule := &models.AlertRule{
+		Name: "cpu_high",
+		For:  timeutil.Interval(2 * commontimeutil.OneMinute),
+	}
+	rs := &ruleState{alerts: make(map[string]*models.Alert)}
+	newSamples := func(hosts ...string) []*sample {
+		var samples []*sample
+		for _, host := range hosts {
+			samples = append(samples, &sample{labels: map[string]string{"host": host}, value: 1})
+		}
+		return samples
+	}
+	now := int64(0)
+	transition(rule, rs, newSamples("a", "b"), now)
+	assert.Equal(t, models.AlertPending, rs.alerts[`{host="a"}`].State)
+	// pending alert disappears
+	now += commontimeutil.OneMinute
+	transition(rule, rs, newSamples("a"), now)
+	assert.Len(t, rs.alerts, 1)
+	// keeps active for duration
+	now += commontimeutil.OneMinute
+	transition(rule, rs, newSamples("a"), now)
+	assert.Equal(t, models.AlertFiring, rs.alerts[`{host="a"}`].State)
+	assert.Equal(t, now, rs.alerts[`{host="a"}`].FiredAt)
+	// resolved
+	now += commontimeutil.OneMinute
+	transition(rule, rs, nil, now)
+	assert.Equal(t, models.AlertResolved, rs.alerts[`{host="a"}`].State)
+	assert.Equal(t, now, rs.alerts[`{host="a"}`].ResolvedAt)
+	// active again, becomes new pending alert
+	now += commontimeutil.OneMinute
+	transition(rule, rs, newSamples("a"), now)
+	assert.Equal(t, models.AlertPending, rs.alerts[`{host="a"}`].State)
+	assert.Equal(t, now, rs.alerts[`{host="a"}`].ActiveAt)
+	// resolved alert removed after retention
+	rs.alerts[`{host="a"}`].State = models.AlertResolved
+	rs.alerts[`{host="a"}`].ResolvedAt = now
+	transition(rule, rs, nil, now+resolvedRetention)
+	assert.Empty(t, rs.alerts)
+}
+
+func TestLatestValue(t *testing.T) {
+	series := commonmodels.NewSeries(nil, "")
+	_, ok := latestValue(series)
+	assert.False(t, ok)
+	series.AddField("a", &commonmodels.Points{Points: map[int64]float64{1: math.NaN()}})
+	series.AddField("b", &commonmodels.Points{Points: map[int64]float64{1: 1, 3: 3, 2: 2}})
+	v, ok := latestValue(series)
+	assert.True(t, ok)
+	assert.Equal(t, 3.0, v)
+}
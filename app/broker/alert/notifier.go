@@ -0,0 +1,162 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package alert
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/lindb/common/pkg/encoding"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+)
+
+//go:generate mockgen -source=./notifier.go -destination=./notifier_mock.go -package=alert
+
+const (
+	// alertmanagerAPIPath represents the path of Alertmanager(v2) alerts api.
+	alertmanagerAPIPath = "/api/v2/alerts"
+	// notifyTimeout represents the timeout of sending notification to one receiver.
+	notifyTimeout = 10 * time.Second
+)
+
+// Notifier represents the notifier which sends alerts to receivers of alert rule.
+type Notifier interface {
+	// Notify sends alerts to all receivers of alert rule.
+	Notify(ctx context.Context, rule *models.AlertRule, alerts []*models.Alert, now int64) error
+}
+
+// alertmanagerAlert represents the alert of Alertmanager(v2 api).
+type alertmanagerAlert struct {
+	Labels       map[string]string `json:"labels"`
+	Annotations  map[string]string `json:"annotations,omitempty"`
+	StartsAt     time.Time         `json:"startsAt"`
+	EndsAt       time.Time         `json:"endsAt"`
+	GeneratorURL string            `json:"generatorURL,omitempty"`
+}
+
+// webhookMessage represents the message of generic webhook receiver.
+type webhookMessage struct {
+	Rule   string          `json:"rule"`
+	Status string          `json:"status"`
+	Alerts []*models.Alert `json:"alerts"`
+}
+
+// httpNotifier implements Notifier interface, sends alerts via http post.
+type httpNotifier struct {
+	client *http.Client
+}
+
+// newHTTPNotifier creates a http notifier.
+func newHTTPNotifier() Notifier {
+	return &httpNotifier{
+		client: &http.Client{Timeout: notifyTimeout},
+	}
+}
+
+// Notify sends alerts to all receivers of alert rule, returns the errors of failure receivers.
+func (n *httpNotifier) Notify(ctx context.Context, rule *models.AlertRule, alerts []*models.Alert, now int64) error {
+	var errs []error
+	for _, receiver := range rule.Receivers {
+		var (
+			url     = receiver.URL
+			payload []byte
+		)
+		switch receiver.Type {
+		case models.AlertmanagerReceiver:
+			if !strings.HasSuffix(url, alertmanagerAPIPath) {
+				url = strings.TrimSuffix(url, "/") + alertmanagerAPIPath
+			}
+			payload = encoding.JSONMarshal(toAlertmanagerAlerts(rule, alerts, now))
+		default:
+			payload = encoding.JSONMarshal(toWebhookMessage(rule, alerts))
+		}
+		if err := n.post(ctx, url, payload); err != nil {
+			errs = append(errs, fmt.Errorf("send alerts to %s failure: %w", url, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// post sends payload to receiver.
+func (n *httpNotifier) post(ctx context.Context, url string, payload []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", constants.ContentTypeJSON)
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// toAlertmanagerAlerts converts alerts to Alertmanager alerts,
+// firing alert's end time is extended, so that it doesn't resolve if next notification delayed.
+func toAlertmanagerAlerts(rule *models.AlertRule, alerts []*models.Alert, now int64) []*alertmanagerAlert {
+	validity := 4 * rule.Interval.Int64()
+	if resend := resendDelay.Milliseconds(); validity < 4*resend {
+		validity = 4 * resend
+	}
+	rs := make([]*alertmanagerAlert, 0, len(alerts))
+	for _, alert := range alerts {
+		endsAt := now + validity
+		if alert.State == models.AlertResolved {
+			endsAt = alert.ResolvedAt
+		}
+		rs = append(rs, &alertmanagerAlert{
+			Labels:      alert.Labels,
+			Annotations: alert.Annotations,
+			StartsAt:    time.UnixMilli(alert.ActiveAt),
+			EndsAt:      time.UnixMilli(endsAt),
+		})
+	}
+	return rs
+}
+
+// toWebhookMessage converts alerts to generic webhook message,
+// message status is firing if any alert is firing, else resolved.
+func toWebhookMessage(rule *models.AlertRule, alerts []*models.Alert) *webhookMessage {
+	status := models.AlertResolved
+	for _, alert := range alerts {
+		if alert.State == models.AlertFiring {
+			status = models.AlertFiring
+			break
+		}
+	}
+	return &webhookMessage{
+		Rule:   rule.Name,
+		Status: string(status),
+		Alerts: alerts,
+	}
+}
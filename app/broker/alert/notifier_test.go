@@ -0,0 +1,95 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package alert
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/lindb/common/pkg/encoding"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+func TestHTTPNotifier_Notify(t *testing.T) {
+	var (
+		paths  []string
+		bodies [][]byte
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, body)
+		if r.URL.Path == "/fail" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	now := time.Now().UnixMilli()
+	alerts := []*models.Alert{
+		{Rule: "cpu", Labels: map[string]string{"host": "a"}, State: models.AlertFiring, ActiveAt: now - 1000},
+		{Rule: "cpu", Labels: map[string]string{"host": "b"}, State: models.AlertResolved, ActiveAt: now - 1000, ResolvedAt: now},
+	}
+	rule := &models.AlertRule{
+		Name:     "cpu",
+		Interval: timeutil.Interval(time.Minute.Milliseconds()),
+		Receivers: []models.AlertReceiver{
+			{Type: models.AlertmanagerReceiver, URL: server.URL + "/"},
+			{Type: models.AlertmanagerReceiver, URL: server.URL + alertmanagerAPIPath},
+			{Type: models.WebhookReceiver, URL: server.URL + "/hook"},
+		},
+	}
+	n := newHTTPNotifier()
+	assert.NoError(t, n.Notify(context.TODO(), rule, alerts, now))
+	assert.Equal(t, []string{alertmanagerAPIPath, alertmanagerAPIPath, "/hook"}, paths)
+
+	var amAlerts []*alertmanagerAlert
+	assert.NoError(t, encoding.JSONUnmarshal(bodies[0], &amAlerts))
+	assert.Len(t, amAlerts, 2)
+	assert.Equal(t, "a", amAlerts[0].Labels["host"])
+	assert.True(t, amAlerts[0].EndsAt.After(time.UnixMilli(now)))
+	assert.Equal(t, now, amAlerts[1].EndsAt.UnixMilli())
+
+	msg := &webhookMessage{}
+	assert.NoError(t, encoding.JSONUnmarshal(bodies[2], msg))
+	assert.Equal(t, "cpu", msg.Rule)
+	assert.Equal(t, string(models.AlertFiring), msg.Status)
+	assert.Len(t, msg.Alerts, 2)
+
+	// receiver failure
+	rule.Receivers = []models.AlertReceiver{
+		{Type: models.WebhookReceiver, URL: server.URL + "/fail"},
+		{Type: models.WebhookReceiver, URL: "http://127.0.0.1:0/hook"},
+		{Type: models.WebhookReceiver, URL: "://bad-url"},
+	}
+	assert.Error(t, n.Notify(context.TODO(), rule, alerts, now))
+}
+
+func TestToWebhookMessage(t *testing.T) {
+	msg := toWebhookMessage(&models.AlertRule{Name: "cpu"}, []*models.Alert{{State: models.AlertResolved}})
+	assert.Equal(t, string(models.AlertResolved), msg.Status)
+}
@@ -0,0 +1,210 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package admin
+
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lindb/common/pkg/encoding"
+	httppkg "github.com/lindb/common/pkg/http"
+	"github.com/lindb/common/pkg/logger"
+	"github.com/prometheus/prometheus/promql/parser"
+
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/validate"
+	sqlpkg "github.com/lindb/lindb/sql"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+var (
+	// AlertRulePath represents alert rule admin api path.
+	AlertRulePath = "/alert/rule"
+)
+
+// AlertRuleAPI represents alert rule admin rest api.
+type AlertRuleAPI struct {
+	deps   *depspkg.HTTPDeps
+	logger logger.Logger
+}
+
+// NewAlertRuleAPI creates alert rule api instance.
+func NewAlertRuleAPI(deps *depspkg.HTTPDeps) *AlertRuleAPI {
+	return &AlertRuleAPI{
+		deps:   deps,
+		logger: logger.GetLogger("Broker", "AlertRuleAPI"),
+	}
+}
+
+// Register adds alert rule admin url route.
+func (a *AlertRuleAPI) Register(route gin.IRoutes) {
+	route.GET(AlertRulePath, a.List)
+	route.POST(AlertRulePath, a.Save)
+	route.DELETE(AlertRulePath, a.Delete)
+}
+
+// List returns alert rule list, if name is specified, returns the alert rule of this name(under database).
+//
+// @Summary list alert rules
+// @Description return alert rule list, if name is specified, returns the alert rule of this name(under database).
+// @Tags Alert
+// @Param name query string false "rule name"
+// @Param database query string false "database name"
+// @Produce json
+// @Success 200 {object} []models.AlertRule
+// @Failure 404 {string} string "not found"
+// @Failure 500 {string} string "internal error"
+// @Router /alert/rule [get]
+func (a *AlertRuleAPI) List(c *gin.Context) {
+	var param struct {
+		Name     string `form:"name"`
+		Database string `form:"database"`
+	}
+	if err := c.ShouldBindQuery(&param); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	ctx, cancel := a.deps.WithTimeout()
+	defer cancel()
+
+	if param.Name != "" {
+		key := (&models.AlertRule{Name: param.Name, Database: param.Database}).Key()
+		data, err := a.deps.Repo.Get(ctx, constants.GetAlertRulePath(key))
+		if err != nil {
+			httppkg.NotFound(c)
+			return
+		}
+		rule := &models.AlertRule{}
+		if err := encoding.JSONUnmarshal(data, rule); err != nil {
+			httppkg.Error(c, err)
+			return
+		}
+		httppkg.OK(c, rule)
+		return
+	}
+	data, err := a.deps.Repo.List(ctx, constants.AlertRulePath)
+	if err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	rules := make([]*models.AlertRule, 0, len(data))
+	for _, val := range data {
+		rule := &models.AlertRule{}
+		if err := encoding.JSONUnmarshal(val.Value, rule); err != nil {
+			a.logger.Warn("unmarshal alert rule error", logger.String("data", string(val.Value)))
+			continue
+		}
+		rules = append(rules, rule)
+	}
+	httppkg.OK(c, rules)
+}
+
+// Save creates the alert rule if not exist, otherwise updates it.
+//
+// @Summary create or update alert rule
+// @Description create alert rule if not exist, otherwise update it, the expression of rule is validated before saving.
+// @Tags Alert
+// @Accept json
+// @Param param body models.AlertRule ture "alert rule"
+// @Produce json
+// @Success 200 {string} string "success"
+// @Failure 500 {string} string "internal error"
+// @Router /alert/rule [post]
+func (a *AlertRuleAPI) Save(c *gin.Context) {
+	rule := &models.AlertRule{}
+	if err := c.ShouldBindJSON(rule); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	if err := validateAlertRule(rule); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	rule.Default()
+
+	ctx, cancel := a.deps.WithTimeout()
+	defer cancel()
+
+	data := encoding.JSONMarshal(rule)
+	a.logger.Info("saving alert rule", logger.String("rule", string(data)))
+	if err := a.deps.Repo.Put(ctx, constants.GetAlertRulePath(rule.Key()), data); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	httppkg.OK(c, "success")
+}
+
+// Delete deletes the alert rule and the alert state of this rule.
+//
+// @Summary delete alert rule
+// @Description delete alert rule by name(under database), the alert state of rule is also deleted.
+// @Tags Alert
+// @Param name query string true "rule name"
+// @Param database query string false "database name"
+// @Produce json
+// @Success 200 {string} string "success"
+// @Failure 500 {string} string "internal error"
+// @Router /alert/rule [delete]
+func (a *AlertRuleAPI) Delete(c *gin.Context) {
+	var param struct {
+		Name     string `form:"name" binding:"required"`
+		Database string `form:"database"`
+	}
+	if err := c.ShouldBindQuery(&param); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	ctx, cancel := a.deps.WithTimeout()
+	defer cancel()
+
+	a.logger.Info("deleting alert rule", logger.String("name", param.Name), logger.String("database", param.Database))
+	key := (&models.AlertRule{Name: param.Name, Database: param.Database}).Key()
+	if err := a.deps.Repo.Delete(ctx, constants.GetAlertRulePath(key)); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	if err := a.deps.Repo.Delete(ctx, constants.GetAlertStatePath(key)); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	httppkg.OK(c, "success")
+}
+
+// validateAlertRule validates the alert rule and the expression of rule.
+func validateAlertRule(rule *models.AlertRule) error {
+	if err := validate.Validator.Struct(rule); err != nil {
+		return err
+	}
+	switch rule.Type {
+	case models.LinQLAlertRule:
+		stmt, err := sqlpkg.Parse(rule.Expr)
+		if err != nil {
+			return err
+		}
+		if _, ok := stmt.(*stmtpkg.Query); !ok {
+			return errors.New("expression of LinQL alert rule must be data query statement")
+		}
+	case models.PromQLAlertRule:
+		if _, err := parser.ParseExpr(rule.Expr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
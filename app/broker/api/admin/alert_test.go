@@ -0,0 +1,193 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package admin
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lindb/common/pkg/encoding"
+	"github.com/lindb/common/pkg/ltoml"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/state"
+)
+
+func newTestAlertRuleAPI(ctrl *gomock.Controller) (*gin.Engine, *state.MockRepository) {
+	r := gin.New()
+	repo := state.NewMockRepository(ctrl)
+	api := NewAlertRuleAPI(&deps.HTTPDeps{
+		Ctx:  context.Background(),
+		Repo: repo,
+		BrokerCfg: &config.Broker{BrokerBase: config.BrokerBase{
+			HTTP: config.HTTP{ReadTimeout: ltoml.Duration(time.Second * 10)}}},
+	})
+	api.Register(r)
+	return r, repo
+}
+
+func TestAlertRuleAPI_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r, repo := newTestAlertRuleAPI(ctrl)
+	// get not found
+	repo.EXPECT().Get(gomock.Any(), "/alert/rule/cpu").Return(nil, state.ErrNotExist)
+	resp := mock.DoRequest(t, r, http.MethodGet, AlertRulePath+"?name=cpu", "")
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+	// get bad content
+	repo.EXPECT().Get(gomock.Any(), "/alert/rule/cpu").Return([]byte("bad-data"), nil)
+	resp = mock.DoRequest(t, r, http.MethodGet, AlertRulePath+"?name=cpu", "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// get ok
+	repo.EXPECT().Get(gomock.Any(), "/alert/rule/cpu").Return([]byte(`{"name":"cpu"}`), nil)
+	resp = mock.DoRequest(t, r, http.MethodGet, AlertRulePath+"?name=cpu", "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	// list failure
+	repo.EXPECT().List(gomock.Any(), "/alert/rule").Return(nil, io.ErrClosedPipe)
+	resp = mock.DoRequest(t, r, http.MethodGet, AlertRulePath, "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// list ok
+	repo.EXPECT().List(gomock.Any(), "/alert/rule").Return([]state.KeyValue{
+		{Key: "cpu", Value: []byte(`{"name":"cpu"}`)},
+		{Key: "err", Value: []byte("bad-data")},
+	}, nil)
+	resp = mock.DoRequest(t, r, http.MethodGet, AlertRulePath, "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	var rules []models.AlertRule
+	assert.NoError(t, encoding.JSONUnmarshal(resp.Body.Bytes(), &rules))
+	assert.Len(t, rules, 1)
+}
+
+func TestAlertRuleAPI_Save(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r, repo := newTestAlertRuleAPI(ctrl)
+	cases := []struct {
+		name    string
+		rule    string
+		prepare func()
+		code    int
+	}{
+		{
+			name: "bad request",
+			rule: "bad-data",
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "validate failure",
+			rule: `{"name":"cpu","type":"linql","expr":"select usage from cpu"}`,
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "linql rule without condition",
+			rule: `{"name":"cpu","type":"linql","database":"test","expr":"select usage from cpu"}`,
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "bad linql expression",
+			rule: `{"name":"cpu","type":"linql","database":"test","expr":"select from","condition":{"operator":">","threshold":10}}`,
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "linql expression not query statement",
+			rule: `{"name":"cpu","type":"linql","database":"test","expr":"show databases","condition":{"operator":">","threshold":10}}`,
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "bad promql expression",
+			rule: `{"name":"cpu","type":"promql","expr":"cpu >"}`,
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "persist failure",
+			rule: `{"name":"cpu","type":"promql","expr":"cpu > 10"}`,
+			prepare: func() {
+				repo.EXPECT().Put(gomock.Any(), "/alert/rule/cpu", gomock.Any()).Return(io.ErrClosedPipe)
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "save successfully",
+			rule: `{"name":"cpu","type":"linql","database":"test","expr":"select usage from cpu",` +
+				`"condition":{"operator":">","threshold":10},"for":"5m","receivers":[{"type":"webhook","url":"http://localhost:8080/alert"}]}`,
+			prepare: func() {
+				repo.EXPECT().Put(gomock.Any(), "/alert/rule/test/cpu", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, data []byte) error {
+						rule := &models.AlertRule{}
+						assert.NoError(t, encoding.JSONUnmarshal(data, rule))
+						assert.Equal(t, "1m", rule.Interval.String())
+						assert.Equal(t, "5m", rule.For.String())
+						assert.Equal(t, &models.AlertCondition{Operator: models.AlertGreaterThan, Threshold: 10}, rule.Condition)
+						return nil
+					})
+			},
+			code: http.StatusOK,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			resp := mock.DoRequest(t, r, http.MethodPost, AlertRulePath, tt.rule)
+			assert.Equal(t, tt.code, resp.Code)
+		})
+	}
+}
+
+func TestAlertRuleAPI_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r, repo := newTestAlertRuleAPI(ctrl)
+	// name required
+	resp := mock.DoRequest(t, r, http.MethodDelete, AlertRulePath, "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// delete rule failure
+	repo.EXPECT().Delete(gomock.Any(), "/alert/rule/cpu").Return(io.ErrClosedPipe)
+	resp = mock.DoRequest(t, r, http.MethodDelete, AlertRulePath+"?name=cpu", "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// delete state failure
+	repo.EXPECT().Delete(gomock.Any(), "/alert/rule/cpu").Return(nil)
+	repo.EXPECT().Delete(gomock.Any(), "/alert/state/cpu").Return(io.ErrClosedPipe)
+	resp = mock.DoRequest(t, r, http.MethodDelete, AlertRulePath+"?name=cpu", "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// delete ok
+	repo.EXPECT().Delete(gomock.Any(), "/alert/rule/cpu").Return(nil)
+	repo.EXPECT().Delete(gomock.Any(), "/alert/state/cpu").Return(nil)
+	resp = mock.DoRequest(t, r, http.MethodDelete, AlertRulePath+"?name=cpu", "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	// delete rule under database
+	repo.EXPECT().Delete(gomock.Any(), "/alert/rule/test/cpu").Return(nil)
+	repo.EXPECT().Delete(gomock.Any(), "/alert/state/test/cpu").Return(nil)
+	resp = mock.DoRequest(t, r, http.MethodDelete, AlertRulePath+"?name=cpu&database=test", "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
@@ -0,0 +1,463 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package admin
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lindb/common/pkg/encoding"
+	httppkg "github.com/lindb/common/pkg/http"
+	"github.com/lindb/common/pkg/logger"
+	commontimeutil "github.com/lindb/common/pkg/timeutil"
+
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/http/middleware"
+	"github.com/lindb/lindb/pkg/validate"
+)
+
+var (
+	// UserPath represents user admin api path.
+	UserPath = "/auth/user"
+	// RolePath represents role admin api path.
+	RolePath = "/auth/role"
+	// APITokenPath represents api token api path.
+	APITokenPath = "/auth/token"
+)
+
+// for testing
+var generateAPITokenFn = models.GenerateAPIToken
+
+// UserAPI represents user admin rest api.
+type UserAPI struct {
+	deps   *depspkg.HTTPDeps
+	logger logger.Logger
+}
+
+// NewUserAPI creates user api instance.
+func NewUserAPI(deps *depspkg.HTTPDeps) *UserAPI {
+	return &UserAPI{
+		deps:   deps,
+		logger: logger.GetLogger("Broker", "UserAPI"),
+	}
+}
+
+// Register adds user admin url route.
+func (a *UserAPI) Register(route gin.IRoutes) {
+	route.GET(UserPath, a.List)
+	route.POST(UserPath, a.Save)
+	route.DELETE(UserPath, a.Delete)
+}
+
+// List returns user list(without password).
+//
+// @Summary list users
+// @Description return user list, password of user is not returned.
+// @Tags Auth
+// @Produce json
+// @Success 200 {object} []models.User
+// @Failure 500 {string} string "internal error"
+// @Router /auth/user [get]
+func (a *UserAPI) List(c *gin.Context) {
+	ctx, cancel := a.deps.WithTimeout()
+	defer cancel()
+
+	data, err := a.deps.Repo.List(ctx, constants.AuthUserPath)
+	if err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	users := make([]*models.User, 0, len(data))
+	for _, val := range data {
+		user := &models.User{}
+		if err := encoding.JSONUnmarshal(val.Value, user); err != nil {
+			a.logger.Warn("unmarshal user error", logger.String("key", val.Key))
+			continue
+		}
+		user.Password = ""
+		users = append(users, user)
+	}
+	httppkg.OK(c, users)
+}
+
+// Save creates the user if not exist, otherwise updates it, password is stored as bcrypt hash.
+//
+// @Summary create or update user
+// @Description create user if not exist, otherwise update it.
+// @Tags Auth
+// @Accept json
+// @Param param body models.User ture "user"
+// @Produce json
+// @Success 200 {string} string "success"
+// @Failure 500 {string} string "internal error"
+// @Router /auth/user [post]
+func (a *UserAPI) Save(c *gin.Context) {
+	user := &models.User{}
+	if err := c.ShouldBindJSON(user); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	if err := validate.Validator.Struct(user); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	if user.Name == a.deps.BrokerCfg.BrokerBase.Auth.Admin.UserName {
+		httppkg.Error(c, errors.New("user name is reserved by admin user"))
+		return
+	}
+	if err := user.HashPassword(); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	ctx, cancel := a.deps.WithTimeout()
+	defer cancel()
+
+	a.logger.Info("saving user", logger.String("user", user.Name), logger.Any("roles", user.Roles))
+	if err := a.deps.Repo.Put(ctx, constants.GetAuthUserPath(user.Name), encoding.JSONMarshal(user)); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	httppkg.OK(c, "success")
+}
+
+// Delete deletes the user and all api tokens of this user.
+//
+// @Summary delete user
+// @Description delete user by name, all api tokens of user are also deleted.
+// @Tags Auth
+// @Param name query string true "user name"
+// @Produce json
+// @Success 200 {string} string "success"
+// @Failure 500 {string} string "internal error"
+// @Router /auth/user [delete]
+func (a *UserAPI) Delete(c *gin.Context) {
+	var param struct {
+		Name string `form:"name" binding:"required"`
+	}
+	if err := c.ShouldBindQuery(&param); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	ctx, cancel := a.deps.WithTimeout()
+	defer cancel()
+
+	a.logger.Info("deleting user", logger.String("name", param.Name))
+	if err := a.deps.Repo.Delete(ctx, constants.GetAuthUserPath(param.Name)); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	data, err := a.deps.Repo.List(ctx, constants.AuthTokenPath)
+	if err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	for _, val := range data {
+		token := &models.APIToken{}
+		if err := encoding.JSONUnmarshal(val.Value, token); err != nil || token.User != param.Name {
+			continue
+		}
+		if err := a.deps.Repo.Delete(ctx, constants.GetAuthTokenPath(token.ID)); err != nil {
+			httppkg.Error(c, err)
+			return
+		}
+	}
+	httppkg.OK(c, "success")
+}
+
+// RoleAPI represents role admin rest api.
+type RoleAPI struct {
+	deps   *depspkg.HTTPDeps
+	logger logger.Logger
+}
+
+// NewRoleAPI creates role api instance.
+func NewRoleAPI(deps *depspkg.HTTPDeps) *RoleAPI {
+	return &RoleAPI{
+		deps:   deps,
+		logger: logger.GetLogger("Broker", "RoleAPI"),
+	}
+}
+
+// Register adds role admin url route.
+func (a *RoleAPI) Register(route gin.IRoutes) {
+	route.GET(RolePath, a.List)
+	route.POST(RolePath, a.Save)
+	route.DELETE(RolePath, a.Delete)
+}
+
+// List returns role list.
+//
+// @Summary list roles
+// @Description return role list.
+// @Tags Auth
+// @Produce json
+// @Success 200 {object} []models.Role
+// @Failure 500 {string} string "internal error"
+// @Router /auth/role [get]
+func (a *RoleAPI) List(c *gin.Context) {
+	ctx, cancel := a.deps.WithTimeout()
+	defer cancel()
+
+	data, err := a.deps.Repo.List(ctx, constants.AuthRolePath)
+	if err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	roles := make([]*models.Role, 0, len(data))
+	for _, val := range data {
+		role := &models.Role{}
+		if err := encoding.JSONUnmarshal(val.Value, role); err != nil {
+			a.logger.Warn("unmarshal role error", logger.String("key", val.Key))
+			continue
+		}
+		roles = append(roles, role)
+	}
+	httppkg.OK(c, roles)
+}
+
+// Save creates the role if not exist, otherwise updates it.
+//
+// @Summary create or update role
+// @Description create role if not exist, otherwise update it.
+// @Tags Auth
+// @Accept json
+// @Param param body models.Role ture "role"
+// @Produce json
+// @Success 200 {string} string "success"
+// @Failure 500 {string} string "internal error"
+// @Router /auth/role [post]
+func (a *RoleAPI) Save(c *gin.Context) {
+	role := &models.Role{}
+	if err := c.ShouldBindJSON(role); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	if err := validate.Validator.Struct(role); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	ctx, cancel := a.deps.WithTimeout()
+	defer cancel()
+
+	data := encoding.JSONMarshal(role)
+	a.logger.Info("saving role", logger.String("role", string(data)))
+	if err := a.deps.Repo.Put(ctx, constants.GetAuthRolePath(role.Name), data); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	httppkg.OK(c, "success")
+}
+
+// Delete deletes the role.
+//
+// @Summary delete role
+// @Description delete role by name.
+// @Tags Auth
+// @Param name query string true "role name"
+// @Produce json
+// @Success 200 {string} string "success"
+// @Failure 500 {string} string "internal error"
+// @Router /auth/role [delete]
+func (a *RoleAPI) Delete(c *gin.Context) {
+	var param struct {
+		Name string `form:"name" binding:"required"`
+	}
+	if err := c.ShouldBindQuery(&param); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	ctx, cancel := a.deps.WithTimeout()
+	defer cancel()
+
+	a.logger.Info("deleting role", logger.String("name", param.Name))
+	if err := a.deps.Repo.Delete(ctx, constants.GetAuthRolePath(param.Name)); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	httppkg.OK(c, "success")
+}
+
+// APITokenAPI represents api token rest api,
+// admin manages all tokens, other users only manage their own tokens.
+type APITokenAPI struct {
+	deps   *depspkg.HTTPDeps
+	logger logger.Logger
+}
+
+// NewAPITokenAPI creates api token api instance.
+func NewAPITokenAPI(deps *depspkg.HTTPDeps) *APITokenAPI {
+	return &APITokenAPI{
+		deps:   deps,
+		logger: logger.GetLogger("Broker", "APITokenAPI"),
+	}
+}
+
+// Register adds api token url route.
+func (a *APITokenAPI) Register(route gin.IRoutes) {
+	route.GET(APITokenPath, a.List)
+	route.POST(APITokenPath, a.Create)
+	route.DELETE(APITokenPath, a.Delete)
+}
+
+// List returns api token list which current user can manage.
+//
+// @Summary list api tokens
+// @Description return api token list, admin returns all tokens, other users return their own tokens.
+// @Tags Auth
+// @Produce json
+// @Success 200 {object} []models.APIToken
+// @Failure 500 {string} string "internal error"
+// @Router /auth/token [get]
+func (a *APITokenAPI) List(c *gin.Context) {
+	ctx, cancel := a.deps.WithTimeout()
+	defer cancel()
+
+	data, err := a.deps.Repo.List(ctx, constants.AuthTokenPath)
+	if err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	tokens := make([]*models.APIToken, 0, len(data))
+	for _, val := range data {
+		token := &models.APIToken{}
+		if err := encoding.JSONUnmarshal(val.Value, token); err != nil {
+			a.logger.Warn("unmarshal api token error", logger.String("key", val.Key))
+			continue
+		}
+		if canManageToken(c, token.User) {
+			tokens = append(tokens, token)
+		}
+	}
+	httppkg.OK(c, tokens)
+}
+
+// Create creates a new api token, the token is only returned once.
+//
+// @Summary create api token
+// @Description create api token for user(default current user), the token is only returned once.
+// @Tags Auth
+// @Accept json
+// @Param param body models.APIToken ture "api token"
+// @Produce json
+// @Success 200 {string} string "api token"
+// @Failure 403 {string} string "permission denied"
+// @Failure 500 {string} string "internal error"
+// @Router /auth/token [post]
+func (a *APITokenAPI) Create(c *gin.Context) {
+	token := &models.APIToken{}
+	if err := c.ShouldBindJSON(token); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	if err := validate.Validator.Struct(token); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	if token.User == "" {
+		if principal, ok := middleware.GetPrincipal(c); ok {
+			token.User = principal.UserName
+		}
+	}
+	if token.User == "" {
+		httppkg.Error(c, errors.New("user of api token cannot be empty"))
+		return
+	}
+	if !canManageToken(c, token.User) {
+		httppkg.Forbidden(c)
+		return
+	}
+	ctx, cancel := a.deps.WithTimeout()
+	defer cancel()
+
+	if _, err := a.deps.Repo.Get(ctx, constants.GetAuthUserPath(token.User)); err != nil {
+		httppkg.Error(c, fmt.Errorf("%w, user: %s", constants.ErrNotFound, token.User))
+		return
+	}
+	raw, err := generateAPITokenFn()
+	if err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	token.ID = models.HashAPIToken(raw)
+	token.CreatedAt = commontimeutil.Now()
+
+	a.logger.Info("creating api token", logger.String("user", token.User), logger.String("name", token.Name))
+	if err := a.deps.Repo.Put(ctx, constants.GetAuthTokenPath(token.ID), encoding.JSONMarshal(token)); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	httppkg.OK(c, raw)
+}
+
+// Delete deletes the api token.
+//
+// @Summary delete api token
+// @Description delete api token by id.
+// @Tags Auth
+// @Param id query string true "token id"
+// @Produce json
+// @Success 200 {string} string "success"
+// @Failure 403 {string} string "permission denied"
+// @Failure 404 {string} string "not found"
+// @Failure 500 {string} string "internal error"
+// @Router /auth/token [delete]
+func (a *APITokenAPI) Delete(c *gin.Context) {
+	var param struct {
+		ID string `form:"id" binding:"required"`
+	}
+	if err := c.ShouldBindQuery(&param); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	ctx, cancel := a.deps.WithTimeout()
+	defer cancel()
+
+	data, err := a.deps.Repo.Get(ctx, constants.GetAuthTokenPath(param.ID))
+	if err != nil {
+		httppkg.NotFound(c)
+		return
+	}
+	token := &models.APIToken{}
+	if err := encoding.JSONUnmarshal(data, token); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	if !canManageToken(c, token.User) {
+		httppkg.Forbidden(c)
+		return
+	}
+	a.logger.Info("deleting api token", logger.String("user", token.User), logger.String("name", token.Name))
+	if err := a.deps.Repo.Delete(ctx, constants.GetAuthTokenPath(param.ID)); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	httppkg.OK(c, "success")
+}
+
+// canManageToken returns if current user can manage the api token of user.
+func canManageToken(c *gin.Context, user string) bool {
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		// auth disabled
+		return true
+	}
+	return principal.UserName == user || principal.HasPermission(models.AllDatabases, models.AdminPermission)
+}
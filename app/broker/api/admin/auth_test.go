@@ -0,0 +1,299 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package admin
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lindb/common/pkg/ltoml"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/http/middleware"
+	"github.com/lindb/lindb/pkg/state"
+)
+
+var testAdmin = config.User{UserName: "admin", Password: "admin123"}
+
+func newTestAuthDeps(ctrl *gomock.Controller) (*deps.HTTPDeps, *state.MockRepository) {
+	repo := state.NewMockRepository(ctrl)
+	return &deps.HTTPDeps{
+		Ctx:  context.Background(),
+		Repo: repo,
+		BrokerCfg: &config.Broker{BrokerBase: config.BrokerBase{
+			HTTP: config.HTTP{ReadTimeout: ltoml.Duration(time.Second * 10)},
+			Auth: config.Auth{Admin: testAdmin},
+		}},
+	}, repo
+}
+
+func basicAuth(user, password string) http.Header {
+	req, _ := http.NewRequest(http.MethodGet, "/", http.NoBody)
+	req.SetBasicAuth(user, password)
+	return req.Header
+}
+
+func TestUserAPI(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	httpDeps, repo := newTestAuthDeps(ctrl)
+	r := gin.New()
+	NewUserAPI(httpDeps).Register(r)
+
+	// list failure
+	repo.EXPECT().List(gomock.Any(), "/auth/user").Return(nil, io.ErrClosedPipe)
+	resp := mock.DoRequest(t, r, http.MethodGet, UserPath, "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// list ok, password is hidden
+	repo.EXPECT().List(gomock.Any(), "/auth/user").Return([]state.KeyValue{
+		{Key: "bob", Value: []byte(`{"name":"bob","password":"hash"}`)},
+		{Key: "err", Value: []byte("bad-data")},
+	}, nil)
+	resp = mock.DoRequest(t, r, http.MethodGet, UserPath, "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, `[{"name":"bob"}]`, resp.Body.String())
+
+	// save bad request
+	resp = mock.DoRequest(t, r, http.MethodPost, UserPath, "bad")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// save validate failure
+	resp = mock.DoRequest(t, r, http.MethodPost, UserPath, `{"name":"bob"}`)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// save admin user
+	resp = mock.DoRequest(t, r, http.MethodPost, UserPath, `{"name":"admin","password":"pwd"}`)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// hash password failure(too long)
+	resp = mock.DoRequest(t, r, http.MethodPost, UserPath,
+		fmt.Sprintf(`{"name":"bob","password":"%s"}`, strings.Repeat("a", 100)))
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// save failure
+	repo.EXPECT().Put(gomock.Any(), "/auth/user/bob", gomock.Any()).Return(io.ErrClosedPipe)
+	resp = mock.DoRequest(t, r, http.MethodPost, UserPath, `{"name":"bob","password":"pwd"}`)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// save ok
+	repo.EXPECT().Put(gomock.Any(), "/auth/user/bob", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, data []byte) error {
+			assert.NotContains(t, string(data), `"pwd"`)
+			return nil
+		})
+	resp = mock.DoRequest(t, r, http.MethodPost, UserPath, `{"name":"bob","password":"pwd"}`)
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	// delete bad request
+	resp = mock.DoRequest(t, r, http.MethodDelete, UserPath, "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// delete failure
+	repo.EXPECT().Delete(gomock.Any(), "/auth/user/bob").Return(io.ErrClosedPipe)
+	resp = mock.DoRequest(t, r, http.MethodDelete, UserPath+"?name=bob", "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// list token failure
+	repo.EXPECT().Delete(gomock.Any(), "/auth/user/bob").Return(nil)
+	repo.EXPECT().List(gomock.Any(), "/auth/token").Return(nil, io.ErrClosedPipe)
+	resp = mock.DoRequest(t, r, http.MethodDelete, UserPath+"?name=bob", "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// delete token failure
+	tokens := []state.KeyValue{
+		{Key: "1", Value: []byte(`{"id":"1","user":"bob"}`)},
+		{Key: "2", Value: []byte(`{"id":"2","user":"alice"}`)},
+		{Key: "3", Value: []byte("bad-data")},
+	}
+	repo.EXPECT().Delete(gomock.Any(), "/auth/user/bob").Return(nil)
+	repo.EXPECT().List(gomock.Any(), "/auth/token").Return(tokens, nil)
+	repo.EXPECT().Delete(gomock.Any(), "/auth/token/1").Return(io.ErrClosedPipe)
+	resp = mock.DoRequest(t, r, http.MethodDelete, UserPath+"?name=bob", "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// delete ok
+	repo.EXPECT().Delete(gomock.Any(), "/auth/user/bob").Return(nil)
+	repo.EXPECT().List(gomock.Any(), "/auth/token").Return(tokens, nil)
+	repo.EXPECT().Delete(gomock.Any(), "/auth/token/1").Return(nil)
+	resp = mock.DoRequest(t, r, http.MethodDelete, UserPath+"?name=bob", "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
+
+func TestRoleAPI(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	httpDeps, repo := newTestAuthDeps(ctrl)
+	r := gin.New()
+	NewRoleAPI(httpDeps).Register(r)
+
+	// list failure
+	repo.EXPECT().List(gomock.Any(), "/auth/role").Return(nil, io.ErrClosedPipe)
+	resp := mock.DoRequest(t, r, http.MethodGet, RolePath, "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// list ok
+	repo.EXPECT().List(gomock.Any(), "/auth/role").Return([]state.KeyValue{
+		{Key: "ops", Value: []byte(`{"name":"ops"}`)},
+		{Key: "err", Value: []byte("bad-data")},
+	}, nil)
+	resp = mock.DoRequest(t, r, http.MethodGet, RolePath, "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	// save bad request
+	resp = mock.DoRequest(t, r, http.MethodPost, RolePath, "bad")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// save validate failure
+	resp = mock.DoRequest(t, r, http.MethodPost, RolePath,
+		`{"name":"ops","privileges":[{"database":"db","permissions":["unknown"]}]}`)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// save failure
+	role := `{"name":"ops","privileges":[{"database":"db","permissions":["read"]}]}`
+	repo.EXPECT().Put(gomock.Any(), "/auth/role/ops", gomock.Any()).Return(io.ErrClosedPipe)
+	resp = mock.DoRequest(t, r, http.MethodPost, RolePath, role)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// save ok
+	repo.EXPECT().Put(gomock.Any(), "/auth/role/ops", gomock.Any()).Return(nil)
+	resp = mock.DoRequest(t, r, http.MethodPost, RolePath, role)
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	// delete bad request
+	resp = mock.DoRequest(t, r, http.MethodDelete, RolePath, "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// delete failure
+	repo.EXPECT().Delete(gomock.Any(), "/auth/role/ops").Return(io.ErrClosedPipe)
+	resp = mock.DoRequest(t, r, http.MethodDelete, RolePath+"?name=ops", "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// delete ok
+	repo.EXPECT().Delete(gomock.Any(), "/auth/role/ops").Return(nil)
+	resp = mock.DoRequest(t, r, http.MethodDelete, RolePath+"?name=ops", "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
+
+func TestAPITokenAPI(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		generateAPITokenFn = models.GenerateAPIToken
+		ctrl.Finish()
+	}()
+
+	httpDeps, repo := newTestAuthDeps(ctrl)
+	store := middleware.NewMockAuthStore(ctrl)
+	bob := &models.User{Name: "bob", Password: "pwd"}
+	assert.NoError(t, bob.HashPassword())
+	store.EXPECT().GetUser("bob").Return(bob, true).AnyTimes()
+	auth := middleware.NewAuthorization(config.Auth{Enabled: true, Admin: testAdmin}, store)
+	r := gin.New()
+	r.Use(auth.Authenticate())
+	NewAPITokenAPI(httpDeps).Register(r)
+	bobAuth := basicAuth("bob", "pwd")
+	adminAuth := basicAuth("admin", "admin123")
+
+	tokens := []state.KeyValue{
+		{Key: "1", Value: []byte(`{"id":"1","name":"t1","user":"bob","createdAt":1}`)},
+		{Key: "2", Value: []byte(`{"id":"2","name":"t2","user":"alice","createdAt":1}`)},
+		{Key: "3", Value: []byte("bad-data")},
+	}
+	// list failure
+	repo.EXPECT().List(gomock.Any(), "/auth/token").Return(nil, io.ErrClosedPipe)
+	resp := mock.DoRequest(t, r, http.MethodGet, APITokenPath, "", bobAuth)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// list own tokens
+	repo.EXPECT().List(gomock.Any(), "/auth/token").Return(tokens, nil)
+	resp = mock.DoRequest(t, r, http.MethodGet, APITokenPath, "", bobAuth)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, `[{"id":"1","name":"t1","user":"bob","createdAt":1}]`, resp.Body.String())
+	// admin list all tokens
+	repo.EXPECT().List(gomock.Any(), "/auth/token").Return(tokens, nil)
+	resp = mock.DoRequest(t, r, http.MethodGet, APITokenPath, "", adminAuth)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Contains(t, resp.Body.String(), "alice")
+
+	// create bad request
+	resp = mock.DoRequest(t, r, http.MethodPost, APITokenPath, "bad", bobAuth)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// create validate failure
+	resp = mock.DoRequest(t, r, http.MethodPost, APITokenPath, `{}`, bobAuth)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// create token for other user
+	resp = mock.DoRequest(t, r, http.MethodPost, APITokenPath, `{"name":"ci","user":"alice"}`, bobAuth)
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+	// user not found
+	repo.EXPECT().Get(gomock.Any(), "/auth/user/alice").Return(nil, state.ErrNotExist)
+	resp = mock.DoRequest(t, r, http.MethodPost, APITokenPath, `{"name":"ci","user":"alice"}`, adminAuth)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// generate token failure
+	repo.EXPECT().Get(gomock.Any(), "/auth/user/bob").Return([]byte("{}"), nil).AnyTimes()
+	generateAPITokenFn = func() (string, error) {
+		return "", fmt.Errorf("err")
+	}
+	resp = mock.DoRequest(t, r, http.MethodPost, APITokenPath, `{"name":"ci"}`, bobAuth)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	generateAPITokenFn = func() (string, error) {
+		return "lin_abc", nil
+	}
+	// put failure
+	repo.EXPECT().Put(gomock.Any(), "/auth/token/"+models.HashAPIToken("lin_abc"), gomock.Any()).Return(io.ErrClosedPipe)
+	resp = mock.DoRequest(t, r, http.MethodPost, APITokenPath, `{"name":"ci"}`, bobAuth)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// create ok
+	repo.EXPECT().Put(gomock.Any(), "/auth/token/"+models.HashAPIToken("lin_abc"), gomock.Any()).Return(nil)
+	resp = mock.DoRequest(t, r, http.MethodPost, APITokenPath, `{"name":"ci"}`, bobAuth)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, `"lin_abc"`, resp.Body.String())
+
+	// delete bad request
+	resp = mock.DoRequest(t, r, http.MethodDelete, APITokenPath, "", bobAuth)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// delete not found
+	repo.EXPECT().Get(gomock.Any(), "/auth/token/1").Return(nil, state.ErrNotExist)
+	resp = mock.DoRequest(t, r, http.MethodDelete, APITokenPath+"?id=1", "", bobAuth)
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+	// delete bad data
+	repo.EXPECT().Get(gomock.Any(), "/auth/token/1").Return([]byte("bad-data"), nil)
+	resp = mock.DoRequest(t, r, http.MethodDelete, APITokenPath+"?id=1", "", bobAuth)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// delete token of other user
+	repo.EXPECT().Get(gomock.Any(), "/auth/token/2").Return(tokens[1].Value, nil)
+	resp = mock.DoRequest(t, r, http.MethodDelete, APITokenPath+"?id=2", "", bobAuth)
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+	// delete failure
+	repo.EXPECT().Get(gomock.Any(), "/auth/token/1").Return(tokens[0].Value, nil)
+	repo.EXPECT().Delete(gomock.Any(), "/auth/token/1").Return(io.ErrClosedPipe)
+	resp = mock.DoRequest(t, r, http.MethodDelete, APITokenPath+"?id=1", "", bobAuth)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// delete ok
+	repo.EXPECT().Get(gomock.Any(), "/auth/token/1").Return(tokens[0].Value, nil)
+	repo.EXPECT().Delete(gomock.Any(), "/auth/token/1").Return(nil)
+	resp = mock.DoRequest(t, r, http.MethodDelete, APITokenPath+"?id=1", "", bobAuth)
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
+
+func TestAPITokenAPI_AuthDisabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	httpDeps, _ := newTestAuthDeps(ctrl)
+	r := gin.New()
+	NewAPITokenAPI(httpDeps).Register(r)
+	// user is required if auth disabled
+	resp := mock.DoRequest(t, r, http.MethodPost, APITokenPath, `{"name":"ci"}`)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+}
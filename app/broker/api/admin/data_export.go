@@ -0,0 +1,410 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"reflect"
+	"sort"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-http-utils/headers"
+
+	commonconstants "github.com/lindb/common/constants"
+	commonmodels "github.com/lindb/common/models"
+	httppkg "github.com/lindb/common/pkg/http"
+	"github.com/lindb/common/pkg/logger"
+	"github.com/lindb/common/pkg/timeutil"
+	protoMetricsV1 "github.com/lindb/common/proto/gen/v1/linmetrics"
+
+	"github.com/lindb/lindb/app/broker/api/exec/command"
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/constants"
+	ingestCommon "github.com/lindb/lindb/ingestion/common"
+	"github.com/lindb/lindb/ingestion/export"
+	"github.com/lindb/lindb/ingestion/influx"
+	"github.com/lindb/lindb/models"
+	timeutilpkg "github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/series/metric"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+var (
+	// ExportDataPath represents database data export api path.
+	ExportDataPath = "/database/export"
+	// ImportDataPath represents database data import api path.
+	ImportDataPath = "/database/import"
+)
+
+// for testing
+var (
+	metricMetadataCommandFn = command.MetricMetadataCommand
+	queryCommandFn          = command.QueryCommand
+)
+
+// exportFieldTypes represents the field types which can be exported,
+// histogram fields are not exported because buckets are not visible for query.
+var exportFieldTypes = map[string]protoMetricsV1.SimpleFieldType{
+	field.SumField.String():   protoMetricsV1.SimpleFieldType_DELTA_SUM,
+	field.MinField.String():   protoMetricsV1.SimpleFieldType_Min,
+	field.MaxField.String():   protoMetricsV1.SimpleFieldType_Max,
+	field.LastField.String():  protoMetricsV1.SimpleFieldType_LAST,
+	field.FirstField.String(): protoMetricsV1.SimpleFieldType_FIRST,
+}
+
+// DataExportAPI represents database data export/import api.
+type DataExportAPI struct {
+	deps   *depspkg.HTTPDeps
+	logger logger.Logger
+}
+
+// NewDataExportAPI creates database data export/import api.
+func NewDataExportAPI(deps *depspkg.HTTPDeps) *DataExportAPI {
+	return &DataExportAPI{
+		deps:   deps,
+		logger: logger.GetLogger("Broker", "DataExportAPI"),
+	}
+}
+
+// Register adds data export/import url route.
+func (api *DataExportAPI) Register(route gin.IRoutes) {
+	route.GET(ExportDataPath, api.Export)
+	route.POST(ImportDataPath, api.Import)
+	route.PUT(ImportDataPath, api.Import)
+}
+
+// Export streams the data of database(optionally filtered by namespace/metric/time range) as portable file.
+// Queries data window by window with the smallest storage interval, so that data is exported without down sampling.
+// If export failure after response sent, the error is reported by http trailer(X-Lindb-Export-Error),
+// and end marker of native format is not written.
+func (api *DataExportAPI) Export(c *gin.Context) {
+	var param models.ExportParam
+	if err := c.ShouldBindQuery(&param); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	if param.Format == "" {
+		param.Format = export.FormatNative
+	}
+	if !export.IsValidFormat(param.Format) {
+		httppkg.Error(c, fmt.Errorf("unknown export format: %s", param.Format))
+		return
+	}
+	db, ok := api.deps.StateMgr.GetDatabaseCfg(param.Database)
+	if !ok || db.Option == nil || len(db.Option.Intervals) == 0 {
+		httppkg.Error(c, fmt.Errorf("database not found: %s", param.Database))
+		return
+	}
+	smallest := db.Option.Intervals[0]
+	interval := smallest.Interval.Int64()
+	now := timeutil.Now()
+	if param.End <= 0 {
+		param.End = now
+	}
+	if param.Start <= 0 {
+		param.Start = now - smallest.Retention.Int64()
+	}
+	if param.Start > param.End {
+		httppkg.Error(c, fmt.Errorf("start time must be before end time"))
+		return
+	}
+
+	ctx := c.Request.Context()
+	// resolve metrics before response sent
+	metrics, err := api.getMetrics(ctx, &param)
+	if err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+
+	if param.Format == export.FormatNative {
+		c.Header(headers.ContentType, constants.ContentTypeOctetStream)
+	} else {
+		c.Header(headers.ContentType, "text/plain; charset=utf-8")
+	}
+	c.Header("Trailer", constants.ExportErrorTrailer)
+	c.Status(http.StatusOK)
+
+	encoder, err := export.NewEncoder(param.Format, c.Writer)
+	if err == nil {
+		err = api.export(ctx, c, &param, metrics, interval, encoder)
+	}
+	if err == nil {
+		err = encoder.Close()
+	}
+	if err != nil {
+		api.logger.Error("export database data failure",
+			logger.String("database", param.Database), logger.Error(err))
+		c.Writer.Header().Set(constants.ExportErrorTrailer, err.Error())
+		return
+	}
+	c.Writer.Flush()
+}
+
+// exportMetric represents the metric which need be exported.
+type exportMetric struct {
+	namespace string
+	name      string
+}
+
+// getMetrics returns the metrics which need be exported.
+func (api *DataExportAPI) getMetrics(ctx context.Context, param *models.ExportParam) ([]exportMetric, error) {
+	namespaces := []string{param.Namespace}
+	if param.Namespace == "" {
+		values, err := api.metadata(ctx, param.Database, &stmtpkg.MetricMetadata{Type: stmtpkg.Namespace})
+		if err != nil {
+			return nil, err
+		}
+		namespaces = values
+	}
+	var result []exportMetric
+	for _, ns := range namespaces {
+		names := []string{param.Metric}
+		if param.Metric == "" {
+			values, err := api.metadata(ctx, param.Database, &stmtpkg.MetricMetadata{Namespace: ns, Type: stmtpkg.Metric})
+			if err != nil {
+				return nil, err
+			}
+			names = values
+		}
+		for _, name := range names {
+			result = append(result, exportMetric{namespace: ns, name: name})
+		}
+	}
+	return result, nil
+}
+
+// export exports the data of metrics window by window,
+// queries raw series(group by all tag keys, keeps series without some of tag keys), so that each series is exported.
+func (api *DataExportAPI) export(ctx context.Context, c *gin.Context, param *models.ExportParam,
+	metrics []exportMetric, interval int64, encoder export.Encoder,
+) error {
+	for _, m := range metrics {
+		tagKeys, err := api.metadata(ctx, param.Database,
+			&stmtpkg.MetricMetadata{Namespace: m.namespace, MetricName: m.name, Type: stmtpkg.TagKey})
+		if err != nil {
+			return err
+		}
+		fields, err := api.fields(ctx, param.Database, m)
+		if err != nil {
+			return err
+		}
+		if len(fields) == 0 {
+			continue
+		}
+		selectItems := make([]stmtpkg.Expr, 0, len(fields))
+		for name := range fields {
+			selectItems = append(selectItems, &stmtpkg.SelectItem{Expr: &stmtpkg.FieldExpr{Name: name}})
+		}
+		// query time range must be less than 1 hour, avoid down sampling
+		for start := timeutilpkg.Truncate(param.Start, interval); start <= param.End; start += timeutil.OneHour {
+			end := min(start+timeutil.OneHour-interval, param.End)
+			result, err := queryCommandFn(ctx, api.deps, &models.ExecuteParam{Database: param.Database}, &stmtpkg.Query{
+				Namespace:   m.namespace,
+				MetricName:  m.name,
+				SelectItems: selectItems,
+				TimeRange:   timeutilpkg.TimeRange{Start: start, End: end},
+				Interval:    timeutilpkg.Interval(interval),
+				GroupBy:     tagKeys,
+				RawSeries:   true,
+				Limit:       math.MaxInt32,
+			})
+			if err != nil {
+				if errors.Is(err, constants.ErrNotFound) {
+					// no data in current window
+					continue
+				}
+				return err
+			}
+			rs, ok := result.(*commonmodels.ResultSet)
+			if !ok {
+				return fmt.Errorf("expected type ResultSet got %s", reflect.TypeOf(result))
+			}
+			if err := encodeResultSet(m, rs, fields, encoder); err != nil {
+				return err
+			}
+			if err := encoder.Flush(); err != nil {
+				return err
+			}
+			c.Writer.Flush()
+		}
+	}
+	return nil
+}
+
+// metadata returns the metadata values(namespaces/metrics/tag keys) without suggestion limit.
+func (api *DataExportAPI) metadata(ctx context.Context, database string, stmt *stmtpkg.MetricMetadata) ([]string, error) {
+	stmt.All = true
+	result, err := metricMetadataCommandFn(ctx, api.deps, &models.ExecuteParam{Database: database}, stmt)
+	if err != nil {
+		return nil, err
+	}
+	rs, ok := result.(*commonmodels.Metadata)
+	if !ok {
+		return nil, fmt.Errorf("expected type Metadata got %s", reflect.TypeOf(result))
+	}
+	values, ok := rs.Values.([]string)
+	if !ok {
+		return nil, fmt.Errorf("expected type []string got %s", reflect.TypeOf(rs.Values))
+	}
+	return values, nil
+}
+
+// fields returns the fields which can be exported with field type.
+func (api *DataExportAPI) fields(ctx context.Context, database string, m exportMetric) (map[string]protoMetricsV1.SimpleFieldType, error) {
+	stmt := &stmtpkg.MetricMetadata{Namespace: m.namespace, MetricName: m.name, Type: stmtpkg.Field, All: true}
+	result, err := metricMetadataCommandFn(ctx, api.deps, &models.ExecuteParam{Database: database}, stmt)
+	if err != nil {
+		return nil, err
+	}
+	rs, ok := result.(*commonmodels.Metadata)
+	if !ok {
+		return nil, fmt.Errorf("expected type Metadata got %s", reflect.TypeOf(result))
+	}
+	values, ok := rs.Values.([]commonmodels.Field)
+	if !ok {
+		return nil, fmt.Errorf("expected type []Field got %s", reflect.TypeOf(rs.Values))
+	}
+	fields := make(map[string]protoMetricsV1.SimpleFieldType)
+	for _, f := range values {
+		if fieldType, ok := exportFieldTypes[f.Type]; ok {
+			fields[f.Name] = fieldType
+		}
+	}
+	return fields, nil
+}
+
+// encodeResultSet encodes the series of result set as metrics ordered by timestamp.
+func encodeResultSet(m exportMetric, rs *commonmodels.ResultSet,
+	fields map[string]protoMetricsV1.SimpleFieldType, encoder export.Encoder,
+) error {
+	for _, series := range rs.Series {
+		tags := make([]*protoMetricsV1.KeyValue, 0, len(series.Tags))
+		for k, v := range series.Tags {
+			if v == "" {
+				// series hasn't the tag key
+				continue
+			}
+			tags = append(tags, &protoMetricsV1.KeyValue{Key: k, Value: v})
+		}
+		sort.Slice(tags, func(i, j int) bool {
+			return tags[i].Key < tags[j].Key
+		})
+		fieldNames := make([]string, 0, len(series.Fields))
+		points := make(map[int64][]*protoMetricsV1.SimpleField)
+		for name := range series.Fields {
+			fieldNames = append(fieldNames, name)
+		}
+		sort.Strings(fieldNames)
+		for _, name := range fieldNames {
+			for timestamp, value := range series.Fields[name] {
+				if math.IsNaN(value) || math.IsInf(value, 0) {
+					continue
+				}
+				points[timestamp] = append(points[timestamp], &protoMetricsV1.SimpleField{
+					Name:  name,
+					Type:  fields[name],
+					Value: value,
+				})
+			}
+		}
+		timestamps := make([]int64, 0, len(points))
+		for timestamp := range points {
+			timestamps = append(timestamps, timestamp)
+		}
+		sort.Slice(timestamps, func(i, j int) bool {
+			return timestamps[i] < timestamps[j]
+		})
+		for _, timestamp := range timestamps {
+			if err := encoder.Encode(&protoMetricsV1.Metric{
+				Namespace:    m.namespace,
+				Name:         m.name,
+				Timestamp:    timestamp,
+				Tags:         tags,
+				SimpleFields: points[timestamp],
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Import replays the exported data through write path, keeps original timestamp
+// even if it is behind the acceptable write time range of database.
+func (api *DataExportAPI) Import(c *gin.Context) {
+	var result *models.ImportResult
+	if err := api.deps.IngestLimiter.Do(func() (err error) {
+		result, err = api.importData(c)
+		return err
+	}); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	httppkg.OK(c, result)
+}
+
+// importData parses the exported data, then writes it to database's write channel.
+func (api *DataExportAPI) importData(c *gin.Context) (*models.ImportResult, error) {
+	var param models.ImportParam
+	if err := c.ShouldBindQuery(&param); err != nil {
+		return nil, err
+	}
+	enrichedTags, err := ingestCommon.ExtractEnrichTags(c.Request)
+	if err != nil {
+		return nil, err
+	}
+	limits := models.GetDatabaseLimits(param.Database)
+	var rows *metric.BrokerBatchRows
+	switch param.Format {
+	case "", export.FormatNative:
+		// keep original namespace of metric if not set
+		rows, err = export.Parse(c.Request, enrichedTags, param.Namespace, limits)
+	case export.FormatLine:
+		if param.Namespace == "" {
+			param.Namespace = commonconstants.DefaultNamespace
+		}
+		if param.Precision == "" {
+			// exported timestamp is millisecond, cannot guess precision of timestamp behind the acceptable range
+			query := c.Request.URL.Query()
+			query.Set("precision", "ms")
+			c.Request.URL.RawQuery = query.Encode()
+		}
+		rows, err = influx.Parse(c.Request, enrichedTags, param.Namespace, limits)
+	default:
+		err = fmt.Errorf("unknown import format: %s", param.Format)
+	}
+	if err != nil {
+		return nil, err
+	}
+	rows.IgnoreBehind()
+	result := &models.ImportResult{Metrics: rows.Len()}
+
+	ctx, cancel := context.WithTimeout(context.Background(),
+		api.deps.BrokerCfg.BrokerBase.Ingestion.IngestTimeout.Duration())
+	defer cancel()
+	if err := api.deps.CM.Write(ctx, param.Database, rows); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,349 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package admin
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	commonmodels "github.com/lindb/common/models"
+	"github.com/lindb/common/pkg/ltoml"
+	"github.com/lindb/common/pkg/timeutil"
+	protoMetricsV1 "github.com/lindb/common/proto/gen/v1/linmetrics"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/lindb/lindb/app/broker/api/exec/command"
+	"github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/coordinator/broker"
+	"github.com/lindb/lindb/ingestion/export"
+	"github.com/lindb/lindb/internal/concurrent"
+	"github.com/lindb/lindb/internal/linmetric"
+	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/option"
+	timeutilpkg "github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/replica"
+	"github.com/lindb/lindb/series/metric"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+var testExportDatabase = models.Database{
+	Name: "test",
+	Option: &option.DatabaseOption{
+		Intervals: option.Intervals{
+			{Interval: timeutilpkg.Interval(10 * timeutil.OneSecond), Retention: timeutilpkg.Interval(timeutil.OneMonth)},
+		},
+	},
+}
+
+func mockExportMetadata(_ context.Context, _ *deps.HTTPDeps, _ *models.ExecuteParam, stmt stmtpkg.Statement) (interface{}, error) {
+	metadata := stmt.(*stmtpkg.MetricMetadata)
+	if !metadata.All {
+		return nil, fmt.Errorf("metadata limit")
+	}
+	switch metadata.Type {
+	case stmtpkg.Namespace:
+		return &commonmodels.Metadata{Values: []string{"ns"}}, nil
+	case stmtpkg.Metric:
+		return &commonmodels.Metadata{Values: []string{"cpu"}}, nil
+	case stmtpkg.TagKey:
+		return &commonmodels.Metadata{Values: []string{"host"}}, nil
+	default:
+		return &commonmodels.Metadata{Values: []commonmodels.Field{
+			{Name: "count", Type: "sum"},
+			{Name: "max", Type: "max"},
+			{Name: "quantile(0.99)", Type: "histogram"},
+		}}, nil
+	}
+}
+
+func newTestExportResultSet() *commonmodels.ResultSet {
+	return &commonmodels.ResultSet{
+		Series: []*commonmodels.Series{{
+			Tags: map[string]string{"host": "a"},
+			Fields: map[string]map[int64]float64{
+				"count": {20000: 2, 10000: 1},
+				"max":   {10000: 5, 30000: math.NaN()},
+			},
+		}, {
+			// series without tag key
+			Tags: map[string]string{"host": ""},
+			Fields: map[string]map[int64]float64{
+				"count": {10000: 3},
+			},
+		}},
+	}
+}
+
+func TestDataExportAPI_Export(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		metricMetadataCommandFn = command.MetricMetadataCommand
+		queryCommandFn = command.QueryCommand
+		ctrl.Finish()
+	}()
+
+	stateMgr := broker.NewMockStateManager(ctrl)
+	api := NewDataExportAPI(&deps.HTTPDeps{StateMgr: stateMgr})
+	r := gin.New()
+	api.Register(r)
+	metricMetadataCommandFn = mockExportMetadata
+
+	// missing db
+	resp := mock.DoRequest(t, r, http.MethodGet, ExportDataPath, "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// bad format
+	resp = mock.DoRequest(t, r, http.MethodGet, ExportDataPath+"?db=test&format=csv", "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// database not found
+	stateMgr.EXPECT().GetDatabaseCfg("test").Return(models.Database{}, false)
+	resp = mock.DoRequest(t, r, http.MethodGet, ExportDataPath+"?db=test", "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+
+	stateMgr.EXPECT().GetDatabaseCfg("test").Return(testExportDatabase, true).AnyTimes()
+	// bad time range
+	resp = mock.DoRequest(t, r, http.MethodGet, ExportDataPath+"?db=test&start=10&end=1", "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// metadata failure
+	metricMetadataCommandFn = func(_ context.Context, _ *deps.HTTPDeps, _ *models.ExecuteParam, _ stmtpkg.Statement) (interface{}, error) {
+		return nil, fmt.Errorf("err")
+	}
+	resp = mock.DoRequest(t, r, http.MethodGet, ExportDataPath+"?db=test", "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	resp = mock.DoRequest(t, r, http.MethodGet, ExportDataPath+"?db=test&ns=ns", "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	metricMetadataCommandFn = mockExportMetadata
+
+	// export ok, 2 windows, no data in second window
+	var timeRanges []timeutilpkg.TimeRange
+	queryCommandFn = func(_ context.Context, _ *deps.HTTPDeps, _ *models.ExecuteParam, stmt stmtpkg.Statement) (interface{}, error) {
+		q := stmt.(*stmtpkg.Query)
+		assert.Len(t, q.SelectItems, 2)
+		assert.Equal(t, []string{"host"}, q.GroupBy)
+		assert.True(t, q.RawSeries)
+		timeRanges = append(timeRanges, q.TimeRange)
+		if len(timeRanges) > 1 {
+			return nil, constants.ErrMetricIDNotFound
+		}
+		return newTestExportResultSet(), nil
+	}
+	resp = mock.DoRequest(t, r, http.MethodGet,
+		fmt.Sprintf("%s?db=test&start=5000&end=%d", ExportDataPath, timeutil.OneHour+5000), "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, resp.Result().Trailer.Get(constants.ExportErrorTrailer))
+	assert.Equal(t, []timeutilpkg.TimeRange{
+		{Start: 0, End: timeutil.OneHour - 10*timeutil.OneSecond},
+		{Start: timeutil.OneHour, End: timeutil.OneHour + 5000},
+	}, timeRanges)
+
+	reader := export.NewReader(bytes.NewReader(resp.Body.Bytes()))
+	assert.NoError(t, reader.ReadHeader())
+	payload, err := reader.Next()
+	assert.NoError(t, err)
+	var ms protoMetricsV1.MetricList
+	assert.NoError(t, ms.Unmarshal(payload))
+	assert.Len(t, ms.Metrics, 3)
+	assert.Equal(t, "ns", ms.Metrics[0].Namespace)
+	assert.Equal(t, "cpu", ms.Metrics[0].Name)
+	assert.Equal(t, int64(10000), ms.Metrics[0].Timestamp)
+	assert.Equal(t, []*protoMetricsV1.KeyValue{{Key: "host", Value: "a"}}, ms.Metrics[0].Tags)
+	assert.Equal(t, []*protoMetricsV1.SimpleField{
+		{Name: "count", Type: protoMetricsV1.SimpleFieldType_DELTA_SUM, Value: 1},
+		{Name: "max", Type: protoMetricsV1.SimpleFieldType_Max, Value: 5},
+	}, ms.Metrics[0].SimpleFields)
+	assert.Equal(t, int64(20000), ms.Metrics[1].Timestamp)
+	assert.Equal(t, int64(10000), ms.Metrics[2].Timestamp)
+	assert.Empty(t, ms.Metrics[2].Tags)
+	_, err = reader.Next()
+	assert.Equal(t, io.EOF, err)
+
+	// export line format
+	queryCommandFn = func(_ context.Context, _ *deps.HTTPDeps, _ *models.ExecuteParam, _ stmtpkg.Statement) (interface{}, error) {
+		return newTestExportResultSet(), nil
+	}
+	resp = mock.DoRequest(t, r, http.MethodGet, ExportDataPath+"?db=test&ns=ns&metric=cpu&start=5000&end=6000&format=line", "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "cpu,host=a count=1,max=5 10000\ncpu,host=a count=2 20000\ncpu count=3 10000\n", resp.Body.String())
+
+	// query failure after response sent, error message with not found isn't ignored
+	queryCommandFn = func(_ context.Context, _ *deps.HTTPDeps, _ *models.ExecuteParam, _ stmtpkg.Statement) (interface{}, error) {
+		return nil, fmt.Errorf("query err: storage node not found")
+	}
+	resp = mock.DoRequest(t, r, http.MethodGet, ExportDataPath+"?db=test&start=5000&end=6000", "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "query err: storage node not found", resp.Result().Trailer.Get(constants.ExportErrorTrailer))
+	reader = export.NewReader(bytes.NewReader(resp.Body.Bytes()))
+	assert.NoError(t, reader.ReadHeader())
+	_, err = reader.Next()
+	assert.Equal(t, export.ErrMissingEnd, err)
+
+	// unexpected result type
+	queryCommandFn = func(_ context.Context, _ *deps.HTTPDeps, _ *models.ExecuteParam, _ stmtpkg.Statement) (interface{}, error) {
+		return "bad", nil
+	}
+	resp = mock.DoRequest(t, r, http.MethodGet, ExportDataPath+"?db=test", "")
+	assert.NotEmpty(t, resp.Result().Trailer.Get(constants.ExportErrorTrailer))
+}
+
+func TestDataExportAPI_metadata(t *testing.T) {
+	defer func() {
+		metricMetadataCommandFn = command.MetricMetadataCommand
+	}()
+	api := NewDataExportAPI(&deps.HTTPDeps{})
+	m := exportMetric{namespace: "ns", name: "cpu"}
+	cases := []struct {
+		name   string
+		result interface{}
+		err    error
+	}{
+		{name: "query failure", err: fmt.Errorf("err")},
+		{name: "bad result", result: "bad"},
+		{name: "bad values", result: &commonmodels.Metadata{Values: 1}},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			metricMetadataCommandFn = func(_ context.Context, _ *deps.HTTPDeps, _ *models.ExecuteParam, _ stmtpkg.Statement) (interface{}, error) {
+				return tt.result, tt.err
+			}
+			_, err := api.metadata(context.TODO(), "test", &stmtpkg.MetricMetadata{Type: stmtpkg.TagKey})
+			assert.Error(t, err)
+			_, err = api.fields(context.TODO(), "test", m)
+			assert.Error(t, err)
+		})
+	}
+	// tag keys/fields failure when exporting
+	metricMetadataCommandFn = func(_ context.Context, _ *deps.HTTPDeps, _ *models.ExecuteParam, stmt stmtpkg.Statement) (interface{}, error) {
+		if stmt.(*stmtpkg.MetricMetadata).Type == stmtpkg.Field {
+			return &commonmodels.Metadata{Values: []commonmodels.Field{{Name: "h", Type: "histogram"}}}, nil
+		}
+		return nil, fmt.Errorf("err")
+	}
+	param := &models.ExportParam{Database: "test"}
+	assert.Error(t, api.export(context.TODO(), nil, param, []exportMetric{m}, 10000, nil))
+	metricMetadataCommandFn = func(_ context.Context, _ *deps.HTTPDeps, _ *models.ExecuteParam, stmt stmtpkg.Statement) (interface{}, error) {
+		if stmt.(*stmtpkg.MetricMetadata).Type == stmtpkg.Field {
+			return nil, fmt.Errorf("err")
+		}
+		return &commonmodels.Metadata{Values: []string{"host"}}, nil
+	}
+	assert.Error(t, api.export(context.TODO(), nil, param, []exportMetric{m}, 10000, nil))
+	// no exportable fields
+	metricMetadataCommandFn = func(_ context.Context, _ *deps.HTTPDeps, _ *models.ExecuteParam, stmt stmtpkg.Statement) (interface{}, error) {
+		if stmt.(*stmtpkg.MetricMetadata).Type == stmtpkg.Field {
+			return &commonmodels.Metadata{Values: []commonmodels.Field{{Name: "h", Type: "histogram"}}}, nil
+		}
+		return &commonmodels.Metadata{Values: []string{"host"}}, nil
+	}
+	assert.NoError(t, api.export(context.TODO(), nil, param, []exportMetric{m}, 10000, nil))
+}
+
+func TestDataExportAPI_Import(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cm := replica.NewMockChannelManager(ctrl)
+	api := NewDataExportAPI(&deps.HTTPDeps{
+		BrokerCfg: &config.Broker{
+			BrokerBase: config.BrokerBase{
+				Ingestion: config.Ingestion{
+					IngestTimeout: ltoml.Duration(time.Second * 2),
+				},
+			},
+		},
+		CM: cm,
+		IngestLimiter: concurrent.NewLimiter(
+			context.TODO(),
+			32,
+			time.Second,
+			metrics.NewLimitStatistics("data_import_test", linmetric.BrokerRegistry)),
+	})
+	r := gin.New()
+	api.Register(r)
+
+	// timestamp behind acceptable write time range
+	var buf bytes.Buffer
+	encoder, err := export.NewEncoder(export.FormatNative, &buf)
+	assert.NoError(t, err)
+	assert.NoError(t, encoder.Encode(&protoMetricsV1.Metric{
+		Namespace: "ns",
+		Name:      "cpu",
+		Timestamp: 10000,
+		SimpleFields: []*protoMetricsV1.SimpleField{
+			{Name: "count", Type: protoMetricsV1.SimpleFieldType_DELTA_SUM, Value: 1},
+		},
+	}))
+	assert.NoError(t, encoder.Close())
+
+	// missing db
+	resp := mock.DoRequest(t, r, http.MethodPut, ImportDataPath, buf.String())
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// bad enrich tag
+	resp = mock.DoRequest(t, r, http.MethodPut, ImportDataPath+"?db=test&enrich_tag=a", buf.String())
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// bad format
+	resp = mock.DoRequest(t, r, http.MethodPut, ImportDataPath+"?db=test&format=csv", buf.String())
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// parse failure
+	resp = mock.DoRequest(t, r, http.MethodPut, ImportDataPath+"?db=test", "bad")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+
+	// write failure
+	cm.EXPECT().Write(gomock.Any(), "test", gomock.Any()).Return(io.ErrClosedPipe)
+	resp = mock.DoRequest(t, r, http.MethodPut, ImportDataPath+"?db=test", buf.String())
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+
+	// native format, keeps namespace and timestamp
+	cm.EXPECT().Write(gomock.Any(), "test", gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, rows *metric.BrokerBatchRows) error {
+			assert.Equal(t, 0, rows.EvictOutOfTimeRange(timeutil.OneHour, timeutil.OneHour))
+			m := rows.Rows()[0].Metric()
+			assert.Equal(t, "ns", string(m.Namespace()))
+			assert.Equal(t, int64(10000), m.Timestamp())
+			return nil
+		})
+	resp = mock.DoRequest(t, r, http.MethodPut, ImportDataPath+"?db=test", buf.String())
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, `{"metrics":1}`, resp.Body.String())
+
+	// line format, precision is millisecond by default
+	cm.EXPECT().Write(gomock.Any(), "test", gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, rows *metric.BrokerBatchRows) error {
+			assert.Equal(t, 2, rows.Len())
+			assert.Equal(t, 0, rows.EvictOutOfTimeRange(timeutil.OneHour, timeutil.OneHour))
+			m := rows.Rows()[0].Metric()
+			assert.Equal(t, "default-ns", string(m.Namespace()))
+			assert.Equal(t, int64(10000), m.Timestamp())
+			return nil
+		})
+	resp = mock.DoRequest(t, r, http.MethodPost, ImportDataPath+"?db=test&format=line",
+		"cpu,host=a count_sum=1 10000\ncpu,host=b count_sum=2 20000\n")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, `{"metrics":2}`, resp.Body.String())
+}
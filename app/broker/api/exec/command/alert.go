@@ -0,0 +1,59 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package command
+
+import (
+	"context"
+	"sort"
+
+	"github.com/lindb/common/pkg/encoding"
+	"github.com/lindb/common/pkg/logger"
+
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+// AlertCommand executes show alerts statement, returns all active alerts.
+func AlertCommand(ctx context.Context, deps *depspkg.HTTPDeps, _ *models.ExecuteParam, _ stmtpkg.Statement) (interface{}, error) {
+	data, err := deps.Repo.List(ctx, constants.AlertStatePath)
+	if err != nil {
+		return nil, err
+	}
+	rs := models.Alerts{}
+	for _, val := range data {
+		var alerts models.Alerts
+		if err := encoding.JSONUnmarshal(val.Value, &alerts); err != nil {
+			log.Warn("unmarshal alert state error",
+				logger.String("data", string(val.Value)))
+			continue
+		}
+		rs = append(rs, alerts...)
+	}
+	sort.Slice(rs, func(i, j int) bool {
+		if rs[i].Database != rs[j].Database {
+			return rs[i].Database < rs[j].Database
+		}
+		if rs[i].Rule != rs[j].Rule {
+			return rs[i].Rule < rs[j].Rule
+		}
+		return rs[i].LabelsString() < rs[j].LabelsString()
+	})
+	return rs, nil
+}
@@ -0,0 +1,67 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package command
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/lindb/common/pkg/encoding"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/state"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestAlert(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := state.NewMockRepository(ctrl)
+	deps := &depspkg.HTTPDeps{
+		Repo: repo,
+	}
+	// list failure
+	repo.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+	rs, err := AlertCommand(context.TODO(), deps, &models.ExecuteParam{}, &stmt.Alert{})
+	assert.Error(t, err)
+	assert.Nil(t, rs)
+
+	// list successfully
+	repo.EXPECT().List(gomock.Any(), gomock.Any()).Return([]state.KeyValue{
+		{Key: "mem", Value: encoding.JSONMarshal(models.Alerts{
+			{Rule: "mem", Labels: map[string]string{"host": "b"}, State: models.AlertFiring},
+			{Rule: "mem", Labels: map[string]string{"host": "a"}, State: models.AlertPending},
+		})},
+		{Key: "cpu", Value: encoding.JSONMarshal(models.Alerts{
+			{Rule: "cpu", Labels: map[string]string{"host": "a"}, State: models.AlertFiring},
+		})},
+		{Key: "err", Value: []byte{1, 2, 4}},
+	}, nil)
+	rs, err = AlertCommand(context.TODO(), deps, &models.ExecuteParam{}, &stmt.Alert{})
+	assert.NoError(t, err)
+	alerts := rs.(models.Alerts)
+	assert.Len(t, alerts, 3)
+	assert.Equal(t, "cpu", alerts[0].Rule)
+	assert.Equal(t, "a", alerts[1].Labels["host"])
+	assert.Equal(t, "b", alerts[2].Labels["host"])
+}
@@ -0,0 +1,128 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package command
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/lindb/common/pkg/encoding"
+	"github.com/lindb/common/pkg/logger"
+
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/state"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/pkg/validate"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+// ContinuousQueryCommand executes continuous query statement.
+func ContinuousQueryCommand(ctx context.Context, deps *depspkg.HTTPDeps,
+	param *models.ExecuteParam, stmt stmtpkg.Statement) (interface{}, error) {
+	db := strings.TrimSpace(param.Database)
+	if db == "" {
+		return nil, constants.ErrDatabaseNameRequired
+	}
+	cqStmt := stmt.(*stmtpkg.ContinuousQuery)
+	switch cqStmt.Type {
+	case stmtpkg.CreateContinuousQuery:
+		return saveContinuousQuery(ctx, db, deps, cqStmt)
+	case stmtpkg.ShowContinuousQueries:
+		return listContinuousQueries(ctx, db, deps)
+	case stmtpkg.DropContinuousQuery:
+		return dropContinuousQuery(ctx, db, deps, cqStmt)
+	}
+	return nil, nil
+}
+
+// saveContinuousQuery creates the continuous query if not exist, otherwise update it.
+func saveContinuousQuery(ctx context.Context, db string, deps *depspkg.HTTPDeps, stmt *stmtpkg.ContinuousQuery) (interface{}, error) {
+	if _, ok := deps.StateMgr.GetDatabaseCfg(db); !ok {
+		return nil, constants.ErrDatabaseNotExist
+	}
+	if stmt.Query != nil && stmt.Query.MetricName == stmt.TargetMetric {
+		return nil, fmt.Errorf("target metric cannot be same as source metric of continuous query: %s", stmt.TargetMetric)
+	}
+	cq := &models.ContinuousQuery{
+		Name:         stmt.Name,
+		Database:     db,
+		Interval:     timeutil.Interval(stmt.Interval),
+		TargetMetric: stmt.TargetMetric,
+		SQL:          stmt.SQL,
+	}
+	if err := validate.Validator.Struct(cq); err != nil {
+		return nil, err
+	}
+	data := encoding.JSONMarshal(cq)
+	log.Info("saving continuous query", logger.String("database", db), logger.String("config", string(data)))
+	if err := deps.Repo.Put(ctx, constants.GetContinuousQueryPath(db, cq.Name), data); err != nil {
+		return nil, err
+	}
+	rs := fmt.Sprintf("Create continuous query[%s] ok", cq.Name)
+	return &rs, nil
+}
+
+// listContinuousQueries returns the continuous query list of database.
+func listContinuousQueries(ctx context.Context, db string, deps *depspkg.HTTPDeps) (interface{}, error) {
+	cqs, err := ListContinuousQueries(ctx, deps.Repo)
+	if err != nil {
+		return nil, err
+	}
+	rs := models.ContinuousQueries{}
+	for _, cq := range cqs {
+		if cq.Database == db {
+			rs = append(rs, cq)
+		}
+	}
+	return rs, nil
+}
+
+// dropContinuousQuery drops the continuous query of database.
+func dropContinuousQuery(ctx context.Context, db string, deps *depspkg.HTTPDeps, stmt *stmtpkg.ContinuousQuery) (interface{}, error) {
+	log.Info("drop continuous query", logger.String("database", db), logger.String("name", stmt.Name))
+	if err := deps.Repo.Delete(ctx, constants.GetContinuousQueryPath(db, stmt.Name)); err != nil {
+		return nil, err
+	}
+	if err := deps.Repo.Delete(ctx, constants.GetContinuousQueryStatePath(db, stmt.Name)); err != nil {
+		return nil, err
+	}
+	rs := fmt.Sprintf("Drop continuous query[%s] ok", stmt.Name)
+	return &rs, nil
+}
+
+// ListContinuousQueries returns all continuous queries in state repository.
+func ListContinuousQueries(ctx context.Context, repo state.Repository) (models.ContinuousQueries, error) {
+	data, err := repo.List(ctx, constants.ContinuousQueryPath)
+	if err != nil {
+		return nil, err
+	}
+	var cqs models.ContinuousQueries
+	for _, val := range data {
+		cq := models.ContinuousQuery{}
+		if err := encoding.JSONUnmarshal(val.Value, &cq); err != nil {
+			log.Warn("unmarshal continuous query error",
+				logger.String("data", string(val.Value)))
+			continue
+		}
+		cqs = append(cqs, cq)
+	}
+	return cqs, nil
+}
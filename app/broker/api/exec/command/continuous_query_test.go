@@ -0,0 +1,191 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package command
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/lindb/common/pkg/encoding"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/coordinator/broker"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/state"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestContinuousQuery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := state.NewMockRepository(ctrl)
+	stateMgr := broker.NewMockStateManager(ctrl)
+	stateMgr.EXPECT().GetDatabaseCfg("test").Return(models.Database{}, true).AnyTimes()
+	stateMgr.EXPECT().GetDatabaseCfg("not_exist").Return(models.Database{}, false).AnyTimes()
+	deps := &depspkg.HTTPDeps{
+		Repo:     repo,
+		StateMgr: stateMgr,
+	}
+	createStmt := &stmt.ContinuousQuery{
+		Type:         stmt.CreateContinuousQuery,
+		Name:         "cpu_1m",
+		Interval:     60000,
+		TargetMetric: "cpu_avg_1m",
+		SQL:          "select avg(usage) from cpu",
+		Query:        &stmt.Query{MetricName: "cpu"},
+	}
+	cases := []struct {
+		name      string
+		db        string
+		statement stmt.Statement
+		prepare   func()
+		wantErr   bool
+	}{
+		{
+			name:    "database name not input",
+			wantErr: true,
+		},
+		{
+			name:      "unknown continuous query op",
+			db:        "test",
+			statement: &stmt.ContinuousQuery{},
+		},
+		{
+			name:      "database not exist",
+			db:        "not_exist",
+			statement: createStmt,
+			wantErr:   true,
+		},
+		{
+			name: "target metric same as source metric",
+			db:   "test",
+			statement: &stmt.ContinuousQuery{
+				Type:         stmt.CreateContinuousQuery,
+				Name:         "cpu_1m",
+				Interval:     60000,
+				TargetMetric: "cpu",
+				SQL:          "select avg(usage) from cpu",
+				Query:        &stmt.Query{MetricName: "cpu"},
+			},
+			wantErr: true,
+		},
+		{
+			name:      "continuous query validation failure",
+			db:        "test",
+			statement: &stmt.ContinuousQuery{Type: stmt.CreateContinuousQuery, Name: "cpu_1m"},
+			wantErr:   true,
+		},
+		{
+			name:      "save continuous query failure",
+			db:        "test",
+			statement: createStmt,
+			prepare: func() {
+				repo.EXPECT().Put(gomock.Any(), "/database/cq/test/cpu_1m", gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:      "save continuous query successfully",
+			db:        "test",
+			statement: createStmt,
+			prepare: func() {
+				repo.EXPECT().Put(gomock.Any(), "/database/cq/test/cpu_1m", gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:      "list continuous queries failure",
+			db:        "test",
+			statement: &stmt.ContinuousQuery{Type: stmt.ShowContinuousQueries},
+			prepare: func() {
+				repo.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:      "list continuous queries successfully",
+			db:        "test",
+			statement: &stmt.ContinuousQuery{Type: stmt.ShowContinuousQueries},
+			prepare: func() {
+				repo.EXPECT().List(gomock.Any(), gomock.Any()).Return([]state.KeyValue{
+					{Key: "cq1", Value: encoding.JSONMarshal(&models.ContinuousQuery{Name: "cq1", Database: "test"})},
+					{Key: "cq2", Value: encoding.JSONMarshal(&models.ContinuousQuery{Name: "cq2", Database: "test2"})},
+					{Key: "err", Value: []byte{1, 2, 4}},
+				}, nil)
+			},
+		},
+		{
+			name:      "drop continuous query failure",
+			db:        "test",
+			statement: &stmt.ContinuousQuery{Type: stmt.DropContinuousQuery, Name: "cpu_1m"},
+			prepare: func() {
+				repo.EXPECT().Delete(gomock.Any(), "/database/cq/test/cpu_1m").Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:      "drop continuous query state failure",
+			db:        "test",
+			statement: &stmt.ContinuousQuery{Type: stmt.DropContinuousQuery, Name: "cpu_1m"},
+			prepare: func() {
+				repo.EXPECT().Delete(gomock.Any(), "/database/cq/test/cpu_1m").Return(nil)
+				repo.EXPECT().Delete(gomock.Any(), "/cq/state/test/cpu_1m").Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:      "drop continuous query successfully",
+			db:        "test",
+			statement: &stmt.ContinuousQuery{Type: stmt.DropContinuousQuery, Name: "cpu_1m"},
+			prepare: func() {
+				repo.EXPECT().Delete(gomock.Any(), "/database/cq/test/cpu_1m").Return(nil)
+				repo.EXPECT().Delete(gomock.Any(), "/cq/state/test/cpu_1m").Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			_, err := ContinuousQueryCommand(context.TODO(), deps, &models.ExecuteParam{Database: tt.db}, tt.statement)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ContinuousQueryCommand() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestListContinuousQueries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := state.NewMockRepository(ctrl)
+	repo.EXPECT().List(gomock.Any(), gomock.Any()).Return([]state.KeyValue{
+		{Key: "cq1", Value: encoding.JSONMarshal(&models.ContinuousQuery{Name: "cq1", Database: "test"})},
+		{Key: "cq2", Value: encoding.JSONMarshal(&models.ContinuousQuery{Name: "cq2", Database: "test2"})},
+	}, nil)
+	rs, err := listContinuousQueries(context.TODO(), "test", &depspkg.HTTPDeps{Repo: repo})
+	assert.NoError(t, err)
+	assert.Equal(t, models.ContinuousQueries{{Name: "cq1", Database: "test"}}, rs)
+}
@@ -0,0 +1,69 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package command
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/state"
+	"github.com/lindb/lindb/query"
+	"github.com/lindb/lindb/query/cache"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestDeleteCommand(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	defer func() {
+		seriesDeleteFn = query.SeriesDelete
+	}()
+
+	seriesDeleteFn = func(_ context.Context, _ *models.ExecuteParam,
+		_ *stmt.Delete, _ *query.SearchMgr) (any, error) {
+		return &models.DeleteResult{}, nil
+	}
+
+	resultCache := cache.NewMockResultCache(ctrl)
+	resultCache.EXPECT().Invalidate("db").Times(2)
+	repo := state.NewMockRepository(ctrl)
+	deps := &depspkg.HTTPDeps{
+		Node: &models.StatelessNode{},
+		BrokerCfg: &config.Broker{
+			Query: *config.NewDefaultQuery(),
+		},
+		Repo:        repo,
+		ResultCache: resultCache,
+	}
+	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	rs, err := DeleteCommand(context.TODO(), deps, &models.ExecuteParam{Database: "db"}, &stmt.Delete{})
+	assert.NoError(t, err)
+	assert.NotNil(t, rs)
+	// invalidate cached results of other brokers failure
+	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+	rs, err = DeleteCommand(context.TODO(), deps, &models.ExecuteParam{Database: "db"}, &stmt.Delete{})
+	assert.Error(t, err)
+	assert.Nil(t, rs)
+}
@@ -22,8 +22,6 @@ import (
 	"fmt"
 	"strconv"
 
-	"github.com/go-resty/resty/v2"
-
 	depspkg "github.com/lindb/lindb/app/broker/deps"
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/coordinator/broker"
@@ -87,7 +85,7 @@ func exploreStateRepoData(ctx context.Context, deps *depspkg.HTTPDeps,
 		masterNode := deps.Master.GetMaster()
 		address := masterNode.Node.HTTPAddress()
 		var meta []interface{}
-		_, err := resty.New().R().SetQueryParams(map[string]string{
+		_, err := client.InternalClient().R().SetQueryParams(map[string]string{
 			"sql": fmt.Sprintf("show storage metedata where path='%s'", metadataStmt.Type)}).
 			SetHeader("Accept", "application/json").
 			SetResult(&meta).
@@ -23,6 +23,7 @@ import (
 	depspkg "github.com/lindb/lindb/app/broker/deps"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/query"
+	queryctx "github.com/lindb/lindb/query/context"
 	stmtpkg "github.com/lindb/lindb/sql/stmt"
 )
 
@@ -34,6 +35,13 @@ var (
 // QueryCommand executes metric query.
 func QueryCommand(ctx context.Context, deps *depspkg.HTTPDeps,
 	param *models.ExecuteParam, stmt stmtpkg.Statement) (interface{}, error) {
+	return QueryStreamCommand(ctx, deps, param, stmt, nil)
+}
+
+// QueryStreamCommand executes metric query, the chunk of result set is written by writer incrementally,
+// returns the rest of result set.
+func QueryStreamCommand(ctx context.Context, deps *depspkg.HTTPDeps,
+	param *models.ExecuteParam, stmt stmtpkg.Statement, writer queryctx.ResultWriter) (interface{}, error) {
 	return metricDataSearchFn(
 		ctx,
 		param,
@@ -44,5 +52,7 @@ func QueryCommand(ctx context.Context, deps *depspkg.HTTPDeps,
 			Choose:       deps.StateMgr,
 			TaskMgr:      deps.TaskMgr,
 			TransportMgr: deps.TransportMgr,
+			ResultCache:  deps.ResultCache,
+			ResultWriter: writer,
 		})
 }
@@ -23,6 +23,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	commonmodels "github.com/lindb/common/models"
+
 	depspkg "github.com/lindb/lindb/app/broker/deps"
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/models"
@@ -48,3 +50,31 @@ func TestQueryCommand(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Nil(t, rs)
 }
+
+func TestQueryStreamCommand(t *testing.T) {
+	defer func() {
+		metricDataSearchFn = query.MetricDataSearch
+	}()
+
+	writer := &resultWriter{}
+	metricDataSearchFn = func(_ context.Context, _ *models.ExecuteParam, _ *stmt.Query, mgr *query.SearchMgr) (any, error) {
+		assert.Equal(t, writer, mgr.ResultWriter)
+		return nil, nil
+	}
+
+	rs, err := QueryStreamCommand(context.Background(), &depspkg.HTTPDeps{
+		Node: &models.StatelessNode{},
+		BrokerCfg: &config.Broker{
+			Query: *config.NewDefaultQuery(),
+		},
+	}, nil, &stmt.Query{}, writer)
+	assert.NoError(t, err)
+	assert.Nil(t, rs)
+}
+
+// resultWriter represents the writer of streaming query for testing.
+type resultWriter struct{}
+
+func (w *resultWriter) Write(_ *commonmodels.ResultSet) error {
+	return nil
+}
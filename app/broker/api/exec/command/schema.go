@@ -66,6 +66,9 @@ func dropDatabase(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.Sch
 	if err := deps.Repo.Delete(ctx, constants.GetDatabaseAssignPath(databaseName)); err != nil {
 		return nil, err
 	}
+	if err := deps.InvalidateResultCache(ctx, databaseName); err != nil {
+		return nil, err
+	}
 	// TODO: remove limits
 	rs := fmt.Sprintf("Drop database[%s] ok", stmt.Value)
 	return &rs, nil
@@ -122,6 +125,10 @@ func saveDataBase(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.Sch
 	if err := deps.Repo.Put(ctx, constants.GetDatabaseConfigPath(database.Name), data); err != nil {
 		return nil, err
 	}
+	// database option(intervals/behind etc.) maybe changed
+	if err := deps.InvalidateResultCache(ctx, database.Name); err != nil {
+		return nil, err
+	}
 	rs := "Create database ok"
 	return &rs, nil
 }
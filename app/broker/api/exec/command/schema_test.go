@@ -87,10 +87,19 @@ func TestSchema(t *testing.T) {
 			wantErr: true,
 		},
 		{
-			name:      "create database successfully",
+			name:      "create database, invalidate result cache failure",
 			statement: &stmt.Schema{Type: stmt.CreateDatabaseSchemaType, Value: databaseCfg},
 			prepare: func() {
 				repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:      "create database successfully",
+			statement: &stmt.Schema{Type: stmt.CreateDatabaseSchemaType, Value: databaseCfg},
+			prepare: func() {
+				repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(2)
 			},
 		},
 		{
@@ -113,6 +122,15 @@ func TestSchema(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name:      "drop database, but invalidate result cache failure",
+			statement: &stmt.Schema{Type: stmt.DropDatabaseSchemaType, Value: "test"},
+			prepare: func() {
+				repo.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+				repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
 		{
 			name:      "drop database successfully",
 			statement: &stmt.Schema{Type: stmt.DropDatabaseSchemaType, Value: "test"},
@@ -121,6 +139,8 @@ func TestSchema(t *testing.T) {
 				repo.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil)
 				// delete database shard assignment ok
 				repo.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil)
+				// change version of cached results
+				repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 			},
 		},
 		{
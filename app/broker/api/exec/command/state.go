@@ -21,8 +21,6 @@ import (
 	"context"
 	"sync"
 
-	"github.com/go-resty/resty/v2"
-
 	"github.com/lindb/common/pkg/logger"
 
 	depspkg "github.com/lindb/lindb/app/broker/deps"
@@ -106,7 +104,7 @@ func fetchStateData(nodes []models.Node, stmt *stmtpkg.State, path string, newSt
 			node := nodes[i]
 			address := node.HTTPAddress()
 			state := newStateFn()
-			_, err := resty.New().R().SetQueryParams(map[string]string{"db": stmt.Database}).
+			_, err := client.InternalClient().R().SetQueryParams(map[string]string{"db": stmt.Database}).
 				SetHeader("Accept", "application/json").
 				SetResult(&state).
 				Get(address + constants.APIVersion1CliPath + path)
@@ -19,16 +19,19 @@ package command
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
 	"sync"
 
-	"github.com/go-resty/resty/v2"
-
 	"github.com/lindb/common/pkg/encoding"
 	"github.com/lindb/common/pkg/logger"
 
 	depspkg "github.com/lindb/lindb/app/broker/deps"
 	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/internal/client"
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/state"
 	stmtpkg "github.com/lindb/lindb/sql/stmt"
 )
 
@@ -46,6 +49,8 @@ type storageCommandFn = func(ctx context.Context, deps *depspkg.HTTPDeps, stmt *
 // storageCommands registers all storage related commands.
 var storageCommands = map[stmtpkg.StorageOpType]storageCommandFn{
 	stmtpkg.StorageOpRecover: recoverStorage,
+	stmtpkg.StorageOpBackup:  backupDatabase,
+	stmtpkg.StorageOpRestore: restoreDatabase,
 }
 
 // StorageCommand executes lin query language for storage related.
@@ -58,7 +63,7 @@ func StorageCommand(ctx context.Context, deps *depspkg.HTTPDeps, _ *models.Execu
 }
 
 // recoverStorage recovers all database config/shard assignment by given storage.
-func recoverStorage(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.Storage) (interface{}, error) {
+func recoverStorage(ctx context.Context, deps *depspkg.HTTPDeps, _ *stmtpkg.Storage) (interface{}, error) {
 	storage := deps.StateMgr.GetStorage()
 	nodes := storage.LiveNodes
 	size := len(nodes)
@@ -75,7 +80,7 @@ func recoverStorage(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.S
 
 			address := node.HTTPAddress()
 			databases := make(map[string]models.DatabaseConfig)
-			_, err := resty.New().R().
+			_, err := client.InternalClient().R().
 				SetHeader("Accept", "application/json").
 				SetResult(&databases).
 				Get(address + constants.APIVersion1CliPath + "/state/metadata/local/database/config")
@@ -91,6 +96,106 @@ func recoverStorage(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.S
 	}
 	wait.Wait()
 
+	databaseNames, err := saveDatabaseConfigs(ctx, deps, result)
+	if err != nil {
+		return nil, err
+	}
+	return &databaseNames, nil
+}
+
+// backupDatabase backups the data of database on all live storage nodes,
+// each storage node writes the data into the sub directory(named by node id) of backup path.
+func backupDatabase(_ context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.Storage) (interface{}, error) {
+	manifests, err := invokeLiveNodes[models.BackupManifest](deps, stmt, constants.APIVersion1CliPath+"/database/backup")
+	if err != nil {
+		return nil, err
+	}
+	if len(manifests) == 0 {
+		return nil, fmt.Errorf("database[%s] not found in live storage nodes", stmt.Database)
+	}
+	return manifests, nil
+}
+
+// restoreDatabase rebuilds the database on all live storage nodes from the sub directory(named by node id)
+// of backup path, then recovers database config/shard assignment based on restored data.
+func restoreDatabase(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.Storage) (interface{}, error) {
+	if _, err := deps.Repo.Get(ctx, constants.GetDatabaseConfigPath(stmt.Database)); err == nil {
+		return nil, fmt.Errorf("database[%s] already exist", stmt.Database)
+	} else if !errors.Is(err, state.ErrNotExist) {
+		return nil, err
+	}
+	cfgs, err := invokeLiveNodes[models.DatabaseConfig](deps, stmt, constants.APIVersion1CliPath+"/database/restore")
+	if err != nil {
+		return nil, err
+	}
+	if len(cfgs) == 0 {
+		return nil, fmt.Errorf("backup of database[%s] not found under path[%s]", stmt.Database, stmt.Path)
+	}
+	var result []databaseConfig
+	for nodeID, cfg := range cfgs {
+		result = append(result, databaseConfig{
+			nodeID:    nodeID,
+			databases: map[string]models.DatabaseConfig{stmt.Database: *cfg},
+		})
+	}
+	databaseNames, err := saveDatabaseConfigs(ctx, deps, result)
+	if err != nil {
+		return nil, err
+	}
+	return &databaseNames, nil
+}
+
+// invokeLiveNodes invokes the backup/restore api of all live storage nodes concurrently,
+// returns the result by node id, the node which returns nil will be ignored.
+func invokeLiveNodes[T any](deps *depspkg.HTTPDeps, stmt *stmtpkg.Storage, apiPath string) (map[models.NodeID]*T, error) {
+	nodes := deps.StateMgr.GetStorage().LiveNodes
+	result := make(map[models.NodeID]*T)
+	var (
+		errs  []error
+		wait  sync.WaitGroup
+		mutex sync.Mutex
+	)
+	wait.Add(len(nodes))
+	for nodeID := range nodes {
+		node := nodes[nodeID]
+		go func() {
+			defer wait.Done()
+
+			var rs *T
+			address := node.HTTPAddress()
+			resp, err := client.InternalClient().R().
+				SetHeader("Accept", "application/json").
+				SetBody(&models.BackupParam{
+					Database: stmt.Database,
+					Path:     filepath.Join(stmt.Path, node.ID.String()),
+				}).
+				SetResult(&rs).
+				Put(address + apiPath)
+			if err == nil && resp.IsError() {
+				err = fmt.Errorf("%s", resp.String())
+			}
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			if err != nil {
+				log.Error("invoke storage node failure", logger.String("url", address+apiPath), logger.Error(err))
+				errs = append(errs, fmt.Errorf("node[%s]: %w", node.ID, err))
+				return
+			}
+			if rs != nil {
+				result[node.ID] = rs
+			}
+		}()
+	}
+	wait.Wait()
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return result, nil
+}
+
+// saveDatabaseConfigs saves the database config/shard assignment into repo based on the configuration of storage nodes.
+func saveDatabaseConfigs(ctx context.Context, deps *depspkg.HTTPDeps, result []databaseConfig) ([]string, error) {
 	databases := make(map[string]*models.ShardAssignment)
 	databaseSchema := make(map[string]*models.Database)
 	for _, cfg := range result {
@@ -118,7 +223,7 @@ func recoverStorage(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.S
 		if err := deps.Repo.Put(ctx, constants.GetShardAssignPath(databaseName), encoding.JSONMarshal(shardAssignment)); err != nil {
 			return nil, err
 		}
-		log.Info("recover database schema", logger.String("config", stmt.Value))
+		log.Info("recover database schema", logger.String("database", databaseName))
 		schema := databaseSchema[databaseName]
 		schema.NumOfShard = len(shardAssignment.Shards)
 		schema.ReplicaFactor = shardAssignment.GetReplicaFactor()
@@ -128,5 +233,5 @@ func recoverStorage(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.S
 		databaseNames = append(databaseNames, databaseName)
 	}
 
-	return &databaseNames, nil
+	return databaseNames, nil
 }
@@ -20,6 +20,7 @@ package command
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -135,3 +136,162 @@ func TestStorage(t *testing.T) {
 		})
 	}
 }
+
+func TestBackupRestoreDatabase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stateMgr := broker.NewMockStateManager(ctrl)
+	repo := state.NewMockRepository(ctrl)
+	deps := &depspkg.HTTPDeps{
+		StateMgr: stateMgr,
+		Repo:     repo,
+	}
+
+	mockSrv := func(code int, data []byte) {
+		server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			param := &models.BackupParam{}
+			assert.NoError(t, encoding.JSONUnmarshal(readBody(t, r), param))
+			assert.Equal(t, "test", param.Database)
+			assert.Equal(t, "/tmp/backup/1", param.Path)
+			rw.Header().Add("content-type", "application/json")
+			rw.WriteHeader(code)
+			_, _ = rw.Write(data)
+		}))
+		t.Cleanup(server.Close)
+		u, err := url.Parse(server.URL)
+		assert.NoError(t, err)
+		p, err := strconv.Atoi(u.Port())
+		assert.NoError(t, err)
+		stateMgr.EXPECT().GetStorage().Return(&models.StorageState{
+			LiveNodes: map[models.NodeID]models.StatefulNode{1: {
+				StatelessNode: models.StatelessNode{
+					HostIP:   u.Hostname(),
+					HTTPPort: uint16(p),
+				},
+				ID: 1,
+			}}})
+	}
+	manifestData := encoding.JSONMarshal(&models.BackupManifest{Database: "test", Path: "/tmp/backup/1"})
+	databaseCfgData := encoding.JSONMarshal(&models.DatabaseConfig{
+		Name:     "test",
+		ShardIDs: []models.ShardID{1, 2},
+		Option:   &option.DatabaseOption{},
+	})
+	backupStmt := &stmt.Storage{Type: stmt.StorageOpBackup, Database: "test", Path: "/tmp/backup"}
+	restoreStmt := &stmt.Storage{Type: stmt.StorageOpRestore, Database: "test", Path: "/tmp/backup"}
+	cases := []struct {
+		name      string
+		statement stmt.Statement
+		prepare   func()
+		wantErr   bool
+	}{
+		{
+			name:      "backup database, but storage node failure",
+			statement: backupStmt,
+			prepare: func() {
+				mockSrv(http.StatusInternalServerError, []byte(`"err"`))
+			},
+			wantErr: true,
+		},
+		{
+			name:      "backup database, but database not found",
+			statement: backupStmt,
+			prepare: func() {
+				mockSrv(http.StatusOK, []byte("null"))
+			},
+			wantErr: true,
+		},
+		{
+			name:      "backup database successfully",
+			statement: backupStmt,
+			prepare: func() {
+				mockSrv(http.StatusOK, manifestData)
+			},
+		},
+		{
+			name:      "restore database, but database exist",
+			statement: restoreStmt,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return([]byte("{}"), nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "restore database, but get database config failure",
+			statement: restoreStmt,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:      "restore database, but storage node failure",
+			statement: restoreStmt,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, state.ErrNotExist)
+				mockSrv(http.StatusInternalServerError, []byte(`"err"`))
+			},
+			wantErr: true,
+		},
+		{
+			name:      "restore database, but backup not found",
+			statement: restoreStmt,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, state.ErrNotExist)
+				mockSrv(http.StatusOK, []byte("null"))
+			},
+			wantErr: true,
+		},
+		{
+			name:      "restore database, but save database config failure",
+			statement: restoreStmt,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, state.ErrNotExist)
+				mockSrv(http.StatusOK, databaseCfgData)
+				repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:      "restore database successfully",
+			statement: restoreStmt,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, state.ErrNotExist)
+				mockSrv(http.StatusOK, databaseCfgData)
+				repo.EXPECT().Put(gomock.Any(), "/database/assign/test", gomock.Any()).Return(nil)
+				repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, data []byte) error {
+						schema := &models.Database{}
+						assert.NoError(t, encoding.JSONUnmarshal(data, schema))
+						assert.Equal(t, "test", schema.Name)
+						assert.Equal(t, 2, schema.NumOfShard)
+						assert.Equal(t, 1, schema.ReplicaFactor)
+						return nil
+					})
+			},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			rs, err := StorageCommand(context.TODO(), deps, nil, tt.statement)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, rs)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, rs)
+			}
+		})
+	}
+}
+
+func readBody(t *testing.T, r *http.Request) []byte {
+	data, err := io.ReadAll(r.Body)
+	assert.NoError(t, err)
+	return data
+}
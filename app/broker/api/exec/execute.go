@@ -24,6 +24,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	commonmodels "github.com/lindb/common/models"
 	httppkg "github.com/lindb/common/pkg/http"
 	"github.com/lindb/common/pkg/logger"
 
@@ -37,7 +38,8 @@ import (
 
 // for testing
 var (
-	sqlParseFn = sqlpkg.Parse
+	sqlParseFn         = sqlpkg.Parse
+	queryStreamCommand = command.QueryStreamCommand
 )
 
 // statementExecFn represents statement execution funcation define.
@@ -52,14 +54,18 @@ var (
 
 	// register all commands for the statement of lin query language.
 	commands = map[stmtpkg.StatementType]statementExecFn{
-		stmtpkg.MetadataStatement:       command.MetadataCommand,
-		stmtpkg.SchemaStatement:         command.SchemaCommand,
-		stmtpkg.StorageStatement:        command.StorageCommand,
-		stmtpkg.StateStatement:          command.StateCommand,
-		stmtpkg.MetricMetadataStatement: command.MetricMetadataCommand,
-		stmtpkg.QueryStatement:          command.QueryCommand,
-		stmtpkg.RequestStatement:        command.RequestCommand,
-		stmtpkg.LimitStatement:          command.LimitCommand,
+		stmtpkg.MetadataStatement:        command.MetadataCommand,
+		stmtpkg.SchemaStatement:          command.SchemaCommand,
+		stmtpkg.StorageStatement:         command.StorageCommand,
+		stmtpkg.StateStatement:           command.StateCommand,
+		stmtpkg.MetricMetadataStatement:  command.MetricMetadataCommand,
+		stmtpkg.QueryStatement:           command.QueryCommand,
+		stmtpkg.RequestStatement:         command.RequestCommand,
+		stmtpkg.LimitStatement:           command.LimitCommand,
+		stmtpkg.ContinuousQueryStatement: command.ContinuousQueryCommand,
+		stmtpkg.AlertStatement:           command.AlertCommand,
+		stmtpkg.DeleteStatement:          command.DeleteCommand,
+		stmtpkg.DropMetricStatement:      command.DropMetricCommand,
 	}
 )
 
@@ -90,12 +96,14 @@ func (e *ExecuteAPI) Register(route gin.IRoutes) {
 // 1. metric data/metadata query statement;
 // 2. cluster metadata/state query statement;
 // 3. database/storage management statement;
+// metric data query returns result chunks as json lines if stream param is true.
 //
 // @Summary execute lin query language
 // @Description Execute lin query language with rate limit, then return different response based on execution statement.
 // @Description 1. metric data/metadata query statement;
 // @Description 2. cluster metadata/state query statement;
 // @Description 3. database/storage management statement;
+// @Description metric data query returns result chunks as json lines(models.ResultChunk) if stream param is true.
 // @Tags LinQL
 // @Accept json
 // @Param param body models.ExecuteParam ture "param data"
@@ -135,7 +143,14 @@ func (e *ExecuteAPI) execute(c *gin.Context) error {
 	if stmt == nil {
 		return errors.New("can't parse lin query language")
 	}
+	if err := checkPermission(c, &param, stmt); err != nil {
+		httppkg.Forbidden(c)
+		return nil
+	}
 
+	if param.Stream && stmt.StatementType() == stmtpkg.QueryStatement {
+		return e.executeStream(ctx, c, &param, stmt)
+	}
 	if commandFn, ok := commands[stmt.StatementType()]; ok {
 		result, err := commandFn(ctx, e.deps, &param, stmt)
 		if err != nil {
@@ -150,3 +165,25 @@ func (e *ExecuteAPI) execute(c *gin.Context) error {
 	}
 	return errors.New("can't parse lin query language")
 }
+
+// executeStream executes metric data query, writes the result set as json lines incrementally.
+func (e *ExecuteAPI) executeStream(ctx context.Context, c *gin.Context,
+	param *models.ExecuteParam, stmt stmtpkg.Statement,
+) error {
+	writer := newResultStreamWriter(c)
+	result, err := queryStreamCommand(ctx, e.deps, param, stmt, writer)
+	if err != nil {
+		if !writer.Started() {
+			return err
+		}
+		// response status is sent, returns error as last chunk
+		writer.Fail(err)
+		return nil
+	}
+	rs, _ := result.(*commonmodels.ResultSet)
+	if err := writer.Complete(rs); err != nil {
+		e.logger.Warn("write result of streaming query failure",
+			logger.String("sql", param.SQL), logger.Error(err))
+	}
+	return nil
+}
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -29,8 +30,11 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
+	commonmodels "github.com/lindb/common/models"
+	"github.com/lindb/common/pkg/encoding"
 	"github.com/lindb/common/pkg/ltoml"
 
+	"github.com/lindb/lindb/app/broker/api/exec/command"
 	"github.com/lindb/lindb/app/broker/deps"
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/coordinator"
@@ -42,6 +46,7 @@ import (
 	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/state"
+	queryctx "github.com/lindb/lindb/query/context"
 	"github.com/lindb/lindb/sql"
 	stmtpkg "github.com/lindb/lindb/sql/stmt"
 )
@@ -156,6 +161,67 @@ func TestExecuteAPI_Execute(t *testing.T) {
 				assert.Equal(t, http.StatusInternalServerError, resp.Code)
 			},
 		},
+		{
+			name:    "stream query",
+			reqBody: `{"sql":"select f from cpu group by host","db":"test","stream":true}`,
+			prepare: func() {
+				queryStreamCommand = func(_ context.Context, _ *deps.HTTPDeps, param *models.ExecuteParam,
+					_ stmtpkg.Statement, writer queryctx.ResultWriter,
+				) (interface{}, error) {
+					assert.True(t, param.Stream)
+					assert.NoError(t, writer.Write(&commonmodels.ResultSet{
+						MetricName: "cpu",
+						Series:     []*commonmodels.Series{commonmodels.NewSeries(map[string]string{"host": "h1"}, "h1")},
+					}))
+					return &commonmodels.ResultSet{MetricName: "cpu"}, nil
+				}
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+				assert.Equal(t, "application/x-ndjson", resp.Header().Get("Content-Type"))
+				lines := strings.Split(strings.TrimSpace(resp.Body.String()), "\n")
+				assert.Len(t, lines, 2)
+				last := &models.ResultChunk{}
+				assert.NoError(t, encoding.JSONUnmarshal([]byte(lines[1]), last))
+				assert.True(t, last.Completed)
+			},
+		},
+		{
+			name:    "stream query failure before chunk written",
+			reqBody: `{"sql":"select f from cpu group by host","db":"test","stream":true}`,
+			prepare: func() {
+				queryStreamCommand = func(_ context.Context, _ *deps.HTTPDeps, _ *models.ExecuteParam,
+					_ stmtpkg.Statement, _ queryctx.ResultWriter,
+				) (interface{}, error) {
+					return nil, fmt.Errorf("err")
+				}
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "stream query failure after chunk written",
+			reqBody: `{"sql":"select f from cpu group by host","db":"test","stream":true}`,
+			prepare: func() {
+				queryStreamCommand = func(_ context.Context, _ *deps.HTTPDeps, _ *models.ExecuteParam,
+					_ stmtpkg.Statement, writer queryctx.ResultWriter,
+				) (interface{}, error) {
+					assert.NoError(t, writer.Write(&commonmodels.ResultSet{
+						Series: []*commonmodels.Series{commonmodels.NewSeries(nil, "")},
+					}))
+					return nil, fmt.Errorf("err")
+				}
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+				lines := strings.Split(strings.TrimSpace(resp.Body.String()), "\n")
+				assert.Len(t, lines, 2)
+				last := &models.ResultChunk{}
+				assert.NoError(t, encoding.JSONUnmarshal([]byte(lines[1]), last))
+				assert.Equal(t, "err", last.Error)
+			},
+		},
 	}
 
 	for _, tt := range cases {
@@ -163,6 +229,7 @@ func TestExecuteAPI_Execute(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			defer func() {
 				sqlParseFn = sql.Parse
+				queryStreamCommand = command.QueryStreamCommand
 			}()
 			if tt.prepare != nil {
 				tt.prepare()
@@ -0,0 +1,83 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package exec
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/http/middleware"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+// checkPermission checks if current user has the permission to execute the statement.
+func checkPermission(c *gin.Context, param *models.ExecuteParam, stmt stmtpkg.Statement) error {
+	database, permission, ok := requiredPermission(param, stmt)
+	if !ok {
+		// only requires authentication
+		return nil
+	}
+	return middleware.CheckPermission(c, database, permission)
+}
+
+// requiredPermission returns the database and permission which the statement requires,
+// returns false if the statement only requires authentication(cluster state/metadata etc.).
+func requiredPermission(param *models.ExecuteParam, stmt stmtpkg.Statement) (database string, permission models.Permission, ok bool) {
+	database = strings.TrimSpace(param.Database)
+	switch s := stmt.(type) {
+	case *stmtpkg.Query, *stmtpkg.MetricMetadata:
+		return database, models.ReadPermission, true
+	case *stmtpkg.Delete:
+		return database, models.WritePermission, true
+	case *stmtpkg.DropMetric:
+		return database, models.AdminPermission, true
+	case *stmtpkg.Limit:
+		if s.Type == stmtpkg.ShowLimit {
+			return database, models.ReadPermission, true
+		}
+		return database, models.AdminPermission, true
+	case *stmtpkg.ContinuousQuery:
+		if s.Type == stmtpkg.ShowContinuousQueries {
+			return database, models.ReadPermission, true
+		}
+		return database, models.AdminPermission, true
+	case *stmtpkg.Schema:
+		switch s.Type {
+		case stmtpkg.CreateDatabaseSchemaType:
+			return models.AllDatabases, models.AdminPermission, true
+		case stmtpkg.DropDatabaseSchemaType:
+			return s.Value, models.AdminPermission, true
+		}
+	case *stmtpkg.Storage:
+		switch s.Type {
+		case stmtpkg.StorageOpRecover, stmtpkg.StorageOpBackup, stmtpkg.StorageOpRestore:
+			// backup/restore reads/writes any path of storage node, requires admin of all databases
+			return models.AllDatabases, models.AdminPermission, true
+		}
+	case *stmtpkg.Alert:
+		// alert rules/states cover all databases, same as alert rule admin api
+		return models.AllDatabases, models.AdminPermission, true
+	case *stmtpkg.Broker:
+		if s.Type != stmtpkg.BrokerOpShow {
+			return models.AllDatabases, models.AdminPermission, true
+		}
+	}
+	return "", "", false
+}
@@ -0,0 +1,112 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package exec
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/lindb/common/pkg/ltoml"
+
+	"github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/internal/concurrent"
+	"github.com/lindb/lindb/internal/linmetric"
+	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/http/middleware"
+	"github.com/lindb/lindb/sql"
+)
+
+func TestRequiredPermission(t *testing.T) {
+	cases := []struct {
+		sql        string
+		database   string
+		permission models.Permission
+		ok         bool
+	}{
+		{sql: "select f from cpu", database: "db", permission: models.ReadPermission, ok: true},
+		{sql: "show metrics", database: "db", permission: models.ReadPermission, ok: true},
+		{sql: "delete from cpu where host='a'", database: "db", permission: models.WritePermission, ok: true},
+		{sql: "drop metric cpu", database: "db", permission: models.AdminPermission, ok: true},
+		{sql: "show limit", database: "db", permission: models.ReadPermission, ok: true},
+		{sql: "set limit 'max-namespaces=10'", database: "db", permission: models.AdminPermission, ok: true},
+		{sql: "show continuous queries", database: "db", permission: models.ReadPermission, ok: true},
+		{sql: "drop continuous query cq", database: "db", permission: models.AdminPermission, ok: true},
+		{sql: "create database {\"name\":\"test\"}", database: models.AllDatabases, permission: models.AdminPermission, ok: true},
+		{sql: "drop database 'test'", database: "test", permission: models.AdminPermission, ok: true},
+		{sql: "recover storage s1", database: models.AllDatabases, permission: models.AdminPermission, ok: true},
+		{sql: "backup database test to '/tmp/backup'", database: models.AllDatabases, permission: models.AdminPermission, ok: true},
+		{sql: "restore database test from '/tmp/backup'", database: models.AllDatabases, permission: models.AdminPermission, ok: true},
+		{sql: "create broker {\"name\":\"test\"}", database: models.AllDatabases, permission: models.AdminPermission, ok: true},
+		{sql: "show brokers"},
+		{sql: "show databases"},
+		{sql: "show master"},
+		{sql: "show alerts", database: models.AllDatabases, permission: models.AdminPermission, ok: true},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.sql, func(t *testing.T) {
+			stmt, err := sql.Parse(tt.sql)
+			assert.NoError(t, err)
+			database, permission, ok := requiredPermission(&models.ExecuteParam{Database: " db "}, stmt)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.database, database)
+			assert.Equal(t, tt.permission, permission)
+		})
+	}
+}
+
+func TestExecuteAPI_PermissionDenied(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := middleware.NewMockAuthStore(ctrl)
+	bob := &models.User{Name: "bob", Password: "pwd"}
+	assert.NoError(t, bob.HashPassword())
+	store.EXPECT().GetUser("bob").Return(bob, true).AnyTimes()
+	auth := middleware.NewAuthorization(config.Auth{Enabled: true}, store)
+	api := NewExecuteAPI(&deps.HTTPDeps{
+		Ctx: context.Background(),
+		BrokerCfg: &config.Broker{BrokerBase: config.BrokerBase{
+			HTTP: config.HTTP{ReadTimeout: ltoml.Duration(time.Second * 10)},
+		}},
+		QueryLimiter: concurrent.NewLimiter(
+			context.TODO(),
+			2,
+			time.Second*5,
+			metrics.NewLimitStatistics("exec", linmetric.BrokerRegistry),
+		),
+	})
+	r := gin.New()
+	r.Use(auth.Authenticate())
+	api.Register(r)
+
+	req, _ := http.NewRequest(http.MethodGet, "/", http.NoBody)
+	req.SetBasicAuth("bob", "pwd")
+	req.Header.Set("content-type", "application/json")
+	resp := mock.DoRequest(t, r, http.MethodPut, ExecutePath, `{"db":"db","sql":"drop metric cpu"}`, req.Header)
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+}
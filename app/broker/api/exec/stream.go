@@ -0,0 +1,129 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package exec
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+
+	commonmodels "github.com/lindb/common/models"
+	"github.com/lindb/common/pkg/encoding"
+
+	"github.com/lindb/lindb/models"
+)
+
+// maxSeriesPerChunk represents the max number of series in one chunk of streaming result.
+const maxSeriesPerChunk = 1000
+
+var errStreamClosed = errors.New("result stream is closed")
+
+// resultStreamWriter writes the result set of metric data query as json lines with chunked transfer encoding,
+// each line is a result chunk, so that client can render series before query completed.
+type resultStreamWriter struct {
+	c       *gin.Context
+	started bool
+	closed  bool // chunk cannot be written after completed/failed(e.g. response received after timeout)
+	mutex   sync.Mutex
+}
+
+// newResultStreamWriter creates the writer of streaming query result.
+func newResultStreamWriter(c *gin.Context) *resultStreamWriter {
+	return &resultStreamWriter{c: c}
+}
+
+// Write writes the series of result set, splits into multiple chunks if too many series.
+func (w *resultStreamWriter) Write(rs *commonmodels.ResultSet) error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	return w.write(rs)
+}
+
+// write writes the series of result set by chunks.
+func (w *resultStreamWriter) write(rs *commonmodels.ResultSet) error {
+	series := rs.Series
+	for len(series) > 0 {
+		n := min(len(series), maxSeriesPerChunk)
+		chunk := *rs
+		chunk.Series = series[:n]
+		chunk.Stats = nil
+		if err := w.writeChunk(&models.ResultChunk{ResultSet: &chunk}); err != nil {
+			return err
+		}
+		series = series[n:]
+	}
+	return nil
+}
+
+// Complete writes the rest of result set, then writes the last chunk with query stats.
+func (w *resultStreamWriter) Complete(rs *commonmodels.ResultSet) error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	last := &models.ResultChunk{Completed: true}
+	if rs != nil {
+		if err := w.write(rs); err != nil {
+			return err
+		}
+		chunk := *rs
+		chunk.Series = nil
+		last.ResultSet = &chunk
+	}
+	err := w.writeChunk(last)
+	w.closed = true
+	return err
+}
+
+// Fail writes the error chunk if query failure after chunks written.
+func (w *resultStreamWriter) Fail(err error) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	_ = w.writeChunk(&models.ResultChunk{Error: err.Error()})
+	w.closed = true
+}
+
+// Started returns if any chunk written.
+func (w *resultStreamWriter) Started() bool {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	return w.started
+}
+
+// writeChunk writes the chunk as json line, then flushes it to client.
+func (w *resultStreamWriter) writeChunk(chunk *models.ResultChunk) error {
+	if w.closed {
+		return errStreamClosed
+	}
+	if !w.started {
+		w.started = true
+		w.c.Header("Content-Type", "application/x-ndjson")
+		w.c.Status(http.StatusOK)
+	}
+	data := encoding.JSONMarshal(chunk)
+	data = append(data, '\n')
+	if _, err := w.c.Writer.Write(data); err != nil {
+		return err
+	}
+	w.c.Writer.Flush()
+	return nil
+}
@@ -0,0 +1,98 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package exec
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	commonmodels "github.com/lindb/common/models"
+	"github.com/lindb/common/pkg/encoding"
+
+	"github.com/lindb/lindb/models"
+)
+
+func TestResultStreamWriter(t *testing.T) {
+	readChunks := func(resp *httptest.ResponseRecorder) (chunks []*models.ResultChunk) {
+		for _, line := range strings.Split(strings.TrimSpace(resp.Body.String()), "\n") {
+			chunk := &models.ResultChunk{}
+			assert.NoError(t, encoding.JSONUnmarshal([]byte(line), chunk))
+			chunks = append(chunks, chunk)
+		}
+		return
+	}
+	newWriter := func() (*resultStreamWriter, *httptest.ResponseRecorder) {
+		resp := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(resp)
+		return newResultStreamWriter(c), resp
+	}
+
+	t.Run("split series into chunks", func(t *testing.T) {
+		writer, resp := newWriter()
+		assert.False(t, writer.Started())
+		rs := &commonmodels.ResultSet{MetricName: "cpu", Stats: &commonmodels.NodeStats{}}
+		for i := 0; i < maxSeriesPerChunk+1; i++ {
+			rs.AddSeries(commonmodels.NewSeries(map[string]string{"host": fmt.Sprintf("h%d", i)}, fmt.Sprintf("h%d", i)))
+		}
+		assert.NoError(t, writer.Write(rs))
+		assert.True(t, writer.Started())
+		assert.NoError(t, writer.Complete(&commonmodels.ResultSet{MetricName: "cpu", Stats: &commonmodels.NodeStats{}}))
+		assert.Equal(t, "application/x-ndjson", resp.Header().Get("Content-Type"))
+		chunks := readChunks(resp)
+		assert.Len(t, chunks, 3)
+		assert.Len(t, chunks[0].ResultSet.Series, maxSeriesPerChunk)
+		assert.Nil(t, chunks[0].ResultSet.Stats)
+		assert.Len(t, chunks[1].ResultSet.Series, 1)
+		assert.Equal(t, "cpu", chunks[1].ResultSet.MetricName)
+		assert.True(t, chunks[2].Completed)
+		assert.Empty(t, chunks[2].ResultSet.Series)
+		assert.NotNil(t, chunks[2].ResultSet.Stats)
+		// cannot write after completed
+		assert.ErrorIs(t, writer.Write(rs), errStreamClosed)
+	})
+	t.Run("complete with rest of result set", func(t *testing.T) {
+		writer, resp := newWriter()
+		assert.NoError(t, writer.Complete(&commonmodels.ResultSet{
+			Series: []*commonmodels.Series{commonmodels.NewSeries(nil, "")},
+		}))
+		chunks := readChunks(resp)
+		assert.Len(t, chunks, 2)
+		assert.Len(t, chunks[0].ResultSet.Series, 1)
+		assert.True(t, chunks[1].Completed)
+	})
+	t.Run("complete without result set", func(t *testing.T) {
+		writer, resp := newWriter()
+		assert.NoError(t, writer.Complete(nil))
+		chunks := readChunks(resp)
+		assert.Len(t, chunks, 1)
+		assert.True(t, chunks[0].Completed)
+		assert.Nil(t, chunks[0].ResultSet)
+	})
+	t.Run("write error chunk", func(t *testing.T) {
+		writer, resp := newWriter()
+		writer.Fail(fmt.Errorf("err"))
+		chunks := readChunks(resp)
+		assert.Equal(t, "err", chunks[0].Error)
+		assert.ErrorIs(t, writer.Complete(nil), errStreamClosed)
+	})
+}
@@ -0,0 +1,159 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ingest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	commonconstants "github.com/lindb/common/constants"
+	httppkg "github.com/lindb/common/pkg/http"
+	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/constants"
+	ingestCommon "github.com/lindb/lindb/ingestion/common"
+	"github.com/lindb/lindb/ingestion/otlp"
+	"github.com/lindb/lindb/internal/linmetric"
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
+)
+
+// OTLPMetricsPath represents OpenTelemetry(OTLP/HTTP) metrics write http api router path.
+var OTLPMetricsPath = "/otlp/v1/metrics"
+
+// OTLPWrite represents write api that processes OpenTelemetry(OTLP/HTTP) metrics data.
+type OTLPWrite struct {
+	deps *depspkg.HTTPDeps
+
+	statistics *linmetric.BoundHistogram
+}
+
+// NewOTLPWrite creates an OTLP writer instance.
+func NewOTLPWrite(deps *depspkg.HTTPDeps) *OTLPWrite {
+	return &OTLPWrite{
+		deps:       deps,
+		statistics: metrics.NewCommonIngestionStatistics().Duration.WithTagValues("otlp"),
+	}
+}
+
+// Register adds the OTLP writer url route.
+func (w *OTLPWrite) Register(route gin.IRoutes) {
+	route.POST(OTLPMetricsPath, w.Write)
+}
+
+// Write processes OTLP/HTTP metrics data with ingest limit.
+//
+// @BasePath /api/v1
+// @Summary write OpenTelemetry metric data
+// @Schemes
+// @Description receive OTLP/HTTP metrics export request, then parse the data based on content type(proto buffer/json).
+// @Description gauge/sum/histogram/exponential histogram are supported, resource attributes are converted into tags,
+// @Description histogram/exponential histogram only supports delta temporality, others are rejected as partial success.
+// @Tags Write
+// @Accept application/x-protobuf
+// @Accept application/json
+// @Param db query string true "database name"
+// @Param ns query string false "namespace, default value: default-ns"
+// @Param string body string ture "OTLP metrics export request"
+// @Produce application/x-protobuf
+// @Produce json
+// @Success 200 {string} string "OTLP metrics export response"
+// @Failure 500 {string} string "internal error"
+// @Router /otlp/v1/metrics [post]
+func (w *OTLPWrite) Write(c *gin.Context) {
+	var rejected int64
+	if err := w.deps.IngestLimiter.Do(func() (err error) {
+		rejected, err = w.write(c)
+		return err
+	}); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	response := pmetricotlp.NewExportResponse()
+	if rejected > 0 {
+		response.PartialSuccess().SetRejectedDataPoints(rejected)
+		response.PartialSuccess().SetErrorMessage("unsupported metric type or aggregation temporality")
+	}
+	var (
+		data        []byte
+		err         error
+		contentType = constants.ContentTypeOTLPProto
+	)
+	if otlp.IsJSON(c.Request) {
+		contentType = constants.ContentTypeJSON
+		data, err = response.MarshalJSON()
+	} else {
+		data, err = response.MarshalProto()
+	}
+	if err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	c.Data(http.StatusOK, contentType, data)
+}
+
+// parse OTLP metrics data, then write parsed data to database's write channel,
+// returns the number of rejected data points.
+func (w *OTLPWrite) write(c *gin.Context) (rejected int64, err error) {
+	var param struct {
+		Database  string `form:"db" binding:"required"`
+		Namespace string `form:"ns"`
+	}
+	err = c.ShouldBindQuery(&param)
+	if err != nil {
+		return 0, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(),
+		w.deps.BrokerCfg.BrokerBase.Ingestion.IngestTimeout.Duration())
+	defer cancel()
+
+	if param.Namespace == "" {
+		param.Namespace = commonconstants.DefaultNamespace
+	}
+	enrichedTags, err := ingestCommon.ExtractEnrichTags(c.Request)
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	limits := models.GetDatabaseLimits(param.Database)
+	if limits.EnableNamespaceLengthCheck() && len(param.Namespace) > limits.MaxNamespaceLength {
+		return 0, constants.ErrNamespaceTooLong
+	}
+	nativeHistogram := false
+	if db, ok := w.deps.StateMgr.GetDatabaseCfg(param.Database); ok && db.Option != nil {
+		nativeHistogram = db.Option.NativeHistogram
+	}
+	rows, rejected, err := otlp.Parse(c.Request, enrichedTags, param.Namespace, limits, nativeHistogram)
+	if err != nil {
+		return 0, fmt.Errorf("parse otlp metrics failure: %w", err)
+	}
+	defer w.statistics.UpdateSince(now)
+
+	if rows.Len() == 0 {
+		return rejected, nil
+	}
+	if err := w.deps.CM.Write(ctx, param.Database, rows); err != nil {
+		return 0, err
+	}
+	return rejected, nil
+}
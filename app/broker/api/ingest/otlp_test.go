@@ -0,0 +1,146 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ingest
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-http-utils/headers"
+	"github.com/lindb/common/pkg/ltoml"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+	"go.uber.org/mock/gomock"
+
+	"github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/coordinator/broker"
+	"github.com/lindb/lindb/internal/concurrent"
+	"github.com/lindb/lindb/internal/linmetric"
+	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/option"
+	"github.com/lindb/lindb/replica"
+)
+
+func newOTLPRequest(withCumulativeHistogram bool) pmetricotlp.ExportRequest {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr("service.name", "checkout")
+	ms := rm.ScopeMetrics().AppendEmpty().Metrics()
+	m := ms.AppendEmpty()
+	m.SetName("cpu")
+	m.SetEmptyGauge().DataPoints().AppendEmpty().SetDoubleValue(1)
+	if withCumulativeHistogram {
+		h := ms.AppendEmpty()
+		h.SetName("latency")
+		h.SetEmptyHistogram().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+		h.Histogram().DataPoints().AppendEmpty()
+	}
+	return pmetricotlp.NewExportRequestFromMetrics(md)
+}
+
+func TestOTLPWrite_Write(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cm := replica.NewMockChannelManager(ctrl)
+	stateMgr := broker.NewMockStateManager(ctrl)
+	stateMgr.EXPECT().GetDatabaseCfg("otlp").
+		Return(models.Database{Option: &option.DatabaseOption{NativeHistogram: true}}, true).AnyTimes()
+	limits := models.NewDefaultLimits()
+	limits.MaxNamespaceLength = 10
+	models.SetDatabaseLimits("otlp", limits)
+	api := NewOTLPWrite(&deps.HTTPDeps{
+		BrokerCfg: &config.Broker{
+			BrokerBase: config.BrokerBase{
+				Ingestion: config.Ingestion{
+					IngestTimeout: ltoml.Duration(time.Second * 2),
+				},
+			},
+		},
+		CM:       cm,
+		StateMgr: stateMgr,
+		IngestLimiter: concurrent.NewLimiter(
+			context.TODO(),
+			32,
+			time.Second,
+			metrics.NewLimitStatistics("otlp_write_test", linmetric.BrokerRegistry)),
+	})
+	r := gin.New()
+	api.Register(r)
+
+	protoHeader := make(http.Header)
+	protoHeader.Set(headers.ContentType, constants.ContentTypeOTLPProto)
+	jsonHeader := make(http.Header)
+	jsonHeader.Set(headers.ContentType, constants.ContentTypeJSON)
+	protoData, _ := newOTLPRequest(false).MarshalProto()
+	jsonData, _ := newOTLPRequest(true).MarshalJSON()
+
+	// missing db param
+	resp := mock.DoRequest(t, r, http.MethodPost, OTLPMetricsPath, string(protoData), protoHeader)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// enrich_tag bad format
+	resp = mock.DoRequest(t, r, http.MethodPost, OTLPMetricsPath+"?db=otlp&enrich_tag=a", string(protoData), protoHeader)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// namespace too long
+	resp = mock.DoRequest(t, r, http.MethodPost, OTLPMetricsPath+"?db=otlp&ns=namespace-1", string(protoData), protoHeader)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// parse err
+	resp = mock.DoRequest(t, r, http.MethodPost, OTLPMetricsPath+"?db=otlp", "error", protoHeader)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+
+	// write error
+	cm.EXPECT().Write(gomock.Any(), gomock.Any(), gomock.Any()).Return(io.ErrClosedPipe)
+	resp = mock.DoRequest(t, r, http.MethodPost, OTLPMetricsPath+"?db=otlp", string(protoData), protoHeader)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+
+	// write proto data
+	cm.EXPECT().Write(gomock.Any(), "otlp", gomock.Any()).Return(nil)
+	resp = mock.DoRequest(t, r, http.MethodPost, OTLPMetricsPath+"?db=otlp&enrich_tag=a=b", string(protoData), protoHeader)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, constants.ContentTypeOTLPProto, resp.Header().Get(headers.ContentType))
+	response := pmetricotlp.NewExportResponse()
+	assert.NoError(t, response.UnmarshalProto(resp.Body.Bytes()))
+	assert.Zero(t, response.PartialSuccess().RejectedDataPoints())
+
+	// write json data with rejected data points
+	cm.EXPECT().Write(gomock.Any(), "otlp", gomock.Any()).Return(nil)
+	resp = mock.DoRequest(t, r, http.MethodPost, OTLPMetricsPath+"?db=otlp", string(jsonData), jsonHeader)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, constants.ContentTypeJSON, resp.Header().Get(headers.ContentType))
+	response = pmetricotlp.NewExportResponse()
+	assert.NoError(t, response.UnmarshalJSON(resp.Body.Bytes()))
+	assert.Equal(t, int64(1), response.PartialSuccess().RejectedDataPoints())
+
+	// all data points rejected, no write
+	md := pmetric.NewMetrics()
+	h := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	h.SetName("latency")
+	h.SetEmptyHistogram().DataPoints().AppendEmpty()
+	rejectedData, _ := pmetricotlp.NewExportRequestFromMetrics(md).MarshalProto()
+	resp = mock.DoRequest(t, r, http.MethodPost, OTLPMetricsPath+"?db=otlp", string(rejectedData), protoHeader)
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
@@ -28,21 +28,24 @@ import (
 )
 
 var (
-	createTokenFn = middleware.CreateToken
-	LoginPath     = "/login"
+	createTokenFn     = middleware.CreateToken
+	createUserTokenFn = middleware.CreateUserToken
+	LoginPath         = "/login"
 )
 
 // LoginAPI represents login param
 type LoginAPI struct {
-	user config.User
+	user  config.User
+	store middleware.AuthStore
 
 	logger logger.Logger
 }
 
 // NewLoginAPI creates login api instance
-func NewLoginAPI(user config.User) *LoginAPI {
+func NewLoginAPI(user config.User, store middleware.AuthStore) *LoginAPI {
 	return &LoginAPI{
 		user:   user,
+		store:  store,
 		logger: logger.GetLogger("Broker", "LoginAPI"),
 	}
 }
@@ -63,10 +66,9 @@ func (l *LoginAPI) Login(c *gin.Context) {
 		http.OK(c, "")
 		return
 	}
-	// user name is error
+	// user name is not admin, try login with user of state repo
 	if l.user.UserName != user.UserName {
-		l.logger.Error("username is invalid")
-		http.OK(c, "")
+		l.loginUser(c, user)
 		return
 	}
 	// password is error
@@ -82,3 +84,24 @@ func (l *LoginAPI) Login(c *gin.Context) {
 	}
 	http.OK(c, token)
 }
+
+// loginUser responses token of user which stored in state repo.
+func (l *LoginAPI) loginUser(c *gin.Context, user config.User) {
+	u, ok := l.store.GetUser(user.UserName)
+	if !ok {
+		l.logger.Error("username is invalid")
+		http.OK(c, "")
+		return
+	}
+	if !u.CheckPassword(user.Password) {
+		l.logger.Error("password is invalid")
+		http.OK(c, "")
+		return
+	}
+	token, err := createUserTokenFn(u.Name, l.user)
+	if err != nil {
+		http.OK(c, "")
+		return
+	}
+	http.OK(c, token)
+}
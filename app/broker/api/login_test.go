@@ -28,6 +28,7 @@ import (
 
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/models"
 	httppkg "github.com/lindb/lindb/pkg/http/middleware"
 )
 
@@ -35,11 +36,14 @@ func TestLogin(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer func() {
 		createTokenFn = httppkg.CreateToken
+		createUserTokenFn = httppkg.CreateUserToken
 		ctrl.Finish()
 	}()
 
 	user := config.User{UserName: "admin", Password: "admin123"}
-	api := NewLoginAPI(user)
+	store := httppkg.NewMockAuthStore(ctrl)
+	store.EXPECT().GetUser(gomock.Any()).Return(nil, false).AnyTimes()
+	api := NewLoginAPI(user, store)
 	r := gin.New()
 	api.Register(r)
 
@@ -78,3 +82,34 @@ func TestLogin(t *testing.T) {
 	resp = mock.DoRequest(t, r, http.MethodPut, LoginPath, `{"username": "admin", "password": "admin123"}`)
 	assert.Equal(t, http.StatusOK, resp.Code)
 }
+
+func TestLogin_User(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		createUserTokenFn = httppkg.CreateUserToken
+		ctrl.Finish()
+	}()
+
+	store := httppkg.NewMockAuthStore(ctrl)
+	user := &models.User{Name: "bob", Password: "pwd"}
+	assert.NoError(t, user.HashPassword())
+	store.EXPECT().GetUser("bob").Return(user, true).AnyTimes()
+	api := NewLoginAPI(config.User{UserName: "admin", Password: "admin123"}, store)
+	r := gin.New()
+	api.Register(r)
+
+	// password is invalid
+	resp := mock.DoRequest(t, r, http.MethodPut, LoginPath, `{"username": "bob", "password": "wrong"}`)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, `""`, resp.Body.String())
+	// token create fail
+	createUserTokenFn = func(_ string, _ config.User) (string, error) {
+		return "", fmt.Errorf("err")
+	}
+	resp = mock.DoRequest(t, r, http.MethodPut, LoginPath, `{"username": "bob", "password": "pwd"}`)
+	assert.Equal(t, `""`, resp.Body.String())
+	// token create ok
+	createUserTokenFn = httppkg.CreateUserToken
+	resp = mock.DoRequest(t, r, http.MethodPut, LoginPath, `{"username": "bob", "password": "pwd"}`)
+	assert.NotEqual(t, `""`, resp.Body.String())
+}
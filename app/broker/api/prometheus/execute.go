@@ -58,12 +58,13 @@ const (
 	executeMetadataPath        = "/metadata"
 	executeSeriesPath          = "/series"
 	executeWritePath           = "/write"
+	executeReadPath            = "/read"
 )
 
 // ExecuteAPI wraps all Prometheus APIs.
 type ExecuteAPI struct {
 	prometheusWriter prometheusIngest.Writer
-	queryable        storage.Queryable
+	queryable        storage.SampleAndChunkQueryable
 	logger           logger.Logger
 	deps             *depspkg.HTTPDeps
 	engine           *promql.Engine
@@ -117,6 +118,9 @@ func (e *ExecuteAPI) Register(route gin.IRoutes) {
 
 	// remote write
 	route.POST(executeWritePath, e.remoteWrite)
+
+	// remote read
+	route.POST(executeReadPath, e.remoteRead)
 }
 
 // waitPrometheusWriteErr watch write error
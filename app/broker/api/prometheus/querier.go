@@ -37,19 +37,45 @@ import (
 	"github.com/prometheus/prometheus/util/annotations"
 )
 
-// Queryable is implementation of storage.Queryable of Prometheus.
+// for testing
+var (
+	metricMetadataCommandFn = command.MetricMetadataCommand
+	queryCommandFn          = command.QueryCommand
+)
+
+// Queryable is implementation of storage.Queryable/storage.ChunkQueryable of Prometheus.
 type Queryable struct {
-	deps *depspkg.HTTPDeps
+	deps     *depspkg.HTTPDeps
+	database string
 }
 
-func NewQueryable(deps *depspkg.HTTPDeps) storage.Queryable {
+func NewQueryable(deps *depspkg.HTTPDeps) storage.SampleAndChunkQueryable {
 	return &Queryable{deps: deps}
 }
 
+// NewDatabaseQueryable creates a queryable which queries the given database,
+// queries the database of Prometheus config if database is empty.
+func NewDatabaseQueryable(deps *depspkg.HTTPDeps, database string) storage.SampleAndChunkQueryable {
+	return &Queryable{deps: deps, database: database}
+}
+
+// getDatabase returns the database which is queried.
+func (q *Queryable) getDatabase() string {
+	if q.database != "" {
+		return q.database
+	}
+	return q.deps.BrokerCfg.Prometheus.Database
+}
+
 func (q *Queryable) Querier(mint, maxt int64) (storage.Querier, error) {
 	return newQuerier(mint, maxt, q), nil
 }
 
+// ChunkQuerier returns a chunk querier which encodes samples queried by Querier into chunks.
+func (q *Queryable) ChunkQuerier(mint, maxt int64) (storage.ChunkQuerier, error) {
+	return newChunkQuerier(newQuerier(mint, maxt, q)), nil
+}
+
 // Querier is implementation of storage.Querier of Prometheus.
 type Querier struct {
 	queryable *Queryable
@@ -79,7 +105,7 @@ func (q *Querier) metadata(ctx context.Context, metric string, metadataType stmt
 	}
 
 	param := &models.ExecuteParam{
-		Database: q.queryable.deps.BrokerCfg.Prometheus.Database,
+		Database: q.queryable.getDatabase(),
 		SQL:      sql,
 	}
 	stmt := &stmtpkg.MetricMetadata{
@@ -88,7 +114,7 @@ func (q *Querier) metadata(ctx context.Context, metric string, metadataType stmt
 		MetricName: metric,
 	}
 
-	result, err := command.MetricMetadataCommand(ctx, q.queryable.deps, param, stmt)
+	result, err := metricMetadataCommandFn(ctx, q.queryable.deps, param, stmt)
 	if err != nil {
 		return nil, err
 	}
@@ -132,6 +158,9 @@ func (q *Querier) Close() error {
 
 // query time series data using the method in LinDB.
 func (q *Querier) query(ctx context.Context, hints *storage.SelectHints, matchers ...*labels.Matcher) (result any, err error) {
+	if hints == nil {
+		hints = &storage.SelectHints{Start: q.mint, End: q.maxt}
+	}
 	metric, condition := makeCondition(matchers...)
 	if metric == "" {
 		return nil, errors.New("metric name does not exist")
@@ -143,7 +172,7 @@ func (q *Querier) query(ctx context.Context, hints *storage.SelectHints, matcher
 	}
 
 	param := &models.ExecuteParam{
-		Database: q.queryable.deps.BrokerCfg.Prometheus.Database,
+		Database: q.queryable.getDatabase(),
 	}
 
 	stmt := &stmtpkg.Query{
@@ -152,8 +181,10 @@ func (q *Querier) query(ctx context.Context, hints *storage.SelectHints, matcher
 		AllFields:  true,
 		Condition:  condition,
 		TimeRange:  timeutil.TimeRange{Start: hints.Start, End: hints.End},
-		// let LinDB calculate the interval
-		Interval: 0,
+		// query with step of hints if set, else with the smallest storage interval of database(raw samples),
+		// avoid LinDB down sampling based on query time range.
+		Interval:      timeutil.Interval(hints.Step),
+		FixedInterval: true,
 		// the logic of LinDB is that if any tag does not exist, the result is empty.
 		// if some data has already been persisted and new tag value pair are added later,
 		// there are some issues with this approach.
@@ -162,7 +193,7 @@ func (q *Querier) query(ctx context.Context, hints *storage.SelectHints, matcher
 		Limit:   1e5,
 	}
 
-	return command.QueryCommand(ctx, q.queryable.deps, param, stmt)
+	return queryCommandFn(ctx, q.queryable.deps, param, stmt)
 }
 
 // Select calls the query method to retrieve data and transforms the results into the SeriesSet.
@@ -192,7 +223,7 @@ func (q *Querier) Select(ctx context.Context, sortSeries bool, hints *storage.Se
 			lbs = append(lbs, k, v)
 		}
 		var points []promql.FPoint
-		if hints.Func != "series" {
+		if hints == nil || hints.Func != "series" {
 			points = make([]promql.FPoint, 0, len(s.Fields[field]))
 			for t, f := range s.Fields[field] {
 				fp := promql.FPoint{
@@ -204,9 +235,6 @@ func (q *Querier) Select(ctx context.Context, sortSeries bool, hints *storage.Se
 			sort.Slice(points, func(i, j int) bool {
 				return points[i].T < points[j].T
 			})
-			if sortSeries {
-				sort.Strings(lbs)
-			}
 		}
 		one := promql.NewStorageSeries(promql.Series{
 			Metric:     labels.FromStrings(lbs...),
@@ -215,8 +243,28 @@ func (q *Querier) Select(ctx context.Context, sortSeries bool, hints *storage.Se
 		})
 		seriesSlice = append(seriesSlice, one)
 	}
+	if sortSeries {
+		sort.Slice(seriesSlice, func(i, j int) bool {
+			return labels.Compare(seriesSlice[i].Labels(), seriesSlice[j].Labels()) < 0
+		})
+	}
 
 	set.setSeries(seriesSlice)
 
 	return set
 }
+
+// chunkQuerier is implementation of storage.ChunkQuerier of Prometheus,
+// encodes the samples of series selected by storage.Querier into XOR chunks.
+type chunkQuerier struct {
+	storage.Querier
+}
+
+func newChunkQuerier(querier storage.Querier) storage.ChunkQuerier {
+	return &chunkQuerier{Querier: querier}
+}
+
+// Select returns chunk series set which encodes samples of series set into chunks.
+func (q *chunkQuerier) Select(ctx context.Context, sortSeries bool, hints *storage.SelectHints, matchers ...*labels.Matcher) storage.ChunkSeriesSet {
+	return storage.NewSeriesSetToChunkSet(q.Querier.Select(ctx, sortSeries, hints, matchers...))
+}
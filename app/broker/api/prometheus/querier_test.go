@@ -18,11 +18,21 @@
 package prometheus
 
 import (
+	"context"
 	"testing"
 
+	"github.com/lindb/lindb/app/broker/api/exec/command"
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/timeutil"
 	stmtpkg "github.com/lindb/lindb/sql/stmt"
 
+	commonmodels "github.com/lindb/common/models"
+	commontimeutil "github.com/lindb/common/pkg/timeutil"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/storage"
+	"github.com/prometheus/prometheus/tsdb/chunkenc"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -121,6 +131,13 @@ func TestIterateMatcher(t *testing.T) {
 	assert.Equal(t, root3, expected3)
 }
 
+func TestQueryable_getDatabase(t *testing.T) {
+	deps := &depspkg.HTTPDeps{BrokerCfg: &config.Broker{Prometheus: config.Prometheus{Database: "prom"}}}
+	assert.Equal(t, "prom", NewQueryable(deps).(*Queryable).getDatabase())
+	assert.Equal(t, "prom", NewDatabaseQueryable(deps, "").(*Queryable).getDatabase())
+	assert.Equal(t, "test", NewDatabaseQueryable(deps, "test").(*Queryable).getDatabase())
+}
+
 func TestMakeCondition(t *testing.T) {
 	a := &labels.Matcher{
 		Type:  labels.MatchEqual,
@@ -228,3 +245,60 @@ func TestMakeCondition(t *testing.T) {
 	assert.Equal(t, me, metric.Value)
 	assert.Equal(t, expr, expected4)
 }
+
+func TestQuerier_Select_Interval(t *testing.T) {
+	defer func() {
+		metricMetadataCommandFn = command.MetricMetadataCommand
+		queryCommandFn = command.QueryCommand
+	}()
+	metricMetadataCommandFn = func(_ context.Context, _ *depspkg.HTTPDeps,
+		_ *models.ExecuteParam, _ stmtpkg.Statement) (any, error) {
+		return &commonmodels.Metadata{Values: []string{"host"}}, nil
+	}
+	now := timeutil.Truncate(commontimeutil.Now(), commontimeutil.OneHour)
+	end := now + 6*commontimeutil.OneHour
+	var query *stmtpkg.Query
+	queryCommandFn = func(_ context.Context, _ *depspkg.HTTPDeps,
+		_ *models.ExecuteParam, stmt stmtpkg.Statement) (any, error) {
+		query = stmt.(*stmtpkg.Query)
+		interval := query.Interval.Int64()
+		if interval == 0 {
+			// smallest storage interval of database
+			interval = 10 * commontimeutil.OneSecond
+		}
+		fields := make(map[int64]float64)
+		for ts := query.TimeRange.Start; ts <= query.TimeRange.End; ts += interval {
+			fields[ts] = 1
+		}
+		return &commonmodels.ResultSet{
+			MetricName: "cpu",
+			Fields:     []string{"f"},
+			Series: []*commonmodels.Series{{
+				Tags:   map[string]string{"host": "a"},
+				Fields: map[string]map[int64]float64{"f": fields},
+			}},
+		}, nil
+	}
+	deps := &depspkg.HTTPDeps{BrokerCfg: &config.Broker{Prometheus: config.Prometheus{Database: "prom"}}}
+	q := newQuerier(now, end, NewQueryable(deps).(*Queryable))
+	matcher := labels.MustNewMatcher(labels.MatchEqual, metricLabelName, "cpu")
+
+	// raw range, no down sampling
+	set := q.Select(context.TODO(), false, &storage.SelectHints{Start: now, End: end}, matcher)
+	assert.True(t, query.FixedInterval)
+	assert.Equal(t, timeutil.Interval(0), query.Interval)
+	assert.True(t, set.Next())
+	samples := 0
+	it := set.At().Iterator(nil)
+	for it.Next() != chunkenc.ValNone {
+		samples++
+	}
+	assert.Equal(t, 6*360+1, samples)
+
+	// step of hints
+	set = q.Select(context.TODO(), false, &storage.SelectHints{Start: now, End: end, Step: commontimeutil.OneMinute}, matcher)
+	assert.True(t, query.FixedInterval)
+	assert.Equal(t, timeutil.Interval(commontimeutil.OneMinute), query.Interval)
+	assert.True(t, set.Next())
+	assert.NoError(t, set.Err())
+}
@@ -0,0 +1,197 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheus
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lindb/common/pkg/logger"
+	"github.com/prometheus/prometheus/prompb"
+	"github.com/prometheus/prometheus/storage"
+	"github.com/prometheus/prometheus/storage/remote"
+	"github.com/prometheus/prometheus/util/annotations"
+)
+
+const (
+	// remoteReadSampleLimit represents the max number of samples of one query in SAMPLES response.
+	remoteReadSampleLimit = 5e7
+	// remoteReadMaxBytesInFrame represents the max size of one frame in STREAMED_XOR_CHUNKS response.
+	remoteReadMaxBytesInFrame = 1024 * 1024
+)
+
+// chunkedResponsePool caches marshal buffers of streamed chunked read responses.
+var chunkedResponsePool = &sync.Pool{}
+
+// remoteRead implements a remote read interface similar to Prometheus,
+// supports SAMPLES and STREAMED_XOR_CHUNKS response types.
+func (e *ExecuteAPI) remoteRead(c *gin.Context) {
+	if err := e.deps.QueryLimiter.Do(func() error {
+		e.read(c.Request.Context(), c.Writer, c.Request)
+		return nil
+	}); err != nil {
+		http.Error(c.Writer, err.Error(), http.StatusServiceUnavailable)
+	}
+}
+
+// read decodes the snappy-compressed remote read request, then responds based on negotiated response type.
+func (e *ExecuteAPI) read(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	req, err := remote.DecodeReadRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	responseType, err := remote.NegotiateResponseType(req.AcceptedResponseTypes)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	switch responseType {
+	case prompb.ReadRequest_STREAMED_XOR_CHUNKS:
+		e.readStreamedXORChunks(ctx, w, req)
+	default:
+		// on empty or unknown types in req.AcceptedResponseTypes, default to non streamed, raw samples response.
+		e.readSamples(ctx, w, req)
+	}
+}
+
+// readSamples responds all queries' samples in one snappy-compressed read response.
+func (e *ExecuteAPI) readSamples(ctx context.Context, w http.ResponseWriter, req *prompb.ReadRequest) {
+	w.Header().Set("Content-Type", "application/x-protobuf")
+	w.Header().Set("Content-Encoding", "snappy")
+
+	resp := prompb.ReadResponse{
+		Results: make([]*prompb.QueryResult, len(req.Queries)),
+	}
+	for i, query := range req.Queries {
+		if err := func() error {
+			matchers, err := remote.FromLabelMatchers(query.Matchers)
+			if err != nil {
+				return err
+			}
+			querier, err := e.queryable.Querier(query.StartTimestampMs, query.EndTimestampMs)
+			if err != nil {
+				return err
+			}
+			defer e.closeQuerier(querier)
+
+			var ws annotations.Annotations
+			resp.Results[i], ws, err = remote.ToQueryResult(
+				querier.Select(ctx, false, selectHints(query), matchers...), remoteReadSampleLimit)
+			if err != nil {
+				return err
+			}
+			e.logWarnings(ws)
+			return nil
+		}(); err != nil {
+			readError(w, err)
+			return
+		}
+	}
+	if err := remote.EncodeReadResponse(&resp, w); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// readStreamedXORChunks streams the series of queries as XOR chunks, one frame per chunked read response.
+func (e *ExecuteAPI) readStreamedXORChunks(ctx context.Context, w http.ResponseWriter, req *prompb.ReadRequest) {
+	w.Header().Set("Content-Type", "application/x-streamed-protobuf; proto=prometheus.ChunkedReadResponse")
+
+	f, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "internal http.ResponseWriter does not implement http.Flusher interface", http.StatusInternalServerError)
+		return
+	}
+	for i, query := range req.Queries {
+		if err := func() error {
+			matchers, err := remote.FromLabelMatchers(query.Matchers)
+			if err != nil {
+				return err
+			}
+			querier, err := e.queryable.ChunkQuerier(query.StartTimestampMs, query.EndTimestampMs)
+			if err != nil {
+				return err
+			}
+			defer e.closeQuerier(querier)
+
+			ws, err := remote.StreamChunkedReadResponses(
+				remote.NewChunkedWriter(w, f),
+				int64(i),
+				// the streaming API has to provide the series sorted.
+				querier.Select(ctx, true, selectHints(query), matchers...),
+				nil,
+				remoteReadMaxBytesInFrame,
+				chunkedResponsePool,
+			)
+			if err != nil {
+				return err
+			}
+			e.logWarnings(ws)
+			return nil
+		}(); err != nil {
+			readError(w, err)
+			return
+		}
+	}
+}
+
+// closeQuerier closes the querier, logs warning if failure.
+func (e *ExecuteAPI) closeQuerier(querier storage.LabelQuerier) {
+	if err := querier.Close(); err != nil {
+		e.logger.Warn("close querier failure when remote read", logger.Error(err))
+	}
+}
+
+// logWarnings logs the warnings of remote read query.
+func (e *ExecuteAPI) logWarnings(ws annotations.Annotations) {
+	for _, w := range ws {
+		e.logger.Warn("warnings on remote read query", logger.Error(w))
+	}
+}
+
+// selectHints returns the select hints of remote read query, uses time range of query if not set.
+func selectHints(query *prompb.Query) *storage.SelectHints {
+	if query.Hints == nil {
+		return &storage.SelectHints{
+			Start: query.StartTimestampMs,
+			End:   query.EndTimestampMs,
+		}
+	}
+	return &storage.SelectHints{
+		Start:    query.Hints.StartMs,
+		End:      query.Hints.EndMs,
+		Step:     query.Hints.StepMs,
+		Func:     query.Hints.Func,
+		Grouping: query.Hints.Grouping,
+		Range:    query.Hints.RangeMs,
+		By:       query.Hints.By,
+	}
+}
+
+// readError responds the error of remote read, uses the status of remote.HTTPError if possible.
+func readError(w http.ResponseWriter, err error) {
+	var httpErr remote.HTTPError
+	if errors.As(err, &httpErr) {
+		http.Error(w, httpErr.Error(), httpErr.Status())
+		return
+	}
+	http.Error(w, err.Error(), http.StatusInternalServerError)
+}
@@ -0,0 +1,227 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheus
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/snappy"
+	"github.com/lindb/common/pkg/logger"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/prompb"
+	"github.com/prometheus/prometheus/promql"
+	"github.com/prometheus/prometheus/storage"
+	"github.com/prometheus/prometheus/storage/remote"
+	"github.com/prometheus/prometheus/tsdb/chunkenc"
+	"github.com/stretchr/testify/assert"
+
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/internal/concurrent"
+	"github.com/lindb/lindb/internal/linmetric"
+	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/metrics"
+)
+
+// mockQueryable returns the fixed series for testing.
+type mockQueryable struct {
+	series []storage.Series
+	err    error
+}
+
+func (q *mockQueryable) Querier(_, _ int64) (storage.Querier, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	return &mockQuerier{Querier: storage.NoopQuerier(), series: q.series}, nil
+}
+
+func (q *mockQueryable) ChunkQuerier(mint, maxt int64) (storage.ChunkQuerier, error) {
+	querier, err := q.Querier(mint, maxt)
+	if err != nil {
+		return nil, err
+	}
+	return newChunkQuerier(querier), nil
+}
+
+type mockQuerier struct {
+	storage.Querier
+	series []storage.Series
+}
+
+func (q *mockQuerier) Select(_ context.Context, sortSeries bool, _ *storage.SelectHints, matchers ...*labels.Matcher) storage.SeriesSet {
+	set := newSeriesSet()
+	var rs []storage.Series
+	for _, s := range q.series {
+		matched := true
+		for _, m := range matchers {
+			if !m.Matches(s.Labels().Get(m.Name)) {
+				matched = false
+			}
+		}
+		if matched {
+			rs = append(rs, s)
+		}
+	}
+	if sortSeries {
+		sort.Slice(rs, func(i, j int) bool {
+			return labels.Compare(rs[i].Labels(), rs[j].Labels()) < 0
+		})
+	}
+	set.setSeries(rs)
+	return set
+}
+
+func newTestReadAPI(queryable storage.SampleAndChunkQueryable) *gin.Engine {
+	api := &ExecuteAPI{
+		deps: &depspkg.HTTPDeps{
+			QueryLimiter: concurrent.NewLimiter(
+				context.TODO(),
+				32,
+				time.Second,
+				metrics.NewLimitStatistics("remote_read_test", linmetric.BrokerRegistry)),
+		},
+		queryable: queryable,
+		logger:    logger.GetLogger("Test", "RemoteRead"),
+	}
+	r := gin.New()
+	r.POST(executeReadPath, api.remoteRead)
+	return r
+}
+
+func newTestSeries() []storage.Series {
+	return []storage.Series{
+		promql.NewStorageSeries(promql.Series{
+			Metric: labels.FromStrings(metricLabelName, "cpu", "host", "b"),
+			Floats: []promql.FPoint{{T: 1000, F: 1}, {T: 2000, F: 2}},
+		}),
+		promql.NewStorageSeries(promql.Series{
+			Metric: labels.FromStrings(metricLabelName, "cpu", "host", "a"),
+			Floats: []promql.FPoint{{T: 1000, F: 3}},
+		}),
+	}
+}
+
+func encodeReadRequest(t *testing.T, responseTypes ...prompb.ReadRequest_ResponseType) string {
+	req := &prompb.ReadRequest{
+		Queries: []*prompb.Query{{
+			StartTimestampMs: 0,
+			EndTimestampMs:   3000,
+			Matchers: []*prompb.LabelMatcher{
+				{Type: prompb.LabelMatcher_EQ, Name: metricLabelName, Value: "cpu"},
+			},
+		}},
+		AcceptedResponseTypes: responseTypes,
+	}
+	data, err := req.Marshal()
+	assert.NoError(t, err)
+	return string(snappy.Encode(nil, data))
+}
+
+func TestExecuteAPI_remoteRead_samples(t *testing.T) {
+	r := newTestReadAPI(&mockQueryable{series: newTestSeries()})
+
+	resp := mock.DoRequest(t, r, http.MethodPost, executeReadPath, encodeReadRequest(t, prompb.ReadRequest_SAMPLES))
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "snappy", resp.Header().Get("Content-Encoding"))
+	data, err := snappy.Decode(nil, resp.Body.Bytes())
+	assert.NoError(t, err)
+	var readResp prompb.ReadResponse
+	assert.NoError(t, readResp.Unmarshal(data))
+	assert.Len(t, readResp.Results, 1)
+	assert.Len(t, readResp.Results[0].Timeseries, 2)
+	total := 0
+	for _, ts := range readResp.Results[0].Timeseries {
+		total += len(ts.Samples)
+	}
+	assert.Equal(t, 3, total)
+}
+
+func TestExecuteAPI_remoteRead_streamedXORChunks(t *testing.T) {
+	r := newTestReadAPI(&mockQueryable{series: newTestSeries()})
+
+	resp := mock.DoRequest(t, r, http.MethodPost, executeReadPath,
+		encodeReadRequest(t, prompb.ReadRequest_STREAMED_XOR_CHUNKS))
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "application/x-streamed-protobuf; proto=prometheus.ChunkedReadResponse",
+		resp.Header().Get("Content-Type"))
+
+	reader := remote.NewChunkedReader(resp.Body, remoteReadMaxBytesInFrame, nil)
+	var hosts []string
+	var values []float64
+	for {
+		var chunkedResp prompb.ChunkedReadResponse
+		err := reader.NextProto(&chunkedResp)
+		if err == io.EOF {
+			break
+		}
+		assert.NoError(t, err)
+		for _, s := range chunkedResp.ChunkedSeries {
+			for _, l := range s.Labels {
+				if l.Name == "host" {
+					hosts = append(hosts, l.Value)
+				}
+			}
+			for _, c := range s.Chunks {
+				assert.Equal(t, prompb.Chunk_XOR, c.Type)
+				chunk, err := chunkenc.FromData(chunkenc.EncXOR, c.Data)
+				assert.NoError(t, err)
+				it := chunk.Iterator(nil)
+				for it.Next() == chunkenc.ValFloat {
+					_, v := it.At()
+					values = append(values, v)
+				}
+			}
+		}
+	}
+	// series are sorted by labels
+	assert.Equal(t, []string{"a", "b"}, hosts)
+	assert.Equal(t, []float64{3, 1, 2}, values)
+}
+
+func TestExecuteAPI_remoteRead_error(t *testing.T) {
+	r := newTestReadAPI(&mockQueryable{err: fmt.Errorf("err")})
+	// bad request
+	resp := mock.DoRequest(t, r, http.MethodPost, executeReadPath, "bad-request")
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	// unsupported response type
+	resp = mock.DoRequest(t, r, http.MethodPost, executeReadPath, encodeReadRequest(t, prompb.ReadRequest_ResponseType(10)))
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	// query failure
+	resp = mock.DoRequest(t, r, http.MethodPost, executeReadPath, encodeReadRequest(t))
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	resp = mock.DoRequest(t, r, http.MethodPost, executeReadPath, encodeReadRequest(t, prompb.ReadRequest_STREAMED_XOR_CHUNKS))
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+}
+
+func TestSelectHints(t *testing.T) {
+	hints := selectHints(&prompb.Query{StartTimestampMs: 10, EndTimestampMs: 20})
+	assert.Equal(t, &storage.SelectHints{Start: 10, End: 20}, hints)
+	hints = selectHints(&prompb.Query{
+		StartTimestampMs: 10,
+		EndTimestampMs:   20,
+		Hints:            &prompb.ReadHints{StartMs: 5, EndMs: 20, StepMs: 1, Func: "rate", RangeMs: 5},
+	})
+	assert.Equal(t, &storage.SelectHints{Start: 5, End: 20, Step: 1, Func: "rate", Range: 5}, hints)
+}
@@ -18,6 +18,9 @@
 package api
 
 import (
+	"net/http"
+	"strings"
+
 	"github.com/gin-gonic/gin"
 
 	commonlogger "github.com/lindb/common/pkg/logger"
@@ -32,7 +35,9 @@ import (
 	"github.com/lindb/lindb/constants"
 	apipkg "github.com/lindb/lindb/internal/api"
 	"github.com/lindb/lindb/internal/linmetric"
+	"github.com/lindb/lindb/models"
 	httppkg "github.com/lindb/lindb/pkg/http"
+	"github.com/lindb/lindb/pkg/http/middleware"
 	"github.com/lindb/lindb/pkg/logger"
 )
 
@@ -40,10 +45,17 @@ import (
 type API struct {
 	deps             *depspkg.HTTPDeps
 	prometheusWriter prometheusIngest.Writer
+	auth             *middleware.Authorization
 
+	login              *LoginAPI
 	execute            *exec.ExecuteAPI
 	database           *admin.DatabaseAPI
 	flusher            *admin.DatabaseFlusherAPI
+	dataExport         *admin.DataExportAPI
+	alertRule          *admin.AlertRuleAPI
+	user               *admin.UserAPI
+	role               *admin.RoleAPI
+	apiToken           *admin.APITokenAPI
 	brokerStateMachine *state.BrokerStateMachineAPI
 	request            *apipkg.RequestAPI
 	metricExplore      *apipkg.ExploreAPI
@@ -51,6 +63,7 @@ type API struct {
 	config             *apipkg.ConfigAPI
 	env                *apipkg.EnvAPI
 	write              *ingest.Write
+	otlpWrite          *ingest.OTLPWrite
 	proxy              *httppkg.ReverseProxy
 	prometheusExecute  *prometheus.ExecuteAPI
 }
@@ -60,9 +73,16 @@ func NewAPI(deps *depspkg.HTTPDeps, prometheusWriter prometheusIngest.Writer) *A
 	return &API{
 		deps:               deps,
 		prometheusWriter:   prometheusWriter,
+		auth:               middleware.NewAuthorization(deps.BrokerCfg.BrokerBase.Auth, deps.StateMgr),
+		login:              NewLoginAPI(deps.BrokerCfg.BrokerBase.Auth.Admin, deps.StateMgr),
 		execute:            exec.NewExecuteAPI(deps),
 		database:           admin.NewDatabaseAPI(deps),
 		flusher:            admin.NewDatabaseFlusherAPI(deps),
+		dataExport:         admin.NewDataExportAPI(deps),
+		alertRule:          admin.NewAlertRuleAPI(deps),
+		user:               admin.NewUserAPI(deps),
+		role:               admin.NewRoleAPI(deps),
+		apiToken:           admin.NewAPITokenAPI(deps),
 		brokerStateMachine: state.NewBrokerStateMachineAPI(deps),
 		request:            apipkg.NewRequestAPI(),
 		metricExplore:      apipkg.NewExploreAPI(deps.GlobalKeyValues, linmetric.BrokerRegistry),
@@ -70,6 +90,7 @@ func NewAPI(deps *depspkg.HTTPDeps, prometheusWriter prometheusIngest.Writer) *A
 		config:             apipkg.NewConfigAPI(deps.Node, deps.BrokerCfg),
 		env:                apipkg.NewEnvAPI(deps.BrokerCfg.Monitor, constants.BrokerRole),
 		write:              ingest.NewWrite(deps),
+		otlpWrite:          ingest.NewOTLPWrite(deps),
 		proxy:              httppkg.NewReverseProxy(),
 		prometheusExecute:  prometheus.NewExecuteAPI(deps, prometheusWriter),
 	}
@@ -79,31 +100,57 @@ func NewAPI(deps *depspkg.HTTPDeps, prometheusWriter prometheusIngest.Writer) *A
 func (api *API) RegisterRouter(router *gin.RouterGroup) {
 	router.Use(SlowSQLLog(api.deps, commonlogger.GetLogger(logger.SlowSQLModule, "SQL")))
 	v1 := router.Group(constants.APIVersion1)
-	// execute lin query language statement
-	api.execute.Register(v1)
+	// login without authentication
+	api.login.Register(v1)
+
+	authenticated := v1.Group("", api.auth.Authenticate())
+	// execute lin query language statement, check permission based on statement
+	api.execute.Register(authenticated)
+	api.apiToken.Register(authenticated)
 
-	api.database.Register(v1)
-	api.flusher.Register(v1)
+	// cluster admin operations
+	clusterAdmin := authenticated.Group("", api.auth.RequirePermission(models.AllDatabases, models.AdminPermission))
+	api.database.Register(clusterAdmin)
+	api.flusher.Register(clusterAdmin)
+	api.dataExport.Register(clusterAdmin)
+	api.alertRule.Register(clusterAdmin)
+	api.user.Register(clusterAdmin)
+	api.role.Register(clusterAdmin)
 
 	// state
-	api.brokerStateMachine.Register(v1)
-	api.request.Register(v1)
+	api.brokerStateMachine.Register(authenticated)
+	api.request.Register(authenticated)
 
 	// write metric data
-	api.write.Register(v1)
+	writer := authenticated.Group("", api.auth.RequirePermissionFunc(func(c *gin.Context) (string, models.Permission) {
+		return c.Query("db"), models.WritePermission
+	}))
+	api.write.Register(writer)
+	api.otlpWrite.Register(writer)
 
 	// monitoring
-	api.metricExplore.Register(v1)
-	api.log.Register(v1)
-	api.config.Register(v1)
+	api.metricExplore.Register(authenticated)
+	api.log.Register(authenticated)
+	api.config.Register(clusterAdmin)
 
-	api.env.Register(v1)
-	api.proxy.Register(v1)
+	api.env.Register(authenticated)
+	api.proxy.Register(clusterAdmin)
 }
 
 // RegisterPrometheusRouter registers prometheus http api router.
 func (api *API) RegisterPrometheusRouter(router *gin.RouterGroup) {
 	router.Use(SlowSQLLog(api.deps, commonlogger.GetLogger(logger.SlowSQLModule, "SQL")))
+	router.Use(api.auth.Authenticate(), api.auth.RequirePermissionFunc(api.prometheusPermission))
 	// execute promql statement
 	api.prometheusExecute.Register(router.Group(constants.APIVersion1CliPath))
 }
+
+// prometheusPermission returns the permission of prometheus database which request requires,
+// remote write requires write permission, others require read permission.
+func (api *API) prometheusPermission(c *gin.Context) (string, models.Permission) {
+	database := api.deps.BrokerCfg.Prometheus.Database
+	if c.Request.Method == http.MethodPost && strings.HasSuffix(c.FullPath(), "/write") {
+		return database, models.WritePermission
+	}
+	return database, models.ReadPermission
+}
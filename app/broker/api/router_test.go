@@ -18,14 +18,18 @@
 package api
 
 import (
+	"net/http"
 	"testing"
 
 	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
 
 	prometheusIngest "github.com/lindb/lindb/app/broker/api/prometheus/ingest"
 	"github.com/lindb/lindb/app/broker/deps"
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/models"
 )
 
 func TestNewRouter(t *testing.T) {
@@ -40,3 +44,54 @@ func TestNewRouter(t *testing.T) {
 	r := NewAPI(httpDeps, prometheusWriter)
 	r.RegisterRouter(gin.New().Group(constants.APIRoot))
 }
+
+func TestRouter_Auth(t *testing.T) {
+	brokerCfg := &config.Broker{
+		BrokerBase: config.BrokerBase{Auth: config.Auth{
+			Enabled: true,
+			Admin:   config.User{UserName: "admin", Password: "admin123"},
+		}},
+		Prometheus: config.Prometheus{Database: "prom"},
+	}
+	httpDeps := &deps.HTTPDeps{BrokerCfg: brokerCfg}
+	prometheusWriter := prometheusIngest.NewWriter(httpDeps, prometheusIngest.DefaultWriteOptions(prometheusIngest.DatabaseConfig{}))
+	r := NewAPI(httpDeps, prometheusWriter)
+	engine := gin.New()
+	r.RegisterRouter(engine.Group(constants.APIRoot))
+	r.RegisterPrometheusRouter(engine.Group(constants.APIPrometheus))
+
+	// without credential
+	resp := mock.DoRequest(t, engine, http.MethodPut, constants.APIRoot+constants.APIVersion1+"/exec", `{"sql":"show master"}`)
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	resp = mock.DoRequest(t, engine, http.MethodPost,
+		constants.APIPrometheus+constants.APIVersion1CliPath+"/write", "")
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	// login without authentication
+	resp = mock.DoRequest(t, engine, http.MethodPut, constants.APIRoot+constants.APIVersion1+LoginPath,
+		`{"username":"admin","password":"admin123"}`)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.NotEqual(t, `""`, resp.Body.String())
+}
+
+func TestAPI_prometheusPermission(t *testing.T) {
+	httpDeps := &deps.HTTPDeps{BrokerCfg: &config.Broker{Prometheus: config.Prometheus{Database: "prom"}}}
+	prometheusWriter := prometheusIngest.NewWriter(httpDeps, prometheusIngest.DefaultWriteOptions(prometheusIngest.DatabaseConfig{}))
+	r := NewAPI(httpDeps, prometheusWriter)
+	engine := gin.New()
+	var (
+		database   string
+		permission models.Permission
+	)
+	handle := func(c *gin.Context) {
+		database, permission = r.prometheusPermission(c)
+	}
+	engine.POST("/api/v1/write", handle)
+	engine.POST("/api/v1/query", handle)
+
+	_ = mock.DoRequest(t, engine, http.MethodPost, "/api/v1/write", "")
+	assert.Equal(t, "prom", database)
+	assert.Equal(t, models.WritePermission, permission)
+	_ = mock.DoRequest(t, engine, http.MethodPost, "/api/v1/query", "")
+	assert.Equal(t, "prom", database)
+	assert.Equal(t, models.ReadPermission, permission)
+}
@@ -0,0 +1,379 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	commonconstants "github.com/lindb/common/constants"
+	commonmodels "github.com/lindb/common/models"
+	"github.com/lindb/common/pkg/encoding"
+	"github.com/lindb/common/pkg/logger"
+	commontimeutil "github.com/lindb/common/pkg/timeutil"
+	protoMetricsV1 "github.com/lindb/common/proto/gen/v1/linmetrics"
+
+	"github.com/lindb/lindb/app/broker/api/exec/command"
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/state"
+	"github.com/lindb/lindb/pkg/strutil"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series/metric"
+	sqlpkg "github.com/lindb/lindb/sql"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+// for testing
+var (
+	sqlParseFn   = sqlpkg.Parse
+	queryFn      = command.QueryCommand
+	listCQFn     = command.ListContinuousQueries
+	newTickerFn  = time.NewTicker
+	nowFn        = commontimeutil.Now
+	checkTimeout = time.Second
+)
+
+const (
+	// checkInterval represents the interval of checking which continuous queries need to be executed.
+	checkInterval = time.Second
+	// maxCatchUpWindows represents the max windows executed for one continuous query in one round,
+	// if broker was blocked for a long time, only the latest windows will be executed.
+	maxCatchUpWindows = 10
+)
+
+// Scheduler represents the continuous query scheduler, which executes continuous queries on a time grid,
+// then writes the query results back into target metric.
+type Scheduler interface {
+	// Start starts the scheduler in background goroutine.
+	Start()
+	// Stop stops the scheduler.
+	Stop()
+}
+
+// scheduler implements Scheduler interface.
+type scheduler struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	deps   *depspkg.HTTPDeps
+
+	// lastWindows represents the last executed window end of continuous query(key: database/name),
+	// which is also persisted in state repo, so that new master continues from the last executed window.
+	lastWindows map[string]int64
+	wait        sync.WaitGroup
+
+	statistics *metrics.ContinuousQueryStatistics
+	logger     logger.Logger
+}
+
+// NewScheduler creates a continuous query scheduler, only master broker node executes continuous queries.
+func NewScheduler(ctx context.Context, deps *depspkg.HTTPDeps) Scheduler {
+	c, cancel := context.WithCancel(ctx)
+	return &scheduler{
+		ctx:         c,
+		cancel:      cancel,
+		deps:        deps,
+		lastWindows: make(map[string]int64),
+		statistics:  metrics.NewContinuousQueryStatistics(),
+		logger:      logger.GetLogger("Broker", "ContinuousQuery"),
+	}
+}
+
+// Start starts the scheduler in background goroutine.
+func (s *scheduler) Start() {
+	s.wait.Add(1)
+	go func() {
+		defer s.wait.Done()
+		s.run()
+	}()
+	s.logger.Info("continuous query scheduler started")
+}
+
+// Stop stops the scheduler, waits the executing continuous queries completed.
+func (s *scheduler) Stop() {
+	s.cancel()
+	s.wait.Wait()
+	s.logger.Info("continuous query scheduler stopped")
+}
+
+// run checks continuous queries periodically until scheduler stopped.
+func (s *scheduler) run() {
+	ticker := newTickerFn(checkInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			s.schedule()
+		}
+	}
+}
+
+// schedule executes all continuous queries which have completed windows.
+func (s *scheduler) schedule() {
+	if !s.deps.Master.IsMaster() {
+		// reset execution state, because master role may be transferred to other node,
+		// reloads the persisted state after becoming master again.
+		if len(s.lastWindows) > 0 {
+			s.lastWindows = make(map[string]int64)
+		}
+		return
+	}
+	ctx, cancel := context.WithTimeout(s.ctx, checkTimeout)
+	cqs, err := listCQFn(ctx, s.deps.Repo)
+	var databases []state.KeyValue
+	if err == nil && len(cqs) > 0 {
+		databases, err = s.deps.Repo.List(ctx, constants.DatabaseConfigPath)
+	}
+	cancel()
+	if err != nil {
+		s.logger.Warn("list continuous queries failure", logger.Error(err))
+		return
+	}
+	existDatabases := make(map[string]struct{}, len(databases))
+	for _, database := range databases {
+		existDatabases[strings.TrimPrefix(database.Key, constants.GetDatabaseConfigPath(""))] = struct{}{}
+	}
+	now := nowFn()
+	actives := make(map[string]struct{}, len(cqs))
+	for idx := range cqs {
+		cq := &cqs[idx]
+		if _, ok := existDatabases[cq.Database]; !ok {
+			// database dropped, remove continuous query and its execution state
+			s.dropCQ(cq)
+			continue
+		}
+		key := cqKey(cq)
+		actives[key] = struct{}{}
+		s.execute(cq, key, now)
+	}
+	// remove dropped continuous queries
+	for key := range s.lastWindows {
+		if _, ok := actives[key]; !ok {
+			delete(s.lastWindows, key)
+		}
+	}
+}
+
+// execute executes all completed windows of continuous query which not executed.
+func (s *scheduler) execute(cq *models.ContinuousQuery, key string, now int64) {
+	interval := cq.Interval.Int64()
+	if interval <= 0 {
+		return
+	}
+	executeDelay := s.deps.BrokerCfg.BrokerBase.ContinuousQuery.ExecuteDelay.Duration().Milliseconds()
+	windowEnd := (now - executeDelay) / interval * interval
+	lastWindowEnd, ok := s.lastWindows[key]
+	if !ok {
+		lastWindowEnd, ok = s.loadState(cq)
+	}
+	if !ok {
+		// first schedule, only executes the latest completed window.
+		lastWindowEnd = windowEnd - interval
+	}
+	if windowEnd <= lastWindowEnd {
+		return
+	}
+	if (windowEnd-lastWindowEnd)/interval > maxCatchUpWindows {
+		lastWindowEnd = windowEnd - maxCatchUpWindows*interval
+	}
+	for start := lastWindowEnd; start < windowEnd; start += interval {
+		if s.ctx.Err() != nil {
+			return
+		}
+		if err := s.executeWindow(cq, start, start+interval); err != nil {
+			s.statistics.ExecuteFailures.Incr()
+			s.logger.Error("execute continuous query failure",
+				logger.String("database", cq.Database), logger.String("name", cq.Name),
+				logger.Int64("start", start), logger.Error(err))
+		} else {
+			s.statistics.Executions.Incr()
+		}
+		// skip failure window, avoid executing it forever.
+		s.lastWindows[key] = start + interval
+		s.saveState(cq, start+interval)
+	}
+}
+
+// dropCQ removes the continuous query and its execution state from state repo.
+func (s *scheduler) dropCQ(cq *models.ContinuousQuery) {
+	ctx, cancel := context.WithTimeout(s.ctx, checkTimeout)
+	defer cancel()
+	s.logger.Info("drop continuous query, because database is dropped",
+		logger.String("database", cq.Database), logger.String("name", cq.Name))
+	if err := s.deps.Repo.Delete(ctx, constants.GetContinuousQueryPath(cq.Database, cq.Name)); err != nil {
+		s.logger.Warn("drop continuous query failure",
+			logger.String("database", cq.Database), logger.String("name", cq.Name), logger.Error(err))
+		return
+	}
+	if err := s.deps.Repo.Delete(ctx, constants.GetContinuousQueryStatePath(cq.Database, cq.Name)); err != nil {
+		s.logger.Warn("drop continuous query state failure",
+			logger.String("database", cq.Database), logger.String("name", cq.Name), logger.Error(err))
+	}
+}
+
+// loadState loads the persisted last executed window end of continuous query, returns false if not exist.
+func (s *scheduler) loadState(cq *models.ContinuousQuery) (int64, bool) {
+	ctx, cancel := context.WithTimeout(s.ctx, checkTimeout)
+	defer cancel()
+	data, err := s.deps.Repo.Get(ctx, constants.GetContinuousQueryStatePath(cq.Database, cq.Name))
+	if err != nil {
+		if !errors.Is(err, state.ErrNotExist) {
+			s.logger.Warn("load continuous query state failure",
+				logger.String("database", cq.Database), logger.String("name", cq.Name), logger.Error(err))
+		}
+		return 0, false
+	}
+	cqState := &models.ContinuousQueryState{}
+	if err := encoding.JSONUnmarshal(data, cqState); err != nil {
+		s.logger.Warn("unmarshal continuous query state failure",
+			logger.String("database", cq.Database), logger.String("name", cq.Name), logger.Error(err))
+		return 0, false
+	}
+	return cqState.LastWindowEnd, true
+}
+
+// saveState persists the last executed window end of continuous query.
+func (s *scheduler) saveState(cq *models.ContinuousQuery, lastWindowEnd int64) {
+	ctx, cancel := context.WithTimeout(s.ctx, checkTimeout)
+	defer cancel()
+	data := encoding.JSONMarshal(&models.ContinuousQueryState{LastWindowEnd: lastWindowEnd})
+	if err := s.deps.Repo.Put(ctx, constants.GetContinuousQueryStatePath(cq.Database, cq.Name), data); err != nil {
+		s.logger.Warn("save continuous query state failure",
+			logger.String("database", cq.Database), logger.String("name", cq.Name), logger.Error(err))
+	}
+}
+
+// executeWindow executes continuous query with time range [start, end), then writes results into target metric.
+func (s *scheduler) executeWindow(cq *models.ContinuousQuery, start, end int64) error {
+	startTime := time.Now()
+	defer s.statistics.Duration.UpdateSince(startTime)
+
+	statement, err := sqlParseFn(cq.SQL)
+	if err != nil {
+		return err
+	}
+	query, ok := statement.(*stmtpkg.Query)
+	if !ok {
+		return fmt.Errorf("continuous query must be data query statement")
+	}
+	query.TimeRange = timeutil.TimeRange{Start: start, End: end - 1}
+	if query.Interval <= 0 || query.AutoGroupByTime {
+		// aggregates all data of window into one point by default.
+		query.Interval = cq.Interval
+		query.AutoGroupByTime = false
+	}
+	param := &models.ExecuteParam{Database: cq.Database, SQL: cq.SQL}
+	var rs interface{}
+	if err := s.deps.QueryLimiter.Do(func() error {
+		ctx, cancel := context.WithTimeout(s.ctx, s.deps.BrokerCfg.Query.Timeout.Duration())
+		defer cancel()
+		rs, err = queryFn(ctx, s.deps, param, query)
+		return err
+	}); err != nil {
+		return err
+	}
+	resultSet, ok := rs.(*commonmodels.ResultSet)
+	if !ok || resultSet == nil || len(resultSet.Series) == 0 {
+		return nil
+	}
+	namespace := query.Namespace
+	if namespace == "" {
+		namespace = commonconstants.DefaultNamespace
+	}
+	rows, err := buildRows(cq, namespace, resultSet)
+	if err != nil {
+		return err
+	}
+	if rows.Len() == 0 {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(s.ctx, s.deps.BrokerCfg.BrokerBase.Ingestion.IngestTimeout.Duration())
+	defer cancel()
+	if err := s.deps.CM.Write(ctx, cq.Database, rows); err != nil {
+		s.statistics.WriteFailures.Incr()
+		return err
+	}
+	s.statistics.MaterializedRows.Add(float64(rows.Len()))
+	return nil
+}
+
+// buildRows converts the query result set into rows of target metric,
+// one row per series and timestamp, the fields of result are written as last value.
+func buildRows(cq *models.ContinuousQuery, namespace string, resultSet *commonmodels.ResultSet) (*metric.BrokerBatchRows, error) {
+	converter, releaseFunc := metric.NewBrokerRowProtoConverter(
+		strutil.String2ByteSlice(namespace), nil, models.GetDatabaseLimits(cq.Database))
+	defer releaseFunc(converter)
+
+	batch := metric.NewBrokerBatchRows()
+	for _, series := range resultSet.Series {
+		tags := make([]*protoMetricsV1.KeyValue, 0, len(series.Tags))
+		for k, v := range series.Tags {
+			tags = append(tags, &protoMetricsV1.KeyValue{Key: k, Value: v})
+		}
+		sort.Slice(tags, func(i, j int) bool {
+			return tags[i].Key < tags[j].Key
+		})
+		// group field values by timestamp
+		points := make(map[int64][]*protoMetricsV1.SimpleField)
+		for fieldName, values := range series.Fields {
+			for timestamp, value := range values {
+				if math.IsNaN(value) {
+					continue
+				}
+				points[timestamp] = append(points[timestamp], &protoMetricsV1.SimpleField{
+					Name:  fieldName,
+					Type:  protoMetricsV1.SimpleFieldType_LAST,
+					Value: value,
+				})
+			}
+		}
+		for timestamp, fields := range points {
+			sort.Slice(fields, func(i, j int) bool {
+				return fields[i].Name < fields[j].Name
+			})
+			m := &protoMetricsV1.Metric{
+				Name:         cq.TargetMetric,
+				Timestamp:    timestamp,
+				Tags:         tags,
+				SimpleFields: fields,
+			}
+			if err := batch.TryAppend(func(row *metric.BrokerRow) error {
+				return converter.ConvertTo(m, row)
+			}); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return batch, nil
+}
+
+// cqKey returns the unique key of continuous query.
+func cqKey(cq *models.ContinuousQuery) string {
+	return cq.Database + "/" + cq.Name
+}
@@ -0,0 +1,343 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cq
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	commonmodels "github.com/lindb/common/models"
+	"github.com/lindb/common/pkg/ltoml"
+	commontimeutil "github.com/lindb/common/pkg/timeutil"
+	flatMetricsV1 "github.com/lindb/common/proto/gen/v1/flatMetricsV1"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/lindb/lindb/app/broker/api/exec/command"
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/coordinator"
+	"github.com/lindb/lindb/internal/concurrent"
+	"github.com/lindb/lindb/internal/linmetric"
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/state"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/replica"
+	sqlpkg "github.com/lindb/lindb/sql"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+var testCQ = models.ContinuousQuery{
+	Name:         "cpu_1m",
+	Database:     "test",
+	Interval:     timeutil.Interval(commontimeutil.OneMinute),
+	TargetMetric: "cpu_avg_1m",
+	SQL:          "select avg(usage) as usage from cpu on ns group by host",
+}
+
+func newTestScheduler(ctrl *gomock.Controller) (*scheduler, *coordinator.MockMasterController, *replica.MockChannelManager) {
+	master := coordinator.NewMockMasterController(ctrl)
+	cm := replica.NewMockChannelManager(ctrl)
+	s := NewScheduler(context.TODO(), &depspkg.HTTPDeps{
+		BrokerCfg: &config.Broker{
+			Query: config.Query{Timeout: ltoml.Duration(time.Second)},
+			BrokerBase: config.BrokerBase{
+				Ingestion:       config.Ingestion{IngestTimeout: ltoml.Duration(time.Second)},
+				ContinuousQuery: config.ContinuousQuery{ExecuteDelay: ltoml.Duration(20 * time.Second)},
+			},
+		},
+		Master: master,
+		Repo:   state.NewMockRepository(ctrl),
+		CM:     cm,
+		QueryLimiter: concurrent.NewLimiter(
+			context.TODO(),
+			32,
+			time.Second,
+			metrics.NewLimitStatistics("cq_test", linmetric.BrokerRegistry)),
+	})
+	return s.(*scheduler), master, cm
+}
+
+func newTestResultSet() *commonmodels.ResultSet {
+	rs := commonmodels.NewResultSet()
+	series := commonmodels.NewSeries(map[string]string{"host": "a"}, "a")
+	series.AddField("usage", &commonmodels.Points{Points: map[int64]float64{
+		9 * commontimeutil.OneMinute: 10,
+	}})
+	rs.AddSeries(series)
+	return rs
+}
+
+func mockCQs(cqs ...models.ContinuousQuery) {
+	listCQFn = func(_ context.Context, _ state.Repository) (models.ContinuousQueries, error) {
+		return cqs, nil
+	}
+}
+
+func TestScheduler_schedule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		listCQFn = command.ListContinuousQueries
+		queryFn = command.QueryCommand
+		nowFn = commontimeutil.Now
+		ctrl.Finish()
+	}()
+	s, master, cm := newTestScheduler(ctrl)
+	executeDelay := 20 * commontimeutil.OneSecond
+	repo := s.deps.Repo.(*state.MockRepository)
+	repo.EXPECT().List(gomock.Any(), "/database/config").Return([]state.KeyValue{{Key: "/database/config/test"}}, nil).AnyTimes()
+	repo.EXPECT().Get(gomock.Any(), "/cq/state/test/cpu_1m").Return(nil, state.ErrNotExist)
+	repo.EXPECT().Put(gomock.Any(), "/cq/state/test/cpu_1m", gomock.Any()).Return(nil).AnyTimes()
+
+	var windows []timeutil.TimeRange
+	queryFn = func(_ context.Context, _ *depspkg.HTTPDeps, param *models.ExecuteParam, stmt stmtpkg.Statement) (interface{}, error) {
+		assert.Equal(t, "test", param.Database)
+		query := stmt.(*stmtpkg.Query)
+		assert.Equal(t, testCQ.Interval, query.Interval)
+		windows = append(windows, query.TimeRange)
+		return newTestResultSet(), nil
+	}
+	// not master
+	master.EXPECT().IsMaster().Return(false)
+	s.lastWindows["test/cpu_1m"] = 10
+	s.schedule()
+	assert.Empty(t, s.lastWindows)
+
+	master.EXPECT().IsMaster().Return(true).AnyTimes()
+	// list failure
+	listCQFn = func(_ context.Context, _ state.Repository) (models.ContinuousQueries, error) {
+		return nil, fmt.Errorf("err")
+	}
+	s.schedule()
+	assert.Empty(t, windows)
+
+	// first schedule, only execute latest window
+	mockCQs(testCQ, models.ContinuousQuery{Name: "invalid", Database: "test"})
+	nowFn = func() int64 {
+		return 10*commontimeutil.OneMinute + executeDelay
+	}
+	cm.EXPECT().Write(gomock.Any(), "test", gomock.Any()).Return(nil)
+	s.schedule()
+	assert.Equal(t, []timeutil.TimeRange{{Start: 9 * commontimeutil.OneMinute, End: 10*commontimeutil.OneMinute - 1}}, windows)
+	assert.Equal(t, 10*commontimeutil.OneMinute, s.lastWindows["test/cpu_1m"])
+
+	// window not completed
+	windows = nil
+	nowFn = func() int64 {
+		return 11*commontimeutil.OneMinute + executeDelay - 1
+	}
+	s.schedule()
+	assert.Empty(t, windows)
+
+	// execute missed windows
+	nowFn = func() int64 {
+		return 13*commontimeutil.OneMinute + executeDelay
+	}
+	cm.EXPECT().Write(gomock.Any(), "test", gomock.Any()).Return(nil).Times(3)
+	s.schedule()
+	assert.Len(t, windows, 3)
+	assert.Equal(t, 10*commontimeutil.OneMinute, windows[0].Start)
+	assert.Equal(t, 13*commontimeutil.OneMinute, s.lastWindows["test/cpu_1m"])
+
+	// too many missed windows, only execute the latest windows
+	windows = nil
+	nowFn = func() int64 {
+		return 100*commontimeutil.OneMinute + executeDelay
+	}
+	cm.EXPECT().Write(gomock.Any(), "test", gomock.Any()).Return(nil).Times(maxCatchUpWindows)
+	s.schedule()
+	assert.Len(t, windows, maxCatchUpWindows)
+	assert.Equal(t, 90*commontimeutil.OneMinute, windows[0].Start)
+
+	// write failure, skip failure window
+	nowFn = func() int64 {
+		return 101*commontimeutil.OneMinute + executeDelay
+	}
+	cm.EXPECT().Write(gomock.Any(), "test", gomock.Any()).Return(fmt.Errorf("err"))
+	s.schedule()
+	assert.Equal(t, 101*commontimeutil.OneMinute, s.lastWindows["test/cpu_1m"])
+
+	// continuous query dropped
+	mockCQs()
+	s.schedule()
+	assert.Empty(t, s.lastWindows)
+
+	// continues from persisted state
+	windows = nil
+	mockCQs(testCQ)
+	repo.EXPECT().Get(gomock.Any(), "/cq/state/test/cpu_1m").
+		Return([]byte(`{"lastWindowEnd":99000000}`), nil)
+	nowFn = func() int64 {
+		return 1653*commontimeutil.OneMinute + executeDelay
+	}
+	cm.EXPECT().Write(gomock.Any(), "test", gomock.Any()).Return(nil).Times(3)
+	s.schedule()
+	assert.Len(t, windows, 3)
+	assert.Equal(t, int64(99000000), windows[0].Start)
+	assert.Equal(t, 1653*commontimeutil.OneMinute, s.lastWindows["test/cpu_1m"])
+
+	// database dropped, remove continuous query and its state
+	windows = nil
+	mockCQs(testCQ, models.ContinuousQuery{Name: "cpu_1h", Database: "dropped", Interval: testCQ.Interval})
+	nowFn = func() int64 {
+		return 1654*commontimeutil.OneMinute + executeDelay
+	}
+	cm.EXPECT().Write(gomock.Any(), "test", gomock.Any()).Return(nil)
+	repo.EXPECT().Delete(gomock.Any(), "/database/cq/dropped/cpu_1h").Return(nil)
+	repo.EXPECT().Delete(gomock.Any(), "/cq/state/dropped/cpu_1h").Return(nil)
+	s.schedule()
+	assert.Len(t, windows, 1)
+	assert.NotContains(t, s.lastWindows, "dropped/cpu_1h")
+}
+
+func TestScheduler_dropCQ(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	s, _, _ := newTestScheduler(ctrl)
+	repo := s.deps.Repo.(*state.MockRepository)
+
+	// drop continuous query failure
+	repo.EXPECT().Delete(gomock.Any(), "/database/cq/test/cpu_1m").Return(fmt.Errorf("err"))
+	s.dropCQ(&testCQ)
+	// drop state failure
+	repo.EXPECT().Delete(gomock.Any(), "/database/cq/test/cpu_1m").Return(nil)
+	repo.EXPECT().Delete(gomock.Any(), "/cq/state/test/cpu_1m").Return(fmt.Errorf("err"))
+	s.dropCQ(&testCQ)
+}
+
+func TestScheduler_state(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	s, _, _ := newTestScheduler(ctrl)
+	repo := s.deps.Repo.(*state.MockRepository)
+
+	// load failure
+	repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+	_, ok := s.loadState(&testCQ)
+	assert.False(t, ok)
+	// unmarshal failure
+	repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return([]byte("abc"), nil)
+	_, ok = s.loadState(&testCQ)
+	assert.False(t, ok)
+	repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return([]byte(`{"lastWindowEnd":10}`), nil)
+	lastWindowEnd, ok := s.loadState(&testCQ)
+	assert.True(t, ok)
+	assert.Equal(t, int64(10), lastWindowEnd)
+	// save failure
+	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+	s.saveState(&testCQ, 10)
+}
+
+func TestScheduler_executeWindow(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		sqlParseFn = sqlpkg.Parse
+		queryFn = command.QueryCommand
+		ctrl.Finish()
+	}()
+	s, _, _ := newTestScheduler(ctrl)
+
+	// parse failure
+	sqlParseFn = func(_ string) (stmtpkg.Statement, error) {
+		return nil, fmt.Errorf("err")
+	}
+	assert.Error(t, s.executeWindow(&testCQ, 0, 10))
+	// not query statement
+	sqlParseFn = func(_ string) (stmtpkg.Statement, error) {
+		return &stmtpkg.Use{}, nil
+	}
+	assert.Error(t, s.executeWindow(&testCQ, 0, 10))
+	sqlParseFn = sqlpkg.Parse
+	// query failure
+	queryFn = func(_ context.Context, _ *depspkg.HTTPDeps, _ *models.ExecuteParam, _ stmtpkg.Statement) (interface{}, error) {
+		return nil, fmt.Errorf("err")
+	}
+	assert.Error(t, s.executeWindow(&testCQ, 0, 10))
+	// empty result
+	queryFn = func(_ context.Context, _ *depspkg.HTTPDeps, _ *models.ExecuteParam, _ stmtpkg.Statement) (interface{}, error) {
+		return commonmodels.NewResultSet(), nil
+	}
+	assert.NoError(t, s.executeWindow(&testCQ, 0, 10))
+	// all values are NaN
+	queryFn = func(_ context.Context, _ *depspkg.HTTPDeps, _ *models.ExecuteParam, _ stmtpkg.Statement) (interface{}, error) {
+		rs := commonmodels.NewResultSet()
+		series := commonmodels.NewSeries(nil, "")
+		series.AddField("usage", &commonmodels.Points{Points: map[int64]float64{10: math.NaN()}})
+		rs.AddSeries(series)
+		return rs, nil
+	}
+	assert.NoError(t, s.executeWindow(&testCQ, 0, 10))
+}
+
+func TestBuildRows(t *testing.T) {
+	rs := newTestResultSet()
+	rs.Series[0].AddField("max", &commonmodels.Points{Points: map[int64]float64{
+		9 * commontimeutil.OneMinute:  20,
+		10 * commontimeutil.OneMinute: 30,
+	}})
+	rows, err := buildRows(&testCQ, "ns", rs)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, rows.Len())
+	values := make(map[int64]map[string]float64)
+	for _, row := range rows.Rows() {
+		m := row.Metric()
+		assert.Equal(t, "ns", string(m.Namespace()))
+		assert.Equal(t, "cpu_avg_1m", string(m.Name()))
+		var kv flatMetricsV1.KeyValue
+		assert.Equal(t, 1, m.KeyValuesLength())
+		m.KeyValues(&kv, 0)
+		assert.Equal(t, "host", string(kv.Key()))
+		assert.Equal(t, "a", string(kv.Value()))
+		fields := make(map[string]float64)
+		var f flatMetricsV1.SimpleField
+		for i := 0; i < m.SimpleFieldsLength(); i++ {
+			m.SimpleFields(&f, i)
+			assert.Equal(t, flatMetricsV1.SimpleFieldTypeLast, f.Type())
+			fields[string(f.Name())] = f.Value()
+		}
+		values[m.Timestamp()] = fields
+	}
+	assert.Equal(t, map[int64]map[string]float64{
+		9 * commontimeutil.OneMinute:  {"usage": 10, "max": 20},
+		10 * commontimeutil.OneMinute: {"max": 30},
+	}, values)
+
+	// metric name too long
+	_, err = buildRows(&models.ContinuousQuery{Database: "test", TargetMetric: string(make([]byte, 1024))}, "ns", rs)
+	assert.Error(t, err)
+}
+
+func TestScheduler_StartStop(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newTickerFn = time.NewTicker
+		ctrl.Finish()
+	}()
+	newTickerFn = func(_ time.Duration) *time.Ticker {
+		return time.NewTicker(time.Millisecond)
+	}
+	s, master, _ := newTestScheduler(ctrl)
+	master.EXPECT().IsMaster().Return(false).AnyTimes()
+	s.Start()
+	time.Sleep(20 * time.Millisecond)
+	s.Stop()
+}
@@ -19,14 +19,18 @@ package deps
 
 import (
 	"context"
+	"strconv"
+	"time"
 
 	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/coordinator"
 	"github.com/lindb/lindb/coordinator/broker"
 	"github.com/lindb/lindb/internal/concurrent"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/state"
 	"github.com/lindb/lindb/query"
+	"github.com/lindb/lindb/query/cache"
 	"github.com/lindb/lindb/replica"
 	"github.com/lindb/lindb/rpc"
 	"github.com/lindb/lindb/series/tag"
@@ -48,10 +52,21 @@ type HTTPDeps struct {
 	CM            replica.ChannelManager
 	IngestLimiter *concurrent.Limiter
 	QueryLimiter  *concurrent.Limiter
+	ResultCache   cache.ResultCache // nil if query result cache disabled
 
 	GlobalKeyValues tag.Tags
 }
 
+// InvalidateResultCache removes the cached query results of database if query result cache enabled,
+// then changes the version of database's cached results, other brokers remove theirs after watching the change.
+func (deps *HTTPDeps) InvalidateResultCache(ctx context.Context, database string) error {
+	if deps.ResultCache != nil {
+		deps.ResultCache.Invalidate(database)
+	}
+	version := strconv.FormatInt(time.Now().UnixNano(), 10)
+	return deps.Repo.Put(ctx, constants.GetQueryCacheVersionPath(database), []byte(version))
+}
+
 func (deps *HTTPDeps) WithTimeout() (context.Context, context.CancelFunc) {
 	timeout := deps.BrokerCfg.BrokerBase.HTTP.ReadTimeout.Duration()
 	return context.WithTimeout(deps.Ctx, timeout)
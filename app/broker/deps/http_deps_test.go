@@ -19,9 +19,16 @@ package deps
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
 	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/pkg/state"
+	"github.com/lindb/lindb/query/cache"
 )
 
 func TestDeps_WithTimeout(t *testing.T) {
@@ -35,3 +42,19 @@ func TestDeps_WithTimeout(t *testing.T) {
 	}
 	_, _ = deps.WithTimeout()
 }
+
+func TestDeps_InvalidateResultCache(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := state.NewMockRepository(ctrl)
+	deps := &HTTPDeps{Repo: repo}
+	repo.EXPECT().Put(gomock.Any(), constants.GetQueryCacheVersionPath("db"), gomock.Any()).Return(nil)
+	assert.NoError(t, deps.InvalidateResultCache(context.TODO(), "db"))
+
+	resultCache := cache.NewMockResultCache(ctrl)
+	resultCache.EXPECT().Invalidate("db")
+	deps.ResultCache = resultCache
+	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+	assert.Error(t, deps.InvalidateResultCache(context.TODO(), "db"))
+}
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"strings"
 	"sync"
 	"time"
 
@@ -30,14 +31,17 @@ import (
 	"go.uber.org/atomic"
 
 	"github.com/lindb/lindb/app"
+	"github.com/lindb/lindb/app/broker/alert"
 	"github.com/lindb/lindb/app/broker/api"
 	prometheusIngest "github.com/lindb/lindb/app/broker/api/prometheus/ingest"
+	"github.com/lindb/lindb/app/broker/cq"
 	"github.com/lindb/lindb/app/broker/deps"
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/coordinator"
 	"github.com/lindb/lindb/coordinator/broker"
 	"github.com/lindb/lindb/coordinator/discovery"
+	"github.com/lindb/lindb/internal/client"
 	"github.com/lindb/lindb/internal/concurrent"
 	"github.com/lindb/lindb/internal/linmetric"
 	"github.com/lindb/lindb/internal/server"
@@ -46,8 +50,10 @@ import (
 	"github.com/lindb/lindb/pkg/hostutil"
 	httppkg "github.com/lindb/lindb/pkg/http"
 	"github.com/lindb/lindb/pkg/state"
+	"github.com/lindb/lindb/pkg/tlsutil"
 	protoCommonV1 "github.com/lindb/lindb/proto/gen/v1/common"
 	"github.com/lindb/lindb/query"
+	"github.com/lindb/lindb/query/cache"
 	"github.com/lindb/lindb/replica"
 	"github.com/lindb/lindb/rpc"
 	"github.com/lindb/lindb/series/tag"
@@ -104,10 +110,16 @@ type runtime struct {
 	registry            discovery.Registry
 	stateMachineFactory discovery.StateMachineFactory
 	stateMgr            broker.StateManager
+	// resultCacheSM watches the version of cached query results, nil if query result cache disabled.
+	resultCacheSM discovery.StateMachine
 
 	httpDeps *deps.HTTPDeps
 	// prometheusWriter writes data received from Prometheus to LinDB.
 	prometheusWriter prometheusIngest.Writer
+	// cqScheduler executes continuous queries, then writes results into target metric.
+	cqScheduler cq.Scheduler
+	// alertEvaluator evaluates alert rules, then sends notifications to receivers.
+	alertEvaluator alert.Evaluator
 
 	grpcServer rpc.GRPCServer
 	rpcHandler *rpcHandler
@@ -165,6 +177,7 @@ func (r *runtime) Run() error {
 		HostName:   hostName,
 		GRPCPort:   r.config.BrokerBase.GRPC.Port,
 		HTTPPort:   r.config.BrokerBase.HTTP.Port,
+		HTTPS:      r.config.BrokerBase.HTTP.TLS.Enabled,
 		OnlineTime: timeutil.Now(),
 		Version:    config.Version,
 	}
@@ -185,6 +198,23 @@ func (r *runtime) Run() error {
 	}
 	r.BaseRuntime = app.NewBaseRuntimeFn(r.ctx, r.config.Monitor, linmetric.BrokerRegistry, r.globalKeyValues)
 
+	// grpc client(broker->storage/broker) uses same tls config with grpc server
+	clientTLSCfg, err := tlsutil.NewClientConfig(&r.config.BrokerBase.GRPC.TLS)
+	if err != nil {
+		r.logger.Error("failed to create grpc client tls config", logger.Error(err))
+		r.state = server.Failed
+		return err
+	}
+	rpc.GetBrokerClientConnFactory().SetTLSConfig(clientTLSCfg)
+	// internal http client(broker->other nodes' http api) uses same tls config with http server
+	httpClientTLSCfg, err := tlsutil.NewClientConfig(&r.config.BrokerBase.HTTP.TLS)
+	if err != nil {
+		r.logger.Error("failed to create http client tls config", logger.Error(err))
+		r.state = server.Failed
+		return err
+	}
+	client.SetInternalTLSConfig(httpClientTLSCfg)
+
 	tackClientFct := newTaskClientFactory(r.ctx, r.node, rpc.GetBrokerClientConnFactory())
 	r.factory = factory{
 		taskClient:    tackClientFct,
@@ -260,6 +290,17 @@ func (r *runtime) Run() error {
 
 	// start http server
 	r.startHTTPServer()
+	if err = r.startResultCacheStateMachine(discoveryFactory); err != nil {
+		r.state = server.Failed
+		return fmt.Errorf("start query result cache state machine error: %s", err)
+	}
+
+	// start continuous query scheduler
+	r.cqScheduler = cq.NewScheduler(r.ctx, r.httpDeps)
+	r.cqScheduler.Start()
+	// start alert rule evaluator
+	r.alertEvaluator = alert.NewEvaluator(r.ctx, r.httpDeps)
+	r.alertEvaluator.Start()
 
 	if r.enableSystemMonitor {
 		// start system collector
@@ -298,6 +339,17 @@ func (r *runtime) Stop() {
 		}
 	}
 
+	// stop continuous query scheduler
+	if r.cqScheduler != nil {
+		r.logger.Info("stopping continuous query scheduler...")
+		r.cqScheduler.Stop()
+	}
+	// stop alert rule evaluator
+	if r.alertEvaluator != nil {
+		r.logger.Info("stopping alert rule evaluator...")
+		r.alertEvaluator.Stop()
+	}
+
 	// close prometheus writer
 	if r.prometheusWriter != nil {
 		r.prometheusWriter.Close()
@@ -324,6 +376,11 @@ func (r *runtime) Stop() {
 	if r.stateMachineFactory != nil {
 		r.stateMachineFactory.Stop()
 	}
+	if r.resultCacheSM != nil {
+		if err := r.resultCacheSM.Close(); err != nil {
+			r.logger.Error("close query result cache state machine error", logger.Error(err))
+		}
+	}
 
 	if r.repo != nil {
 		r.logger.Info("closing state repo...")
@@ -362,6 +419,31 @@ func (r *runtime) Stop() {
 	r.logger.Info("stopped broker server successfully")
 }
 
+// startResultCacheStateMachine watches the version of cached query results which is changed after data deleted
+// or database changed on any broker, then removes the local cached results of the database.
+func (r *runtime) startResultCacheStateMachine(discoveryFactory discovery.Factory) error {
+	resultCache := r.httpDeps.ResultCache
+	if resultCache == nil {
+		return nil
+	}
+	sm, err := discovery.NewStateMachineFn(
+		r.ctx,
+		discovery.QueryCacheStateMachine,
+		discoveryFactory,
+		constants.QueryCacheVersionPath,
+		false,
+		func(key string, _ []byte) {
+			resultCache.Invalidate(strings.TrimPrefix(key, constants.GetQueryCacheVersionPath("")))
+		},
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+	r.resultCacheSM = sm
+	return nil
+}
+
 // startHTTPServer starts http server for api rpcHandler
 func (r *runtime) startHTTPServer() {
 	r.logger.Info("starting HTTP server")
@@ -392,6 +474,13 @@ func (r *runtime) startHTTPServer() {
 		),
 		GlobalKeyValues: r.globalKeyValues,
 	}
+	if cacheSize := r.config.Query.ResultCacheSize; cacheSize > 0 {
+		r.httpDeps.ResultCache = cache.NewResultCache(
+			int(cacheSize),
+			r.config.Query.ResultCacheTTL.Duration(),
+			linmetric.BrokerRegistry,
+		)
+	}
 	// prometheus writer
 	schema := prometheusIngest.DatabaseConfig{
 		Namespace: r.config.Prometheus.Namespace,
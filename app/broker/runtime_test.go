@@ -26,10 +26,13 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/lindb/common/pkg/logger"
+	"github.com/lindb/common/pkg/ltoml"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
+	"github.com/lindb/lindb/app/broker/deps"
 	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/coordinator"
 	brokerpkg "github.com/lindb/lindb/coordinator/broker"
 	"github.com/lindb/lindb/coordinator/discovery"
@@ -39,6 +42,7 @@ import (
 	"github.com/lindb/lindb/pkg/hostutil"
 	httppkg "github.com/lindb/lindb/pkg/http"
 	"github.com/lindb/lindb/pkg/state"
+	"github.com/lindb/lindb/query/cache"
 	"github.com/lindb/lindb/replica"
 	"github.com/lindb/lindb/rpc"
 )
@@ -107,6 +111,22 @@ func TestBrokerRuntime_Run(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "create grpc client tls config failure",
+			prepare: func() {
+				repoFct.EXPECT().CreateNormalRepo(gomock.Any()).Return(repo, nil)
+				cfg.BrokerBase.GRPC.TLS = config.TLS{Enabled: true, CAFile: "not_exist"}
+			},
+			wantErr: true,
+		},
+		{
+			name: "create http client tls config failure",
+			prepare: func() {
+				repoFct.EXPECT().CreateNormalRepo(gomock.Any()).Return(repo, nil)
+				cfg.BrokerBase.HTTP.TLS = config.TLS{Enabled: true, CAFile: "not_exist"}
+			},
+			wantErr: true,
+		},
 		{
 			name: "registry alive node failure",
 			prepare: func() {
@@ -195,6 +215,12 @@ func TestBrokerRuntime_Run(t *testing.T) {
 					return httpSrv
 				}
 				httpSrv.EXPECT().Run().Return(nil)
+				cfg.Query.ResultCacheSize = ltoml.Size(1024 * 1024)
+				discovery.NewStateMachineFn = func(_ context.Context, _ discovery.StateMachineType,
+					_ discovery.Factory, _ string, _ bool, _ func(key string, resource []byte), _ func(key string),
+				) (discovery.StateMachine, error) {
+					return discovery.NewMockStateMachine(ctrl), nil
+				}
 			},
 			wantErr: false,
 		},
@@ -207,6 +233,9 @@ func TestBrokerRuntime_Run(t *testing.T) {
 				getHostIP = hostutil.GetHostIP
 				hostName = os.Hostname
 				newGRPCServer = rpc.NewGRPCServer
+				cfg.BrokerBase.GRPC.TLS = config.TLS{}
+				cfg.BrokerBase.HTTP.TLS = config.TLS{}
+				cfg.Query.ResultCacheSize = 0
 				newTaskClientFactory = rpc.NewTaskClientFactory
 				newStateManager = brokerpkg.NewStateManager
 				newChannelManager = replica.NewChannelManager
@@ -215,6 +244,7 @@ func TestBrokerRuntime_Run(t *testing.T) {
 				serveGRPCFn = serveGRPC
 				newHTTPServer = httppkg.NewServer
 				newStateMachineFactory = brokerpkg.NewStateMachineFactory
+				discovery.NewStateMachineFn = discovery.NewStateMachine
 			}()
 
 			r := &runtime{
@@ -250,6 +280,7 @@ func TestBrokerRuntime_Stop(t *testing.T) {
 	registry := discovery.NewMockRegistry(ctrl)
 	mc := coordinator.NewMockMasterController(ctrl)
 	smFct := discovery.NewMockStateMachineFactory(ctrl)
+	resultCacheSM := discovery.NewMockStateMachine(ctrl)
 	repo := state.NewMockRepository(ctrl)
 	stateMgr := brokerpkg.NewMockStateManager(ctrl)
 	connectionMgr := rpc.NewMockConnectionManager(ctrl)
@@ -268,6 +299,7 @@ func TestBrokerRuntime_Stop(t *testing.T) {
 				registry.EXPECT().Close().Return(fmt.Errorf("err"))
 				mc.EXPECT().Stop()
 				smFct.EXPECT().Stop()
+				resultCacheSM.EXPECT().Close().Return(fmt.Errorf("err"))
 				repo.EXPECT().Close().Return(fmt.Errorf("err"))
 				stateMgr.EXPECT().Close()
 				connectionMgr.EXPECT().Close().Return(fmt.Errorf("err"))
@@ -282,6 +314,7 @@ func TestBrokerRuntime_Stop(t *testing.T) {
 				registry.EXPECT().Close().Return(nil)
 				mc.EXPECT().Stop()
 				smFct.EXPECT().Stop()
+				resultCacheSM.EXPECT().Close().Return(nil)
 				repo.EXPECT().Close().Return(nil)
 				stateMgr.EXPECT().Close()
 				connectionMgr.EXPECT().Close().Return(nil)
@@ -302,6 +335,7 @@ func TestBrokerRuntime_Stop(t *testing.T) {
 				registry:            registry,
 				master:              mc,
 				stateMachineFactory: smFct,
+				resultCacheSM:       resultCacheSM,
 				repo:                repo,
 				stateMgr:            stateMgr,
 				srv: srv{
@@ -322,6 +356,39 @@ func TestBrokerRuntime_Stop(t *testing.T) {
 	}
 }
 
+func TestBrokerRuntime_startResultCacheStateMachine(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		discovery.NewStateMachineFn = discovery.NewStateMachine
+		ctrl.Finish()
+	}()
+
+	r := &runtime{ctx: context.TODO(), httpDeps: &deps.HTTPDeps{}}
+	// query result cache disabled
+	assert.NoError(t, r.startResultCacheStateMachine(nil))
+	assert.Nil(t, r.resultCacheSM)
+
+	resultCache := cache.NewMockResultCache(ctrl)
+	r.httpDeps.ResultCache = resultCache
+	discovery.NewStateMachineFn = func(_ context.Context, _ discovery.StateMachineType,
+		_ discovery.Factory, _ string, _ bool, _ func(key string, resource []byte), _ func(key string),
+	) (discovery.StateMachine, error) {
+		return nil, fmt.Errorf("err")
+	}
+	assert.Error(t, r.startResultCacheStateMachine(nil))
+
+	discovery.NewStateMachineFn = func(_ context.Context, _ discovery.StateMachineType,
+		_ discovery.Factory, path string, _ bool, onCreate func(key string, resource []byte), _ func(key string),
+	) (discovery.StateMachine, error) {
+		assert.Equal(t, constants.QueryCacheVersionPath, path)
+		resultCache.EXPECT().Invalidate("db")
+		onCreate(constants.GetQueryCacheVersionPath("db"), []byte("1"))
+		return discovery.NewMockStateMachine(ctrl), nil
+	}
+	assert.NoError(t, r.startResultCacheStateMachine(nil))
+	assert.NotNil(t, r.resultCacheSM)
+}
+
 func TestBrokerRuntime_startGrpcServer(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
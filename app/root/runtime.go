@@ -34,6 +34,7 @@ import (
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/coordinator/discovery"
 	"github.com/lindb/lindb/coordinator/root"
+	"github.com/lindb/lindb/internal/client"
 	"github.com/lindb/lindb/internal/concurrent"
 	"github.com/lindb/lindb/internal/linmetric"
 	"github.com/lindb/lindb/internal/server"
@@ -42,6 +43,7 @@ import (
 	"github.com/lindb/lindb/pkg/hostutil"
 	httppkg "github.com/lindb/lindb/pkg/http"
 	"github.com/lindb/lindb/pkg/state"
+	"github.com/lindb/lindb/pkg/tlsutil"
 	"github.com/lindb/lindb/query"
 	"github.com/lindb/lindb/rpc"
 	"github.com/lindb/lindb/series/tag"
@@ -130,6 +132,7 @@ func (r *runtime) Run() error {
 		HostIP:     ip,
 		HostName:   hostName,
 		HTTPPort:   r.config.HTTP.Port,
+		HTTPS:      r.config.HTTP.TLS.Enabled,
 		OnlineTime: timeutil.Now(),
 		Version:    config.Version,
 	}
@@ -141,6 +144,23 @@ func (r *runtime) Run() error {
 	r.logger.Info("starting root", logger.String("host", hostName), logger.String("ip", ip),
 		logger.Uint16("http", r.node.HTTPPort))
 
+	// grpc client(root->broker) uses the grpc tls config of brokers
+	clientTLSCfg, err := tlsutil.NewClientConfig(&r.config.GRPC.TLS)
+	if err != nil {
+		r.logger.Error("failed to create grpc client tls config", logger.Error(err))
+		r.state = server.Failed
+		return err
+	}
+	rpc.GetBrokerClientConnFactory().SetTLSConfig(clientTLSCfg)
+	// internal http client(root->broker http api) uses same tls config with http server
+	httpClientTLSCfg, err := tlsutil.NewClientConfig(&r.config.HTTP.TLS)
+	if err != nil {
+		r.logger.Error("failed to create http client tls config", logger.Error(err))
+		r.state = server.Failed
+		return err
+	}
+	client.SetInternalTLSConfig(httpClientTLSCfg)
+
 	// build dependencies
 	repoFct := newRepositoryFactory("root")
 	taskClientFct := newTaskClientFactory(r.ctx, r.node, rpc.GetBrokerClientConnFactory())
@@ -133,6 +133,24 @@ func TestRootRun_Err(t *testing.T) {
 	newStateMachineFactory = func(_ context.Context, _ discovery.Factory, _ root.StateManager) discovery.StateMachineFactory {
 		return stateMachineFct
 	}
+	t.Run("create grpc client tls config fail", func(t *testing.T) {
+		defer func() {
+			cfg.GRPC.TLS = config.TLS{}
+		}()
+		cfg.GRPC.TLS = config.TLS{Enabled: true, CAFile: "not_exist"}
+		r := NewRootRuntime("test-version", &cfg)
+		err := r.Run()
+		assert.Error(t, err)
+	})
+	t.Run("create http client tls config fail", func(t *testing.T) {
+		defer func() {
+			cfg.HTTP.TLS = config.TLS{}
+		}()
+		cfg.HTTP.TLS = config.TLS{Enabled: true, CAFile: "not_exist"}
+		r := NewRootRuntime("test-version", &cfg)
+		err := r.Run()
+		assert.Error(t, err)
+	})
 	t.Run("start repo fail", func(t *testing.T) {
 		repoFct.EXPECT().CreateRootRepo(gomock.Any()).Return(nil, fmt.Errorf("err"))
 		r := NewRootRuntime("test-version", &cfg)
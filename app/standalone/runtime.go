@@ -33,12 +33,14 @@ import (
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/internal/bootstrap"
+	"github.com/lindb/lindb/internal/client"
 	"github.com/lindb/lindb/internal/monitoring"
 	"github.com/lindb/lindb/internal/server"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/option"
 	"github.com/lindb/lindb/pkg/state"
 	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/pkg/tlsutil"
 )
 
 // for testing
@@ -71,6 +73,13 @@ type runtime struct {
 // NewStandaloneRuntime creates the runtime
 func NewStandaloneRuntime(version string, cfg *config.Standalone, embedEtcd bool) server.Service {
 	ctx, cancel := context.WithCancel(context.Background())
+	adminAuth := client.WithBasicAuth(cfg.BrokerBase.Auth.Admin.UserName, cfg.BrokerBase.Auth.Admin.Password)
+	brokerEndpoint := fmt.Sprintf("http://localhost:%d", cfg.BrokerBase.HTTP.Port)
+	// ignore error, broker's http server will fail if tls config invalid
+	brokerTLSCfg, _ := tlsutil.NewClientConfig(&cfg.BrokerBase.HTTP.TLS)
+	if brokerTLSCfg != nil {
+		brokerEndpoint = fmt.Sprintf("https://localhost:%d", cfg.BrokerBase.HTTP.Port)
+	}
 	return &runtime{
 		version:     version,
 		embedEtcd:   embedEtcd,
@@ -96,7 +105,7 @@ func NewStandaloneRuntime(version string, cfg *config.Standalone, embedEtcd bool
 				Logging:     cfg.Logging,
 			}),
 		cfg:         cfg,
-		initializer: bootstrap.NewClusterInitializer(fmt.Sprintf("http://localhost:%d", cfg.BrokerBase.HTTP.Port)),
+		initializer: bootstrap.NewClusterInitializer(brokerEndpoint, adminAuth, client.WithTLSConfig(brokerTLSCfg)),
 		ctx:         ctx,
 		cancel:      cancel,
 	}
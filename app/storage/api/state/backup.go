@@ -0,0 +1,91 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package state
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/lindb/common/pkg/fileutil"
+	httppkg "github.com/lindb/common/pkg/http"
+
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/tsdb"
+)
+
+var (
+	BackupDatabasePath  = "/database/backup"
+	RestoreDatabasePath = "/database/restore"
+)
+
+// BackupAPI represents database backup/restore rest api.
+type BackupAPI struct {
+	engine tsdb.Engine
+}
+
+// NewBackupAPI creates a database backup api instance.
+func NewBackupAPI(engine tsdb.Engine) *BackupAPI {
+	return &BackupAPI{
+		engine: engine,
+	}
+}
+
+// Register adds database backup/restore url route.
+func (api *BackupAPI) Register(route gin.IRoutes) {
+	route.PUT(BackupDatabasePath, api.Backup)
+	route.PUT(RestoreDatabasePath, api.Restore)
+}
+
+// Backup backups the data of database into given path,
+// returns nil if database not exist in current node.
+func (api *BackupAPI) Backup(c *gin.Context) {
+	param := &models.BackupParam{}
+	if err := c.ShouldBind(param); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	if _, ok := api.engine.GetDatabase(param.Database); !ok {
+		httppkg.OK(c, nil)
+		return
+	}
+	manifest, err := api.engine.BackupDatabase(param.Database, param.Path)
+	if err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	httppkg.OK(c, manifest)
+}
+
+// Restore rebuilds the database from given backup path, returns the configuration of restored database,
+// returns nil if backup path not exist in current node.
+func (api *BackupAPI) Restore(c *gin.Context) {
+	param := &models.BackupParam{}
+	if err := c.ShouldBind(param); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	if !fileutil.Exist(param.Path) {
+		httppkg.OK(c, nil)
+		return
+	}
+	db, err := api.engine.RestoreDatabase(param.Database, param.Path)
+	if err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	httppkg.OK(c, db.GetConfig())
+}
@@ -0,0 +1,90 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package state
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/tsdb"
+)
+
+func TestBackupAPI_Backup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	engine := tsdb.NewMockEngine(ctrl)
+	api := NewBackupAPI(engine)
+	r := gin.New()
+	api.Register(r)
+
+	// case 1: params invalid
+	resp := mock.DoRequest(t, r, http.MethodPut, BackupDatabasePath, `{"database":"test"}`)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 2: database not exist
+	engine.EXPECT().GetDatabase("test").Return(nil, false)
+	resp = mock.DoRequest(t, r, http.MethodPut, BackupDatabasePath, `{"database":"test","path":"/tmp/backup"}`)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "null", resp.Body.String())
+	// case 3: backup failure
+	engine.EXPECT().GetDatabase("test").Return(tsdb.NewMockDatabase(ctrl), true).AnyTimes()
+	engine.EXPECT().BackupDatabase("test", "/tmp/backup").Return(nil, fmt.Errorf("err"))
+	resp = mock.DoRequest(t, r, http.MethodPut, BackupDatabasePath, `{"database":"test","path":"/tmp/backup"}`)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 4: backup successfully
+	engine.EXPECT().BackupDatabase("test", "/tmp/backup").Return(&models.BackupManifest{Database: "test"}, nil)
+	resp = mock.DoRequest(t, r, http.MethodPut, BackupDatabasePath, `{"database":"test","path":"/tmp/backup"}`)
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
+
+func TestBackupAPI_Restore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	engine := tsdb.NewMockEngine(ctrl)
+	api := NewBackupAPI(engine)
+	r := gin.New()
+	api.Register(r)
+	path := t.TempDir()
+	body := fmt.Sprintf(`{"database":"test","path":"%s"}`, path)
+
+	// case 1: params invalid
+	resp := mock.DoRequest(t, r, http.MethodPut, RestoreDatabasePath, `{"database":"test"}`)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 2: backup path not exist
+	resp = mock.DoRequest(t, r, http.MethodPut, RestoreDatabasePath, `{"database":"test","path":"/tmp/not_exist/backup"}`)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "null", resp.Body.String())
+	// case 3: restore failure
+	engine.EXPECT().RestoreDatabase("test", path).Return(nil, fmt.Errorf("err"))
+	resp = mock.DoRequest(t, r, http.MethodPut, RestoreDatabasePath, body)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 4: restore successfully
+	db := tsdb.NewMockDatabase(ctrl)
+	engine.EXPECT().RestoreDatabase("test", path).Return(db, nil)
+	db.EXPECT().GetConfig().Return(&models.DatabaseConfig{Name: "test", ShardIDs: []models.ShardID{1}})
+	resp = mock.DoRequest(t, r, http.MethodPut, RestoreDatabasePath, body)
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
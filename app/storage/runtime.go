@@ -43,11 +43,14 @@ import (
 	"github.com/lindb/lindb/internal/linmetric"
 	"github.com/lindb/lindb/internal/server"
 	"github.com/lindb/lindb/kv"
+	"github.com/lindb/lindb/kv/table"
 	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/blob"
 	"github.com/lindb/lindb/pkg/hostutil"
 	httppkg "github.com/lindb/lindb/pkg/http"
 	"github.com/lindb/lindb/pkg/state"
+	"github.com/lindb/lindb/pkg/tlsutil"
 	protoCommonV1 "github.com/lindb/lindb/proto/gen/v1/common"
 	protoReplicaV1 "github.com/lindb/lindb/proto/gen/v1/replica"
 	protoWriteV1 "github.com/lindb/lindb/proto/gen/v1/write"
@@ -84,6 +87,8 @@ var (
 	newDatabaseLifecycleFn    = NewDatabaseLifecycle
 	newEngineFn               = tsdb.NewEngine
 	newWriteAheadLogManagerFn = replica.NewWriteAheadLogManager
+	newBlobBackendFn          = blob.NewBackend
+	newCachedBlobBackendFn    = blob.NewCachedBackend
 	mkDirIfNotExistFn         = fileutil.MkDirIfNotExist
 	readFileFn                = os.ReadFile
 	writeFileFn               = os.WriteFile
@@ -168,6 +173,11 @@ func (r *runtime) Run() error {
 		return fmt.Errorf("failed to get server ip address, error: %s", err)
 	}
 
+	if err := r.initRemoteTier(); err != nil {
+		r.state = server.Failed
+		return err
+	}
+
 	r.jobScheduler = kv.NewJobScheduler(r.ctx, kv.DefaultCompactCheckInterval)
 	r.jobScheduler.Startup() // startup kv compact job scheduler
 
@@ -191,6 +201,7 @@ func (r *runtime) Run() error {
 			GRPCPort:   r.config.StorageBase.GRPC.Port,
 			HostName:   hostName,
 			HTTPPort:   r.config.StorageBase.HTTP.Port,
+			HTTPS:      r.config.StorageBase.HTTP.TLS.Enabled,
 			OnlineTime: timeutil.Now(),
 			Version:    config.Version,
 		},
@@ -202,6 +213,15 @@ func (r *runtime) Run() error {
 	}
 	r.BaseRuntime = app.NewBaseRuntimeFn(r.ctx, r.config.Monitor, linmetric.StorageRegistry, r.globalKeyValues)
 
+	// grpc client(storage->storage replica) uses same tls config with grpc server
+	clientTLSCfg, err := tlsutil.NewClientConfig(&r.config.StorageBase.GRPC.TLS)
+	if err != nil {
+		r.log.Error("failed to create grpc client tls config", logger.Error(err))
+		r.state = server.Failed
+		return err
+	}
+	rpc.GetStorageClientConnFactory().SetTLSConfig(clientTLSCfg)
+
 	walMgr := newWriteAheadLogManagerFn(
 		r.ctx,
 		r.config.StorageBase.WAL,
@@ -248,6 +268,26 @@ func (r *runtime) Run() error {
 	return nil
 }
 
+// initRemoteTier initializes the remote tier which old data is offloaded into, if tiered storage is enabled.
+func (r *runtime) initRemoteTier() error {
+	cfg := &r.config.StorageBase.Tiering
+	if !cfg.Enabled() {
+		return nil
+	}
+	backend, err := newBlobBackendFn(cfg)
+	if err != nil {
+		return fmt.Errorf("create tiering backend failure, err: %s", err)
+	}
+	backend, err = newCachedBlobBackendFn(backend, cfg.CacheDir, int64(cfg.CacheSize))
+	if err != nil {
+		return fmt.Errorf("create tiering cache failure, err: %s", err)
+	}
+	// objects of each storage node are stored under its own prefix
+	table.InitRemoteTier(&table.RemoteTier{Backend: backend, Prefix: strconv.Itoa(r.myID)})
+	r.log.Info("tiered storage enabled", logger.String("backend", cfg.Backend))
+	return nil
+}
+
 func (r *runtime) startStorageState() error {
 	// Use Leader election mechanism to ensure the uniqueness of stateful node id
 	if err := r.MustRegisterStatefulNode(); err != nil {
@@ -404,6 +444,8 @@ func (r *runtime) startHTTPServer() {
 	requestAPI.Register(v1)
 	metadataAPI := stateapi.NewMetadataAPI(r.engine)
 	metadataAPI.Register(v1)
+	backupAPI := stateapi.NewBackupAPI(r.engine)
+	backupAPI.Register(v1)
 
 	go r.runHTTPServer()
 }
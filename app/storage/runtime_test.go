@@ -39,7 +39,9 @@ import (
 	storagepkg "github.com/lindb/lindb/coordinator/storage"
 	"github.com/lindb/lindb/internal/mock"
 	"github.com/lindb/lindb/internal/server"
+	"github.com/lindb/lindb/kv/table"
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/blob"
 	"github.com/lindb/lindb/pkg/hostutil"
 	"github.com/lindb/lindb/pkg/http"
 	"github.com/lindb/lindb/pkg/state"
@@ -225,6 +227,42 @@ func TestStorageRun_Err(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestStorage_InitRemoteTier(t *testing.T) {
+	defer func() {
+		newBlobBackendFn = blob.NewBackend
+		newCachedBlobBackendFn = blob.NewCachedBackend
+		table.InitRemoteTier(nil)
+	}()
+	r := &runtime{
+		myID:   1,
+		log:    logger.GetLogger("Storage", "Runtime"),
+		config: &config.Storage{},
+	}
+	// tiered storage disabled
+	assert.NoError(t, r.initRemoteTier())
+	assert.Nil(t, table.GetRemoteTier())
+
+	r.config.StorageBase.Tiering = config.Tiering{
+		Backend:   blob.LocalBackend,
+		Dir:       filepath.Join(t.TempDir(), "tiering"),
+		CacheDir:  filepath.Join(t.TempDir(), "cache"),
+		CacheSize: 1024,
+	}
+	assert.NoError(t, r.initRemoteTier())
+	assert.NotNil(t, table.GetRemoteTier())
+	assert.Equal(t, "1", table.GetRemoteTier().Prefix)
+
+	newCachedBlobBackendFn = func(_ blob.Backend, _ string, _ int64) (blob.Backend, error) {
+		return nil, fmt.Errorf("err")
+	}
+	assert.Error(t, r.initRemoteTier())
+	newBlobBackendFn = func(_ *config.Tiering) (blob.Backend, error) {
+		return nil, fmt.Errorf("err")
+	}
+	assert.Error(t, r.initRemoteTier())
+
+}
+
 func TestStorage_StartStorageState_Error(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer func() {
@@ -58,6 +58,9 @@ type inputCtx struct {
 
 var (
 	endpoint string
+	username string
+	password string
+	apiToken string
 	// tokens represents suggest token.
 	tokens = []prompt.Suggest{
 		{Text: "show"},
@@ -85,6 +88,14 @@ var (
 		{Text: "key"},
 		{Text: "values"},
 		{Text: "and"},
+		{Text: "continuous"},
+		{Text: "query"},
+		{Text: "queries"},
+		{Text: "every"},
+		{Text: "into"},
+		{Text: "alerts"},
+		{Text: "delete"},
+		{Text: "drop"},
 	}
 	spacesPattern = regexp.MustCompile(`\s+`)
 	inputC        = &inputCtx{}
@@ -95,6 +106,9 @@ var (
 
 func init() {
 	flag.StringVar(&endpoint, "endpoint", "http://localhost:9000", "Broker HTTP Endpoint")
+	flag.StringVar(&username, "username", "", "Username of basic auth, if broker auth enabled")
+	flag.StringVar(&password, "password", "", "Password of basic auth, if broker auth enabled")
+	flag.StringVar(&apiToken, "token", "", "API token, if broker auth enabled")
 }
 
 // printErr prints error message.
@@ -157,6 +171,22 @@ func executor(in string) {
 					return
 				}
 				result = &commonmodels.Metadata{}
+			case *stmtpkg.ContinuousQuery:
+				if strings.TrimSpace(inputC.db) == "" {
+					printErr(errors.New("please select database(use ...)"))
+					return
+				}
+				if s.Type == stmtpkg.ShowContinuousQueries {
+					result = &models.ContinuousQueries{}
+				}
+			case *stmtpkg.Alert:
+				result = &models.Alerts{}
+			case *stmtpkg.Delete, *stmtpkg.DropMetric:
+				if strings.TrimSpace(inputC.db) == "" {
+					printErr(errors.New("please select database(use ...)"))
+					return
+				}
+				result = &models.DeleteResult{}
 			case *stmtpkg.Query:
 				result = &commonmodels.ResultSet{}
 				if strings.TrimSpace(inputC.db) == "" {
@@ -204,7 +234,7 @@ func main() {
 	_ = logger.InitLogger(commonlogger.Setting{Level: "error", Dir: "."}, "lin-cli.log")
 
 	apiEndpoint := endpoint + constants.APIVersion1CliPath
-	cli = newExecuteCli(apiEndpoint)
+	cli = newExecuteCli(apiEndpoint, client.WithBasicAuth(username, password), client.WithToken(apiToken))
 
 	// first retry connect and get master state
 	master := &models.Master{}
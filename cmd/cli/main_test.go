@@ -56,7 +56,7 @@ func Test_main(t *testing.T) {
 		{
 			name: "get master successfully",
 			prepare: func() {
-				newExecuteCli = func(endpoint string) client.ExecuteCli {
+				newExecuteCli = func(endpoint string, _ ...client.Option) client.ExecuteCli {
 					return cli
 				}
 				cli.EXPECT().Execute(gomock.Any(), gomock.Any()).
@@ -0,0 +1,258 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/lindb/common/pkg/timeutil"
+	"github.com/spf13/cobra"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/ingestion/export"
+	"github.com/lindb/lindb/internal/client"
+	"github.com/lindb/lindb/models"
+)
+
+// importChunkSize represents the max size of data imported by one request.
+const importChunkSize = 4 * 1024 * 1024
+
+// for testing
+var (
+	newDataCli = client.NewDataCli
+)
+
+var (
+	endpoint     string
+	username     string
+	password     string
+	apiToken     string
+	database     string
+	namespace    string
+	metricName   string
+	startTime    string
+	endTime      string
+	format       string
+	outputFile   string
+	inputFile    string
+	precision    string
+	importFormat string
+)
+
+func addBrokerFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&endpoint, "endpoint", "http://localhost:9000", "Broker HTTP Endpoint")
+	cmd.Flags().StringVar(&username, "username", "", "Username of basic auth, if broker auth enabled")
+	cmd.Flags().StringVar(&password, "password", "", "Password of basic auth, if broker auth enabled")
+	cmd.Flags().StringVar(&apiToken, "token", "", "API token, if broker auth enabled")
+	cmd.Flags().StringVar(&database, "db", "", "database name")
+	_ = cmd.MarkFlagRequired("db")
+}
+
+func newExportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export data of database to portable file via broker",
+		Args:  cobra.NoArgs,
+		RunE:  runExport,
+	}
+	addBrokerFlags(cmd)
+	cmd.Flags().StringVar(&namespace, "ns", "", "only export given namespace")
+	cmd.Flags().StringVar(&metricName, "metric", "", "only export given metric")
+	cmd.Flags().StringVar(&startTime, "start", "",
+		"start time(millisecond or '2006-01-02 15:04:05'), default: now - retention")
+	cmd.Flags().StringVar(&endTime, "end", "", "end time(millisecond or '2006-01-02 15:04:05'), default: now")
+	cmd.Flags().StringVar(&format, "format", export.FormatNative, "file format, native or line(influxdb line protocol)")
+	cmd.Flags().StringVarP(&outputFile, "output", "o", "", "output file, default: stdout")
+	return cmd
+}
+
+func newImportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import exported file into database via broker, original timestamps are preserved",
+		Args:  cobra.NoArgs,
+		RunE:  runImport,
+	}
+	addBrokerFlags(cmd)
+	cmd.Flags().StringVar(&namespace, "ns", "",
+		"namespace of imported data, default: original namespace for native format, default-ns for line format")
+	cmd.Flags().StringVar(&importFormat, "format", "", "file format, native or line, detect by file header if not set")
+	cmd.Flags().StringVar(&precision, "precision", "", "timestamp precision of line format, default: ms")
+	cmd.Flags().StringVarP(&inputFile, "input", "i", "", "input file")
+	_ = cmd.MarkFlagRequired("input")
+	return cmd
+}
+
+func newCli() client.DataCli {
+	return newDataCli(endpoint+constants.APIVersion1CliPath,
+		client.WithBasicAuth(username, password), client.WithToken(apiToken))
+}
+
+func runExport(cmd *cobra.Command, _ []string) error {
+	if !export.IsValidFormat(format) {
+		return fmt.Errorf("unknown export format: %s", format)
+	}
+	start, err := parseTime(startTime)
+	if err != nil {
+		return err
+	}
+	end, err := parseTime(endTime)
+	if err != nil {
+		return err
+	}
+	w := cmd.OutOrStdout()
+	if outputFile != "" {
+		f, err := os.Create(outputFile)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = f.Close()
+		}()
+		w = f
+	}
+	bw := bufio.NewWriter(w)
+	if err := newCli().Export(models.ExportParam{
+		Database:  database,
+		Namespace: namespace,
+		Metric:    metricName,
+		Start:     start,
+		End:       end,
+		Format:    format,
+	}, bw); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+func runImport(cmd *cobra.Command, _ []string) error {
+	f, err := os.Open(inputFile)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	r := bufio.NewReader(f)
+	fileFormat := importFormat
+	if fileFormat == "" {
+		fileFormat = export.FormatLine
+		header, _ := r.Peek(export.HeaderSize)
+		if export.HasHeader(header) {
+			fileFormat = export.FormatNative
+		}
+	}
+	cli := newCli()
+	param := models.ImportParam{
+		Database:  database,
+		Namespace: namespace,
+		Format:    fileFormat,
+		Precision: precision,
+	}
+	total := 0
+	send := func(chunk *bytes.Buffer) error {
+		if chunk.Len() == 0 {
+			return nil
+		}
+		rs, err := cli.Import(param, chunk.Bytes())
+		if err != nil {
+			return err
+		}
+		total += rs.Metrics
+		chunk.Reset()
+		return nil
+	}
+	switch fileFormat {
+	case export.FormatNative:
+		err = importNative(r, send)
+	case export.FormatLine:
+		err = importLine(r, send)
+	default:
+		err = fmt.Errorf("unknown import format: %s", fileFormat)
+	}
+	if err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(cmd.OutOrStdout(), "imported %d metrics\n", total)
+	return nil
+}
+
+// importNative sends records of native file by chunks.
+func importNative(r io.Reader, send func(chunk *bytes.Buffer) error) error {
+	reader := export.NewReader(r)
+	if err := reader.ReadHeader(); err != nil {
+		return err
+	}
+	var chunk bytes.Buffer
+	for {
+		payload, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			return send(&chunk)
+		}
+		if err != nil {
+			return err
+		}
+		_ = export.WriteRecord(&chunk, payload)
+		if chunk.Len() >= importChunkSize {
+			if err := send(&chunk); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// importLine sends lines of line protocol file by chunks.
+func importLine(r io.Reader, send func(chunk *bytes.Buffer) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), importChunkSize)
+	var chunk bytes.Buffer
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		chunk.Write(line)
+		chunk.WriteByte('\n')
+		if chunk.Len() >= importChunkSize {
+			if err := send(&chunk); err != nil {
+				return err
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	return send(&chunk)
+}
+
+// parseTime parses timestamp(millisecond) or date time string, returns 0 if empty.
+func parseTime(s string) (int64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	if timestamp, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return timestamp, nil
+	}
+	return timeutil.ParseTimestamp(s)
+}
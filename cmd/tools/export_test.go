@@ -0,0 +1,211 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	protoMetricsV1 "github.com/lindb/common/proto/gen/v1/linmetrics"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/lindb/lindb/ingestion/export"
+	"github.com/lindb/lindb/internal/client"
+	"github.com/lindb/lindb/models"
+)
+
+func runCmd(cmd *cobra.Command, args ...string) (string, error) {
+	w := &bytes.Buffer{}
+	cmd.SetOut(w)
+	cmd.SetErr(io.Discard)
+	cmd.SetArgs(args)
+	err := cmd.Execute()
+	return w.String(), err
+}
+
+func TestExportCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newDataCli = client.NewDataCli
+		ctrl.Finish()
+	}()
+	cli := client.NewMockDataCli(ctrl)
+	newDataCli = func(endpoint string, _ ...client.Option) client.DataCli {
+		assert.Equal(t, "http://localhost:9000/api/v1", endpoint)
+		return cli
+	}
+
+	// db required
+	_, err := runCmd(newExportCmd())
+	assert.Error(t, err)
+	// bad format
+	_, err = runCmd(newExportCmd(), "--db", "test", "--format", "csv")
+	assert.Error(t, err)
+	// bad time
+	_, err = runCmd(newExportCmd(), "--db", "test", "--start", "abc")
+	assert.Error(t, err)
+	_, err = runCmd(newExportCmd(), "--db", "test", "--end", "abc")
+	assert.Error(t, err)
+	// export failure
+	cli.EXPECT().Export(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+	_, err = runCmd(newExportCmd(), "--db", "test")
+	assert.Error(t, err)
+
+	// export to stdout
+	cli.EXPECT().Export(gomock.Any(), gomock.Any()).DoAndReturn(func(param models.ExportParam, w io.Writer) error {
+		assert.Equal(t, models.ExportParam{
+			Database: "test", Namespace: "ns", Metric: "cpu", Start: 1000, End: param.End, Format: export.FormatLine,
+		}, param)
+		assert.True(t, param.End > 0)
+		_, _ = w.Write([]byte("data"))
+		return nil
+	})
+	out, err := runCmd(newExportCmd(), "--db", "test", "--ns", "ns", "--metric", "cpu",
+		"--start", "1000", "--end", "2024-01-01 00:00:00", "--format", "line")
+	assert.NoError(t, err)
+	assert.Equal(t, "data", out)
+
+	// export to file
+	file := filepath.Join(t.TempDir(), "export.data")
+	cli.EXPECT().Export(gomock.Any(), gomock.Any()).DoAndReturn(func(_ models.ExportParam, w io.Writer) error {
+		_, _ = w.Write([]byte("data"))
+		return nil
+	})
+	_, err = runCmd(newExportCmd(), "--db", "test", "-o", file)
+	assert.NoError(t, err)
+	data, err := os.ReadFile(file)
+	assert.NoError(t, err)
+	assert.Equal(t, "data", string(data))
+	// create file failure
+	_, err = runCmd(newExportCmd(), "--db", "test", "-o", filepath.Join(file, "a"))
+	assert.Error(t, err)
+}
+
+func writeNativeFile(t *testing.T, count int, end bool) string {
+	var buf bytes.Buffer
+	encoder, err := export.NewEncoder(export.FormatNative, &buf)
+	assert.NoError(t, err)
+	for i := 0; i < count; i++ {
+		assert.NoError(t, encoder.Encode(&protoMetricsV1.Metric{
+			Name:      "cpu",
+			Timestamp: int64(i),
+			SimpleFields: []*protoMetricsV1.SimpleField{
+				{Name: "count", Type: protoMetricsV1.SimpleFieldType_DELTA_SUM, Value: 1},
+			},
+		}))
+	}
+	if end {
+		assert.NoError(t, encoder.Close())
+	} else {
+		assert.NoError(t, encoder.Flush())
+	}
+	file := filepath.Join(t.TempDir(), "export.data")
+	assert.NoError(t, os.WriteFile(file, buf.Bytes(), 0o644))
+	return file
+}
+
+func TestImportCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newDataCli = client.NewDataCli
+		ctrl.Finish()
+	}()
+	cli := client.NewMockDataCli(ctrl)
+	newDataCli = func(_ string, _ ...client.Option) client.DataCli {
+		return cli
+	}
+
+	// input required
+	_, err := runCmd(newImportCmd(), "--db", "test")
+	assert.Error(t, err)
+	// file not exist
+	_, err = runCmd(newImportCmd(), "--db", "test", "-i", filepath.Join(t.TempDir(), "not_exist"))
+	assert.Error(t, err)
+
+	// native format
+	file := writeNativeFile(t, 10, true)
+	cli.EXPECT().Import(gomock.Any(), gomock.Any()).DoAndReturn(func(param models.ImportParam, data []byte) (*models.ImportResult, error) {
+		assert.Equal(t, models.ImportParam{Database: "test", Format: export.FormatNative}, param)
+		assert.False(t, export.HasHeader(data))
+		return &models.ImportResult{Metrics: 10}, nil
+	})
+	out, err := runCmd(newImportCmd(), "--db", "test", "-i", file, "--ns", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "imported 10 metrics\n", out)
+	// import failure
+	cli.EXPECT().Import(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+	_, err = runCmd(newImportCmd(), "--db", "test", "-i", file)
+	assert.Error(t, err)
+	// data truncated
+	_, err = runCmd(newImportCmd(), "--db", "test", "-i", writeNativeFile(t, 10, false))
+	assert.ErrorIs(t, err, export.ErrMissingEnd)
+	// bad header
+	lineFile := filepath.Join(t.TempDir(), "export.line")
+	assert.NoError(t, os.WriteFile(lineFile, []byte("cpu count=1 1000\n\ncpu count=2 2000\n"), 0o644))
+	_, err = runCmd(newImportCmd(), "--db", "test", "-i", lineFile, "--format", "native")
+	assert.Error(t, err)
+	// unknown format
+	_, err = runCmd(newImportCmd(), "--db", "test", "-i", lineFile, "--format", "csv")
+	assert.Error(t, err)
+
+	// line format
+	cli.EXPECT().Import(gomock.Any(), gomock.Any()).DoAndReturn(func(param models.ImportParam, data []byte) (*models.ImportResult, error) {
+		assert.Equal(t, export.FormatLine, param.Format)
+		assert.Equal(t, "cpu count=1 1000\ncpu count=2 2000\n", string(data))
+		return &models.ImportResult{Metrics: 2}, nil
+	})
+	out, err = runCmd(newImportCmd(), "--db", "test", "-i", lineFile, "--format", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "imported 2 metrics\n", out)
+
+	// large file is imported by chunks
+	var buf strings.Builder
+	line := "cpu,host=" + strings.Repeat("a", 1024) + " count=1 1000\n"
+	for buf.Len() < importChunkSize+len(line) {
+		buf.WriteString(line)
+	}
+	assert.NoError(t, os.WriteFile(lineFile, []byte(buf.String()), 0o644))
+	cli.EXPECT().Import(gomock.Any(), gomock.Any()).Return(&models.ImportResult{Metrics: 1}, nil).Times(2)
+	out, err = runCmd(newImportCmd(), "--db", "test", "-i", lineFile)
+	assert.NoError(t, err)
+	assert.Equal(t, "imported 2 metrics\n", out)
+	cli.EXPECT().Import(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+	_, err = runCmd(newImportCmd(), "--db", "test", "-i", lineFile)
+	assert.Error(t, err)
+}
+
+func TestParseTime(t *testing.T) {
+	timestamp, err := parseTime("")
+	assert.NoError(t, err)
+	assert.Zero(t, timestamp)
+	timestamp, err = parseTime("1000")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1000), timestamp)
+	timestamp, err = parseTime("2024-01-01 00:00:00")
+	assert.NoError(t, err)
+	assert.True(t, timestamp > 0)
+	_, err = parseTime("abc")
+	assert.Error(t, err)
+}
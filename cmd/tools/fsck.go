@@ -0,0 +1,147 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/lindb/lindb/kv"
+	"github.com/lindb/lindb/kv/table"
+	"github.com/lindb/lindb/kv/version"
+
+	// register block inspector of metric data family
+	_ "github.com/lindb/lindb/tsdb/tblstore/metricsdata"
+)
+
+// for testing
+var (
+	fsckFunc      = kv.Fsck
+	dumpTableFunc = kv.DumpTable
+)
+
+var (
+	quarantine      bool
+	rebuildManifest bool
+	dumpFamily      string
+	dumpFile        int64
+	dumpKeys        []uint
+)
+
+func newFsckCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fsck [store path]",
+		Short: "Check(and repair) kv store files offline, storage node must be stopped",
+		Args:  cobra.ExactArgs(1),
+		RunE:  runFsck,
+	}
+	cmd.Flags().BoolVar(&quarantine, "quarantine", false,
+		"move corrupted table files into quarantine dir, then remove them from manifest")
+	cmd.Flags().BoolVar(&rebuildManifest, "rebuild-manifest", false,
+		"rebuild manifest based on table files on disk if current manifest cannot be loaded")
+	return cmd
+}
+
+func newDumpCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dump [store path]",
+		Short: "Dump keys and decoded values of kv table file",
+		Args:  cobra.ExactArgs(1),
+		RunE:  runDump,
+	}
+	cmd.Flags().StringVar(&dumpFamily, "family", "", "family name of table file")
+	cmd.Flags().Int64Var(&dumpFile, "file", 0, "file number of table file")
+	cmd.Flags().UintSliceVar(&dumpKeys, "key", nil, "only dump given keys")
+	_ = cmd.MarkFlagRequired("family")
+	_ = cmd.MarkFlagRequired("file")
+	return cmd
+}
+
+func runFsck(cmd *cobra.Command, args []string) error {
+	report, err := fsckFunc(args[0], kv.FsckOption{
+		Quarantine:      quarantine,
+		RebuildManifest: rebuildManifest,
+	})
+	if err != nil {
+		return err
+	}
+	printFsckReport(cmd.OutOrStdout(), report)
+	if !report.Healthy() && !report.Repaired {
+		return errors.New("problems found in store")
+	}
+	return nil
+}
+
+func runDump(cmd *cobra.Command, args []string) error {
+	keys := make([]uint32, len(dumpKeys))
+	for idx, key := range dumpKeys {
+		keys[idx] = uint32(key)
+	}
+	return dumpTableFunc(cmd.OutOrStdout(), args[0], dumpFamily, table.FileNumber(dumpFile), keys)
+}
+
+// printFsckReport prints families/manifests and the check result of each file.
+func printFsckReport(w io.Writer, report *kv.FsckReport) {
+	_, _ = fmt.Fprintf(w, "store: %s\n", report.Path)
+	_, _ = fmt.Fprintf(w, "manifest: %s, all: [%s]\n", report.Manifest, strings.Join(report.Manifests, ","))
+	if report.ManifestErr != nil {
+		_, _ = fmt.Fprintf(w, "manifest error: %s\n", report.ManifestErr)
+	}
+	printFile := func(kind string, file *kv.FileReport) {
+		state := "ok"
+		switch {
+		case file.Remote:
+			state = "offloaded"
+		case file.Err != nil:
+			state = fmt.Sprintf("error: %s", file.Err)
+		}
+		_, _ = fmt.Fprintf(w, "  %s: %s, size: %d, keys: %d, range: [%d,%d], %s\n",
+			kind, version.Table(file.FileNumber), file.Size, file.Keys, file.MinKey, file.MaxKey, state)
+	}
+	for _, family := range report.Families {
+		_, _ = fmt.Fprintf(w, "family: %s, id: %d, merger: %s\n", family.Name, family.ID, family.Merger)
+		for _, file := range family.Files {
+			kind := fmt.Sprintf("level%d", file.Level)
+			if file.Level < 0 {
+				kind = "rollup"
+			}
+			printFile(kind, file)
+		}
+		for _, file := range family.Orphans {
+			printFile("orphan", file)
+		}
+		for _, fileNumber := range family.Missing {
+			_, _ = fmt.Fprintf(w, "  missing: %s\n", version.Table(fileNumber))
+		}
+		for _, fileNumber := range family.Quarantined {
+			_, _ = fmt.Fprintf(w, "  quarantined: %s\n", version.Table(fileNumber))
+		}
+	}
+	switch {
+	case report.Repaired:
+		_, _ = fmt.Fprintln(w, "result: repaired, manifest rewritten")
+	case report.Healthy():
+		_, _ = fmt.Fprintln(w, "result: healthy")
+	default:
+		_, _ = fmt.Fprintln(w, "result: problems found")
+	}
+}
@@ -0,0 +1,128 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/kv"
+	"github.com/lindb/lindb/kv/table"
+)
+
+func TestFsckCmd(t *testing.T) {
+	defer func() {
+		fsckFunc = kv.Fsck
+	}()
+	run := func(report *kv.FsckReport, err error, args ...string) (string, error) {
+		fsckFunc = func(_ string, _ kv.FsckOption) (*kv.FsckReport, error) {
+			return report, err
+		}
+		cmd := newFsckCmd()
+		w := &bytes.Buffer{}
+		cmd.SetOut(w)
+		cmd.SetErr(io.Discard)
+		cmd.SetArgs(args)
+		execErr := cmd.Execute()
+		return w.String(), execErr
+	}
+	// fsck failure
+	_, err := run(nil, fmt.Errorf("err"), "/tmp/store")
+	assert.Error(t, err)
+	// store path required
+	_, err = run(nil, nil)
+	assert.Error(t, err)
+
+	// healthy
+	report := &kv.FsckReport{
+		Path:      "/tmp/store",
+		Manifest:  "MANIFEST-000010",
+		Manifests: []string{"MANIFEST-000010"},
+		Families: []*kv.FamilyReport{{
+			Name: "f", ID: 1, Merger: "merger",
+			Files: []*kv.FileReport{
+				{FileNumber: 11, Level: 0, Size: 100, Keys: 2, MinKey: 1, MaxKey: 10},
+				{FileNumber: 12, Level: -1, Remote: true},
+			},
+		}},
+	}
+	out, err := run(report, nil, "/tmp/store")
+	assert.NoError(t, err)
+	assert.Contains(t, out, "family: f, id: 1, merger: merger")
+	assert.Contains(t, out, "level0: 000011.sst, size: 100, keys: 2, range: [1,10], ok")
+	assert.Contains(t, out, "rollup: 000012.sst, size: 0, keys: 0, range: [0,0], offloaded")
+	assert.Contains(t, out, "result: healthy")
+
+	// problems found
+	report.ManifestErr = fmt.Errorf("bad manifest")
+	report.Families[0].Files[0].Err = fmt.Errorf("bad file")
+	report.Families[0].Orphans = []*kv.FileReport{{FileNumber: 13, Level: -1}}
+	report.Families[0].Missing = []table.FileNumber{14}
+	out, err = run(report, nil, "/tmp/store")
+	assert.Error(t, err)
+	assert.Contains(t, out, "manifest error: bad manifest")
+	assert.Contains(t, out, "error: bad file")
+	assert.Contains(t, out, "orphan: 000013.sst")
+	assert.Contains(t, out, "missing: 000014.sst")
+	assert.Contains(t, out, "result: problems found")
+
+	// repaired
+	report.Repaired = true
+	report.Families[0].Quarantined = []table.FileNumber{11}
+	out, err = run(report, nil, "/tmp/store", "--quarantine")
+	assert.NoError(t, err)
+	assert.Contains(t, out, "quarantined: 000011.sst")
+	assert.Contains(t, out, "result: repaired")
+}
+
+func TestDumpCmd(t *testing.T) {
+	defer func() {
+		dumpTableFunc = kv.DumpTable
+	}()
+	var (
+		family string
+		file   table.FileNumber
+		keys   []uint32
+	)
+	dumpTableFunc = func(_ io.Writer, _, familyName string, fileNumber table.FileNumber, dumpKeys []uint32) error {
+		family, file, keys = familyName, fileNumber, dumpKeys
+		return nil
+	}
+	run := func(args ...string) error {
+		cmd := newDumpCmd()
+		cmd.SetOut(io.Discard)
+		cmd.SetErr(io.Discard)
+		cmd.SetArgs(args)
+		return cmd.Execute()
+	}
+	// family/file required
+	assert.Error(t, run("/tmp/store"))
+	assert.NoError(t, run("/tmp/store", "--family", "f", "--file", "10", "--key", "1,2"))
+	assert.Equal(t, "f", family)
+	assert.Equal(t, table.FileNumber(10), file)
+	assert.Equal(t, []uint32{1, 2}, keys)
+
+	dumpTableFunc = func(_ io.Writer, _, _ string, _ table.FileNumber, _ []uint32) error {
+		return fmt.Errorf("err")
+	}
+	assert.Error(t, run("/tmp/store", "--family", "f", "--file", "10"))
+}
@@ -33,6 +33,10 @@ var RootCmd = &cobra.Command{
 func init() {
 	RootCmd.AddCommand(
 		keyWordsCmd,
+		newFsckCmd(),
+		newDumpCmd(),
+		newExportCmd(),
+		newImportCmd(),
 	)
 }
 
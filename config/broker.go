@@ -31,6 +31,7 @@ type HTTP struct {
 	IdleTimeout  ltoml.Duration `env:"IDLE_TIMEOUT" toml:"idle-timeout"`
 	WriteTimeout ltoml.Duration `env:"WRITE_TIMEOUT" toml:"write-timeout"`
 	ReadTimeout  ltoml.Duration `env:"READ_TIMEOUT" toml:"read-timeout"`
+	TLS          TLS            `envPrefix:"TLS_" toml:"tls"`
 }
 
 func (h *HTTP) TOML() string {
@@ -93,10 +94,25 @@ ingest-timeout = "%s"`,
 		i.IngestTimeout.Duration().String())
 }
 
+// ContinuousQuery represents the configuration of continuous query execution.
+type ContinuousQuery struct {
+	ExecuteDelay ltoml.Duration `env:"EXECUTE_DELAY" toml:"execute-delay"`
+}
+
+func (cq *ContinuousQuery) TOML() string {
+	return fmt.Sprintf(`
+## Delay after the end of window before executing continuous query, waits late data arrived.
+## Default: %s
+## Env: LINDB_BROKER_CQ_EXECUTE_DELAY
+execute-delay = "%s"`,
+		cq.ExecuteDelay.Duration().String(),
+		cq.ExecuteDelay.Duration().String())
+}
+
 // User represents user model
 type User struct {
-	UserName string `toml:"username" json:"username" binding:"required"`
-	Password string `toml:"password" json:"password" binding:"required"`
+	UserName string `env:"USERNAME" toml:"username" json:"username" binding:"required"`
+	Password string `env:"PASSWORD" toml:"password" json:"password" binding:"required"`
 }
 
 func (u *User) TOML() string {
@@ -108,6 +124,32 @@ password = "%s"`,
 		u.Password)
 }
 
+// Auth represents authentication/authorization config of broker.
+type Auth struct {
+	Enabled bool `env:"ENABLED" toml:"enabled"`
+	// Admin represents the super user, which has all permissions.
+	Admin User `envPrefix:"ADMIN_" toml:"admin"`
+}
+
+func (a *Auth) TOML() string {
+	return fmt.Sprintf(`
+## Enable authentication/authorization for HTTP API(write/query/admin).
+## Users, roles and api tokens are stored in state repository,
+## each role grants read/write/admin permissions of databases.
+## Default: %v
+## Env: LINDB_BROKER_AUTH_ENABLED
+enabled = %v
+
+## Super user which has all permissions, required if auth enabled.
+## Env: LINDB_BROKER_AUTH_ADMIN_USERNAME
+## Env: LINDB_BROKER_AUTH_ADMIN_PASSWORD
+[broker.auth.admin]%s`,
+		a.Enabled,
+		a.Enabled,
+		a.Admin.TOML(),
+	)
+}
+
 // Write represents config for write replication in broker.
 type Write struct {
 	BatchTimeout   ltoml.Duration `env:"BATCH_TIMEOUT" toml:"batch-timeout"`
@@ -146,6 +188,9 @@ type BrokerBase struct {
 	Ingestion Ingestion      `envPrefix:"INGESTION_" toml:"ingestion"`
 	Write     Write          `envPrefix:"WRITE_" toml:"write"`
 	GRPC      GRPC           `envPrefix:"GRPC_" toml:"grpc"`
+	Auth      Auth           `envPrefix:"AUTH_" toml:"auth"`
+	// ContinuousQuery represents the configuration of continuous query execution.
+	ContinuousQuery ContinuousQuery `envPrefix:"CQ_" toml:"continuous-query"`
 }
 
 // TOML returns broker's base configuration string as toml format.
@@ -162,6 +207,9 @@ slow-sql = "%s"
 ## Controls how HTTP Server are configured.
 [broker.http]%s
 
+## Controls TLS(HTTPS) of HTTP Server.
+[broker.http.tls]%s
+
 ## Ingestion configuration for broker handle ingest request.
 [broker.ingestion]%s
 
@@ -169,13 +217,26 @@ slow-sql = "%s"
 [broker.write]%s
 
 ## Controls how GRPC Server are configured.
-[broker.grpc]%s`,
+[broker.grpc]%s
+
+## Controls TLS of GRPC Server and GRPC client between broker and storage nodes.
+[broker.grpc.tls]%s
+
+## Controls authentication/authorization of broker.
+[broker.auth]%s
+
+## Controls how continuous queries are executed.
+[broker.continuous-query]%s`,
 		bb.SlowSQL.String(),
 		bb.SlowSQL.String(),
 		bb.HTTP.TOML(),
+		bb.HTTP.TLS.TOML("LINDB_BROKER_HTTP_TLS"),
 		bb.Ingestion.TOML(),
 		bb.Write.TOML(),
 		bb.GRPC.TOML(),
+		bb.GRPC.TLS.TOML("LINDB_BROKER_GRPC_TLS"),
+		bb.Auth.TOML(),
+		bb.ContinuousQuery.TOML(),
 	)
 }
 
@@ -202,6 +263,12 @@ func NewDefaultBrokerBase() *BrokerBase {
 			MaxConcurrentStreams: 1024,
 			ConnectTimeout:       ltoml.Duration(time.Second * 3),
 		},
+		Auth: Auth{
+			Admin: User{UserName: "admin"},
+		},
+		ContinuousQuery: ContinuousQuery{
+			ExecuteDelay: ltoml.Duration(time.Second * 10),
+		},
 	}
 }
 
@@ -274,6 +341,9 @@ func checkBrokerBaseCfg(brokerBaseCfg *BrokerBase) error {
 	if brokerBaseCfg.HTTP.IdleTimeout <= 0 {
 		brokerBaseCfg.HTTP.IdleTimeout = defaultBrokerCfg.HTTP.IdleTimeout
 	}
+	if err := checkTLSCfg(&brokerBaseCfg.HTTP.TLS, true); err != nil {
+		return err
+	}
 
 	// ingestion
 	if brokerBaseCfg.Ingestion.IngestTimeout <= 0 {
@@ -292,6 +362,15 @@ func checkBrokerBaseCfg(brokerBaseCfg *BrokerBase) error {
 	if brokerBaseCfg.Write.GCTaskInterval <= 0 {
 		brokerBaseCfg.Write.GCTaskInterval = defaultBrokerCfg.Write.GCTaskInterval
 	}
+	// continuous query check
+	if brokerBaseCfg.ContinuousQuery.ExecuteDelay <= 0 {
+		brokerBaseCfg.ContinuousQuery.ExecuteDelay = defaultBrokerCfg.ContinuousQuery.ExecuteDelay
+	}
+	// auth check
+	if brokerBaseCfg.Auth.Enabled &&
+		(brokerBaseCfg.Auth.Admin.UserName == "" || brokerBaseCfg.Auth.Admin.Password == "") {
+		return fmt.Errorf("admin username/password cannot be empty when auth enabled")
+	}
 
 	return nil
 }
@@ -48,37 +48,52 @@ func TestDumpExampleCfg(t *testing.T) {
 func TestBroker_Env(t *testing.T) {
 	cfg := Broker{}
 	opts := env.Options{Environment: map[string]string{
-		"LINDB_COORDINATOR_NAMESPACE":              "ns",
-		"LINDB_COORDINATOR_ENDPOINTS":              "endpoint1,endpoint2",
-		"LINDB_COORDINATOR_LEASE_TTL":              "60s",
-		"LINDB_COORDINATOR_TIMEOUT":                "60s",
-		"LINDB_COORDINATOR_DIAL_TIMEOUT":           "60s",
-		"LINDB_COORDINATOR_USERNAME":               "LinDB",
-		"LINDB_COORDINATOR_PASSWORD":               "pwd",
-		"LINDB_QUERY_CONCURRENCY":                  "100",
-		"LINDB_QUERY_IDLE_TIMEOUT":                 "100s",
-		"LINDB_QUERY_TIMEOUT":                      "120s",
-		"LINDB_BROKER_SLOW_SQL":                    "120s",
-		"LINDB_BROKER_HTTP_PORT":                   "3000",
-		"LINDB_BROKER_HTTP_IDLE_TIMEOUT":           "120s",
-		"LINDB_BROKER_HTTP_WRITE_TIMEOUT":          "120s",
-		"LINDB_BROKER_HTTP_READ_TIMEOUT":           "2m",
-		"LINDB_BROKER_INGESTION_CONCURRENCY":       "100",
-		"LINDB_BROKER_INGESTION_TIMEOUT":           "2m",
-		"LINDB_BROKER_WRITE_BATCH_TIMEOUT":         "2m",
-		"LINDB_BROKER_WRITE_BLOCK_SIZE":            "1Mib",
-		"LINDB_BROKER_WRITE_GC_INTERVAL":           "2m",
-		"LINDB_BROKER_GRPC_PORT":                   "2899",
-		"LINDB_BROKER_GRPC_MAX_CONCURRENT_STREAMS": "10000",
-		"LINDB_BROKER_GRPC_CONNECT_TIMEOUT":        "2m",
-		"LINDB_MONITOR_PUSH_TIMEOUT":               "2m",
-		"LINDB_MONITOR_REPORT_INTERVAL":            "2m",
-		"LINDB_MONITOR_URL":                        "monitor_url",
-		"LINDB_LOGGING_DIR":                        "log_dir",
-		"LINDB_LOGGING_LEVEL":                      "fatal",
-		"LINDB_LOGGING_MAX_SIZE":                   "1Mib",
-		"LINDB_LOGGING_MAX_BACKUPS":                "10",
-		"LINDB_LOGGING_MAX_AGE":                    "20",
+		"LINDB_COORDINATOR_NAMESPACE":                "ns",
+		"LINDB_COORDINATOR_ENDPOINTS":                "endpoint1,endpoint2",
+		"LINDB_COORDINATOR_LEASE_TTL":                "60s",
+		"LINDB_COORDINATOR_TIMEOUT":                  "60s",
+		"LINDB_COORDINATOR_DIAL_TIMEOUT":             "60s",
+		"LINDB_COORDINATOR_USERNAME":                 "LinDB",
+		"LINDB_COORDINATOR_PASSWORD":                 "pwd",
+		"LINDB_QUERY_CONCURRENCY":                    "100",
+		"LINDB_QUERY_IDLE_TIMEOUT":                   "100s",
+		"LINDB_QUERY_TIMEOUT":                        "120s",
+		"LINDB_QUERY_RESULT_CACHE_SIZE":              "64MiB",
+		"LINDB_QUERY_RESULT_CACHE_TTL":               "5m",
+		"LINDB_BROKER_SLOW_SQL":                      "120s",
+		"LINDB_BROKER_HTTP_PORT":                     "3000",
+		"LINDB_BROKER_HTTP_IDLE_TIMEOUT":             "120s",
+		"LINDB_BROKER_HTTP_WRITE_TIMEOUT":            "120s",
+		"LINDB_BROKER_HTTP_READ_TIMEOUT":             "2m",
+		"LINDB_BROKER_INGESTION_CONCURRENCY":         "100",
+		"LINDB_BROKER_INGESTION_TIMEOUT":             "2m",
+		"LINDB_BROKER_WRITE_BATCH_TIMEOUT":           "2m",
+		"LINDB_BROKER_WRITE_BLOCK_SIZE":              "1Mib",
+		"LINDB_BROKER_WRITE_GC_INTERVAL":             "2m",
+		"LINDB_BROKER_GRPC_PORT":                     "2899",
+		"LINDB_BROKER_GRPC_MAX_CONCURRENT_STREAMS":   "10000",
+		"LINDB_BROKER_GRPC_CONNECT_TIMEOUT":          "2m",
+		"LINDB_BROKER_HTTP_TLS_ENABLED":              "true",
+		"LINDB_BROKER_HTTP_TLS_CERT_FILE":            "http.pem",
+		"LINDB_BROKER_HTTP_TLS_KEY_FILE":             "http-key.pem",
+		"LINDB_BROKER_GRPC_TLS_ENABLED":              "true",
+		"LINDB_BROKER_GRPC_TLS_CA_FILE":              "ca.pem",
+		"LINDB_BROKER_GRPC_TLS_CLIENT_AUTH":          "true",
+		"LINDB_BROKER_GRPC_TLS_SERVER_NAME":          "lindb",
+		"LINDB_COORDINATOR_TLS_ENABLED":              "true",
+		"LINDB_COORDINATOR_TLS_INSECURE_SKIP_VERIFY": "true",
+		"LINDB_BROKER_AUTH_ENABLED":                  "true",
+		"LINDB_BROKER_AUTH_ADMIN_USERNAME":           "root",
+		"LINDB_BROKER_AUTH_ADMIN_PASSWORD":           "root_pwd",
+		"LINDB_BROKER_CQ_EXECUTE_DELAY":              "30s",
+		"LINDB_MONITOR_PUSH_TIMEOUT":                 "2m",
+		"LINDB_MONITOR_REPORT_INTERVAL":              "2m",
+		"LINDB_MONITOR_URL":                          "monitor_url",
+		"LINDB_LOGGING_DIR":                          "log_dir",
+		"LINDB_LOGGING_LEVEL":                        "fatal",
+		"LINDB_LOGGING_MAX_SIZE":                     "1Mib",
+		"LINDB_LOGGING_MAX_BACKUPS":                  "10",
+		"LINDB_LOGGING_MAX_AGE":                      "20",
 	}}
 	err := env.Parse(&cfg, opts)
 	assert.NoError(t, err)
@@ -93,6 +108,8 @@ func TestBroker_Env(t *testing.T) {
 	assert.Equal(t, 100, cfg.Query.QueryConcurrency)
 	assert.Equal(t, ltoml.Duration(time.Second*100), cfg.Query.IdleTimeout)
 	assert.Equal(t, ltoml.Duration(time.Second*120), cfg.Query.Timeout)
+	assert.Equal(t, ltoml.Size(64*1024*1024), cfg.Query.ResultCacheSize)
+	assert.Equal(t, ltoml.Duration(time.Minute*5), cfg.Query.ResultCacheTTL)
 	assert.Equal(t, ltoml.Duration(time.Second*120), cfg.BrokerBase.SlowSQL)
 	assert.Equal(t, uint16(3000), cfg.BrokerBase.HTTP.Port)
 	assert.Equal(t, ltoml.Duration(time.Second*120), cfg.BrokerBase.HTTP.WriteTimeout)
@@ -106,6 +123,13 @@ func TestBroker_Env(t *testing.T) {
 	assert.Equal(t, uint16(2899), cfg.BrokerBase.GRPC.Port)
 	assert.Equal(t, 10000, cfg.BrokerBase.GRPC.MaxConcurrentStreams)
 	assert.Equal(t, ltoml.Duration(time.Second*120), cfg.BrokerBase.GRPC.ConnectTimeout)
+	assert.Equal(t, TLS{Enabled: true, CertFile: "http.pem", KeyFile: "http-key.pem"}, cfg.BrokerBase.HTTP.TLS)
+	assert.Equal(t, TLS{Enabled: true, CAFile: "ca.pem", ClientAuth: true, ServerName: "lindb"}, cfg.BrokerBase.GRPC.TLS)
+	assert.Equal(t, TLS{Enabled: true, InsecureSkipVerify: true}, cfg.Coordinator.TLS)
+	assert.True(t, cfg.BrokerBase.Auth.Enabled)
+	assert.Equal(t, "root", cfg.BrokerBase.Auth.Admin.UserName)
+	assert.Equal(t, "root_pwd", cfg.BrokerBase.Auth.Admin.Password)
+	assert.Equal(t, ltoml.Duration(time.Second*30), cfg.BrokerBase.ContinuousQuery.ExecuteDelay)
 	assert.Equal(t, ltoml.Duration(time.Second*120), cfg.Monitor.PushTimeout)
 	assert.Equal(t, ltoml.Duration(time.Second*120), cfg.Monitor.ReportInterval)
 	assert.Equal(t, "monitor_url", cfg.Monitor.URL)
@@ -112,6 +112,24 @@ func Test_checkBrokerBaseCfg(t *testing.T) {
 	assert.NotZero(t, brokerCfg3.HTTP.IdleTimeout)
 	assert.NotZero(t, brokerCfg3.HTTP.WriteTimeout)
 	assert.NotZero(t, brokerCfg3.Ingestion.IngestTimeout)
+	assert.NotZero(t, brokerCfg3.ContinuousQuery.ExecuteDelay)
+
+	// http tls failure
+	brokerCfg3.HTTP.TLS = TLS{Enabled: true}
+	assert.Error(t, checkBrokerBaseCfg(brokerCfg3))
+	brokerCfg3.HTTP.TLS = TLS{Enabled: true, CertFile: "cert.pem", KeyFile: "key.pem"}
+	assert.NoError(t, checkBrokerBaseCfg(brokerCfg3))
+	// grpc tls failure
+	brokerCfg3.GRPC.TLS = TLS{Enabled: true, CertFile: "cert.pem", KeyFile: "key.pem", ClientAuth: true}
+	assert.Error(t, checkBrokerBaseCfg(brokerCfg3))
+	brokerCfg3.GRPC.TLS.CAFile = "ca.pem"
+	assert.NoError(t, checkBrokerBaseCfg(brokerCfg3))
+
+	// auth admin failure
+	brokerCfg3.Auth.Enabled = true
+	assert.Error(t, checkBrokerBaseCfg(brokerCfg3))
+	brokerCfg3.Auth.Admin = User{UserName: "admin", Password: "admin123"}
+	assert.NoError(t, checkBrokerBaseCfg(brokerCfg3))
 }
 
 func Test_checkStorageBaseCfg(t *testing.T) {
@@ -145,6 +163,14 @@ func Test_checkStorageBaseCfg(t *testing.T) {
 	assert.NotZero(t, storageCfg4.TSDB.MaxMemUsageBeforeFlush)
 	assert.NotZero(t, storageCfg4.TSDB.TargetMemUsageAfterFlush)
 	assert.NotZero(t, storageCfg4.TSDB.FlushConcurrency)
+
+	// http tls failure
+	storageCfg4.HTTP.TLS = TLS{Enabled: true, CertFile: "cert.pem"}
+	assert.Error(t, checkStorageBaseCfg(storageCfg4))
+	// grpc tls failure
+	storageCfg4.HTTP.TLS = TLS{}
+	storageCfg4.GRPC.TLS = TLS{Enabled: true, KeyFile: "key.pem"}
+	assert.Error(t, checkStorageBaseCfg(storageCfg4))
 }
 
 func Test_checkCoordinatorCfg(t *testing.T) {
@@ -158,4 +184,11 @@ func Test_checkCoordinatorCfg(t *testing.T) {
 	assert.NoError(t, checkCoordinatorCfg(&repo))
 
 	assert.Equal(t, "/1/2", repo.WithSubNamespace("2").Namespace)
+
+	// client certificate is optional for etcd
+	repo.TLS = TLS{Enabled: true, CAFile: "ca.pem"}
+	assert.NoError(t, checkCoordinatorCfg(&repo))
+	assert.Equal(t, repo.TLS, repo.WithSubNamespace("2").TLS)
+	repo.TLS = TLS{Enabled: true, CertFile: "cert.pem"}
+	assert.Error(t, checkCoordinatorCfg(&repo))
 }
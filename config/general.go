@@ -34,6 +34,40 @@ type Configuration interface {
 	TOML() string
 }
 
+// redactedValue represents the value of secret configuration item which is hidden.
+const redactedValue = "******"
+
+// Redact returns a copy of configuration with secrets(passwords/keys) hidden,
+// which is safe to expose by api.
+func Redact(cfg Configuration) Configuration {
+	switch c := cfg.(type) {
+	case *Broker:
+		redacted := *c
+		redacted.Coordinator.Password = redactSecret(c.Coordinator.Password)
+		redacted.BrokerBase.Auth.Admin.Password = redactSecret(c.BrokerBase.Auth.Admin.Password)
+		return &redacted
+	case *Storage:
+		redacted := *c
+		redacted.Coordinator.Password = redactSecret(c.Coordinator.Password)
+		redacted.StorageBase.Tiering.SecretAccessKey = redactSecret(c.StorageBase.Tiering.SecretAccessKey)
+		return &redacted
+	case *Root:
+		redacted := *c
+		redacted.Coordinator.Password = redactSecret(c.Coordinator.Password)
+		return &redacted
+	default:
+		return cfg
+	}
+}
+
+// redactSecret hides the secret value if set.
+func redactSecret(secret string) string {
+	if secret == "" {
+		return ""
+	}
+	return redactedValue
+}
+
 // RepoState represents state repository config
 type RepoState struct {
 	Namespace   string         `env:"NAMESPACE" toml:"namespace" json:"namespace" validate:"required"`
@@ -43,6 +77,7 @@ type RepoState struct {
 	DialTimeout ltoml.Duration `env:"DIAL_TIMEOUT" toml:"dial-timeout" json:"dialTimeout"`
 	Username    string         `env:"USERNAME" toml:"username" json:"username"`
 	Password    string         `env:"PASSWORD" toml:"password" json:"password"`
+	TLS         TLS            `envPrefix:"TLS_" toml:"tls" json:"tls"`
 }
 
 // String returns string value of RepoState.
@@ -59,6 +94,7 @@ func (rs *RepoState) WithSubNamespace(subDir string) *RepoState {
 		DialTimeout: rs.DialTimeout,
 		Username:    rs.Username,
 		Password:    rs.Password,
+		TLS:         rs.TLS,
 	}
 }
 
@@ -97,7 +133,10 @@ username = "%s"
 ## Password is a password for etcd authentication.
 ## Default: "%s"
 ## Env: LINDB_COORDINATOR_PASSWORD
-password = "%s"`,
+password = "%s"
+
+## TLS related configuration for connecting etcd.
+[coordinator.tls]%s`,
 		rs.Namespace,
 		rs.Namespace,
 		coordinatorEndpoints,
@@ -112,6 +151,7 @@ password = "%s"`,
 		rs.Username,
 		rs.Password,
 		rs.Password,
+		rs.TLS.TOML("LINDB_COORDINATOR_TLS"),
 	)
 }
 
@@ -125,11 +165,66 @@ func NewDefaultCoordinator() *RepoState {
 	}
 }
 
+// TLS represents transport layer security config,
+// used by http/grpc server, grpc client and etcd client.
+type TLS struct {
+	Enabled            bool   `env:"ENABLED" toml:"enabled" json:"enabled"`
+	CertFile           string `env:"CERT_FILE" toml:"cert-file" json:"certFile,omitempty"`
+	KeyFile            string `env:"KEY_FILE" toml:"key-file" json:"keyFile,omitempty"`
+	CAFile             string `env:"CA_FILE" toml:"ca-file" json:"caFile,omitempty"`
+	ClientAuth         bool   `env:"CLIENT_AUTH" toml:"client-auth" json:"clientAuth,omitempty"`
+	ServerName         string `env:"SERVER_NAME" toml:"server-name" json:"serverName,omitempty"`
+	InsecureSkipVerify bool   `env:"INSECURE_SKIP_VERIFY" toml:"insecure-skip-verify" json:"insecureSkipVerify,omitempty"`
+}
+
+// TOML returns TLS's toml config string, envPrefixes are the env prefix of the TLS config in the parent section.
+func (t *TLS) TOML(envPrefixes ...string) string {
+	env := func(key string) string {
+		var sb strings.Builder
+		for _, prefix := range envPrefixes {
+			sb.WriteString(fmt.Sprintf("\n## Env: %s_%s", prefix, key))
+		}
+		return sb.String()
+	}
+	return fmt.Sprintf(`
+## enable TLS for the connection.
+## Default: %v%s
+enabled = %v
+## certificate file(PEM) presented to the remote peer.
+## Default: "%s"%s
+cert-file = "%s"
+## private key file(PEM) of the certificate.
+## Default: "%s"%s
+key-file = "%s"
+## CA certificate file(PEM) used to verify the remote peer,
+## if not set, using the host's root CA set.
+## Default: "%s"%s
+ca-file = "%s"
+## server side requires and verifies client certificate by ca-file(mutual TLS).
+## Default: %v%s
+client-auth = %v
+## server name used to verify the hostname of server certificate(client side).
+## Default: "%s"%s
+server-name = "%s"
+## skip verifying server certificate chain and host name(client side), only for testing.
+## Default: %v%s
+insecure-skip-verify = %v`,
+		t.Enabled, env("ENABLED"), t.Enabled,
+		t.CertFile, env("CERT_FILE"), t.CertFile,
+		t.KeyFile, env("KEY_FILE"), t.KeyFile,
+		t.CAFile, env("CA_FILE"), t.CAFile,
+		t.ClientAuth, env("CLIENT_AUTH"), t.ClientAuth,
+		t.ServerName, env("SERVER_NAME"), t.ServerName,
+		t.InsecureSkipVerify, env("INSECURE_SKIP_VERIFY"), t.InsecureSkipVerify,
+	)
+}
+
 // GRPC represents grpc server config
 type GRPC struct {
 	Port                 uint16         `env:"PORT" toml:"port"`
 	MaxConcurrentStreams int            `env:"MAX_CONCURRENT_STREAMS" toml:"max-concurrent-streams"`
 	ConnectTimeout       ltoml.Duration `env:"CONNECT_TIMEOUT" toml:"connect-timeout"`
+	TLS                  TLS            `envPrefix:"TLS_" toml:"tls"`
 }
 
 func (g *GRPC) TOML() string {
@@ -173,6 +268,8 @@ type Query struct {
 	QueryConcurrency int            `env:"CONCURRENCY" toml:"query-concurrency"`
 	IdleTimeout      ltoml.Duration `env:"IDLE_TIMEOUT" toml:"idle-timeout"`
 	Timeout          ltoml.Duration `env:"TIMEOUT" toml:"timeout"`
+	ResultCacheSize  ltoml.Size     `env:"RESULT_CACHE_SIZE" toml:"result-cache-size"`
+	ResultCacheTTL   ltoml.Duration `env:"RESULT_CACHE_TTL" toml:"result-cache-ttl"`
 }
 
 func (q *Query) TOML() string {
@@ -188,13 +285,26 @@ idle-timeout = "%s"
 ## Maximum timeout threshold for query.
 ## Default: %s
 ## Env: LINDB_QUERY_TIMEOUT
-timeout = "%s"`,
+timeout = "%s"
+## Maximum memory size of broker query result cache, 0 means disable result cache.
+## Only the results older than the behind option of database are cached, because late data maybe written.
+## Default: %s
+## Env: LINDB_QUERY_RESULT_CACHE_SIZE
+result-cache-size = "%s"
+## Cached query result will be expired after this duration.
+## Default: %s
+## Env: LINDB_QUERY_RESULT_CACHE_TTL
+result-cache-ttl = "%s"`,
 		q.QueryConcurrency,
 		q.QueryConcurrency,
 		q.IdleTimeout,
 		q.IdleTimeout,
 		q.Timeout,
 		q.Timeout,
+		q.ResultCacheSize,
+		q.ResultCacheSize,
+		q.ResultCacheTTL,
+		q.ResultCacheTTL,
 	)
 }
 
@@ -203,6 +313,7 @@ func NewDefaultQuery() *Query {
 		QueryConcurrency: 1024,
 		IdleTimeout:      ltoml.Duration(5 * time.Second),
 		Timeout:          ltoml.Duration(5 * time.Second),
+		ResultCacheTTL:   ltoml.Duration(10 * time.Minute),
 	}
 }
 
@@ -222,7 +333,7 @@ func checkCoordinatorCfg(state *RepoState) error {
 	if state.DialTimeout <= 0 {
 		state.Timeout = ltoml.Duration(time.Second * 5)
 	}
-	return nil
+	return checkTLSCfg(&state.TLS, false)
 }
 
 func checkGRPCCfg(grpcCfg *GRPC) error {
@@ -235,6 +346,23 @@ func checkGRPCCfg(grpcCfg *GRPC) error {
 	if grpcCfg.ConnectTimeout <= 0 {
 		grpcCfg.ConnectTimeout = ltoml.Duration(time.Second * 3)
 	}
+	return checkTLSCfg(&grpcCfg.TLS, true)
+}
+
+// checkTLSCfg checks TLS config if TLS enabled, server side must set certificate.
+func checkTLSCfg(tlsCfg *TLS, server bool) error {
+	if !tlsCfg.Enabled {
+		return nil
+	}
+	if server && tlsCfg.CertFile == "" {
+		return fmt.Errorf("tls cert-file cannot be empty")
+	}
+	if (tlsCfg.CertFile == "") != (tlsCfg.KeyFile == "") {
+		return fmt.Errorf("tls cert-file and key-file must be set together")
+	}
+	if tlsCfg.ClientAuth && tlsCfg.CAFile == "" {
+		return fmt.Errorf("tls ca-file cannot be empty when client-auth enabled")
+	}
 	return nil
 }
 
@@ -249,4 +377,7 @@ func checkQueryCfg(queryCfg *Query) {
 	if queryCfg.IdleTimeout <= 0 {
 		queryCfg.IdleTimeout = defaultQuery.IdleTimeout
 	}
+	if queryCfg.ResultCacheTTL <= 0 {
+		queryCfg.ResultCacheTTL = defaultQuery.ResultCacheTTL
+	}
 }
@@ -43,3 +43,32 @@ func TestGeneral_string(t *testing.T) {
 		strings.Join(repo.Endpoints, ","), repo.LeaseTTL, repo.Timeout, repo.DialTimeout),
 		repo.String())
 }
+
+func TestRedact(t *testing.T) {
+	broker := Broker{Coordinator: *NewDefaultCoordinator(), BrokerBase: *NewDefaultBrokerBase()}
+	broker.Coordinator.Password = "etcd-pwd"
+	broker.BrokerBase.Auth.Admin.Password = "admin-pwd"
+	rs := Redact(&broker).TOML()
+	assert.NotContains(t, rs, "etcd-pwd")
+	assert.NotContains(t, rs, "admin-pwd")
+	assert.Contains(t, rs, redactedValue)
+	// original config not changed
+	assert.Equal(t, "admin-pwd", broker.BrokerBase.Auth.Admin.Password)
+
+	storage := Storage{Coordinator: *NewDefaultCoordinator(), StorageBase: *NewDefaultStorageBase()}
+	storage.Coordinator.Password = "etcd-pwd"
+	storage.StorageBase.Tiering.SecretAccessKey = "secret-key"
+	rs = Redact(&storage).TOML()
+	assert.NotContains(t, rs, "etcd-pwd")
+	assert.NotContains(t, rs, "secret-key")
+
+	root := NewDefaultRoot()
+	root.Coordinator.Password = "etcd-pwd"
+	assert.NotContains(t, Redact(root).TOML(), "etcd-pwd")
+
+	// empty secret
+	assert.Empty(t, redactSecret(""))
+
+	cfg := &Query{}
+	assert.Equal(t, cfg, Redact(cfg))
+}
@@ -85,6 +85,12 @@ func LoadAndSetRootConfig(cfgName, defaultPath string, rootCfg *Root) error {
 	if err := checkCoordinatorCfg(&rootCfg.Coordinator); err != nil {
 		return fmt.Errorf("failed check coordinator config: %s", err)
 	}
+	if err := checkTLSCfg(&rootCfg.HTTP.TLS, true); err != nil {
+		return fmt.Errorf("failed check http config: %s", err)
+	}
+	if err := checkTLSCfg(&rootCfg.GRPC.TLS, false); err != nil {
+		return fmt.Errorf("failed check grpc config: %s", err)
+	}
 	globalRootCfg.Store(rootCfg)
 	return nil
 }
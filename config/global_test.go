@@ -155,6 +155,26 @@ func TestLoadAdnSetRootConfig(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "valid http tls failure",
+			prepare: func(cfg *Root) {
+				loadConfigFn = func(cfgPath, defaultCfgPath string, v interface{}) error {
+					return nil
+				}
+				cfg.HTTP.TLS.Enabled = true
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid grpc tls failure",
+			prepare: func(cfg *Root) {
+				loadConfigFn = func(cfgPath, defaultCfgPath string, v interface{}) error {
+					return nil
+				}
+				cfg.GRPC.TLS = TLS{Enabled: true, CertFile: "cert.pem"}
+			},
+			wantErr: true,
+		},
 		{
 			name: "load and set cfg success",
 			prepare: func(_ *Root) {
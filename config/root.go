@@ -30,11 +30,17 @@ type Root struct {
 	Coordinator RepoState      `envPrefix:"LINDB_COORDINATOR_" toml:"coordinator"`
 	Query       Query          `envPrefix:"LINDB_QUERY_" toml:"query"`
 	HTTP        HTTP           `envPrefix:"LINDB_ROOT_HTTP_" toml:"http"`
+	GRPC        RootGRPC       `envPrefix:"LINDB_ROOT_GRPC_" toml:"grpc"`
 	Monitor     Monitor        `envPrefix:"LINDB_MONITOR_" toml:"monitor"`
 	Logging     logger.Setting `envPrefix:"LINDB_LOGGING_" toml:"logging"`
 	Prometheus  Prometheus     `envPrefix:"LINDB_PROMETHEUS_" toml:"prometheus"`
 }
 
+// RootGRPC represents grpc client config of root(root->broker).
+type RootGRPC struct {
+	TLS TLS `envPrefix:"TLS_" toml:"tls"`
+}
+
 // TOML returns root's configuration string as toml format.
 func (r *Root) TOML() string {
 	return fmt.Sprintf(`## Coordinator related configuration.
@@ -46,12 +52,21 @@ func (r *Root) TOML() string {
 ## Controls how HTTP Server are configured.
 [http]%s
 
+## Controls TLS(HTTPS) of HTTP Server.
+[http.tls]%s
+
+## Controls TLS of GRPC client which connects to brokers,
+## must match the grpc tls config of brokers.
+[grpc.tls]%s
+
 %s
 %s
 %s`,
 		r.Coordinator.TOML(),
 		r.Query.TOML(),
 		r.HTTP.TOML(),
+		r.HTTP.TLS.TOML("LINDB_ROOT_HTTP_TLS"),
+		r.GRPC.TLS.TOML("LINDB_ROOT_GRPC_TLS"),
 		r.Monitor.TOML(),
 		r.Logging.TOML("LINDB"),
 		r.Prometheus.TOML(),
@@ -90,11 +90,100 @@ flush-concurrency = %d`,
 	)
 }
 
+// Tiering represents the tiered storage configuration,
+// old data families are offloaded into the object store backend.
+type Tiering struct {
+	Backend         string     `env:"BACKEND" toml:"backend"`
+	Dir             string     `env:"DIR" toml:"dir"`
+	Endpoint        string     `env:"ENDPOINT" toml:"endpoint"`
+	Region          string     `env:"REGION" toml:"region"`
+	Bucket          string     `env:"BUCKET" toml:"bucket"`
+	Prefix          string     `env:"PREFIX" toml:"prefix"`
+	AccessKeyID     string     `env:"ACCESS_KEY_ID" toml:"access-key-id"`
+	SecretAccessKey string     `env:"SECRET_ACCESS_KEY" toml:"secret-access-key"`
+	ForcePathStyle  bool       `env:"FORCE_PATH_STYLE" toml:"force-path-style"`
+	CacheDir        string     `env:"CACHE_DIR" toml:"cache-dir"`
+	CacheSize       ltoml.Size `env:"CACHE_SIZE" toml:"cache-size"`
+}
+
+// Enabled returns if tiered storage is enabled.
+func (t *Tiering) Enabled() bool {
+	return t.Backend != ""
+}
+
+func (t *Tiering) TOML() string {
+	return fmt.Sprintf(`
+## The object store backend which old data is offloaded into, available backends are [local, s3],
+## tiered storage is disabled if backend is empty.
+## Default: %s
+## Env: LINDB_STORAGE_TIERING_BACKEND
+backend = "%s"
+## The directory where the local backend stores offloaded files.
+## Default: %s
+## Env: LINDB_STORAGE_TIERING_DIR
+dir = "%s"
+## The endpoint of S3-compatible object store, uses aws endpoint if empty.
+## Default: %s
+## Env: LINDB_STORAGE_TIERING_ENDPOINT
+endpoint = "%s"
+## The region of S3-compatible object store.
+## Default: %s
+## Env: LINDB_STORAGE_TIERING_REGION
+region = "%s"
+## The bucket of S3-compatible object store.
+## Default: %s
+## Env: LINDB_STORAGE_TIERING_BUCKET
+bucket = "%s"
+## The key prefix of all objects in bucket.
+## Default: %s
+## Env: LINDB_STORAGE_TIERING_PREFIX
+prefix = "%s"
+## The credentials of S3-compatible object store, uses aws default credential chain if empty.
+## Env: LINDB_STORAGE_TIERING_ACCESS_KEY_ID
+access-key-id = "%s"
+## Env: LINDB_STORAGE_TIERING_SECRET_ACCESS_KEY
+secret-access-key = "%s"
+## Whether to use path-style addressing(http://endpoint/bucket/key), most S3-compatible stores need it.
+## Default: %v
+## Env: LINDB_STORAGE_TIERING_FORCE_PATH_STYLE
+force-path-style = %v
+## The directory of local disk cache for the blocks read from object store.
+## Default: %s
+## Env: LINDB_STORAGE_TIERING_CACHE_DIR
+cache-dir = "%s"
+## The maximum size of local disk cache.
+## Default: %s
+## Env: LINDB_STORAGE_TIERING_CACHE_SIZE
+cache-size = "%s"`,
+		t.Backend,
+		t.Backend,
+		strings.ReplaceAll(t.Dir, "\\", "\\\\"),
+		strings.ReplaceAll(t.Dir, "\\", "\\\\"),
+		t.Endpoint,
+		t.Endpoint,
+		t.Region,
+		t.Region,
+		t.Bucket,
+		t.Bucket,
+		t.Prefix,
+		t.Prefix,
+		t.AccessKeyID,
+		t.SecretAccessKey,
+		t.ForcePathStyle,
+		t.ForcePathStyle,
+		strings.ReplaceAll(t.CacheDir, "\\", "\\\\"),
+		strings.ReplaceAll(t.CacheDir, "\\", "\\\\"),
+		t.CacheSize.String(),
+		t.CacheSize.String(),
+	)
+}
+
 // StorageBase represents a storage configuration
 type StorageBase struct {
 	BrokerEndpoint  string         `env:"BROKER_ENDPOINT" toml:"broker-endpoint"`
 	WAL             WAL            `envPrefix:"WAL_" toml:"wal"`
 	TSDB            TSDB           `envPrefix:"TSDB_" toml:"tsdb"`
+	Tiering         Tiering        `envPrefix:"TIERING_" toml:"tiering"`
 	HTTP            HTTP           `envPrefix:"HTTP_" toml:"http"`
 	GRPC            GRPC           `envPrefix:"GRPC_" toml:"grpc"`
 	TTLTaskInterval ltoml.Duration `env:"TTL_TASK_INTERVAL" toml:"ttl-task-interval"`
@@ -113,20 +202,32 @@ ttl-task-interval = "%s"
 ## Storage HTTP related configuration.
 [storage.http]%s
 
+## Controls TLS(HTTPS) of Storage HTTP Server.
+[storage.http.tls]%s
+
 ## Storage GRPC related configuration.
 [storage.grpc]%s
 
+## Controls TLS of Storage GRPC Server and GRPC client between broker and storage nodes.
+[storage.grpc.tls]%s
+
 ## Write Ahead Log related configuration.
 [storage.wal]%s
 
 ## TSDB related configuration.
-[storage.tsdb]%s`,
+[storage.tsdb]%s
+
+## Tiered storage related configuration.
+[storage.tiering]%s`,
 		s.TTLTaskInterval,
 		s.TTLTaskInterval,
 		s.HTTP.TOML(),
+		s.HTTP.TLS.TOML("LINDB_STORAGE_HTTP_TLS"),
 		s.GRPC.TOML(),
+		s.GRPC.TLS.TOML("LINDB_STORAGE_GRPC_TLS"),
 		s.WAL.TOML(),
 		s.TSDB.TOML(),
+		s.Tiering.TOML(),
 	)
 }
 
@@ -228,6 +329,11 @@ func NewDefaultStorageBase() *StorageBase {
 			SeriesSequenceCache:      1000,
 			MetaSequenceCache:        100,
 		},
+		Tiering: Tiering{
+			Dir:       filepath.Join(defaultParentDir, "storage", "tiering"),
+			CacheDir:  filepath.Join(defaultParentDir, "storage", "tiering-cache"),
+			CacheSize: ltoml.Size(1024 * 1024 * 1024),
+		},
 	}
 }
 
@@ -283,9 +389,41 @@ func checkStorageBaseCfg(storageBaseCfg *StorageBase) error {
 	if err := checkGRPCCfg(&storageBaseCfg.GRPC); err != nil {
 		return err
 	}
+	if err := checkTLSCfg(&storageBaseCfg.HTTP.TLS, true); err != nil {
+		return err
+	}
 	defaultStorageCfg := NewDefaultStorageBase()
 	if storageBaseCfg.TTLTaskInterval <= 0 {
 		storageBaseCfg.TTLTaskInterval = defaultStorageCfg.TTLTaskInterval
 	}
+	if err := checkTieringCfg(&storageBaseCfg.Tiering); err != nil {
+		return err
+	}
 	return checkTSDBCfg(&storageBaseCfg.TSDB)
 }
+
+// checkTieringCfg checks tiered storage config.
+func checkTieringCfg(tieringCfg *Tiering) error {
+	defaultStorageCfg := NewDefaultStorageBase()
+	switch tieringCfg.Backend {
+	case "":
+		return nil
+	case "local":
+		if tieringCfg.Dir == "" {
+			return fmt.Errorf("tiering dir cannot be empty when using local backend")
+		}
+	case "s3":
+		if tieringCfg.Bucket == "" {
+			return fmt.Errorf("tiering bucket cannot be empty when using s3 backend")
+		}
+	default:
+		return fmt.Errorf("unknown tiering backend: %s", tieringCfg.Backend)
+	}
+	if tieringCfg.CacheDir == "" {
+		tieringCfg.CacheDir = defaultStorageCfg.Tiering.CacheDir
+	}
+	if tieringCfg.CacheSize <= 0 {
+		tieringCfg.CacheSize = defaultStorageCfg.Tiering.CacheSize
+	}
+	return nil
+}
@@ -77,6 +77,10 @@ func TestStorage_Env(t *testing.T) {
 		"LINDB_STORAGE_TSDB_FLUSH_CONCURRENCY":            "2000",
 		"LINDB_STORAGE_TSDB_SERIES_SEQ_CACHE":             "1000",
 		"LINDB_STORAGE_TSDB_META_SEQ_CACHE":               "1000",
+		"LINDB_STORAGE_TIERING_BACKEND":                   "s3",
+		"LINDB_STORAGE_TIERING_BUCKET":                    "bucket",
+		"LINDB_STORAGE_TIERING_FORCE_PATH_STYLE":          "true",
+		"LINDB_STORAGE_TIERING_CACHE_SIZE":                "1Mib",
 		"LINDB_MONITOR_PUSH_TIMEOUT":                      "2m",
 		"LINDB_MONITOR_REPORT_INTERVAL":                   "2m",
 		"LINDB_MONITOR_URL":                               "monitor_url",
@@ -120,6 +124,10 @@ func TestStorage_Env(t *testing.T) {
 	assert.Equal(t, 2000, cfg.StorageBase.TSDB.FlushConcurrency)
 	assert.Equal(t, uint32(1000), cfg.StorageBase.TSDB.SeriesSequenceCache)
 	assert.Equal(t, uint32(1000), cfg.StorageBase.TSDB.MetaSequenceCache)
+	assert.Equal(t, "s3", cfg.StorageBase.Tiering.Backend)
+	assert.Equal(t, "bucket", cfg.StorageBase.Tiering.Bucket)
+	assert.True(t, cfg.StorageBase.Tiering.ForcePathStyle)
+	assert.Equal(t, ltoml.Size(1024*1024), cfg.StorageBase.Tiering.CacheSize)
 
 	assert.Equal(t, ltoml.Duration(time.Second*120), cfg.Monitor.PushTimeout)
 	assert.Equal(t, ltoml.Duration(time.Second*120), cfg.Monitor.ReportInterval)
@@ -130,3 +138,19 @@ func TestStorage_Env(t *testing.T) {
 	assert.Equal(t, uint16(10), cfg.Logging.MaxBackups)
 	assert.Equal(t, uint16(20), cfg.Logging.MaxAge)
 }
+
+func TestTiering_Check(t *testing.T) {
+	assert.NoError(t, checkTieringCfg(&Tiering{}))
+	assert.False(t, (&Tiering{}).Enabled())
+	assert.Error(t, checkTieringCfg(&Tiering{Backend: "hdfs"}))
+	assert.Error(t, checkTieringCfg(&Tiering{Backend: "local"}))
+	assert.Error(t, checkTieringCfg(&Tiering{Backend: "s3"}))
+
+	cfg := &Tiering{Backend: "s3", Bucket: "bucket"}
+	assert.NoError(t, checkTieringCfg(cfg))
+	assert.True(t, cfg.Enabled())
+	defaultCfg := NewDefaultStorageBase()
+	assert.Equal(t, defaultCfg.Tiering.CacheDir, cfg.CacheDir)
+	assert.Equal(t, defaultCfg.Tiering.CacheSize, cfg.CacheSize)
+	assert.NoError(t, checkTieringCfg(&Tiering{Backend: "local", Dir: "tiering"}))
+}
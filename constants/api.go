@@ -34,4 +34,12 @@ const (
 	ContentTypeProto = "application/protobuf"
 	// ContentTypeInflux represents influx content type.
 	ContentTypeInflux = "application/influx"
+	// ContentTypeOTLPProto represents OpenTelemetry(OTLP/HTTP) proto buffer content type.
+	ContentTypeOTLPProto = "application/x-protobuf"
+	// ContentTypeJSON represents json content type.
+	ContentTypeJSON = "application/json"
+	// ContentTypeOctetStream represents binary stream content type.
+	ContentTypeOctetStream = "application/octet-stream"
+	// ExportErrorTrailer represents http trailer which reports the failure of data export after response sent.
+	ExportErrorTrailer = "X-Lindb-Export-Error"
 )
@@ -59,6 +59,13 @@ const (
 	DatabaseConfigPath = "/database/config"
 	// DatabaseLimitPath represents database limit path.
 	DatabaseLimitPath = "/database/limit"
+	// ContinuousQueryPath represents database continuous query path.
+	ContinuousQueryPath = "/database/cq"
+	// ContinuousQueryStatePath represents continuous query execution state path.
+	ContinuousQueryStatePath = "/cq/state"
+	// QueryCacheVersionPath represents the version of database's cached query results,
+	// changes of version invalidate the cached results on all brokers.
+	QueryCacheVersionPath = "/query/cache/version"
 	// ShardAssignmentPath represents database shard assignment.
 	ShardAssignmentPath = "/database/assign"
 	// StorageConfigPath represents storage cluster's config.
@@ -67,6 +74,18 @@ const (
 	StorageStatePath = "/storage/state"
 	// BrokerConfigPath represents broker cluster's config.
 	BrokerConfigPath = "/broker/config"
+	// AlertRulePath represents alert rule path.
+	AlertRulePath = "/alert/rule"
+	// AlertStatePath represents alert state path.
+	AlertStatePath = "/alert/state"
+	// AuthPath represents auth(user/role/token) root path.
+	AuthPath = "/auth"
+	// AuthUserPath represents user path.
+	AuthUserPath = AuthPath + "/user"
+	// AuthRolePath represents role path.
+	AuthRolePath = AuthPath + "/role"
+	// AuthTokenPath represents api token path.
+	AuthTokenPath = AuthPath + "/token"
 )
 
 // GetBrokerClusterConfigPath returns path which storing config of broker cluster.
@@ -74,6 +93,31 @@ func GetBrokerClusterConfigPath(name string) string {
 	return fmt.Sprintf("%s/%s", BrokerConfigPath, name)
 }
 
+// GetAlertRulePath returns path which storing alert rule.
+func GetAlertRulePath(name string) string {
+	return fmt.Sprintf("%s/%s", AlertRulePath, name)
+}
+
+// GetAlertStatePath returns path which storing alert state of rule.
+func GetAlertStatePath(name string) string {
+	return fmt.Sprintf("%s/%s", AlertStatePath, name)
+}
+
+// GetAuthUserPath returns path which storing user.
+func GetAuthUserPath(name string) string {
+	return fmt.Sprintf("%s/%s", AuthUserPath, name)
+}
+
+// GetAuthRolePath returns path which storing role.
+func GetAuthRolePath(name string) string {
+	return fmt.Sprintf("%s/%s", AuthRolePath, name)
+}
+
+// GetAuthTokenPath returns path which storing api token by token id.
+func GetAuthTokenPath(id string) string {
+	return fmt.Sprintf("%s/%s", AuthTokenPath, id)
+}
+
 // GetDatabaseConfigPath returns path which storing config of database
 func GetDatabaseConfigPath(name string) string {
 	return fmt.Sprintf("%s/%s", DatabaseConfigPath, name)
@@ -84,6 +128,21 @@ func GetDatabaseLimitPath(name string) string {
 	return fmt.Sprintf("%s/%s", DatabaseLimitPath, name)
 }
 
+// GetContinuousQueryPath returns path which storing continuous query of database.
+func GetContinuousQueryPath(database, name string) string {
+	return fmt.Sprintf("%s/%s/%s", ContinuousQueryPath, database, name)
+}
+
+// GetContinuousQueryStatePath returns path which storing execution state of continuous query.
+func GetContinuousQueryStatePath(database, name string) string {
+	return fmt.Sprintf("%s/%s/%s", ContinuousQueryStatePath, database, name)
+}
+
+// GetQueryCacheVersionPath returns path which storing the version of database's cached query results.
+func GetQueryCacheVersionPath(database string) string {
+	return fmt.Sprintf("%s/%s", QueryCacheVersionPath, database)
+}
+
 // GetDatabaseAssignPath returns path which storing shard assignment of database
 func GetDatabaseAssignPath(name string) string {
 	return fmt.Sprintf("%s/%s", ShardAssignmentPath, name)
@@ -40,6 +40,20 @@ func TestGetDatabaseLimitPath(t *testing.T) {
 	assert.Equal(t, DatabaseLimitPath+slashPathName, GetDatabaseLimitPath(pathName))
 }
 
+func TestGetContinuousQueryPath(t *testing.T) {
+	assert.Equal(t, ContinuousQueryPath+"/db"+slashPathName, GetContinuousQueryPath("db", pathName))
+	assert.Equal(t, ContinuousQueryStatePath+"/db"+slashPathName, GetContinuousQueryStatePath("db", pathName))
+}
+
+func TestGetQueryCacheVersionPath(t *testing.T) {
+	assert.Equal(t, QueryCacheVersionPath+"/db", GetQueryCacheVersionPath("db"))
+}
+
+func TestGetAlertPath(t *testing.T) {
+	assert.Equal(t, AlertRulePath+slashPathName, GetAlertRulePath(pathName))
+	assert.Equal(t, AlertStatePath+slashPathName, GetAlertStatePath(pathName))
+}
+
 func TestGetNodePath(t *testing.T) {
 	assert.Equal(t, StorageLiveNodesPath+slashPathName, GetStorageLiveNodePath(pathName))
 }
@@ -55,3 +69,9 @@ func TestGetLiveNodePath(t *testing.T) {
 func TestShardAssignPath(t *testing.T) {
 	assert.Equal(t, ShardAssignmentPath+slashPathName, GetShardAssignPath(pathName))
 }
+
+func TestGetAuthPath(t *testing.T) {
+	assert.Equal(t, "/auth/user"+slashPathName, GetAuthUserPath(pathName))
+	assert.Equal(t, "/auth/role"+slashPathName, GetAuthRolePath(pathName))
+	assert.Equal(t, "/auth/token"+slashPathName, GetAuthTokenPath(pathName))
+}
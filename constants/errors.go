@@ -36,6 +36,7 @@ var (
 	ErrTargetNodesNotFound  = fmt.Errorf("target nodes %w", ErrNotFound)
 	ErrReceiveNodesNotFound = fmt.Errorf("receive nodes %w", ErrNotFound)
 	ErrMetricIDNotFound     = fmt.Errorf("metric %w", ErrNotFound)
+	ErrNamespaceNotFound    = fmt.Errorf("namespace %w", ErrNotFound)
 	ErrTagKeyIDNotFound     = fmt.Errorf("tag key %w", ErrNotFound)
 	ErrTagKeyMetaNotFound   = fmt.Errorf("tag key %w", ErrNotFound)
 	ErrTagValueSeqNotFound  = fmt.Errorf("tagValueSeq %w", ErrNotFound)
@@ -102,6 +102,13 @@ func (f *stateMachineFactory) Start() (err error) {
 	}
 	f.stateMachines = append(f.stateMachines, sm)
 
+	f.logger.Debug("starting AuthStateMachine")
+	sm, err = f.createAuthStateMachine()
+	if err != nil {
+		return err
+	}
+	f.stateMachines = append(f.stateMachines, sm)
+
 	f.logger.Info("started BrokerStateMachines")
 	return nil
 }
@@ -174,6 +181,30 @@ func (f *stateMachineFactory) createDatabaseLimitsStateMachine() (discovery.Stat
 	)
 }
 
+// createAuthStateMachine creates auth(user/role/token) state machine.
+func (f *stateMachineFactory) createAuthStateMachine() (discovery.StateMachine, error) {
+	return discovery.NewStateMachine(
+		f.ctx,
+		discovery.AuthStateMachine,
+		f.discoveryFactory,
+		constants.AuthPath,
+		true,
+		func(key string, data []byte) {
+			f.stateMgr.EmitEvent(&discovery.Event{
+				Type:  discovery.AuthChanged,
+				Key:   key,
+				Value: data,
+			})
+		},
+		func(key string) {
+			f.stateMgr.EmitEvent(&discovery.Event{
+				Type: discovery.AuthDeletion,
+				Key:  key,
+			})
+		},
+	)
+}
+
 // onDatabaseConfigChanged triggers when database config modified(create/update)
 func (f *stateMachineFactory) onDatabaseConfigChanged(key string, data []byte) {
 	f.stateMgr.EmitEvent(&discovery.Event{
@@ -58,8 +58,13 @@ func TestStateMachineFactory_Start(t *testing.T) {
 	discovery1.EXPECT().Discovery(gomock.Any()).Return(fmt.Errorf("err"))
 	err = fct.Start()
 	assert.Error(t, err)
-	// all state machines are ok
+	// auth sm err
 	discovery1.EXPECT().Discovery(gomock.Any()).Return(nil).MaxTimes(4)
+	discovery1.EXPECT().Discovery(gomock.Any()).Return(fmt.Errorf("err"))
+	err = fct.Start()
+	assert.Error(t, err)
+	// all state machines are ok
+	discovery1.EXPECT().Discovery(gomock.Any()).Return(nil).MaxTimes(5)
 	err = fct.Start()
 	assert.NoError(t, err)
 }
@@ -164,3 +169,32 @@ func TestStateMachineFactory_DatabaseLimits(t *testing.T) {
 	sm.OnCreate("/test", []byte("value"))
 	sm.OnDelete("/test")
 }
+
+func TestStateMachineFactory_Auth(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stateMgr := NewMockStateManager(ctrl)
+	discoveryFct := discovery.NewMockFactory(ctrl)
+	discovery1 := discovery.NewMockDiscovery(ctrl)
+	discoveryFct.EXPECT().CreateDiscovery(gomock.Any(), gomock.Any()).Return(discovery1)
+	discovery1.EXPECT().Discovery(gomock.Any()).Return(nil)
+	fct := NewStateMachineFactory(context.TODO(), discoveryFct, stateMgr)
+	fct1 := fct.(*stateMachineFactory)
+
+	sm, err := fct1.createAuthStateMachine()
+	assert.NoError(t, err)
+	assert.NotNil(t, sm)
+
+	stateMgr.EXPECT().EmitEvent(&discovery.Event{
+		Type:  discovery.AuthChanged,
+		Key:   "/auth/user/test",
+		Value: []byte("value"),
+	})
+	sm.OnCreate("/auth/user/test", []byte("value"))
+	stateMgr.EXPECT().EmitEvent(&discovery.Event{
+		Type: discovery.AuthDeletion,
+		Key:  "/auth/user/test",
+	})
+	sm.OnDelete("/auth/user/test")
+}
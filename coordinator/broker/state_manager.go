@@ -19,6 +19,7 @@ package broker
 
 import (
 	"context"
+	"fmt"
 	"path/filepath"
 	"sort"
 	"strings"
@@ -42,6 +43,7 @@ import (
 // StateManager represents broker state manager, maintains broker node/database/storage states in memory.
 type StateManager interface {
 	flow.NodeChoose
+	flow.ReplicaChoose
 	discovery.StateMachineEventHandle
 
 	// GetCurrentNode returns the current node.
@@ -58,6 +60,12 @@ type StateManager interface {
 	GetQueryableReplicas(databaseName string) (map[string][]models.ShardID, error)
 	// GetStorage returns storage state.
 	GetStorage() *models.StorageState
+	// GetUser returns the user by name.
+	GetUser(name string) (*models.User, bool)
+	// GetRole returns the role by name.
+	GetRole(name string) (*models.Role, bool)
+	// GetAPIToken returns the api token by token id(hash of token).
+	GetAPIToken(id string) (*models.APIToken, bool)
 
 	WatchShardStateChangeEvent(fn func(databaseCfg models.Database,
 		shards map[models.ShardID]models.ShardState,
@@ -75,6 +83,9 @@ type stateManager struct {
 	storageState *models.StorageState            // storage state
 	databases    map[string]models.Database      // database config
 	nodes        map[string]models.StatelessNode // live nodes of broker cluster
+	users        map[string]*models.User         // users
+	roles        map[string]*models.Role         // roles
+	tokens       map[string]*models.APIToken     // api token id => api token
 
 	callbacks []func(databaseCfg models.Database,
 		shards map[models.ShardID]models.ShardState,
@@ -106,6 +117,9 @@ func NewStateManager(
 		storageState:      models.NewStorageState(),
 		databases:         make(map[string]models.Database),
 		nodes:             make(map[string]models.StatelessNode),
+		users:             make(map[string]*models.User),
+		roles:             make(map[string]*models.Role),
+		tokens:            make(map[string]*models.APIToken),
 		events:            make(chan *discovery.Event, 10),
 		statistics:        metrics.NewStateManagerStatistics(linmetric.BrokerRegistry),
 		logger:            logger.GetLogger("Broker", "StateManager"),
@@ -147,6 +161,43 @@ func (m *stateManager) Choose(database string, numOfNodes int) ([]*models.Physic
 	return []*models.PhysicalPlan{physicalPlan}, nil
 }
 
+// ChooseReplicas chooses all replica nodes of all shards then builds physical plan,
+// returns error if any replica node is not alive.
+func (m *stateManager) ChooseReplicas(database string) (*models.PhysicalPlan, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	if _, ok := m.databases[database]; !ok {
+		return nil, constants.ErrDatabaseNotFound
+	}
+	shards := m.storageState.ShardStates[database]
+	if len(shards) == 0 {
+		return nil, constants.ErrShardNotFound
+	}
+	liveNodes := m.storageState.LiveNodes
+	replicas := make(map[string][]models.ShardID)
+	for shardID, shardState := range shards {
+		for _, nodeID := range shardState.Replica.Replicas {
+			node, ok := liveNodes[nodeID]
+			if !ok {
+				return nil, fmt.Errorf("%w, shard: %d, node: %d", constants.ErrNoLiveReplica, shardID, nodeID)
+			}
+			nodeIndicator := node.Indicator()
+			replicas[nodeIndicator] = append(replicas[nodeIndicator], shardID)
+		}
+	}
+	physicalPlan := &models.PhysicalPlan{
+		Database: database,
+	}
+	for storageNode, shardIDs := range replicas {
+		physicalPlan.AddTarget(&models.Target{
+			Indicator: storageNode,
+			ShardIDs:  shardIDs,
+		})
+	}
+	return physicalPlan, nil
+}
+
 func (m *stateManager) WatchShardStateChangeEvent(fn func(databaseCfg models.Database,
 	shards map[models.ShardID]models.ShardState,
 	liveNodes map[models.NodeID]models.StatefulNode,
@@ -210,6 +261,10 @@ func (m *stateManager) processEvent(event *discovery.Event) {
 		err = m.onStorageStateChange(event.Key, event.Value)
 	case discovery.DatabaseLimitsChanged:
 		err = m.onDatabaseLimitsChange(event.Key, event.Value)
+	case discovery.AuthChanged:
+		err = m.onAuthChange(event.Key, event.Value)
+	case discovery.AuthDeletion:
+		m.onAuthDelete(event.Key)
 	}
 	if err != nil {
 		m.statistics.HandleEventFailure.WithTagValues(eventType, constants.BrokerRole).Incr()
@@ -236,6 +291,50 @@ func (m *stateManager) onDatabaseLimitsChange(key string, data []byte) error {
 	return nil
 }
 
+// onAuthChange triggers when user/role/api token create/modify.
+func (m *stateManager) onAuthChange(key string, data []byte) error {
+	m.logger.Info("auth config is modified", logger.String("key", key))
+
+	var err error
+	switch {
+	case strings.HasPrefix(key, constants.GetAuthUserPath("")):
+		user := &models.User{}
+		if err = encoding.JSONUnmarshal(data, user); err == nil {
+			m.users[user.Name] = user
+		}
+	case strings.HasPrefix(key, constants.GetAuthRolePath("")):
+		role := &models.Role{}
+		if err = encoding.JSONUnmarshal(data, role); err == nil {
+			m.roles[role.Name] = role
+		}
+	case strings.HasPrefix(key, constants.GetAuthTokenPath("")):
+		token := &models.APIToken{}
+		if err = encoding.JSONUnmarshal(data, token); err == nil {
+			m.tokens[token.ID] = token
+		}
+	}
+	if err != nil {
+		m.logger.Error("auth config modified but unmarshal error",
+			logger.String("key", key), logger.Error(err))
+	}
+	return err
+}
+
+// onAuthDelete triggers when user/role/api token is deletion.
+func (m *stateManager) onAuthDelete(key string) {
+	m.logger.Info("auth config deleted", logger.String("key", key))
+
+	_, name := filepath.Split(key)
+	switch {
+	case strings.HasPrefix(key, constants.GetAuthUserPath("")):
+		delete(m.users, name)
+	case strings.HasPrefix(key, constants.GetAuthRolePath("")):
+		delete(m.roles, name)
+	case strings.HasPrefix(key, constants.GetAuthTokenPath("")):
+		delete(m.tokens, name)
+	}
+}
+
 // onDatabaseCfgChange triggers when database create/modify.
 func (m *stateManager) onDatabaseCfgChange(key string, data []byte) error {
 	m.logger.Info("database config is modified",
@@ -392,6 +491,33 @@ func (m *stateManager) GetStorage() *models.StorageState {
 	return m.storageState
 }
 
+// GetUser returns the user by name.
+func (m *stateManager) GetUser(name string) (*models.User, bool) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	user, ok := m.users[name]
+	return user, ok
+}
+
+// GetRole returns the role by name.
+func (m *stateManager) GetRole(name string) (*models.Role, bool) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	role, ok := m.roles[name]
+	return role, ok
+}
+
+// GetAPIToken returns the api token by token id(hash of token).
+func (m *stateManager) GetAPIToken(id string) (*models.APIToken, bool) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	token, ok := m.tokens[id]
+	return token, ok
+}
+
 // GetQueryableReplicas returns the queryable replicas, else return detail error msg.::x
 // returns storage node => shard id list
 func (m *stateManager) GetQueryableReplicas(databaseName string) (map[string][]models.ShardID, error) {
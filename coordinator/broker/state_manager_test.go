@@ -19,6 +19,7 @@ package broker
 
 import (
 	"context"
+	"sort"
 	"testing"
 	"time"
 
@@ -326,6 +327,57 @@ func TestStateManager_Choose(t *testing.T) {
 	assert.Len(t, plans, 1)
 }
 
+func TestStateManager_ChooseReplicas(t *testing.T) {
+	mgr := &stateManager{
+		databases: map[string]models.Database{
+			"test_1": {},
+			"test_2": {},
+		},
+		storageState: &models.StorageState{
+			LiveNodes: map[models.NodeID]models.StatefulNode{
+				1: {StatelessNode: models.StatelessNode{HostIP: "1.1.1.1", GRPCPort: 2891}},
+				2: {StatelessNode: models.StatelessNode{HostIP: "1.1.1.2", GRPCPort: 2891}},
+			},
+			ShardStates: map[string]map[models.ShardID]models.ShardState{
+				"test_1": {
+					1: {Replica: models.Replica{Replicas: []models.NodeID{1, 2}}},
+					2: {Replica: models.Replica{Replicas: []models.NodeID{2}}},
+				},
+				"test_3": {
+					1: {Replica: models.Replica{Replicas: []models.NodeID{1, 3}}},
+				},
+			},
+		},
+		logger: logger.GetLogger("Test", "StateManager"),
+	}
+	// database not found
+	plan, err := mgr.ChooseReplicas("test")
+	assert.Error(t, err)
+	assert.Nil(t, plan)
+	// shard not found
+	plan, err = mgr.ChooseReplicas("test_2")
+	assert.Error(t, err)
+	assert.Nil(t, plan)
+	// replica node not alive
+	mgr.databases["test_3"] = models.Database{}
+	plan, err = mgr.ChooseReplicas("test_3")
+	assert.ErrorIs(t, err, constants.ErrNoLiveReplica)
+	assert.Nil(t, plan)
+
+	plan, err = mgr.ChooseReplicas("test_1")
+	assert.NoError(t, err)
+	assert.Len(t, plan.Targets, 2)
+	shards := make(map[string][]models.ShardID)
+	for _, target := range plan.Targets {
+		sort.Slice(target.ShardIDs, func(i, j int) bool { return target.ShardIDs[i] < target.ShardIDs[j] })
+		shards[target.Indicator] = target.ShardIDs
+	}
+	assert.Equal(t, map[string][]models.ShardID{
+		"1.1.1.1:2891": {1},
+		"1.1.1.2:2891": {1, 2},
+	}, shards)
+}
+
 func TestStateManager_onDatabaseLimits(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -350,3 +402,53 @@ func TestStateManager_onDatabaseLimits(t *testing.T) {
 	assert.Equal(t, limit2, models.GetDatabaseLimits("db2"))
 	assert.Equal(t, models.NewDefaultLimits(), models.GetDatabaseLimits("test"))
 }
+
+func TestStateManager_Auth(t *testing.T) {
+	mgr := NewStateManager(context.TODO(), models.StatelessNode{}, nil, nil)
+	defer mgr.Close()
+
+	// case 1: unmarshal user err
+	mgr.EmitEvent(&discovery.Event{
+		Type:  discovery.AuthChanged,
+		Key:   constants.GetAuthUserPath("bob"),
+		Value: []byte("dd"),
+	})
+	// case 2: cache user/role/token
+	mgr.EmitEvent(&discovery.Event{
+		Type:  discovery.AuthChanged,
+		Key:   constants.GetAuthUserPath("bob"),
+		Value: []byte(`{"name":"bob","roles":["ops"]}`),
+	})
+	mgr.EmitEvent(&discovery.Event{
+		Type:  discovery.AuthChanged,
+		Key:   constants.GetAuthRolePath("ops"),
+		Value: []byte(`{"name":"ops","privileges":[{"database":"db","permissions":["read"]}]}`),
+	})
+	mgr.EmitEvent(&discovery.Event{
+		Type:  discovery.AuthChanged,
+		Key:   constants.GetAuthTokenPath("abc"),
+		Value: []byte(`{"id":"abc","name":"ci","user":"bob"}`),
+	})
+	time.Sleep(100 * time.Millisecond)
+	user, ok := mgr.GetUser("bob")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"ops"}, user.Roles)
+	role, ok := mgr.GetRole("ops")
+	assert.True(t, ok)
+	assert.True(t, role.Allow("db", models.ReadPermission))
+	token, ok := mgr.GetAPIToken("abc")
+	assert.True(t, ok)
+	assert.Equal(t, "bob", token.User)
+
+	// case 3: remove user/role/token
+	mgr.EmitEvent(&discovery.Event{Type: discovery.AuthDeletion, Key: constants.GetAuthUserPath("bob")})
+	mgr.EmitEvent(&discovery.Event{Type: discovery.AuthDeletion, Key: constants.GetAuthRolePath("ops")})
+	mgr.EmitEvent(&discovery.Event{Type: discovery.AuthDeletion, Key: constants.GetAuthTokenPath("abc")})
+	time.Sleep(100 * time.Millisecond)
+	_, ok = mgr.GetUser("bob")
+	assert.False(t, ok)
+	_, ok = mgr.GetRole("ops")
+	assert.False(t, ok)
+	_, ok = mgr.GetAPIToken("abc")
+	assert.False(t, ok)
+}
@@ -31,6 +31,8 @@ const (
 	BrokerConfigChanged
 	BrokerConfigDeletion
 	DatabaseLimitsChanged
+	AuthChanged
+	AuthDeletion
 )
 
 // String returns string value of EventType.
@@ -56,6 +58,10 @@ func (e EventType) String() string {
 		return "BrokerConfigDeletion"
 	case DatabaseLimitsChanged:
 		return "DatabaseLimitsChanged"
+	case AuthChanged:
+		return "AuthChanged"
+	case AuthDeletion:
+		return "AuthDeletion"
 	default:
 		return "unknown"
 	}
@@ -35,4 +35,6 @@ func TestEventType_String(t *testing.T) {
 	assert.Equal(t, "BrokerConfigDeletion", BrokerConfigDeletion.String())
 	assert.Equal(t, "BrokerConfigChanged", BrokerConfigChanged.String())
 	assert.Equal(t, "DatabaseLimitsChanged", DatabaseLimitsChanged.String())
+	assert.Equal(t, "AuthChanged", AuthChanged.String())
+	assert.Equal(t, "AuthDeletion", AuthDeletion.String())
 }
@@ -50,6 +50,8 @@ const (
 	BrokerConfigStateMachine
 	BrokerNodeStateMachine
 	DatabaseLimitsStateMachine
+	AuthStateMachine
+	QueryCacheStateMachine
 )
 
 // String returns state machine type desc.
@@ -73,6 +75,10 @@ func (st StateMachineType) String() string {
 		return "BrokerNodeStateMachine"
 	case DatabaseLimitsStateMachine:
 		return "DatabaseLimitsStateMachine"
+	case AuthStateMachine:
+		return "AuthStateMachine"
+	case QueryCacheStateMachine:
+		return "QueryCacheStateMachine"
 	default:
 		return "Unknown"
 	}
@@ -41,6 +41,8 @@ func TestStateMachineType_String(t *testing.T) {
 	assert.Equal(t, BrokerConfigStateMachine.String(), "BrokerConfigStateMachine")
 	assert.Equal(t, BrokerNodeStateMachine.String(), "BrokerNodeStateMachine")
 	assert.Equal(t, DatabaseLimitsStateMachine.String(), "DatabaseLimitsStateMachine")
+	assert.Equal(t, AuthStateMachine.String(), "AuthStateMachine")
+	assert.Equal(t, QueryCacheStateMachine.String(), "QueryCacheStateMachine")
 }
 
 func TestNewMockStateMachine(t *testing.T) {
@@ -33,6 +33,7 @@ import (
 	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/series"
 	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/series/histogram"
 	"github.com/lindb/lindb/series/metric"
 	"github.com/lindb/lindb/series/tag"
 	"github.com/lindb/lindb/sql/stmt"
@@ -87,6 +88,10 @@ type StorageExecuteContext struct {
 	GroupByTagKeyIDs []tag.KeyID
 	// for group by query store tag value ids for each group tag key
 	GroupingTagValueIDs []*roaring.Bitmap
+	// rewrites grouping tags based on group by tag functions, nil if query hasn't group by tag function.
+	GroupingTagsRewriter *GroupingTagsRewriter
+	// set value in plan stage when lookup distinct_count(tag) function.
+	DistinctTags tag.Metas
 
 	mutex sync.Mutex
 }
@@ -104,6 +109,10 @@ func (ctx *StorageExecuteContext) collectGroupingTagValueIDs(tagValueIDs []uint3
 	// need add lock, because build group concurrent(multi-shard)
 	ctx.mutex.Lock()
 	for idx, tagValueID := range tagValueIDs {
+		if tagValueID == MissingTagValueID {
+			// series hasn't the tag key(raw series query)
+			continue
+		}
 		tIDs := ctx.GroupingTagValueIDs[idx]
 		if tIDs == nil {
 			ctx.GroupingTagValueIDs[idx] = roaring.BitmapOf(tagValueID)
@@ -285,8 +294,12 @@ type DataLoadContext struct {
 	IsMultiField, IsGrouping bool
 	MinSeriesID, MaxSeriesID uint16
 
-	Decoder      *encoding.TSDDecoder
-	DownSampling func(slotRange timeutil.SlotRange, seriesIdx uint16, fieldIdx int, getter encoding.TSDValueGetter)
+	// hash of tag value for each distinct tag by series index, 0 if series hasn't the tag.
+	DistinctTagValues [][]float64
+
+	Decoder          *encoding.TSDDecoder
+	HistogramDecoder *histogram.BlockDecoder
+	DownSampling     func(slotRange timeutil.SlotRange, seriesIdx uint16, fieldIdx int, getter encoding.TSDValueGetter)
 
 	PendingDataLoadTasks *atomic.Int32
 }
@@ -310,14 +323,17 @@ func (ctx *DataLoadContext) NewSeriesAggregator(groupingKey string) uint16 {
 	groupingSeriesAgg := &GroupingSeriesAgg{
 		Key: groupingKey,
 	}
-	tagsData := []byte(groupingKey)
-	var tagValueIDs []uint32
-	for idx := range ctx.ShardExecuteCtx.StorageExecuteCtx.GroupByTagKeyIDs {
-		offset := idx * 4
-		tagValueID := binary.LittleEndian.Uint32(tagsData[offset:])
-		tagValueIDs = append(tagValueIDs, tagValueID)
+	// if grouping tags need rewrite, tag values are collected when regroup series aggregator
+	if ctx.ShardExecuteCtx.StorageExecuteCtx.GroupingTagsRewriter == nil {
+		tagsData := []byte(groupingKey)
+		var tagValueIDs []uint32
+		for idx := range ctx.ShardExecuteCtx.StorageExecuteCtx.GroupByTagKeyIDs {
+			offset := idx * 4
+			tagValueID := binary.LittleEndian.Uint32(tagsData[offset:])
+			tagValueIDs = append(tagValueIDs, tagValueID)
+		}
+		ctx.ShardExecuteCtx.StorageExecuteCtx.collectGroupingTagValueIDs(tagValueIDs)
 	}
-	ctx.ShardExecuteCtx.StorageExecuteCtx.collectGroupingTagValueIDs(tagValueIDs)
 
 	if ctx.IsMultiField {
 		groupingSeriesAgg.Aggregators = ctx.newSeriesAggregators()
@@ -329,10 +345,37 @@ func (ctx *DataLoadContext) NewSeriesAggregator(groupingKey string) uint16 {
 	return rs
 }
 
+// RegroupSeriesAggregator regroups the grouping series aggregators by new grouping keys(aggregator index => new key),
+// aggregators with same new key are merged into one, so that series are aggregated by new grouping key when load data.
+func (ctx *DataLoadContext) RegroupSeriesAggregator(groupingKeys []string) {
+	if len(ctx.GroupingSeriesAgg) == 0 {
+		return
+	}
+	aggIdxs := make(map[string]uint16)                    // new grouping key => new aggregator index
+	aggRefs := make([]uint16, len(ctx.GroupingSeriesAgg)) // old aggregator index => new aggregator index
+	var groupingSeriesAgg []*GroupingSeriesAgg
+	for idx, groupingKey := range groupingKeys {
+		aggIdx, ok := aggIdxs[groupingKey]
+		if !ok {
+			aggIdx = uint16(len(groupingSeriesAgg))
+			agg := ctx.GroupingSeriesAgg[idx]
+			agg.Key = groupingKey
+			groupingSeriesAgg = append(groupingSeriesAgg, agg)
+			aggIdxs[groupingKey] = aggIdx
+		}
+		aggRefs[idx] = aggIdx
+	}
+	for seriesIdx, aggIdx := range ctx.GroupingSeriesAggRefs {
+		ctx.GroupingSeriesAggRefs[seriesIdx] = aggRefs[aggIdx]
+	}
+	ctx.GroupingSeriesAgg = groupingSeriesAgg
+	ctx.groupingSeriesAggRefIdx = uint16(len(groupingSeriesAgg))
+}
+
 // newSeriesAggregators creates the series aggregators for multi field.
 func (ctx *DataLoadContext) newSeriesAggregators() []aggregation.SeriesAggregator {
-	rs := make([]aggregation.SeriesAggregator, len(ctx.ShardExecuteCtx.StorageExecuteCtx.Fields))
-	for fieldIdx := range ctx.ShardExecuteCtx.StorageExecuteCtx.Fields {
+	rs := make([]aggregation.SeriesAggregator, len(ctx.ShardExecuteCtx.StorageExecuteCtx.DownSamplingSpecs))
+	for fieldIdx := range ctx.ShardExecuteCtx.StorageExecuteCtx.DownSamplingSpecs {
 		rs[fieldIdx] = aggregation.NewSeriesAggregator(
 			ctx.ShardExecuteCtx.StorageExecuteCtx.Query.Interval,
 			ctx.ShardExecuteCtx.StorageExecuteCtx.Query.IntervalRatio,
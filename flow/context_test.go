@@ -244,6 +244,47 @@ func TestDataLoadContext_NewSeriesAggregator(t *testing.T) {
 	assert.Equal(t, uint16(0), idx)
 	assert.Nil(t, ctx.GroupingSeriesAgg[0].Aggregator)
 	assert.NotNil(t, ctx.GroupingSeriesAgg[0].Aggregators)
+
+	// grouping tags need rewrite, not collect tag value ids
+	storageCtx := &StorageExecuteContext{
+		DownSamplingSpecs:    aggregation.AggregatorSpecs{aggregation.NewAggregatorSpec("f", field.SumField)},
+		Query:                &stmt.Query{},
+		GroupByTagKeyIDs:     []tag.KeyID{1},
+		GroupingTagValueIDs:  make([]*roaring.Bitmap, 1),
+		GroupingTagsRewriter: &GroupingTagsRewriter{},
+	}
+	ctx = &DataLoadContext{ShardExecuteCtx: &ShardExecuteContext{StorageExecuteCtx: storageCtx}}
+	ctx.NewSeriesAggregator(string([]byte{1, 0, 0, 0}))
+	assert.False(t, storageCtx.HasGroupingTagValueIDs())
+}
+
+func TestDataLoadContext_RegroupSeriesAggregator(t *testing.T) {
+	ctx := &DataLoadContext{
+		ShardExecuteCtx: &ShardExecuteContext{
+			StorageExecuteCtx: &StorageExecuteContext{
+				DownSamplingSpecs: aggregation.AggregatorSpecs{aggregation.NewAggregatorSpec("f", field.SumField)},
+				Query:             &stmt.Query{},
+			},
+		},
+	}
+	// no group
+	ctx.RegroupSeriesAggregator(nil)
+	assert.Empty(t, ctx.GroupingSeriesAgg)
+
+	ctx.GroupingSeriesAggRefs = make([]uint16, 4)
+	for seriesIdx, key := range []string{"a", "b", "c", "d"} {
+		ctx.GroupingSeriesAggRefs[seriesIdx] = ctx.NewSeriesAggregator(key)
+	}
+	agg1 := ctx.GroupingSeriesAgg[1]
+	ctx.RegroupSeriesAggregator([]string{"x", "y", "y", "x"})
+	assert.Len(t, ctx.GroupingSeriesAgg, 2)
+	assert.Equal(t, "x", ctx.GroupingSeriesAgg[0].Key)
+	assert.Equal(t, "y", ctx.GroupingSeriesAgg[1].Key)
+	assert.Equal(t, []uint16{0, 1, 1, 0}, ctx.GroupingSeriesAggRefs)
+	// first aggregator of merged groups is reused
+	assert.Same(t, agg1, ctx.GroupingSeriesAgg[1])
+	// new aggregator after regroup
+	assert.Equal(t, uint16(2), ctx.NewSeriesAggregator("z"))
 }
 
 func TestDataLoadContext_HasGroupingData(t *testing.T) {
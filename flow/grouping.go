@@ -19,6 +19,7 @@ package flow
 
 import (
 	"encoding/binary"
+	"math"
 
 	"github.com/lindb/roaring"
 
@@ -28,6 +29,9 @@ import (
 
 //go:generate mockgen -source=./grouping.go -destination=./grouping_mock.go -package=flow
 
+// MissingTagValueID represents the tag value id of grouping tag key which series hasn't(raw series query).
+const MissingTagValueID = math.MaxUint32
+
 // GroupingContext represents the context of group by query for tag keys
 type GroupingContext interface {
 	// BuildGroup builds the grouped series ids by the high key of series id
@@ -36,6 +40,8 @@ type GroupingContext interface {
 	// ScanTagValueIDs scans grouping context by high key/container of series ids,
 	// then returns grouped tag value ids for each tag key
 	ScanTagValueIDs(highKey uint16, container roaring.Container) []*roaring.Bitmap
+	// ScanSeriesTagValueIDs scans tag value id of each series which query need by tag key.
+	ScanSeriesTagValueIDs(ctx *DataLoadContext, tagKeyID tag.KeyID, fn func(seriesIdxFromQuery uint16, tagValueID uint32))
 }
 
 // GroupingScanner represents the scanner which scans the group by data by high key of series id
@@ -107,6 +113,10 @@ func (g *groupingContext) ScanTagValueIDs(highKey uint16, container roaring.Cont
 // BuildGroup builds the grouped series ids by the high key of series id
 // and the container includes low keys of series id.
 func (g *groupingContext) BuildGroup(ctx *DataLoadContext) {
+	if ctx.ShardExecuteCtx.StorageExecuteCtx.Query.RawSeries {
+		g.buildGroupForRawSeries(ctx)
+		return
+	}
 	if len(g.tagKeys) == 1 {
 		g.buildGroupForSingleTag(ctx)
 	} else {
@@ -162,22 +172,58 @@ func (g *groupingContext) buildGroupForSingleTag(ctx *DataLoadContext) {
 	})
 }
 
+// buildGroupForRawSeries builds grouping for each series, includes the series which hasn't some of tag keys,
+// tag value id of missing tag key is MissingTagValueID.
+func (g *groupingContext) buildGroupForRawSeries(ctx *DataLoadContext) {
+	tagSize := len(g.tagKeys)
+	missingTagValueIDs := make([]byte, tagSize*4)
+	for idx := 0; idx < tagSize; idx++ {
+		binary.LittleEndian.PutUint32(missingTagValueIDs[idx*4:], MissingTagValueID)
+	}
+	tagValueIDsForGrouping := make([][]byte, len(ctx.LowSeriesIDs))
+	g.scanGroupingTags(ctx, func(seriesIdxFromQuery uint16, tagKeyIDIdx int, tagValueID uint32) {
+		tagValueIDs := tagValueIDsForGrouping[seriesIdxFromQuery]
+		if tagValueIDs == nil {
+			tagValueIDs = append([]byte(nil), missingTagValueIDs...)
+			tagValueIDsForGrouping[seriesIdxFromQuery] = tagValueIDs
+		}
+		binary.LittleEndian.PutUint32(tagValueIDs[tagKeyIDIdx*4:], tagValueID)
+	})
+	it := ctx.LowSeriesIDsContainer.PeekableIterator()
+	for it.HasNext() {
+		seriesIdxFromQuery := it.Next() - ctx.MinSeriesID
+		tagValueIDs := tagValueIDsForGrouping[seriesIdxFromQuery]
+		if tagValueIDs == nil {
+			tagValueIDs = missingTagValueIDs
+		}
+		// tags of series are unique, each series has its own aggregator
+		ctx.GroupingSeriesAggRefs[seriesIdxFromQuery] = ctx.NewSeriesAggregator(string(tagValueIDs))
+	}
+}
+
 // scanGroupingTags scans grouping tags(series ids=>tag value ids)
 func (g *groupingContext) scanGroupingTags(ctx *DataLoadContext,
 	fn func(seriesIdxFromQuery uint16, tagKeyIDIdx int, tagValueID uint32),
 ) {
-	seriesIDHighKey := ctx.SeriesIDHighKey
 	for tagKeyIdx, tagKey := range g.tagKeys {
-		scanners := g.scanners[tagKey]
-		for _, scanner := range scanners {
-			lowSeriesIDs, tagValueIDs := scanner.GetSeriesAndTagValue(seriesIDHighKey)
-			if lowSeriesIDs == nil {
-				// high key not exist
-				continue
-			}
-			ctx.IterateLowSeriesIDs(lowSeriesIDs, func(seriesIdxFromQuery uint16, seriesIdxFromStorage int) {
-				fn(seriesIdxFromQuery, tagKeyIdx, tagValueIDs[seriesIdxFromStorage])
-			})
+		g.ScanSeriesTagValueIDs(ctx, tagKey, func(seriesIdxFromQuery uint16, tagValueID uint32) {
+			fn(seriesIdxFromQuery, tagKeyIdx, tagValueID)
+		})
+	}
+}
+
+// ScanSeriesTagValueIDs scans tag value id of each series which query need by tag key.
+func (g *groupingContext) ScanSeriesTagValueIDs(ctx *DataLoadContext, tagKeyID tag.KeyID,
+	fn func(seriesIdxFromQuery uint16, tagValueID uint32),
+) {
+	for _, scanner := range g.scanners[tagKeyID] {
+		lowSeriesIDs, tagValueIDs := scanner.GetSeriesAndTagValue(ctx.SeriesIDHighKey)
+		if lowSeriesIDs == nil {
+			// high key not exist
+			continue
 		}
+		ctx.IterateLowSeriesIDs(lowSeriesIDs, func(seriesIdxFromQuery uint16, seriesIdxFromStorage int) {
+			fn(seriesIdxFromQuery, tagValueIDs[seriesIdxFromStorage])
+		})
 	}
 }
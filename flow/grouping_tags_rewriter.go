@@ -0,0 +1,130 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package flow
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/lindb/lindb/series/tag"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+// groupingTag represents the grouping tag of result which derived from source tag values.
+type groupingTag struct {
+	groupByTag *stmt.GroupByTag
+	sources    []int // index of source tag values
+	pattern    *regexp.Regexp
+}
+
+// GroupingTagsRewriter rewrites the source tag values(grouped by source tag keys)
+// to the grouping tag values of result based on group by tag functions.
+type GroupingTagsRewriter struct {
+	sourceLen int
+	tags      []*groupingTag
+	rewritten map[string]string // source tag values => tag values of result
+
+	mutex sync.Mutex
+}
+
+// NewGroupingTagsRewriter creates the grouping tags rewriter, returns nil if query has not group by tag function.
+func NewGroupingTagsRewriter(statement *stmt.Query) (*GroupingTagsRewriter, error) {
+	if len(statement.GroupByTags) == 0 {
+		return nil, nil
+	}
+	sourceIdx := make(map[string]int)
+	for idx, tagKey := range statement.GroupBy {
+		sourceIdx[tagKey] = idx
+	}
+	rewriter := &GroupingTagsRewriter{
+		sourceLen: len(statement.GroupBy),
+		rewritten: make(map[string]string),
+	}
+	for _, groupByTag := range statement.GroupByTags {
+		pattern, err := groupByTag.Pattern()
+		if err != nil {
+			return nil, err
+		}
+		t := &groupingTag{groupByTag: groupByTag, pattern: pattern}
+		for _, tagKey := range groupByTag.TagKeys {
+			idx, ok := sourceIdx[tagKey]
+			if !ok {
+				return nil, fmt.Errorf("tag key of group by tag function not in group by: %s", tagKey)
+			}
+			t.sources = append(t.sources, idx)
+		}
+		rewriter.tags = append(rewriter.tags, t)
+	}
+	return rewriter, nil
+}
+
+// Rewrite returns the tag values of result based on source tag values,
+// if source tag values not match group by tag keys, returns source tag values.
+// Rewrite is thread-safe, because grouping of multi-shard are executed concurrently.
+func (r *GroupingTagsRewriter) Rewrite(tags string) string {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if rewritten, ok := r.rewritten[tags]; ok {
+		return rewritten
+	}
+	sourceValues := tag.SplitTagValues(tags)
+	if len(sourceValues) != r.sourceLen {
+		return tags
+	}
+	tagValues := make([]string, len(r.tags))
+	for idx, t := range r.tags {
+		tagValues[idx] = t.eval(sourceValues)
+	}
+	rewritten := tag.ConcatTagValues(tagValues)
+	r.rewritten[tags] = rewritten
+	return rewritten
+}
+
+// eval evaluates the tag value based on source tag values.
+func (t *groupingTag) eval(sourceValues []string) string {
+	value := sourceValues[t.sources[0]]
+	switch t.groupByTag.FuncType {
+	case stmt.RegexpExtract:
+		match := t.pattern.FindStringSubmatch(value)
+		switch {
+		case match == nil:
+			return ""
+		case len(match) > 1:
+			return match[1]
+		default:
+			return match[0]
+		}
+	case stmt.LabelReplace:
+		match := t.pattern.FindStringSubmatchIndex(value)
+		if match == nil {
+			return value
+		}
+		return string(t.pattern.ExpandString(nil, t.groupByTag.Args[1], value, match))
+	case stmt.LabelJoin:
+		values := make([]string, len(t.sources))
+		for idx, source := range t.sources {
+			values[idx] = sourceValues[source]
+		}
+		return strings.Join(values, t.groupByTag.Args[0])
+	default:
+		return value
+	}
+}
@@ -0,0 +1,67 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package flow
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/series/tag"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestGroupingTagsRewriter_Rewrite(t *testing.T) {
+	rewriter, err := NewGroupingTagsRewriter(&stmt.Query{GroupBy: []string{"host"}})
+	assert.NoError(t, err)
+	assert.Nil(t, rewriter)
+
+	rewriter, err = NewGroupingTagsRewriter(&stmt.Query{
+		GroupBy: []string{"region", "host"},
+		GroupByTags: []*stmt.GroupByTag{
+			{TagKeys: []string{"region"}, Alias: "region"},
+			{FuncType: stmt.RegexpExtract, TagKeys: []string{"host"}, Args: []string{`\.(\w+)$`}, Alias: "dc"},
+			{FuncType: stmt.RegexpExtract, TagKeys: []string{"host"}, Args: []string{`^web`}, Alias: "role"},
+			{FuncType: stmt.LabelReplace, TagKeys: []string{"host"}, Args: []string{`web-(\d+)\..*`, "node-$1"}, Alias: "node"},
+			{FuncType: stmt.LabelJoin, TagKeys: []string{"region", "host"}, Args: []string{"/"}, Alias: "location"},
+		},
+	})
+	assert.NoError(t, err)
+	tags := rewriter.Rewrite(tag.ConcatTagValues([]string{"sh", "web-12.dc1"}))
+	assert.Equal(t, []string{"sh", "dc1", "web", "node-12", "sh/web-12.dc1"}, tag.SplitTagValues(tags))
+	// cached
+	assert.Equal(t, tags, rewriter.Rewrite(tag.ConcatTagValues([]string{"sh", "web-12.dc1"})))
+	// not matched
+	tags = rewriter.Rewrite(tag.ConcatTagValues([]string{"bj", "db"}))
+	assert.Equal(t, []string{"bj", "", "", "db", "bj/db"}, tag.SplitTagValues(tags))
+	// tag values not match group by tag keys
+	assert.Equal(t, "sh", rewriter.Rewrite("sh"))
+}
+
+func TestGroupingTagsRewriter_New_Fail(t *testing.T) {
+	_, err := NewGroupingTagsRewriter(&stmt.Query{
+		GroupBy:     []string{"host"},
+		GroupByTags: []*stmt.GroupByTag{{FuncType: stmt.RegexpExtract, TagKeys: []string{"host"}, Args: []string{"(a"}, Alias: "dc"}},
+	})
+	assert.Error(t, err)
+	_, err = NewGroupingTagsRewriter(&stmt.Query{
+		GroupBy:     []string{"host"},
+		GroupByTags: []*stmt.GroupByTag{{FuncType: stmt.RegexpExtract, TagKeys: []string{"ip"}, Args: []string{"a"}, Alias: "dc"}},
+	})
+	assert.Error(t, err)
+}
@@ -18,6 +18,7 @@
 package flow
 
 import (
+	"encoding/binary"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -83,6 +84,39 @@ func TestGroupingContext_BuildSingleTag(t *testing.T) {
 	assert.Empty(t, rs)
 }
 
+func TestGroupingContext_ScanSeriesTagValueIDs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	scanner := NewMockGroupingScanner(ctrl)
+	// distinct tag isn't group by tag key
+	ctx := NewGroupContext(nil, map[tag.KeyID][]GroupingScanner{2: {scanner}})
+	storageSeriesIDs := roaring.BitmapOf(1, 2, 3, 10)
+	scanner.EXPECT().GetSeriesAndTagValue(uint16(1)).
+		Return(storageSeriesIDs.GetContainerAtIndex(0), []uint32{10, 20, 30, 0})
+	querySeriesIDs := roaring.BitmapOf(1, 2, 6, 10)
+	dataLoadCtx := &DataLoadContext{
+		SeriesIDHighKey:       1,
+		LowSeriesIDsContainer: querySeriesIDs.GetContainerAtIndex(0),
+	}
+	dataLoadCtx.Grouping()
+	rs := make(map[uint16]uint32)
+	ctx.ScanSeriesTagValueIDs(dataLoadCtx, 2, func(seriesIdxFromQuery uint16, tagValueID uint32) {
+		rs[seriesIdxFromQuery+dataLoadCtx.MinSeriesID] = tagValueID
+	})
+	assert.Equal(t, map[uint16]uint32{1: 10, 2: 20, 10: 0}, rs)
+
+	// tag key not found
+	ctx.ScanSeriesTagValueIDs(dataLoadCtx, 3, func(_ uint16, _ uint32) {
+		assert.Fail(t, "tag key not found")
+	})
+	// high key not found
+	scanner.EXPECT().GetSeriesAndTagValue(uint16(1)).Return(nil, nil)
+	ctx.ScanSeriesTagValueIDs(dataLoadCtx, 2, func(_ uint16, _ uint32) {
+		assert.Fail(t, "high key not found")
+	})
+}
+
 func TestGroupingContext_BuildMultiTag(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer func() {
@@ -128,6 +162,55 @@ func TestGroupingContext_BuildMultiTag(t *testing.T) {
 	assert.Equal(t, uint16(0), dataLoadCtx.GroupingSeriesAggRefs[10-dataLoadCtx.MinSeriesID])
 }
 
+func TestGroupingContext_BuildRawSeries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	scanner1 := NewMockGroupingScanner(ctrl)
+	scanner2 := NewMockGroupingScanner(ctrl)
+	ctx := NewGroupContext([]tag.KeyID{1, 2}, map[tag.KeyID][]GroupingScanner{1: {scanner1}, 2: {scanner2}})
+	scanner1.EXPECT().GetSeriesAndTagValue(uint16(1)).
+		Return(roaring.BitmapOf(1, 2, 3, 10).GetContainerAtIndex(0), []uint32{10, 20, 30, 10})
+	scanner2.EXPECT().GetSeriesAndTagValue(uint16(1)).
+		Return(roaring.BitmapOf(2).GetContainerAtIndex(0), []uint32{5})
+	// series 6 hasn't any tag key, series 1/10 have same tag value of tag key 1
+	querySeriesIDs := roaring.BitmapOf(1, 2, 6, 10)
+	dataLoadCtx := &DataLoadContext{
+		SeriesIDHighKey:       1,
+		LowSeriesIDsContainer: querySeriesIDs.GetContainerAtIndex(0),
+		ShardExecuteCtx: &ShardExecuteContext{
+			StorageExecuteCtx: &StorageExecuteContext{
+				DownSamplingSpecs:   aggregation.AggregatorSpecs{aggregation.NewAggregatorSpec("f", field.SumField)},
+				GroupByTagKeyIDs:    []tag.KeyID{1, 2},
+				GroupingTagValueIDs: make([]*roaring.Bitmap, 2),
+				Query:               &stmt.Query{GroupBy: []string{"a", "b"}, RawSeries: true},
+			},
+		},
+		IsGrouping: true,
+	}
+	dataLoadCtx.Grouping()
+	ctx.BuildGroup(dataLoadCtx)
+	// each series has its own aggregator
+	assert.Len(t, dataLoadCtx.GroupingSeriesAgg, 4)
+	for idx, seriesID := range []uint16{1, 2, 6, 10} {
+		assert.Equal(t, uint16(idx), dataLoadCtx.GroupingSeriesAggRefs[seriesID-dataLoadCtx.MinSeriesID])
+	}
+	keyOf := func(tagValueIDs ...uint32) string {
+		key := make([]byte, 8)
+		binary.LittleEndian.PutUint32(key, tagValueIDs[0])
+		binary.LittleEndian.PutUint32(key[4:], tagValueIDs[1])
+		return string(key)
+	}
+	assert.Equal(t, keyOf(10, MissingTagValueID), dataLoadCtx.GroupingSeriesAgg[0].Key)
+	assert.Equal(t, keyOf(20, 5), dataLoadCtx.GroupingSeriesAgg[1].Key)
+	assert.Equal(t, keyOf(MissingTagValueID, MissingTagValueID), dataLoadCtx.GroupingSeriesAgg[2].Key)
+	assert.Equal(t, keyOf(10, MissingTagValueID), dataLoadCtx.GroupingSeriesAgg[3].Key)
+	// missing tag value isn't collected
+	storageExecuteCtx := dataLoadCtx.ShardExecuteCtx.StorageExecuteCtx
+	assert.Equal(t, []uint32{10, 20}, storageExecuteCtx.GroupingTagValueIDs[0].ToArray())
+	assert.Equal(t, []uint32{5}, storageExecuteCtx.GroupingTagValueIDs[1].ToArray())
+}
+
 func TestGroupingContext_ScanTagValueIDs(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer func() {
@@ -32,6 +32,13 @@ type NodeChoose interface {
 	Choose(database string, numOfNodes int) ([]*models.PhysicalPlan, error)
 }
 
+// ReplicaChoose represents replica choose for data modification(delete etc.).
+type ReplicaChoose interface {
+	// ChooseReplicas chooses all replica nodes of all shards then builds physical plan,
+	// returns error if any replica node is not alive.
+	ChooseReplicas(database string) (*models.PhysicalPlan, error)
+}
+
 // BuildPhysicalPlan returns physical plan based on live nodes and node number, need shuffle live node.
 func BuildPhysicalPlan(database string, liveNodes []models.StatelessNode, numOfNodes int) *models.PhysicalPlan {
 	physicalPlan := &models.PhysicalPlan{
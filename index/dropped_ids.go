@@ -0,0 +1,197 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package index
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/lindb/roaring"
+
+	"github.com/lindb/lindb/pkg/stream"
+	"github.com/lindb/lindb/series/metric"
+	"github.com/lindb/lindb/series/tag"
+)
+
+// for testing
+var (
+	writeFileFn = os.WriteFile
+	renameFn    = os.Rename
+	readFileFn  = os.ReadFile
+)
+
+const (
+	droppedIDsFileName = "DROPPED"
+	droppedIDsVersion  = uint8(1)
+)
+
+// errInvalidDroppedIDsFile represents dropped ids file is corrupted.
+var errInvalidDroppedIDsFile = errors.New("invalid dropped ids file")
+
+// DroppedIDs represents the ids of dropped namespaces/metrics, including tag keys/values of dropped metrics.
+// Because all ids are generated by sequence, dropped ids never be reused.
+type DroppedIDs interface {
+	// IsNamespaceDropped returns if the namespace is dropped.
+	IsNamespaceDropped(nsID uint32) bool
+	// IsMetricDropped returns if the metric is dropped.
+	IsMetricDropped(metricID metric.ID) bool
+	// IsTagKeyDropped returns if the tag key is dropped.
+	IsTagKeyDropped(tagKeyID tag.KeyID) bool
+	// IsTagValueDropped returns if the tag value is dropped.
+	IsTagValueDropped(tagValueID uint32) bool
+}
+
+// droppedIDs implements DroppedIDs interface, persists the dropped ids into file under dir.
+type droppedIDs struct {
+	fileName   string
+	namespaces *roaring.Bitmap
+	metrics    *roaring.Bitmap
+	tagKeys    *roaring.Bitmap
+	tagValues  *roaring.Bitmap
+
+	lock sync.RWMutex
+}
+
+// newDroppedIDsStore creates the dropped ids, loads the dropped ids from file if exist.
+func newDroppedIDsStore(dir string) (*droppedIDs, error) {
+	d := &droppedIDs{
+		fileName:   filepath.Join(dir, droppedIDsFileName),
+		namespaces: roaring.New(),
+		metrics:    roaring.New(),
+		tagKeys:    roaring.New(),
+		tagValues:  roaring.New(),
+	}
+	if err := d.load(); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// IsNamespaceDropped returns if the namespace is dropped.
+func (d *droppedIDs) IsNamespaceDropped(nsID uint32) bool {
+	return d.contains(d.namespaces, nsID)
+}
+
+// IsMetricDropped returns if the metric is dropped.
+func (d *droppedIDs) IsMetricDropped(metricID metric.ID) bool {
+	return d.contains(d.metrics, uint32(metricID))
+}
+
+// IsTagKeyDropped returns if the tag key is dropped.
+func (d *droppedIDs) IsTagKeyDropped(tagKeyID tag.KeyID) bool {
+	return d.contains(d.tagKeys, uint32(tagKeyID))
+}
+
+// IsTagValueDropped returns if the tag value is dropped.
+func (d *droppedIDs) IsTagValueDropped(tagValueID uint32) bool {
+	return d.contains(d.tagValues, tagValueID)
+}
+
+// add adds the dropped ids, then persists all dropped ids into file.
+func (d *droppedIDs) add(namespaces, metrics, tagKeys, tagValues []uint32) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.namespaces.AddMany(namespaces)
+	d.metrics.AddMany(metrics)
+	d.tagKeys.AddMany(tagKeys)
+	d.tagValues.AddMany(tagValues)
+	return d.persist()
+}
+
+// contains returns if the id is in the dropped ids with read lock.
+func (d *droppedIDs) contains(ids *roaring.Bitmap, id uint32) bool {
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+
+	return ids.Contains(id)
+}
+
+// persist writes all dropped ids into temp file, then renames it to target file.
+func (d *droppedIDs) persist() error {
+	writer := stream.NewBufferWriter(nil)
+	writer.PutByte(droppedIDsVersion)
+	for _, ids := range []*roaring.Bitmap{d.namespaces, d.metrics, d.tagKeys, d.tagValues} {
+		data, err := ids.MarshalBinary()
+		if err != nil {
+			return err
+		}
+		writer.PutUvarint32(uint32(len(data)))
+		writer.PutBytes(data)
+	}
+	data, err := writer.Bytes()
+	if err != nil {
+		return err
+	}
+	tmp := d.fileName + ".tmp"
+	if err := writeFileFn(tmp, data, 0o644); err != nil {
+		return err
+	}
+	if err := renameFn(tmp, d.fileName); err != nil {
+		return fmt.Errorf("rename tmp file[%s] name error:%s", tmp, err)
+	}
+	return nil
+}
+
+// load reads the dropped ids from file if exist.
+func (d *droppedIDs) load() error {
+	data, err := readFileFn(d.fileName)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	if len(data) == 0 {
+		return nil
+	}
+	reader := stream.NewReader(data)
+	if reader.ReadByte() != droppedIDsVersion {
+		return errInvalidDroppedIDsFile
+	}
+	for _, ids := range []*roaring.Bitmap{d.namespaces, d.metrics, d.tagKeys, d.tagValues} {
+		length := reader.ReadUvarint32()
+		bitmapData := reader.ReadSlice(int(length))
+		if reader.Error() != nil {
+			return errInvalidDroppedIDsFile
+		}
+		if err := ids.UnmarshalBinary(bitmapData); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// kvTombstone implements v1.Tombstone interface, purges the dropped keys/values when doing compaction.
+type kvTombstone struct {
+	isKeyDropped   func(key uint32) bool
+	isValueDropped func(value uint32) bool
+}
+
+// IsKeyDropped returns if the key is dropped.
+func (t *kvTombstone) IsKeyDropped(key uint32) bool {
+	return t.isKeyDropped != nil && t.isKeyDropped(key)
+}
+
+// IsValueDropped returns if the value is dropped.
+func (t *kvTombstone) IsValueDropped(value uint32) bool {
+	return t.isValueDropped != nil && t.isValueDropped(value)
+}
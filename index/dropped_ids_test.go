@@ -0,0 +1,115 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package index
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDroppedIDs(t *testing.T) {
+	dir := t.TempDir()
+	d, err := newDroppedIDsStore(dir)
+	assert.NoError(t, err)
+	assert.False(t, d.IsNamespaceDropped(1))
+	assert.NoError(t, d.add([]uint32{1}, []uint32{2}, []uint32{3}, []uint32{4, 5}))
+	check := func(d *droppedIDs) {
+		assert.True(t, d.IsNamespaceDropped(1))
+		assert.False(t, d.IsNamespaceDropped(2))
+		assert.True(t, d.IsMetricDropped(2))
+		assert.False(t, d.IsMetricDropped(1))
+		assert.True(t, d.IsTagKeyDropped(3))
+		assert.False(t, d.IsTagKeyDropped(4))
+		assert.True(t, d.IsTagValueDropped(4))
+		assert.True(t, d.IsTagValueDropped(5))
+		assert.False(t, d.IsTagValueDropped(3))
+	}
+	check(d)
+	// reload from file
+	d, err = newDroppedIDsStore(dir)
+	assert.NoError(t, err)
+	check(d)
+}
+
+func TestDroppedIDs_Error(t *testing.T) {
+	defer func() {
+		writeFileFn = os.WriteFile
+		renameFn = os.Rename
+		readFileFn = os.ReadFile
+	}()
+	dir := t.TempDir()
+	d, err := newDroppedIDsStore(dir)
+	assert.NoError(t, err)
+	// write file error
+	writeFileFn = func(_ string, _ []byte, _ os.FileMode) error {
+		return fmt.Errorf("err")
+	}
+	assert.Error(t, d.add([]uint32{1}, nil, nil, nil))
+	writeFileFn = os.WriteFile
+	// rename error
+	renameFn = func(_, _ string) error {
+		return fmt.Errorf("err")
+	}
+	assert.Error(t, d.add([]uint32{1}, nil, nil, nil))
+	renameFn = os.Rename
+	// read file error
+	readFileFn = func(_ string) ([]byte, error) {
+		return nil, fmt.Errorf("err")
+	}
+	_, err = newDroppedIDsStore(dir)
+	assert.Error(t, err)
+	// empty file
+	readFileFn = func(_ string) ([]byte, error) {
+		return nil, nil
+	}
+	_, err = newDroppedIDsStore(dir)
+	assert.NoError(t, err)
+	readFileFn = os.ReadFile
+
+	fileName := filepath.Join(dir, droppedIDsFileName)
+	// invalid version
+	assert.NoError(t, os.WriteFile(fileName, []byte{99}, 0o644))
+	_, err = newDroppedIDsStore(dir)
+	assert.Equal(t, errInvalidDroppedIDsFile, err)
+	// corrupted length
+	assert.NoError(t, os.WriteFile(fileName, []byte{droppedIDsVersion, 10, 1}, 0o644))
+	_, err = newDroppedIDsStore(dir)
+	assert.Equal(t, errInvalidDroppedIDsFile, err)
+	// corrupted bitmap
+	assert.NoError(t, os.WriteFile(fileName, []byte{droppedIDsVersion, 2, 1, 2}, 0o644))
+	_, err = newDroppedIDsStore(dir)
+	assert.Error(t, err)
+}
+
+func TestKVTombstone(t *testing.T) {
+	tombstone := &kvTombstone{}
+	assert.False(t, tombstone.IsKeyDropped(1))
+	assert.False(t, tombstone.IsValueDropped(1))
+	tombstone = &kvTombstone{
+		isKeyDropped:   func(key uint32) bool { return key == 1 },
+		isValueDropped: func(value uint32) bool { return value == 2 },
+	}
+	assert.True(t, tombstone.IsKeyDropped(1))
+	assert.False(t, tombstone.IsKeyDropped(2))
+	assert.True(t, tombstone.IsValueDropped(2))
+	assert.False(t, tombstone.IsValueDropped(1))
+}
@@ -23,7 +23,9 @@ import (
 	"github.com/lindb/roaring"
 
 	"github.com/lindb/lindb/flow"
+	v1 "github.com/lindb/lindb/index/v1"
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/series"
 	"github.com/lindb/lindb/series/field"
 	"github.com/lindb/lindb/series/metric"
@@ -57,6 +59,8 @@ type IndexKVStore interface {
 	CollectKVs(bucketID uint32, values *roaring.Bitmap, result map[uint32]string) error
 	// Suggest suggests the kv pairs by prefix.
 	Suggest(bucketID uint32, prefix string, limit int) ([]string, error)
+	// setTombstone sets the tombstone of dropped buckets/values.
+	setTombstone(tombstone v1.Tombstone)
 }
 
 // MetricSchemaStore represents metric schema(tags/fields etc.) store.
@@ -69,6 +73,8 @@ type MetricSchemaStore interface {
 	genFieldID(id metric.ID, fm field.Meta, limits *models.Limits) (field.ID, error)
 	// genTagKeyID generates tag key id if tag key not exist.
 	genTagKeyID(id metric.ID, tagKey []byte, limits *models.Limits, createFn func() uint32) (tag.KeyID, error)
+	// setTombstone sets the tombstone of dropped metrics.
+	setTombstone(tombstone v1.Tombstone)
 }
 
 // MetricMetaDatabase represents metric metadata store.
@@ -93,6 +99,16 @@ type MetricMetaDatabase interface {
 	GetSchema(metricID metric.ID) (*metric.Schema, error)
 	// GetMetricID returns metric id by namespace and metric name.
 	GetMetricID(namespace, metricName string) (metric.ID, error)
+	// GetMetricIDs returns all metric ids under namespace.
+	GetMetricIDs(namespace string) ([]metric.ID, error)
+	// DropMetric drops the metric's name/schema/tag values, and frees the metric from limit accounting,
+	// returns the dropped metric id.
+	DropMetric(namespace, metricName string) (metric.ID, error)
+	// DropNamespace drops the namespace and all metrics under it, and frees them from limit accounting,
+	// returns the dropped metric ids.
+	DropNamespace(namespace string) ([]metric.ID, error)
+	// DroppedIDs returns the ids of dropped namespaces/metrics.
+	DroppedIDs() DroppedIDs
 	// FindTagValueDsByExpr finds tag value ids by tag filter expr for spec tag key,
 	// if not exist, return nil, constants.ErrNotFound, else returns tag value ids
 	FindTagValueDsByExpr(tagKeyID tag.KeyID, expr stmt.TagFilter) (*roaring.Bitmap, error)
@@ -116,4 +132,12 @@ type MetricIndexDatabase interface {
 
 	// GenSeriesID generates time series id based on tags hash.
 	GenSeriesID(metricID metric.ID, row *metric.StorageRow) (seriesID uint32, err error)
+	// DeleteSeries records the tombstone of series under metric in time range.
+	DeleteSeries(metricID metric.ID, seriesIDs *roaring.Bitmap, timeRange timeutil.TimeRange) error
+	// GetDeletedSeriesIDs returns the series ids of metric which are deleted in the whole time range,
+	// returns nil if not found.
+	GetDeletedSeriesIDs(metricID metric.ID, timeRange timeutil.TimeRange) *roaring.Bitmap
+	// ExpireDeletedSeries removes the tombstones of series whose time range ends before timestamp,
+	// because the deleted data in the time range has been expired by retention.
+	ExpireDeletedSeries(timestamp int64) error
 }
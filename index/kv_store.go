@@ -54,6 +54,8 @@ type indexKVStore struct {
 	immutable *imap.IntMap[map[string]uint32]
 	// cache
 	bucketCache *expirable.LRU[uint32, *model.TrieBucket]
+	// tombstone of dropped buckets/values
+	tombstone v1.Tombstone
 
 	lock sync.RWMutex
 }
@@ -87,10 +89,7 @@ func (s *indexKVStore) GetValue(bucketID uint32, key []byte) (id uint32, ok bool
 
 // GetValues returns all values for bucket.
 func (s *indexKVStore) GetValues(bucketID uint32) (ids []uint32, err error) {
-	snapshot := s.getSnapshot()
-
-	reader := v1.NewIndexKVReader(snapshot)
-	bucket, err := reader.GetBucket(bucketID)
+	bucket, err := s.getBucket(s.getSnapshot(), bucketID)
 	if err != nil {
 		return nil, err
 	}
@@ -152,17 +151,14 @@ func (s *indexKVStore) CollectKVs(bucketID uint32, values *roaring.Bitmap, resul
 			if values.IsEmpty() {
 				return
 			}
-			if values.Contains(v) {
+			if values.Contains(v) && !s.isDropped(bucketID, v) {
 				result[v] = k
 				values.Remove(v)
 			}
 		}
 	}
 
-	snapshot := s.getSnapshot()
-
-	reader := v1.NewIndexKVReader(snapshot)
-	bucket, err := reader.GetBucket(bucketID)
+	bucket, err := s.getBucket(s.getSnapshot(), bucketID)
 	if err != nil {
 		return err
 	}
@@ -201,18 +197,15 @@ func (s *indexKVStore) Suggest(bucketID uint32, prefix string, limit int) ([]str
 			return nil
 		}
 		var result []string
-		for k := range kvs {
-			if strings.HasPrefix(k, prefix) {
+		for k, v := range kvs {
+			if strings.HasPrefix(k, prefix) && !s.isDropped(bucketID, v) {
 				result = append(result, k)
 			}
 		}
 		return sortResult(result)
 	}
 
-	snapshot := s.getSnapshot()
-
-	reader := v1.NewIndexKVReader(snapshot)
-	bucket, err := reader.GetBucket(bucketID)
+	bucket, err := s.getBucket(s.getSnapshot(), bucketID)
 	if err != nil {
 		return nil, err
 	}
@@ -269,9 +262,16 @@ func (s *indexKVStore) Flush() (err error) {
 		var keys [][]byte
 		var ids []uint32
 		for k, v := range kvs {
+			if s.isDropped(bucket, v) {
+				// skip dropped value
+				continue
+			}
 			keys = append(keys, strutil.String2ByteSlice(k))
 			ids = append(ids, v)
 		}
+		if len(keys) == 0 {
+			return nil
+		}
 		if err0 := flusher.WriteKVs(keys, ids); err0 != nil {
 			return err0
 		}
@@ -319,9 +319,7 @@ func (s *indexKVStore) getOrCreateValue(bucketID uint32, key []byte,
 	bucket, ok := s.bucketCache.Get(bucketID)
 	if !ok {
 		// get from kv store(persist)
-		snapshot := s.getSnapshot()
-		reader := v1.NewIndexKVReader(snapshot)
-		bucket, err = reader.GetBucket(bucketID)
+		bucket, err = s.getBucket(s.getSnapshot(), bucketID)
 		if err != nil {
 			return 0, false, false, err
 		}
@@ -381,10 +379,7 @@ func (s *indexKVStore) GetValueFromMem(bucketID uint32, key []byte) (uint32, boo
 
 // FindValuesByRegexp returns values by regexp expr.
 func (s *indexKVStore) FindValuesByRegexp(bucketID uint32, rp *regexp.Regexp, ids []uint32) ([]uint32, error) {
-	snapshot := s.getSnapshot()
-
-	reader := v1.NewIndexKVReader(snapshot)
-	bucket, err := reader.GetBucket(bucketID)
+	bucket, err := s.getBucket(s.getSnapshot(), bucketID)
 	if err != nil {
 		return nil, err
 	}
@@ -436,7 +431,7 @@ func (s *indexKVStore) findValuesByRegexp(mem *imap.IntMap[map[string]uint32], b
 		return ids
 	}
 	for k, v := range kvs {
-		if rp.Match(strutil.String2ByteSlice(k)) {
+		if rp.Match(strutil.String2ByteSlice(k)) && !s.isDropped(bucketID, v) {
 			ids = append(ids, v)
 		}
 	}
@@ -447,9 +442,7 @@ func (s *indexKVStore) findValuesByLike(bucketID uint32,
 	prefix, subKey []byte,
 	check func(a, b []byte) bool, ids []uint32,
 ) ([]uint32, error) {
-	snapshot := s.getSnapshot()
-	reader := v1.NewIndexKVReader(snapshot)
-	bucket, err := reader.GetBucket(bucketID)
+	bucket, err := s.getBucket(s.getSnapshot(), bucketID)
 	if err != nil {
 		return nil, err
 	}
@@ -479,7 +472,7 @@ func (s *indexKVStore) findValuesByLikeFormMem(
 		return ids
 	}
 	for k, v := range kvs {
-		if check(strutil.String2ByteSlice(k), key) {
+		if check(strutil.String2ByteSlice(k), key) && !s.isDropped(bucketID, v) {
 			ids = append(ids, v)
 		}
 	}
@@ -506,6 +499,9 @@ func (s *indexKVStore) getValueFromMem(mem *imap.IntMap[map[string]uint32], buck
 		return 0, false
 	}
 	id, ok := kvs[strutil.ByteSlice2String(key)]
+	if ok && s.isDropped(bucketID, id) {
+		return 0, false
+	}
 	return id, ok
 }
 
@@ -518,7 +514,45 @@ func (s *indexKVStore) getValuesFromMem(mem *imap.IntMap[map[string]uint32], buc
 		return result
 	}
 	for _, v := range kvs {
-		result = append(result, v)
+		if !s.isDropped(bucketID, v) {
+			result = append(result, v)
+		}
 	}
 	return result
 }
+
+// setTombstone sets the tombstone of dropped buckets/values, dropped buckets/values are skipped
+// when reading/flushing, and purged when doing compaction.
+func (s *indexKVStore) setTombstone(tombstone v1.Tombstone) {
+	s.lock.Lock()
+	s.tombstone = tombstone
+	s.lock.Unlock()
+
+	s.bucketCache.Purge()
+	s.family.SetMergeContext(kv.TombstoneContext, tombstone)
+}
+
+// isDropped returns if the bucket/value is dropped.
+func (s *indexKVStore) isDropped(bucketID, value uint32) bool {
+	return s.tombstone != nil && (s.tombstone.IsKeyDropped(bucketID) || s.tombstone.IsValueDropped(value))
+}
+
+// getBucket returns the trie bucket from kv store, dropped values are skipped, returns nil if not exist.
+func (s *indexKVStore) getBucket(snapshot version.Snapshot, bucketID uint32) (*model.TrieBucket, error) {
+	s.lock.RLock()
+	tombstone := s.tombstone
+	s.lock.RUnlock()
+
+	if tombstone != nil && tombstone.IsKeyDropped(bucketID) {
+		return nil, nil
+	}
+	reader := v1.NewIndexKVReader(snapshot)
+	bucket, err := reader.GetBucket(bucketID)
+	if err != nil || bucket == nil {
+		return nil, err
+	}
+	if tombstone != nil {
+		bucket.SetDropFilter(tombstone.IsValueDropped)
+	}
+	return bucket, nil
+}
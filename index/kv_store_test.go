@@ -148,6 +148,66 @@ func TestIndexKVStore_Compact(t *testing.T) {
 	assert.NoError(t, kv.GetStoreManager().CloseStore(name))
 }
 
+func TestIndexKVStore_Tombstone(t *testing.T) {
+	name := "./index_tombstone"
+	defer func() {
+		_ = os.RemoveAll(name)
+	}()
+	store, err := kv.GetStoreManager().CreateStore(name, kv.StoreOption{Levels: 2})
+	assert.NoError(t, err)
+	family, err := store.CreateFamily("index", familyOption)
+	assert.NoError(t, err)
+	indexStore := NewIndexKVStore(family, 10, time.Minute)
+	for bucketID := uint32(1); bucketID <= 2; bucketID++ {
+		for i := 0; i < 3; i++ {
+			id := bucketID*10 + uint32(i)
+			_, _, err = indexStore.GetOrCreateValue(bucketID, []byte(fmt.Sprintf("key-%d", i)), func() (uint32, error) {
+				return id, nil
+			})
+			assert.NoError(t, err)
+		}
+	}
+	indexStore.setTombstone(&kvTombstone{
+		isKeyDropped:   func(key uint32) bool { return key == 2 },
+		isValueDropped: func(value uint32) bool { return value == 11 },
+	})
+	check := func() {
+		_, ok, err0 := indexStore.GetValue(1, []byte("key-1"))
+		assert.NoError(t, err0)
+		assert.False(t, ok)
+		id, ok, err0 := indexStore.GetValue(1, []byte("key-2"))
+		assert.NoError(t, err0)
+		assert.True(t, ok)
+		assert.Equal(t, uint32(12), id)
+		values, err0 := indexStore.GetValues(1)
+		assert.NoError(t, err0)
+		assert.ElementsMatch(t, []uint32{10, 12}, values)
+		values, err0 = indexStore.GetValues(2)
+		assert.NoError(t, err0)
+		assert.Empty(t, values)
+		result, err0 := indexStore.Suggest(1, "key", 10)
+		assert.NoError(t, err0)
+		assert.Equal(t, []string{"key-0", "key-2"}, result)
+		kvs := make(map[uint32]string)
+		assert.NoError(t, indexStore.CollectKVs(1, roaring.BitmapOf(10, 11, 12), kvs))
+		assert.Equal(t, map[uint32]string{10: "key-0", 12: "key-2"}, kvs)
+		values, err0 = indexStore.FindValuesByExpr(1, &stmt.RegexExpr{Key: "key", Regexp: "key-.*"})
+		assert.NoError(t, err0)
+		assert.ElementsMatch(t, []uint32{10, 12}, values)
+		values, err0 = indexStore.FindValuesByExpr(1, &stmt.LikeExpr{Key: "key", Value: "key-*"})
+		assert.NoError(t, err0)
+		assert.ElementsMatch(t, []uint32{10, 12}, values)
+	}
+	// read from memory
+	check()
+	// read from kv
+	indexStore.PrepareFlush()
+	assert.NoError(t, indexStore.Flush())
+	check()
+
+	assert.NoError(t, kv.GetStoreManager().CloseStore(name))
+}
+
 func TestIndexKVStore_Find(t *testing.T) {
 	name := "./index_find"
 	defer func() {
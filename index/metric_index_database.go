@@ -38,8 +38,10 @@ import (
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/imap"
 	"github.com/lindb/lindb/pkg/strutil"
+	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/series/metric"
 	"github.com/lindb/lindb/series/tag"
+	"github.com/lindb/lindb/tsdb/tombstone"
 )
 
 // for testing
@@ -48,6 +50,7 @@ var (
 	newForwardIndexFlusher  = v1.NewForwardIndexFlusher
 	bitmapUnmarshal         = encoding.BitmapUnmarshal
 	newForwardReader        = v1.NewForwardReader
+	newTombstoneStore       = tombstone.NewStore
 )
 
 const (
@@ -68,6 +71,7 @@ type metricIndexDatabase struct {
 	metricInverted *invertedIndex // metric id => time series ids
 	inverted       *invertedIndex // tag value id => time series ids
 	forward        *forwardIndex  // tag key id => [time seried ids, tag value ids]
+	tombstones     tombstone.Store
 
 	sequenceCache *expirable.LRU[metric.ID, uint32]
 	statistics    *metrics.IndexDBStatistics
@@ -107,6 +111,20 @@ func NewMetricIndexDatabase(dir string, metaDB MetricMetaDatabase) (MetricIndexD
 	if err != nil {
 		return nil, err
 	}
+	tombstones, err := newTombstoneStore(dir)
+	if err != nil {
+		return nil, err
+	}
+	// purge the index of dropped metrics when doing compaction
+	dropped := metaDB.DroppedIDs()
+	isMetricDropped := func(key uint32) bool {
+		return dropped.IsMetricDropped(metric.ID(key))
+	}
+	metricFamily.SetMergeContext(kv.TombstoneContext, &kvTombstone{isKeyDropped: isMetricDropped})
+	invertedFamily.SetMergeContext(kv.TombstoneContext, &kvTombstone{isKeyDropped: dropped.IsTagValueDropped})
+	forwadFamily.SetMergeContext(kv.TombstoneContext, &kvTombstone{isKeyDropped: func(key uint32) bool {
+		return dropped.IsTagKeyDropped(tag.KeyID(key))
+	}})
 	ctx, cancel := context.WithCancel(context.Background())
 	index := &metricIndexDatabase{
 		ctx:            ctx,
@@ -117,10 +135,12 @@ func NewMetricIndexDatabase(dir string, metaDB MetricMetaDatabase) (MetricIndexD
 		metricInverted: newInvertedIndex(metricFamily),
 		inverted:       newInvertedIndex(invertedFamily),
 		forward:        newForwardIndex(forwadFamily),
+		tombstones:     tombstones,
 		statistics:     metrics.NewIndexDBStatistics(metaDB.Name()),
 		sequenceCache:  expirable.NewLRU[metric.ID, uint32](100000, nil, time.Hour),
 		logger:         logger.GetLogger("Index", "IndexDB"),
 	}
+	index.series.setTombstone(&kvTombstone{isKeyDropped: isMetricDropped})
 	return index, nil
 }
 
@@ -184,6 +204,27 @@ func (index *metricIndexDatabase) GetSeriesIDsByTagValueIDs(tagKeyID tag.KeyID,
 	return index.inverted.findSeriesIDsByKeys(tagValueIDs)
 }
 
+// DeleteSeries records the tombstone of series under metric in time range.
+func (index *metricIndexDatabase) DeleteSeries(metricID metric.ID, seriesIDs *roaring.Bitmap, timeRange timeutil.TimeRange) error {
+	return index.tombstones.Add(&tombstone.Tombstone{
+		MetricID:  metricID,
+		SeriesIDs: seriesIDs,
+		TimeRange: timeRange,
+	})
+}
+
+// GetDeletedSeriesIDs returns the series ids of metric which are deleted in the whole time range,
+// returns nil if not found.
+func (index *metricIndexDatabase) GetDeletedSeriesIDs(metricID metric.ID, timeRange timeutil.TimeRange) *roaring.Bitmap {
+	return index.tombstones.GetDeletedSeriesIDs(metricID, timeRange)
+}
+
+// ExpireDeletedSeries removes the tombstones of series whose time range ends before timestamp,
+// because the deleted data in the time range has been expired by retention.
+func (index *metricIndexDatabase) ExpireDeletedSeries(timestamp int64) error {
+	return index.tombstones.Expire(timestamp)
+}
+
 // GetGroupingContext returns the context of group by
 func (index *metricIndexDatabase) GetGroupingContext(ctx *flow.ShardExecuteContext) error {
 	return index.forward.GetGroupingContext(ctx)
@@ -459,6 +500,8 @@ func (fi *forwardIndex) GetGroupingContext(ctx *flow.ShardExecuteContext) error
 
 	scannerMap := make(map[tag.KeyID][]flow.GroupingScanner)
 	tagKeyIDs := ctx.StorageExecuteCtx.GroupByTagKeyIDs
+	// raw series query keeps the series which hasn't some of group by tag keys
+	rawSeries := ctx.StorageExecuteCtx.Query != nil && ctx.StorageExecuteCtx.Query.RawSeries
 	seriesIDs := ctx.SeriesIDsAfterFiltering
 	finalSeriesIDs := seriesIDs.Clone()
 	defer func() {
@@ -476,11 +519,25 @@ func (fi *forwardIndex) GetGroupingContext(ctx *flow.ShardExecuteContext) error
 		for idx := range scanners {
 			seriesIDsForCurrentTagKey.Or(scanners[idx].GetSeriesIDs())
 		}
+		scannerMap[tagKeyID] = scanners
+		if rawSeries {
+			continue
+		}
 		finalSeriesIDs.And(seriesIDsForCurrentTagKey)
 		if finalSeriesIDs.IsEmpty() {
 			return constants.ErrNotFound
 		}
-		scannerMap[tagKeyID] = scanners
+	}
+	for _, distinctTag := range ctx.StorageExecuteCtx.DistinctTags {
+		if _, ok := scannerMap[distinctTag.ID]; ok {
+			continue
+		}
+		// series which hasn't distinct tag still need to load data, so no filtering series ids
+		scanners, err := fi.getGroupingScanners(distinctTag.ID, seriesIDs, snapshot)
+		if err != nil {
+			return err
+		}
+		scannerMap[distinctTag.ID] = scanners
 	}
 
 	// set context for next execution stage of query
@@ -42,8 +42,11 @@ import (
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/imap"
+	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/series/metric"
 	"github.com/lindb/lindb/series/tag"
+	"github.com/lindb/lindb/sql/stmt"
+	"github.com/lindb/lindb/tsdb/tombstone"
 )
 
 func TestMetricIndexDatabase(t *testing.T) {
@@ -123,6 +126,27 @@ func TestMetricIndexDatabase(t *testing.T) {
 			},
 			SeriesIDsAfterFiltering: roaring.BitmapOf(100, 200), // series ids not found
 		}))
+		// raw series query keeps series which hasn't group by tag key
+		shardCtx := &flow.ShardExecuteContext{
+			StorageExecuteCtx: &flow.StorageExecuteContext{
+				Query:            &stmt.Query{RawSeries: true},
+				GroupByTagKeyIDs: []tag.KeyID{0},
+			},
+			SeriesIDsAfterFiltering: roaring.BitmapOf(0, 100, 200),
+		}
+		assert.NoError(t, db.GetGroupingContext(shardCtx))
+		assert.NotNil(t, shardCtx.GroupingContext)
+		assert.Equal(t, []uint32{0, 100, 200}, shardCtx.SeriesIDsAfterFiltering.ToArray())
+		// distinct tag doesn't filter series ids
+		shardCtx = &flow.ShardExecuteContext{
+			StorageExecuteCtx: &flow.StorageExecuteContext{
+				DistinctTags: tag.Metas{{Key: "key1", ID: 0}},
+			},
+			SeriesIDsAfterFiltering: roaring.BitmapOf(0, 1),
+		}
+		assert.NoError(t, db.GetGroupingContext(shardCtx))
+		assert.NotNil(t, shardCtx.GroupingContext)
+		assert.Equal(t, []uint32{0, 1}, shardCtx.SeriesIDsAfterFiltering.ToArray())
 	}
 	// wait tag index build completed
 	time.Sleep(100 * time.Millisecond)
@@ -142,6 +166,17 @@ func TestMetricIndexDatabase(t *testing.T) {
 	seriesIDs, err := db.GetSeriesIDsForMetric(0)
 	assert.NoError(t, err)
 	assert.Equal(t, []uint32{0, 1, 2}, seriesIDs.ToArray())
+	// delete series
+	assert.Nil(t, db.GetDeletedSeriesIDs(0, timeutil.TimeRange{Start: 10, End: 20}))
+	assert.NoError(t, db.DeleteSeries(0, roaring.BitmapOf(1), timeutil.TimeRange{Start: 0, End: 100}))
+	assert.Equal(t, roaring.BitmapOf(1), db.GetDeletedSeriesIDs(0, timeutil.TimeRange{Start: 10, End: 20}))
+	assert.Nil(t, db.GetDeletedSeriesIDs(0, timeutil.TimeRange{Start: 10, End: 200}))
+	// deleted data not expired
+	assert.NoError(t, db.ExpireDeletedSeries(100))
+	assert.Equal(t, roaring.BitmapOf(1), db.GetDeletedSeriesIDs(0, timeutil.TimeRange{Start: 10, End: 20}))
+	// deleted data expired by retention
+	assert.NoError(t, db.ExpireDeletedSeries(101))
+	assert.Nil(t, db.GetDeletedSeriesIDs(0, timeutil.TimeRange{Start: 10, End: 20}))
 	// flushing
 	db1.flushing.Store(true)
 	assert.NoError(t, db.Close())
@@ -153,6 +188,7 @@ func TestMetricIndexDatabase_New_Error(t *testing.T) {
 	defer func() {
 		ctrl.Finish()
 		newSequence = NewSequence
+		newTombstoneStore = tombstone.NewStore
 		kv.InitStoreManager(oldMgr)
 	}()
 	kvStore := kv.NewMockStore(ctrl)
@@ -200,6 +236,16 @@ func TestMetricIndexDatabase_New_Error(t *testing.T) {
 				kvStore.EXPECT().CreateFamily(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
 			},
 		},
+		{
+			name: "create tombstone store error",
+			prepare: func() {
+				storeMgr.EXPECT().CreateStore(gomock.Any(), gomock.Any()).Return(kvStore, nil)
+				kvStore.EXPECT().CreateFamily(gomock.Any(), gomock.Any()).Return(nil, nil).MaxTimes(4)
+				newTombstoneStore = func(_ string) (tombstone.Store, error) {
+					return nil, fmt.Errorf("err")
+				}
+			},
+		},
 	}
 
 	for i := range cases {
@@ -513,6 +559,15 @@ func TestMetricIndexDatabase_ForwardIndex_Read_Error(t *testing.T) {
 			SeriesIDsAfterFiltering: roaring.BitmapOf(0, 1, 2),
 		}))
 	})
+	t.Run("find kv reader error when scanning distinct tag", func(t *testing.T) {
+		snapshot.EXPECT().FindReaders(gomock.Any()).Return(nil, fmt.Errorf("err"))
+		assert.Error(t, index.GetGroupingContext(&flow.ShardExecuteContext{
+			StorageExecuteCtx: &flow.StorageExecuteContext{
+				DistinctTags: tag.Metas{{Key: "key1", ID: 0}},
+			},
+			SeriesIDsAfterFiltering: roaring.BitmapOf(0, 1, 2),
+		}))
+	})
 	t.Run("read group scaner error when grouping", func(t *testing.T) {
 		snapshot.EXPECT().FindReaders(gomock.Any()).Return([]table.Reader{nil}, nil)
 		newForwardReader = func(readers []table.Reader) v1.ForwardReader {
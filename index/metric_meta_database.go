@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"math"
 	"path"
+	"sync"
 	"time"
 
 	commonfileutil "github.com/lindb/common/pkg/fileutil"
@@ -43,8 +44,9 @@ import (
 
 // for testing
 var (
-	mkdir       = commonfileutil.MkDirIfNotExist
-	newSequence = NewSequence
+	mkdir         = commonfileutil.MkDirIfNotExist
+	newSequence   = NewSequence
+	newDroppedIDs = newDroppedIDsStore
 )
 
 const (
@@ -67,9 +69,11 @@ type metricMetaDatabase struct {
 	schemaStore  MetricSchemaStore
 	statistics   *metrics.MetaDBStatistics
 	sequence     *Sequence
+	dropped      *droppedIDs
 	logger       logger.Logger
 	databaseName string
 
+	dropLock sync.Mutex
 	flushing atomic.Bool
 }
 
@@ -82,6 +86,10 @@ func NewMetricMetaDatabase(databaseName, dir string) (MetricMetaDatabase, error)
 	if err != nil {
 		return nil, err
 	}
+	dropped, err := newDroppedIDs(dir)
+	if err != nil {
+		return nil, err
+	}
 	kvStore, err := kv.GetStoreManager().CreateStore(path.Join(dir, metaPath), kv.DefaultStoreOption())
 	if err != nil {
 		return nil, err
@@ -122,10 +130,29 @@ func NewMetricMetaDatabase(databaseName, dir string) (MetricMetaDatabase, error)
 		tagValue:     NewIndexKVStore(tagValueFamily, 10000, 10*time.Minute),
 		schemaStore:  NewMetricSchemaStore(schemaFamily),
 		sequence:     sequence,
+		dropped:      dropped,
 		statistics:   metrics.NewMetaDBStatistics(databaseName),
 		logger:       logger.GetLogger("Index", "MetricMetaDatabase"),
 	}
-
+	// skip dropped namespaces/metrics when reading, and purge them when doing compaction
+	mm.ns.setTombstone(&kvTombstone{isValueDropped: dropped.IsNamespaceDropped})
+	mm.metric.setTombstone(&kvTombstone{
+		isKeyDropped: dropped.IsNamespaceDropped,
+		isValueDropped: func(value uint32) bool {
+			return dropped.IsMetricDropped(metric.ID(value))
+		},
+	})
+	mm.tagValue.setTombstone(&kvTombstone{
+		isKeyDropped: func(key uint32) bool {
+			return dropped.IsTagKeyDropped(tag.KeyID(key))
+		},
+		isValueDropped: dropped.IsTagValueDropped,
+	})
+	mm.schemaStore.setTombstone(&kvTombstone{
+		isKeyDropped: func(key uint32) bool {
+			return dropped.IsMetricDropped(metric.ID(key))
+		},
+	})
 	return mm, nil
 }
 
@@ -136,7 +163,7 @@ func (mm *metricMetaDatabase) Name() string {
 
 func (mm *metricMetaDatabase) genNSID() (uint32, error) {
 	limits := models.GetDatabaseLimits(mm.databaseName)
-	if limits.EnableNamespacesCheck() && limits.MaxNamespaces < mm.sequence.GetNamespaceSeq() {
+	if limits.EnableNamespacesCheck() && limits.MaxNamespaces < mm.sequence.GetNumOfNamespaces() {
 		return 0, constants.ErrTooManyNamespace
 	}
 	return mm.sequence.GenNamespaceSeq(), nil
@@ -144,7 +171,7 @@ func (mm *metricMetaDatabase) genNSID() (uint32, error) {
 
 func (mm *metricMetaDatabase) genMetricID() (uint32, error) {
 	limits := models.GetDatabaseLimits(mm.databaseName)
-	if limits.EnableMetricsCheck() && limits.MaxMetrics < mm.sequence.GetMetricNameSeq() {
+	if limits.EnableMetricsCheck() && limits.MaxMetrics < mm.sequence.GetNumOfMetricNames() {
 		return 0, constants.ErrTooManyMetric
 	}
 	return mm.sequence.GenMetricNameSeq(), nil
@@ -261,6 +288,113 @@ func (mm *metricMetaDatabase) GetMetricID(namespace, metricName string) (metric.
 	return metric.ID(metricID), nil
 }
 
+// GetMetricIDs returns all metric ids under namespace.
+func (mm *metricMetaDatabase) GetMetricIDs(namespace string) ([]metric.ID, error) {
+	nsID, err := mm.getNamespaceID(namespace)
+	if err != nil {
+		return nil, err
+	}
+	ids, err := mm.metric.GetValues(nsID)
+	if err != nil {
+		return nil, err
+	}
+	metricIDs := make([]metric.ID, len(ids))
+	for idx, id := range ids {
+		metricIDs[idx] = metric.ID(id)
+	}
+	return metricIDs, nil
+}
+
+// DropMetric drops the metric's name/schema/tag values, and frees the metric from limit accounting,
+// returns the dropped metric id.
+func (mm *metricMetaDatabase) DropMetric(namespace, metricName string) (metric.ID, error) {
+	mm.dropLock.Lock()
+	defer mm.dropLock.Unlock()
+
+	metricID, err := mm.GetMetricID(namespace, metricName)
+	if err != nil {
+		return 0, err
+	}
+	if err := mm.drop(nil, []metric.ID{metricID}); err != nil {
+		mm.statistics.DropFailures.Incr()
+		return 0, err
+	}
+	mm.statistics.DropMetrics.Incr()
+	return metricID, nil
+}
+
+// DropNamespace drops the namespace and all metrics under it, and frees them from limit accounting,
+// returns the dropped metric ids.
+func (mm *metricMetaDatabase) DropNamespace(namespace string) ([]metric.ID, error) {
+	mm.dropLock.Lock()
+	defer mm.dropLock.Unlock()
+
+	nsID, err := mm.getNamespaceID(namespace)
+	if err != nil {
+		return nil, err
+	}
+	metricIDs, err := mm.GetMetricIDs(namespace)
+	if err != nil {
+		return nil, err
+	}
+	if err := mm.drop([]uint32{nsID}, metricIDs); err != nil {
+		mm.statistics.DropFailures.Incr()
+		return nil, err
+	}
+	mm.statistics.DropNamespaces.Incr()
+	mm.statistics.DropMetrics.Add(float64(len(metricIDs)))
+	return metricIDs, nil
+}
+
+// DroppedIDs returns the ids of dropped namespaces/metrics.
+func (mm *metricMetaDatabase) DroppedIDs() DroppedIDs {
+	return mm.dropped
+}
+
+// drop records the dropped namespaces/metrics(including tag keys/values of metrics), then frees them from limit accounting.
+func (mm *metricMetaDatabase) drop(namespaces []uint32, metricIDs []metric.ID) error {
+	var metrics, tagKeys, tagValues []uint32
+	for _, metricID := range metricIDs {
+		schema, err := mm.schemaStore.GetSchema(metricID)
+		if err != nil {
+			return err
+		}
+		if schema != nil {
+			for _, tagKey := range schema.TagKeys {
+				ids, err := mm.tagValue.GetValues(uint32(tagKey.ID))
+				if err != nil {
+					return err
+				}
+				tagKeys = append(tagKeys, uint32(tagKey.ID))
+				tagValues = append(tagValues, ids...)
+			}
+		}
+		metrics = append(metrics, uint32(metricID))
+	}
+	if err := mm.dropped.add(namespaces, metrics, tagKeys, tagValues); err != nil {
+		return err
+	}
+	mm.sequence.DropNamespaces(uint32(len(namespaces)))
+	mm.sequence.DropMetricNames(uint32(len(metrics)))
+	return mm.sequence.Sync()
+}
+
+// getNamespaceID returns namespace id by namespace, returns constants.ErrNamespaceNotFound if not exist.
+func (mm *metricMetaDatabase) getNamespaceID(namespace string) (uint32, error) {
+	if namespace == "" {
+		return 0, fmt.Errorf("%w, namespace: %s", constants.ErrNamespaceNotFound, namespace)
+	}
+	ns := strutil.String2ByteSlice(namespace)
+	nsID, ok, err := mm.ns.GetValue(uint32(ns[0]), ns)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return 0, fmt.Errorf("%w, namespace: %s", constants.ErrNamespaceNotFound, namespace)
+	}
+	return nsID, nil
+}
+
 // FindTagValueDsByExpr finds tag value ids by tag filter expr for spec tag key,
 // if not exist, return nil, constants.ErrNotFound, else returns tag value ids
 func (mm *metricMetaDatabase) FindTagValueDsByExpr(tagKeyID tag.KeyID, expr stmt.TagFilter) (*roaring.Bitmap, error) {
@@ -139,6 +139,155 @@ func TestMetricMetaDatabase(t *testing.T) {
 	assert.NoError(t, db.Close())
 }
 
+func TestMetricMetaDatabase_Drop(t *testing.T) {
+	name := "./metric_meta_database_drop"
+	defer func() {
+		_ = os.RemoveAll(name)
+	}()
+	limits := models.NewDefaultLimits()
+	limits.MaxMetrics = 1
+	limits.MaxNamespaces = 1
+	models.SetDatabaseLimits("drop", limits)
+
+	db, err := NewMetricMetaDatabase("drop", name)
+	assert.NoError(t, err)
+	cpuID, err := db.GenMetricID([]byte("system"), []byte("cpu"))
+	assert.NoError(t, err)
+	memID, err := db.GenMetricID([]byte("system"), []byte("mem"))
+	assert.NoError(t, err)
+	_, err = db.GenMetricID([]byte("system"), []byte("disk"))
+	assert.Equal(t, constants.ErrTooManyMetric, err)
+	keyID, err := db.GenTagKeyID(cpuID, []byte("host"))
+	assert.NoError(t, err)
+	_, err = db.GenTagValueID(keyID, []byte("host1"))
+	assert.NoError(t, err)
+	db.PrepareFlush()
+	assert.NoError(t, db.Flush())
+
+	// drop metric
+	_, err = db.DropMetric("system", "not-exist")
+	assert.ErrorIs(t, err, constants.ErrMetricIDNotFound)
+	_, err = db.DropMetric("not-exist", "cpu")
+	assert.ErrorIs(t, err, constants.ErrMetricIDNotFound)
+	metricID, err := db.DropMetric("system", "cpu")
+	assert.NoError(t, err)
+	assert.Equal(t, cpuID, metricID)
+
+	checkDropped := func() {
+		_, err = db.GetMetricID("system", "cpu")
+		assert.ErrorIs(t, err, constants.ErrMetricIDNotFound)
+		schema, err := db.GetSchema(cpuID)
+		assert.NoError(t, err)
+		assert.Nil(t, schema)
+		tagValueIDs, err := db.FindTagValueIDsForTag(keyID)
+		assert.NoError(t, err)
+		assert.True(t, tagValueIDs.IsEmpty())
+		result, err := db.SuggestMetrics("system", "", 10)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"mem"}, result)
+		assert.True(t, db.DroppedIDs().IsMetricDropped(cpuID))
+		assert.True(t, db.DroppedIDs().IsTagKeyDropped(keyID))
+		assert.True(t, db.DroppedIDs().IsTagValueDropped(0))
+	}
+	checkDropped()
+	// metric freed from limit accounting, re-create metric with new id
+	newCPUID, err := db.GenMetricID([]byte("system"), []byte("cpu"))
+	assert.NoError(t, err)
+	assert.NotEqual(t, cpuID, newCPUID)
+	db.PrepareFlush()
+	assert.NoError(t, db.Flush())
+	assert.NoError(t, db.Close())
+
+	// reopen, dropped ids/accounting persisted
+	db, err = NewMetricMetaDatabase("drop", name)
+	assert.NoError(t, err)
+	id, err := db.GetMetricID("system", "cpu")
+	assert.NoError(t, err)
+	assert.Equal(t, newCPUID, id)
+	_, err = db.GenMetricID([]byte("system"), []byte("disk"))
+	assert.Equal(t, constants.ErrTooManyMetric, err)
+	_, err = db.GenMetricID([]byte("app"), []byte("cpu"))
+	assert.Equal(t, constants.ErrTooManyMetric, err)
+	_, err = db.GenMetricID([]byte("other"), []byte("cpu"))
+	assert.Equal(t, constants.ErrTooManyNamespace, err)
+
+	// drop namespace
+	_, err = db.DropNamespace("not-exist")
+	assert.ErrorIs(t, err, constants.ErrNamespaceNotFound)
+	_, err = db.GetMetricIDs("")
+	assert.ErrorIs(t, err, constants.ErrNamespaceNotFound)
+	metricIDs, err := db.GetMetricIDs("system")
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []metric.ID{memID, newCPUID}, metricIDs)
+	metricIDs, err = db.DropNamespace("system")
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []metric.ID{memID, newCPUID}, metricIDs)
+	_, err = db.GetMetricID("system", "mem")
+	assert.ErrorIs(t, err, constants.ErrMetricIDNotFound)
+	result, err := db.SuggestNamespace("sys", 10)
+	assert.NoError(t, err)
+	assert.Empty(t, result)
+	// namespace/metrics freed from limit accounting
+	_, err = db.GenMetricID([]byte("other"), []byte("cpu"))
+	assert.NoError(t, err)
+	_, err = db.GenMetricID([]byte("test"), []byte("cpu"))
+	assert.Equal(t, constants.ErrTooManyNamespace, err)
+	assert.NoError(t, db.Close())
+}
+
+func TestMetricMetaDatabase_Drop_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ns := NewMockIndexKVStore(ctrl)
+	metricStore := NewMockIndexKVStore(ctrl)
+	tagValue := NewMockIndexKVStore(ctrl)
+	schemaStore := NewMockMetricSchemaStore(ctrl)
+	db := &metricMetaDatabase{
+		ns:          ns,
+		metric:      metricStore,
+		tagValue:    tagValue,
+		schemaStore: schemaStore,
+		statistics:  metrics.NewMetaDBStatistics("test"),
+	}
+	// get namespace error
+	ns.EXPECT().GetValue(gomock.Any(), gomock.Any()).Return(uint32(0), false, fmt.Errorf("err"))
+	_, err := db.DropNamespace("ns")
+	assert.Error(t, err)
+	// get metric ids error
+	ns.EXPECT().GetValue(gomock.Any(), gomock.Any()).Return(uint32(1), true, nil).AnyTimes()
+	metricStore.EXPECT().GetValues(gomock.Any()).Return(nil, fmt.Errorf("err"))
+	_, err = db.DropNamespace("ns")
+	assert.Error(t, err)
+	// get schema error
+	metricStore.EXPECT().GetValue(gomock.Any(), gomock.Any()).Return(uint32(1), true, nil).AnyTimes()
+	schemaStore.EXPECT().GetSchema(gomock.Any()).Return(nil, fmt.Errorf("err"))
+	_, err = db.DropMetric("ns", "cpu")
+	assert.Error(t, err)
+	// get tag values error
+	schemaStore.EXPECT().GetSchema(gomock.Any()).Return(&metric.Schema{TagKeys: tag.Metas{{ID: 1, Key: "host"}}}, nil)
+	tagValue.EXPECT().GetValues(gomock.Any()).Return(nil, fmt.Errorf("err"))
+	_, err = db.DropMetric("ns", "cpu")
+	assert.Error(t, err)
+	// persist dropped ids error
+	defer func() {
+		writeFileFn = os.WriteFile
+	}()
+	writeFileFn = func(_ string, _ []byte, _ os.FileMode) error {
+		return fmt.Errorf("err")
+	}
+	db.dropped = &droppedIDs{
+		namespaces: roaring.New(),
+		metrics:    roaring.New(),
+		tagKeys:    roaring.New(),
+		tagValues:  roaring.New(),
+	}
+	metricStore.EXPECT().GetValues(gomock.Any()).Return([]uint32{1}, nil)
+	schemaStore.EXPECT().GetSchema(gomock.Any()).Return(nil, nil)
+	_, err = db.DropNamespace("ns")
+	assert.Error(t, err)
+}
+
 func TestMetricMetaDatabase_Flush_Error(t *testing.T) {
 	name := "./metric_meta_database_flush_error"
 	ctrl := gomock.NewController(t)
@@ -201,6 +350,7 @@ func TestMetricMetaDatabase_New_Error(t *testing.T) {
 		ctrl.Finish()
 		mkdir = commonfileutil.MkDirIfNotExist
 		newSequence = NewSequence
+		newDroppedIDs = newDroppedIDsStore
 		kv.InitStoreManager(oldMgr)
 	}()
 	kvStore := kv.NewMockStore(ctrl)
@@ -227,6 +377,14 @@ func TestMetricMetaDatabase_New_Error(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "new dropped ids error",
+			prepare: func() {
+				newDroppedIDs = func(_ string) (*droppedIDs, error) {
+					return nil, fmt.Errorf("err")
+				}
+			},
+		},
 		{
 			name: "create kv store error",
 			prepare: func() {
@@ -275,6 +433,9 @@ func TestMetricMetaDatabase_New_Error(t *testing.T) {
 			newSequence = func(fileName string) (*Sequence, error) {
 				return nil, nil
 			}
+			newDroppedIDs = func(_ string) (*droppedIDs, error) {
+				return &droppedIDs{}, nil
+			}
 			tt.prepare()
 
 			_, err := NewMetricMetaDatabase("test", "./dir")
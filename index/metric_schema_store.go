@@ -47,6 +47,8 @@ type metricSchemaStore struct {
 	immutable *imap.IntMap[*metric.Schema]
 
 	cache *expirable.LRU[metric.ID, *metric.Schema]
+	// tombstone of dropped metrics
+	tombstone v1.Tombstone
 
 	lock sync.RWMutex
 }
@@ -64,6 +66,9 @@ func NewMetricSchemaStore(family kv.Family) MetricSchemaStore {
 
 // GetSchema returns metric schema by metric id, return nil if not exist.
 func (s *metricSchemaStore) GetSchema(id metric.ID) (schema *metric.Schema, err error) {
+	if s.isDropped(uint32(id)) {
+		return nil, nil
+	}
 	schema = s.getSchemaFromMem(id)
 	if schema != nil {
 		return schema, nil
@@ -148,6 +153,22 @@ func (s *metricSchemaStore) genTagKeyID(id metric.ID, tagKey []byte, limits *mod
 	return tm.ID, nil
 }
 
+// setTombstone sets the tombstone of dropped metrics, the schema of dropped metrics are skipped
+// when reading/flushing, and purged when doing compaction.
+func (s *metricSchemaStore) setTombstone(tombstone v1.Tombstone) {
+	s.lock.Lock()
+	s.tombstone = tombstone
+	s.lock.Unlock()
+
+	s.cache.Purge()
+	s.family.SetMergeContext(kv.TombstoneContext, tombstone)
+}
+
+// isDropped returns if the metric is dropped.
+func (s *metricSchemaStore) isDropped(id uint32) bool {
+	return s.tombstone != nil && s.tombstone.IsKeyDropped(id)
+}
+
 // getSchemaFromKV gets schema from kv store.
 func (s *metricSchemaStore) getSchemaFromKV(id metric.ID) (schema *metric.Schema, err error) {
 	snapshot := s.family.GetSnapshot()
@@ -217,7 +238,7 @@ func (s *metricSchemaStore) Flush() error {
 		return err
 	}
 	err = s.immutable.WalkEntry(func(key uint32, value *metric.Schema) error {
-		if !value.NeedWrite() {
+		if !value.NeedWrite() || s.isDropped(key) {
 			return nil
 		}
 		flusher.Prepare(key)
@@ -63,6 +63,36 @@ func TestMetricSchemaStore_GenFieldID(t *testing.T) {
 	assert.Equal(t, field.ID(0), fID)
 }
 
+func TestMetricSchemaStore_Tombstone(t *testing.T) {
+	name := "./schema_tombstone"
+	defer func() {
+		_ = os.RemoveAll(name)
+	}()
+	kvStore, err := kv.GetStoreManager().CreateStore(name, kv.StoreOption{Levels: 2})
+	assert.NoError(t, err)
+	family, err := kvStore.CreateFamily("field", kv.FamilyOption{Merger: string(v1.MetricSchemaMerger)})
+	assert.NoError(t, err)
+	s := NewMetricSchemaStore(family)
+	for _, id := range []metric.ID{10, 20} {
+		_, err = s.genFieldID(id, field.Meta{Name: "test", Type: field.SumField}, models.NewDefaultLimits())
+		assert.NoError(t, err)
+	}
+	s.setTombstone(&kvTombstone{isKeyDropped: func(key uint32) bool { return key == 10 }})
+	check := func() {
+		schema, err0 := s.GetSchema(10)
+		assert.NoError(t, err0)
+		assert.Nil(t, schema)
+		schema, err0 = s.GetSchema(20)
+		assert.NoError(t, err0)
+		assert.NotNil(t, schema)
+	}
+	check()
+	s.PrepareFlush()
+	assert.NoError(t, s.Flush())
+	check()
+	assert.NoError(t, kv.GetStoreManager().CloseStore(name))
+}
+
 func TestMetricSchemaStore_GenTagKeyID(t *testing.T) {
 	name := "./gen_tag_key"
 	defer func() {
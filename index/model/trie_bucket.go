@@ -63,6 +63,8 @@ type tries []*trieEntry
 type TrieBucket struct {
 	kvs       tries
 	blockSize int
+	// isDropped checks if the value is dropped, dropped values are skipped when reading or writing.
+	isDropped func(value uint32) bool
 }
 
 // NewTrieBucket creates a trie bucket with default block size.
@@ -93,14 +95,43 @@ func (b *TrieBucket) Unmarshal(block []byte) error {
 	return nil
 }
 
-// Write writes kv tries based on block size.
+// SetDropFilter sets the filter of dropped values, dropped values are skipped when reading or writing.
+func (b *TrieBucket) SetDropFilter(isDropped func(value uint32) bool) {
+	b.isDropped = isDropped
+}
+
+// dropped returns if the value is dropped.
+func (b *TrieBucket) dropped(value uint32) bool {
+	return b.isDropped != nil && b.isDropped(value)
+}
+
+// hasDropped returns if the trie has any dropped value.
+func (b *TrieBucket) hasDropped(tree *trieEntry) bool {
+	if b.isDropped == nil {
+		return false
+	}
+	for _, value := range tree.tree.Values() {
+		if b.isDropped(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// Write writes kv tries based on block size, the tries which have dropped values are rebuilt.
 func (b *TrieBucket) Write(w io.Writer) error {
 	sort.Slice(b.kvs, func(i, j int) bool {
 		return b.kvs[i].tree.Size() > b.kvs[j].tree.Size()
 	})
 	var pendingKVs tries
+	rebuild := false
 	for idx := range b.kvs {
 		tree := b.kvs[idx]
+		if b.hasDropped(tree) {
+			rebuild = true
+			pendingKVs = append(pendingKVs, tree)
+			continue
+		}
 		if tree.tree.Size() >= b.blockSize {
 			if _, err := w.Write(tree.buf); err != nil {
 				return err
@@ -115,9 +146,13 @@ func (b *TrieBucket) Write(w io.Writer) error {
 		// if no pending kvs return
 		return nil
 	case 1:
-		if _, err := w.Write(pendingKVs[0].buf); err != nil {
-			return err
+		if !rebuild {
+			if _, err := w.Write(pendingKVs[0].buf); err != nil {
+				return err
+			}
+			return nil
 		}
+		fallthrough
 	default:
 		var keys [][]byte
 		var ids []uint32
@@ -125,6 +160,10 @@ func (b *TrieBucket) Write(w io.Writer) error {
 		for _, kv := range pendingKVs {
 			itr := kv.tree.NewPrefixIterator(nil)
 			for itr.Valid() {
+				if b.dropped(itr.Value()) {
+					itr.Next()
+					continue
+				}
 				key := itr.Key()
 				// NOTE: need copy key, because trie iterator reuse key when iterate
 				k := make([]byte, len(key))
@@ -135,11 +174,13 @@ func (b *TrieBucket) Write(w io.Writer) error {
 			}
 		}
 
+		if len(keys) == 0 {
+			// all values dropped
+			return nil
+		}
 		builder := NewTrieBucketBuilder(b.blockSize, w)
 		return builder.Write(keys, ids)
 	}
-
-	return nil
 }
 
 // Release releases bucket resource.
@@ -153,17 +194,25 @@ func (b *TrieBucket) Release() {
 func (b *TrieBucket) GetValue(key []byte) (id uint32, ok bool) {
 	for _, kvs := range b.kvs {
 		id, ok = kvs.tree.Get(key)
-		if ok {
-			return
+		if ok && !b.dropped(id) {
+			return id, true
 		}
 	}
-	return
+	return 0, false
 }
 
 // GetValues returns all values.
 func (b *TrieBucket) GetValues() (ids []uint32) {
 	for _, kvs := range b.kvs {
-		ids = append(ids, kvs.tree.Values()...)
+		if b.isDropped == nil {
+			ids = append(ids, kvs.tree.Values()...)
+			continue
+		}
+		for _, id := range kvs.tree.Values() {
+			if !b.isDropped(id) {
+				ids = append(ids, id)
+			}
+		}
 	}
 	return
 }
@@ -175,7 +224,7 @@ func (b *TrieBucket) CollectKVs(values *roaring.Bitmap, result map[uint32]string
 		itr := kv.tree.NewPrefixIterator(nil)
 		for itr.Valid() {
 			val := itr.Value()
-			if values.Contains(val) {
+			if values.Contains(val) && !b.dropped(val) {
 				result[val] = string(itr.Key())
 				values.Remove(val)
 			}
@@ -195,6 +244,9 @@ func (b *TrieBucket) Suggest(prefix string, limit int) (rs []string) {
 	}
 	it := NewMergedIterator(its)
 	for it.HasNext() {
+		if b.dropped(it.Value()) {
+			continue
+		}
 		key := it.Key()
 		rs = append(rs, string(key))
 		if len(rs) >= limit {
@@ -211,7 +263,7 @@ func (b *TrieBucket) FindValuesByRegexp(rp *regexp.Regexp, ids []uint32) []uint3
 	for _, kv := range b.kvs {
 		itr := kv.tree.NewPrefixIterator(literalPrefixByte)
 		for itr.Valid() {
-			if rp.Match(itr.Key()) {
+			if rp.Match(itr.Key()) && !b.dropped(itr.Value()) {
 				ids = append(ids, itr.Value())
 			}
 			itr.Next()
@@ -225,7 +277,7 @@ func (b *TrieBucket) FindValuesByLike(prefix, subKey []byte, check func(a, b []b
 	for _, kv := range b.kvs {
 		itr := kv.tree.NewPrefixIterator(prefix)
 		for itr.Valid() {
-			if check(itr.Key(), subKey) {
+			if check(itr.Key(), subKey) && !b.dropped(itr.Value()) {
 				ids = append(ids, itr.Value())
 			}
 			itr.Next()
@@ -239,7 +291,8 @@ type mergedIterator struct {
 	its []*trie.PrefixIterator
 	pq  priorityQueue
 
-	curKey []byte
+	curKey   []byte
+	curValue uint32
 }
 
 // NewMergedIterator create merged iterator for multi iterators
@@ -258,7 +311,8 @@ func (m *mergedIterator) initQueue() {
 		if it.Valid() {
 			m.pq = append(m.pq, &item{
 				it:    it,
-				key:   it.Key(),
+				key:   cloneKey(it.Key()),
+				value: it.Value(),
 				index: i,
 			})
 			it.Next()
@@ -280,11 +334,13 @@ func (m *mergedIterator) HasNext() bool {
 		item := val.(*item)
 		// use getKey() instead of item.key to prevent key from being overwritten.
 		m.curKey = item.getKey()
+		m.curValue = item.value
 
 		// if it has value, push back queue and adjust priority
 		it := item.it
 		if it.Valid() {
-			item.key = it.Key()
+			item.key = cloneKey(it.Key())
+			item.value = it.Value()
 			m.pq.Push(item)
 			m.pq.update(item)
 
@@ -299,11 +355,17 @@ func (m *mergedIterator) Key() []byte {
 	return m.curKey
 }
 
+// Value returns the value of the current key
+func (m *mergedIterator) Value() uint32 {
+	return m.curValue
+}
+
 // item represents an item under priority queue, using key as priority.
 type item struct {
 	it *trie.PrefixIterator
 
-	key []byte
+	key   []byte
+	value uint32
 
 	index int
 }
@@ -318,6 +380,11 @@ func (i *item) getKey() []byte {
 	return key
 }
 
+// cloneKey clones the key, because trie iterator reuses key buffer when iterating.
+func cloneKey(key []byte) []byte {
+	return append([]byte(nil), key...)
+}
+
 // priorityQueue implements heap.Interface and holds Items.
 type priorityQueue []*item
 
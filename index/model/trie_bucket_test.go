@@ -135,6 +135,48 @@ func TestTrieBucket_Write_Error(t *testing.T) {
 	})
 }
 
+func TestTrieBucket_DropFilter(t *testing.T) {
+	keys, _, data := createTriesData(t, 3)
+	// drop "ab"(1)/"abc"(3)
+	dropped := roaring.BitmapOf(1, 3)
+	isDropped := func(value uint32) bool {
+		return dropped.Contains(value)
+	}
+	tries := NewTrieBucketWithBlockSize(3)
+	defer tries.Release()
+	assert.NoError(t, tries.Unmarshal(data))
+	tries.SetDropFilter(isDropped)
+
+	_, ok := tries.GetValue([]byte("ab"))
+	assert.False(t, ok)
+	id, ok := tries.GetValue([]byte("a"))
+	assert.True(t, ok)
+	assert.Equal(t, uint32(0), id)
+	assert.Len(t, tries.GetValues(), len(keys)-2)
+	assert.Equal(t, []string{"a", "abcdefgh"}, tries.Suggest("a", 2))
+	assert.Len(t, tries.FindValuesByRegexp(regexp.MustCompile("^abc"), nil), 3)
+	assert.Len(t, tries.FindValuesByLike([]byte("ab"), []byte("ab"), bytes.HasPrefix, nil), 3)
+	result := make(map[uint32]string)
+	tries.CollectKVs(roaring.BitmapOf(0, 1), result)
+	assert.Equal(t, map[uint32]string{0: "a"}, result)
+
+	// purge dropped values when writing
+	w := bytes.NewBuffer([]byte{})
+	assert.NoError(t, tries.Write(w))
+	tries2 := NewTrieBucket()
+	defer tries2.Release()
+	assert.NoError(t, tries2.Unmarshal(w.Bytes()))
+	assert.Len(t, tries2.GetValues(), len(keys)-2)
+	_, ok = tries2.GetValue([]byte("abc"))
+	assert.False(t, ok)
+
+	// all values dropped
+	tries2.SetDropFilter(func(_ uint32) bool { return true })
+	w.Reset()
+	assert.NoError(t, tries2.Write(w))
+	assert.Zero(t, w.Len())
+}
+
 func TestTrieBucket_CollectKVs(t *testing.T) {
 	keys, values, data := createTriesData(t, math.MaxUint16)
 	tries := NewTrieBucket()
@@ -35,11 +35,13 @@ var (
 )
 
 const (
-	SeqSize          = 4 * 4 // ns/name/tag key/tag value
-	NamespaceOffset  = 0
-	MetricNameOffset = 4
-	TagKeyOffset     = 8
-	TagValueOffset   = 12
+	SeqSize                 = 6 * 4 // ns/name/tag key/tag value/dropped ns/dropped name
+	NamespaceOffset         = 0
+	MetricNameOffset        = 4
+	TagKeyOffset            = 8
+	TagValueOffset          = 12
+	DroppedNamespaceOffset  = 16
+	DroppedMetricNameOffset = 20
 )
 
 // Sequence represents the sequence allocate of metadata.
@@ -50,6 +52,9 @@ type Sequence struct {
 	metric   *atomic.Uint32
 	tagKey   *atomic.Uint32
 	tagValue *atomic.Uint32
+	// number of dropped namespace/metric name, sequence cannot be reused after dropping.
+	droppedNS     *atomic.Uint32
+	droppedMetric *atomic.Uint32
 
 	buf []byte // mmap buf
 }
@@ -74,6 +79,9 @@ func NewSequence(fileName string) (*Sequence, error) {
 		metric:   atomic.NewUint32(stream.ReadUint32(buf, MetricNameOffset)),
 		tagKey:   atomic.NewUint32(stream.ReadUint32(buf, TagKeyOffset)),
 		tagValue: atomic.NewUint32(stream.ReadUint32(buf, TagValueOffset)),
+
+		droppedNS:     atomic.NewUint32(stream.ReadUint32(buf, DroppedNamespaceOffset)),
+		droppedMetric: atomic.NewUint32(stream.ReadUint32(buf, DroppedMetricNameOffset)),
 	}, nil
 }
 
@@ -87,6 +95,26 @@ func (s *Sequence) GetMetricNameSeq() uint32 {
 	return s.metric.Load()
 }
 
+// GetNumOfNamespaces returns the number of namespaces which are not dropped.
+func (s *Sequence) GetNumOfNamespaces() uint32 {
+	return s.ns.Load() - s.droppedNS.Load()
+}
+
+// GetNumOfMetricNames returns the number of metric names which are not dropped.
+func (s *Sequence) GetNumOfMetricNames() uint32 {
+	return s.metric.Load() - s.droppedMetric.Load()
+}
+
+// DropNamespaces frees the dropped namespaces from accounting.
+func (s *Sequence) DropNamespaces(n uint32) {
+	s.droppedNS.Add(n)
+}
+
+// DropMetricNames frees the dropped metric names from accounting.
+func (s *Sequence) DropMetricNames(n uint32) {
+	s.droppedMetric.Add(n)
+}
+
 // GenNamespaceSeq generates sequence for namespace.
 func (s *Sequence) GenNamespaceSeq() uint32 {
 	return s.ns.Inc() - 1
@@ -113,6 +141,8 @@ func (s *Sequence) Sync() error {
 	stream.PutUint32(s.buf, MetricNameOffset, s.metric.Load())
 	stream.PutUint32(s.buf, TagKeyOffset, s.tagKey.Load())
 	stream.PutUint32(s.buf, TagValueOffset, s.tagValue.Load())
+	stream.PutUint32(s.buf, DroppedNamespaceOffset, s.droppedNS.Load())
+	stream.PutUint32(s.buf, DroppedMetricNameOffset, s.droppedMetric.Load())
 	return syncFn(s.buf)
 }
 
@@ -46,6 +46,10 @@ func TestSequence(t *testing.T) {
 	test(0, 8, seq.GenMetricNameSeq)
 	test(0, 16, seq.GenTagKeySeq)
 	test(0, 32, seq.GenTagValueSeq)
+	seq.DropNamespaces(1)
+	seq.DropMetricNames(3)
+	assert.Equal(t, uint32(3), seq.GetNumOfNamespaces())
+	assert.Equal(t, uint32(5), seq.GetNumOfMetricNames())
 
 	assert.NoError(t, seq.Sync())
 	assert.NoError(t, seq.Close())
@@ -59,6 +63,26 @@ func TestSequence(t *testing.T) {
 	assert.Equal(t, uint32(9), seq.GetMetricNameSeq())
 	assert.Equal(t, uint32(16), seq.GenTagKeySeq())
 	assert.Equal(t, uint32(32), seq.GenTagValueSeq())
+	assert.Equal(t, uint32(4), seq.GetNumOfNamespaces())
+	assert.Equal(t, uint32(6), seq.GetNumOfMetricNames())
+	assert.NoError(t, seq.Close())
+}
+
+func TestSequence_Upgrade(t *testing.T) {
+	name := "./sequence_upgrade"
+	defer func() {
+		_ = os.RemoveAll(name)
+	}()
+	// sequence file without dropped counters
+	data := make([]byte, 4*4)
+	data[NamespaceOffset] = 2
+	data[MetricNameOffset] = 3
+	assert.NoError(t, os.WriteFile(name, data, 0644))
+
+	seq, err := NewSequence(name)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(2), seq.GetNumOfNamespaces())
+	assert.Equal(t, uint32(3), seq.GetNumOfMetricNames())
 	assert.NoError(t, seq.Close())
 }
 
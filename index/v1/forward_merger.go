@@ -43,6 +43,7 @@ type forwardIndexMerger struct {
 	seriesIDs   *roaring.Bitmap
 	tagValueIDs []uint32
 	scanners    []*tagForwardScanner
+	tombstone   Tombstone
 }
 
 // NewForwardIndexMerger creates a forward index merger.
@@ -57,10 +58,17 @@ func NewForwardIndexMerger(kvFlusher kv.Flusher) (kv.Merger, error) {
 	}, nil
 }
 
-func (m *forwardIndexMerger) Init(params map[string]interface{}) {}
+// Init initializes merger, if tombstone context exist purge the dropped keys.
+func (m *forwardIndexMerger) Init(params map[string]interface{}) {
+	m.tombstone = getTombstone(params)
+}
 
 // Merge merges series ids -> tag value ids.
 func (m *forwardIndexMerger) Merge(tagKeyID uint32, values [][]byte) error {
+	if m.tombstone != nil && m.tombstone.IsKeyDropped(tagKeyID) {
+		// key dropped, purge it
+		return nil
+	}
 	m.seriesIDs.Clear() // target merged series ids
 	m.scanners = m.scanners[:0]
 	// 1. prepare tag forward scanner
@@ -31,8 +31,9 @@ func init() {
 }
 
 type indexKVMerger struct {
-	flusher  kv.Flusher
-	kvWriter table.StreamWriter
+	flusher   kv.Flusher
+	kvWriter  table.StreamWriter
+	tombstone Tombstone
 }
 
 func NewIndexKVMerger(kvFlusher kv.Flusher) (kv.Merger, error) {
@@ -46,11 +47,21 @@ func NewIndexKVMerger(kvFlusher kv.Flusher) (kv.Merger, error) {
 	}, nil
 }
 
-func (m *indexKVMerger) Init(_ map[string]interface{}) {}
+// Init initializes index kv merger, if tombstone context exist purge the dropped buckets/values.
+func (m *indexKVMerger) Init(params map[string]interface{}) {
+	m.tombstone = getTombstone(params)
+}
 
 func (m *indexKVMerger) Merge(bucketID uint32, buckets [][]byte) error {
+	if m.tombstone != nil && m.tombstone.IsKeyDropped(bucketID) {
+		// bucket dropped, purge it
+		return nil
+	}
 	// TODO: reuse bucket
 	trieBucket := model.NewTrieBucket()
+	if m.tombstone != nil {
+		trieBucket.SetDropFilter(m.tombstone.IsValueDropped)
+	}
 	for _, bucket := range buckets {
 		err := trieBucket.Unmarshal(bucket)
 		if err != nil {
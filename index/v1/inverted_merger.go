@@ -41,6 +41,7 @@ type invertedIndexMerger struct {
 	flusher         InvertedIndexFlusher
 	targetSeriesIDs *roaring.Bitmap // target merged series ids
 	seriesIDs       *roaring.Bitmap
+	tombstone       Tombstone
 }
 
 // NewInvertedIndexMerger creates an inverted index merger.
@@ -56,10 +57,17 @@ func NewInvertedIndexMerger(kvFlusher kv.Flusher) (kv.Merger, error) {
 	}, nil
 }
 
-func (m *invertedIndexMerger) Init(params map[string]interface{}) {}
+// Init initializes merger, if tombstone context exist purge the dropped keys.
+func (m *invertedIndexMerger) Init(params map[string]interface{}) {
+	m.tombstone = getTombstone(params)
+}
 
 // Merge merges series ids for key.
 func (m *invertedIndexMerger) Merge(key uint32, values [][]byte) error {
+	if m.tombstone != nil && m.tombstone.IsKeyDropped(key) {
+		// key dropped, purge it
+		return nil
+	}
 	m.targetSeriesIDs.Clear()
 	for _, val := range values {
 		if _, err := bitmapUnmarshal(m.seriesIDs, val); err != nil {
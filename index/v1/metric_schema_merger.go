@@ -31,7 +31,8 @@ func init() {
 
 // metricSchemaMerger implements kv.Merger interface for merging metric schema.
 type metricSchemaMerger struct {
-	flusher MetricSchemaFlusher
+	flusher   MetricSchemaFlusher
+	tombstone Tombstone
 }
 
 // NewMetricScheamMerger creates a MetricSchemaMerger instance.
@@ -45,10 +46,17 @@ func NewMetricScheamMerger(kvFlusher kv.Flusher) (kv.Merger, error) {
 	}, nil
 }
 
-func (m *metricSchemaMerger) Init(params map[string]interface{}) {}
+// Init initializes merger, if tombstone context exist purge the dropped keys.
+func (m *metricSchemaMerger) Init(params map[string]interface{}) {
+	m.tombstone = getTombstone(params)
+}
 
 // Merge merges metric schema.
 func (m *metricSchemaMerger) Merge(metricID uint32, values [][]byte) error {
+	if m.tombstone != nil && m.tombstone.IsKeyDropped(metricID) {
+		// key dropped, purge it
+		return nil
+	}
 	schema := &metric.Schema{}
 	for _, val := range values {
 		schema.Unmarshal(val)
@@ -0,0 +1,40 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package v1
+
+import (
+	"github.com/lindb/lindb/kv"
+)
+
+// Tombstone represents the dropped keys/values of index which need to be purged when doing compaction.
+type Tombstone interface {
+	// IsKeyDropped returns if the key(bucket/metric/tag key/tag value id) is dropped.
+	IsKeyDropped(key uint32) bool
+	// IsValueDropped returns if the value of index kv is dropped.
+	IsValueDropped(value uint32) bool
+}
+
+// getTombstone returns the tombstone from merge context, returns nil if not exist.
+func getTombstone(params map[string]interface{}) Tombstone {
+	if tombstoneCtx, ok := params[kv.TombstoneContext]; ok {
+		if tombstone, ok := tombstoneCtx.(Tombstone); ok {
+			return tombstone
+		}
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package v1
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/lindb/roaring"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/lindb/lindb/kv"
+	"github.com/lindb/lindb/kv/table"
+)
+
+type mockTombstone struct {
+	keys   *roaring.Bitmap
+	values *roaring.Bitmap
+}
+
+func (t *mockTombstone) IsKeyDropped(key uint32) bool {
+	return t.keys.Contains(key)
+}
+
+func (t *mockTombstone) IsValueDropped(value uint32) bool {
+	return t.values.Contains(value)
+}
+
+func TestGetTombstone(t *testing.T) {
+	assert.Nil(t, getTombstone(nil))
+	assert.Nil(t, getTombstone(map[string]interface{}{kv.TombstoneContext: "tombstone"}))
+	tombstone := &mockTombstone{}
+	assert.Equal(t, tombstone, getTombstone(map[string]interface{}{kv.TombstoneContext: tombstone}))
+}
+
+func TestIndexKV_Merge_Tombstone(t *testing.T) {
+	name := "./IndexKV_Merge_Tombstone"
+	family := createKVIndexFamily(t, name)
+	defer func() {
+		_ = os.RemoveAll(name)
+	}()
+	family.SetMergeContext(kv.TombstoneContext, &mockTombstone{
+		keys:   roaring.BitmapOf(200),
+		values: roaring.BitmapOf(1),
+	})
+
+	write := func(i int) {
+		kvFlusher := family.NewFlusher()
+		defer kvFlusher.Release()
+		flusher, err := NewIndexKVFlusher(1, kvFlusher)
+		assert.NoError(t, err)
+		flusher.PrepareBucket(100)
+		assert.NoError(t, flusher.WriteKVs([][]byte{[]byte(fmt.Sprintf("key-%d", i))}, []uint32{uint32(i)}))
+		assert.NoError(t, flusher.CommitBucket())
+		flusher.PrepareBucket(200)
+		assert.NoError(t, flusher.WriteKVs([][]byte{[]byte(fmt.Sprintf("key-%d", i))}, []uint32{uint32(i)}))
+		assert.NoError(t, flusher.CommitBucket())
+		assert.NoError(t, flusher.Close())
+	}
+	for i := 0; i < 4; i++ {
+		write(i)
+	}
+
+	family.Compact()
+	time.Sleep(100 * time.Millisecond)
+
+	snapshot := family.GetSnapshot()
+	defer snapshot.Close()
+
+	reader := NewIndexKVReader(snapshot)
+	bucket, err := reader.GetBucket(100)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []uint32{0, 2, 3}, bucket.GetValues())
+	_, ok := bucket.GetValue([]byte("key-1"))
+	assert.False(t, ok)
+	bucket, err = reader.GetBucket(200)
+	assert.NoError(t, err)
+	assert.Nil(t, bucket)
+}
+
+func TestMerger_Tombstone(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newInvertedIndexFlusher = NewInvertedIndexFlusher
+		newForwardIndexFlusher = NewForwardIndexFlusher
+		ctrl.Finish()
+	}()
+	params := map[string]interface{}{kv.TombstoneContext: &mockTombstone{keys: roaring.BitmapOf(10)}}
+
+	newInvertedIndexFlusher = func(_ kv.Flusher) (InvertedIndexFlusher, error) {
+		return NewMockInvertedIndexFlusher(ctrl), nil
+	}
+	m, err := NewInvertedIndexMerger(nil)
+	assert.NoError(t, err)
+	m.Init(params)
+	assert.NoError(t, m.Merge(10, [][]byte{{1, 2}}))
+
+	newForwardIndexFlusher = func(_ kv.Flusher) (ForwardIndexFlusher, error) {
+		return NewMockForwardIndexFlusher(ctrl), nil
+	}
+	m, err = NewForwardIndexMerger(nil)
+	assert.NoError(t, err)
+	m.Init(params)
+	assert.NoError(t, m.Merge(10, [][]byte{{1, 2}}))
+
+	kvFlusher := kv.NewMockFlusher(ctrl)
+	kvFlusher.EXPECT().StreamWriter().Return(table.NewMockStreamWriter(ctrl), nil).Times(2)
+	m, err = NewMetricScheamMerger(kvFlusher)
+	assert.NoError(t, err)
+	m.Init(params)
+	assert.NoError(t, m.Merge(10, [][]byte{{1, 2}}))
+
+	m, err = NewIndexKVMerger(kvFlusher)
+	assert.NoError(t, err)
+	m.Init(params)
+	assert.NoError(t, m.Merge(10, [][]byte{{1, 2}}))
+}
@@ -0,0 +1,176 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package export
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	protoMetricsV1 "github.com/lindb/common/proto/gen/v1/linmetrics"
+)
+
+// Exported data is stored as portable file, native format layout:
+//
+//	magic("LINDBEXP") | version(1 byte) | record | record | ... | end marker(uvarint 0)
+//	record: uvarint(length of payload) | payload(protobuf MetricList)
+//
+// Native format keeps namespace and field types, so it can be replayed without loss.
+// Line format is influxdb line protocol with millisecond timestamps, which is human-readable,
+// but namespace and field types are dropped(the importer guesses field types by field name suffix).
+
+const (
+	// FormatNative represents the native format(length-delimited protobuf records with field types).
+	FormatNative = "native"
+	// FormatLine represents the influxdb line protocol format.
+	FormatLine = "line"
+
+	// nativeVersion represents the version of native format.
+	nativeVersion byte = 1
+	// defaultBatchSize represents the max metrics of a record.
+	defaultBatchSize = 1000
+	// maxRecordSize represents the max payload size of a record.
+	maxRecordSize = 64 * 1024 * 1024
+)
+
+var (
+	// nativeMagic represents the header magic of native format.
+	nativeMagic = []byte("LINDBEXP")
+	// HeaderSize represents the size of native format header.
+	HeaderSize = len(nativeMagic) + 1
+
+	// ErrBadHeader represents the header of native format is invalid.
+	ErrBadHeader = errors.New("bad native export header")
+	// ErrBadRecord represents the record of native format is invalid.
+	ErrBadRecord = errors.New("bad native export record")
+	// ErrMissingEnd represents the native stream ends without end marker, the data may be truncated.
+	ErrMissingEnd = errors.New("native export data truncated, missing end marker")
+)
+
+// IsValidFormat checks if the format is supported.
+func IsValidFormat(format string) bool {
+	return format == FormatNative || format == FormatLine
+}
+
+// Encoder represents the encoder which encodes exported metrics into the underlying writer.
+type Encoder interface {
+	// Encode encodes the metric.
+	Encode(m *protoMetricsV1.Metric) error
+	// Flush flushes the pending metrics into the underlying writer.
+	Flush() error
+	// Close flushes the pending metrics and writes the end of stream.
+	Close() error
+}
+
+// NewEncoder creates an encoder by given format.
+func NewEncoder(format string, w io.Writer) (Encoder, error) {
+	switch format {
+	case FormatNative:
+		return newNativeEncoder(w)
+	case FormatLine:
+		return newLineEncoder(w), nil
+	default:
+		return nil, fmt.Errorf("unknown export format: %s", format)
+	}
+}
+
+// WriteHeader writes the header of native format.
+func WriteHeader(w io.Writer) error {
+	_, err := w.Write(append(append([]byte{}, nativeMagic...), nativeVersion))
+	return err
+}
+
+// HasHeader checks if the data starts with the header of native format.
+func HasHeader(data []byte) bool {
+	return bytes.HasPrefix(data, nativeMagic)
+}
+
+// WriteRecord writes the payload as a length-delimited record, empty payload means end marker.
+func WriteRecord(w io.Writer, payload []byte) error {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buf[:], uint64(len(payload)))
+	if _, err := w.Write(buf[:n]); err != nil {
+		return err
+	}
+	if len(payload) == 0 {
+		return nil
+	}
+	_, err := w.Write(payload)
+	return err
+}
+
+// Reader represents the reader which reads records of native format.
+type Reader struct {
+	r   *bufio.Reader
+	buf []byte
+	end bool
+}
+
+// NewReader creates a native format reader.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{r: bufio.NewReader(r)}
+}
+
+// ReadHeader reads and validates the header of native format.
+func (r *Reader) ReadHeader() error {
+	header := make([]byte, HeaderSize)
+	if _, err := io.ReadFull(r.r, header); err != nil {
+		return fmt.Errorf("%w: %s", ErrBadHeader, err)
+	}
+	if !HasHeader(header) {
+		return ErrBadHeader
+	}
+	if header[len(nativeMagic)] != nativeVersion {
+		return fmt.Errorf("%w: unsupported version %d", ErrBadHeader, header[len(nativeMagic)])
+	}
+	return nil
+}
+
+// Next returns the payload of next record, returns io.EOF after end marker,
+// returns ErrMissingEnd if the stream ends without end marker.
+// NOTE: the payload is reused by next call.
+func (r *Reader) Next() ([]byte, error) {
+	if r.end {
+		return nil, io.EOF
+	}
+	size, err := binary.ReadUvarint(r.r)
+	if err != nil {
+		if err == io.EOF {
+			return nil, ErrMissingEnd
+		}
+		return nil, fmt.Errorf("%w: %s", ErrBadRecord, err)
+	}
+	if size == 0 {
+		r.end = true
+		return nil, io.EOF
+	}
+	if size > maxRecordSize {
+		return nil, fmt.Errorf("%w: record size %d too large", ErrBadRecord, size)
+	}
+	if cap(r.buf) < int(size) {
+		r.buf = make([]byte, size)
+	}
+	r.buf = r.buf[:size]
+	if _, err := io.ReadFull(r.r, r.buf); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrBadRecord, err)
+	}
+	return r.buf, nil
+}
@@ -0,0 +1,141 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package export
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	protoMetricsV1 "github.com/lindb/common/proto/gen/v1/linmetrics"
+	"github.com/stretchr/testify/assert"
+)
+
+type errWriter struct{}
+
+func (w *errWriter) Write(_ []byte) (int, error) {
+	return 0, io.ErrClosedPipe
+}
+
+func newTestMetric(name string, timestamp int64) *protoMetricsV1.Metric {
+	return &protoMetricsV1.Metric{
+		Namespace: "ns",
+		Name:      name,
+		Timestamp: timestamp,
+		Tags: []*protoMetricsV1.KeyValue{
+			{Key: "ip", Value: "1.1.1.1"},
+			{Key: "host", Value: "a b"},
+		},
+		SimpleFields: []*protoMetricsV1.SimpleField{
+			{Name: "count", Type: protoMetricsV1.SimpleFieldType_DELTA_SUM, Value: 10},
+			{Name: "max", Type: protoMetricsV1.SimpleFieldType_Max, Value: 2.5},
+		},
+	}
+}
+
+func TestFormat(t *testing.T) {
+	assert.True(t, IsValidFormat(FormatNative))
+	assert.True(t, IsValidFormat(FormatLine))
+	assert.False(t, IsValidFormat("csv"))
+	e, err := NewEncoder("csv", &bytes.Buffer{})
+	assert.Error(t, err)
+	assert.Nil(t, e)
+	e, err = NewEncoder(FormatNative, &errWriter{})
+	assert.Error(t, err)
+	assert.Nil(t, e)
+}
+
+func TestNativeEncoder_Reader(t *testing.T) {
+	var buf bytes.Buffer
+	e, err := NewEncoder(FormatNative, &buf)
+	assert.NoError(t, err)
+	count := defaultBatchSize + 10
+	for i := 0; i < count; i++ {
+		assert.NoError(t, e.Encode(newTestMetric("cpu", int64(i))))
+	}
+	assert.NoError(t, e.Close())
+	assert.True(t, HasHeader(buf.Bytes()))
+
+	r := NewReader(bytes.NewReader(buf.Bytes()))
+	assert.NoError(t, r.ReadHeader())
+	records := 0
+	total := 0
+	for {
+		payload, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		assert.NoError(t, err)
+		var ms protoMetricsV1.MetricList
+		assert.NoError(t, ms.Unmarshal(payload))
+		for _, m := range ms.Metrics {
+			assert.Equal(t, "ns", m.Namespace)
+			assert.Equal(t, protoMetricsV1.SimpleFieldType_Max, m.SimpleFields[1].Type)
+			assert.Equal(t, int64(total), m.Timestamp)
+			total++
+		}
+		records++
+	}
+	assert.Equal(t, 2, records)
+	assert.Equal(t, count, total)
+	// read after end
+	_, err = r.Next()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestReader_Error(t *testing.T) {
+	// bad header
+	r := NewReader(bytes.NewReader([]byte("LIN")))
+	assert.True(t, errors.Is(r.ReadHeader(), ErrBadHeader))
+	r = NewReader(bytes.NewReader([]byte("123456789")))
+	assert.True(t, errors.Is(r.ReadHeader(), ErrBadHeader))
+	r = NewReader(bytes.NewReader(append([]byte("LINDBEXP"), 9)))
+	assert.True(t, errors.Is(r.ReadHeader(), ErrBadHeader))
+
+	// missing end marker
+	var buf bytes.Buffer
+	assert.NoError(t, WriteHeader(&buf))
+	assert.NoError(t, WriteRecord(&buf, []byte("abc")))
+	r = NewReader(bytes.NewReader(buf.Bytes()))
+	assert.NoError(t, r.ReadHeader())
+	payload, err := r.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("abc"), payload)
+	_, err = r.Next()
+	assert.Equal(t, ErrMissingEnd, err)
+
+	// truncated record
+	r = NewReader(bytes.NewReader(buf.Bytes()[:buf.Len()-1]))
+	assert.NoError(t, r.ReadHeader())
+	_, err = r.Next()
+	assert.True(t, errors.Is(err, ErrBadRecord))
+
+	// record too large
+	r = NewReader(bytes.NewReader([]byte{0xff, 0xff, 0xff, 0xff, 0x0f}))
+	_, err = r.Next()
+	assert.True(t, errors.Is(err, ErrBadRecord))
+}
+
+func TestWriteRecord_Error(t *testing.T) {
+	assert.Error(t, WriteRecord(&errWriter{}, []byte("abc")))
+	e := &nativeEncoder{w: &errWriter{}}
+	assert.NoError(t, e.Flush())
+	assert.NoError(t, e.Encode(newTestMetric("cpu", 1)))
+	assert.Error(t, e.Close())
+}
@@ -0,0 +1,96 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package export
+
+import (
+	"bufio"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	protoMetricsV1 "github.com/lindb/common/proto/gen/v1/linmetrics"
+)
+
+var (
+	metricNameReplacer = strings.NewReplacer(",", `\,`, " ", `\ `)
+	tagReplacer        = strings.NewReplacer(",", `\,`, " ", `\ `, "=", `\=`)
+)
+
+// lineEncoder encodes metrics as influxdb line protocol, timestamp precision is millisecond.
+type lineEncoder struct {
+	w *bufio.Writer
+}
+
+// newLineEncoder creates a line protocol encoder.
+func newLineEncoder(w io.Writer) Encoder {
+	return &lineEncoder{w: bufio.NewWriter(w)}
+}
+
+// Encode encodes the metric as a line, metric without valid field is ignored.
+func (e *lineEncoder) Encode(m *protoMetricsV1.Metric) error {
+	fields := 0
+	for _, f := range m.SimpleFields {
+		if !math.IsNaN(f.Value) && !math.IsInf(f.Value, 0) {
+			fields++
+		}
+	}
+	if fields == 0 {
+		return nil
+	}
+	_, _ = e.w.WriteString(metricNameReplacer.Replace(m.Name))
+	tags := make([]*protoMetricsV1.KeyValue, len(m.Tags))
+	copy(tags, m.Tags)
+	sort.Slice(tags, func(i, j int) bool {
+		return tags[i].Key < tags[j].Key
+	})
+	for _, kv := range tags {
+		if kv.Key == "" || kv.Value == "" {
+			continue
+		}
+		_ = e.w.WriteByte(',')
+		_, _ = e.w.WriteString(tagReplacer.Replace(kv.Key))
+		_ = e.w.WriteByte('=')
+		_, _ = e.w.WriteString(tagReplacer.Replace(kv.Value))
+	}
+	sep := byte(' ')
+	for _, f := range m.SimpleFields {
+		if math.IsNaN(f.Value) || math.IsInf(f.Value, 0) {
+			continue
+		}
+		_ = e.w.WriteByte(sep)
+		sep = ','
+		_, _ = e.w.WriteString(tagReplacer.Replace(f.Name))
+		_ = e.w.WriteByte('=')
+		_, _ = e.w.WriteString(strconv.FormatFloat(f.Value, 'g', -1, 64))
+	}
+	_ = e.w.WriteByte(' ')
+	_, _ = e.w.WriteString(strconv.FormatInt(m.Timestamp, 10))
+	return e.w.WriteByte('\n')
+}
+
+// Flush flushes buffered lines into the underlying writer.
+func (e *lineEncoder) Flush() error {
+	return e.w.Flush()
+}
+
+// Close flushes buffered lines.
+func (e *lineEncoder) Close() error {
+	return e.Flush()
+}
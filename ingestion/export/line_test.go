@@ -0,0 +1,52 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package export
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	protoMetricsV1 "github.com/lindb/common/proto/gen/v1/linmetrics"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLineEncoder(t *testing.T) {
+	var buf bytes.Buffer
+	e, err := NewEncoder(FormatLine, &buf)
+	assert.NoError(t, err)
+	assert.NoError(t, e.Encode(newTestMetric("cpu,load", 1000)))
+	// ignore metric without valid field
+	assert.NoError(t, e.Encode(&protoMetricsV1.Metric{
+		Name: "mem",
+		SimpleFields: []*protoMetricsV1.SimpleField{
+			{Name: "used", Type: protoMetricsV1.SimpleFieldType_LAST, Value: math.NaN()},
+		},
+	}))
+	assert.NoError(t, e.Encode(&protoMetricsV1.Metric{
+		Name:      "disk",
+		Timestamp: 2000,
+		Tags:      []*protoMetricsV1.KeyValue{{Key: "path", Value: ""}},
+		SimpleFields: []*protoMetricsV1.SimpleField{
+			{Name: "free", Type: protoMetricsV1.SimpleFieldType_LAST, Value: math.Inf(1)},
+			{Name: "used", Type: protoMetricsV1.SimpleFieldType_LAST, Value: 0.5},
+		},
+	}))
+	assert.NoError(t, e.Close())
+	assert.Equal(t, "cpu\\,load,host=a\\ b,ip=1.1.1.1 count=10,max=2.5 1000\ndisk used=0.5 2000\n", buf.String())
+}
@@ -0,0 +1,74 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package export
+
+import (
+	"io"
+
+	protoMetricsV1 "github.com/lindb/common/proto/gen/v1/linmetrics"
+)
+
+// nativeEncoder encodes metrics as length-delimited protobuf records.
+type nativeEncoder struct {
+	w       io.Writer
+	metrics protoMetricsV1.MetricList
+	buf     []byte
+}
+
+// newNativeEncoder creates a native format encoder, writes the header first.
+func newNativeEncoder(w io.Writer) (Encoder, error) {
+	if err := WriteHeader(w); err != nil {
+		return nil, err
+	}
+	return &nativeEncoder{w: w}, nil
+}
+
+// Encode encodes the metric, flushes a record if batch is full.
+func (e *nativeEncoder) Encode(m *protoMetricsV1.Metric) error {
+	e.metrics.Metrics = append(e.metrics.Metrics, m)
+	if len(e.metrics.Metrics) >= defaultBatchSize {
+		return e.Flush()
+	}
+	return nil
+}
+
+// Flush writes pending metrics as a record.
+func (e *nativeEncoder) Flush() error {
+	if len(e.metrics.Metrics) == 0 {
+		return nil
+	}
+	size := e.metrics.Size()
+	if cap(e.buf) < size {
+		e.buf = make([]byte, size)
+	}
+	e.buf = e.buf[:size]
+	n, err := e.metrics.MarshalToSizedBuffer(e.buf)
+	if err != nil {
+		return err
+	}
+	e.metrics.Metrics = e.metrics.Metrics[:0]
+	return WriteRecord(e.w, e.buf[size-n:])
+}
+
+// Close flushes pending metrics, then writes the end marker.
+func (e *nativeEncoder) Close() error {
+	if err := e.Flush(); err != nil {
+		return err
+	}
+	return WriteRecord(e.w, nil)
+}
@@ -0,0 +1,116 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	protoMetricsV1 "github.com/lindb/common/proto/gen/v1/linmetrics"
+
+	ingestCommon "github.com/lindb/lindb/ingestion/common"
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/strutil"
+	"github.com/lindb/lindb/series/metric"
+	"github.com/lindb/lindb/series/tag"
+)
+
+var (
+	importIngestionStatistics = metrics.NewImportIngestionStatistics()
+)
+
+// Parse parses the native format data for importing, the body is a sequence of records,
+// header and end marker are optional(the importer sends a large file in chunks).
+// Each metric keeps its original namespace unless namespace is given.
+func Parse(req *http.Request, enrichedTags tag.Tags, namespace string, limits *models.Limits) (*metric.BrokerBatchRows, error) {
+	var reader = req.Body
+	if strings.EqualFold(req.Header.Get("Content-Encoding"), "gzip") {
+		gzipReader, err := ingestCommon.GetGzipReader(req.Body)
+		if err != nil {
+			importIngestionStatistics.CorruptedData.Incr()
+			return nil, fmt.Errorf("ingestion corrupted gzip data: %w", err)
+		}
+		defer ingestCommon.PutGzipReader(gzipReader)
+		reader = gzipReader
+	}
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	importIngestionStatistics.ReadBytes.Add(float64(len(data)))
+
+	batch, err := parseRecords(data, enrichedTags, namespace, limits)
+	if err != nil {
+		importIngestionStatistics.CorruptedData.Incr()
+		return nil, err
+	}
+	if batch.Len() == 0 {
+		return nil, fmt.Errorf("empty metrics")
+	}
+	importIngestionStatistics.IngestedMetrics.Add(float64(batch.Len()))
+	return batch, nil
+}
+
+// parseRecords parses all records of native format data.
+func parseRecords(
+	data []byte,
+	enrichedTags tag.Tags,
+	namespace string,
+	limits *models.Limits,
+) (
+	batch *metric.BrokerBatchRows, err error,
+) {
+	r := NewReader(bytes.NewReader(data))
+	if HasHeader(data) {
+		if err := r.ReadHeader(); err != nil {
+			return nil, err
+		}
+	}
+
+	batch = metric.NewBrokerBatchRows()
+	converter, releaseFunc := metric.NewBrokerRowProtoConverter(strutil.String2ByteSlice(namespace), enrichedTags, limits)
+	defer releaseFunc(converter)
+
+	var ms protoMetricsV1.MetricList
+	for {
+		payload, err := r.Next()
+		if err == io.EOF || err == ErrMissingEnd {
+			return batch, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		ms.Reset()
+		if err := ms.Unmarshal(payload); err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrBadRecord, err)
+		}
+		for _, m := range ms.Metrics {
+			m := m
+			if err := batch.TryAppend(func(row *metric.BrokerRow) error {
+				return converter.ConvertTo(m, row)
+			}); err != nil {
+				importIngestionStatistics.DroppedMetrics.Incr()
+			}
+		}
+	}
+}
@@ -0,0 +1,112 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package export
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/klauspost/compress/gzip"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/series/tag"
+)
+
+func encodeNative(t *testing.T, count int) []byte {
+	var buf bytes.Buffer
+	e, err := NewEncoder(FormatNative, &buf)
+	assert.NoError(t, err)
+	for i := 0; i < count; i++ {
+		assert.NoError(t, e.Encode(newTestMetric("cpu", int64(i+1)*1000)))
+	}
+	assert.NoError(t, e.Close())
+	return buf.Bytes()
+}
+
+func newImportRequest(t *testing.T, data []byte) *http.Request {
+	req, err := http.NewRequestWithContext(context.TODO(), http.MethodPut, "", bytes.NewReader(data))
+	assert.NoError(t, err)
+	return req
+}
+
+func TestParse(t *testing.T) {
+	data := encodeNative(t, 10)
+	// whole file
+	batch, err := Parse(newImportRequest(t, data), nil, "", models.NewDefaultLimits())
+	assert.NoError(t, err)
+	assert.Equal(t, 10, batch.Len())
+	m := batch.Rows()[0].Metric()
+	assert.Equal(t, "ns", string(m.Namespace()))
+	assert.Equal(t, int64(1000), m.Timestamp())
+
+	// records without header/end marker, with namespace and enriched tags
+	batch, err = Parse(newImportRequest(t, data[HeaderSize:len(data)-1]),
+		tag.Tags{tag.NewTag([]byte("region"), []byte("nj"))}, "other", models.NewDefaultLimits())
+	assert.NoError(t, err)
+	assert.Equal(t, 10, batch.Len())
+	m = batch.Rows()[0].Metric()
+	assert.Equal(t, "other", string(m.Namespace()))
+	assert.Equal(t, 3, m.KeyValuesLength())
+
+	// gzip
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	_, _ = w.Write(data)
+	_ = w.Close()
+	req := newImportRequest(t, buf.Bytes())
+	req.Header.Set("Content-Encoding", "gzip")
+	batch, err = Parse(req, nil, "", models.NewDefaultLimits())
+	assert.NoError(t, err)
+	assert.Equal(t, 10, batch.Len())
+}
+
+func TestParse_Error(t *testing.T) {
+	// bad gzip
+	req := newImportRequest(t, []byte("bad-data"))
+	req.Header.Set("Content-Encoding", "gzip")
+	_, err := Parse(req, nil, "", models.NewDefaultLimits())
+	assert.Error(t, err)
+	// empty
+	_, err = Parse(newImportRequest(t, encodeNative(t, 0)), nil, "", models.NewDefaultLimits())
+	assert.Error(t, err)
+	// bad header
+	_, err = Parse(newImportRequest(t, append([]byte("LINDBEXP"), 9)), nil, "", models.NewDefaultLimits())
+	assert.Error(t, err)
+	// bad record
+	var buf bytes.Buffer
+	assert.NoError(t, WriteRecord(&buf, []byte("bad-record")))
+	_, err = Parse(newImportRequest(t, buf.Bytes()), nil, "", models.NewDefaultLimits())
+	assert.Error(t, err)
+	// truncated record
+	_, err = Parse(newImportRequest(t, []byte{10, 1}), nil, "", models.NewDefaultLimits())
+	assert.Error(t, err)
+	// read body failure
+	req, err = http.NewRequestWithContext(context.TODO(), http.MethodPut, "", &errReader{})
+	assert.NoError(t, err)
+	_, err = Parse(req, nil, "", models.NewDefaultLimits())
+	assert.Error(t, err)
+}
+
+type errReader struct{}
+
+func (r *errReader) Read(_ []byte) (int, error) {
+	return 0, http.ErrBodyReadAfterClose
+}
@@ -0,0 +1,180 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package otlp
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	protoMetricsV1 "github.com/lindb/common/proto/gen/v1/linmetrics"
+)
+
+// defaultCumulativeStateTTL represents the ttl of cumulative histogram state,
+// state of series which is not reported within ttl will be evicted.
+const defaultCumulativeStateTTL = time.Hour
+
+// cumulativeHistograms keeps the latest cumulative histogram state of each series.
+var cumulativeHistograms = newDeltaConverter(defaultCumulativeStateTTL)
+
+// cumulativeState represents the latest cumulative histogram of series.
+type cumulativeState struct {
+	startTime uint64
+	bounds    []float64
+	values    []float64
+	count     float64
+	sum       float64
+	lastSeen  time.Time
+}
+
+// deltaConverter converts cumulative histogram into delta histogram per series,
+// because buckets of histogram are accumulated when writing.
+// NOTE: state is kept in memory of current broker, so series should be sent to the same broker.
+type deltaConverter struct {
+	ttl       time.Duration
+	states    map[string]*cumulativeState
+	lastSweep time.Time
+	mutex     sync.Mutex
+}
+
+// newDeltaConverter creates a cumulative => delta histogram converter.
+func newDeltaConverter(ttl time.Duration) *deltaConverter {
+	return &deltaConverter{
+		ttl:       ttl,
+		states:    make(map[string]*cumulativeState),
+		lastSweep: time.Now(),
+	}
+}
+
+// toDelta returns the delta histogram between current cumulative histogram and previous one of series,
+// returns false if no delta can be computed:
+// 1. first data point of series without start time(unknown accumulated interval);
+// 2. no new observations.
+// If series is reset(start time changed or count decreased), current cumulative histogram is the delta.
+// If bounds of histogram changed(e.g. exponential buckets expanded), previous buckets are aligned by bound,
+// if they cannot be aligned, baseline is reset and current cumulative histogram is emitted as the first sample.
+func (c *deltaConverter) toDelta(
+	key string,
+	startTime uint64,
+	bounds, values []float64,
+	count, sum float64,
+) (deltaValues []float64, deltaCount, deltaSum float64, ok bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	now := time.Now()
+	c.sweep(now)
+
+	prev, exist := c.states[key]
+	c.states[key] = &cumulativeState{
+		startTime: startTime,
+		bounds:    append([]float64(nil), bounds...),
+		values:    append([]float64(nil), values...),
+		count:     count,
+		sum:       sum,
+		lastSeen:  now,
+	}
+	if !exist {
+		// start time known, histogram is accumulated since start time
+		return values, count, sum, startTime > 0 && count > 0
+	}
+	if prev.startTime != startTime || count < prev.count {
+		// series reset, histogram is accumulated since reset
+		return values, count, sum, count > 0
+	}
+	prevValues := prev.values
+	if !equalBounds(prev.bounds, bounds) {
+		prevValues, ok = alignBuckets(prev.bounds, prev.values, bounds)
+		if !ok {
+			// baseline is reset by current histogram
+			return values, count, sum, count > 0
+		}
+	}
+	deltaValues = make([]float64, len(values))
+	for i := range values {
+		deltaValues[i] = values[i] - prevValues[i]
+		if deltaValues[i] < 0 {
+			// bucket count decreased, treat it as reset
+			return values, count, sum, count > 0
+		}
+	}
+	deltaCount = count - prev.count
+	return deltaValues, deltaCount, sum - prev.sum, deltaCount > 0
+}
+
+// sweep evicts expired state of series, sweeps at most once within ttl.
+func (c *deltaConverter) sweep(now time.Time) {
+	if now.Sub(c.lastSweep) < c.ttl {
+		return
+	}
+	c.lastSweep = now
+	for key, state := range c.states {
+		if now.Sub(state.lastSeen) >= c.ttl {
+			delete(c.states, key)
+		}
+	}
+}
+
+// seriesKey returns the unique key of series based on namespace/metric name/sorted tags.
+func seriesKey(namespace, name string, tags []*protoMetricsV1.KeyValue) string {
+	var sb strings.Builder
+	sb.WriteString(namespace)
+	sb.WriteByte(0)
+	sb.WriteString(name)
+	for _, kv := range tags {
+		sb.WriteByte(0)
+		sb.WriteString(kv.Key)
+		sb.WriteByte('=')
+		sb.WriteString(kv.Value)
+	}
+	return sb.String()
+}
+
+// alignBuckets aligns the bucket values of previous histogram into current bounds by bound,
+// returns false if any non-empty bucket of previous histogram does not exist in current bounds.
+func alignBuckets(prevBounds, prevValues, bounds []float64) ([]float64, bool) {
+	indexes := make(map[float64]int, len(bounds))
+	for idx, bound := range bounds {
+		indexes[bound] = idx
+	}
+	values := make([]float64, len(bounds))
+	for idx, bound := range prevBounds {
+		if prevValues[idx] == 0 {
+			continue
+		}
+		i, ok := indexes[bound]
+		if !ok {
+			return nil, false
+		}
+		values[i] = prevValues[idx]
+	}
+	return values, true
+}
+
+// equalBounds returns if two histograms have same bounds.
+func equalBounds(a, b []float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,91 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package otlp
+
+import (
+	"testing"
+	"time"
+
+	protoMetricsV1 "github.com/lindb/common/proto/gen/v1/linmetrics"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeltaConverter_toDelta(t *testing.T) {
+	c := newDeltaConverter(time.Hour)
+	bounds := []float64{5, 10, 20}
+	// first data point without start time
+	_, _, _, ok := c.toDelta("a", 0, bounds, []float64{1, 1, 1}, 3, 30)
+	assert.False(t, ok)
+	values, count, sum, ok := c.toDelta("a", 0, bounds, []float64{1, 2, 3}, 6, 50)
+	assert.True(t, ok)
+	assert.Equal(t, []float64{0, 1, 2}, values)
+	assert.Equal(t, 3.0, count)
+	assert.Equal(t, 20.0, sum)
+	// no new observations
+	_, _, _, ok = c.toDelta("a", 0, bounds, []float64{1, 2, 3}, 6, 50)
+	assert.False(t, ok)
+	// count decreased, series reset
+	values, count, _, ok = c.toDelta("a", 0, bounds, []float64{1, 0, 0}, 1, 2)
+	assert.True(t, ok)
+	assert.Equal(t, []float64{1, 0, 0}, values)
+	assert.Equal(t, 1.0, count)
+	// bucket decreased, series reset
+	values, _, _, ok = c.toDelta("a", 0, bounds, []float64{0, 2, 0}, 2, 12)
+	assert.True(t, ok)
+	assert.Equal(t, []float64{0, 2, 0}, values)
+	// start time changed, series reset
+	values, _, _, ok = c.toDelta("a", 10, bounds, []float64{0, 1, 0}, 1, 6)
+	assert.True(t, ok)
+	assert.Equal(t, []float64{0, 1, 0}, values)
+	// bounds expanded, previous buckets aligned by bound
+	values, count, _, ok = c.toDelta("a", 10, []float64{2, 5, 10, 20}, []float64{1, 0, 2, 0}, 3, 10)
+	assert.True(t, ok)
+	assert.Equal(t, []float64{1, 0, 1, 0}, values)
+	assert.Equal(t, 2.0, count)
+	// bounds changed, cannot be aligned, baseline reset
+	values, count, _, ok = c.toDelta("a", 10, []float64{5, 15}, []float64{1, 3}, 4, 20)
+	assert.True(t, ok)
+	assert.Equal(t, []float64{1, 3}, values)
+	assert.Equal(t, 4.0, count)
+	// delta based on new baseline
+	values, _, _, ok = c.toDelta("a", 10, []float64{5, 15}, []float64{2, 3}, 5, 22)
+	assert.True(t, ok)
+	assert.Equal(t, []float64{1, 0}, values)
+	// first data point with start time
+	values, _, _, ok = c.toDelta("b", 10, bounds, []float64{0, 1, 0}, 1, 6)
+	assert.True(t, ok)
+	assert.Equal(t, []float64{0, 1, 0}, values)
+}
+
+func TestDeltaConverter_sweep(t *testing.T) {
+	c := newDeltaConverter(time.Hour)
+	_, _, _, _ = c.toDelta("a", 0, []float64{1}, []float64{1}, 1, 1)
+	c.states["a"].lastSeen = time.Now().Add(-2 * time.Hour)
+	c.sweep(time.Now())
+	assert.Len(t, c.states, 1)
+	c.lastSweep = time.Now().Add(-2 * time.Hour)
+	c.sweep(time.Now())
+	assert.Empty(t, c.states)
+}
+
+func Test_seriesKey(t *testing.T) {
+	tags := []*protoMetricsV1.KeyValue{{Key: "host", Value: "h1"}}
+	assert.Equal(t, seriesKey("ns", "cpu", tags), seriesKey("ns", "cpu", tags))
+	assert.NotEqual(t, seriesKey("ns", "cpu", tags), seriesKey("ns", "cpu", nil))
+	assert.NotEqual(t, seriesKey("ns", "cpu", tags), seriesKey("ns2", "cpu", tags))
+}
@@ -0,0 +1,386 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package otlp
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/go-http-utils/headers"
+	"github.com/lindb/common/pkg/timeutil"
+	protoMetricsV1 "github.com/lindb/common/proto/gen/v1/linmetrics"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+
+	"github.com/lindb/lindb/constants"
+	ingestCommon "github.com/lindb/lindb/ingestion/common"
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/strutil"
+	"github.com/lindb/lindb/series/histogram"
+	"github.com/lindb/lindb/series/metric"
+	"github.com/lindb/lindb/series/tag"
+)
+
+// ValueFieldName represents the field name of gauge/sum data point.
+const ValueFieldName = "value"
+
+const (
+	// legacyExponentialSchema represents the schema which exponential buckets are re-bucketed into
+	// if native histogram is disabled, bounds of coarser schemas are subset of its bounds,
+	// so that the number of __bucket_${boundary} fields is bounded.
+	legacyExponentialSchema int32 = 0
+	// maxLegacyExponentialBuckets represents the max number of exponential buckets if native histogram is disabled.
+	maxLegacyExponentialBuckets = 64
+)
+
+var (
+	otlpIngestionStatistics = metrics.NewOTLPIngestionStatistics()
+)
+
+// IsJSON returns if the content type of OTLP/HTTP request is json, else is proto buffer.
+func IsJSON(req *http.Request) bool {
+	contentType := strings.ToLower(strings.TrimSpace(req.Header.Get(headers.ContentType)))
+	return strings.HasPrefix(contentType, constants.ContentTypeJSON)
+}
+
+// Parse parses OTLP/HTTP metrics request(proto buffer/json), converts data points into broker rows,
+// returns the number of data points which are rejected because of unsupported type/bad data.
+// If native histogram is disabled, exponential histogram is re-bucketed into fixed exponential layout.
+func Parse(req *http.Request, enrichedTags tag.Tags, namespace string, limits *models.Limits, nativeHistogram bool) (
+	batch *metric.BrokerBatchRows, rejected int64, err error,
+) {
+	var reader = req.Body
+	if strings.EqualFold(req.Header.Get("Content-Encoding"), "gzip") {
+		gzipReader, err := ingestCommon.GetGzipReader(req.Body)
+		if err != nil {
+			otlpIngestionStatistics.CorruptedData.Incr()
+			return nil, 0, fmt.Errorf("ingestion corrupted gzip data: %w", err)
+		}
+		defer ingestCommon.PutGzipReader(gzipReader)
+		reader = gzipReader
+	}
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, 0, err
+	}
+	otlpIngestionStatistics.ReadBytes.Add(float64(len(data)))
+
+	request := pmetricotlp.NewExportRequest()
+	if IsJSON(req) {
+		err = request.UnmarshalJSON(data)
+	} else {
+		err = request.UnmarshalProto(data)
+	}
+	if err != nil {
+		otlpIngestionStatistics.CorruptedData.Incr()
+		return nil, 0, err
+	}
+	batch, rejected = parseOTLPMetrics(request.Metrics(), enrichedTags, namespace, limits, nativeHistogram)
+	if batch.Len() == 0 && rejected == 0 {
+		return nil, 0, fmt.Errorf("empty metrics")
+	}
+	otlpIngestionStatistics.IngestedMetrics.Add(float64(batch.Len()))
+	otlpIngestionStatistics.RejectedDataPoints.Add(float64(rejected))
+	return batch, rejected, nil
+}
+
+// parseOTLPMetrics converts OTLP metrics into broker rows.
+func parseOTLPMetrics(
+	md pmetric.Metrics,
+	enrichedTags tag.Tags,
+	namespace string,
+	limits *models.Limits,
+	nativeHistogram bool,
+) (
+	batch *metric.BrokerBatchRows, rejected int64,
+) {
+	converter, releaseFunc := metric.NewBrokerRowProtoConverter(strutil.String2ByteSlice(namespace), enrichedTags, limits)
+	defer releaseFunc(converter)
+
+	p := &metricParser{
+		batch:           metric.NewBrokerBatchRows(),
+		converter:       converter,
+		namespace:       namespace,
+		nativeHistogram: nativeHistogram,
+		deltas:          cumulativeHistograms,
+	}
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+		p.resourceAttrs = rm.Resource().Attributes()
+		sms := rm.ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			ms := sms.At(j).Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				p.parseMetric(ms.At(k))
+			}
+		}
+	}
+	return p.batch, p.rejected
+}
+
+// metricParser converts OTLP metric's data points into broker rows,
+// one data point => one broker row, resource attributes => tags.
+type metricParser struct {
+	batch         *metric.BrokerBatchRows
+	converter     *metric.BrokerRowProtoConverter
+	resourceAttrs pcommon.Map
+	namespace     string
+	// nativeHistogram represents if histogram is stored as native histogram in database.
+	nativeHistogram bool
+	deltas          *deltaConverter
+	rejected        int64
+}
+
+// parseMetric converts data points of metric based on metric type.
+func (p *metricParser) parseMetric(m pmetric.Metric) {
+	switch m.Type() {
+	case pmetric.MetricTypeGauge:
+		p.parseNumberDataPoints(m.Name(), m.Gauge().DataPoints(), protoMetricsV1.SimpleFieldType_LAST)
+	case pmetric.MetricTypeSum:
+		sum := m.Sum()
+		// cumulative sum keeps the latest value(like prometheus counter), delta sum is accumulated.
+		fieldType := protoMetricsV1.SimpleFieldType_LAST
+		if sum.AggregationTemporality() == pmetric.AggregationTemporalityDelta {
+			fieldType = protoMetricsV1.SimpleFieldType_DELTA_SUM
+		}
+		p.parseNumberDataPoints(m.Name(), sum.DataPoints(), fieldType)
+	case pmetric.MetricTypeHistogram:
+		h := m.Histogram()
+		// buckets of histogram are accumulated when writing, cumulative histogram is converted into delta
+		cumulative := h.AggregationTemporality() != pmetric.AggregationTemporalityDelta
+		p.parseHistogramDataPoints(m.Name(), h.DataPoints(), cumulative)
+	case pmetric.MetricTypeExponentialHistogram:
+		h := m.ExponentialHistogram()
+		cumulative := h.AggregationTemporality() != pmetric.AggregationTemporalityDelta
+		p.parseExponentialHistogramDataPoints(m.Name(), h.DataPoints(), cumulative)
+	case pmetric.MetricTypeSummary:
+		p.rejected += int64(m.Summary().DataPoints().Len())
+	}
+}
+
+// parseNumberDataPoints converts gauge/sum data points into simple field.
+func (p *metricParser) parseNumberDataPoints(name string, dps pmetric.NumberDataPointSlice, fieldType protoMetricsV1.SimpleFieldType) {
+	for idx := 0; idx < dps.Len(); idx++ {
+		dp := dps.At(idx)
+		if dp.Flags().NoRecordedValue() {
+			continue
+		}
+		var value float64
+		switch dp.ValueType() {
+		case pmetric.NumberDataPointValueTypeInt:
+			value = float64(dp.IntValue())
+		case pmetric.NumberDataPointValueTypeDouble:
+			value = dp.DoubleValue()
+		default:
+			p.rejected++
+			continue
+		}
+		p.append(&protoMetricsV1.Metric{
+			Name:      name,
+			Timestamp: timestamp(dp.Timestamp()),
+			Tags:      p.tags(dp.Attributes()),
+			SimpleFields: []*protoMetricsV1.SimpleField{
+				{Name: ValueFieldName, Type: fieldType, Value: value},
+			},
+		})
+	}
+}
+
+// parseHistogramDataPoints converts explicit bounds histogram data points into compound field.
+func (p *metricParser) parseHistogramDataPoints(name string, dps pmetric.HistogramDataPointSlice, cumulative bool) {
+	for idx := 0; idx < dps.Len(); idx++ {
+		dp := dps.At(idx)
+		if dp.Flags().NoRecordedValue() {
+			continue
+		}
+		counts := dp.BucketCounts()
+		bounds := dp.ExplicitBounds()
+		if counts.Len() == 0 || counts.Len() != bounds.Len()+1 {
+			p.rejected++
+			continue
+		}
+		explicitBounds := make([]float64, 0, counts.Len())
+		values := make([]float64, 0, counts.Len())
+		for i := 0; i < bounds.Len(); i++ {
+			explicitBounds = append(explicitBounds, bounds.At(i))
+		}
+		explicitBounds = append(explicitBounds, math.Inf(1))
+		for i := 0; i < counts.Len(); i++ {
+			values = append(values, float64(counts.At(i)))
+		}
+		compoundField := newCompoundField(explicitBounds, values, float64(dp.Count()))
+		if dp.HasSum() {
+			compoundField.Sum = dp.Sum()
+		}
+		if dp.HasMin() {
+			compoundField.Min = dp.Min()
+		}
+		if dp.HasMax() {
+			compoundField.Max = dp.Max()
+		}
+		p.appendHistogram(name, dp.Attributes(), dp.StartTimestamp(), dp.Timestamp(), compoundField, cumulative)
+	}
+}
+
+// parseExponentialHistogramDataPoints converts exponential histogram data points into compound field,
+// positive buckets are converted into contiguous exponential bounds, which can be restored as native histogram.
+func (p *metricParser) parseExponentialHistogramDataPoints(
+	name string,
+	dps pmetric.ExponentialHistogramDataPointSlice,
+	cumulative bool,
+) {
+	for idx := 0; idx < dps.Len(); idx++ {
+		dp := dps.At(idx)
+		if dp.Flags().NoRecordedValue() {
+			continue
+		}
+		if hasObservations(dp.Negative().BucketCounts()) {
+			// negative observations cannot be stored
+			p.rejected++
+			continue
+		}
+		positive := dp.Positive()
+		counts := make([]float64, positive.BucketCounts().Len())
+		for i := range counts {
+			counts[i] = float64(positive.BucketCounts().At(i))
+		}
+		scale, offset := dp.Scale(), positive.Offset()
+		if !p.nativeHistogram {
+			// each bound is written as one field, re-buckets into fixed layout with limited buckets
+			scale, offset, counts = histogram.DownscaleExponential(scale, offset, counts, legacyExponentialSchema)
+			for len(counts) > maxLegacyExponentialBuckets && scale > histogram.MinSchema {
+				scale, offset, counts = histogram.DownscaleExponential(scale, offset, counts, scale-1)
+			}
+		}
+		bounds, values := histogram.ExponentialBounds(scale, offset, float64(dp.ZeroCount()), counts)
+		compoundField := newCompoundField(bounds, values, float64(dp.Count()))
+		if dp.HasSum() {
+			compoundField.Sum = dp.Sum()
+		}
+		if dp.HasMin() {
+			compoundField.Min = dp.Min()
+		}
+		if dp.HasMax() {
+			compoundField.Max = dp.Max()
+		}
+		p.appendHistogram(name, dp.Attributes(), dp.StartTimestamp(), dp.Timestamp(), compoundField, cumulative)
+	}
+}
+
+// appendHistogram appends histogram data point, converts cumulative histogram into delta histogram,
+// min/max of cumulative histogram are ignored because they are accumulated since start time.
+func (p *metricParser) appendHistogram(
+	name string,
+	attrs pcommon.Map,
+	startTime, ts pcommon.Timestamp,
+	compoundField *protoMetricsV1.CompoundField,
+	cumulative bool,
+) {
+	tags := p.tags(attrs)
+	if cumulative {
+		key := seriesKey(p.namespace, name, tags)
+		values, count, sum, ok := p.deltas.toDelta(key, uint64(startTime),
+			compoundField.ExplicitBounds, compoundField.Values, compoundField.Count, compoundField.Sum)
+		if !ok {
+			return
+		}
+		compoundField.Values = values
+		compoundField.Count = count
+		compoundField.Sum = sum
+		compoundField.Min = 0
+		compoundField.Max = 0
+	}
+	p.append(&protoMetricsV1.Metric{
+		Name:          name,
+		Timestamp:     timestamp(ts),
+		Tags:          tags,
+		CompoundField: compoundField,
+	})
+}
+
+// append converts metric into broker row, then appends it into batch.
+func (p *metricParser) append(m *protoMetricsV1.Metric) {
+	if err := p.batch.TryAppend(func(row *metric.BrokerRow) error {
+		return p.converter.ConvertTo(m, row)
+	}); err != nil {
+		otlpIngestionStatistics.DroppedMetrics.Incr()
+	}
+}
+
+// tags returns the tags of data point, merges resource attributes and data point attributes,
+// attribute of data point overrides resource attribute with same key, empty value is ignored.
+func (p *metricParser) tags(attrs pcommon.Map) []*protoMetricsV1.KeyValue {
+	kvs := make(map[string]string, p.resourceAttrs.Len()+attrs.Len())
+	collect := func(k string, v pcommon.Value) bool {
+		if value := v.AsString(); value != "" {
+			kvs[k] = value
+		}
+		return true
+	}
+	p.resourceAttrs.Range(collect)
+	attrs.Range(collect)
+
+	tags := make([]*protoMetricsV1.KeyValue, 0, len(kvs))
+	for k, v := range kvs {
+		tags = append(tags, &protoMetricsV1.KeyValue{Key: k, Value: v})
+	}
+	sort.Slice(tags, func(i, j int) bool {
+		return tags[i].Key < tags[j].Key
+	})
+	return tags
+}
+
+// newCompoundField creates compound field with bounds/values, compound field needs at least 3 buckets,
+// so pads empty buckets(bound=0) before the first bucket if not enough.
+func newCompoundField(bounds, values []float64, count float64) *protoMetricsV1.CompoundField {
+	for len(bounds) <= 2 {
+		bounds = append([]float64{0}, bounds...)
+		values = append([]float64{0}, values...)
+	}
+	return &protoMetricsV1.CompoundField{
+		Count:          count,
+		ExplicitBounds: bounds,
+		Values:         values,
+	}
+}
+
+// hasObservations returns if any bucket count > 0.
+func hasObservations(counts pcommon.UInt64Slice) bool {
+	for i := 0; i < counts.Len(); i++ {
+		if counts.At(i) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// timestamp converts the timestamp of data point(nanoseconds) into milliseconds, uses now if not set.
+func timestamp(ts pcommon.Timestamp) int64 {
+	if ts == 0 {
+		return timeutil.Now()
+	}
+	return ts.AsTime().UnixMilli()
+}
@@ -0,0 +1,399 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package otlp
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-http-utils/headers"
+	"github.com/klauspost/compress/gzip"
+	"github.com/lindb/common/proto/gen/v1/flatMetricsV1"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pmetric/pmetricotlp"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/series/metric"
+	"github.com/lindb/lindb/series/tag"
+)
+
+var testTimestamp = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func newTestMetrics() (pmetric.Metrics, pmetric.MetricSlice) {
+	md := pmetric.NewMetrics()
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr("service.name", "checkout")
+	rm.Resource().Attributes().PutStr("host", "host-1")
+	rm.Resource().Attributes().PutStr("empty", "")
+	return md, rm.ScopeMetrics().AppendEmpty().Metrics()
+}
+
+func newTestGauge(ms pmetric.MetricSlice) {
+	m := ms.AppendEmpty()
+	m.SetName("cpu")
+	dp := m.SetEmptyGauge().DataPoints().AppendEmpty()
+	dp.SetTimestamp(pcommon.NewTimestampFromTime(testTimestamp))
+	dp.SetDoubleValue(10.5)
+	dp.Attributes().PutStr("host", "host-2")
+	dp.Attributes().PutStr("core", "0")
+}
+
+func marshalRequest(t *testing.T, md pmetric.Metrics, json bool) []byte {
+	request := pmetricotlp.NewExportRequestFromMetrics(md)
+	var (
+		data []byte
+		err  error
+	)
+	if json {
+		data, err = request.MarshalJSON()
+	} else {
+		data, err = request.MarshalProto()
+	}
+	assert.NoError(t, err)
+	return data
+}
+
+func newRequest(data []byte, contentType string) *http.Request {
+	req, _ := http.NewRequestWithContext(context.TODO(), http.MethodPost, "", bytes.NewReader(data))
+	req.Header.Set(headers.ContentType, contentType)
+	return req
+}
+
+func Test_Parse(t *testing.T) {
+	md, ms := newTestMetrics()
+	newTestGauge(ms)
+
+	enrichedTags := []tag.Tag{
+		tag.NewTag([]byte("region"), []byte("nj")),
+	}
+	for _, json := range []bool{false, true} {
+		contentType := constants.ContentTypeOTLPProto
+		if json {
+			contentType = constants.ContentTypeJSON
+		}
+		req := newRequest(marshalRequest(t, md, json), contentType)
+		batch, rejected, err := Parse(req, enrichedTags, "ns", models.NewDefaultLimits(), false)
+		assert.NoError(t, err)
+		assert.Zero(t, rejected)
+		assert.Equal(t, 1, batch.Len())
+		m := batch.Rows()[0].Metric()
+		assert.Equal(t, "ns", string(m.Namespace()))
+		assert.Equal(t, "cpu", string(m.Name()))
+		assert.Equal(t, testTimestamp.UnixMilli(), m.Timestamp())
+		assert.Equal(t, map[string]string{
+			"core":         "0",
+			"host":         "host-2",
+			"region":       "nj",
+			"service.name": "checkout",
+		}, tagsOf(&m))
+		var f flatMetricsV1.SimpleField
+		assert.True(t, m.SimpleFields(&f, 0))
+		assert.Equal(t, ValueFieldName, string(f.Name()))
+		assert.Equal(t, flatMetricsV1.SimpleFieldTypeLast, f.Type())
+		assert.Equal(t, 10.5, f.Value())
+	}
+}
+
+func Test_Parse_gzip(t *testing.T) {
+	md, ms := newTestMetrics()
+	newTestGauge(ms)
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	_, _ = writer.Write(marshalRequest(t, md, false))
+	_ = writer.Close()
+
+	req := newRequest(buf.Bytes(), constants.ContentTypeOTLPProto)
+	req.Header.Set("Content-Encoding", "gzip")
+	batch, _, err := Parse(req, nil, "ns", models.NewDefaultLimits(), false)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, batch.Len())
+
+	// bad gzip data
+	req = newRequest([]byte("bad-data"), constants.ContentTypeOTLPProto)
+	req.Header.Set("Content-Encoding", "gzip")
+	_, _, err = Parse(req, nil, "ns", models.NewDefaultLimits(), false)
+	assert.Error(t, err)
+}
+
+func Test_Parse_error(t *testing.T) {
+	// bad proto data
+	req := newRequest([]byte("bad-data"), constants.ContentTypeOTLPProto)
+	_, _, err := Parse(req, nil, "ns", models.NewDefaultLimits(), false)
+	assert.Error(t, err)
+	// bad json data
+	req = newRequest([]byte("bad-data"), constants.ContentTypeJSON)
+	_, _, err = Parse(req, nil, "ns", models.NewDefaultLimits(), false)
+	assert.Error(t, err)
+	// empty metrics
+	req = newRequest(marshalRequest(t, pmetric.NewMetrics(), false), constants.ContentTypeOTLPProto)
+	_, _, err = Parse(req, nil, "ns", models.NewDefaultLimits(), false)
+	assert.Error(t, err)
+	// read body failure
+	req, _ = http.NewRequestWithContext(context.TODO(), http.MethodPost, "", &badReader{})
+	_, _, err = Parse(req, nil, "ns", models.NewDefaultLimits(), false)
+	assert.Error(t, err)
+}
+
+func Test_parseOTLPMetrics_sum(t *testing.T) {
+	md, ms := newTestMetrics()
+	delta := ms.AppendEmpty()
+	delta.SetName("requests")
+	deltaSum := delta.SetEmptySum()
+	deltaSum.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	dp := deltaSum.DataPoints().AppendEmpty()
+	dp.SetIntValue(5)
+	cumulative := ms.AppendEmpty()
+	cumulative.SetName("requests_total")
+	cumulativeSum := cumulative.SetEmptySum()
+	cumulativeSum.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	cumulativeSum.SetIsMonotonic(true)
+	cumulativeSum.DataPoints().AppendEmpty().SetDoubleValue(100)
+	// no recorded value, ignore it
+	cumulativeSum.DataPoints().AppendEmpty().SetFlags(pmetric.DefaultDataPointFlags.WithNoRecordedValue(true))
+	// empty value
+	cumulativeSum.DataPoints().AppendEmpty()
+
+	batch, rejected := parseOTLPMetrics(md, nil, "ns", models.NewDefaultLimits(), false)
+	assert.Equal(t, int64(1), rejected)
+	assert.Equal(t, 2, batch.Len())
+	var f flatMetricsV1.SimpleField
+	m := batch.Rows()[0].Metric()
+	assert.True(t, m.SimpleFields(&f, 0))
+	assert.Equal(t, flatMetricsV1.SimpleFieldTypeDeltaSum, f.Type())
+	assert.Equal(t, 5.0, f.Value())
+	m = batch.Rows()[1].Metric()
+	assert.True(t, m.SimpleFields(&f, 0))
+	assert.Equal(t, flatMetricsV1.SimpleFieldTypeLast, f.Type())
+	assert.Equal(t, 100.0, f.Value())
+}
+
+func Test_parseOTLPMetrics_histogram(t *testing.T) {
+	md, ms := newTestMetrics()
+	m := ms.AppendEmpty()
+	m.SetName("latency")
+	h := m.SetEmptyHistogram()
+	h.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	dp := h.DataPoints().AppendEmpty()
+	dp.SetCount(6)
+	dp.SetSum(60)
+	dp.SetMin(1)
+	dp.SetMax(30)
+	dp.ExplicitBounds().FromRaw([]float64{5, 10})
+	dp.BucketCounts().FromRaw([]uint64{1, 2, 3})
+	// only one bucket, pads empty buckets
+	dp = h.DataPoints().AppendEmpty()
+	dp.SetCount(2)
+	dp.BucketCounts().FromRaw([]uint64{2})
+	// bad bucket counts
+	dp = h.DataPoints().AppendEmpty()
+	dp.ExplicitBounds().FromRaw([]float64{5, 10})
+	dp.BucketCounts().FromRaw([]uint64{1})
+	// no recorded value
+	h.DataPoints().AppendEmpty().SetFlags(pmetric.DefaultDataPointFlags.WithNoRecordedValue(true))
+
+	cumulative := ms.AppendEmpty()
+	cumulative.SetName("latency_cumulative")
+	ch := cumulative.SetEmptyHistogram()
+	ch.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	// first data point without start time, only keeps state
+	dp = ch.DataPoints().AppendEmpty()
+	dp.SetCount(3)
+	dp.SetSum(30)
+	dp.ExplicitBounds().FromRaw([]float64{5, 10})
+	dp.BucketCounts().FromRaw([]uint64{1, 1, 1})
+	dp = ch.DataPoints().AppendEmpty()
+	dp.SetCount(5)
+	dp.SetSum(45)
+	dp.SetMax(20)
+	dp.ExplicitBounds().FromRaw([]float64{5, 10})
+	dp.BucketCounts().FromRaw([]uint64{1, 2, 2})
+
+	defer func() {
+		cumulativeHistograms = newDeltaConverter(defaultCumulativeStateTTL)
+	}()
+	batch, rejected := parseOTLPMetrics(md, nil, "ns", models.NewDefaultLimits(), false)
+	assert.Equal(t, int64(1), rejected)
+	assert.Equal(t, 3, batch.Len())
+	cf := compoundFieldOf(batch.Rows()[0])
+	assert.Equal(t, 6.0, cf.Count())
+	assert.Equal(t, 60.0, cf.Sum())
+	assert.Equal(t, 1.0, cf.Min())
+	assert.Equal(t, 30.0, cf.Max())
+	assert.Equal(t, []float64{5, 10, math.Inf(1)}, explicitBoundsOf(cf))
+	assert.Equal(t, []float64{1, 2, 3}, valuesOf(cf))
+	cf = compoundFieldOf(batch.Rows()[1])
+	assert.Equal(t, []float64{0, 0, math.Inf(1)}, explicitBoundsOf(cf))
+	assert.Equal(t, []float64{0, 0, 2}, valuesOf(cf))
+	cf = compoundFieldOf(batch.Rows()[2])
+	assert.Equal(t, 2.0, cf.Count())
+	assert.Equal(t, 15.0, cf.Sum())
+	assert.Zero(t, cf.Max())
+	assert.Equal(t, []float64{0, 1, 1}, valuesOf(cf))
+}
+
+func Test_parseOTLPMetrics_exponentialHistogram(t *testing.T) {
+	md, ms := newTestMetrics()
+	m := ms.AppendEmpty()
+	m.SetName("latency")
+	h := m.SetEmptyExponentialHistogram()
+	h.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	dp := h.DataPoints().AppendEmpty()
+	dp.SetScale(0)
+	dp.SetCount(7)
+	dp.SetSum(20)
+	dp.SetZeroCount(1)
+	dp.Positive().SetOffset(1)
+	dp.Positive().BucketCounts().FromRaw([]uint64{2, 4})
+	// only zero bucket
+	dp = h.DataPoints().AppendEmpty()
+	dp.SetCount(1)
+	dp.SetZeroCount(1)
+	// negative observations
+	dp = h.DataPoints().AppendEmpty()
+	dp.Negative().BucketCounts().FromRaw([]uint64{1})
+
+	cumulative := ms.AppendEmpty()
+	cumulative.SetName("latency_cumulative")
+	ch := cumulative.SetEmptyExponentialHistogram()
+	ch.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	// start time known, accumulated since start time
+	dp = ch.DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(pcommon.NewTimestampFromTime(testTimestamp))
+	dp.SetCount(2)
+	dp.SetZeroCount(2)
+	summary := ms.AppendEmpty()
+	summary.SetName("summary")
+	summary.SetEmptySummary().DataPoints().AppendEmpty()
+
+	defer func() {
+		cumulativeHistograms = newDeltaConverter(defaultCumulativeStateTTL)
+	}()
+	batch, rejected := parseOTLPMetrics(md, nil, "ns", models.NewDefaultLimits(), false)
+	assert.Equal(t, int64(2), rejected)
+	assert.Equal(t, 3, batch.Len())
+	cf := compoundFieldOf(batch.Rows()[0])
+	assert.Equal(t, 7.0, cf.Count())
+	assert.Equal(t, 20.0, cf.Sum())
+	// scale 0, offset 1 => buckets: (2,4],(4,8]
+	assert.Equal(t, []float64{0, 4, 8, math.Inf(1)}, explicitBoundsOf(cf))
+	assert.Equal(t, []float64{1, 2, 4, 0}, valuesOf(cf))
+	cf = compoundFieldOf(batch.Rows()[1])
+	assert.Equal(t, []float64{0, 0, math.Inf(1)}, explicitBoundsOf(cf))
+	assert.Equal(t, []float64{0, 1, 0}, valuesOf(cf))
+	cf = compoundFieldOf(batch.Rows()[2])
+	assert.Equal(t, 2.0, cf.Count())
+	assert.Equal(t, []float64{0, 2, 0}, valuesOf(cf))
+}
+
+func Test_parseOTLPMetrics_exponentialHistogram_rebucket(t *testing.T) {
+	md, ms := newTestMetrics()
+	m := ms.AppendEmpty()
+	m.SetName("latency")
+	h := m.SetEmptyExponentialHistogram()
+	h.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	dp := h.DataPoints().AppendEmpty()
+	dp.SetScale(1)
+	dp.SetCount(10)
+	dp.Positive().SetOffset(0)
+	dp.Positive().BucketCounts().FromRaw([]uint64{1, 2, 3, 4})
+	wide := h.DataPoints().AppendEmpty()
+	wide.SetScale(0)
+	wide.SetCount(200)
+	wide.Positive().SetOffset(-100)
+	counts := make([]uint64, 200)
+	for i := range counts {
+		counts[i] = 1
+	}
+	wide.Positive().BucketCounts().FromRaw(counts)
+
+	// native histogram keeps original buckets
+	batch, _ := parseOTLPMetrics(md, nil, "ns", models.NewDefaultLimits(), true)
+	assert.Equal(t, 2, batch.Len())
+	cf := compoundFieldOf(batch.Rows()[0])
+	assert.Len(t, explicitBoundsOf(cf), 6)
+	// re-bucket into schema 0 if native histogram disabled
+	batch, _ = parseOTLPMetrics(md, nil, "ns", models.NewDefaultLimits(), false)
+	assert.Equal(t, 2, batch.Len())
+	cf = compoundFieldOf(batch.Rows()[0])
+	assert.Equal(t, []float64{0, 2, 4, math.Inf(1)}, explicitBoundsOf(cf))
+	assert.Equal(t, []float64{0, 3, 7, 0}, valuesOf(cf))
+	// number of buckets is limited
+	cf = compoundFieldOf(batch.Rows()[1])
+	assert.LessOrEqual(t, len(explicitBoundsOf(cf)), maxLegacyExponentialBuckets+2)
+	assert.Equal(t, 200.0, cf.Count())
+}
+
+func Test_parseOTLPMetrics_dropped(t *testing.T) {
+	md, ms := newTestMetrics()
+	m := ms.AppendEmpty()
+	m.SetName("cpu")
+	m.SetEmptyGauge().DataPoints().AppendEmpty().SetDoubleValue(math.NaN())
+	batch, rejected := parseOTLPMetrics(md, nil, "ns", models.NewDefaultLimits(), false)
+	assert.Zero(t, rejected)
+	assert.Zero(t, batch.Len())
+}
+
+func Test_timestamp(t *testing.T) {
+	assert.Equal(t, testTimestamp.UnixMilli(), timestamp(pcommon.NewTimestampFromTime(testTimestamp)))
+	assert.True(t, timestamp(0) > 0)
+}
+
+func tagsOf(m *flatMetricsV1.Metric) map[string]string {
+	tags := make(map[string]string)
+	var kv flatMetricsV1.KeyValue
+	for i := 0; i < m.KeyValuesLength(); i++ {
+		m.KeyValues(&kv, i)
+		tags[string(kv.Key())] = string(kv.Value())
+	}
+	return tags
+}
+
+func explicitBoundsOf(cf *flatMetricsV1.CompoundField) []float64 {
+	var rs []float64
+	for i := 0; i < cf.ExplicitBoundsLength(); i++ {
+		rs = append(rs, cf.ExplicitBounds(i))
+	}
+	return rs
+}
+
+func valuesOf(cf *flatMetricsV1.CompoundField) []float64 {
+	var rs []float64
+	for i := 0; i < cf.ValuesLength(); i++ {
+		rs = append(rs, cf.Values(i))
+	}
+	return rs
+}
+
+type badReader struct{}
+
+func (r *badReader) Read(_ []byte) (int, error) {
+	return 0, io.ErrUnexpectedEOF
+}
+
+func compoundFieldOf(row metric.BrokerRow) *flatMetricsV1.CompoundField {
+	m := row.Metric()
+	return m.CompoundField(nil)
+}
@@ -34,9 +34,12 @@ func TestConfigHandler_Configuration(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	api := NewConfigAPI(&models.StatelessNode{}, &config.Broker{})
+	api := NewConfigAPI(&models.StatelessNode{}, &config.Broker{BrokerBase: config.BrokerBase{
+		Auth: config.Auth{Admin: config.User{UserName: "admin", Password: "admin-pwd"}},
+	}})
 	r := gin.New()
 	api.Register(r)
 	resp := mock.DoRequest(t, r, http.MethodGet, ConfigPath, "")
 	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.NotContains(t, resp.Body.String(), "admin-pwd")
 }
@@ -39,18 +39,19 @@ type ClusterInitializer interface {
 // clusterInitializer implements ClusterInitializer interface.
 type clusterInitializer struct {
 	endpoint string
+	opts     []client.Option
 }
 
 // NewClusterInitializer creates a initializer
-func NewClusterInitializer(endpoint string) ClusterInitializer {
+func NewClusterInitializer(endpoint string, opts ...client.Option) ClusterInitializer {
 	u, _ := url.Parse(endpoint)
 	u.Path = path.Join(u.Path, constants.APIVersion1CliPath)
-	return &clusterInitializer{endpoint: u.String()}
+	return &clusterInitializer{endpoint: u.String(), opts: opts}
 }
 
 // InitInternalDatabase initializes internal database
 func (i *clusterInitializer) InitInternalDatabase(database models.Database) error {
-	cli := client.NewExecuteCli(i.endpoint)
+	cli := client.NewExecuteCli(i.endpoint, i.opts...)
 	if err := cli.Execute(models.ExecuteParam{
 		SQL: "create database " + string(encoding.JSONMarshal(&database)),
 	}, nil); err != nil {
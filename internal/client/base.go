@@ -17,9 +17,64 @@
 
 package client
 
-import resty "github.com/go-resty/resty/v2"
+import (
+	"crypto/tls"
+	"sync/atomic"
+
+	resty "github.com/go-resty/resty/v2"
+)
+
+// internalClient represents the shared http client for requesting other nodes' http api.
+var internalClient atomic.Pointer[resty.Client]
+
+func init() {
+	internalClient.Store(resty.New())
+}
+
+// SetInternalTLSConfig rebuilds the shared internal http client with tls config,
+// which is used to request the https api of other nodes, plaintext if tls config is nil.
+func SetInternalTLSConfig(tlsCfg *tls.Config) {
+	cli := resty.New()
+	WithTLSConfig(tlsCfg)(cli)
+	internalClient.Store(cli)
+}
+
+// InternalClient returns the shared http client for requesting other nodes' http api.
+func InternalClient() *resty.Client {
+	return internalClient.Load()
+}
 
 // Base represents base client.
 type Base struct {
 	cli *resty.Client
 }
+
+// Option represents the option of client.
+type Option func(cli *resty.Client)
+
+// WithBasicAuth sets the basic auth credential of client, ignores empty username.
+func WithBasicAuth(username, password string) Option {
+	return func(cli *resty.Client) {
+		if username != "" {
+			cli.SetBasicAuth(username, password)
+		}
+	}
+}
+
+// WithTLSConfig sets the tls config of client for https endpoint, ignores nil config.
+func WithTLSConfig(tlsCfg *tls.Config) Option {
+	return func(cli *resty.Client) {
+		if tlsCfg != nil {
+			cli.SetTLSClientConfig(tlsCfg)
+		}
+	}
+}
+
+// WithToken sets the bearer token(api token or login token) of client, ignores empty token.
+func WithToken(token string) Option {
+	return func(cli *resty.Client) {
+		if token != "" {
+			cli.SetAuthToken(token)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"crypto/tls"
+	"net/http"
+	"testing"
+
+	resty "github.com/go-resty/resty/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptions(t *testing.T) {
+	cli := resty.New()
+	WithBasicAuth("", "")(cli)
+	WithToken("")(cli)
+	WithTLSConfig(nil)(cli)
+	assert.Nil(t, cli.UserInfo)
+	assert.Empty(t, cli.Token)
+
+	WithBasicAuth("admin", "admin123")(cli)
+	assert.Equal(t, "admin", cli.UserInfo.Username)
+	WithToken("lin_token")(cli)
+	assert.Equal(t, "lin_token", cli.Token)
+	tlsCfg := &tls.Config{ServerName: "localhost", MinVersion: tls.VersionTLS12}
+	WithTLSConfig(tlsCfg)(cli)
+	transport, ok := cli.GetClient().Transport.(*http.Transport)
+	assert.True(t, ok)
+	assert.Equal(t, "localhost", transport.TLSClientConfig.ServerName)
+}
+
+func TestInternalClient(t *testing.T) {
+	defer SetInternalTLSConfig(nil)
+
+	cli := InternalClient()
+	assert.NotNil(t, cli)
+	assert.Same(t, cli, InternalClient())
+	SetInternalTLSConfig(&tls.Config{ServerName: "localhost", MinVersion: tls.VersionTLS12})
+	assert.NotSame(t, cli, InternalClient())
+	transport, ok := InternalClient().GetClient().Transport.(*http.Transport)
+	assert.True(t, ok)
+	assert.Equal(t, "localhost", transport.TLSClientConfig.ServerName)
+}
@@ -0,0 +1,124 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	resty "github.com/go-resty/resty/v2"
+
+	"github.com/lindb/common/pkg/encoding"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+)
+
+//go:generate mockgen -source=./data.go -destination=./data_mock.go -package=client
+
+// DataCli represents database data export/import client.
+type DataCli interface {
+	// Export exports the data of database, then writes the exported data into writer.
+	Export(param models.ExportParam, w io.Writer) error
+	// Import imports the exported data(a chunk of exported file) into database.
+	Import(param models.ImportParam, data []byte) (*models.ImportResult, error)
+}
+
+// dataCli implements DataCli interface.
+type dataCli struct {
+	Base
+}
+
+// NewDataCli creates a database data export/import client instance.
+func NewDataCli(endpoint string, opts ...Option) DataCli {
+	cli := resty.New()
+	cli.SetBaseURL(endpoint)
+	for _, opt := range opts {
+		opt(cli)
+	}
+	return &dataCli{
+		Base{
+			cli: cli,
+		}}
+}
+
+// Export exports the data of database, then writes the exported data into writer.
+func (cli *dataCli) Export(param models.ExportParam, w io.Writer) error {
+	params := map[string]string{
+		"db":     param.Database,
+		"ns":     param.Namespace,
+		"metric": param.Metric,
+		"format": param.Format,
+	}
+	if param.Start > 0 {
+		params["start"] = strconv.FormatInt(param.Start, 10)
+	}
+	if param.End > 0 {
+		params["end"] = strconv.FormatInt(param.End, 10)
+	}
+	resp, err := cli.cli.R().
+		SetQueryParams(params).
+		SetDoNotParseResponse(true).
+		Get("/database/export")
+	if err != nil {
+		return err
+	}
+	body := resp.RawBody()
+	defer func() {
+		_ = body.Close()
+	}()
+	if resp.StatusCode() != http.StatusOK {
+		data, _ := io.ReadAll(body)
+		return errors.New(string(data))
+	}
+	if _, err := io.Copy(w, body); err != nil {
+		return err
+	}
+	// export failure after response sent
+	if msg := resp.RawResponse.Trailer.Get(constants.ExportErrorTrailer); msg != "" {
+		return errors.New(msg)
+	}
+	return nil
+}
+
+// Import imports the exported data(a chunk of exported file) into database.
+func (cli *dataCli) Import(param models.ImportParam, data []byte) (*models.ImportResult, error) {
+	resp, err := cli.cli.R().
+		SetQueryParams(map[string]string{
+			"db":        param.Database,
+			"ns":        param.Namespace,
+			"format":    param.Format,
+			"precision": param.Precision,
+		}).
+		SetHeader("Content-Type", constants.ContentTypeOctetStream).
+		SetBody(data).
+		Put("/database/import")
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, errors.New(string(resp.Body()))
+	}
+	result := &models.ImportResult{}
+	if err := encoding.JSONUnmarshal(resp.Body(), result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,164 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+)
+
+func TestDataCli_Export(t *testing.T) {
+	cases := []struct {
+		prepare func(rw http.ResponseWriter, r *http.Request)
+		name    string
+		url     string
+		data    string
+		wantErr bool
+	}{
+		{
+			name:    "wrong url",
+			url:     "http://localhost:30001",
+			wantErr: true,
+		},
+		{
+			name: "http status no ok",
+			prepare: func(rw http.ResponseWriter, _ *http.Request) {
+				rw.WriteHeader(http.StatusInternalServerError)
+				_, _ = rw.Write([]byte("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "export failure after response sent",
+			prepare: func(rw http.ResponseWriter, _ *http.Request) {
+				rw.Header().Set("Trailer", constants.ExportErrorTrailer)
+				_, _ = rw.Write([]byte("data"))
+				rw.Header().Set(constants.ExportErrorTrailer, "err")
+			},
+			data:    "data",
+			wantErr: true,
+		},
+		{
+			name: "export successfully",
+			prepare: func(rw http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "test", r.URL.Query().Get("db"))
+				assert.Equal(t, "1", r.URL.Query().Get("start"))
+				assert.Equal(t, "2", r.URL.Query().Get("end"))
+				rw.Header().Set("Trailer", constants.ExportErrorTrailer)
+				_, _ = rw.Write([]byte("data"))
+			},
+			data: "data",
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/database/export", r.URL.Path)
+				if tt.prepare != nil {
+					tt.prepare(rw, r)
+				}
+			}))
+			defer server.Close()
+			url := server.URL
+			if tt.url != "" {
+				url = tt.url
+			}
+			cli := NewDataCli(url)
+			var buf bytes.Buffer
+			err := cli.Export(models.ExportParam{Database: "test", Start: 1, End: 2}, &buf)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Export() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			assert.Equal(t, tt.data, buf.String())
+		})
+	}
+}
+
+func TestDataCli_Import(t *testing.T) {
+	cases := []struct {
+		prepare func(rw http.ResponseWriter, r *http.Request)
+		name    string
+		url     string
+		wantErr bool
+	}{
+		{
+			name:    "wrong url",
+			url:     "http://localhost:30001",
+			wantErr: true,
+		},
+		{
+			name: "http status no ok",
+			prepare: func(rw http.ResponseWriter, _ *http.Request) {
+				rw.WriteHeader(http.StatusInternalServerError)
+			},
+			wantErr: true,
+		},
+		{
+			name: "unmarshal result failure",
+			prepare: func(rw http.ResponseWriter, _ *http.Request) {
+				_, _ = rw.Write([]byte("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "import successfully",
+			prepare: func(rw http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, "line", r.URL.Query().Get("format"))
+				data, _ := io.ReadAll(r.Body)
+				assert.Equal(t, "data", string(data))
+				_, _ = rw.Write([]byte(`{"metrics":10}`))
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/database/import", r.URL.Path)
+				if tt.prepare != nil {
+					tt.prepare(rw, r)
+				}
+			}))
+			defer server.Close()
+			url := server.URL
+			if tt.url != "" {
+				url = tt.url
+			}
+			cli := NewDataCli(url)
+			rs, err := cli.Import(models.ImportParam{Database: "test", Format: "line"}, []byte("data"))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Import() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr {
+				assert.Equal(t, 10, rs.Metrics)
+			}
+		})
+	}
+}
@@ -48,9 +48,12 @@ type executeCli struct {
 }
 
 // NewExecuteCli creates a lin query language execute client instance.
-func NewExecuteCli(endpoint string) ExecuteCli {
+func NewExecuteCli(endpoint string, opts ...Option) ExecuteCli {
 	cli := resty.New()
 	cli.SetBaseURL(endpoint)
+	for _, opt := range opts {
+		opt(cli)
+	}
 	return &executeCli{
 		Base{
 			cli: cli,
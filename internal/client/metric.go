@@ -21,8 +21,6 @@ import (
 	"net/url"
 	"sync"
 
-	resty "github.com/go-resty/resty/v2"
-
 	"github.com/lindb/common/pkg/logger"
 
 	"github.com/lindb/lindb/constants"
@@ -70,7 +68,7 @@ func (cli *metricCli) FetchMetricData(nodes []models.Node, names []string) (inte
 			node := nodes[i]
 			address := node.HTTPAddress()
 			metric := make(map[string][]*models.StateMetric)
-			_, err := resty.New().R().SetQueryParamsFromValues(params).
+			_, err := InternalClient().R().SetQueryParamsFromValues(params).
 				SetHeader("Accept", "application/json").
 				SetResult(&metric).
 				Get(address + constants.APIVersion1CliPath + "/state/explore/current")
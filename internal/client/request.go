@@ -21,8 +21,6 @@ import (
 	"sort"
 	"sync"
 
-	resty "github.com/go-resty/resty/v2"
-
 	"github.com/lindb/common/pkg/logger"
 
 	"github.com/lindb/lindb/constants"
@@ -65,7 +63,7 @@ func (cli *requestCli) FetchRequestsByNodes(nodes []models.Node) []*models.Reque
 			node := nodes[i]
 			address := node.HTTPAddress()
 			var stats []*models.Request
-			_, err := resty.New().R().
+			_, err := InternalClient().R().
 				SetHeader("Accept", "application/json").
 				SetResult(&stats).
 				Get(address + constants.APIVersion1CliPath + "/state/requests")
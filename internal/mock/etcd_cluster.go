@@ -22,6 +22,7 @@ import (
 	"net/url"
 	"testing"
 
+	"go.etcd.io/etcd/client/pkg/v3/transport"
 	"go.etcd.io/etcd/server/v3/embed"
 	"go.uber.org/zap/zapcore"
 )
@@ -34,7 +35,25 @@ type EtcdCluster struct {
 
 // StartEtcdCluster starts integration etcd cluster
 func StartEtcdCluster(t *testing.T, endpoint string) *EtcdCluster {
+	return startEtcdCluster(t, endpoint, nil)
+}
+
+// StartEtcdClusterWithTLS starts integration etcd cluster which requires client certificate(mutual TLS),
+// endpoint's scheme must be https.
+func StartEtcdClusterWithTLS(t *testing.T, endpoint string, certs *TLSCertificates) *EtcdCluster {
+	return startEtcdCluster(t, endpoint, certs)
+}
+
+func startEtcdCluster(t *testing.T, endpoint string, certs *TLSCertificates) *EtcdCluster {
 	cfg := embed.NewConfig()
+	if certs != nil {
+		cfg.ClientTLSInfo = transport.TLSInfo{
+			CertFile:       certs.ServerCertFile,
+			KeyFile:        certs.ServerKeyFile,
+			TrustedCAFile:  certs.CAFile,
+			ClientCertAuth: true,
+		}
+	}
 	lcurl, _ := url.Parse(endpoint)
 	acurl, _ := url.Parse(fmt.Sprintf("http://localhost:1%s", lcurl.Port()))
 	cfg.Dir = t.TempDir()
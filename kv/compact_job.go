@@ -141,8 +141,15 @@ func (c *compactJob) doMerge() error {
 	if err != nil {
 		return err
 	}
+	params := c.family.getMergeContext()
 	if c.rollup != nil {
-		merger.Init(map[string]interface{}{RollupContext: c.rollup})
+		if params == nil {
+			params = make(map[string]interface{})
+		}
+		params[RollupContext] = c.rollup
+	}
+	if len(params) > 0 {
+		merger.Init(params)
 	}
 
 	var needMerge [][]byte
@@ -183,6 +183,36 @@ func TestCompactJob_merge_doMerge_fail(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestCompactJob_merge_context(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	snapshot := version.NewMockSnapshot(ctrl)
+	reader1 := table.NewMockReader(ctrl)
+	reader2 := table.NewMockReader(ctrl)
+	reader1.EXPECT().Iterator().Return(generateIterator(ctrl, map[uint32][]byte{}))
+	reader2.EXPECT().Iterator().Return(generateIterator(ctrl, map[uint32][]byte{}))
+	snapshot.EXPECT().GetReader(table.FileNumber(1)).Return(reader1, nil)
+	snapshot.EXPECT().GetReader(table.FileNumber(4)).Return(reader2, nil)
+	merge := NewMockMerger(ctrl)
+	family := NewMockFamily(ctrl)
+	family.EXPECT().getNewMerger().Return(func(flusher Flusher) (Merger, error) {
+		return merge, nil
+	})
+	family.EXPECT().familyInfo().Return("family").AnyTimes()
+	family.EXPECT().getMergeContext().Return(map[string]interface{}{TombstoneContext: "tombstone"})
+	family.EXPECT().commitEditLog(gomock.Any()).Return(true)
+	merge.EXPECT().Init(map[string]interface{}{TombstoneContext: "tombstone"})
+
+	f1 := version.NewFileMeta(1, 1, 10, 100)
+	f4 := version.NewFileMeta(4, 30, 100, 100)
+	compaction := version.NewCompaction(1, 0, []*version.FileMeta{f1}, []*version.FileMeta{f4})
+	state := newCompactionState(10000, snapshot, compaction)
+	compactJobIntf := newCompactJob(family, state, nil)
+	err := compactJobIntf.Run()
+	assert.NoError(t, err)
+}
+
 func TestCompactJob_output_fail(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -373,6 +403,7 @@ func TestCompactJob_merge_rollup(t *testing.T) {
 func generateMockFamily(ctrl *gomock.Controller, merger NewMerger) *MockFamily {
 	family := NewMockFamily(ctrl)
 	family.EXPECT().getNewMerger().Return(merger).AnyTimes()
+	family.EXPECT().getMergeContext().Return(nil).AnyTimes()
 	family.EXPECT().Name().Return("test-family").AnyTimes()
 	family.EXPECT().commitEditLog(gomock.Any()).Return(true).AnyTimes()
 	return family
@@ -29,6 +29,7 @@ import (
 
 	"github.com/lindb/lindb/kv/table"
 	"github.com/lindb/lindb/kv/version"
+	"github.com/lindb/lindb/pkg/compress"
 )
 
 //go:generate mockgen -source ./family.go -destination=./family_mock.go -package kv
@@ -45,12 +46,20 @@ type Family interface {
 	ID() version.FamilyID
 	// Name return family's name.
 	Name() string
+	// Path returns family's storage path.
+	Path() string
 	// NewFlusher creates flusher for saving data to family.
 	NewFlusher() Flusher
 	// GetSnapshot returns current version's snapshot.
 	GetSnapshot() version.Snapshot
 	// Compact compacts all files of level0.
 	Compact()
+	// SetMergeContext sets the context which passes to merger when doing compaction/rollup job.
+	SetMergeContext(key string, value interface{})
+	// Offload offloads sst files of family into remote tier, only keeps the index of files locally.
+	Offload() error
+	// NextFileNumber generates next file number, files created after have larger file number.
+	NextFileNumber() table.FileNumber
 
 	getStore() Store
 	// familyInfo return family info
@@ -68,6 +77,8 @@ type Family interface {
 	compact()
 	// getNewMerger returns new merger function, merger need implement Merger interface
 	getNewMerger() NewMerger
+	// getMergeContext returns a copy of the context which passes to merger.
+	getMergeContext() map[string]interface{}
 	// addPendingOutput add a file which current writing file number
 	addPendingOutput(fileNumber table.FileNumber)
 	// removePendingOutput removes pending output file after compact or flush
@@ -94,6 +105,7 @@ type family struct {
 	merger            NewMerger
 	newCompactJobFunc func(family Family, state *compactionState, rollup Rollup) CompactJob
 	pendingOutputs    sync.Map
+	mergeContext      sync.Map
 	name              string
 	familyPath        string
 	option            FamilyOption
@@ -149,6 +161,11 @@ func (f *family) Name() string {
 	return f.name
 }
 
+// Path returns family's storage path.
+func (f *family) Path() string {
+	return f.familyPath
+}
+
 func (f *family) getStore() Store {
 	return f.store
 }
@@ -173,11 +190,16 @@ func (f *family) familyInfo() string {
 
 // newTableBuilder creates table builder instance for storing kv data.
 func (f *family) newTableBuilder() (table.Builder, error) {
+	// compression option is applied on flush/compaction/rollup, files with different compression can coexist
+	codec, err := compress.GetBlockCodec(f.store.Option().Compression)
+	if err != nil {
+		return nil, err
+	}
 	fileNumber := f.store.nextFileNumber()
 	// NOTE: need add pending output before create write
 	f.addPendingOutput(fileNumber)
 	fileName := filepath.Join(f.familyPath, version.Table(fileNumber))
-	return table.NewStoreBuilder(fileNumber, fileName)
+	return table.NewStoreBuilderWithCodec(fileNumber, fileName, codec)
 }
 
 // commitEditLog persists edit logs into manifest file.
@@ -288,7 +310,7 @@ func (f *family) deleteSST(fileNumber table.FileNumber) error {
 	if err := removeDirFunc(filepath.Join(f.familyPath, version.Table(fileNumber))); err != nil {
 		return err
 	}
-	return nil
+	return f.deleteRemoteSST(fileNumber)
 }
 
 // getFamilyVersion returns the family version
@@ -301,6 +323,26 @@ func (f *family) getNewMerger() NewMerger {
 	return f.merger
 }
 
+// SetMergeContext sets the context which passes to merger when doing compaction/rollup job.
+func (f *family) SetMergeContext(key string, value interface{}) {
+	f.mergeContext.Store(key, value)
+}
+
+// NextFileNumber generates next file number, files created after have larger file number.
+func (f *family) NextFileNumber() table.FileNumber {
+	return f.store.nextFileNumber()
+}
+
+// getMergeContext returns a copy of the context which passes to merger.
+func (f *family) getMergeContext() map[string]interface{} {
+	result := make(map[string]interface{})
+	f.mergeContext.Range(func(key, value interface{}) bool {
+		result[key.(string)] = value
+		return true
+	})
+	return result
+}
+
 // deleteObsoleteFiles deletes obsolete files
 func (f *family) deleteObsoleteFiles() {
 	sstFiles, err := listDirFunc(f.familyPath)
@@ -334,7 +376,7 @@ func (f *family) deleteObsoleteFiles() {
 		}
 		keep := true
 		fileNumber := fileDesc.FileNumber
-		if fileDesc.FileType == version.TypeTable {
+		if fileDesc.FileType == version.TypeTable || fileDesc.FileType == version.TypeRemoteTable {
 			_, keep = liveFiles[fileNumber]
 		}
 		if !keep {
@@ -30,6 +30,7 @@ import (
 
 	"github.com/lindb/lindb/kv/table"
 	"github.com/lindb/lindb/kv/version"
+	"github.com/lindb/lindb/pkg/compress"
 	"github.com/lindb/lindb/pkg/timeutil"
 )
 
@@ -44,8 +45,9 @@ func TestFamily_New(t *testing.T) {
 		ctrl.Finish()
 	}()
 	store := NewMockStore(ctrl)
+	storePath := t.TempDir()
 	store.EXPECT().Option().Return(DefaultStoreOption()).AnyTimes()
-	store.EXPECT().Path().Return(t.TempDir()).AnyTimes()
+	store.EXPECT().Path().Return(storePath).AnyTimes()
 	// case 1: create family err, mkdir err
 	mkDirFunc = func(path string) error {
 		return fmt.Errorf("err")
@@ -66,6 +68,7 @@ func TestFamily_New(t *testing.T) {
 	assert.NotNil(t, f)
 	assert.Equal(t, version.FamilyID(10), f.ID())
 	assert.Equal(t, "f", f.Name())
+	assert.Equal(t, filepath.Join(storePath, "f"), f.Path())
 	vs.EXPECT().GetSnapshot().Return(version.NewMockSnapshot(ctrl))
 	assert.NotNil(t, f.GetSnapshot())
 	flusher := f.NewFlusher()
@@ -74,6 +77,8 @@ func TestFamily_New(t *testing.T) {
 
 	assert.NotNil(t, f.getFamilyVersion())
 	assert.NotNil(t, f.getNewMerger())
+	store.EXPECT().nextFileNumber().Return(table.FileNumber(10))
+	assert.Equal(t, table.FileNumber(10), f.NextFileNumber())
 }
 
 func TestFamily_Data_Write_Read(t *testing.T) {
@@ -105,6 +110,59 @@ func TestFamily_Data_Write_Read(t *testing.T) {
 	snapshot.Close()
 }
 
+func TestFamily_Data_Compression(t *testing.T) {
+	testKVPath := filepath.Join(t.TempDir(), "test_data")
+	write := func(option StoreOption) {
+		kv, err := newStore("test_kv", testKVPath, option)
+		assert.NoError(t, err)
+		defer func() {
+			_ = kv.close()
+		}()
+		f, err := kv.CreateFamily("f", FamilyOption{Merger: "mockMerger"})
+		assert.NoError(t, err)
+		flusher := f.NewFlusher()
+		defer flusher.Release()
+		assert.NoError(t, flusher.Add(1, []byte("test-compression")))
+		assert.NoError(t, flusher.Add(10, []byte("test-compression")))
+		assert.NoError(t, flusher.Commit())
+	}
+	option := DefaultStoreOption()
+	option.Compression = compress.BlockOption{Type: "zstd", Level: 9}
+	write(option)
+	option.Compression = compress.BlockOption{Type: "lz4"}
+	write(option)
+	// files with different compression coexist
+	kv, err := newStore("test_kv", testKVPath, DefaultStoreOption())
+	assert.NoError(t, err)
+	defer func() {
+		_ = kv.close()
+	}()
+	f := kv.GetFamily("f")
+	snapshot := f.GetSnapshot()
+	defer snapshot.Close()
+	readers, err := snapshot.FindReaders(10)
+	assert.NoError(t, err)
+	assert.Len(t, readers, 2)
+	var values []string
+	for _, r := range readers {
+		it := r.Iterator()
+		for it.HasNext() {
+			_ = it.Key()
+			values = append(values, string(it.Value()))
+		}
+	}
+	assert.Equal(t, []string{"test-compression", "test-compression", "test-compression", "test-compression"}, values)
+
+	// bad compression option
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	store := NewMockStore(ctrl)
+	store.EXPECT().Option().Return(StoreOption{Compression: compress.BlockOption{Type: "gzip"}})
+	builder, err := (&family{store: store}).newTableBuilder()
+	assert.Error(t, err)
+	assert.Nil(t, builder)
+}
+
 func TestFamily_commitEditLog(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -329,3 +387,10 @@ func TestFamily_Comapct(t *testing.T) {
 	f.Compact()
 	time.Sleep(100 * time.Millisecond)
 }
+
+func TestFamily_MergeContext(t *testing.T) {
+	f := &family{}
+	assert.Empty(t, f.getMergeContext())
+	f.SetMergeContext(TombstoneContext, "tombstone")
+	assert.Equal(t, map[string]interface{}{TombstoneContext: "tombstone"}, f.getMergeContext())
+}
@@ -0,0 +1,150 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kv
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+
+	"github.com/lindb/common/pkg/fileutil"
+	"github.com/lindb/common/pkg/logger"
+
+	"github.com/lindb/lindb/kv/table"
+	"github.com/lindb/lindb/kv/version"
+)
+
+// for testing
+var (
+	getRemoteTierFunc    = table.GetRemoteTier
+	offloadFileFunc      = table.Offload
+	removeRemoteFileFunc = table.RemoveRemoteFile
+	downloadFileFunc     = table.Download
+	fileExistFunc        = fileutil.Exist
+)
+
+// ErrRemoteTierNotInit represents the error that tiered storage is disabled.
+var ErrRemoteTierNotInit = errors.New("remote tier not initialized")
+
+// Offload offloads sst files of family into remote tier, only keeps the index of files locally.
+// NOTE: skips offloading if family isn't fully compacted(has compaction/rollup job doing,
+// level0 files need to compact or source files need to rollup), it will be offloaded next time.
+func (f *family) Offload() error {
+	tier := getRemoteTierFunc()
+	if tier == nil {
+		return ErrRemoteTierNotInit
+	}
+	if f.rolluping.Load() || len(f.familyVersion.GetLiveRollupFiles()) > 0 {
+		return nil
+	}
+	// holds compacting flag during offloading, avoids compaction job running concurrently
+	if !f.compacting.CompareAndSwap(false, true) {
+		return nil
+	}
+	needCompact, err := f.offload(tier)
+	f.compacting.Store(false)
+	if needCompact {
+		f.Compact()
+	}
+	return err
+}
+
+// offload offloads all sst files of current version into remote tier,
+// returns true if level0 files need to compact before offloading.
+func (f *family) offload(tier *table.RemoteTier) (needCompact bool, err error) {
+	snapshot := f.GetSnapshot()
+	defer snapshot.Close()
+
+	current := snapshot.GetCurrent()
+	if current.NumberOfFilesInLevel(0) > 1 {
+		return true, nil
+	}
+	for _, file := range current.GetAllFiles() {
+		fileName := version.Table(file.GetFileNumber())
+		filePath := filepath.Join(f.familyPath, fileName)
+		if !fileExistFunc(filePath) {
+			// file has been offloaded
+			continue
+		}
+		key := path.Join(filepath.ToSlash(f.store.Name()), f.name, fileName)
+		if err := offloadFileFunc(tier, filePath, key); err != nil {
+			return false, fmt.Errorf("offload file[%s] error:%s", filePath, err)
+		}
+		// NOTE: only removes the local file, the reader mapping it still works until evicted from cache,
+		// then new reader reads the file from remote tier.
+		if err := removeDirFunc(filePath); err != nil {
+			return false, fmt.Errorf("remove offloaded file[%s] error:%s", filePath, err)
+		}
+		kvLogger.Info("offload sst file successfully",
+			logger.String("family", f.familyInfo()), logger.String("file", fileName))
+	}
+	return false, nil
+}
+
+// deleteRemoteSST deletes the sst file offloaded into remote tier if exist.
+func (f *family) deleteRemoteSST(fileNumber table.FileNumber) error {
+	stubPath := filepath.Join(f.familyPath, version.RemoteTable(fileNumber))
+	if !fileExistFunc(stubPath) {
+		return nil
+	}
+	tier := getRemoteTierFunc()
+	if tier == nil {
+		return ErrRemoteTierNotInit
+	}
+	return removeRemoteFileFunc(tier, stubPath)
+}
+
+// backupFile links the sst file into backup path, if the file has been offloaded into remote tier,
+// downloads it for making backup self-contained.
+func backupFile(filePath, target string) error {
+	stubPath := filePath + table.RemoteSuffix
+	if fileExistFunc(filePath) || !fileExistFunc(stubPath) {
+		return linkFileFunc(filePath, target)
+	}
+	tier := getRemoteTierFunc()
+	if tier == nil {
+		return ErrRemoteTierNotInit
+	}
+	return downloadFileFunc(tier, stubPath, target)
+}
+
+// RemoveRemoteFiles removes all the sst files offloaded into remote tier under given dir,
+// includes the stub files.
+func RemoveRemoteFiles(dir string) error {
+	if !fileExistFunc(dir) {
+		return nil
+	}
+	return filepath.WalkDir(dir, func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		if fileDesc := version.ParseFileName(d.Name()); fileDesc == nil || fileDesc.FileType != version.TypeRemoteTable {
+			return nil
+		}
+		tier := getRemoteTierFunc()
+		if tier == nil {
+			return ErrRemoteTierNotInit
+		}
+		return removeRemoteFileFunc(tier, filePath)
+	})
+}
@@ -0,0 +1,246 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kv
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lindb/common/pkg/fileutil"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/lindb/lindb/kv/table"
+	"github.com/lindb/lindb/kv/version"
+	"github.com/lindb/lindb/pkg/blob"
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+func TestFamily_Offload(t *testing.T) {
+	defer table.InitRemoteTier(nil)
+
+	testKVPath := filepath.Join(t.TempDir(), "test_data")
+	kv, err := newStore("test_kv", testKVPath, DefaultStoreOption())
+	assert.NoError(t, err)
+	defer func() {
+		_ = kv.close()
+	}()
+	f, err := kv.CreateFamily("f", FamilyOption{Merger: "mockMerger"})
+	assert.NoError(t, err)
+	flusher := f.NewFlusher()
+	assert.NoError(t, flusher.Add(1, []byte("test")))
+	assert.NoError(t, flusher.Add(10, []byte("test10")))
+	assert.NoError(t, flusher.Commit())
+	flusher.Release()
+
+	// remote tier not initialized
+	assert.ErrorIs(t, f.Offload(), ErrRemoteTierNotInit)
+
+	backend, err := blob.NewLocalBackend(filepath.Join(t.TempDir(), "tiering"))
+	assert.NoError(t, err)
+	table.InitRemoteTier(&table.RemoteTier{Backend: backend, Prefix: "1"})
+	assert.NoError(t, f.Offload())
+	files := f.GetSnapshot().GetCurrent().GetAllFiles()
+	assert.Len(t, files, 1)
+	fileNumber := files[0].GetFileNumber()
+	fileNames, err := fileutil.ListDir(f.Path())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{version.RemoteTable(fileNumber)}, fileNames)
+	// offload again, skip offloaded files
+	assert.NoError(t, f.Offload())
+
+	// read from remote tier
+	kv.evictFamilyFile(fileNumber)
+	snapshot := f.GetSnapshot()
+	readers, err := snapshot.FindReaders(10)
+	assert.NoError(t, err)
+	assert.Len(t, readers, 1)
+	value, err := readers[0].Get(10)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("test10"), value)
+	snapshot.Close()
+
+	// backup downloads offloaded files
+	backupPath := filepath.Join(t.TempDir(), "backup")
+	assert.NoError(t, kv.Backup(backupPath))
+	assert.True(t, fileutil.Exist(filepath.Join(backupPath, "f", version.Table(fileNumber))))
+
+	// delete offloaded file
+	assert.NoError(t, f.(*family).deleteSST(fileNumber))
+	assert.False(t, fileutil.Exist(filepath.Join(f.Path(), version.RemoteTable(fileNumber))))
+	_, err = backend.GetRange("1/test_kv/f/"+version.Table(fileNumber), 0, 1)
+	assert.Error(t, err)
+}
+
+func TestFamily_Offload_Skip(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		getRemoteTierFunc = table.GetRemoteTier
+		ctrl.Finish()
+	}()
+	getRemoteTierFunc = func() *table.RemoteTier {
+		return &table.RemoteTier{}
+	}
+	fv := version.NewMockFamilyVersion(ctrl)
+	f := &family{familyVersion: fv, familyPath: t.TempDir()}
+
+	// has compaction job doing
+	fv.EXPECT().GetLiveRollupFiles().Return(nil)
+	f.compacting.Store(true)
+	assert.NoError(t, f.Offload())
+	assert.True(t, f.compacting.Load())
+	f.compacting.Store(false)
+	// need rollup
+	fv.EXPECT().GetLiveRollupFiles().Return(map[table.FileNumber][]timeutil.Interval{10: {10}})
+	assert.NoError(t, f.Offload())
+	// need compaction
+	snapshot := version.NewMockSnapshot(ctrl)
+	v := version.NewMockVersion(ctrl)
+	fv.EXPECT().GetLiveRollupFiles().Return(nil)
+	fv.EXPECT().GetSnapshot().Return(snapshot).AnyTimes()
+	snapshot.EXPECT().GetCurrent().Return(v).AnyTimes()
+	snapshot.EXPECT().Close().AnyTimes()
+	v.EXPECT().NumberOfFilesInLevel(0).Return(2).Times(2)
+	v.EXPECT().PickL0Compaction(gomock.Any()).Return(nil)
+	fv.EXPECT().GetAllActiveFiles().Return(nil).AnyTimes()
+	fv.EXPECT().GetLiveRollupFiles().Return(nil).AnyTimes()
+	assert.NoError(t, f.Offload())
+	f.close()
+}
+
+func TestFamily_Offload_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		getRemoteTierFunc = table.GetRemoteTier
+		offloadFileFunc = table.Offload
+		removeDirFunc = fileutil.RemoveDir
+		fileExistFunc = fileutil.Exist
+		ctrl.Finish()
+	}()
+	getRemoteTierFunc = func() *table.RemoteTier {
+		return &table.RemoteTier{}
+	}
+	fileExistFunc = func(_ string) bool {
+		return true
+	}
+	store := NewMockStore(ctrl)
+	store.EXPECT().Name().Return("test_kv").AnyTimes()
+	fv := version.NewMockFamilyVersion(ctrl)
+	snapshot := version.NewMockSnapshot(ctrl)
+	v := version.NewMockVersion(ctrl)
+	fv.EXPECT().GetLiveRollupFiles().Return(nil).AnyTimes()
+	fv.EXPECT().GetSnapshot().Return(snapshot).AnyTimes()
+	snapshot.EXPECT().GetCurrent().Return(v).AnyTimes()
+	snapshot.EXPECT().Close().AnyTimes()
+	v.EXPECT().NumberOfFilesInLevel(0).Return(0).AnyTimes()
+	v.EXPECT().GetAllFiles().Return([]*version.FileMeta{version.NewFileMeta(1, 1, 10, 1024)}).AnyTimes()
+	f := &family{familyVersion: fv, store: store, name: "f", familyPath: t.TempDir()}
+
+	offloadFileFunc = func(_ *table.RemoteTier, _, _ string) error {
+		return fmt.Errorf("err")
+	}
+	assert.Error(t, f.Offload())
+	offloadFileFunc = func(_ *table.RemoteTier, _, key string) error {
+		assert.Equal(t, "test_kv/f/000001.sst", key)
+		return nil
+	}
+	removeDirFunc = func(_ string) error {
+		return fmt.Errorf("err")
+	}
+	assert.Error(t, f.Offload())
+	// releases compacting flag after offloading
+	assert.False(t, f.compacting.Load())
+}
+
+func TestFamily_deleteRemoteSST(t *testing.T) {
+	defer func() {
+		getRemoteTierFunc = table.GetRemoteTier
+		removeRemoteFileFunc = table.RemoveRemoteFile
+		fileExistFunc = fileutil.Exist
+	}()
+	f := &family{familyPath: t.TempDir()}
+	assert.NoError(t, f.deleteRemoteSST(1))
+
+	fileExistFunc = func(_ string) bool {
+		return true
+	}
+	getRemoteTierFunc = func() *table.RemoteTier {
+		return nil
+	}
+	assert.ErrorIs(t, f.deleteRemoteSST(1), ErrRemoteTierNotInit)
+	getRemoteTierFunc = func() *table.RemoteTier {
+		return &table.RemoteTier{}
+	}
+	removeRemoteFileFunc = func(_ *table.RemoteTier, _ string) error {
+		return fmt.Errorf("err")
+	}
+	assert.Error(t, f.deleteRemoteSST(1))
+}
+
+func TestBackupFile(t *testing.T) {
+	defer func() {
+		getRemoteTierFunc = table.GetRemoteTier
+		downloadFileFunc = table.Download
+		fileExistFunc = fileutil.Exist
+	}()
+	fileExistFunc = func(file string) bool {
+		return file == "000001.sst"+table.RemoteSuffix
+	}
+	getRemoteTierFunc = func() *table.RemoteTier {
+		return nil
+	}
+	assert.ErrorIs(t, backupFile("000001.sst", "backup/000001.sst"), ErrRemoteTierNotInit)
+	getRemoteTierFunc = func() *table.RemoteTier {
+		return &table.RemoteTier{}
+	}
+	downloadFileFunc = func(_ *table.RemoteTier, _, _ string) error {
+		return fmt.Errorf("err")
+	}
+	assert.Error(t, backupFile("000001.sst", "backup/000001.sst"))
+}
+
+func TestRemoveRemoteFiles(t *testing.T) {
+	defer func() {
+		getRemoteTierFunc = table.GetRemoteTier
+		removeRemoteFileFunc = table.RemoveRemoteFile
+	}()
+	dir := t.TempDir()
+	assert.NoError(t, RemoveRemoteFiles(filepath.Join(dir, "not_exist")))
+	assert.NoError(t, fileutil.MkDirIfNotExist(filepath.Join(dir, "f")))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "f", version.Table(1)), []byte("sst"), 0o644))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "f", version.RemoteTable(2)), []byte("stub"), 0o644))
+	// no remote files
+	assert.NoError(t, RemoveRemoteFiles(filepath.Join(dir, "f", version.Table(1))))
+
+	getRemoteTierFunc = func() *table.RemoteTier {
+		return nil
+	}
+	assert.ErrorIs(t, RemoveRemoteFiles(dir), ErrRemoteTierNotInit)
+	getRemoteTierFunc = func() *table.RemoteTier {
+		return &table.RemoteTier{}
+	}
+	var removed []string
+	removeRemoteFileFunc = func(_ *table.RemoteTier, stubPath string) error {
+		removed = append(removed, stubPath)
+		return nil
+	}
+	assert.NoError(t, RemoveRemoteFiles(dir))
+	assert.Equal(t, []string{filepath.Join(dir, "f", version.RemoteTable(2))}, removed)
+}
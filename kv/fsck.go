@@ -0,0 +1,463 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kv
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lindb/common/pkg/fileutil"
+
+	"github.com/lindb/lindb/kv/table"
+	"github.com/lindb/lindb/kv/version"
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+// QuarantineDir is the directory under store path which keeps the corrupted files moved out by fsck.
+const QuarantineDir = "quarantine"
+
+// for testing
+var (
+	loadVersionSetFunc  = version.LoadVersionSet
+	rewriteManifestFunc = version.RewriteManifest
+	openReaderFunc      = table.OpenReader
+	renameFileFunc      = os.Rename
+)
+
+// FsckOption represents the option of checking store offline.
+type FsckOption struct {
+	// Quarantine moves corrupted table files into quarantine dir, then removes them from manifest.
+	Quarantine bool
+	// RebuildManifest rebuilds manifest based on table files on disk if current manifest cannot be loaded,
+	// all files are put into level0.
+	RebuildManifest bool
+}
+
+// FsckReport represents the result of checking store.
+type FsckReport struct {
+	Path        string
+	Manifest    string   // manifest file name which current file points to
+	Manifests   []string // all manifest files under store path
+	ManifestErr error    // error of loading manifest
+	Families    []*FamilyReport
+	Repaired    bool // manifest is rewritten
+}
+
+// Healthy returns if no problem is found in store.
+func (r *FsckReport) Healthy() bool {
+	if r.ManifestErr != nil {
+		return false
+	}
+	for _, f := range r.Families {
+		if !f.Healthy() {
+			return false
+		}
+	}
+	return true
+}
+
+// FamilyReport represents the result of checking family.
+type FamilyReport struct {
+	Name        string
+	Merger      string
+	ID          version.FamilyID
+	Files       []*FileReport      // files referenced by manifest
+	Orphans     []*FileReport      // table files on disk, but not referenced by manifest
+	Missing     []table.FileNumber // files referenced by manifest, but not exist on disk
+	Quarantined []table.FileNumber // corrupted files moved into quarantine dir
+}
+
+// Healthy returns if no problem is found in family.
+func (r *FamilyReport) Healthy() bool {
+	if len(r.Orphans) > 0 || len(r.Missing) > 0 {
+		return false
+	}
+	for _, f := range r.Files {
+		if f.Err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// FileReport represents the result of checking table file.
+type FileReport struct {
+	Err        error
+	meta       *version.FileMeta
+	FileNumber table.FileNumber
+	Level      int // -1 if only referenced by rollup job
+	Size       int64
+	Keys       int
+	MinKey     uint32
+	MaxKey     uint32
+	Remote     bool // offloaded into remote tier, only local stub exists, so not verified
+}
+
+// Fsck checks the store under path offline, it must not be opened by other process.
+// It verifies all table files(including value blocks if inspector of family registered),
+// detects the files not referenced by manifest or missing on disk, and repairs store based on option.
+func Fsck(path string, option FsckOption) (*FsckReport, error) {
+	info, err := readStoreInfo(path)
+	if err != nil {
+		return nil, err
+	}
+	lock, err := newFileLockFunc(filepath.Join(path, version.Lock))
+	if err != nil {
+		return nil, err
+	}
+	if err := lock.Lock(); err != nil {
+		return nil, fmt.Errorf("store:%s is in use, error:%s", path, err)
+	}
+	defer func() {
+		_ = lock.Unlock()
+	}()
+
+	report := &FsckReport{Path: path}
+	names, err := listDirFunc(path)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		if strings.HasPrefix(name, version.ManifestPrefix) {
+			report.Manifests = append(report.Manifests, name)
+		}
+	}
+	sort.Strings(report.Manifests)
+
+	numOfLevels := info.StoreOption.Levels
+	families := make(map[string]version.FamilyID)
+	for name, familyOption := range info.Families {
+		families[name] = version.FamilyID(familyOption.ID)
+	}
+	report.Manifest, report.ManifestErr = version.CurrentManifest(path)
+	var vs version.StoreVersionSet
+	if report.ManifestErr == nil {
+		vs, report.ManifestErr = loadVersionSetFunc(path, numOfLevels, families)
+	}
+
+	maxFileNumber := table.FileNumber(0)
+	editLogs := make(map[string]version.EditLog)
+	for name, familyOption := range info.Families {
+		familyReport, err := checkFamily(path, name, familyOption, vs, numOfLevels)
+		if err != nil {
+			return nil, err
+		}
+		report.Families = append(report.Families, familyReport)
+		for _, file := range append(append([]*FileReport{}, familyReport.Files...), familyReport.Orphans...) {
+			if file.FileNumber > maxFileNumber {
+				maxFileNumber = file.FileNumber
+			}
+		}
+		if option.Quarantine {
+			editLog, err := quarantineFamily(path, familyReport, vs)
+			if err != nil {
+				return nil, err
+			}
+			if !editLog.IsEmpty() {
+				editLogs[name] = editLog
+			}
+		}
+	}
+	sort.Slice(report.Families, func(i, j int) bool {
+		return report.Families[i].Name < report.Families[j].Name
+	})
+	for _, manifest := range report.Manifests {
+		if fd := version.ParseFileName(manifest); fd != nil && fd.FileNumber > maxFileNumber {
+			maxFileNumber = fd.FileNumber
+		}
+	}
+
+	switch {
+	case vs != nil && len(editLogs) > 0:
+		// remove quarantined files from current manifest
+		if err := rewriteManifestFunc(vs, editLogs, maxFileNumber+1); err != nil {
+			return nil, err
+		}
+		report.Repaired = true
+	case vs == nil && option.RebuildManifest:
+		// current manifest cannot be loaded, rebuild it based on table files on disk
+		vs = newVersionSetFunc(path, table.NewCache(path, time.Minute), numOfLevels)
+		for _, familyReport := range report.Families {
+			vs.CreateFamilyVersion(familyReport.Name, familyReport.ID)
+			editLog := version.NewEditLog(familyReport.ID)
+			for _, file := range familyReport.Orphans {
+				if file.Err == nil && !file.Remote {
+					editLog.Add(version.CreateNewFile(0,
+						version.NewFileMeta(file.FileNumber, file.MinKey, file.MaxKey, uint32(file.Size))))
+				}
+			}
+			editLogs[familyReport.Name] = editLog
+		}
+		if err := rewriteManifestFunc(vs, editLogs, maxFileNumber+1); err != nil {
+			return nil, err
+		}
+		report.Repaired = true
+	}
+	return report, nil
+}
+
+// readStoreInfo reads the store info(store/family options) from options file.
+func readStoreInfo(path string) (*storeInfo, error) {
+	info := &storeInfo{}
+	optionsFile := filepath.Join(path, version.Options)
+	if !fileutil.Exist(optionsFile) {
+		return nil, fmt.Errorf("options file of store:%s not exist", path)
+	}
+	if err := decodeTomlFunc(optionsFile, info); err != nil {
+		return nil, fmt.Errorf("load store info file:%s, error:%s", optionsFile, err)
+	}
+	return info, nil
+}
+
+// checkFamily checks all files of family, compares the files referenced by manifest with the files on disk.
+func checkFamily(storePath, name string, option FamilyOption, vs version.StoreVersionSet,
+	numOfLevels int,
+) (*FamilyReport, error) {
+	report := &FamilyReport{
+		Name:   name,
+		ID:     version.FamilyID(option.ID),
+		Merger: option.Merger,
+	}
+	familyPath := filepath.Join(storePath, name)
+	localFiles := make(map[table.FileNumber]struct{})
+	remoteFiles := make(map[table.FileNumber]struct{})
+	if fileutil.Exist(familyPath) {
+		names, err := listDirFunc(familyPath)
+		if err != nil {
+			return nil, err
+		}
+		for _, fileName := range names {
+			fd := version.ParseFileName(fileName)
+			if fd == nil {
+				continue
+			}
+			switch fd.FileType {
+			case version.TypeTable:
+				localFiles[fd.FileNumber] = struct{}{}
+			case version.TypeRemoteTable:
+				remoteFiles[fd.FileNumber] = struct{}{}
+			}
+		}
+	}
+	inspector := GetBlockInspector(MergerType(option.Merger))
+	referenced := make(map[table.FileNumber]struct{})
+	checkReferencedFile := func(fileReport *FileReport) {
+		referenced[fileReport.FileNumber] = struct{}{}
+		_, local := localFiles[fileReport.FileNumber]
+		_, remote := remoteFiles[fileReport.FileNumber]
+		switch {
+		case local:
+			checkTableFile(familyPath, fileReport, inspector)
+			report.Files = append(report.Files, fileReport)
+		case remote:
+			fileReport.Remote = true
+			report.Files = append(report.Files, fileReport)
+		default:
+			report.Missing = append(report.Missing, fileReport.FileNumber)
+		}
+	}
+	if vs != nil {
+		if familyVersion := vs.GetFamilyVersion(name); familyVersion != nil {
+			snapshot := familyVersion.GetSnapshot()
+			current := snapshot.GetCurrent()
+			for level := 0; level < numOfLevels; level++ {
+				for _, meta := range current.GetFiles(level) {
+					checkReferencedFile(&FileReport{FileNumber: meta.GetFileNumber(), Level: level, meta: meta})
+				}
+			}
+			// rollup files maybe removed from levels, but still need be kept for rollup job
+			for fileNumber := range current.GetRollupFiles() {
+				if _, ok := referenced[fileNumber]; !ok {
+					checkReferencedFile(&FileReport{FileNumber: fileNumber, Level: -1})
+				}
+			}
+			snapshot.Close()
+		}
+	}
+	for fileNumber := range localFiles {
+		if _, ok := referenced[fileNumber]; !ok {
+			fileReport := &FileReport{FileNumber: fileNumber, Level: -1}
+			checkTableFile(familyPath, fileReport, inspector)
+			report.Orphans = append(report.Orphans, fileReport)
+		}
+	}
+	for fileNumber := range remoteFiles {
+		if _, ok := referenced[fileNumber]; !ok {
+			if _, ok := localFiles[fileNumber]; !ok {
+				report.Orphans = append(report.Orphans, &FileReport{FileNumber: fileNumber, Level: -1, Remote: true})
+			}
+		}
+	}
+	sortFileReports(report.Files)
+	sortFileReports(report.Orphans)
+	sort.Slice(report.Missing, func(i, j int) bool {
+		return report.Missing[i] < report.Missing[j]
+	})
+	return report, nil
+}
+
+// checkTableFile reads all key/value pairs of table file, verifies value blocks if inspector exists.
+func checkTableFile(familyPath string, report *FileReport, inspector BlockInspector) {
+	fileName := version.Table(report.FileNumber)
+	path := filepath.Join(familyPath, fileName)
+	stat, err := os.Stat(path)
+	if err != nil {
+		report.Err = err
+		return
+	}
+	report.Size = stat.Size()
+	reader, err := openReaderFunc(path, fileName)
+	if err != nil {
+		report.Err = err
+		return
+	}
+	defer func() {
+		_ = reader.Close()
+	}()
+	it := reader.Iterator()
+	for it.HasNext() {
+		key := it.Key()
+		block, err := reader.Get(key)
+		if err != nil {
+			report.Err = fmt.Errorf("read value of key:%d error:%s", key, err)
+			return
+		}
+		if inspector != nil {
+			if err := inspector.Verify(key, block); err != nil {
+				report.Err = fmt.Errorf("verify value of key:%d error:%s", key, err)
+				return
+			}
+		}
+		if report.Keys == 0 {
+			report.MinKey = key
+		}
+		report.MaxKey = key
+		report.Keys++
+	}
+	// compare with the file meta in manifest
+	if meta := report.meta; meta != nil {
+		if uint32(report.Size) != meta.GetFileSize() {
+			report.Err = fmt.Errorf("file size not match, manifest: %d, actual: %d", meta.GetFileSize(), report.Size)
+		} else if report.MinKey != meta.GetMinKey() || report.MaxKey != meta.GetMaxKey() {
+			report.Err = fmt.Errorf("key range not match, manifest: [%d,%d], actual: [%d,%d]",
+				meta.GetMinKey(), meta.GetMaxKey(), report.MinKey, report.MaxKey)
+		}
+	}
+}
+
+// quarantineFamily moves the corrupted table files of family into quarantine dir,
+// returns the edit log which removes those files from manifest.
+func quarantineFamily(storePath string, report *FamilyReport, vs version.StoreVersionSet) (version.EditLog, error) {
+	editLog := version.NewEditLog(report.ID)
+	var rollupFiles map[table.FileNumber][]timeutil.Interval
+	if vs != nil {
+		if familyVersion := vs.GetFamilyVersion(report.Name); familyVersion != nil {
+			snapshot := familyVersion.GetSnapshot()
+			rollupFiles = snapshot.GetCurrent().GetRollupFiles()
+			snapshot.Close()
+		}
+	}
+	quarantine := func(file *FileReport) error {
+		if file.Err == nil || file.Remote {
+			return nil
+		}
+		fileName := version.Table(file.FileNumber)
+		target := filepath.Join(storePath, QuarantineDir, report.Name)
+		if err := mkDirFunc(target); err != nil {
+			return err
+		}
+		if err := renameFileFunc(filepath.Join(storePath, report.Name, fileName), filepath.Join(target, fileName)); err != nil {
+			return err
+		}
+		report.Quarantined = append(report.Quarantined, file.FileNumber)
+		if file.Level >= 0 {
+			editLog.Add(version.NewDeleteFile(int32(file.Level), file.FileNumber))
+		}
+		for _, interval := range rollupFiles[file.FileNumber] {
+			editLog.Add(version.CreateDeleteRollupFile(file.FileNumber, interval))
+		}
+		return nil
+	}
+	for _, file := range report.Files {
+		if err := quarantine(file); err != nil {
+			return nil, err
+		}
+	}
+	for _, file := range report.Orphans {
+		if err := quarantine(file); err != nil {
+			return nil, err
+		}
+	}
+	return editLog, nil
+}
+
+func sortFileReports(files []*FileReport) {
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].FileNumber < files[j].FileNumber
+	})
+}
+
+// DumpTable writes key/value pairs of table file under family in readable format,
+// value block is decoded by the inspector registered for the merger of family, else only dumps the length of it.
+// If keys is not empty, only dumps the given keys.
+func DumpTable(w io.Writer, storePath, familyName string, fileNumber table.FileNumber, keys []uint32) error {
+	info, err := readStoreInfo(storePath)
+	if err != nil {
+		return err
+	}
+	familyOption, ok := info.Families[familyName]
+	if !ok {
+		return fmt.Errorf("family:%s not exist in store:%s", familyName, storePath)
+	}
+	fileName := version.Table(fileNumber)
+	path := filepath.Join(storePath, familyName, fileName)
+	reader, err := openReaderFunc(path, fileName)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = reader.Close()
+	}()
+	if len(keys) == 0 {
+		it := reader.Iterator()
+		for it.HasNext() {
+			keys = append(keys, it.Key())
+		}
+	}
+	inspector := GetBlockInspector(MergerType(familyOption.Merger))
+	_, _ = fmt.Fprintf(w, "file: %s, merger: %s, keys: %d\n", path, familyOption.Merger, len(keys))
+	for _, key := range keys {
+		block, err := reader.Get(key)
+		if err != nil {
+			_, _ = fmt.Fprintf(w, "key: %d, error: %s\n", key, err)
+			continue
+		}
+		_, _ = fmt.Fprintf(w, "key: %d, length: %d\n", key, len(block))
+		if inspector != nil {
+			if err := inspector.Dump(w, key, block); err != nil {
+				_, _ = fmt.Fprintf(w, "  decode error: %s\n", err)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,273 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kv
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/lindb/lindb/kv/table"
+	"github.com/lindb/lindb/kv/version"
+)
+
+func newFsckTestStore(t *testing.T) (storePath string, files []table.FileNumber) {
+	storePath = filepath.Join(t.TempDir(), "test_fsck")
+	kv, err := newStore("test_fsck", storePath, DefaultStoreOption())
+	assert.NoError(t, err)
+	f, err := kv.CreateFamily("f", FamilyOption{Merger: "mockMerger"})
+	assert.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		flusher := f.NewFlusher()
+		assert.NoError(t, flusher.Add(1, []byte("test")))
+		assert.NoError(t, flusher.Add(10, []byte("test10")))
+		assert.NoError(t, flusher.Commit())
+		flusher.Release()
+	}
+	assert.NoError(t, kv.close())
+	names, err := listDirFunc(filepath.Join(storePath, "f"))
+	assert.NoError(t, err)
+	for _, name := range names {
+		if fd := version.ParseFileName(name); fd != nil && fd.FileType == version.TypeTable {
+			files = append(files, fd.FileNumber)
+		}
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i] < files[j]
+	})
+	assert.Len(t, files, 2)
+	return storePath, files
+}
+
+func TestFsck_Healthy(t *testing.T) {
+	storePath, files := newFsckTestStore(t)
+	report, err := Fsck(storePath, FsckOption{Quarantine: true})
+	assert.NoError(t, err)
+	assert.True(t, report.Healthy())
+	assert.False(t, report.Repaired)
+	assert.NotEmpty(t, report.Manifest)
+	assert.Contains(t, report.Manifests, report.Manifest)
+	assert.Len(t, report.Families, 1)
+	familyReport := report.Families[0]
+	assert.Equal(t, "f", familyReport.Name)
+	assert.Len(t, familyReport.Files, 2)
+	for i, file := range familyReport.Files {
+		assert.Equal(t, files[i], file.FileNumber)
+		assert.Equal(t, 0, file.Level)
+		assert.Equal(t, 2, file.Keys)
+		assert.Equal(t, uint32(1), file.MinKey)
+		assert.Equal(t, uint32(10), file.MaxKey)
+	}
+	// store can be opened after fsck
+	kv, err := newStore("test_fsck", storePath, DefaultStoreOption())
+	assert.NoError(t, err)
+	assert.NoError(t, kv.close())
+}
+
+func TestFsck_Quarantine(t *testing.T) {
+	storePath, files := newFsckTestStore(t)
+	corrupted := filepath.Join(storePath, "f", version.Table(files[0]))
+	assert.NoError(t, os.WriteFile(corrupted, []byte("bad table file"), 0o644))
+	// check only, nothing changed
+	report, err := Fsck(storePath, FsckOption{})
+	assert.NoError(t, err)
+	assert.False(t, report.Healthy())
+	assert.False(t, report.Repaired)
+	assert.Error(t, report.Families[0].Files[0].Err)
+	assert.NoError(t, report.Families[0].Files[1].Err)
+	manifest := report.Manifest
+
+	report, err = Fsck(storePath, FsckOption{Quarantine: true})
+	assert.NoError(t, err)
+	assert.True(t, report.Repaired)
+	assert.Equal(t, []table.FileNumber{files[0]}, report.Families[0].Quarantined)
+	_, err = os.Stat(filepath.Join(storePath, QuarantineDir, "f", version.Table(files[0])))
+	assert.NoError(t, err)
+	_, err = os.Stat(corrupted)
+	assert.True(t, os.IsNotExist(err))
+
+	report, err = Fsck(storePath, FsckOption{})
+	assert.NoError(t, err)
+	assert.True(t, report.Healthy())
+	assert.NotEqual(t, manifest, report.Manifest)
+	assert.Len(t, report.Families[0].Files, 1)
+	assert.Equal(t, files[1], report.Families[0].Files[0].FileNumber)
+	// new file number doesn't reuse existing one after repair
+	kv, err := newStore("test_fsck", storePath, DefaultStoreOption())
+	assert.NoError(t, err)
+	f := kv.GetFamily("f")
+	snapshot := f.GetSnapshot()
+	readers, err := snapshot.FindReaders(1)
+	assert.NoError(t, err)
+	assert.Len(t, readers, 1)
+	snapshot.Close()
+	flusher := f.NewFlusher()
+	assert.NoError(t, flusher.Add(1, []byte("test")))
+	assert.NoError(t, flusher.Commit())
+	flusher.Release()
+	assert.NoError(t, kv.close())
+	report, err = Fsck(storePath, FsckOption{})
+	assert.NoError(t, err)
+	assert.True(t, report.Healthy())
+	assert.Len(t, report.Families[0].Files, 2)
+}
+
+func TestFsck_OrphanAndMissing(t *testing.T) {
+	storePath, files := newFsckTestStore(t)
+	familyPath := filepath.Join(storePath, "f")
+	// missing file
+	assert.NoError(t, os.Remove(filepath.Join(familyPath, version.Table(files[0]))))
+	// orphan file
+	data, err := os.ReadFile(filepath.Join(familyPath, version.Table(files[1])))
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(filepath.Join(familyPath, version.Table(1000)), data, 0o644))
+	assert.NoError(t, os.WriteFile(filepath.Join(familyPath, version.RemoteTable(1001)), nil, 0o644))
+
+	report, err := Fsck(storePath, FsckOption{Quarantine: true})
+	assert.NoError(t, err)
+	assert.False(t, report.Healthy())
+	assert.False(t, report.Repaired)
+	familyReport := report.Families[0]
+	assert.Equal(t, []table.FileNumber{files[0]}, familyReport.Missing)
+	assert.Len(t, familyReport.Orphans, 2)
+	assert.Equal(t, table.FileNumber(1000), familyReport.Orphans[0].FileNumber)
+	assert.Equal(t, 2, familyReport.Orphans[0].Keys)
+	assert.NoError(t, familyReport.Orphans[0].Err)
+	assert.True(t, familyReport.Orphans[1].Remote)
+}
+
+func TestFsck_RebuildManifest(t *testing.T) {
+	storePath, files := newFsckTestStore(t)
+	assert.NoError(t, os.Remove(filepath.Join(storePath, "CURRENT")))
+	report, err := Fsck(storePath, FsckOption{})
+	assert.NoError(t, err)
+	assert.Error(t, report.ManifestErr)
+	assert.False(t, report.Healthy())
+	assert.False(t, report.Repaired)
+	assert.Len(t, report.Families[0].Orphans, 2)
+
+	report, err = Fsck(storePath, FsckOption{RebuildManifest: true})
+	assert.NoError(t, err)
+	assert.True(t, report.Repaired)
+
+	report, err = Fsck(storePath, FsckOption{})
+	assert.NoError(t, err)
+	assert.True(t, report.Healthy())
+	assert.Len(t, report.Families[0].Files, 2)
+	for i, file := range report.Families[0].Files {
+		assert.Equal(t, files[i], file.FileNumber)
+	}
+	// rebuild failure
+	defer func() {
+		rewriteManifestFunc = version.RewriteManifest
+	}()
+	rewriteManifestFunc = func(_ version.StoreVersionSet, _ map[string]version.EditLog, _ table.FileNumber) error {
+		return fmt.Errorf("err")
+	}
+	assert.NoError(t, os.Remove(filepath.Join(storePath, "CURRENT")))
+	report, err = Fsck(storePath, FsckOption{RebuildManifest: true})
+	assert.Error(t, err)
+	assert.Nil(t, report)
+}
+
+func TestFsck_Error(t *testing.T) {
+	defer func() {
+		renameFileFunc = os.Rename
+	}()
+	// options file not exist
+	report, err := Fsck(t.TempDir(), FsckOption{})
+	assert.Error(t, err)
+	assert.Nil(t, report)
+
+	storePath, files := newFsckTestStore(t)
+	// store in use
+	kv, err := newStore("test_fsck", storePath, DefaultStoreOption())
+	assert.NoError(t, err)
+	report, err = Fsck(storePath, FsckOption{})
+	assert.Error(t, err)
+	assert.Nil(t, report)
+	assert.NoError(t, kv.close())
+
+	// quarantine failure
+	assert.NoError(t, os.WriteFile(filepath.Join(storePath, "f", version.Table(files[0])), []byte("bad"), 0o644))
+	renameFileFunc = func(_, _ string) error {
+		return fmt.Errorf("err")
+	}
+	report, err = Fsck(storePath, FsckOption{Quarantine: true})
+	assert.Error(t, err)
+	assert.Nil(t, report)
+}
+
+func TestDumpTable(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storePath, files := newFsckTestStore(t)
+	w := &bytes.Buffer{}
+	assert.NoError(t, DumpTable(w, storePath, "f", files[0], nil))
+	assert.Contains(t, w.String(), "keys: 2")
+	assert.Contains(t, w.String(), "key: 1, length: 4")
+	assert.Contains(t, w.String(), "key: 10, length: 6")
+
+	w.Reset()
+	assert.NoError(t, DumpTable(w, storePath, "f", files[0], []uint32{10, 100}))
+	assert.Contains(t, w.String(), "key: 10, length: 6")
+	assert.Contains(t, w.String(), "key: 100, error:")
+
+	// with inspector
+	inspector := NewMockBlockInspector(ctrl)
+	RegisterBlockInspector("mockMerger", inspector)
+	defer delete(inspectors, "mockMerger")
+	inspector.EXPECT().Dump(gomock.Any(), uint32(1), []byte("test")).Return(fmt.Errorf("err"))
+	w.Reset()
+	assert.NoError(t, DumpTable(w, storePath, "f", files[0], []uint32{1}))
+	assert.Contains(t, w.String(), "decode error: err")
+
+	// verify failure
+	inspector.EXPECT().Verify(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err")).AnyTimes()
+	report, err := Fsck(storePath, FsckOption{})
+	assert.NoError(t, err)
+	assert.False(t, report.Healthy())
+
+	// family not exist
+	assert.Error(t, DumpTable(w, storePath, "f2", files[0], nil))
+	// file not exist
+	assert.Error(t, DumpTable(w, storePath, "f", 1000, nil))
+	// store not exist
+	assert.Error(t, DumpTable(w, t.TempDir(), "f", 1000, nil))
+}
+
+func TestRegisterBlockInspector(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	inspector := NewMockBlockInspector(ctrl)
+	defer delete(inspectors, "test_inspector")
+	assert.Panics(t, func() {
+		RegisterBlockInspector("test_inspector", inspector)
+		RegisterBlockInspector("test_inspector", inspector)
+	})
+	assert.Equal(t, inspector, GetBlockInspector("test_inspector"))
+	assert.Nil(t, GetBlockInspector("not_exist"))
+}
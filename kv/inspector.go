@@ -0,0 +1,48 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kv
+
+import (
+	"io"
+)
+
+//go:generate mockgen -source ./inspector.go -destination=./inspector_mock.go -package kv
+
+var inspectors = make(map[MergerType]BlockInspector)
+
+// RegisterBlockInspector registers the value block inspector of family, keyed by the merger type of family.
+func RegisterBlockInspector(name MergerType, inspector BlockInspector) {
+	if _, ok := inspectors[name]; ok {
+		panic("block inspector already register")
+	}
+	inspectors[name] = inspector
+}
+
+// GetBlockInspector returns the value block inspector by the merger type of family, returns nil if not register.
+func GetBlockInspector(name MergerType) BlockInspector {
+	return inspectors[name]
+}
+
+// BlockInspector represents inspector which understands the value block format of family,
+// used by offline tools to verify and dump store files.
+type BlockInspector interface {
+	// Verify checks if the value block of key is corrupted.
+	Verify(key uint32, block []byte) error
+	// Dump writes the decoded value block of key in readable format.
+	Dump(w io.Writer, key uint32, block []byte) error
+}
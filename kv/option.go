@@ -22,6 +22,7 @@ import (
 
 	"github.com/lindb/common/pkg/ltoml"
 
+	"github.com/lindb/lindb/pkg/compress"
 	"github.com/lindb/lindb/pkg/timeutil"
 )
 
@@ -37,11 +38,12 @@ type FamilyOption struct {
 
 // StoreOption defines config item for store level
 type StoreOption struct {
-	Rollup          []timeutil.Interval `toml:"rollup"`
-	Levels          int                 `toml:"levels"`
-	TTL             ltoml.Duration      `toml:"ttl"`
-	CompactInterval ltoml.Duration      `toml:"compactInterval"`
-	Source          timeutil.Interval   `toml:"source"`
+	Rollup          []timeutil.Interval  `toml:"rollup"`
+	Levels          int                  `toml:"levels"`
+	TTL             ltoml.Duration       `toml:"ttl"`
+	CompactInterval ltoml.Duration       `toml:"compactInterval"`
+	Source          timeutil.Interval    `toml:"source"`
+	Compression     compress.BlockOption `toml:"compression"` // block compression of new store files
 }
 
 // DefaultStoreOption builds default store option
@@ -32,6 +32,7 @@ import (
 
 	"github.com/lindb/lindb/kv/table"
 	"github.com/lindb/lindb/kv/version"
+	lindbfileutil "github.com/lindb/lindb/pkg/fileutil"
 	"github.com/lindb/lindb/pkg/lockers"
 )
 
@@ -48,6 +49,8 @@ var (
 	removeFunc        = os.Remove
 	newFileLockFunc   = lockers.NewFileLock
 	newStoreFunc      = newStore
+	linkFileFunc      = lindbfileutil.LinkOrCopyFile
+	copyFileFunc      = lindbfileutil.CopyFile
 )
 
 // Store is kv store, supporting column family, but is different from other LSM implementation.
@@ -67,6 +70,9 @@ type Store interface {
 	Option() StoreOption
 	// ForceRollup does rollup job manual.
 	ForceRollup()
+	// Backup pins current version of all families,
+	// then links(copy if link not supported) all live files of those versions into backup path.
+	Backup(backupPath string) error
 
 	// compact the families under store.
 	compact()
@@ -270,6 +276,68 @@ func (s *store) ForceRollup() {
 	}
 }
 
+// Backup pins current version of all families,
+// then links(copy if link not supported) all live files of those versions into backup path.
+func (s *store) Backup(backupPath string) error {
+	if err := mkDirFunc(backupPath); err != nil {
+		return fmt.Errorf("create backup path error:%s", err)
+	}
+	// pin versions and write manifest, files of pinned versions cannot be deleted until snapshots closed.
+	snapshots, err := s.versions.Backup(backupPath)
+	if err != nil {
+		return fmt.Errorf("backup store version set error:%s", err)
+	}
+	defer func() {
+		for _, snapshot := range snapshots {
+			snapshot.Close()
+		}
+	}()
+	for familyName, snapshot := range snapshots {
+		familyBackupPath := filepath.Join(backupPath, familyName)
+		if err := mkDirFunc(familyBackupPath); err != nil {
+			return fmt.Errorf("create family backup path error:%s", err)
+		}
+		current := snapshot.GetCurrent()
+		files := make(map[table.FileNumber]struct{})
+		for _, file := range current.GetAllFiles() {
+			files[file.GetFileNumber()] = struct{}{}
+		}
+		// rollup files maybe not alive in current version, but need them when do rollup job after restore
+		for fileNumber := range current.GetRollupFiles() {
+			files[fileNumber] = struct{}{}
+		}
+		for fileNumber := range files {
+			fileName := version.Table(fileNumber)
+			if err := backupFile(filepath.Join(s.path, familyName, fileName),
+				filepath.Join(familyBackupPath, fileName)); err != nil {
+				return fmt.Errorf("backup family[%s] file[%s] error:%s", familyName, fileName, err)
+			}
+		}
+		// copy other files which maintained by upper layer under family path, like tombstones etc.
+		fileNames, err := listDirFunc(filepath.Join(s.path, familyName))
+		if err != nil {
+			return fmt.Errorf("list family[%s] files error:%s", familyName, err)
+		}
+		for _, fileName := range fileNames {
+			if version.ParseFileName(fileName) != nil || strings.HasSuffix(fileName, version.TmpSuffix) {
+				continue
+			}
+			if err := copyFileFunc(filepath.Join(s.path, familyName, fileName),
+				filepath.Join(familyBackupPath, fileName)); err != nil {
+				return fmt.Errorf("backup family[%s] file[%s] error:%s", familyName, fileName, err)
+			}
+		}
+	}
+	// dump store info after pinning versions, make sure all families in manifest are included.
+	s.rwMutex.RLock()
+	defer s.rwMutex.RUnlock()
+	infoPath := filepath.Join(backupPath, version.Options)
+	if err := encodeTomlFunc(infoPath, s.storeInfo); err != nil {
+		return fmt.Errorf("write store info to file[%s] error:%s", infoPath, err)
+	}
+	return nil
+}
+
 // close the store, then release some resource
 func (s *store) close() error {
 	// close each family in kv store.
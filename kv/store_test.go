@@ -31,6 +31,7 @@ import (
 
 	"github.com/lindb/lindb/kv/table"
 	"github.com/lindb/lindb/kv/version"
+	lindbfileutil "github.com/lindb/lindb/pkg/fileutil"
 	"github.com/lindb/lindb/pkg/lockers"
 )
 
@@ -204,6 +205,106 @@ func TestStore_CreateFamily(t *testing.T) {
 	s.deleteFamilyObsoleteFiles()
 }
 
+func TestStore_Backup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	dir := t.TempDir()
+	path := filepath.Join(dir, "kv")
+	backupPath := filepath.Join(dir, "backup")
+	option := DefaultStoreOption()
+	defer func() {
+		encodeTomlFunc = ltoml.EncodeToml
+		mkDirFunc = fileutil.MkDirIfNotExist
+		linkFileFunc = lindbfileutil.LinkOrCopyFile
+		copyFileFunc = lindbfileutil.CopyFile
+		listDirFunc = fileutil.ListDir
+		ctrl.Finish()
+	}()
+
+	kv, err := newStore("test_kv", path, option)
+	assert.NoError(t, err)
+	f, err := kv.CreateFamily("f", FamilyOption{Merger: "mockMerger"})
+	assert.NoError(t, err)
+	flusher := f.NewFlusher()
+	_ = flusher.Add(1, []byte("test"))
+	assert.NoError(t, flusher.Commit())
+	flusher.Release()
+	assert.NoError(t, os.WriteFile(filepath.Join(path, "f", "TOMBSTONE"), []byte("tombstone"), 0644))
+
+	backup := func() error {
+		assert.NoError(t, os.RemoveAll(backupPath))
+		return kv.Backup(backupPath)
+	}
+	// case 1: create backup path failure
+	mkDirFunc = func(_ string) error {
+		return fmt.Errorf("err")
+	}
+	assert.Error(t, backup())
+	// case 2: create family backup path failure
+	mkDirFunc = func(p string) error {
+		if p == backupPath {
+			return fileutil.MkDirIfNotExist(p)
+		}
+		return fmt.Errorf("err")
+	}
+	assert.Error(t, backup())
+	// case 3: link file failure
+	mkDirFunc = fileutil.MkDirIfNotExist
+	linkFileFunc = func(_, _ string) error {
+		return fmt.Errorf("err")
+	}
+	assert.Error(t, backup())
+	// case 4: list family files failure
+	linkFileFunc = lindbfileutil.LinkOrCopyFile
+	listDirFunc = func(_ string) ([]string, error) {
+		return nil, fmt.Errorf("err")
+	}
+	assert.Error(t, backup())
+	// case 5: copy family file failure
+	listDirFunc = fileutil.ListDir
+	copyFileFunc = func(_, _ string) error {
+		return fmt.Errorf("err")
+	}
+	assert.Error(t, backup())
+	// case 6: dump store info failure
+	copyFileFunc = lindbfileutil.CopyFile
+	encodeTomlFunc = func(_ string, _ interface{}) error {
+		return fmt.Errorf("err")
+	}
+	assert.Error(t, backup())
+	// case 7: backup successfully
+	encodeTomlFunc = ltoml.EncodeToml
+	assert.NoError(t, backup())
+	tombstone, err := os.ReadFile(filepath.Join(backupPath, "f", "TOMBSTONE"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("tombstone"), tombstone)
+	// case 8: backup store version set failure
+	versions := version.NewMockStoreVersionSet(ctrl)
+	s := kv.(*store)
+	versionSet := s.versions
+	s.versions = versions
+	versions.EXPECT().Backup(gomock.Any()).Return(nil, fmt.Errorf("err"))
+	assert.Error(t, kv.Backup(filepath.Join(dir, "backup_err")))
+	s.versions = versionSet
+	assert.NoError(t, kv.close())
+
+	// open store from backup path
+	kv, err = newStore("test_kv", backupPath, option)
+	assert.NoError(t, err)
+	defer func() {
+		assert.NoError(t, kv.close())
+	}()
+	f = kv.GetFamily("f")
+	assert.NotNil(t, f)
+	snapshot := f.GetSnapshot()
+	defer snapshot.Close()
+	readers, err := snapshot.FindReaders(1)
+	assert.NoError(t, err)
+	assert.Len(t, readers, 1)
+	value, err := readers[0].Get(1)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("test"), value)
+}
+
 func TestStore_deleteObsoleteFiles(t *testing.T) {
 	path := filepath.Join(t.TempDir(), "test_data")
 	option := DefaultStoreOption()
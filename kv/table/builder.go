@@ -29,6 +29,7 @@ import (
 
 	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/pkg/bufioutil"
+	"github.com/lindb/lindb/pkg/compress"
 	"github.com/lindb/lindb/pkg/encoding"
 )
 
@@ -97,6 +98,8 @@ type storeBuilder struct {
 	fileName   string
 	writer     bufioutil.BufioWriter
 	offset     *encoding.FixedOffsetEncoder
+	codec      compress.BlockCodec
+	scratch    []byte // buffer for compressed block
 
 	// see paper of roaring bitmap: https://arxiv.org/pdf/1603.06549.pdf
 	keys   *roaring.Bitmap
@@ -106,8 +109,18 @@ type storeBuilder struct {
 	first bool
 }
 
-// NewStoreBuilder creates store builder instance for building store file
+// NewStoreBuilder creates store builder instance for building store file without block compression.
 func NewStoreBuilder(fileNumber FileNumber, fileName string) (Builder, error) {
+	codec, err := compress.GetBlockCodecByType(compress.NoCompression, 0)
+	if err != nil {
+		return nil, err
+	}
+	return NewStoreBuilderWithCodec(fileNumber, fileName, codec)
+}
+
+// NewStoreBuilderWithCodec creates store builder instance for building store file,
+// each value block is compressed by codec, and compression type is recorded in file footer.
+func NewStoreBuilderWithCodec(fileNumber FileNumber, fileName string, codec compress.BlockCodec) (Builder, error) {
 	writer, err := newBufioWriterFunc(fileName)
 	if err != nil {
 		return nil, fmt.Errorf("create file write for store builder error:%s", err)
@@ -119,6 +132,7 @@ func NewStoreBuilder(fileNumber FileNumber, fileName string) (Builder, error) {
 		writer:     writer,
 		first:      true,
 		offset:     encoding.NewFixedOffsetEncoder(true),
+		codec:      codec,
 	}, nil
 }
 
@@ -162,8 +176,8 @@ func (b *storeBuilder) Add(key uint32, value []byte) error {
 
 	// get write offset
 	offset := b.writer.Size()
-	if _, err := b.writer.Write(value); err != nil {
-		return fmt.Errorf("write data into store file error:%s", err)
+	if err := b.writeBlock(value); err != nil {
+		return err
 	}
 	metrics.TableWriteStatistics.AddKeys.Incr()
 	metrics.TableWriteStatistics.WriteBytes.Add(float64(len(value)))
@@ -171,6 +185,18 @@ func (b *storeBuilder) Add(key uint32, value []byte) error {
 	return nil
 }
 
+// writeBlock compresses the value block if need, then writes it into store file.
+func (b *storeBuilder) writeBlock(value []byte) error {
+	if b.codec.Type() != compress.NoCompression {
+		b.scratch = b.codec.Compress(b.scratch[:0], value)
+		value = b.scratch
+	}
+	if _, err := b.writer.Write(value); err != nil {
+		return fmt.Errorf("write data into store file error:%s", err)
+	}
+	return nil
+}
+
 // MinKey returns min key in store
 func (b *storeBuilder) MinKey() uint32 {
 	return b.minKey
@@ -222,18 +248,30 @@ func (b *storeBuilder) Close() (err error) {
 		return err
 	}
 
-	// for file footer for offsets/keys index, length=1+4+4+8
-	var buf [17]byte
-	binary.LittleEndian.PutUint32(buf[:4], uint32(posOfOffset))
-	binary.LittleEndian.PutUint32(buf[4:8], uint32(posOfKeys))
-	buf[8] = version0
-	binary.LittleEndian.PutUint64(buf[9:], magicNumberOffsetFile)
-	if _, err = b.writer.Write(buf[:]); err != nil {
+	if _, err = b.writer.Write(b.footer(posOfOffset, posOfKeys)); err != nil {
 		return err
 	}
 	return nil
 }
 
+// footer returns the file footer for offsets/keys index,
+// writes version0 footer(length=4+4+1+8) if no compression, keeps file readable by old version,
+// else writes version1 footer(length=4+4+1+1+8) with compression type.
+func (b *storeBuilder) footer(posOfOffset, posOfKeys int64) []byte {
+	var buf [sstFileFooterSizeV1]byte
+	binary.LittleEndian.PutUint32(buf[:4], uint32(posOfOffset))
+	binary.LittleEndian.PutUint32(buf[4:8], uint32(posOfKeys))
+	if b.codec.Type() == compress.NoCompression {
+		buf[8] = version0
+		binary.LittleEndian.PutUint64(buf[9:], magicNumberOffsetFile)
+		return buf[:sstFileFooterSize]
+	}
+	buf[8] = byte(b.codec.Type())
+	buf[9] = version1
+	binary.LittleEndian.PutUint64(buf[10:], magicNumberOffsetFile)
+	return buf[:]
+}
+
 func (b *storeBuilder) StreamWriter() StreamWriter {
 	return newStreamWriter(b)
 }
@@ -246,8 +284,11 @@ func newStreamWriter(builder *storeBuilder) *streamWriter {
 	}
 }
 
+// streamWriter writes data into store file directly if no compression,
+// else buffers data of current key, then compresses and writes it when committing.
 type streamWriter struct {
 	builder *storeBuilder
+	buf     []byte
 	size    uint32
 	key     uint32
 	offset  int64
@@ -260,6 +301,7 @@ func (sw *streamWriter) Prepare(key uint32) {
 	sw.offset = sw.builder.writer.Size()
 	sw.key = key
 	sw.size = 0
+	sw.buf = sw.buf[:0]
 	sw.crc32.Reset()
 }
 
@@ -267,7 +309,16 @@ func (sw *streamWriter) Write(data []byte) (int, error) {
 	if sw.badKey {
 		return 0, nil
 	}
-	n, err := sw.builder.writer.Write(data)
+	var (
+		n   int
+		err error
+	)
+	if sw.builder.codec.Type() == compress.NoCompression {
+		n, err = sw.builder.writer.Write(data)
+	} else {
+		sw.buf = append(sw.buf, data...)
+		n = len(data)
+	}
 	_, _ = sw.crc32.Write(data)
 	if err == nil {
 		sw.size += uint32(n)
@@ -288,6 +339,11 @@ func (sw *streamWriter) Commit() error {
 	if sw.badKey {
 		return nil
 	}
+	if sw.builder.codec.Type() != compress.NoCompression {
+		if err := sw.builder.writeBlock(sw.buf); err != nil {
+			return err
+		}
+	}
 	sw.builder.afterWrite(sw.key, int(sw.offset))
 	// preventing committing twice
 	sw.badKey = true
@@ -23,6 +23,7 @@ import (
 	"hash/crc32"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -32,6 +33,7 @@ import (
 	"github.com/lindb/roaring"
 
 	"github.com/lindb/lindb/pkg/bufioutil"
+	"github.com/lindb/lindb/pkg/compress"
 	"github.com/lindb/lindb/pkg/encoding"
 )
 
@@ -210,6 +212,86 @@ func Test_StreamWriter_CheckSum32(t *testing.T) {
 	assert.Equal(t, uint32(2180413220), writer.CRC32CheckSum())
 }
 
+func TestStoreBuilder_Compression(t *testing.T) {
+	value := []byte(strings.Repeat("compressible value,", 100))
+	build := func(path string, codec compress.BlockCodec) int64 {
+		builder, err := NewStoreBuilderWithCodec(10, path, codec)
+		assert.NoError(t, err)
+		assert.NoError(t, builder.Add(1, value))
+		writer := builder.StreamWriter()
+		writer.Prepare(2)
+		_, _ = writer.Write(value[:10])
+		_, _ = writer.Write(value[10:])
+		// size/checksum based on raw data
+		assert.Equal(t, uint32(len(value)), writer.Size())
+		assert.Equal(t, crc32.ChecksumIEEE(value), writer.CRC32CheckSum())
+		assert.NoError(t, writer.Commit())
+		// bad key
+		writer.Prepare(2)
+		_, _ = writer.Write(value)
+		assert.NoError(t, writer.Commit())
+		assert.NoError(t, builder.Close())
+		stat, err := os.Stat(path)
+		assert.NoError(t, err)
+		return stat.Size()
+	}
+	dir := t.TempDir()
+	noCodec, _ := compress.GetBlockCodecByType(compress.NoCompression, 0)
+	rawSize := build(filepath.Join(dir, "000010.sst"), noCodec)
+
+	cases := []struct {
+		name   string
+		option compress.BlockOption
+	}{
+		{name: "zstd", option: compress.BlockOption{Type: "zstd", Level: 9}},
+		{name: "lz4", option: compress.BlockOption{Type: "lz4"}},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			codec, err := compress.GetBlockCodec(tt.option)
+			assert.NoError(t, err)
+			path := filepath.Join(dir, tt.name+".sst")
+			assert.Less(t, build(path, codec), rawSize)
+
+			r, err := newMMapStoreReader(path, tt.name+".sst")
+			assert.NoError(t, err)
+			defer func() {
+				_ = r.Close()
+			}()
+			for _, key := range []uint32{1, 2} {
+				block, err := r.Get(key)
+				assert.NoError(t, err)
+				assert.Equal(t, value, block)
+			}
+		})
+	}
+}
+
+func TestStoreBuilder_Compression_Write_Err(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newBufioWriterFunc = bufioutil.NewBufioStreamWriter
+		ctrl.Finish()
+	}()
+	writer := bufioutil.NewMockBufioWriter(ctrl)
+	newBufioWriterFunc = func(fileName string) (bufioutil.BufioWriter, error) {
+		return writer, nil
+	}
+	codec, _ := compress.GetBlockCodecByType(compress.ZSTD, 0)
+	builder, err := NewStoreBuilderWithCodec(10, "000010.sst", codec)
+	assert.NoError(t, err)
+	writer.EXPECT().Size().Return(int64(0)).AnyTimes()
+	writer.EXPECT().Write(gomock.Any()).Return(0, fmt.Errorf("err")).AnyTimes()
+	sw := builder.StreamWriter()
+	sw.Prepare(1)
+	n, err := sw.Write([]byte("test"))
+	assert.NoError(t, err)
+	assert.Equal(t, 4, n)
+	assert.Error(t, sw.Commit())
+	assert.Error(t, builder.Add(2, []byte("test")))
+}
+
 func Benchmark_CRC32_1MB(b *testing.B) {
 	hasher := crc32.New(crc32.IEEETable)
 	buf := make([]byte, 1024)
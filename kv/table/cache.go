@@ -25,6 +25,7 @@ import (
 
 	"go.uber.org/atomic"
 
+	"github.com/lindb/common/pkg/fileutil"
 	"github.com/lindb/common/pkg/logger"
 	"github.com/lindb/common/pkg/timeutil"
 
@@ -36,6 +37,7 @@ import (
 // for test
 var (
 	newMMapStoreReaderFunc = newMMapStoreReader
+	fileExist              = fileutil.Exist
 )
 
 // Cache caches table readers.
@@ -110,7 +112,7 @@ func (c *storeCache) GetReader(family, fileName string) (Reader, error) {
 	metrics.TableCacheStatistics.ActiveReaders.Incr()
 	// create new reader
 	path := filepath.Join(c.storePath, family, fileName)
-	newReader, err := newMMapStoreReaderFunc(path, fileName)
+	newReader, err := newStoreReader(path, fileName)
 	if err != nil {
 		return nil, err
 	}
@@ -132,6 +134,22 @@ func (c *storeCache) GetReader(family, fileName string) (Reader, error) {
 	return newReader, nil
 }
 
+// OpenReader opens store file reader without cache, invoker need close it after reading, used by offline tools.
+func OpenReader(path, fileName string) (Reader, error) {
+	return newStoreReader(path, fileName)
+}
+
+// newStoreReader creates store file reader, if the file has been offloaded into remote tier,
+// creates remote reader based on the stub file.
+func newStoreReader(path, fileName string) (Reader, error) {
+	if !fileExist(path) {
+		if tier := GetRemoteTier(); tier != nil && fileExist(path+RemoteSuffix) {
+			return newRemoteStoreReaderFunc(tier.Backend, path+RemoteSuffix, fileName)
+		}
+	}
+	return newMMapStoreReaderFunc(path, fileName)
+}
+
 // Cleanup cleans the expired reader from cache.
 func (c *storeCache) Cleanup() {
 	c.mutex.Lock()
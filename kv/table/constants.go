@@ -30,14 +30,24 @@ var (
 const (
 	// magic-number in the footer of sst file
 	magicNumberOffsetFile uint64 = 0x69632d656d656c65
-	// current file layout version
+	// file layout version without block compression
 	version0 = 0
+	// current file layout version, compression type of blocks is recorded in footer
+	version1 = 1
 
+	// footer size of version0 file
 	sstFileFooterSize = 4 + // posOfOffset(4)
 		4 + // posOfKeys(4)
 		1 + // version(1)
 		8 // magicNumber(8)
-	magicNumberAtFooter = 9
+	// footer size of version1 file
+	sstFileFooterSizeV1 = 4 + // posOfOffset(4)
+		4 + // posOfKeys(4)
+		1 + // compression(1)
+		1 + // version(1)
+		8 // magicNumber(8)
+	// position of version from the end of file, same for all versions
+	versionAtFooter = 9
 )
 
 var tableLogger = logger.GetLogger("KV", "Table")
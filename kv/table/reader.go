@@ -28,6 +28,7 @@ import (
 	"github.com/lindb/roaring"
 
 	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/pkg/compress"
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/fileutil"
 )
@@ -65,6 +66,7 @@ type storeMMapReader struct {
 	f            *os.File
 	keys         *roaring.Bitmap
 	offsets      *encoding.FixedOffsetDecoder
+	codec        compress.BlockCodec
 	path         string
 	fileName     string
 	fullBlock    []byte
@@ -120,37 +122,89 @@ func newMMapStoreReader(path, fileName string) (r Reader, err error) {
 
 // initialize store reader, reads index block(keys,offset etc.), then caches it.
 func (r *storeMMapReader) initialize() error {
-	// decode footer
-	footerStart := len(r.fullBlock) - sstFileFooterSize
+	r.offsets = encoding.NewFixedOffsetDecoder()
+	footer, err := decodeIndex(r.path, r.fullBlock, 0, r.offsets, r.keys)
+	if err != nil {
+		return err
+	}
+	r.codec, err = compress.GetBlockCodecByType(footer.compression, 0)
+	if err != nil {
+		return fmt.Errorf("get block codec of sstfile:%s error:%s", r.path, err)
+	}
+	// read entries block
+	r.entriesBlock = r.fullBlock[:footer.posOfOffset]
+	return nil
+}
+
+// footer represents the footer of store file.
+type footer struct {
+	posOfOffset int
+	posOfKeys   int
+	compression compress.Type
+	size        int // length of footer
+}
+
+// decodeFooter decodes footer from the tail of store file, version byte is before magic-number in all versions.
+func decodeFooter(path string, tail []byte) (*footer, error) {
+	if len(tail) < sstFileFooterSize {
+		return nil, fmt.Errorf("length of sstfile:%s footer is too short", path)
+	}
 	// validate magic-number
-	if uint64Func(r.fullBlock[footerStart+magicNumberAtFooter:]) != magicNumberOffsetFile {
-		return fmt.Errorf("verify magic-number of sstfile:%s failure", r.path)
+	if uint64Func(tail[len(tail)-8:]) != magicNumberOffsetFile {
+		return nil, fmt.Errorf("verify magic-number of sstfile:%s failure", path)
+	}
+	f := &footer{}
+	switch version := tail[len(tail)-versionAtFooter]; version {
+	case version0:
+		f.size = sstFileFooterSize
+	case version1:
+		if len(tail) < sstFileFooterSizeV1 {
+			return nil, fmt.Errorf("length of sstfile:%s footer is too short", path)
+		}
+		f.size = sstFileFooterSizeV1
+		f.compression = compress.Type(tail[len(tail)-versionAtFooter-1])
+	default:
+		return nil, fmt.Errorf("unknown version of sstfile:%s, version: %d", path, version)
+	}
+	footerStart := len(tail) - f.size
+	f.posOfOffset = int(binary.LittleEndian.Uint32(tail[footerStart : footerStart+4]))
+	f.posOfKeys = int(binary.LittleEndian.Uint32(tail[footerStart+4 : footerStart+8]))
+	return f, nil
+}
+
+// decodeIndex decodes index block(offsets/keys) from the tail of store file,
+// tail is the data of store file starting at base position, returns the footer of store file.
+func decodeIndex(path string, tail []byte, base int,
+	offsets *encoding.FixedOffsetDecoder, keys *roaring.Bitmap,
+) (*footer, error) {
+	// decode footer
+	f, err := decodeFooter(path, tail)
+	if err != nil {
+		return nil, err
 	}
-	posOfOffset := int(binary.LittleEndian.Uint32(r.fullBlock[footerStart : footerStart+4]))
-	posOfKeys := int(binary.LittleEndian.Uint32(r.fullBlock[footerStart+4 : footerStart+8]))
+	footerStart := len(tail) - f.size
+	posOfOffset := f.posOfOffset
+	posOfKeys := f.posOfKeys
 	if !intsAreSortedFunc([]int{
-		0, posOfOffset, posOfKeys, footerStart,
+		base, posOfOffset, posOfKeys, base + footerStart,
 	}) {
-		return fmt.Errorf("bad footer data, posOfOffsets: %d posOfKeys: %d,"+
-			" footerStart: %d", posOfOffset, posOfKeys, footerStart)
+		return nil, fmt.Errorf("bad footer data, posOfOffsets: %d posOfKeys: %d,"+
+			" footerStart: %d", posOfOffset, posOfKeys, base+footerStart)
 	}
 	// decode offsets
-	offsetsBlock := r.fullBlock[posOfOffset:posOfKeys]
-	r.offsets = encoding.NewFixedOffsetDecoder()
-	if err := unmarshalFixedOffsetFunc(r.offsets, offsetsBlock); err != nil {
-		return fmt.Errorf("unmarshal fixed-offsets decoder with error: %s", err)
+	offsetsBlock := tail[posOfOffset-base : posOfKeys-base]
+	if err := unmarshalFixedOffsetFunc(offsets, offsetsBlock); err != nil {
+		return nil, fmt.Errorf("unmarshal fixed-offsets decoder with error: %s", err)
 	}
 	// decode keys
-	if _, err := encoding.BitmapUnmarshal(r.keys, r.fullBlock[posOfKeys:]); err != nil {
-		return fmt.Errorf("unmarshal keys data from file[%s] error:%s", r.path, err)
+	if _, err := encoding.BitmapUnmarshal(keys, tail[posOfKeys-base:footerStart]); err != nil {
+		return nil, fmt.Errorf("unmarshal keys data from file[%s] error:%s", path, err)
 	}
 	// validate keys and offsets
-	if r.offsets.Size() != int(r.keys.GetCardinality()) {
-		return fmt.Errorf("num. of keys != num. of offsets in file[%s]", r.path)
+	if offsets.Size() != int(keys.GetCardinality()) {
+		return nil, fmt.Errorf("num. of keys != num. of offsets in file[%s]", path)
 	}
-	// read entries block
-	r.entriesBlock = r.fullBlock[:posOfOffset]
-	return nil
+	return f, nil
 }
 
 func unmarshalFixedOffset(decoder *encoding.FixedOffsetDecoder, data []byte) error {
@@ -180,6 +234,9 @@ func (r *storeMMapReader) Get(key uint32) ([]byte, error) {
 
 func (r *storeMMapReader) getBlock(idx int) ([]byte, error) {
 	block, err := r.offsets.GetBlock(idx, r.entriesBlock)
+	if err == nil {
+		block, err = r.codec.Decompress(block)
+	}
 	if err == nil {
 		metrics.TableReadStatistics.Gets.Incr()
 		metrics.TableReadStatistics.ReadBytes.Add(float64(len(block)))
@@ -209,9 +266,15 @@ func (r *storeMMapReader) Close() error {
 	return err
 }
 
-// storeMMapIterator iterates k/v pair using mmap store reader
+// blockReader represents reader which reads block by index.
+type blockReader interface {
+	// getBlock returns the block by index.
+	getBlock(idx int) ([]byte, error)
+}
+
+// storeMMapIterator iterates k/v pair using mmap/remote store reader
 type storeMMapIterator struct {
-	reader *storeMMapReader
+	reader blockReader
 	keyIt  roaring.IntIterable
 
 	idx int
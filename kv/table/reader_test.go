@@ -31,6 +31,7 @@ import (
 	commonfileutil "github.com/lindb/common/pkg/fileutil"
 	"github.com/lindb/roaring"
 
+	"github.com/lindb/lindb/pkg/compress"
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/fileutil"
 )
@@ -228,3 +229,77 @@ func TestStoreIterator(t *testing.T) {
 
 	assert.False(t, it.HasNext())
 }
+
+func TestReader_Footer_Version(t *testing.T) {
+	dir := t.TempDir()
+	path := buildStoreFile(t, dir)
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	writeFooter := func(data []byte) string {
+		p := filepath.Join(dir, "000011.sst")
+		assert.NoError(t, os.WriteFile(p, data, 0o644))
+		return p
+	}
+	// version0 file without compression byte in footer if no compression
+	assert.Equal(t, byte(version0), data[len(data)-versionAtFooter])
+	r, err := newMMapStoreReader(path, "000010.sst")
+	assert.NoError(t, err)
+	value, err := r.Get(10)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("test10"), value)
+	assert.NoError(t, r.Close())
+
+	// version1 file with compression byte in footer
+	codec, _ := compress.GetBlockCodecByType(compress.LZ4, 0)
+	builder, err := NewStoreBuilderWithCodec(10, path, codec)
+	assert.NoError(t, err)
+	assert.NoError(t, builder.Add(10, []byte("test10")))
+	assert.NoError(t, builder.Close())
+	data, err = os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, byte(version1), data[len(data)-versionAtFooter])
+	assert.Equal(t, byte(compress.LZ4), data[len(data)-versionAtFooter-1])
+	r, err = newMMapStoreReader(path, "000010.sst")
+	assert.NoError(t, err)
+	value, err = r.Get(10)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("test10"), value)
+	assert.NoError(t, r.Close())
+
+	// unknown version
+	bad := append([]byte{}, data...)
+	bad[len(bad)-versionAtFooter] = 100
+	_, err = newMMapStoreReader(writeFooter(bad), "000011.sst")
+	assert.Error(t, err)
+	// unknown compression type
+	bad = append([]byte{}, data...)
+	bad[len(bad)-versionAtFooter-1] = 100
+	_, err = newMMapStoreReader(writeFooter(bad), "000011.sst")
+	assert.Error(t, err)
+	// footer of version1 too short
+	_, err = decodeFooter(path, data[len(data)-sstFileFooterSize:])
+	assert.Error(t, err)
+}
+
+func TestReader_Decompress_Err(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "000010.sst")
+	codec, _ := compress.GetBlockCodecByType(compress.LZ4, 0)
+	builder, err := NewStoreBuilderWithCodec(10, path, codec)
+	assert.NoError(t, err)
+	assert.NoError(t, builder.Add(1, []byte("test")))
+	assert.NoError(t, builder.Close())
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	// corrupt length of raw data
+	data[0] = 0xff
+	assert.NoError(t, os.WriteFile(path, data, 0o644))
+
+	r, err := newMMapStoreReader(path, "000010.sst")
+	assert.NoError(t, err)
+	defer func() {
+		_ = r.Close()
+	}()
+	_, err = r.Get(1)
+	assert.Error(t, err)
+}
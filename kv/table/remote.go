@@ -0,0 +1,331 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package table
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path"
+	"sync"
+
+	"github.com/lindb/roaring"
+
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/pkg/blob"
+	"github.com/lindb/lindb/pkg/compress"
+	"github.com/lindb/lindb/pkg/encoding"
+)
+
+// RemoteSuffix is the suffix of stub file, which keeps the index of store file offloaded into remote tier.
+const RemoteSuffix = ".remote"
+
+const (
+	// magic-number in the footer of stub file
+	magicNumberRemoteFile uint64 = 0x72656d6f74652d74
+
+	stubFileFooterSize = 4 + // length of object key(4)
+		8 // magicNumber(8)
+)
+
+// for testing
+var (
+	newRemoteStoreReaderFunc = newRemoteStoreReader
+	readFileFn               = os.ReadFile
+	renameFileFn             = os.Rename
+	removeFileFn             = os.Remove
+)
+
+var (
+	remoteTier     *RemoteTier
+	remoteTierLock sync.RWMutex
+)
+
+// RemoteTier represents the remote tier which stores the store files offloaded from local disk.
+type RemoteTier struct {
+	Backend blob.Backend
+	Prefix  string // key prefix of the objects offloaded by current node
+}
+
+// InitRemoteTier initializes the remote tier, disables tiered storage if tier is nil.
+func InitRemoteTier(tier *RemoteTier) {
+	remoteTierLock.Lock()
+	defer remoteTierLock.Unlock()
+
+	remoteTier = tier
+}
+
+// GetRemoteTier returns the remote tier, returns nil if tiered storage is disabled.
+func GetRemoteTier() *RemoteTier {
+	remoteTierLock.RLock()
+	defer remoteTierLock.RUnlock()
+
+	return remoteTier
+}
+
+// Offload uploads the store file into remote tier, then writes the stub file(path+RemoteSuffix)
+// which only keeps the index block(offsets/keys/footer) of store file locally.
+// NOTE: local store file isn't removed, caller need remove it after offloading successfully.
+func Offload(tier *RemoteTier, filePath, key string) error {
+	f, err := openFileFn(filePath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	stat, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	size := stat.Size()
+	if size < sstFileFooterSize {
+		return fmt.Errorf("length of sstfile:%s length is too short", filePath)
+	}
+	// read the max footer of all versions, decodes the actual footer based on version
+	footerBuf := make([]byte, min(size, sstFileFooterSizeV1))
+	if _, err := f.ReadAt(footerBuf, size-int64(len(footerBuf))); err != nil {
+		return err
+	}
+	footer, err := decodeFooter(filePath, footerBuf)
+	if err != nil {
+		return err
+	}
+	posOfOffset := int64(footer.posOfOffset)
+	if posOfOffset > size-int64(footer.size) {
+		return fmt.Errorf("bad footer data of sstfile:%s, posOfOffsets: %d", filePath, posOfOffset)
+	}
+	tail := make([]byte, size-posOfOffset)
+	if _, err := f.ReadAt(tail, posOfOffset); err != nil {
+		return err
+	}
+	// validate index block before uploading
+	if _, err := decodeIndex(filePath, tail, int(posOfOffset), encoding.NewFixedOffsetDecoder(), roaring.New()); err != nil {
+		return err
+	}
+	objectKey := path.Join(tier.Prefix, key)
+	if err := tier.Backend.Put(objectKey, f); err != nil {
+		return fmt.Errorf("upload sstfile:%s to remote tier error:%s", filePath, err)
+	}
+	// stub file layout: index block of store file + object key + length of object key + magic-number
+	stub := make([]byte, len(tail)+len(objectKey)+stubFileFooterSize)
+	copy(stub, tail)
+	copy(stub[len(tail):], objectKey)
+	binary.LittleEndian.PutUint32(stub[len(stub)-stubFileFooterSize:], uint32(len(objectKey)))
+	binary.LittleEndian.PutUint64(stub[len(stub)-8:], magicNumberRemoteFile)
+
+	stubPath := filePath + RemoteSuffix
+	tmp := stubPath + ".tmp"
+	if err := writeFileSync(tmp, stub); err != nil {
+		_ = removeFileFn(tmp)
+		return err
+	}
+	return renameFileFn(tmp, stubPath)
+}
+
+// Download downloads the store file offloaded into remote tier based on stub file,
+// then writes it into the target path.
+func Download(tier *RemoteTier, stubPath, target string) error {
+	tail, key, err := readStub(stubPath)
+	if err != nil {
+		return err
+	}
+	posOfOffset, err := posOfOffsetInTail(stubPath, tail)
+	if err != nil {
+		return err
+	}
+	if _, err := decodeIndex(stubPath, tail, posOfOffset, encoding.NewFixedOffsetDecoder(), roaring.New()); err != nil {
+		return err
+	}
+	size := int64(posOfOffset + len(tail))
+	data, err := tier.Backend.GetRange(key, 0, size)
+	if err != nil {
+		return err
+	}
+	if int64(len(data)) != size {
+		return fmt.Errorf("remote object[%s] is corrupted, expect size: %d, actual size: %d", key, size, len(data))
+	}
+	return writeFileSync(target, data)
+}
+
+// RemoveRemoteFile removes the store file offloaded into remote tier, and the stub file of it.
+func RemoveRemoteFile(tier *RemoteTier, stubPath string) error {
+	_, key, err := readStub(stubPath)
+	if err != nil {
+		return err
+	}
+	if err := tier.Backend.Delete(key); err != nil {
+		return fmt.Errorf("delete remote object[%s] error:%s", key, err)
+	}
+	return removeFileFn(stubPath)
+}
+
+// storeRemoteReader represents store file reader which reads blocks from remote tier on demand.
+type storeRemoteReader struct {
+	backend     blob.Backend
+	codec       compress.BlockCodec
+	keys        *roaring.Bitmap
+	offsets     *encoding.FixedOffsetDecoder
+	path        string
+	fileName    string
+	key         string
+	entriesSize int
+}
+
+// newRemoteStoreReader creates store file reader based on stub file.
+func newRemoteStoreReader(backend blob.Backend, stubPath, fileName string) (Reader, error) {
+	tail, key, err := readStub(stubPath)
+	if err != nil {
+		return nil, err
+	}
+	posOfOffset, err := posOfOffsetInTail(stubPath, tail)
+	if err != nil {
+		return nil, err
+	}
+	reader := &storeRemoteReader{
+		backend:     backend,
+		keys:        roaring.New(),
+		offsets:     encoding.NewFixedOffsetDecoder(),
+		path:        stubPath,
+		fileName:    fileName,
+		key:         key,
+		entriesSize: posOfOffset,
+	}
+	footer, err := decodeIndex(stubPath, tail, posOfOffset, reader.offsets, reader.keys)
+	if err != nil {
+		return nil, err
+	}
+	reader.codec, err = compress.GetBlockCodecByType(footer.compression, 0)
+	if err != nil {
+		return nil, fmt.Errorf("get block codec of stub file:%s error:%s", stubPath, err)
+	}
+	return reader, nil
+}
+
+// Path returns the stub file path.
+func (r *storeRemoteReader) Path() string {
+	return r.path
+}
+
+// FileName returns the file name of reader.
+func (r *storeRemoteReader) FileName() string {
+	return r.fileName
+}
+
+// Get return value for key, if not exist return nil, ErrKeyNotExist.
+func (r *storeRemoteReader) Get(key uint32) ([]byte, error) {
+	if !r.keys.Contains(key) {
+		return nil, ErrKeyNotExist
+	}
+	// bitmap data's index from 1, so idx= get index - 1
+	idx := r.keys.Rank(key)
+	return r.getBlock(int(idx) - 1)
+}
+
+// getBlock reads the block by index from remote tier.
+func (r *storeRemoteReader) getBlock(idx int) ([]byte, error) {
+	block, err := r.readBlock(idx)
+	if err == nil {
+		metrics.TableReadStatistics.Gets.Incr()
+		metrics.TableReadStatistics.ReadBytes.Add(float64(len(block)))
+	} else {
+		metrics.TableReadStatistics.GetFailures.Incr()
+	}
+	return block, err
+}
+
+func (r *storeRemoteReader) readBlock(idx int) ([]byte, error) {
+	startOffset, ok := r.offsets.Get(idx)
+	if !ok {
+		return nil, fmt.Errorf("corrupted FixedOffsetDecoder block, index:%d", idx)
+	}
+	endOffset, ok := r.offsets.Get(idx + 1)
+	if !ok {
+		endOffset = r.entriesSize
+	}
+	if startOffset < 0 || endOffset < startOffset || endOffset > r.entriesSize {
+		return nil, fmt.Errorf("corrupted FixedOffsetDecoder block, "+
+			"data block length: %d, data range: [%d, %d]", r.entriesSize, startOffset, endOffset)
+	}
+	block, err := r.backend.GetRange(r.key, int64(startOffset), int64(endOffset-startOffset))
+	if err != nil {
+		return nil, err
+	}
+	if len(block) != endOffset-startOffset {
+		return nil, fmt.Errorf("remote object[%s] is corrupted, data range: [%d, %d], read: %d",
+			r.key, startOffset, endOffset, len(block))
+	}
+	return r.codec.Decompress(block)
+}
+
+// Iterator iterates over a store's key/value pairs in key order.
+func (r *storeRemoteReader) Iterator() Iterator {
+	return &storeMMapIterator{
+		reader: r,
+		keyIt:  r.keys.Iterator(),
+	}
+}
+
+// Close store reader, nothing to release.
+func (r *storeRemoteReader) Close() error {
+	return nil
+}
+
+// readStub reads the stub file, returns the index block and object key of offloaded store file.
+func readStub(stubPath string) (tail []byte, key string, err error) {
+	data, err := readFileFn(stubPath)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(data) < stubFileFooterSize+sstFileFooterSize {
+		return nil, "", fmt.Errorf("length of stub file:%s length is too short", stubPath)
+	}
+	if binary.LittleEndian.Uint64(data[len(data)-8:]) != magicNumberRemoteFile {
+		return nil, "", fmt.Errorf("verify magic-number of stub file:%s failure", stubPath)
+	}
+	keyEnd := len(data) - stubFileFooterSize
+	keyLen := int(binary.LittleEndian.Uint32(data[keyEnd:]))
+	if keyLen > keyEnd-sstFileFooterSize {
+		return nil, "", fmt.Errorf("bad footer data of stub file:%s, length of key: %d", stubPath, keyLen)
+	}
+	return data[:keyEnd-keyLen], string(data[keyEnd-keyLen : keyEnd]), nil
+}
+
+// posOfOffsetInTail returns the position of offsets block in store file based on the footer in index block.
+func posOfOffsetInTail(path string, tail []byte) (int, error) {
+	footer, err := decodeFooter(path, tail)
+	if err != nil {
+		return 0, err
+	}
+	return footer.posOfOffset, nil
+}
+
+// writeFileSync writes data into file, then syncs it.
+func writeFileSync(filePath string, data []byte) error {
+	f, err := os.OpenFile(filePath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	if _, err := f.Write(data); err != nil {
+		return err
+	}
+	return f.Sync()
+}
@@ -0,0 +1,290 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package table
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	commonfileutil "github.com/lindb/common/pkg/fileutil"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/lindb/lindb/pkg/blob"
+	"github.com/lindb/lindb/pkg/compress"
+)
+
+func buildStoreFile(t *testing.T, dir string) string {
+	path := filepath.Join(dir, "000010.sst")
+	builder, err := NewStoreBuilder(10, path)
+	assert.NoError(t, err)
+	assert.NoError(t, builder.Add(1, []byte("test")))
+	assert.NoError(t, builder.Add(10, []byte("test10")))
+	assert.NoError(t, builder.Add(100, []byte("test100")))
+	assert.NoError(t, builder.Close())
+	return path
+}
+
+func newTestRemoteTier(t *testing.T) *RemoteTier {
+	backend, err := blob.NewLocalBackend(filepath.Join(t.TempDir(), "tiering"))
+	assert.NoError(t, err)
+	return &RemoteTier{Backend: backend, Prefix: "1"}
+}
+
+func TestRemoteTier_Init(t *testing.T) {
+	defer InitRemoteTier(nil)
+
+	assert.Nil(t, GetRemoteTier())
+	tier := &RemoteTier{Prefix: "1"}
+	InitRemoteTier(tier)
+	assert.Equal(t, tier, GetRemoteTier())
+}
+
+func TestRemoteTier_Offload(t *testing.T) {
+	dir := t.TempDir()
+	path := buildStoreFile(t, dir)
+	tier := newTestRemoteTier(t)
+
+	assert.NoError(t, Offload(tier, path, "db/20231010/000010.sst"))
+	assert.True(t, commonfileutil.Exist(path+RemoteSuffix))
+	stat, err := os.Stat(path)
+	assert.NoError(t, err)
+	stubStat, err := os.Stat(path + RemoteSuffix)
+	assert.NoError(t, err)
+	assert.Less(t, stubStat.Size(), stat.Size()+int64(len("1/db/20231010/000010.sst"))+stubFileFooterSize)
+	assert.NoError(t, os.Remove(path))
+
+	reader, err := newRemoteStoreReader(tier.Backend, path+RemoteSuffix, "000010.sst")
+	assert.NoError(t, err)
+	assert.Equal(t, path+RemoteSuffix, reader.Path())
+	assert.Equal(t, "000010.sst", reader.FileName())
+	value, err := reader.Get(10)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("test10"), value)
+	value, err = reader.Get(100)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("test100"), value)
+	_, err = reader.Get(2)
+	assert.Equal(t, ErrKeyNotExist, err)
+
+	it := reader.Iterator()
+	var keys []uint32
+	var values []string
+	for it.HasNext() {
+		keys = append(keys, it.Key())
+		values = append(values, string(it.Value()))
+	}
+	assert.Equal(t, []uint32{1, 10, 100}, keys)
+	assert.Equal(t, []string{"test", "test10", "test100"}, values)
+	assert.NoError(t, reader.Close())
+
+	// download offloaded file
+	target := filepath.Join(dir, "000011.sst")
+	assert.NoError(t, Download(tier, path+RemoteSuffix, target))
+	mmapReader, err := newMMapStoreReader(target, "000011.sst")
+	assert.NoError(t, err)
+	value, err = mmapReader.Get(1)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("test"), value)
+	assert.NoError(t, mmapReader.Close())
+
+	// remove offloaded file
+	assert.NoError(t, RemoveRemoteFile(tier, path+RemoteSuffix))
+	assert.False(t, commonfileutil.Exist(path+RemoteSuffix))
+	_, err = tier.Backend.GetRange("1/db/20231010/000010.sst", 0, 1)
+	assert.Error(t, err)
+}
+
+func TestRemoteTier_Offload_Compression(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "000010.sst")
+	codec, err := compress.GetBlockCodecByType(compress.ZSTD, 0)
+	assert.NoError(t, err)
+	builder, err := NewStoreBuilderWithCodec(10, path, codec)
+	assert.NoError(t, err)
+	assert.NoError(t, builder.Add(1, []byte("test")))
+	assert.NoError(t, builder.Add(10, []byte("test10")))
+	assert.NoError(t, builder.Close())
+	tier := newTestRemoteTier(t)
+
+	assert.NoError(t, Offload(tier, path, "db/20231010/000010.sst"))
+	assert.NoError(t, os.Remove(path))
+	reader, err := newRemoteStoreReader(tier.Backend, path+RemoteSuffix, "000010.sst")
+	assert.NoError(t, err)
+	value, err := reader.Get(10)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("test10"), value)
+
+	// download offloaded file, keeps compressed blocks
+	target := filepath.Join(dir, "000011.sst")
+	assert.NoError(t, Download(tier, path+RemoteSuffix, target))
+	mmapReader, err := newMMapStoreReader(target, "000011.sst")
+	assert.NoError(t, err)
+	value, err = mmapReader.Get(1)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("test"), value)
+	assert.NoError(t, mmapReader.Close())
+}
+
+func TestRemoteTier_Offload_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		openFileFn = os.Open
+		renameFileFn = os.Rename
+		ctrl.Finish()
+	}()
+	dir := t.TempDir()
+	path := buildStoreFile(t, dir)
+	backend := blob.NewMockBackend(ctrl)
+	tier := &RemoteTier{Backend: backend}
+
+	// file not exist
+	assert.Error(t, Offload(tier, filepath.Join(dir, "000001.sst"), "000001.sst"))
+	// file too short
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "000002.sst"), []byte("short"), 0o644))
+	assert.Error(t, Offload(tier, filepath.Join(dir, "000002.sst"), "000002.sst"))
+	// bad footer
+	data := make([]byte, 100)
+	binary.LittleEndian.PutUint32(data[100-sstFileFooterSize:], 200)
+	binary.LittleEndian.PutUint64(data[100-8:], magicNumberOffsetFile)
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "000003.sst"), data, 0o644))
+	assert.Error(t, Offload(tier, filepath.Join(dir, "000003.sst"), "000003.sst"))
+	// bad magic number
+	binary.LittleEndian.PutUint32(data[100-sstFileFooterSize:], 10)
+	binary.LittleEndian.PutUint64(data[100-8:], 0)
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "000004.sst"), data, 0o644))
+	assert.Error(t, Offload(tier, filepath.Join(dir, "000004.sst"), "000004.sst"))
+	// upload failure
+	backend.EXPECT().Put(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+	assert.Error(t, Offload(tier, path, "000010.sst"))
+	// write stub failure
+	assert.NoError(t, os.Mkdir(path+RemoteSuffix+".tmp", 0o755))
+	backend.EXPECT().Put(gomock.Any(), gomock.Any()).Return(nil)
+	assert.Error(t, Offload(tier, path, "000010.sst"))
+	// rename stub failure
+	renameFileFn = func(oldpath, newpath string) error {
+		return fmt.Errorf("err")
+	}
+	backend.EXPECT().Put(gomock.Any(), gomock.Any()).Return(nil)
+	assert.Error(t, Offload(tier, path, "000010.sst"))
+}
+
+func TestRemoteReader_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir := t.TempDir()
+	path := buildStoreFile(t, dir)
+	backend := blob.NewMockBackend(ctrl)
+	tier := &RemoteTier{Backend: backend}
+	backend.EXPECT().Put(gomock.Any(), gomock.Any()).Return(nil)
+	assert.NoError(t, Offload(tier, path, "000010.sst"))
+	stubPath := path + RemoteSuffix
+
+	// read remote block failure
+	reader, err := newRemoteStoreReader(backend, stubPath, "000010.sst")
+	assert.NoError(t, err)
+	backend.EXPECT().GetRange(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+	_, err = reader.Get(1)
+	assert.Error(t, err)
+	// remote object corrupted
+	backend.EXPECT().GetRange(gomock.Any(), gomock.Any(), gomock.Any()).Return([]byte("t"), nil)
+	_, err = reader.Get(1)
+	assert.Error(t, err)
+	r := reader.(*storeRemoteReader)
+	r.entriesSize = 1
+	_, err = reader.Get(1)
+	assert.Error(t, err)
+	_, err = r.getBlock(10)
+	assert.Error(t, err)
+
+	backend.EXPECT().GetRange(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+	assert.Error(t, Download(tier, stubPath, filepath.Join(dir, "000011.sst")))
+	backend.EXPECT().GetRange(gomock.Any(), gomock.Any(), gomock.Any()).Return([]byte("t"), nil)
+	assert.Error(t, Download(tier, stubPath, filepath.Join(dir, "000011.sst")))
+	backend.EXPECT().Delete(gomock.Any()).Return(fmt.Errorf("err"))
+	assert.Error(t, RemoveRemoteFile(tier, stubPath))
+
+	// bad stub files
+	stub, err := os.ReadFile(stubPath)
+	assert.NoError(t, err)
+	cases := []struct {
+		name       string
+		data       []byte
+		keepObject bool
+	}{
+		{name: "too short", data: []byte("stub")},
+		{name: "bad magic number", data: append(append([]byte{}, stub[:len(stub)-1]...), 0)},
+		{name: "bad key length", data: func() []byte {
+			data := append([]byte{}, stub...)
+			binary.LittleEndian.PutUint32(data[len(data)-stubFileFooterSize:], uint32(len(data)))
+			return data
+		}()},
+		{name: "bad index block", data: func() []byte {
+			data := append([]byte{}, stub...)
+			data[0] = 0xff
+			data[len(data)-stubFileFooterSize-len("000010.sst")-1] = 0
+			return data
+		}(), keepObject: true},
+	}
+	for _, tt := range cases {
+		assert.NoError(t, os.WriteFile(stubPath, tt.data, 0o644), tt.name)
+		_, err = newRemoteStoreReader(backend, stubPath, "000010.sst")
+		assert.Error(t, err, tt.name)
+		assert.Error(t, Download(tier, stubPath, filepath.Join(dir, "000011.sst")), tt.name)
+		if tt.keepObject {
+			backend.EXPECT().Delete("000010.sst").Return(nil)
+			assert.NoError(t, RemoveRemoteFile(tier, stubPath), tt.name)
+		} else {
+			assert.Error(t, RemoveRemoteFile(tier, stubPath), tt.name)
+		}
+	}
+	// stub not exist
+	_, err = newRemoteStoreReader(backend, filepath.Join(dir, "000001.sst.remote"), "000001.sst")
+	assert.Error(t, err)
+	_, err = posOfOffsetInTail(stubPath, []byte("t"))
+	assert.Error(t, err)
+}
+
+func TestCache_GetRemoteReader(t *testing.T) {
+	defer InitRemoteTier(nil)
+
+	dir := t.TempDir()
+	path := buildStoreFile(t, dir)
+	tier := newTestRemoteTier(t)
+	assert.NoError(t, Offload(tier, path, "000010.sst"))
+	assert.NoError(t, os.Remove(path))
+
+	cache := NewCache(filepath.Dir(dir), time.Minute)
+	// remote tier not initialized
+	_, err := cache.GetReader(filepath.Base(dir), "000010.sst")
+	assert.Error(t, err)
+
+	InitRemoteTier(tier)
+	reader, err := cache.GetReader(filepath.Base(dir), "000010.sst")
+	assert.NoError(t, err)
+	value, err := reader.Get(1)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("test"), value)
+	cache.ReleaseReaders([]Reader{reader})
+	assert.NoError(t, cache.Close())
+}
@@ -45,6 +45,7 @@ const (
 	TypeTable
 	TypeTemp
 	TypeInfo
+	TypeRemoteTable
 )
 
 // FileDesc represents file type and file number
@@ -63,6 +64,11 @@ func Table(fileNumber table.FileNumber) string {
 	return fmt.Sprintf("%06d.%s", fileNumber, sstSuffix)
 }
 
+// RemoteTable returns the stub file name of sst which offloaded into remote tier
+func RemoteTable(fileNumber table.FileNumber) string {
+	return Table(fileNumber) + table.RemoteSuffix
+}
+
 // ManifestFileName returns manifest file name
 func ManifestFileName(fileNumber table.FileNumber) string {
 	return fmt.Sprintf("%s%06d", ManifestPrefix, fileNumber)
@@ -71,6 +77,16 @@ func ManifestFileName(fileNumber table.FileNumber) string {
 // ParseFileName parses file name.
 // if the file name was successfully parsed, returns file desc instance, else return nil.
 func ParseFileName(fileName string) *FileDesc {
+	if strings.HasSuffix(fileName, ".sst"+table.RemoteSuffix) {
+		n, err := strconv.ParseInt(removeSuffix(fileName, ".sst"+table.RemoteSuffix), 10, 64)
+		if err != nil {
+			return nil
+		}
+		return &FileDesc{
+			FileType:   TypeRemoteTable,
+			FileNumber: table.FileNumber(n),
+		}
+	}
 	if strings.HasSuffix(fileName, ".sst") {
 		n, err := strconv.ParseInt(removeSuffix(fileName, ".sst"), 10, 64)
 		if err != nil {
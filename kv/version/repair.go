@@ -0,0 +1,87 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package version
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/lindb/common/pkg/fileutil"
+
+	"github.com/lindb/lindb/kv/table"
+)
+
+// LoadVersionSet loads the version set of store from current manifest in read-only mode,
+// journal writer isn't initialized, so nothing is written into store path, used by offline tools.
+func LoadVersionSet(storePath string, numOfLevels int, families map[string]FamilyID) (StoreVersionSet, error) {
+	// snapshot releases readers into cache, no reader is opened by offline tools
+	vs := NewStoreVersionSet(storePath, table.NewCache(storePath, time.Minute), numOfLevels).(*storeVersionSet)
+	for name, id := range families {
+		vs.CreateFamilyVersion(name, id)
+	}
+	if !fileutil.Exist(vs.getCurrentPath()) {
+		return nil, fmt.Errorf("current file of store:%s not exist", storePath)
+	}
+	if err := vs.recover(); err != nil {
+		return nil, err
+	}
+	return vs, nil
+}
+
+// CurrentManifest returns the manifest file name which current file of store points to.
+func CurrentManifest(storePath string) (string, error) {
+	v, err := readFileFunc(filepath.Join(storePath, current()))
+	if err != nil {
+		return "", err
+	}
+	return string(v), nil
+}
+
+// RewriteManifest applies edit logs(family name => edit log) into the version set loaded by LoadVersionSet,
+// then writes all versions into a new manifest and points current file to it, old manifest file is kept.
+// Next file number is raised to minFileNumber at least, so that new files never reuse the file number
+// existing on disk, used by offline repair tools.
+func RewriteManifest(vs StoreVersionSet, editLogs map[string]EditLog, minFileNumber table.FileNumber) error {
+	s, ok := vs.(*storeVersionSet)
+	if !ok {
+		return fmt.Errorf("unsupported version set for rewriting manifest")
+	}
+	if s.manifest != nil {
+		return fmt.Errorf("version set of store:%s is in use", s.storePath)
+	}
+	for family, editLog := range editLogs {
+		familyVersion := s.GetFamilyVersion(family)
+		if familyVersion == nil {
+			return fmt.Errorf("cannot find family version for name: %s", family)
+		}
+		snapshot := familyVersion.GetSnapshot()
+		editLog.apply(snapshot.GetCurrent())
+		snapshot.Close()
+	}
+	// make sure new manifest file name is different with the old one
+	next := table.FileNumber(s.nextFileNumber.Load())
+	if next < minFileNumber {
+		next = minFileNumber
+	}
+	s.setNextFileNumberWithoutLock(next)
+	if err := s.initJournal(); err != nil {
+		return err
+	}
+	return s.Destroy()
+}
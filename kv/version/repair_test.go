@@ -0,0 +1,101 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package version
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/kv/table"
+	"github.com/lindb/lindb/pkg/bufioutil"
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+func TestLoadVersionSet_RewriteManifest(t *testing.T) {
+	defer func() {
+		newBufferWriterFunc = bufioutil.NewBufioEntryWriter
+	}()
+	storePath := t.TempDir()
+	families := map[string]FamilyID{"f": 1}
+	// current file not exist
+	_, err := LoadVersionSet(storePath, 2, families)
+	assert.Error(t, err)
+	_, err = CurrentManifest(storePath)
+	assert.Error(t, err)
+
+	vs := NewStoreVersionSet(storePath, table.NewCache(storePath, time.Minute), 2)
+	vs.CreateFamilyVersion("f", 1)
+	assert.NoError(t, vs.Recover())
+	editLog := NewEditLog(1)
+	editLog.Add(CreateNewFile(0, NewFileMeta(10, 1, 100, 1024)))
+	editLog.Add(CreateNewFile(1, NewFileMeta(11, 1, 100, 1024)))
+	editLog.Add(CreateNewRollupFile(11, timeutil.Interval(10*1000)))
+	assert.NoError(t, vs.CommitFamilyEditLog("f", editLog))
+	assert.NoError(t, vs.Destroy())
+	manifest, err := CurrentManifest(storePath)
+	assert.NoError(t, err)
+
+	loaded, err := LoadVersionSet(storePath, 2, families)
+	assert.NoError(t, err)
+	snapshot := loaded.GetFamilyVersion("f").GetSnapshot()
+	assert.Len(t, snapshot.GetCurrent().GetFiles(0), 1)
+	assert.Len(t, snapshot.GetCurrent().GetFiles(1), 1)
+	snapshot.Close()
+	// load doesn't write anything
+	current, err := CurrentManifest(storePath)
+	assert.NoError(t, err)
+	assert.Equal(t, manifest, current)
+
+	// rewrite manifest, remove file 11
+	editLog = NewEditLog(1)
+	editLog.Add(NewDeleteFile(1, 11))
+	editLog.Add(CreateDeleteRollupFile(11, timeutil.Interval(10*1000)))
+	assert.Error(t, RewriteManifest(loaded, map[string]EditLog{"not_exist": editLog}, 0))
+	assert.NoError(t, RewriteManifest(loaded, map[string]EditLog{"f": editLog}, 100))
+	current, err = CurrentManifest(storePath)
+	assert.NoError(t, err)
+	assert.Equal(t, ManifestFileName(100), current)
+	_, err = os.Stat(storePath + "/" + manifest)
+	assert.NoError(t, err)
+
+	loaded, err = LoadVersionSet(storePath, 2, families)
+	assert.NoError(t, err)
+	snapshot = loaded.GetFamilyVersion("f").GetSnapshot()
+	assert.Len(t, snapshot.GetCurrent().GetFiles(0), 1)
+	assert.Empty(t, snapshot.GetCurrent().GetFiles(1))
+	assert.Empty(t, snapshot.GetCurrent().GetRollupFiles())
+	snapshot.Close()
+	assert.True(t, loaded.NextFileNumber() > 100)
+
+	// write manifest failure
+	newBufferWriterFunc = func(fileName string) (bufioutil.BufioWriter, error) {
+		return nil, fmt.Errorf("err")
+	}
+	assert.Error(t, RewriteManifest(loaded, nil, 0))
+	// version set in use
+	newBufferWriterFunc = bufioutil.NewBufioEntryWriter
+	assert.Error(t, RewriteManifest(NewMockStoreVersionSet(nil), nil, 0))
+	vs = NewStoreVersionSet(t.TempDir(), table.NewCache(storePath, time.Minute), 2)
+	assert.NoError(t, vs.Recover())
+	assert.Error(t, RewriteManifest(vs, nil, 0))
+	assert.NoError(t, vs.Destroy())
+}
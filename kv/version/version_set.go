@@ -60,6 +60,9 @@ type StoreVersionSet interface {
 	CreateFamilyVersion(family string, familyID FamilyID) FamilyVersion
 	// GetFamilyVersion returns family version if it existed, else return nil
 	GetFamilyVersion(family string) FamilyVersion
+	// Backup pins current version of all families, then writes those versions into backup path as manifest,
+	// returns the pinned snapshots by family name, invoker must close them after backup files.
+	Backup(backupPath string) (map[string]Snapshot, error)
 
 	// newVersionID generates new version id
 	newVersionID() int64
@@ -287,6 +290,48 @@ func (vs *storeVersionSet) setNextFileNumberWithoutLock(newNextFileNumber table.
 	vs.nextFileNumber.Store(next + 1)
 }
 
+// Backup pins current version of all families, then writes those versions into backup path as manifest,
+// returns the pinned snapshots by family name, invoker must close them after backup files.
+// NOTE: replica sequences are not included, because backup is restored without write ahead log.
+func (vs *storeVersionSet) Backup(backupPath string) (map[string]Snapshot, error) {
+	// hold lock, make sure versions/next file number not changed when create snapshot
+	vs.mutex.Lock()
+	defer vs.mutex.Unlock()
+
+	snapshots := make(map[string]Snapshot)
+	closeSnapshots := func() {
+		for _, snapshot := range snapshots {
+			snapshot.Close()
+		}
+	}
+	var editLogs []EditLog
+	for id, name := range vs.familyIDs {
+		snapshot := vs.familyVersions[name].GetSnapshot()
+		snapshots[name] = snapshot
+		editLogs = append(editLogs, vs.createVersionEditLog(id, snapshot.GetCurrent(), false))
+	}
+	editLogs = append(editLogs, vs.createStoreSnapshot())
+
+	manifestFileName := ManifestFileName(table.FileNumber(vs.manifestFileNumber.Load()))
+	writer, err := newBufferWriterFunc(filepath.Join(backupPath, manifestFileName))
+	if err != nil {
+		closeSnapshots()
+		return nil, err
+	}
+	err = vs.persistEditLogs(writer, editLogs)
+	if closeErr := writer.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = writeCurrent(backupPath, manifestFileName)
+	}
+	if err != nil {
+		closeSnapshots()
+		return nil, err
+	}
+	return snapshots, nil
+}
+
 // readManifestFileName reads manifest file name from current file
 func (vs *storeVersionSet) readManifestFileName() (string, error) {
 	current := vs.getCurrentPath()
@@ -344,7 +389,12 @@ func (vs *storeVersionSet) newVersionID() int64 {
 
 // setCurrent writes manifest file name into CURRENT file
 func (vs *storeVersionSet) setCurrent(manifestFile string) error {
-	current := vs.getCurrentPath()
+	return writeCurrent(vs.storePath, manifestFile)
+}
+
+// writeCurrent writes manifest file name into CURRENT file under given path.
+func writeCurrent(storePath, manifestFile string) error {
+	current := filepath.Join(storePath, current())
 	tmp := fmt.Sprintf("%s.%s", current, TmpSuffix)
 	// write manifest file name into current file
 	if err := writeFileFunc(tmp, []byte(manifestFile), 0666); err != nil {
@@ -382,12 +432,15 @@ func (vs *storeVersionSet) createSnapshot() (editLogs []EditLog) {
 // createFamilySnapshot creates snapshot of edit log for family level.
 // NOTE: IMPORTANT!!!!!, need write edit logs for all data of version.
 func (vs *storeVersionSet) createFamilySnapshot(familyID FamilyID, familyVersion FamilyVersion) EditLog {
-	editLog := NewEditLog(familyID)
 	// save current version all active files
 	snapshot := familyVersion.GetSnapshot()
 	defer snapshot.Close()
-	current := snapshot.GetCurrent()
+	return vs.createVersionEditLog(familyID, snapshot.GetCurrent(), true)
+}
 
+// createVersionEditLog creates edit log which includes all data of given version.
+func (vs *storeVersionSet) createVersionEditLog(familyID FamilyID, current Version, withSequences bool) EditLog {
+	editLog := NewEditLog(familyID)
 	// write log for current file list under this family.
 	levels := current.Levels()
 	for numOfLevel, level := range levels {
@@ -399,10 +452,12 @@ func (vs *storeVersionSet) createFamilySnapshot(familyID FamilyID, familyVersion
 		}
 	}
 	// write log if family has replica sequences.
-	sequences := current.GetSequences()
-	for leader, seq := range sequences {
-		// leader -> replica sequence
-		editLog.Add(CreateSequence(leader, seq))
+	if withSequences {
+		sequences := current.GetSequences()
+		for leader, seq := range sequences {
+			// leader -> replica sequence
+			editLog.Add(CreateSequence(leader, seq))
+		}
 	}
 
 	// write log if family has reference files
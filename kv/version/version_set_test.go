@@ -380,6 +380,65 @@ func TestStoreVersionSet_NextFileNumber(t *testing.T) {
 	assert.Equal(t, total, c)
 }
 
+func TestStoreVersionSet_Backup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newBufferWriterFunc = bufioutil.NewBufioEntryWriter
+		writeFileFunc = os.WriteFile
+		ctrl.Finish()
+	}()
+	path := t.TempDir()
+	backupPath := t.TempDir()
+	cache := table.NewMockCache(ctrl)
+	cache.EXPECT().ReleaseReaders(gomock.Any()).AnyTimes()
+	vs := NewStoreVersionSet(path, cache, 2)
+	assert.NoError(t, vs.Recover())
+	familyID := FamilyID(1)
+	vs.CreateFamilyVersion("f", familyID)
+	editLog := NewEditLog(familyID)
+	nFile := CreateNewFile(0, NewFileMeta(12, 1, 100, 2014))
+	editLog.Add(nFile)
+	editLog.Add(CreateSequence(1, 10))
+	editLog.Add(CreateNewRollupFile(12, 10000))
+	assert.NoError(t, vs.CommitFamilyEditLog("f", editLog))
+
+	// case 1: new writer failure
+	newBufferWriterFunc = func(_ string) (bufioutil.BufioWriter, error) {
+		return nil, fmt.Errorf("err")
+	}
+	snapshots, err := vs.Backup(backupPath)
+	assert.Error(t, err)
+	assert.Nil(t, snapshots)
+	// case 2: write current failure
+	newBufferWriterFunc = bufioutil.NewBufioEntryWriter
+	writeFileFunc = func(_ string, _ []byte, _ os.FileMode) error {
+		return fmt.Errorf("err")
+	}
+	snapshots, err = vs.Backup(backupPath)
+	assert.Error(t, err)
+	assert.Nil(t, snapshots)
+	// case 3: backup successfully
+	writeFileFunc = os.WriteFile
+	snapshots, err = vs.Backup(backupPath)
+	assert.NoError(t, err)
+	assert.Len(t, snapshots, 1)
+	assert.Equal(t, nFile.(*newFile).file, snapshots["f"].GetCurrent().GetAllFiles()[0])
+	snapshots["f"].Close()
+	assert.NoError(t, vs.Destroy())
+
+	// recover from backup path, replica sequences are dropped
+	vs = NewStoreVersionSet(backupPath, cache, 2)
+	vs.CreateFamilyVersion("f", familyID)
+	assert.NoError(t, vs.Recover())
+	snapshot := vs.GetFamilyVersion("f").GetSnapshot()
+	current := snapshot.GetCurrent()
+	assert.Equal(t, nFile.(*newFile).file, current.GetAllFiles()[0])
+	assert.Empty(t, current.GetSequences())
+	assert.Equal(t, map[table.FileNumber][]timeutil.Interval{12: {10000}}, current.GetRollupFiles())
+	snapshot.Close()
+	assert.NoError(t, vs.Destroy())
+}
+
 func initVersionSetTestData() {
 	if err := fileutil.MkDirIfNotExist(vsTestPath); err != nil {
 		fmt.Println("create test path error")
@@ -0,0 +1,43 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package metrics
+
+import (
+	"github.com/lindb/lindb/internal/linmetric"
+)
+
+// AlertStatistics represents alert rule evaluation/notification statistics.
+type AlertStatistics struct {
+	Evaluations          *linmetric.BoundCounter   // evaluate alert rule success
+	EvaluationFailures   *linmetric.BoundCounter   // evaluate alert rule failure
+	Notifications        *linmetric.BoundCounter   // send alert notification success
+	NotificationFailures *linmetric.BoundCounter   // send alert notification failure
+	Duration             *linmetric.BoundHistogram // evaluate duration(include count)
+}
+
+// NewAlertStatistics creates an alert statistics.
+func NewAlertStatistics() *AlertStatistics {
+	scope := linmetric.BrokerRegistry.NewScope("lindb.broker.alert")
+	return &AlertStatistics{
+		Evaluations:          scope.NewCounter("evaluations"),
+		EvaluationFailures:   scope.NewCounter("evaluation_failures"),
+		Notifications:        scope.NewCounter("notifications"),
+		NotificationFailures: scope.NewCounter("notification_failures"),
+		Duration:             scope.NewHistogram(),
+	}
+}
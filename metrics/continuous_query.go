@@ -0,0 +1,43 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package metrics
+
+import (
+	"github.com/lindb/lindb/internal/linmetric"
+)
+
+// ContinuousQueryStatistics represents continuous query statistics.
+type ContinuousQueryStatistics struct {
+	Executions       *linmetric.BoundCounter   // execute continuous query success
+	ExecuteFailures  *linmetric.BoundCounter   // execute continuous query failure
+	WriteFailures    *linmetric.BoundCounter   // write query results failure
+	MaterializedRows *linmetric.BoundCounter   // rows written into target metric
+	Duration         *linmetric.BoundHistogram // execute duration(include count)
+}
+
+// NewContinuousQueryStatistics creates a continuous query statistics.
+func NewContinuousQueryStatistics() *ContinuousQueryStatistics {
+	scope := linmetric.BrokerRegistry.NewScope("lindb.broker.continuous_query")
+	return &ContinuousQueryStatistics{
+		Executions:       scope.NewCounter("executions"),
+		ExecuteFailures:  scope.NewCounter("execute_failures"),
+		WriteFailures:    scope.NewCounter("write_failures"),
+		MaterializedRows: scope.NewCounter("materialized_rows"),
+		Duration:         scope.NewHistogram(),
+	}
+}
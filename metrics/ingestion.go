@@ -54,6 +54,15 @@ type NativeIngestionStatistics struct {
 	DroppedMetrics  *linmetric.BoundCounter // drop metric when append
 }
 
+// OTLPIngestionStatistics represents OpenTelemetry(OTLP) ingestion statistics.
+type OTLPIngestionStatistics struct {
+	CorruptedData      *linmetric.BoundCounter // corrupted when parse
+	IngestedMetrics    *linmetric.BoundCounter // ingested metrics
+	ReadBytes          *linmetric.BoundCounter // read data bytes
+	DroppedMetrics     *linmetric.BoundCounter // drop metric when append
+	RejectedDataPoints *linmetric.BoundCounter // data points which cannot be mapped(unsupported type/temporality)
+}
+
 // CommonIngestionStatistics represents ingestion common statistics.
 type CommonIngestionStatistics struct {
 	Duration *linmetric.DeltaHistogramVec // ingest duration(include count)
@@ -70,6 +79,29 @@ func NewNativeIngestionStatistics() *NativeIngestionStatistics {
 	}
 }
 
+// NewImportIngestionStatistics creates data import ingestion statistics.
+func NewImportIngestionStatistics() *NativeIngestionStatistics {
+	importIngestionScope := linmetric.BrokerRegistry.NewScope("lindb.ingestion.import")
+	return &NativeIngestionStatistics{
+		CorruptedData:   importIngestionScope.NewCounter("data_corrupted"),
+		IngestedMetrics: importIngestionScope.NewCounter("ingested_metrics"),
+		ReadBytes:       importIngestionScope.NewCounter("read_bytes"),
+		DroppedMetrics:  importIngestionScope.NewCounter("dropped_metrics"),
+	}
+}
+
+// NewOTLPIngestionStatistics creates an OpenTelemetry(OTLP) ingestion statistics.
+func NewOTLPIngestionStatistics() *OTLPIngestionStatistics {
+	otlpIngestionScope := linmetric.BrokerRegistry.NewScope("lindb.ingestion.otlp")
+	return &OTLPIngestionStatistics{
+		CorruptedData:      otlpIngestionScope.NewCounter("data_corrupted"),
+		IngestedMetrics:    otlpIngestionScope.NewCounter("ingested_metrics"),
+		ReadBytes:          otlpIngestionScope.NewCounter("read_bytes"),
+		DroppedMetrics:     otlpIngestionScope.NewCounter("dropped_metrics"),
+		RejectedDataPoints: otlpIngestionScope.NewCounter("rejected_data_points"),
+	}
+}
+
 // NewInfluxIngestionStatistics creates an influx ingestion statistics.
 func NewInfluxIngestionStatistics() *InfluxIngestionStatistics {
 	influxIngestionScope := linmetric.BrokerRegistry.NewScope("lindb.ingestion.influx")
@@ -28,4 +28,6 @@ func TestIngestionStatistics_New(t *testing.T) {
 	assert.NotNil(t, NewCommonIngestionStatistics())
 	assert.NotNil(t, NewInfluxIngestionStatistics())
 	assert.NotNil(t, NewNativeIngestionStatistics())
+	assert.NotNil(t, NewImportIngestionStatistics())
+	assert.NotNil(t, NewOTLPIngestionStatistics())
 }
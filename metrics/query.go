@@ -44,9 +44,23 @@ type StorageQueryStatistics struct {
 	MetricQueryFailures *linmetric.BoundCounter // execute metric query failure
 	MetaQuery           *linmetric.BoundCounter // metadata query success
 	MetaQueryFailures   *linmetric.BoundCounter // metadata query failure
+	Delete              *linmetric.BoundCounter // delete series success
+	DeleteFailures      *linmetric.BoundCounter // delete series failure
+	Drop                *linmetric.BoundCounter // drop metric/namespace success
+	DropFailures        *linmetric.BoundCounter // drop metric/namespace failure
 	OmitRequest         *linmetric.BoundCounter // omit request(task no belong to current node, wrong stream etc.)
 }
 
+// QueryCacheStatistics represents broker query result cache statistics.
+type QueryCacheStatistics struct {
+	Hits        *linmetric.BoundCounter // whole query range hit in cache
+	PartialHits *linmetric.BoundCounter // history range hit in cache, need query tail range
+	Misses      *linmetric.BoundCounter // not found in cache
+	Evictions   *linmetric.BoundCounter // evict cache entry(capacity/expire/invalidate)
+	Entries     *linmetric.BoundGauge   // number of cache entries
+	Size        *linmetric.BoundGauge   // memory size of cache entries
+}
+
 // NewTransportStatistics creates a transport statistics.
 func NewTransportStatistics(registry *linmetric.Registry) *TransportStatistics {
 	scope := registry.NewScope("lindb.task.transport")
@@ -78,6 +92,23 @@ func NewStorageQueryStatistics() *StorageQueryStatistics {
 		MetricQueryFailures: scope.NewCounter("metric_query_failures"),
 		MetaQuery:           scope.NewCounter("meta_queries"),
 		MetaQueryFailures:   scope.NewCounter("meta_query_failures"),
+		Delete:              scope.NewCounter("deletes"),
+		DeleteFailures:      scope.NewCounter("delete_failures"),
+		Drop:                scope.NewCounter("drops"),
+		DropFailures:        scope.NewCounter("drop_failures"),
 		OmitRequest:         scope.NewCounter("omitted_requests"),
 	}
 }
+
+// NewQueryCacheStatistics creates a broker query result cache statistics.
+func NewQueryCacheStatistics(registry *linmetric.Registry) *QueryCacheStatistics {
+	scope := registry.NewScope("lindb.query.result_cache")
+	return &QueryCacheStatistics{
+		Hits:        scope.NewCounter("hits"),
+		PartialHits: scope.NewCounter("partial_hits"),
+		Misses:      scope.NewCounter("misses"),
+		Evictions:   scope.NewCounter("evictions"),
+		Entries:     scope.NewGauge("entries"),
+		Size:        scope.NewGauge("size"),
+	}
+}
@@ -29,4 +29,5 @@ func TestQueryStatistics(t *testing.T) {
 	assert.NotNil(t, NewQueryStatistics(linmetric.RootRegistry))
 	assert.NotNil(t, NewTransportStatistics(linmetric.RootRegistry))
 	assert.NotNil(t, NewStorageQueryStatistics())
+	assert.NotNil(t, NewQueryCacheStatistics(linmetric.BrokerRegistry))
 }
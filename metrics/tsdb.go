@@ -60,6 +60,9 @@ type MetaDBStatistics struct {
 	GenTagKeyIDFailures   *linmetric.BoundCounter // generate tag key id failure
 	GenTagValueIDs        *linmetric.BoundCounter // generate tag value id success
 	GenTagValueIDFailures *linmetric.BoundCounter // generate tag value id failure
+	DropNamespaces        *linmetric.BoundCounter // drop namespace success
+	DropMetrics           *linmetric.BoundCounter // drop metric success
+	DropFailures          *linmetric.BoundCounter // drop namespace/metric failure
 }
 
 // ShardStatistics represents shard statistics.
@@ -126,6 +129,9 @@ func NewMetaDBStatistics(database string) *MetaDBStatistics {
 		GenTagKeyIDFailures:   metaDBScope.NewCounterVec("gen_tag_key_id_failures", "db").WithTagValues(database),
 		GenTagValueIDs:        metaDBScope.NewCounterVec("gen_tag_value_ids", "db").WithTagValues(database),
 		GenTagValueIDFailures: metaDBScope.NewCounterVec("gen_tag_value_id_failures", "db").WithTagValues(database),
+		DropNamespaces:        metaDBScope.NewCounterVec("drop_namespaces", "db").WithTagValues(database),
+		DropMetrics:           metaDBScope.NewCounterVec("drop_metrics", "db").WithTagValues(database),
+		DropFailures:          metaDBScope.NewCounterVec("drop_failures", "db").WithTagValues(database),
 	}
 }
 
@@ -0,0 +1,190 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/lindb/common/models"
+	commontimeutil "github.com/lindb/common/pkg/timeutil"
+
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+// AlertRuleType represents the expression type of alert rule.
+type AlertRuleType string
+
+// Defines all expression types of alert rule.
+const (
+	LinQLAlertRule  AlertRuleType = "linql"
+	PromQLAlertRule AlertRuleType = "promql"
+)
+
+// AlertOperator represents the compare operator of alert condition.
+type AlertOperator string
+
+// Defines all compare operators of alert condition.
+const (
+	AlertGreaterThan      AlertOperator = ">"
+	AlertGreaterThanEqual AlertOperator = ">="
+	AlertLessThan         AlertOperator = "<"
+	AlertLessThanEqual    AlertOperator = "<="
+	AlertEqual            AlertOperator = "=="
+	AlertNotEqual         AlertOperator = "!="
+)
+
+// AlertReceiverType represents the type of alert notification receiver.
+type AlertReceiverType string
+
+// Defines all types of alert notification receiver.
+const (
+	// AlertmanagerReceiver sends alerts to Alertmanager(v2 api) compatible receiver.
+	AlertmanagerReceiver AlertReceiverType = "alertmanager"
+	// WebhookReceiver sends alerts to generic webhook receiver.
+	WebhookReceiver AlertReceiverType = "webhook"
+)
+
+// AlertState represents the state of alert.
+type AlertState string
+
+// Defines all states of alert.
+const (
+	AlertPending  AlertState = "pending"
+	AlertFiring   AlertState = "firing"
+	AlertResolved AlertState = "resolved"
+)
+
+// AlertReceiver represents the receiver of alert notification.
+type AlertReceiver struct {
+	Type AlertReceiverType `json:"type" validate:"required,oneof=alertmanager webhook"`
+	URL  string            `json:"url" validate:"required,url"`
+}
+
+// AlertCondition represents the condition of alert rule, compares the value of series with threshold.
+type AlertCondition struct {
+	Operator  AlertOperator `json:"operator" validate:"required,oneof=> >= < <= == !="`
+	Threshold float64       `json:"threshold"`
+}
+
+// Match returns if the value matches the condition.
+func (c *AlertCondition) Match(value float64) bool {
+	switch c.Operator {
+	case AlertGreaterThan:
+		return value > c.Threshold
+	case AlertGreaterThanEqual:
+		return value >= c.Threshold
+	case AlertLessThan:
+		return value < c.Threshold
+	case AlertLessThanEqual:
+		return value <= c.Threshold
+	case AlertEqual:
+		return value == c.Threshold
+	case AlertNotEqual:
+		return value != c.Threshold
+	}
+	return false
+}
+
+// AlertRule represents the definition of alert rule,
+// which evaluates the expression periodically, each series of result matched the condition is an active alert.
+type AlertRule struct {
+	Name string        `json:"name" validate:"required"`
+	Type AlertRuleType `json:"type" validate:"required,oneof=linql promql"`
+	// Database is required for LinQL rule, PromQL rule queries the database of Prometheus config.
+	Database string `json:"database,omitempty" validate:"required_if=Type linql"`
+	Expr     string `json:"expr" validate:"required"`
+	// Condition is required for LinQL rule, PromQL rule filters series by comparison in expression,
+	// optional condition of PromQL rule is applied to the result of expression.
+	Condition *AlertCondition `json:"condition,omitempty" validate:"required_if=Type linql"`
+	// Interval represents the evaluation interval, default 1m.
+	Interval timeutil.Interval `json:"interval,omitempty"`
+	// For represents the duration which alert keeps active before firing.
+	For         timeutil.Interval `json:"for,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+	Receivers   []AlertReceiver   `json:"receivers,omitempty" validate:"dive"`
+}
+
+// Key returns the unique key of alert rule, rules with same name under different databases are different rules.
+func (r *AlertRule) Key() string {
+	if r.Database == "" {
+		return r.Name
+	}
+	return r.Database + "/" + r.Name
+}
+
+// Default sets the default value of alert rule.
+func (r *AlertRule) Default() {
+	if r.Interval <= 0 {
+		r.Interval = timeutil.Interval(commontimeutil.OneMinute)
+	}
+}
+
+// Alert represents the alert of rule for one label set.
+type Alert struct {
+	Database    string            `json:"database,omitempty"`
+	Rule        string            `json:"rule"`
+	Labels      map[string]string `json:"labels"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+	State       AlertState        `json:"state"`
+	Value       float64           `json:"value"`
+	ActiveAt    int64             `json:"activeAt"`
+	FiredAt     int64             `json:"firedAt,omitempty"`
+	ResolvedAt  int64             `json:"resolvedAt,omitempty"`
+	LastSentAt  int64             `json:"lastSentAt,omitempty"`
+}
+
+// LabelsString returns the string of labels which sorted by label name.
+func (a *Alert) LabelsString() string {
+	names := make([]string, 0, len(a.Labels))
+	for name := range a.Labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var sb strings.Builder
+	sb.WriteString("{")
+	for idx, name := range names {
+		if idx > 0 {
+			sb.WriteString(",")
+		}
+		sb.WriteString(fmt.Sprintf("%s=%q", name, a.Labels[name]))
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+// Alerts represents the alert list.
+type Alerts []Alert
+
+// ToTable returns alert list as table if it has value, else return empty string.
+func (alerts Alerts) ToTable() (rows int, tableStr string) {
+	if len(alerts) == 0 {
+		return 0, ""
+	}
+	writer := models.NewTableFormatter()
+	writer.AppendHeader(table.Row{"Database", "Rule", "State", "Labels", "Value", "Active At"})
+	for i := range alerts {
+		r := alerts[i]
+		writer.AppendRow(table.Row{r.Database, r.Rule, r.State, r.LabelsString(), r.Value,
+			commontimeutil.FormatTimestamp(r.ActiveAt, commontimeutil.DataTimeFormat2)})
+	}
+	return len(alerts), writer.Render()
+}
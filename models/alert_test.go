@@ -0,0 +1,102 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"testing"
+
+	commontimeutil "github.com/lindb/common/pkg/timeutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/pkg/validate"
+)
+
+func TestAlertRule_Validate(t *testing.T) {
+	rule := &AlertRule{Name: "cpu", Type: LinQLAlertRule, Expr: "select usage from cpu"}
+	assert.Error(t, validate.Validator.Struct(rule))
+	rule.Database = "test"
+	assert.Error(t, validate.Validator.Struct(rule))
+	rule.Condition = &AlertCondition{Operator: "~", Threshold: 10}
+	assert.Error(t, validate.Validator.Struct(rule))
+	rule.Condition = &AlertCondition{Operator: AlertGreaterThan, Threshold: 10}
+	assert.NoError(t, validate.Validator.Struct(rule))
+	rule.Receivers = []AlertReceiver{{Type: "unknown", URL: "http://localhost:9093"}}
+	assert.Error(t, validate.Validator.Struct(rule))
+	rule.Receivers = []AlertReceiver{{Type: AlertmanagerReceiver, URL: "http://localhost:9093"}}
+	assert.NoError(t, validate.Validator.Struct(rule))
+
+	rule = &AlertRule{Name: "cpu", Type: PromQLAlertRule, Expr: "cpu > 10"}
+	assert.NoError(t, validate.Validator.Struct(rule))
+	rule.Type = "unknown"
+	assert.Error(t, validate.Validator.Struct(rule))
+}
+
+func TestAlertCondition_Match(t *testing.T) {
+	cases := []struct {
+		op    AlertOperator
+		value float64
+		match bool
+	}{
+		{AlertGreaterThan, 11, true},
+		{AlertGreaterThan, 10, false},
+		{AlertGreaterThanEqual, 10, true},
+		{AlertGreaterThanEqual, 9, false},
+		{AlertLessThan, 9, true},
+		{AlertLessThan, 10, false},
+		{AlertLessThanEqual, 10, true},
+		{AlertLessThanEqual, 11, false},
+		{AlertEqual, 10, true},
+		{AlertEqual, 11, false},
+		{AlertNotEqual, 11, true},
+		{AlertNotEqual, 10, false},
+		{"unknown", 10, false},
+	}
+	for _, c := range cases {
+		cond := &AlertCondition{Operator: c.op, Threshold: 10}
+		assert.Equal(t, c.match, cond.Match(c.value), "%s %v", c.op, c.value)
+	}
+}
+
+func TestAlertRule_Default(t *testing.T) {
+	rule := &AlertRule{}
+	rule.Default()
+	assert.Equal(t, timeutil.Interval(commontimeutil.OneMinute), rule.Interval)
+	rule.Interval = timeutil.Interval(commontimeutil.OneSecond)
+	rule.Default()
+	assert.Equal(t, timeutil.Interval(commontimeutil.OneSecond), rule.Interval)
+}
+
+func TestAlertRule_Key(t *testing.T) {
+	assert.Equal(t, "cpu", (&AlertRule{Name: "cpu"}).Key())
+	assert.Equal(t, "test/cpu", (&AlertRule{Name: "cpu", Database: "test"}).Key())
+}
+
+func TestAlert_LabelsString(t *testing.T) {
+	assert.Equal(t, "{}", (&Alert{}).LabelsString())
+	assert.Equal(t, `{host="a",ip="1.1.1.1"}`, (&Alert{Labels: map[string]string{"ip": "1.1.1.1", "host": "a"}}).LabelsString())
+}
+
+func TestAlerts_ToTable(t *testing.T) {
+	rows, rs := Alerts{}.ToTable()
+	assert.Zero(t, rows)
+	assert.Empty(t, rs)
+	rows, rs = Alerts{{Rule: "cpu", State: AlertFiring, Labels: map[string]string{"host": "a"}, Value: 10}}.ToTable()
+	assert.Equal(t, 1, rows)
+	assert.Contains(t, rs, "firing")
+}
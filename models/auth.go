@@ -0,0 +1,146 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Permission represents the permission of database.
+type Permission string
+
+// Defines all permissions of database.
+const (
+	// ReadPermission allows querying data/metadata of database.
+	ReadPermission Permission = "read"
+	// WritePermission allows writing/deleting data of database.
+	WritePermission Permission = "write"
+	// AdminPermission allows managing database(drop/limits etc.), implies read and write.
+	AdminPermission Permission = "admin"
+)
+
+const (
+	// AllDatabases represents privilege which applies to all databases and cluster level operations.
+	AllDatabases = "*"
+	// APITokenPrefix represents the prefix of api token.
+	APITokenPrefix = "lin_"
+	// apiTokenLength represents the random bytes length of api token.
+	apiTokenLength = 24
+)
+
+// Implies returns if current permission implies the required permission.
+func (p Permission) Implies(required Permission) bool {
+	return p == AdminPermission || p == required
+}
+
+// Privilege represents the permissions of a database.
+type Privilege struct {
+	Database    string       `json:"database" validate:"required"`
+	Permissions []Permission `json:"permissions" validate:"required,min=1,dive,oneof=read write admin"`
+}
+
+// Allow returns if privilege grants the permission of database.
+func (p *Privilege) Allow(database string, required Permission) bool {
+	if p.Database != AllDatabases && p.Database != database {
+		return false
+	}
+	for _, permission := range p.Permissions {
+		if permission.Implies(required) {
+			return true
+		}
+	}
+	return false
+}
+
+// Role represents a named set of privileges.
+type Role struct {
+	Name       string      `json:"name" validate:"required"`
+	Privileges []Privilege `json:"privileges" validate:"dive"`
+}
+
+// Allow returns if role grants the permission of database.
+func (r *Role) Allow(database string, required Permission) bool {
+	for idx := range r.Privileges {
+		if r.Privileges[idx].Allow(database, required) {
+			return true
+		}
+	}
+	return false
+}
+
+// User represents the user of cluster, password stored as bcrypt hash.
+type User struct {
+	Name     string   `json:"name" validate:"required"`
+	Password string   `json:"password,omitempty" validate:"required"`
+	Roles    []string `json:"roles,omitempty"`
+}
+
+// HashPassword replaces the plain password with bcrypt hash.
+func (u *User) HashPassword() error {
+	hash, err := bcrypt.GenerateFromPassword([]byte(u.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	u.Password = string(hash)
+	return nil
+}
+
+// CheckPassword checks if the plain password matches the password hash of user.
+func (u *User) CheckPassword(password string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(u.Password), []byte(password)) == nil
+}
+
+// APIToken represents the api token of user, only the hash of token is stored.
+type APIToken struct {
+	ID        string `json:"id"`
+	Name      string `json:"name" validate:"required"`
+	User      string `json:"user"`
+	CreatedAt int64  `json:"createdAt"`
+	// ExpiresAt represents expire timestamp(ms), 0 means never expire.
+	ExpiresAt int64 `json:"expiresAt,omitempty"`
+}
+
+// IsExpired returns if token is expired at given timestamp(ms).
+func (t *APIToken) IsExpired(now int64) bool {
+	return t.ExpiresAt > 0 && t.ExpiresAt <= now
+}
+
+// GenerateAPIToken generates a random api token with prefix.
+func GenerateAPIToken() (string, error) {
+	buf := make([]byte, apiTokenLength)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return APITokenPrefix + hex.EncodeToString(buf), nil
+}
+
+// IsAPIToken returns if the credential is an api token.
+func IsAPIToken(credential string) bool {
+	return strings.HasPrefix(credential, APITokenPrefix)
+}
+
+// HashAPIToken returns the id(sha256 hex) of api token.
+func HashAPIToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
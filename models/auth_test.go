@@ -0,0 +1,85 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/validate"
+)
+
+func TestPrivilege_Allow(t *testing.T) {
+	p := &Privilege{Database: "db", Permissions: []Permission{ReadPermission}}
+	assert.True(t, p.Allow("db", ReadPermission))
+	assert.False(t, p.Allow("db", WritePermission))
+	assert.False(t, p.Allow("db", AdminPermission))
+	assert.False(t, p.Allow("other", ReadPermission))
+
+	p = &Privilege{Database: "db", Permissions: []Permission{AdminPermission}}
+	assert.True(t, p.Allow("db", ReadPermission))
+	assert.True(t, p.Allow("db", WritePermission))
+	assert.True(t, p.Allow("db", AdminPermission))
+
+	p = &Privilege{Database: AllDatabases, Permissions: []Permission{WritePermission}}
+	assert.True(t, p.Allow("db", WritePermission))
+	assert.True(t, p.Allow(AllDatabases, WritePermission))
+	assert.False(t, p.Allow("db", ReadPermission))
+}
+
+func TestRole_Allow(t *testing.T) {
+	r := &Role{Name: "ops", Privileges: []Privilege{
+		{Database: "db1", Permissions: []Permission{ReadPermission}},
+		{Database: "db2", Permissions: []Permission{ReadPermission, WritePermission}},
+	}}
+	assert.True(t, r.Allow("db1", ReadPermission))
+	assert.False(t, r.Allow("db1", WritePermission))
+	assert.True(t, r.Allow("db2", WritePermission))
+	assert.False(t, r.Allow("db3", ReadPermission))
+}
+
+func TestRole_Validate(t *testing.T) {
+	r := &Role{Name: "ops", Privileges: []Privilege{{Database: "db", Permissions: []Permission{"unknown"}}}}
+	assert.Error(t, validate.Validator.Struct(r))
+	r.Privileges[0].Permissions = []Permission{ReadPermission}
+	assert.NoError(t, validate.Validator.Struct(r))
+}
+
+func TestUser_Password(t *testing.T) {
+	u := &User{Name: "bob", Password: "secret"}
+	assert.NoError(t, u.HashPassword())
+	assert.NotEqual(t, "secret", u.Password)
+	assert.True(t, u.CheckPassword("secret"))
+	assert.False(t, u.CheckPassword("wrong"))
+}
+
+func TestAPIToken(t *testing.T) {
+	token, err := GenerateAPIToken()
+	assert.NoError(t, err)
+	assert.True(t, IsAPIToken(token))
+	assert.False(t, IsAPIToken("abc"))
+	assert.Len(t, HashAPIToken(token), 64)
+	assert.Equal(t, HashAPIToken(token), HashAPIToken(token))
+
+	apiToken := &APIToken{}
+	assert.False(t, apiToken.IsExpired(100))
+	apiToken.ExpiresAt = 100
+	assert.True(t, apiToken.IsExpired(100))
+	assert.False(t, apiToken.IsExpired(99))
+}
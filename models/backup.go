@@ -0,0 +1,32 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+// BackupParam represents the parameter of database backup/restore.
+type BackupParam struct {
+	Database string `json:"database" binding:"required"`
+	Path     string `json:"path" binding:"required"`
+}
+
+// BackupManifest represents the manifest of database backup, which is stored under backup path.
+type BackupManifest struct {
+	Database  string   `json:"database"`
+	Path      string   `json:"path"`
+	Stores    []string `json:"stores"` // relative path of kv stores under backup path
+	Timestamp int64    `json:"timestamp"`
+}
@@ -0,0 +1,58 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/lindb/common/models"
+
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+// ContinuousQuery represents the definition of continuous query,
+// which executes the query periodically and writes the results into target metric.
+type ContinuousQuery struct {
+	Name         string            `json:"name" validate:"required"`
+	Database     string            `json:"database" validate:"required"`
+	Interval     timeutil.Interval `json:"interval" validate:"required"`
+	TargetMetric string            `json:"targetMetric" validate:"required"`
+	SQL          string            `json:"sql" validate:"required"`
+}
+
+// ContinuousQueryState represents the execution state of continuous query,
+// which is persisted so that new master continues from the last executed window.
+type ContinuousQueryState struct {
+	LastWindowEnd int64 `json:"lastWindowEnd"`
+}
+
+// ContinuousQueries represents the continuous query list.
+type ContinuousQueries []ContinuousQuery
+
+// ToTable returns continuous query list as table if it has value, else return empty string.
+func (cqs ContinuousQueries) ToTable() (rows int, tableStr string) {
+	if len(cqs) == 0 {
+		return 0, ""
+	}
+	writer := models.NewTableFormatter()
+	writer.AppendHeader(table.Row{"Name", "Interval", "Target Metric", "Query"})
+	for i := range cqs {
+		r := cqs[i]
+		writer.AppendRow(table.Row{r.Name, r.Interval.String(), r.TargetMetric, r.SQL})
+	}
+	return len(cqs), writer.Render()
+}
@@ -21,4 +21,6 @@ package models
 type ExecuteParam struct {
 	Database string `form:"db" json:"db"`
 	SQL      string `form:"sql" json:"sql" binding:"required"`
+	// Stream returns the result of metric data query as json lines incrementally.
+	Stream bool `form:"stream" json:"stream"`
 }
@@ -0,0 +1,41 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+// ExportParam represents the parameter of database data export.
+type ExportParam struct {
+	Database  string `form:"db" json:"db" binding:"required"`
+	Namespace string `form:"ns" json:"ns"`         // export all namespaces if not set
+	Metric    string `form:"metric" json:"metric"` // export all metrics if not set
+	Start     int64  `form:"start" json:"start"`   // default: now - retention of smallest interval
+	End       int64  `form:"end" json:"end"`       // default: now
+	Format    string `form:"format" json:"format"` // native(default)/line
+}
+
+// ImportParam represents the parameter of database data import.
+type ImportParam struct {
+	Database  string `form:"db" json:"db" binding:"required"`
+	Namespace string `form:"ns" json:"ns"`         // overrides namespace of imported metrics if set
+	Format    string `form:"format" json:"format"` // native(default)/line
+	Precision string `form:"precision" json:"precision"`
+}
+
+// ImportResult represents the result of database data import.
+type ImportResult struct {
+	Metrics int `json:"metrics"`
+}
@@ -89,6 +89,7 @@ type StatelessNode struct {
 	OnlineTime int64  `json:"onlineTime"`
 	GRPCPort   uint16 `json:"grpcPort,omitempty"`
 	HTTPPort   uint16 `json:"httpPort"`
+	HTTPS      bool   `json:"https,omitempty"`
 }
 
 // Indicator returns node indicator's string.
@@ -99,7 +100,11 @@ func (n *StatelessNode) Indicator() string {
 	return fmt.Sprintf("%s:%d", n.HostIP, n.HTTPPort)
 }
 
+// HTTPAddress returns address for http, using https scheme if node's http server enables TLS.
 func (n *StatelessNode) HTTPAddress() string {
+	if n.HTTPS {
+		return fmt.Sprintf("https://%s:%d", n.HostIP, n.HTTPPort)
+	}
 	return fmt.Sprintf("http://%s:%d", n.HostIP, n.HTTPPort)
 }
 
@@ -17,7 +17,72 @@
 
 package models
 
+import (
+	"sort"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/lindb/common/models"
+)
+
 // SuggestResult represents the suggest result set
 type SuggestResult struct {
 	Values []string `json:"values"`
 }
+
+// ResultChunk represents a chunk of streaming query result, each chunk is written as a json line.
+// series of chunks are disjoint, the last chunk is completed with query stats.
+type ResultChunk struct {
+	ResultSet *models.ResultSet `json:"resultSet,omitempty"`
+	Completed bool              `json:"completed,omitempty"`
+	Error     string            `json:"error,omitempty"`
+}
+
+// DeleteResult represents the result of deleting series.
+type DeleteResult struct {
+	// DeletedSeries represents the number of deleted series of each shard.
+	DeletedSeries map[ShardID]uint64 `json:"deletedSeries"`
+}
+
+// Merge merges the deleted series of other result, keeps the max value for same shard(replicas).
+func (rs *DeleteResult) Merge(o *DeleteResult) {
+	if len(o.DeletedSeries) == 0 {
+		return
+	}
+	if rs.DeletedSeries == nil {
+		rs.DeletedSeries = make(map[ShardID]uint64)
+	}
+	for shardID, deletedSeries := range o.DeletedSeries {
+		if deletedSeries > rs.DeletedSeries[shardID] {
+			rs.DeletedSeries[shardID] = deletedSeries
+		}
+	}
+}
+
+// NumOfDeletedSeries returns the total number of deleted series.
+func (rs *DeleteResult) NumOfDeletedSeries() (total uint64) {
+	for _, deletedSeries := range rs.DeletedSeries {
+		total += deletedSeries
+	}
+	return
+}
+
+// ToTable returns the deleted series of each shard as table if it has value, else return empty string.
+func (rs *DeleteResult) ToTable() (rows int, tableStr string) {
+	if len(rs.DeletedSeries) == 0 {
+		return 0, ""
+	}
+	shardIDs := make([]ShardID, 0, len(rs.DeletedSeries))
+	for shardID := range rs.DeletedSeries {
+		shardIDs = append(shardIDs, shardID)
+	}
+	sort.Slice(shardIDs, func(i, j int) bool {
+		return shardIDs[i] < shardIDs[j]
+	})
+	writer := models.NewTableFormatter()
+	writer.AppendHeader(table.Row{"Shard", "Deleted Series"})
+	for _, shardID := range shardIDs {
+		writer.AppendRow(table.Row{shardID, rs.DeletedSeries[shardID]})
+	}
+	writer.AppendFooter(table.Row{"Total", rs.NumOfDeletedSeries()})
+	return len(shardIDs), writer.Render()
+}
@@ -0,0 +1,57 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package blob
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/lindb/lindb/config"
+)
+
+//go:generate mockgen -source ./backend.go -destination=./backend_mock.go -package blob
+
+const (
+	// LocalBackend represents the backend which stores objects in local filesystem.
+	LocalBackend = "local"
+	// S3Backend represents the backend which stores objects in S3-compatible object store.
+	S3Backend = "s3"
+)
+
+// Backend represents the object store which stores the offloaded files.
+type Backend interface {
+	// Put uploads the object with given key, overwrites it if exist.
+	Put(key string, r io.ReadSeeker) error
+	// GetRange reads length bytes of the object starting at offset,
+	// returns fewer bytes if the object ends before offset+length.
+	GetRange(key string, offset, length int64) ([]byte, error)
+	// Delete removes the object with given key, returns nil if the object not exist.
+	Delete(key string) error
+}
+
+// NewBackend creates the object store backend based on tiering config.
+func NewBackend(cfg *config.Tiering) (Backend, error) {
+	switch cfg.Backend {
+	case LocalBackend:
+		return NewLocalBackend(cfg.Dir)
+	case S3Backend:
+		return NewS3Backend(cfg)
+	default:
+		return nil, fmt.Errorf("unknown tiering backend: %s", cfg.Backend)
+	}
+}
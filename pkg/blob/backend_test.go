@@ -0,0 +1,39 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package blob
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/config"
+)
+
+func TestNewBackend(t *testing.T) {
+	backend, err := NewBackend(&config.Tiering{Backend: LocalBackend, Dir: filepath.Join(t.TempDir(), "tiering")})
+	assert.NoError(t, err)
+	assert.NotNil(t, backend)
+	backend, err = NewBackend(&config.Tiering{Backend: S3Backend, Bucket: "bucket", Region: "us-east-1"})
+	assert.NoError(t, err)
+	assert.NotNil(t, backend)
+	backend, err = NewBackend(&config.Tiering{Backend: "hdfs"})
+	assert.Error(t, err)
+	assert.Nil(t, backend)
+}
@@ -0,0 +1,189 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package blob
+
+import (
+	"container/list"
+	"crypto/sha1" //nolint:gosec
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/lindb/common/pkg/fileutil"
+	"github.com/lindb/common/pkg/logger"
+)
+
+// for testing
+var (
+	removeDirFunc = fileutil.RemoveDir
+	readFileFunc  = os.ReadFile
+	writeFileFunc = os.WriteFile
+)
+
+// defaultChunkSize is the size of chunk which cached in local disk.
+const defaultChunkSize int64 = 1024 * 1024 // 1MB
+
+// chunk represents a cached part of object.
+type chunk struct {
+	name string
+	size int64
+}
+
+// cachedBackend wraps the backend, caches the chunks of objects in local disk,
+// evicts the least recently used chunks if the total size exceeds the capacity.
+type cachedBackend struct {
+	Backend
+
+	dir       string
+	chunkSize int64
+	capacity  int64
+	size      int64
+	chunks    map[string]*list.Element // chunk name => element of lru list
+	lru       *list.List
+	logger    logger.Logger
+
+	mutex sync.Mutex
+}
+
+// NewCachedBackend creates the backend which reads objects through local disk cache.
+// NOTE: the chunks cached by previous process are cleaned up.
+func NewCachedBackend(backend Backend, dir string, capacity int64) (Backend, error) {
+	if err := removeDirFunc(dir); err != nil {
+		return nil, err
+	}
+	if err := mkDirFunc(dir); err != nil {
+		return nil, err
+	}
+	return &cachedBackend{
+		Backend:   backend,
+		dir:       dir,
+		chunkSize: defaultChunkSize,
+		capacity:  capacity,
+		chunks:    make(map[string]*list.Element),
+		lru:       list.New(),
+		logger:    logger.GetLogger("Blob", "Cache"),
+	}, nil
+}
+
+// GetRange reads length bytes of the object starting at offset,
+// reads the chunks from local disk cache, fetches them from backend if not cached.
+func (c *cachedBackend) GetRange(key string, offset, length int64) ([]byte, error) {
+	if length <= 0 {
+		return nil, nil
+	}
+	end := offset + length
+	result := make([]byte, 0, length)
+	for idx := offset / c.chunkSize; idx*c.chunkSize < end; idx++ {
+		data, err := c.getChunk(key, idx)
+		if err != nil {
+			return nil, err
+		}
+		chunkStart := idx * c.chunkSize
+		start := max(offset-chunkStart, 0)
+		if start >= int64(len(data)) {
+			break
+		}
+		result = append(result, data[start:min(end-chunkStart, int64(len(data)))]...)
+		if int64(len(data)) < c.chunkSize {
+			// last chunk of object
+			break
+		}
+	}
+	return result, nil
+}
+
+// Delete removes the object with given key, and drops the cached chunks of it.
+func (c *cachedBackend) Delete(key string) error {
+	if err := c.Backend.Delete(key); err != nil {
+		return err
+	}
+	prefix := objectHash(key) + "-"
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for name, elem := range c.chunks {
+		if strings.HasPrefix(name, prefix) {
+			c.removeChunk(elem)
+		}
+	}
+	return nil
+}
+
+// getChunk returns the chunk of object by index.
+func (c *cachedBackend) getChunk(key string, idx int64) ([]byte, error) {
+	name := fmt.Sprintf("%s-%d", objectHash(key), idx)
+	path := filepath.Join(c.dir, name)
+
+	c.mutex.Lock()
+	elem, ok := c.chunks[name]
+	if ok {
+		c.lru.MoveToFront(elem)
+	}
+	c.mutex.Unlock()
+
+	if ok {
+		data, err := readFileFunc(path)
+		if err == nil {
+			return data, nil
+		}
+		// chunk maybe evicted concurrently, fetch it again
+	}
+
+	data, err := c.Backend.GetRange(key, idx*c.chunkSize, c.chunkSize)
+	if err != nil {
+		return nil, err
+	}
+	if err := writeFileFunc(path, data, 0o644); err != nil {
+		// cannot cache the chunk, just return the data
+		c.logger.Warn("write chunk into cache failure", logger.String("chunk", path), logger.Error(err))
+		return data, nil
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if _, ok := c.chunks[name]; !ok {
+		c.chunks[name] = c.lru.PushFront(&chunk{name: name, size: int64(len(data))})
+		c.size += int64(len(data))
+	}
+	// evict the least recently used chunks
+	for c.size > c.capacity && c.lru.Len() > 1 {
+		c.removeChunk(c.lru.Back())
+	}
+	return data, nil
+}
+
+// removeChunk removes the chunk from cache.
+func (c *cachedBackend) removeChunk(elem *list.Element) {
+	ck := c.lru.Remove(elem).(*chunk)
+	delete(c.chunks, ck.name)
+	c.size -= ck.size
+	if err := removeFunc(filepath.Join(c.dir, ck.name)); err != nil {
+		c.logger.Warn("remove chunk from cache failure", logger.String("chunk", ck.name), logger.Error(err))
+	}
+}
+
+// objectHash returns the hash of object key, which is used as the prefix of chunk name.
+func objectHash(key string) string {
+	h := sha1.Sum([]byte(key)) //nolint:gosec
+	return hex.EncodeToString(h[:])
+}
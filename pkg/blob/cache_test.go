@@ -0,0 +1,169 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package blob
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lindb/common/pkg/fileutil"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewCachedBackend(t *testing.T) {
+	defer func() {
+		removeDirFunc = fileutil.RemoveDir
+		mkDirFunc = fileutil.MkDirIfNotExist
+	}()
+	dir := filepath.Join(t.TempDir(), "cache")
+	assert.NoError(t, fileutil.MkDirIfNotExist(dir))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "chunk"), []byte("data"), 0o644))
+	backend, err := NewCachedBackend(nil, dir, 1024)
+	assert.NoError(t, err)
+	assert.NotNil(t, backend)
+	// chunks of previous process cleaned up
+	assert.False(t, fileutil.Exist(filepath.Join(dir, "chunk")))
+
+	mkDirFunc = func(path string) error {
+		return fmt.Errorf("err")
+	}
+	_, err = NewCachedBackend(nil, dir, 1024)
+	assert.Error(t, err)
+	removeDirFunc = func(path string) error {
+		return fmt.Errorf("err")
+	}
+	_, err = NewCachedBackend(nil, dir, 1024)
+	assert.Error(t, err)
+}
+
+func TestCachedBackend_GetRange(t *testing.T) {
+	local, err := NewLocalBackend(filepath.Join(t.TempDir(), "tiering"))
+	assert.NoError(t, err)
+	data := []byte("0123456789abcdefghij")
+	assert.NoError(t, local.Put("db/000001.sst", bytes.NewReader(data)))
+	backend, err := NewCachedBackend(local, filepath.Join(t.TempDir(), "cache"), 12)
+	assert.NoError(t, err)
+	c := backend.(*cachedBackend)
+	c.chunkSize = 4
+
+	cases := []struct {
+		offset, length int64
+		want           string
+	}{
+		{offset: 0, length: 0, want: ""},
+		{offset: 0, length: 4, want: "0123"},
+		{offset: 2, length: 7, want: "2345678"},
+		{offset: 5, length: 10, want: "56789abcde"},
+		{offset: 16, length: 10, want: "ghij"},
+		{offset: 18, length: 10, want: "ij"},
+		{offset: 20, length: 10, want: ""},
+	}
+	for _, tt := range cases {
+		rs, err := backend.GetRange("db/000001.sst", tt.offset, tt.length)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.want, string(rs))
+		assert.LessOrEqual(t, c.size, c.capacity)
+	}
+	// read from cache
+	assert.NoError(t, local.Delete("db/000001.sst"))
+	rs, err := backend.GetRange("db/000001.sst", 16, 4)
+	assert.NoError(t, err)
+	assert.Equal(t, "ghij", string(rs))
+	// chunk evicted
+	_, err = backend.GetRange("db/000001.sst", 0, 4)
+	assert.Error(t, err)
+}
+
+func TestCachedBackend_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	local := NewMockBackend(ctrl)
+	backend, err := NewCachedBackend(local, filepath.Join(t.TempDir(), "cache"), 1024)
+	assert.NoError(t, err)
+	c := backend.(*cachedBackend)
+
+	local.EXPECT().GetRange("db/000001.sst", int64(0), defaultChunkSize).Return([]byte("data"), nil)
+	local.EXPECT().GetRange("db/000002.sst", int64(0), defaultChunkSize).Return([]byte("data"), nil)
+	_, err = backend.GetRange("db/000001.sst", 0, 4)
+	assert.NoError(t, err)
+	_, err = backend.GetRange("db/000002.sst", 0, 4)
+	assert.NoError(t, err)
+	assert.Len(t, c.chunks, 2)
+
+	local.EXPECT().Delete("db/000001.sst").Return(fmt.Errorf("err"))
+	assert.Error(t, backend.Delete("db/000001.sst"))
+	local.EXPECT().Delete("db/000001.sst").Return(nil)
+	assert.NoError(t, backend.Delete("db/000001.sst"))
+	assert.Len(t, c.chunks, 1)
+	assert.Equal(t, int64(4), c.size)
+}
+
+func TestCachedBackend_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		writeFileFunc = os.WriteFile
+		readFileFunc = os.ReadFile
+		removeFunc = os.Remove
+		ctrl.Finish()
+	}()
+
+	local := NewMockBackend(ctrl)
+	backend, err := NewCachedBackend(local, filepath.Join(t.TempDir(), "cache"), 4)
+	assert.NoError(t, err)
+	c := backend.(*cachedBackend)
+
+	local.EXPECT().GetRange(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+	_, err = backend.GetRange("db/000001.sst", 0, 4)
+	assert.Error(t, err)
+
+	// write cache failure
+	writeFileFunc = func(name string, data []byte, perm os.FileMode) error {
+		return fmt.Errorf("err")
+	}
+	local.EXPECT().GetRange(gomock.Any(), gomock.Any(), gomock.Any()).Return([]byte("data"), nil)
+	rs, err := backend.GetRange("db/000001.sst", 0, 4)
+	assert.NoError(t, err)
+	assert.Equal(t, "data", string(rs))
+	assert.Empty(t, c.chunks)
+	writeFileFunc = os.WriteFile
+
+	// read cache failure, fetch again
+	local.EXPECT().GetRange(gomock.Any(), gomock.Any(), gomock.Any()).Return([]byte("data"), nil).Times(2)
+	_, err = backend.GetRange("db/000001.sst", 0, 4)
+	assert.NoError(t, err)
+	readFileFunc = func(name string) ([]byte, error) {
+		return nil, fmt.Errorf("err")
+	}
+	rs, err = backend.GetRange("db/000001.sst", 0, 4)
+	assert.NoError(t, err)
+	assert.Equal(t, "data", string(rs))
+
+	// remove chunk failure
+	removeFunc = func(name string) error {
+		return fmt.Errorf("err")
+	}
+	local.EXPECT().GetRange(gomock.Any(), gomock.Any(), gomock.Any()).Return([]byte("data"), nil)
+	_, err = backend.GetRange("db/000002.sst", 0, 4)
+	assert.NoError(t, err)
+	assert.Len(t, c.chunks, 1)
+}
@@ -0,0 +1,108 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package blob
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/lindb/common/pkg/fileutil"
+)
+
+// for testing
+var (
+	mkDirFunc   = fileutil.MkDirIfNotExist
+	renameFunc  = os.Rename
+	removeFunc  = os.Remove
+	openFunc    = os.Open
+	readAllFunc = io.ReadAll
+)
+
+// localBackend implements Backend interface, stores objects in local filesystem.
+type localBackend struct {
+	dir string
+}
+
+// NewLocalBackend creates the backend which stores objects under given dir.
+func NewLocalBackend(dir string) (Backend, error) {
+	if err := mkDirFunc(dir); err != nil {
+		return nil, err
+	}
+	return &localBackend{dir: dir}, nil
+}
+
+// Put uploads the object with given key, overwrites it if exist.
+func (b *localBackend) Put(key string, r io.ReadSeeker) error {
+	path := b.path(key)
+	if err := mkDirFunc(filepath.Dir(path)); err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := writeFile(tmp, r); err != nil {
+		_ = removeFunc(tmp)
+		return err
+	}
+	return renameFunc(tmp, path)
+}
+
+// GetRange reads length bytes of the object starting at offset.
+func (b *localBackend) GetRange(key string, offset, length int64) ([]byte, error) {
+	f, err := openFunc(b.path(key))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	buf := make([]byte, length)
+	n, err := f.ReadAt(buf, offset)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	return buf[:n], nil
+}
+
+// Delete removes the object with given key.
+func (b *localBackend) Delete(key string) error {
+	if err := removeFunc(b.path(key)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// path returns the file path of object.
+func (b *localBackend) path(key string) string {
+	return filepath.Join(b.dir, filepath.FromSlash(key))
+}
+
+// writeFile writes all data of reader into file.
+func writeFile(path string, r io.Reader) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	if _, err := io.Copy(f, r); err != nil {
+		return err
+	}
+	return f.Sync()
+}
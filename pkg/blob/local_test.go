@@ -0,0 +1,79 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package blob
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lindb/common/pkg/fileutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalBackend(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "tiering")
+	backend, err := NewLocalBackend(dir)
+	assert.NoError(t, err)
+
+	assert.NoError(t, backend.Put("db/1/000001.sst", bytes.NewReader([]byte("0123456789"))))
+	assert.True(t, fileutil.Exist(filepath.Join(dir, "db", "1", "000001.sst")))
+
+	data, err := backend.GetRange("db/1/000001.sst", 2, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("234"), data)
+	// read beyond the end of object
+	data, err = backend.GetRange("db/1/000001.sst", 8, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("89"), data)
+	_, err = backend.GetRange("db/1/000002.sst", 0, 10)
+	assert.Error(t, err)
+
+	assert.NoError(t, backend.Delete("db/1/000001.sst"))
+	assert.False(t, fileutil.Exist(filepath.Join(dir, "db", "1", "000001.sst")))
+	// delete not exist object
+	assert.NoError(t, backend.Delete("db/1/000001.sst"))
+}
+
+func TestLocalBackend_Error(t *testing.T) {
+	defer func() {
+		mkDirFunc = fileutil.MkDirIfNotExist
+		renameFunc = os.Rename
+		removeFunc = os.Remove
+	}()
+	mkDirFunc = func(path string) error {
+		return fmt.Errorf("err")
+	}
+	backend, err := NewLocalBackend(t.TempDir())
+	assert.Error(t, err)
+	assert.Nil(t, backend)
+
+	backend = &localBackend{dir: t.TempDir()}
+	assert.Error(t, backend.Put("000001.sst", bytes.NewReader([]byte("data"))))
+	mkDirFunc = fileutil.MkDirIfNotExist
+	renameFunc = func(oldpath, newpath string) error {
+		return fmt.Errorf("err")
+	}
+	assert.Error(t, backend.Put("000001.sst", bytes.NewReader([]byte("data"))))
+	removeFunc = func(name string) error {
+		return fmt.Errorf("err")
+	}
+	assert.Error(t, backend.Delete("000001.sst"))
+}
@@ -0,0 +1,120 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package blob
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"path"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+
+	"github.com/lindb/lindb/config"
+)
+
+// for testing
+var (
+	newSessionFunc = session.NewSession
+)
+
+// s3Backend implements Backend interface, stores objects in S3-compatible object store.
+type s3Backend struct {
+	client s3iface.S3API
+	bucket string
+	prefix string
+}
+
+// NewS3Backend creates the backend which stores objects in S3-compatible object store.
+func NewS3Backend(cfg *config.Tiering) (Backend, error) {
+	awsCfg := aws.NewConfig().WithS3ForcePathStyle(cfg.ForcePathStyle)
+	if cfg.Region != "" {
+		awsCfg = awsCfg.WithRegion(cfg.Region)
+	}
+	if cfg.Endpoint != "" {
+		awsCfg = awsCfg.WithEndpoint(cfg.Endpoint)
+	}
+	if cfg.AccessKeyID != "" {
+		awsCfg = awsCfg.WithCredentials(credentials.NewStaticCredentials(cfg.AccessKeyID, cfg.SecretAccessKey, ""))
+	}
+	sess, err := newSessionFunc(awsCfg)
+	if err != nil {
+		return nil, fmt.Errorf("create s3 session error:%s", err)
+	}
+	return &s3Backend{
+		client: s3.New(sess),
+		bucket: cfg.Bucket,
+		prefix: cfg.Prefix,
+	}, nil
+}
+
+// Put uploads the object with given key, overwrites it if exist.
+func (b *s3Backend) Put(key string, r io.ReadSeeker) error {
+	_, err := b.client.PutObject(&s3.PutObjectInput{
+		Bucket: aws.String(b.bucket),
+		Key:    aws.String(b.objectKey(key)),
+		Body:   r,
+	})
+	return err
+}
+
+// GetRange reads length bytes of the object starting at offset.
+func (b *s3Backend) GetRange(key string, offset, length int64) ([]byte, error) {
+	if length <= 0 {
+		return nil, nil
+	}
+	output, err := b.client.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String(b.bucket),
+		Key:    aws.String(b.objectKey(key)),
+		Range:  aws.String(fmt.Sprintf("bytes=%d-%d", offset, offset+length-1)),
+	})
+	if err != nil {
+		var awsErr awserr.Error
+		if errors.As(err, &awsErr) && awsErr.Code() == "InvalidRange" {
+			// offset is beyond the end of object
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer func() {
+		_ = output.Body.Close()
+	}()
+	return readAllFunc(output.Body)
+}
+
+// Delete removes the object with given key.
+func (b *s3Backend) Delete(key string) error {
+	_, err := b.client.DeleteObject(&s3.DeleteObjectInput{
+		Bucket: aws.String(b.bucket),
+		Key:    aws.String(b.objectKey(key)),
+	})
+	return err
+}
+
+// objectKey returns the key of object in bucket.
+func (b *s3Backend) objectKey(key string) string {
+	if b.prefix == "" {
+		return key
+	}
+	return path.Join(b.prefix, key)
+}
@@ -0,0 +1,132 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package blob
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/config"
+)
+
+type mockS3Client struct {
+	s3iface.S3API
+	objects map[string][]byte
+	err     error
+}
+
+func (c *mockS3Client) PutObject(input *s3.PutObjectInput) (*s3.PutObjectOutput, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	data, _ := io.ReadAll(input.Body)
+	c.objects[*input.Bucket+"/"+*input.Key] = data
+	return &s3.PutObjectOutput{}, nil
+}
+
+func (c *mockS3Client) GetObject(input *s3.GetObjectInput) (*s3.GetObjectOutput, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	data, ok := c.objects[*input.Bucket+"/"+*input.Key]
+	if !ok {
+		return nil, awserr.New(s3.ErrCodeNoSuchKey, "not found", nil)
+	}
+	var start, end int
+	_, _ = fmt.Sscanf(*input.Range, "bytes=%d-%d", &start, &end)
+	if start >= len(data) {
+		return nil, awserr.New("InvalidRange", "invalid range", nil)
+	}
+	end = min(end+1, len(data))
+	return &s3.GetObjectOutput{Body: io.NopCloser(bytes.NewReader(data[start:end]))}, nil
+}
+
+func (c *mockS3Client) DeleteObject(input *s3.DeleteObjectInput) (*s3.DeleteObjectOutput, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	delete(c.objects, *input.Bucket+"/"+*input.Key)
+	return &s3.DeleteObjectOutput{}, nil
+}
+
+func TestNewS3Backend(t *testing.T) {
+	defer func() {
+		newSessionFunc = session.NewSession
+	}()
+	backend, err := NewS3Backend(&config.Tiering{
+		Bucket:          "bucket",
+		Region:          "us-east-1",
+		Endpoint:        "http://127.0.0.1:9000",
+		AccessKeyID:     "key",
+		SecretAccessKey: "secret",
+		ForcePathStyle:  true,
+	})
+	assert.NoError(t, err)
+	assert.NotNil(t, backend)
+
+	newSessionFunc = func(_ ...*aws.Config) (*session.Session, error) {
+		return nil, fmt.Errorf("err")
+	}
+	backend, err = NewS3Backend(&config.Tiering{Bucket: "bucket"})
+	assert.Error(t, err)
+	assert.Nil(t, backend)
+}
+
+func TestS3Backend(t *testing.T) {
+	client := &mockS3Client{objects: make(map[string][]byte)}
+	backend := &s3Backend{client: client, bucket: "bucket", prefix: "lindb"}
+
+	assert.NoError(t, backend.Put("db/000001.sst", bytes.NewReader([]byte("0123456789"))))
+	assert.Contains(t, client.objects, "bucket/lindb/db/000001.sst")
+
+	data, err := backend.GetRange("db/000001.sst", 2, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("234"), data)
+	data, err = backend.GetRange("db/000001.sst", 8, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("89"), data)
+	data, err = backend.GetRange("db/000001.sst", 20, 10)
+	assert.NoError(t, err)
+	assert.Empty(t, data)
+	data, err = backend.GetRange("db/000001.sst", 0, 0)
+	assert.NoError(t, err)
+	assert.Empty(t, data)
+	_, err = backend.GetRange("db/000002.sst", 0, 10)
+	assert.Error(t, err)
+
+	assert.NoError(t, backend.Delete("db/000001.sst"))
+	assert.Empty(t, client.objects)
+
+	backend.prefix = ""
+	assert.Equal(t, "db/000001.sst", backend.objectKey("db/000001.sst"))
+
+	client.err = fmt.Errorf("err")
+	assert.Error(t, backend.Put("db/000001.sst", bytes.NewReader([]byte("data"))))
+	_, err = backend.GetRange("db/000001.sst", 0, 10)
+	assert.Error(t, err)
+	assert.Error(t, backend.Delete("db/000001.sst"))
+}
@@ -0,0 +1,287 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package compress
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+)
+
+// Type represents the compression algorithm of block.
+type Type uint8
+
+// Defines all compression algorithm of block.
+const (
+	// NoCompression stores block as raw data.
+	NoCompression Type = iota
+	// ZSTD compresses block using zstd, level range [1,22], default level 3.
+	ZSTD
+	// LZ4 compresses block using lz4, level range [1,9] uses high compression mode, 0 is fast mode.
+	LZ4
+)
+
+const (
+	defaultZSTDLevel = 3
+	maxZSTDLevel     = 22
+	maxLZ4Level      = 9
+)
+
+// String returns the string value of compression type.
+func (t Type) String() string {
+	switch t {
+	case NoCompression:
+		return "none"
+	case ZSTD:
+		return "zstd"
+	case LZ4:
+		return "lz4"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseType returns the compression type by name, empty name means no compression.
+func ParseType(name string) (Type, error) {
+	switch strings.ToLower(name) {
+	case "", "none":
+		return NoCompression, nil
+	case "zstd":
+		return ZSTD, nil
+	case "lz4":
+		return LZ4, nil
+	default:
+		return NoCompression, fmt.Errorf("unknown compression type: %s", name)
+	}
+}
+
+// BlockOption represents the block compression option.
+type BlockOption struct {
+	Type  string `toml:"type" json:"type,omitempty"`   // none/zstd/lz4, default none
+	Level int    `toml:"level" json:"level,omitempty"` // compression level, 0 means default level of algorithm
+}
+
+// Validate checks if block compression option is valid.
+func (o BlockOption) Validate() error {
+	t, err := ParseType(o.Type)
+	if err != nil {
+		return err
+	}
+	return validateLevel(t, o.Level)
+}
+
+// String returns the string value of block compression option.
+func (o BlockOption) String() string {
+	t, _ := ParseType(o.Type)
+	if t == NoCompression || o.Level == 0 {
+		return t.String()
+	}
+	return fmt.Sprintf("%s(level:%d)", t, o.Level)
+}
+
+// BlockCodec represents the codec which compresses/decompresses a whole block,
+// it is safe for concurrent use.
+type BlockCodec interface {
+	// Type returns the compression type of codec.
+	Type() Type
+	// Compress appends the compressed data of src to dst, returns the updated slice.
+	Compress(dst, src []byte) []byte
+	// Decompress returns the decompressed data of src.
+	// NOTICE: returns src directly if no compression.
+	Decompress(src []byte) ([]byte, error)
+}
+
+type codecKey struct {
+	t     Type
+	level int
+}
+
+var (
+	codecs     = make(map[codecKey]BlockCodec)
+	codecsLock sync.Mutex
+)
+
+// GetBlockCodec returns the block codec by compression option.
+func GetBlockCodec(option BlockOption) (BlockCodec, error) {
+	t, err := ParseType(option.Type)
+	if err != nil {
+		return nil, err
+	}
+	return GetBlockCodecByType(t, option.Level)
+}
+
+// GetBlockCodecByType returns the block codec by compression type and level,
+// codec is cached for reusing the encoder/decoder.
+func GetBlockCodecByType(t Type, level int) (BlockCodec, error) {
+	if err := validateLevel(t, level); err != nil {
+		return nil, err
+	}
+	key := codecKey{t: t, level: level}
+	codecsLock.Lock()
+	defer codecsLock.Unlock()
+
+	if codec, ok := codecs[key]; ok {
+		return codec, nil
+	}
+	var codec BlockCodec
+	switch t {
+	case NoCompression:
+		codec = &noCodec{}
+	case ZSTD:
+		if level == 0 {
+			level = defaultZSTDLevel
+		}
+		encoder, err := zstd.NewWriter(nil,
+			zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(level)),
+			zstd.WithEncoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+		decoder, err := zstd.NewReader(nil, zstd.WithDecoderConcurrency(0))
+		if err != nil {
+			return nil, err
+		}
+		codec = &zstdCodec{encoder: encoder, decoder: decoder}
+	case LZ4:
+		codec = newLZ4Codec(level)
+	}
+	codecs[key] = codec
+	return codec, nil
+}
+
+// validateLevel checks if compression level is valid for compression type.
+func validateLevel(t Type, level int) error {
+	switch t {
+	case NoCompression:
+		return nil
+	case ZSTD:
+		if level < 0 || level > maxZSTDLevel {
+			return fmt.Errorf("zstd compression level must be in [0,%d], level: %d", maxZSTDLevel, level)
+		}
+		return nil
+	case LZ4:
+		if level < 0 || level > maxLZ4Level {
+			return fmt.Errorf("lz4 compression level must be in [0,%d], level: %d", maxLZ4Level, level)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown compression type: %d", t)
+	}
+}
+
+// noCodec keeps block as raw data.
+type noCodec struct{}
+
+func (c *noCodec) Type() Type {
+	return NoCompression
+}
+
+func (c *noCodec) Compress(dst, src []byte) []byte {
+	return append(dst, src...)
+}
+
+func (c *noCodec) Decompress(src []byte) ([]byte, error) {
+	return src, nil
+}
+
+// zstdCodec compresses block using zstd, frame of zstd keeps the length of raw data.
+type zstdCodec struct {
+	encoder *zstd.Encoder
+	decoder *zstd.Decoder
+}
+
+func (c *zstdCodec) Type() Type {
+	return ZSTD
+}
+
+func (c *zstdCodec) Compress(dst, src []byte) []byte {
+	return c.encoder.EncodeAll(src, dst)
+}
+
+func (c *zstdCodec) Decompress(src []byte) ([]byte, error) {
+	return c.decoder.DecodeAll(src, nil)
+}
+
+// lz4Codec compresses block using lz4,
+// block layout: length of raw data(uvarint) + compressed data.
+type lz4Codec struct {
+	compressors sync.Pool
+}
+
+func newLZ4Codec(level int) BlockCodec {
+	c := &lz4Codec{}
+	if level == 0 {
+		c.compressors.New = func() any {
+			return &lz4.Compressor{}
+		}
+	} else {
+		c.compressors.New = func() any {
+			return &lz4.CompressorHC{Level: lz4.CompressionLevel(1 << (8 + level))}
+		}
+	}
+	return c
+}
+
+func (c *lz4Codec) Type() Type {
+	return LZ4
+}
+
+func (c *lz4Codec) Compress(dst, src []byte) []byte {
+	dst = binary.AppendUvarint(dst, uint64(len(src)))
+	if len(src) == 0 {
+		return dst
+	}
+	start := len(dst)
+	bound := lz4.CompressBlockBound(len(src))
+	dst = append(dst, make([]byte, bound)...)
+
+	compressor := c.compressors.Get().(interface {
+		CompressBlock(src, dst []byte) (int, error)
+	})
+	defer c.compressors.Put(compressor)
+	// dst has length of CompressBlockBound, compression always succeeds.
+	n, _ := compressor.CompressBlock(src, dst[start:])
+	return dst[:start+n]
+}
+
+func (c *lz4Codec) Decompress(src []byte) ([]byte, error) {
+	rawLen, n := binary.Uvarint(src)
+	if n <= 0 {
+		return nil, fmt.Errorf("bad lz4 block, cannot read length of raw data")
+	}
+	// lz4 compression ratio cannot exceed 255, avoid allocating huge buffer for corrupted block
+	if rawLen > uint64(len(src)-n)*255 {
+		return nil, fmt.Errorf("bad lz4 block, length of raw data: %d is too large", rawLen)
+	}
+	dst := make([]byte, rawLen)
+	if rawLen == 0 {
+		return dst, nil
+	}
+	size, err := lz4.UncompressBlock(src[n:], dst)
+	if err != nil {
+		return nil, err
+	}
+	if uint64(size) != rawLen {
+		return nil, fmt.Errorf("bad lz4 block, expect length: %d, actual length: %d", rawLen, size)
+	}
+	return dst, nil
+}
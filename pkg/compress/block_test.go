@@ -0,0 +1,141 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package compress
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseType(t *testing.T) {
+	cases := []struct {
+		name    string
+		t       Type
+		wantErr bool
+	}{
+		{name: "", t: NoCompression},
+		{name: "none", t: NoCompression},
+		{name: "ZSTD", t: ZSTD},
+		{name: "lz4", t: LZ4},
+		{name: "gzip", wantErr: true},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			typ, err := ParseType(tt.name)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.t, typ)
+		})
+	}
+	assert.Equal(t, "none", NoCompression.String())
+	assert.Equal(t, "zstd", ZSTD.String())
+	assert.Equal(t, "lz4", LZ4.String())
+	assert.Equal(t, "unknown", Type(99).String())
+}
+
+func TestBlockOption_Validate(t *testing.T) {
+	assert.NoError(t, BlockOption{}.Validate())
+	assert.NoError(t, BlockOption{Type: "zstd", Level: 19}.Validate())
+	assert.NoError(t, BlockOption{Type: "lz4", Level: 9}.Validate())
+	assert.Error(t, BlockOption{Type: "gzip"}.Validate())
+	assert.Error(t, BlockOption{Type: "zstd", Level: 23}.Validate())
+	assert.Error(t, BlockOption{Type: "zstd", Level: -1}.Validate())
+	assert.Error(t, BlockOption{Type: "lz4", Level: 10}.Validate())
+
+	assert.Equal(t, "none", BlockOption{Level: 3}.String())
+	assert.Equal(t, "lz4", BlockOption{Type: "lz4"}.String())
+	assert.Equal(t, "zstd(level:9)", BlockOption{Type: "zstd", Level: 9}.String())
+}
+
+func TestGetBlockCodec(t *testing.T) {
+	_, err := GetBlockCodec(BlockOption{Type: "gzip"})
+	assert.Error(t, err)
+	_, err = GetBlockCodecByType(Type(99), 0)
+	assert.Error(t, err)
+
+	c1, err := GetBlockCodec(BlockOption{Type: "zstd", Level: 5})
+	assert.NoError(t, err)
+	c2, err := GetBlockCodecByType(ZSTD, 5)
+	assert.NoError(t, err)
+	assert.Same(t, c1, c2)
+}
+
+func TestBlockCodec_Roundtrip(t *testing.T) {
+	data := bytes.Repeat([]byte("lindb block compression,"), 1024)
+	cases := []struct {
+		name   string
+		option BlockOption
+	}{
+		{name: "none", option: BlockOption{}},
+		{name: "zstd default level", option: BlockOption{Type: "zstd"}},
+		{name: "zstd level 19", option: BlockOption{Type: "zstd", Level: 19}},
+		{name: "lz4 fast", option: BlockOption{Type: "lz4"}},
+		{name: "lz4 hc", option: BlockOption{Type: "lz4", Level: 9}},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			codec, err := GetBlockCodec(tt.option)
+			assert.NoError(t, err)
+			typ, _ := ParseType(tt.option.Type)
+			assert.Equal(t, typ, codec.Type())
+
+			prefix := []byte("prefix")
+			compressed := codec.Compress(prefix, data)
+			assert.Equal(t, prefix, compressed[:len(prefix)])
+			if typ != NoCompression {
+				assert.Less(t, len(compressed), len(data))
+			}
+			raw, err := codec.Decompress(compressed[len(prefix):])
+			assert.NoError(t, err)
+			assert.Equal(t, data, raw)
+
+			// empty block
+			compressed = codec.Compress(nil, nil)
+			raw, err = codec.Decompress(compressed)
+			assert.NoError(t, err)
+			assert.Empty(t, raw)
+		})
+	}
+}
+
+func TestBlockCodec_Decompress_Corrupted(t *testing.T) {
+	zstdCodec, _ := GetBlockCodecByType(ZSTD, 0)
+	_, err := zstdCodec.Decompress([]byte("bad data"))
+	assert.Error(t, err)
+
+	lz4Codec, _ := GetBlockCodecByType(LZ4, 0)
+	_, err = lz4Codec.Decompress(nil)
+	assert.Error(t, err)
+	compressed := lz4Codec.Compress(nil, bytes.Repeat([]byte("lz4"), 100))
+	_, err = lz4Codec.Decompress(compressed[:len(compressed)-2])
+	assert.Error(t, err)
+	// length of raw data too large
+	_, err = lz4Codec.Decompress([]byte{0xff, 0xff, 0xff, 0x7f, 1})
+	assert.Error(t, err)
+	// length of raw data not match
+	compressed[0]++
+	_, err = lz4Codec.Decompress(compressed)
+	assert.Error(t, err)
+}
@@ -0,0 +1,80 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fileutil
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCopyFile(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "src")
+	dst := filepath.Join(dir, "dst")
+	assert.NoError(t, os.WriteFile(src, []byte("abc123"), 0644))
+	assert.NoError(t, os.WriteFile(dst, []byte("old content"), 0644))
+
+	assert.NoError(t, CopyFile(src, dst))
+	data, err := os.ReadFile(dst)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("abc123"), data)
+
+	// src not exist
+	assert.Error(t, CopyFile(filepath.Join(dir, "not_exist"), dst))
+	// dst dir not exist
+	assert.Error(t, CopyFile(src, filepath.Join(dir, "not_exist", "dst")))
+}
+
+func TestLinkOrCopyFile(t *testing.T) {
+	defer func() {
+		linkFunc = os.Link
+	}()
+	dir := t.TempDir()
+	src := filepath.Join(dir, "src")
+	assert.NoError(t, os.WriteFile(src, []byte("abc123"), 0644))
+
+	link := filepath.Join(dir, "link")
+	assert.NoError(t, LinkOrCopyFile(src, link))
+	srcStat, err := os.Stat(src)
+	assert.NoError(t, err)
+	linkStat, err := os.Stat(link)
+	assert.NoError(t, err)
+	assert.True(t, os.SameFile(srcStat, linkStat))
+	// dst exist
+	assert.Error(t, LinkOrCopyFile(src, link))
+	data, err := os.ReadFile(src)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("abc123"), data)
+
+	// link not supported, fallback to copy
+	linkFunc = func(_, _ string) error {
+		return fmt.Errorf("err")
+	}
+	copied := filepath.Join(dir, "copied")
+	assert.NoError(t, LinkOrCopyFile(src, copied))
+	copiedStat, err := os.Stat(copied)
+	assert.NoError(t, err)
+	assert.False(t, os.SameFile(srcStat, copiedStat))
+	data, err = os.ReadFile(copied)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("abc123"), data)
+}
@@ -19,6 +19,7 @@ package http
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"io/fs"
 	"net/http"
@@ -40,6 +41,7 @@ import (
 	"github.com/lindb/lindb/internal/conntrack"
 	"github.com/lindb/lindb/internal/linmetric"
 	"github.com/lindb/lindb/pkg/hostutil"
+	"github.com/lindb/lindb/pkg/tlsutil"
 )
 
 //go:generate mockgen -source ./http_server.go -destination=./http_server_mock.go -package=http
@@ -113,8 +115,12 @@ func (s *server) init() {
 	if config.Doc {
 		// swagger-ui: http://localhost:port/swagger/index.html
 		ip, _ := hostutil.GetHostIP()
+		scheme := "http"
+		if s.cfg.TLS.Enabled {
+			scheme = "https"
+		}
 		s.gin.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler,
-			ginSwagger.URL(fmt.Sprintf("http://%s:%d/swagger/doc.json", ip, s.cfg.Port)),
+			ginSwagger.URL(fmt.Sprintf("%s://%s:%d/swagger/doc.json", scheme, ip, s.cfg.Port)),
 			ginSwagger.DefaultModelsExpandDepth(-1)))
 	}
 	if s.staticResource {
@@ -146,13 +152,21 @@ func (s *server) GetPrometheusAPIRouter() *gin.RouterGroup {
 
 // Run runs the HTTP server.
 func (s *server) Run() error {
-	s.logger.Info("starting http server", logger.String("addr", s.server.Addr))
+	s.logger.Info("starting http server", logger.String("addr", s.addr), logger.Any("tls", s.cfg.TLS.Enabled))
 	s.server.Handler = s.gin
+	tlsCfg, err := tlsutil.NewServerConfig(&s.cfg.TLS)
+	if err != nil {
+		return err
+	}
 	// Open listener.
 	trackedListener, err := conntrack.NewTrackedListener("tcp", s.addr, s.r)
 	if err != nil {
 		return err
 	}
+	if tlsCfg != nil {
+		// serve https
+		return s.server.Serve(tls.NewListener(trackedListener, tlsCfg))
+	}
 	return s.server.Serve(trackedListener)
 }
 
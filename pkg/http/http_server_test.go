@@ -19,13 +19,19 @@ package http
 
 import (
 	"context"
+	"crypto/tls"
+	"io"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/internal/linmetric"
+	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/pkg/tlsutil"
 )
 
 func init() {
@@ -44,3 +50,53 @@ func TestNewHTTPServer(t *testing.T) {
 		_ = s.Close(context.TODO())
 	}()
 }
+
+func TestNewHTTPServer_TLS(t *testing.T) {
+	config.Profile = false
+	config.Doc = false
+	defer func() {
+		config.Profile = true
+		config.Doc = true
+	}()
+	// cert not exist
+	s := NewServer(config.HTTP{Port: 9997, TLS: config.TLS{Enabled: true, CertFile: "not_exist"}},
+		false, linmetric.BrokerRegistry)
+	assert.Error(t, s.Run())
+
+	certs := mock.GenerateTLSCertificates(t)
+	s = NewServer(config.HTTP{Port: 9998, TLS: config.TLS{
+		Enabled:  true,
+		CertFile: certs.ServerCertFile,
+		KeyFile:  certs.ServerKeyFile,
+	}}, false, linmetric.BrokerRegistry)
+	s.GetAPIRouter().GET("/ping", func(c *gin.Context) {
+		c.String(http.StatusOK, "pong")
+	})
+	go func() {
+		_ = s.Run()
+	}()
+	defer func() {
+		_ = s.Close(context.TODO())
+	}()
+
+	clientCfg, err := tlsutil.NewClientConfig(&config.TLS{Enabled: true, CAFile: certs.CAFile})
+	assert.NoError(t, err)
+	cli := &http.Client{Transport: &http.Transport{TLSClientConfig: clientCfg}}
+	var resp *http.Response
+	for i := 0; i < 50; i++ {
+		resp, err = cli.Get("https://localhost:9998/api/ping")
+		if err == nil {
+			break
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	assert.NoError(t, err)
+	body, _ := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	assert.Equal(t, "pong", string(body))
+
+	// plaintext/untrusted client cannot access https server
+	_, err = (&http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{MinVersion: tls.VersionTLS12}}}).
+		Get("https://localhost:9998/api/ping")
+	assert.Error(t, err)
+}
@@ -0,0 +1,261 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package middleware
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/gin-gonic/gin"
+	commontimeutil "github.com/lindb/common/pkg/timeutil"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/models"
+)
+
+//go:generate mockgen -source=./authorization.go -destination=./authorization_mock.go -package=middleware
+
+// principalKey represents the key of principal in request context.
+const principalKey = "principal"
+
+// userTokenTTL represents the ttl of user login token.
+const userTokenTTL = 24 * time.Hour
+
+var (
+	// ErrUnauthorized represents the request without valid credential.
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrPermissionDenied represents the principal without required permission.
+	ErrPermissionDenied = errors.New("permission denied")
+)
+
+// AuthStore represents the store of users/roles/api tokens.
+type AuthStore interface {
+	// GetUser returns the user by name.
+	GetUser(name string) (*models.User, bool)
+	// GetRole returns the role by name.
+	GetRole(name string) (*models.Role, bool)
+	// GetAPIToken returns the api token by token id(hash of token).
+	GetAPIToken(id string) (*models.APIToken, bool)
+}
+
+// Principal represents the authenticated user of request.
+type Principal struct {
+	UserName string
+	// SuperUser represents the admin user of config, which has all permissions.
+	SuperUser bool
+	Roles     []*models.Role
+}
+
+// HasPermission returns if principal has the permission of database.
+func (p *Principal) HasPermission(database string, required models.Permission) bool {
+	if p.SuperUser {
+		return true
+	}
+	for _, role := range p.Roles {
+		if role.Allow(database, required) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetPrincipal returns the authenticated principal of request.
+func GetPrincipal(c *gin.Context) (*Principal, bool) {
+	val, ok := c.Get(principalKey)
+	if !ok {
+		return nil, false
+	}
+	principal, ok := val.(*Principal)
+	return principal, ok
+}
+
+// CheckPermission checks if the principal of request has the permission of database,
+// if auth disabled(no principal of request), always pass.
+func CheckPermission(c *gin.Context, database string, required models.Permission) error {
+	principal, ok := GetPrincipal(c)
+	if !ok {
+		return nil
+	}
+	if !principal.HasPermission(database, required) {
+		return fmt.Errorf("%w, user: %s, database: %s, required: %s",
+			ErrPermissionDenied, principal.UserName, database, required)
+	}
+	return nil
+}
+
+// CreateUserToken returns jwt token of user which stored in state repo,
+// signed by the secret key of admin user.
+func CreateUserToken(userName string, admin config.User) (string, error) {
+	claims := CustomClaims{
+		StandardClaims: jwt.StandardClaims{
+			ExpiresAt: time.Now().Add(userTokenTTL).Unix(),
+		},
+		UserName: userName,
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, &claims)
+	return token.SignedString([]byte(Md5Encrypt(admin)))
+}
+
+// Authorization represents authentication/authorization of http api.
+type Authorization struct {
+	cfg   config.Auth
+	store AuthStore
+
+	// verified caches the password which verified by bcrypt(slow),
+	// key: user name/password hash/sha256 of plain password.
+	verified sync.Map
+}
+
+// NewAuthorization creates an authorization instance.
+func NewAuthorization(cfg config.Auth, store AuthStore) *Authorization {
+	return &Authorization{
+		cfg:   cfg,
+		store: store,
+	}
+}
+
+// Authenticate returns a middleware which authenticates the credential of request,
+// supports basic auth, api token(Bearer lin_xxx) and jwt token of login.
+// If auth disabled, does nothing.
+func (a *Authorization) Authenticate() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !a.cfg.Enabled {
+			c.Next()
+			return
+		}
+		principal, err := a.authenticate(c.Request)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, err.Error())
+			return
+		}
+		c.Set(principalKey, principal)
+		c.Next()
+	}
+}
+
+// RequirePermission returns a middleware which checks the permission of database.
+func (a *Authorization) RequirePermission(database string, required models.Permission) gin.HandlerFunc {
+	return a.RequirePermissionFunc(func(_ *gin.Context) (string, models.Permission) {
+		return database, required
+	})
+}
+
+// RequirePermissionFunc returns a middleware which checks the permission of database resolved by request.
+func (a *Authorization) RequirePermissionFunc(resolve func(c *gin.Context) (string, models.Permission)) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		database, required := resolve(c)
+		if err := CheckPermission(c, database, required); err != nil {
+			c.AbortWithStatusJSON(http.StatusForbidden, err.Error())
+			return
+		}
+		c.Next()
+	}
+}
+
+// authenticate returns the principal of request based on credential.
+func (a *Authorization) authenticate(req *http.Request) (*Principal, error) {
+	if userName, password, ok := req.BasicAuth(); ok {
+		return a.authenticatePassword(userName, password)
+	}
+	credential := strings.TrimSpace(strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer "))
+	switch {
+	case credential == "":
+		return nil, ErrUnauthorized
+	case models.IsAPIToken(credential):
+		return a.authenticateAPIToken(credential)
+	default:
+		return a.authenticateJWT(credential)
+	}
+}
+
+// authenticatePassword authenticates user by name/password.
+func (a *Authorization) authenticatePassword(userName, password string) (*Principal, error) {
+	if userName == a.cfg.Admin.UserName {
+		if subtle.ConstantTimeCompare([]byte(password), []byte(a.cfg.Admin.Password)) != 1 {
+			return nil, ErrUnauthorized
+		}
+		return &Principal{UserName: userName, SuperUser: true}, nil
+	}
+	user, ok := a.store.GetUser(userName)
+	if !ok {
+		return nil, ErrUnauthorized
+	}
+	sum := sha256.Sum256([]byte(password))
+	key := user.Name + "/" + user.Password + "/" + hex.EncodeToString(sum[:])
+	if _, ok := a.verified.Load(key); !ok {
+		if !user.CheckPassword(password) {
+			return nil, ErrUnauthorized
+		}
+		a.verified.Store(key, struct{}{})
+	}
+	return a.newPrincipal(user), nil
+}
+
+// authenticateAPIToken authenticates user by api token.
+func (a *Authorization) authenticateAPIToken(credential string) (*Principal, error) {
+	token, ok := a.store.GetAPIToken(models.HashAPIToken(credential))
+	if !ok || token.IsExpired(commontimeutil.Now()) {
+		return nil, ErrUnauthorized
+	}
+	user, ok := a.store.GetUser(token.User)
+	if !ok {
+		return nil, ErrUnauthorized
+	}
+	return a.newPrincipal(user), nil
+}
+
+// authenticateJWT authenticates user by jwt token which signed by the secret key of admin user.
+func (a *Authorization) authenticateJWT(credential string) (*Principal, error) {
+	claims := &CustomClaims{}
+	token, err := jwt.ParseWithClaims(credential, claims, func(_ *jwt.Token) (interface{}, error) {
+		return []byte(Md5Encrypt(a.cfg.Admin)), nil
+	})
+	if err != nil || !token.Valid {
+		return nil, ErrUnauthorized
+	}
+	if claims.UserName == a.cfg.Admin.UserName {
+		if claims.Password != a.cfg.Admin.Password {
+			return nil, ErrUnauthorized
+		}
+		return &Principal{UserName: claims.UserName, SuperUser: true}, nil
+	}
+	user, ok := a.store.GetUser(claims.UserName)
+	if !ok {
+		return nil, ErrUnauthorized
+	}
+	return a.newPrincipal(user), nil
+}
+
+// newPrincipal creates the principal with roles of user.
+func (a *Authorization) newPrincipal(user *models.User) *Principal {
+	principal := &Principal{UserName: user.Name}
+	for _, roleName := range user.Roles {
+		if role, ok := a.store.GetRole(roleName); ok {
+			principal.Roles = append(principal.Roles, role)
+		}
+	}
+	return principal
+}
@@ -0,0 +1,186 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/models"
+)
+
+func newAuthRouter(auth *Authorization) *gin.Engine {
+	r := gin.New()
+	r.Use(auth.Authenticate())
+	r.GET("/read", auth.RequirePermission("db", models.ReadPermission), func(c *gin.Context) {
+		c.String(http.StatusOK, "ok")
+	})
+	r.GET("/admin", auth.RequirePermission(models.AllDatabases, models.AdminPermission), func(c *gin.Context) {
+		c.String(http.StatusOK, "ok")
+	})
+	r.GET("/write", auth.RequirePermissionFunc(func(c *gin.Context) (string, models.Permission) {
+		return c.Query("db"), models.WritePermission
+	}), func(c *gin.Context) {
+		c.String(http.StatusOK, "ok")
+	})
+	return r
+}
+
+func doAuthRequest(r *gin.Engine, path string, header func(req *http.Request)) int {
+	req := httptest.NewRequest(http.MethodGet, path, http.NoBody)
+	if header != nil {
+		header(req)
+	}
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+	return resp.Code
+}
+
+func TestAuthorization_Disabled(t *testing.T) {
+	r := newAuthRouter(NewAuthorization(config.Auth{}, nil))
+	assert.Equal(t, http.StatusOK, doAuthRequest(r, "/read", nil))
+	assert.Equal(t, http.StatusOK, doAuthRequest(r, "/admin", nil))
+}
+
+func TestAuthorization_Authenticate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	admin := config.User{UserName: "admin", Password: "admin123"}
+	store := NewMockAuthStore(ctrl)
+	r := newAuthRouter(NewAuthorization(config.Auth{Enabled: true, Admin: admin}, store))
+
+	user := &models.User{Name: "bob", Password: "pwd", Roles: []string{"reader", "not_exist"}}
+	assert.NoError(t, user.HashPassword())
+	role := &models.Role{Name: "reader", Privileges: []models.Privilege{
+		{Database: "db", Permissions: []models.Permission{models.ReadPermission}},
+	}}
+	apiToken, err := models.GenerateAPIToken()
+	assert.NoError(t, err)
+	store.EXPECT().GetUser("bob").Return(user, true).AnyTimes()
+	store.EXPECT().GetUser(gomock.Any()).Return(nil, false).AnyTimes()
+	store.EXPECT().GetRole("reader").Return(role, true).AnyTimes()
+	store.EXPECT().GetRole(gomock.Any()).Return(nil, false).AnyTimes()
+	store.EXPECT().GetAPIToken(models.HashAPIToken(apiToken)).
+		Return(&models.APIToken{User: "bob"}, true).AnyTimes()
+	store.EXPECT().GetAPIToken(gomock.Any()).Return(nil, false).AnyTimes()
+
+	// no credential
+	assert.Equal(t, http.StatusUnauthorized, doAuthRequest(r, "/read", nil))
+	// basic auth
+	assert.Equal(t, http.StatusOK, doAuthRequest(r, "/admin", func(req *http.Request) {
+		req.SetBasicAuth("admin", "admin123")
+	}))
+	assert.Equal(t, http.StatusUnauthorized, doAuthRequest(r, "/admin", func(req *http.Request) {
+		req.SetBasicAuth("admin", "wrong")
+	}))
+	assert.Equal(t, http.StatusUnauthorized, doAuthRequest(r, "/read", func(req *http.Request) {
+		req.SetBasicAuth("alice", "pwd")
+	}))
+	for i := 0; i < 2; i++ {
+		// second request uses verified cache
+		assert.Equal(t, http.StatusOK, doAuthRequest(r, "/read", func(req *http.Request) {
+			req.SetBasicAuth("bob", "pwd")
+		}))
+	}
+	assert.Equal(t, http.StatusUnauthorized, doAuthRequest(r, "/read", func(req *http.Request) {
+		req.SetBasicAuth("bob", "wrong")
+	}))
+	assert.Equal(t, http.StatusForbidden, doAuthRequest(r, "/admin", func(req *http.Request) {
+		req.SetBasicAuth("bob", "pwd")
+	}))
+	assert.Equal(t, http.StatusForbidden, doAuthRequest(r, "/write?db=db", func(req *http.Request) {
+		req.SetBasicAuth("bob", "pwd")
+	}))
+	assert.Equal(t, http.StatusOK, doAuthRequest(r, "/write?db=db", func(req *http.Request) {
+		req.SetBasicAuth("admin", "admin123")
+	}))
+	// api token
+	assert.Equal(t, http.StatusOK, doAuthRequest(r, "/read", func(req *http.Request) {
+		req.Header.Set("Authorization", "Bearer "+apiToken)
+	}))
+	assert.Equal(t, http.StatusUnauthorized, doAuthRequest(r, "/read", func(req *http.Request) {
+		req.Header.Set("Authorization", "Bearer lin_not_exist")
+	}))
+	// jwt token of admin
+	assert.Equal(t, http.StatusOK, doAuthRequest(r, "/admin", func(req *http.Request) {
+		req.Header.Set("Authorization", tokenStr)
+	}))
+	// jwt token of user
+	token, err := CreateUserToken("bob", admin)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, doAuthRequest(r, "/read", func(req *http.Request) {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}))
+	// user name same as admin, but without password
+	token, err = CreateUserToken("admin", admin)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, doAuthRequest(r, "/read", func(req *http.Request) {
+		req.Header.Set("Authorization", token)
+	}))
+	// user not exist
+	token, err = CreateUserToken("alice", admin)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, doAuthRequest(r, "/read", func(req *http.Request) {
+		req.Header.Set("Authorization", token)
+	}))
+	// invalid signature
+	token, err = CreateUserToken("bob", config.User{UserName: "admin", Password: "other"})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, doAuthRequest(r, "/read", func(req *http.Request) {
+		req.Header.Set("Authorization", token)
+	}))
+}
+
+func TestAuthorization_APITokenExpired(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := NewMockAuthStore(ctrl)
+	r := newAuthRouter(NewAuthorization(config.Auth{Enabled: true}, store))
+	store.EXPECT().GetAPIToken(gomock.Any()).Return(&models.APIToken{User: "bob", ExpiresAt: 1}, true)
+	assert.Equal(t, http.StatusUnauthorized, doAuthRequest(r, "/read", func(req *http.Request) {
+		req.Header.Set("Authorization", "Bearer lin_abc")
+	}))
+	// token's user not exist
+	store.EXPECT().GetAPIToken(gomock.Any()).Return(&models.APIToken{User: "bob"}, true)
+	store.EXPECT().GetUser(gomock.Any()).Return(nil, false)
+	assert.Equal(t, http.StatusUnauthorized, doAuthRequest(r, "/read", func(req *http.Request) {
+		req.Header.Set("Authorization", "Bearer lin_abc")
+	}))
+}
+
+func TestGetPrincipal(t *testing.T) {
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	_, ok := GetPrincipal(c)
+	assert.False(t, ok)
+	assert.NoError(t, CheckPermission(c, "db", models.AdminPermission))
+	c.Set(principalKey, "principal")
+	_, ok = GetPrincipal(c)
+	assert.False(t, ok)
+	c.Set(principalKey, &Principal{UserName: "bob"})
+	assert.ErrorIs(t, CheckPermission(c, "db", models.ReadPermission), ErrPermissionDenied)
+	c.Set(principalKey, &Principal{UserName: "admin", SuperUser: true})
+	assert.NoError(t, CheckPermission(c, "db", models.AdminPermission))
+}
@@ -24,6 +24,7 @@ import (
 	"strings"
 
 	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/pkg/compress"
 	"github.com/lindb/lindb/pkg/timeutil"
 )
 
@@ -56,14 +57,31 @@ func (m Intervals) IsValid() error {
 				exist.String(), intervalType.String(), i.String(), intervalType.String())
 		}
 		intervalMap[intervalType] = i
+		if i.LocalRetention < 0 {
+			return fmt.Errorf("local retention cannot be negative,%s", i.String())
+		}
+		if i.LocalRetention > 0 && i.LocalRetention >= i.Retention {
+			return fmt.Errorf("local retention must be less than retention,%s(local:%s)",
+				i.String(), i.LocalRetention.String())
+		}
+		if i.Compression != nil {
+			if err := i.Compression.Validate(); err != nil {
+				return fmt.Errorf("invalid compression of interval,%s: %s", i.String(), err)
+			}
+		}
 	}
 	return nil
 }
 
 // Interval represents the database's interval option, include interval and data retention.
+// If local retention is set, data older than it is offloaded from local tier into remote tier,
+// which keeps data until retention.
+// If compression is set, it overrides the block compression of database for this interval.
 type Interval struct {
-	Interval  timeutil.Interval `toml:"interval" json:"interval,omitempty" validate:"required"`
-	Retention timeutil.Interval `toml:"retention" json:"retention,omitempty" validate:"required"`
+	Interval       timeutil.Interval     `toml:"interval" json:"interval,omitempty" validate:"required"`
+	Retention      timeutil.Interval     `toml:"retention" json:"retention,omitempty" validate:"required"`
+	LocalRetention timeutil.Interval     `toml:"localRetention,omitempty" json:"localRetention,omitempty"`
+	Compression    *compress.BlockOption `toml:"compression,omitempty" json:"compression,omitempty"`
 }
 
 // String returns the string representation of the Interval.
@@ -85,11 +103,16 @@ type DatabaseOption struct {
 	Intervals Intervals     `toml:"intervals" json:"intervals,omitempty"  validate:"required"`
 	Index     FlusherOption `toml:"index" json:"index,omitempty"`
 	Data      FlusherOption `toml:"data" json:"data,omitempty"`
+	// Compression is the block compression of data files, applied on flush/compaction/rollup
+	Compression compress.BlockOption `toml:"compression" json:"compression,omitempty"`
 
 	ahead  int64
 	behind int64
 
 	AutoCreateNS bool `toml:"autoCreateNS" json:"autoCreateNS,omitempty"`
+	// NativeHistogram writes histogram buckets as one native histogram value(opt-in),
+	// default writes legacy __bucket_${boundary} fields.
+	NativeHistogram bool `toml:"nativeHistogram" json:"nativeHistogram,omitempty"`
 }
 
 // FindMatchSmallestInterval returns the smallest interval which match query interval.
@@ -123,6 +146,9 @@ func (e *DatabaseOption) Validate() error {
 	if err := e.Intervals.IsValid(); err != nil {
 		return err
 	}
+	if err := e.Compression.Validate(); err != nil {
+		return err
+	}
 	// TODO: need remove
 	if err := validateInterval(e.Ahead, false); err != nil {
 		return err
@@ -133,6 +159,17 @@ func (e *DatabaseOption) Validate() error {
 	return nil
 }
 
+// GetCompression returns the block compression option of interval,
+// interval's compression overrides database's compression if set.
+func (e *DatabaseOption) GetCompression(interval timeutil.Interval) compress.BlockOption {
+	for _, i := range e.Intervals {
+		if i.Interval == interval && i.Compression != nil {
+			return *i.Compression
+		}
+	}
+	return e.Compression
+}
+
 // GetAcceptWritableRange returns accept writable time range.
 func (e *DatabaseOption) GetAcceptWritableRange() (ahead, behind int64) {
 	if e.ahead <= 0 {
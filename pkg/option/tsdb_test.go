@@ -18,6 +18,7 @@
 package option
 
 import (
+	"encoding/json"
 	"sort"
 	"testing"
 
@@ -25,6 +26,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/pkg/compress"
 	"github.com/lindb/lindb/pkg/timeutil"
 )
 
@@ -57,11 +59,16 @@ func TestDatabaseOption_Validate(t *testing.T) {
 		{
 			"validation pass",
 			DatabaseOption{Intervals: Intervals{
-				{timeutil.Interval(commontimeutil.OneSecond), timeutil.Interval(commontimeutil.OneMonth)},
-				{timeutil.Interval(commontimeutil.OneMinute), timeutil.Interval(commontimeutil.OneMonth)},
+				{Interval: timeutil.Interval(commontimeutil.OneSecond), Retention: timeutil.Interval(commontimeutil.OneMonth)},
+				{Interval: timeutil.Interval(commontimeutil.OneMinute), Retention: timeutil.Interval(commontimeutil.OneMonth)},
 			}, Behind: "1h", Ahead: "1h"},
 			true,
 		},
+		{
+			"compression invalid",
+			DatabaseOption{Intervals: Intervals{{}}, Compression: compress.BlockOption{Type: "gzip"}},
+			true,
+		},
 		{
 			"validation pass",
 			DatabaseOption{Intervals: Intervals{{}}, Behind: "1h", Ahead: "1h"},
@@ -141,15 +148,15 @@ func TestInterval_String(t *testing.T) {
 
 func TestIntervals_Sort(t *testing.T) {
 	intervals := Intervals{
-		{timeutil.Interval(commontimeutil.OneMinute), timeutil.Interval(commontimeutil.OneMonth)},
-		{timeutil.Interval(commontimeutil.OneHour), timeutil.Interval(commontimeutil.OneMonth)},
-		{timeutil.Interval(commontimeutil.OneSecond), timeutil.Interval(commontimeutil.OneMonth)},
+		{Interval: timeutil.Interval(commontimeutil.OneMinute), Retention: timeutil.Interval(commontimeutil.OneMonth)},
+		{Interval: timeutil.Interval(commontimeutil.OneHour), Retention: timeutil.Interval(commontimeutil.OneMonth)},
+		{Interval: timeutil.Interval(commontimeutil.OneSecond), Retention: timeutil.Interval(commontimeutil.OneMonth)},
 	}
 	sort.Sort(intervals)
 	assert.Equal(t, Intervals{
-		{timeutil.Interval(commontimeutil.OneSecond), timeutil.Interval(commontimeutil.OneMonth)},
-		{timeutil.Interval(commontimeutil.OneMinute), timeutil.Interval(commontimeutil.OneMonth)},
-		{timeutil.Interval(commontimeutil.OneHour), timeutil.Interval(commontimeutil.OneMonth)},
+		{Interval: timeutil.Interval(commontimeutil.OneSecond), Retention: timeutil.Interval(commontimeutil.OneMonth)},
+		{Interval: timeutil.Interval(commontimeutil.OneMinute), Retention: timeutil.Interval(commontimeutil.OneMonth)},
+		{Interval: timeutil.Interval(commontimeutil.OneHour), Retention: timeutil.Interval(commontimeutil.OneMonth)},
 	}, intervals)
 
 	assert.Equal(t, "[1s->1M,1m->1M,1h->1M]", intervals.String())
@@ -157,24 +164,62 @@ func TestIntervals_Sort(t *testing.T) {
 
 func TestIntervals_IsValid(t *testing.T) {
 	intervals := Intervals{
-		{timeutil.Interval(commontimeutil.OneSecond), timeutil.Interval(commontimeutil.OneMonth)},
-		{timeutil.Interval(commontimeutil.OneMinute), timeutil.Interval(commontimeutil.OneMonth)},
-		{timeutil.Interval(commontimeutil.OneHour), timeutil.Interval(commontimeutil.OneMonth)},
+		{Interval: timeutil.Interval(commontimeutil.OneSecond), Retention: timeutil.Interval(commontimeutil.OneMonth)},
+		{Interval: timeutil.Interval(commontimeutil.OneMinute), Retention: timeutil.Interval(commontimeutil.OneMonth)},
+		{Interval: timeutil.Interval(commontimeutil.OneHour), Retention: timeutil.Interval(commontimeutil.OneMonth)},
 	}
 	assert.Error(t, intervals.IsValid())
 	intervals = Intervals{
-		{timeutil.Interval(commontimeutil.OneSecond), timeutil.Interval(commontimeutil.OneMonth)},
-		{timeutil.Interval(commontimeutil.OneMinute * 5), timeutil.Interval(commontimeutil.OneMonth)},
-		{timeutil.Interval(commontimeutil.OneHour), timeutil.Interval(commontimeutil.OneMonth)},
+		{Interval: timeutil.Interval(commontimeutil.OneSecond), Retention: timeutil.Interval(commontimeutil.OneMonth)},
+		{Interval: timeutil.Interval(commontimeutil.OneMinute * 5), Retention: timeutil.Interval(commontimeutil.OneMonth)},
+		{Interval: timeutil.Interval(commontimeutil.OneHour), Retention: timeutil.Interval(commontimeutil.OneMonth)},
 	}
 	assert.NoError(t, intervals.IsValid())
+	intervals = Intervals{{
+		Interval:       timeutil.Interval(commontimeutil.OneSecond),
+		Retention:      timeutil.Interval(commontimeutil.OneMonth),
+		LocalRetention: timeutil.Interval(commontimeutil.OneDay * 7),
+	}}
+	assert.NoError(t, intervals.IsValid())
+	intervals[0].LocalRetention = timeutil.Interval(commontimeutil.OneMonth)
+	assert.Error(t, intervals.IsValid())
+	intervals[0].LocalRetention = -1
+	assert.Error(t, intervals.IsValid())
+}
+
+func TestInterval_LocalRetention_JSON(t *testing.T) {
+	var opt DatabaseOption
+	assert.NoError(t, json.Unmarshal([]byte(`{"intervals":[{"interval":"10s","retention":"30d","localRetention":"7d"}]}`), &opt))
+	assert.Equal(t, timeutil.Interval(commontimeutil.OneDay*7), opt.Intervals[0].LocalRetention)
+	assert.NoError(t, opt.Validate())
+	data, err := json.Marshal(&Interval{
+		Interval:  timeutil.Interval(commontimeutil.OneSecond * 10),
+		Retention: timeutil.Interval(commontimeutil.OneDay * 30),
+	})
+	assert.NoError(t, err)
+	assert.NotContains(t, string(data), "localRetention")
+}
+
+func TestDatabaseOption_GetCompression(t *testing.T) {
+	var opt DatabaseOption
+	assert.NoError(t, json.Unmarshal([]byte(`{"intervals":[{"interval":"10s","retention":"30d"},`+
+		`{"interval":"5m","retention":"3M","compression":{"type":"zstd","level":19}}],`+
+		`"compression":{"type":"lz4"}}`), &opt))
+	assert.NoError(t, opt.Validate())
+	assert.Equal(t, compress.BlockOption{Type: "lz4"}, opt.GetCompression(timeutil.Interval(commontimeutil.OneSecond*10)))
+	assert.Equal(t, compress.BlockOption{Type: "zstd", Level: 19}, opt.GetCompression(timeutil.Interval(commontimeutil.OneMinute*5)))
+	assert.Equal(t, compress.BlockOption{Type: "lz4"}, opt.GetCompression(timeutil.Interval(commontimeutil.OneHour)))
+
+	// invalid compression of interval
+	opt.Intervals[1].Compression.Level = 100
+	assert.Error(t, opt.Validate())
 }
 
 func TestDatabaseOption_FindMatchSmallestInterval(t *testing.T) {
 	opt := DatabaseOption{Intervals: Intervals{
-		{timeutil.Interval(commontimeutil.OneSecond), timeutil.Interval(commontimeutil.OneMonth)},
-		{timeutil.Interval(commontimeutil.OneMinute), timeutil.Interval(commontimeutil.OneMonth)},
-		{timeutil.Interval(commontimeutil.OneHour), timeutil.Interval(commontimeutil.OneMonth)},
+		{Interval: timeutil.Interval(commontimeutil.OneSecond), Retention: timeutil.Interval(commontimeutil.OneMonth)},
+		{Interval: timeutil.Interval(commontimeutil.OneMinute), Retention: timeutil.Interval(commontimeutil.OneMonth)},
+		{Interval: timeutil.Interval(commontimeutil.OneHour), Retention: timeutil.Interval(commontimeutil.OneMonth)},
 	}}
 	interval := opt.FindMatchSmallestInterval(timeutil.Interval(commontimeutil.OneMinute * 3))
 	assert.Equal(t, timeutil.Interval(commontimeutil.OneMinute), interval)
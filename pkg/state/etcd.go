@@ -35,6 +35,7 @@ import (
 
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/pkg/tlsutil"
 )
 
 // etcdRepository is repository based on etcd storage
@@ -47,6 +48,10 @@ type etcdRepository struct {
 
 // newEtcdRepository creates a new repository based on etcd storage
 func newEtcdRepository(repoState *config.RepoState, owner string) (Repository, error) {
+	tlsCfg, err := tlsutil.NewClientConfig(&repoState.TLS)
+	if err != nil {
+		return nil, fmt.Errorf("create etcd client tls config error:%s", err)
+	}
 	zapCfg := zap.NewProductionConfig()
 	zapCfg.Level = zap.NewAtomicLevelAt(zapcore.ErrorLevel)
 	cfg := etcdcliv3.Config{
@@ -57,6 +62,7 @@ func newEtcdRepository(repoState *config.RepoState, owner string) (Repository, e
 		DialOptions:          []grpc.DialOption{grpc.WithBlock()},
 		Username:             repoState.Username,
 		Password:             repoState.Password,
+		TLS:                  tlsCfg,
 		LogConfig:            &zapCfg,
 	}
 	cli, err := etcdcliv3.New(cfg)
@@ -27,6 +27,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/lindb/common/pkg/encoding"
+	"github.com/lindb/common/pkg/ltoml"
 
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/internal/mock"
@@ -73,6 +74,50 @@ func Test_Write_Read(t *testing.T) {
 	_ = rep.Close()
 }
 
+func TestEtcdRepository_TLS(t *testing.T) {
+	certs := mock.GenerateTLSCertificates(t)
+	cluster := mock.StartEtcdClusterWithTLS(t, "https://localhost:8790", certs)
+	defer cluster.Terminate(t)
+
+	// invalid tls config
+	rep, err := newEtcdRepository(&config.RepoState{
+		Endpoints: cluster.Endpoints,
+		Namespace: "/tls",
+		TLS:       config.TLS{Enabled: true, CAFile: "not_exist"},
+	}, "nobody")
+	assert.Error(t, err)
+	assert.Nil(t, rep)
+
+	// mutual tls
+	rep, err = newEtcdRepository(&config.RepoState{
+		Endpoints:   cluster.Endpoints,
+		Namespace:   "/tls",
+		DialTimeout: ltoml.Duration(time.Second * 5),
+		Timeout:     ltoml.Duration(time.Second * 5),
+		TLS: config.TLS{
+			Enabled:  true,
+			CertFile: certs.ClientCertFile,
+			KeyFile:  certs.ClientKeyFile,
+			CAFile:   certs.CAFile,
+		},
+	}, "nobody")
+	assert.NoError(t, err)
+	assert.NoError(t, rep.Put(context.TODO(), "/test/key1", []byte("value")))
+	val, err := rep.Get(context.TODO(), "/test/key1")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("value"), val)
+	_ = rep.Close()
+
+	// without client certificate
+	_, err = newEtcdRepository(&config.RepoState{
+		Endpoints:   cluster.Endpoints,
+		Namespace:   "/tls",
+		DialTimeout: ltoml.Duration(time.Second),
+		TLS:         config.TLS{Enabled: true, CAFile: certs.CAFile},
+	}, "nobody")
+	assert.Error(t, err)
+}
+
 func TestList(t *testing.T) {
 	cluster := mock.StartEtcdCluster(t, "http://localhost:8701")
 	defer cluster.Terminate(t)
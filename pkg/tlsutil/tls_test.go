@@ -0,0 +1,137 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tlsutil
+
+import (
+	"crypto/tls"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/internal/mock"
+)
+
+func TestNewServerConfig(t *testing.T) {
+	certs := mock.GenerateTLSCertificates(t)
+	// tls disabled
+	cfg, err := NewServerConfig(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, cfg)
+	cfg, err = NewServerConfig(&config.TLS{})
+	assert.NoError(t, err)
+	assert.Nil(t, cfg)
+	// cert not exist
+	_, err = NewServerConfig(&config.TLS{Enabled: true, CertFile: "not_exist", KeyFile: "not_exist"})
+	assert.Error(t, err)
+	// ca not exist
+	_, err = NewServerConfig(&config.TLS{Enabled: true,
+		CertFile: certs.ServerCertFile, KeyFile: certs.ServerKeyFile, CAFile: "not_exist"})
+	assert.Error(t, err)
+	// tls
+	cfg, err = NewServerConfig(&config.TLS{Enabled: true, CertFile: certs.ServerCertFile, KeyFile: certs.ServerKeyFile})
+	assert.NoError(t, err)
+	assert.Len(t, cfg.Certificates, 1)
+	assert.Equal(t, tls.NoClientCert, cfg.ClientAuth)
+	// verify client cert if given
+	cfg, err = NewServerConfig(&config.TLS{Enabled: true,
+		CertFile: certs.ServerCertFile, KeyFile: certs.ServerKeyFile, CAFile: certs.CAFile})
+	assert.NoError(t, err)
+	assert.NotNil(t, cfg.ClientCAs)
+	assert.Equal(t, tls.VerifyClientCertIfGiven, cfg.ClientAuth)
+	// mutual tls
+	cfg, err = NewServerConfig(&config.TLS{Enabled: true,
+		CertFile: certs.ServerCertFile, KeyFile: certs.ServerKeyFile, CAFile: certs.CAFile, ClientAuth: true})
+	assert.NoError(t, err)
+	assert.Equal(t, tls.RequireAndVerifyClientCert, cfg.ClientAuth)
+}
+
+func TestNewClientConfig(t *testing.T) {
+	certs := mock.GenerateTLSCertificates(t)
+	// tls disabled
+	cfg, err := NewClientConfig(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, cfg)
+	// cert not exist
+	_, err = NewClientConfig(&config.TLS{Enabled: true, CertFile: "not_exist", KeyFile: "not_exist"})
+	assert.Error(t, err)
+	// ca not exist
+	_, err = NewClientConfig(&config.TLS{Enabled: true, CAFile: "not_exist"})
+	assert.Error(t, err)
+	// invalid ca
+	invalidCA := filepath.Join(t.TempDir(), "ca.pem")
+	assert.NoError(t, os.WriteFile(invalidCA, []byte("invalid"), 0o600))
+	_, err = NewClientConfig(&config.TLS{Enabled: true, CAFile: invalidCA})
+	assert.Error(t, err)
+	// tls
+	cfg, err = NewClientConfig(&config.TLS{Enabled: true, CAFile: certs.CAFile, ServerName: "localhost"})
+	assert.NoError(t, err)
+	assert.NotNil(t, cfg.RootCAs)
+	assert.Empty(t, cfg.Certificates)
+	assert.Equal(t, "localhost", cfg.ServerName)
+	// mutual tls
+	cfg, err = NewClientConfig(&config.TLS{Enabled: true,
+		CertFile: certs.ClientCertFile, KeyFile: certs.ClientKeyFile, CAFile: certs.CAFile})
+	assert.NoError(t, err)
+	assert.Len(t, cfg.Certificates, 1)
+}
+
+func TestMutualTLS_Handshake(t *testing.T) {
+	certs := mock.GenerateTLSCertificates(t)
+	serverCfg, err := NewServerConfig(&config.TLS{Enabled: true,
+		CertFile: certs.ServerCertFile, KeyFile: certs.ServerKeyFile, CAFile: certs.CAFile, ClientAuth: true})
+	assert.NoError(t, err)
+	lis, err := tls.Listen("tcp", "127.0.0.1:0", serverCfg)
+	assert.NoError(t, err)
+	defer lis.Close()
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				return
+			}
+			if err := conn.(*tls.Conn).Handshake(); err == nil {
+				_, _ = conn.Write([]byte{1})
+			}
+			_ = conn.Close()
+		}
+	}()
+
+	handshake := func(cfg *config.TLS) error {
+		clientCfg, err := NewClientConfig(cfg)
+		assert.NoError(t, err)
+		conn, err := tls.Dial("tcp", lis.Addr().String(), clientCfg)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+		// tls1.3 reports client certificate error on first read
+		_, err = conn.Read(make([]byte, 1))
+		return err
+	}
+	// without client certificate
+	assert.Error(t, handshake(&config.TLS{Enabled: true, CAFile: certs.CAFile}))
+	// with client certificate
+	assert.NoError(t, handshake(&config.TLS{Enabled: true,
+		CertFile: certs.ClientCertFile, KeyFile: certs.ClientKeyFile, CAFile: certs.CAFile}))
+	// unknown server ca
+	assert.Error(t, handshake(&config.TLS{Enabled: true,
+		CertFile: certs.ClientCertFile, KeyFile: certs.ClientKeyFile}))
+}
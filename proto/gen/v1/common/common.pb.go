@@ -48,16 +48,22 @@ type RequestType int32
 const (
 	RequestType_Data     RequestType = 0
 	RequestType_Metadata RequestType = 1
+	RequestType_Delete   RequestType = 2
+	RequestType_Drop     RequestType = 3
 )
 
 var RequestType_name = map[int32]string{
 	0: "Data",
 	1: "Metadata",
+	2: "Delete",
+	3: "Drop",
 }
 
 var RequestType_value = map[string]int32{
 	"Data":     0,
 	"Metadata": 1,
+	"Delete":   2,
+	"Drop":     3,
 }
 
 func (x RequestType) String() string {
@@ -444,41 +450,42 @@ func init() {
 func init() { proto.RegisterFile("common.proto", fileDescriptor_555bd8c177793206) }
 
 var fileDescriptor_555bd8c177793206 = []byte{
-	// 537 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcd, 0x8e, 0xd3, 0x30,
-	0x10, 0xae, 0x9b, 0xb6, 0xdb, 0x4e, 0xd3, 0xaa, 0xb2, 0x10, 0x0a, 0x65, 0xa9, 0xaa, 0x48, 0x2b,
-	0x45, 0x1c, 0x2a, 0x28, 0x17, 0x40, 0x70, 0x28, 0xbb, 0xfc, 0x49, 0x2c, 0x42, 0x6e, 0xb5, 0x77,
-	0x93, 0xcc, 0x86, 0x68, 0xd3, 0x24, 0xd8, 0x6e, 0xa5, 0xbe, 0x09, 0xe2, 0x05, 0x78, 0x15, 0x8e,
-	0x3c, 0x00, 0x07, 0x54, 0xae, 0x3c, 0x04, 0xb2, 0x93, 0x6d, 0x9a, 0x0a, 0x0e, 0x7b, 0xca, 0x7c,
-	0x9f, 0x67, 0xc6, 0xdf, 0x4c, 0x3e, 0x83, 0xed, 0xa7, 0xcb, 0x65, 0x9a, 0x4c, 0x32, 0x91, 0xaa,
-	0x94, 0xf6, 0xcc, 0xe7, 0xd4, 0x50, 0x17, 0x0f, 0xdd, 0x6f, 0x04, 0xba, 0x0b, 0x2e, 0xaf, 0x18,
-	0x7e, 0x5e, 0xa1, 0x54, 0xf4, 0x18, 0x3a, 0x22, 0x0f, 0xdf, 0x9e, 0x39, 0x64, 0x4c, 0xbc, 0x0e,
-	0x2b, 0x09, 0xfa, 0x0c, 0xba, 0x05, 0x58, 0x6c, 0x32, 0x74, 0xac, 0x31, 0xf1, 0xfa, 0xd3, 0xe1,
-	0xa4, 0xd2, 0x72, 0xc2, 0xca, 0x0c, 0xb6, 0x9f, 0x4e, 0x5d, 0xb0, 0xb3, 0x4f, 0x1b, 0x19, 0xf9,
-	0x3c, 0xfe, 0x10, 0xf3, 0xc4, 0x69, 0x8c, 0x89, 0x67, 0xb3, 0x0a, 0x47, 0x1d, 0x38, 0xca, 0xf8,
-	0x26, 0x4e, 0x79, 0xe0, 0x34, 0xcd, 0xf1, 0x35, 0x74, 0xff, 0x10, 0xb0, 0x73, 0xa5, 0x32, 0x4b,
-	0x13, 0x89, 0x37, 0x93, 0x5a, 0xbf, 0x99, 0xd4, 0x63, 0xe8, 0xf8, 0xe9, 0x32, 0x8b, 0x51, 0x61,
-	0x60, 0xc6, 0x6c, 0xb3, 0x92, 0xa0, 0xb7, 0xa1, 0x85, 0x42, 0x9c, 0xcb, 0xd0, 0x8c, 0xd0, 0x61,
-	0x05, 0xa2, 0x43, 0x68, 0x4b, 0x4c, 0x82, 0x45, 0xb4, 0x44, 0xa3, 0xde, 0x62, 0x3b, 0xbc, 0x3f,
-	0x58, 0xab, 0x32, 0x18, 0xbd, 0x05, 0x4d, 0xa9, 0xb8, 0x92, 0xce, 0x91, 0xe1, 0x73, 0xe0, 0xfe,
-	0x24, 0xd0, 0xd7, 0x85, 0x73, 0x14, 0x11, 0xca, 0x77, 0x91, 0x54, 0x45, 0xa2, 0x50, 0x66, 0x58,
-	0x8b, 0xe5, 0x80, 0x0e, 0xc0, 0xc2, 0x24, 0x30, 0x03, 0x5a, 0x4c, 0x87, 0x5a, 0x46, 0x94, 0x28,
-	0x14, 0x6b, 0x1e, 0x1b, 0xed, 0x16, 0xdb, 0x61, 0x3a, 0x83, 0xbe, 0xaa, 0x74, 0x75, 0x1a, 0x63,
-	0xcb, 0xeb, 0x4e, 0xef, 0x1c, 0x6c, 0xa6, 0xbc, 0x9a, 0x1d, 0x14, 0xd0, 0x53, 0xe8, 0x5d, 0x46,
-	0x18, 0x07, 0xb3, 0x30, 0x9c, 0x67, 0xe8, 0x4b, 0xa7, 0x69, 0x3a, 0xdc, 0x3b, 0xe8, 0x30, 0x0b,
-	0x43, 0x81, 0x21, 0x57, 0xa9, 0xd0, 0x59, 0xac, 0x5a, 0xe3, 0x7e, 0x25, 0x00, 0xe5, 0x1d, 0x94,
-	0x42, 0x43, 0xf1, 0x50, 0x16, 0xbf, 0xd1, 0xc4, 0xf4, 0x39, 0xb4, 0x4c, 0x8d, 0x74, 0xea, 0xe6,
-	0x82, 0x93, 0xff, 0x4a, 0x9c, 0xbc, 0x32, 0x79, 0x2f, 0x13, 0x25, 0x36, 0xac, 0x28, 0x1a, 0x3e,
-	0x81, 0xee, 0x1e, 0xad, 0xd7, 0x74, 0x85, 0x9b, 0xe2, 0x02, 0x1d, 0xea, 0x75, 0xae, 0x79, 0xbc,
-	0xca, 0xbd, 0x61, 0xb3, 0x1c, 0x3c, 0xad, 0x3f, 0x26, 0x6e, 0x06, 0xfd, 0xaa, 0x7a, 0xed, 0x07,
-	0xd3, 0xf6, 0x3d, 0x5f, 0xe2, 0xb5, 0xd7, 0x76, 0xc4, 0xee, 0x74, 0xe7, 0xb4, 0x1e, 0x2b, 0x09,
-	0x6d, 0xfb, 0xcb, 0x55, 0xe2, 0xeb, 0xd8, 0x2c, 0xdc, 0x1a, 0x5b, 0x5e, 0x8f, 0x55, 0xb8, 0xfb,
-	0x27, 0xd0, 0xdd, 0xf3, 0x22, 0x6d, 0x43, 0xe3, 0x8c, 0x2b, 0x3e, 0xa8, 0x51, 0x1b, 0xda, 0xe7,
-	0xa8, 0x78, 0xa0, 0x11, 0x99, 0x5e, 0xe4, 0x8f, 0x75, 0x8e, 0x62, 0x1d, 0xf9, 0x48, 0x5f, 0x43,
-	0xeb, 0x0d, 0x4f, 0x82, 0x18, 0xe9, 0xa1, 0xb1, 0xf7, 0x9e, 0xf4, 0xf0, 0xee, 0x3f, 0xcf, 0xf2,
-	0x47, 0xe4, 0xd6, 0x3c, 0xf2, 0x80, 0xbc, 0x18, 0x7c, 0xdf, 0x8e, 0xc8, 0x8f, 0xed, 0x88, 0xfc,
-	0xda, 0x8e, 0xc8, 0x97, 0xdf, 0xa3, 0xda, 0xc7, 0x96, 0xa9, 0x79, 0xf4, 0x37, 0x00, 0x00, 0xff,
-	0xff, 0x8d, 0x33, 0xad, 0xda, 0x3d, 0x04, 0x00, 0x00,
+	// 547 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xee, 0xc6, 0xa9, 0x9b, 0x4c, 0x9c, 0x28, 0x5a, 0x21, 0x64, 0x42, 0x89, 0x22, 0x4b, 0x48,
+	0x16, 0x87, 0x08, 0xca, 0x85, 0xdf, 0x43, 0x68, 0xf8, 0x93, 0x28, 0x42, 0x9b, 0xa8, 0xf7, 0xc5,
+	0x9e, 0x1a, 0xab, 0x8e, 0x6d, 0x76, 0x37, 0x91, 0xf2, 0x26, 0x88, 0x17, 0xe0, 0x55, 0x38, 0xf2,
+	0x00, 0x1c, 0x50, 0xb8, 0xf2, 0x10, 0x68, 0xd7, 0x6e, 0x1c, 0x47, 0x70, 0xe8, 0xc9, 0xf3, 0x7d,
+	0x3b, 0x33, 0xfb, 0xcd, 0xf8, 0x5b, 0x70, 0x82, 0x6c, 0xb1, 0xc8, 0xd2, 0x71, 0x2e, 0x32, 0x95,
+	0xd1, 0xae, 0xf9, 0x9c, 0x1a, 0xea, 0xfc, 0x81, 0xf7, 0x8d, 0x40, 0x67, 0xce, 0xe5, 0x25, 0xc3,
+	0xcf, 0x4b, 0x94, 0x8a, 0x1e, 0x43, 0x5b, 0x14, 0xe1, 0xdb, 0xa9, 0x4b, 0x46, 0xc4, 0x6f, 0xb3,
+	0x8a, 0xa0, 0xcf, 0xa0, 0x53, 0x82, 0xf9, 0x3a, 0x47, 0xd7, 0x1a, 0x11, 0xbf, 0x77, 0x32, 0x18,
+	0xd7, 0x5a, 0x8e, 0x59, 0x95, 0xc1, 0x76, 0xd3, 0xa9, 0x07, 0x4e, 0xfe, 0x69, 0x2d, 0xe3, 0x80,
+	0x27, 0x1f, 0x12, 0x9e, 0xba, 0xcd, 0x11, 0xf1, 0x1d, 0x56, 0xe3, 0xa8, 0x0b, 0x47, 0x39, 0x5f,
+	0x27, 0x19, 0x0f, 0xdd, 0x43, 0x73, 0x7c, 0x05, 0xbd, 0x3f, 0x04, 0x9c, 0x42, 0xa9, 0xcc, 0xb3,
+	0x54, 0xe2, 0xf5, 0xa4, 0x36, 0xae, 0x27, 0xf5, 0x18, 0xda, 0x41, 0xb6, 0xc8, 0x13, 0x54, 0x18,
+	0x9a, 0x31, 0x5b, 0xac, 0x22, 0xe8, 0x4d, 0xb0, 0x51, 0x88, 0x33, 0x19, 0x99, 0x11, 0xda, 0xac,
+	0x44, 0x74, 0x00, 0x2d, 0x89, 0x69, 0x38, 0x8f, 0x17, 0x68, 0xd4, 0x5b, 0x6c, 0x8b, 0x77, 0x07,
+	0xb3, 0x6b, 0x83, 0xd1, 0x1b, 0x70, 0x28, 0x15, 0x57, 0xd2, 0x3d, 0x32, 0x7c, 0x01, 0xbc, 0x9f,
+	0x04, 0x7a, 0xba, 0x70, 0x86, 0x22, 0x46, 0xf9, 0x2e, 0x96, 0xaa, 0x4c, 0x14, 0xca, 0x0c, 0x6b,
+	0xb1, 0x02, 0xd0, 0x3e, 0x58, 0x98, 0x86, 0x66, 0x40, 0x8b, 0xe9, 0x50, 0xcb, 0x88, 0x53, 0x85,
+	0x62, 0xc5, 0x13, 0xa3, 0xdd, 0x62, 0x5b, 0x4c, 0x27, 0xd0, 0x53, 0xb5, 0xae, 0x6e, 0x73, 0x64,
+	0xf9, 0x9d, 0x93, 0x5b, 0x7b, 0x9b, 0xa9, 0xae, 0x66, 0x7b, 0x05, 0xf4, 0x14, 0xba, 0x17, 0x31,
+	0x26, 0xe1, 0x24, 0x8a, 0x66, 0x39, 0x06, 0xd2, 0x3d, 0x34, 0x1d, 0xee, 0xec, 0x75, 0x98, 0x44,
+	0x91, 0xc0, 0x88, 0xab, 0x4c, 0xe8, 0x2c, 0x56, 0xaf, 0xf1, 0xbe, 0x12, 0x80, 0xea, 0x0e, 0x4a,
+	0xa1, 0xa9, 0x78, 0x24, 0xcb, 0xdf, 0x68, 0x62, 0xfa, 0x1c, 0x6c, 0x53, 0x23, 0xdd, 0x86, 0xb9,
+	0xe0, 0xee, 0x7f, 0x25, 0x8e, 0x5f, 0x99, 0xbc, 0x97, 0xa9, 0x12, 0x6b, 0x56, 0x16, 0x0d, 0x1e,
+	0x43, 0x67, 0x87, 0xd6, 0x6b, 0xba, 0xc4, 0x75, 0x79, 0x81, 0x0e, 0xf5, 0x3a, 0x57, 0x3c, 0x59,
+	0x16, 0xde, 0x70, 0x58, 0x01, 0x9e, 0x34, 0x1e, 0x11, 0x2f, 0x87, 0x5e, 0x5d, 0xbd, 0xf6, 0x83,
+	0x69, 0xfb, 0x9e, 0x2f, 0xf0, 0xca, 0x6b, 0x5b, 0x62, 0x7b, 0xba, 0x75, 0x5a, 0x97, 0x55, 0x84,
+	0xb6, 0xfd, 0xc5, 0x32, 0x0d, 0x74, 0x6c, 0x16, 0x6e, 0x8d, 0x2c, 0xbf, 0xcb, 0x6a, 0xdc, 0xbd,
+	0xa7, 0xd0, 0xd9, 0xf1, 0x22, 0x6d, 0x41, 0x73, 0xca, 0x15, 0xef, 0x1f, 0x50, 0x07, 0x5a, 0x67,
+	0xa8, 0x78, 0xa8, 0x11, 0xa1, 0x00, 0xf6, 0x14, 0xb5, 0x07, 0xfb, 0x0d, 0x93, 0x23, 0xb2, 0xbc,
+	0x6f, 0x9d, 0x9c, 0x17, 0x4f, 0x78, 0x86, 0x62, 0x15, 0x07, 0x48, 0x5f, 0x83, 0xfd, 0x86, 0xa7,
+	0x61, 0x82, 0x74, 0xdf, 0xee, 0x3b, 0x0f, 0x7d, 0x70, 0xfb, 0x9f, 0x67, 0xc5, 0xd3, 0xf2, 0x0e,
+	0x7c, 0x72, 0x9f, 0xbc, 0xe8, 0x7f, 0xdf, 0x0c, 0xc9, 0x8f, 0xcd, 0x90, 0xfc, 0xda, 0x0c, 0xc9,
+	0x97, 0xdf, 0xc3, 0x83, 0x8f, 0xb6, 0xa9, 0x79, 0xf8, 0x77, 0x00, 0x4e, 0x8f, 0x68, 0xda, 0x53,
+	0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -0,0 +1,168 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cache
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/lindb/lindb/series"
+	"github.com/lindb/lindb/series/field"
+)
+
+// errMarshalNotSupport represents cached result iterator cannot be marshaled.
+var errMarshalNotSupport = errors.New("cached result iterator not support marshal")
+
+// groupedIterator implements series.GroupedIterator for cached result.
+type groupedIterator struct {
+	tags       string
+	fields     map[field.Name]*fieldValues
+	fieldNames []field.Name
+	offset     int64
+	idx        int
+}
+
+// newGroupedIterator creates a grouped iterator, slot of value = pos + offset.
+func newGroupedIterator(tags string, fields map[field.Name]*fieldValues, offset int64) series.GroupedIterator {
+	it := &groupedIterator{
+		tags:   tags,
+		fields: fields,
+		offset: offset,
+	}
+	for fieldName := range fields {
+		it.fieldNames = append(it.fieldNames, fieldName)
+	}
+	sort.Slice(it.fieldNames, func(i, j int) bool {
+		return it.fieldNames[i] < it.fieldNames[j]
+	})
+	return it
+}
+
+// Tags returns group tags.
+func (it *groupedIterator) Tags() string {
+	return it.tags
+}
+
+// HasNext returns if the iteration has more field's iterator.
+func (it *groupedIterator) HasNext() bool {
+	return it.idx < len(it.fieldNames)
+}
+
+// Next returns the field's iterator.
+func (it *groupedIterator) Next() series.Iterator {
+	fieldName := it.fieldNames[it.idx]
+	it.idx++
+	return &seriesIterator{
+		fieldName: fieldName,
+		values:    it.fields[fieldName],
+		offset:    it.offset,
+	}
+}
+
+// seriesIterator implements series.Iterator for cached result.
+type seriesIterator struct {
+	fieldName field.Name
+	values    *fieldValues
+	offset    int64
+	consumed  bool
+}
+
+// FieldName returns the field name.
+func (it *seriesIterator) FieldName() field.Name {
+	return it.fieldName
+}
+
+// FieldType returns the field type.
+func (it *seriesIterator) FieldType() field.Type {
+	return it.values.fieldType
+}
+
+// HasNext returns if the iteration has more field's iterator.
+func (it *seriesIterator) HasNext() bool {
+	return !it.consumed
+}
+
+// Next returns the field's iterator, cached result only has one segment.
+func (it *seriesIterator) Next() (startTime int64, fieldIt series.FieldIterator) {
+	it.consumed = true
+	return 0, &fieldIterator{values: it.values, offset: it.offset}
+}
+
+// MarshalBinary marshals the data.
+func (it *seriesIterator) MarshalBinary() ([]byte, error) {
+	return nil, errMarshalNotSupport
+}
+
+// fieldIterator implements series.FieldIterator for cached result.
+type fieldIterator struct {
+	values *fieldValues
+	offset int64
+	idx    int
+}
+
+// HasNext returns if the iteration has more fields.
+func (it *fieldIterator) HasNext() bool {
+	return it.idx < len(it.values.aggTypes)
+}
+
+// Next returns the data point in the iteration.
+func (it *fieldIterator) Next() series.PrimitiveIterator {
+	pIt := &primitiveIterator{
+		aggType: it.values.aggTypes[it.idx],
+		values:  it.values.values[it.idx],
+		offset:  it.offset,
+		pos:     -1,
+	}
+	it.idx++
+	return pIt
+}
+
+// MarshalBinary marshals the data.
+func (it *fieldIterator) MarshalBinary() ([]byte, error) {
+	return nil, errMarshalNotSupport
+}
+
+// primitiveIterator implements series.PrimitiveIterator for cached result.
+type primitiveIterator struct {
+	aggType field.AggType
+	values  []float64
+	offset  int64
+	pos     int
+}
+
+// AggType returns the primitive field's agg type.
+func (it *primitiveIterator) AggType() field.AggType {
+	return it.aggType
+}
+
+// HasNext returns if the iteration has more data points.
+func (it *primitiveIterator) HasNext() bool {
+	for it.pos+1 < len(it.values) {
+		it.pos++
+		if !math.IsNaN(it.values[it.pos]) && int64(it.pos)+it.offset >= 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Next returns the data point in the iteration.
+func (it *primitiveIterator) Next() (timeSlot int, value float64) {
+	return int(int64(it.pos) + it.offset), it.values[it.pos]
+}
@@ -0,0 +1,371 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cache
+
+import (
+	"math"
+
+	"github.com/lindb/lindb/aggregation"
+	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/pkg/timeutil"
+	protoCommonV1 "github.com/lindb/lindb/proto/gen/v1/common"
+	"github.com/lindb/lindb/series"
+	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+// Result represents the aggregated result of metric query which is aligned by query interval,
+// the time range of result is [start, end), slot of value = (timestamp - start) / interval.
+type Result struct {
+	start, end int64
+	interval   int64
+	specs      map[string]*protoCommonV1.AggregatorSpec
+	series     map[string]map[field.Name]*fieldValues // tags => field name => field values
+}
+
+// fieldValues represents the values of field, one value list per agg type.
+type fieldValues struct {
+	fieldType field.Type
+	aggTypes  []field.AggType
+	values    [][]float64 // NaN means no value
+}
+
+// NewResult creates the result from grouping aggregator(group aggregator maybe nil if no data),
+// time range is the query time range which end is inclusive.
+// if result cannot be cached(e.g. native histogram field, distribution function), returns false.
+func NewResult(
+	timeRange timeutil.TimeRange,
+	interval int64,
+	specs map[string]*protoCommonV1.AggregatorSpec,
+	groupAgg aggregation.GroupingAggregator,
+) (*Result, bool) {
+	if interval <= 0 {
+		return nil, false
+	}
+	for _, spec := range specs {
+		if field.Type(spec.FieldType) == field.NativeHistogramField {
+			return nil, false
+		}
+		for _, funcType := range spec.FuncTypeList {
+			if function.IsDistribution(function.FuncType(funcType)) {
+				// values collected from each series cannot be merged with other time range
+				return nil, false
+			}
+		}
+	}
+	r := newResult(timeRange.Start, timeRange.End+interval, interval)
+	for name, spec := range specs {
+		r.specs[name] = spec
+	}
+	if groupAgg == nil {
+		return r, true
+	}
+	// slot of aggregator is based on the start time of aggregator
+	baseTime := groupAgg.TimeRange().Start
+	for _, groupIt := range groupAgg.ResultSet() {
+		fields := r.getSeries(groupIt.Tags())
+		for groupIt.HasNext() {
+			seriesIt := groupIt.Next()
+			if seriesIt.FieldType() == field.NativeHistogramField {
+				return nil, false
+			}
+			values := fields[seriesIt.FieldName()]
+			if values == nil {
+				values = &fieldValues{fieldType: seriesIt.FieldType()}
+				fields[seriesIt.FieldName()] = values
+			}
+			for seriesIt.HasNext() {
+				_, fieldIt := seriesIt.Next()
+				if fieldIt == nil {
+					continue
+				}
+				for fieldIt.HasNext() {
+					primitiveIt := fieldIt.Next()
+					if primitiveIt.AggType().IsDistribution() {
+						return nil, false
+					}
+					points := values.getValues(primitiveIt.AggType(), r.slots())
+					for primitiveIt.HasNext() {
+						slot, value := primitiveIt.Next()
+						pos := r.pos(baseTime + int64(slot)*interval)
+						if pos < 0 || pos >= len(points) {
+							continue
+						}
+						points[pos] = value
+					}
+				}
+			}
+		}
+	}
+	return r, true
+}
+
+// newResult creates an empty result.
+func newResult(start, end, interval int64) *Result {
+	return &Result{
+		start:    start,
+		end:      end,
+		interval: interval,
+		specs:    make(map[string]*protoCommonV1.AggregatorSpec),
+		series:   make(map[string]map[field.Name]*fieldValues),
+	}
+}
+
+// Start returns the start time of result(inclusive).
+func (r *Result) Start() int64 {
+	return r.start
+}
+
+// End returns the end time of result(exclusive).
+func (r *Result) End() int64 {
+	return r.end
+}
+
+// Interval returns the interval of result.
+func (r *Result) Interval() int64 {
+	return r.interval
+}
+
+// AggregatorSpecs returns the aggregator specs of result.
+func (r *Result) AggregatorSpecs() map[string]*protoCommonV1.AggregatorSpec {
+	specs := make(map[string]*protoCommonV1.AggregatorSpec, len(r.specs))
+	for name, spec := range r.specs {
+		specs[name] = spec
+	}
+	return specs
+}
+
+// Slice returns the sub result in time range [start, end),
+// time range will be cut if out of result's time range.
+func (r *Result) Slice(start, end int64) *Result {
+	if start < r.start {
+		start = r.start
+	}
+	if end > r.end {
+		end = r.end
+	}
+	if end < start {
+		end = start
+	}
+	rs := newResult(start, end, r.interval)
+	rs.copyFrom(r)
+	return rs
+}
+
+// Merge merges the other result which has same interval, returns a new result,
+// if both results have value at same time, value of other result will be used(newer data).
+func (r *Result) Merge(other *Result) *Result {
+	start, end := r.start, r.end
+	if other.start < start {
+		start = other.start
+	}
+	if other.end > end {
+		end = other.end
+	}
+	rs := newResult(start, end, r.interval)
+	rs.copyFrom(r)
+	rs.copyFrom(other)
+	return rs
+}
+
+// Align returns a new result which is moved to the start time, field names are renamed by time offset
+// (see stmt.OffsetFieldName), which aligns the result of time offset sub query on the query time range.
+func (r *Result) Align(start, offset int64) *Result {
+	return r.rename(start, func(name string) string {
+		return stmt.OffsetFieldName(name, offset)
+	})
+}
+
+// Qualify returns a new result which field names are qualified by metric alias(see stmt.JoinFieldName),
+// which distinguishes the fields of different metrics in cross metric query.
+func (r *Result) Qualify(alias string) *Result {
+	return r.rename(r.start, func(name string) string {
+		return stmt.JoinFieldName(alias, name)
+	})
+}
+
+// Join inner joins the other result which has same interval on series tags and time slot, returns a new result,
+// only keeps the series which both results have, and the slots which both series have value.
+func (r *Result) Join(other *Result) *Result {
+	start, end := r.start, r.end
+	if other.start > start {
+		start = other.start
+	}
+	if other.end < end {
+		end = other.end
+	}
+	if end < start {
+		end = start
+	}
+	rs := newResult(start, end, r.interval)
+	rs.copyFrom(r)
+	rs.copyFrom(other)
+	slots := rs.slots()
+	for tags, fields := range rs.series {
+		left, ok := r.series[tags]
+		if !ok {
+			delete(rs.series, tags)
+			continue
+		}
+		right, ok := other.series[tags]
+		if !ok {
+			delete(rs.series, tags)
+			continue
+		}
+		for pos := 0; pos < slots; pos++ {
+			if hasValue(fields, left, pos) && hasValue(fields, right, pos) {
+				continue
+			}
+			for _, values := range fields {
+				for _, points := range values.values {
+					points[pos] = math.NaN()
+				}
+			}
+		}
+	}
+	return rs
+}
+
+// rename returns a new result which is moved to the start time, field names are renamed by rename function.
+func (r *Result) rename(start int64, rename func(name string) string) *Result {
+	rs := newResult(start, start+r.end-r.start, r.interval)
+	for name, spec := range r.specs {
+		newName := rename(name)
+		rs.specs[newName] = &protoCommonV1.AggregatorSpec{
+			FieldName:    newName,
+			FieldType:    spec.FieldType,
+			FuncTypeList: spec.FuncTypeList,
+		}
+	}
+	for tags, fields := range r.series {
+		newFields := rs.getSeries(tags)
+		for name, values := range fields {
+			newFields[field.Name(rename(string(name)))] = values
+		}
+	}
+	return rs
+}
+
+// GroupedIterators returns the grouped iterators of result,
+// slot of value is based on the start time and only returns value after start time.
+func (r *Result) GroupedIterators(start int64) series.GroupedIterators {
+	var its series.GroupedIterators
+	for tags, fields := range r.series {
+		its = append(its, newGroupedIterator(tags, fields, (r.start-start)/r.interval))
+	}
+	return its
+}
+
+// size returns the approximate memory size of result.
+func (r *Result) size() int {
+	size := 0
+	for tags, fields := range r.series {
+		size += len(tags)
+		for name, values := range fields {
+			size += len(name)
+			for _, points := range values.values {
+				size += 8 * len(points)
+			}
+		}
+	}
+	return size
+}
+
+// copyFrom copies the values of source result which in current result's time range.
+func (r *Result) copyFrom(source *Result) {
+	for name, spec := range source.specs {
+		r.specs[name] = spec
+	}
+	slots := r.slots()
+	offset := int((source.start - r.start) / r.interval)
+	for tags, sourceFields := range source.series {
+		fields := r.getSeries(tags)
+		for name, sourceValues := range sourceFields {
+			values := fields[name]
+			if values == nil {
+				values = &fieldValues{fieldType: sourceValues.fieldType}
+				fields[name] = values
+			}
+			for idx, aggType := range sourceValues.aggTypes {
+				points := values.getValues(aggType, slots)
+				for sourcePos, value := range sourceValues.values[idx] {
+					pos := sourcePos + offset
+					if pos < 0 || pos >= slots || math.IsNaN(value) {
+						continue
+					}
+					points[pos] = value
+				}
+			}
+		}
+	}
+}
+
+// getSeries returns the fields of series, creates it if not exist.
+func (r *Result) getSeries(tags string) map[field.Name]*fieldValues {
+	fields, ok := r.series[tags]
+	if !ok {
+		fields = make(map[field.Name]*fieldValues)
+		r.series[tags] = fields
+	}
+	return fields
+}
+
+// slots returns the number of slots.
+func (r *Result) slots() int {
+	return int((r.end - r.start) / r.interval)
+}
+
+// pos returns the slot of timestamp.
+func (r *Result) pos(timestamp int64) int {
+	if timestamp < r.start {
+		return -1
+	}
+	return int((timestamp - r.start) / r.interval)
+}
+
+// hasValue checks if any field in names has value at the slot.
+func hasValue(fields, names map[field.Name]*fieldValues, pos int) bool {
+	for name := range names {
+		values, ok := fields[name]
+		if !ok {
+			continue
+		}
+		for _, points := range values.values {
+			if !math.IsNaN(points[pos]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// getValues returns the values of agg type, creates it if not exist.
+func (v *fieldValues) getValues(aggType field.AggType, slots int) []float64 {
+	for idx, t := range v.aggTypes {
+		if t == aggType {
+			return v.values[idx]
+		}
+	}
+	values := make([]float64, slots)
+	for idx := range values {
+		values[idx] = math.NaN()
+	}
+	v.aggTypes = append(v.aggTypes, aggType)
+	v.values = append(v.values, values)
+	return values
+}
@@ -0,0 +1,202 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cache
+
+import (
+	"container/list"
+	"strings"
+	"sync"
+	"time"
+
+	commontimeutil "github.com/lindb/common/pkg/timeutil"
+
+	"github.com/lindb/lindb/internal/linmetric"
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+//go:generate mockgen -source=./result_cache.go -destination=./result_cache_mock.go -package=cache
+
+// keySeparator separates database and query in cache key.
+const keySeparator = "\x00"
+
+// ResultCache represents the query result cache on broker side,
+// caches the stable(cannot be changed by late data) history result of query.
+type ResultCache interface {
+	// Get returns the cached result which starts with the start of query time range,
+	// returned result will be cut by query time range.
+	Get(key string, timeRange timeutil.TimeRange, interval int64) (*Result, bool)
+	// Put puts the result of query into cache.
+	Put(key string, result *Result)
+	// Invalidate removes all cached results of the database.
+	Invalidate(database string)
+}
+
+// Key returns the cache key of query, which ignores time range and the options applied on final result.
+func Key(database string, q *stmt.Query) string {
+	normalized := *q
+	normalized.Explain = false
+	normalized.TimeRange = timeutil.TimeRange{}
+	// fill/having/order by/limit are applied on final result set in root node
+	normalized.Fill = stmt.NoFill
+	normalized.FillValue = 0
+	normalized.Having = nil
+	normalized.OrderByItems = nil
+	normalized.Limit = 0
+	data, _ := normalized.MarshalJSON()
+	return database + keySeparator + string(data)
+}
+
+// Cacheable returns if the query result can be cached.
+func Cacheable(q *stmt.Query) bool {
+	// auto group by time's interval changes with time range,
+	// time offset/cross metric query is merged by the results of sub queries which can be cached,
+	// outer query of sub query is evaluated on the result set of inner query which can be cached
+	return !q.Explain && !q.AutoGroupByTime && q.Interval > 0 && !q.HasOffset() && !q.HasJoin() && !q.HasSubQuery()
+}
+
+// entry represents the cache entry.
+type entry struct {
+	key      string
+	database string
+	result   *Result
+	size     int
+	expireAt int64
+}
+
+// resultCache implements ResultCache interface, evicts the least recently used entry if cache is full.
+type resultCache struct {
+	maxSize int
+	ttl     time.Duration
+
+	entries map[string]*list.Element
+	lru     *list.List
+	size    int
+
+	statistics *metrics.QueryCacheStatistics
+	mutex      sync.Mutex
+}
+
+// NewResultCache creates a query result cache.
+func NewResultCache(maxSize int, ttl time.Duration, registry *linmetric.Registry) ResultCache {
+	return &resultCache{
+		maxSize:    maxSize,
+		ttl:        ttl,
+		entries:    make(map[string]*list.Element),
+		lru:        list.New(),
+		statistics: metrics.NewQueryCacheStatistics(registry),
+	}
+}
+
+// Get returns the cached result which starts with the start of query time range,
+// returned result will be cut by query time range.
+func (c *resultCache) Get(key string, timeRange timeutil.TimeRange, interval int64) (*Result, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		c.statistics.Misses.Incr()
+		return nil, false
+	}
+	e := elem.Value.(*entry)
+	if e.expireAt <= commontimeutil.Now() {
+		c.evict(elem)
+		c.updateStatistics()
+		c.statistics.Misses.Incr()
+		return nil, false
+	}
+	r := e.result
+	start := timeRange.Start
+	if r.interval != interval || start < r.start || start >= r.end || (start-r.start)%interval != 0 {
+		c.statistics.Misses.Incr()
+		return nil, false
+	}
+	c.lru.MoveToFront(elem)
+	rs := r.Slice(start, timeRange.End+interval)
+	if rs.end > timeRange.End {
+		c.statistics.Hits.Incr()
+	} else {
+		c.statistics.PartialHits.Incr()
+	}
+	return rs, true
+}
+
+// Put puts the result of query into cache.
+func (c *resultCache) Put(key string, result *Result) {
+	size := len(key) + result.size()
+	if size > c.maxSize {
+		return
+	}
+	database, _, _ := strings.Cut(key, keySeparator)
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	expireAt := commontimeutil.Now() + c.ttl.Milliseconds()
+	if elem, ok := c.entries[key]; ok {
+		// keep expire time of entry, make sure cached result will be reloaded from storage after ttl
+		expireAt = elem.Value.(*entry).expireAt
+		c.remove(elem)
+	}
+	c.entries[key] = c.lru.PushFront(&entry{
+		key:      key,
+		database: database,
+		result:   result,
+		size:     size,
+		expireAt: expireAt,
+	})
+	c.size += size
+	for c.size > c.maxSize {
+		c.evict(c.lru.Back())
+	}
+	c.updateStatistics()
+}
+
+// Invalidate removes all cached results of the database.
+func (c *resultCache) Invalidate(database string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for _, elem := range c.entries {
+		if elem.Value.(*entry).database == database {
+			c.evict(elem)
+		}
+	}
+	c.updateStatistics()
+}
+
+// evict removes the cache entry and records eviction.
+func (c *resultCache) evict(elem *list.Element) {
+	c.remove(elem)
+	c.statistics.Evictions.Incr()
+}
+
+// remove removes the cache entry.
+func (c *resultCache) remove(elem *list.Element) {
+	e := c.lru.Remove(elem).(*entry)
+	delete(c.entries, e.key)
+	c.size -= e.size
+}
+
+// updateStatistics updates the statistics of cache entries.
+func (c *resultCache) updateStatistics() {
+	c.statistics.Entries.Update(float64(len(c.entries)))
+	c.statistics.Size.Update(float64(c.size))
+}
@@ -0,0 +1,151 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	commontimeutil "github.com/lindb/common/pkg/timeutil"
+
+	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/internal/linmetric"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestKey(t *testing.T) {
+	q := &stmt.Query{
+		MetricName: "cpu",
+		TimeRange:  timeutil.TimeRange{Start: 10, End: 20},
+		Interval:   10000,
+		Limit:      10,
+	}
+	key := Key("db", q)
+	assert.Equal(t, key, Key("db", &stmt.Query{
+		Explain:    true,
+		MetricName: "cpu",
+		TimeRange:  timeutil.TimeRange{Start: 30, End: 40},
+		Interval:   10000,
+		Limit:      20,
+		Fill:       stmt.ValueFill,
+		FillValue:  1,
+	}))
+	assert.NotEqual(t, key, Key("db2", q))
+	assert.NotEqual(t, key, Key("db", &stmt.Query{MetricName: "cpu", Interval: 20000}))
+	// time range of query not changed
+	assert.Equal(t, timeutil.TimeRange{Start: 10, End: 20}, q.TimeRange)
+}
+
+func TestCacheable(t *testing.T) {
+	assert.True(t, Cacheable(&stmt.Query{Interval: 10}))
+	assert.False(t, Cacheable(&stmt.Query{}))
+	assert.False(t, Cacheable(&stmt.Query{Interval: 10, Explain: true}))
+	assert.False(t, Cacheable(&stmt.Query{Interval: 10, AutoGroupByTime: true}))
+	assert.False(t, Cacheable(&stmt.Query{Interval: 10, SelectItems: []stmt.Expr{
+		&stmt.SelectItem{Expr: &stmt.CallExpr{FuncType: function.Sum, Params: []stmt.Expr{&stmt.FieldExpr{Name: "f"}}, Offset: 10}},
+	}}))
+	assert.False(t, Cacheable(&stmt.Query{Interval: 10, Joins: []*stmt.JoinMetric{
+		{MetricName: "cpu", Alias: "a"}, {MetricName: "mem", Alias: "b"},
+	}}))
+	assert.False(t, Cacheable(&stmt.Query{Interval: 10, Joins: []*stmt.JoinMetric{{MetricName: "cpu", Alias: "a"}}}))
+	assert.False(t, Cacheable(&stmt.Query{Interval: 10, SubQuery: &stmt.Query{Interval: 10}}))
+}
+
+func TestResultCache_Get(t *testing.T) {
+	c := NewResultCache(1024*1024, time.Minute, linmetric.BrokerRegistry)
+	key := Key("db", &stmt.Query{MetricName: "cpu", Interval: 10})
+	r, ok := c.Get(key, timeutil.TimeRange{Start: 100, End: 190}, 10)
+	assert.False(t, ok)
+	assert.Nil(t, r)
+
+	c.Put(key, newTestResult(100, 200, 10, map[int64]float64{100: 1, 150: 2, 190: 3}))
+	// whole time range hit
+	r, ok = c.Get(key, timeutil.TimeRange{Start: 110, End: 170}, 10)
+	assert.True(t, ok)
+	assert.Equal(t, int64(110), r.Start())
+	assert.Equal(t, int64(180), r.End())
+	// partial hit
+	r, ok = c.Get(key, timeutil.TimeRange{Start: 150, End: 300}, 10)
+	assert.True(t, ok)
+	assert.Equal(t, int64(150), r.Start())
+	assert.Equal(t, int64(200), r.End())
+	// miss
+	cases := []struct {
+		timeRange timeutil.TimeRange
+		interval  int64
+	}{
+		{timeRange: timeutil.TimeRange{Start: 150, End: 300}, interval: 20},
+		{timeRange: timeutil.TimeRange{Start: 90, End: 300}, interval: 10},
+		{timeRange: timeutil.TimeRange{Start: 200, End: 300}, interval: 10},
+		{timeRange: timeutil.TimeRange{Start: 155, End: 300}, interval: 10},
+	}
+	for _, tt := range cases {
+		r, ok = c.Get(key, tt.timeRange, tt.interval)
+		assert.False(t, ok)
+		assert.Nil(t, r)
+	}
+}
+
+func TestResultCache_Put(t *testing.T) {
+	r := newTestResult(100, 200, 10, map[int64]float64{100: 1})
+	size := r.size()
+	c := NewResultCache(2*(size+len("db\x00key1")), time.Minute, linmetric.BrokerRegistry)
+	rc := c.(*resultCache)
+	// too large
+	c.Put("db\x00key1", newTestResult(100, 400, 10, nil))
+	assert.Empty(t, rc.entries)
+
+	c.Put("db\x00key1", r)
+	c.Put("db\x00key2", r)
+	assert.Len(t, rc.entries, 2)
+	// replace entry, keep expire time
+	expireAt := rc.entries["db\x00key1"].Value.(*entry).expireAt
+	c.Put("db\x00key1", r)
+	assert.Len(t, rc.entries, 2)
+	assert.Equal(t, expireAt, rc.entries["db\x00key1"].Value.(*entry).expireAt)
+	// evict least recently used entry
+	c.Put("db\x00key3", r)
+	assert.Len(t, rc.entries, 2)
+	assert.NotContains(t, rc.entries, "db\x00key2")
+	assert.Equal(t, rc.size, 2*(size+len("db\x00key1")))
+}
+
+func TestResultCache_Expire(t *testing.T) {
+	c := NewResultCache(1024*1024, time.Minute, linmetric.BrokerRegistry)
+	c.Put("db\x00key", newTestResult(100, 200, 10, nil))
+	c.(*resultCache).entries["db\x00key"].Value.(*entry).expireAt = commontimeutil.Now() - 1
+	r, ok := c.Get("db\x00key", timeutil.TimeRange{Start: 100, End: 190}, 10)
+	assert.False(t, ok)
+	assert.Nil(t, r)
+	assert.Empty(t, c.(*resultCache).entries)
+	assert.Zero(t, c.(*resultCache).size)
+}
+
+func TestResultCache_Invalidate(t *testing.T) {
+	c := NewResultCache(1024*1024, time.Minute, linmetric.BrokerRegistry)
+	c.Put("db1\x00key", newTestResult(100, 200, 10, nil))
+	c.Put("db2\x00key", newTestResult(100, 200, 10, nil))
+	c.Invalidate("db1")
+	_, ok := c.Get("db1\x00key", timeutil.TimeRange{Start: 100, End: 190}, 10)
+	assert.False(t, ok)
+	_, ok = c.Get("db2\x00key", timeutil.TimeRange{Start: 100, End: 190}, 10)
+	assert.True(t, ok)
+}
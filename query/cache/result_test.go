@@ -0,0 +1,230 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cache
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/lindb/lindb/aggregation"
+	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/pkg/timeutil"
+	protoCommonV1 "github.com/lindb/lindb/proto/gen/v1/common"
+	"github.com/lindb/lindb/series"
+	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+var sumSpec = &protoCommonV1.AggregatorSpec{FieldName: "f", FieldType: uint32(field.SumField)}
+
+// newTestResult creates the result with sum field for testing.
+func newTestResult(start, end, interval int64, values map[int64]float64) *Result {
+	r := newResult(start, end, interval)
+	r.specs["f"] = sumSpec
+	sumValues := &fieldValues{fieldType: field.SumField}
+	points := sumValues.getValues(field.Sum, r.slots())
+	for timestamp, value := range values {
+		points[r.pos(timestamp)] = value
+	}
+	r.series["host1"] = map[field.Name]*fieldValues{"f": sumValues}
+	return r
+}
+
+// readValues reads the values of sum field based on grouped iterators.
+func readValues(its series.GroupedIterators, start, interval int64) map[int64]float64 {
+	rs := make(map[int64]float64)
+	for _, groupIt := range its {
+		for groupIt.HasNext() {
+			seriesIt := groupIt.Next()
+			for seriesIt.HasNext() {
+				_, fieldIt := seriesIt.Next()
+				for fieldIt.HasNext() {
+					primitiveIt := fieldIt.Next()
+					for primitiveIt.HasNext() {
+						slot, value := primitiveIt.Next()
+						rs[start+int64(slot)*interval] = value
+					}
+				}
+			}
+		}
+	}
+	return rs
+}
+
+func TestNewResult(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	timeRange := timeutil.TimeRange{Start: 100, End: 190}
+	specs := map[string]*protoCommonV1.AggregatorSpec{"f": sumSpec}
+
+	r, ok := NewResult(timeRange, 0, specs, nil)
+	assert.False(t, ok)
+	assert.Nil(t, r)
+	r, ok = NewResult(timeRange, 10, map[string]*protoCommonV1.AggregatorSpec{
+		"h": {FieldName: "h", FieldType: uint32(field.NativeHistogramField)},
+	}, nil)
+	assert.False(t, ok)
+	assert.Nil(t, r)
+
+	// empty result
+	r, ok = NewResult(timeRange, 10, specs, nil)
+	assert.True(t, ok)
+	assert.Equal(t, int64(100), r.Start())
+	assert.Equal(t, int64(200), r.End())
+	assert.Equal(t, int64(10), r.Interval())
+	assert.Equal(t, specs, r.AggregatorSpecs())
+	assert.Empty(t, r.GroupedIterators(100))
+
+	groupAgg := aggregation.NewMockGroupingAggregator(ctrl)
+	source := newTestResult(90, 210, 10, map[int64]float64{90: 1, 100: 2, 150: 3, 190: 4, 200: 5})
+	groupAgg.EXPECT().TimeRange().Return(timeutil.TimeRange{Start: 90, End: 200})
+	groupAgg.EXPECT().ResultSet().Return(source.GroupedIterators(90))
+	r, ok = NewResult(timeRange, 10, specs, groupAgg)
+	assert.True(t, ok)
+	assert.Equal(t, map[int64]float64{100: 2, 150: 3, 190: 4}, readValues(r.GroupedIterators(100), 100, 10))
+
+	// native histogram series cannot be cached
+	source.series["host1"]["f"].fieldType = field.NativeHistogramField
+	groupAgg.EXPECT().TimeRange().Return(timeutil.TimeRange{Start: 90, End: 200})
+	groupAgg.EXPECT().ResultSet().Return(source.GroupedIterators(90))
+	r, ok = NewResult(timeRange, 10, specs, groupAgg)
+	assert.False(t, ok)
+	assert.Nil(t, r)
+
+	// distribution function cannot be cached
+	r, ok = NewResult(timeRange, 10, map[string]*protoCommonV1.AggregatorSpec{
+		"f": {FieldName: "f", FieldType: uint32(field.SumField), FuncTypeList: []uint32{uint32(function.Percentile)}},
+	}, nil)
+	assert.False(t, ok)
+	assert.Nil(t, r)
+	source.series["host1"]["f"].fieldType = field.SumField
+	source.series["host1"]["f"].aggTypes = []field.AggType{field.Distribution}
+	groupAgg.EXPECT().TimeRange().Return(timeutil.TimeRange{Start: 90, End: 200})
+	groupAgg.EXPECT().ResultSet().Return(source.GroupedIterators(90))
+	r, ok = NewResult(timeRange, 10, specs, groupAgg)
+	assert.False(t, ok)
+	assert.Nil(t, r)
+}
+
+func TestResult_Slice(t *testing.T) {
+	r := newTestResult(100, 200, 10, map[int64]float64{100: 1, 150: 2, 190: 3})
+	rs := r.Slice(120, 170)
+	assert.Equal(t, int64(120), rs.Start())
+	assert.Equal(t, int64(170), rs.End())
+	assert.Equal(t, map[int64]float64{150: 2}, readValues(rs.GroupedIterators(120), 120, 10))
+	// cut by time range of result
+	rs = r.Slice(50, 300)
+	assert.Equal(t, int64(100), rs.Start())
+	assert.Equal(t, int64(200), rs.End())
+	assert.Equal(t, map[int64]float64{100: 1, 150: 2, 190: 3}, readValues(rs.GroupedIterators(100), 100, 10))
+	rs = r.Slice(150, 120)
+	assert.Equal(t, int64(150), rs.Start())
+	assert.Equal(t, int64(150), rs.End())
+	assert.Empty(t, readValues(rs.GroupedIterators(150), 150, 10))
+}
+
+func TestResult_Merge(t *testing.T) {
+	r := newTestResult(100, 200, 10, map[int64]float64{100: 1, 150: 2, 190: 3})
+	tail := newTestResult(190, 250, 10, map[int64]float64{190: 4, 240: 5})
+	tail.series["host2"] = map[field.Name]*fieldValues{"f": {
+		fieldType: field.SumField,
+		aggTypes:  []field.AggType{field.Sum},
+		values:    [][]float64{{6, math.NaN(), math.NaN(), math.NaN(), math.NaN(), math.NaN()}},
+	}}
+	rs := r.Merge(tail)
+	assert.Equal(t, int64(100), rs.Start())
+	assert.Equal(t, int64(250), rs.End())
+	assert.Len(t, rs.series, 2)
+	assert.Equal(t, map[int64]float64{100: 1, 150: 2, 190: 4, 240: 5},
+		readValues(series.GroupedIterators{
+			newGroupedIterator("host1", rs.series["host1"], 0),
+		}, 100, 10))
+	assert.Equal(t, map[int64]float64{190: 6},
+		readValues(series.GroupedIterators{
+			newGroupedIterator("host2", rs.series["host2"], 0),
+		}, 100, 10))
+	// values before start time are ignored
+	assert.Equal(t, map[int64]float64{150: 2, 190: 4, 240: 5},
+		readValues(series.GroupedIterators{
+			newGroupedIterator("host1", rs.series["host1"], -4),
+		}, 140, 10))
+	assert.True(t, rs.size() > 0)
+}
+
+func TestResult_Align(t *testing.T) {
+	r := newTestResult(100, 200, 10, map[int64]float64{100: 1, 150: 2})
+	rs := r.Align(1100, 1000)
+	assert.Equal(t, int64(1100), rs.Start())
+	assert.Equal(t, int64(1200), rs.End())
+	offsetName := stmt.OffsetFieldName("f", 1000)
+	assert.Equal(t, offsetName, rs.AggregatorSpecs()[offsetName].FieldName)
+	assert.Equal(t, sumSpec.FieldType, rs.AggregatorSpecs()[offsetName].FieldType)
+	assert.Nil(t, rs.series["host1"]["f"])
+	assert.Equal(t, map[int64]float64{1100: 1, 1150: 2},
+		readValues(rs.GroupedIterators(1100), 1100, 10))
+	// merge with current result
+	merged := rs.Merge(newTestResult(1100, 1200, 10, map[int64]float64{1110: 3}))
+	assert.Len(t, merged.series["host1"], 2)
+	assert.Len(t, merged.AggregatorSpecs(), 2)
+}
+
+func TestResult_Join(t *testing.T) {
+	a := newTestResult(100, 200, 10, map[int64]float64{100: 1, 120: 2, 150: 3}).Qualify("a")
+	assert.Equal(t, "a.f", a.AggregatorSpecs()["a.f"].FieldName)
+	b := newTestResult(100, 200, 10, map[int64]float64{100: 10, 150: 30, 160: 40}).Qualify("b")
+	b.series["host2"] = b.series["host1"]
+	rs := a.Join(b)
+	assert.Len(t, rs.AggregatorSpecs(), 2)
+	assert.Len(t, rs.series, 1)
+	fields := rs.series["host1"]
+	assert.Len(t, fields, 2)
+	assert.Equal(t, 1.0, fields["a.f"].values[0][0])
+	assert.Equal(t, 3.0, fields["a.f"].values[0][5])
+	assert.True(t, math.IsNaN(fields["a.f"].values[0][2]))
+	assert.Equal(t, 10.0, fields["b.f"].values[0][0])
+	assert.Equal(t, 30.0, fields["b.f"].values[0][5])
+	assert.True(t, math.IsNaN(fields["b.f"].values[0][6]))
+	// series not in both results
+	rs = a.Join(newResult(100, 200, 10))
+	assert.Empty(t, rs.series)
+}
+
+func TestIterator_MarshalBinary(t *testing.T) {
+	r := newTestResult(100, 200, 10, map[int64]float64{100: 1})
+	its := r.GroupedIterators(100)
+	assert.Len(t, its, 1)
+	assert.Equal(t, "host1", its[0].Tags())
+	assert.True(t, its[0].HasNext())
+	seriesIt := its[0].Next()
+	assert.Equal(t, field.Name("f"), seriesIt.FieldName())
+	assert.Equal(t, field.SumField, seriesIt.FieldType())
+	_, err := seriesIt.MarshalBinary()
+	assert.Equal(t, errMarshalNotSupport, err)
+	assert.True(t, seriesIt.HasNext())
+	_, fieldIt := seriesIt.Next()
+	assert.False(t, seriesIt.HasNext())
+	_, err = fieldIt.MarshalBinary()
+	assert.Equal(t, errMarshalNotSupport, err)
+	assert.True(t, fieldIt.HasNext())
+	assert.Equal(t, field.Sum, fieldIt.Next().AggType())
+	assert.False(t, fieldIt.HasNext())
+}
@@ -0,0 +1,135 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package context
+
+import (
+	"context"
+	"errors"
+
+	"github.com/lindb/common/pkg/encoding"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/flow"
+	"github.com/lindb/lindb/models"
+	protoCommonV1 "github.com/lindb/lindb/proto/gen/v1/common"
+	"github.com/lindb/lindb/rpc"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+// errUnsupportedDeleteStatement represents the statement cannot be executed by delete context.
+var errUnsupportedDeleteStatement = errors.New("unsupported delete statement")
+
+// DeleteDeps represents series delete/metric drop dependency.
+type DeleteDeps struct {
+	Ctx     context.Context
+	Request *models.Request
+
+	Database     string
+	Statement    stmt.Statement // delete or drop metric statement
+	CurrentNode  models.StatelessNode
+	Choose       flow.ReplicaChoose
+	TransportMgr rpc.TransportManager
+}
+
+// DeleteContext represents series delete/metric drop context, sends delete request to all replicas of shards.
+type DeleteContext struct {
+	baseTaskContext
+
+	Deps *DeleteDeps
+	// handle response
+	result *models.DeleteResult
+}
+
+// NewDeleteContext creates series delete context.
+func NewDeleteContext(deps *DeleteDeps) *DeleteContext {
+	return &DeleteContext{
+		baseTaskContext: newBaseTaskContext(deps.Ctx, deps.TransportMgr),
+		Deps:            deps,
+		result:          &models.DeleteResult{},
+	}
+}
+
+// WaitResponse waits series delete task completed and returns the number of deleted series.
+func (ctx *DeleteContext) WaitResponse() (any, error) {
+	select {
+	case <-ctx.doneCh:
+		// received all responses, break for loop
+		return ctx.result, ctx.err
+	case <-ctx.Deps.Ctx.Done():
+		return nil, constants.ErrTimeout
+	}
+}
+
+// HandleResponse handles series delete task response.
+func (ctx *DeleteContext) HandleResponse(resp *protoCommonV1.TaskResponse, fromNode string) {
+	ctx.handleResponse(resp, fromNode)
+	ctx.tryClose()
+}
+
+// MakePlan makes the series delete physical plan, which includes all replicas of shards.
+func (ctx *DeleteContext) MakePlan() error {
+	physicalPlan, err := ctx.Deps.Choose.ChooseReplicas(ctx.Deps.Database)
+	if err != nil {
+		return err
+	}
+	physicalPlan.AddReceiver(ctx.Deps.CurrentNode.Indicator())
+	if err := physicalPlan.Validate(); err != nil {
+		return err
+	}
+	var requestType protoCommonV1.RequestType
+	var payload []byte
+	switch statement := ctx.Deps.Statement.(type) {
+	case *stmt.Delete:
+		requestType = protoCommonV1.RequestType_Delete
+		payload, _ = statement.MarshalJSON()
+	case *stmt.DropMetric:
+		requestType = protoCommonV1.RequestType_Drop
+		payload = encoding.JSONMarshal(statement)
+	default:
+		return errUnsupportedDeleteStatement
+	}
+	ctx.addRequests(
+		&protoCommonV1.TaskRequest{
+			RequestID:    ctx.Deps.Request.RequestID,
+			RequestType:  requestType,
+			PhysicalPlan: encoding.JSONMarshal(physicalPlan),
+			Payload:      payload,
+		}, physicalPlan)
+	return nil
+}
+
+// handleResponse handles series delete task response with lock.
+func (ctx *DeleteContext) handleResponse(resp *protoCommonV1.TaskResponse, fromNode string) {
+	ctx.mutex.Lock()
+	defer ctx.mutex.Unlock()
+
+	ctx.handleTaskState(resp, fromNode)
+	ctx.expectResults--
+
+	if resp.ErrMsg != "" {
+		// delete must be completed on all replicas
+		ctx.err = errors.New(resp.ErrMsg)
+		return
+	}
+	result := &models.DeleteResult{}
+	if err := encoding.JSONUnmarshal(resp.Payload, result); err != nil {
+		ctx.err = err
+		return
+	}
+	ctx.result.Merge(result)
+}
@@ -0,0 +1,181 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package context
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lindb/common/pkg/encoding"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/flow"
+	"github.com/lindb/lindb/models"
+	protoCommonV1 "github.com/lindb/lindb/proto/gen/v1/common"
+	"github.com/lindb/lindb/query/tracker"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestDeleteContext_WaitResponse(t *testing.T) {
+	t.Run("task complete", func(t *testing.T) {
+		ctx := NewDeleteContext(&DeleteDeps{
+			Ctx:       context.TODO(),
+			Statement: &stmt.Delete{},
+		})
+		ctx.SetTracker(tracker.NewStageTracker(flow.NewTaskContextWithTimeout(context.TODO(), time.Minute)))
+		go func() {
+			ctx.Complete(fmt.Errorf("err"))
+		}()
+		_, err := ctx.WaitResponse()
+		assert.Error(t, err)
+	})
+	t.Run("task cancel", func(t *testing.T) {
+		c, cancel := context.WithCancel(context.TODO())
+		ctx := NewDeleteContext(&DeleteDeps{
+			Ctx:       c,
+			Statement: &stmt.Delete{},
+		})
+		go func() {
+			cancel()
+		}()
+		rs, err := ctx.WaitResponse()
+		assert.Equal(t, constants.ErrTimeout, err)
+		assert.Nil(t, rs)
+	})
+}
+
+func TestDeleteContext_MakePlan(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	chooseMgr := flow.NewMockReplicaChoose(ctrl)
+	cases := []struct {
+		name        string
+		statement   stmt.Statement
+		prepare     func()
+		requestType protoCommonV1.RequestType
+		wantErr     bool
+	}{
+		{
+			name:      "choose fail",
+			statement: &stmt.Delete{},
+			prepare: func() {
+				chooseMgr.EXPECT().ChooseReplicas(gomock.Any()).Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:      "plan invalid",
+			statement: &stmt.Delete{},
+			prepare: func() {
+				chooseMgr.EXPECT().ChooseReplicas(gomock.Any()).Return(&models.PhysicalPlan{}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "unsupported statement",
+			statement: &stmt.Query{},
+			prepare: func() {
+				chooseMgr.EXPECT().ChooseReplicas(gomock.Any()).
+					Return(&models.PhysicalPlan{Database: "test", Targets: []*models.Target{{Indicator: "1.1.1.1:2891"}}}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "make delete plan successfully",
+			statement: &stmt.Delete{},
+			prepare: func() {
+				chooseMgr.EXPECT().ChooseReplicas(gomock.Any()).
+					Return(&models.PhysicalPlan{Database: "test", Targets: []*models.Target{{Indicator: "1.1.1.1:2891"}}}, nil)
+			},
+			requestType: protoCommonV1.RequestType_Delete,
+		},
+		{
+			name:      "make drop plan successfully",
+			statement: &stmt.DropMetric{Namespace: "ns", MetricName: "cpu"},
+			prepare: func() {
+				chooseMgr.EXPECT().ChooseReplicas(gomock.Any()).
+					Return(&models.PhysicalPlan{Database: "test", Targets: []*models.Target{{Indicator: "1.1.1.1:2891"}}}, nil)
+			},
+			requestType: protoCommonV1.RequestType_Drop,
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			tt.prepare()
+			ctx := NewDeleteContext(&DeleteDeps{
+				Ctx:         context.TODO(),
+				Request:     &models.Request{},
+				Statement:   tt.statement,
+				Choose:      chooseMgr,
+				CurrentNode: models.StatelessNode{},
+			})
+			err := ctx.MakePlan()
+			assert.Equal(t, tt.wantErr, err != nil)
+			if !tt.wantErr {
+				requests := ctx.GetRequests()
+				assert.Len(t, requests, 1)
+				assert.Equal(t, tt.requestType, requests["1.1.1.1:2891"].RequestType)
+			}
+		})
+	}
+}
+
+func TestDeleteContext_HandleResponse(t *testing.T) {
+	newCtx := func() *DeleteContext {
+		ctx := NewDeleteContext(&DeleteDeps{
+			Ctx:       context.TODO(),
+			Statement: &stmt.Delete{},
+		})
+		ctx.SetTracker(tracker.NewStageTracker(flow.NewTaskContextWithTimeout(context.TODO(), time.Minute)))
+		ctx.expectResults = 2
+		return ctx
+	}
+	t.Run("delete successfully", func(t *testing.T) {
+		ctx := newCtx()
+		ctx.HandleResponse(&protoCommonV1.TaskResponse{
+			Completed: true,
+			Payload:   encoding.JSONMarshal(&models.DeleteResult{DeletedSeries: map[models.ShardID]uint64{1: 10}}),
+		}, "leaf-1")
+		ctx.HandleResponse(&protoCommonV1.TaskResponse{
+			Completed: true,
+			Payload:   encoding.JSONMarshal(&models.DeleteResult{DeletedSeries: map[models.ShardID]uint64{1: 10, 2: 3}}),
+		}, "leaf-2")
+		rs, err := ctx.WaitResponse()
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(13), rs.(*models.DeleteResult).NumOfDeletedSeries())
+	})
+	t.Run("delete failure", func(t *testing.T) {
+		ctx := newCtx()
+		ctx.HandleResponse(&protoCommonV1.TaskResponse{ErrMsg: "err"}, "leaf-1")
+		_, err := ctx.WaitResponse()
+		assert.Error(t, err)
+	})
+	t.Run("unmarshal failure", func(t *testing.T) {
+		ctx := newCtx()
+		ctx.HandleResponse(&protoCommonV1.TaskResponse{Payload: []byte{1, 2, 3}}, "leaf-1")
+		_, err := ctx.WaitResponse()
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,73 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package context
+
+import (
+	"sync"
+
+	"github.com/lindb/lindb/flow"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/sql/stmt"
+	"github.com/lindb/lindb/tsdb"
+)
+
+// LeafDeleteContext represents leaf node execution delete series context.
+type LeafDeleteContext struct {
+	Request  *stmt.Delete
+	Database tsdb.Database
+	ShardIDs []models.ShardID
+
+	StorageExecuteCtx *flow.StorageExecuteContext
+
+	deletedSeries map[models.ShardID]uint64
+	mutex         sync.Mutex
+}
+
+// NewLeafDeleteContext creates a LeafDeleteContext instance.
+func NewLeafDeleteContext(request *stmt.Delete, database tsdb.Database, shardIDs []models.ShardID) *LeafDeleteContext {
+	return &LeafDeleteContext{
+		Request:       request,
+		Database:      database,
+		ShardIDs:      shardIDs,
+		deletedSeries: make(map[models.ShardID]uint64),
+		StorageExecuteCtx: &flow.StorageExecuteContext{
+			Query: &stmt.Query{
+				Namespace:  request.Namespace,
+				MetricName: request.MetricName,
+				Condition:  request.Condition,
+				TimeRange:  request.TimeRange,
+			},
+		},
+	}
+}
+
+// AddDeletedSeries adds the number of deleted series for shard.
+func (ctx *LeafDeleteContext) AddDeletedSeries(shardID models.ShardID, deletedSeries uint64) {
+	ctx.mutex.Lock()
+	defer ctx.mutex.Unlock()
+
+	ctx.deletedSeries[shardID] += deletedSeries
+}
+
+// GetResult returns the number of deleted series of each shard.
+func (ctx *LeafDeleteContext) GetResult() *models.DeleteResult {
+	ctx.mutex.Lock()
+	defer ctx.mutex.Unlock()
+
+	return &models.DeleteResult{DeletedSeries: ctx.deletedSeries}
+}
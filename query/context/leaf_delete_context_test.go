@@ -0,0 +1,44 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package context
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/timeutil"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+func TestLeafDeleteContext(t *testing.T) {
+	ctx := NewLeafDeleteContext(&stmtpkg.Delete{
+		Namespace:  "ns",
+		MetricName: "cpu",
+		TimeRange:  timeutil.TimeRange{Start: 10, End: 100},
+	}, nil, nil)
+	assert.Equal(t, "ns", ctx.StorageExecuteCtx.Query.Namespace)
+	assert.Equal(t, "cpu", ctx.StorageExecuteCtx.Query.MetricName)
+	assert.Equal(t, timeutil.TimeRange{Start: 10, End: 100}, ctx.StorageExecuteCtx.Query.TimeRange)
+	assert.Empty(t, ctx.GetResult().DeletedSeries)
+	ctx.AddDeletedSeries(1, 10)
+	ctx.AddDeletedSeries(1, 5)
+	ctx.AddDeletedSeries(2, 5)
+	assert.Equal(t, map[models.ShardID]uint64{1: 15, 2: 5}, ctx.GetResult().DeletedSeries)
+}
@@ -0,0 +1,66 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package context
+
+import (
+	"sync"
+
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/series/metric"
+	"github.com/lindb/lindb/sql/stmt"
+	"github.com/lindb/lindb/tsdb"
+)
+
+// LeafDropContext represents leaf node execution drop metric/namespace context.
+type LeafDropContext struct {
+	Request  *stmt.DropMetric
+	Database tsdb.Database
+	ShardIDs []models.ShardID
+
+	// MetricIDs represents the ids of metrics which need to drop.
+	MetricIDs []metric.ID
+
+	droppedSeries map[models.ShardID]uint64
+	mutex         sync.Mutex
+}
+
+// NewLeafDropContext creates a LeafDropContext instance.
+func NewLeafDropContext(request *stmt.DropMetric, database tsdb.Database, shardIDs []models.ShardID) *LeafDropContext {
+	return &LeafDropContext{
+		Request:       request,
+		Database:      database,
+		ShardIDs:      shardIDs,
+		droppedSeries: make(map[models.ShardID]uint64),
+	}
+}
+
+// AddDroppedSeries adds the number of dropped series for shard.
+func (ctx *LeafDropContext) AddDroppedSeries(shardID models.ShardID, droppedSeries uint64) {
+	ctx.mutex.Lock()
+	defer ctx.mutex.Unlock()
+
+	ctx.droppedSeries[shardID] += droppedSeries
+}
+
+// GetResult returns the number of dropped series of each shard.
+func (ctx *LeafDropContext) GetResult() *models.DeleteResult {
+	ctx.mutex.Lock()
+	defer ctx.mutex.Unlock()
+
+	return &models.DeleteResult{DeletedSeries: ctx.droppedSeries}
+}
@@ -0,0 +1,38 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package context
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/models"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+func TestLeafDropContext(t *testing.T) {
+	ctx := NewLeafDropContext(&stmtpkg.DropMetric{Namespace: "ns", MetricName: "cpu"}, nil, []models.ShardID{1, 2})
+	assert.Equal(t, "cpu", ctx.Request.MetricName)
+	assert.Equal(t, []models.ShardID{1, 2}, ctx.ShardIDs)
+	assert.Empty(t, ctx.GetResult().DeletedSeries)
+	ctx.AddDroppedSeries(1, 10)
+	ctx.AddDroppedSeries(1, 5)
+	ctx.AddDroppedSeries(2, 5)
+	assert.Equal(t, map[models.ShardID]uint64{1: 15, 2: 5}, ctx.GetResult().DeletedSeries)
+}
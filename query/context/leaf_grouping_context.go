@@ -26,6 +26,7 @@ import (
 
 	commonmodels "github.com/lindb/common/models"
 
+	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/query/tracker"
 	"github.com/lindb/lindb/series/tag"
 )
@@ -155,9 +156,14 @@ func (ctx *LeafGroupingContext) getTagValues(tagValueIDs string) string {
 		tagValuesForKey := ctx.tagValuesMap[idx]
 		offset := idx * 4
 		tagValueID := binary.LittleEndian.Uint32(tagsData[offset:])
-		if tagValue, ok := tagValuesForKey[tagValueID]; ok {
+		tagValue, ok := tagValuesForKey[tagValueID]
+		switch {
+		case tagValueID == flow.MissingTagValueID:
+			// series hasn't the tag key(raw series query)
+			ctx.tagValues[idx] = ""
+		case ok:
 			ctx.tagValues[idx] = tagValue
-		} else {
+		default:
 			ctx.tagValues[idx] = tagValueNotFound
 		}
 	}
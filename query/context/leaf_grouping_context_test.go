@@ -147,4 +147,7 @@ func TestLeafGroupingContext_getTagValues(t *testing.T) {
 	t.Run("tag value not found", func(t *testing.T) {
 		assert.Equal(t, tagValueNotFound, ctx.getTagValues(string([]byte{2, 0, 0, 0})))
 	})
+	t.Run("series without tag key", func(t *testing.T) {
+		assert.Equal(t, "", ctx.getTagValues(string([]byte{0xff, 0xff, 0xff, 0xff})))
+	})
 }
@@ -18,6 +18,8 @@
 package context
 
 import (
+	"math"
+
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/models"
@@ -54,6 +56,9 @@ func NewLeafMetadataContext(request *stmt.MetricMetadata, database tsdb.Database
 // getLimit returns result limit.
 func (ctx *LeafMetadataContext) getLimit() int {
 	req := ctx.Request
+	if req.All {
+		return math.MaxInt32
+	}
 	limit := req.Limit
 	if limit == 0 || limit > constants.MaxSuggestions {
 		// if limit = 0 or > max suggestion items, need reset limit
@@ -40,4 +40,11 @@ func TestLeafMetadataContext(t *testing.T) {
 
 	ctx = NewLeafMetadataContext(&stmtpkg.MetricMetadata{Limit: 500}, nil, nil)
 	assert.Equal(t, constants.MaxSuggestions, ctx.Limit)
+
+	// returns all values
+	ctx = NewLeafMetadataContext(&stmtpkg.MetricMetadata{Limit: 500, All: true}, nil, nil)
+	for i := 0; i < 1000; i++ {
+		ctx.AddValue(fmt.Sprintf("value-%d", i))
+	}
+	assert.Len(t, ctx.ResultSet, 1000)
 }
@@ -143,7 +143,11 @@ func (ctx *LeafReduceContext) makeTimeSeriesList() []*protoCommonV1.TimeSeries {
 
 		if len(fields) > 0 {
 			tags := ""
-			if hasGroupBy {
+			switch {
+			case hasGroupBy && ctx.storageExecuteCtx.GroupingTagsRewriter != nil:
+				// grouping tags are rewritten when grouping, returns tag values directly
+				tags = groupedSeriesItr.Tags()
+			case hasGroupBy:
 				tagValueIDs := groupedSeriesItr.Tags() // returns tag value ids string value under leaf node.
 				tags = ctx.leafGroupingCtx.getTagValues(tagValueIDs)
 			}
@@ -129,3 +129,29 @@ func TestLeafReduceContext_BuildResultSet(t *testing.T) {
 		})
 	}
 }
+
+func TestLeafReduceContext_makeTimeSeriesList_RewrittenTags(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storageCtx := &flow.StorageExecuteContext{
+		Query:                &stmtpkg.Query{GroupBy: []string{"host"}},
+		GroupingTagsRewriter: &flow.GroupingTagsRewriter{},
+	}
+	ctx := NewLeafReduceContext(storageCtx, &LeafGroupingContext{})
+	agg := aggregation.NewMockGroupingAggregator(ctrl)
+	ctx.reduceAgg = agg
+	gIt := series.NewMockGroupedIterator(ctrl)
+	agg.EXPECT().ResultSet().Return(series.GroupedIterators{gIt})
+	gIt.EXPECT().HasNext().Return(true)
+	it := series.NewMockIterator(ctrl)
+	gIt.EXPECT().Next().Return(it)
+	it.EXPECT().MarshalBinary().Return([]byte{1, 2, 3}, nil)
+	it.EXPECT().FieldName().Return(field.Name("f"))
+	gIt.EXPECT().HasNext().Return(false)
+	gIt.EXPECT().Tags().Return("dc1")
+	timeSeriesList := ctx.makeTimeSeriesList()
+	assert.Len(t, timeSeriesList, 1)
+	// tag values of grouping key are used directly
+	assert.Equal(t, "dc1", timeSeriesList[0].Tags)
+}
@@ -53,7 +53,7 @@ type MetadataContext struct {
 
 // NewMetadataContext creates metric metadata search context.
 func NewMetadataContext(deps *MetadataDeps) *MetadataContext {
-	if deps.Statement.Limit == 0 || deps.Statement.Limit > constants.MaxSuggestions {
+	if !deps.Statement.All && (deps.Statement.Limit == 0 || deps.Statement.Limit > constants.MaxSuggestions) {
 		// if limit =0 or > max suggestion items, need reset limit
 		deps.Statement.Limit = constants.MaxSuggestions
 	}
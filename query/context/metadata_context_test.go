@@ -63,6 +63,13 @@ func TestMetadataContext_WaitResponse(t *testing.T) {
 	})
 }
 
+func TestNewMetadataContext_Limit(t *testing.T) {
+	ctx := NewMetadataContext(&MetadataDeps{Ctx: context.TODO(), Statement: &stmt.MetricMetadata{Limit: 500}})
+	assert.Equal(t, constants.MaxSuggestions, ctx.Deps.Statement.Limit)
+	ctx = NewMetadataContext(&MetadataDeps{Ctx: context.TODO(), Statement: &stmt.MetricMetadata{All: true}})
+	assert.Equal(t, 0, ctx.Deps.Statement.Limit)
+}
+
 func TestMetadataContext_MakePlan(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
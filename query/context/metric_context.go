@@ -120,21 +120,11 @@ func (ctx *MetricContext) handleResponse(resp *protoCommonV1.TaskResponse, fromN
 	}
 
 	if ctx.groupAgg == nil {
-		AggregatorSpecs := make(aggregation.AggregatorSpecs, len(tsList.FieldAggSpecs))
-		for idx, aggSpec := range tsList.FieldAggSpecs {
-			AggregatorSpecs[idx] = aggregation.NewAggregatorSpec(
-				field.Name(aggSpec.FieldName),
-				field.Type(aggSpec.FieldType),
-			)
-			for _, funcType := range aggSpec.FuncTypeList {
-				AggregatorSpecs[idx].AddFunctionType(function.FuncType(funcType))
-			}
-		}
 		ctx.groupAgg = newGroupingAgg(
 			timeutil.Interval(ctx.interval),
 			1, // interval ratio is 1 when do merge result.
 			ctx.timeRange,
-			AggregatorSpecs,
+			newAggregatorSpecs(tsList.FieldAggSpecs),
 		)
 	}
 
@@ -151,6 +141,21 @@ func (ctx *MetricContext) handleResponse(resp *protoCommonV1.TaskResponse, fromN
 	}
 }
 
+// newAggregatorSpecs creates aggregator specs based on aggregator specs of response.
+func newAggregatorSpecs(specs []*protoCommonV1.AggregatorSpec) aggregation.AggregatorSpecs {
+	aggregatorSpecs := make(aggregation.AggregatorSpecs, len(specs))
+	for idx, aggSpec := range specs {
+		aggregatorSpecs[idx] = aggregation.NewAggregatorSpec(
+			field.Name(aggSpec.FieldName),
+			field.Type(aggSpec.FieldType),
+		)
+		for _, funcType := range aggSpec.FuncTypeList {
+			aggregatorSpecs[idx].AddFunctionType(function.FuncType(funcType))
+		}
+	}
+	return aggregatorSpecs
+}
+
 // checkError checks if it has an error should be returned.
 // node of the cluster may return not found error,
 // ignoreResponse=true symbols that the response should be ignored
@@ -160,16 +165,31 @@ func (ctx *MetricContext) checkError(errMsg string) (ignoreResponse bool, err er
 	}
 	// real error
 	if !strings.Contains(errMsg, "not found") {
-		goto ReturnError
+		return true, errors.New(errMsg)
 	}
 	ctx.tolerantNotFounds--
 	// not found, but there may be still more responses not reached
 	if ctx.tolerantNotFounds > 0 {
 		return true, nil
 	}
-	// fallthrough, all node returns not found errors
-ReturnError:
-	return true, errors.New(errMsg)
+	// all node returns not found errors
+	return true, &notFoundError{msg: errMsg}
+}
+
+// notFoundError represents the not found error message returned by remote node,
+// which matches constants.ErrNotFound.
+type notFoundError struct {
+	msg string
+}
+
+// Error returns the error message of remote node.
+func (e *notFoundError) Error() string {
+	return e.msg
+}
+
+// Is returns true if target is constants.ErrNotFound.
+func (e *notFoundError) Is(target error) bool {
+	return target == constants.ErrNotFound
 }
 
 // handleStats handles the node stats of query task.
@@ -163,6 +163,7 @@ func TestMetricContext_checkErr(t *testing.T) {
 			assertFn: func(ignore bool, err error) {
 				assert.True(t, ignore)
 				assert.Error(t, err)
+				assert.NotErrorIs(t, err, constants.ErrNotFound)
 			},
 		},
 		{
@@ -181,7 +182,8 @@ func TestMetricContext_checkErr(t *testing.T) {
 			},
 			assertFn: func(ignore bool, err error) {
 				assert.True(t, ignore)
-				assert.Error(t, err)
+				assert.ErrorIs(t, err, constants.ErrNotFound)
+				assert.Equal(t, "not found", err.Error())
 			},
 		},
 	}
@@ -25,10 +25,12 @@ import (
 	"reflect"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	commonmodels "github.com/lindb/common/models"
 	"github.com/lindb/common/pkg/encoding"
+	commontimeutil "github.com/lindb/common/pkg/timeutil"
 
 	"github.com/lindb/lindb/aggregation"
 	"github.com/lindb/lindb/aggregation/function"
@@ -38,6 +40,7 @@ import (
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/timeutil"
 	protoCommonV1 "github.com/lindb/lindb/proto/gen/v1/common"
+	"github.com/lindb/lindb/query/cache"
 	"github.com/lindb/lindb/query/tracker"
 	"github.com/lindb/lindb/rpc"
 	"github.com/lindb/lindb/series/field"
@@ -46,12 +49,21 @@ import (
 	"github.com/lindb/lindb/sql/stmt"
 )
 
+var errSubQueryNotSupport = errors.New("time offset/cross metric query not support native histogram field")
+
 var (
 	newExpressionFn    = aggregation.NewExpression
 	newGroupingAgg     = aggregation.NewGroupingAggregator
 	newResultLimiterFn = aggregation.NewResultLimiter
+	nowFn              = commontimeutil.Now
 )
 
+// ResultWriter writes the chunk of result set incrementally for streaming query.
+type ResultWriter interface {
+	// Write writes the chunk of result set, series of chunks are disjoint.
+	Write(rs *commonmodels.ResultSet) error
+}
+
 // RootMetricContextDeps represents root metric data search dependency.
 type RootMetricContextDeps struct {
 	Ctx          context.Context
@@ -61,6 +73,11 @@ type RootMetricContextDeps struct {
 	Statement    *stmt.Query
 	Choose       flow.NodeChoose
 	TransportMgr rpc.TransportManager
+	ResultCache  cache.ResultCache // query result cache, nil if disabled
+	// ExecSubQuery executes the sub query task of time offset/cross metric query, returns the result of task.
+	ExecSubQuery func(ctx TaskContext, req *models.Request) (any, error)
+	// Writer writes the chunk of result set when response received, nil if not streaming query.
+	Writer ResultWriter
 }
 
 // RootMetricContext represents root metric data search context.
@@ -68,6 +85,16 @@ type RootMetricContext struct {
 	MetricContext
 
 	Deps *RootMetricContextDeps
+
+	cacheKey     string
+	cachedResult *cache.Result
+	queryRange   timeutil.TimeRange // time range of data query(sent to storage)
+	stableEnd    int64              // result before stable end cannot be changed by late data
+	subQuery     bool               // sub query of time offset/cross metric query, returns grouped result instead of result set
+	subQueryRows []aggregation.Row  // rows of outer query which aggregated on result set of inner query
+	streaming    bool               // write result chunk when response received, groups of each response are complete
+	remaining    int                // num. of series can be written for streaming query
+	writing      sync.WaitGroup     // chunks which are being written for streaming query
 }
 
 // NewRootMetricContext creates the root metric data search context.
@@ -80,6 +107,14 @@ func NewRootMetricContext(deps *RootMetricContextDeps) *RootMetricContext {
 
 // MakePlan makes the metric data physical plan.
 func (ctx *RootMetricContext) MakePlan() error {
+	// validate group by tag functions before dispatching query, grouping tags are rewritten by storage nodes
+	if _, err := flow.NewGroupingTagsRewriter(ctx.Deps.Statement); err != nil {
+		return err
+	}
+	if ctx.Deps.Statement.HasSubQuery() {
+		// inner query is executed when waiting response
+		return nil
+	}
 	database := ctx.Deps.Database
 	computeNodes := 1
 	if ctx.Deps.Statement.HasGroupBy() {
@@ -101,8 +136,28 @@ func (ctx *RootMetricContext) MakePlan() error {
 			return constants.ErrDatabaseNotExist
 		}
 		calcTimeRangeAndInterval(ctx.Deps.Statement, databaseCfg)
+		if ctx.lookupResultCache(databaseCfg) {
+			// whole query time range hit in cache, no need to query storage
+			return nil
+		}
 	}
-	payload, _ := ctx.Deps.Statement.MarshalJSON()
+	statement := ctx.Deps.Statement
+	if statement.HasOffset() || statement.HasJoin() {
+		// sub queries split by time offset/metric are executed when waiting response
+		return nil
+	}
+	if ctx.cachedResult != nil {
+		// only query the tail time range which not in cache
+		tailStatement := *statement
+		tailStatement.TimeRange = ctx.queryRange
+		statement = &tailStatement
+	}
+	ctx.streaming = ctx.canStream(physicalPlans)
+	ctx.remaining = math.MaxInt32
+	if statement.ExplicitLimit {
+		ctx.remaining = statement.Limit
+	}
+	payload, _ := statement.MarshalJSON()
 	for _, physicalPlan := range physicalPlans {
 		//FIXME:
 		physicalPlan.AddReceiver(ctx.Deps.CurrentNode.Indicator())
@@ -120,16 +175,347 @@ func (ctx *RootMetricContext) MakePlan() error {
 	return nil
 }
 
+// canStream checks if result chunk can be written when response received,
+// groups must be partitioned by intermediate nodes(or only one target node) and result is not ordered/selected/cached.
+func (ctx *RootMetricContext) canStream(physicalPlans []*models.PhysicalPlan) bool {
+	statement := ctx.Deps.Statement
+	if ctx.Deps.Writer == nil || ctx.cacheKey != "" || len(statement.OrderByItems) > 0 {
+		return false
+	}
+	if len(aggregation.NewSeriesSelectors(statement.SelectItems)) > 0 {
+		return false
+	}
+	var targets []*models.Target
+	for _, physicalPlan := range physicalPlans {
+		targets = append(targets, physicalPlan.Targets...)
+	}
+	if len(targets) == 1 {
+		return true
+	}
+	for _, target := range targets {
+		if len(target.ShardIDs) > 0 {
+			// storage node returns part of group
+			return false
+		}
+	}
+	return true
+}
+
+// HandleResponse handles metric data search task response, writes result chunk if streaming query.
+func (ctx *RootMetricContext) HandleResponse(resp *protoCommonV1.TaskResponse, fromNode string) {
+	ctx.handleResponse(resp, fromNode)
+	if ctx.streaming {
+		ctx.writeChunk()
+	}
+	ctx.tryClose()
+}
+
+// writeChunk makes the result chunk of received groups under lock, then writes it without lock,
+// so that slow writer doesn't block handling response of other nodes.
+func (ctx *RootMetricContext) writeChunk() {
+	ctx.mutex.Lock()
+	rs, err := ctx.makeChunk()
+	if err != nil {
+		ctx.setErr(err)
+	}
+	if rs == nil || err != nil {
+		ctx.mutex.Unlock()
+		return
+	}
+	ctx.writing.Add(1)
+	ctx.mutex.Unlock()
+
+	defer ctx.writing.Done()
+	if err := ctx.Deps.Writer.Write(rs); err != nil {
+		ctx.mutex.Lock()
+		ctx.setErr(err)
+		ctx.mutex.Unlock()
+	}
+}
+
+// setErr sets the first error of query, must be called under lock.
+func (ctx *RootMetricContext) setErr(err error) {
+	if ctx.err == nil {
+		ctx.err = err
+	}
+}
+
+// makeChunk makes the result chunk of received groups, then releases the grouping aggregator,
+// returns nil if no series can be written.
+func (ctx *RootMetricContext) makeChunk() (*commonmodels.ResultSet, error) {
+	if ctx.err != nil || ctx.groupAgg == nil {
+		return nil, nil
+	}
+	// stats is returned with the last chunk when all responses received
+	stats := ctx.stats
+	ctx.stats = nil
+	rs, err := ctx.makeResultSet()
+	ctx.stats = stats
+	ctx.groupAgg = nil
+	if err != nil {
+		return nil, err
+	}
+	if len(rs.Series) > ctx.remaining {
+		rs.Series = rs.Series[:ctx.remaining]
+	}
+	if len(rs.Series) == 0 {
+		return nil, nil
+	}
+	ctx.remaining -= len(rs.Series)
+	return rs, nil
+}
+
 // WaitResponse waits metric data search task completed, then returns the result set,
+// returns the result set without series for streaming query which series are written by writer.
 func (ctx *RootMetricContext) WaitResponse() (any, error) {
 	err := ctx.waitResponse()
 	if err != nil {
 		return nil, err
 	}
+	if ctx.streaming {
+		// wait the chunks written before the last chunk
+		ctx.writing.Wait()
+		ctx.mutex.Lock()
+		defer ctx.mutex.Unlock()
+		if ctx.err != nil {
+			return nil, ctx.err
+		}
+		return ctx.makeResultSet()
+	}
+	if ctx.Deps.Statement.HasOffset() {
+		return ctx.makeOffsetResultSet()
+	}
+	if ctx.Deps.Statement.HasSubQuery() {
+		return ctx.makeSubQueryResultSet()
+	}
+	if ctx.Deps.Statement.HasJoin() {
+		return ctx.makeJoinResultSet()
+	}
+	ctx.mergeResultCache()
+	if ctx.subQuery {
+		return ctx.makeGroupedResult()
+	}
 
 	return ctx.makeResultSet()
 }
 
+// makeOffsetResultSet executes the sub queries split by time offset concurrently,
+// then makes result set from the merged result which is aligned on query time range.
+func (ctx *RootMetricContext) makeOffsetResultSet() (*commonmodels.ResultSet, error) {
+	statement := ctx.Deps.Statement
+	var (
+		offsets []int64
+		queries []*stmt.Query
+	)
+	for offset, query := range statement.OffsetQueries() {
+		offsets = append(offsets, offset)
+		queries = append(queries, query)
+	}
+	results, err := ctx.execSubQueries(queries)
+	if err != nil {
+		return nil, err
+	}
+	var result *cache.Result
+	for idx, rs := range results {
+		// data of sub query is shifted back by offset, align it on query time range
+		rs = rs.Align(statement.TimeRange.Start, offsets[idx])
+		if result == nil {
+			result = rs
+		} else {
+			result = result.Merge(rs)
+		}
+	}
+	ctx.rebuildGroupingAgg(result)
+	return ctx.makeResultSet()
+}
+
+// makeJoinResultSet executes the sub query of each metric concurrently,
+// then makes result set from the result which is inner joined on group by tags and time.
+func (ctx *RootMetricContext) makeJoinResultSet() (*commonmodels.ResultSet, error) {
+	statement := ctx.Deps.Statement
+	joinQueries := statement.JoinQueries()
+	queries := make([]*stmt.Query, 0, len(statement.Joins))
+	for _, join := range statement.Joins {
+		queries = append(queries, joinQueries[join.Alias])
+	}
+	results, err := ctx.execSubQueries(queries)
+	if err != nil {
+		return nil, err
+	}
+	var result *cache.Result
+	for idx, rs := range results {
+		// qualify field name by metric alias, e.g. a.f
+		rs = rs.Qualify(statement.Joins[idx].Alias)
+		if result == nil {
+			result = rs
+		} else {
+			result = result.Join(rs)
+		}
+	}
+	ctx.rebuildGroupingAgg(result)
+	return ctx.makeResultSet()
+}
+
+// makeSubQueryResultSet executes the inner query through query pipeline,
+// then makes result set which aggregates the result set of inner query in memory.
+func (ctx *RootMetricContext) makeSubQueryResultSet() (*commonmodels.ResultSet, error) {
+	statement := ctx.Deps.Statement
+	req := models.NewRequest(ctx.Deps.Request.Entry, ctx.Deps.Request.DB, ctx.Deps.Request.SQL)
+	deps := *ctx.Deps
+	deps.Request = req
+	deps.Statement = statement.SubQuery
+	deps.Writer = nil
+	rs, err := ctx.Deps.ExecSubQuery(NewRootMetricContext(&deps), req)
+	if err != nil {
+		return nil, err
+	}
+	agg := aggregation.NewSubQueryAggregator(statement.SelectItems, statement.GroupBy, statement.Interval.Int64())
+	rows, err := agg.Aggregate(rs.(*commonmodels.ResultSet))
+	if err != nil {
+		return nil, err
+	}
+	ctx.timeRange = agg.TimeRange()
+	ctx.interval = agg.Interval()
+	ctx.subQueryRows = rows
+	return ctx.makeResultSet()
+}
+
+// execSubQueries executes the sub queries concurrently, returns the grouped results in order of queries.
+func (ctx *RootMetricContext) execSubQueries(queries []*stmt.Query) ([]*cache.Result, error) {
+	var (
+		wg      sync.WaitGroup
+		mutex   sync.Mutex
+		results = make([]*cache.Result, len(queries))
+		err     error
+	)
+	for idx, query := range queries {
+		wg.Add(1)
+		go func(idx int, query *stmt.Query) {
+			defer wg.Done()
+			rs, err0 := ctx.execSubQuery(query)
+			if err0 != nil {
+				mutex.Lock()
+				err = err0
+				mutex.Unlock()
+				return
+			}
+			results[idx] = rs
+		}(idx, query)
+	}
+	wg.Wait()
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// execSubQuery executes the sub query of time offset/cross metric query, returns the grouped result.
+func (ctx *RootMetricContext) execSubQuery(statement *stmt.Query) (*cache.Result, error) {
+	req := models.NewRequest(ctx.Deps.Request.Entry, ctx.Deps.Request.DB, ctx.Deps.Request.SQL)
+	deps := *ctx.Deps
+	deps.Request = req
+	deps.Statement = statement
+	deps.Writer = nil
+	subCtx := NewRootMetricContext(&deps)
+	subCtx.subQuery = true
+	rs, err := ctx.Deps.ExecSubQuery(subCtx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rs.(*cache.Result), nil
+}
+
+// makeGroupedResult makes the grouped result of sub query which is merged by time offset/cross metric query.
+func (ctx *RootMetricContext) makeGroupedResult() (*cache.Result, error) {
+	statement := ctx.Deps.Statement
+	result, ok := cache.NewResult(statement.TimeRange, statement.Interval.Int64(), ctx.aggregatorSpecs, ctx.groupAgg)
+	if !ok {
+		return nil, errSubQueryNotSupport
+	}
+	return result, nil
+}
+
+// lookupResultCache aligns query time range by interval, then finds cached history result,
+// returns true if whole query time range hit in cache.
+func (ctx *RootMetricContext) lookupResultCache(databaseCfg models.Database) bool {
+	statement := ctx.Deps.Statement
+	if ctx.Deps.ResultCache == nil || !cache.Cacheable(statement) {
+		return false
+	}
+	interval := statement.Interval.Int64()
+	statement.TimeRange.Start = timeutil.Truncate(statement.TimeRange.Start, interval)
+	statement.TimeRange.End = timeutil.Truncate(statement.TimeRange.End, interval)
+	// data in [now-behind, now] maybe changed by late data, cannot be cached
+	_, behind := databaseCfg.Option.GetAcceptWritableRange()
+	ctx.stableEnd = timeutil.Truncate(nowFn()-behind, interval)
+	ctx.cacheKey = cache.Key(ctx.Deps.Database, statement)
+	ctx.queryRange = statement.TimeRange
+
+	result, ok := ctx.Deps.ResultCache.Get(ctx.cacheKey, statement.TimeRange, interval)
+	if !ok {
+		return false
+	}
+	ctx.cachedResult = result
+	if result.End() > statement.TimeRange.End {
+		return true
+	}
+	ctx.queryRange.Start = result.End()
+	return false
+}
+
+// mergeResultCache merges the result of storage with cached history result,
+// then caches the stable part of result.
+func (ctx *RootMetricContext) mergeResultCache() {
+	if ctx.cacheKey == "" {
+		return
+	}
+	statement := ctx.Deps.Statement
+	interval := statement.Interval.Int64()
+	result := ctx.cachedResult
+	if result != nil && result.End() > statement.TimeRange.End {
+		// whole query time range hit in cache
+		ctx.rebuildGroupingAgg(result)
+		return
+	}
+	queryResult, ok := cache.NewResult(ctx.queryRange, interval, ctx.aggregatorSpecs, ctx.groupAgg)
+	if !ok {
+		return
+	}
+	if result == nil {
+		result = queryResult
+	} else {
+		result = result.Merge(queryResult)
+		ctx.rebuildGroupingAgg(result)
+	}
+	if ctx.stableEnd > statement.TimeRange.Start {
+		ctx.Deps.ResultCache.Put(ctx.cacheKey, result.Slice(statement.TimeRange.Start, ctx.stableEnd))
+	}
+}
+
+// rebuildGroupingAgg rebuilds the grouping aggregator with the whole query time range from result.
+func (ctx *RootMetricContext) rebuildGroupingAgg(result *cache.Result) {
+	timeRange := ctx.Deps.Statement.TimeRange
+	interval := result.Interval()
+	ctx.aggregatorSpecs = result.AggregatorSpecs()
+	ctx.timeRange = timeRange
+	ctx.interval = interval
+	ctx.groupAgg = nil
+	if len(ctx.aggregatorSpecs) == 0 {
+		return
+	}
+	specs := make([]*protoCommonV1.AggregatorSpec, 0, len(ctx.aggregatorSpecs))
+	for _, spec := range ctx.aggregatorSpecs {
+		specs = append(specs, spec)
+	}
+	sort.Slice(specs, func(i, j int) bool {
+		return specs[i].FieldName < specs[j].FieldName
+	})
+	ctx.groupAgg = newGroupingAgg(timeutil.Interval(interval), 1, timeRange, newAggregatorSpecs(specs))
+	for _, it := range result.Slice(timeRange.Start, timeRange.End+interval).GroupedIterators(timeRange.Start) {
+		ctx.groupAgg.Aggregate(it)
+	}
+}
+
 // makeResultSet makes final result set from time series event(GroupedIterators).
 // TODO: can opt use stream, leaf node need return grouping if completed.
 func (ctx *RootMetricContext) makeResultSet() (resultSet *commonmodels.ResultSet, err error) {
@@ -142,30 +528,43 @@ func (ctx *RootMetricContext) makeResultSet() (resultSet *commonmodels.ResultSet
 	statement := ctx.Deps.Statement
 	resultSet = new(commonmodels.ResultSet)
 	// TODO: merge stats for cross idc query?
-	groupByKeys := statement.GroupBy
+	groupByKeys := statement.GroupByKeys()
 	groupByKeysLength := len(groupByKeys)
 	fieldsMap := make(map[string]struct{})
 	timeRange := ctx.timeRange
 	interval := ctx.interval
-	if ctx.groupAgg != nil {
-		groupIts := ctx.groupAgg.ResultSet()
-		selectItems := ctx.getSelectItems()
-		for _, it := range groupIts {
-			// TODO: reuse expression??
-			expression := newExpressionFn(
-				timeRange,
-				interval,
-				selectItems,
-			)
-			// do expression eval
-			expression.Eval(it)
-
-			// result order by/limit
-			orderBy.Push(aggregation.NewOrderByRow(it.Tags(), expression.ResultSet()))
+	if ctx.groupAgg != nil || statement.HasSubQuery() {
+		selectItems := statement.SelectItems
+		rows := ctx.subQueryRows
+		if ctx.groupAgg != nil {
+			groupIts := ctx.groupAgg.ResultSet()
+			selectItems = ctx.getSelectItems()
+			for _, it := range groupIts {
+				// TODO: reuse expression??
+				expression := newExpressionFn(
+					timeRange,
+					interval,
+					selectItems,
+				)
+				// do expression eval
+				expression.Eval(it)
+				rows = append(rows, aggregation.NewOrderByRow(it.Tags(), expression.ResultSet()))
+			}
 		}
-
-		rows := orderBy.ResultSet()
+		if selectors := aggregation.NewSeriesSelectors(selectItems); len(selectors) > 0 {
+			// top/bottom need select series based on all series
+			othersTagValues := make([]string, groupByKeysLength)
+			for idx := range othersTagValues {
+				othersTagValues[idx] = stmt.OthersOption
+			}
+			rows = aggregation.SelectSeries(rows, selectors, tag.ConcatTagValues(othersTagValues))
+		}
+		// result order by/limit
 		for _, row := range rows {
+			orderBy.Push(row)
+		}
+
+		for _, row := range orderBy.ResultSet() {
 			var tags map[string]string
 			tagValues, fields := row.ResultSet()
 			if groupByKeysLength > 0 {
@@ -183,6 +582,13 @@ func (ctx *RootMetricContext) makeResultSet() (resultSet *commonmodels.ResultSet
 			timeSeries := commonmodels.NewSeries(tags, tagValues)
 			resultSet.AddSeries(timeSeries)
 
+			if statement.Fill != stmt.NoFill {
+				// fill empty slots after down sampling/merge
+				for _, values := range fields {
+					aggregation.Fill(values, statement.Fill, statement.FillValue)
+				}
+			}
+
 			having := ctx.Deps.Statement.Having
 			notHavingSlots := make(map[int]struct{})
 			slotValues := make(map[int]map[string]float64)
@@ -251,7 +657,7 @@ func (ctx *RootMetricContext) makeResultSet() (resultSet *commonmodels.ResultSet
 	})
 
 	resultSet.MetricName = statement.MetricName
-	resultSet.GroupBy = statement.GroupBy
+	resultSet.GroupBy = groupByKeys
 	for fName := range fieldsMap {
 		resultSet.Fields = append(resultSet.Fields, fName)
 	}
@@ -21,7 +21,9 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
@@ -33,11 +35,16 @@ import (
 	"github.com/lindb/lindb/aggregation/function"
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/coordinator/broker"
+	"github.com/lindb/lindb/flow"
+	"github.com/lindb/lindb/internal/linmetric"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/collections"
 	"github.com/lindb/lindb/pkg/option"
+	"github.com/lindb/lindb/pkg/stream"
 	"github.com/lindb/lindb/pkg/timeutil"
 	protoCommonV1 "github.com/lindb/lindb/proto/gen/v1/common"
+	"github.com/lindb/lindb/query/cache"
+	"github.com/lindb/lindb/query/tracker"
 	"github.com/lindb/lindb/series"
 	"github.com/lindb/lindb/series/field"
 	"github.com/lindb/lindb/sql/stmt"
@@ -148,6 +155,240 @@ func TestRootMetricDataContext_MakePlan(t *testing.T) {
 			}
 		})
 	}
+	// invalid group by tag function
+	metricCtx = NewRootMetricContext(&RootMetricContextDeps{
+		Ctx:     context.TODO(),
+		Choose:  stateMgr,
+		Request: &models.Request{},
+		Statement: &stmt.Query{
+			GroupBy:     []string{"ip"},
+			GroupByTags: []*stmt.GroupByTag{{FuncType: stmt.RegexpExtract, TagKeys: []string{"ip"}, Args: []string{"(a"}, Alias: "a"}},
+		},
+	})
+	assert.Error(t, metricCtx.MakePlan())
+}
+
+func TestRootMetricContext_ResultCache(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	now := commontimeutil.Now()
+	defer func() {
+		nowFn = commontimeutil.Now
+		ctrl.Finish()
+	}()
+	nowFn = func() int64 {
+		return now
+	}
+
+	interval := 10 * commontimeutil.OneSecond
+	end := timeutil.Truncate(now, interval)
+	start := end - 30*commontimeutil.OneMinute
+	stableEnd := timeutil.Truncate(now-10*commontimeutil.OneMinute, interval)
+	cfg := models.Database{
+		Option: &option.DatabaseOption{
+			Intervals: option.Intervals{{Interval: timeutil.Interval(interval)}},
+			Behind:    "10m",
+		},
+	}
+	stateMgr := broker.NewMockStateManager(ctrl)
+	stateMgr.EXPECT().Choose(gomock.Any(), gomock.Any()).DoAndReturn(func(_ string, _ int) ([]*models.PhysicalPlan, error) {
+		return []*models.PhysicalPlan{{Database: "test", Targets: []*models.Target{{}}}}, nil
+	}).AnyTimes()
+	stateMgr.EXPECT().GetDatabaseCfg(gomock.Any()).Return(cfg, true).AnyTimes()
+	resultCache := cache.NewResultCache(1024*1024, time.Minute, linmetric.BrokerRegistry)
+
+	query := func(timeRange timeutil.TimeRange, tailRange *timeutil.TimeRange, values map[int64]float64) map[int64]float64 {
+		metricCtx := NewRootMetricContext(&RootMetricContextDeps{
+			Ctx:      context.TODO(),
+			Database: "test",
+			Request:  &models.Request{},
+			Choose:   stateMgr,
+			Statement: &stmt.Query{
+				MetricName:  "cpu",
+				SelectItems: []stmt.Expr{&stmt.SelectItem{Expr: &stmt.FieldExpr{Name: "f"}}},
+				GroupBy:     []string{"host"},
+				Limit:       10,
+				TimeRange:   timeRange,
+			},
+			ResultCache: resultCache,
+		})
+		metricCtx.SetTracker(tracker.NewStageTracker(flow.NewTaskContextWithTimeout(context.TODO(), time.Minute)))
+		assert.NoError(t, metricCtx.MakePlan())
+		requests := metricCtx.GetRequests()
+		if tailRange == nil {
+			// hit in cache
+			assert.Empty(t, requests)
+			metricCtx.Complete(nil)
+		} else {
+			assert.Len(t, requests, 1)
+			for _, req := range requests {
+				q := &stmt.Query{}
+				assert.NoError(t, q.UnmarshalJSON(req.Payload))
+				assert.Equal(t, *tailRange, q.TimeRange)
+			}
+			metricCtx.HandleResponse(&protoCommonV1.TaskResponse{
+				Payload: newTimeSeriesPayload(t, "host1", *tailRange, interval, values),
+			}, "leaf")
+		}
+		rs, err := metricCtx.WaitResponse()
+		assert.NoError(t, err)
+		resultSet := rs.(*commonmodels.ResultSet)
+		assert.Equal(t, timeRange.Start, resultSet.StartTime)
+		if len(resultSet.Series) == 0 {
+			return nil
+		}
+		return resultSet.Series[0].Fields["f"]
+	}
+
+	// cache miss, query whole time range
+	timeRange := timeutil.TimeRange{Start: start, End: end}
+	points := query(timeRange, &timeRange, map[int64]float64{
+		start:                              1,
+		start + 5*commontimeutil.OneMinute: 2,
+		end - 15*commontimeutil.OneMinute:  3,
+		end:                                4,
+	})
+	assert.Len(t, points, 4)
+	// sliding window, only query the tail time range which not stable
+	timeRange = timeutil.TimeRange{Start: start + commontimeutil.OneMinute, End: end + commontimeutil.OneMinute}
+	points = query(timeRange, &timeutil.TimeRange{Start: stableEnd, End: end + commontimeutil.OneMinute},
+		map[int64]float64{end: 5, end + commontimeutil.OneMinute: 6})
+	assert.Equal(t, map[int64]float64{
+		start + 5*commontimeutil.OneMinute: 2,
+		end - 15*commontimeutil.OneMinute:  3,
+		end:                                5,
+		end + commontimeutil.OneMinute:     6,
+	}, points)
+	// whole time range hit in cache
+	timeRange = timeutil.TimeRange{Start: start + commontimeutil.OneMinute, End: start + 10*commontimeutil.OneMinute}
+	points = query(timeRange, nil, nil)
+	assert.Equal(t, map[int64]float64{start + 5*commontimeutil.OneMinute: 2}, points)
+	// query time range before cached result
+	timeRange = timeutil.TimeRange{Start: start, End: end}
+	points = query(timeRange, &timeRange, map[int64]float64{end: 7})
+	assert.Equal(t, map[int64]float64{end: 7}, points)
+}
+
+func TestRootMetricContext_Offset(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	interval := 10 * commontimeutil.OneSecond
+	end := timeutil.Truncate(commontimeutil.Now(), interval)
+	start := end - 30*commontimeutil.OneMinute
+	cfg := models.Database{
+		Option: &option.DatabaseOption{
+			Intervals: option.Intervals{{Interval: timeutil.Interval(interval)}},
+		},
+	}
+	stateMgr := broker.NewMockStateManager(ctrl)
+	stateMgr.EXPECT().Choose(gomock.Any(), gomock.Any()).Return([]*models.PhysicalPlan{{Database: "test", Targets: []*models.Target{{}}}}, nil).AnyTimes()
+	stateMgr.EXPECT().GetDatabaseCfg(gomock.Any()).Return(cfg, true).AnyTimes()
+	values := map[int64]map[int64]float64{
+		0:                      {start: 2, start + commontimeutil.OneMinute: 4},
+		commontimeutil.OneHour: {start - commontimeutil.OneHour: 1, start + commontimeutil.OneMinute - commontimeutil.OneHour: 2},
+	}
+	newCtx := func(execSubQuery func(ctx TaskContext, req *models.Request) (any, error)) *RootMetricContext {
+		metricCtx := NewRootMetricContext(&RootMetricContextDeps{
+			Ctx:      context.TODO(),
+			Database: "test",
+			Request:  &models.Request{},
+			Choose:   stateMgr,
+			Statement: &stmt.Query{
+				MetricName: "cpu",
+				SelectItems: []stmt.Expr{&stmt.SelectItem{Expr: &stmt.BinaryExpr{
+					Left:     &stmt.CallExpr{FuncType: function.Sum, Params: []stmt.Expr{&stmt.FieldExpr{Name: "f"}}},
+					Right:    &stmt.CallExpr{FuncType: function.Sum, Params: []stmt.Expr{&stmt.FieldExpr{Name: "f"}}, Offset: commontimeutil.OneHour},
+					Operator: stmt.DIV,
+				}}},
+				GroupBy:   []string{"host"},
+				Limit:     10,
+				TimeRange: timeutil.TimeRange{Start: start, End: end},
+			},
+			ExecSubQuery: execSubQuery,
+		})
+		metricCtx.SetTracker(tracker.NewStageTracker(flow.NewTaskContextWithTimeout(context.TODO(), time.Minute)))
+		assert.NoError(t, metricCtx.MakePlan())
+		// sub queries are executed when waiting response
+		assert.Empty(t, metricCtx.GetRequests())
+		metricCtx.Complete(nil)
+		return metricCtx
+	}
+
+	t.Run("merge sub queries", func(t *testing.T) {
+		metricCtx := newCtx(func(ctx TaskContext, _ *models.Request) (any, error) {
+			subCtx := ctx.(*RootMetricContext)
+			subCtx.SetTracker(tracker.NewStageTracker(flow.NewTaskContextWithTimeout(context.TODO(), time.Minute)))
+			assert.NoError(t, subCtx.MakePlan())
+			requests := subCtx.GetRequests()
+			assert.Len(t, requests, 1)
+			q := &stmt.Query{}
+			for _, req := range requests {
+				assert.NoError(t, q.UnmarshalJSON(req.Payload))
+			}
+			assert.False(t, q.HasOffset())
+			offset := start - q.TimeRange.Start
+			subCtx.HandleResponse(&protoCommonV1.TaskResponse{
+				Payload: newTimeSeriesPayload(t, "host1", q.TimeRange, interval, values[offset]),
+			}, "leaf")
+			return subCtx.WaitResponse()
+		})
+		rs, err := metricCtx.WaitResponse()
+		assert.NoError(t, err)
+		resultSet := rs.(*commonmodels.ResultSet)
+		assert.Len(t, resultSet.Series, 1)
+		assert.Equal(t, map[int64]float64{start: 2, start + commontimeutil.OneMinute: 2},
+			resultSet.Series[0].Fields["sum(f)/sum(f) offset 1h"])
+	})
+	t.Run("sub query failure", func(t *testing.T) {
+		metricCtx := newCtx(func(_ TaskContext, _ *models.Request) (any, error) {
+			return nil, fmt.Errorf("err")
+		})
+		rs, err := metricCtx.WaitResponse()
+		assert.Error(t, err)
+		assert.Nil(t, rs)
+	})
+	t.Run("native histogram not support", func(t *testing.T) {
+		metricCtx := NewRootMetricContext(&RootMetricContextDeps{
+			Statement: &stmt.Query{Interval: timeutil.Interval(interval)},
+		})
+		metricCtx.aggregatorSpecs["h"] = &protoCommonV1.AggregatorSpec{FieldName: "h", FieldType: uint32(field.NativeHistogramField)}
+		rs, err := metricCtx.makeGroupedResult()
+		assert.ErrorIs(t, err, errSubQueryNotSupport)
+		assert.Nil(t, rs)
+	})
+}
+
+// newTimeSeriesPayload builds the time series list payload of sum field for testing.
+func newTimeSeriesPayload(t *testing.T, tags string, timeRange timeutil.TimeRange, interval int64, values map[int64]float64) []byte {
+	aggSpec := aggregation.NewAggregatorSpec("f", field.SumField)
+	aggSpec.AddFunctionType(function.Sum)
+	fieldAgg := aggregation.NewFieldAggregator(aggSpec, timeRange.Start, 0, int((timeRange.End-timeRange.Start)/interval))
+	for timestamp, value := range values {
+		fieldAgg.AggregateBySlot(int((timestamp-timeRange.Start)/interval), value)
+	}
+	_, fieldIt := fieldAgg.ResultSet()
+	data, err := fieldIt.MarshalBinary()
+	assert.NoError(t, err)
+	writer := stream.NewBufferWriter(nil)
+	writer.PutByte(byte(field.SumField))
+	writer.PutVarint64(timeRange.Start)
+	writer.PutVarint32(int32(len(data)))
+	writer.PutBytes(data)
+	fieldData, err := writer.Bytes()
+	assert.NoError(t, err)
+	payload, err := (&protoCommonV1.TimeSeriesList{
+		Start:    timeRange.Start,
+		End:      timeRange.End,
+		Interval: interval,
+		FieldAggSpecs: []*protoCommonV1.AggregatorSpec{{
+			FieldName:    "f",
+			FieldType:    uint32(field.SumField),
+			FuncTypeList: []uint32{uint32(function.Sum)},
+		}},
+		TimeSeriesList: []*protoCommonV1.TimeSeries{{Tags: tags, Fields: map[string][]byte{"f": fieldData}}},
+	}).Marshal()
+	assert.NoError(t, err)
+	return payload
 }
 
 func TestRootMetricDataContext_makeResultSet(t *testing.T) {
@@ -284,6 +525,114 @@ func TestRootMetricDataContext_makeResultSet(t *testing.T) {
 				assert.NoError(t, err)
 			},
 		},
+		{
+			name: "build result set with fill",
+			prepare: func(ctx *RootMetricContext) {
+				ctx.Deps.Statement.GroupBy = []string{"a"}
+				ctx.Deps.Statement.Fill = stmt.LinearFill
+				ctx.timeRange = timeutil.TimeRange{Start: 0, End: 40}
+				ctx.interval = 10
+				ctx.groupAgg = groupAgg
+				groupIt := series.NewMockGroupedIterator(ctrl)
+				groupAgg.EXPECT().ResultSet().Return(series.GroupedIterators{groupIt})
+				expr.EXPECT().Eval(gomock.Any())
+				groupIt.EXPECT().Tags().Return("tags")
+				expr.EXPECT().ResultSet().Return(map[string]*collections.FloatArray{"f": collections.NewFloatArray(5)})
+				orderBy.EXPECT().Push(gomock.Any())
+				row := aggregation.NewMockRow(ctrl)
+				values := collections.NewFloatArray(5)
+				values.SetValue(1, 1)
+				values.SetValue(2, math.NaN())
+				values.SetValue(4, 4)
+				row.EXPECT().ResultSet().Return("a", map[string]*collections.FloatArray{"f": values})
+				orderBy.EXPECT().ResultSet().Return([]aggregation.Row{row})
+			},
+			assert: func(rs *commonmodels.ResultSet, err error) {
+				assert.NoError(t, err)
+				assert.Len(t, rs.Series, 1)
+				assert.Equal(t, map[int64]float64{10: 1, 20: 2, 30: 3, 40: 4}, rs.Series[0].Fields["f"])
+			},
+		},
+		{
+			name: "build result set with group by tag function",
+			prepare: func(ctx *RootMetricContext) {
+				ctx.Deps.Statement.GroupBy = []string{"host"}
+				ctx.Deps.Statement.GroupByTags = []*stmt.GroupByTag{
+					{FuncType: stmt.RegexpExtract, TagKeys: []string{"host"}, Args: []string{"a"}, Alias: "dc"},
+				}
+				ctx.timeRange = timeutil.TimeRange{Start: 0, End: 10}
+				ctx.interval = 10
+				ctx.groupAgg = groupAgg
+				groupIt := series.NewMockGroupedIterator(ctrl)
+				groupAgg.EXPECT().ResultSet().Return(series.GroupedIterators{groupIt})
+				expr.EXPECT().Eval(gomock.Any())
+				groupIt.EXPECT().Tags().Return("dc1")
+				expr.EXPECT().ResultSet().Return(nil)
+				row := aggregation.NewOrderByRow("dc1", nil)
+				orderBy.EXPECT().Push(gomock.Any())
+				orderBy.EXPECT().ResultSet().Return([]aggregation.Row{row})
+			},
+			assert: func(rs *commonmodels.ResultSet, err error) {
+				assert.NoError(t, err)
+				assert.Len(t, rs.Series, 1)
+				assert.Equal(t, []string{"dc"}, rs.GroupBy)
+				assert.Equal(t, map[string]string{"dc": "dc1"}, rs.Series[0].Tags)
+			},
+		},
+		{
+			name: "build result set with top series",
+			prepare: func(ctx *RootMetricContext) {
+				ctx.Deps.Statement.GroupBy = []string{"a"}
+				ctx.Deps.Statement.SelectItems = []stmt.Expr{&stmt.SelectItem{
+					Expr: &stmt.CallExpr{
+						FuncType: function.Top,
+						Params:   []stmt.Expr{&stmt.NumberLiteral{Val: 1}, &stmt.FieldExpr{Name: "f"}},
+						Others:   true,
+					},
+					Alias: "f",
+				}}
+				ctx.timeRange = timeutil.TimeRange{Start: 0, End: 10}
+				ctx.interval = 10
+				ctx.groupAgg = groupAgg
+				groupIt1 := series.NewMockGroupedIterator(ctrl)
+				groupIt2 := series.NewMockGroupedIterator(ctrl)
+				groupIt3 := series.NewMockGroupedIterator(ctrl)
+				groupAgg.EXPECT().ResultSet().Return(series.GroupedIterators{groupIt1, groupIt2, groupIt3})
+				expr.EXPECT().Eval(gomock.Any()).Times(3)
+				groupIt1.EXPECT().Tags().Return("a1")
+				groupIt2.EXPECT().Tags().Return("a2")
+				groupIt3.EXPECT().Tags().Return("a3")
+				newValues := func(values ...float64) map[string]*collections.FloatArray {
+					arr := collections.NewFloatArray(len(values))
+					for idx, val := range values {
+						arr.SetValue(idx, val)
+					}
+					return map[string]*collections.FloatArray{"f": arr}
+				}
+				gomock.InOrder(
+					expr.EXPECT().ResultSet().Return(newValues(1, 10)),
+					expr.EXPECT().ResultSet().Return(newValues(5, 2)),
+					expr.EXPECT().ResultSet().Return(newValues(2, 3)),
+				)
+				var rows []aggregation.Row
+				orderBy.EXPECT().Push(gomock.Any()).DoAndReturn(func(row aggregation.Row) {
+					rows = append(rows, row)
+				}).AnyTimes()
+				orderBy.EXPECT().ResultSet().DoAndReturn(func() []aggregation.Row {
+					return rows
+				})
+			},
+			assert: func(rs *commonmodels.ResultSet, err error) {
+				assert.NoError(t, err)
+				assert.Len(t, rs.Series, 3)
+				assert.Equal(t, map[string]string{"a": "a1"}, rs.Series[0].Tags)
+				assert.Equal(t, map[int64]float64{10: 10}, rs.Series[0].Fields["f"])
+				assert.Equal(t, map[string]string{"a": "a2"}, rs.Series[1].Tags)
+				assert.Equal(t, map[int64]float64{0: 5}, rs.Series[1].Fields["f"])
+				assert.Equal(t, map[string]string{"a": "others"}, rs.Series[2].Tags)
+				assert.Equal(t, map[int64]float64{0: 3, 10: 5}, rs.Series[2].Fields["f"])
+			},
+		},
 	}
 
 	for _, tt := range cases {
@@ -308,3 +657,339 @@ func TestRootMetricDataContext_makeResultSet(t *testing.T) {
 		})
 	}
 }
+
+func TestRootMetricContext_Join(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	interval := 10 * commontimeutil.OneSecond
+	end := timeutil.Truncate(commontimeutil.Now(), interval)
+	start := end - 30*commontimeutil.OneMinute
+	cfg := models.Database{
+		Option: &option.DatabaseOption{
+			Intervals: option.Intervals{{Interval: timeutil.Interval(interval)}},
+		},
+	}
+	stateMgr := broker.NewMockStateManager(ctrl)
+	stateMgr.EXPECT().Choose(gomock.Any(), gomock.Any()).Return([]*models.PhysicalPlan{{Database: "test", Targets: []*models.Target{{}}}}, nil).AnyTimes()
+	stateMgr.EXPECT().GetDatabaseCfg(gomock.Any()).Return(cfg, true).AnyTimes()
+	values := map[string]map[int64]float64{
+		"http_errors":   {start: 2, start + commontimeutil.OneMinute: 4},
+		"http_requests": {start: 10, start + 2*commontimeutil.OneMinute: 20},
+	}
+	newCtx := func(execSubQuery func(ctx TaskContext, req *models.Request) (any, error)) *RootMetricContext {
+		metricCtx := NewRootMetricContext(&RootMetricContextDeps{
+			Ctx:      context.TODO(),
+			Database: "test",
+			Request:  &models.Request{},
+			Choose:   stateMgr,
+			Statement: &stmt.Query{
+				MetricName: "http_errors,http_requests",
+				Joins: []*stmt.JoinMetric{
+					{MetricName: "http_errors", Alias: "a"},
+					{MetricName: "http_requests", Alias: "b"},
+				},
+				SelectItems: []stmt.Expr{&stmt.SelectItem{Expr: &stmt.BinaryExpr{
+					Left:     &stmt.CallExpr{FuncType: function.Sum, Params: []stmt.Expr{&stmt.FieldExpr{Name: "a.f"}}},
+					Right:    &stmt.CallExpr{FuncType: function.Sum, Params: []stmt.Expr{&stmt.FieldExpr{Name: "b.f"}}},
+					Operator: stmt.DIV,
+				}, Alias: "ratio"}},
+				GroupBy:   []string{"host"},
+				Limit:     10,
+				TimeRange: timeutil.TimeRange{Start: start, End: end},
+			},
+			ExecSubQuery: execSubQuery,
+		})
+		metricCtx.SetTracker(tracker.NewStageTracker(flow.NewTaskContextWithTimeout(context.TODO(), time.Minute)))
+		assert.NoError(t, metricCtx.MakePlan())
+		// sub queries are executed when waiting response
+		assert.Empty(t, metricCtx.GetRequests())
+		metricCtx.Complete(nil)
+		return metricCtx
+	}
+
+	t.Run("join sub queries", func(t *testing.T) {
+		metricCtx := newCtx(func(ctx TaskContext, _ *models.Request) (any, error) {
+			subCtx := ctx.(*RootMetricContext)
+			subCtx.SetTracker(tracker.NewStageTracker(flow.NewTaskContextWithTimeout(context.TODO(), time.Minute)))
+			assert.NoError(t, subCtx.MakePlan())
+			requests := subCtx.GetRequests()
+			assert.Len(t, requests, 1)
+			q := &stmt.Query{}
+			for _, req := range requests {
+				assert.NoError(t, q.UnmarshalJSON(req.Payload))
+			}
+			assert.False(t, q.HasJoin())
+			assert.Equal(t, []stmt.Expr{&stmt.SelectItem{Expr: &stmt.CallExpr{
+				FuncType: function.Sum,
+				Params:   []stmt.Expr{&stmt.FieldExpr{Name: "f"}},
+			}}}, q.SelectItems)
+			subCtx.HandleResponse(&protoCommonV1.TaskResponse{
+				Payload: newTimeSeriesPayload(t, "host1", q.TimeRange, interval, values[q.MetricName]),
+			}, "leaf")
+			return subCtx.WaitResponse()
+		})
+		rs, err := metricCtx.WaitResponse()
+		assert.NoError(t, err)
+		resultSet := rs.(*commonmodels.ResultSet)
+		assert.Len(t, resultSet.Series, 1)
+		// inner join on time, only keeps the time which both metrics have value
+		assert.Equal(t, map[int64]float64{start: 0.2}, resultSet.Series[0].Fields["ratio"])
+	})
+	t.Run("sub query failure", func(t *testing.T) {
+		metricCtx := newCtx(func(_ TaskContext, _ *models.Request) (any, error) {
+			return nil, fmt.Errorf("err")
+		})
+		rs, err := metricCtx.WaitResponse()
+		assert.Error(t, err)
+		assert.Nil(t, rs)
+	})
+}
+
+func TestRootMetricContext_SubQuery(t *testing.T) {
+	inner := &stmt.Query{
+		MetricName: "system",
+		SelectItems: []stmt.Expr{&stmt.SelectItem{
+			Expr:  &stmt.CallExpr{FuncType: function.Avg, Params: []stmt.Expr{&stmt.FieldExpr{Name: "cpu"}}},
+			Alias: "v",
+		}},
+		GroupBy: []string{"host", "region"},
+	}
+	newCtx := func(execSubQuery func(ctx TaskContext, req *models.Request) (any, error)) *RootMetricContext {
+		metricCtx := NewRootMetricContext(&RootMetricContextDeps{
+			Ctx:     context.TODO(),
+			Request: &models.Request{},
+			Statement: &stmt.Query{
+				MetricName: "system",
+				SelectItems: []stmt.Expr{&stmt.SelectItem{
+					Expr: &stmt.CallExpr{FuncType: function.Max, Params: []stmt.Expr{&stmt.FieldExpr{Name: "v"}}},
+				}},
+				GroupBy:  []string{"region"},
+				Interval: timeutil.Interval(20),
+				Limit:    10,
+				SubQuery: inner,
+			},
+			ExecSubQuery: execSubQuery,
+		})
+		metricCtx.SetTracker(tracker.NewStageTracker(flow.NewTaskContextWithTimeout(context.TODO(), time.Minute)))
+		// inner query is executed when waiting response
+		assert.NoError(t, metricCtx.MakePlan())
+		assert.Empty(t, metricCtx.GetRequests())
+		metricCtx.Complete(nil)
+		return metricCtx
+	}
+	newSeries := func(host, region string, points map[int64]float64) *commonmodels.Series {
+		series := commonmodels.NewSeries(map[string]string{"host": host, "region": region}, host+","+region)
+		series.Fields["v"] = points
+		return series
+	}
+
+	t.Run("aggregate inner query result", func(t *testing.T) {
+		metricCtx := newCtx(func(ctx TaskContext, _ *models.Request) (any, error) {
+			assert.Equal(t, inner, ctx.(*RootMetricContext).Deps.Statement)
+			return &commonmodels.ResultSet{
+				Series: []*commonmodels.Series{
+					newSeries("h1", "sh", map[int64]float64{0: 1, 10: 5, 20: 2}),
+					newSeries("h2", "sh", map[int64]float64{0: 3, 30: 9}),
+					newSeries("h3", "bj", map[int64]float64{20: 4}),
+				},
+				StartTime: 0,
+				EndTime:   30,
+				Interval:  10,
+			}, nil
+		})
+		rs, err := metricCtx.WaitResponse()
+		assert.NoError(t, err)
+		resultSet := rs.(*commonmodels.ResultSet)
+		assert.Equal(t, "system", resultSet.MetricName)
+		assert.Equal(t, int64(20), resultSet.Interval)
+		assert.Len(t, resultSet.Series, 2)
+		assert.Equal(t, map[string]string{"region": "bj"}, resultSet.Series[0].Tags)
+		assert.Equal(t, map[int64]float64{20: 4}, resultSet.Series[0].Fields["max(v)"])
+		assert.Equal(t, map[string]string{"region": "sh"}, resultSet.Series[1].Tags)
+		assert.Equal(t, map[int64]float64{0: 5, 20: 9}, resultSet.Series[1].Fields["max(v)"])
+	})
+	t.Run("inner query failure", func(t *testing.T) {
+		metricCtx := newCtx(func(_ TaskContext, _ *models.Request) (any, error) {
+			return nil, fmt.Errorf("err")
+		})
+		rs, err := metricCtx.WaitResponse()
+		assert.Error(t, err)
+		assert.Nil(t, rs)
+	})
+	t.Run("aggregate failure", func(t *testing.T) {
+		metricCtx := newCtx(func(_ TaskContext, _ *models.Request) (any, error) {
+			return &commonmodels.ResultSet{Interval: 10, Series: []*commonmodels.Series{
+				commonmodels.NewSeries(map[string]string{"host": "h1"}, "h1"),
+			}}, nil
+		})
+		rs, err := metricCtx.WaitResponse()
+		assert.Error(t, err)
+		assert.Nil(t, rs)
+	})
+}
+
+// resultWriter records the chunks of streaming query for testing.
+type resultWriter struct {
+	chunks []*commonmodels.ResultSet
+	err    error
+}
+
+func (w *resultWriter) Write(rs *commonmodels.ResultSet) error {
+	w.chunks = append(w.chunks, rs)
+	return w.err
+}
+
+// blockingResultWriter blocks the first chunk until released for testing.
+type blockingResultWriter struct {
+	started chan struct{}
+	release chan struct{}
+	written atomic.Int32
+}
+
+func (w *blockingResultWriter) Write(_ *commonmodels.ResultSet) error {
+	if w.written.Add(1) == 1 {
+		close(w.started)
+		<-w.release
+	}
+	return nil
+}
+
+func TestRootMetricContext_Stream(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	interval := 10 * commontimeutil.OneSecond
+	timeRange := timeutil.TimeRange{Start: 0, End: 3 * interval}
+	cfg := models.Database{
+		Option: &option.DatabaseOption{Intervals: option.Intervals{{Interval: timeutil.Interval(interval)}}},
+	}
+	computeTargets := []*models.Target{{Indicator: "b1"}, {Indicator: "b2", ReceiveOnly: true}}
+	newCtx := func(targets []*models.Target, writer ResultWriter, statement *stmt.Query) *RootMetricContext {
+		stateMgr := broker.NewMockStateManager(ctrl)
+		stateMgr.EXPECT().Choose(gomock.Any(), gomock.Any()).
+			Return([]*models.PhysicalPlan{{Database: "test", Targets: targets}}, nil)
+		stateMgr.EXPECT().GetDatabaseCfg(gomock.Any()).Return(cfg, true)
+		statement.MetricName = "cpu"
+		statement.SelectItems = []stmt.Expr{&stmt.SelectItem{Expr: &stmt.FieldExpr{Name: "f"}}}
+		statement.GroupBy = []string{"host"}
+		statement.TimeRange = timeRange
+		statement.Interval = timeutil.Interval(interval)
+		metricCtx := NewRootMetricContext(&RootMetricContextDeps{
+			Ctx:         context.TODO(),
+			Database:    "test",
+			Request:     &models.Request{},
+			CurrentNode: models.StatelessNode{HostIP: "127.0.0.1", GRPCPort: 9000},
+			Choose:      stateMgr,
+			Statement:   statement,
+			Writer:      writer,
+		})
+		metricCtx.SetTracker(tracker.NewStageTracker(flow.NewTaskContextWithTimeout(context.TODO(), time.Minute)))
+		assert.NoError(t, metricCtx.MakePlan())
+		return metricCtx
+	}
+	handleResponse := func(metricCtx *RootMetricContext, node, tags string, value float64) {
+		metricCtx.HandleResponse(&protoCommonV1.TaskResponse{
+			Payload:   newTimeSeriesPayload(t, tags, timeRange, interval, map[int64]float64{interval: value}),
+			Completed: true,
+		}, node)
+	}
+
+	t.Run("write chunk when response received", func(t *testing.T) {
+		writer := &resultWriter{}
+		metricCtx := newCtx(computeTargets, writer, &stmt.Query{Limit: 10})
+		assert.True(t, metricCtx.streaming)
+		handleResponse(metricCtx, "b1", "host1", 1)
+		assert.Len(t, writer.chunks, 1)
+		assert.Equal(t, "cpu", writer.chunks[0].MetricName)
+		assert.Equal(t, map[string]string{"host": "host1"}, writer.chunks[0].Series[0].Tags)
+		assert.Equal(t, map[int64]float64{interval: 1}, writer.chunks[0].Series[0].Fields["f"])
+		assert.Nil(t, metricCtx.groupAgg)
+		handleResponse(metricCtx, "b2", "host2", 2)
+		assert.Len(t, writer.chunks, 2)
+		assert.Equal(t, map[string]string{"host": "host2"}, writer.chunks[1].Series[0].Tags)
+
+		rs, err := metricCtx.WaitResponse()
+		assert.NoError(t, err)
+		resultSet := rs.(*commonmodels.ResultSet)
+		assert.Empty(t, resultSet.Series)
+		assert.Equal(t, []string{"host"}, resultSet.GroupBy)
+	})
+	t.Run("limit series of chunks", func(t *testing.T) {
+		writer := &resultWriter{}
+		metricCtx := newCtx(computeTargets, writer, &stmt.Query{Limit: 1, ExplicitLimit: true})
+		handleResponse(metricCtx, "b1", "host1", 1)
+		handleResponse(metricCtx, "b2", "host2", 2)
+		assert.Len(t, writer.chunks, 1)
+		_, err := metricCtx.WaitResponse()
+		assert.NoError(t, err)
+	})
+	t.Run("default limit not applied", func(t *testing.T) {
+		writer := &resultWriter{}
+		metricCtx := newCtx(computeTargets, writer, &stmt.Query{Limit: 1})
+		handleResponse(metricCtx, "b1", "host1", 1)
+		handleResponse(metricCtx, "b2", "host2", 2)
+		assert.Len(t, writer.chunks, 2)
+		_, err := metricCtx.WaitResponse()
+		assert.NoError(t, err)
+	})
+	t.Run("write chunk without lock", func(t *testing.T) {
+		writer := &blockingResultWriter{started: make(chan struct{}), release: make(chan struct{})}
+		metricCtx := newCtx(computeTargets, writer, &stmt.Query{Limit: 10})
+		go handleResponse(metricCtx, "b1", "host1", 1)
+		<-writer.started
+		// handle response of other node when writing chunk
+		handleResponse(metricCtx, "b2", "host2", 2)
+		close(writer.release)
+		// wait chunks written
+		_, err := metricCtx.WaitResponse()
+		assert.NoError(t, err)
+		assert.Equal(t, 2, int(writer.written.Load()))
+	})
+	t.Run("write chunk failure", func(t *testing.T) {
+		writer := &resultWriter{err: fmt.Errorf("err")}
+		metricCtx := newCtx(computeTargets, writer, &stmt.Query{Limit: 10})
+		handleResponse(metricCtx, "b1", "host1", 1)
+		rs, err := metricCtx.WaitResponse()
+		assert.Error(t, err)
+		assert.Nil(t, rs)
+	})
+	t.Run("cannot stream", func(t *testing.T) {
+		// single storage node returns complete groups
+		metricCtx := newCtx([]*models.Target{{Indicator: "s1", ShardIDs: []models.ShardID{1}}}, &resultWriter{}, &stmt.Query{Limit: 10})
+		assert.True(t, metricCtx.streaming)
+
+		storageTargets := []*models.Target{
+			{Indicator: "s1", ShardIDs: []models.ShardID{1}},
+			{Indicator: "s2", ShardIDs: []models.ShardID{2}},
+		}
+		metricCtx = newCtx(storageTargets, &resultWriter{}, &stmt.Query{Limit: 10})
+		assert.False(t, metricCtx.streaming)
+		metricCtx = newCtx(computeTargets, nil, &stmt.Query{Limit: 10})
+		assert.False(t, metricCtx.streaming)
+		metricCtx = newCtx(computeTargets, &resultWriter{}, &stmt.Query{
+			Limit:        10,
+			OrderByItems: []stmt.Expr{&stmt.OrderByExpr{Expr: &stmt.FieldExpr{Name: "f"}}},
+		})
+		assert.False(t, metricCtx.streaming)
+		statement := &stmt.Query{Limit: 10}
+		metricCtx = newCtx(computeTargets, &resultWriter{}, statement)
+		statement.SelectItems = []stmt.Expr{&stmt.SelectItem{Expr: &stmt.CallExpr{
+			FuncType: function.Top,
+			Params:   []stmt.Expr{&stmt.NumberLiteral{Val: 1}, &stmt.FieldExpr{Name: "f"}},
+		}}}
+		assert.False(t, metricCtx.canStream([]*models.PhysicalPlan{{Targets: computeTargets}}))
+
+		// return whole result set
+		writer := &resultWriter{}
+		metricCtx = newCtx(storageTargets, writer, &stmt.Query{Limit: 10})
+		handleResponse(metricCtx, "s1", "host1", 1)
+		handleResponse(metricCtx, "s2", "host1", 2)
+		assert.Empty(t, writer.chunks)
+		rs, err := metricCtx.WaitResponse()
+		assert.NoError(t, err)
+		resultSet := rs.(*commonmodels.ResultSet)
+		assert.Len(t, resultSet.Series, 1)
+		assert.Equal(t, map[int64]float64{interval: 3}, resultSet.Series[0].Fields["f"])
+	})
+}
@@ -31,8 +31,10 @@ func calcTimeRangeAndInterval(statement *stmt.Query, cfg models.Database) {
 		// if query interval not set, first set it using the smallest interval in storage option.
 		interval = option.Intervals[0].Interval
 	}
-	// re-calc query interval based on query time range
-	interval = timeutil.CalcQueryInterval(statement.TimeRange, interval)
+	if !statement.FixedInterval {
+		// re-calc query interval based on query time range
+		interval = timeutil.CalcQueryInterval(statement.TimeRange, interval)
+	}
 	storageInterval := option.FindMatchSmallestInterval(interval)
 	intervalVal := storageInterval.Int64()
 	statement.TimeRange.Start = timeutil.Truncate(statement.TimeRange.Start, intervalVal)
@@ -52,4 +52,20 @@ func Test_calcTimeRangeAndInterval(t *testing.T) {
 	statement.TimeRange = timeutil.TimeRange{Start: commontimeutil.Now(), End: commontimeutil.Now() + 6*commontimeutil.OneHour}
 	calcTimeRangeAndInterval(statement, cfg)
 	assert.Equal(t, timeutil.Interval(6*commontimeutil.OneHour)+statement.StorageInterval, statement.Interval)
+
+	// fixed interval, keeps raw samples of long time range
+	now := timeutil.Truncate(commontimeutil.Now(), commontimeutil.OneHour)
+	statement = &stmt.Query{FixedInterval: true}
+	statement.TimeRange = timeutil.TimeRange{Start: now, End: now + 6*commontimeutil.OneHour}
+	calcTimeRangeAndInterval(statement, cfg)
+	assert.Equal(t, timeutil.Interval(commontimeutil.OneSecond), statement.Interval)
+	assert.Equal(t, timeutil.Interval(commontimeutil.OneSecond), statement.StorageInterval)
+	assert.Equal(t, 1, statement.IntervalRatio)
+	assert.Equal(t, int64(6*3600+1), (statement.TimeRange.End-statement.TimeRange.Start)/statement.Interval.Int64()+1)
+
+	statement = &stmt.Query{FixedInterval: true, Interval: timeutil.Interval(15 * commontimeutil.OneSecond)}
+	statement.TimeRange = timeutil.TimeRange{Start: now, End: now + 6*commontimeutil.OneHour}
+	calcTimeRangeAndInterval(statement, cfg)
+	assert.Equal(t, timeutil.Interval(15*commontimeutil.OneSecond), statement.Interval)
+	assert.Equal(t, int64(6*240+1), (statement.TimeRange.End-statement.TimeRange.Start)/statement.Interval.Int64()+1)
 }
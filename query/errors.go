@@ -28,6 +28,8 @@ var (
 	ErrUnmarshalPlan               = errors.New("unmarshal physical plan error")
 	ErrUnmarshalQuery              = errors.New("unmarshal query statement error")
 	ErrUnmarshalSuggest            = errors.New("unmarshal metadata suggest statement error")
+	ErrUnmarshalDelete             = errors.New("unmarshal delete statement error")
+	ErrUnmarshalDrop               = errors.New("unmarshal drop metric statement error")
 	ErrBadPhysicalPlan             = errors.New("bad plan")
 	ErrNoSendStream                = errors.New("send stream not found")
 	ErrTaskSend                    = errors.New("send task request error")
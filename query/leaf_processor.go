@@ -115,6 +115,18 @@ func (p *leafTaskProcessor) Process(
 			return err
 		}
 		p.statistics.MetaQuery.Incr()
+	case protoCommonV1.RequestType_Delete:
+		if err := p.processDelete(ctx, db, curLeaf.ShardIDs, req, stream); err != nil {
+			p.statistics.DeleteFailures.Incr()
+			return err
+		}
+		p.statistics.Delete.Incr()
+	case protoCommonV1.RequestType_Drop:
+		if err := p.processDrop(ctx, db, curLeaf.ShardIDs, req, stream); err != nil {
+			p.statistics.DropFailures.Incr()
+			return err
+		}
+		p.statistics.Drop.Incr()
 	default:
 		p.statistics.OmitRequest.Incr()
 		return nil
@@ -163,6 +175,90 @@ func (p *leafTaskProcessor) processMetadataSuggest(
 	return nil
 }
 
+// processDelete processes series delete, marks the data of series deleted.
+func (p *leafTaskProcessor) processDelete(
+	ctx *flow.TaskContext,
+	db tsdb.Database,
+	shardIDs []models.ShardID,
+	req *protoCommonV1.TaskRequest,
+	stream protoCommonV1.TaskService_HandleServer,
+) error {
+	defer ctx.Release()
+	var stmtDelete = &stmt.Delete{}
+	if err := stmtDelete.UnmarshalJSON(req.Payload); err != nil {
+		return ErrUnmarshalDelete
+	}
+	leafDeleteCtx := context.NewLeafDeleteContext(stmtDelete, db, shardIDs)
+	pipeline := newExecutePipelineFn(trackerpkg.NewStageTracker(ctx), func(err error) {
+		var errMsg string
+		var payload []byte
+		if err != nil && !errors.Is(err, constants.ErrNotFound) {
+			errMsg = err.Error()
+			p.statistics.DeleteFailures.Incr()
+		} else {
+			payload = encoding.JSONMarshal(leafDeleteCtx.GetResult())
+		}
+		// send result to upstream
+		if err := stream.Send(&protoCommonV1.TaskResponse{
+			RequestType: req.RequestType,
+			RequestID:   req.RequestID,
+			Completed:   true,
+			ErrMsg:      errMsg,
+			SendTime:    timeutil.NowNano(),
+			Payload:     payload,
+		}); err != nil {
+			p.logger.Error("failed to send error message to target stream",
+				logger.String("requestID", req.RequestID),
+				logger.Error(err),
+			)
+		}
+	})
+	pipeline.Execute(stage.NewSeriesDeleteStage(leafDeleteCtx))
+	return nil
+}
+
+// processDrop processes metric/namespace drop, marks the data of metrics deleted, then drops the metadata.
+func (p *leafTaskProcessor) processDrop(
+	ctx *flow.TaskContext,
+	db tsdb.Database,
+	shardIDs []models.ShardID,
+	req *protoCommonV1.TaskRequest,
+	stream protoCommonV1.TaskService_HandleServer,
+) error {
+	defer ctx.Release()
+	var stmtDrop = &stmt.DropMetric{}
+	if err := encoding.JSONUnmarshal(req.Payload, stmtDrop); err != nil {
+		return ErrUnmarshalDrop
+	}
+	leafDropCtx := context.NewLeafDropContext(stmtDrop, db, shardIDs)
+	pipeline := newExecutePipelineFn(trackerpkg.NewStageTracker(ctx), func(err error) {
+		var errMsg string
+		var payload []byte
+		if err != nil && !errors.Is(err, constants.ErrNotFound) {
+			errMsg = err.Error()
+			p.statistics.DropFailures.Incr()
+		} else {
+			payload = encoding.JSONMarshal(leafDropCtx.GetResult())
+		}
+		// send result to upstream
+		if err := stream.Send(&protoCommonV1.TaskResponse{
+			RequestType: req.RequestType,
+			RequestID:   req.RequestID,
+			Completed:   true,
+			ErrMsg:      errMsg,
+			SendTime:    timeutil.NowNano(),
+			Payload:     payload,
+		}); err != nil {
+			p.logger.Error("failed to send error message to target stream",
+				logger.String("requestID", req.RequestID),
+				logger.Error(err),
+			)
+		}
+	})
+	pipeline.Execute(stage.NewMetricDropStage(leafDropCtx))
+	return nil
+}
+
 // processDataSearch processes metric data search.
 func (p *leafTaskProcessor) processDataSearch(
 	ctx *flow.TaskContext,
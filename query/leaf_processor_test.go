@@ -30,6 +30,7 @@ import (
 
 	"github.com/lindb/common/pkg/encoding"
 
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/models"
 	protoCommonV1 "github.com/lindb/lindb/proto/gen/v1/common"
@@ -279,3 +280,217 @@ func TestLeafTask_Suggest_Process(t *testing.T) {
 		})
 	}
 }
+
+func TestLeafTask_Delete_Process(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskServerFactory := rpc.NewMockTaskServerFactory(ctrl)
+	engine := tsdb.NewMockEngine(ctrl)
+
+	currentNode := models.StatelessNode{HostIP: "1.1.1.3", GRPCPort: 8000}
+	processorI := NewLeafTaskProcessor(&currentNode, engine, taskServerFactory)
+	processor := processorI.(*leafTaskProcessor)
+	mockDatabase := tsdb.NewMockDatabase(ctrl)
+	plan := encoding.JSONMarshal(&models.PhysicalPlan{
+		Database: "test_db",
+		Targets:  []*models.Target{{Indicator: "1.1.1.3:8000"}},
+	})
+	engine.EXPECT().GetDatabase(gomock.Any()).Return(mockDatabase, true).AnyTimes()
+	serverStream := protoCommonV1.NewMockTaskService_HandleServer(ctrl)
+
+	mockPipeline := func(err error) {
+		pipeline := NewMockPipeline(ctrl)
+		newExecutePipelineFn = func(_ *trackerpkg.StageTracker,
+			completeCallback func(err error)) Pipeline {
+			completeCallback(err) // mock invoke callback
+			return pipeline
+		}
+		pipeline.EXPECT().Execute(gomock.Any())
+	}
+	cases := []struct {
+		name    string
+		payload []byte
+		prepare func()
+		wantErr bool
+	}{
+		{
+			name:    "unmarshal err",
+			payload: []byte{1, 2, 3},
+			wantErr: true,
+		},
+		{
+			name:    "stream err",
+			payload: encoding.JSONMarshal(&stmt.Delete{MetricName: "cpu"}),
+			prepare: func() {
+				mockPipeline(nil)
+				serverStream.EXPECT().Send(gomock.Any()).Return(io.ErrClosedPipe)
+			},
+		},
+		{
+			name:    "delete successfully",
+			payload: encoding.JSONMarshal(&stmt.Delete{MetricName: "cpu"}),
+			prepare: func() {
+				mockPipeline(nil)
+				serverStream.EXPECT().Send(gomock.Any()).DoAndReturn(func(resp *protoCommonV1.TaskResponse) error {
+					assert.Empty(t, resp.ErrMsg)
+					assert.True(t, resp.Completed)
+					rs := &models.DeleteResult{}
+					assert.NoError(t, encoding.JSONUnmarshal(resp.Payload, rs))
+					return nil
+				})
+			},
+		},
+		{
+			name:    "metric not found",
+			payload: encoding.JSONMarshal(&stmt.Delete{MetricName: "cpu"}),
+			prepare: func() {
+				mockPipeline(constants.ErrNotFound)
+				serverStream.EXPECT().Send(gomock.Any()).DoAndReturn(func(resp *protoCommonV1.TaskResponse) error {
+					assert.Empty(t, resp.ErrMsg)
+					return nil
+				})
+			},
+		},
+		{
+			name:    "delete failure",
+			payload: encoding.JSONMarshal(&stmt.Delete{MetricName: "cpu"}),
+			prepare: func() {
+				mockPipeline(fmt.Errorf("err"))
+				serverStream.EXPECT().Send(gomock.Any()).DoAndReturn(func(resp *protoCommonV1.TaskResponse) error {
+					assert.Equal(t, "err", resp.ErrMsg)
+					return nil
+				})
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				newExecutePipelineFn = NewExecutePipeline
+			}()
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			err := processor.Process(flow.NewTaskContextWithTimeout(context.Background(), time.Second), serverStream,
+				&protoCommonV1.TaskRequest{
+					PhysicalPlan: plan,
+					RequestType:  protoCommonV1.RequestType_Delete,
+					Payload:      tt.payload})
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestLeafTask_Drop_Process(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskServerFactory := rpc.NewMockTaskServerFactory(ctrl)
+	engine := tsdb.NewMockEngine(ctrl)
+
+	currentNode := models.StatelessNode{HostIP: "1.1.1.3", GRPCPort: 8000}
+	processorI := NewLeafTaskProcessor(&currentNode, engine, taskServerFactory)
+	processor := processorI.(*leafTaskProcessor)
+	mockDatabase := tsdb.NewMockDatabase(ctrl)
+	plan := encoding.JSONMarshal(&models.PhysicalPlan{
+		Database: "test_db",
+		Targets:  []*models.Target{{Indicator: "1.1.1.3:8000"}},
+	})
+	engine.EXPECT().GetDatabase(gomock.Any()).Return(mockDatabase, true).AnyTimes()
+	serverStream := protoCommonV1.NewMockTaskService_HandleServer(ctrl)
+
+	mockPipeline := func(err error) {
+		pipeline := NewMockPipeline(ctrl)
+		newExecutePipelineFn = func(_ *trackerpkg.StageTracker,
+			completeCallback func(err error)) Pipeline {
+			completeCallback(err) // mock invoke callback
+			return pipeline
+		}
+		pipeline.EXPECT().Execute(gomock.Any())
+	}
+	cases := []struct {
+		name    string
+		payload []byte
+		prepare func()
+		wantErr bool
+	}{
+		{
+			name:    "unmarshal err",
+			payload: []byte{1, 2, 3},
+			wantErr: true,
+		},
+		{
+			name:    "stream err",
+			payload: encoding.JSONMarshal(&stmt.DropMetric{Namespace: "ns", MetricName: "cpu"}),
+			prepare: func() {
+				mockPipeline(nil)
+				serverStream.EXPECT().Send(gomock.Any()).Return(io.ErrClosedPipe)
+			},
+		},
+		{
+			name:    "drop successfully",
+			payload: encoding.JSONMarshal(&stmt.DropMetric{Namespace: "ns", MetricName: "cpu"}),
+			prepare: func() {
+				mockPipeline(nil)
+				serverStream.EXPECT().Send(gomock.Any()).DoAndReturn(func(resp *protoCommonV1.TaskResponse) error {
+					assert.Empty(t, resp.ErrMsg)
+					assert.True(t, resp.Completed)
+					rs := &models.DeleteResult{}
+					assert.NoError(t, encoding.JSONUnmarshal(resp.Payload, rs))
+					return nil
+				})
+			},
+		},
+		{
+			name:    "metric not found",
+			payload: encoding.JSONMarshal(&stmt.DropMetric{Namespace: "ns", MetricName: "cpu"}),
+			prepare: func() {
+				mockPipeline(constants.ErrNotFound)
+				serverStream.EXPECT().Send(gomock.Any()).DoAndReturn(func(resp *protoCommonV1.TaskResponse) error {
+					assert.Empty(t, resp.ErrMsg)
+					return nil
+				})
+			},
+		},
+		{
+			name:    "drop failure",
+			payload: encoding.JSONMarshal(&stmt.DropMetric{Namespace: "ns", MetricName: "cpu"}),
+			prepare: func() {
+				mockPipeline(fmt.Errorf("err"))
+				serverStream.EXPECT().Send(gomock.Any()).DoAndReturn(func(resp *protoCommonV1.TaskResponse) error {
+					assert.Equal(t, "err", resp.ErrMsg)
+					return nil
+				})
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				newExecutePipelineFn = NewExecutePipeline
+			}()
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			err := processor.Process(flow.NewTaskContextWithTimeout(context.Background(), time.Second), serverStream,
+				&protoCommonV1.TaskRequest{
+					PhysicalPlan: plan,
+					RequestType:  protoCommonV1.RequestType_Drop,
+					Payload:      tt.payload})
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
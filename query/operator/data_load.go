@@ -29,6 +29,7 @@ import (
 	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series/histogram"
 )
 
 // dataLoad represents load data operator by grouping context.
@@ -77,12 +78,30 @@ func (op *dataLoad) Execute() error {
 	op.executeCtx.DownSampling = func(slotRange timeutil.SlotRange, lowSeriesIdx uint16, fieldIdx int, getter encoding.TSDValueGetter) {
 		seriesAggregator := op.executeCtx.GetSeriesAggregator(lowSeriesIdx, fieldIdx)
 
-		agg := seriesAggregator.GetAggregator(familyTime)
+		agg := seriesAggregator.GetSeriesAggregator(lowSeriesIdx, familyTime)
 		op.foundSeries++
+		distinct := op.distinctAggregate(lowSeriesIdx, familyTime)
+		if histogramAgg, ok := agg.(aggregation.HistogramAggregator); ok {
+			// native histogram field, merges histogram values
+			if histogramGetter, ok := getter.(histogram.Getter); ok {
+				aggregation.DownSamplingHistogram(
+					slotRange, targetSlotRange, queryIntervalRatio, baseSlot,
+					histogramGetter,
+					func(slot int, value *histogram.Histogram) {
+						histogramAgg.AggregateHistogramBySlot(slot, value)
+						distinct(slot)
+					},
+				)
+			}
+			return
+		}
 		aggregation.DownSampling(
 			slotRange, targetSlotRange, queryIntervalRatio, baseSlot,
 			getter,
-			agg.AggregateBySlot,
+			func(slot int, value float64) {
+				agg.AggregateBySlot(slot, value)
+				distinct(slot)
+			},
 		)
 	}
 
@@ -94,6 +113,29 @@ func (op *dataLoad) Execute() error {
 	return nil
 }
 
+// distinctAggregate returns the function which collects hash of tag value for distinct tags,
+// when series has data in time slot.
+func (op *dataLoad) distinctAggregate(lowSeriesIdx uint16, familyTime int64) func(slot int) {
+	var (
+		aggs   []aggregation.FieldAggregator
+		values []float64
+	)
+	numOfFields := len(op.executeCtx.ShardExecuteCtx.StorageExecuteCtx.Fields)
+	for idx, tagValues := range op.executeCtx.DistinctTagValues {
+		if value := tagValues[lowSeriesIdx]; value != 0 {
+			// specs of distinct tags are after fields
+			seriesAggregator := op.executeCtx.GetSeriesAggregator(lowSeriesIdx, numOfFields+idx)
+			aggs = append(aggs, seriesAggregator.GetSeriesAggregator(lowSeriesIdx, familyTime))
+			values = append(values, value)
+		}
+	}
+	return func(slot int) {
+		for idx, agg := range aggs {
+			agg.AggregateBySlot(slot, values[idx])
+		}
+	}
+}
+
 // Identifier returns identifier value of data load operator.
 func (op *dataLoad) Identifier() string {
 	identifiers := strings.Split(op.rs.Identifier(), "segment")
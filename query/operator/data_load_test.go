@@ -74,7 +74,7 @@ func TestDataLoader_Execute(t *testing.T) {
 		rs.EXPECT().SeriesIDs().Return(roaring.BitmapOf(1, 2))
 		rs.EXPECT().Load(gomock.Any()).Return(loader)
 		fAgg := aggregation.NewMockFieldAggregator(ctrl)
-		agg.EXPECT().GetAggregator(gomock.Any()).Return(fAgg).MaxTimes(2)
+		agg.EXPECT().GetSeriesAggregator(uint16(0), gomock.Any()).Return(fAgg).MaxTimes(2)
 		getter := encoding.NewMockTSDValueGetter(ctrl)
 		getter.EXPECT().GetValue(gomock.Any()).Return(5.0, true).AnyTimes()
 		loader.EXPECT().Load(gomock.Any()).Do(func(ctx *flow.DataLoadContext) {
@@ -86,6 +86,58 @@ func TestDataLoader_Execute(t *testing.T) {
 	})
 }
 
+func TestDataLoader_DistinctTags(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rs := flow.NewMockFilterResultSet(ctrl)
+	loader := flow.NewMockDataLoader(ctrl)
+	ctx := &flow.DataLoadContext{
+		PendingDataLoadTasks: atomic.NewInt32(0),
+		ShardExecuteCtx: &flow.ShardExecuteContext{
+			StorageExecuteCtx: &flow.StorageExecuteContext{
+				Query:  &stmt.Query{Interval: 1, IntervalRatio: 1.0},
+				Fields: field.Metas{{ID: 1, Name: "f", Type: field.SumField}},
+				DownSamplingSpecs: aggregation.AggregatorSpecs{
+					aggregation.NewAggregatorSpec("f", field.SumField),
+					aggregation.NewAggregatorSpec("distinct_count(host)", field.Unknown),
+				},
+			},
+			SeriesIDsAfterFiltering: roaring.BitmapOf(1, 2),
+		},
+		IsMultiField: true,
+		// series 0 has tag value, series 1 hasn't
+		DistinctTagValues: [][]float64{{7, 0}},
+	}
+	ctx.PrepareAggregatorWithoutGrouping()
+	agg := aggregation.NewMockSeriesAggregator(ctrl)
+	distinctAgg := aggregation.NewMockSeriesAggregator(ctrl)
+	ctx.WithoutGroupingSeriesAgg.Aggregators = aggregation.FieldAggregates{agg, distinctAgg}
+	segment := &flow.TimeSegmentResultSet{
+		FilterRS:      []flow.FilterResultSet{rs},
+		Target:        timeutil.SlotRange{Start: 5, End: 5},
+		IntervalRatio: 1,
+	}
+	fAgg := aggregation.NewMockFieldAggregator(ctrl)
+	dAgg := aggregation.NewMockFieldAggregator(ctrl)
+	getter := encoding.NewMockTSDValueGetter(ctrl)
+	getter.EXPECT().GetValue(uint16(5)).Return(5.0, true).AnyTimes()
+
+	rs.EXPECT().SeriesIDs().Return(roaring.BitmapOf(1, 2))
+	rs.EXPECT().Load(gomock.Any()).Return(loader)
+	agg.EXPECT().GetSeriesAggregator(uint16(0), gomock.Any()).Return(fAgg)
+	agg.EXPECT().GetSeriesAggregator(uint16(1), gomock.Any()).Return(fAgg)
+	distinctAgg.EXPECT().GetSeriesAggregator(uint16(0), gomock.Any()).Return(dAgg)
+	fAgg.EXPECT().AggregateBySlot(5, 5.0).Times(2)
+	dAgg.EXPECT().AggregateBySlot(5, 7.0)
+	loader.EXPECT().Load(gomock.Any()).Do(func(ctx *flow.DataLoadContext) {
+		ctx.DownSampling(timeutil.SlotRange{Start: 5, End: 5}, 0, 0, getter)
+		ctx.DownSampling(timeutil.SlotRange{Start: 5, End: 5}, 1, 0, getter)
+	})
+	op := NewDataLoad(ctx, segment, rs)
+	assert.NoError(t, op.Execute())
+}
+
 func TestDataLoad_Stats(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -0,0 +1,78 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package operator
+
+import (
+	"github.com/lindb/roaring"
+
+	"github.com/lindb/lindb/aggregation"
+	"github.com/lindb/lindb/flow"
+	"github.com/lindb/lindb/index"
+)
+
+// distinctTagsLookup represents distinct tags lookup operator,
+// finds tag value of each series for distinct_count(tag) function.
+type distinctTagsLookup struct {
+	executeCtx *flow.DataLoadContext
+	metaDB     index.MetricMetaDatabase
+}
+
+// NewDistinctTagsLookup creates a distinctTagsLookup instance.
+func NewDistinctTagsLookup(executeCtx *flow.DataLoadContext, metaDB index.MetricMetaDatabase) Operator {
+	return &distinctTagsLookup{
+		executeCtx: executeCtx,
+		metaDB:     metaDB,
+	}
+}
+
+// Execute executes tag value lookup of each series for distinct tags,
+// tag value id is different in each node, so uses hash of tag value.
+func (op *distinctTagsLookup) Execute() error {
+	groupingCtx := op.executeCtx.ShardExecuteCtx.GroupingContext
+	distinctTags := op.executeCtx.ShardExecuteCtx.StorageExecuteCtx.DistinctTags
+	if groupingCtx == nil || len(distinctTags) == 0 {
+		return nil
+	}
+	distinctTagValues := make([][]float64, len(distinctTags))
+	for idx, distinctTag := range distinctTags {
+		seriesTagValueIDs := make(map[uint16]uint32)
+		tagValueIDs := roaring.New()
+		groupingCtx.ScanSeriesTagValueIDs(op.executeCtx, distinctTag.ID, func(seriesIdxFromQuery uint16, tagValueID uint32) {
+			seriesTagValueIDs[seriesIdxFromQuery] = tagValueID
+			tagValueIDs.Add(tagValueID)
+		})
+		tagValues := make(map[uint32]string)
+		if err := op.metaDB.CollectTagValues(distinctTag.ID, tagValueIDs, tagValues); err != nil {
+			return err
+		}
+		values := make([]float64, len(op.executeCtx.LowSeriesIDs))
+		for seriesIdx, tagValueID := range seriesTagValueIDs {
+			if tagValue, ok := tagValues[tagValueID]; ok {
+				values[seriesIdx] = aggregation.DistinctValue(tagValue)
+			}
+		}
+		distinctTagValues[idx] = values
+	}
+	op.executeCtx.DistinctTagValues = distinctTagValues
+	return nil
+}
+
+// Identifier returns identifier string value of distinct tags lookup operator.
+func (op *distinctTagsLookup) Identifier() string {
+	return "Distinct Tags Lookup"
+}
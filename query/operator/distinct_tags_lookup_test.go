@@ -0,0 +1,93 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package operator
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/lindb/roaring"
+
+	"github.com/lindb/lindb/aggregation"
+	"github.com/lindb/lindb/flow"
+	"github.com/lindb/lindb/index"
+	"github.com/lindb/lindb/series/tag"
+)
+
+func TestDistinctTagsLookup_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	metaDB := index.NewMockMetricMetaDatabase(ctrl)
+	groupingCtx := flow.NewMockGroupingContext(ctrl)
+	seriesIDs := roaring.BitmapOf(1, 2, 3)
+	ctx := &flow.ShardExecuteContext{
+		SeriesIDsAfterFiltering: seriesIDs,
+		StorageExecuteCtx:       &flow.StorageExecuteContext{},
+	}
+	dataLoadCtx := &flow.DataLoadContext{
+		ShardExecuteCtx:       ctx,
+		LowSeriesIDsContainer: seriesIDs.GetContainerAtIndex(0),
+	}
+	dataLoadCtx.Grouping()
+	op := NewDistinctTagsLookup(dataLoadCtx, metaDB)
+
+	t.Run("no distinct tags", func(t *testing.T) {
+		ctx.GroupingContext = groupingCtx
+		assert.NoError(t, op.Execute())
+		assert.Nil(t, dataLoadCtx.DistinctTagValues)
+	})
+	ctx.StorageExecuteCtx.DistinctTags = tag.Metas{{ID: 5, Key: "host"}}
+	t.Run("no grouping context", func(t *testing.T) {
+		ctx.GroupingContext = nil
+		assert.NoError(t, op.Execute())
+		assert.Nil(t, dataLoadCtx.DistinctTagValues)
+	})
+	ctx.GroupingContext = groupingCtx
+	scan := func(_ *flow.DataLoadContext, _ tag.KeyID, fn func(seriesIdxFromQuery uint16, tagValueID uint32)) {
+		// series 3 hasn't tag value
+		fn(0, 0)
+		fn(1, 10)
+	}
+	t.Run("collect tag values failure", func(t *testing.T) {
+		groupingCtx.EXPECT().ScanSeriesTagValueIDs(dataLoadCtx, tag.KeyID(5), gomock.Any()).DoAndReturn(scan)
+		metaDB.EXPECT().CollectTagValues(tag.KeyID(5), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+		assert.Error(t, op.Execute())
+	})
+	t.Run("lookup successfully", func(t *testing.T) {
+		groupingCtx.EXPECT().ScanSeriesTagValueIDs(dataLoadCtx, tag.KeyID(5), gomock.Any()).DoAndReturn(scan)
+		metaDB.EXPECT().CollectTagValues(tag.KeyID(5), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ tag.KeyID, tagValueIDs *roaring.Bitmap, tagValues map[uint32]string) error {
+				assert.Equal(t, []uint32{0, 10}, tagValueIDs.ToArray())
+				tagValues[0] = "a"
+				tagValues[10] = "b"
+				return nil
+			})
+		assert.NoError(t, op.Execute())
+		assert.Equal(t, [][]float64{{
+			aggregation.DistinctValue("a"), aggregation.DistinctValue("b"), 0,
+		}}, dataLoadCtx.DistinctTagValues)
+	})
+}
+
+func TestDistinctTagsLookup_Identifier(t *testing.T) {
+	assert.Equal(t, "Distinct Tags Lookup", NewDistinctTagsLookup(nil, nil).Identifier())
+}
@@ -34,7 +34,8 @@ func NewGroupingTagsLookup(executeCtx *flow.DataLoadContext) Operator {
 // Execute executes grouping tag value ids lookup, if it hasn't grouping tag key returns no grouping.
 func (op *groupingTagsLookup) Execute() error {
 	op.executeCtx.Grouping()
-	if op.executeCtx.ShardExecuteCtx.GroupingContext != nil {
+	// grouping context maybe only for distinct tags if query hasn't group by
+	if op.executeCtx.IsGrouping && op.executeCtx.ShardExecuteCtx.GroupingContext != nil {
 		// lookup grouping tags, grouped series: tags => series IDs(based on low series ids)
 		op.executeCtx.ShardExecuteCtx.GroupingContext.BuildGroup(op.executeCtx)
 	} else {
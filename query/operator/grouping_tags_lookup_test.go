@@ -52,10 +52,17 @@ func TestGroupingTagsLookup_Execute(t *testing.T) {
 		op := NewGroupingTagsLookup(dataLoadCtx)
 		assert.NoError(t, op.Execute())
 	})
+	t.Run("grouping context for distinct tags", func(t *testing.T) {
+		ctx.GroupingContext = flow.NewMockGroupingContext(ctrl)
+		op := NewGroupingTagsLookup(dataLoadCtx)
+		assert.NoError(t, op.Execute())
+		assert.NotNil(t, dataLoadCtx.WithoutGroupingSeriesAgg)
+	})
 	t.Run("has grouping", func(t *testing.T) {
 		groupingCtx := flow.NewMockGroupingContext(ctrl)
-		groupingCtx.EXPECT().BuildGroup(gomock.Any()).AnyTimes()
+		groupingCtx.EXPECT().BuildGroup(gomock.Any())
 		ctx.GroupingContext = groupingCtx
+		dataLoadCtx.IsGrouping = true
 		op := NewGroupingTagsLookup(dataLoadCtx)
 		assert.NoError(t, op.Execute())
 	})
@@ -0,0 +1,96 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package operator
+
+import (
+	"encoding/binary"
+
+	"github.com/lindb/roaring"
+
+	"github.com/lindb/lindb/flow"
+	"github.com/lindb/lindb/index"
+	"github.com/lindb/lindb/series/tag"
+)
+
+// groupingTagsRewrite represents grouping tags rewrite operator,
+// regroups series by grouping tag values which derived from group by tag functions.
+type groupingTagsRewrite struct {
+	executeCtx *flow.DataLoadContext
+	metaDB     index.MetricMetaDatabase
+}
+
+// NewGroupingTagsRewrite creates a groupingTagsRewrite instance.
+func NewGroupingTagsRewrite(executeCtx *flow.DataLoadContext, metaDB index.MetricMetaDatabase) Operator {
+	return &groupingTagsRewrite{
+		executeCtx: executeCtx,
+		metaDB:     metaDB,
+	}
+}
+
+// Execute executes grouping tags rewrite, finds source tag values of each group,
+// then merges the groups which have same rewritten tag values.
+func (op *groupingTagsRewrite) Execute() error {
+	storageExecuteCtx := op.executeCtx.ShardExecuteCtx.StorageExecuteCtx
+	rewriter := storageExecuteCtx.GroupingTagsRewriter
+	groupingSeriesAgg := op.executeCtx.GroupingSeriesAgg
+	if rewriter == nil || !op.executeCtx.IsGrouping || len(groupingSeriesAgg) == 0 {
+		return nil
+	}
+	groupByTags := storageExecuteCtx.GroupByTags
+	// collect tag value ids of each group by tag key
+	tagValueIDs := make([]*roaring.Bitmap, len(groupByTags))
+	for idx := range tagValueIDs {
+		tagValueIDs[idx] = roaring.New()
+	}
+	for _, agg := range groupingSeriesAgg {
+		tagsData := []byte(agg.Key)
+		for idx := range groupByTags {
+			tagValueID := binary.LittleEndian.Uint32(tagsData[idx*4:])
+			if tagValueID != flow.MissingTagValueID {
+				tagValueIDs[idx].Add(tagValueID)
+			}
+		}
+	}
+	tagValues := make([]map[uint32]string, len(groupByTags)) // tag value id => tag value for each group by tag key
+	for idx, groupByTag := range groupByTags {
+		tagValues[idx] = make(map[uint32]string)
+		if tagValueIDs[idx].IsEmpty() {
+			continue
+		}
+		if err := op.metaDB.CollectTagValues(groupByTag.ID, tagValueIDs[idx], tagValues[idx]); err != nil {
+			return err
+		}
+	}
+	// rewrite source tag values of each group, series hasn't the tag key if tag value not found
+	sourceValues := make([]string, len(groupByTags))
+	groupingKeys := make([]string, len(groupingSeriesAgg))
+	for aggIdx, agg := range groupingSeriesAgg {
+		tagsData := []byte(agg.Key)
+		for idx := range groupByTags {
+			sourceValues[idx] = tagValues[idx][binary.LittleEndian.Uint32(tagsData[idx*4:])]
+		}
+		groupingKeys[aggIdx] = rewriter.Rewrite(tag.ConcatTagValues(sourceValues))
+	}
+	op.executeCtx.RegroupSeriesAggregator(groupingKeys)
+	return nil
+}
+
+// Identifier returns identifier string value of grouping tags rewrite operator.
+func (op *groupingTagsRewrite) Identifier() string {
+	return "Grouping Tags Rewrite"
+}
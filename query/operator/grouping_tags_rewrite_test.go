@@ -0,0 +1,101 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package operator
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/lindb/roaring"
+
+	"github.com/lindb/lindb/aggregation"
+	"github.com/lindb/lindb/flow"
+	"github.com/lindb/lindb/index"
+	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/series/tag"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestGroupingTagsRewrite_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	metaDB := index.NewMockMetricMetaDatabase(ctrl)
+	query := &stmt.Query{
+		GroupBy:     []string{"host"},
+		GroupByTags: []*stmt.GroupByTag{{FuncType: stmt.RegexpExtract, TagKeys: []string{"host"}, Args: []string{`\.(\w+)$`}, Alias: "dc"}},
+	}
+	rewriter, err := flow.NewGroupingTagsRewriter(query)
+	assert.NoError(t, err)
+	storageCtx := &flow.StorageExecuteContext{
+		Query:               query,
+		DownSamplingSpecs:   aggregation.AggregatorSpecs{aggregation.NewAggregatorSpec("f", field.SumField)},
+		GroupByTags:         tag.Metas{{ID: 5, Key: "host"}},
+		GroupByTagKeyIDs:    []tag.KeyID{5},
+		GroupingTagValueIDs: make([]*roaring.Bitmap, 1),
+	}
+	newDataLoadCtx := func() *flow.DataLoadContext {
+		dataLoadCtx := &flow.DataLoadContext{
+			ShardExecuteCtx:       &flow.ShardExecuteContext{StorageExecuteCtx: storageCtx},
+			IsGrouping:            true,
+			GroupingSeriesAggRefs: make([]uint16, 3),
+		}
+		for seriesIdx, tagValueID := range []byte{1, 2, 3} {
+			dataLoadCtx.GroupingSeriesAggRefs[seriesIdx] = dataLoadCtx.NewSeriesAggregator(string([]byte{tagValueID, 0, 0, 0}))
+		}
+		return dataLoadCtx
+	}
+
+	t.Run("no rewriter", func(t *testing.T) {
+		dataLoadCtx := newDataLoadCtx()
+		assert.NoError(t, NewGroupingTagsRewrite(dataLoadCtx, metaDB).Execute())
+		assert.Len(t, dataLoadCtx.GroupingSeriesAgg, 3)
+	})
+	storageCtx.GroupingTagsRewriter = rewriter
+	storageCtx.GroupingTagValueIDs = make([]*roaring.Bitmap, 1)
+	t.Run("collect tag values failure", func(t *testing.T) {
+		dataLoadCtx := newDataLoadCtx()
+		metaDB.EXPECT().CollectTagValues(tag.KeyID(5), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+		assert.Error(t, NewGroupingTagsRewrite(dataLoadCtx, metaDB).Execute())
+	})
+	t.Run("regroup by rewritten tags", func(t *testing.T) {
+		dataLoadCtx := newDataLoadCtx()
+		metaDB.EXPECT().CollectTagValues(tag.KeyID(5), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ tag.KeyID, tagValueIDs *roaring.Bitmap, tagValues map[uint32]string) error {
+				assert.Equal(t, []uint32{1, 2, 3}, tagValueIDs.ToArray())
+				tagValues[1] = "web-1.dc1"
+				tagValues[2] = "web-2.dc2"
+				tagValues[3] = "web-3.dc1"
+				return nil
+			})
+		assert.NoError(t, NewGroupingTagsRewrite(dataLoadCtx, metaDB).Execute())
+		assert.Len(t, dataLoadCtx.GroupingSeriesAgg, 2)
+		assert.Equal(t, "dc1", dataLoadCtx.GroupingSeriesAgg[0].Key)
+		assert.Equal(t, "dc2", dataLoadCtx.GroupingSeriesAgg[1].Key)
+		assert.Equal(t, []uint16{0, 1, 0}, dataLoadCtx.GroupingSeriesAggRefs)
+		// tag value ids not collected for leaf grouping context
+		assert.False(t, storageCtx.HasGroupingTagValueIDs())
+	})
+}
+
+func TestGroupingTagsRewrite_Identifier(t *testing.T) {
+	assert.Equal(t, "Grouping Tags Rewrite", NewGroupingTagsRewrite(nil, nil).Identifier())
+}
@@ -82,6 +82,15 @@ func (op *metadataLookup) Execute() error {
 	if err := op.selectList(); err != nil {
 		return err
 	}
+	if len(op.fields) == 0 && len(op.executeCtx.DistinctTags) > 0 {
+		// only distinct_count(tag), counts tag values of series which have data of any field
+		for _, fieldMeta := range schema.Fields {
+			op.planField(nil, fieldMeta)
+		}
+		if op.err != nil {
+			return op.err
+		}
+	}
 
 	op.buildField()
 	return nil
@@ -109,6 +118,12 @@ func (op *metadataLookup) groupBy() error {
 	// init grouping tag value collection, need cache found grouping tag value id
 	op.executeCtx.GroupingTagValueIDs = make([]*roaring.Bitmap, lengthOfGroupByTagKeys)
 
+	// grouping tags derived from group by tag functions, series are grouped by rewritten tag values
+	rewriter, err := flow.NewGroupingTagsRewriter(op.executeCtx.Query)
+	if err != nil {
+		return err
+	}
+	op.executeCtx.GroupingTagsRewriter = rewriter
 	return nil
 }
 
@@ -138,6 +153,16 @@ func (op *metadataLookup) buildField() {
 		op.executeCtx.DownSamplingSpecs[fieldIdx] = f.DownSampling
 		op.executeCtx.AggregatorSpecs[fieldIdx] = f.Aggregator
 	}
+	// distinct tags use the specs after fields, which collect hash of tag values
+	for _, distinctTag := range op.executeCtx.DistinctTags {
+		fieldName := field.Name(stmt.DistinctFieldName(distinctTag.Key))
+		downSampling := aggregation.NewAggregatorSpec(fieldName, field.Unknown)
+		downSampling.AddFunctionType(function.DistinctCount)
+		aggregator := aggregation.NewAggregatorSpec(fieldName, field.Unknown)
+		aggregator.AddFunctionType(function.DistinctCount)
+		op.executeCtx.DownSamplingSpecs = append(op.executeCtx.DownSamplingSpecs, downSampling)
+		op.executeCtx.AggregatorSpecs = append(op.executeCtx.AggregatorSpecs, aggregator)
+	}
 }
 
 // selectList plans the select list from down sampling aggregation specification
@@ -177,6 +202,21 @@ func (op *metadataLookup) field(parentFunc *stmt.CallExpr, expr stmt.Expr) {
 			op.planHistogramFields(e)
 			return
 		}
+		if e.FuncType == function.DistinctCount {
+			op.planDistinctTag(e)
+			return
+		}
+		if (e.FuncType == function.HistogramCount || e.FuncType == function.HistogramSum) && len(e.Params) == 0 {
+			op.planNativeHistogramFields()
+			return
+		}
+		if function.IsPostAggregation(e.FuncType) {
+			// transform/selector/scalar function evaluates on the down sampling result of field
+			for _, param := range e.Params {
+				op.field(nil, param)
+			}
+			return
+		}
 		for _, param := range e.Params {
 			op.field(e, param)
 		}
@@ -251,8 +291,47 @@ func (op *metadataLookup) planHistogramFields(e *stmt.CallExpr) {
 			aggregator.Aggregator = aggregation.NewAggregatorSpec(fieldMeta.Name, fieldMeta.Type)
 			op.fields[fieldMeta.ID] = aggregator
 		}
-		aggregator.Aggregator.AddFunctionType(function.Sum)
-		aggregator.DownSampling.AddFunctionType(function.Sum)
+		funcType := fieldMeta.Type.DownSamplingFunc()
+		aggregator.Aggregator.AddFunctionType(funcType)
+		aggregator.DownSampling.AddFunctionType(funcType)
+	}
+}
+
+// planDistinctTag plans the tag key of distinct_count(tag) function.
+func (op *metadataLookup) planDistinctTag(e *stmt.CallExpr) {
+	if len(e.Params) != 1 {
+		op.err = fmt.Errorf("distinct_count params must be one tag key")
+		return
+	}
+	tagKeyExpr, ok := e.Params[0].(*stmt.FieldExpr)
+	if !ok {
+		op.err = fmt.Errorf("distinct_count param: %s is not tag key", e.Params[0].Rewrite())
+		return
+	}
+	tagMeta, ok := op.executeCtx.Schema.TagKeys.Find(tagKeyExpr.Name)
+	if !ok {
+		op.err = fmt.Errorf("%w, tag key: %s", constants.ErrTagKeyIDNotFound, tagKeyExpr.Name)
+		return
+	}
+	for _, distinctTag := range op.executeCtx.DistinctTags {
+		if distinctTag.ID == tagMeta.ID {
+			return
+		}
+	}
+	op.executeCtx.DistinctTags = append(op.executeCtx.DistinctTags, tagMeta)
+}
+
+// planNativeHistogramFields plans all native histogram fields for histogram_count/histogram_sum function without params.
+func (op *metadataLookup) planNativeHistogramFields() {
+	found := false
+	for _, fieldMeta := range op.executeCtx.Schema.Fields {
+		if fieldMeta.Type == field.NativeHistogramField {
+			op.planField(nil, fieldMeta)
+			found = true
+		}
+	}
+	if !found {
+		op.err = fmt.Errorf("%w, native histogram field", constants.ErrFieldNotFound)
 	}
 }
 
@@ -26,6 +26,7 @@ import (
 
 	"github.com/lindb/lindb/aggregation"
 	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/index"
 	"github.com/lindb/lindb/series/field"
@@ -159,6 +160,18 @@ func TestMetadataLookup_groupBy(t *testing.T) {
 	}
 
 	assert.NoError(t, op.groupBy())
+	assert.Nil(t, ctx.GroupingTagsRewriter)
+
+	// group by tag function
+	ctx.Query.GroupByTags = []*stmtpkg.GroupByTag{
+		{FuncType: stmtpkg.RegexpExtract, TagKeys: []string{"k"}, Args: []string{`\.(\w+)$`}, Alias: "dc"},
+	}
+	assert.NoError(t, op.groupBy())
+	assert.NotNil(t, ctx.GroupingTagsRewriter)
+
+	// invalid group by tag function
+	ctx.Query.GroupByTags[0].Args = []string{"(a"}
+	assert.Error(t, op.groupBy())
 }
 
 func TestMetadataLookup_field(t *testing.T) {
@@ -257,6 +270,45 @@ func TestMetadataLookup_field(t *testing.T) {
 				Params:   []stmtpkg.Expr{&stmtpkg.FieldExpr{Name: "f1"}},
 			},
 		},
+		{
+			name: "handle transform function",
+			in: &stmtpkg.CallExpr{
+				FuncType: function.MovingAverage,
+				Params:   []stmtpkg.Expr{&stmtpkg.FieldExpr{Name: "f1"}, &stmtpkg.NumberLiteral{Val: 5}},
+			},
+		},
+		{
+			name: "handle transform function with aggregation",
+			in: &stmtpkg.CallExpr{
+				FuncType: function.Increase,
+				Params: []stmtpkg.Expr{&stmtpkg.CallExpr{
+					FuncType: function.Max,
+					Params:   []stmtpkg.Expr{&stmtpkg.FieldExpr{Name: "f1"}},
+				}},
+			},
+		},
+		{
+			name: "handle selector function",
+			in: &stmtpkg.CallExpr{
+				FuncType: function.Top,
+				Params: []stmtpkg.Expr{&stmtpkg.NumberLiteral{Val: 3}, &stmtpkg.CallExpr{
+					FuncType: function.Sum,
+					Params:   []stmtpkg.Expr{&stmtpkg.FieldExpr{Name: "f1"}},
+				}},
+				Others: true,
+			},
+		},
+		{
+			name: "handle scalar function",
+			in: &stmtpkg.CallExpr{
+				FuncType: function.If,
+				Params: []stmtpkg.Expr{
+					&stmtpkg.BinaryExpr{Left: &stmtpkg.FieldExpr{Name: "f1"}, Operator: stmtpkg.GREATER, Right: &stmtpkg.NumberLiteral{Val: 1}},
+					&stmtpkg.CallExpr{FuncType: function.Log10, Params: []stmtpkg.Expr{&stmtpkg.FieldExpr{Name: "f1"}}},
+					&stmtpkg.NumberLiteral{Val: 0},
+				},
+			},
+		},
 		{
 			name: "handle quantile function",
 			in: &stmtpkg.CallExpr{
@@ -363,3 +415,92 @@ func TestMetadataLookup_Identifier(t *testing.T) {
 	db.EXPECT().MetaDB().Return(metaDB).AnyTimes()
 	assert.Equal(t, "Metadata Lookup", NewMetadataLookup(nil, db).Identifier())
 }
+
+func TestMetadataLookup_planNativeHistogramFields(t *testing.T) {
+	newOp := func(fields field.Metas) *metadataLookup {
+		return &metadataLookup{
+			executeCtx: &flow.StorageExecuteContext{
+				Query:  &stmtpkg.Query{},
+				Schema: &metric.Schema{Fields: fields},
+			},
+			fields: make(map[field.ID]*aggregation.Aggregator),
+		}
+	}
+	histogramCount := &stmtpkg.CallExpr{FuncType: function.HistogramCount}
+	// native histogram field not found
+	op := newOp(field.Metas{{ID: 1, Type: field.HistogramField, Name: "__bucket_1"}})
+	op.field(nil, histogramCount)
+	assert.ErrorIs(t, op.err, constants.ErrFieldNotFound)
+
+	op = newOp(field.Metas{
+		{ID: 1, Type: field.HistogramField, Name: "__bucket_1"},
+		{ID: 2, Type: field.NativeHistogramField, Name: "Histogram"},
+	})
+	op.field(nil, histogramCount)
+	assert.NoError(t, op.err)
+	assert.Len(t, op.fields, 1)
+	assert.NotNil(t, op.fields[2])
+	// quantile plans native/legacy histogram fields
+	op.field(nil, &stmtpkg.CallExpr{FuncType: function.Quantile, Params: []stmtpkg.Expr{&stmtpkg.FieldExpr{Name: "0.99"}}})
+	assert.NoError(t, op.err)
+	assert.Len(t, op.fields, 2)
+	// function with field param
+	op.field(nil, &stmtpkg.CallExpr{FuncType: function.HistogramSum, Params: []stmtpkg.Expr{&stmtpkg.FieldExpr{Name: "Histogram"}}})
+	assert.NoError(t, op.err)
+	op.field(nil, &stmtpkg.CallExpr{FuncType: function.HistogramSum, Params: []stmtpkg.Expr{&stmtpkg.FieldExpr{Name: "__bucket_1"}}})
+	assert.Error(t, op.err)
+}
+
+func TestMetadataLookup_DistinctCount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := tsdb.NewMockDatabase(ctrl)
+	metaDB := index.NewMockMetricMetaDatabase(ctrl)
+	db.EXPECT().MetaDB().Return(metaDB).AnyTimes()
+	schema := &metric.Schema{
+		Fields: field.Metas{
+			{ID: 1, Type: field.SumField, Name: "f"},
+			{ID: 2, Type: field.LastField, Name: "g"},
+		},
+		TagKeys: tag.Metas{{ID: 5, Key: "host"}},
+	}
+	distinctCount := func(param stmtpkg.Expr) stmtpkg.Expr {
+		return &stmtpkg.CallExpr{FuncType: function.DistinctCount, Params: []stmtpkg.Expr{param}}
+	}
+	execute := func(selectItems ...stmtpkg.Expr) (*flow.StorageExecuteContext, error) {
+		ctx := &flow.StorageExecuteContext{
+			Query: &stmtpkg.Query{SelectItems: selectItems},
+		}
+		metaDB.EXPECT().GetMetricID(gomock.Any(), gomock.Any()).Return(metric.ID(10), nil)
+		metaDB.EXPECT().GetSchema(gomock.Any()).Return(schema, nil)
+		return ctx, NewMetadataLookup(ctx, db).Execute()
+	}
+
+	// only distinct_count, plans all fields
+	ctx, err := execute(distinctCount(&stmtpkg.FieldExpr{Name: "host"}), distinctCount(&stmtpkg.FieldExpr{Name: "host"}))
+	assert.NoError(t, err)
+	assert.Equal(t, tag.Metas{{ID: 5, Key: "host"}}, ctx.DistinctTags)
+	assert.Len(t, ctx.Fields, 2)
+	assert.Len(t, ctx.DownSamplingSpecs, 3)
+	assert.Len(t, ctx.AggregatorSpecs, 3)
+	assert.Equal(t, field.Name("distinct_count(host)"), ctx.DownSamplingSpecs[2].FieldName())
+	assert.Equal(t, field.Unknown, ctx.AggregatorSpecs[2].GetFieldType())
+	assert.Equal(t, []field.AggType{field.Distinct}, ctx.AggregatorSpecs[2].GetFieldType().GetFuncFieldParams(function.DistinctCount))
+	// distinct_count with field
+	ctx, err = execute(distinctCount(&stmtpkg.FieldExpr{Name: "host"}),
+		&stmtpkg.CallExpr{FuncType: function.Percentile, Params: []stmtpkg.Expr{
+			&stmtpkg.FieldExpr{Name: "g"}, &stmtpkg.NumberLiteral{Val: 90},
+		}})
+	assert.NoError(t, err)
+	assert.Len(t, ctx.Fields, 1)
+	assert.Len(t, ctx.DownSamplingSpecs, 2)
+	// tag key not exist
+	_, err = execute(distinctCount(&stmtpkg.FieldExpr{Name: "ip"}))
+	assert.ErrorIs(t, err, constants.ErrTagKeyIDNotFound)
+	// param isn't tag key
+	_, err = execute(distinctCount(&stmtpkg.NumberLiteral{Val: 1}))
+	assert.Error(t, err)
+	_, err = execute(&stmtpkg.CallExpr{FuncType: function.DistinctCount})
+	assert.Error(t, err)
+}
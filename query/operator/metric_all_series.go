@@ -52,6 +52,10 @@ func (op *metricAllSeries) Execute() error {
 		// add series id without tags, maybe metric has too many series, but one series without tags
 		seriesIDs.Add(series.IDWithoutTags)
 	}
+	// remove the series which are deleted in query time range
+	if deletedSeriesIDs := op.indexDB.GetDeletedSeriesIDs(op.executeCtx.StorageExecuteCtx.MetricID, queryStmt.TimeRange); deletedSeriesIDs != nil {
+		seriesIDs.AndNot(deletedSeriesIDs)
+	}
 	op.executeCtx.SeriesIDsAfterFiltering.Or(seriesIDs)
 	return nil
 }
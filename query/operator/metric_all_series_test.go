@@ -68,9 +68,18 @@ func TestMetricAllSeries_Execute(t *testing.T) {
 		ctx.SeriesIDsAfterFiltering = roaring.New()
 		op := NewMetricAllSeries(ctx, shard)
 		indexDB.EXPECT().GetSeriesIDsForMetric(gomock.Any()).Return(roaring.BitmapOf(3, 5), nil)
+		indexDB.EXPECT().GetDeletedSeriesIDs(gomock.Any(), gomock.Any()).Return(nil)
 		assert.NoError(t, op.Execute())
 		assert.Equal(t, roaring.BitmapOf(0, 3, 5), ctx.SeriesIDsAfterFiltering)
 	})
+	t.Run("remove deleted series ids", func(t *testing.T) {
+		ctx.SeriesIDsAfterFiltering = roaring.New()
+		op := NewMetricAllSeries(ctx, shard)
+		indexDB.EXPECT().GetSeriesIDsForMetric(gomock.Any()).Return(roaring.BitmapOf(3, 5), nil)
+		indexDB.EXPECT().GetDeletedSeriesIDs(gomock.Any(), gomock.Any()).Return(roaring.BitmapOf(3))
+		assert.NoError(t, op.Execute())
+		assert.Equal(t, roaring.BitmapOf(0, 5), ctx.SeriesIDsAfterFiltering)
+	})
 }
 
 func TestMetricAllSeries_Stats(t *testing.T) {
@@ -0,0 +1,66 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package operator
+
+import (
+	"github.com/lindb/common/models"
+
+	"github.com/lindb/lindb/query/context"
+	"github.com/lindb/lindb/tsdb"
+)
+
+// metricDataDrop represents shard level metric data drop operator.
+type metricDataDrop struct {
+	ctx   *context.LeafDropContext
+	shard tsdb.Shard
+
+	droppedSeries uint64
+}
+
+// NewMetricDataDrop creates a metricDataDrop instance.
+func NewMetricDataDrop(ctx *context.LeafDropContext, shard tsdb.Shard) Operator {
+	return &metricDataDrop{
+		ctx:   ctx,
+		shard: shard,
+	}
+}
+
+// Execute marks the data of all series under dropped metrics deleted.
+func (op *metricDataDrop) Execute() error {
+	for _, metricID := range op.ctx.MetricIDs {
+		droppedSeries, err := op.shard.DropMetric(metricID)
+		if err != nil {
+			return err
+		}
+		op.droppedSeries += droppedSeries
+	}
+	op.ctx.AddDroppedSeries(op.shard.ShardID(), op.droppedSeries)
+	return nil
+}
+
+// Identifier returns identifier value of metric data drop operator.
+func (op *metricDataDrop) Identifier() string {
+	return "Metric Data Drop"
+}
+
+// Stats returns the stats of metric data drop operator.
+func (op *metricDataDrop) Stats() interface{} {
+	return &models.SeriesStats{
+		NumOfSeries: op.droppedSeries,
+	}
+}
@@ -0,0 +1,56 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package operator
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/query/context"
+	"github.com/lindb/lindb/series/metric"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+	"github.com/lindb/lindb/tsdb"
+)
+
+func TestMetricDataDrop_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	shard := tsdb.NewMockShard(ctrl)
+	dropCtx := context.NewLeafDropContext(&stmtpkg.DropMetric{Namespace: "ns"}, nil, nil)
+	dropCtx.MetricIDs = []metric.ID{1, 2}
+
+	op := NewMetricDataDrop(dropCtx, shard)
+	assert.Equal(t, "Metric Data Drop", op.Identifier())
+	// drop failure
+	shard.EXPECT().DropMetric(metric.ID(1)).Return(uint64(0), fmt.Errorf("err"))
+	assert.Error(t, op.Execute())
+	assert.Empty(t, dropCtx.GetResult().DeletedSeries)
+	// drop successfully
+	op = NewMetricDataDrop(dropCtx, shard)
+	shard.EXPECT().DropMetric(metric.ID(1)).Return(uint64(3), nil)
+	shard.EXPECT().DropMetric(metric.ID(2)).Return(uint64(5), nil)
+	shard.EXPECT().ShardID().Return(models.ShardID(1))
+	assert.NoError(t, op.Execute())
+	assert.Equal(t, map[models.ShardID]uint64{1: 8}, dropCtx.GetResult().DeletedSeries)
+	assert.NotNil(t, op.(TrackableOperator).Stats())
+}
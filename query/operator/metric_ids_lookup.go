@@ -0,0 +1,60 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package operator
+
+import (
+	"github.com/lindb/lindb/query/context"
+	"github.com/lindb/lindb/series/metric"
+)
+
+// metricIDsLookup represents the ids lookup operator for the metrics which need to drop.
+type metricIDsLookup struct {
+	ctx *context.LeafDropContext
+}
+
+// NewMetricIDsLookup creates a metricIDsLookup instance.
+func NewMetricIDsLookup(ctx *context.LeafDropContext) Operator {
+	return &metricIDsLookup{
+		ctx: ctx,
+	}
+}
+
+// Execute finds the metric id by namespace/metric name, or all metric ids under namespace if drops namespace.
+func (op *metricIDsLookup) Execute() error {
+	request := op.ctx.Request
+	metaDB := op.ctx.Database.MetaDB()
+	if request.IsDropNamespace() {
+		metricIDs, err := metaDB.GetMetricIDs(request.Namespace)
+		if err != nil {
+			return err
+		}
+		op.ctx.MetricIDs = metricIDs
+		return nil
+	}
+	metricID, err := metaDB.GetMetricID(request.Namespace, request.MetricName)
+	if err != nil {
+		return err
+	}
+	op.ctx.MetricIDs = []metric.ID{metricID}
+	return nil
+}
+
+// Identifier returns identifier value of metric ids lookup operator.
+func (op *metricIDsLookup) Identifier() string {
+	return "Metric IDs Lookup"
+}
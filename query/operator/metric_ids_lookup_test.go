@@ -0,0 +1,65 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package operator
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/lindb/lindb/index"
+	"github.com/lindb/lindb/query/context"
+	"github.com/lindb/lindb/series/metric"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+	"github.com/lindb/lindb/tsdb"
+)
+
+func TestMetricIDsLookup_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := tsdb.NewMockDatabase(ctrl)
+	metaDB := index.NewMockMetricMetaDatabase(ctrl)
+	db.EXPECT().MetaDB().Return(metaDB).AnyTimes()
+
+	t.Run("find metric id failure", func(t *testing.T) {
+		ctx := context.NewLeafDropContext(&stmtpkg.DropMetric{Namespace: "ns", MetricName: "cpu"}, db, nil)
+		metaDB.EXPECT().GetMetricID("ns", "cpu").Return(metric.ID(0), fmt.Errorf("err"))
+		assert.Error(t, NewMetricIDsLookup(ctx).Execute())
+	})
+	t.Run("find metric id successfully", func(t *testing.T) {
+		ctx := context.NewLeafDropContext(&stmtpkg.DropMetric{Namespace: "ns", MetricName: "cpu"}, db, nil)
+		metaDB.EXPECT().GetMetricID("ns", "cpu").Return(metric.ID(10), nil)
+		assert.NoError(t, NewMetricIDsLookup(ctx).Execute())
+		assert.Equal(t, []metric.ID{10}, ctx.MetricIDs)
+	})
+	t.Run("find metric ids failure", func(t *testing.T) {
+		ctx := context.NewLeafDropContext(&stmtpkg.DropMetric{Namespace: "ns"}, db, nil)
+		metaDB.EXPECT().GetMetricIDs("ns").Return(nil, fmt.Errorf("err"))
+		assert.Error(t, NewMetricIDsLookup(ctx).Execute())
+	})
+	t.Run("find metric ids successfully", func(t *testing.T) {
+		ctx := context.NewLeafDropContext(&stmtpkg.DropMetric{Namespace: "ns"}, db, nil)
+		metaDB.EXPECT().GetMetricIDs("ns").Return([]metric.ID{1, 2}, nil)
+		assert.NoError(t, NewMetricIDsLookup(ctx).Execute())
+		assert.Equal(t, []metric.ID{1, 2}, ctx.MetricIDs)
+	})
+	assert.Equal(t, "Metric IDs Lookup", NewMetricIDsLookup(nil).Identifier())
+}
@@ -0,0 +1,65 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package operator
+
+import (
+	"fmt"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/flow"
+	"github.com/lindb/lindb/index"
+	"github.com/lindb/lindb/tsdb"
+)
+
+// metricLookup represents metric id/schema lookup operator.
+type metricLookup struct {
+	metaDB     index.MetricMetaDatabase
+	executeCtx *flow.StorageExecuteContext
+}
+
+// NewMetricLookup creates a metricLookup instance.
+func NewMetricLookup(executeCtx *flow.StorageExecuteContext, database tsdb.Database) Operator {
+	return &metricLookup{
+		metaDB:     database.MetaDB(),
+		executeCtx: executeCtx,
+	}
+}
+
+// Execute executes metric id/schema lookup by namespace/metric name.
+func (op *metricLookup) Execute() error {
+	query := op.executeCtx.Query
+	metricID, err := op.metaDB.GetMetricID(query.Namespace, query.MetricName)
+	if err != nil {
+		return err
+	}
+	schema, err := op.metaDB.GetSchema(metricID)
+	if err != nil {
+		return err
+	}
+	if schema == nil {
+		return fmt.Errorf("%w, metric: %s", constants.ErrMetricIDNotFound, query.MetricName)
+	}
+	op.executeCtx.Schema = schema
+	op.executeCtx.MetricID = metricID
+	return nil
+}
+
+// Identifier returns identifier value of metric lookup operator.
+func (op *metricLookup) Identifier() string {
+	return "Metric Lookup"
+}
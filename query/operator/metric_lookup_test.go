@@ -0,0 +1,72 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package operator
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/lindb/lindb/flow"
+	"github.com/lindb/lindb/index"
+	"github.com/lindb/lindb/series/metric"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+	"github.com/lindb/lindb/tsdb"
+)
+
+func TestMetricLookup_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := tsdb.NewMockDatabase(ctrl)
+	metaDB := index.NewMockMetricMetaDatabase(ctrl)
+	db.EXPECT().MetaDB().Return(metaDB).AnyTimes()
+
+	ctx := &flow.StorageExecuteContext{
+		Query: &stmtpkg.Query{Namespace: "ns", MetricName: "cpu"},
+	}
+	t.Run("find metric id failure", func(t *testing.T) {
+		op := NewMetricLookup(ctx, db)
+		metaDB.EXPECT().GetMetricID("ns", "cpu").Return(metric.ID(0), fmt.Errorf("err"))
+		assert.Error(t, op.Execute())
+	})
+	t.Run("get schema failure", func(t *testing.T) {
+		op := NewMetricLookup(ctx, db)
+		metaDB.EXPECT().GetMetricID("ns", "cpu").Return(metric.ID(10), nil)
+		metaDB.EXPECT().GetSchema(metric.ID(10)).Return(nil, fmt.Errorf("err"))
+		assert.Error(t, op.Execute())
+	})
+	t.Run("schema not found", func(t *testing.T) {
+		op := NewMetricLookup(ctx, db)
+		metaDB.EXPECT().GetMetricID("ns", "cpu").Return(metric.ID(10), nil)
+		metaDB.EXPECT().GetSchema(metric.ID(10)).Return(nil, nil)
+		assert.Error(t, op.Execute())
+	})
+	t.Run("lookup metric successfully", func(t *testing.T) {
+		op := NewMetricLookup(ctx, db)
+		schema := &metric.Schema{}
+		metaDB.EXPECT().GetMetricID("ns", "cpu").Return(metric.ID(10), nil)
+		metaDB.EXPECT().GetSchema(metric.ID(10)).Return(schema, nil)
+		assert.NoError(t, op.Execute())
+		assert.Equal(t, metric.ID(10), ctx.MetricID)
+		assert.Equal(t, schema, ctx.Schema)
+	})
+	assert.Equal(t, "Metric Lookup", NewMetricLookup(ctx, db).Identifier())
+}
@@ -0,0 +1,60 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package operator
+
+import (
+	"github.com/lindb/lindb/query/context"
+)
+
+// metricMetaDrop represents metric metadata drop operator,
+// removes metric schema/index and frees the metric from limit accounting.
+type metricMetaDrop struct {
+	ctx *context.LeafDropContext
+}
+
+// NewMetricMetaDrop creates a metricMetaDrop instance.
+func NewMetricMetaDrop(ctx *context.LeafDropContext) Operator {
+	return &metricMetaDrop{
+		ctx: ctx,
+	}
+}
+
+// Execute drops the metadata of metric/namespace.
+func (op *metricMetaDrop) Execute() (err error) {
+	request := op.ctx.Request
+	metaDB := op.ctx.Database.MetaDB()
+	if request.IsDropNamespace() {
+		_, err = metaDB.DropNamespace(request.Namespace)
+	} else {
+		_, err = metaDB.DropMetric(request.Namespace, request.MetricName)
+	}
+	if err != nil {
+		return err
+	}
+	// evict memory metric meta store of dropped metrics
+	memMetaDB := op.ctx.Database.MemMetaDB()
+	for _, metricID := range op.ctx.MetricIDs {
+		memMetaDB.DropMetricMeta(uint32(metricID))
+	}
+	return nil
+}
+
+// Identifier returns identifier value of metric metadata drop operator.
+func (op *metricMetaDrop) Identifier() string {
+	return "Metric Metadata Drop"
+}
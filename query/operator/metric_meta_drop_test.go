@@ -0,0 +1,63 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package operator
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/lindb/lindb/index"
+	"github.com/lindb/lindb/query/context"
+	"github.com/lindb/lindb/series/metric"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+	"github.com/lindb/lindb/tsdb"
+	"github.com/lindb/lindb/tsdb/memdb"
+)
+
+func TestMetricMetaDrop_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := tsdb.NewMockDatabase(ctrl)
+	metaDB := index.NewMockMetricMetaDatabase(ctrl)
+	memMetaDB := memdb.NewMockMetadataDatabase(ctrl)
+	db.EXPECT().MetaDB().Return(metaDB).AnyTimes()
+	db.EXPECT().MemMetaDB().Return(memMetaDB).AnyTimes()
+
+	dropMetricCtx := context.NewLeafDropContext(&stmtpkg.DropMetric{Namespace: "ns", MetricName: "cpu"}, db, nil)
+	dropMetricCtx.MetricIDs = []metric.ID{10}
+	metaDB.EXPECT().DropMetric("ns", "cpu").Return(metric.ID(0), fmt.Errorf("err"))
+	assert.Error(t, NewMetricMetaDrop(dropMetricCtx).Execute())
+	metaDB.EXPECT().DropMetric("ns", "cpu").Return(metric.ID(10), nil)
+	memMetaDB.EXPECT().DropMetricMeta(uint32(10))
+	assert.NoError(t, NewMetricMetaDrop(dropMetricCtx).Execute())
+
+	dropNamespaceCtx := context.NewLeafDropContext(&stmtpkg.DropMetric{Namespace: "ns"}, db, nil)
+	dropNamespaceCtx.MetricIDs = []metric.ID{1, 2}
+	metaDB.EXPECT().DropNamespace("ns").Return(nil, fmt.Errorf("err"))
+	assert.Error(t, NewMetricMetaDrop(dropNamespaceCtx).Execute())
+	metaDB.EXPECT().DropNamespace("ns").Return([]metric.ID{1, 2}, nil)
+	memMetaDB.EXPECT().DropMetricMeta(uint32(1))
+	memMetaDB.EXPECT().DropMetricMeta(uint32(2))
+	assert.NoError(t, NewMetricMetaDrop(dropNamespaceCtx).Execute())
+
+	assert.Equal(t, "Metric Metadata Drop", NewMetricMetaDrop(nil).Identifier())
+}
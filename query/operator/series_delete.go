@@ -0,0 +1,67 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package operator
+
+import (
+	"github.com/lindb/common/models"
+
+	"github.com/lindb/lindb/flow"
+	"github.com/lindb/lindb/query/context"
+	"github.com/lindb/lindb/tsdb"
+)
+
+// seriesDelete represents series delete operator.
+type seriesDelete struct {
+	ctx        *context.LeafDeleteContext
+	executeCtx *flow.ShardExecuteContext
+	shard      tsdb.Shard
+}
+
+// NewSeriesDelete creates a seriesDelete instance.
+func NewSeriesDelete(ctx *context.LeafDeleteContext, executeCtx *flow.ShardExecuteContext, shard tsdb.Shard) Operator {
+	return &seriesDelete{
+		ctx:        ctx,
+		executeCtx: executeCtx,
+		shard:      shard,
+	}
+}
+
+// Execute marks the data of filtering series deleted in time range of delete statement.
+func (op *seriesDelete) Execute() error {
+	seriesIDs := op.executeCtx.SeriesIDsAfterFiltering
+	if seriesIDs.IsEmpty() {
+		return nil
+	}
+	if err := op.shard.DeleteSeries(op.executeCtx.StorageExecuteCtx.MetricID, seriesIDs, op.ctx.Request.TimeRange); err != nil {
+		return err
+	}
+	op.ctx.AddDeletedSeries(op.shard.ShardID(), seriesIDs.GetCardinality())
+	return nil
+}
+
+// Identifier returns identifier value of series delete operator.
+func (op *seriesDelete) Identifier() string {
+	return "Series Delete"
+}
+
+// Stats returns the stats of series delete operator.
+func (op *seriesDelete) Stats() interface{} {
+	return &models.SeriesStats{
+		NumOfSeries: op.executeCtx.SeriesIDsAfterFiltering.GetCardinality(),
+	}
+}
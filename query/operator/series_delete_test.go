@@ -0,0 +1,63 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package operator
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/lindb/roaring"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/lindb/lindb/flow"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/query/context"
+	"github.com/lindb/lindb/series/metric"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+	"github.com/lindb/lindb/tsdb"
+)
+
+func TestSeriesDelete_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	shard := tsdb.NewMockShard(ctrl)
+	timeRange := timeutil.TimeRange{Start: 10, End: 100}
+	deleteCtx := context.NewLeafDeleteContext(&stmtpkg.Delete{MetricName: "cpu", TimeRange: timeRange}, nil, nil)
+	deleteCtx.StorageExecuteCtx.MetricID = 10
+	shardCtx := flow.NewShardExecuteContext(deleteCtx.StorageExecuteCtx)
+
+	op := NewSeriesDelete(deleteCtx, shardCtx, shard)
+	assert.Equal(t, "Series Delete", op.Identifier())
+	// no series
+	assert.NoError(t, op.Execute())
+	// delete failure
+	shardCtx.SeriesIDsAfterFiltering.Add(1)
+	shardCtx.SeriesIDsAfterFiltering.Add(2)
+	shard.EXPECT().DeleteSeries(metric.ID(10), roaring.BitmapOf(1, 2), timeRange).Return(fmt.Errorf("err"))
+	assert.Error(t, op.Execute())
+	assert.Empty(t, deleteCtx.GetResult().DeletedSeries)
+	// delete successfully
+	shard.EXPECT().DeleteSeries(metric.ID(10), roaring.BitmapOf(1, 2), timeRange).Return(nil)
+	shard.EXPECT().ShardID().Return(models.ShardID(1))
+	assert.NoError(t, op.Execute())
+	assert.Equal(t, map[models.ShardID]uint64{1: 2}, deleteCtx.GetResult().DeletedSeries)
+	assert.NotNil(t, op.(TrackableOperator).Stats())
+}
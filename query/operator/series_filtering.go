@@ -55,6 +55,11 @@ func (op *seriesFiltering) Execute() error {
 	if op.err != nil {
 		return op.err
 	}
+	// remove the series which are deleted in query time range
+	storageCtx := op.executeCtx.StorageExecuteCtx
+	if deletedSeriesIDs := op.indexDB.GetDeletedSeriesIDs(storageCtx.MetricID, queryStmt.TimeRange); deletedSeriesIDs != nil {
+		seriesIDs.AndNot(deletedSeriesIDs)
+	}
 	op.executeCtx.SeriesIDsAfterFiltering.Or(seriesIDs)
 	return nil
 }
@@ -40,6 +40,7 @@ func TestSeriesFiltering_Execute(t *testing.T) {
 	shard := tsdb.NewMockShard(ctrl)
 	indexDB := index.NewMockMetricIndexDatabase(ctrl)
 	shard.EXPECT().IndexDB().Return(indexDB).AnyTimes()
+	indexDB.EXPECT().GetDeletedSeriesIDs(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	storageCtx := &flow.StorageExecuteContext{
 		Query: &stmtpkg.Query{},
 		TagFilterResult: map[string]*flow.TagFilterResult{
@@ -204,6 +205,30 @@ func TestSeriesFiltering_Execute(t *testing.T) {
 	}
 }
 
+func TestSeriesFiltering_Execute_DeletedSeries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	shard := tsdb.NewMockShard(ctrl)
+	indexDB := index.NewMockMetricIndexDatabase(ctrl)
+	shard.EXPECT().IndexDB().Return(indexDB).AnyTimes()
+	storageCtx := &flow.StorageExecuteContext{
+		Query: &stmtpkg.Query{Condition: &stmtpkg.EqualsExpr{Key: "key1", Value: "value1"}},
+		TagFilterResult: map[string]*flow.TagFilterResult{
+			"key1=value1": {
+				TagKeyID:    tag.KeyID(1),
+				TagValueIDs: roaring.BitmapOf(1, 2, 3),
+			},
+		},
+	}
+	shardCtx := flow.NewShardExecuteContext(storageCtx)
+	indexDB.EXPECT().GetSeriesIDsByTagValueIDs(gomock.Any(), gomock.Any()).Return(roaring.BitmapOf(1, 2), nil)
+	indexDB.EXPECT().GetDeletedSeriesIDs(gomock.Any(), gomock.Any()).Return(roaring.BitmapOf(2))
+	op := NewSeriesFiltering(shardCtx, shard)
+	assert.NoError(t, op.Execute())
+	assert.Equal(t, roaring.BitmapOf(1), shardCtx.SeriesIDsAfterFiltering)
+}
+
 func TestSeriesFiltering_Stats(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
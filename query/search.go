@@ -30,6 +30,7 @@ import (
 	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/strutil"
+	"github.com/lindb/lindb/query/cache"
 	queryctx "github.com/lindb/lindb/query/context"
 	"github.com/lindb/lindb/query/stage"
 	trackerpkg "github.com/lindb/lindb/query/tracker"
@@ -41,12 +42,15 @@ import (
 // SearchMgr represents the dependencies for searching.
 type SearchMgr struct {
 	// for intermediate processor set reqeust id, must keep using same request id
-	RequestID    string
-	Timeout      time.Duration
-	CurNode      models.StatelessNode
-	Choose       flow.NodeChoose
-	TaskMgr      TaskManager
-	TransportMgr rpc.TransportManager
+	RequestID     string
+	Timeout       time.Duration
+	CurNode       models.StatelessNode
+	Choose        flow.NodeChoose
+	ReplicaChoose flow.ReplicaChoose
+	TaskMgr       TaskManager
+	TransportMgr  rpc.TransportManager
+	ResultCache   cache.ResultCache     // query result cache for metric data search, nil if disabled
+	ResultWriter  queryctx.ResultWriter // writes result chunks of metric data search incrementally, nil if not streaming
 }
 
 // MetricMetadataSearchWithResult represents the metadata query executor and retruns the final result set.
@@ -83,6 +87,42 @@ func MetricMetadataSearch(ctx context.Context,
 	return exec(taskCtx, req, mgr)
 }
 
+// SeriesDelete represents the series delete executor,
+// sends delete request to all replicas of shards, then returns the number of deleted series.
+func SeriesDelete(ctx context.Context,
+	param *models.ExecuteParam, statement *stmtpkg.Delete,
+	mgr *SearchMgr,
+) (any, error) {
+	return deleteExec(ctx, param, statement, mgr)
+}
+
+// MetricDrop represents the metric drop executor,
+// sends drop request to all replicas of shards, then returns the number of dropped series.
+func MetricDrop(ctx context.Context,
+	param *models.ExecuteParam, statement *stmtpkg.DropMetric,
+	mgr *SearchMgr,
+) (any, error) {
+	return deleteExec(ctx, param, statement, mgr)
+}
+
+// deleteExec executes the delete/drop statement on all replicas of shards.
+func deleteExec(ctx context.Context,
+	param *models.ExecuteParam, statement stmtpkg.Statement,
+	mgr *SearchMgr,
+) (any, error) {
+	req := models.NewRequest(mgr.CurNode.Indicator(), param.Database, param.SQL)
+	taskCtx := queryctx.NewDeleteContext(&queryctx.DeleteDeps{
+		Ctx:          ctx,
+		Request:      req,
+		Database:     param.Database,
+		Statement:    statement,
+		CurrentNode:  mgr.CurNode,
+		Choose:       mgr.ReplicaChoose,
+		TransportMgr: mgr.TransportMgr,
+	})
+	return exec(taskCtx, req, mgr)
+}
+
 // MetricMetadata represents a query executor both storage/broker side.
 // When returning query results the following is the order in which processing takes place:
 // 1) filtering
@@ -122,6 +162,11 @@ func MetricDataSearch(ctx context.Context,
 			Statement:    statement,
 			Choose:       mgr.Choose,
 			TransportMgr: mgr.TransportMgr,
+			ResultCache:  mgr.ResultCache,
+			Writer:       mgr.ResultWriter,
+			ExecSubQuery: func(subCtx queryctx.TaskContext, subReq *models.Request) (any, error) {
+				return exec(subCtx, subReq, mgr)
+			},
 		})
 	return exec(taskCtx, req, mgr)
 }
@@ -42,6 +42,62 @@ func TestSearchFail(t *testing.T) {
 	rs, err = MetricDataSearch(context.TODO(), &models.ExecuteParam{}, &stmt.Query{}, &SearchMgr{})
 	assert.Error(t, err)
 	assert.Nil(t, rs)
+	rs, err = SeriesDelete(context.TODO(), &models.ExecuteParam{}, &stmt.Delete{}, &SearchMgr{})
+	assert.Error(t, err)
+	assert.Nil(t, rs)
+	rs, err = MetricDrop(context.TODO(), &models.ExecuteParam{}, &stmt.DropMetric{}, &SearchMgr{})
+	assert.Error(t, err)
+	assert.Nil(t, rs)
+}
+
+func TestSeriesDelete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newExecutePipelineFn = NewExecutePipeline
+		ctrl.Finish()
+	}()
+
+	pipeline := NewMockPipeline(ctrl)
+	newExecutePipelineFn = func(_ *trackerpkg.StageTracker,
+		completeCallback func(err error)) Pipeline {
+		completeCallback(nil) // just mock invoke
+		return pipeline
+	}
+	pipeline.EXPECT().Execute(gomock.Any())
+	taskMgr := NewMockTaskManager(ctrl)
+	taskMgr.EXPECT().AddTask(gomock.Any(), gomock.Any())
+	taskMgr.EXPECT().RemoveTask(gomock.Any())
+	rs, err := SeriesDelete(context.TODO(), &models.ExecuteParam{Database: "test"}, &stmt.Delete{}, &SearchMgr{
+		RequestID: "xxxx-1bc",
+		TaskMgr:   taskMgr,
+	})
+	assert.NoError(t, err)
+	assert.NotNil(t, rs)
+}
+
+func TestMetricDrop(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newExecutePipelineFn = NewExecutePipeline
+		ctrl.Finish()
+	}()
+
+	pipeline := NewMockPipeline(ctrl)
+	newExecutePipelineFn = func(_ *trackerpkg.StageTracker,
+		completeCallback func(err error)) Pipeline {
+		completeCallback(nil) // just mock invoke
+		return pipeline
+	}
+	pipeline.EXPECT().Execute(gomock.Any())
+	taskMgr := NewMockTaskManager(ctrl)
+	taskMgr.EXPECT().AddTask(gomock.Any(), gomock.Any())
+	taskMgr.EXPECT().RemoveTask(gomock.Any())
+	rs, err := MetricDrop(context.TODO(), &models.ExecuteParam{Database: "test"}, &stmt.DropMetric{Namespace: "ns"}, &SearchMgr{
+		RequestID: "xxxx-1bc",
+		TaskMgr:   taskMgr,
+	})
+	assert.NoError(t, err)
+	assert.NotNil(t, rs)
 }
 
 func TestMetricMetadataSearch(t *testing.T) {
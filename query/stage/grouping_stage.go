@@ -52,7 +52,16 @@ func NewGroupingStage(leafExecuteCtx *context.LeafExecuteContext, executeCtx *fl
 // Plan returns sub execution plan tree for grouping.
 func (stage *groupingStage) Plan() PlanNode {
 	// add find grouping node
-	return NewPlanNode(operator.NewGroupingTagsLookup(stage.executeCtx))
+	node := NewPlanNode(operator.NewGroupingTagsLookup(stage.executeCtx))
+	if stage.executeCtx.ShardExecuteCtx.StorageExecuteCtx.GroupingTagsRewriter != nil {
+		// regroup series by grouping tag values which derived from group by tag functions
+		node.AddChild(NewPlanNode(operator.NewGroupingTagsRewrite(stage.executeCtx, stage.leafExecuteCtx.Database.MetaDB())))
+	}
+	if len(stage.executeCtx.ShardExecuteCtx.StorageExecuteCtx.DistinctTags) > 0 {
+		// find tag value of each series for distinct_count(tag) after grouping
+		node.AddChild(NewPlanNode(operator.NewDistinctTagsLookup(stage.executeCtx, stage.leafExecuteCtx.Database.MetaDB())))
+	}
+	return node
 }
 
 // NextStages returns the stages after grouping.
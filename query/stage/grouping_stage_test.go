@@ -27,6 +27,7 @@ import (
 	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/query/context"
+	"github.com/lindb/lindb/series/tag"
 	stmtpkg "github.com/lindb/lindb/sql/stmt"
 	"github.com/lindb/lindb/tsdb"
 )
@@ -37,7 +38,9 @@ func TestGroupingStage(t *testing.T) {
 
 	db := tsdb.NewMockDatabase(ctrl)
 	db.EXPECT().ExecutorPool().Return(&tsdb.ExecutorPool{}).AnyTimes()
-	dataLoadCtx := &flow.DataLoadContext{}
+	dataLoadCtx := &flow.DataLoadContext{
+		ShardExecuteCtx: &flow.ShardExecuteContext{StorageExecuteCtx: &flow.StorageExecuteContext{}},
+	}
 	shard := tsdb.NewMockShard(ctrl)
 	stage := NewGroupingStage(&context.LeafExecuteContext{
 		TaskCtx:  &flow.TaskContext{},
@@ -48,7 +51,15 @@ func TestGroupingStage(t *testing.T) {
 		}),
 	}, dataLoadCtx, shard)
 
-	assert.NotNil(t, stage.Plan())
+	assert.Empty(t, stage.Plan().Children())
+	// distinct tags lookup after grouping
+	db.EXPECT().MetaDB().Return(nil)
+	dataLoadCtx.ShardExecuteCtx.StorageExecuteCtx.DistinctTags = tag.Metas{{ID: 1, Key: "host"}}
+	assert.Len(t, stage.Plan().Children(), 1)
+	// grouping tags rewrite after grouping
+	db.EXPECT().MetaDB().Return(nil).Times(2)
+	dataLoadCtx.ShardExecuteCtx.StorageExecuteCtx.GroupingTagsRewriter = &flow.GroupingTagsRewriter{}
+	assert.Len(t, stage.Plan().Children(), 2)
 	stage.Complete()
 	shard.EXPECT().ShardID().Return(models.ShardID(19))
 	assert.Equal(t, "Grouping[Shard(19)]", stage.Identifier())
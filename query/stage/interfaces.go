@@ -43,6 +43,12 @@ const (
 	PhysicalPlan
 	// TaskSend represents task send stage.
 	TaskSend
+	// SeriesDelete represents series delete stage.
+	SeriesDelete
+	// ShardDelete represents shard level series delete stage.
+	ShardDelete
+	// MetricDrop represents metric/namespace drop stage.
+	MetricDrop
 )
 
 // String returns string value of stage type.
@@ -64,6 +70,12 @@ func (t Type) String() string {
 		return "PhysicalPlan"
 	case TaskSend:
 		return "TaskSend"
+	case SeriesDelete:
+		return "SeriesDelete"
+	case ShardDelete:
+		return "ShardDelete"
+	case MetricDrop:
+		return "MetricDrop"
 	default:
 		return "Unknown"
 	}
@@ -33,4 +33,7 @@ func TestType_String(t *testing.T) {
 	assert.Equal(t, "ShardLookup", ShardLookup.String())
 	assert.Equal(t, "PhysicalPlan", PhysicalPlan.String())
 	assert.Equal(t, "TaskSend", TaskSend.String())
+	assert.Equal(t, "SeriesDelete", SeriesDelete.String())
+	assert.Equal(t, "ShardDelete", ShardDelete.String())
+	assert.Equal(t, "MetricDrop", MetricDrop.String())
 }
@@ -0,0 +1,62 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package stage
+
+import (
+	"github.com/lindb/lindb/query/context"
+	"github.com/lindb/lindb/query/operator"
+)
+
+// metricDropStage represents metric/namespace drop stage.
+type metricDropStage struct {
+	baseStage
+	ctx *context.LeafDropContext
+}
+
+// NewMetricDropStage creates a metricDropStage instance.
+func NewMetricDropStage(ctx *context.LeafDropContext) Stage {
+	return &metricDropStage{
+		baseStage: baseStage{
+			stageType: MetricDrop,
+		},
+		ctx: ctx,
+	}
+}
+
+// Plan returns sub execution tree for metric drop, the nodes execute in order:
+// 1. lookup the ids of metrics which need to drop;
+// 2. mark the data of metrics deleted in each shard;
+// 3. drop the metadata of metrics after data dropped, metric ids cannot be found after metadata dropped.
+func (stage *metricDropStage) Plan() PlanNode {
+	execPlan := NewEmptyPlanNode()
+	execPlan.AddChild(NewPlanNode(operator.NewMetricIDsLookup(stage.ctx)))
+	for _, shardID := range stage.ctx.ShardIDs {
+		shard, ok := stage.ctx.Database.GetShard(shardID)
+		if !ok {
+			continue
+		}
+		execPlan.AddChild(NewPlanNode(operator.NewMetricDataDrop(stage.ctx, shard)))
+	}
+	execPlan.AddChild(NewPlanNode(operator.NewMetricMetaDrop(stage.ctx)))
+	return execPlan
+}
+
+// Identifier returns identifier value of metric drop stage.
+func (stage *metricDropStage) Identifier() string {
+	return "Metric Drop"
+}
@@ -0,0 +1,47 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package stage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/query/context"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+	"github.com/lindb/lindb/tsdb"
+)
+
+func TestMetricDropStage_Plan(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := tsdb.NewMockDatabase(ctrl)
+	db.EXPECT().GetShard(models.ShardID(1)).Return(nil, false)
+	db.EXPECT().GetShard(models.ShardID(2)).Return(tsdb.NewMockShard(ctrl), true)
+
+	ctx := context.NewLeafDropContext(&stmtpkg.DropMetric{Namespace: "ns"}, db, []models.ShardID{1, 2})
+	s := NewMetricDropStage(ctx)
+	// metric ids lookup + shard 2 data drop + metadata drop
+	assert.Len(t, s.Plan().Children(), 3)
+	assert.Equal(t, "Metric Drop", s.Identifier())
+	assert.Equal(t, MetricDrop, s.Type())
+	assert.Empty(t, s.NextStages())
+}
@@ -0,0 +1,77 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package stage
+
+import (
+	"github.com/lindb/lindb/flow"
+	"github.com/lindb/lindb/query/context"
+	"github.com/lindb/lindb/query/operator"
+)
+
+// seriesDeleteStage represents series delete stage.
+type seriesDeleteStage struct {
+	baseStage
+	ctx *context.LeafDeleteContext
+}
+
+// NewSeriesDeleteStage creates a seriesDeleteStage instance.
+func NewSeriesDeleteStage(ctx *context.LeafDeleteContext) Stage {
+	return &seriesDeleteStage{
+		baseStage: baseStage{
+			stageType: SeriesDelete,
+		},
+		ctx: ctx,
+	}
+}
+
+// Plan returns sub execution tree for metric/tag values lookup.
+func (stage *seriesDeleteStage) Plan() PlanNode {
+	execPlan := NewEmptyPlanNode()
+	execCtx := stage.ctx.StorageExecuteCtx
+	database := stage.ctx.Database
+	// add metric lookup node
+	execPlan.AddChild(NewPlanNode(operator.NewMetricLookup(execCtx, database)))
+	if execCtx.Query.Condition != nil {
+		// add tag values lookup node if delete statement has where condition
+		execPlan.AddChild(NewPlanNode(operator.NewTagValuesLookup(execCtx, database)))
+	}
+	return execPlan
+}
+
+// NextStages returns the shard delete stages after metric/tag values lookup completed.
+func (stage *seriesDeleteStage) NextStages() (stages []Stage) {
+	storageExecuteCtx := stage.ctx.StorageExecuteCtx
+	if storageExecuteCtx.Query.Condition != nil && len(storageExecuteCtx.TagFilterResult) == 0 {
+		// filter not match, no series need to delete
+		return
+	}
+	for _, shardID := range stage.ctx.ShardIDs {
+		shard, ok := stage.ctx.Database.GetShard(shardID)
+		if !ok {
+			continue
+		}
+		shardExecuteCtx := flow.NewShardExecuteContext(storageExecuteCtx)
+		stages = append(stages, NewShardDeleteStage(stage.ctx, shardExecuteCtx, shard))
+	}
+	return
+}
+
+// Identifier returns identifier value of series delete stage.
+func (stage *seriesDeleteStage) Identifier() string {
+	return "Series Delete"
+}
@@ -0,0 +1,74 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package stage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/lindb/lindb/flow"
+	"github.com/lindb/lindb/index"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/query/context"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+	"github.com/lindb/lindb/tsdb"
+)
+
+func TestSeriesDeleteStage_Plan(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := tsdb.NewMockDatabase(ctrl)
+	metaDB := index.NewMockMetricMetaDatabase(ctrl)
+	db.EXPECT().MetaDB().Return(metaDB).AnyTimes()
+
+	ctx := context.NewLeafDeleteContext(&stmtpkg.Delete{}, db, nil)
+	s := NewSeriesDeleteStage(ctx)
+	assert.Len(t, s.Plan().Children(), 1)
+
+	ctx = context.NewLeafDeleteContext(&stmtpkg.Delete{Condition: &stmtpkg.EqualsExpr{}}, db, nil)
+	s = NewSeriesDeleteStage(ctx)
+	assert.Len(t, s.Plan().Children(), 2)
+	assert.Equal(t, "Series Delete", s.Identifier())
+	assert.Equal(t, SeriesDelete, s.Type())
+}
+
+func TestSeriesDeleteStage_NextStages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := tsdb.NewMockDatabase(ctrl)
+	t.Run("tag filter result not found", func(t *testing.T) {
+		ctx := context.NewLeafDeleteContext(&stmtpkg.Delete{Condition: &stmtpkg.EqualsExpr{}}, db, []models.ShardID{1})
+		assert.Empty(t, NewSeriesDeleteStage(ctx).NextStages())
+	})
+	t.Run("plan next stages", func(t *testing.T) {
+		ctx := context.NewLeafDeleteContext(&stmtpkg.Delete{Condition: &stmtpkg.EqualsExpr{}}, db, []models.ShardID{1, 2})
+		ctx.StorageExecuteCtx.TagFilterResult = map[string]*flow.TagFilterResult{"test": nil}
+		db.EXPECT().GetShard(models.ShardID(1)).Return(nil, false)
+		db.EXPECT().GetShard(models.ShardID(2)).Return(nil, true)
+		assert.Len(t, NewSeriesDeleteStage(ctx).NextStages(), 1)
+	})
+	t.Run("delete all series", func(t *testing.T) {
+		ctx := context.NewLeafDeleteContext(&stmtpkg.Delete{}, db, []models.ShardID{1})
+		db.EXPECT().GetShard(models.ShardID(1)).Return(nil, true)
+		assert.Len(t, NewSeriesDeleteStage(ctx).NextStages(), 1)
+	})
+}
@@ -0,0 +1,67 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package stage
+
+import (
+	"fmt"
+
+	"github.com/lindb/lindb/flow"
+	"github.com/lindb/lindb/query/context"
+	"github.com/lindb/lindb/query/operator"
+	"github.com/lindb/lindb/tsdb"
+)
+
+// shardDeleteStage represents shard level series delete.
+type shardDeleteStage struct {
+	baseStage
+	ctx             *context.LeafDeleteContext
+	shardExecuteCtx *flow.ShardExecuteContext
+	shard           tsdb.Shard
+}
+
+// NewShardDeleteStage creates a shardDeleteStage instance.
+func NewShardDeleteStage(ctx *context.LeafDeleteContext, shardExecuteCtx *flow.ShardExecuteContext, shard tsdb.Shard) Stage {
+	return &shardDeleteStage{
+		baseStage: baseStage{
+			stageType: ShardDelete,
+		},
+		ctx:             ctx,
+		shardExecuteCtx: shardExecuteCtx,
+		shard:           shard,
+	}
+}
+
+// Plan returns sub execution tree for series filtering and delete.
+func (stage *shardDeleteStage) Plan() PlanNode {
+	execPlan := NewEmptyPlanNode()
+	if stage.ctx.StorageExecuteCtx.Query.Condition != nil {
+		// add shard level series filtering node
+		execPlan.AddChild(NewPlanNodeWithIgnore(operator.NewSeriesFiltering(stage.shardExecuteCtx, stage.shard)))
+	} else {
+		// add shard level all series lookup node
+		execPlan.AddChild(NewPlanNodeWithIgnore(operator.NewMetricAllSeries(stage.shardExecuteCtx, stage.shard)))
+	}
+	// add series delete node
+	execPlan.AddChild(NewPlanNode(operator.NewSeriesDelete(stage.ctx, stage.shardExecuteCtx, stage.shard)))
+	return execPlan
+}
+
+// Identifier returns identifier value of shard delete stage.
+func (stage *shardDeleteStage) Identifier() string {
+	return fmt.Sprintf("Shard Delete[Shard(%d)]", stage.shard.ShardID())
+}
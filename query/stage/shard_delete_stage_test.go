@@ -0,0 +1,52 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package stage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/lindb/lindb/index"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/query/context"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+	"github.com/lindb/lindb/tsdb"
+)
+
+func TestShardDeleteStage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	shard := tsdb.NewMockShard(ctrl)
+	indexDB := index.NewMockMetricIndexDatabase(ctrl)
+	shard.EXPECT().IndexDB().Return(indexDB).AnyTimes()
+
+	ctx := context.NewLeafDeleteContext(&stmtpkg.Delete{}, nil, nil)
+	s := NewShardDeleteStage(ctx, nil, shard)
+	assert.Len(t, s.Plan().Children(), 2)
+
+	ctx = context.NewLeafDeleteContext(&stmtpkg.Delete{Condition: &stmtpkg.EqualsExpr{}}, nil, nil)
+	s = NewShardDeleteStage(ctx, nil, shard)
+	assert.Len(t, s.Plan().Children(), 2)
+	assert.Equal(t, ShardDelete, s.Type())
+
+	shard.EXPECT().ShardID().Return(models.ShardID(19))
+	assert.Equal(t, "Shard Delete[Shard(19)]", s.Identifier())
+}
@@ -81,9 +81,9 @@ func (stage *shardScanStage) Plan() PlanNode {
 		execPlan.AddChild(NewPlanNodeWithIgnore(operator.NewDataFamilyRead(shardExecuteCtx, family)))
 	}
 
-	if shardExecuteCtx.StorageExecuteCtx.Query.HasGroupBy() {
+	if shardExecuteCtx.StorageExecuteCtx.Query.HasGroupBy() || len(shardExecuteCtx.StorageExecuteCtx.DistinctTags) > 0 {
 		// if it has grouping, do group by tag keys, else just split series ids as batch first,
-		// get grouping context if it needs
+		// get grouping context if it needs(distinct_count(tag) scans tag value of each series)
 		// group context find task maybe change shardExecuteContext.SeriesIDsAfterFiltering value.
 		execPlan.AddChild(NewPlanNodeWithIgnore(operator.NewGroupingContextBuild(shardExecuteCtx, shard)))
 	}
@@ -108,7 +108,7 @@ func (stage *shardScanStage) NextStages() (stages []Stage) {
 			ShardExecuteCtx:       shardExecuteContext,
 			LowSeriesIDsContainer: lowSeriesIDs,
 			SeriesIDHighKey:       seriesIDsHighKeys[highSeriesIDIdx],
-			IsMultiField:          len(shardExecuteContext.StorageExecuteCtx.Fields) > 1,
+			IsMultiField:          len(shardExecuteContext.StorageExecuteCtx.DownSamplingSpecs) > 1,
 			IsGrouping:            shardExecuteContext.StorageExecuteCtx.Query.HasGroupBy(),
 			PendingDataLoadTasks:  atomic.NewInt32(0),
 		}
@@ -32,6 +32,7 @@ import (
 	"github.com/lindb/lindb/models"
 	contextpkg "github.com/lindb/lindb/query/context"
 	trackerpkg "github.com/lindb/lindb/query/tracker"
+	"github.com/lindb/lindb/series/tag"
 	"github.com/lindb/lindb/sql/stmt"
 	"github.com/lindb/lindb/tsdb"
 )
@@ -80,6 +81,22 @@ func TestShardScanStage(t *testing.T) {
 			Return([]tsdb.DataFamily{tsdb.NewMockDataFamily(ctrl)})
 		assert.NotNil(t, s.Plan())
 	})
+	t.Run("distinct tags without group by", func(t *testing.T) {
+		defer func() {
+			storageCtx.Query.GroupBy = []string{"key"}
+			storageCtx.DistinctTags = nil
+		}()
+		storageCtx.Query.GroupBy = nil
+		shard.EXPECT().GetDataFamilies(gomock.Any(), gomock.Any()).
+			Return([]tsdb.DataFamily{tsdb.NewMockDataFamily(ctrl)})
+		// series lookup + family read + series limit
+		assert.Len(t, s.Plan().Children(), 3)
+		storageCtx.DistinctTags = tag.Metas{{ID: 1, Key: "host"}}
+		shard.EXPECT().GetDataFamilies(gomock.Any(), gomock.Any()).
+			Return([]tsdb.DataFamily{tsdb.NewMockDataFamily(ctrl)})
+		// with grouping context build
+		assert.Len(t, s.Plan().Children(), 4)
+	})
 
 	shardExecuteCtx.SeriesIDsAfterFiltering = roaring.BitmapOf(1, 2, 3)
 	assert.NotEmpty(t, s.NextStages())
@@ -19,10 +19,12 @@ package rpc
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"sync"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
 
@@ -72,14 +74,17 @@ type ClientConnFactory interface {
 	GetClientConn(target models.Node) (*grpc.ClientConn, error)
 	// CloseClientConn closes client connection for spec target node.
 	CloseClientConn(target models.Node) error
+	// SetTLSConfig sets the tls config for new connections, plaintext if tls config is nil.
+	SetTLSConfig(tlsCfg *tls.Config)
 }
 
 // clientConnFactory implements ClientConnFactory.
 type clientConnFactory struct {
 	// target's indicator -> connection
 	connMap map[string]*grpc.ClientConn
-	// lock to protect connMap
+	// lock to protect connMap/creds
 	mu            sync.RWMutex
+	creds         credentials.TransportCredentials
 	clientTracker *conntrack.GRPCClientTracker
 }
 
@@ -118,7 +123,7 @@ func (fct *clientConnFactory) GetClientConn(target models.Node) (*grpc.ClientCon
 	}
 	conn, err := grpcDialFn(
 		target.Indicator(),
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithTransportCredentials(fct.transportCredentials()),
 		grpc.WithStreamInterceptor(fct.clientTracker.StreamClientInterceptor()),
 		grpc.WithUnaryInterceptor(fct.clientTracker.UnaryClientInterceptor()),
 	)
@@ -130,6 +135,26 @@ func (fct *clientConnFactory) GetClientConn(target models.Node) (*grpc.ClientCon
 	return conn, nil
 }
 
+// SetTLSConfig sets the tls config for new connections, plaintext if tls config is nil.
+func (fct *clientConnFactory) SetTLSConfig(tlsCfg *tls.Config) {
+	fct.mu.Lock()
+	defer fct.mu.Unlock()
+
+	if tlsCfg == nil {
+		fct.creds = nil
+		return
+	}
+	fct.creds = credentials.NewTLS(tlsCfg)
+}
+
+// transportCredentials returns the transport credentials for dialing, must hold the lock.
+func (fct *clientConnFactory) transportCredentials() credentials.TransportCredentials {
+	if fct.creds == nil {
+		return insecure.NewCredentials()
+	}
+	return fct.creds
+}
+
 // CloseClientConn closes client connection for spec target node.
 func (fct *clientConnFactory) CloseClientConn(target models.Node) error {
 	indicator := target.Indicator()
@@ -26,12 +26,14 @@ import (
 	"github.com/lindb/common/pkg/logger"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/status"
 
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/internal/conntrack"
 	"github.com/lindb/lindb/internal/linmetric"
 	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/pkg/tlsutil"
 )
 
 //go:generate mockgen -source ./server.go -destination=./server_mock.go -package=rpc
@@ -50,6 +52,7 @@ type grpcServer struct {
 	gs          *grpc.Server
 	statistics  *metrics.GRPCServerStatistics
 	bindAddress string
+	err         error // failure when creating grpc server, returns it when starting
 }
 
 func NewGRPCServer(cfg config.GRPC, r *linmetric.Registry) GRPCServer {
@@ -64,28 +67,40 @@ func NewGRPCServer(cfg config.GRPC, r *linmetric.Registry) GRPCServer {
 			return status.Errorf(codes.Internal, "panic triggered: %v", p)
 		}),
 	}
+	serverOpts := []grpc.ServerOption{
+		grpc.ConnectionTimeout(cfg.ConnectTimeout.Duration()),
+		grpc.StreamInterceptor(grpcmiddleware.ChainStreamServer(
+			grpcServerTracker.StreamServerInterceptor(),
+			grpcrecovery.StreamServerInterceptor(opts...),
+		)),
+		grpc.UnaryInterceptor(grpcmiddleware.ChainUnaryServer(
+			grpcServerTracker.UnaryServerInterceptor(),
+			grpcrecovery.UnaryServerInterceptor(opts...),
+		)),
+		grpc.MaxConcurrentStreams(uint32(cfg.MaxConcurrentStreams)),
+	}
+	tlsCfg, err := tlsutil.NewServerConfig(&cfg.TLS)
+	if err != nil {
+		log.Error("create grpc server tls config failure", logger.Error(err))
+	}
+	if tlsCfg != nil {
+		serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(tlsCfg)))
+	}
 	return &grpcServer{
 		logger:      log,
 		statistics:  statistics,
 		bindAddress: fmt.Sprintf(":%d", cfg.Port),
-		gs: grpc.NewServer(
-			grpc.ConnectionTimeout(cfg.ConnectTimeout.Duration()),
-			grpc.StreamInterceptor(grpcmiddleware.ChainStreamServer(
-				grpcServerTracker.StreamServerInterceptor(),
-				grpcrecovery.StreamServerInterceptor(opts...),
-			)),
-			grpc.UnaryInterceptor(grpcmiddleware.ChainUnaryServer(
-				grpcServerTracker.UnaryServerInterceptor(),
-				grpcrecovery.UnaryServerInterceptor(opts...),
-			)),
-			grpc.MaxConcurrentStreams(uint32(cfg.MaxConcurrentStreams)),
-		),
+		gs:          grpc.NewServer(serverOpts...),
+		err:         err,
 	}
 }
 
 // Start listens the bind address and serves grpc tcpServer,
 // block the caller, return fatal error or non-nil error if server is not stop gracefully.
 func (s *grpcServer) Start() error {
+	if s.err != nil {
+		return s.err
+	}
 	lis, err := net.Listen("tcp", s.bindAddress)
 	if err != nil {
 		return err
@@ -0,0 +1,92 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package rpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lindb/common/pkg/ltoml"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/internal/conntrack"
+	"github.com/lindb/lindb/internal/linmetric"
+	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/tlsutil"
+)
+
+func TestGRPCServer_TLSFailure(t *testing.T) {
+	s := NewGRPCServer(config.GRPC{Port: 9921, TLS: config.TLS{Enabled: true, CertFile: "not_exist"}},
+		linmetric.BrokerRegistry)
+	assert.NotNil(t, s.GetServer())
+	assert.Error(t, s.Start())
+	s.Stop()
+}
+
+func TestGRPCServer_MutualTLS(t *testing.T) {
+	certs := mock.GenerateTLSCertificates(t)
+	s := NewGRPCServer(config.GRPC{Port: 9922, ConnectTimeout: ltoml.Duration(time.Second), TLS: config.TLS{
+		Enabled:    true,
+		CertFile:   certs.ServerCertFile,
+		KeyFile:    certs.ServerKeyFile,
+		CAFile:     certs.CAFile,
+		ClientAuth: true,
+	}}, linmetric.BrokerRegistry)
+	grpc_health_v1.RegisterHealthServer(s.GetServer(), health.NewServer())
+	go func() {
+		_ = s.Start()
+	}()
+	defer s.Stop()
+
+	target := &models.StatelessNode{HostIP: "127.0.0.1", GRPCPort: 9922}
+	check := func(tlsCfg *config.TLS) error {
+		fct := &clientConnFactory{
+			connMap:       make(map[string]*grpc.ClientConn),
+			clientTracker: conntrack.NewGRPCClientTracker(linmetric.BrokerRegistry),
+		}
+		clientCfg, err := tlsutil.NewClientConfig(tlsCfg)
+		assert.NoError(t, err)
+		fct.SetTLSConfig(clientCfg)
+		conn, err := fct.GetClientConn(target)
+		assert.NoError(t, err)
+		defer func() {
+			_ = fct.CloseClientConn(target)
+		}()
+		ctx, cancel := context.WithTimeout(context.TODO(), time.Second)
+		defer cancel()
+		_, err = grpc_health_v1.NewHealthClient(conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{}, grpc.WaitForReady(true))
+		return err
+	}
+	// mutual tls
+	assert.NoError(t, check(&config.TLS{
+		Enabled:  true,
+		CertFile: certs.ClientCertFile,
+		KeyFile:  certs.ClientKeyFile,
+		CAFile:   certs.CAFile,
+	}))
+	// without client certificate
+	assert.Error(t, check(&config.TLS{Enabled: true, CAFile: certs.CAFile}))
+	// plaintext
+	assert.Error(t, check(nil))
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/stream"
 	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/series/histogram"
 )
 
 // binaryGroupedIterator implements GroupedIterator interface.
@@ -124,6 +125,8 @@ func (b *BinaryIterator) MarshalBinary() ([]byte, error) {
 type BinaryFieldIterator struct {
 	reader *stream.Reader
 	pIt    *BinaryPrimitiveIterator
+	hIt    *BinaryHistogramIterator
+	dIt    *BinaryDistributionIterator
 }
 
 // NewFieldIterator create field iterator based on binary data
@@ -145,6 +148,22 @@ func (it *BinaryFieldIterator) Next() PrimitiveIterator {
 	length := it.reader.ReadVarint32()
 	data := it.reader.ReadBytes(int(length))
 
+	if aggType == field.Histogram {
+		if it.hIt == nil {
+			it.hIt = NewHistogramIterator(data)
+		} else {
+			it.hIt.Reset(data)
+		}
+		return it.hIt
+	}
+	if aggType.IsDistribution() {
+		if it.dIt == nil {
+			it.dIt = NewDistributionIterator(aggType, data)
+		} else {
+			it.dIt.Reset(aggType, data)
+		}
+		return it.dIt
+	}
 	if it.pIt == nil {
 		it.pIt = NewPrimitiveIterator(aggType, encoding.NewTSDDecoder(data)) // TODO get from pool?
 	} else {
@@ -197,3 +216,87 @@ func (pi *BinaryPrimitiveIterator) Next() (timeSlot int, value float64) {
 	value = math.Float64frombits(val)
 	return
 }
+
+// BinaryHistogramIterator implements HistogramIterator interface.
+type BinaryHistogramIterator struct {
+	decoder *histogram.BlockDecoder
+	idx     int
+}
+
+// NewHistogramIterator creates a histogram iterator based on histogram block.
+func NewHistogramIterator(data []byte) *BinaryHistogramIterator {
+	it := &BinaryHistogramIterator{decoder: histogram.NewBlockDecoder()}
+	it.Reset(data)
+	return it
+}
+
+func (hi *BinaryHistogramIterator) Reset(data []byte) {
+	hi.idx = 0
+	_ = hi.decoder.Reset(data)
+}
+
+func (hi *BinaryHistogramIterator) AggType() field.AggType {
+	return field.Histogram
+}
+
+func (hi *BinaryHistogramIterator) HasNext() bool {
+	return hi.idx < hi.decoder.Len()
+}
+
+func (hi *BinaryHistogramIterator) Next() (timeSlot int, value float64) {
+	timeSlot, h := hi.NextHistogram()
+	return timeSlot, h.Count
+}
+
+func (hi *BinaryHistogramIterator) NextHistogram() (timeSlot int, value *histogram.Histogram) {
+	timeSlot = int(hi.decoder.SlotAt(hi.idx))
+	value = hi.decoder.HistogramAt(hi.idx)
+	hi.idx++
+	return
+}
+
+// BinaryDistributionIterator implements DistributionIterator interface,
+// block format: (slot + count of values + values) of each time slot.
+type BinaryDistributionIterator struct {
+	aggType field.AggType
+	reader  *stream.Reader
+}
+
+// NewDistributionIterator creates a distribution iterator based on distribution block.
+func NewDistributionIterator(aggType field.AggType, data []byte) *BinaryDistributionIterator {
+	return &BinaryDistributionIterator{
+		aggType: aggType,
+		reader:  stream.NewReader(data),
+	}
+}
+
+func (di *BinaryDistributionIterator) Reset(aggType field.AggType, data []byte) {
+	di.aggType = aggType
+	di.reader.Reset(data)
+}
+
+func (di *BinaryDistributionIterator) AggType() field.AggType {
+	return di.aggType
+}
+
+func (di *BinaryDistributionIterator) HasNext() bool {
+	return !di.reader.Empty() && di.reader.Error() == nil
+}
+
+func (di *BinaryDistributionIterator) Next() (timeSlot int, value float64) {
+	timeSlot, values := di.NextValues()
+	return timeSlot, float64(len(values))
+}
+
+func (di *BinaryDistributionIterator) NextValues() (timeSlot int, values []float64) {
+	timeSlot = int(di.reader.ReadUvarint32())
+	count := int(di.reader.ReadUvarint32())
+	for i := 0; i < count; i++ {
+		value := di.reader.ReadUint64()
+		if di.reader.Error() != nil {
+			break
+		}
+		values = append(values, math.Float64frombits(value))
+	}
+	return
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/stream"
 	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/series/histogram"
 )
 
 func TestBinaryGroupedIterator(t *testing.T) {
@@ -146,3 +147,87 @@ func buildFieldIterator() []byte {
 	d, _ := writer.Bytes()
 	return d
 }
+
+func TestBinaryHistogramIterator(t *testing.T) {
+	encoder := histogram.NewBlockEncoder()
+	encoder.Append(5, &histogram.Histogram{Schema: 0, Count: 2, Sum: 3, Buckets: []histogram.Bucket{{Index: 1, Count: 2}}})
+	encoder.Append(7, &histogram.Histogram{Schema: 0, Count: 1, Sum: 4, Buckets: []histogram.Bucket{{Index: 2, Count: 1}}})
+	block, err := encoder.Bytes()
+	assert.NoError(t, err)
+	writer := stream.NewBufferWriter(nil)
+	writer.PutByte(byte(field.Histogram))
+	writer.PutVarint32(int32(len(block)))
+	writer.PutBytes(block)
+	data, err := writer.Bytes()
+	assert.NoError(t, err)
+
+	it := NewFieldIterator(data)
+	assert.True(t, it.HasNext())
+	pIt := it.Next()
+	assert.False(t, it.HasNext())
+	assert.Equal(t, field.Histogram, pIt.AggType())
+	hIt, ok := pIt.(HistogramIterator)
+	assert.True(t, ok)
+	assert.True(t, hIt.HasNext())
+	slot, count := hIt.Next()
+	assert.Equal(t, 5, slot)
+	assert.Equal(t, 2.0, count)
+	assert.True(t, hIt.HasNext())
+	slot, h := hIt.NextHistogram()
+	assert.Equal(t, 7, slot)
+	assert.Equal(t, 4.0, h.Sum)
+	assert.False(t, hIt.HasNext())
+
+	// invalid histogram block
+	hIt2 := NewHistogramIterator(block[:len(block)-1])
+	assert.False(t, hIt2.HasNext())
+}
+
+func TestBinaryDistributionIterator(t *testing.T) {
+	block := stream.NewBufferWriter(nil)
+	block.PutUvarint32(5)
+	block.PutUvarint32(2)
+	block.PutUint64(math.Float64bits(1.5))
+	block.PutUint64(math.Float64bits(3.0))
+	block.PutUvarint32(7)
+	block.PutUvarint32(1)
+	block.PutUint64(math.Float64bits(4.0))
+	blockData, err := block.Bytes()
+	assert.NoError(t, err)
+	writer := stream.NewBufferWriter(nil)
+	for _, aggType := range []field.AggType{field.Distribution, field.Distinct} {
+		writer.PutByte(byte(aggType))
+		writer.PutVarint32(int32(len(blockData)))
+		writer.PutBytes(blockData)
+	}
+	data, err := writer.Bytes()
+	assert.NoError(t, err)
+
+	it := NewFieldIterator(data)
+	for _, aggType := range []field.AggType{field.Distribution, field.Distinct} {
+		assert.True(t, it.HasNext())
+		pIt := it.Next()
+		assert.Equal(t, aggType, pIt.AggType())
+		dIt, ok := pIt.(DistributionIterator)
+		assert.True(t, ok)
+		assert.True(t, dIt.HasNext())
+		slot, values := dIt.NextValues()
+		assert.Equal(t, 5, slot)
+		assert.Equal(t, []float64{1.5, 3.0}, values)
+		assert.True(t, dIt.HasNext())
+		slot, count := dIt.Next()
+		assert.Equal(t, 7, slot)
+		assert.Equal(t, 1.0, count)
+		assert.False(t, dIt.HasNext())
+	}
+	assert.False(t, it.HasNext())
+
+	// invalid distribution block
+	dIt := NewDistributionIterator(field.Distribution, blockData[:len(blockData)-1])
+	assert.True(t, dIt.HasNext())
+	_, _ = dIt.NextValues()
+	assert.True(t, dIt.HasNext())
+	_, values := dIt.NextValues()
+	assert.Empty(t, values)
+	assert.False(t, dIt.HasNext())
+}
@@ -47,12 +47,23 @@ const (
 	Max
 	Last
 	First
+	// Histogram merges native histogram values, float value represents the count of histogram.
+	Histogram
+	// Distribution collects the value of each series, float value represents the count of values.
+	Distribution
+	// Distinct collects the unique values of series(e.g. hash of tag value), float value represents the count of values.
+	Distinct
 )
 
+// IsDistribution returns if agg type collects the value of each series rather than merging them.
+func (t AggType) IsDistribution() bool {
+	return t == Distribution || t == Distinct
+}
+
 // Aggregate aggregates two float64 values into one
 func (t AggType) Aggregate(a, b float64) float64 {
 	switch t {
-	case Sum, Count:
+	case Sum, Count, Histogram, Distribution, Distinct:
 		return a + b
 	case Last:
 		return b
@@ -79,6 +90,7 @@ const (
 	LastField
 	HistogramField // alias for sumField, only visible for tsdb
 	FirstField
+	NativeHistogramField // sparse exponential buckets histogram, one histogram value per time slot
 )
 
 // String returns the field type's string value
@@ -96,6 +108,8 @@ func (t Type) String() string {
 		return "histogram"
 	case FirstField:
 		return "first"
+	case NativeHistogramField:
+		return "native_histogram"
 	default:
 		return "unknown"
 	}
@@ -114,6 +128,8 @@ func (t Type) AggType() AggType {
 		return Last
 	case FirstField:
 		return First
+	case NativeHistogramField:
+		return Histogram
 	default:
 		panic("need impl")
 	}
@@ -133,6 +149,8 @@ func (t Type) DownSamplingFunc() function.FuncType {
 		return function.First
 	case HistogramField:
 		return function.Sum
+	case NativeHistogramField:
+		return function.HistogramCount
 	default:
 		return function.Unknown
 	}
@@ -142,35 +160,35 @@ func (t Type) IsFuncSupported(funcType function.FuncType) bool {
 	switch t {
 	case SumField:
 		switch funcType {
-		case function.Sum, function.Min, function.Max, function.Rate:
+		case function.Sum, function.Min, function.Max, function.Rate, function.Percentile, function.Median:
 			return true
 		default:
 			return false
 		}
 	case MinField:
 		switch funcType {
-		case function.Min:
+		case function.Min, function.Percentile, function.Median:
 			return true
 		default:
 			return false
 		}
 	case MaxField:
 		switch funcType {
-		case function.Max:
+		case function.Max, function.Percentile, function.Median:
 			return true
 		default:
 			return false
 		}
 	case LastField:
 		switch funcType {
-		case function.Sum, function.Min, function.Max, function.Last:
+		case function.Sum, function.Min, function.Max, function.Last, function.Percentile, function.Median:
 			return true
 		default:
 			return false
 		}
 	case FirstField:
 		switch funcType {
-		case function.Sum, function.Min, function.Max, function.First:
+		case function.Sum, function.Min, function.Max, function.First, function.Percentile, function.Median:
 			return true
 		default:
 			return false
@@ -182,6 +200,13 @@ func (t Type) IsFuncSupported(funcType function.FuncType) bool {
 		default:
 			return false
 		}
+	case NativeHistogramField:
+		switch funcType {
+		case function.Quantile, function.HistogramCount, function.HistogramSum:
+			return true
+		default:
+			return false
+		}
 	default:
 		return false
 	}
@@ -189,6 +214,14 @@ func (t Type) IsFuncSupported(funcType function.FuncType) bool {
 
 // GetFuncFieldParams returns agg type for field aggregator by given function type.
 func (t Type) GetFuncFieldParams(funcType function.FuncType) []AggType {
+	switch funcType {
+	case function.Percentile, function.Median:
+		// collects the value of each series
+		return []AggType{Distribution}
+	case function.DistinctCount:
+		// collects the tag value of each series, not based on field type
+		return []AggType{Distinct}
+	}
 	switch t {
 	case SumField:
 		return getFieldParamsForSumField(funcType)
@@ -203,6 +236,9 @@ func (t Type) GetFuncFieldParams(funcType function.FuncType) []AggType {
 	case HistogramField:
 		// Histogram field only supports sum
 		return []AggType{Sum}
+	case NativeHistogramField:
+		// native histogram field always merges histogram values
+		return []AggType{Histogram}
 	}
 	return nil
 }
@@ -222,6 +258,8 @@ func (t Type) GetDefaultFuncFieldParams() []AggType {
 		return []AggType{Max}
 	case HistogramField:
 		return []AggType{Sum}
+	case NativeHistogramField:
+		return []AggType{Histogram}
 	}
 	return nil
 }
@@ -241,6 +279,8 @@ func (t Type) GetOrderByFunc() function.FuncType {
 		return function.First
 	case HistogramField:
 		return function.Stddev
+	case NativeHistogramField:
+		return function.HistogramCount
 	default:
 		return function.Stddev
 	}
@@ -32,6 +32,7 @@ func TestDownSamplingFunc(t *testing.T) {
 	assert.Equal(t, function.Max, MaxField.DownSamplingFunc())
 	assert.Equal(t, function.Last, LastField.DownSamplingFunc())
 	assert.Equal(t, function.First, FirstField.DownSamplingFunc())
+	assert.Equal(t, function.HistogramCount, NativeHistogramField.DownSamplingFunc())
 	assert.Equal(t, function.Unknown, Unknown.DownSamplingFunc())
 }
 
@@ -41,6 +42,7 @@ func TestType_String(t *testing.T) {
 	assert.Equal(t, "min", MinField.String())
 	assert.Equal(t, "last", LastField.String())
 	assert.Equal(t, "first", FirstField.String())
+	assert.Equal(t, "native_histogram", NativeHistogramField.String())
 	assert.Equal(t, "histogram", HistogramField.String())
 	assert.Equal(t, "unknown", Unknown.String())
 	assert.Equal(t, "name", Name("name").String())
@@ -63,10 +65,21 @@ func TestIsSupportFunc(t *testing.T) {
 
 	assert.True(t, FirstField.IsFuncSupported(function.First))
 	assert.False(t, FirstField.IsFuncSupported(function.Quantile))
+	assert.True(t, NativeHistogramField.IsFuncSupported(function.Quantile))
+	assert.True(t, NativeHistogramField.IsFuncSupported(function.HistogramCount))
+	assert.True(t, NativeHistogramField.IsFuncSupported(function.HistogramSum))
+	assert.False(t, NativeHistogramField.IsFuncSupported(function.Sum))
 
 	assert.True(t, MinField.IsFuncSupported(function.Min))
 	assert.False(t, MinField.IsFuncSupported(function.Quantile))
 
+	for _, fieldType := range []Type{SumField, MinField, MaxField, LastField, FirstField} {
+		assert.True(t, fieldType.IsFuncSupported(function.Percentile))
+		assert.True(t, fieldType.IsFuncSupported(function.Median))
+	}
+	assert.False(t, NativeHistogramField.IsFuncSupported(function.Percentile))
+	assert.False(t, HistogramField.IsFuncSupported(function.Median))
+
 	assert.False(t, Unknown.IsFuncSupported(function.Quantile))
 }
 
@@ -82,6 +95,9 @@ func TestAggType_Aggregate(t *testing.T) {
 	assert.Equal(t, 99.0, LastField.AggType().Aggregate(1, 99.0))
 
 	assert.Equal(t, 1.0, FirstField.AggType().Aggregate(1, 99.0))
+	assert.Equal(t, 100.0, NativeHistogramField.AggType().Aggregate(1, 99.0))
+	assert.Equal(t, 3.0, Distribution.Aggregate(1, 2))
+	assert.Equal(t, 3.0, Distinct.Aggregate(1, 2))
 
 	assert.Panics(t, func() {
 		AggType(22).Aggregate(1, 2)
@@ -117,6 +133,18 @@ func TestType_GetFuncFieldParams(t *testing.T) {
 	assert.Equal(t, []AggType{Max}, FirstField.GetFuncFieldParams(function.Max))
 	assert.Equal(t, []AggType{Min}, FirstField.GetFuncFieldParams(function.Min))
 	assert.Equal(t, []AggType{First}, FirstField.GetFuncFieldParams(function.First))
+	assert.Equal(t, []AggType{Histogram}, NativeHistogramField.GetFuncFieldParams(function.Quantile))
+
+	assert.Equal(t, []AggType{Distribution}, LastField.GetFuncFieldParams(function.Percentile))
+	assert.Equal(t, []AggType{Distribution}, SumField.GetFuncFieldParams(function.Median))
+	assert.Equal(t, []AggType{Distinct}, Unknown.GetFuncFieldParams(function.DistinctCount))
+}
+
+func TestAggType_IsDistribution(t *testing.T) {
+	assert.True(t, Distribution.IsDistribution())
+	assert.True(t, Distinct.IsDistribution())
+	assert.False(t, Sum.IsDistribution())
+	assert.False(t, Histogram.IsDistribution())
 }
 
 func TestType_GetDefaultFuncFieldParams(t *testing.T) {
@@ -127,6 +155,7 @@ func TestType_GetDefaultFuncFieldParams(t *testing.T) {
 	assert.Equal(t, []AggType{Min}, MinField.GetDefaultFuncFieldParams())
 	assert.Equal(t, []AggType{Last}, LastField.GetDefaultFuncFieldParams())
 	assert.Equal(t, []AggType{First}, FirstField.GetDefaultFuncFieldParams())
+	assert.Equal(t, []AggType{Histogram}, NativeHistogramField.GetDefaultFuncFieldParams())
 }
 
 func Test_GetOrderByFunc(t *testing.T) {
@@ -137,4 +166,5 @@ func Test_GetOrderByFunc(t *testing.T) {
 	assert.Equal(t, function.Max, MaxField.GetOrderByFunc())
 	assert.Equal(t, function.Last, LastField.GetOrderByFunc())
 	assert.Equal(t, function.First, FirstField.GetOrderByFunc())
+	assert.Equal(t, function.HistogramCount, NativeHistogramField.GetOrderByFunc())
 }
@@ -0,0 +1,155 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package histogram
+
+import (
+	"sort"
+
+	"github.com/lindb/lindb/pkg/stream"
+)
+
+// Block layout(histogram values of one series field under time slots):
+// ┌──────────┬──────────┬──────────┬──────────┬──────────┬──────────┐
+// │  Points  │   Slot   │ Histogram│   Slot   │ Histogram│          │
+// │  Count   │  Delta   │  Value   │  Delta   │  Value   │  ......  │
+// ├──────────┼──────────┼──────────┼──────────┼──────────┼──────────┤
+// │ uvarint  │ uvarint  │          │ uvarint  │          │          │
+// └──────────┴──────────┴──────────┴──────────┴──────────┴──────────┘
+// slot delta: first point is the slot, others are delta of previous slot.
+
+// Getter represents the getter which gets histogram value by time slot.
+type Getter interface {
+	// GetHistogram returns histogram value by time slot, if it hasn't, return false.
+	GetHistogram(slot uint16) (*Histogram, bool)
+}
+
+// BlockEncoder encodes histogram values of one series field in order by time slot.
+type BlockEncoder struct {
+	slots  []uint16
+	values []*Histogram
+}
+
+// NewBlockEncoder creates a histogram block encoder.
+func NewBlockEncoder() *BlockEncoder {
+	return &BlockEncoder{}
+}
+
+// Append appends a histogram value with time slot, slot must be appended in order.
+func (e *BlockEncoder) Append(slot uint16, h *Histogram) {
+	e.slots = append(e.slots, slot)
+	e.values = append(e.values, h)
+}
+
+// Len returns the number of histogram values.
+func (e *BlockEncoder) Len() int {
+	return len(e.slots)
+}
+
+// Bytes returns the binary of histogram block, returns nil if empty.
+func (e *BlockEncoder) Bytes() ([]byte, error) {
+	if len(e.slots) == 0 {
+		return nil, nil
+	}
+	writer := stream.NewBufferWriter(nil)
+	writer.PutUvarint32(uint32(len(e.slots)))
+	prev := uint16(0)
+	for idx, slot := range e.slots {
+		writer.PutUvarint32(uint32(slot - prev))
+		prev = slot
+		e.values[idx].EncodeTo(writer)
+	}
+	return writer.Bytes()
+}
+
+// Reset resets encoder for reusing.
+func (e *BlockEncoder) Reset() {
+	e.slots = e.slots[:0]
+	e.values = e.values[:0]
+}
+
+// BlockDecoder decodes histogram block, implements encoding.TSDValueGetter(returns count of histogram).
+type BlockDecoder struct {
+	slots  []uint16
+	values []*Histogram
+}
+
+// NewBlockDecoder creates a histogram block decoder.
+func NewBlockDecoder() *BlockDecoder {
+	return &BlockDecoder{}
+}
+
+// Reset decodes the histogram block for reading.
+func (d *BlockDecoder) Reset(data []byte) error {
+	d.slots = d.slots[:0]
+	d.values = d.values[:0]
+	if len(data) == 0 {
+		return nil
+	}
+	reader := stream.NewReader(data)
+	num := int(reader.ReadUvarint32())
+	if reader.Error() != nil || num > len(data) {
+		return ErrInvalidHistogram
+	}
+	prev := uint16(0)
+	for idx := 0; idx < num; idx++ {
+		slot := prev + uint16(reader.ReadUvarint32())
+		prev = slot
+		h := &Histogram{}
+		if err := h.DecodeFrom(reader); err != nil {
+			d.slots = d.slots[:0]
+			d.values = d.values[:0]
+			return err
+		}
+		d.slots = append(d.slots, slot)
+		d.values = append(d.values, h)
+	}
+	return nil
+}
+
+// Len returns the number of histogram values.
+func (d *BlockDecoder) Len() int {
+	return len(d.slots)
+}
+
+// SlotAt returns time slot by index.
+func (d *BlockDecoder) SlotAt(idx int) uint16 {
+	return d.slots[idx]
+}
+
+// HistogramAt returns histogram value by index.
+func (d *BlockDecoder) HistogramAt(idx int) *Histogram {
+	return d.values[idx]
+}
+
+// GetHistogram returns histogram value by time slot, if it hasn't, return false.
+func (d *BlockDecoder) GetHistogram(slot uint16) (*Histogram, bool) {
+	idx := sort.Search(len(d.slots), func(i int) bool { return d.slots[i] >= slot })
+	if idx < len(d.slots) && d.slots[idx] == slot {
+		return d.values[idx], true
+	}
+	return nil, false
+}
+
+// GetValue returns count of histogram by time slot, if it hasn't, return false.
+func (d *BlockDecoder) GetValue(slot uint16) (float64, bool) {
+	h, ok := d.GetHistogram(slot)
+	if !ok {
+		return 0, false
+	}
+	return h.Count, true
+}
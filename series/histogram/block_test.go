@@ -0,0 +1,64 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package histogram
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlock_Encode_Decode(t *testing.T) {
+	encoder := NewBlockEncoder()
+	data, err := encoder.Bytes()
+	assert.NoError(t, err)
+	assert.Nil(t, data)
+
+	encoder.Append(5, &Histogram{Schema: 0, Count: 1, Sum: 2, Buckets: []Bucket{{Index: 1, Count: 1}}})
+	encoder.Append(10, &Histogram{Schema: 1, Count: 3, Sum: 6, Buckets: []Bucket{{Index: 2, Count: 3}}})
+	assert.Equal(t, 2, encoder.Len())
+	data, err = encoder.Bytes()
+	assert.NoError(t, err)
+
+	decoder := NewBlockDecoder()
+	assert.NoError(t, decoder.Reset(data))
+	assert.Equal(t, 2, decoder.Len())
+	assert.Equal(t, uint16(5), decoder.SlotAt(0))
+	assert.Equal(t, uint16(10), decoder.SlotAt(1))
+	assert.Equal(t, 3.0, decoder.HistogramAt(1).Count)
+
+	h, ok := decoder.GetHistogram(10)
+	assert.True(t, ok)
+	assert.Equal(t, 6.0, h.Sum)
+	_, ok = decoder.GetHistogram(7)
+	assert.False(t, ok)
+	v, ok := decoder.GetValue(5)
+	assert.True(t, ok)
+	assert.Equal(t, 1.0, v)
+	_, ok = decoder.GetValue(11)
+	assert.False(t, ok)
+
+	// reuse decoder
+	assert.NoError(t, decoder.Reset(nil))
+	assert.Zero(t, decoder.Len())
+	assert.Error(t, decoder.Reset(data[:len(data)-1]))
+	assert.Zero(t, decoder.Len())
+
+	encoder.Reset()
+	assert.Zero(t, encoder.Len())
+}
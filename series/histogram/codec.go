@@ -0,0 +1,173 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package histogram
+
+import (
+	"errors"
+	"math"
+
+	"github.com/lindb/lindb/pkg/stream"
+)
+
+// ErrInvalidHistogram represents histogram binary data is invalid.
+var ErrInvalidHistogram = errors.New("invalid histogram data")
+
+const (
+	flagIntegerCounts byte = 1 << iota
+	flagZeroBucket
+	flagCustomBounds
+)
+
+// Histogram value layout:
+// ┌──────────┬──────────┬──────────┬──────────┬──────────┬──────────┬──────────┐
+// │  Flags   │  Schema  │  Count   │   Sum    │   Zero   │ Buckets  │  Custom  │
+// │          │          │          │          │ (option) │          │ (option) │
+// ├──────────┼──────────┼──────────┼──────────┼──────────┼──────────┼──────────┤
+// │  1 Byte  │ varint32 │  count   │ 8 Bytes  │          │          │          │
+// └──────────┴──────────┴──────────┴──────────┴──────────┴──────────┴──────────┘
+// Zero: threshold(8 Bytes) + count
+// Buckets: number(uvarint32) + first index(varint32) + [index delta-1(uvarint32)...] + [count...]
+// Custom: number(uvarint32) + [bound(8 Bytes)...]
+// count: uvarint64 if all counts are integers, else 8 Bytes float64
+
+// MarshalBinary marshals histogram into compact binary.
+func (h *Histogram) MarshalBinary() ([]byte, error) {
+	writer := stream.NewBufferWriter(nil)
+	h.EncodeTo(writer)
+	return writer.Bytes()
+}
+
+// EncodeTo writes histogram binary into buffer writer.
+func (h *Histogram) EncodeTo(writer *stream.BufferWriter) {
+	flags := byte(0)
+	if h.isIntegerCounts() {
+		flags |= flagIntegerCounts
+	}
+	if h.ZeroCount > 0 || h.ZeroThreshold > 0 {
+		flags |= flagZeroBucket
+	}
+	if h.IsCustom() {
+		flags |= flagCustomBounds
+	}
+	integer := flags&flagIntegerCounts != 0
+	writer.PutByte(flags)
+	writer.PutVarint32(h.Schema)
+	putCount(writer, h.Count, integer)
+	writer.PutUint64(math.Float64bits(h.Sum))
+	if flags&flagZeroBucket != 0 {
+		writer.PutUint64(math.Float64bits(h.ZeroThreshold))
+		putCount(writer, h.ZeroCount, integer)
+	}
+	writer.PutUvarint32(uint32(len(h.Buckets)))
+	for idx, b := range h.Buckets {
+		if idx == 0 {
+			writer.PutVarint32(b.Index)
+		} else {
+			writer.PutUvarint32(uint32(b.Index - h.Buckets[idx-1].Index - 1))
+		}
+	}
+	for _, b := range h.Buckets {
+		putCount(writer, b.Count, integer)
+	}
+	if flags&flagCustomBounds != 0 {
+		writer.PutUvarint32(uint32(len(h.CustomBounds)))
+		for _, bound := range h.CustomBounds {
+			writer.PutUint64(math.Float64bits(bound))
+		}
+	}
+}
+
+// UnmarshalBinary unmarshalls histogram from binary.
+func (h *Histogram) UnmarshalBinary(data []byte) error {
+	return h.DecodeFrom(stream.NewReader(data))
+}
+
+// DecodeFrom reads histogram from stream reader.
+func (h *Histogram) DecodeFrom(reader *stream.Reader) error {
+	h.Reset()
+	flags := reader.ReadByte()
+	integer := flags&flagIntegerCounts != 0
+	h.Schema = reader.ReadVarint32()
+	h.Count = readCount(reader, integer)
+	h.Sum = math.Float64frombits(reader.ReadUint64())
+	if flags&flagZeroBucket != 0 {
+		h.ZeroThreshold = math.Float64frombits(reader.ReadUint64())
+		h.ZeroCount = readCount(reader, integer)
+	}
+	numOfBuckets := int(reader.ReadUvarint32())
+	if reader.Error() != nil || numOfBuckets > len(reader.UnreadSlice()) {
+		return ErrInvalidHistogram
+	}
+	for idx := 0; idx < numOfBuckets; idx++ {
+		var index int32
+		if idx == 0 {
+			index = reader.ReadVarint32()
+		} else {
+			index = h.Buckets[idx-1].Index + int32(reader.ReadUvarint32()) + 1
+		}
+		h.Buckets = append(h.Buckets, Bucket{Index: index})
+	}
+	for idx := range h.Buckets {
+		h.Buckets[idx].Count = readCount(reader, integer)
+	}
+	if flags&flagCustomBounds != 0 {
+		numOfBounds := int(reader.ReadUvarint32())
+		if reader.Error() != nil || numOfBounds*8 > len(reader.UnreadSlice()) {
+			return ErrInvalidHistogram
+		}
+		for idx := 0; idx < numOfBounds; idx++ {
+			h.CustomBounds = append(h.CustomBounds, math.Float64frombits(reader.ReadUint64()))
+		}
+	}
+	if reader.Error() != nil {
+		return ErrInvalidHistogram
+	}
+	return nil
+}
+
+// isIntegerCounts checks if all counts of histogram are integers.
+func (h *Histogram) isIntegerCounts() bool {
+	if !isInteger(h.Count) || !isInteger(h.ZeroCount) {
+		return false
+	}
+	for _, b := range h.Buckets {
+		if !isInteger(b.Count) {
+			return false
+		}
+	}
+	return true
+}
+
+func isInteger(v float64) bool {
+	return v >= 0 && v < math.MaxInt64 && v == math.Trunc(v)
+}
+
+func putCount(writer *stream.BufferWriter, v float64, integer bool) {
+	if integer {
+		writer.PutUvarint64(uint64(v))
+		return
+	}
+	writer.PutUint64(math.Float64bits(v))
+}
+
+func readCount(reader *stream.Reader, integer bool) float64 {
+	if integer {
+		return float64(reader.ReadUvarint64())
+	}
+	return math.Float64frombits(reader.ReadUint64())
+}
@@ -0,0 +1,69 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package histogram
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHistogram_Codec(t *testing.T) {
+	cases := []*Histogram{
+		{Schema: 3, Count: 10, Sum: 100.5, Buckets: []Bucket{{Index: -2, Count: 3}, {Index: 5, Count: 7}}},
+		{Schema: 0, Count: 2.5, Sum: 1, ZeroThreshold: 0.001, ZeroCount: 1.5, Buckets: []Bucket{{Index: 1, Count: 1}}},
+		{Schema: CustomBucketsSchema, Count: 3, Sum: 20, CustomBounds: []float64{1, 5, math.Inf(1)},
+			Buckets: []Bucket{{Index: 0, Count: 1}, {Index: 2, Count: 2}}},
+		{Schema: 1},
+	}
+	for _, h := range cases {
+		data, err := h.MarshalBinary()
+		assert.NoError(t, err)
+		h2 := &Histogram{}
+		assert.NoError(t, h2.UnmarshalBinary(data))
+		if len(h.Buckets) == 0 {
+			assert.Empty(t, h2.Buckets)
+			continue
+		}
+		assert.Equal(t, h, h2)
+	}
+}
+
+func TestHistogram_Codec_Compact(t *testing.T) {
+	h := &Histogram{Schema: 3, Count: 60, Sum: 1000}
+	for i := 0; i < 20; i++ {
+		h.Buckets = append(h.Buckets, Bucket{Index: int32(i), Count: 3})
+	}
+	data, err := h.MarshalBinary()
+	assert.NoError(t, err)
+	// flags+schema+count+sum+number of buckets+first index+deltas+counts
+	assert.Equal(t, 1+1+1+8+1+1+19+20, len(data))
+}
+
+func TestHistogram_Decode_Error(t *testing.T) {
+	h := &Histogram{Schema: CustomBucketsSchema, Count: 3, Sum: 20, CustomBounds: []float64{1, 5, math.Inf(1)},
+		Buckets: []Bucket{{Index: 0, Count: 1}, {Index: 2, Count: 2}}}
+	data, err := h.MarshalBinary()
+	assert.NoError(t, err)
+	for i := 0; i < len(data); i++ {
+		h2 := &Histogram{}
+		assert.Error(t, h2.UnmarshalBinary(data[:i]))
+	}
+	assert.Error(t, (&Histogram{}).UnmarshalBinary(nil))
+}
@@ -0,0 +1,141 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package histogram
+
+import (
+	"math"
+)
+
+// boundTolerance represents the tolerance when checking if bound is an exponential bucket bound.
+const boundTolerance = 1e-6
+
+// FromExplicitBounds creates a histogram from explicit bounds(compound field), values are count of each bucket.
+// If bounds are contiguous exponential bucket bounds(first bound is 0 as zero bucket, last bound is +Inf),
+// returns a sparse exponential histogram, else returns a histogram with custom bucket bounds.
+func FromExplicitBounds(bounds, values []float64, sum, count float64) *Histogram {
+	h := &Histogram{Sum: sum, Count: count}
+	if schema, ok := detectSchema(bounds, values); ok {
+		h.Schema = schema
+		for idx, bound := range bounds {
+			v := values[idx]
+			if v <= 0 || math.IsInf(bound, 1) {
+				continue
+			}
+			if bound == 0 {
+				h.ZeroCount += v
+				continue
+			}
+			h.Buckets = append(h.Buckets, Bucket{Index: exponentialIndex(schema, bound), Count: v})
+		}
+		return h
+	}
+	h.Schema = CustomBucketsSchema
+	h.CustomBounds = append(h.CustomBounds, bounds...)
+	for idx, v := range values {
+		if v > 0 {
+			h.Buckets = append(h.Buckets, Bucket{Index: int32(idx), Count: v})
+		}
+	}
+	return h
+}
+
+// ExponentialBounds returns the explicit bounds and values of contiguous exponential buckets,
+// bounds start with 0(zero bucket) and end with +Inf, which can be converted back by FromExplicitBounds.
+func ExponentialBounds(schema, offset int32, zeroCount float64, counts []float64) (bounds, values []float64) {
+	bounds = make([]float64, 0, len(counts)+2)
+	values = make([]float64, 0, len(counts)+2)
+	bounds = append(bounds, 0)
+	values = append(values, zeroCount)
+	for idx, c := range counts {
+		// bucket index = offset + idx + 1, covers (base^(offset+idx), base^(offset+idx+1)]
+		bounds = append(bounds, exponentialBound(schema, offset+int32(idx)+1))
+		values = append(values, c)
+	}
+	bounds = append(bounds, math.Inf(1))
+	values = append(values, 0)
+	return bounds, values
+}
+
+// DownscaleExponential merges contiguous exponential buckets into coarser buckets of target schema,
+// bucket index i under schema is merged into bucket index i>>(schema-target) under target schema,
+// returns the original buckets if schema is not greater than target schema.
+func DownscaleExponential(schema, offset int32, counts []float64, target int32) (newSchema, newOffset int32, newCounts []float64) {
+	if schema <= target || len(counts) == 0 {
+		return schema, offset, counts
+	}
+	shift := uint(schema - target)
+	newOffset = offset >> shift
+	last := (offset + int32(len(counts)) - 1) >> shift
+	newCounts = make([]float64, last-newOffset+1)
+	for idx, c := range counts {
+		newCounts[((offset+int32(idx))>>shift)-newOffset] += c
+	}
+	return target, newOffset, newCounts
+}
+
+// detectSchema detects the schema of exponential buckets by explicit bounds,
+// returns false if bounds cannot be mapped into contiguous exponential buckets.
+func detectSchema(bounds, values []float64) (int32, bool) {
+	if len(bounds) != len(values) || len(bounds) < 2 {
+		return 0, false
+	}
+	if bounds[0] != 0 && values[0] > 0 {
+		// lower bound of first bucket is unknown
+		return 0, false
+	}
+	for schema := MinSchema; schema <= MaxSchema; schema++ {
+		if isExponentialBounds(schema, bounds, values) {
+			return schema, true
+		}
+	}
+	return 0, false
+}
+
+// isExponentialBounds checks if all finite bounds are contiguous exponential bucket bounds under schema.
+func isExponentialBounds(schema int32, bounds, values []float64) bool {
+	factor := math.Exp2(float64(schema))
+	hasBound := false
+	var prev int32
+	for idx, bound := range bounds {
+		if math.IsInf(bound, 1) {
+			if values[idx] > 0 {
+				return false
+			}
+			continue
+		}
+		if bound <= 0 {
+			continue
+		}
+		x := math.Log2(bound) * factor
+		index := math.Round(x)
+		if math.Abs(x-index) > boundTolerance {
+			return false
+		}
+		if hasBound && int32(index) != prev+1 {
+			return false
+		}
+		prev = int32(index)
+		hasBound = true
+	}
+	return hasBound
+}
+
+// exponentialIndex returns the index of exponential bucket by upper bound.
+func exponentialIndex(schema int32, bound float64) int32 {
+	return int32(math.Round(math.Log2(bound) * math.Exp2(float64(schema))))
+}
@@ -0,0 +1,88 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package histogram
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromExplicitBounds_Exponential(t *testing.T) {
+	bounds, values := ExponentialBounds(1, 2, 1, []float64{2, 0, 3})
+	assert.Equal(t, []float64{0, exponentialBound(1, 3), exponentialBound(1, 4), exponentialBound(1, 5), math.Inf(1)}, bounds)
+	assert.Equal(t, []float64{1, 2, 0, 3, 0}, values)
+
+	h := FromExplicitBounds(bounds, values, 20, 6)
+	assert.False(t, h.IsCustom())
+	assert.Equal(t, int32(1), h.Schema)
+	assert.Equal(t, 1.0, h.ZeroCount)
+	assert.Equal(t, 6.0, h.Count)
+	assert.Equal(t, 20.0, h.Sum)
+	assert.Equal(t, []Bucket{{Index: 3, Count: 2}, {Index: 5, Count: 3}}, h.Buckets)
+
+	// power of 2 bounds
+	h = FromExplicitBounds([]float64{1, 2, 4, 8, math.Inf(1)}, []float64{0, 1, 1, 1, 0}, 10, 3)
+	assert.False(t, h.IsCustom())
+	assert.Equal(t, int32(0), h.Schema)
+	assert.Equal(t, []Bucket{{Index: 1, Count: 1}, {Index: 2, Count: 1}, {Index: 3, Count: 1}}, h.Buckets)
+}
+
+func TestFromExplicitBounds_Custom(t *testing.T) {
+	cases := []struct {
+		bounds []float64
+		values []float64
+	}{
+		{bounds: []float64{1, 5, 10, math.Inf(1)}, values: []float64{1, 2, 0, 1}},
+		// +Inf bucket has value
+		{bounds: []float64{1, 2, 4, math.Inf(1)}, values: []float64{0, 1, 1, 1}},
+		// first bound not zero and has value
+		{bounds: []float64{1, 2, 4, math.Inf(1)}, values: []float64{1, 1, 1, 0}},
+		{bounds: []float64{math.Inf(1)}, values: []float64{1}},
+	}
+	for _, c := range cases {
+		h := FromExplicitBounds(c.bounds, c.values, 1, 1)
+		assert.True(t, h.IsCustom())
+		assert.Equal(t, c.bounds, h.CustomBounds)
+		for _, b := range h.Buckets {
+			assert.Equal(t, c.values[b.Index], b.Count)
+		}
+	}
+}
+
+func TestDownscaleExponential(t *testing.T) {
+	// schema not greater than target
+	schema, offset, counts := DownscaleExponential(0, 1, []float64{1, 2}, 0)
+	assert.Equal(t, int32(0), schema)
+	assert.Equal(t, int32(1), offset)
+	assert.Equal(t, []float64{1, 2}, counts)
+	// schema 1, index 1..4 => schema 0, index 0..2
+	schema, offset, counts = DownscaleExponential(1, 1, []float64{1, 2, 3, 4}, 0)
+	assert.Equal(t, int32(0), schema)
+	assert.Equal(t, int32(0), offset)
+	assert.Equal(t, []float64{1, 5, 4}, counts)
+	// negative index, schema 2, index -3..0 => schema 0, index -1..0
+	schema, offset, counts = DownscaleExponential(2, -3, []float64{1, 1, 1, 1}, 0)
+	assert.Equal(t, int32(0), schema)
+	assert.Equal(t, int32(-1), offset)
+	assert.Equal(t, []float64{3, 1}, counts)
+	// bounds are kept after downscale
+	bounds, _ := ExponentialBounds(schema, offset, 0, counts)
+	assert.Equal(t, []float64{0, 1, 2, math.Inf(1)}, bounds)
+}
@@ -0,0 +1,332 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package histogram
+
+import (
+	"math"
+	"sort"
+)
+
+const (
+	// MinSchema represents the min schema of exponential buckets(base = 2^16).
+	MinSchema int32 = -4
+	// MaxSchema represents the max schema of exponential buckets(base = 2^(2^-8)).
+	MaxSchema int32 = 8
+	// CustomBucketsSchema represents the histogram using custom(explicit) bucket bounds.
+	CustomBucketsSchema int32 = -53
+	// MaxBuckets represents the max number of sparse buckets, histogram will be down scaled if exceeds.
+	MaxBuckets = 160
+)
+
+// Bucket represents a sparse bucket of histogram.
+// for exponential histogram, bucket covers (base^(index-1), base^index],
+// for custom bounds histogram, index is the position of bound.
+type Bucket struct {
+	Index int32
+	Count float64
+}
+
+// Histogram represents a native histogram with sparse exponential buckets
+// (like Prometheus native histogram/OpenTelemetry exponential histogram),
+// or with custom bucket bounds if the bounds cannot be mapped into exponential buckets.
+type Histogram struct {
+	Schema        int32
+	ZeroThreshold float64
+	ZeroCount     float64
+	Count         float64
+	Sum           float64
+	// Buckets represents the sparse buckets in order by index, only keeps buckets which count > 0.
+	Buckets []Bucket
+	// CustomBounds represents upper bounds of buckets when schema is CustomBucketsSchema.
+	CustomBounds []float64
+}
+
+// IsCustom returns if histogram uses custom bucket bounds.
+func (h *Histogram) IsCustom() bool {
+	return h.Schema == CustomBucketsSchema
+}
+
+// UpperBound returns the upper bound of bucket by index.
+func (h *Histogram) UpperBound(index int32) float64 {
+	if h.IsCustom() {
+		if index < 0 || int(index) >= len(h.CustomBounds) {
+			return math.Inf(1)
+		}
+		return h.CustomBounds[index]
+	}
+	return exponentialBound(h.Schema, index)
+}
+
+// LowerBound returns the lower bound of bucket by index.
+func (h *Histogram) LowerBound(index int32) float64 {
+	if h.IsCustom() {
+		if index <= 0 {
+			return 0
+		}
+		return h.UpperBound(index - 1)
+	}
+	return exponentialBound(h.Schema, index-1)
+}
+
+// Clone returns a deep copy of histogram.
+func (h *Histogram) Clone() *Histogram {
+	c := *h
+	c.Buckets = append([]Bucket(nil), h.Buckets...)
+	c.CustomBounds = append([]float64(nil), h.CustomBounds...)
+	return &c
+}
+
+// Reset resets histogram for reusing.
+func (h *Histogram) Reset() {
+	h.Schema = 0
+	h.ZeroThreshold = 0
+	h.ZeroCount = 0
+	h.Count = 0
+	h.Sum = 0
+	h.Buckets = h.Buckets[:0]
+	h.CustomBounds = h.CustomBounds[:0]
+}
+
+// Merge merges other histogram into current histogram,
+// schema is reduced to the smaller one of two histograms.
+func (h *Histogram) Merge(o *Histogram) {
+	if o == nil {
+		return
+	}
+	if h.isEmpty() {
+		// empty histogram, just copies the layout of other histogram
+		h.Schema = o.Schema
+		h.ZeroThreshold = o.ZeroThreshold
+		h.ZeroCount = o.ZeroCount
+		h.Count = o.Count
+		h.Sum = o.Sum
+		h.Buckets = append(h.Buckets[:0], o.Buckets...)
+		h.CustomBounds = append(h.CustomBounds[:0], o.CustomBounds...)
+		return
+	}
+	h.Count += o.Count
+	h.Sum += o.Sum
+	switch {
+	case h.IsCustom() || o.IsCustom():
+		h.mergeCustom(o)
+	default:
+		h.mergeExponential(o)
+	}
+}
+
+// Downscale reduces the schema of exponential histogram, merges buckets into coarser buckets.
+func (h *Histogram) Downscale(schema int32) {
+	if h.IsCustom() || schema >= h.Schema {
+		return
+	}
+	if schema < MinSchema {
+		schema = MinSchema
+	}
+	h.Buckets = downscaleBuckets(h.Buckets, h.Schema-schema)
+	h.Schema = schema
+}
+
+// Quantile returns the estimated value of given quantile(0<=q<=1),
+// buckets are interpolated exponentially for exponential histogram,
+// linearly for zero bucket and custom bounds histogram.
+func (h *Histogram) Quantile(q float64) float64 {
+	total := h.ZeroCount
+	for _, b := range h.Buckets {
+		total += b.Count
+	}
+	if total == 0 {
+		return 0
+	}
+	rank := q * total
+	if rank <= h.ZeroCount && h.ZeroCount > 0 {
+		return h.ZeroThreshold * (rank / h.ZeroCount)
+	}
+	cumulative := h.ZeroCount
+	for idx, b := range h.Buckets {
+		if cumulative+b.Count < rank && idx < len(h.Buckets)-1 {
+			cumulative += b.Count
+			continue
+		}
+		fraction := (rank - cumulative) / b.Count
+		if fraction < 0 {
+			fraction = 0
+		} else if fraction > 1 {
+			fraction = 1
+		}
+		upper := h.UpperBound(b.Index)
+		lower := h.LowerBound(b.Index)
+		if h.IsCustom() {
+			if math.IsInf(upper, 1) {
+				// cannot interpolate in +Inf bucket, returns the lower bound
+				return lower
+			}
+			return lower + (upper-lower)*fraction
+		}
+		if lower < h.ZeroThreshold {
+			lower = h.ZeroThreshold
+		}
+		if lower <= 0 {
+			return upper * fraction
+		}
+		return lower * math.Pow(upper/lower, fraction)
+	}
+	return h.ZeroThreshold
+}
+
+// isEmpty returns if histogram hasn't any observations and bucket layout.
+func (h *Histogram) isEmpty() bool {
+	return h.Count == 0 && h.Sum == 0 && h.ZeroCount == 0 && len(h.Buckets) == 0 && len(h.CustomBounds) == 0
+}
+
+// mergeExponential merges exponential histogram into current exponential histogram.
+func (h *Histogram) mergeExponential(o *Histogram) {
+	otherBuckets := o.Buckets
+	switch {
+	case h.Schema > o.Schema:
+		h.Downscale(o.Schema)
+	case h.Schema < o.Schema:
+		otherBuckets = downscaleBuckets(append([]Bucket(nil), o.Buckets...), o.Schema-h.Schema)
+	}
+	h.ZeroCount += o.ZeroCount
+	if o.ZeroThreshold > h.ZeroThreshold {
+		h.ZeroThreshold = o.ZeroThreshold
+	}
+	h.Buckets = mergeBuckets(h.Buckets, otherBuckets)
+	// move buckets which be covered by zero bucket into zero bucket
+	if h.ZeroThreshold > 0 {
+		pos := 0
+		for pos < len(h.Buckets) && exponentialBound(h.Schema, h.Buckets[pos].Index) <= h.ZeroThreshold {
+			h.ZeroCount += h.Buckets[pos].Count
+			pos++
+		}
+		if pos > 0 {
+			h.Buckets = append(h.Buckets[:0], h.Buckets[pos:]...)
+		}
+	}
+	for len(h.Buckets) > MaxBuckets && h.Schema > MinSchema {
+		h.Downscale(h.Schema - 1)
+	}
+}
+
+// mergeCustom merges two histograms when anyone uses custom bucket bounds,
+// buckets are re-bucketed by the union of upper bounds.
+func (h *Histogram) mergeCustom(o *Histogram) {
+	if h.IsCustom() && o.IsCustom() && equalBounds(h.CustomBounds, o.CustomBounds) {
+		h.Buckets = mergeBuckets(h.Buckets, o.Buckets)
+		return
+	}
+	counts := make(map[float64]float64)
+	h.collectByUpperBound(counts)
+	o.collectByUpperBound(counts)
+
+	bounds := make([]float64, 0, len(counts))
+	for bound := range counts {
+		bounds = append(bounds, bound)
+	}
+	sort.Float64s(bounds)
+	buckets := make([]Bucket, 0, len(bounds))
+	for idx, bound := range bounds {
+		if c := counts[bound]; c > 0 {
+			buckets = append(buckets, Bucket{Index: int32(idx), Count: c})
+		}
+	}
+	h.Schema = CustomBucketsSchema
+	h.CustomBounds = bounds
+	h.Buckets = buckets
+	h.ZeroCount = 0
+	h.ZeroThreshold = 0
+}
+
+// collectByUpperBound collects count of buckets by upper bound.
+func (h *Histogram) collectByUpperBound(counts map[float64]float64) {
+	if h.IsCustom() {
+		for _, bound := range h.CustomBounds {
+			if _, ok := counts[bound]; !ok {
+				counts[bound] = 0
+			}
+		}
+	}
+	if h.ZeroCount > 0 {
+		counts[h.ZeroThreshold] += h.ZeroCount
+	}
+	for _, b := range h.Buckets {
+		counts[h.UpperBound(b.Index)] += b.Count
+	}
+}
+
+// exponentialBound returns the upper bound of exponential bucket, bound = 2^(index * 2^-schema).
+func exponentialBound(schema, index int32) float64 {
+	return math.Exp2(float64(index) * math.Exp2(float64(-schema)))
+}
+
+// downscaleBuckets merges buckets into coarser buckets by schema delta, returns buckets in order.
+func downscaleBuckets(buckets []Bucket, delta int32) []Bucket {
+	if delta <= 0 || len(buckets) == 0 {
+		return buckets
+	}
+	pos := 0
+	for _, b := range buckets {
+		index := ((b.Index - 1) >> delta) + 1
+		if pos > 0 && buckets[pos-1].Index == index {
+			buckets[pos-1].Count += b.Count
+			continue
+		}
+		buckets[pos] = Bucket{Index: index, Count: b.Count}
+		pos++
+	}
+	return buckets[:pos]
+}
+
+// mergeBuckets merges two bucket lists which in order by index.
+func mergeBuckets(a, b []Bucket) []Bucket {
+	if len(b) == 0 {
+		return a
+	}
+	rs := make([]Bucket, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i].Index == b[j].Index:
+			rs = append(rs, Bucket{Index: a[i].Index, Count: a[i].Count + b[j].Count})
+			i++
+			j++
+		case a[i].Index < b[j].Index:
+			rs = append(rs, a[i])
+			i++
+		default:
+			rs = append(rs, b[j])
+			j++
+		}
+	}
+	rs = append(rs, a[i:]...)
+	rs = append(rs, b[j:]...)
+	return rs
+}
+
+// equalBounds checks if two bound lists are same.
+func equalBounds(a, b []float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for idx := range a {
+		if a[idx] != b[idx] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,150 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package histogram
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHistogram_Bounds(t *testing.T) {
+	h := &Histogram{Schema: 0}
+	assert.Equal(t, 4.0, h.UpperBound(2))
+	assert.Equal(t, 2.0, h.LowerBound(2))
+	assert.False(t, h.IsCustom())
+
+	h = &Histogram{Schema: CustomBucketsSchema, CustomBounds: []float64{1, 5, math.Inf(1)}}
+	assert.True(t, h.IsCustom())
+	assert.Equal(t, 0.0, h.LowerBound(0))
+	assert.Equal(t, 1.0, h.UpperBound(0))
+	assert.Equal(t, 1.0, h.LowerBound(1))
+	assert.Equal(t, 5.0, h.UpperBound(1))
+	assert.True(t, math.IsInf(h.UpperBound(3), 1))
+}
+
+func TestHistogram_Clone_Reset(t *testing.T) {
+	h := &Histogram{Schema: 1, Count: 3, Sum: 10, Buckets: []Bucket{{Index: 1, Count: 3}}}
+	c := h.Clone()
+	assert.Equal(t, h, c)
+	c.Buckets[0].Count = 10
+	assert.Equal(t, 3.0, h.Buckets[0].Count)
+	c.Reset()
+	assert.True(t, c.isEmpty())
+}
+
+func TestHistogram_Merge_Exponential(t *testing.T) {
+	h := &Histogram{}
+	h.Merge(nil)
+	assert.True(t, h.isEmpty())
+	// merge into empty histogram, copy layout
+	h.Merge(&Histogram{Schema: 2, Count: 2, Sum: 3, Buckets: []Bucket{{Index: 1, Count: 1}, {Index: 3, Count: 1}}})
+	assert.Equal(t, int32(2), h.Schema)
+	assert.Equal(t, []Bucket{{Index: 1, Count: 1}, {Index: 3, Count: 1}}, h.Buckets)
+	// same schema
+	h.Merge(&Histogram{Schema: 2, Count: 2, Sum: 3, Buckets: []Bucket{{Index: 2, Count: 1}, {Index: 3, Count: 1}}})
+	assert.Equal(t, 4.0, h.Count)
+	assert.Equal(t, 6.0, h.Sum)
+	assert.Equal(t, []Bucket{{Index: 1, Count: 1}, {Index: 2, Count: 1}, {Index: 3, Count: 2}}, h.Buckets)
+	// smaller schema, down scale current histogram
+	h.Merge(&Histogram{Schema: 1, Count: 1, Sum: 1, Buckets: []Bucket{{Index: 1, Count: 1}}})
+	assert.Equal(t, int32(1), h.Schema)
+	assert.Equal(t, []Bucket{{Index: 1, Count: 3}, {Index: 2, Count: 2}}, h.Buckets)
+	// bigger schema, down scale other histogram
+	h.Merge(&Histogram{Schema: 3, Count: 1, Sum: 1, Buckets: []Bucket{{Index: 5, Count: 1}}})
+	assert.Equal(t, int32(1), h.Schema)
+	assert.Equal(t, []Bucket{{Index: 1, Count: 3}, {Index: 2, Count: 3}}, h.Buckets)
+	// zero bucket
+	h.Merge(&Histogram{Schema: 1, Count: 1, ZeroThreshold: math.Sqrt2, ZeroCount: 1})
+	assert.Equal(t, 4.0, h.ZeroCount)
+	assert.Equal(t, []Bucket{{Index: 2, Count: 3}}, h.Buckets)
+}
+
+func TestHistogram_Merge_MaxBuckets(t *testing.T) {
+	h := &Histogram{Schema: MaxSchema, Count: 1, Buckets: []Bucket{{Index: 1, Count: 1}}}
+	o := &Histogram{Schema: MaxSchema}
+	for i := 0; i < MaxBuckets*2; i++ {
+		o.Buckets = append(o.Buckets, Bucket{Index: int32(i + 2), Count: 1})
+		o.Count++
+	}
+	h.Merge(o)
+	assert.True(t, len(h.Buckets) <= MaxBuckets)
+	assert.True(t, h.Schema < MaxSchema)
+	total := 0.0
+	for _, b := range h.Buckets {
+		total += b.Count
+	}
+	assert.Equal(t, h.Count, total)
+}
+
+func TestHistogram_Merge_Custom(t *testing.T) {
+	bounds := []float64{1, 5, math.Inf(1)}
+	h := &Histogram{Schema: CustomBucketsSchema, Count: 2, CustomBounds: bounds, Buckets: []Bucket{{Index: 0, Count: 2}}}
+	// same bounds
+	h.Merge(&Histogram{Schema: CustomBucketsSchema, Count: 1, CustomBounds: bounds, Buckets: []Bucket{{Index: 1, Count: 1}}})
+	assert.Equal(t, []Bucket{{Index: 0, Count: 2}, {Index: 1, Count: 1}}, h.Buckets)
+	// different bounds
+	h.Merge(&Histogram{Schema: CustomBucketsSchema, Count: 1, CustomBounds: []float64{2, math.Inf(1)},
+		Buckets: []Bucket{{Index: 0, Count: 1}}})
+	assert.Equal(t, []float64{1, 2, 5, math.Inf(1)}, h.CustomBounds)
+	assert.Equal(t, []Bucket{{Index: 0, Count: 2}, {Index: 1, Count: 1}, {Index: 2, Count: 1}}, h.Buckets)
+	// exponential histogram
+	h.Merge(&Histogram{Schema: 0, Count: 1, Buckets: []Bucket{{Index: 2, Count: 1}}})
+	assert.Equal(t, []float64{1, 2, 4, 5, math.Inf(1)}, h.CustomBounds)
+	assert.Equal(t, 5.0, h.Count)
+	assert.Equal(t, Bucket{Index: 2, Count: 1}, h.Buckets[2])
+}
+
+func TestHistogram_Downscale(t *testing.T) {
+	h := &Histogram{Schema: 2, Buckets: []Bucket{{Index: -3, Count: 1}, {Index: 1, Count: 1}, {Index: 4, Count: 1}, {Index: 5, Count: 1}}}
+	h.Downscale(3)
+	assert.Equal(t, int32(2), h.Schema)
+	h.Downscale(0)
+	assert.Equal(t, int32(0), h.Schema)
+	// (2^-1, 2^0] => 0, (2^0, 2^1] => 1, (2^1, 2^2] => 2
+	assert.Equal(t, []Bucket{{Index: 0, Count: 1}, {Index: 1, Count: 2}, {Index: 2, Count: 1}}, h.Buckets)
+	h.Downscale(-10)
+	assert.Equal(t, MinSchema, h.Schema)
+
+	c := &Histogram{Schema: CustomBucketsSchema}
+	c.Downscale(0)
+	assert.Equal(t, CustomBucketsSchema, c.Schema)
+}
+
+func TestHistogram_Quantile(t *testing.T) {
+	assert.Equal(t, 0.0, (&Histogram{}).Quantile(0.5))
+	// exponential buckets: (1,2]:1, (2,4]:1
+	h := &Histogram{Schema: 0, Buckets: []Bucket{{Index: 1, Count: 1}, {Index: 2, Count: 1}}}
+	assert.Equal(t, 2.0, h.Quantile(0.5))
+	assert.InDelta(t, 2*math.Sqrt2, h.Quantile(0.75), 1e-9)
+	assert.Equal(t, 4.0, h.Quantile(1))
+	// zero bucket
+	h.ZeroThreshold = 1
+	h.ZeroCount = 2
+	assert.Equal(t, 0.5, h.Quantile(0.25))
+	// custom bounds
+	h = &Histogram{Schema: CustomBucketsSchema, CustomBounds: []float64{10, 20, math.Inf(1)},
+		Buckets: []Bucket{{Index: 0, Count: 2}, {Index: 1, Count: 2}, {Index: 2, Count: 1}}}
+	assert.Equal(t, 10.0, h.Quantile(0.4))
+	assert.Equal(t, 15.0, h.Quantile(0.6))
+	assert.Equal(t, 20.0, h.Quantile(0.99))
+	// lower bound of first bucket is zero
+	h = &Histogram{Schema: 0, Buckets: []Bucket{{Index: math.MinInt32 + 1, Count: 1}}}
+	assert.Equal(t, 0.0, h.Quantile(0.5))
+}
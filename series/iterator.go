@@ -21,6 +21,7 @@ import (
 	enc "encoding"
 
 	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/series/histogram"
 )
 
 //go:generate mockgen -source ./iterator.go -destination=./iterator_mock.go -package=series
@@ -70,3 +71,19 @@ type PrimitiveIterator interface {
 	// Next returns the data point in the iteration.
 	Next() (timeSlot int, value float64)
 }
+
+// HistogramIterator represents an iterator over a native histogram field,
+// Next returns the count of histogram, NextHistogram returns the histogram value.
+type HistogramIterator interface {
+	PrimitiveIterator
+	// NextHistogram returns the histogram data point in the iteration.
+	NextHistogram() (timeSlot int, value *histogram.Histogram)
+}
+
+// DistributionIterator represents an iterator over the values collected from each series,
+// Next returns the count of values, NextValues returns the values of time slot.
+type DistributionIterator interface {
+	PrimitiveIterator
+	// NextValues returns the values of time slot in the iteration.
+	NextValues() (timeSlot int, values []float64)
+}
@@ -72,6 +72,7 @@ type BrokerBatchRows struct {
 	rows               []BrokerRow
 	shardGroupIterator BrokerBatchShardIterator
 	rowCount           int
+	ignoreBehind       bool // keeps the rows behind acceptable write time range, rows are replayed with original timestamp
 }
 
 func newBrokerBatchRows() *BrokerBatchRows {
@@ -92,7 +93,14 @@ func NewBrokerBatchRows() (batch *BrokerBatchRows) {
 // Release releases rows context into sync.Pool
 func (br *BrokerBatchRows) Release() { brokerBatchRowsPool.Put(br) }
 
-func (br *BrokerBatchRows) reset() { br.rowCount = 0 }
+func (br *BrokerBatchRows) reset() {
+	br.rowCount = 0
+	br.ignoreBehind = false
+}
+
+// IgnoreBehind marks the rows are replayed with original timestamp(e.g. importing exported data),
+// so that the rows behind acceptable write time range of database are not evicted.
+func (br *BrokerBatchRows) IgnoreBehind() { br.ignoreBehind = true }
 
 func (br *BrokerBatchRows) Len() int { return br.rowCount }
 
@@ -106,6 +114,9 @@ func (br *BrokerBatchRows) Rows() []BrokerRow { return br.rows[:br.rowCount] }
 
 // EvictOutOfTimeRange evicts and marks out-of-range metrics invalid
 func (br *BrokerBatchRows) EvictOutOfTimeRange(behind, ahead int64) (evicted int) {
+	if br.ignoreBehind {
+		behind = 0
+	}
 	// check metric timestamp if in acceptable time range
 	now := fasttime.UnixMilliseconds()
 	for idx := 0; idx < br.Len(); idx++ {
@@ -85,6 +85,10 @@ func assertBrokerBatchRows(t *testing.T, brokerRows *BrokerBatchRows) {
 	}
 	assert.False(t, itr.HasRowsForNextShard())
 
+	// ignore behind, only evicts rows ahead
+	brokerRows.IgnoreBehind()
+	assert.Zero(t, brokerRows.EvictOutOfTimeRange(100, 100))
+	brokerRows.ignoreBehind = false
 	// eviction
 	assert.InDelta(t, 1000,
 		brokerRows.EvictOutOfTimeRange(100, 100), 100)
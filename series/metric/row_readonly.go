@@ -27,6 +27,7 @@ import (
 	"github.com/lindb/common/proto/gen/v1/flatMetricsV1"
 
 	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/series/histogram"
 )
 
 var defaultNS = []byte(constants.DefaultNamespace)
@@ -196,13 +197,27 @@ func (itr *CompoundFieldIterator) BucketName() field.Name {
 	return field.Name(BucketNameOfHistogramExplicitBound(itr.NextExplicitBound()))
 }
 
+// Histogram returns the native histogram converted from explicit bounds of compound field.
+func (itr *CompoundFieldIterator) Histogram() *histogram.Histogram {
+	bounds := make([]float64, itr.num)
+	values := make([]float64, itr.num)
+	for idx := 0; idx < itr.num; idx++ {
+		bounds[idx] = itr.f.ExplicitBounds(idx)
+		values[idx] = itr.f.Values(idx)
+	}
+	return histogram.FromExplicitBounds(bounds, values, itr.f.Sum(), itr.f.Count())
+}
+
 const (
+	histogramName  = field.Name("Histogram")
 	histogramSum   = field.Name("HistogramSum")
 	histogramCount = field.Name("HistogramCount")
 	histogramMax   = field.Name("HistogramMax")
 	histogramMin   = field.Name("HistogramMin")
 )
 
+func (itr *CompoundFieldIterator) HistogramFieldName() field.Name { return histogramName }
+
 func (itr *CompoundFieldIterator) HistogramSumFieldName() field.Name { return histogramSum }
 
 func (itr *CompoundFieldIterator) HistogramCountFieldName() field.Name { return histogramCount }
@@ -31,11 +31,11 @@ type Schema struct {
 	TagKeys tag.Metas
 }
 
-// GetAllHistogramFields returns all histogram fields.
+// GetAllHistogramFields returns all histogram fields(include native histogram fields).
 func (s *Schema) GetAllHistogramFields() (rs field.Metas) {
-	// with format like __bucket_${boundary}
+	// with format like __bucket_${boundary} or native histogram
 	for idx := range s.Fields {
-		if s.Fields[idx].Type == field.HistogramField {
+		if fType := s.Fields[idx].Type; fType == field.HistogramField || fType == field.NativeHistogramField {
 			rs = append(rs, s.Fields[idx])
 		}
 	}
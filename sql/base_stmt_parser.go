@@ -34,7 +34,8 @@ type baseStmtParser struct {
 	exprStack *collections.Stack
 	condition stmt.Expr
 
-	limit int
+	limit    int
+	hasLimit bool // limit is set by limit clause
 
 	err error
 }
@@ -50,6 +51,7 @@ func (b *baseStmtParser) visitLimit(ctx *grammar.LimitClauseContext) {
 		return
 	}
 	b.limit = int(limit)
+	b.hasLimit = true
 }
 
 // visitMetricName visits when production metricName expression is entered
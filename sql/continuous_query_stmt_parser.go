@@ -0,0 +1,90 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"errors"
+
+	"github.com/antlr4-go/antlr/v4"
+
+	"github.com/lindb/lindb/pkg/strutil"
+	"github.com/lindb/lindb/sql/grammar"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+var (
+	errContinuousQueryInterval = errors.New("continuous query interval must be greater than 0")
+	errContinuousQueryExplain  = errors.New("continuous query not support explain query")
+)
+
+// continuousQueryStmtParser represents continuous query statement parser.
+type continuousQueryStmtParser struct {
+	cq        *stmt.ContinuousQuery
+	queryStmt *queryStmtParser
+	err       error
+}
+
+// newContinuousQueryStmtParse creates a continuous query statement parser.
+func newContinuousQueryStmtParse(op stmt.ContinuousQueryOpType) *continuousQueryStmtParser {
+	return &continuousQueryStmtParser{cq: &stmt.ContinuousQuery{Type: op}}
+}
+
+// visitCreate visits when production create continuous query expression is entered.
+func (s *continuousQueryStmtParser) visitCreate(ctx *grammar.CreateContinuousQueryStmtContext) {
+	s.cq.Interval, s.err = parseDuration(ctx.DurationLit())
+	s.cq.TargetMetric = strutil.GetStringValue(ctx.MetricName().GetText())
+	queryCtx := ctx.QueryStmt()
+	if queryCtx == nil {
+		return
+	}
+	start, stop := queryCtx.GetStart(), queryCtx.GetStop()
+	if start != nil && stop != nil && start.GetInputStream() != nil {
+		// keep the original text(including whitespace) of query statement
+		s.cq.SQL = start.GetInputStream().GetTextFromInterval(antlr.NewInterval(start.GetStart(), stop.GetStop()))
+	}
+}
+
+// visitName visits when production continuous query name expression is entered.
+func (s *continuousQueryStmtParser) visitName(ctx *grammar.CqNameContext) {
+	s.cq.Name = strutil.GetStringValue(ctx.GetText())
+}
+
+// build returns the continuous query statement.
+func (s *continuousQueryStmtParser) build() (stmt.Statement, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	if s.cq.Type != stmt.CreateContinuousQuery {
+		return s.cq, nil
+	}
+	if s.cq.Interval <= 0 {
+		return nil, errContinuousQueryInterval
+	}
+	if s.queryStmt == nil {
+		return nil, errors.New("continuous query statement not found")
+	}
+	if s.queryStmt.explain {
+		return nil, errContinuousQueryExplain
+	}
+	query, err := s.queryStmt.build()
+	if err != nil {
+		return nil, err
+	}
+	s.cq.Query = query.(*stmt.Query)
+	return s.cq, nil
+}
@@ -0,0 +1,72 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"testing"
+
+	commontimeutil "github.com/lindb/common/pkg/timeutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestCreateContinuousQueryStatement(t *testing.T) {
+	q, err := Parse("create continuous query cpu_1m every 1m into cpu_avg_1m " +
+		"as select avg(usage) from cpu where host='a' group by ip, time(1m)")
+	assert.NoError(t, err)
+	cq := q.(*stmt.ContinuousQuery)
+	assert.Equal(t, stmt.CreateContinuousQuery, cq.Type)
+	assert.Equal(t, "cpu_1m", cq.Name)
+	assert.Equal(t, commontimeutil.OneMinute, cq.Interval)
+	assert.Equal(t, "cpu_avg_1m", cq.TargetMetric)
+	assert.Equal(t, "select avg(usage) from cpu where host='a' group by ip, time(1m)", cq.SQL)
+	assert.Equal(t, "cpu", cq.Query.MetricName)
+	assert.Equal(t, []string{"ip"}, cq.Query.GroupBy)
+
+	q, err = Parse("create continuous query 'cpu.1m' every 30s into 'cpu.sum' as select sum(usage) from cpu on ns")
+	assert.NoError(t, err)
+	cq = q.(*stmt.ContinuousQuery)
+	assert.Equal(t, "cpu.1m", cq.Name)
+	assert.Equal(t, 30*commontimeutil.OneSecond, cq.Interval)
+	assert.Equal(t, "cpu.sum", cq.TargetMetric)
+	assert.Equal(t, "ns", cq.Query.Namespace)
+}
+
+func TestCreateContinuousQueryStatement_Fail(t *testing.T) {
+	_, err := Parse("create continuous query cpu every 0s into cpu_avg as select avg(usage) from cpu")
+	assert.Equal(t, errContinuousQueryInterval, err)
+	_, err = Parse("create continuous query cpu every 1m into cpu_avg as explain select avg(usage) from cpu")
+	assert.Equal(t, errContinuousQueryExplain, err)
+	_, err = Parse("create continuous query cpu every 1m into cpu_avg as select avg(usage) from cpu group by time(99999999999999999999s)")
+	assert.Error(t, err)
+	_, err = Parse("create continuous query cpu every 1m into cpu_avg")
+	assert.Error(t, err)
+}
+
+func TestShowContinuousQueriesStatement(t *testing.T) {
+	q, err := Parse("show continuous queries")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.ContinuousQuery{Type: stmt.ShowContinuousQueries}, q)
+}
+
+func TestDropContinuousQueryStatement(t *testing.T) {
+	q, err := Parse("drop continuous query cpu_1m")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.ContinuousQuery{Type: stmt.DropContinuousQuery, Name: "cpu_1m"}, q)
+}
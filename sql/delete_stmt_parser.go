@@ -0,0 +1,73 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"errors"
+	"fmt"
+
+	commontimeutil "github.com/lindb/common/pkg/timeutil"
+
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+// deleteStmtParser represents delete statement parser,
+// reuses query statement parser for from/where clause.
+type deleteStmtParser struct {
+	queryStmt *queryStmtParser
+}
+
+// newDeleteStmtParse creates a delete statement parser.
+func newDeleteStmtParse() *deleteStmtParser {
+	return &deleteStmtParser{
+		queryStmt: newQueryStmtParse(false),
+	}
+}
+
+// build returns the delete statement.
+func (s *deleteStmtParser) build() (stmt.Statement, error) {
+	q := s.queryStmt
+	if q.err != nil {
+		return nil, q.err
+	}
+	if q.metricName == "" {
+		return nil, errors.New("metric name cannot be empty")
+	}
+	if q.condition == nil && q.startTime <= 0 && q.endTime <= 0 {
+		return nil, errors.New("delete statement need tag filter or time range")
+	}
+	// delete all history data if start time not set, end time cannot be larger than now
+	now := commontimeutil.Now()
+	timeRange := timeutil.TimeRange{Start: q.startTime, End: q.endTime}
+	if timeRange.Start < 0 {
+		timeRange.Start = 0
+	}
+	if timeRange.End <= 0 || timeRange.End > now {
+		timeRange.End = now
+	}
+	if timeRange.End < timeRange.Start {
+		return nil, fmt.Errorf("start time cannot be larger than end time")
+	}
+	return &stmt.Delete{
+		Namespace:  q.namespace,
+		MetricName: q.metricName,
+		Condition:  q.condition,
+		TimeRange:  timeRange,
+	}, nil
+}
@@ -0,0 +1,81 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"testing"
+
+	commontimeutil "github.com/lindb/common/pkg/timeutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestDeleteStatement(t *testing.T) {
+	q, err := Parse("delete from cpu on ns where host='a' and ip in ('1.1.1.1') and time>'20190410 10:00:00' and time<now()-1h")
+	assert.NoError(t, err)
+	deleteStmt := q.(*stmt.Delete)
+	assert.Equal(t, "cpu", deleteStmt.MetricName)
+	assert.Equal(t, "ns", deleteStmt.Namespace)
+	assert.Equal(t, &stmt.BinaryExpr{
+		Left:     &stmt.EqualsExpr{Key: "host", Value: "a"},
+		Operator: stmt.AND,
+		Right:    &stmt.InExpr{Key: "ip", Values: []string{"1.1.1.1"}},
+	}, deleteStmt.Condition)
+	start, _ := commontimeutil.ParseTimestamp("20190410 10:00:00")
+	assert.Equal(t, start, deleteStmt.TimeRange.Start)
+	assert.True(t, deleteStmt.TimeRange.End < commontimeutil.Now()-commontimeutil.OneHour+commontimeutil.OneMinute)
+
+	// only tag filter, delete all history data
+	now := commontimeutil.Now()
+	q, err = Parse("delete from cpu where host='a'")
+	assert.NoError(t, err)
+	deleteStmt = q.(*stmt.Delete)
+	assert.Equal(t, "default-ns", deleteStmt.Namespace)
+	assert.Equal(t, int64(0), deleteStmt.TimeRange.Start)
+	assert.True(t, deleteStmt.TimeRange.End >= now)
+
+	// only time range, end time cannot be larger than now
+	q, err = Parse("delete from cpu where time>now()-1h and time<now()+1h")
+	assert.NoError(t, err)
+	deleteStmt = q.(*stmt.Delete)
+	assert.Nil(t, deleteStmt.Condition)
+	assert.True(t, deleteStmt.TimeRange.End <= commontimeutil.Now())
+}
+
+func TestDeleteStatement_Fail(t *testing.T) {
+	// where clause required
+	_, err := Parse("delete from cpu")
+	assert.Error(t, err)
+	_, err = Parse("delete from cpu where time>now()+1h")
+	assert.Error(t, err)
+	_, err = Parse("delete from cpu where time>'abc'")
+	assert.Error(t, err)
+
+	q := newDeleteStmtParse()
+	_, err = q.build()
+	assert.Error(t, err)
+	q.queryStmt.metricName = "cpu"
+	_, err = q.build()
+	assert.Error(t, err)
+	q.queryStmt.startTime = -10
+	q.queryStmt.endTime = 10
+	s, err := q.build()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), s.(*stmt.Delete).TimeRange.Start)
+}
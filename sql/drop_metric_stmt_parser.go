@@ -0,0 +1,62 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	commonconstants "github.com/lindb/common/constants"
+
+	"github.com/lindb/lindb/pkg/strutil"
+	"github.com/lindb/lindb/sql/grammar"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+// dropMetricStmtParser represents drop metric/namespace statement parser.
+type dropMetricStmtParser struct {
+	dropMetric *stmt.DropMetric
+}
+
+// newDropMetricStmtParse creates a drop metric statement parser.
+func newDropMetricStmtParse() *dropMetricStmtParser {
+	return &dropMetricStmtParser{
+		dropMetric: &stmt.DropMetric{
+			Namespace: commonconstants.DefaultNamespace,
+		},
+	}
+}
+
+// visitDropMetric visits when production drop metric expression is entered.
+func (s *dropMetricStmtParser) visitDropMetric(ctx *grammar.DropMetricStmtContext) {
+	if metricName := ctx.MetricName(); metricName != nil {
+		s.dropMetric.MetricName = strutil.GetStringValue(metricName.GetText())
+	}
+	if namespace := ctx.Namespace(); namespace != nil {
+		s.dropMetric.Namespace = strutil.GetStringValue(namespace.GetText())
+	}
+}
+
+// visitDropNamespace visits when production drop namespace expression is entered.
+func (s *dropMetricStmtParser) visitDropNamespace(ctx *grammar.DropNamespaceStmtContext) {
+	if namespace := ctx.Namespace(); namespace != nil {
+		s.dropMetric.Namespace = strutil.GetStringValue(namespace.GetText())
+	}
+}
+
+// build returns the drop metric statement.
+func (s *dropMetricStmtParser) build() (stmt.Statement, error) {
+	return s.dropMetric, nil
+}
@@ -0,0 +1,50 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestDropMetricStatement(t *testing.T) {
+	q, err := Parse("drop metric cpu on ns")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.DropMetric{Namespace: "ns", MetricName: "cpu"}, q)
+
+	q, err = Parse("drop metric 'system.cpu'")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.DropMetric{Namespace: "default-ns", MetricName: "system.cpu"}, q)
+
+	_, err = Parse("drop metric")
+	assert.Error(t, err)
+}
+
+func TestDropNamespaceStatement(t *testing.T) {
+	q, err := Parse("drop namespace 'app.ns'")
+	assert.NoError(t, err)
+	dropStmt := q.(*stmt.DropMetric)
+	assert.Equal(t, &stmt.DropMetric{Namespace: "app.ns"}, dropStmt)
+	assert.True(t, dropStmt.IsDropNamespace())
+
+	_, err = Parse("drop namespace")
+	assert.Error(t, err)
+}
@@ -5,10 +5,17 @@ grammar SQL;
 statement               : showStmt
                         | createBrokerStmt
                         | recoverStorageStmt
+                        | backupDatabaseStmt
+                        | restoreDatabaseStmt
                         | useStmt
                         | queryStmt
                         | createDatabaseStmt
                         | dropDatabaseStmt
+                        | createContinuousQueryStmt
+                        | dropContinuousQueryStmt
+                        | deleteStmt
+                        | dropMetricStmt
+                        | dropNamespaceStmt
 						| setLimitStmt
                         | ident // just for suggest filtering.
                         EOF ;
@@ -39,6 +46,8 @@ showStmt                : showMasterStmt
                         | showTagValuesStmt
 						| showRequestsStmt
 						| showRequestStmt
+                        | showContinuousQueriesStmt
+                        | showAlertsStmt
                         ;
 //meta data query statement
 showMasterStmt       : T_SHOW T_MASTER ;
@@ -60,6 +69,8 @@ showStorageMetricStmt: T_SHOW T_STORAGE T_METRIC T_WHERE metricListFilter ;
 createStorageStmt    : T_CREATE T_STORAGE json;
 createBrokerStmt     : T_CREATE T_BROKER json;
 recoverStorageStmt   : T_RECOVER T_STORAGE storageName;
+backupDatabaseStmt   : T_BACKUP T_DATASBAE databaseName T_TO backupPath;
+restoreDatabaseStmt  : T_RESTORE T_DATASBAE databaseName T_FROM backupPath;
 showSchemasStmt      : T_SHOW T_SCHEMAS ;
 createDatabaseStmt   : T_CREATE T_DATASBAE (json|optionClause);
 dropDatabaseStmt     : T_DROP T_DATASBAE databaseName;
@@ -68,12 +79,18 @@ showNameSpacesStmt   : T_SHOW T_NAMESPACES (T_WHERE T_NAMESPACE T_EQUAL prefix)?
 showMetricsStmt      : T_SHOW T_METRICS (T_ON namespace)? (T_WHERE T_METRIC T_EQUAL prefix)? limitClause?;
 showFieldsStmt       : T_SHOW T_FIELDS fromClause;
 showTagKeysStmt      : T_SHOW T_TAG T_KEYS fromClause;
+showContinuousQueriesStmt : T_SHOW T_CONTINUOUS T_QUERIES;
+showAlertsStmt       : T_SHOW T_ALERTS ;
+createContinuousQueryStmt : T_CREATE T_CONTINUOUS T_QUERY cqName T_EVERY durationLit T_INTO metricName T_AS queryStmt;
+dropContinuousQueryStmt   : T_DROP T_CONTINUOUS T_QUERY cqName;
+cqName               : ident ;
 showTagValuesStmt    : T_SHOW T_TAG T_VALUES fromClause T_WITH T_KEY T_EQUAL withTagKey whereClause? limitClause?;
 prefix               : ident ;
 withTagKey           : ident ;
 namespace            : ident ;
 databaseName         : ident ;
 storageName          : ident ;
+backupPath           : ident ;
 requestID            : ident ;
 source               : (T_STATE_MACHINE|T_STATE_REPO) ;
 // create table option
@@ -98,9 +115,14 @@ optionValue          : STRING
                      | intNumber
                      ;
 
+//data delete
+deleteStmt              : T_DELETE fromClause whereClause ;
+dropMetricStmt          : T_DROP T_METRIC metricName (T_ON namespace)? ;
+dropNamespaceStmt       : T_DROP T_NAMESPACE namespace ;
+
 //data query plan
 queryStmt               : T_EXPLAIN? sourceAndSelect whereClause? groupByClause? orderByClause? limitClause? T_WITH_VALUE?;
-sourceAndSelect         : selectExpr fromClause | fromClause selectExpr ;
+sourceAndSelect         : selectExpr (fromClause | joinClause | subQueryClause) | (fromClause | joinClause) selectExpr ;
 selectExpr              : T_SELECT fields;
 //select fields
 fields                  : field ( T_COMMA field )* ;
@@ -112,6 +134,10 @@ typeFilter              : T_TYPE T_EQUAL ident  ;
 
 //from clause
 fromClause              : T_FROM metricName (T_ON namespace)? ;
+joinClause              : T_FROM joinMetric (T_COMMA joinMetric)+ (T_ON namespace)? ;
+joinMetric              : metricName T_AS metricAlias ;
+metricAlias             : ident ;
+subQueryClause          : T_FROM T_OPEN_P queryStmt T_CLOSE_P ;
 
 //where clause
 whereClause             : T_WHERE conditionExpr;
@@ -138,8 +164,10 @@ nowFunc                 : T_NOW T_OPEN_P exprFuncParams? T_CLOSE_P ;
 //group by
 groupByClause          : T_GROUP T_BY groupByKeys (T_FILL T_OPEN_P fillOption T_CLOSE_P)? havingClause? ;
 groupByKeys            : groupByKey (T_COMMA groupByKey)* ;
-groupByKey             : ident | T_TIME T_OPEN_P durationLit T_CLOSE_P | T_TIME T_OPEN_P T_CLOSE_P;
-fillOption             : T_NULL | T_PREVIOUS | L_INT | L_DEC ;
+groupByKey             : ident | groupByTagExpr | T_TIME T_OPEN_P durationLit T_CLOSE_P | T_TIME T_OPEN_P T_CLOSE_P;
+groupByTagExpr         : groupByTagFunc T_OPEN_P ident (T_COMMA ident)* T_CLOSE_P T_AS ident ;
+groupByTagFunc         : T_REGEXP_EXTRACT | T_LABEL_REPLACE | T_LABEL_JOIN ;
+fillOption             : T_NULL | T_PREVIOUS | T_LINEAR | T_SUB? (L_INT | L_DEC) ;
 
 orderByClause          : T_ORDER T_BY sortFields ;
 sortField               : fieldExpr ( T_ASC | T_DESC )* ;
@@ -188,12 +216,18 @@ intervalItem            :
                          | T_MONTH
                          | T_YEAR
                          ;
-exprFunc                : funcName T_OPEN_P exprFuncParams? T_CLOSE_P ;
-funcName                : T_SUM | T_MIN | T_MAX | T_AVG | T_COUNT | T_LAST | T_FIRST | T_STDDEV | T_QUANTILE | T_RATE;
+exprFunc                : funcName T_OPEN_P exprFuncParams? T_CLOSE_P (T_OFFSET durationLit)? ;
+funcName                : T_SUM | T_MIN | T_MAX | T_AVG | T_COUNT | T_LAST | T_FIRST | T_STDDEV | T_QUANTILE | T_RATE | T_HISTOGRAM_COUNT | T_HISTOGRAM_SUM
+                          | T_MOVING_AVERAGE | T_DERIVATIVE | T_NON_NEGATIVE_DIFFERENCE | T_CUMULATIVE_SUM | T_DELTA | T_INCREASE | T_INTEGRAL
+                          | T_TOP | T_BOTTOM
+                          | T_ABS | T_CEIL | T_FLOOR | T_ROUND | T_LOG | T_LOG2 | T_LOG10 | T_SQRT | T_POW
+                          | T_CLAMP_MIN | T_CLAMP_MAX | T_IF | T_COALESCE
+                          | T_PERCENTILE | T_MEDIAN | T_DISTINCT_COUNT;
 exprFuncParams          : funcParam (T_COMMA funcParam)* ;
 funcParam               :
                            fieldExpr
                          | tagFilterExpr
+                         | boolExpr
                          ;
 exprAtom                :
                            ident identFilter?
@@ -230,7 +264,7 @@ value
    | arr
    | 'true'
    | 'false'
-   | 'null'
+   | T_NULL
    ;
 
 // Integer (positive or negative)
@@ -317,6 +351,38 @@ nonReservedWords      :
                         | T_STDDEV
                         | T_QUANTILE
                         | T_RATE
+                        | T_HISTOGRAM_COUNT
+                        | T_HISTOGRAM_SUM
+                        | T_MOVING_AVERAGE
+                        | T_DERIVATIVE
+                        | T_NON_NEGATIVE_DIFFERENCE
+                        | T_CUMULATIVE_SUM
+                        | T_DELTA
+                        | T_INCREASE
+                        | T_INTEGRAL
+                        | T_TOP
+                        | T_BOTTOM
+                        | T_ABS
+                        | T_CEIL
+                        | T_FLOOR
+                        | T_ROUND
+                        | T_LOG2
+                        | T_LOG10
+                        | T_SQRT
+                        | T_POW
+                        | T_CLAMP_MIN
+                        | T_CLAMP_MAX
+                        | T_IF
+                        | T_COALESCE
+                        | T_REGEXP_EXTRACT
+                        | T_LABEL_REPLACE
+                        | T_LABEL_JOIN
+                        | T_PERCENTILE
+                        | T_MEDIAN
+                        | T_DISTINCT_COUNT
+                        | T_BACKUP
+                        | T_RESTORE
+                        | T_TO
                         | T_SECOND
                         | T_MINUTE
                         | T_HOUR
@@ -342,6 +408,13 @@ nonReservedWords      :
                         | T_REQUEST
                         | T_ID
                         | T_ROLLUP
+                        | T_CONTINUOUS
+                        | T_EVERY
+                        | T_INTO
+                        | T_ALERTS
+                        | T_DELETE
+                        | T_LINEAR
+                        | T_OFFSET
                         ;
 
 STRING
@@ -390,6 +463,9 @@ T_KILL               : K I L L                          ;
 T_ON                 : O N                              ;
 T_SHOW               : S H O W                          ;
 T_RECOVER            : R E C O V E R                    ;
+T_BACKUP             : B A C K U P                      ;
+T_RESTORE            : R E S T O R E                    ;
+T_TO                 : T O                              ;
 T_USE                : U S E                            ;
 T_STATE_REPO         : S T A T E T_UNDERLINE R E P O    ;
 T_STATE_MACHINE      : S T A T E T_UNDERLINE M A C H I N E;
@@ -450,6 +526,13 @@ T_TIME               : T I M E                          ;
 T_NOW                : N O W                            ;
 T_IN                 : I N                              ;
 T_ROLLUP             : R O L L U P                      ;
+T_CONTINUOUS         : C O N T I N U O U S              ;
+T_EVERY              : E V E R Y                        ;
+T_INTO               : I N T O                          ;
+T_ALERTS             : A L E R T S                      ;
+T_DELETE             : D E L E T E                      ;
+T_LINEAR             : L I N E A R                      ;
+T_OFFSET             : O F F S E T                      ;
 
 T_LOG                : L O G                            ;
 T_PROFILE            : P R O F I L E                    ;
@@ -467,6 +550,35 @@ T_AVG                : A V G                            ;
 T_STDDEV             : S T D D E V                      ;
 T_QUANTILE           : Q U A N T I L E                  ;
 T_RATE               : R A T E                          ;
+T_HISTOGRAM_COUNT    : H I S T O G R A M '_' C O U N T  ;
+T_HISTOGRAM_SUM      : H I S T O G R A M '_' S U M      ;
+T_MOVING_AVERAGE     : M O V I N G '_' A V E R A G E    ;
+T_DERIVATIVE         : D E R I V A T I V E              ;
+T_NON_NEGATIVE_DIFFERENCE : N O N '_' N E G A T I V E '_' D I F F E R E N C E ;
+T_CUMULATIVE_SUM     : C U M U L A T I V E '_' S U M    ;
+T_DELTA              : D E L T A                        ;
+T_INCREASE           : I N C R E A S E                  ;
+T_INTEGRAL           : I N T E G R A L                  ;
+T_TOP                : T O P                            ;
+T_BOTTOM             : B O T T O M                      ;
+T_ABS                : A B S                            ;
+T_CEIL               : C E I L                          ;
+T_FLOOR              : F L O O R                        ;
+T_ROUND              : R O U N D                        ;
+T_LOG2               : L O G '2'                        ;
+T_LOG10              : L O G '10'                       ;
+T_SQRT               : S Q R T                          ;
+T_POW                : P O W                            ;
+T_CLAMP_MIN          : C L A M P '_' M I N              ;
+T_CLAMP_MAX          : C L A M P '_' M A X              ;
+T_IF                 : I F                              ;
+T_COALESCE           : C O A L E S C E                  ;
+T_REGEXP_EXTRACT     : R E G E X P '_' E X T R A C T    ;
+T_LABEL_REPLACE      : L A B E L '_' R E P L A C E      ;
+T_LABEL_JOIN         : L A B E L '_' J O I N            ;
+T_PERCENTILE         : P E R C E N T I L E              ;
+T_MEDIAN             : M E D I A N                      ;
+T_DISTINCT_COUNT     : D I S T I N C T '_' C O U N T    ;
 
 // create table option key
 T_NUM_OF_SHARD   : N U M O F S H A R D;
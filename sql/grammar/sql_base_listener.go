@@ -175,6 +175,18 @@ func (s *BaseSQLListener) EnterRecoverStorageStmt(ctx *RecoverStorageStmtContext
 // ExitRecoverStorageStmt is called when production recoverStorageStmt is exited.
 func (s *BaseSQLListener) ExitRecoverStorageStmt(ctx *RecoverStorageStmtContext) {}
 
+// EnterBackupDatabaseStmt is called when production backupDatabaseStmt is entered.
+func (s *BaseSQLListener) EnterBackupDatabaseStmt(ctx *BackupDatabaseStmtContext) {}
+
+// ExitBackupDatabaseStmt is called when production backupDatabaseStmt is exited.
+func (s *BaseSQLListener) ExitBackupDatabaseStmt(ctx *BackupDatabaseStmtContext) {}
+
+// EnterRestoreDatabaseStmt is called when production restoreDatabaseStmt is entered.
+func (s *BaseSQLListener) EnterRestoreDatabaseStmt(ctx *RestoreDatabaseStmtContext) {}
+
+// ExitRestoreDatabaseStmt is called when production restoreDatabaseStmt is exited.
+func (s *BaseSQLListener) ExitRestoreDatabaseStmt(ctx *RestoreDatabaseStmtContext) {}
+
 // EnterShowSchemasStmt is called when production showSchemasStmt is entered.
 func (s *BaseSQLListener) EnterShowSchemasStmt(ctx *ShowSchemasStmtContext) {}
 
@@ -223,6 +235,36 @@ func (s *BaseSQLListener) EnterShowTagKeysStmt(ctx *ShowTagKeysStmtContext) {}
 // ExitShowTagKeysStmt is called when production showTagKeysStmt is exited.
 func (s *BaseSQLListener) ExitShowTagKeysStmt(ctx *ShowTagKeysStmtContext) {}
 
+// EnterShowContinuousQueriesStmt is called when production showContinuousQueriesStmt is entered.
+func (s *BaseSQLListener) EnterShowContinuousQueriesStmt(ctx *ShowContinuousQueriesStmtContext) {}
+
+// ExitShowContinuousQueriesStmt is called when production showContinuousQueriesStmt is exited.
+func (s *BaseSQLListener) ExitShowContinuousQueriesStmt(ctx *ShowContinuousQueriesStmtContext) {}
+
+// EnterShowAlertsStmt is called when production showAlertsStmt is entered.
+func (s *BaseSQLListener) EnterShowAlertsStmt(ctx *ShowAlertsStmtContext) {}
+
+// ExitShowAlertsStmt is called when production showAlertsStmt is exited.
+func (s *BaseSQLListener) ExitShowAlertsStmt(ctx *ShowAlertsStmtContext) {}
+
+// EnterCreateContinuousQueryStmt is called when production createContinuousQueryStmt is entered.
+func (s *BaseSQLListener) EnterCreateContinuousQueryStmt(ctx *CreateContinuousQueryStmtContext) {}
+
+// ExitCreateContinuousQueryStmt is called when production createContinuousQueryStmt is exited.
+func (s *BaseSQLListener) ExitCreateContinuousQueryStmt(ctx *CreateContinuousQueryStmtContext) {}
+
+// EnterDropContinuousQueryStmt is called when production dropContinuousQueryStmt is entered.
+func (s *BaseSQLListener) EnterDropContinuousQueryStmt(ctx *DropContinuousQueryStmtContext) {}
+
+// ExitDropContinuousQueryStmt is called when production dropContinuousQueryStmt is exited.
+func (s *BaseSQLListener) ExitDropContinuousQueryStmt(ctx *DropContinuousQueryStmtContext) {}
+
+// EnterCqName is called when production cqName is entered.
+func (s *BaseSQLListener) EnterCqName(ctx *CqNameContext) {}
+
+// ExitCqName is called when production cqName is exited.
+func (s *BaseSQLListener) ExitCqName(ctx *CqNameContext) {}
+
 // EnterShowTagValuesStmt is called when production showTagValuesStmt is entered.
 func (s *BaseSQLListener) EnterShowTagValuesStmt(ctx *ShowTagValuesStmtContext) {}
 
@@ -259,6 +301,12 @@ func (s *BaseSQLListener) EnterStorageName(ctx *StorageNameContext) {}
 // ExitStorageName is called when production storageName is exited.
 func (s *BaseSQLListener) ExitStorageName(ctx *StorageNameContext) {}
 
+// EnterBackupPath is called when production backupPath is entered.
+func (s *BaseSQLListener) EnterBackupPath(ctx *BackupPathContext) {}
+
+// ExitBackupPath is called when production backupPath is exited.
+func (s *BaseSQLListener) ExitBackupPath(ctx *BackupPathContext) {}
+
 // EnterRequestID is called when production requestID is entered.
 func (s *BaseSQLListener) EnterRequestID(ctx *RequestIDContext) {}
 
@@ -307,6 +355,24 @@ func (s *BaseSQLListener) EnterOptionValue(ctx *OptionValueContext) {}
 // ExitOptionValue is called when production optionValue is exited.
 func (s *BaseSQLListener) ExitOptionValue(ctx *OptionValueContext) {}
 
+// EnterDeleteStmt is called when production deleteStmt is entered.
+func (s *BaseSQLListener) EnterDeleteStmt(ctx *DeleteStmtContext) {}
+
+// ExitDeleteStmt is called when production deleteStmt is exited.
+func (s *BaseSQLListener) ExitDeleteStmt(ctx *DeleteStmtContext) {}
+
+// EnterDropMetricStmt is called when production dropMetricStmt is entered.
+func (s *BaseSQLListener) EnterDropMetricStmt(ctx *DropMetricStmtContext) {}
+
+// ExitDropMetricStmt is called when production dropMetricStmt is exited.
+func (s *BaseSQLListener) ExitDropMetricStmt(ctx *DropMetricStmtContext) {}
+
+// EnterDropNamespaceStmt is called when production dropNamespaceStmt is entered.
+func (s *BaseSQLListener) EnterDropNamespaceStmt(ctx *DropNamespaceStmtContext) {}
+
+// ExitDropNamespaceStmt is called when production dropNamespaceStmt is exited.
+func (s *BaseSQLListener) ExitDropNamespaceStmt(ctx *DropNamespaceStmtContext) {}
+
 // EnterQueryStmt is called when production queryStmt is entered.
 func (s *BaseSQLListener) EnterQueryStmt(ctx *QueryStmtContext) {}
 
@@ -367,6 +433,30 @@ func (s *BaseSQLListener) EnterFromClause(ctx *FromClauseContext) {}
 // ExitFromClause is called when production fromClause is exited.
 func (s *BaseSQLListener) ExitFromClause(ctx *FromClauseContext) {}
 
+// EnterJoinClause is called when production joinClause is entered.
+func (s *BaseSQLListener) EnterJoinClause(ctx *JoinClauseContext) {}
+
+// ExitJoinClause is called when production joinClause is exited.
+func (s *BaseSQLListener) ExitJoinClause(ctx *JoinClauseContext) {}
+
+// EnterJoinMetric is called when production joinMetric is entered.
+func (s *BaseSQLListener) EnterJoinMetric(ctx *JoinMetricContext) {}
+
+// ExitJoinMetric is called when production joinMetric is exited.
+func (s *BaseSQLListener) ExitJoinMetric(ctx *JoinMetricContext) {}
+
+// EnterMetricAlias is called when production metricAlias is entered.
+func (s *BaseSQLListener) EnterMetricAlias(ctx *MetricAliasContext) {}
+
+// ExitMetricAlias is called when production metricAlias is exited.
+func (s *BaseSQLListener) ExitMetricAlias(ctx *MetricAliasContext) {}
+
+// EnterSubQueryClause is called when production subQueryClause is entered.
+func (s *BaseSQLListener) EnterSubQueryClause(ctx *SubQueryClauseContext) {}
+
+// ExitSubQueryClause is called when production subQueryClause is exited.
+func (s *BaseSQLListener) ExitSubQueryClause(ctx *SubQueryClauseContext) {}
+
 // EnterWhereClause is called when production whereClause is entered.
 func (s *BaseSQLListener) EnterWhereClause(ctx *WhereClauseContext) {}
 
@@ -445,6 +535,18 @@ func (s *BaseSQLListener) EnterGroupByKey(ctx *GroupByKeyContext) {}
 // ExitGroupByKey is called when production groupByKey is exited.
 func (s *BaseSQLListener) ExitGroupByKey(ctx *GroupByKeyContext) {}
 
+// EnterGroupByTagExpr is called when production groupByTagExpr is entered.
+func (s *BaseSQLListener) EnterGroupByTagExpr(ctx *GroupByTagExprContext) {}
+
+// ExitGroupByTagExpr is called when production groupByTagExpr is exited.
+func (s *BaseSQLListener) ExitGroupByTagExpr(ctx *GroupByTagExprContext) {}
+
+// EnterGroupByTagFunc is called when production groupByTagFunc is entered.
+func (s *BaseSQLListener) EnterGroupByTagFunc(ctx *GroupByTagFuncContext) {}
+
+// ExitGroupByTagFunc is called when production groupByTagFunc is exited.
+func (s *BaseSQLListener) ExitGroupByTagFunc(ctx *GroupByTagFuncContext) {}
+
 // EnterFillOption is called when production fillOption is entered.
 func (s *BaseSQLListener) EnterFillOption(ctx *FillOptionContext) {}
 
@@ -116,6 +116,14 @@ func (v *BaseSQLVisitor) VisitRecoverStorageStmt(ctx *RecoverStorageStmtContext)
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSQLVisitor) VisitBackupDatabaseStmt(ctx *BackupDatabaseStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitRestoreDatabaseStmt(ctx *RestoreDatabaseStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSQLVisitor) VisitShowSchemasStmt(ctx *ShowSchemasStmtContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -148,6 +156,26 @@ func (v *BaseSQLVisitor) VisitShowTagKeysStmt(ctx *ShowTagKeysStmtContext) inter
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSQLVisitor) VisitShowContinuousQueriesStmt(ctx *ShowContinuousQueriesStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitShowAlertsStmt(ctx *ShowAlertsStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitCreateContinuousQueryStmt(ctx *CreateContinuousQueryStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitDropContinuousQueryStmt(ctx *DropContinuousQueryStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitCqName(ctx *CqNameContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSQLVisitor) VisitShowTagValuesStmt(ctx *ShowTagValuesStmtContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -172,6 +200,10 @@ func (v *BaseSQLVisitor) VisitStorageName(ctx *StorageNameContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSQLVisitor) VisitBackupPath(ctx *BackupPathContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSQLVisitor) VisitRequestID(ctx *RequestIDContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -204,6 +236,18 @@ func (v *BaseSQLVisitor) VisitOptionValue(ctx *OptionValueContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSQLVisitor) VisitDeleteStmt(ctx *DeleteStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitDropMetricStmt(ctx *DropMetricStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitDropNamespaceStmt(ctx *DropNamespaceStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSQLVisitor) VisitQueryStmt(ctx *QueryStmtContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -244,6 +288,22 @@ func (v *BaseSQLVisitor) VisitFromClause(ctx *FromClauseContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSQLVisitor) VisitJoinClause(ctx *JoinClauseContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitJoinMetric(ctx *JoinMetricContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitMetricAlias(ctx *MetricAliasContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitSubQueryClause(ctx *SubQueryClauseContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSQLVisitor) VisitWhereClause(ctx *WhereClauseContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -296,6 +356,14 @@ func (v *BaseSQLVisitor) VisitGroupByKey(ctx *GroupByKeyContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSQLVisitor) VisitGroupByTagExpr(ctx *GroupByTagExprContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitGroupByTagFunc(ctx *GroupByTagFuncContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSQLVisitor) VisitFillOption(ctx *FillOptionContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -93,6 +93,12 @@ type SQLListener interface {
 	// EnterRecoverStorageStmt is called when entering the recoverStorageStmt production.
 	EnterRecoverStorageStmt(c *RecoverStorageStmtContext)
 
+	// EnterBackupDatabaseStmt is called when entering the backupDatabaseStmt production.
+	EnterBackupDatabaseStmt(c *BackupDatabaseStmtContext)
+
+	// EnterRestoreDatabaseStmt is called when entering the restoreDatabaseStmt production.
+	EnterRestoreDatabaseStmt(c *RestoreDatabaseStmtContext)
+
 	// EnterShowSchemasStmt is called when entering the showSchemasStmt production.
 	EnterShowSchemasStmt(c *ShowSchemasStmtContext)
 
@@ -117,6 +123,21 @@ type SQLListener interface {
 	// EnterShowTagKeysStmt is called when entering the showTagKeysStmt production.
 	EnterShowTagKeysStmt(c *ShowTagKeysStmtContext)
 
+	// EnterShowContinuousQueriesStmt is called when entering the showContinuousQueriesStmt production.
+	EnterShowContinuousQueriesStmt(c *ShowContinuousQueriesStmtContext)
+
+	// EnterShowAlertsStmt is called when entering the showAlertsStmt production.
+	EnterShowAlertsStmt(c *ShowAlertsStmtContext)
+
+	// EnterCreateContinuousQueryStmt is called when entering the createContinuousQueryStmt production.
+	EnterCreateContinuousQueryStmt(c *CreateContinuousQueryStmtContext)
+
+	// EnterDropContinuousQueryStmt is called when entering the dropContinuousQueryStmt production.
+	EnterDropContinuousQueryStmt(c *DropContinuousQueryStmtContext)
+
+	// EnterCqName is called when entering the cqName production.
+	EnterCqName(c *CqNameContext)
+
 	// EnterShowTagValuesStmt is called when entering the showTagValuesStmt production.
 	EnterShowTagValuesStmt(c *ShowTagValuesStmtContext)
 
@@ -135,6 +156,9 @@ type SQLListener interface {
 	// EnterStorageName is called when entering the storageName production.
 	EnterStorageName(c *StorageNameContext)
 
+	// EnterBackupPath is called when entering the backupPath production.
+	EnterBackupPath(c *BackupPathContext)
+
 	// EnterRequestID is called when entering the requestID production.
 	EnterRequestID(c *RequestIDContext)
 
@@ -159,6 +183,15 @@ type SQLListener interface {
 	// EnterOptionValue is called when entering the optionValue production.
 	EnterOptionValue(c *OptionValueContext)
 
+	// EnterDeleteStmt is called when entering the deleteStmt production.
+	EnterDeleteStmt(c *DeleteStmtContext)
+
+	// EnterDropMetricStmt is called when entering the dropMetricStmt production.
+	EnterDropMetricStmt(c *DropMetricStmtContext)
+
+	// EnterDropNamespaceStmt is called when entering the dropNamespaceStmt production.
+	EnterDropNamespaceStmt(c *DropNamespaceStmtContext)
+
 	// EnterQueryStmt is called when entering the queryStmt production.
 	EnterQueryStmt(c *QueryStmtContext)
 
@@ -189,6 +222,18 @@ type SQLListener interface {
 	// EnterFromClause is called when entering the fromClause production.
 	EnterFromClause(c *FromClauseContext)
 
+	// EnterJoinClause is called when entering the joinClause production.
+	EnterJoinClause(c *JoinClauseContext)
+
+	// EnterJoinMetric is called when entering the joinMetric production.
+	EnterJoinMetric(c *JoinMetricContext)
+
+	// EnterMetricAlias is called when entering the metricAlias production.
+	EnterMetricAlias(c *MetricAliasContext)
+
+	// EnterSubQueryClause is called when entering the subQueryClause production.
+	EnterSubQueryClause(c *SubQueryClauseContext)
+
 	// EnterWhereClause is called when entering the whereClause production.
 	EnterWhereClause(c *WhereClauseContext)
 
@@ -228,6 +273,12 @@ type SQLListener interface {
 	// EnterGroupByKey is called when entering the groupByKey production.
 	EnterGroupByKey(c *GroupByKeyContext)
 
+	// EnterGroupByTagExpr is called when entering the groupByTagExpr production.
+	EnterGroupByTagExpr(c *GroupByTagExprContext)
+
+	// EnterGroupByTagFunc is called when entering the groupByTagFunc production.
+	EnterGroupByTagFunc(c *GroupByTagFuncContext)
+
 	// EnterFillOption is called when entering the fillOption production.
 	EnterFillOption(c *FillOptionContext)
 
@@ -399,6 +450,12 @@ type SQLListener interface {
 	// ExitRecoverStorageStmt is called when exiting the recoverStorageStmt production.
 	ExitRecoverStorageStmt(c *RecoverStorageStmtContext)
 
+	// ExitBackupDatabaseStmt is called when exiting the backupDatabaseStmt production.
+	ExitBackupDatabaseStmt(c *BackupDatabaseStmtContext)
+
+	// ExitRestoreDatabaseStmt is called when exiting the restoreDatabaseStmt production.
+	ExitRestoreDatabaseStmt(c *RestoreDatabaseStmtContext)
+
 	// ExitShowSchemasStmt is called when exiting the showSchemasStmt production.
 	ExitShowSchemasStmt(c *ShowSchemasStmtContext)
 
@@ -423,6 +480,21 @@ type SQLListener interface {
 	// ExitShowTagKeysStmt is called when exiting the showTagKeysStmt production.
 	ExitShowTagKeysStmt(c *ShowTagKeysStmtContext)
 
+	// ExitShowContinuousQueriesStmt is called when exiting the showContinuousQueriesStmt production.
+	ExitShowContinuousQueriesStmt(c *ShowContinuousQueriesStmtContext)
+
+	// ExitShowAlertsStmt is called when exiting the showAlertsStmt production.
+	ExitShowAlertsStmt(c *ShowAlertsStmtContext)
+
+	// ExitCreateContinuousQueryStmt is called when exiting the createContinuousQueryStmt production.
+	ExitCreateContinuousQueryStmt(c *CreateContinuousQueryStmtContext)
+
+	// ExitDropContinuousQueryStmt is called when exiting the dropContinuousQueryStmt production.
+	ExitDropContinuousQueryStmt(c *DropContinuousQueryStmtContext)
+
+	// ExitCqName is called when exiting the cqName production.
+	ExitCqName(c *CqNameContext)
+
 	// ExitShowTagValuesStmt is called when exiting the showTagValuesStmt production.
 	ExitShowTagValuesStmt(c *ShowTagValuesStmtContext)
 
@@ -441,6 +513,9 @@ type SQLListener interface {
 	// ExitStorageName is called when exiting the storageName production.
 	ExitStorageName(c *StorageNameContext)
 
+	// ExitBackupPath is called when exiting the backupPath production.
+	ExitBackupPath(c *BackupPathContext)
+
 	// ExitRequestID is called when exiting the requestID production.
 	ExitRequestID(c *RequestIDContext)
 
@@ -465,6 +540,15 @@ type SQLListener interface {
 	// ExitOptionValue is called when exiting the optionValue production.
 	ExitOptionValue(c *OptionValueContext)
 
+	// ExitDeleteStmt is called when exiting the deleteStmt production.
+	ExitDeleteStmt(c *DeleteStmtContext)
+
+	// ExitDropMetricStmt is called when exiting the dropMetricStmt production.
+	ExitDropMetricStmt(c *DropMetricStmtContext)
+
+	// ExitDropNamespaceStmt is called when exiting the dropNamespaceStmt production.
+	ExitDropNamespaceStmt(c *DropNamespaceStmtContext)
+
 	// ExitQueryStmt is called when exiting the queryStmt production.
 	ExitQueryStmt(c *QueryStmtContext)
 
@@ -495,6 +579,18 @@ type SQLListener interface {
 	// ExitFromClause is called when exiting the fromClause production.
 	ExitFromClause(c *FromClauseContext)
 
+	// ExitJoinClause is called when exiting the joinClause production.
+	ExitJoinClause(c *JoinClauseContext)
+
+	// ExitJoinMetric is called when exiting the joinMetric production.
+	ExitJoinMetric(c *JoinMetricContext)
+
+	// ExitMetricAlias is called when exiting the metricAlias production.
+	ExitMetricAlias(c *MetricAliasContext)
+
+	// ExitSubQueryClause is called when exiting the subQueryClause production.
+	ExitSubQueryClause(c *SubQueryClauseContext)
+
 	// ExitWhereClause is called when exiting the whereClause production.
 	ExitWhereClause(c *WhereClauseContext)
 
@@ -534,6 +630,12 @@ type SQLListener interface {
 	// ExitGroupByKey is called when exiting the groupByKey production.
 	ExitGroupByKey(c *GroupByKeyContext)
 
+	// ExitGroupByTagExpr is called when exiting the groupByTagExpr production.
+	ExitGroupByTagExpr(c *GroupByTagExprContext)
+
+	// ExitGroupByTagFunc is called when exiting the groupByTagFunc production.
+	ExitGroupByTagFunc(c *GroupByTagFuncContext)
+
 	// ExitFillOption is called when exiting the fillOption production.
 	ExitFillOption(c *FillOptionContext)
 
@@ -49,40 +49,49 @@ var SQLParserStaticData struct {
 func sqlParserInit() {
 	staticData := &SQLParserStaticData
 	staticData.LiteralNames = []string{
-		"", "'true'", "'false'", "'null'", "", "", "", "", "", "", "", "", "",
+		"", "'true'", "'false'", "", "", "", "", "", "", "", "", "", "", "",
 		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
-		"", "", "", "", "", "", "", "'m'", "", "", "", "'M'", "", "'.'", "':'",
-		"'='", "'<>'", "'!='", "'>'", "'>='", "'<'", "'<='", "'=~'", "'!~'",
-		"','", "'{'", "'}'", "'['", "']'", "'('", "')'", "'+'", "'-'", "'/'",
-		"'*'", "'%'", "'_'",
+		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+		"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
+		"", "", "", "", "", "", "", "", "", "", "'m'", "", "", "", "'M'", "",
+		"'.'", "':'", "'='", "'<>'", "'!='", "'>'", "'>='", "'<'", "'<='", "'=~'",
+		"'!~'", "','", "'{'", "'}'", "'['", "']'", "'('", "')'", "'+'", "'-'",
+		"'/'", "'*'", "'%'", "'_'",
 	}
 	staticData.SymbolicNames = []string{
-		"", "", "", "", "STRING", "WS", "T_CREATE", "T_UPDATE", "T_SET", "T_DROP",
+		"", "", "", "STRING", "WS", "T_CREATE", "T_UPDATE", "T_SET", "T_DROP",
 		"T_INTERVAL", "T_INTERVAL_NAME", "T_SHARD", "T_REPLICATION", "T_MEMORY",
 		"T_TTL", "T_META_TTL", "T_PAST_TTL", "T_FUTURE_TTL", "T_KILL", "T_ON",
-		"T_SHOW", "T_RECOVER", "T_USE", "T_STATE_REPO", "T_STATE_MACHINE", "T_MASTER",
-		"T_METADATA", "T_TYPES", "T_TYPE", "T_STORAGES", "T_STORAGE", "T_BROKER",
-		"T_ROOT", "T_BROKERS", "T_ALIVE", "T_SCHEMAS", "T_DATASBAE", "T_DATASBAES",
-		"T_NAMESPACE", "T_NAMESPACES", "T_NODE", "T_METRICS", "T_METRIC", "T_FIELD",
-		"T_FIELDS", "T_TAG", "T_INFO", "T_KEYS", "T_KEY", "T_WITH", "T_VALUES",
-		"T_VALUE", "T_FROM", "T_WHERE", "T_LIMIT", "T_QUERIES", "T_QUERY", "T_EXPLAIN",
-		"T_WITH_VALUE", "T_SELECT", "T_AS", "T_AND", "T_OR", "T_FILL", "T_NULL",
-		"T_PREVIOUS", "T_ORDER", "T_ASC", "T_DESC", "T_LIKE", "T_NOT", "T_BETWEEN",
-		"T_IS", "T_GROUP", "T_HAVING", "T_BY", "T_FOR", "T_STATS", "T_TIME",
-		"T_NOW", "T_IN", "T_ROLLUP", "T_LOG", "T_PROFILE", "T_REQUESTS", "T_REQUEST",
-		"T_ID", "T_SUM", "T_MIN", "T_MAX", "T_COUNT", "T_LAST", "T_FIRST", "T_AVG",
-		"T_STDDEV", "T_QUANTILE", "T_RATE", "T_NUM_OF_SHARD", "T_REPLICA_FACTOR",
-		"T_AUTO_CREATE_NS", "T_BEHEAD", "T_AHEAD", "T_RETENTION", "T_SECOND",
-		"T_MINUTE", "T_HOUR", "T_DAY", "T_WEEK", "T_MONTH", "T_YEAR", "T_DOT",
-		"T_COLON", "T_EQUAL", "T_NOTEQUAL", "T_NOTEQUAL2", "T_GREATER", "T_GREATEREQUAL",
-		"T_LESS", "T_LESSEQUAL", "T_REGEXP", "T_NEQREGEXP", "T_COMMA", "T_OPEN_B",
-		"T_CLOSE_B", "T_OPEN_SB", "T_CLOSE_SB", "T_OPEN_P", "T_CLOSE_P", "T_ADD",
-		"T_SUB", "T_DIV", "T_MUL", "T_MOD", "T_UNDERLINE", "L_ID", "L_INT",
-		"L_DEC",
+		"T_SHOW", "T_RECOVER", "T_BACKUP", "T_RESTORE", "T_TO", "T_USE", "T_STATE_REPO",
+		"T_STATE_MACHINE", "T_MASTER", "T_METADATA", "T_TYPES", "T_TYPE", "T_STORAGES",
+		"T_STORAGE", "T_BROKER", "T_ROOT", "T_BROKERS", "T_ALIVE", "T_SCHEMAS",
+		"T_DATASBAE", "T_DATASBAES", "T_NAMESPACE", "T_NAMESPACES", "T_NODE",
+		"T_METRICS", "T_METRIC", "T_FIELD", "T_FIELDS", "T_TAG", "T_INFO", "T_KEYS",
+		"T_KEY", "T_WITH", "T_VALUES", "T_VALUE", "T_FROM", "T_WHERE", "T_LIMIT",
+		"T_QUERIES", "T_QUERY", "T_EXPLAIN", "T_WITH_VALUE", "T_SELECT", "T_AS",
+		"T_AND", "T_OR", "T_FILL", "T_NULL", "T_PREVIOUS", "T_ORDER", "T_ASC",
+		"T_DESC", "T_LIKE", "T_NOT", "T_BETWEEN", "T_IS", "T_GROUP", "T_HAVING",
+		"T_BY", "T_FOR", "T_STATS", "T_TIME", "T_NOW", "T_IN", "T_ROLLUP", "T_CONTINUOUS",
+		"T_EVERY", "T_INTO", "T_ALERTS", "T_DELETE", "T_LINEAR", "T_OFFSET",
+		"T_LOG", "T_PROFILE", "T_REQUESTS", "T_REQUEST", "T_ID", "T_SUM", "T_MIN",
+		"T_MAX", "T_COUNT", "T_LAST", "T_FIRST", "T_AVG", "T_STDDEV", "T_QUANTILE",
+		"T_RATE", "T_HISTOGRAM_COUNT", "T_HISTOGRAM_SUM", "T_MOVING_AVERAGE",
+		"T_DERIVATIVE", "T_NON_NEGATIVE_DIFFERENCE", "T_CUMULATIVE_SUM", "T_DELTA",
+		"T_INCREASE", "T_INTEGRAL", "T_TOP", "T_BOTTOM", "T_ABS", "T_CEIL",
+		"T_FLOOR", "T_ROUND", "T_LOG2", "T_LOG10", "T_SQRT", "T_POW", "T_CLAMP_MIN",
+		"T_CLAMP_MAX", "T_IF", "T_COALESCE", "T_REGEXP_EXTRACT", "T_LABEL_REPLACE",
+		"T_LABEL_JOIN", "T_PERCENTILE", "T_MEDIAN", "T_DISTINCT_COUNT", "T_NUM_OF_SHARD",
+		"T_REPLICA_FACTOR", "T_AUTO_CREATE_NS", "T_BEHEAD", "T_AHEAD", "T_RETENTION",
+		"T_SECOND", "T_MINUTE", "T_HOUR", "T_DAY", "T_WEEK", "T_MONTH", "T_YEAR",
+		"T_DOT", "T_COLON", "T_EQUAL", "T_NOTEQUAL", "T_NOTEQUAL2", "T_GREATER",
+		"T_GREATEREQUAL", "T_LESS", "T_LESSEQUAL", "T_REGEXP", "T_NEQREGEXP",
+		"T_COMMA", "T_OPEN_B", "T_CLOSE_B", "T_OPEN_SB", "T_CLOSE_SB", "T_OPEN_P",
+		"T_CLOSE_P", "T_ADD", "T_SUB", "T_DIV", "T_MUL", "T_MOD", "T_UNDERLINE",
+		"L_ID", "L_INT", "L_DEC",
 	}
 	staticData.RuleNames = []string{
 		"statement", "useStmt", "setLimitStmt", "showStmt", "showMasterStmt",
@@ -90,18 +99,22 @@ func sqlParserInit() {
 		"showMetadataTypesStmt", "showRootMetaStmt", "showBrokerMetaStmt", "showMasterMetaStmt",
 		"showStorageMetaStmt", "showAliveStmt", "showReplicationStmt", "showMemoryDatabaseStmt",
 		"showRootMetricStmt", "showBrokerMetricStmt", "showStorageMetricStmt",
-		"createStorageStmt", "createBrokerStmt", "recoverStorageStmt", "showSchemasStmt",
-		"createDatabaseStmt", "dropDatabaseStmt", "showDatabaseStmt", "showNameSpacesStmt",
-		"showMetricsStmt", "showFieldsStmt", "showTagKeysStmt", "showTagValuesStmt",
-		"prefix", "withTagKey", "namespace", "databaseName", "storageName",
+		"createStorageStmt", "createBrokerStmt", "recoverStorageStmt", "backupDatabaseStmt",
+		"restoreDatabaseStmt", "showSchemasStmt", "createDatabaseStmt", "dropDatabaseStmt",
+		"showDatabaseStmt", "showNameSpacesStmt", "showMetricsStmt", "showFieldsStmt",
+		"showTagKeysStmt", "showContinuousQueriesStmt", "showAlertsStmt", "createContinuousQueryStmt",
+		"dropContinuousQueryStmt", "cqName", "showTagValuesStmt", "prefix",
+		"withTagKey", "namespace", "databaseName", "storageName", "backupPath",
 		"requestID", "source", "optionClause", "optionPairs", "closedOptionPairs",
-		"optionPair", "optionKey", "optionValue", "queryStmt", "sourceAndSelect",
-		"selectExpr", "fields", "field", "alias", "brokerFilter", "databaseFilter",
-		"typeFilter", "fromClause", "whereClause", "conditionExpr", "tagFilterExpr",
-		"tagValueList", "metricListFilter", "metricList", "timeRangeExpr", "timeExpr",
-		"nowExpr", "nowFunc", "groupByClause", "groupByKeys", "groupByKey",
-		"fillOption", "orderByClause", "sortField", "sortFields", "havingClause",
-		"boolExpr", "boolExprLogicalOp", "boolExprAtom", "binaryExpr", "binaryOperator",
+		"optionPair", "optionKey", "optionValue", "deleteStmt", "dropMetricStmt",
+		"dropNamespaceStmt", "queryStmt", "sourceAndSelect", "selectExpr", "fields",
+		"field", "alias", "brokerFilter", "databaseFilter", "typeFilter", "fromClause",
+		"joinClause", "joinMetric", "metricAlias", "subQueryClause", "whereClause",
+		"conditionExpr", "tagFilterExpr", "tagValueList", "metricListFilter",
+		"metricList", "timeRangeExpr", "timeExpr", "nowExpr", "nowFunc", "groupByClause",
+		"groupByKeys", "groupByKey", "groupByTagExpr", "groupByTagFunc", "fillOption",
+		"orderByClause", "sortField", "sortFields", "havingClause", "boolExpr",
+		"boolExprLogicalOp", "boolExprAtom", "binaryExpr", "binaryOperator",
 		"fieldExpr", "star", "durationLit", "intervalItem", "exprFunc", "funcName",
 		"exprFuncParams", "funcParam", "exprAtom", "identFilter", "json", "toml",
 		"obj", "pair", "arr", "value", "intNumber", "decNumber", "limitClause",
@@ -109,391 +122,463 @@ func sqlParserInit() {
 	}
 	staticData.PredictionContextCache = antlr.NewPredictionContextCache()
 	staticData.serializedATN = []int32{
-		4, 1, 137, 876, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4, 7,
-		4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9, 2, 10, 7,
-		10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2, 15, 7, 15,
-		2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20, 7, 20, 2,
-		21, 7, 21, 2, 22, 7, 22, 2, 23, 7, 23, 2, 24, 7, 24, 2, 25, 7, 25, 2, 26,
-		7, 26, 2, 27, 7, 27, 2, 28, 7, 28, 2, 29, 7, 29, 2, 30, 7, 30, 2, 31, 7,
-		31, 2, 32, 7, 32, 2, 33, 7, 33, 2, 34, 7, 34, 2, 35, 7, 35, 2, 36, 7, 36,
-		2, 37, 7, 37, 2, 38, 7, 38, 2, 39, 7, 39, 2, 40, 7, 40, 2, 41, 7, 41, 2,
-		42, 7, 42, 2, 43, 7, 43, 2, 44, 7, 44, 2, 45, 7, 45, 2, 46, 7, 46, 2, 47,
-		7, 47, 2, 48, 7, 48, 2, 49, 7, 49, 2, 50, 7, 50, 2, 51, 7, 51, 2, 52, 7,
-		52, 2, 53, 7, 53, 2, 54, 7, 54, 2, 55, 7, 55, 2, 56, 7, 56, 2, 57, 7, 57,
-		2, 58, 7, 58, 2, 59, 7, 59, 2, 60, 7, 60, 2, 61, 7, 61, 2, 62, 7, 62, 2,
-		63, 7, 63, 2, 64, 7, 64, 2, 65, 7, 65, 2, 66, 7, 66, 2, 67, 7, 67, 2, 68,
-		7, 68, 2, 69, 7, 69, 2, 70, 7, 70, 2, 71, 7, 71, 2, 72, 7, 72, 2, 73, 7,
-		73, 2, 74, 7, 74, 2, 75, 7, 75, 2, 76, 7, 76, 2, 77, 7, 77, 2, 78, 7, 78,
-		2, 79, 7, 79, 2, 80, 7, 80, 2, 81, 7, 81, 2, 82, 7, 82, 2, 83, 7, 83, 2,
-		84, 7, 84, 2, 85, 7, 85, 2, 86, 7, 86, 2, 87, 7, 87, 2, 88, 7, 88, 2, 89,
-		7, 89, 2, 90, 7, 90, 2, 91, 7, 91, 2, 92, 7, 92, 2, 93, 7, 93, 2, 94, 7,
-		94, 2, 95, 7, 95, 2, 96, 7, 96, 2, 97, 7, 97, 2, 98, 7, 98, 2, 99, 7, 99,
-		2, 100, 7, 100, 2, 101, 7, 101, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1,
-		0, 1, 0, 1, 0, 1, 0, 1, 0, 3, 0, 216, 8, 0, 1, 1, 1, 1, 1, 1, 1, 2, 1,
-		2, 1, 2, 1, 2, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1,
+		4, 1, 175, 1030, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4,
+		7, 4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9, 2, 10,
+		7, 10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2, 15, 7,
+		15, 2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20, 7, 20,
+		2, 21, 7, 21, 2, 22, 7, 22, 2, 23, 7, 23, 2, 24, 7, 24, 2, 25, 7, 25, 2,
+		26, 7, 26, 2, 27, 7, 27, 2, 28, 7, 28, 2, 29, 7, 29, 2, 30, 7, 30, 2, 31,
+		7, 31, 2, 32, 7, 32, 2, 33, 7, 33, 2, 34, 7, 34, 2, 35, 7, 35, 2, 36, 7,
+		36, 2, 37, 7, 37, 2, 38, 7, 38, 2, 39, 7, 39, 2, 40, 7, 40, 2, 41, 7, 41,
+		2, 42, 7, 42, 2, 43, 7, 43, 2, 44, 7, 44, 2, 45, 7, 45, 2, 46, 7, 46, 2,
+		47, 7, 47, 2, 48, 7, 48, 2, 49, 7, 49, 2, 50, 7, 50, 2, 51, 7, 51, 2, 52,
+		7, 52, 2, 53, 7, 53, 2, 54, 7, 54, 2, 55, 7, 55, 2, 56, 7, 56, 2, 57, 7,
+		57, 2, 58, 7, 58, 2, 59, 7, 59, 2, 60, 7, 60, 2, 61, 7, 61, 2, 62, 7, 62,
+		2, 63, 7, 63, 2, 64, 7, 64, 2, 65, 7, 65, 2, 66, 7, 66, 2, 67, 7, 67, 2,
+		68, 7, 68, 2, 69, 7, 69, 2, 70, 7, 70, 2, 71, 7, 71, 2, 72, 7, 72, 2, 73,
+		7, 73, 2, 74, 7, 74, 2, 75, 7, 75, 2, 76, 7, 76, 2, 77, 7, 77, 2, 78, 7,
+		78, 2, 79, 7, 79, 2, 80, 7, 80, 2, 81, 7, 81, 2, 82, 7, 82, 2, 83, 7, 83,
+		2, 84, 7, 84, 2, 85, 7, 85, 2, 86, 7, 86, 2, 87, 7, 87, 2, 88, 7, 88, 2,
+		89, 7, 89, 2, 90, 7, 90, 2, 91, 7, 91, 2, 92, 7, 92, 2, 93, 7, 93, 2, 94,
+		7, 94, 2, 95, 7, 95, 2, 96, 7, 96, 2, 97, 7, 97, 2, 98, 7, 98, 2, 99, 7,
+		99, 2, 100, 7, 100, 2, 101, 7, 101, 2, 102, 7, 102, 2, 103, 7, 103, 2,
+		104, 7, 104, 2, 105, 7, 105, 2, 106, 7, 106, 2, 107, 7, 107, 2, 108, 7,
+		108, 2, 109, 7, 109, 2, 110, 7, 110, 2, 111, 7, 111, 2, 112, 7, 112, 2,
+		113, 7, 113, 2, 114, 7, 114, 2, 115, 7, 115, 2, 116, 7, 116, 2, 117, 7,
+		117, 2, 118, 7, 118, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1,
+		0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 3, 0, 257, 8,
+		0, 1, 1, 1, 1, 1, 1, 1, 2, 1, 2, 1, 2, 1, 2, 1, 3, 1, 3, 1, 3, 1, 3, 1,
 		3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1,
-		3, 1, 3, 3, 3, 248, 8, 3, 1, 4, 1, 4, 1, 4, 1, 5, 1, 5, 1, 5, 1, 6, 1,
-		6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 7, 1, 7, 1, 7, 1, 8, 1, 8, 1, 8, 1,
-		9, 1, 9, 1, 9, 1, 9, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1,
-		10, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 3, 11,
-		290, 8, 11, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1,
-		13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 14, 1, 14, 1, 14,
-		1, 14, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 16, 1, 16, 1, 16, 1, 16, 1,
-		16, 1, 16, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 18, 1, 18, 1, 18,
-		1, 18, 1, 18, 1, 18, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 20, 1,
-		20, 1, 20, 1, 20, 1, 21, 1, 21, 1, 21, 1, 21, 1, 22, 1, 22, 1, 22, 1, 22,
-		1, 23, 1, 23, 1, 23, 1, 24, 1, 24, 1, 24, 1, 24, 3, 24, 360, 8, 24, 1,
-		25, 1, 25, 1, 25, 1, 25, 1, 26, 1, 26, 1, 26, 1, 27, 1, 27, 1, 27, 1, 27,
-		1, 27, 1, 27, 3, 27, 375, 8, 27, 1, 27, 3, 27, 378, 8, 27, 1, 28, 1, 28,
-		1, 28, 1, 28, 3, 28, 384, 8, 28, 1, 28, 1, 28, 1, 28, 1, 28, 3, 28, 390,
-		8, 28, 1, 28, 3, 28, 393, 8, 28, 1, 29, 1, 29, 1, 29, 1, 29, 1, 30, 1,
-		30, 1, 30, 1, 30, 1, 30, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31,
-		1, 31, 1, 31, 3, 31, 413, 8, 31, 1, 31, 3, 31, 416, 8, 31, 1, 32, 1, 32,
-		1, 33, 1, 33, 1, 34, 1, 34, 1, 35, 1, 35, 1, 36, 1, 36, 1, 37, 1, 37, 1,
-		38, 1, 38, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39,
-		1, 39, 5, 39, 442, 8, 39, 10, 39, 12, 39, 445, 9, 39, 1, 39, 1, 39, 1,
-		40, 1, 40, 1, 40, 5, 40, 452, 8, 40, 10, 40, 12, 40, 455, 9, 40, 1, 41,
-		1, 41, 1, 41, 1, 41, 1, 42, 1, 42, 1, 42, 1, 42, 1, 43, 1, 43, 1, 44, 1,
-		44, 1, 44, 1, 44, 1, 44, 3, 44, 472, 8, 44, 1, 45, 3, 45, 475, 8, 45, 1,
-		45, 1, 45, 3, 45, 479, 8, 45, 1, 45, 3, 45, 482, 8, 45, 1, 45, 3, 45, 485,
-		8, 45, 1, 45, 3, 45, 488, 8, 45, 1, 45, 3, 45, 491, 8, 45, 1, 46, 1, 46,
-		1, 46, 1, 46, 1, 46, 1, 46, 3, 46, 499, 8, 46, 1, 47, 1, 47, 1, 47, 1,
-		48, 1, 48, 1, 48, 5, 48, 507, 8, 48, 10, 48, 12, 48, 510, 9, 48, 1, 49,
-		1, 49, 3, 49, 514, 8, 49, 1, 50, 1, 50, 1, 50, 1, 51, 1, 51, 1, 51, 1,
-		51, 1, 52, 1, 52, 1, 52, 1, 52, 1, 53, 1, 53, 1, 53, 1, 53, 1, 54, 1, 54,
-		1, 54, 1, 54, 3, 54, 535, 8, 54, 1, 55, 1, 55, 1, 55, 1, 56, 1, 56, 1,
-		56, 1, 56, 1, 56, 1, 56, 1, 56, 1, 56, 3, 56, 548, 8, 56, 3, 56, 550, 8,
-		56, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57,
-		1, 57, 1, 57, 1, 57, 1, 57, 3, 57, 566, 8, 57, 1, 57, 1, 57, 1, 57, 1,
-		57, 1, 57, 1, 57, 3, 57, 574, 8, 57, 1, 57, 1, 57, 1, 57, 1, 57, 3, 57,
-		580, 8, 57, 1, 57, 1, 57, 1, 57, 5, 57, 585, 8, 57, 10, 57, 12, 57, 588,
-		9, 57, 1, 58, 1, 58, 1, 58, 5, 58, 593, 8, 58, 10, 58, 12, 58, 596, 9,
-		58, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 60, 1, 60, 1, 60, 5, 60,
-		607, 8, 60, 10, 60, 12, 60, 610, 9, 60, 1, 61, 1, 61, 1, 61, 3, 61, 615,
-		8, 61, 1, 62, 1, 62, 1, 62, 1, 62, 3, 62, 621, 8, 62, 1, 63, 1, 63, 3,
-		63, 625, 8, 63, 1, 64, 1, 64, 1, 64, 3, 64, 630, 8, 64, 1, 64, 1, 64, 1,
-		65, 1, 65, 1, 65, 1, 65, 1, 65, 1, 65, 1, 65, 1, 65, 3, 65, 642, 8, 65,
-		1, 65, 3, 65, 645, 8, 65, 1, 66, 1, 66, 1, 66, 5, 66, 650, 8, 66, 10, 66,
-		12, 66, 653, 9, 66, 1, 67, 1, 67, 1, 67, 1, 67, 1, 67, 1, 67, 1, 67, 1,
-		67, 1, 67, 3, 67, 664, 8, 67, 1, 68, 1, 68, 1, 69, 1, 69, 1, 69, 1, 69,
-		1, 70, 1, 70, 5, 70, 674, 8, 70, 10, 70, 12, 70, 677, 9, 70, 1, 71, 1,
-		71, 1, 71, 5, 71, 682, 8, 71, 10, 71, 12, 71, 685, 9, 71, 1, 72, 1, 72,
-		1, 72, 1, 73, 1, 73, 1, 73, 1, 73, 1, 73, 1, 73, 3, 73, 696, 8, 73, 1,
-		73, 1, 73, 1, 73, 1, 73, 5, 73, 702, 8, 73, 10, 73, 12, 73, 705, 9, 73,
-		1, 74, 1, 74, 1, 75, 1, 75, 1, 76, 1, 76, 1, 76, 1, 76, 1, 77, 1, 77, 1,
-		77, 1, 77, 1, 77, 1, 77, 1, 77, 1, 77, 3, 77, 723, 8, 77, 1, 78, 1, 78,
-		1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 3, 78, 734, 8, 78, 1,
-		78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78,
-		1, 78, 5, 78, 748, 8, 78, 10, 78, 12, 78, 751, 9, 78, 1, 79, 1, 79, 1,
-		80, 1, 80, 1, 80, 1, 81, 1, 81, 1, 82, 1, 82, 1, 82, 3, 82, 763, 8, 82,
-		1, 82, 1, 82, 1, 83, 1, 83, 1, 84, 1, 84, 1, 84, 5, 84, 772, 8, 84, 10,
-		84, 12, 84, 775, 9, 84, 1, 85, 1, 85, 3, 85, 779, 8, 85, 1, 86, 1, 86,
-		3, 86, 783, 8, 86, 1, 86, 1, 86, 3, 86, 787, 8, 86, 1, 87, 1, 87, 1, 87,
-		1, 87, 1, 88, 1, 88, 1, 89, 1, 89, 1, 90, 1, 90, 1, 90, 1, 90, 5, 90, 801,
-		8, 90, 10, 90, 12, 90, 804, 9, 90, 1, 90, 1, 90, 1, 90, 1, 90, 3, 90, 810,
-		8, 90, 1, 91, 1, 91, 1, 91, 1, 91, 1, 92, 1, 92, 1, 92, 1, 92, 5, 92, 820,
-		8, 92, 10, 92, 12, 92, 823, 9, 92, 1, 92, 1, 92, 1, 92, 1, 92, 3, 92, 829,
-		8, 92, 1, 93, 1, 93, 1, 93, 1, 93, 1, 93, 1, 93, 1, 93, 1, 93, 3, 93, 839,
-		8, 93, 1, 94, 3, 94, 842, 8, 94, 1, 94, 1, 94, 1, 95, 3, 95, 847, 8, 95,
-		1, 95, 1, 95, 1, 96, 1, 96, 1, 96, 1, 97, 1, 97, 1, 98, 1, 98, 1, 99, 1,
-		99, 1, 100, 1, 100, 3, 100, 862, 8, 100, 1, 100, 1, 100, 1, 100, 3, 100,
-		867, 8, 100, 5, 100, 869, 8, 100, 10, 100, 12, 100, 872, 9, 100, 1, 101,
-		1, 101, 1, 101, 0, 3, 114, 146, 156, 102, 0, 2, 4, 6, 8, 10, 12, 14, 16,
-		18, 20, 22, 24, 26, 28, 30, 32, 34, 36, 38, 40, 42, 44, 46, 48, 50, 52,
-		54, 56, 58, 60, 62, 64, 66, 68, 70, 72, 74, 76, 78, 80, 82, 84, 86, 88,
-		90, 92, 94, 96, 98, 100, 102, 104, 106, 108, 110, 112, 114, 116, 118, 120,
-		122, 124, 126, 128, 130, 132, 134, 136, 138, 140, 142, 144, 146, 148, 150,
-		152, 154, 156, 158, 160, 162, 164, 166, 168, 170, 172, 174, 176, 178, 180,
-		182, 184, 186, 188, 190, 192, 194, 196, 198, 200, 202, 0, 11, 1, 0, 31,
-		33, 1, 0, 24, 25, 3, 0, 10, 10, 31, 31, 98, 103, 1, 0, 62, 63, 2, 0, 65,
-		66, 136, 137, 1, 0, 68, 69, 2, 0, 70, 70, 120, 120, 1, 0, 104, 110, 1,
-		0, 88, 97, 1, 0, 129, 130, 3, 0, 6, 21, 23, 97, 104, 110, 893, 0, 215,
-		1, 0, 0, 0, 2, 217, 1, 0, 0, 0, 4, 220, 1, 0, 0, 0, 6, 247, 1, 0, 0, 0,
-		8, 249, 1, 0, 0, 0, 10, 252, 1, 0, 0, 0, 12, 255, 1, 0, 0, 0, 14, 262,
-		1, 0, 0, 0, 16, 265, 1, 0, 0, 0, 18, 268, 1, 0, 0, 0, 20, 272, 1, 0, 0,
-		0, 22, 280, 1, 0, 0, 0, 24, 291, 1, 0, 0, 0, 26, 299, 1, 0, 0, 0, 28, 307,
-		1, 0, 0, 0, 30, 311, 1, 0, 0, 0, 32, 316, 1, 0, 0, 0, 34, 322, 1, 0, 0,
-		0, 36, 328, 1, 0, 0, 0, 38, 334, 1, 0, 0, 0, 40, 340, 1, 0, 0, 0, 42, 344,
-		1, 0, 0, 0, 44, 348, 1, 0, 0, 0, 46, 352, 1, 0, 0, 0, 48, 355, 1, 0, 0,
-		0, 50, 361, 1, 0, 0, 0, 52, 365, 1, 0, 0, 0, 54, 368, 1, 0, 0, 0, 56, 379,
-		1, 0, 0, 0, 58, 394, 1, 0, 0, 0, 60, 398, 1, 0, 0, 0, 62, 403, 1, 0, 0,
-		0, 64, 417, 1, 0, 0, 0, 66, 419, 1, 0, 0, 0, 68, 421, 1, 0, 0, 0, 70, 423,
-		1, 0, 0, 0, 72, 425, 1, 0, 0, 0, 74, 427, 1, 0, 0, 0, 76, 429, 1, 0, 0,
-		0, 78, 431, 1, 0, 0, 0, 80, 448, 1, 0, 0, 0, 82, 456, 1, 0, 0, 0, 84, 460,
-		1, 0, 0, 0, 86, 464, 1, 0, 0, 0, 88, 471, 1, 0, 0, 0, 90, 474, 1, 0, 0,
-		0, 92, 498, 1, 0, 0, 0, 94, 500, 1, 0, 0, 0, 96, 503, 1, 0, 0, 0, 98, 511,
-		1, 0, 0, 0, 100, 515, 1, 0, 0, 0, 102, 518, 1, 0, 0, 0, 104, 522, 1, 0,
-		0, 0, 106, 526, 1, 0, 0, 0, 108, 530, 1, 0, 0, 0, 110, 536, 1, 0, 0, 0,
-		112, 549, 1, 0, 0, 0, 114, 579, 1, 0, 0, 0, 116, 589, 1, 0, 0, 0, 118,
-		597, 1, 0, 0, 0, 120, 603, 1, 0, 0, 0, 122, 611, 1, 0, 0, 0, 124, 616,
-		1, 0, 0, 0, 126, 622, 1, 0, 0, 0, 128, 626, 1, 0, 0, 0, 130, 633, 1, 0,
-		0, 0, 132, 646, 1, 0, 0, 0, 134, 663, 1, 0, 0, 0, 136, 665, 1, 0, 0, 0,
-		138, 667, 1, 0, 0, 0, 140, 671, 1, 0, 0, 0, 142, 678, 1, 0, 0, 0, 144,
-		686, 1, 0, 0, 0, 146, 695, 1, 0, 0, 0, 148, 706, 1, 0, 0, 0, 150, 708,
-		1, 0, 0, 0, 152, 710, 1, 0, 0, 0, 154, 722, 1, 0, 0, 0, 156, 733, 1, 0,
-		0, 0, 158, 752, 1, 0, 0, 0, 160, 754, 1, 0, 0, 0, 162, 757, 1, 0, 0, 0,
-		164, 759, 1, 0, 0, 0, 166, 766, 1, 0, 0, 0, 168, 768, 1, 0, 0, 0, 170,
-		778, 1, 0, 0, 0, 172, 786, 1, 0, 0, 0, 174, 788, 1, 0, 0, 0, 176, 792,
-		1, 0, 0, 0, 178, 794, 1, 0, 0, 0, 180, 809, 1, 0, 0, 0, 182, 811, 1, 0,
-		0, 0, 184, 828, 1, 0, 0, 0, 186, 838, 1, 0, 0, 0, 188, 841, 1, 0, 0, 0,
-		190, 846, 1, 0, 0, 0, 192, 850, 1, 0, 0, 0, 194, 853, 1, 0, 0, 0, 196,
-		855, 1, 0, 0, 0, 198, 857, 1, 0, 0, 0, 200, 861, 1, 0, 0, 0, 202, 873,
-		1, 0, 0, 0, 204, 216, 3, 6, 3, 0, 205, 216, 3, 42, 21, 0, 206, 216, 3,
-		44, 22, 0, 207, 216, 3, 2, 1, 0, 208, 216, 3, 90, 45, 0, 209, 216, 3, 48,
-		24, 0, 210, 216, 3, 50, 25, 0, 211, 216, 3, 4, 2, 0, 212, 213, 3, 200,
-		100, 0, 213, 214, 5, 0, 0, 1, 214, 216, 1, 0, 0, 0, 215, 204, 1, 0, 0,
-		0, 215, 205, 1, 0, 0, 0, 215, 206, 1, 0, 0, 0, 215, 207, 1, 0, 0, 0, 215,
-		208, 1, 0, 0, 0, 215, 209, 1, 0, 0, 0, 215, 210, 1, 0, 0, 0, 215, 211,
-		1, 0, 0, 0, 215, 212, 1, 0, 0, 0, 216, 1, 1, 0, 0, 0, 217, 218, 5, 23,
-		0, 0, 218, 219, 3, 200, 100, 0, 219, 3, 1, 0, 0, 0, 220, 221, 5, 8, 0,
-		0, 221, 222, 5, 55, 0, 0, 222, 223, 3, 178, 89, 0, 223, 5, 1, 0, 0, 0,
-		224, 248, 3, 8, 4, 0, 225, 248, 3, 18, 9, 0, 226, 248, 3, 20, 10, 0, 227,
-		248, 3, 22, 11, 0, 228, 248, 3, 24, 12, 0, 229, 248, 3, 26, 13, 0, 230,
-		248, 3, 14, 7, 0, 231, 248, 3, 16, 8, 0, 232, 248, 3, 28, 14, 0, 233, 248,
-		3, 34, 17, 0, 234, 248, 3, 36, 18, 0, 235, 248, 3, 38, 19, 0, 236, 248,
-		3, 30, 15, 0, 237, 248, 3, 32, 16, 0, 238, 248, 3, 46, 23, 0, 239, 248,
-		3, 52, 26, 0, 240, 248, 3, 54, 27, 0, 241, 248, 3, 56, 28, 0, 242, 248,
-		3, 58, 29, 0, 243, 248, 3, 60, 30, 0, 244, 248, 3, 62, 31, 0, 245, 248,
-		3, 10, 5, 0, 246, 248, 3, 12, 6, 0, 247, 224, 1, 0, 0, 0, 247, 225, 1,
-		0, 0, 0, 247, 226, 1, 0, 0, 0, 247, 227, 1, 0, 0, 0, 247, 228, 1, 0, 0,
-		0, 247, 229, 1, 0, 0, 0, 247, 230, 1, 0, 0, 0, 247, 231, 1, 0, 0, 0, 247,
-		232, 1, 0, 0, 0, 247, 233, 1, 0, 0, 0, 247, 234, 1, 0, 0, 0, 247, 235,
-		1, 0, 0, 0, 247, 236, 1, 0, 0, 0, 247, 237, 1, 0, 0, 0, 247, 238, 1, 0,
-		0, 0, 247, 239, 1, 0, 0, 0, 247, 240, 1, 0, 0, 0, 247, 241, 1, 0, 0, 0,
-		247, 242, 1, 0, 0, 0, 247, 243, 1, 0, 0, 0, 247, 244, 1, 0, 0, 0, 247,
-		245, 1, 0, 0, 0, 247, 246, 1, 0, 0, 0, 248, 7, 1, 0, 0, 0, 249, 250, 5,
-		21, 0, 0, 250, 251, 5, 26, 0, 0, 251, 9, 1, 0, 0, 0, 252, 253, 5, 21, 0,
-		0, 253, 254, 5, 85, 0, 0, 254, 11, 1, 0, 0, 0, 255, 256, 5, 21, 0, 0, 256,
-		257, 5, 86, 0, 0, 257, 258, 5, 54, 0, 0, 258, 259, 5, 87, 0, 0, 259, 260,
-		5, 113, 0, 0, 260, 261, 3, 74, 37, 0, 261, 13, 1, 0, 0, 0, 262, 263, 5,
-		21, 0, 0, 263, 264, 5, 34, 0, 0, 264, 15, 1, 0, 0, 0, 265, 266, 5, 21,
-		0, 0, 266, 267, 5, 55, 0, 0, 267, 17, 1, 0, 0, 0, 268, 269, 5, 21, 0, 0,
-		269, 270, 5, 27, 0, 0, 270, 271, 5, 28, 0, 0, 271, 19, 1, 0, 0, 0, 272,
-		273, 5, 21, 0, 0, 273, 274, 5, 33, 0, 0, 274, 275, 5, 27, 0, 0, 275, 276,
-		5, 53, 0, 0, 276, 277, 3, 76, 38, 0, 277, 278, 5, 54, 0, 0, 278, 279, 3,
-		106, 53, 0, 279, 21, 1, 0, 0, 0, 280, 281, 5, 21, 0, 0, 281, 282, 5, 32,
-		0, 0, 282, 283, 5, 27, 0, 0, 283, 284, 5, 53, 0, 0, 284, 285, 3, 76, 38,
-		0, 285, 286, 5, 54, 0, 0, 286, 289, 3, 106, 53, 0, 287, 288, 5, 62, 0,
-		0, 288, 290, 3, 102, 51, 0, 289, 287, 1, 0, 0, 0, 289, 290, 1, 0, 0, 0,
-		290, 23, 1, 0, 0, 0, 291, 292, 5, 21, 0, 0, 292, 293, 5, 26, 0, 0, 293,
-		294, 5, 27, 0, 0, 294, 295, 5, 53, 0, 0, 295, 296, 3, 76, 38, 0, 296, 297,
-		5, 54, 0, 0, 297, 298, 3, 106, 53, 0, 298, 25, 1, 0, 0, 0, 299, 300, 5,
-		21, 0, 0, 300, 301, 5, 31, 0, 0, 301, 302, 5, 27, 0, 0, 302, 303, 5, 53,
-		0, 0, 303, 304, 3, 76, 38, 0, 304, 305, 5, 54, 0, 0, 305, 306, 3, 106,
-		53, 0, 306, 27, 1, 0, 0, 0, 307, 308, 5, 21, 0, 0, 308, 309, 7, 0, 0, 0,
-		309, 310, 5, 35, 0, 0, 310, 29, 1, 0, 0, 0, 311, 312, 5, 21, 0, 0, 312,
-		313, 5, 13, 0, 0, 313, 314, 5, 54, 0, 0, 314, 315, 3, 104, 52, 0, 315,
-		31, 1, 0, 0, 0, 316, 317, 5, 21, 0, 0, 317, 318, 5, 14, 0, 0, 318, 319,
-		5, 37, 0, 0, 319, 320, 5, 54, 0, 0, 320, 321, 3, 104, 52, 0, 321, 33, 1,
-		0, 0, 0, 322, 323, 5, 21, 0, 0, 323, 324, 5, 33, 0, 0, 324, 325, 5, 43,
-		0, 0, 325, 326, 5, 54, 0, 0, 326, 327, 3, 118, 59, 0, 327, 35, 1, 0, 0,
-		0, 328, 329, 5, 21, 0, 0, 329, 330, 5, 32, 0, 0, 330, 331, 5, 43, 0, 0,
-		331, 332, 5, 54, 0, 0, 332, 333, 3, 118, 59, 0, 333, 37, 1, 0, 0, 0, 334,
-		335, 5, 21, 0, 0, 335, 336, 5, 31, 0, 0, 336, 337, 5, 43, 0, 0, 337, 338,
-		5, 54, 0, 0, 338, 339, 3, 118, 59, 0, 339, 39, 1, 0, 0, 0, 340, 341, 5,
-		6, 0, 0, 341, 342, 5, 31, 0, 0, 342, 343, 3, 176, 88, 0, 343, 41, 1, 0,
-		0, 0, 344, 345, 5, 6, 0, 0, 345, 346, 5, 32, 0, 0, 346, 347, 3, 176, 88,
-		0, 347, 43, 1, 0, 0, 0, 348, 349, 5, 22, 0, 0, 349, 350, 5, 31, 0, 0, 350,
-		351, 3, 72, 36, 0, 351, 45, 1, 0, 0, 0, 352, 353, 5, 21, 0, 0, 353, 354,
-		5, 36, 0, 0, 354, 47, 1, 0, 0, 0, 355, 356, 5, 6, 0, 0, 356, 359, 5, 37,
-		0, 0, 357, 360, 3, 176, 88, 0, 358, 360, 3, 78, 39, 0, 359, 357, 1, 0,
-		0, 0, 359, 358, 1, 0, 0, 0, 360, 49, 1, 0, 0, 0, 361, 362, 5, 9, 0, 0,
-		362, 363, 5, 37, 0, 0, 363, 364, 3, 70, 35, 0, 364, 51, 1, 0, 0, 0, 365,
-		366, 5, 21, 0, 0, 366, 367, 5, 38, 0, 0, 367, 53, 1, 0, 0, 0, 368, 369,
-		5, 21, 0, 0, 369, 374, 5, 40, 0, 0, 370, 371, 5, 54, 0, 0, 371, 372, 5,
-		39, 0, 0, 372, 373, 5, 113, 0, 0, 373, 375, 3, 64, 32, 0, 374, 370, 1,
-		0, 0, 0, 374, 375, 1, 0, 0, 0, 375, 377, 1, 0, 0, 0, 376, 378, 3, 192,
-		96, 0, 377, 376, 1, 0, 0, 0, 377, 378, 1, 0, 0, 0, 378, 55, 1, 0, 0, 0,
-		379, 380, 5, 21, 0, 0, 380, 383, 5, 42, 0, 0, 381, 382, 5, 20, 0, 0, 382,
-		384, 3, 68, 34, 0, 383, 381, 1, 0, 0, 0, 383, 384, 1, 0, 0, 0, 384, 389,
-		1, 0, 0, 0, 385, 386, 5, 54, 0, 0, 386, 387, 5, 43, 0, 0, 387, 388, 5,
-		113, 0, 0, 388, 390, 3, 64, 32, 0, 389, 385, 1, 0, 0, 0, 389, 390, 1, 0,
-		0, 0, 390, 392, 1, 0, 0, 0, 391, 393, 3, 192, 96, 0, 392, 391, 1, 0, 0,
-		0, 392, 393, 1, 0, 0, 0, 393, 57, 1, 0, 0, 0, 394, 395, 5, 21, 0, 0, 395,
-		396, 5, 45, 0, 0, 396, 397, 3, 108, 54, 0, 397, 59, 1, 0, 0, 0, 398, 399,
-		5, 21, 0, 0, 399, 400, 5, 46, 0, 0, 400, 401, 5, 48, 0, 0, 401, 402, 3,
-		108, 54, 0, 402, 61, 1, 0, 0, 0, 403, 404, 5, 21, 0, 0, 404, 405, 5, 46,
-		0, 0, 405, 406, 5, 51, 0, 0, 406, 407, 3, 108, 54, 0, 407, 408, 5, 50,
-		0, 0, 408, 409, 5, 49, 0, 0, 409, 410, 5, 113, 0, 0, 410, 412, 3, 66, 33,
-		0, 411, 413, 3, 110, 55, 0, 412, 411, 1, 0, 0, 0, 412, 413, 1, 0, 0, 0,
-		413, 415, 1, 0, 0, 0, 414, 416, 3, 192, 96, 0, 415, 414, 1, 0, 0, 0, 415,
-		416, 1, 0, 0, 0, 416, 63, 1, 0, 0, 0, 417, 418, 3, 200, 100, 0, 418, 65,
-		1, 0, 0, 0, 419, 420, 3, 200, 100, 0, 420, 67, 1, 0, 0, 0, 421, 422, 3,
-		200, 100, 0, 422, 69, 1, 0, 0, 0, 423, 424, 3, 200, 100, 0, 424, 71, 1,
-		0, 0, 0, 425, 426, 3, 200, 100, 0, 426, 73, 1, 0, 0, 0, 427, 428, 3, 200,
-		100, 0, 428, 75, 1, 0, 0, 0, 429, 430, 7, 1, 0, 0, 430, 77, 1, 0, 0, 0,
-		431, 432, 3, 70, 35, 0, 432, 433, 5, 50, 0, 0, 433, 434, 5, 127, 0, 0,
-		434, 435, 3, 80, 40, 0, 435, 436, 5, 128, 0, 0, 436, 437, 5, 82, 0, 0,
-		437, 438, 5, 127, 0, 0, 438, 443, 3, 82, 41, 0, 439, 440, 5, 122, 0, 0,
-		440, 442, 3, 82, 41, 0, 441, 439, 1, 0, 0, 0, 442, 445, 1, 0, 0, 0, 443,
-		441, 1, 0, 0, 0, 443, 444, 1, 0, 0, 0, 444, 446, 1, 0, 0, 0, 445, 443,
-		1, 0, 0, 0, 446, 447, 5, 128, 0, 0, 447, 79, 1, 0, 0, 0, 448, 453, 3, 84,
-		42, 0, 449, 450, 5, 122, 0, 0, 450, 452, 3, 84, 42, 0, 451, 449, 1, 0,
-		0, 0, 452, 455, 1, 0, 0, 0, 453, 451, 1, 0, 0, 0, 453, 454, 1, 0, 0, 0,
-		454, 81, 1, 0, 0, 0, 455, 453, 1, 0, 0, 0, 456, 457, 5, 127, 0, 0, 457,
-		458, 3, 80, 40, 0, 458, 459, 5, 128, 0, 0, 459, 83, 1, 0, 0, 0, 460, 461,
-		3, 86, 43, 0, 461, 462, 5, 112, 0, 0, 462, 463, 3, 88, 44, 0, 463, 85,
-		1, 0, 0, 0, 464, 465, 7, 2, 0, 0, 465, 87, 1, 0, 0, 0, 466, 472, 5, 4,
-		0, 0, 467, 472, 5, 1, 0, 0, 468, 472, 5, 2, 0, 0, 469, 472, 3, 160, 80,
-		0, 470, 472, 3, 188, 94, 0, 471, 466, 1, 0, 0, 0, 471, 467, 1, 0, 0, 0,
-		471, 468, 1, 0, 0, 0, 471, 469, 1, 0, 0, 0, 471, 470, 1, 0, 0, 0, 472,
-		89, 1, 0, 0, 0, 473, 475, 5, 58, 0, 0, 474, 473, 1, 0, 0, 0, 474, 475,
-		1, 0, 0, 0, 475, 476, 1, 0, 0, 0, 476, 478, 3, 92, 46, 0, 477, 479, 3,
-		110, 55, 0, 478, 477, 1, 0, 0, 0, 478, 479, 1, 0, 0, 0, 479, 481, 1, 0,
-		0, 0, 480, 482, 3, 130, 65, 0, 481, 480, 1, 0, 0, 0, 481, 482, 1, 0, 0,
-		0, 482, 484, 1, 0, 0, 0, 483, 485, 3, 138, 69, 0, 484, 483, 1, 0, 0, 0,
-		484, 485, 1, 0, 0, 0, 485, 487, 1, 0, 0, 0, 486, 488, 3, 192, 96, 0, 487,
-		486, 1, 0, 0, 0, 487, 488, 1, 0, 0, 0, 488, 490, 1, 0, 0, 0, 489, 491,
-		5, 59, 0, 0, 490, 489, 1, 0, 0, 0, 490, 491, 1, 0, 0, 0, 491, 91, 1, 0,
-		0, 0, 492, 493, 3, 94, 47, 0, 493, 494, 3, 108, 54, 0, 494, 499, 1, 0,
-		0, 0, 495, 496, 3, 108, 54, 0, 496, 497, 3, 94, 47, 0, 497, 499, 1, 0,
-		0, 0, 498, 492, 1, 0, 0, 0, 498, 495, 1, 0, 0, 0, 499, 93, 1, 0, 0, 0,
-		500, 501, 5, 60, 0, 0, 501, 502, 3, 96, 48, 0, 502, 95, 1, 0, 0, 0, 503,
-		508, 3, 98, 49, 0, 504, 505, 5, 122, 0, 0, 505, 507, 3, 98, 49, 0, 506,
-		504, 1, 0, 0, 0, 507, 510, 1, 0, 0, 0, 508, 506, 1, 0, 0, 0, 508, 509,
-		1, 0, 0, 0, 509, 97, 1, 0, 0, 0, 510, 508, 1, 0, 0, 0, 511, 513, 3, 156,
-		78, 0, 512, 514, 3, 100, 50, 0, 513, 512, 1, 0, 0, 0, 513, 514, 1, 0, 0,
-		0, 514, 99, 1, 0, 0, 0, 515, 516, 5, 61, 0, 0, 516, 517, 3, 200, 100, 0,
-		517, 101, 1, 0, 0, 0, 518, 519, 5, 32, 0, 0, 519, 520, 5, 113, 0, 0, 520,
-		521, 3, 200, 100, 0, 521, 103, 1, 0, 0, 0, 522, 523, 5, 37, 0, 0, 523,
-		524, 5, 113, 0, 0, 524, 525, 3, 200, 100, 0, 525, 105, 1, 0, 0, 0, 526,
-		527, 5, 29, 0, 0, 527, 528, 5, 113, 0, 0, 528, 529, 3, 200, 100, 0, 529,
-		107, 1, 0, 0, 0, 530, 531, 5, 53, 0, 0, 531, 534, 3, 194, 97, 0, 532, 533,
-		5, 20, 0, 0, 533, 535, 3, 68, 34, 0, 534, 532, 1, 0, 0, 0, 534, 535, 1,
-		0, 0, 0, 535, 109, 1, 0, 0, 0, 536, 537, 5, 54, 0, 0, 537, 538, 3, 112,
-		56, 0, 538, 111, 1, 0, 0, 0, 539, 550, 3, 114, 57, 0, 540, 541, 3, 114,
-		57, 0, 541, 542, 5, 62, 0, 0, 542, 543, 3, 122, 61, 0, 543, 550, 1, 0,
-		0, 0, 544, 547, 3, 122, 61, 0, 545, 546, 5, 62, 0, 0, 546, 548, 3, 114,
-		57, 0, 547, 545, 1, 0, 0, 0, 547, 548, 1, 0, 0, 0, 548, 550, 1, 0, 0, 0,
-		549, 539, 1, 0, 0, 0, 549, 540, 1, 0, 0, 0, 549, 544, 1, 0, 0, 0, 550,
-		113, 1, 0, 0, 0, 551, 552, 6, 57, -1, 0, 552, 553, 5, 127, 0, 0, 553, 554,
-		3, 114, 57, 0, 554, 555, 5, 128, 0, 0, 555, 580, 1, 0, 0, 0, 556, 565,
-		3, 196, 98, 0, 557, 566, 5, 113, 0, 0, 558, 566, 5, 70, 0, 0, 559, 560,
-		5, 71, 0, 0, 560, 566, 5, 70, 0, 0, 561, 566, 5, 120, 0, 0, 562, 566, 5,
-		121, 0, 0, 563, 566, 5, 114, 0, 0, 564, 566, 5, 115, 0, 0, 565, 557, 1,
-		0, 0, 0, 565, 558, 1, 0, 0, 0, 565, 559, 1, 0, 0, 0, 565, 561, 1, 0, 0,
-		0, 565, 562, 1, 0, 0, 0, 565, 563, 1, 0, 0, 0, 565, 564, 1, 0, 0, 0, 566,
-		567, 1, 0, 0, 0, 567, 568, 3, 198, 99, 0, 568, 580, 1, 0, 0, 0, 569, 573,
-		3, 196, 98, 0, 570, 574, 5, 81, 0, 0, 571, 572, 5, 71, 0, 0, 572, 574,
-		5, 81, 0, 0, 573, 570, 1, 0, 0, 0, 573, 571, 1, 0, 0, 0, 574, 575, 1, 0,
-		0, 0, 575, 576, 5, 127, 0, 0, 576, 577, 3, 116, 58, 0, 577, 578, 5, 128,
-		0, 0, 578, 580, 1, 0, 0, 0, 579, 551, 1, 0, 0, 0, 579, 556, 1, 0, 0, 0,
-		579, 569, 1, 0, 0, 0, 580, 586, 1, 0, 0, 0, 581, 582, 10, 1, 0, 0, 582,
-		583, 7, 3, 0, 0, 583, 585, 3, 114, 57, 2, 584, 581, 1, 0, 0, 0, 585, 588,
-		1, 0, 0, 0, 586, 584, 1, 0, 0, 0, 586, 587, 1, 0, 0, 0, 587, 115, 1, 0,
-		0, 0, 588, 586, 1, 0, 0, 0, 589, 594, 3, 198, 99, 0, 590, 591, 5, 122,
-		0, 0, 591, 593, 3, 198, 99, 0, 592, 590, 1, 0, 0, 0, 593, 596, 1, 0, 0,
-		0, 594, 592, 1, 0, 0, 0, 594, 595, 1, 0, 0, 0, 595, 117, 1, 0, 0, 0, 596,
-		594, 1, 0, 0, 0, 597, 598, 5, 43, 0, 0, 598, 599, 5, 81, 0, 0, 599, 600,
-		5, 127, 0, 0, 600, 601, 3, 120, 60, 0, 601, 602, 5, 128, 0, 0, 602, 119,
-		1, 0, 0, 0, 603, 608, 3, 200, 100, 0, 604, 605, 5, 122, 0, 0, 605, 607,
-		3, 200, 100, 0, 606, 604, 1, 0, 0, 0, 607, 610, 1, 0, 0, 0, 608, 606, 1,
-		0, 0, 0, 608, 609, 1, 0, 0, 0, 609, 121, 1, 0, 0, 0, 610, 608, 1, 0, 0,
-		0, 611, 614, 3, 124, 62, 0, 612, 613, 5, 62, 0, 0, 613, 615, 3, 124, 62,
-		0, 614, 612, 1, 0, 0, 0, 614, 615, 1, 0, 0, 0, 615, 123, 1, 0, 0, 0, 616,
-		617, 5, 79, 0, 0, 617, 620, 3, 154, 77, 0, 618, 621, 3, 126, 63, 0, 619,
-		621, 3, 200, 100, 0, 620, 618, 1, 0, 0, 0, 620, 619, 1, 0, 0, 0, 621, 125,
-		1, 0, 0, 0, 622, 624, 3, 128, 64, 0, 623, 625, 3, 160, 80, 0, 624, 623,
-		1, 0, 0, 0, 624, 625, 1, 0, 0, 0, 625, 127, 1, 0, 0, 0, 626, 627, 5, 80,
-		0, 0, 627, 629, 5, 127, 0, 0, 628, 630, 3, 168, 84, 0, 629, 628, 1, 0,
-		0, 0, 629, 630, 1, 0, 0, 0, 630, 631, 1, 0, 0, 0, 631, 632, 5, 128, 0,
-		0, 632, 129, 1, 0, 0, 0, 633, 634, 5, 74, 0, 0, 634, 635, 5, 76, 0, 0,
-		635, 641, 3, 132, 66, 0, 636, 637, 5, 64, 0, 0, 637, 638, 5, 127, 0, 0,
-		638, 639, 3, 136, 68, 0, 639, 640, 5, 128, 0, 0, 640, 642, 1, 0, 0, 0,
-		641, 636, 1, 0, 0, 0, 641, 642, 1, 0, 0, 0, 642, 644, 1, 0, 0, 0, 643,
-		645, 3, 144, 72, 0, 644, 643, 1, 0, 0, 0, 644, 645, 1, 0, 0, 0, 645, 131,
-		1, 0, 0, 0, 646, 651, 3, 134, 67, 0, 647, 648, 5, 122, 0, 0, 648, 650,
-		3, 134, 67, 0, 649, 647, 1, 0, 0, 0, 650, 653, 1, 0, 0, 0, 651, 649, 1,
-		0, 0, 0, 651, 652, 1, 0, 0, 0, 652, 133, 1, 0, 0, 0, 653, 651, 1, 0, 0,
-		0, 654, 664, 3, 200, 100, 0, 655, 656, 5, 79, 0, 0, 656, 657, 5, 127, 0,
-		0, 657, 658, 3, 160, 80, 0, 658, 659, 5, 128, 0, 0, 659, 664, 1, 0, 0,
-		0, 660, 661, 5, 79, 0, 0, 661, 662, 5, 127, 0, 0, 662, 664, 5, 128, 0,
-		0, 663, 654, 1, 0, 0, 0, 663, 655, 1, 0, 0, 0, 663, 660, 1, 0, 0, 0, 664,
-		135, 1, 0, 0, 0, 665, 666, 7, 4, 0, 0, 666, 137, 1, 0, 0, 0, 667, 668,
-		5, 67, 0, 0, 668, 669, 5, 76, 0, 0, 669, 670, 3, 142, 71, 0, 670, 139,
-		1, 0, 0, 0, 671, 675, 3, 156, 78, 0, 672, 674, 7, 5, 0, 0, 673, 672, 1,
-		0, 0, 0, 674, 677, 1, 0, 0, 0, 675, 673, 1, 0, 0, 0, 675, 676, 1, 0, 0,
-		0, 676, 141, 1, 0, 0, 0, 677, 675, 1, 0, 0, 0, 678, 683, 3, 140, 70, 0,
-		679, 680, 5, 122, 0, 0, 680, 682, 3, 140, 70, 0, 681, 679, 1, 0, 0, 0,
-		682, 685, 1, 0, 0, 0, 683, 681, 1, 0, 0, 0, 683, 684, 1, 0, 0, 0, 684,
-		143, 1, 0, 0, 0, 685, 683, 1, 0, 0, 0, 686, 687, 5, 75, 0, 0, 687, 688,
-		3, 146, 73, 0, 688, 145, 1, 0, 0, 0, 689, 690, 6, 73, -1, 0, 690, 691,
-		5, 127, 0, 0, 691, 692, 3, 146, 73, 0, 692, 693, 5, 128, 0, 0, 693, 696,
-		1, 0, 0, 0, 694, 696, 3, 150, 75, 0, 695, 689, 1, 0, 0, 0, 695, 694, 1,
-		0, 0, 0, 696, 703, 1, 0, 0, 0, 697, 698, 10, 2, 0, 0, 698, 699, 3, 148,
-		74, 0, 699, 700, 3, 146, 73, 3, 700, 702, 1, 0, 0, 0, 701, 697, 1, 0, 0,
-		0, 702, 705, 1, 0, 0, 0, 703, 701, 1, 0, 0, 0, 703, 704, 1, 0, 0, 0, 704,
-		147, 1, 0, 0, 0, 705, 703, 1, 0, 0, 0, 706, 707, 7, 3, 0, 0, 707, 149,
-		1, 0, 0, 0, 708, 709, 3, 152, 76, 0, 709, 151, 1, 0, 0, 0, 710, 711, 3,
-		156, 78, 0, 711, 712, 3, 154, 77, 0, 712, 713, 3, 156, 78, 0, 713, 153,
-		1, 0, 0, 0, 714, 723, 5, 113, 0, 0, 715, 723, 5, 114, 0, 0, 716, 723, 5,
-		115, 0, 0, 717, 723, 5, 118, 0, 0, 718, 723, 5, 119, 0, 0, 719, 723, 5,
-		116, 0, 0, 720, 723, 5, 117, 0, 0, 721, 723, 7, 6, 0, 0, 722, 714, 1, 0,
-		0, 0, 722, 715, 1, 0, 0, 0, 722, 716, 1, 0, 0, 0, 722, 717, 1, 0, 0, 0,
-		722, 718, 1, 0, 0, 0, 722, 719, 1, 0, 0, 0, 722, 720, 1, 0, 0, 0, 722,
-		721, 1, 0, 0, 0, 723, 155, 1, 0, 0, 0, 724, 725, 6, 78, -1, 0, 725, 726,
-		5, 127, 0, 0, 726, 727, 3, 156, 78, 0, 727, 728, 5, 128, 0, 0, 728, 734,
-		1, 0, 0, 0, 729, 734, 3, 164, 82, 0, 730, 734, 3, 172, 86, 0, 731, 734,
-		3, 160, 80, 0, 732, 734, 3, 158, 79, 0, 733, 724, 1, 0, 0, 0, 733, 729,
-		1, 0, 0, 0, 733, 730, 1, 0, 0, 0, 733, 731, 1, 0, 0, 0, 733, 732, 1, 0,
-		0, 0, 734, 749, 1, 0, 0, 0, 735, 736, 10, 9, 0, 0, 736, 737, 5, 132, 0,
-		0, 737, 748, 3, 156, 78, 10, 738, 739, 10, 8, 0, 0, 739, 740, 5, 131, 0,
-		0, 740, 748, 3, 156, 78, 9, 741, 742, 10, 7, 0, 0, 742, 743, 5, 129, 0,
-		0, 743, 748, 3, 156, 78, 8, 744, 745, 10, 6, 0, 0, 745, 746, 5, 130, 0,
-		0, 746, 748, 3, 156, 78, 7, 747, 735, 1, 0, 0, 0, 747, 738, 1, 0, 0, 0,
-		747, 741, 1, 0, 0, 0, 747, 744, 1, 0, 0, 0, 748, 751, 1, 0, 0, 0, 749,
-		747, 1, 0, 0, 0, 749, 750, 1, 0, 0, 0, 750, 157, 1, 0, 0, 0, 751, 749,
-		1, 0, 0, 0, 752, 753, 5, 132, 0, 0, 753, 159, 1, 0, 0, 0, 754, 755, 3,
-		188, 94, 0, 755, 756, 3, 162, 81, 0, 756, 161, 1, 0, 0, 0, 757, 758, 7,
-		7, 0, 0, 758, 163, 1, 0, 0, 0, 759, 760, 3, 166, 83, 0, 760, 762, 5, 127,
-		0, 0, 761, 763, 3, 168, 84, 0, 762, 761, 1, 0, 0, 0, 762, 763, 1, 0, 0,
-		0, 763, 764, 1, 0, 0, 0, 764, 765, 5, 128, 0, 0, 765, 165, 1, 0, 0, 0,
-		766, 767, 7, 8, 0, 0, 767, 167, 1, 0, 0, 0, 768, 773, 3, 170, 85, 0, 769,
-		770, 5, 122, 0, 0, 770, 772, 3, 170, 85, 0, 771, 769, 1, 0, 0, 0, 772,
-		775, 1, 0, 0, 0, 773, 771, 1, 0, 0, 0, 773, 774, 1, 0, 0, 0, 774, 169,
-		1, 0, 0, 0, 775, 773, 1, 0, 0, 0, 776, 779, 3, 156, 78, 0, 777, 779, 3,
-		114, 57, 0, 778, 776, 1, 0, 0, 0, 778, 777, 1, 0, 0, 0, 779, 171, 1, 0,
-		0, 0, 780, 782, 3, 200, 100, 0, 781, 783, 3, 174, 87, 0, 782, 781, 1, 0,
-		0, 0, 782, 783, 1, 0, 0, 0, 783, 787, 1, 0, 0, 0, 784, 787, 3, 190, 95,
-		0, 785, 787, 3, 188, 94, 0, 786, 780, 1, 0, 0, 0, 786, 784, 1, 0, 0, 0,
-		786, 785, 1, 0, 0, 0, 787, 173, 1, 0, 0, 0, 788, 789, 5, 125, 0, 0, 789,
-		790, 3, 114, 57, 0, 790, 791, 5, 126, 0, 0, 791, 175, 1, 0, 0, 0, 792,
-		793, 3, 186, 93, 0, 793, 177, 1, 0, 0, 0, 794, 795, 3, 200, 100, 0, 795,
-		179, 1, 0, 0, 0, 796, 797, 5, 123, 0, 0, 797, 802, 3, 182, 91, 0, 798,
-		799, 5, 122, 0, 0, 799, 801, 3, 182, 91, 0, 800, 798, 1, 0, 0, 0, 801,
-		804, 1, 0, 0, 0, 802, 800, 1, 0, 0, 0, 802, 803, 1, 0, 0, 0, 803, 805,
-		1, 0, 0, 0, 804, 802, 1, 0, 0, 0, 805, 806, 5, 124, 0, 0, 806, 810, 1,
-		0, 0, 0, 807, 808, 5, 123, 0, 0, 808, 810, 5, 124, 0, 0, 809, 796, 1, 0,
-		0, 0, 809, 807, 1, 0, 0, 0, 810, 181, 1, 0, 0, 0, 811, 812, 5, 4, 0, 0,
-		812, 813, 5, 112, 0, 0, 813, 814, 3, 186, 93, 0, 814, 183, 1, 0, 0, 0,
-		815, 816, 5, 125, 0, 0, 816, 821, 3, 186, 93, 0, 817, 818, 5, 122, 0, 0,
-		818, 820, 3, 186, 93, 0, 819, 817, 1, 0, 0, 0, 820, 823, 1, 0, 0, 0, 821,
-		819, 1, 0, 0, 0, 821, 822, 1, 0, 0, 0, 822, 824, 1, 0, 0, 0, 823, 821,
-		1, 0, 0, 0, 824, 825, 5, 126, 0, 0, 825, 829, 1, 0, 0, 0, 826, 827, 5,
-		125, 0, 0, 827, 829, 5, 126, 0, 0, 828, 815, 1, 0, 0, 0, 828, 826, 1, 0,
-		0, 0, 829, 185, 1, 0, 0, 0, 830, 839, 5, 4, 0, 0, 831, 839, 3, 188, 94,
-		0, 832, 839, 3, 190, 95, 0, 833, 839, 3, 180, 90, 0, 834, 839, 3, 184,
-		92, 0, 835, 839, 5, 1, 0, 0, 836, 839, 5, 2, 0, 0, 837, 839, 5, 3, 0, 0,
-		838, 830, 1, 0, 0, 0, 838, 831, 1, 0, 0, 0, 838, 832, 1, 0, 0, 0, 838,
-		833, 1, 0, 0, 0, 838, 834, 1, 0, 0, 0, 838, 835, 1, 0, 0, 0, 838, 836,
-		1, 0, 0, 0, 838, 837, 1, 0, 0, 0, 839, 187, 1, 0, 0, 0, 840, 842, 7, 9,
-		0, 0, 841, 840, 1, 0, 0, 0, 841, 842, 1, 0, 0, 0, 842, 843, 1, 0, 0, 0,
-		843, 844, 5, 136, 0, 0, 844, 189, 1, 0, 0, 0, 845, 847, 7, 9, 0, 0, 846,
-		845, 1, 0, 0, 0, 846, 847, 1, 0, 0, 0, 847, 848, 1, 0, 0, 0, 848, 849,
-		5, 137, 0, 0, 849, 191, 1, 0, 0, 0, 850, 851, 5, 55, 0, 0, 851, 852, 5,
-		136, 0, 0, 852, 193, 1, 0, 0, 0, 853, 854, 3, 200, 100, 0, 854, 195, 1,
-		0, 0, 0, 855, 856, 3, 200, 100, 0, 856, 197, 1, 0, 0, 0, 857, 858, 3, 200,
-		100, 0, 858, 199, 1, 0, 0, 0, 859, 862, 5, 135, 0, 0, 860, 862, 3, 202,
-		101, 0, 861, 859, 1, 0, 0, 0, 861, 860, 1, 0, 0, 0, 862, 870, 1, 0, 0,
-		0, 863, 866, 5, 111, 0, 0, 864, 867, 5, 135, 0, 0, 865, 867, 3, 202, 101,
-		0, 866, 864, 1, 0, 0, 0, 866, 865, 1, 0, 0, 0, 867, 869, 1, 0, 0, 0, 868,
-		863, 1, 0, 0, 0, 869, 872, 1, 0, 0, 0, 870, 868, 1, 0, 0, 0, 870, 871,
-		1, 0, 0, 0, 871, 201, 1, 0, 0, 0, 872, 870, 1, 0, 0, 0, 873, 874, 7, 10,
-		0, 0, 874, 203, 1, 0, 0, 0, 63, 215, 247, 289, 359, 374, 377, 383, 389,
-		392, 412, 415, 443, 453, 471, 474, 478, 481, 484, 487, 490, 498, 508, 513,
-		534, 547, 549, 565, 573, 579, 586, 594, 608, 614, 620, 624, 629, 641, 644,
-		651, 663, 675, 683, 695, 703, 722, 733, 747, 749, 762, 773, 778, 782, 786,
-		802, 809, 821, 828, 838, 841, 846, 861, 866, 870,
+		3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 3, 3, 291, 8, 3, 1,
+		4, 1, 4, 1, 4, 1, 5, 1, 5, 1, 5, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1,
+		6, 1, 7, 1, 7, 1, 7, 1, 8, 1, 8, 1, 8, 1, 9, 1, 9, 1, 9, 1, 9, 1, 10, 1,
+		10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 11, 1, 11, 1, 11, 1, 11,
+		1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 3, 11, 333, 8, 11, 1, 12, 1, 12, 1,
+		12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13,
+		1, 13, 1, 13, 1, 13, 1, 14, 1, 14, 1, 14, 1, 14, 1, 15, 1, 15, 1, 15, 1,
+		15, 1, 15, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 17, 1, 17, 1, 17,
+		1, 17, 1, 17, 1, 17, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 19, 1,
+		19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 20, 1, 20, 1, 20, 1, 20, 1, 21, 1, 21,
+		1, 21, 1, 21, 1, 22, 1, 22, 1, 22, 1, 22, 1, 23, 1, 23, 1, 23, 1, 23, 1,
+		23, 1, 23, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 25, 1, 25, 1, 25,
+		1, 26, 1, 26, 1, 26, 1, 26, 3, 26, 415, 8, 26, 1, 27, 1, 27, 1, 27, 1,
+		27, 1, 28, 1, 28, 1, 28, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 3, 29,
+		430, 8, 29, 1, 29, 3, 29, 433, 8, 29, 1, 30, 1, 30, 1, 30, 1, 30, 3, 30,
+		439, 8, 30, 1, 30, 1, 30, 1, 30, 1, 30, 3, 30, 445, 8, 30, 1, 30, 3, 30,
+		448, 8, 30, 1, 31, 1, 31, 1, 31, 1, 31, 1, 32, 1, 32, 1, 32, 1, 32, 1,
+		32, 1, 33, 1, 33, 1, 33, 1, 33, 1, 34, 1, 34, 1, 34, 1, 35, 1, 35, 1, 35,
+		1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 36, 1, 36, 1,
+		36, 1, 36, 1, 36, 1, 37, 1, 37, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38,
+		1, 38, 1, 38, 1, 38, 3, 38, 493, 8, 38, 1, 38, 3, 38, 496, 8, 38, 1, 39,
+		1, 39, 1, 40, 1, 40, 1, 41, 1, 41, 1, 42, 1, 42, 1, 43, 1, 43, 1, 44, 1,
+		44, 1, 45, 1, 45, 1, 46, 1, 46, 1, 47, 1, 47, 1, 47, 1, 47, 1, 47, 1, 47,
+		1, 47, 1, 47, 1, 47, 1, 47, 5, 47, 524, 8, 47, 10, 47, 12, 47, 527, 9,
+		47, 1, 47, 1, 47, 1, 48, 1, 48, 1, 48, 5, 48, 534, 8, 48, 10, 48, 12, 48,
+		537, 9, 48, 1, 49, 1, 49, 1, 49, 1, 49, 1, 50, 1, 50, 1, 50, 1, 50, 1,
+		51, 1, 51, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 3, 52, 554, 8, 52, 1, 53,
+		1, 53, 1, 53, 1, 53, 1, 54, 1, 54, 1, 54, 1, 54, 1, 54, 3, 54, 565, 8,
+		54, 1, 55, 1, 55, 1, 55, 1, 55, 1, 56, 3, 56, 572, 8, 56, 1, 56, 1, 56,
+		3, 56, 576, 8, 56, 1, 56, 3, 56, 579, 8, 56, 1, 56, 3, 56, 582, 8, 56,
+		1, 56, 3, 56, 585, 8, 56, 1, 56, 3, 56, 588, 8, 56, 1, 57, 1, 57, 1, 57,
+		1, 57, 3, 57, 594, 8, 57, 1, 57, 1, 57, 3, 57, 598, 8, 57, 1, 57, 1, 57,
+		3, 57, 602, 8, 57, 1, 58, 1, 58, 1, 58, 1, 59, 1, 59, 1, 59, 5, 59, 610,
+		8, 59, 10, 59, 12, 59, 613, 9, 59, 1, 60, 1, 60, 3, 60, 617, 8, 60, 1,
+		61, 1, 61, 1, 61, 1, 62, 1, 62, 1, 62, 1, 62, 1, 63, 1, 63, 1, 63, 1, 63,
+		1, 64, 1, 64, 1, 64, 1, 64, 1, 65, 1, 65, 1, 65, 1, 65, 3, 65, 638, 8,
+		65, 1, 66, 1, 66, 1, 66, 1, 66, 4, 66, 644, 8, 66, 11, 66, 12, 66, 645,
+		1, 66, 1, 66, 3, 66, 650, 8, 66, 1, 67, 1, 67, 1, 67, 1, 67, 1, 68, 1,
+		68, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 70, 1, 70, 1, 70, 1, 71, 1, 71,
+		1, 71, 1, 71, 1, 71, 1, 71, 1, 71, 1, 71, 3, 71, 674, 8, 71, 3, 71, 676,
+		8, 71, 1, 72, 1, 72, 1, 72, 1, 72, 1, 72, 1, 72, 1, 72, 1, 72, 1, 72, 1,
+		72, 1, 72, 1, 72, 1, 72, 1, 72, 3, 72, 692, 8, 72, 1, 72, 1, 72, 1, 72,
+		1, 72, 1, 72, 1, 72, 3, 72, 700, 8, 72, 1, 72, 1, 72, 1, 72, 1, 72, 3,
+		72, 706, 8, 72, 1, 72, 1, 72, 1, 72, 5, 72, 711, 8, 72, 10, 72, 12, 72,
+		714, 9, 72, 1, 73, 1, 73, 1, 73, 5, 73, 719, 8, 73, 10, 73, 12, 73, 722,
+		9, 73, 1, 74, 1, 74, 1, 74, 1, 74, 1, 74, 1, 74, 1, 75, 1, 75, 1, 75, 5,
+		75, 733, 8, 75, 10, 75, 12, 75, 736, 9, 75, 1, 76, 1, 76, 1, 76, 3, 76,
+		741, 8, 76, 1, 77, 1, 77, 1, 77, 1, 77, 3, 77, 747, 8, 77, 1, 78, 1, 78,
+		3, 78, 751, 8, 78, 1, 79, 1, 79, 1, 79, 3, 79, 756, 8, 79, 1, 79, 1, 79,
+		1, 80, 1, 80, 1, 80, 1, 80, 1, 80, 1, 80, 1, 80, 1, 80, 3, 80, 768, 8,
+		80, 1, 80, 3, 80, 771, 8, 80, 1, 81, 1, 81, 1, 81, 5, 81, 776, 8, 81, 10,
+		81, 12, 81, 779, 9, 81, 1, 82, 1, 82, 1, 82, 1, 82, 1, 82, 1, 82, 1, 82,
+		1, 82, 1, 82, 1, 82, 3, 82, 791, 8, 82, 1, 83, 1, 83, 1, 83, 1, 83, 1,
+		83, 5, 83, 798, 8, 83, 10, 83, 12, 83, 801, 9, 83, 1, 83, 1, 83, 1, 83,
+		1, 83, 1, 84, 1, 84, 1, 85, 1, 85, 1, 85, 1, 85, 3, 85, 813, 8, 85, 1,
+		85, 3, 85, 816, 8, 85, 1, 86, 1, 86, 1, 86, 1, 86, 1, 87, 1, 87, 5, 87,
+		824, 8, 87, 10, 87, 12, 87, 827, 9, 87, 1, 88, 1, 88, 1, 88, 5, 88, 832,
+		8, 88, 10, 88, 12, 88, 835, 9, 88, 1, 89, 1, 89, 1, 89, 1, 90, 1, 90, 1,
+		90, 1, 90, 1, 90, 1, 90, 3, 90, 846, 8, 90, 1, 90, 1, 90, 1, 90, 1, 90,
+		5, 90, 852, 8, 90, 10, 90, 12, 90, 855, 9, 90, 1, 91, 1, 91, 1, 92, 1,
+		92, 1, 93, 1, 93, 1, 93, 1, 93, 1, 94, 1, 94, 1, 94, 1, 94, 1, 94, 1, 94,
+		1, 94, 1, 94, 3, 94, 873, 8, 94, 1, 95, 1, 95, 1, 95, 1, 95, 1, 95, 1,
+		95, 1, 95, 1, 95, 1, 95, 3, 95, 884, 8, 95, 1, 95, 1, 95, 1, 95, 1, 95,
+		1, 95, 1, 95, 1, 95, 1, 95, 1, 95, 1, 95, 1, 95, 1, 95, 5, 95, 898, 8,
+		95, 10, 95, 12, 95, 901, 9, 95, 1, 96, 1, 96, 1, 97, 1, 97, 1, 97, 1, 98,
+		1, 98, 1, 99, 1, 99, 1, 99, 3, 99, 913, 8, 99, 1, 99, 1, 99, 1, 99, 3,
+		99, 918, 8, 99, 1, 100, 1, 100, 1, 101, 1, 101, 1, 101, 5, 101, 925, 8,
+		101, 10, 101, 12, 101, 928, 9, 101, 1, 102, 1, 102, 1, 102, 3, 102, 933,
+		8, 102, 1, 103, 1, 103, 3, 103, 937, 8, 103, 1, 103, 1, 103, 3, 103, 941,
+		8, 103, 1, 104, 1, 104, 1, 104, 1, 104, 1, 105, 1, 105, 1, 106, 1, 106,
+		1, 107, 1, 107, 1, 107, 1, 107, 5, 107, 955, 8, 107, 10, 107, 12, 107,
+		958, 9, 107, 1, 107, 1, 107, 1, 107, 1, 107, 3, 107, 964, 8, 107, 1, 108,
+		1, 108, 1, 108, 1, 108, 1, 109, 1, 109, 1, 109, 1, 109, 5, 109, 974, 8,
+		109, 10, 109, 12, 109, 977, 9, 109, 1, 109, 1, 109, 1, 109, 1, 109, 3,
+		109, 983, 8, 109, 1, 110, 1, 110, 1, 110, 1, 110, 1, 110, 1, 110, 1, 110,
+		1, 110, 3, 110, 993, 8, 110, 1, 111, 3, 111, 996, 8, 111, 1, 111, 1, 111,
+		1, 112, 3, 112, 1001, 8, 112, 1, 112, 1, 112, 1, 113, 1, 113, 1, 113, 1,
+		114, 1, 114, 1, 115, 1, 115, 1, 116, 1, 116, 1, 117, 1, 117, 3, 117, 1016,
+		8, 117, 1, 117, 1, 117, 1, 117, 3, 117, 1021, 8, 117, 5, 117, 1023, 8,
+		117, 10, 117, 12, 117, 1026, 9, 117, 1, 118, 1, 118, 1, 118, 0, 3, 144,
+		180, 190, 119, 0, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30,
+		32, 34, 36, 38, 40, 42, 44, 46, 48, 50, 52, 54, 56, 58, 60, 62, 64, 66,
+		68, 70, 72, 74, 76, 78, 80, 82, 84, 86, 88, 90, 92, 94, 96, 98, 100, 102,
+		104, 106, 108, 110, 112, 114, 116, 118, 120, 122, 124, 126, 128, 130, 132,
+		134, 136, 138, 140, 142, 144, 146, 148, 150, 152, 154, 156, 158, 160, 162,
+		164, 166, 168, 170, 172, 174, 176, 178, 180, 182, 184, 186, 188, 190, 192,
+		194, 196, 198, 200, 202, 204, 206, 208, 210, 212, 214, 216, 218, 220, 222,
+		224, 226, 228, 230, 232, 234, 236, 0, 12, 1, 0, 33, 35, 1, 0, 26, 27, 3,
+		0, 9, 9, 33, 33, 136, 141, 1, 0, 64, 65, 1, 0, 130, 132, 1, 0, 174, 175,
+		1, 0, 70, 71, 2, 0, 72, 72, 158, 158, 1, 0, 142, 148, 3, 0, 92, 92, 97,
+		129, 133, 135, 1, 0, 167, 168, 3, 0, 5, 20, 22, 135, 142, 148, 1053, 0,
+		256, 1, 0, 0, 0, 2, 258, 1, 0, 0, 0, 4, 261, 1, 0, 0, 0, 6, 290, 1, 0,
+		0, 0, 8, 292, 1, 0, 0, 0, 10, 295, 1, 0, 0, 0, 12, 298, 1, 0, 0, 0, 14,
+		305, 1, 0, 0, 0, 16, 308, 1, 0, 0, 0, 18, 311, 1, 0, 0, 0, 20, 315, 1,
+		0, 0, 0, 22, 323, 1, 0, 0, 0, 24, 334, 1, 0, 0, 0, 26, 342, 1, 0, 0, 0,
+		28, 350, 1, 0, 0, 0, 30, 354, 1, 0, 0, 0, 32, 359, 1, 0, 0, 0, 34, 365,
+		1, 0, 0, 0, 36, 371, 1, 0, 0, 0, 38, 377, 1, 0, 0, 0, 40, 383, 1, 0, 0,
+		0, 42, 387, 1, 0, 0, 0, 44, 391, 1, 0, 0, 0, 46, 395, 1, 0, 0, 0, 48, 401,
+		1, 0, 0, 0, 50, 407, 1, 0, 0, 0, 52, 410, 1, 0, 0, 0, 54, 416, 1, 0, 0,
+		0, 56, 420, 1, 0, 0, 0, 58, 423, 1, 0, 0, 0, 60, 434, 1, 0, 0, 0, 62, 449,
+		1, 0, 0, 0, 64, 453, 1, 0, 0, 0, 66, 458, 1, 0, 0, 0, 68, 462, 1, 0, 0,
+		0, 70, 465, 1, 0, 0, 0, 72, 476, 1, 0, 0, 0, 74, 481, 1, 0, 0, 0, 76, 483,
+		1, 0, 0, 0, 78, 497, 1, 0, 0, 0, 80, 499, 1, 0, 0, 0, 82, 501, 1, 0, 0,
+		0, 84, 503, 1, 0, 0, 0, 86, 505, 1, 0, 0, 0, 88, 507, 1, 0, 0, 0, 90, 509,
+		1, 0, 0, 0, 92, 511, 1, 0, 0, 0, 94, 513, 1, 0, 0, 0, 96, 530, 1, 0, 0,
+		0, 98, 538, 1, 0, 0, 0, 100, 542, 1, 0, 0, 0, 102, 546, 1, 0, 0, 0, 104,
+		553, 1, 0, 0, 0, 106, 555, 1, 0, 0, 0, 108, 559, 1, 0, 0, 0, 110, 566,
+		1, 0, 0, 0, 112, 571, 1, 0, 0, 0, 114, 601, 1, 0, 0, 0, 116, 603, 1, 0,
+		0, 0, 118, 606, 1, 0, 0, 0, 120, 614, 1, 0, 0, 0, 122, 618, 1, 0, 0, 0,
+		124, 621, 1, 0, 0, 0, 126, 625, 1, 0, 0, 0, 128, 629, 1, 0, 0, 0, 130,
+		633, 1, 0, 0, 0, 132, 639, 1, 0, 0, 0, 134, 651, 1, 0, 0, 0, 136, 655,
+		1, 0, 0, 0, 138, 657, 1, 0, 0, 0, 140, 662, 1, 0, 0, 0, 142, 675, 1, 0,
+		0, 0, 144, 705, 1, 0, 0, 0, 146, 715, 1, 0, 0, 0, 148, 723, 1, 0, 0, 0,
+		150, 729, 1, 0, 0, 0, 152, 737, 1, 0, 0, 0, 154, 742, 1, 0, 0, 0, 156,
+		748, 1, 0, 0, 0, 158, 752, 1, 0, 0, 0, 160, 759, 1, 0, 0, 0, 162, 772,
+		1, 0, 0, 0, 164, 790, 1, 0, 0, 0, 166, 792, 1, 0, 0, 0, 168, 806, 1, 0,
+		0, 0, 170, 815, 1, 0, 0, 0, 172, 817, 1, 0, 0, 0, 174, 821, 1, 0, 0, 0,
+		176, 828, 1, 0, 0, 0, 178, 836, 1, 0, 0, 0, 180, 845, 1, 0, 0, 0, 182,
+		856, 1, 0, 0, 0, 184, 858, 1, 0, 0, 0, 186, 860, 1, 0, 0, 0, 188, 872,
+		1, 0, 0, 0, 190, 883, 1, 0, 0, 0, 192, 902, 1, 0, 0, 0, 194, 904, 1, 0,
+		0, 0, 196, 907, 1, 0, 0, 0, 198, 909, 1, 0, 0, 0, 200, 919, 1, 0, 0, 0,
+		202, 921, 1, 0, 0, 0, 204, 932, 1, 0, 0, 0, 206, 940, 1, 0, 0, 0, 208,
+		942, 1, 0, 0, 0, 210, 946, 1, 0, 0, 0, 212, 948, 1, 0, 0, 0, 214, 963,
+		1, 0, 0, 0, 216, 965, 1, 0, 0, 0, 218, 982, 1, 0, 0, 0, 220, 992, 1, 0,
+		0, 0, 222, 995, 1, 0, 0, 0, 224, 1000, 1, 0, 0, 0, 226, 1004, 1, 0, 0,
+		0, 228, 1007, 1, 0, 0, 0, 230, 1009, 1, 0, 0, 0, 232, 1011, 1, 0, 0, 0,
+		234, 1015, 1, 0, 0, 0, 236, 1027, 1, 0, 0, 0, 238, 257, 3, 6, 3, 0, 239,
+		257, 3, 42, 21, 0, 240, 257, 3, 44, 22, 0, 241, 257, 3, 46, 23, 0, 242,
+		257, 3, 48, 24, 0, 243, 257, 3, 2, 1, 0, 244, 257, 3, 112, 56, 0, 245,
+		257, 3, 52, 26, 0, 246, 257, 3, 54, 27, 0, 247, 257, 3, 70, 35, 0, 248,
+		257, 3, 72, 36, 0, 249, 257, 3, 106, 53, 0, 250, 257, 3, 108, 54, 0, 251,
+		257, 3, 110, 55, 0, 252, 257, 3, 4, 2, 0, 253, 254, 3, 234, 117, 0, 254,
+		255, 5, 0, 0, 1, 255, 257, 1, 0, 0, 0, 256, 238, 1, 0, 0, 0, 256, 239,
+		1, 0, 0, 0, 256, 240, 1, 0, 0, 0, 256, 241, 1, 0, 0, 0, 256, 242, 1, 0,
+		0, 0, 256, 243, 1, 0, 0, 0, 256, 244, 1, 0, 0, 0, 256, 245, 1, 0, 0, 0,
+		256, 246, 1, 0, 0, 0, 256, 247, 1, 0, 0, 0, 256, 248, 1, 0, 0, 0, 256,
+		249, 1, 0, 0, 0, 256, 250, 1, 0, 0, 0, 256, 251, 1, 0, 0, 0, 256, 252,
+		1, 0, 0, 0, 256, 253, 1, 0, 0, 0, 257, 1, 1, 0, 0, 0, 258, 259, 5, 25,
+		0, 0, 259, 260, 3, 234, 117, 0, 260, 3, 1, 0, 0, 0, 261, 262, 5, 7, 0,
+		0, 262, 263, 5, 57, 0, 0, 263, 264, 3, 212, 106, 0, 264, 5, 1, 0, 0, 0,
+		265, 291, 3, 8, 4, 0, 266, 291, 3, 18, 9, 0, 267, 291, 3, 20, 10, 0, 268,
+		291, 3, 22, 11, 0, 269, 291, 3, 24, 12, 0, 270, 291, 3, 26, 13, 0, 271,
+		291, 3, 14, 7, 0, 272, 291, 3, 16, 8, 0, 273, 291, 3, 28, 14, 0, 274, 291,
+		3, 34, 17, 0, 275, 291, 3, 36, 18, 0, 276, 291, 3, 38, 19, 0, 277, 291,
+		3, 30, 15, 0, 278, 291, 3, 32, 16, 0, 279, 291, 3, 50, 25, 0, 280, 291,
+		3, 56, 28, 0, 281, 291, 3, 58, 29, 0, 282, 291, 3, 60, 30, 0, 283, 291,
+		3, 62, 31, 0, 284, 291, 3, 64, 32, 0, 285, 291, 3, 76, 38, 0, 286, 291,
+		3, 10, 5, 0, 287, 291, 3, 12, 6, 0, 288, 291, 3, 66, 33, 0, 289, 291, 3,
+		68, 34, 0, 290, 265, 1, 0, 0, 0, 290, 266, 1, 0, 0, 0, 290, 267, 1, 0,
+		0, 0, 290, 268, 1, 0, 0, 0, 290, 269, 1, 0, 0, 0, 290, 270, 1, 0, 0, 0,
+		290, 271, 1, 0, 0, 0, 290, 272, 1, 0, 0, 0, 290, 273, 1, 0, 0, 0, 290,
+		274, 1, 0, 0, 0, 290, 275, 1, 0, 0, 0, 290, 276, 1, 0, 0, 0, 290, 277,
+		1, 0, 0, 0, 290, 278, 1, 0, 0, 0, 290, 279, 1, 0, 0, 0, 290, 280, 1, 0,
+		0, 0, 290, 281, 1, 0, 0, 0, 290, 282, 1, 0, 0, 0, 290, 283, 1, 0, 0, 0,
+		290, 284, 1, 0, 0, 0, 290, 285, 1, 0, 0, 0, 290, 286, 1, 0, 0, 0, 290,
+		287, 1, 0, 0, 0, 290, 288, 1, 0, 0, 0, 290, 289, 1, 0, 0, 0, 291, 7, 1,
+		0, 0, 0, 292, 293, 5, 20, 0, 0, 293, 294, 5, 28, 0, 0, 294, 9, 1, 0, 0,
+		0, 295, 296, 5, 20, 0, 0, 296, 297, 5, 94, 0, 0, 297, 11, 1, 0, 0, 0, 298,
+		299, 5, 20, 0, 0, 299, 300, 5, 95, 0, 0, 300, 301, 5, 56, 0, 0, 301, 302,
+		5, 96, 0, 0, 302, 303, 5, 151, 0, 0, 303, 304, 3, 90, 45, 0, 304, 13, 1,
+		0, 0, 0, 305, 306, 5, 20, 0, 0, 306, 307, 5, 36, 0, 0, 307, 15, 1, 0, 0,
+		0, 308, 309, 5, 20, 0, 0, 309, 310, 5, 57, 0, 0, 310, 17, 1, 0, 0, 0, 311,
+		312, 5, 20, 0, 0, 312, 313, 5, 29, 0, 0, 313, 314, 5, 30, 0, 0, 314, 19,
+		1, 0, 0, 0, 315, 316, 5, 20, 0, 0, 316, 317, 5, 35, 0, 0, 317, 318, 5,
+		29, 0, 0, 318, 319, 5, 55, 0, 0, 319, 320, 3, 92, 46, 0, 320, 321, 5, 56,
+		0, 0, 321, 322, 3, 128, 64, 0, 322, 21, 1, 0, 0, 0, 323, 324, 5, 20, 0,
+		0, 324, 325, 5, 34, 0, 0, 325, 326, 5, 29, 0, 0, 326, 327, 5, 55, 0, 0,
+		327, 328, 3, 92, 46, 0, 328, 329, 5, 56, 0, 0, 329, 332, 3, 128, 64, 0,
+		330, 331, 5, 64, 0, 0, 331, 333, 3, 124, 62, 0, 332, 330, 1, 0, 0, 0, 332,
+		333, 1, 0, 0, 0, 333, 23, 1, 0, 0, 0, 334, 335, 5, 20, 0, 0, 335, 336,
+		5, 28, 0, 0, 336, 337, 5, 29, 0, 0, 337, 338, 5, 55, 0, 0, 338, 339, 3,
+		92, 46, 0, 339, 340, 5, 56, 0, 0, 340, 341, 3, 128, 64, 0, 341, 25, 1,
+		0, 0, 0, 342, 343, 5, 20, 0, 0, 343, 344, 5, 33, 0, 0, 344, 345, 5, 29,
+		0, 0, 345, 346, 5, 55, 0, 0, 346, 347, 3, 92, 46, 0, 347, 348, 5, 56, 0,
+		0, 348, 349, 3, 128, 64, 0, 349, 27, 1, 0, 0, 0, 350, 351, 5, 20, 0, 0,
+		351, 352, 7, 0, 0, 0, 352, 353, 5, 37, 0, 0, 353, 29, 1, 0, 0, 0, 354,
+		355, 5, 20, 0, 0, 355, 356, 5, 12, 0, 0, 356, 357, 5, 56, 0, 0, 357, 358,
+		3, 126, 63, 0, 358, 31, 1, 0, 0, 0, 359, 360, 5, 20, 0, 0, 360, 361, 5,
+		13, 0, 0, 361, 362, 5, 39, 0, 0, 362, 363, 5, 56, 0, 0, 363, 364, 3, 126,
+		63, 0, 364, 33, 1, 0, 0, 0, 365, 366, 5, 20, 0, 0, 366, 367, 5, 35, 0,
+		0, 367, 368, 5, 45, 0, 0, 368, 369, 5, 56, 0, 0, 369, 370, 3, 148, 74,
+		0, 370, 35, 1, 0, 0, 0, 371, 372, 5, 20, 0, 0, 372, 373, 5, 34, 0, 0, 373,
+		374, 5, 45, 0, 0, 374, 375, 5, 56, 0, 0, 375, 376, 3, 148, 74, 0, 376,
+		37, 1, 0, 0, 0, 377, 378, 5, 20, 0, 0, 378, 379, 5, 33, 0, 0, 379, 380,
+		5, 45, 0, 0, 380, 381, 5, 56, 0, 0, 381, 382, 3, 148, 74, 0, 382, 39, 1,
+		0, 0, 0, 383, 384, 5, 5, 0, 0, 384, 385, 5, 33, 0, 0, 385, 386, 3, 210,
+		105, 0, 386, 41, 1, 0, 0, 0, 387, 388, 5, 5, 0, 0, 388, 389, 5, 34, 0,
+		0, 389, 390, 3, 210, 105, 0, 390, 43, 1, 0, 0, 0, 391, 392, 5, 21, 0, 0,
+		392, 393, 5, 33, 0, 0, 393, 394, 3, 86, 43, 0, 394, 45, 1, 0, 0, 0, 395,
+		396, 5, 22, 0, 0, 396, 397, 5, 39, 0, 0, 397, 398, 3, 84, 42, 0, 398, 399,
+		5, 24, 0, 0, 399, 400, 3, 88, 44, 0, 400, 47, 1, 0, 0, 0, 401, 402, 5,
+		23, 0, 0, 402, 403, 5, 39, 0, 0, 403, 404, 3, 84, 42, 0, 404, 405, 5, 55,
+		0, 0, 405, 406, 3, 88, 44, 0, 406, 49, 1, 0, 0, 0, 407, 408, 5, 20, 0,
+		0, 408, 409, 5, 38, 0, 0, 409, 51, 1, 0, 0, 0, 410, 411, 5, 5, 0, 0, 411,
+		414, 5, 39, 0, 0, 412, 415, 3, 210, 105, 0, 413, 415, 3, 94, 47, 0, 414,
+		412, 1, 0, 0, 0, 414, 413, 1, 0, 0, 0, 415, 53, 1, 0, 0, 0, 416, 417, 5,
+		8, 0, 0, 417, 418, 5, 39, 0, 0, 418, 419, 3, 84, 42, 0, 419, 55, 1, 0,
+		0, 0, 420, 421, 5, 20, 0, 0, 421, 422, 5, 40, 0, 0, 422, 57, 1, 0, 0, 0,
+		423, 424, 5, 20, 0, 0, 424, 429, 5, 42, 0, 0, 425, 426, 5, 56, 0, 0, 426,
+		427, 5, 41, 0, 0, 427, 428, 5, 151, 0, 0, 428, 430, 3, 78, 39, 0, 429,
+		425, 1, 0, 0, 0, 429, 430, 1, 0, 0, 0, 430, 432, 1, 0, 0, 0, 431, 433,
+		3, 226, 113, 0, 432, 431, 1, 0, 0, 0, 432, 433, 1, 0, 0, 0, 433, 59, 1,
+		0, 0, 0, 434, 435, 5, 20, 0, 0, 435, 438, 5, 44, 0, 0, 436, 437, 5, 19,
+		0, 0, 437, 439, 3, 82, 41, 0, 438, 436, 1, 0, 0, 0, 438, 439, 1, 0, 0,
+		0, 439, 444, 1, 0, 0, 0, 440, 441, 5, 56, 0, 0, 441, 442, 5, 45, 0, 0,
+		442, 443, 5, 151, 0, 0, 443, 445, 3, 78, 39, 0, 444, 440, 1, 0, 0, 0, 444,
+		445, 1, 0, 0, 0, 445, 447, 1, 0, 0, 0, 446, 448, 3, 226, 113, 0, 447, 446,
+		1, 0, 0, 0, 447, 448, 1, 0, 0, 0, 448, 61, 1, 0, 0, 0, 449, 450, 5, 20,
+		0, 0, 450, 451, 5, 47, 0, 0, 451, 452, 3, 130, 65, 0, 452, 63, 1, 0, 0,
+		0, 453, 454, 5, 20, 0, 0, 454, 455, 5, 48, 0, 0, 455, 456, 5, 50, 0, 0,
+		456, 457, 3, 130, 65, 0, 457, 65, 1, 0, 0, 0, 458, 459, 5, 20, 0, 0, 459,
+		460, 5, 85, 0, 0, 460, 461, 5, 58, 0, 0, 461, 67, 1, 0, 0, 0, 462, 463,
+		5, 20, 0, 0, 463, 464, 5, 88, 0, 0, 464, 69, 1, 0, 0, 0, 465, 466, 5, 5,
+		0, 0, 466, 467, 5, 85, 0, 0, 467, 468, 5, 59, 0, 0, 468, 469, 3, 74, 37,
+		0, 469, 470, 5, 86, 0, 0, 470, 471, 3, 194, 97, 0, 471, 472, 5, 87, 0,
+		0, 472, 473, 3, 228, 114, 0, 473, 474, 5, 63, 0, 0, 474, 475, 3, 112, 56,
+		0, 475, 71, 1, 0, 0, 0, 476, 477, 5, 8, 0, 0, 477, 478, 5, 85, 0, 0, 478,
+		479, 5, 59, 0, 0, 479, 480, 3, 74, 37, 0, 480, 73, 1, 0, 0, 0, 481, 482,
+		3, 234, 117, 0, 482, 75, 1, 0, 0, 0, 483, 484, 5, 20, 0, 0, 484, 485, 5,
+		48, 0, 0, 485, 486, 5, 53, 0, 0, 486, 487, 3, 130, 65, 0, 487, 488, 5,
+		52, 0, 0, 488, 489, 5, 51, 0, 0, 489, 490, 5, 151, 0, 0, 490, 492, 3, 80,
+		40, 0, 491, 493, 3, 140, 70, 0, 492, 491, 1, 0, 0, 0, 492, 493, 1, 0, 0,
+		0, 493, 495, 1, 0, 0, 0, 494, 496, 3, 226, 113, 0, 495, 494, 1, 0, 0, 0,
+		495, 496, 1, 0, 0, 0, 496, 77, 1, 0, 0, 0, 497, 498, 3, 234, 117, 0, 498,
+		79, 1, 0, 0, 0, 499, 500, 3, 234, 117, 0, 500, 81, 1, 0, 0, 0, 501, 502,
+		3, 234, 117, 0, 502, 83, 1, 0, 0, 0, 503, 504, 3, 234, 117, 0, 504, 85,
+		1, 0, 0, 0, 505, 506, 3, 234, 117, 0, 506, 87, 1, 0, 0, 0, 507, 508, 3,
+		234, 117, 0, 508, 89, 1, 0, 0, 0, 509, 510, 3, 234, 117, 0, 510, 91, 1,
+		0, 0, 0, 511, 512, 7, 1, 0, 0, 512, 93, 1, 0, 0, 0, 513, 514, 3, 84, 42,
+		0, 514, 515, 5, 52, 0, 0, 515, 516, 5, 165, 0, 0, 516, 517, 3, 96, 48,
+		0, 517, 518, 5, 166, 0, 0, 518, 519, 5, 84, 0, 0, 519, 520, 5, 165, 0,
+		0, 520, 525, 3, 98, 49, 0, 521, 522, 5, 160, 0, 0, 522, 524, 3, 98, 49,
+		0, 523, 521, 1, 0, 0, 0, 524, 527, 1, 0, 0, 0, 525, 523, 1, 0, 0, 0, 525,
+		526, 1, 0, 0, 0, 526, 528, 1, 0, 0, 0, 527, 525, 1, 0, 0, 0, 528, 529,
+		5, 166, 0, 0, 529, 95, 1, 0, 0, 0, 530, 535, 3, 100, 50, 0, 531, 532, 5,
+		160, 0, 0, 532, 534, 3, 100, 50, 0, 533, 531, 1, 0, 0, 0, 534, 537, 1,
+		0, 0, 0, 535, 533, 1, 0, 0, 0, 535, 536, 1, 0, 0, 0, 536, 97, 1, 0, 0,
+		0, 537, 535, 1, 0, 0, 0, 538, 539, 5, 165, 0, 0, 539, 540, 3, 96, 48, 0,
+		540, 541, 5, 166, 0, 0, 541, 99, 1, 0, 0, 0, 542, 543, 3, 102, 51, 0, 543,
+		544, 5, 150, 0, 0, 544, 545, 3, 104, 52, 0, 545, 101, 1, 0, 0, 0, 546,
+		547, 7, 2, 0, 0, 547, 103, 1, 0, 0, 0, 548, 554, 5, 3, 0, 0, 549, 554,
+		5, 1, 0, 0, 550, 554, 5, 2, 0, 0, 551, 554, 3, 194, 97, 0, 552, 554, 3,
+		222, 111, 0, 553, 548, 1, 0, 0, 0, 553, 549, 1, 0, 0, 0, 553, 550, 1, 0,
+		0, 0, 553, 551, 1, 0, 0, 0, 553, 552, 1, 0, 0, 0, 554, 105, 1, 0, 0, 0,
+		555, 556, 5, 89, 0, 0, 556, 557, 3, 130, 65, 0, 557, 558, 3, 140, 70, 0,
+		558, 107, 1, 0, 0, 0, 559, 560, 5, 8, 0, 0, 560, 561, 5, 45, 0, 0, 561,
+		564, 3, 228, 114, 0, 562, 563, 5, 19, 0, 0, 563, 565, 3, 82, 41, 0, 564,
+		562, 1, 0, 0, 0, 564, 565, 1, 0, 0, 0, 565, 109, 1, 0, 0, 0, 566, 567,
+		5, 8, 0, 0, 567, 568, 5, 41, 0, 0, 568, 569, 3, 82, 41, 0, 569, 111, 1,
+		0, 0, 0, 570, 572, 5, 60, 0, 0, 571, 570, 1, 0, 0, 0, 571, 572, 1, 0, 0,
+		0, 572, 573, 1, 0, 0, 0, 573, 575, 3, 114, 57, 0, 574, 576, 3, 140, 70,
+		0, 575, 574, 1, 0, 0, 0, 575, 576, 1, 0, 0, 0, 576, 578, 1, 0, 0, 0, 577,
+		579, 3, 160, 80, 0, 578, 577, 1, 0, 0, 0, 578, 579, 1, 0, 0, 0, 579, 581,
+		1, 0, 0, 0, 580, 582, 3, 172, 86, 0, 581, 580, 1, 0, 0, 0, 581, 582, 1,
+		0, 0, 0, 582, 584, 1, 0, 0, 0, 583, 585, 3, 226, 113, 0, 584, 583, 1, 0,
+		0, 0, 584, 585, 1, 0, 0, 0, 585, 587, 1, 0, 0, 0, 586, 588, 5, 61, 0, 0,
+		587, 586, 1, 0, 0, 0, 587, 588, 1, 0, 0, 0, 588, 113, 1, 0, 0, 0, 589,
+		593, 3, 116, 58, 0, 590, 594, 3, 130, 65, 0, 591, 594, 3, 132, 66, 0, 592,
+		594, 3, 138, 69, 0, 593, 590, 1, 0, 0, 0, 593, 591, 1, 0, 0, 0, 593, 592,
+		1, 0, 0, 0, 594, 602, 1, 0, 0, 0, 595, 598, 3, 130, 65, 0, 596, 598, 3,
+		132, 66, 0, 597, 595, 1, 0, 0, 0, 597, 596, 1, 0, 0, 0, 598, 599, 1, 0,
+		0, 0, 599, 600, 3, 116, 58, 0, 600, 602, 1, 0, 0, 0, 601, 589, 1, 0, 0,
+		0, 601, 597, 1, 0, 0, 0, 602, 115, 1, 0, 0, 0, 603, 604, 5, 62, 0, 0, 604,
+		605, 3, 118, 59, 0, 605, 117, 1, 0, 0, 0, 606, 611, 3, 120, 60, 0, 607,
+		608, 5, 160, 0, 0, 608, 610, 3, 120, 60, 0, 609, 607, 1, 0, 0, 0, 610,
+		613, 1, 0, 0, 0, 611, 609, 1, 0, 0, 0, 611, 612, 1, 0, 0, 0, 612, 119,
+		1, 0, 0, 0, 613, 611, 1, 0, 0, 0, 614, 616, 3, 190, 95, 0, 615, 617, 3,
+		122, 61, 0, 616, 615, 1, 0, 0, 0, 616, 617, 1, 0, 0, 0, 617, 121, 1, 0,
+		0, 0, 618, 619, 5, 63, 0, 0, 619, 620, 3, 234, 117, 0, 620, 123, 1, 0,
+		0, 0, 621, 622, 5, 34, 0, 0, 622, 623, 5, 151, 0, 0, 623, 624, 3, 234,
+		117, 0, 624, 125, 1, 0, 0, 0, 625, 626, 5, 39, 0, 0, 626, 627, 5, 151,
+		0, 0, 627, 628, 3, 234, 117, 0, 628, 127, 1, 0, 0, 0, 629, 630, 5, 31,
+		0, 0, 630, 631, 5, 151, 0, 0, 631, 632, 3, 234, 117, 0, 632, 129, 1, 0,
+		0, 0, 633, 634, 5, 55, 0, 0, 634, 637, 3, 228, 114, 0, 635, 636, 5, 19,
+		0, 0, 636, 638, 3, 82, 41, 0, 637, 635, 1, 0, 0, 0, 637, 638, 1, 0, 0,
+		0, 638, 131, 1, 0, 0, 0, 639, 640, 5, 55, 0, 0, 640, 643, 3, 134, 67, 0,
+		641, 642, 5, 160, 0, 0, 642, 644, 3, 134, 67, 0, 643, 641, 1, 0, 0, 0,
+		644, 645, 1, 0, 0, 0, 645, 643, 1, 0, 0, 0, 645, 646, 1, 0, 0, 0, 646,
+		649, 1, 0, 0, 0, 647, 648, 5, 19, 0, 0, 648, 650, 3, 82, 41, 0, 649, 647,
+		1, 0, 0, 0, 649, 650, 1, 0, 0, 0, 650, 133, 1, 0, 0, 0, 651, 652, 3, 228,
+		114, 0, 652, 653, 5, 63, 0, 0, 653, 654, 3, 136, 68, 0, 654, 135, 1, 0,
+		0, 0, 655, 656, 3, 234, 117, 0, 656, 137, 1, 0, 0, 0, 657, 658, 5, 55,
+		0, 0, 658, 659, 5, 165, 0, 0, 659, 660, 3, 112, 56, 0, 660, 661, 5, 166,
+		0, 0, 661, 139, 1, 0, 0, 0, 662, 663, 5, 56, 0, 0, 663, 664, 3, 142, 71,
+		0, 664, 141, 1, 0, 0, 0, 665, 676, 3, 144, 72, 0, 666, 667, 3, 144, 72,
+		0, 667, 668, 5, 64, 0, 0, 668, 669, 3, 152, 76, 0, 669, 676, 1, 0, 0, 0,
+		670, 673, 3, 152, 76, 0, 671, 672, 5, 64, 0, 0, 672, 674, 3, 144, 72, 0,
+		673, 671, 1, 0, 0, 0, 673, 674, 1, 0, 0, 0, 674, 676, 1, 0, 0, 0, 675,
+		665, 1, 0, 0, 0, 675, 666, 1, 0, 0, 0, 675, 670, 1, 0, 0, 0, 676, 143,
+		1, 0, 0, 0, 677, 678, 6, 72, -1, 0, 678, 679, 5, 165, 0, 0, 679, 680, 3,
+		144, 72, 0, 680, 681, 5, 166, 0, 0, 681, 706, 1, 0, 0, 0, 682, 691, 3,
+		230, 115, 0, 683, 692, 5, 151, 0, 0, 684, 692, 5, 72, 0, 0, 685, 686, 5,
+		73, 0, 0, 686, 692, 5, 72, 0, 0, 687, 692, 5, 158, 0, 0, 688, 692, 5, 159,
+		0, 0, 689, 692, 5, 152, 0, 0, 690, 692, 5, 153, 0, 0, 691, 683, 1, 0, 0,
+		0, 691, 684, 1, 0, 0, 0, 691, 685, 1, 0, 0, 0, 691, 687, 1, 0, 0, 0, 691,
+		688, 1, 0, 0, 0, 691, 689, 1, 0, 0, 0, 691, 690, 1, 0, 0, 0, 692, 693,
+		1, 0, 0, 0, 693, 694, 3, 232, 116, 0, 694, 706, 1, 0, 0, 0, 695, 699, 3,
+		230, 115, 0, 696, 700, 5, 83, 0, 0, 697, 698, 5, 73, 0, 0, 698, 700, 5,
+		83, 0, 0, 699, 696, 1, 0, 0, 0, 699, 697, 1, 0, 0, 0, 700, 701, 1, 0, 0,
+		0, 701, 702, 5, 165, 0, 0, 702, 703, 3, 146, 73, 0, 703, 704, 5, 166, 0,
+		0, 704, 706, 1, 0, 0, 0, 705, 677, 1, 0, 0, 0, 705, 682, 1, 0, 0, 0, 705,
+		695, 1, 0, 0, 0, 706, 712, 1, 0, 0, 0, 707, 708, 10, 1, 0, 0, 708, 709,
+		7, 3, 0, 0, 709, 711, 3, 144, 72, 2, 710, 707, 1, 0, 0, 0, 711, 714, 1,
+		0, 0, 0, 712, 710, 1, 0, 0, 0, 712, 713, 1, 0, 0, 0, 713, 145, 1, 0, 0,
+		0, 714, 712, 1, 0, 0, 0, 715, 720, 3, 232, 116, 0, 716, 717, 5, 160, 0,
+		0, 717, 719, 3, 232, 116, 0, 718, 716, 1, 0, 0, 0, 719, 722, 1, 0, 0, 0,
+		720, 718, 1, 0, 0, 0, 720, 721, 1, 0, 0, 0, 721, 147, 1, 0, 0, 0, 722,
+		720, 1, 0, 0, 0, 723, 724, 5, 45, 0, 0, 724, 725, 5, 83, 0, 0, 725, 726,
+		5, 165, 0, 0, 726, 727, 3, 150, 75, 0, 727, 728, 5, 166, 0, 0, 728, 149,
+		1, 0, 0, 0, 729, 734, 3, 234, 117, 0, 730, 731, 5, 160, 0, 0, 731, 733,
+		3, 234, 117, 0, 732, 730, 1, 0, 0, 0, 733, 736, 1, 0, 0, 0, 734, 732, 1,
+		0, 0, 0, 734, 735, 1, 0, 0, 0, 735, 151, 1, 0, 0, 0, 736, 734, 1, 0, 0,
+		0, 737, 740, 3, 154, 77, 0, 738, 739, 5, 64, 0, 0, 739, 741, 3, 154, 77,
+		0, 740, 738, 1, 0, 0, 0, 740, 741, 1, 0, 0, 0, 741, 153, 1, 0, 0, 0, 742,
+		743, 5, 81, 0, 0, 743, 746, 3, 188, 94, 0, 744, 747, 3, 156, 78, 0, 745,
+		747, 3, 234, 117, 0, 746, 744, 1, 0, 0, 0, 746, 745, 1, 0, 0, 0, 747, 155,
+		1, 0, 0, 0, 748, 750, 3, 158, 79, 0, 749, 751, 3, 194, 97, 0, 750, 749,
+		1, 0, 0, 0, 750, 751, 1, 0, 0, 0, 751, 157, 1, 0, 0, 0, 752, 753, 5, 82,
+		0, 0, 753, 755, 5, 165, 0, 0, 754, 756, 3, 202, 101, 0, 755, 754, 1, 0,
+		0, 0, 755, 756, 1, 0, 0, 0, 756, 757, 1, 0, 0, 0, 757, 758, 5, 166, 0,
+		0, 758, 159, 1, 0, 0, 0, 759, 760, 5, 76, 0, 0, 760, 761, 5, 78, 0, 0,
+		761, 767, 3, 162, 81, 0, 762, 763, 5, 66, 0, 0, 763, 764, 5, 165, 0, 0,
+		764, 765, 3, 170, 85, 0, 765, 766, 5, 166, 0, 0, 766, 768, 1, 0, 0, 0,
+		767, 762, 1, 0, 0, 0, 767, 768, 1, 0, 0, 0, 768, 770, 1, 0, 0, 0, 769,
+		771, 3, 178, 89, 0, 770, 769, 1, 0, 0, 0, 770, 771, 1, 0, 0, 0, 771, 161,
+		1, 0, 0, 0, 772, 777, 3, 164, 82, 0, 773, 774, 5, 160, 0, 0, 774, 776,
+		3, 164, 82, 0, 775, 773, 1, 0, 0, 0, 776, 779, 1, 0, 0, 0, 777, 775, 1,
+		0, 0, 0, 777, 778, 1, 0, 0, 0, 778, 163, 1, 0, 0, 0, 779, 777, 1, 0, 0,
+		0, 780, 791, 3, 234, 117, 0, 781, 791, 3, 166, 83, 0, 782, 783, 5, 81,
+		0, 0, 783, 784, 5, 165, 0, 0, 784, 785, 3, 194, 97, 0, 785, 786, 5, 166,
+		0, 0, 786, 791, 1, 0, 0, 0, 787, 788, 5, 81, 0, 0, 788, 789, 5, 165, 0,
+		0, 789, 791, 5, 166, 0, 0, 790, 780, 1, 0, 0, 0, 790, 781, 1, 0, 0, 0,
+		790, 782, 1, 0, 0, 0, 790, 787, 1, 0, 0, 0, 791, 165, 1, 0, 0, 0, 792,
+		793, 3, 168, 84, 0, 793, 794, 5, 165, 0, 0, 794, 799, 3, 234, 117, 0, 795,
+		796, 5, 160, 0, 0, 796, 798, 3, 234, 117, 0, 797, 795, 1, 0, 0, 0, 798,
+		801, 1, 0, 0, 0, 799, 797, 1, 0, 0, 0, 799, 800, 1, 0, 0, 0, 800, 802,
+		1, 0, 0, 0, 801, 799, 1, 0, 0, 0, 802, 803, 5, 166, 0, 0, 803, 804, 5,
+		63, 0, 0, 804, 805, 3, 234, 117, 0, 805, 167, 1, 0, 0, 0, 806, 807, 7,
+		4, 0, 0, 807, 169, 1, 0, 0, 0, 808, 816, 5, 67, 0, 0, 809, 816, 5, 68,
+		0, 0, 810, 816, 5, 90, 0, 0, 811, 813, 5, 168, 0, 0, 812, 811, 1, 0, 0,
+		0, 812, 813, 1, 0, 0, 0, 813, 814, 1, 0, 0, 0, 814, 816, 7, 5, 0, 0, 815,
+		808, 1, 0, 0, 0, 815, 809, 1, 0, 0, 0, 815, 810, 1, 0, 0, 0, 815, 812,
+		1, 0, 0, 0, 816, 171, 1, 0, 0, 0, 817, 818, 5, 69, 0, 0, 818, 819, 5, 78,
+		0, 0, 819, 820, 3, 176, 88, 0, 820, 173, 1, 0, 0, 0, 821, 825, 3, 190,
+		95, 0, 822, 824, 7, 6, 0, 0, 823, 822, 1, 0, 0, 0, 824, 827, 1, 0, 0, 0,
+		825, 823, 1, 0, 0, 0, 825, 826, 1, 0, 0, 0, 826, 175, 1, 0, 0, 0, 827,
+		825, 1, 0, 0, 0, 828, 833, 3, 174, 87, 0, 829, 830, 5, 160, 0, 0, 830,
+		832, 3, 174, 87, 0, 831, 829, 1, 0, 0, 0, 832, 835, 1, 0, 0, 0, 833, 831,
+		1, 0, 0, 0, 833, 834, 1, 0, 0, 0, 834, 177, 1, 0, 0, 0, 835, 833, 1, 0,
+		0, 0, 836, 837, 5, 77, 0, 0, 837, 838, 3, 180, 90, 0, 838, 179, 1, 0, 0,
+		0, 839, 840, 6, 90, -1, 0, 840, 841, 5, 165, 0, 0, 841, 842, 3, 180, 90,
+		0, 842, 843, 5, 166, 0, 0, 843, 846, 1, 0, 0, 0, 844, 846, 3, 184, 92,
+		0, 845, 839, 1, 0, 0, 0, 845, 844, 1, 0, 0, 0, 846, 853, 1, 0, 0, 0, 847,
+		848, 10, 2, 0, 0, 848, 849, 3, 182, 91, 0, 849, 850, 3, 180, 90, 3, 850,
+		852, 1, 0, 0, 0, 851, 847, 1, 0, 0, 0, 852, 855, 1, 0, 0, 0, 853, 851,
+		1, 0, 0, 0, 853, 854, 1, 0, 0, 0, 854, 181, 1, 0, 0, 0, 855, 853, 1, 0,
+		0, 0, 856, 857, 7, 3, 0, 0, 857, 183, 1, 0, 0, 0, 858, 859, 3, 186, 93,
+		0, 859, 185, 1, 0, 0, 0, 860, 861, 3, 190, 95, 0, 861, 862, 3, 188, 94,
+		0, 862, 863, 3, 190, 95, 0, 863, 187, 1, 0, 0, 0, 864, 873, 5, 151, 0,
+		0, 865, 873, 5, 152, 0, 0, 866, 873, 5, 153, 0, 0, 867, 873, 5, 156, 0,
+		0, 868, 873, 5, 157, 0, 0, 869, 873, 5, 154, 0, 0, 870, 873, 5, 155, 0,
+		0, 871, 873, 7, 7, 0, 0, 872, 864, 1, 0, 0, 0, 872, 865, 1, 0, 0, 0, 872,
+		866, 1, 0, 0, 0, 872, 867, 1, 0, 0, 0, 872, 868, 1, 0, 0, 0, 872, 869,
+		1, 0, 0, 0, 872, 870, 1, 0, 0, 0, 872, 871, 1, 0, 0, 0, 873, 189, 1, 0,
+		0, 0, 874, 875, 6, 95, -1, 0, 875, 876, 5, 165, 0, 0, 876, 877, 3, 190,
+		95, 0, 877, 878, 5, 166, 0, 0, 878, 884, 1, 0, 0, 0, 879, 884, 3, 198,
+		99, 0, 880, 884, 3, 206, 103, 0, 881, 884, 3, 194, 97, 0, 882, 884, 3,
+		192, 96, 0, 883, 874, 1, 0, 0, 0, 883, 879, 1, 0, 0, 0, 883, 880, 1, 0,
+		0, 0, 883, 881, 1, 0, 0, 0, 883, 882, 1, 0, 0, 0, 884, 899, 1, 0, 0, 0,
+		885, 886, 10, 9, 0, 0, 886, 887, 5, 170, 0, 0, 887, 898, 3, 190, 95, 10,
+		888, 889, 10, 8, 0, 0, 889, 890, 5, 169, 0, 0, 890, 898, 3, 190, 95, 9,
+		891, 892, 10, 7, 0, 0, 892, 893, 5, 167, 0, 0, 893, 898, 3, 190, 95, 8,
+		894, 895, 10, 6, 0, 0, 895, 896, 5, 168, 0, 0, 896, 898, 3, 190, 95, 7,
+		897, 885, 1, 0, 0, 0, 897, 888, 1, 0, 0, 0, 897, 891, 1, 0, 0, 0, 897,
+		894, 1, 0, 0, 0, 898, 901, 1, 0, 0, 0, 899, 897, 1, 0, 0, 0, 899, 900,
+		1, 0, 0, 0, 900, 191, 1, 0, 0, 0, 901, 899, 1, 0, 0, 0, 902, 903, 5, 170,
+		0, 0, 903, 193, 1, 0, 0, 0, 904, 905, 3, 222, 111, 0, 905, 906, 3, 196,
+		98, 0, 906, 195, 1, 0, 0, 0, 907, 908, 7, 8, 0, 0, 908, 197, 1, 0, 0, 0,
+		909, 910, 3, 200, 100, 0, 910, 912, 5, 165, 0, 0, 911, 913, 3, 202, 101,
+		0, 912, 911, 1, 0, 0, 0, 912, 913, 1, 0, 0, 0, 913, 914, 1, 0, 0, 0, 914,
+		917, 5, 166, 0, 0, 915, 916, 5, 91, 0, 0, 916, 918, 3, 194, 97, 0, 917,
+		915, 1, 0, 0, 0, 917, 918, 1, 0, 0, 0, 918, 199, 1, 0, 0, 0, 919, 920,
+		7, 9, 0, 0, 920, 201, 1, 0, 0, 0, 921, 926, 3, 204, 102, 0, 922, 923, 5,
+		160, 0, 0, 923, 925, 3, 204, 102, 0, 924, 922, 1, 0, 0, 0, 925, 928, 1,
+		0, 0, 0, 926, 924, 1, 0, 0, 0, 926, 927, 1, 0, 0, 0, 927, 203, 1, 0, 0,
+		0, 928, 926, 1, 0, 0, 0, 929, 933, 3, 190, 95, 0, 930, 933, 3, 144, 72,
+		0, 931, 933, 3, 180, 90, 0, 932, 929, 1, 0, 0, 0, 932, 930, 1, 0, 0, 0,
+		932, 931, 1, 0, 0, 0, 933, 205, 1, 0, 0, 0, 934, 936, 3, 234, 117, 0, 935,
+		937, 3, 208, 104, 0, 936, 935, 1, 0, 0, 0, 936, 937, 1, 0, 0, 0, 937, 941,
+		1, 0, 0, 0, 938, 941, 3, 224, 112, 0, 939, 941, 3, 222, 111, 0, 940, 934,
+		1, 0, 0, 0, 940, 938, 1, 0, 0, 0, 940, 939, 1, 0, 0, 0, 941, 207, 1, 0,
+		0, 0, 942, 943, 5, 163, 0, 0, 943, 944, 3, 144, 72, 0, 944, 945, 5, 164,
+		0, 0, 945, 209, 1, 0, 0, 0, 946, 947, 3, 220, 110, 0, 947, 211, 1, 0, 0,
+		0, 948, 949, 3, 234, 117, 0, 949, 213, 1, 0, 0, 0, 950, 951, 5, 161, 0,
+		0, 951, 956, 3, 216, 108, 0, 952, 953, 5, 160, 0, 0, 953, 955, 3, 216,
+		108, 0, 954, 952, 1, 0, 0, 0, 955, 958, 1, 0, 0, 0, 956, 954, 1, 0, 0,
+		0, 956, 957, 1, 0, 0, 0, 957, 959, 1, 0, 0, 0, 958, 956, 1, 0, 0, 0, 959,
+		960, 5, 162, 0, 0, 960, 964, 1, 0, 0, 0, 961, 962, 5, 161, 0, 0, 962, 964,
+		5, 162, 0, 0, 963, 950, 1, 0, 0, 0, 963, 961, 1, 0, 0, 0, 964, 215, 1,
+		0, 0, 0, 965, 966, 5, 3, 0, 0, 966, 967, 5, 150, 0, 0, 967, 968, 3, 220,
+		110, 0, 968, 217, 1, 0, 0, 0, 969, 970, 5, 163, 0, 0, 970, 975, 3, 220,
+		110, 0, 971, 972, 5, 160, 0, 0, 972, 974, 3, 220, 110, 0, 973, 971, 1,
+		0, 0, 0, 974, 977, 1, 0, 0, 0, 975, 973, 1, 0, 0, 0, 975, 976, 1, 0, 0,
+		0, 976, 978, 1, 0, 0, 0, 977, 975, 1, 0, 0, 0, 978, 979, 5, 164, 0, 0,
+		979, 983, 1, 0, 0, 0, 980, 981, 5, 163, 0, 0, 981, 983, 5, 164, 0, 0, 982,
+		969, 1, 0, 0, 0, 982, 980, 1, 0, 0, 0, 983, 219, 1, 0, 0, 0, 984, 993,
+		5, 3, 0, 0, 985, 993, 3, 222, 111, 0, 986, 993, 3, 224, 112, 0, 987, 993,
+		3, 214, 107, 0, 988, 993, 3, 218, 109, 0, 989, 993, 5, 1, 0, 0, 990, 993,
+		5, 2, 0, 0, 991, 993, 5, 67, 0, 0, 992, 984, 1, 0, 0, 0, 992, 985, 1, 0,
+		0, 0, 992, 986, 1, 0, 0, 0, 992, 987, 1, 0, 0, 0, 992, 988, 1, 0, 0, 0,
+		992, 989, 1, 0, 0, 0, 992, 990, 1, 0, 0, 0, 992, 991, 1, 0, 0, 0, 993,
+		221, 1, 0, 0, 0, 994, 996, 7, 10, 0, 0, 995, 994, 1, 0, 0, 0, 995, 996,
+		1, 0, 0, 0, 996, 997, 1, 0, 0, 0, 997, 998, 5, 174, 0, 0, 998, 223, 1,
+		0, 0, 0, 999, 1001, 7, 10, 0, 0, 1000, 999, 1, 0, 0, 0, 1000, 1001, 1,
+		0, 0, 0, 1001, 1002, 1, 0, 0, 0, 1002, 1003, 5, 175, 0, 0, 1003, 225, 1,
+		0, 0, 0, 1004, 1005, 5, 57, 0, 0, 1005, 1006, 5, 174, 0, 0, 1006, 227,
+		1, 0, 0, 0, 1007, 1008, 3, 234, 117, 0, 1008, 229, 1, 0, 0, 0, 1009, 1010,
+		3, 234, 117, 0, 1010, 231, 1, 0, 0, 0, 1011, 1012, 3, 234, 117, 0, 1012,
+		233, 1, 0, 0, 0, 1013, 1016, 5, 173, 0, 0, 1014, 1016, 3, 236, 118, 0,
+		1015, 1013, 1, 0, 0, 0, 1015, 1014, 1, 0, 0, 0, 1016, 1024, 1, 0, 0, 0,
+		1017, 1020, 5, 149, 0, 0, 1018, 1021, 5, 173, 0, 0, 1019, 1021, 3, 236,
+		118, 0, 1020, 1018, 1, 0, 0, 0, 1020, 1019, 1, 0, 0, 0, 1021, 1023, 1,
+		0, 0, 0, 1022, 1017, 1, 0, 0, 0, 1023, 1026, 1, 0, 0, 0, 1024, 1022, 1,
+		0, 0, 0, 1024, 1025, 1, 0, 0, 0, 1025, 235, 1, 0, 0, 0, 1026, 1024, 1,
+		0, 0, 0, 1027, 1028, 7, 11, 0, 0, 1028, 237, 1, 0, 0, 0, 72, 256, 290,
+		332, 414, 429, 432, 438, 444, 447, 492, 495, 525, 535, 553, 564, 571, 575,
+		578, 581, 584, 587, 593, 597, 601, 611, 616, 637, 645, 649, 673, 675, 691,
+		699, 705, 712, 720, 734, 740, 746, 750, 755, 767, 770, 777, 790, 799, 812,
+		815, 825, 833, 845, 853, 872, 883, 897, 899, 912, 917, 926, 932, 936, 940,
+		956, 963, 975, 982, 992, 995, 1000, 1015, 1020, 1024,
 	}
 	deserializer := antlr.NewATNDeserializer(nil)
 	staticData.atn = deserializer.Deserialize(staticData.serializedATN)
@@ -531,250 +616,305 @@ func NewSQLParser(input antlr.TokenStream) *SQLParser {
 
 // SQLParser tokens.
 const (
-	SQLParserEOF              = antlr.TokenEOF
-	SQLParserT__0             = 1
-	SQLParserT__1             = 2
-	SQLParserT__2             = 3
-	SQLParserSTRING           = 4
-	SQLParserWS               = 5
-	SQLParserT_CREATE         = 6
-	SQLParserT_UPDATE         = 7
-	SQLParserT_SET            = 8
-	SQLParserT_DROP           = 9
-	SQLParserT_INTERVAL       = 10
-	SQLParserT_INTERVAL_NAME  = 11
-	SQLParserT_SHARD          = 12
-	SQLParserT_REPLICATION    = 13
-	SQLParserT_MEMORY         = 14
-	SQLParserT_TTL            = 15
-	SQLParserT_META_TTL       = 16
-	SQLParserT_PAST_TTL       = 17
-	SQLParserT_FUTURE_TTL     = 18
-	SQLParserT_KILL           = 19
-	SQLParserT_ON             = 20
-	SQLParserT_SHOW           = 21
-	SQLParserT_RECOVER        = 22
-	SQLParserT_USE            = 23
-	SQLParserT_STATE_REPO     = 24
-	SQLParserT_STATE_MACHINE  = 25
-	SQLParserT_MASTER         = 26
-	SQLParserT_METADATA       = 27
-	SQLParserT_TYPES          = 28
-	SQLParserT_TYPE           = 29
-	SQLParserT_STORAGES       = 30
-	SQLParserT_STORAGE        = 31
-	SQLParserT_BROKER         = 32
-	SQLParserT_ROOT           = 33
-	SQLParserT_BROKERS        = 34
-	SQLParserT_ALIVE          = 35
-	SQLParserT_SCHEMAS        = 36
-	SQLParserT_DATASBAE       = 37
-	SQLParserT_DATASBAES      = 38
-	SQLParserT_NAMESPACE      = 39
-	SQLParserT_NAMESPACES     = 40
-	SQLParserT_NODE           = 41
-	SQLParserT_METRICS        = 42
-	SQLParserT_METRIC         = 43
-	SQLParserT_FIELD          = 44
-	SQLParserT_FIELDS         = 45
-	SQLParserT_TAG            = 46
-	SQLParserT_INFO           = 47
-	SQLParserT_KEYS           = 48
-	SQLParserT_KEY            = 49
-	SQLParserT_WITH           = 50
-	SQLParserT_VALUES         = 51
-	SQLParserT_VALUE          = 52
-	SQLParserT_FROM           = 53
-	SQLParserT_WHERE          = 54
-	SQLParserT_LIMIT          = 55
-	SQLParserT_QUERIES        = 56
-	SQLParserT_QUERY          = 57
-	SQLParserT_EXPLAIN        = 58
-	SQLParserT_WITH_VALUE     = 59
-	SQLParserT_SELECT         = 60
-	SQLParserT_AS             = 61
-	SQLParserT_AND            = 62
-	SQLParserT_OR             = 63
-	SQLParserT_FILL           = 64
-	SQLParserT_NULL           = 65
-	SQLParserT_PREVIOUS       = 66
-	SQLParserT_ORDER          = 67
-	SQLParserT_ASC            = 68
-	SQLParserT_DESC           = 69
-	SQLParserT_LIKE           = 70
-	SQLParserT_NOT            = 71
-	SQLParserT_BETWEEN        = 72
-	SQLParserT_IS             = 73
-	SQLParserT_GROUP          = 74
-	SQLParserT_HAVING         = 75
-	SQLParserT_BY             = 76
-	SQLParserT_FOR            = 77
-	SQLParserT_STATS          = 78
-	SQLParserT_TIME           = 79
-	SQLParserT_NOW            = 80
-	SQLParserT_IN             = 81
-	SQLParserT_ROLLUP         = 82
-	SQLParserT_LOG            = 83
-	SQLParserT_PROFILE        = 84
-	SQLParserT_REQUESTS       = 85
-	SQLParserT_REQUEST        = 86
-	SQLParserT_ID             = 87
-	SQLParserT_SUM            = 88
-	SQLParserT_MIN            = 89
-	SQLParserT_MAX            = 90
-	SQLParserT_COUNT          = 91
-	SQLParserT_LAST           = 92
-	SQLParserT_FIRST          = 93
-	SQLParserT_AVG            = 94
-	SQLParserT_STDDEV         = 95
-	SQLParserT_QUANTILE       = 96
-	SQLParserT_RATE           = 97
-	SQLParserT_NUM_OF_SHARD   = 98
-	SQLParserT_REPLICA_FACTOR = 99
-	SQLParserT_AUTO_CREATE_NS = 100
-	SQLParserT_BEHEAD         = 101
-	SQLParserT_AHEAD          = 102
-	SQLParserT_RETENTION      = 103
-	SQLParserT_SECOND         = 104
-	SQLParserT_MINUTE         = 105
-	SQLParserT_HOUR           = 106
-	SQLParserT_DAY            = 107
-	SQLParserT_WEEK           = 108
-	SQLParserT_MONTH          = 109
-	SQLParserT_YEAR           = 110
-	SQLParserT_DOT            = 111
-	SQLParserT_COLON          = 112
-	SQLParserT_EQUAL          = 113
-	SQLParserT_NOTEQUAL       = 114
-	SQLParserT_NOTEQUAL2      = 115
-	SQLParserT_GREATER        = 116
-	SQLParserT_GREATEREQUAL   = 117
-	SQLParserT_LESS           = 118
-	SQLParserT_LESSEQUAL      = 119
-	SQLParserT_REGEXP         = 120
-	SQLParserT_NEQREGEXP      = 121
-	SQLParserT_COMMA          = 122
-	SQLParserT_OPEN_B         = 123
-	SQLParserT_CLOSE_B        = 124
-	SQLParserT_OPEN_SB        = 125
-	SQLParserT_CLOSE_SB       = 126
-	SQLParserT_OPEN_P         = 127
-	SQLParserT_CLOSE_P        = 128
-	SQLParserT_ADD            = 129
-	SQLParserT_SUB            = 130
-	SQLParserT_DIV            = 131
-	SQLParserT_MUL            = 132
-	SQLParserT_MOD            = 133
-	SQLParserT_UNDERLINE      = 134
-	SQLParserL_ID             = 135
-	SQLParserL_INT            = 136
-	SQLParserL_DEC            = 137
+	SQLParserEOF                       = antlr.TokenEOF
+	SQLParserT__0                      = 1
+	SQLParserT__1                      = 2
+	SQLParserSTRING                    = 3
+	SQLParserWS                        = 4
+	SQLParserT_CREATE                  = 5
+	SQLParserT_UPDATE                  = 6
+	SQLParserT_SET                     = 7
+	SQLParserT_DROP                    = 8
+	SQLParserT_INTERVAL                = 9
+	SQLParserT_INTERVAL_NAME           = 10
+	SQLParserT_SHARD                   = 11
+	SQLParserT_REPLICATION             = 12
+	SQLParserT_MEMORY                  = 13
+	SQLParserT_TTL                     = 14
+	SQLParserT_META_TTL                = 15
+	SQLParserT_PAST_TTL                = 16
+	SQLParserT_FUTURE_TTL              = 17
+	SQLParserT_KILL                    = 18
+	SQLParserT_ON                      = 19
+	SQLParserT_SHOW                    = 20
+	SQLParserT_RECOVER                 = 21
+	SQLParserT_BACKUP                  = 22
+	SQLParserT_RESTORE                 = 23
+	SQLParserT_TO                      = 24
+	SQLParserT_USE                     = 25
+	SQLParserT_STATE_REPO              = 26
+	SQLParserT_STATE_MACHINE           = 27
+	SQLParserT_MASTER                  = 28
+	SQLParserT_METADATA                = 29
+	SQLParserT_TYPES                   = 30
+	SQLParserT_TYPE                    = 31
+	SQLParserT_STORAGES                = 32
+	SQLParserT_STORAGE                 = 33
+	SQLParserT_BROKER                  = 34
+	SQLParserT_ROOT                    = 35
+	SQLParserT_BROKERS                 = 36
+	SQLParserT_ALIVE                   = 37
+	SQLParserT_SCHEMAS                 = 38
+	SQLParserT_DATASBAE                = 39
+	SQLParserT_DATASBAES               = 40
+	SQLParserT_NAMESPACE               = 41
+	SQLParserT_NAMESPACES              = 42
+	SQLParserT_NODE                    = 43
+	SQLParserT_METRICS                 = 44
+	SQLParserT_METRIC                  = 45
+	SQLParserT_FIELD                   = 46
+	SQLParserT_FIELDS                  = 47
+	SQLParserT_TAG                     = 48
+	SQLParserT_INFO                    = 49
+	SQLParserT_KEYS                    = 50
+	SQLParserT_KEY                     = 51
+	SQLParserT_WITH                    = 52
+	SQLParserT_VALUES                  = 53
+	SQLParserT_VALUE                   = 54
+	SQLParserT_FROM                    = 55
+	SQLParserT_WHERE                   = 56
+	SQLParserT_LIMIT                   = 57
+	SQLParserT_QUERIES                 = 58
+	SQLParserT_QUERY                   = 59
+	SQLParserT_EXPLAIN                 = 60
+	SQLParserT_WITH_VALUE              = 61
+	SQLParserT_SELECT                  = 62
+	SQLParserT_AS                      = 63
+	SQLParserT_AND                     = 64
+	SQLParserT_OR                      = 65
+	SQLParserT_FILL                    = 66
+	SQLParserT_NULL                    = 67
+	SQLParserT_PREVIOUS                = 68
+	SQLParserT_ORDER                   = 69
+	SQLParserT_ASC                     = 70
+	SQLParserT_DESC                    = 71
+	SQLParserT_LIKE                    = 72
+	SQLParserT_NOT                     = 73
+	SQLParserT_BETWEEN                 = 74
+	SQLParserT_IS                      = 75
+	SQLParserT_GROUP                   = 76
+	SQLParserT_HAVING                  = 77
+	SQLParserT_BY                      = 78
+	SQLParserT_FOR                     = 79
+	SQLParserT_STATS                   = 80
+	SQLParserT_TIME                    = 81
+	SQLParserT_NOW                     = 82
+	SQLParserT_IN                      = 83
+	SQLParserT_ROLLUP                  = 84
+	SQLParserT_CONTINUOUS              = 85
+	SQLParserT_EVERY                   = 86
+	SQLParserT_INTO                    = 87
+	SQLParserT_ALERTS                  = 88
+	SQLParserT_DELETE                  = 89
+	SQLParserT_LINEAR                  = 90
+	SQLParserT_OFFSET                  = 91
+	SQLParserT_LOG                     = 92
+	SQLParserT_PROFILE                 = 93
+	SQLParserT_REQUESTS                = 94
+	SQLParserT_REQUEST                 = 95
+	SQLParserT_ID                      = 96
+	SQLParserT_SUM                     = 97
+	SQLParserT_MIN                     = 98
+	SQLParserT_MAX                     = 99
+	SQLParserT_COUNT                   = 100
+	SQLParserT_LAST                    = 101
+	SQLParserT_FIRST                   = 102
+	SQLParserT_AVG                     = 103
+	SQLParserT_STDDEV                  = 104
+	SQLParserT_QUANTILE                = 105
+	SQLParserT_RATE                    = 106
+	SQLParserT_HISTOGRAM_COUNT         = 107
+	SQLParserT_HISTOGRAM_SUM           = 108
+	SQLParserT_MOVING_AVERAGE          = 109
+	SQLParserT_DERIVATIVE              = 110
+	SQLParserT_NON_NEGATIVE_DIFFERENCE = 111
+	SQLParserT_CUMULATIVE_SUM          = 112
+	SQLParserT_DELTA                   = 113
+	SQLParserT_INCREASE                = 114
+	SQLParserT_INTEGRAL                = 115
+	SQLParserT_TOP                     = 116
+	SQLParserT_BOTTOM                  = 117
+	SQLParserT_ABS                     = 118
+	SQLParserT_CEIL                    = 119
+	SQLParserT_FLOOR                   = 120
+	SQLParserT_ROUND                   = 121
+	SQLParserT_LOG2                    = 122
+	SQLParserT_LOG10                   = 123
+	SQLParserT_SQRT                    = 124
+	SQLParserT_POW                     = 125
+	SQLParserT_CLAMP_MIN               = 126
+	SQLParserT_CLAMP_MAX               = 127
+	SQLParserT_IF                      = 128
+	SQLParserT_COALESCE                = 129
+	SQLParserT_REGEXP_EXTRACT          = 130
+	SQLParserT_LABEL_REPLACE           = 131
+	SQLParserT_LABEL_JOIN              = 132
+	SQLParserT_PERCENTILE              = 133
+	SQLParserT_MEDIAN                  = 134
+	SQLParserT_DISTINCT_COUNT          = 135
+	SQLParserT_NUM_OF_SHARD            = 136
+	SQLParserT_REPLICA_FACTOR          = 137
+	SQLParserT_AUTO_CREATE_NS          = 138
+	SQLParserT_BEHEAD                  = 139
+	SQLParserT_AHEAD                   = 140
+	SQLParserT_RETENTION               = 141
+	SQLParserT_SECOND                  = 142
+	SQLParserT_MINUTE                  = 143
+	SQLParserT_HOUR                    = 144
+	SQLParserT_DAY                     = 145
+	SQLParserT_WEEK                    = 146
+	SQLParserT_MONTH                   = 147
+	SQLParserT_YEAR                    = 148
+	SQLParserT_DOT                     = 149
+	SQLParserT_COLON                   = 150
+	SQLParserT_EQUAL                   = 151
+	SQLParserT_NOTEQUAL                = 152
+	SQLParserT_NOTEQUAL2               = 153
+	SQLParserT_GREATER                 = 154
+	SQLParserT_GREATEREQUAL            = 155
+	SQLParserT_LESS                    = 156
+	SQLParserT_LESSEQUAL               = 157
+	SQLParserT_REGEXP                  = 158
+	SQLParserT_NEQREGEXP               = 159
+	SQLParserT_COMMA                   = 160
+	SQLParserT_OPEN_B                  = 161
+	SQLParserT_CLOSE_B                 = 162
+	SQLParserT_OPEN_SB                 = 163
+	SQLParserT_CLOSE_SB                = 164
+	SQLParserT_OPEN_P                  = 165
+	SQLParserT_CLOSE_P                 = 166
+	SQLParserT_ADD                     = 167
+	SQLParserT_SUB                     = 168
+	SQLParserT_DIV                     = 169
+	SQLParserT_MUL                     = 170
+	SQLParserT_MOD                     = 171
+	SQLParserT_UNDERLINE               = 172
+	SQLParserL_ID                      = 173
+	SQLParserL_INT                     = 174
+	SQLParserL_DEC                     = 175
 )
 
 // SQLParser rules.
 const (
-	SQLParserRULE_statement              = 0
-	SQLParserRULE_useStmt                = 1
-	SQLParserRULE_setLimitStmt           = 2
-	SQLParserRULE_showStmt               = 3
-	SQLParserRULE_showMasterStmt         = 4
-	SQLParserRULE_showRequestsStmt       = 5
-	SQLParserRULE_showRequestStmt        = 6
-	SQLParserRULE_showBrokersStmt        = 7
-	SQLParserRULE_showLimitStmt          = 8
-	SQLParserRULE_showMetadataTypesStmt  = 9
-	SQLParserRULE_showRootMetaStmt       = 10
-	SQLParserRULE_showBrokerMetaStmt     = 11
-	SQLParserRULE_showMasterMetaStmt     = 12
-	SQLParserRULE_showStorageMetaStmt    = 13
-	SQLParserRULE_showAliveStmt          = 14
-	SQLParserRULE_showReplicationStmt    = 15
-	SQLParserRULE_showMemoryDatabaseStmt = 16
-	SQLParserRULE_showRootMetricStmt     = 17
-	SQLParserRULE_showBrokerMetricStmt   = 18
-	SQLParserRULE_showStorageMetricStmt  = 19
-	SQLParserRULE_createStorageStmt      = 20
-	SQLParserRULE_createBrokerStmt       = 21
-	SQLParserRULE_recoverStorageStmt     = 22
-	SQLParserRULE_showSchemasStmt        = 23
-	SQLParserRULE_createDatabaseStmt     = 24
-	SQLParserRULE_dropDatabaseStmt       = 25
-	SQLParserRULE_showDatabaseStmt       = 26
-	SQLParserRULE_showNameSpacesStmt     = 27
-	SQLParserRULE_showMetricsStmt        = 28
-	SQLParserRULE_showFieldsStmt         = 29
-	SQLParserRULE_showTagKeysStmt        = 30
-	SQLParserRULE_showTagValuesStmt      = 31
-	SQLParserRULE_prefix                 = 32
-	SQLParserRULE_withTagKey             = 33
-	SQLParserRULE_namespace              = 34
-	SQLParserRULE_databaseName           = 35
-	SQLParserRULE_storageName            = 36
-	SQLParserRULE_requestID              = 37
-	SQLParserRULE_source                 = 38
-	SQLParserRULE_optionClause           = 39
-	SQLParserRULE_optionPairs            = 40
-	SQLParserRULE_closedOptionPairs      = 41
-	SQLParserRULE_optionPair             = 42
-	SQLParserRULE_optionKey              = 43
-	SQLParserRULE_optionValue            = 44
-	SQLParserRULE_queryStmt              = 45
-	SQLParserRULE_sourceAndSelect        = 46
-	SQLParserRULE_selectExpr             = 47
-	SQLParserRULE_fields                 = 48
-	SQLParserRULE_field                  = 49
-	SQLParserRULE_alias                  = 50
-	SQLParserRULE_brokerFilter           = 51
-	SQLParserRULE_databaseFilter         = 52
-	SQLParserRULE_typeFilter             = 53
-	SQLParserRULE_fromClause             = 54
-	SQLParserRULE_whereClause            = 55
-	SQLParserRULE_conditionExpr          = 56
-	SQLParserRULE_tagFilterExpr          = 57
-	SQLParserRULE_tagValueList           = 58
-	SQLParserRULE_metricListFilter       = 59
-	SQLParserRULE_metricList             = 60
-	SQLParserRULE_timeRangeExpr          = 61
-	SQLParserRULE_timeExpr               = 62
-	SQLParserRULE_nowExpr                = 63
-	SQLParserRULE_nowFunc                = 64
-	SQLParserRULE_groupByClause          = 65
-	SQLParserRULE_groupByKeys            = 66
-	SQLParserRULE_groupByKey             = 67
-	SQLParserRULE_fillOption             = 68
-	SQLParserRULE_orderByClause          = 69
-	SQLParserRULE_sortField              = 70
-	SQLParserRULE_sortFields             = 71
-	SQLParserRULE_havingClause           = 72
-	SQLParserRULE_boolExpr               = 73
-	SQLParserRULE_boolExprLogicalOp      = 74
-	SQLParserRULE_boolExprAtom           = 75
-	SQLParserRULE_binaryExpr             = 76
-	SQLParserRULE_binaryOperator         = 77
-	SQLParserRULE_fieldExpr              = 78
-	SQLParserRULE_star                   = 79
-	SQLParserRULE_durationLit            = 80
-	SQLParserRULE_intervalItem           = 81
-	SQLParserRULE_exprFunc               = 82
-	SQLParserRULE_funcName               = 83
-	SQLParserRULE_exprFuncParams         = 84
-	SQLParserRULE_funcParam              = 85
-	SQLParserRULE_exprAtom               = 86
-	SQLParserRULE_identFilter            = 87
-	SQLParserRULE_json                   = 88
-	SQLParserRULE_toml                   = 89
-	SQLParserRULE_obj                    = 90
-	SQLParserRULE_pair                   = 91
-	SQLParserRULE_arr                    = 92
-	SQLParserRULE_value                  = 93
-	SQLParserRULE_intNumber              = 94
-	SQLParserRULE_decNumber              = 95
-	SQLParserRULE_limitClause            = 96
-	SQLParserRULE_metricName             = 97
-	SQLParserRULE_tagKey                 = 98
-	SQLParserRULE_tagValue               = 99
-	SQLParserRULE_ident                  = 100
-	SQLParserRULE_nonReservedWords       = 101
+	SQLParserRULE_statement                 = 0
+	SQLParserRULE_useStmt                   = 1
+	SQLParserRULE_setLimitStmt              = 2
+	SQLParserRULE_showStmt                  = 3
+	SQLParserRULE_showMasterStmt            = 4
+	SQLParserRULE_showRequestsStmt          = 5
+	SQLParserRULE_showRequestStmt           = 6
+	SQLParserRULE_showBrokersStmt           = 7
+	SQLParserRULE_showLimitStmt             = 8
+	SQLParserRULE_showMetadataTypesStmt     = 9
+	SQLParserRULE_showRootMetaStmt          = 10
+	SQLParserRULE_showBrokerMetaStmt        = 11
+	SQLParserRULE_showMasterMetaStmt        = 12
+	SQLParserRULE_showStorageMetaStmt       = 13
+	SQLParserRULE_showAliveStmt             = 14
+	SQLParserRULE_showReplicationStmt       = 15
+	SQLParserRULE_showMemoryDatabaseStmt    = 16
+	SQLParserRULE_showRootMetricStmt        = 17
+	SQLParserRULE_showBrokerMetricStmt      = 18
+	SQLParserRULE_showStorageMetricStmt     = 19
+	SQLParserRULE_createStorageStmt         = 20
+	SQLParserRULE_createBrokerStmt          = 21
+	SQLParserRULE_recoverStorageStmt        = 22
+	SQLParserRULE_backupDatabaseStmt        = 23
+	SQLParserRULE_restoreDatabaseStmt       = 24
+	SQLParserRULE_showSchemasStmt           = 25
+	SQLParserRULE_createDatabaseStmt        = 26
+	SQLParserRULE_dropDatabaseStmt          = 27
+	SQLParserRULE_showDatabaseStmt          = 28
+	SQLParserRULE_showNameSpacesStmt        = 29
+	SQLParserRULE_showMetricsStmt           = 30
+	SQLParserRULE_showFieldsStmt            = 31
+	SQLParserRULE_showTagKeysStmt           = 32
+	SQLParserRULE_showContinuousQueriesStmt = 33
+	SQLParserRULE_showAlertsStmt            = 34
+	SQLParserRULE_createContinuousQueryStmt = 35
+	SQLParserRULE_dropContinuousQueryStmt   = 36
+	SQLParserRULE_cqName                    = 37
+	SQLParserRULE_showTagValuesStmt         = 38
+	SQLParserRULE_prefix                    = 39
+	SQLParserRULE_withTagKey                = 40
+	SQLParserRULE_namespace                 = 41
+	SQLParserRULE_databaseName              = 42
+	SQLParserRULE_storageName               = 43
+	SQLParserRULE_backupPath                = 44
+	SQLParserRULE_requestID                 = 45
+	SQLParserRULE_source                    = 46
+	SQLParserRULE_optionClause              = 47
+	SQLParserRULE_optionPairs               = 48
+	SQLParserRULE_closedOptionPairs         = 49
+	SQLParserRULE_optionPair                = 50
+	SQLParserRULE_optionKey                 = 51
+	SQLParserRULE_optionValue               = 52
+	SQLParserRULE_deleteStmt                = 53
+	SQLParserRULE_dropMetricStmt            = 54
+	SQLParserRULE_dropNamespaceStmt         = 55
+	SQLParserRULE_queryStmt                 = 56
+	SQLParserRULE_sourceAndSelect           = 57
+	SQLParserRULE_selectExpr                = 58
+	SQLParserRULE_fields                    = 59
+	SQLParserRULE_field                     = 60
+	SQLParserRULE_alias                     = 61
+	SQLParserRULE_brokerFilter              = 62
+	SQLParserRULE_databaseFilter            = 63
+	SQLParserRULE_typeFilter                = 64
+	SQLParserRULE_fromClause                = 65
+	SQLParserRULE_joinClause                = 66
+	SQLParserRULE_joinMetric                = 67
+	SQLParserRULE_metricAlias               = 68
+	SQLParserRULE_subQueryClause            = 69
+	SQLParserRULE_whereClause               = 70
+	SQLParserRULE_conditionExpr             = 71
+	SQLParserRULE_tagFilterExpr             = 72
+	SQLParserRULE_tagValueList              = 73
+	SQLParserRULE_metricListFilter          = 74
+	SQLParserRULE_metricList                = 75
+	SQLParserRULE_timeRangeExpr             = 76
+	SQLParserRULE_timeExpr                  = 77
+	SQLParserRULE_nowExpr                   = 78
+	SQLParserRULE_nowFunc                   = 79
+	SQLParserRULE_groupByClause             = 80
+	SQLParserRULE_groupByKeys               = 81
+	SQLParserRULE_groupByKey                = 82
+	SQLParserRULE_groupByTagExpr            = 83
+	SQLParserRULE_groupByTagFunc            = 84
+	SQLParserRULE_fillOption                = 85
+	SQLParserRULE_orderByClause             = 86
+	SQLParserRULE_sortField                 = 87
+	SQLParserRULE_sortFields                = 88
+	SQLParserRULE_havingClause              = 89
+	SQLParserRULE_boolExpr                  = 90
+	SQLParserRULE_boolExprLogicalOp         = 91
+	SQLParserRULE_boolExprAtom              = 92
+	SQLParserRULE_binaryExpr                = 93
+	SQLParserRULE_binaryOperator            = 94
+	SQLParserRULE_fieldExpr                 = 95
+	SQLParserRULE_star                      = 96
+	SQLParserRULE_durationLit               = 97
+	SQLParserRULE_intervalItem              = 98
+	SQLParserRULE_exprFunc                  = 99
+	SQLParserRULE_funcName                  = 100
+	SQLParserRULE_exprFuncParams            = 101
+	SQLParserRULE_funcParam                 = 102
+	SQLParserRULE_exprAtom                  = 103
+	SQLParserRULE_identFilter               = 104
+	SQLParserRULE_json                      = 105
+	SQLParserRULE_toml                      = 106
+	SQLParserRULE_obj                       = 107
+	SQLParserRULE_pair                      = 108
+	SQLParserRULE_arr                       = 109
+	SQLParserRULE_value                     = 110
+	SQLParserRULE_intNumber                 = 111
+	SQLParserRULE_decNumber                 = 112
+	SQLParserRULE_limitClause               = 113
+	SQLParserRULE_metricName                = 114
+	SQLParserRULE_tagKey                    = 115
+	SQLParserRULE_tagValue                  = 116
+	SQLParserRULE_ident                     = 117
+	SQLParserRULE_nonReservedWords          = 118
 )
 
 // IStatementContext is an interface to support dynamic dispatch.
@@ -788,10 +928,17 @@ type IStatementContext interface {
 	ShowStmt() IShowStmtContext
 	CreateBrokerStmt() ICreateBrokerStmtContext
 	RecoverStorageStmt() IRecoverStorageStmtContext
+	BackupDatabaseStmt() IBackupDatabaseStmtContext
+	RestoreDatabaseStmt() IRestoreDatabaseStmtContext
 	UseStmt() IUseStmtContext
 	QueryStmt() IQueryStmtContext
 	CreateDatabaseStmt() ICreateDatabaseStmtContext
 	DropDatabaseStmt() IDropDatabaseStmtContext
+	CreateContinuousQueryStmt() ICreateContinuousQueryStmtContext
+	DropContinuousQueryStmt() IDropContinuousQueryStmtContext
+	DeleteStmt() IDeleteStmtContext
+	DropMetricStmt() IDropMetricStmtContext
+	DropNamespaceStmt() IDropNamespaceStmtContext
 	SetLimitStmt() ISetLimitStmtContext
 	Ident() IIdentContext
 	EOF() antlr.TerminalNode
@@ -880,6 +1027,38 @@ func (s *StatementContext) RecoverStorageStmt() IRecoverStorageStmtContext {
 	return t.(IRecoverStorageStmtContext)
 }
 
+func (s *StatementContext) BackupDatabaseStmt() IBackupDatabaseStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBackupDatabaseStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBackupDatabaseStmtContext)
+}
+
+func (s *StatementContext) RestoreDatabaseStmt() IRestoreDatabaseStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IRestoreDatabaseStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IRestoreDatabaseStmtContext)
+}
+
 func (s *StatementContext) UseStmt() IUseStmtContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
@@ -944,6 +1123,86 @@ func (s *StatementContext) DropDatabaseStmt() IDropDatabaseStmtContext {
 	return t.(IDropDatabaseStmtContext)
 }
 
+func (s *StatementContext) CreateContinuousQueryStmt() ICreateContinuousQueryStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICreateContinuousQueryStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICreateContinuousQueryStmtContext)
+}
+
+func (s *StatementContext) DropContinuousQueryStmt() IDropContinuousQueryStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDropContinuousQueryStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDropContinuousQueryStmtContext)
+}
+
+func (s *StatementContext) DeleteStmt() IDeleteStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDeleteStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDeleteStmtContext)
+}
+
+func (s *StatementContext) DropMetricStmt() IDropMetricStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDropMetricStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDropMetricStmtContext)
+}
+
+func (s *StatementContext) DropNamespaceStmt() IDropNamespaceStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDropNamespaceStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDropNamespaceStmtContext)
+}
+
 func (s *StatementContext) SetLimitStmt() ISetLimitStmtContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
@@ -1013,7 +1272,7 @@ func (s *StatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 func (p *SQLParser) Statement() (localctx IStatementContext) {
 	localctx = NewStatementContext(p, p.GetParserRuleContext(), p.GetState())
 	p.EnterRule(localctx, 0, SQLParserRULE_statement)
-	p.SetState(215)
+	p.SetState(256)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -1023,67 +1282,116 @@ func (p *SQLParser) Statement() (localctx IStatementContext) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(204)
+			p.SetState(238)
 			p.ShowStmt()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(205)
+			p.SetState(239)
 			p.CreateBrokerStmt()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(206)
+			p.SetState(240)
 			p.RecoverStorageStmt()
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(207)
-			p.UseStmt()
+			p.SetState(241)
+			p.BackupDatabaseStmt()
 		}
 
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(208)
-			p.QueryStmt()
+			p.SetState(242)
+			p.RestoreDatabaseStmt()
 		}
 
 	case 6:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(209)
-			p.CreateDatabaseStmt()
+			p.SetState(243)
+			p.UseStmt()
 		}
 
 	case 7:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(210)
-			p.DropDatabaseStmt()
+			p.SetState(244)
+			p.QueryStmt()
 		}
 
 	case 8:
 		p.EnterOuterAlt(localctx, 8)
 		{
-			p.SetState(211)
-			p.SetLimitStmt()
+			p.SetState(245)
+			p.CreateDatabaseStmt()
 		}
 
 	case 9:
 		p.EnterOuterAlt(localctx, 9)
 		{
-			p.SetState(212)
+			p.SetState(246)
+			p.DropDatabaseStmt()
+		}
+
+	case 10:
+		p.EnterOuterAlt(localctx, 10)
+		{
+			p.SetState(247)
+			p.CreateContinuousQueryStmt()
+		}
+
+	case 11:
+		p.EnterOuterAlt(localctx, 11)
+		{
+			p.SetState(248)
+			p.DropContinuousQueryStmt()
+		}
+
+	case 12:
+		p.EnterOuterAlt(localctx, 12)
+		{
+			p.SetState(249)
+			p.DeleteStmt()
+		}
+
+	case 13:
+		p.EnterOuterAlt(localctx, 13)
+		{
+			p.SetState(250)
+			p.DropMetricStmt()
+		}
+
+	case 14:
+		p.EnterOuterAlt(localctx, 14)
+		{
+			p.SetState(251)
+			p.DropNamespaceStmt()
+		}
+
+	case 15:
+		p.EnterOuterAlt(localctx, 15)
+		{
+			p.SetState(252)
+			p.SetLimitStmt()
+		}
+
+	case 16:
+		p.EnterOuterAlt(localctx, 16)
+		{
+			p.SetState(253)
 			p.Ident()
 		}
 		{
-			p.SetState(213)
+			p.SetState(254)
 			p.Match(SQLParserEOF)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -1210,7 +1518,7 @@ func (p *SQLParser) UseStmt() (localctx IUseStmtContext) {
 	p.EnterRule(localctx, 2, SQLParserRULE_useStmt)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(217)
+		p.SetState(258)
 		p.Match(SQLParserT_USE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -1218,7 +1526,7 @@ func (p *SQLParser) UseStmt() (localctx IUseStmtContext) {
 		}
 	}
 	{
-		p.SetState(218)
+		p.SetState(259)
 		p.Ident()
 	}
 
@@ -1342,7 +1650,7 @@ func (p *SQLParser) SetLimitStmt() (localctx ISetLimitStmtContext) {
 	p.EnterRule(localctx, 4, SQLParserRULE_setLimitStmt)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(220)
+		p.SetState(261)
 		p.Match(SQLParserT_SET)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -1350,7 +1658,7 @@ func (p *SQLParser) SetLimitStmt() (localctx ISetLimitStmtContext) {
 		}
 	}
 	{
-		p.SetState(221)
+		p.SetState(262)
 		p.Match(SQLParserT_LIMIT)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -1358,7 +1666,7 @@ func (p *SQLParser) SetLimitStmt() (localctx ISetLimitStmtContext) {
 		}
 	}
 	{
-		p.SetState(222)
+		p.SetState(263)
 		p.Toml()
 	}
 
@@ -1406,6 +1714,8 @@ type IShowStmtContext interface {
 	ShowTagValuesStmt() IShowTagValuesStmtContext
 	ShowRequestsStmt() IShowRequestsStmtContext
 	ShowRequestStmt() IShowRequestStmtContext
+	ShowContinuousQueriesStmt() IShowContinuousQueriesStmtContext
+	ShowAlertsStmt() IShowAlertsStmtContext
 
 	// IsShowStmtContext differentiates from other interfaces.
 	IsShowStmtContext()
@@ -1811,6 +2121,38 @@ func (s *ShowStmtContext) ShowRequestStmt() IShowRequestStmtContext {
 	return t.(IShowRequestStmtContext)
 }
 
+func (s *ShowStmtContext) ShowContinuousQueriesStmt() IShowContinuousQueriesStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowContinuousQueriesStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowContinuousQueriesStmtContext)
+}
+
+func (s *ShowStmtContext) ShowAlertsStmt() IShowAlertsStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowAlertsStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowAlertsStmtContext)
+}
+
 func (s *ShowStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -1844,7 +2186,7 @@ func (s *ShowStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 func (p *SQLParser) ShowStmt() (localctx IShowStmtContext) {
 	localctx = NewShowStmtContext(p, p.GetParserRuleContext(), p.GetState())
 	p.EnterRule(localctx, 6, SQLParserRULE_showStmt)
-	p.SetState(247)
+	p.SetState(290)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -1854,164 +2196,178 @@ func (p *SQLParser) ShowStmt() (localctx IShowStmtContext) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(224)
+			p.SetState(265)
 			p.ShowMasterStmt()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(225)
+			p.SetState(266)
 			p.ShowMetadataTypesStmt()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(226)
+			p.SetState(267)
 			p.ShowRootMetaStmt()
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(227)
+			p.SetState(268)
 			p.ShowBrokerMetaStmt()
 		}
 
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(228)
+			p.SetState(269)
 			p.ShowMasterMetaStmt()
 		}
 
 	case 6:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(229)
+			p.SetState(270)
 			p.ShowStorageMetaStmt()
 		}
 
 	case 7:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(230)
+			p.SetState(271)
 			p.ShowBrokersStmt()
 		}
 
 	case 8:
 		p.EnterOuterAlt(localctx, 8)
 		{
-			p.SetState(231)
+			p.SetState(272)
 			p.ShowLimitStmt()
 		}
 
 	case 9:
 		p.EnterOuterAlt(localctx, 9)
 		{
-			p.SetState(232)
+			p.SetState(273)
 			p.ShowAliveStmt()
 		}
 
 	case 10:
 		p.EnterOuterAlt(localctx, 10)
 		{
-			p.SetState(233)
+			p.SetState(274)
 			p.ShowRootMetricStmt()
 		}
 
 	case 11:
 		p.EnterOuterAlt(localctx, 11)
 		{
-			p.SetState(234)
+			p.SetState(275)
 			p.ShowBrokerMetricStmt()
 		}
 
 	case 12:
 		p.EnterOuterAlt(localctx, 12)
 		{
-			p.SetState(235)
+			p.SetState(276)
 			p.ShowStorageMetricStmt()
 		}
 
 	case 13:
 		p.EnterOuterAlt(localctx, 13)
 		{
-			p.SetState(236)
+			p.SetState(277)
 			p.ShowReplicationStmt()
 		}
 
 	case 14:
 		p.EnterOuterAlt(localctx, 14)
 		{
-			p.SetState(237)
+			p.SetState(278)
 			p.ShowMemoryDatabaseStmt()
 		}
 
 	case 15:
 		p.EnterOuterAlt(localctx, 15)
 		{
-			p.SetState(238)
+			p.SetState(279)
 			p.ShowSchemasStmt()
 		}
 
 	case 16:
 		p.EnterOuterAlt(localctx, 16)
 		{
-			p.SetState(239)
+			p.SetState(280)
 			p.ShowDatabaseStmt()
 		}
 
 	case 17:
 		p.EnterOuterAlt(localctx, 17)
 		{
-			p.SetState(240)
+			p.SetState(281)
 			p.ShowNameSpacesStmt()
 		}
 
 	case 18:
 		p.EnterOuterAlt(localctx, 18)
 		{
-			p.SetState(241)
+			p.SetState(282)
 			p.ShowMetricsStmt()
 		}
 
 	case 19:
 		p.EnterOuterAlt(localctx, 19)
 		{
-			p.SetState(242)
+			p.SetState(283)
 			p.ShowFieldsStmt()
 		}
 
 	case 20:
 		p.EnterOuterAlt(localctx, 20)
 		{
-			p.SetState(243)
+			p.SetState(284)
 			p.ShowTagKeysStmt()
 		}
 
 	case 21:
 		p.EnterOuterAlt(localctx, 21)
 		{
-			p.SetState(244)
+			p.SetState(285)
 			p.ShowTagValuesStmt()
 		}
 
 	case 22:
 		p.EnterOuterAlt(localctx, 22)
 		{
-			p.SetState(245)
+			p.SetState(286)
 			p.ShowRequestsStmt()
 		}
 
 	case 23:
 		p.EnterOuterAlt(localctx, 23)
 		{
-			p.SetState(246)
+			p.SetState(287)
 			p.ShowRequestStmt()
 		}
 
+	case 24:
+		p.EnterOuterAlt(localctx, 24)
+		{
+			p.SetState(288)
+			p.ShowContinuousQueriesStmt()
+		}
+
+	case 25:
+		p.EnterOuterAlt(localctx, 25)
+		{
+			p.SetState(289)
+			p.ShowAlertsStmt()
+		}
+
 	case antlr.ATNInvalidAltNumber:
 		goto errorExit
 	}
@@ -2119,7 +2475,7 @@ func (p *SQLParser) ShowMasterStmt() (localctx IShowMasterStmtContext) {
 	p.EnterRule(localctx, 8, SQLParserRULE_showMasterStmt)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(249)
+		p.SetState(292)
 		p.Match(SQLParserT_SHOW)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -2127,7 +2483,7 @@ func (p *SQLParser) ShowMasterStmt() (localctx IShowMasterStmtContext) {
 		}
 	}
 	{
-		p.SetState(250)
+		p.SetState(293)
 		p.Match(SQLParserT_MASTER)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -2238,7 +2594,7 @@ func (p *SQLParser) ShowRequestsStmt() (localctx IShowRequestsStmtContext) {
 	p.EnterRule(localctx, 10, SQLParserRULE_showRequestsStmt)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(252)
+		p.SetState(295)
 		p.Match(SQLParserT_SHOW)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -2246,7 +2602,7 @@ func (p *SQLParser) ShowRequestsStmt() (localctx IShowRequestsStmtContext) {
 		}
 	}
 	{
-		p.SetState(253)
+		p.SetState(296)
 		p.Match(SQLParserT_REQUESTS)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -2389,7 +2745,7 @@ func (p *SQLParser) ShowRequestStmt() (localctx IShowRequestStmtContext) {
 	p.EnterRule(localctx, 12, SQLParserRULE_showRequestStmt)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(255)
+		p.SetState(298)
 		p.Match(SQLParserT_SHOW)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -2397,7 +2753,7 @@ func (p *SQLParser) ShowRequestStmt() (localctx IShowRequestStmtContext) {
 		}
 	}
 	{
-		p.SetState(256)
+		p.SetState(299)
 		p.Match(SQLParserT_REQUEST)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -2405,7 +2761,7 @@ func (p *SQLParser) ShowRequestStmt() (localctx IShowRequestStmtContext) {
 		}
 	}
 	{
-		p.SetState(257)
+		p.SetState(300)
 		p.Match(SQLParserT_WHERE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -2413,7 +2769,7 @@ func (p *SQLParser) ShowRequestStmt() (localctx IShowRequestStmtContext) {
 		}
 	}
 	{
-		p.SetState(258)
+		p.SetState(301)
 		p.Match(SQLParserT_ID)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -2421,7 +2777,7 @@ func (p *SQLParser) ShowRequestStmt() (localctx IShowRequestStmtContext) {
 		}
 	}
 	{
-		p.SetState(259)
+		p.SetState(302)
 		p.Match(SQLParserT_EQUAL)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -2429,7 +2785,7 @@ func (p *SQLParser) ShowRequestStmt() (localctx IShowRequestStmtContext) {
 		}
 	}
 	{
-		p.SetState(260)
+		p.SetState(303)
 		p.RequestID()
 	}
 
@@ -2536,7 +2892,7 @@ func (p *SQLParser) ShowBrokersStmt() (localctx IShowBrokersStmtContext) {
 	p.EnterRule(localctx, 14, SQLParserRULE_showBrokersStmt)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(262)
+		p.SetState(305)
 		p.Match(SQLParserT_SHOW)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -2544,7 +2900,7 @@ func (p *SQLParser) ShowBrokersStmt() (localctx IShowBrokersStmtContext) {
 		}
 	}
 	{
-		p.SetState(263)
+		p.SetState(306)
 		p.Match(SQLParserT_BROKERS)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -2655,7 +3011,7 @@ func (p *SQLParser) ShowLimitStmt() (localctx IShowLimitStmtContext) {
 	p.EnterRule(localctx, 16, SQLParserRULE_showLimitStmt)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(265)
+		p.SetState(308)
 		p.Match(SQLParserT_SHOW)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -2663,7 +3019,7 @@ func (p *SQLParser) ShowLimitStmt() (localctx IShowLimitStmtContext) {
 		}
 	}
 	{
-		p.SetState(266)
+		p.SetState(309)
 		p.Match(SQLParserT_LIMIT)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -2779,7 +3135,7 @@ func (p *SQLParser) ShowMetadataTypesStmt() (localctx IShowMetadataTypesStmtCont
 	p.EnterRule(localctx, 18, SQLParserRULE_showMetadataTypesStmt)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(268)
+		p.SetState(311)
 		p.Match(SQLParserT_SHOW)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -2787,7 +3143,7 @@ func (p *SQLParser) ShowMetadataTypesStmt() (localctx IShowMetadataTypesStmtCont
 		}
 	}
 	{
-		p.SetState(269)
+		p.SetState(312)
 		p.Match(SQLParserT_METADATA)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -2795,7 +3151,7 @@ func (p *SQLParser) ShowMetadataTypesStmt() (localctx IShowMetadataTypesStmtCont
 		}
 	}
 	{
-		p.SetState(270)
+		p.SetState(313)
 		p.Match(SQLParserT_TYPES)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -2955,7 +3311,7 @@ func (p *SQLParser) ShowRootMetaStmt() (localctx IShowRootMetaStmtContext) {
 	p.EnterRule(localctx, 20, SQLParserRULE_showRootMetaStmt)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(272)
+		p.SetState(315)
 		p.Match(SQLParserT_SHOW)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -2963,7 +3319,7 @@ func (p *SQLParser) ShowRootMetaStmt() (localctx IShowRootMetaStmtContext) {
 		}
 	}
 	{
-		p.SetState(273)
+		p.SetState(316)
 		p.Match(SQLParserT_ROOT)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -2971,7 +3327,7 @@ func (p *SQLParser) ShowRootMetaStmt() (localctx IShowRootMetaStmtContext) {
 		}
 	}
 	{
-		p.SetState(274)
+		p.SetState(317)
 		p.Match(SQLParserT_METADATA)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -2979,7 +3335,7 @@ func (p *SQLParser) ShowRootMetaStmt() (localctx IShowRootMetaStmtContext) {
 		}
 	}
 	{
-		p.SetState(275)
+		p.SetState(318)
 		p.Match(SQLParserT_FROM)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -2987,11 +3343,11 @@ func (p *SQLParser) ShowRootMetaStmt() (localctx IShowRootMetaStmtContext) {
 		}
 	}
 	{
-		p.SetState(276)
+		p.SetState(319)
 		p.Source()
 	}
 	{
-		p.SetState(277)
+		p.SetState(320)
 		p.Match(SQLParserT_WHERE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -2999,7 +3355,7 @@ func (p *SQLParser) ShowRootMetaStmt() (localctx IShowRootMetaStmtContext) {
 		}
 	}
 	{
-		p.SetState(278)
+		p.SetState(321)
 		p.TypeFilter()
 	}
 
@@ -3179,7 +3535,7 @@ func (p *SQLParser) ShowBrokerMetaStmt() (localctx IShowBrokerMetaStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(280)
+		p.SetState(323)
 		p.Match(SQLParserT_SHOW)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -3187,7 +3543,7 @@ func (p *SQLParser) ShowBrokerMetaStmt() (localctx IShowBrokerMetaStmtContext) {
 		}
 	}
 	{
-		p.SetState(281)
+		p.SetState(324)
 		p.Match(SQLParserT_BROKER)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -3195,7 +3551,7 @@ func (p *SQLParser) ShowBrokerMetaStmt() (localctx IShowBrokerMetaStmtContext) {
 		}
 	}
 	{
-		p.SetState(282)
+		p.SetState(325)
 		p.Match(SQLParserT_METADATA)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -3203,7 +3559,7 @@ func (p *SQLParser) ShowBrokerMetaStmt() (localctx IShowBrokerMetaStmtContext) {
 		}
 	}
 	{
-		p.SetState(283)
+		p.SetState(326)
 		p.Match(SQLParserT_FROM)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -3211,11 +3567,11 @@ func (p *SQLParser) ShowBrokerMetaStmt() (localctx IShowBrokerMetaStmtContext) {
 		}
 	}
 	{
-		p.SetState(284)
+		p.SetState(327)
 		p.Source()
 	}
 	{
-		p.SetState(285)
+		p.SetState(328)
 		p.Match(SQLParserT_WHERE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -3223,10 +3579,10 @@ func (p *SQLParser) ShowBrokerMetaStmt() (localctx IShowBrokerMetaStmtContext) {
 		}
 	}
 	{
-		p.SetState(286)
+		p.SetState(329)
 		p.TypeFilter()
 	}
-	p.SetState(289)
+	p.SetState(332)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -3235,7 +3591,7 @@ func (p *SQLParser) ShowBrokerMetaStmt() (localctx IShowBrokerMetaStmtContext) {
 
 	if _la == SQLParserT_AND {
 		{
-			p.SetState(287)
+			p.SetState(330)
 			p.Match(SQLParserT_AND)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -3243,7 +3599,7 @@ func (p *SQLParser) ShowBrokerMetaStmt() (localctx IShowBrokerMetaStmtContext) {
 			}
 		}
 		{
-			p.SetState(288)
+			p.SetState(331)
 			p.BrokerFilter()
 		}
 
@@ -3401,7 +3757,7 @@ func (p *SQLParser) ShowMasterMetaStmt() (localctx IShowMasterMetaStmtContext) {
 	p.EnterRule(localctx, 24, SQLParserRULE_showMasterMetaStmt)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(291)
+		p.SetState(334)
 		p.Match(SQLParserT_SHOW)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -3409,7 +3765,7 @@ func (p *SQLParser) ShowMasterMetaStmt() (localctx IShowMasterMetaStmtContext) {
 		}
 	}
 	{
-		p.SetState(292)
+		p.SetState(335)
 		p.Match(SQLParserT_MASTER)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -3417,7 +3773,7 @@ func (p *SQLParser) ShowMasterMetaStmt() (localctx IShowMasterMetaStmtContext) {
 		}
 	}
 	{
-		p.SetState(293)
+		p.SetState(336)
 		p.Match(SQLParserT_METADATA)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -3425,7 +3781,7 @@ func (p *SQLParser) ShowMasterMetaStmt() (localctx IShowMasterMetaStmtContext) {
 		}
 	}
 	{
-		p.SetState(294)
+		p.SetState(337)
 		p.Match(SQLParserT_FROM)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -3433,11 +3789,11 @@ func (p *SQLParser) ShowMasterMetaStmt() (localctx IShowMasterMetaStmtContext) {
 		}
 	}
 	{
-		p.SetState(295)
+		p.SetState(338)
 		p.Source()
 	}
 	{
-		p.SetState(296)
+		p.SetState(339)
 		p.Match(SQLParserT_WHERE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -3445,7 +3801,7 @@ func (p *SQLParser) ShowMasterMetaStmt() (localctx IShowMasterMetaStmtContext) {
 		}
 	}
 	{
-		p.SetState(297)
+		p.SetState(340)
 		p.TypeFilter()
 	}
 
@@ -3601,7 +3957,7 @@ func (p *SQLParser) ShowStorageMetaStmt() (localctx IShowStorageMetaStmtContext)
 	p.EnterRule(localctx, 26, SQLParserRULE_showStorageMetaStmt)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(299)
+		p.SetState(342)
 		p.Match(SQLParserT_SHOW)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -3609,7 +3965,7 @@ func (p *SQLParser) ShowStorageMetaStmt() (localctx IShowStorageMetaStmtContext)
 		}
 	}
 	{
-		p.SetState(300)
+		p.SetState(343)
 		p.Match(SQLParserT_STORAGE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -3617,7 +3973,7 @@ func (p *SQLParser) ShowStorageMetaStmt() (localctx IShowStorageMetaStmtContext)
 		}
 	}
 	{
-		p.SetState(301)
+		p.SetState(344)
 		p.Match(SQLParserT_METADATA)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -3625,7 +3981,7 @@ func (p *SQLParser) ShowStorageMetaStmt() (localctx IShowStorageMetaStmtContext)
 		}
 	}
 	{
-		p.SetState(302)
+		p.SetState(345)
 		p.Match(SQLParserT_FROM)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -3633,11 +3989,11 @@ func (p *SQLParser) ShowStorageMetaStmt() (localctx IShowStorageMetaStmtContext)
 		}
 	}
 	{
-		p.SetState(303)
+		p.SetState(346)
 		p.Source()
 	}
 	{
-		p.SetState(304)
+		p.SetState(347)
 		p.Match(SQLParserT_WHERE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -3645,7 +4001,7 @@ func (p *SQLParser) ShowStorageMetaStmt() (localctx IShowStorageMetaStmtContext)
 		}
 	}
 	{
-		p.SetState(305)
+		p.SetState(348)
 		p.TypeFilter()
 	}
 
@@ -3769,7 +4125,7 @@ func (p *SQLParser) ShowAliveStmt() (localctx IShowAliveStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(307)
+		p.SetState(350)
 		p.Match(SQLParserT_SHOW)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -3777,10 +4133,10 @@ func (p *SQLParser) ShowAliveStmt() (localctx IShowAliveStmtContext) {
 		}
 	}
 	{
-		p.SetState(308)
+		p.SetState(351)
 		_la = p.GetTokenStream().LA(1)
 
-		if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&15032385536) != 0) {
+		if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&60129542144) != 0) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -3788,7 +4144,7 @@ func (p *SQLParser) ShowAliveStmt() (localctx IShowAliveStmtContext) {
 		}
 	}
 	{
-		p.SetState(309)
+		p.SetState(352)
 		p.Match(SQLParserT_ALIVE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -3921,7 +4277,7 @@ func (p *SQLParser) ShowReplicationStmt() (localctx IShowReplicationStmtContext)
 	p.EnterRule(localctx, 30, SQLParserRULE_showReplicationStmt)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(311)
+		p.SetState(354)
 		p.Match(SQLParserT_SHOW)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -3929,7 +4285,7 @@ func (p *SQLParser) ShowReplicationStmt() (localctx IShowReplicationStmtContext)
 		}
 	}
 	{
-		p.SetState(312)
+		p.SetState(355)
 		p.Match(SQLParserT_REPLICATION)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -3937,7 +4293,7 @@ func (p *SQLParser) ShowReplicationStmt() (localctx IShowReplicationStmtContext)
 		}
 	}
 	{
-		p.SetState(313)
+		p.SetState(356)
 		p.Match(SQLParserT_WHERE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -3945,7 +4301,7 @@ func (p *SQLParser) ShowReplicationStmt() (localctx IShowReplicationStmtContext)
 		}
 	}
 	{
-		p.SetState(314)
+		p.SetState(357)
 		p.DatabaseFilter()
 	}
 
@@ -4079,7 +4435,7 @@ func (p *SQLParser) ShowMemoryDatabaseStmt() (localctx IShowMemoryDatabaseStmtCo
 	p.EnterRule(localctx, 32, SQLParserRULE_showMemoryDatabaseStmt)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(316)
+		p.SetState(359)
 		p.Match(SQLParserT_SHOW)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -4087,7 +4443,7 @@ func (p *SQLParser) ShowMemoryDatabaseStmt() (localctx IShowMemoryDatabaseStmtCo
 		}
 	}
 	{
-		p.SetState(317)
+		p.SetState(360)
 		p.Match(SQLParserT_MEMORY)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -4095,7 +4451,7 @@ func (p *SQLParser) ShowMemoryDatabaseStmt() (localctx IShowMemoryDatabaseStmtCo
 		}
 	}
 	{
-		p.SetState(318)
+		p.SetState(361)
 		p.Match(SQLParserT_DATASBAE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -4103,7 +4459,7 @@ func (p *SQLParser) ShowMemoryDatabaseStmt() (localctx IShowMemoryDatabaseStmtCo
 		}
 	}
 	{
-		p.SetState(319)
+		p.SetState(362)
 		p.Match(SQLParserT_WHERE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -4111,7 +4467,7 @@ func (p *SQLParser) ShowMemoryDatabaseStmt() (localctx IShowMemoryDatabaseStmtCo
 		}
 	}
 	{
-		p.SetState(320)
+		p.SetState(363)
 		p.DatabaseFilter()
 	}
 
@@ -4245,7 +4601,7 @@ func (p *SQLParser) ShowRootMetricStmt() (localctx IShowRootMetricStmtContext) {
 	p.EnterRule(localctx, 34, SQLParserRULE_showRootMetricStmt)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(322)
+		p.SetState(365)
 		p.Match(SQLParserT_SHOW)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -4253,7 +4609,7 @@ func (p *SQLParser) ShowRootMetricStmt() (localctx IShowRootMetricStmtContext) {
 		}
 	}
 	{
-		p.SetState(323)
+		p.SetState(366)
 		p.Match(SQLParserT_ROOT)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -4261,7 +4617,7 @@ func (p *SQLParser) ShowRootMetricStmt() (localctx IShowRootMetricStmtContext) {
 		}
 	}
 	{
-		p.SetState(324)
+		p.SetState(367)
 		p.Match(SQLParserT_METRIC)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -4269,7 +4625,7 @@ func (p *SQLParser) ShowRootMetricStmt() (localctx IShowRootMetricStmtContext) {
 		}
 	}
 	{
-		p.SetState(325)
+		p.SetState(368)
 		p.Match(SQLParserT_WHERE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -4277,7 +4633,7 @@ func (p *SQLParser) ShowRootMetricStmt() (localctx IShowRootMetricStmtContext) {
 		}
 	}
 	{
-		p.SetState(326)
+		p.SetState(369)
 		p.MetricListFilter()
 	}
 
@@ -4411,7 +4767,7 @@ func (p *SQLParser) ShowBrokerMetricStmt() (localctx IShowBrokerMetricStmtContex
 	p.EnterRule(localctx, 36, SQLParserRULE_showBrokerMetricStmt)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(328)
+		p.SetState(371)
 		p.Match(SQLParserT_SHOW)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -4419,7 +4775,7 @@ func (p *SQLParser) ShowBrokerMetricStmt() (localctx IShowBrokerMetricStmtContex
 		}
 	}
 	{
-		p.SetState(329)
+		p.SetState(372)
 		p.Match(SQLParserT_BROKER)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -4427,7 +4783,7 @@ func (p *SQLParser) ShowBrokerMetricStmt() (localctx IShowBrokerMetricStmtContex
 		}
 	}
 	{
-		p.SetState(330)
+		p.SetState(373)
 		p.Match(SQLParserT_METRIC)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -4435,7 +4791,7 @@ func (p *SQLParser) ShowBrokerMetricStmt() (localctx IShowBrokerMetricStmtContex
 		}
 	}
 	{
-		p.SetState(331)
+		p.SetState(374)
 		p.Match(SQLParserT_WHERE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -4443,7 +4799,7 @@ func (p *SQLParser) ShowBrokerMetricStmt() (localctx IShowBrokerMetricStmtContex
 		}
 	}
 	{
-		p.SetState(332)
+		p.SetState(375)
 		p.MetricListFilter()
 	}
 
@@ -4577,7 +4933,7 @@ func (p *SQLParser) ShowStorageMetricStmt() (localctx IShowStorageMetricStmtCont
 	p.EnterRule(localctx, 38, SQLParserRULE_showStorageMetricStmt)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(334)
+		p.SetState(377)
 		p.Match(SQLParserT_SHOW)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -4585,7 +4941,7 @@ func (p *SQLParser) ShowStorageMetricStmt() (localctx IShowStorageMetricStmtCont
 		}
 	}
 	{
-		p.SetState(335)
+		p.SetState(378)
 		p.Match(SQLParserT_STORAGE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -4593,7 +4949,7 @@ func (p *SQLParser) ShowStorageMetricStmt() (localctx IShowStorageMetricStmtCont
 		}
 	}
 	{
-		p.SetState(336)
+		p.SetState(379)
 		p.Match(SQLParserT_METRIC)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -4601,7 +4957,7 @@ func (p *SQLParser) ShowStorageMetricStmt() (localctx IShowStorageMetricStmtCont
 		}
 	}
 	{
-		p.SetState(337)
+		p.SetState(380)
 		p.Match(SQLParserT_WHERE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -4609,7 +4965,7 @@ func (p *SQLParser) ShowStorageMetricStmt() (localctx IShowStorageMetricStmtCont
 		}
 	}
 	{
-		p.SetState(338)
+		p.SetState(381)
 		p.MetricListFilter()
 	}
 
@@ -4733,7 +5089,7 @@ func (p *SQLParser) CreateStorageStmt() (localctx ICreateStorageStmtContext) {
 	p.EnterRule(localctx, 40, SQLParserRULE_createStorageStmt)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(340)
+		p.SetState(383)
 		p.Match(SQLParserT_CREATE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -4741,7 +5097,7 @@ func (p *SQLParser) CreateStorageStmt() (localctx ICreateStorageStmtContext) {
 		}
 	}
 	{
-		p.SetState(341)
+		p.SetState(384)
 		p.Match(SQLParserT_STORAGE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -4749,7 +5105,7 @@ func (p *SQLParser) CreateStorageStmt() (localctx ICreateStorageStmtContext) {
 		}
 	}
 	{
-		p.SetState(342)
+		p.SetState(385)
 		p.Json()
 	}
 
@@ -4873,7 +5229,7 @@ func (p *SQLParser) CreateBrokerStmt() (localctx ICreateBrokerStmtContext) {
 	p.EnterRule(localctx, 42, SQLParserRULE_createBrokerStmt)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(344)
+		p.SetState(387)
 		p.Match(SQLParserT_CREATE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -4881,7 +5237,7 @@ func (p *SQLParser) CreateBrokerStmt() (localctx ICreateBrokerStmtContext) {
 		}
 	}
 	{
-		p.SetState(345)
+		p.SetState(388)
 		p.Match(SQLParserT_BROKER)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -4889,7 +5245,7 @@ func (p *SQLParser) CreateBrokerStmt() (localctx ICreateBrokerStmtContext) {
 		}
 	}
 	{
-		p.SetState(346)
+		p.SetState(389)
 		p.Json()
 	}
 
@@ -5013,7 +5369,7 @@ func (p *SQLParser) RecoverStorageStmt() (localctx IRecoverStorageStmtContext) {
 	p.EnterRule(localctx, 44, SQLParserRULE_recoverStorageStmt)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(348)
+		p.SetState(391)
 		p.Match(SQLParserT_RECOVER)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -5021,7 +5377,7 @@ func (p *SQLParser) RecoverStorageStmt() (localctx IRecoverStorageStmtContext) {
 		}
 	}
 	{
-		p.SetState(349)
+		p.SetState(392)
 		p.Match(SQLParserT_STORAGE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -5029,7 +5385,7 @@ func (p *SQLParser) RecoverStorageStmt() (localctx IRecoverStorageStmtContext) {
 		}
 	}
 	{
-		p.SetState(350)
+		p.SetState(393)
 		p.StorageName()
 	}
 
@@ -5046,111 +5402,166 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IShowSchemasStmtContext is an interface to support dynamic dispatch.
-type IShowSchemasStmtContext interface {
+// IBackupDatabaseStmtContext is an interface to support dynamic dispatch.
+type IBackupDatabaseStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	T_SHOW() antlr.TerminalNode
-	T_SCHEMAS() antlr.TerminalNode
+	T_BACKUP() antlr.TerminalNode
+	T_DATASBAE() antlr.TerminalNode
+	DatabaseName() IDatabaseNameContext
+	T_TO() antlr.TerminalNode
+	BackupPath() IBackupPathContext
 
-	// IsShowSchemasStmtContext differentiates from other interfaces.
-	IsShowSchemasStmtContext()
+	// IsBackupDatabaseStmtContext differentiates from other interfaces.
+	IsBackupDatabaseStmtContext()
 }
 
-type ShowSchemasStmtContext struct {
+type BackupDatabaseStmtContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowSchemasStmtContext() *ShowSchemasStmtContext {
-	var p = new(ShowSchemasStmtContext)
+func NewEmptyBackupDatabaseStmtContext() *BackupDatabaseStmtContext {
+	var p = new(BackupDatabaseStmtContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_showSchemasStmt
+	p.RuleIndex = SQLParserRULE_backupDatabaseStmt
 	return p
 }
 
-func InitEmptyShowSchemasStmtContext(p *ShowSchemasStmtContext) {
+func InitEmptyBackupDatabaseStmtContext(p *BackupDatabaseStmtContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_showSchemasStmt
+	p.RuleIndex = SQLParserRULE_backupDatabaseStmt
 }
 
-func (*ShowSchemasStmtContext) IsShowSchemasStmtContext() {}
+func (*BackupDatabaseStmtContext) IsBackupDatabaseStmtContext() {}
 
-func NewShowSchemasStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowSchemasStmtContext {
-	var p = new(ShowSchemasStmtContext)
+func NewBackupDatabaseStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *BackupDatabaseStmtContext {
+	var p = new(BackupDatabaseStmtContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showSchemasStmt
+	p.RuleIndex = SQLParserRULE_backupDatabaseStmt
 
 	return p
 }
 
-func (s *ShowSchemasStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *BackupDatabaseStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowSchemasStmtContext) T_SHOW() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SHOW, 0)
+func (s *BackupDatabaseStmtContext) T_BACKUP() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_BACKUP, 0)
 }
 
-func (s *ShowSchemasStmtContext) T_SCHEMAS() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SCHEMAS, 0)
+func (s *BackupDatabaseStmtContext) T_DATASBAE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DATASBAE, 0)
 }
 
-func (s *ShowSchemasStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *BackupDatabaseStmtContext) DatabaseName() IDatabaseNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDatabaseNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDatabaseNameContext)
+}
+
+func (s *BackupDatabaseStmtContext) T_TO() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TO, 0)
+}
+
+func (s *BackupDatabaseStmtContext) BackupPath() IBackupPathContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBackupPathContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBackupPathContext)
+}
+
+func (s *BackupDatabaseStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowSchemasStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *BackupDatabaseStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowSchemasStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *BackupDatabaseStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowSchemasStmt(s)
+		listenerT.EnterBackupDatabaseStmt(s)
 	}
 }
 
-func (s *ShowSchemasStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *BackupDatabaseStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowSchemasStmt(s)
+		listenerT.ExitBackupDatabaseStmt(s)
 	}
 }
 
-func (s *ShowSchemasStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *BackupDatabaseStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowSchemasStmt(s)
+		return t.VisitBackupDatabaseStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowSchemasStmt() (localctx IShowSchemasStmtContext) {
-	localctx = NewShowSchemasStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 46, SQLParserRULE_showSchemasStmt)
+func (p *SQLParser) BackupDatabaseStmt() (localctx IBackupDatabaseStmtContext) {
+	localctx = NewBackupDatabaseStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 46, SQLParserRULE_backupDatabaseStmt)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(352)
-		p.Match(SQLParserT_SHOW)
+		p.SetState(395)
+		p.Match(SQLParserT_BACKUP)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
 	{
-		p.SetState(353)
-		p.Match(SQLParserT_SCHEMAS)
+		p.SetState(396)
+		p.Match(SQLParserT_DATASBAE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(397)
+		p.DatabaseName()
+	}
+	{
+		p.SetState(398)
+		p.Match(SQLParserT_TO)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
+	{
+		p.SetState(399)
+		p.BackupPath()
+	}
 
 errorExit:
 	if p.HasError() {
@@ -5165,67 +5576,68 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ICreateDatabaseStmtContext is an interface to support dynamic dispatch.
-type ICreateDatabaseStmtContext interface {
+// IRestoreDatabaseStmtContext is an interface to support dynamic dispatch.
+type IRestoreDatabaseStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	T_CREATE() antlr.TerminalNode
+	T_RESTORE() antlr.TerminalNode
 	T_DATASBAE() antlr.TerminalNode
-	Json() IJsonContext
-	OptionClause() IOptionClauseContext
+	DatabaseName() IDatabaseNameContext
+	T_FROM() antlr.TerminalNode
+	BackupPath() IBackupPathContext
 
-	// IsCreateDatabaseStmtContext differentiates from other interfaces.
-	IsCreateDatabaseStmtContext()
+	// IsRestoreDatabaseStmtContext differentiates from other interfaces.
+	IsRestoreDatabaseStmtContext()
 }
 
-type CreateDatabaseStmtContext struct {
+type RestoreDatabaseStmtContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyCreateDatabaseStmtContext() *CreateDatabaseStmtContext {
-	var p = new(CreateDatabaseStmtContext)
+func NewEmptyRestoreDatabaseStmtContext() *RestoreDatabaseStmtContext {
+	var p = new(RestoreDatabaseStmtContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_createDatabaseStmt
+	p.RuleIndex = SQLParserRULE_restoreDatabaseStmt
 	return p
 }
 
-func InitEmptyCreateDatabaseStmtContext(p *CreateDatabaseStmtContext) {
+func InitEmptyRestoreDatabaseStmtContext(p *RestoreDatabaseStmtContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_createDatabaseStmt
+	p.RuleIndex = SQLParserRULE_restoreDatabaseStmt
 }
 
-func (*CreateDatabaseStmtContext) IsCreateDatabaseStmtContext() {}
+func (*RestoreDatabaseStmtContext) IsRestoreDatabaseStmtContext() {}
 
-func NewCreateDatabaseStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CreateDatabaseStmtContext {
-	var p = new(CreateDatabaseStmtContext)
+func NewRestoreDatabaseStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RestoreDatabaseStmtContext {
+	var p = new(RestoreDatabaseStmtContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_createDatabaseStmt
+	p.RuleIndex = SQLParserRULE_restoreDatabaseStmt
 
 	return p
 }
 
-func (s *CreateDatabaseStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *RestoreDatabaseStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *CreateDatabaseStmtContext) T_CREATE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_CREATE, 0)
+func (s *RestoreDatabaseStmtContext) T_RESTORE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_RESTORE, 0)
 }
 
-func (s *CreateDatabaseStmtContext) T_DATASBAE() antlr.TerminalNode {
+func (s *RestoreDatabaseStmtContext) T_DATASBAE() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_DATASBAE, 0)
 }
 
-func (s *CreateDatabaseStmtContext) Json() IJsonContext {
+func (s *RestoreDatabaseStmtContext) DatabaseName() IDatabaseNameContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IJsonContext); ok {
+		if _, ok := ctx.(IDatabaseNameContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -5235,13 +5647,17 @@ func (s *CreateDatabaseStmtContext) Json() IJsonContext {
 		return nil
 	}
 
-	return t.(IJsonContext)
+	return t.(IDatabaseNameContext)
 }
 
-func (s *CreateDatabaseStmtContext) OptionClause() IOptionClauseContext {
+func (s *RestoreDatabaseStmtContext) T_FROM() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FROM, 0)
+}
+
+func (s *RestoreDatabaseStmtContext) BackupPath() IBackupPathContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IOptionClauseContext); ok {
+		if _, ok := ctx.(IBackupPathContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -5251,81 +5667,74 @@ func (s *CreateDatabaseStmtContext) OptionClause() IOptionClauseContext {
 		return nil
 	}
 
-	return t.(IOptionClauseContext)
+	return t.(IBackupPathContext)
 }
 
-func (s *CreateDatabaseStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *RestoreDatabaseStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *CreateDatabaseStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *RestoreDatabaseStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *CreateDatabaseStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *RestoreDatabaseStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterCreateDatabaseStmt(s)
+		listenerT.EnterRestoreDatabaseStmt(s)
 	}
 }
 
-func (s *CreateDatabaseStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *RestoreDatabaseStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitCreateDatabaseStmt(s)
+		listenerT.ExitRestoreDatabaseStmt(s)
 	}
 }
 
-func (s *CreateDatabaseStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *RestoreDatabaseStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitCreateDatabaseStmt(s)
+		return t.VisitRestoreDatabaseStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) CreateDatabaseStmt() (localctx ICreateDatabaseStmtContext) {
-	localctx = NewCreateDatabaseStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 48, SQLParserRULE_createDatabaseStmt)
+func (p *SQLParser) RestoreDatabaseStmt() (localctx IRestoreDatabaseStmtContext) {
+	localctx = NewRestoreDatabaseStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 48, SQLParserRULE_restoreDatabaseStmt)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(355)
-		p.Match(SQLParserT_CREATE)
+		p.SetState(401)
+		p.Match(SQLParserT_RESTORE)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
 	{
-		p.SetState(356)
+		p.SetState(402)
 		p.Match(SQLParserT_DATASBAE)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(359)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
+	{
+		p.SetState(403)
+		p.DatabaseName()
 	}
-
-	switch p.GetTokenStream().LA(1) {
-	case SQLParserT__0, SQLParserT__1, SQLParserT__2, SQLParserSTRING, SQLParserT_OPEN_B, SQLParserT_OPEN_SB, SQLParserT_ADD, SQLParserT_SUB, SQLParserL_INT, SQLParserL_DEC:
-		{
-			p.SetState(357)
-			p.Json()
-		}
-
-	case SQLParserT_CREATE, SQLParserT_UPDATE, SQLParserT_SET, SQLParserT_DROP, SQLParserT_INTERVAL, SQLParserT_INTERVAL_NAME, SQLParserT_SHARD, SQLParserT_REPLICATION, SQLParserT_MEMORY, SQLParserT_TTL, SQLParserT_META_TTL, SQLParserT_PAST_TTL, SQLParserT_FUTURE_TTL, SQLParserT_KILL, SQLParserT_ON, SQLParserT_SHOW, SQLParserT_USE, SQLParserT_STATE_REPO, SQLParserT_STATE_MACHINE, SQLParserT_MASTER, SQLParserT_METADATA, SQLParserT_TYPES, SQLParserT_TYPE, SQLParserT_STORAGES, SQLParserT_STORAGE, SQLParserT_BROKER, SQLParserT_ROOT, SQLParserT_BROKERS, SQLParserT_ALIVE, SQLParserT_SCHEMAS, SQLParserT_DATASBAE, SQLParserT_DATASBAES, SQLParserT_NAMESPACE, SQLParserT_NAMESPACES, SQLParserT_NODE, SQLParserT_METRICS, SQLParserT_METRIC, SQLParserT_FIELD, SQLParserT_FIELDS, SQLParserT_TAG, SQLParserT_INFO, SQLParserT_KEYS, SQLParserT_KEY, SQLParserT_WITH, SQLParserT_VALUES, SQLParserT_VALUE, SQLParserT_FROM, SQLParserT_WHERE, SQLParserT_LIMIT, SQLParserT_QUERIES, SQLParserT_QUERY, SQLParserT_EXPLAIN, SQLParserT_WITH_VALUE, SQLParserT_SELECT, SQLParserT_AS, SQLParserT_AND, SQLParserT_OR, SQLParserT_FILL, SQLParserT_NULL, SQLParserT_PREVIOUS, SQLParserT_ORDER, SQLParserT_ASC, SQLParserT_DESC, SQLParserT_LIKE, SQLParserT_NOT, SQLParserT_BETWEEN, SQLParserT_IS, SQLParserT_GROUP, SQLParserT_HAVING, SQLParserT_BY, SQLParserT_FOR, SQLParserT_STATS, SQLParserT_TIME, SQLParserT_NOW, SQLParserT_IN, SQLParserT_ROLLUP, SQLParserT_LOG, SQLParserT_PROFILE, SQLParserT_REQUESTS, SQLParserT_REQUEST, SQLParserT_ID, SQLParserT_SUM, SQLParserT_MIN, SQLParserT_MAX, SQLParserT_COUNT, SQLParserT_LAST, SQLParserT_FIRST, SQLParserT_AVG, SQLParserT_STDDEV, SQLParserT_QUANTILE, SQLParserT_RATE, SQLParserT_SECOND, SQLParserT_MINUTE, SQLParserT_HOUR, SQLParserT_DAY, SQLParserT_WEEK, SQLParserT_MONTH, SQLParserT_YEAR, SQLParserL_ID:
-		{
-			p.SetState(358)
-			p.OptionClause()
+	{
+		p.SetState(404)
+		p.Match(SQLParserT_FROM)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
-
-	default:
-		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-		goto errorExit
+	}
+	{
+		p.SetState(405)
+		p.BackupPath()
 	}
 
 errorExit:
@@ -5341,132 +5750,111 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IDropDatabaseStmtContext is an interface to support dynamic dispatch.
-type IDropDatabaseStmtContext interface {
+// IShowSchemasStmtContext is an interface to support dynamic dispatch.
+type IShowSchemasStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	T_DROP() antlr.TerminalNode
-	T_DATASBAE() antlr.TerminalNode
-	DatabaseName() IDatabaseNameContext
+	T_SHOW() antlr.TerminalNode
+	T_SCHEMAS() antlr.TerminalNode
 
-	// IsDropDatabaseStmtContext differentiates from other interfaces.
-	IsDropDatabaseStmtContext()
+	// IsShowSchemasStmtContext differentiates from other interfaces.
+	IsShowSchemasStmtContext()
 }
 
-type DropDatabaseStmtContext struct {
+type ShowSchemasStmtContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyDropDatabaseStmtContext() *DropDatabaseStmtContext {
-	var p = new(DropDatabaseStmtContext)
+func NewEmptyShowSchemasStmtContext() *ShowSchemasStmtContext {
+	var p = new(ShowSchemasStmtContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_dropDatabaseStmt
+	p.RuleIndex = SQLParserRULE_showSchemasStmt
 	return p
 }
 
-func InitEmptyDropDatabaseStmtContext(p *DropDatabaseStmtContext) {
+func InitEmptyShowSchemasStmtContext(p *ShowSchemasStmtContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_dropDatabaseStmt
+	p.RuleIndex = SQLParserRULE_showSchemasStmt
 }
 
-func (*DropDatabaseStmtContext) IsDropDatabaseStmtContext() {}
+func (*ShowSchemasStmtContext) IsShowSchemasStmtContext() {}
 
-func NewDropDatabaseStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DropDatabaseStmtContext {
-	var p = new(DropDatabaseStmtContext)
+func NewShowSchemasStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowSchemasStmtContext {
+	var p = new(ShowSchemasStmtContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_dropDatabaseStmt
+	p.RuleIndex = SQLParserRULE_showSchemasStmt
 
 	return p
 }
 
-func (s *DropDatabaseStmtContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *DropDatabaseStmtContext) T_DROP() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_DROP, 0)
-}
+func (s *ShowSchemasStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *DropDatabaseStmtContext) T_DATASBAE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_DATASBAE, 0)
+func (s *ShowSchemasStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
 }
 
-func (s *DropDatabaseStmtContext) DatabaseName() IDatabaseNameContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IDatabaseNameContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IDatabaseNameContext)
+func (s *ShowSchemasStmtContext) T_SCHEMAS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SCHEMAS, 0)
 }
 
-func (s *DropDatabaseStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *ShowSchemasStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *DropDatabaseStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ShowSchemasStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *DropDatabaseStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ShowSchemasStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterDropDatabaseStmt(s)
+		listenerT.EnterShowSchemasStmt(s)
 	}
 }
 
-func (s *DropDatabaseStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ShowSchemasStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitDropDatabaseStmt(s)
+		listenerT.ExitShowSchemasStmt(s)
 	}
 }
 
-func (s *DropDatabaseStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ShowSchemasStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitDropDatabaseStmt(s)
+		return t.VisitShowSchemasStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) DropDatabaseStmt() (localctx IDropDatabaseStmtContext) {
-	localctx = NewDropDatabaseStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 50, SQLParserRULE_dropDatabaseStmt)
+func (p *SQLParser) ShowSchemasStmt() (localctx IShowSchemasStmtContext) {
+	localctx = NewShowSchemasStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 50, SQLParserRULE_showSchemasStmt)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(361)
-		p.Match(SQLParserT_DROP)
+		p.SetState(407)
+		p.Match(SQLParserT_SHOW)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
 	{
-		p.SetState(362)
-		p.Match(SQLParserT_DATASBAE)
+		p.SetState(408)
+		p.Match(SQLParserT_SCHEMAS)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	{
-		p.SetState(363)
-		p.DatabaseName()
-	}
 
 errorExit:
 	if p.HasError() {
@@ -5481,111 +5869,167 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IShowDatabaseStmtContext is an interface to support dynamic dispatch.
-type IShowDatabaseStmtContext interface {
+// ICreateDatabaseStmtContext is an interface to support dynamic dispatch.
+type ICreateDatabaseStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	T_SHOW() antlr.TerminalNode
-	T_DATASBAES() antlr.TerminalNode
+	T_CREATE() antlr.TerminalNode
+	T_DATASBAE() antlr.TerminalNode
+	Json() IJsonContext
+	OptionClause() IOptionClauseContext
 
-	// IsShowDatabaseStmtContext differentiates from other interfaces.
-	IsShowDatabaseStmtContext()
+	// IsCreateDatabaseStmtContext differentiates from other interfaces.
+	IsCreateDatabaseStmtContext()
 }
 
-type ShowDatabaseStmtContext struct {
+type CreateDatabaseStmtContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowDatabaseStmtContext() *ShowDatabaseStmtContext {
-	var p = new(ShowDatabaseStmtContext)
+func NewEmptyCreateDatabaseStmtContext() *CreateDatabaseStmtContext {
+	var p = new(CreateDatabaseStmtContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_showDatabaseStmt
+	p.RuleIndex = SQLParserRULE_createDatabaseStmt
 	return p
 }
 
-func InitEmptyShowDatabaseStmtContext(p *ShowDatabaseStmtContext) {
+func InitEmptyCreateDatabaseStmtContext(p *CreateDatabaseStmtContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_showDatabaseStmt
+	p.RuleIndex = SQLParserRULE_createDatabaseStmt
 }
 
-func (*ShowDatabaseStmtContext) IsShowDatabaseStmtContext() {}
+func (*CreateDatabaseStmtContext) IsCreateDatabaseStmtContext() {}
 
-func NewShowDatabaseStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowDatabaseStmtContext {
-	var p = new(ShowDatabaseStmtContext)
+func NewCreateDatabaseStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CreateDatabaseStmtContext {
+	var p = new(CreateDatabaseStmtContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showDatabaseStmt
+	p.RuleIndex = SQLParserRULE_createDatabaseStmt
 
 	return p
 }
 
-func (s *ShowDatabaseStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *CreateDatabaseStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowDatabaseStmtContext) T_SHOW() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SHOW, 0)
+func (s *CreateDatabaseStmtContext) T_CREATE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CREATE, 0)
 }
 
-func (s *ShowDatabaseStmtContext) T_DATASBAES() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_DATASBAES, 0)
+func (s *CreateDatabaseStmtContext) T_DATASBAE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DATASBAE, 0)
 }
 
-func (s *ShowDatabaseStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *CreateDatabaseStmtContext) Json() IJsonContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IJsonContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IJsonContext)
+}
+
+func (s *CreateDatabaseStmtContext) OptionClause() IOptionClauseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IOptionClauseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IOptionClauseContext)
+}
+
+func (s *CreateDatabaseStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowDatabaseStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *CreateDatabaseStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowDatabaseStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *CreateDatabaseStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowDatabaseStmt(s)
+		listenerT.EnterCreateDatabaseStmt(s)
 	}
 }
 
-func (s *ShowDatabaseStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *CreateDatabaseStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowDatabaseStmt(s)
+		listenerT.ExitCreateDatabaseStmt(s)
 	}
 }
 
-func (s *ShowDatabaseStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *CreateDatabaseStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowDatabaseStmt(s)
+		return t.VisitCreateDatabaseStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowDatabaseStmt() (localctx IShowDatabaseStmtContext) {
-	localctx = NewShowDatabaseStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 52, SQLParserRULE_showDatabaseStmt)
+func (p *SQLParser) CreateDatabaseStmt() (localctx ICreateDatabaseStmtContext) {
+	localctx = NewCreateDatabaseStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 52, SQLParserRULE_createDatabaseStmt)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(365)
-		p.Match(SQLParserT_SHOW)
+		p.SetState(410)
+		p.Match(SQLParserT_CREATE)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
 	{
-		p.SetState(366)
-		p.Match(SQLParserT_DATASBAES)
+		p.SetState(411)
+		p.Match(SQLParserT_DATASBAE)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
+	p.SetState(414)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 3, p.GetParserRuleContext()) {
+	case 1:
+		{
+			p.SetState(412)
+			p.Json()
+		}
+
+	case 2:
+		{
+			p.SetState(413)
+			p.OptionClause()
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
+	}
 
 errorExit:
 	if p.HasError() {
@@ -5600,98 +6044,66 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IShowNameSpacesStmtContext is an interface to support dynamic dispatch.
-type IShowNameSpacesStmtContext interface {
+// IDropDatabaseStmtContext is an interface to support dynamic dispatch.
+type IDropDatabaseStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	T_SHOW() antlr.TerminalNode
-	T_NAMESPACES() antlr.TerminalNode
-	T_WHERE() antlr.TerminalNode
-	T_NAMESPACE() antlr.TerminalNode
-	T_EQUAL() antlr.TerminalNode
-	Prefix() IPrefixContext
-	LimitClause() ILimitClauseContext
+	T_DROP() antlr.TerminalNode
+	T_DATASBAE() antlr.TerminalNode
+	DatabaseName() IDatabaseNameContext
 
-	// IsShowNameSpacesStmtContext differentiates from other interfaces.
-	IsShowNameSpacesStmtContext()
+	// IsDropDatabaseStmtContext differentiates from other interfaces.
+	IsDropDatabaseStmtContext()
 }
 
-type ShowNameSpacesStmtContext struct {
+type DropDatabaseStmtContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowNameSpacesStmtContext() *ShowNameSpacesStmtContext {
-	var p = new(ShowNameSpacesStmtContext)
+func NewEmptyDropDatabaseStmtContext() *DropDatabaseStmtContext {
+	var p = new(DropDatabaseStmtContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_showNameSpacesStmt
+	p.RuleIndex = SQLParserRULE_dropDatabaseStmt
 	return p
 }
 
-func InitEmptyShowNameSpacesStmtContext(p *ShowNameSpacesStmtContext) {
+func InitEmptyDropDatabaseStmtContext(p *DropDatabaseStmtContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_showNameSpacesStmt
+	p.RuleIndex = SQLParserRULE_dropDatabaseStmt
 }
 
-func (*ShowNameSpacesStmtContext) IsShowNameSpacesStmtContext() {}
+func (*DropDatabaseStmtContext) IsDropDatabaseStmtContext() {}
 
-func NewShowNameSpacesStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowNameSpacesStmtContext {
-	var p = new(ShowNameSpacesStmtContext)
+func NewDropDatabaseStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DropDatabaseStmtContext {
+	var p = new(DropDatabaseStmtContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showNameSpacesStmt
+	p.RuleIndex = SQLParserRULE_dropDatabaseStmt
 
 	return p
 }
 
-func (s *ShowNameSpacesStmtContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ShowNameSpacesStmtContext) T_SHOW() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SHOW, 0)
-}
-
-func (s *ShowNameSpacesStmtContext) T_NAMESPACES() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_NAMESPACES, 0)
-}
-
-func (s *ShowNameSpacesStmtContext) T_WHERE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_WHERE, 0)
-}
-
-func (s *ShowNameSpacesStmtContext) T_NAMESPACE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_NAMESPACE, 0)
-}
+func (s *DropDatabaseStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowNameSpacesStmtContext) T_EQUAL() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_EQUAL, 0)
+func (s *DropDatabaseStmtContext) T_DROP() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DROP, 0)
 }
 
-func (s *ShowNameSpacesStmtContext) Prefix() IPrefixContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IPrefixContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IPrefixContext)
+func (s *DropDatabaseStmtContext) T_DATASBAE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DATASBAE, 0)
 }
 
-func (s *ShowNameSpacesStmtContext) LimitClause() ILimitClauseContext {
+func (s *DropDatabaseStmtContext) DatabaseName() IDatabaseNameContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ILimitClauseContext); ok {
+		if _, ok := ctx.(IDatabaseNameContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -5701,112 +6113,181 @@ func (s *ShowNameSpacesStmtContext) LimitClause() ILimitClauseContext {
 		return nil
 	}
 
-	return t.(ILimitClauseContext)
+	return t.(IDatabaseNameContext)
 }
 
-func (s *ShowNameSpacesStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *DropDatabaseStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowNameSpacesStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *DropDatabaseStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowNameSpacesStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *DropDatabaseStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowNameSpacesStmt(s)
+		listenerT.EnterDropDatabaseStmt(s)
 	}
 }
 
-func (s *ShowNameSpacesStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *DropDatabaseStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowNameSpacesStmt(s)
+		listenerT.ExitDropDatabaseStmt(s)
 	}
 }
 
-func (s *ShowNameSpacesStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *DropDatabaseStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowNameSpacesStmt(s)
+		return t.VisitDropDatabaseStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowNameSpacesStmt() (localctx IShowNameSpacesStmtContext) {
-	localctx = NewShowNameSpacesStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 54, SQLParserRULE_showNameSpacesStmt)
-	var _la int
-
+func (p *SQLParser) DropDatabaseStmt() (localctx IDropDatabaseStmtContext) {
+	localctx = NewDropDatabaseStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 54, SQLParserRULE_dropDatabaseStmt)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(368)
-		p.Match(SQLParserT_SHOW)
+		p.SetState(416)
+		p.Match(SQLParserT_DROP)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
 	{
-		p.SetState(369)
-		p.Match(SQLParserT_NAMESPACES)
-		if p.HasError() {
+		p.SetState(417)
+		p.Match(SQLParserT_DATASBAE)
+		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(374)
-	p.GetErrorHandler().Sync(p)
+	{
+		p.SetState(418)
+		p.DatabaseName()
+	}
+
+errorExit:
 	if p.HasError() {
-		goto errorExit
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
 	}
-	_la = p.GetTokenStream().LA(1)
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
 
-	if _la == SQLParserT_WHERE {
-		{
-			p.SetState(370)
-			p.Match(SQLParserT_WHERE)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(371)
-			p.Match(SQLParserT_NAMESPACE)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(372)
-			p.Match(SQLParserT_EQUAL)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(373)
-			p.Prefix()
-		}
+// IShowDatabaseStmtContext is an interface to support dynamic dispatch.
+type IShowDatabaseStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	T_SHOW() antlr.TerminalNode
+	T_DATASBAES() antlr.TerminalNode
+
+	// IsShowDatabaseStmtContext differentiates from other interfaces.
+	IsShowDatabaseStmtContext()
+}
+
+type ShowDatabaseStmtContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
 
+func NewEmptyShowDatabaseStmtContext() *ShowDatabaseStmtContext {
+	var p = new(ShowDatabaseStmtContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SQLParserRULE_showDatabaseStmt
+	return p
+}
+
+func InitEmptyShowDatabaseStmtContext(p *ShowDatabaseStmtContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SQLParserRULE_showDatabaseStmt
+}
+
+func (*ShowDatabaseStmtContext) IsShowDatabaseStmtContext() {}
+
+func NewShowDatabaseStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowDatabaseStmtContext {
+	var p = new(ShowDatabaseStmtContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showDatabaseStmt
+
+	return p
+}
+
+func (s *ShowDatabaseStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowDatabaseStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowDatabaseStmtContext) T_DATASBAES() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DATASBAES, 0)
+}
+
+func (s *ShowDatabaseStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowDatabaseStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowDatabaseStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowDatabaseStmt(s)
 	}
-	p.SetState(377)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
+}
+
+func (s *ShowDatabaseStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowDatabaseStmt(s)
 	}
-	_la = p.GetTokenStream().LA(1)
+}
 
-	if _la == SQLParserT_LIMIT {
-		{
-			p.SetState(376)
-			p.LimitClause()
-		}
+func (s *ShowDatabaseStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowDatabaseStmt(s)
 
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowDatabaseStmt() (localctx IShowDatabaseStmtContext) {
+	localctx = NewShowDatabaseStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 56, SQLParserRULE_showDatabaseStmt)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(420)
+		p.Match(SQLParserT_SHOW)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(421)
+		p.Match(SQLParserT_DATASBAES)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
 	}
 
 errorExit:
@@ -5822,8 +6303,8 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IShowMetricsStmtContext is an interface to support dynamic dispatch.
-type IShowMetricsStmtContext interface {
+// IShowNameSpacesStmtContext is an interface to support dynamic dispatch.
+type IShowNameSpacesStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
@@ -5831,92 +6312,70 @@ type IShowMetricsStmtContext interface {
 
 	// Getter signatures
 	T_SHOW() antlr.TerminalNode
-	T_METRICS() antlr.TerminalNode
-	T_ON() antlr.TerminalNode
-	Namespace() INamespaceContext
+	T_NAMESPACES() antlr.TerminalNode
 	T_WHERE() antlr.TerminalNode
-	T_METRIC() antlr.TerminalNode
+	T_NAMESPACE() antlr.TerminalNode
 	T_EQUAL() antlr.TerminalNode
 	Prefix() IPrefixContext
 	LimitClause() ILimitClauseContext
 
-	// IsShowMetricsStmtContext differentiates from other interfaces.
-	IsShowMetricsStmtContext()
+	// IsShowNameSpacesStmtContext differentiates from other interfaces.
+	IsShowNameSpacesStmtContext()
 }
 
-type ShowMetricsStmtContext struct {
+type ShowNameSpacesStmtContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowMetricsStmtContext() *ShowMetricsStmtContext {
-	var p = new(ShowMetricsStmtContext)
+func NewEmptyShowNameSpacesStmtContext() *ShowNameSpacesStmtContext {
+	var p = new(ShowNameSpacesStmtContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_showMetricsStmt
+	p.RuleIndex = SQLParserRULE_showNameSpacesStmt
 	return p
 }
 
-func InitEmptyShowMetricsStmtContext(p *ShowMetricsStmtContext) {
+func InitEmptyShowNameSpacesStmtContext(p *ShowNameSpacesStmtContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_showMetricsStmt
+	p.RuleIndex = SQLParserRULE_showNameSpacesStmt
 }
 
-func (*ShowMetricsStmtContext) IsShowMetricsStmtContext() {}
+func (*ShowNameSpacesStmtContext) IsShowNameSpacesStmtContext() {}
 
-func NewShowMetricsStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowMetricsStmtContext {
-	var p = new(ShowMetricsStmtContext)
+func NewShowNameSpacesStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowNameSpacesStmtContext {
+	var p = new(ShowNameSpacesStmtContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showMetricsStmt
+	p.RuleIndex = SQLParserRULE_showNameSpacesStmt
 
 	return p
 }
 
-func (s *ShowMetricsStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *ShowNameSpacesStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowMetricsStmtContext) T_SHOW() antlr.TerminalNode {
+func (s *ShowNameSpacesStmtContext) T_SHOW() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_SHOW, 0)
 }
 
-func (s *ShowMetricsStmtContext) T_METRICS() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_METRICS, 0)
-}
-
-func (s *ShowMetricsStmtContext) T_ON() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_ON, 0)
-}
-
-func (s *ShowMetricsStmtContext) Namespace() INamespaceContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(INamespaceContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(INamespaceContext)
+func (s *ShowNameSpacesStmtContext) T_NAMESPACES() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_NAMESPACES, 0)
 }
 
-func (s *ShowMetricsStmtContext) T_WHERE() antlr.TerminalNode {
+func (s *ShowNameSpacesStmtContext) T_WHERE() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_WHERE, 0)
 }
 
-func (s *ShowMetricsStmtContext) T_METRIC() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_METRIC, 0)
+func (s *ShowNameSpacesStmtContext) T_NAMESPACE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_NAMESPACE, 0)
 }
 
-func (s *ShowMetricsStmtContext) T_EQUAL() antlr.TerminalNode {
+func (s *ShowNameSpacesStmtContext) T_EQUAL() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_EQUAL, 0)
 }
 
-func (s *ShowMetricsStmtContext) Prefix() IPrefixContext {
+func (s *ShowNameSpacesStmtContext) Prefix() IPrefixContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(IPrefixContext); ok {
@@ -5932,7 +6391,7 @@ func (s *ShowMetricsStmtContext) Prefix() IPrefixContext {
 	return t.(IPrefixContext)
 }
 
-func (s *ShowMetricsStmtContext) LimitClause() ILimitClauseContext {
+func (s *ShowNameSpacesStmtContext) LimitClause() ILimitClauseContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(ILimitClauseContext); ok {
@@ -5948,44 +6407,44 @@ func (s *ShowMetricsStmtContext) LimitClause() ILimitClauseContext {
 	return t.(ILimitClauseContext)
 }
 
-func (s *ShowMetricsStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *ShowNameSpacesStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowMetricsStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ShowNameSpacesStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowMetricsStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ShowNameSpacesStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowMetricsStmt(s)
+		listenerT.EnterShowNameSpacesStmt(s)
 	}
 }
 
-func (s *ShowMetricsStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ShowNameSpacesStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowMetricsStmt(s)
+		listenerT.ExitShowNameSpacesStmt(s)
 	}
 }
 
-func (s *ShowMetricsStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ShowNameSpacesStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowMetricsStmt(s)
+		return t.VisitShowNameSpacesStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowMetricsStmt() (localctx IShowMetricsStmtContext) {
-	localctx = NewShowMetricsStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 56, SQLParserRULE_showMetricsStmt)
+func (p *SQLParser) ShowNameSpacesStmt() (localctx IShowNameSpacesStmtContext) {
+	localctx = NewShowNameSpacesStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 58, SQLParserRULE_showNameSpacesStmt)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(379)
+		p.SetState(423)
 		p.Match(SQLParserT_SHOW)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -5993,36 +6452,280 @@ func (p *SQLParser) ShowMetricsStmt() (localctx IShowMetricsStmtContext) {
 		}
 	}
 	{
-		p.SetState(380)
-		p.Match(SQLParserT_METRICS)
+		p.SetState(424)
+		p.Match(SQLParserT_NAMESPACES)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(383)
+	p.SetState(429)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	if _la == SQLParserT_ON {
+	if _la == SQLParserT_WHERE {
 		{
-			p.SetState(381)
-			p.Match(SQLParserT_ON)
+			p.SetState(425)
+			p.Match(SQLParserT_WHERE)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
 		{
-			p.SetState(382)
-			p.Namespace()
-		}
-
+			p.SetState(426)
+			p.Match(SQLParserT_NAMESPACE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(427)
+			p.Match(SQLParserT_EQUAL)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(428)
+			p.Prefix()
+		}
+
+	}
+	p.SetState(432)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_LIMIT {
+		{
+			p.SetState(431)
+			p.LimitClause()
+		}
+
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IShowMetricsStmtContext is an interface to support dynamic dispatch.
+type IShowMetricsStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	T_SHOW() antlr.TerminalNode
+	T_METRICS() antlr.TerminalNode
+	T_ON() antlr.TerminalNode
+	Namespace() INamespaceContext
+	T_WHERE() antlr.TerminalNode
+	T_METRIC() antlr.TerminalNode
+	T_EQUAL() antlr.TerminalNode
+	Prefix() IPrefixContext
+	LimitClause() ILimitClauseContext
+
+	// IsShowMetricsStmtContext differentiates from other interfaces.
+	IsShowMetricsStmtContext()
+}
+
+type ShowMetricsStmtContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowMetricsStmtContext() *ShowMetricsStmtContext {
+	var p = new(ShowMetricsStmtContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SQLParserRULE_showMetricsStmt
+	return p
+}
+
+func InitEmptyShowMetricsStmtContext(p *ShowMetricsStmtContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SQLParserRULE_showMetricsStmt
+}
+
+func (*ShowMetricsStmtContext) IsShowMetricsStmtContext() {}
+
+func NewShowMetricsStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowMetricsStmtContext {
+	var p = new(ShowMetricsStmtContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showMetricsStmt
+
+	return p
+}
+
+func (s *ShowMetricsStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowMetricsStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowMetricsStmtContext) T_METRICS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_METRICS, 0)
+}
+
+func (s *ShowMetricsStmtContext) T_ON() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ON, 0)
+}
+
+func (s *ShowMetricsStmtContext) Namespace() INamespaceContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INamespaceContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INamespaceContext)
+}
+
+func (s *ShowMetricsStmtContext) T_WHERE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WHERE, 0)
+}
+
+func (s *ShowMetricsStmtContext) T_METRIC() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_METRIC, 0)
+}
+
+func (s *ShowMetricsStmtContext) T_EQUAL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_EQUAL, 0)
+}
+
+func (s *ShowMetricsStmtContext) Prefix() IPrefixContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IPrefixContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPrefixContext)
+}
+
+func (s *ShowMetricsStmtContext) LimitClause() ILimitClauseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ILimitClauseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ILimitClauseContext)
+}
+
+func (s *ShowMetricsStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowMetricsStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ShowMetricsStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowMetricsStmt(s)
+	}
+}
+
+func (s *ShowMetricsStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowMetricsStmt(s)
+	}
+}
+
+func (s *ShowMetricsStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowMetricsStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ShowMetricsStmt() (localctx IShowMetricsStmtContext) {
+	localctx = NewShowMetricsStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 60, SQLParserRULE_showMetricsStmt)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(434)
+		p.Match(SQLParserT_SHOW)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(435)
+		p.Match(SQLParserT_METRICS)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(438)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
 	}
-	p.SetState(389)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_ON {
+		{
+			p.SetState(436)
+			p.Match(SQLParserT_ON)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(437)
+			p.Namespace()
+		}
+
+	}
+	p.SetState(444)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -6031,7 +6734,7 @@ func (p *SQLParser) ShowMetricsStmt() (localctx IShowMetricsStmtContext) {
 
 	if _la == SQLParserT_WHERE {
 		{
-			p.SetState(385)
+			p.SetState(440)
 			p.Match(SQLParserT_WHERE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -6039,7 +6742,7 @@ func (p *SQLParser) ShowMetricsStmt() (localctx IShowMetricsStmtContext) {
 			}
 		}
 		{
-			p.SetState(386)
+			p.SetState(441)
 			p.Match(SQLParserT_METRIC)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -6047,7 +6750,7 @@ func (p *SQLParser) ShowMetricsStmt() (localctx IShowMetricsStmtContext) {
 			}
 		}
 		{
-			p.SetState(387)
+			p.SetState(442)
 			p.Match(SQLParserT_EQUAL)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -6055,12 +6758,12 @@ func (p *SQLParser) ShowMetricsStmt() (localctx IShowMetricsStmtContext) {
 			}
 		}
 		{
-			p.SetState(388)
+			p.SetState(443)
 			p.Prefix()
 		}
 
 	}
-	p.SetState(392)
+	p.SetState(447)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -6069,7 +6772,7 @@ func (p *SQLParser) ShowMetricsStmt() (localctx IShowMetricsStmtContext) {
 
 	if _la == SQLParserT_LIMIT {
 		{
-			p.SetState(391)
+			p.SetState(446)
 			p.LimitClause()
 		}
 
@@ -6192,10 +6895,10 @@ func (s *ShowFieldsStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface
 
 func (p *SQLParser) ShowFieldsStmt() (localctx IShowFieldsStmtContext) {
 	localctx = NewShowFieldsStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 58, SQLParserRULE_showFieldsStmt)
+	p.EnterRule(localctx, 62, SQLParserRULE_showFieldsStmt)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(394)
+		p.SetState(449)
 		p.Match(SQLParserT_SHOW)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -6203,7 +6906,7 @@ func (p *SQLParser) ShowFieldsStmt() (localctx IShowFieldsStmtContext) {
 		}
 	}
 	{
-		p.SetState(395)
+		p.SetState(450)
 		p.Match(SQLParserT_FIELDS)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -6211,7 +6914,7 @@ func (p *SQLParser) ShowFieldsStmt() (localctx IShowFieldsStmtContext) {
 		}
 	}
 	{
-		p.SetState(396)
+		p.SetState(451)
 		p.FromClause()
 	}
 
@@ -6337,10 +7040,10 @@ func (s *ShowTagKeysStmtContext) Accept(visitor antlr.ParseTreeVisitor) interfac
 
 func (p *SQLParser) ShowTagKeysStmt() (localctx IShowTagKeysStmtContext) {
 	localctx = NewShowTagKeysStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 60, SQLParserRULE_showTagKeysStmt)
+	p.EnterRule(localctx, 64, SQLParserRULE_showTagKeysStmt)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(398)
+		p.SetState(453)
 		p.Match(SQLParserT_SHOW)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -6348,7 +7051,7 @@ func (p *SQLParser) ShowTagKeysStmt() (localctx IShowTagKeysStmtContext) {
 		}
 	}
 	{
-		p.SetState(399)
+		p.SetState(454)
 		p.Match(SQLParserT_TAG)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -6356,7 +7059,7 @@ func (p *SQLParser) ShowTagKeysStmt() (localctx IShowTagKeysStmtContext) {
 		}
 	}
 	{
-		p.SetState(400)
+		p.SetState(455)
 		p.Match(SQLParserT_KEYS)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -6364,7 +7067,7 @@ func (p *SQLParser) ShowTagKeysStmt() (localctx IShowTagKeysStmtContext) {
 		}
 	}
 	{
-		p.SetState(401)
+		p.SetState(456)
 		p.FromClause()
 	}
 
@@ -6381,8 +7084,8 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IShowTagValuesStmtContext is an interface to support dynamic dispatch.
-type IShowTagValuesStmtContext interface {
+// IShowContinuousQueriesStmtContext is an interface to support dynamic dispatch.
+type IShowContinuousQueriesStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
@@ -6390,178 +7093,93 @@ type IShowTagValuesStmtContext interface {
 
 	// Getter signatures
 	T_SHOW() antlr.TerminalNode
-	T_TAG() antlr.TerminalNode
-	T_VALUES() antlr.TerminalNode
-	FromClause() IFromClauseContext
-	T_WITH() antlr.TerminalNode
-	T_KEY() antlr.TerminalNode
-	T_EQUAL() antlr.TerminalNode
-	WithTagKey() IWithTagKeyContext
-	WhereClause() IWhereClauseContext
-	LimitClause() ILimitClauseContext
+	T_CONTINUOUS() antlr.TerminalNode
+	T_QUERIES() antlr.TerminalNode
 
-	// IsShowTagValuesStmtContext differentiates from other interfaces.
-	IsShowTagValuesStmtContext()
+	// IsShowContinuousQueriesStmtContext differentiates from other interfaces.
+	IsShowContinuousQueriesStmtContext()
 }
 
-type ShowTagValuesStmtContext struct {
+type ShowContinuousQueriesStmtContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowTagValuesStmtContext() *ShowTagValuesStmtContext {
-	var p = new(ShowTagValuesStmtContext)
+func NewEmptyShowContinuousQueriesStmtContext() *ShowContinuousQueriesStmtContext {
+	var p = new(ShowContinuousQueriesStmtContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_showTagValuesStmt
+	p.RuleIndex = SQLParserRULE_showContinuousQueriesStmt
 	return p
 }
 
-func InitEmptyShowTagValuesStmtContext(p *ShowTagValuesStmtContext) {
+func InitEmptyShowContinuousQueriesStmtContext(p *ShowContinuousQueriesStmtContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_showTagValuesStmt
+	p.RuleIndex = SQLParserRULE_showContinuousQueriesStmt
 }
 
-func (*ShowTagValuesStmtContext) IsShowTagValuesStmtContext() {}
+func (*ShowContinuousQueriesStmtContext) IsShowContinuousQueriesStmtContext() {}
 
-func NewShowTagValuesStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowTagValuesStmtContext {
-	var p = new(ShowTagValuesStmtContext)
+func NewShowContinuousQueriesStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowContinuousQueriesStmtContext {
+	var p = new(ShowContinuousQueriesStmtContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showTagValuesStmt
+	p.RuleIndex = SQLParserRULE_showContinuousQueriesStmt
 
 	return p
 }
 
-func (s *ShowTagValuesStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *ShowContinuousQueriesStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowTagValuesStmtContext) T_SHOW() antlr.TerminalNode {
+func (s *ShowContinuousQueriesStmtContext) T_SHOW() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_SHOW, 0)
 }
 
-func (s *ShowTagValuesStmtContext) T_TAG() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_TAG, 0)
-}
-
-func (s *ShowTagValuesStmtContext) T_VALUES() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_VALUES, 0)
-}
-
-func (s *ShowTagValuesStmtContext) FromClause() IFromClauseContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFromClauseContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IFromClauseContext)
-}
-
-func (s *ShowTagValuesStmtContext) T_WITH() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_WITH, 0)
-}
-
-func (s *ShowTagValuesStmtContext) T_KEY() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_KEY, 0)
-}
-
-func (s *ShowTagValuesStmtContext) T_EQUAL() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_EQUAL, 0)
-}
-
-func (s *ShowTagValuesStmtContext) WithTagKey() IWithTagKeyContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IWithTagKeyContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IWithTagKeyContext)
-}
-
-func (s *ShowTagValuesStmtContext) WhereClause() IWhereClauseContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IWhereClauseContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IWhereClauseContext)
+func (s *ShowContinuousQueriesStmtContext) T_CONTINUOUS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CONTINUOUS, 0)
 }
 
-func (s *ShowTagValuesStmtContext) LimitClause() ILimitClauseContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ILimitClauseContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(ILimitClauseContext)
+func (s *ShowContinuousQueriesStmtContext) T_QUERIES() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_QUERIES, 0)
 }
 
-func (s *ShowTagValuesStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *ShowContinuousQueriesStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowTagValuesStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ShowContinuousQueriesStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShowTagValuesStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ShowContinuousQueriesStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowTagValuesStmt(s)
+		listenerT.EnterShowContinuousQueriesStmt(s)
 	}
 }
 
-func (s *ShowTagValuesStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ShowContinuousQueriesStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowTagValuesStmt(s)
+		listenerT.ExitShowContinuousQueriesStmt(s)
 	}
 }
 
-func (s *ShowTagValuesStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ShowContinuousQueriesStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowTagValuesStmt(s)
+		return t.VisitShowContinuousQueriesStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ShowTagValuesStmt() (localctx IShowTagValuesStmtContext) {
-	localctx = NewShowTagValuesStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 62, SQLParserRULE_showTagValuesStmt)
-	var _la int
-
+func (p *SQLParser) ShowContinuousQueriesStmt() (localctx IShowContinuousQueriesStmtContext) {
+	localctx = NewShowContinuousQueriesStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 66, SQLParserRULE_showContinuousQueriesStmt)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(403)
+		p.SetState(458)
 		p.Match(SQLParserT_SHOW)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -6569,81 +7187,21 @@ func (p *SQLParser) ShowTagValuesStmt() (localctx IShowTagValuesStmtContext) {
 		}
 	}
 	{
-		p.SetState(404)
-		p.Match(SQLParserT_TAG)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(405)
-		p.Match(SQLParserT_VALUES)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(406)
-		p.FromClause()
-	}
-	{
-		p.SetState(407)
-		p.Match(SQLParserT_WITH)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(408)
-		p.Match(SQLParserT_KEY)
+		p.SetState(459)
+		p.Match(SQLParserT_CONTINUOUS)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
 	{
-		p.SetState(409)
-		p.Match(SQLParserT_EQUAL)
+		p.SetState(460)
+		p.Match(SQLParserT_QUERIES)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	{
-		p.SetState(410)
-		p.WithTagKey()
-	}
-	p.SetState(412)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == SQLParserT_WHERE {
-		{
-			p.SetState(411)
-			p.WhereClause()
-		}
-
-	}
-	p.SetState(415)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == SQLParserT_LIMIT {
-		{
-			p.SetState(414)
-			p.LimitClause()
-		}
-
-	}
 
 errorExit:
 	if p.HasError() {
@@ -6658,105 +7216,110 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IPrefixContext is an interface to support dynamic dispatch.
-type IPrefixContext interface {
+// IShowAlertsStmtContext is an interface to support dynamic dispatch.
+type IShowAlertsStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	Ident() IIdentContext
+	T_SHOW() antlr.TerminalNode
+	T_ALERTS() antlr.TerminalNode
 
-	// IsPrefixContext differentiates from other interfaces.
-	IsPrefixContext()
+	// IsShowAlertsStmtContext differentiates from other interfaces.
+	IsShowAlertsStmtContext()
 }
 
-type PrefixContext struct {
+type ShowAlertsStmtContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyPrefixContext() *PrefixContext {
-	var p = new(PrefixContext)
+func NewEmptyShowAlertsStmtContext() *ShowAlertsStmtContext {
+	var p = new(ShowAlertsStmtContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_prefix
+	p.RuleIndex = SQLParserRULE_showAlertsStmt
 	return p
 }
 
-func InitEmptyPrefixContext(p *PrefixContext) {
+func InitEmptyShowAlertsStmtContext(p *ShowAlertsStmtContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_prefix
+	p.RuleIndex = SQLParserRULE_showAlertsStmt
 }
 
-func (*PrefixContext) IsPrefixContext() {}
+func (*ShowAlertsStmtContext) IsShowAlertsStmtContext() {}
 
-func NewPrefixContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PrefixContext {
-	var p = new(PrefixContext)
+func NewShowAlertsStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowAlertsStmtContext {
+	var p = new(ShowAlertsStmtContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_prefix
+	p.RuleIndex = SQLParserRULE_showAlertsStmt
 
 	return p
 }
 
-func (s *PrefixContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *PrefixContext) Ident() IIdentContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIdentContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
+func (s *ShowAlertsStmtContext) GetParser() antlr.Parser { return s.parser }
 
-	if t == nil {
-		return nil
-	}
+func (s *ShowAlertsStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
 
-	return t.(IIdentContext)
+func (s *ShowAlertsStmtContext) T_ALERTS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ALERTS, 0)
 }
 
-func (s *PrefixContext) GetRuleContext() antlr.RuleContext {
+func (s *ShowAlertsStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *PrefixContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ShowAlertsStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *PrefixContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ShowAlertsStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterPrefix(s)
+		listenerT.EnterShowAlertsStmt(s)
 	}
 }
 
-func (s *PrefixContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ShowAlertsStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitPrefix(s)
+		listenerT.ExitShowAlertsStmt(s)
 	}
 }
 
-func (s *PrefixContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ShowAlertsStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitPrefix(s)
+		return t.VisitShowAlertsStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) Prefix() (localctx IPrefixContext) {
-	localctx = NewPrefixContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 64, SQLParserRULE_prefix)
+func (p *SQLParser) ShowAlertsStmt() (localctx IShowAlertsStmtContext) {
+	localctx = NewShowAlertsStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 68, SQLParserRULE_showAlertsStmt)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(417)
-		p.Ident()
+		p.SetState(462)
+		p.Match(SQLParserT_SHOW)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(463)
+		p.Match(SQLParserT_ALERTS)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
 	}
 
 errorExit:
@@ -6772,105 +7335,246 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IWithTagKeyContext is an interface to support dynamic dispatch.
-type IWithTagKeyContext interface {
+// ICreateContinuousQueryStmtContext is an interface to support dynamic dispatch.
+type ICreateContinuousQueryStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	Ident() IIdentContext
+	T_CREATE() antlr.TerminalNode
+	T_CONTINUOUS() antlr.TerminalNode
+	T_QUERY() antlr.TerminalNode
+	CqName() ICqNameContext
+	T_EVERY() antlr.TerminalNode
+	DurationLit() IDurationLitContext
+	T_INTO() antlr.TerminalNode
+	MetricName() IMetricNameContext
+	T_AS() antlr.TerminalNode
+	QueryStmt() IQueryStmtContext
 
-	// IsWithTagKeyContext differentiates from other interfaces.
-	IsWithTagKeyContext()
+	// IsCreateContinuousQueryStmtContext differentiates from other interfaces.
+	IsCreateContinuousQueryStmtContext()
 }
 
-type WithTagKeyContext struct {
+type CreateContinuousQueryStmtContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyWithTagKeyContext() *WithTagKeyContext {
-	var p = new(WithTagKeyContext)
+func NewEmptyCreateContinuousQueryStmtContext() *CreateContinuousQueryStmtContext {
+	var p = new(CreateContinuousQueryStmtContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_withTagKey
+	p.RuleIndex = SQLParserRULE_createContinuousQueryStmt
 	return p
 }
 
-func InitEmptyWithTagKeyContext(p *WithTagKeyContext) {
+func InitEmptyCreateContinuousQueryStmtContext(p *CreateContinuousQueryStmtContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_withTagKey
+	p.RuleIndex = SQLParserRULE_createContinuousQueryStmt
 }
 
-func (*WithTagKeyContext) IsWithTagKeyContext() {}
+func (*CreateContinuousQueryStmtContext) IsCreateContinuousQueryStmtContext() {}
 
-func NewWithTagKeyContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *WithTagKeyContext {
-	var p = new(WithTagKeyContext)
+func NewCreateContinuousQueryStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CreateContinuousQueryStmtContext {
+	var p = new(CreateContinuousQueryStmtContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_withTagKey
+	p.RuleIndex = SQLParserRULE_createContinuousQueryStmt
 
 	return p
 }
 
-func (s *WithTagKeyContext) GetParser() antlr.Parser { return s.parser }
+func (s *CreateContinuousQueryStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *WithTagKeyContext) Ident() IIdentContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIdentContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
+func (s *CreateContinuousQueryStmtContext) T_CREATE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CREATE, 0)
+}
+
+func (s *CreateContinuousQueryStmtContext) T_CONTINUOUS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CONTINUOUS, 0)
+}
+
+func (s *CreateContinuousQueryStmtContext) T_QUERY() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_QUERY, 0)
+}
+
+func (s *CreateContinuousQueryStmtContext) CqName() ICqNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICqNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
 	}
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IIdentContext)
+	return t.(ICqNameContext)
 }
 
-func (s *WithTagKeyContext) GetRuleContext() antlr.RuleContext {
+func (s *CreateContinuousQueryStmtContext) T_EVERY() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_EVERY, 0)
+}
+
+func (s *CreateContinuousQueryStmtContext) DurationLit() IDurationLitContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDurationLitContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDurationLitContext)
+}
+
+func (s *CreateContinuousQueryStmtContext) T_INTO() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_INTO, 0)
+}
+
+func (s *CreateContinuousQueryStmtContext) MetricName() IMetricNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IMetricNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMetricNameContext)
+}
+
+func (s *CreateContinuousQueryStmtContext) T_AS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_AS, 0)
+}
+
+func (s *CreateContinuousQueryStmtContext) QueryStmt() IQueryStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IQueryStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IQueryStmtContext)
+}
+
+func (s *CreateContinuousQueryStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *WithTagKeyContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *CreateContinuousQueryStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *WithTagKeyContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *CreateContinuousQueryStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterWithTagKey(s)
+		listenerT.EnterCreateContinuousQueryStmt(s)
 	}
 }
 
-func (s *WithTagKeyContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *CreateContinuousQueryStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitWithTagKey(s)
+		listenerT.ExitCreateContinuousQueryStmt(s)
 	}
 }
 
-func (s *WithTagKeyContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *CreateContinuousQueryStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitWithTagKey(s)
+		return t.VisitCreateContinuousQueryStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) WithTagKey() (localctx IWithTagKeyContext) {
-	localctx = NewWithTagKeyContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 66, SQLParserRULE_withTagKey)
+func (p *SQLParser) CreateContinuousQueryStmt() (localctx ICreateContinuousQueryStmtContext) {
+	localctx = NewCreateContinuousQueryStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 70, SQLParserRULE_createContinuousQueryStmt)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(419)
-		p.Ident()
+		p.SetState(465)
+		p.Match(SQLParserT_CREATE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(466)
+		p.Match(SQLParserT_CONTINUOUS)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(467)
+		p.Match(SQLParserT_QUERY)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(468)
+		p.CqName()
+	}
+	{
+		p.SetState(469)
+		p.Match(SQLParserT_EVERY)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(470)
+		p.DurationLit()
+	}
+	{
+		p.SetState(471)
+		p.Match(SQLParserT_INTO)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(472)
+		p.MetricName()
+	}
+	{
+		p.SetState(473)
+		p.Match(SQLParserT_AS)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(474)
+		p.QueryStmt()
 	}
 
 errorExit:
@@ -6886,56 +7590,71 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// INamespaceContext is an interface to support dynamic dispatch.
-type INamespaceContext interface {
+// IDropContinuousQueryStmtContext is an interface to support dynamic dispatch.
+type IDropContinuousQueryStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	Ident() IIdentContext
+	T_DROP() antlr.TerminalNode
+	T_CONTINUOUS() antlr.TerminalNode
+	T_QUERY() antlr.TerminalNode
+	CqName() ICqNameContext
 
-	// IsNamespaceContext differentiates from other interfaces.
-	IsNamespaceContext()
+	// IsDropContinuousQueryStmtContext differentiates from other interfaces.
+	IsDropContinuousQueryStmtContext()
 }
 
-type NamespaceContext struct {
+type DropContinuousQueryStmtContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyNamespaceContext() *NamespaceContext {
-	var p = new(NamespaceContext)
+func NewEmptyDropContinuousQueryStmtContext() *DropContinuousQueryStmtContext {
+	var p = new(DropContinuousQueryStmtContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_namespace
+	p.RuleIndex = SQLParserRULE_dropContinuousQueryStmt
 	return p
 }
 
-func InitEmptyNamespaceContext(p *NamespaceContext) {
+func InitEmptyDropContinuousQueryStmtContext(p *DropContinuousQueryStmtContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_namespace
+	p.RuleIndex = SQLParserRULE_dropContinuousQueryStmt
 }
 
-func (*NamespaceContext) IsNamespaceContext() {}
+func (*DropContinuousQueryStmtContext) IsDropContinuousQueryStmtContext() {}
 
-func NewNamespaceContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NamespaceContext {
-	var p = new(NamespaceContext)
+func NewDropContinuousQueryStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DropContinuousQueryStmtContext {
+	var p = new(DropContinuousQueryStmtContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_namespace
+	p.RuleIndex = SQLParserRULE_dropContinuousQueryStmt
 
 	return p
 }
 
-func (s *NamespaceContext) GetParser() antlr.Parser { return s.parser }
+func (s *DropContinuousQueryStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *NamespaceContext) Ident() IIdentContext {
+func (s *DropContinuousQueryStmtContext) T_DROP() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DROP, 0)
+}
+
+func (s *DropContinuousQueryStmtContext) T_CONTINUOUS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CONTINUOUS, 0)
+}
+
+func (s *DropContinuousQueryStmtContext) T_QUERY() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_QUERY, 0)
+}
+
+func (s *DropContinuousQueryStmtContext) CqName() ICqNameContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIdentContext); ok {
+		if _, ok := ctx.(ICqNameContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -6945,46 +7664,70 @@ func (s *NamespaceContext) Ident() IIdentContext {
 		return nil
 	}
 
-	return t.(IIdentContext)
+	return t.(ICqNameContext)
 }
 
-func (s *NamespaceContext) GetRuleContext() antlr.RuleContext {
+func (s *DropContinuousQueryStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *NamespaceContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *DropContinuousQueryStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *NamespaceContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *DropContinuousQueryStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterNamespace(s)
+		listenerT.EnterDropContinuousQueryStmt(s)
 	}
 }
 
-func (s *NamespaceContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *DropContinuousQueryStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitNamespace(s)
+		listenerT.ExitDropContinuousQueryStmt(s)
 	}
 }
 
-func (s *NamespaceContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *DropContinuousQueryStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitNamespace(s)
+		return t.VisitDropContinuousQueryStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) Namespace() (localctx INamespaceContext) {
-	localctx = NewNamespaceContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 68, SQLParserRULE_namespace)
+func (p *SQLParser) DropContinuousQueryStmt() (localctx IDropContinuousQueryStmtContext) {
+	localctx = NewDropContinuousQueryStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 72, SQLParserRULE_dropContinuousQueryStmt)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(421)
-		p.Ident()
+		p.SetState(476)
+		p.Match(SQLParserT_DROP)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(477)
+		p.Match(SQLParserT_CONTINUOUS)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(478)
+		p.Match(SQLParserT_QUERY)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(479)
+		p.CqName()
 	}
 
 errorExit:
@@ -7000,8 +7743,8 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IDatabaseNameContext is an interface to support dynamic dispatch.
-type IDatabaseNameContext interface {
+// ICqNameContext is an interface to support dynamic dispatch.
+type ICqNameContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
@@ -7010,43 +7753,43 @@ type IDatabaseNameContext interface {
 	// Getter signatures
 	Ident() IIdentContext
 
-	// IsDatabaseNameContext differentiates from other interfaces.
-	IsDatabaseNameContext()
+	// IsCqNameContext differentiates from other interfaces.
+	IsCqNameContext()
 }
 
-type DatabaseNameContext struct {
+type CqNameContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyDatabaseNameContext() *DatabaseNameContext {
-	var p = new(DatabaseNameContext)
+func NewEmptyCqNameContext() *CqNameContext {
+	var p = new(CqNameContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_databaseName
+	p.RuleIndex = SQLParserRULE_cqName
 	return p
 }
 
-func InitEmptyDatabaseNameContext(p *DatabaseNameContext) {
+func InitEmptyCqNameContext(p *CqNameContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_databaseName
+	p.RuleIndex = SQLParserRULE_cqName
 }
 
-func (*DatabaseNameContext) IsDatabaseNameContext() {}
+func (*CqNameContext) IsCqNameContext() {}
 
-func NewDatabaseNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DatabaseNameContext {
-	var p = new(DatabaseNameContext)
+func NewCqNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CqNameContext {
+	var p = new(CqNameContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_databaseName
+	p.RuleIndex = SQLParserRULE_cqName
 
 	return p
 }
 
-func (s *DatabaseNameContext) GetParser() antlr.Parser { return s.parser }
+func (s *CqNameContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *DatabaseNameContext) Ident() IIdentContext {
+func (s *CqNameContext) Ident() IIdentContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(IIdentContext); ok {
@@ -7062,42 +7805,42 @@ func (s *DatabaseNameContext) Ident() IIdentContext {
 	return t.(IIdentContext)
 }
 
-func (s *DatabaseNameContext) GetRuleContext() antlr.RuleContext {
+func (s *CqNameContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *DatabaseNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *CqNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *DatabaseNameContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *CqNameContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterDatabaseName(s)
+		listenerT.EnterCqName(s)
 	}
 }
 
-func (s *DatabaseNameContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *CqNameContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitDatabaseName(s)
+		listenerT.ExitCqName(s)
 	}
 }
 
-func (s *DatabaseNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *CqNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitDatabaseName(s)
+		return t.VisitCqName(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) DatabaseName() (localctx IDatabaseNameContext) {
-	localctx = NewDatabaseNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 70, SQLParserRULE_databaseName)
+func (p *SQLParser) CqName() (localctx ICqNameContext) {
+	localctx = NewCqNameContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 74, SQLParserRULE_cqName)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(423)
+		p.SetState(481)
 		p.Ident()
 	}
 
@@ -7114,57 +7857,78 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IStorageNameContext is an interface to support dynamic dispatch.
-type IStorageNameContext interface {
+// IShowTagValuesStmtContext is an interface to support dynamic dispatch.
+type IShowTagValuesStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	Ident() IIdentContext
+	T_SHOW() antlr.TerminalNode
+	T_TAG() antlr.TerminalNode
+	T_VALUES() antlr.TerminalNode
+	FromClause() IFromClauseContext
+	T_WITH() antlr.TerminalNode
+	T_KEY() antlr.TerminalNode
+	T_EQUAL() antlr.TerminalNode
+	WithTagKey() IWithTagKeyContext
+	WhereClause() IWhereClauseContext
+	LimitClause() ILimitClauseContext
 
-	// IsStorageNameContext differentiates from other interfaces.
-	IsStorageNameContext()
+	// IsShowTagValuesStmtContext differentiates from other interfaces.
+	IsShowTagValuesStmtContext()
 }
 
-type StorageNameContext struct {
+type ShowTagValuesStmtContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyStorageNameContext() *StorageNameContext {
-	var p = new(StorageNameContext)
+func NewEmptyShowTagValuesStmtContext() *ShowTagValuesStmtContext {
+	var p = new(ShowTagValuesStmtContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_storageName
+	p.RuleIndex = SQLParserRULE_showTagValuesStmt
 	return p
 }
 
-func InitEmptyStorageNameContext(p *StorageNameContext) {
+func InitEmptyShowTagValuesStmtContext(p *ShowTagValuesStmtContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_storageName
+	p.RuleIndex = SQLParserRULE_showTagValuesStmt
 }
 
-func (*StorageNameContext) IsStorageNameContext() {}
+func (*ShowTagValuesStmtContext) IsShowTagValuesStmtContext() {}
 
-func NewStorageNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StorageNameContext {
-	var p = new(StorageNameContext)
+func NewShowTagValuesStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowTagValuesStmtContext {
+	var p = new(ShowTagValuesStmtContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_storageName
+	p.RuleIndex = SQLParserRULE_showTagValuesStmt
 
 	return p
 }
 
-func (s *StorageNameContext) GetParser() antlr.Parser { return s.parser }
+func (s *ShowTagValuesStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *StorageNameContext) Ident() IIdentContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIdentContext); ok {
-			t = ctx.(antlr.RuleContext)
+func (s *ShowTagValuesStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowTagValuesStmtContext) T_TAG() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TAG, 0)
+}
+
+func (s *ShowTagValuesStmtContext) T_VALUES() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_VALUES, 0)
+}
+
+func (s *ShowTagValuesStmtContext) FromClause() IFromClauseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFromClauseContext); ok {
+			t = ctx.(antlr.RuleContext)
 			break
 		}
 	}
@@ -7173,46 +7937,188 @@ func (s *StorageNameContext) Ident() IIdentContext {
 		return nil
 	}
 
-	return t.(IIdentContext)
+	return t.(IFromClauseContext)
 }
 
-func (s *StorageNameContext) GetRuleContext() antlr.RuleContext {
+func (s *ShowTagValuesStmtContext) T_WITH() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WITH, 0)
+}
+
+func (s *ShowTagValuesStmtContext) T_KEY() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_KEY, 0)
+}
+
+func (s *ShowTagValuesStmtContext) T_EQUAL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_EQUAL, 0)
+}
+
+func (s *ShowTagValuesStmtContext) WithTagKey() IWithTagKeyContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IWithTagKeyContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IWithTagKeyContext)
+}
+
+func (s *ShowTagValuesStmtContext) WhereClause() IWhereClauseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IWhereClauseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IWhereClauseContext)
+}
+
+func (s *ShowTagValuesStmtContext) LimitClause() ILimitClauseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ILimitClauseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ILimitClauseContext)
+}
+
+func (s *ShowTagValuesStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *StorageNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ShowTagValuesStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *StorageNameContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ShowTagValuesStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterStorageName(s)
+		listenerT.EnterShowTagValuesStmt(s)
 	}
 }
 
-func (s *StorageNameContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ShowTagValuesStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitStorageName(s)
+		listenerT.ExitShowTagValuesStmt(s)
 	}
 }
 
-func (s *StorageNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ShowTagValuesStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitStorageName(s)
+		return t.VisitShowTagValuesStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) StorageName() (localctx IStorageNameContext) {
-	localctx = NewStorageNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 72, SQLParserRULE_storageName)
+func (p *SQLParser) ShowTagValuesStmt() (localctx IShowTagValuesStmtContext) {
+	localctx = NewShowTagValuesStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 76, SQLParserRULE_showTagValuesStmt)
+	var _la int
+
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(425)
-		p.Ident()
+		p.SetState(483)
+		p.Match(SQLParserT_SHOW)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(484)
+		p.Match(SQLParserT_TAG)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(485)
+		p.Match(SQLParserT_VALUES)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(486)
+		p.FromClause()
+	}
+	{
+		p.SetState(487)
+		p.Match(SQLParserT_WITH)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(488)
+		p.Match(SQLParserT_KEY)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(489)
+		p.Match(SQLParserT_EQUAL)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(490)
+		p.WithTagKey()
+	}
+	p.SetState(492)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_WHERE {
+		{
+			p.SetState(491)
+			p.WhereClause()
+		}
+
+	}
+	p.SetState(495)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_LIMIT {
+		{
+			p.SetState(494)
+			p.LimitClause()
+		}
+
 	}
 
 errorExit:
@@ -7228,8 +8134,8 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IRequestIDContext is an interface to support dynamic dispatch.
-type IRequestIDContext interface {
+// IPrefixContext is an interface to support dynamic dispatch.
+type IPrefixContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
@@ -7238,43 +8144,43 @@ type IRequestIDContext interface {
 	// Getter signatures
 	Ident() IIdentContext
 
-	// IsRequestIDContext differentiates from other interfaces.
-	IsRequestIDContext()
+	// IsPrefixContext differentiates from other interfaces.
+	IsPrefixContext()
 }
 
-type RequestIDContext struct {
+type PrefixContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyRequestIDContext() *RequestIDContext {
-	var p = new(RequestIDContext)
+func NewEmptyPrefixContext() *PrefixContext {
+	var p = new(PrefixContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_requestID
+	p.RuleIndex = SQLParserRULE_prefix
 	return p
 }
 
-func InitEmptyRequestIDContext(p *RequestIDContext) {
+func InitEmptyPrefixContext(p *PrefixContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_requestID
+	p.RuleIndex = SQLParserRULE_prefix
 }
 
-func (*RequestIDContext) IsRequestIDContext() {}
+func (*PrefixContext) IsPrefixContext() {}
 
-func NewRequestIDContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RequestIDContext {
-	var p = new(RequestIDContext)
+func NewPrefixContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PrefixContext {
+	var p = new(PrefixContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_requestID
+	p.RuleIndex = SQLParserRULE_prefix
 
 	return p
 }
 
-func (s *RequestIDContext) GetParser() antlr.Parser { return s.parser }
+func (s *PrefixContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *RequestIDContext) Ident() IIdentContext {
+func (s *PrefixContext) Ident() IIdentContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(IIdentContext); ok {
@@ -7290,42 +8196,42 @@ func (s *RequestIDContext) Ident() IIdentContext {
 	return t.(IIdentContext)
 }
 
-func (s *RequestIDContext) GetRuleContext() antlr.RuleContext {
+func (s *PrefixContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *RequestIDContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *PrefixContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *RequestIDContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *PrefixContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterRequestID(s)
+		listenerT.EnterPrefix(s)
 	}
 }
 
-func (s *RequestIDContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *PrefixContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitRequestID(s)
+		listenerT.ExitPrefix(s)
 	}
 }
 
-func (s *RequestIDContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *PrefixContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitRequestID(s)
+		return t.VisitPrefix(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) RequestID() (localctx IRequestIDContext) {
-	localctx = NewRequestIDContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 74, SQLParserRULE_requestID)
+func (p *SQLParser) Prefix() (localctx IPrefixContext) {
+	localctx = NewPrefixContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 78, SQLParserRULE_prefix)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(427)
+		p.SetState(497)
 		p.Ident()
 	}
 
@@ -7342,107 +8248,105 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ISourceContext is an interface to support dynamic dispatch.
-type ISourceContext interface {
+// IWithTagKeyContext is an interface to support dynamic dispatch.
+type IWithTagKeyContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	T_STATE_MACHINE() antlr.TerminalNode
-	T_STATE_REPO() antlr.TerminalNode
+	Ident() IIdentContext
 
-	// IsSourceContext differentiates from other interfaces.
-	IsSourceContext()
+	// IsWithTagKeyContext differentiates from other interfaces.
+	IsWithTagKeyContext()
 }
 
-type SourceContext struct {
+type WithTagKeyContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptySourceContext() *SourceContext {
-	var p = new(SourceContext)
+func NewEmptyWithTagKeyContext() *WithTagKeyContext {
+	var p = new(WithTagKeyContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_source
+	p.RuleIndex = SQLParserRULE_withTagKey
 	return p
 }
 
-func InitEmptySourceContext(p *SourceContext) {
+func InitEmptyWithTagKeyContext(p *WithTagKeyContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_source
+	p.RuleIndex = SQLParserRULE_withTagKey
 }
 
-func (*SourceContext) IsSourceContext() {}
+func (*WithTagKeyContext) IsWithTagKeyContext() {}
 
-func NewSourceContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SourceContext {
-	var p = new(SourceContext)
+func NewWithTagKeyContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *WithTagKeyContext {
+	var p = new(WithTagKeyContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_source
+	p.RuleIndex = SQLParserRULE_withTagKey
 
 	return p
 }
 
-func (s *SourceContext) GetParser() antlr.Parser { return s.parser }
+func (s *WithTagKeyContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *SourceContext) T_STATE_MACHINE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_STATE_MACHINE, 0)
-}
+func (s *WithTagKeyContext) Ident() IIdentContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-func (s *SourceContext) T_STATE_REPO() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_STATE_REPO, 0)
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentContext)
 }
 
-func (s *SourceContext) GetRuleContext() antlr.RuleContext {
+func (s *WithTagKeyContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *SourceContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *WithTagKeyContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *SourceContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *WithTagKeyContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterSource(s)
+		listenerT.EnterWithTagKey(s)
 	}
 }
 
-func (s *SourceContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *WithTagKeyContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitSource(s)
+		listenerT.ExitWithTagKey(s)
 	}
 }
 
-func (s *SourceContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *WithTagKeyContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitSource(s)
+		return t.VisitWithTagKey(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) Source() (localctx ISourceContext) {
-	localctx = NewSourceContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 76, SQLParserRULE_source)
-	var _la int
-
+func (p *SQLParser) WithTagKey() (localctx IWithTagKeyContext) {
+	localctx = NewWithTagKeyContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 80, SQLParserRULE_withTagKey)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(429)
-		_la = p.GetTokenStream().LA(1)
-
-		if !(_la == SQLParserT_STATE_REPO || _la == SQLParserT_STATE_MACHINE) {
-			p.GetErrorHandler().RecoverInline(p)
-		} else {
-			p.GetErrorHandler().ReportMatch(p)
-			p.Consume()
-		}
+		p.SetState(499)
+		p.Ident()
 	}
 
 errorExit:
@@ -7458,67 +8362,56 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IOptionClauseContext is an interface to support dynamic dispatch.
-type IOptionClauseContext interface {
+// INamespaceContext is an interface to support dynamic dispatch.
+type INamespaceContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	DatabaseName() IDatabaseNameContext
-	T_WITH() antlr.TerminalNode
-	AllT_OPEN_P() []antlr.TerminalNode
-	T_OPEN_P(i int) antlr.TerminalNode
-	OptionPairs() IOptionPairsContext
-	AllT_CLOSE_P() []antlr.TerminalNode
-	T_CLOSE_P(i int) antlr.TerminalNode
-	T_ROLLUP() antlr.TerminalNode
-	AllClosedOptionPairs() []IClosedOptionPairsContext
-	ClosedOptionPairs(i int) IClosedOptionPairsContext
-	AllT_COMMA() []antlr.TerminalNode
-	T_COMMA(i int) antlr.TerminalNode
+	Ident() IIdentContext
 
-	// IsOptionClauseContext differentiates from other interfaces.
-	IsOptionClauseContext()
+	// IsNamespaceContext differentiates from other interfaces.
+	IsNamespaceContext()
 }
 
-type OptionClauseContext struct {
+type NamespaceContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyOptionClauseContext() *OptionClauseContext {
-	var p = new(OptionClauseContext)
+func NewEmptyNamespaceContext() *NamespaceContext {
+	var p = new(NamespaceContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_optionClause
+	p.RuleIndex = SQLParserRULE_namespace
 	return p
 }
 
-func InitEmptyOptionClauseContext(p *OptionClauseContext) {
+func InitEmptyNamespaceContext(p *NamespaceContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_optionClause
+	p.RuleIndex = SQLParserRULE_namespace
 }
 
-func (*OptionClauseContext) IsOptionClauseContext() {}
+func (*NamespaceContext) IsNamespaceContext() {}
 
-func NewOptionClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *OptionClauseContext {
-	var p = new(OptionClauseContext)
+func NewNamespaceContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NamespaceContext {
+	var p = new(NamespaceContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_optionClause
+	p.RuleIndex = SQLParserRULE_namespace
 
 	return p
 }
 
-func (s *OptionClauseContext) GetParser() antlr.Parser { return s.parser }
+func (s *NamespaceContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *OptionClauseContext) DatabaseName() IDatabaseNameContext {
+func (s *NamespaceContext) Ident() IIdentContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IDatabaseNameContext); ok {
+		if _, ok := ctx.(IIdentContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -7528,80 +8421,113 @@ func (s *OptionClauseContext) DatabaseName() IDatabaseNameContext {
 		return nil
 	}
 
-	return t.(IDatabaseNameContext)
+	return t.(IIdentContext)
 }
 
-func (s *OptionClauseContext) T_WITH() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_WITH, 0)
+func (s *NamespaceContext) GetRuleContext() antlr.RuleContext {
+	return s
 }
 
-func (s *OptionClauseContext) AllT_OPEN_P() []antlr.TerminalNode {
-	return s.GetTokens(SQLParserT_OPEN_P)
+func (s *NamespaceContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *OptionClauseContext) T_OPEN_P(i int) antlr.TerminalNode {
-	return s.GetToken(SQLParserT_OPEN_P, i)
+func (s *NamespaceContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterNamespace(s)
+	}
 }
 
-func (s *OptionClauseContext) OptionPairs() IOptionPairsContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IOptionPairsContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
+func (s *NamespaceContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitNamespace(s)
 	}
+}
 
-	if t == nil {
-		return nil
+func (s *NamespaceContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitNamespace(s)
+
+	default:
+		return t.VisitChildren(s)
 	}
+}
 
-	return t.(IOptionPairsContext)
+func (p *SQLParser) Namespace() (localctx INamespaceContext) {
+	localctx = NewNamespaceContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 82, SQLParserRULE_namespace)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(501)
+		p.Ident()
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-func (s *OptionClauseContext) AllT_CLOSE_P() []antlr.TerminalNode {
-	return s.GetTokens(SQLParserT_CLOSE_P)
+// IDatabaseNameContext is an interface to support dynamic dispatch.
+type IDatabaseNameContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	Ident() IIdentContext
+
+	// IsDatabaseNameContext differentiates from other interfaces.
+	IsDatabaseNameContext()
 }
 
-func (s *OptionClauseContext) T_CLOSE_P(i int) antlr.TerminalNode {
-	return s.GetToken(SQLParserT_CLOSE_P, i)
+type DatabaseNameContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
 }
 
-func (s *OptionClauseContext) T_ROLLUP() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_ROLLUP, 0)
+func NewEmptyDatabaseNameContext() *DatabaseNameContext {
+	var p = new(DatabaseNameContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SQLParserRULE_databaseName
+	return p
 }
 
-func (s *OptionClauseContext) AllClosedOptionPairs() []IClosedOptionPairsContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IClosedOptionPairsContext); ok {
-			len++
-		}
-	}
+func InitEmptyDatabaseNameContext(p *DatabaseNameContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SQLParserRULE_databaseName
+}
 
-	tst := make([]IClosedOptionPairsContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IClosedOptionPairsContext); ok {
-			tst[i] = t.(IClosedOptionPairsContext)
-			i++
-		}
-	}
+func (*DatabaseNameContext) IsDatabaseNameContext() {}
 
-	return tst
+func NewDatabaseNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DatabaseNameContext {
+	var p = new(DatabaseNameContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_databaseName
+
+	return p
 }
 
-func (s *OptionClauseContext) ClosedOptionPairs(i int) IClosedOptionPairsContext {
+func (s *DatabaseNameContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *DatabaseNameContext) Ident() IIdentContext {
 	var t antlr.RuleContext
-	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IClosedOptionPairsContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
+		if _, ok := ctx.(IIdentContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
@@ -7609,140 +8535,46 @@ func (s *OptionClauseContext) ClosedOptionPairs(i int) IClosedOptionPairsContext
 		return nil
 	}
 
-	return t.(IClosedOptionPairsContext)
-}
-
-func (s *OptionClauseContext) AllT_COMMA() []antlr.TerminalNode {
-	return s.GetTokens(SQLParserT_COMMA)
-}
-
-func (s *OptionClauseContext) T_COMMA(i int) antlr.TerminalNode {
-	return s.GetToken(SQLParserT_COMMA, i)
+	return t.(IIdentContext)
 }
 
-func (s *OptionClauseContext) GetRuleContext() antlr.RuleContext {
+func (s *DatabaseNameContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *OptionClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *DatabaseNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *OptionClauseContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *DatabaseNameContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterOptionClause(s)
+		listenerT.EnterDatabaseName(s)
 	}
 }
 
-func (s *OptionClauseContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *DatabaseNameContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitOptionClause(s)
+		listenerT.ExitDatabaseName(s)
 	}
 }
 
-func (s *OptionClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *DatabaseNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitOptionClause(s)
+		return t.VisitDatabaseName(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) OptionClause() (localctx IOptionClauseContext) {
-	localctx = NewOptionClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 78, SQLParserRULE_optionClause)
-	var _la int
-
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(431)
-		p.DatabaseName()
-	}
-	{
-		p.SetState(432)
-		p.Match(SQLParserT_WITH)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(433)
-		p.Match(SQLParserT_OPEN_P)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(434)
-		p.OptionPairs()
-	}
-	{
-		p.SetState(435)
-		p.Match(SQLParserT_CLOSE_P)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(436)
-		p.Match(SQLParserT_ROLLUP)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(437)
-		p.Match(SQLParserT_OPEN_P)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(438)
-		p.ClosedOptionPairs()
-	}
-	p.SetState(443)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
-
-	for _la == SQLParserT_COMMA {
-		{
-			p.SetState(439)
-			p.Match(SQLParserT_COMMA)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(440)
-			p.ClosedOptionPairs()
-		}
-
-		p.SetState(445)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_la = p.GetTokenStream().LA(1)
-	}
+func (p *SQLParser) DatabaseName() (localctx IDatabaseNameContext) {
+	localctx = NewDatabaseNameContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 84, SQLParserRULE_databaseName)
+	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(446)
-		p.Match(SQLParserT_CLOSE_P)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
+		p.SetState(503)
+		p.Ident()
 	}
 
 errorExit:
@@ -7758,86 +8590,58 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IOptionPairsContext is an interface to support dynamic dispatch.
-type IOptionPairsContext interface {
+// IStorageNameContext is an interface to support dynamic dispatch.
+type IStorageNameContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	AllOptionPair() []IOptionPairContext
-	OptionPair(i int) IOptionPairContext
-	AllT_COMMA() []antlr.TerminalNode
-	T_COMMA(i int) antlr.TerminalNode
+	Ident() IIdentContext
 
-	// IsOptionPairsContext differentiates from other interfaces.
-	IsOptionPairsContext()
+	// IsStorageNameContext differentiates from other interfaces.
+	IsStorageNameContext()
 }
 
-type OptionPairsContext struct {
+type StorageNameContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyOptionPairsContext() *OptionPairsContext {
-	var p = new(OptionPairsContext)
+func NewEmptyStorageNameContext() *StorageNameContext {
+	var p = new(StorageNameContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_optionPairs
+	p.RuleIndex = SQLParserRULE_storageName
 	return p
 }
 
-func InitEmptyOptionPairsContext(p *OptionPairsContext) {
+func InitEmptyStorageNameContext(p *StorageNameContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_optionPairs
+	p.RuleIndex = SQLParserRULE_storageName
 }
 
-func (*OptionPairsContext) IsOptionPairsContext() {}
+func (*StorageNameContext) IsStorageNameContext() {}
 
-func NewOptionPairsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *OptionPairsContext {
-	var p = new(OptionPairsContext)
+func NewStorageNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StorageNameContext {
+	var p = new(StorageNameContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_optionPairs
+	p.RuleIndex = SQLParserRULE_storageName
 
 	return p
 }
 
-func (s *OptionPairsContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *OptionPairsContext) AllOptionPair() []IOptionPairContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IOptionPairContext); ok {
-			len++
-		}
-	}
-
-	tst := make([]IOptionPairContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IOptionPairContext); ok {
-			tst[i] = t.(IOptionPairContext)
-			i++
-		}
-	}
-
-	return tst
-}
+func (s *StorageNameContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *OptionPairsContext) OptionPair(i int) IOptionPairContext {
+func (s *StorageNameContext) Ident() IIdentContext {
 	var t antlr.RuleContext
-	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IOptionPairContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
+		if _, ok := ctx.(IIdentContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
@@ -7845,84 +8649,46 @@ func (s *OptionPairsContext) OptionPair(i int) IOptionPairContext {
 		return nil
 	}
 
-	return t.(IOptionPairContext)
-}
-
-func (s *OptionPairsContext) AllT_COMMA() []antlr.TerminalNode {
-	return s.GetTokens(SQLParserT_COMMA)
-}
-
-func (s *OptionPairsContext) T_COMMA(i int) antlr.TerminalNode {
-	return s.GetToken(SQLParserT_COMMA, i)
+	return t.(IIdentContext)
 }
 
-func (s *OptionPairsContext) GetRuleContext() antlr.RuleContext {
+func (s *StorageNameContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *OptionPairsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *StorageNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *OptionPairsContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *StorageNameContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterOptionPairs(s)
+		listenerT.EnterStorageName(s)
 	}
 }
 
-func (s *OptionPairsContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *StorageNameContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitOptionPairs(s)
+		listenerT.ExitStorageName(s)
 	}
 }
 
-func (s *OptionPairsContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *StorageNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitOptionPairs(s)
+		return t.VisitStorageName(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) OptionPairs() (localctx IOptionPairsContext) {
-	localctx = NewOptionPairsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 80, SQLParserRULE_optionPairs)
-	var _la int
-
+func (p *SQLParser) StorageName() (localctx IStorageNameContext) {
+	localctx = NewStorageNameContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 86, SQLParserRULE_storageName)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(448)
-		p.OptionPair()
-	}
-	p.SetState(453)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
-
-	for _la == SQLParserT_COMMA {
-		{
-			p.SetState(449)
-			p.Match(SQLParserT_COMMA)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(450)
-			p.OptionPair()
-		}
-
-		p.SetState(455)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
-		}
-		_la = p.GetTokenStream().LA(1)
+		p.SetState(505)
+		p.Ident()
 	}
 
 errorExit:
@@ -7938,62 +8704,56 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IClosedOptionPairsContext is an interface to support dynamic dispatch.
-type IClosedOptionPairsContext interface {
+// IBackupPathContext is an interface to support dynamic dispatch.
+type IBackupPathContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	T_OPEN_P() antlr.TerminalNode
-	OptionPairs() IOptionPairsContext
-	T_CLOSE_P() antlr.TerminalNode
+	Ident() IIdentContext
 
-	// IsClosedOptionPairsContext differentiates from other interfaces.
-	IsClosedOptionPairsContext()
+	// IsBackupPathContext differentiates from other interfaces.
+	IsBackupPathContext()
 }
 
-type ClosedOptionPairsContext struct {
+type BackupPathContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyClosedOptionPairsContext() *ClosedOptionPairsContext {
-	var p = new(ClosedOptionPairsContext)
+func NewEmptyBackupPathContext() *BackupPathContext {
+	var p = new(BackupPathContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_closedOptionPairs
+	p.RuleIndex = SQLParserRULE_backupPath
 	return p
 }
 
-func InitEmptyClosedOptionPairsContext(p *ClosedOptionPairsContext) {
+func InitEmptyBackupPathContext(p *BackupPathContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_closedOptionPairs
+	p.RuleIndex = SQLParserRULE_backupPath
 }
 
-func (*ClosedOptionPairsContext) IsClosedOptionPairsContext() {}
+func (*BackupPathContext) IsBackupPathContext() {}
 
-func NewClosedOptionPairsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ClosedOptionPairsContext {
-	var p = new(ClosedOptionPairsContext)
+func NewBackupPathContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *BackupPathContext {
+	var p = new(BackupPathContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_closedOptionPairs
+	p.RuleIndex = SQLParserRULE_backupPath
 
 	return p
 }
 
-func (s *ClosedOptionPairsContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ClosedOptionPairsContext) T_OPEN_P() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_OPEN_P, 0)
-}
+func (s *BackupPathContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ClosedOptionPairsContext) OptionPairs() IOptionPairsContext {
+func (s *BackupPathContext) Ident() IIdentContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IOptionPairsContext); ok {
+		if _, ok := ctx.(IIdentContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -8003,66 +8763,46 @@ func (s *ClosedOptionPairsContext) OptionPairs() IOptionPairsContext {
 		return nil
 	}
 
-	return t.(IOptionPairsContext)
-}
-
-func (s *ClosedOptionPairsContext) T_CLOSE_P() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_CLOSE_P, 0)
+	return t.(IIdentContext)
 }
 
-func (s *ClosedOptionPairsContext) GetRuleContext() antlr.RuleContext {
+func (s *BackupPathContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ClosedOptionPairsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *BackupPathContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ClosedOptionPairsContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *BackupPathContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterClosedOptionPairs(s)
+		listenerT.EnterBackupPath(s)
 	}
 }
 
-func (s *ClosedOptionPairsContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *BackupPathContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitClosedOptionPairs(s)
+		listenerT.ExitBackupPath(s)
 	}
 }
 
-func (s *ClosedOptionPairsContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *BackupPathContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitClosedOptionPairs(s)
+		return t.VisitBackupPath(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ClosedOptionPairs() (localctx IClosedOptionPairsContext) {
-	localctx = NewClosedOptionPairsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 82, SQLParserRULE_closedOptionPairs)
+func (p *SQLParser) BackupPath() (localctx IBackupPathContext) {
+	localctx = NewBackupPathContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 88, SQLParserRULE_backupPath)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(456)
-		p.Match(SQLParserT_OPEN_P)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(457)
-		p.OptionPairs()
-	}
-	{
-		p.SetState(458)
-		p.Match(SQLParserT_CLOSE_P)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
+		p.SetState(507)
+		p.Ident()
 	}
 
 errorExit:
@@ -8078,78 +8818,56 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IOptionPairContext is an interface to support dynamic dispatch.
-type IOptionPairContext interface {
+// IRequestIDContext is an interface to support dynamic dispatch.
+type IRequestIDContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	OptionKey() IOptionKeyContext
-	T_COLON() antlr.TerminalNode
-	OptionValue() IOptionValueContext
+	Ident() IIdentContext
 
-	// IsOptionPairContext differentiates from other interfaces.
-	IsOptionPairContext()
+	// IsRequestIDContext differentiates from other interfaces.
+	IsRequestIDContext()
 }
 
-type OptionPairContext struct {
+type RequestIDContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyOptionPairContext() *OptionPairContext {
-	var p = new(OptionPairContext)
+func NewEmptyRequestIDContext() *RequestIDContext {
+	var p = new(RequestIDContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_optionPair
+	p.RuleIndex = SQLParserRULE_requestID
 	return p
 }
 
-func InitEmptyOptionPairContext(p *OptionPairContext) {
+func InitEmptyRequestIDContext(p *RequestIDContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_optionPair
+	p.RuleIndex = SQLParserRULE_requestID
 }
 
-func (*OptionPairContext) IsOptionPairContext() {}
+func (*RequestIDContext) IsRequestIDContext() {}
 
-func NewOptionPairContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *OptionPairContext {
-	var p = new(OptionPairContext)
+func NewRequestIDContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RequestIDContext {
+	var p = new(RequestIDContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_optionPair
+	p.RuleIndex = SQLParserRULE_requestID
 
 	return p
 }
 
-func (s *OptionPairContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *OptionPairContext) OptionKey() IOptionKeyContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IOptionKeyContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IOptionKeyContext)
-}
-
-func (s *OptionPairContext) T_COLON() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_COLON, 0)
-}
+func (s *RequestIDContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *OptionPairContext) OptionValue() IOptionValueContext {
+func (s *RequestIDContext) Ident() IIdentContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IOptionValueContext); ok {
+		if _, ok := ctx.(IIdentContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -8159,58 +8877,46 @@ func (s *OptionPairContext) OptionValue() IOptionValueContext {
 		return nil
 	}
 
-	return t.(IOptionValueContext)
+	return t.(IIdentContext)
 }
 
-func (s *OptionPairContext) GetRuleContext() antlr.RuleContext {
+func (s *RequestIDContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *OptionPairContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *RequestIDContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *OptionPairContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *RequestIDContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterOptionPair(s)
+		listenerT.EnterRequestID(s)
 	}
 }
 
-func (s *OptionPairContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *RequestIDContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitOptionPair(s)
+		listenerT.ExitRequestID(s)
 	}
 }
 
-func (s *OptionPairContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *RequestIDContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitOptionPair(s)
+		return t.VisitRequestID(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) OptionPair() (localctx IOptionPairContext) {
-	localctx = NewOptionPairContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 84, SQLParserRULE_optionPair)
+func (p *SQLParser) RequestID() (localctx IRequestIDContext) {
+	localctx = NewRequestIDContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 90, SQLParserRULE_requestID)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(460)
-		p.OptionKey()
-	}
-	{
-		p.SetState(461)
-		p.Match(SQLParserT_COLON)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(462)
-		p.OptionValue()
+		p.SetState(509)
+		p.Ident()
 	}
 
 errorExit:
@@ -8226,132 +8932,102 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IOptionKeyContext is an interface to support dynamic dispatch.
-type IOptionKeyContext interface {
+// ISourceContext is an interface to support dynamic dispatch.
+type ISourceContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	T_STORAGE() antlr.TerminalNode
-	T_NUM_OF_SHARD() antlr.TerminalNode
-	T_REPLICA_FACTOR() antlr.TerminalNode
-	T_AUTO_CREATE_NS() antlr.TerminalNode
-	T_BEHEAD() antlr.TerminalNode
-	T_AHEAD() antlr.TerminalNode
-	T_INTERVAL() antlr.TerminalNode
-	T_RETENTION() antlr.TerminalNode
+	T_STATE_MACHINE() antlr.TerminalNode
+	T_STATE_REPO() antlr.TerminalNode
 
-	// IsOptionKeyContext differentiates from other interfaces.
-	IsOptionKeyContext()
+	// IsSourceContext differentiates from other interfaces.
+	IsSourceContext()
 }
 
-type OptionKeyContext struct {
+type SourceContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyOptionKeyContext() *OptionKeyContext {
-	var p = new(OptionKeyContext)
+func NewEmptySourceContext() *SourceContext {
+	var p = new(SourceContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_optionKey
+	p.RuleIndex = SQLParserRULE_source
 	return p
 }
 
-func InitEmptyOptionKeyContext(p *OptionKeyContext) {
+func InitEmptySourceContext(p *SourceContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_optionKey
+	p.RuleIndex = SQLParserRULE_source
 }
 
-func (*OptionKeyContext) IsOptionKeyContext() {}
+func (*SourceContext) IsSourceContext() {}
 
-func NewOptionKeyContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *OptionKeyContext {
-	var p = new(OptionKeyContext)
+func NewSourceContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SourceContext {
+	var p = new(SourceContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_optionKey
+	p.RuleIndex = SQLParserRULE_source
 
 	return p
 }
 
-func (s *OptionKeyContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *OptionKeyContext) T_STORAGE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_STORAGE, 0)
-}
-
-func (s *OptionKeyContext) T_NUM_OF_SHARD() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_NUM_OF_SHARD, 0)
-}
-
-func (s *OptionKeyContext) T_REPLICA_FACTOR() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_REPLICA_FACTOR, 0)
-}
-
-func (s *OptionKeyContext) T_AUTO_CREATE_NS() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_AUTO_CREATE_NS, 0)
-}
-
-func (s *OptionKeyContext) T_BEHEAD() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_BEHEAD, 0)
-}
-
-func (s *OptionKeyContext) T_AHEAD() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_AHEAD, 0)
-}
+func (s *SourceContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *OptionKeyContext) T_INTERVAL() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_INTERVAL, 0)
+func (s *SourceContext) T_STATE_MACHINE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_STATE_MACHINE, 0)
 }
 
-func (s *OptionKeyContext) T_RETENTION() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_RETENTION, 0)
+func (s *SourceContext) T_STATE_REPO() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_STATE_REPO, 0)
 }
 
-func (s *OptionKeyContext) GetRuleContext() antlr.RuleContext {
+func (s *SourceContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *OptionKeyContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *SourceContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *OptionKeyContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *SourceContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterOptionKey(s)
+		listenerT.EnterSource(s)
 	}
 }
 
-func (s *OptionKeyContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *SourceContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitOptionKey(s)
+		listenerT.ExitSource(s)
 	}
 }
 
-func (s *OptionKeyContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *SourceContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitOptionKey(s)
+		return t.VisitSource(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) OptionKey() (localctx IOptionKeyContext) {
-	localctx = NewOptionKeyContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 86, SQLParserRULE_optionKey)
+func (p *SQLParser) Source() (localctx ISourceContext) {
+	localctx = NewSourceContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 92, SQLParserRULE_source)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(464)
+		p.SetState(511)
 		_la = p.GetTokenStream().LA(1)
 
-		if !(_la == SQLParserT_INTERVAL || _la == SQLParserT_STORAGE || ((int64((_la-98)) & ^0x3f) == 0 && ((int64(1)<<(_la-98))&63) != 0)) {
+		if !(_la == SQLParserT_STATE_REPO || _la == SQLParserT_STATE_MACHINE) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -8372,62 +9048,67 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IOptionValueContext is an interface to support dynamic dispatch.
-type IOptionValueContext interface {
+// IOptionClauseContext is an interface to support dynamic dispatch.
+type IOptionClauseContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	STRING() antlr.TerminalNode
-	DurationLit() IDurationLitContext
-	IntNumber() IIntNumberContext
+	DatabaseName() IDatabaseNameContext
+	T_WITH() antlr.TerminalNode
+	AllT_OPEN_P() []antlr.TerminalNode
+	T_OPEN_P(i int) antlr.TerminalNode
+	OptionPairs() IOptionPairsContext
+	AllT_CLOSE_P() []antlr.TerminalNode
+	T_CLOSE_P(i int) antlr.TerminalNode
+	T_ROLLUP() antlr.TerminalNode
+	AllClosedOptionPairs() []IClosedOptionPairsContext
+	ClosedOptionPairs(i int) IClosedOptionPairsContext
+	AllT_COMMA() []antlr.TerminalNode
+	T_COMMA(i int) antlr.TerminalNode
 
-	// IsOptionValueContext differentiates from other interfaces.
-	IsOptionValueContext()
+	// IsOptionClauseContext differentiates from other interfaces.
+	IsOptionClauseContext()
 }
 
-type OptionValueContext struct {
+type OptionClauseContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyOptionValueContext() *OptionValueContext {
-	var p = new(OptionValueContext)
+func NewEmptyOptionClauseContext() *OptionClauseContext {
+	var p = new(OptionClauseContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_optionValue
+	p.RuleIndex = SQLParserRULE_optionClause
 	return p
 }
 
-func InitEmptyOptionValueContext(p *OptionValueContext) {
+func InitEmptyOptionClauseContext(p *OptionClauseContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_optionValue
+	p.RuleIndex = SQLParserRULE_optionClause
 }
 
-func (*OptionValueContext) IsOptionValueContext() {}
+func (*OptionClauseContext) IsOptionClauseContext() {}
 
-func NewOptionValueContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *OptionValueContext {
-	var p = new(OptionValueContext)
+func NewOptionClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *OptionClauseContext {
+	var p = new(OptionClauseContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_optionValue
+	p.RuleIndex = SQLParserRULE_optionClause
 
 	return p
 }
 
-func (s *OptionValueContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *OptionValueContext) STRING() antlr.TerminalNode {
-	return s.GetToken(SQLParserSTRING, 0)
-}
+func (s *OptionClauseContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *OptionValueContext) DurationLit() IDurationLitContext {
+func (s *OptionClauseContext) DatabaseName() IDatabaseNameContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IDurationLitContext); ok {
+		if _, ok := ctx.(IDatabaseNameContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -8437,13 +9118,25 @@ func (s *OptionValueContext) DurationLit() IDurationLitContext {
 		return nil
 	}
 
-	return t.(IDurationLitContext)
+	return t.(IDatabaseNameContext)
 }
 
-func (s *OptionValueContext) IntNumber() IIntNumberContext {
+func (s *OptionClauseContext) T_WITH() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WITH, 0)
+}
+
+func (s *OptionClauseContext) AllT_OPEN_P() []antlr.TerminalNode {
+	return s.GetTokens(SQLParserT_OPEN_P)
+}
+
+func (s *OptionClauseContext) T_OPEN_P(i int) antlr.TerminalNode {
+	return s.GetToken(SQLParserT_OPEN_P, i)
+}
+
+func (s *OptionClauseContext) OptionPairs() IOptionPairsContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIntNumberContext); ok {
+		if _, ok := ctx.(IOptionPairsContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -8453,98 +9146,193 @@ func (s *OptionValueContext) IntNumber() IIntNumberContext {
 		return nil
 	}
 
-	return t.(IIntNumberContext)
+	return t.(IOptionPairsContext)
 }
 
-func (s *OptionValueContext) GetRuleContext() antlr.RuleContext {
+func (s *OptionClauseContext) AllT_CLOSE_P() []antlr.TerminalNode {
+	return s.GetTokens(SQLParserT_CLOSE_P)
+}
+
+func (s *OptionClauseContext) T_CLOSE_P(i int) antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CLOSE_P, i)
+}
+
+func (s *OptionClauseContext) T_ROLLUP() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ROLLUP, 0)
+}
+
+func (s *OptionClauseContext) AllClosedOptionPairs() []IClosedOptionPairsContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IClosedOptionPairsContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IClosedOptionPairsContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IClosedOptionPairsContext); ok {
+			tst[i] = t.(IClosedOptionPairsContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *OptionClauseContext) ClosedOptionPairs(i int) IClosedOptionPairsContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IClosedOptionPairsContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IClosedOptionPairsContext)
+}
+
+func (s *OptionClauseContext) AllT_COMMA() []antlr.TerminalNode {
+	return s.GetTokens(SQLParserT_COMMA)
+}
+
+func (s *OptionClauseContext) T_COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(SQLParserT_COMMA, i)
+}
+
+func (s *OptionClauseContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *OptionValueContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *OptionClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *OptionValueContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *OptionClauseContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterOptionValue(s)
+		listenerT.EnterOptionClause(s)
 	}
 }
 
-func (s *OptionValueContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *OptionClauseContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitOptionValue(s)
+		listenerT.ExitOptionClause(s)
 	}
 }
 
-func (s *OptionValueContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *OptionClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitOptionValue(s)
+		return t.VisitOptionClause(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) OptionValue() (localctx IOptionValueContext) {
-	localctx = NewOptionValueContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 88, SQLParserRULE_optionValue)
-	p.SetState(471)
+func (p *SQLParser) OptionClause() (localctx IOptionClauseContext) {
+	localctx = NewOptionClauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 94, SQLParserRULE_optionClause)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(513)
+		p.DatabaseName()
+	}
+	{
+		p.SetState(514)
+		p.Match(SQLParserT_WITH)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(515)
+		p.Match(SQLParserT_OPEN_P)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(516)
+		p.OptionPairs()
+	}
+	{
+		p.SetState(517)
+		p.Match(SQLParserT_CLOSE_P)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(518)
+		p.Match(SQLParserT_ROLLUP)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(519)
+		p.Match(SQLParserT_OPEN_P)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(520)
+		p.ClosedOptionPairs()
+	}
+	p.SetState(525)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
+	_la = p.GetTokenStream().LA(1)
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 13, p.GetParserRuleContext()) {
-	case 1:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(466)
-			p.Match(SQLParserSTRING)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
+	for _la == SQLParserT_COMMA {
 		{
-			p.SetState(467)
-			p.Match(SQLParserT__0)
+			p.SetState(521)
+			p.Match(SQLParserT_COMMA)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-
-	case 3:
-		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(468)
-			p.Match(SQLParserT__1)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
+			p.SetState(522)
+			p.ClosedOptionPairs()
 		}
 
-	case 4:
-		p.EnterOuterAlt(localctx, 4)
-		{
-			p.SetState(469)
-			p.DurationLit()
+		p.SetState(527)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
 		}
-
-	case 5:
-		p.EnterOuterAlt(localctx, 5)
-		{
-			p.SetState(470)
-			p.IntNumber()
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(528)
+		p.Match(SQLParserT_CLOSE_P)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
-
-	case antlr.ATNInvalidAltNumber:
-		goto errorExit
 	}
 
 errorExit:
@@ -8560,116 +9348,86 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IQueryStmtContext is an interface to support dynamic dispatch.
-type IQueryStmtContext interface {
+// IOptionPairsContext is an interface to support dynamic dispatch.
+type IOptionPairsContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	SourceAndSelect() ISourceAndSelectContext
-	T_EXPLAIN() antlr.TerminalNode
-	WhereClause() IWhereClauseContext
-	GroupByClause() IGroupByClauseContext
-	OrderByClause() IOrderByClauseContext
-	LimitClause() ILimitClauseContext
-	T_WITH_VALUE() antlr.TerminalNode
+	AllOptionPair() []IOptionPairContext
+	OptionPair(i int) IOptionPairContext
+	AllT_COMMA() []antlr.TerminalNode
+	T_COMMA(i int) antlr.TerminalNode
 
-	// IsQueryStmtContext differentiates from other interfaces.
-	IsQueryStmtContext()
+	// IsOptionPairsContext differentiates from other interfaces.
+	IsOptionPairsContext()
 }
 
-type QueryStmtContext struct {
+type OptionPairsContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyQueryStmtContext() *QueryStmtContext {
-	var p = new(QueryStmtContext)
+func NewEmptyOptionPairsContext() *OptionPairsContext {
+	var p = new(OptionPairsContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_queryStmt
+	p.RuleIndex = SQLParserRULE_optionPairs
 	return p
 }
 
-func InitEmptyQueryStmtContext(p *QueryStmtContext) {
+func InitEmptyOptionPairsContext(p *OptionPairsContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_queryStmt
+	p.RuleIndex = SQLParserRULE_optionPairs
 }
 
-func (*QueryStmtContext) IsQueryStmtContext() {}
+func (*OptionPairsContext) IsOptionPairsContext() {}
 
-func NewQueryStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *QueryStmtContext {
-	var p = new(QueryStmtContext)
+func NewOptionPairsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *OptionPairsContext {
+	var p = new(OptionPairsContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_queryStmt
+	p.RuleIndex = SQLParserRULE_optionPairs
 
 	return p
 }
 
-func (s *QueryStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *OptionPairsContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *QueryStmtContext) SourceAndSelect() ISourceAndSelectContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ISourceAndSelectContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+func (s *OptionPairsContext) AllOptionPair() []IOptionPairContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IOptionPairContext); ok {
+			len++
 		}
 	}
 
-	if t == nil {
-		return nil
-	}
-
-	return t.(ISourceAndSelectContext)
-}
-
-func (s *QueryStmtContext) T_EXPLAIN() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_EXPLAIN, 0)
-}
-
-func (s *QueryStmtContext) WhereClause() IWhereClauseContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IWhereClauseContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IWhereClauseContext)
-}
-
-func (s *QueryStmtContext) GroupByClause() IGroupByClauseContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IGroupByClauseContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+	tst := make([]IOptionPairContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IOptionPairContext); ok {
+			tst[i] = t.(IOptionPairContext)
+			i++
 		}
 	}
 
-	if t == nil {
-		return nil
-	}
-
-	return t.(IGroupByClauseContext)
+	return tst
 }
 
-func (s *QueryStmtContext) OrderByClause() IOrderByClauseContext {
+func (s *OptionPairsContext) OptionPair(i int) IOptionPairContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IOrderByClauseContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		if _, ok := ctx.(IOptionPairContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -8677,160 +9435,84 @@ func (s *QueryStmtContext) OrderByClause() IOrderByClauseContext {
 		return nil
 	}
 
-	return t.(IOrderByClauseContext)
+	return t.(IOptionPairContext)
 }
 
-func (s *QueryStmtContext) LimitClause() ILimitClauseContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ILimitClauseContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(ILimitClauseContext)
+func (s *OptionPairsContext) AllT_COMMA() []antlr.TerminalNode {
+	return s.GetTokens(SQLParserT_COMMA)
 }
 
-func (s *QueryStmtContext) T_WITH_VALUE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_WITH_VALUE, 0)
+func (s *OptionPairsContext) T_COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(SQLParserT_COMMA, i)
 }
 
-func (s *QueryStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *OptionPairsContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *QueryStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *OptionPairsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *QueryStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *OptionPairsContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterQueryStmt(s)
+		listenerT.EnterOptionPairs(s)
 	}
 }
 
-func (s *QueryStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *OptionPairsContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitQueryStmt(s)
+		listenerT.ExitOptionPairs(s)
 	}
 }
 
-func (s *QueryStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *OptionPairsContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitQueryStmt(s)
+		return t.VisitOptionPairs(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) QueryStmt() (localctx IQueryStmtContext) {
-	localctx = NewQueryStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 90, SQLParserRULE_queryStmt)
+func (p *SQLParser) OptionPairs() (localctx IOptionPairsContext) {
+	localctx = NewOptionPairsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 96, SQLParserRULE_optionPairs)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(474)
+	{
+		p.SetState(530)
+		p.OptionPair()
+	}
+	p.SetState(535)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	if _la == SQLParserT_EXPLAIN {
+	for _la == SQLParserT_COMMA {
 		{
-			p.SetState(473)
-			p.Match(SQLParserT_EXPLAIN)
+			p.SetState(531)
+			p.Match(SQLParserT_COMMA)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-
-	}
-	{
-		p.SetState(476)
-		p.SourceAndSelect()
-	}
-	p.SetState(478)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == SQLParserT_WHERE {
-		{
-			p.SetState(477)
-			p.WhereClause()
-		}
-
-	}
-	p.SetState(481)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == SQLParserT_GROUP {
-		{
-			p.SetState(480)
-			p.GroupByClause()
-		}
-
-	}
-	p.SetState(484)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == SQLParserT_ORDER {
-		{
-			p.SetState(483)
-			p.OrderByClause()
-		}
-
-	}
-	p.SetState(487)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == SQLParserT_LIMIT {
 		{
-			p.SetState(486)
-			p.LimitClause()
+			p.SetState(532)
+			p.OptionPair()
 		}
 
-	}
-	p.SetState(490)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == SQLParserT_WITH_VALUE {
-		{
-			p.SetState(489)
-			p.Match(SQLParserT_WITH_VALUE)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
+		p.SetState(537)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
 		}
-
+		_la = p.GetTokenStream().LA(1)
 	}
 
 errorExit:
@@ -8846,73 +9528,62 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ISourceAndSelectContext is an interface to support dynamic dispatch.
-type ISourceAndSelectContext interface {
+// IClosedOptionPairsContext is an interface to support dynamic dispatch.
+type IClosedOptionPairsContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	SelectExpr() ISelectExprContext
-	FromClause() IFromClauseContext
+	T_OPEN_P() antlr.TerminalNode
+	OptionPairs() IOptionPairsContext
+	T_CLOSE_P() antlr.TerminalNode
 
-	// IsSourceAndSelectContext differentiates from other interfaces.
-	IsSourceAndSelectContext()
+	// IsClosedOptionPairsContext differentiates from other interfaces.
+	IsClosedOptionPairsContext()
 }
 
-type SourceAndSelectContext struct {
+type ClosedOptionPairsContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptySourceAndSelectContext() *SourceAndSelectContext {
-	var p = new(SourceAndSelectContext)
+func NewEmptyClosedOptionPairsContext() *ClosedOptionPairsContext {
+	var p = new(ClosedOptionPairsContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_sourceAndSelect
+	p.RuleIndex = SQLParserRULE_closedOptionPairs
 	return p
 }
 
-func InitEmptySourceAndSelectContext(p *SourceAndSelectContext) {
+func InitEmptyClosedOptionPairsContext(p *ClosedOptionPairsContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_sourceAndSelect
+	p.RuleIndex = SQLParserRULE_closedOptionPairs
 }
 
-func (*SourceAndSelectContext) IsSourceAndSelectContext() {}
+func (*ClosedOptionPairsContext) IsClosedOptionPairsContext() {}
 
-func NewSourceAndSelectContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SourceAndSelectContext {
-	var p = new(SourceAndSelectContext)
+func NewClosedOptionPairsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ClosedOptionPairsContext {
+	var p = new(ClosedOptionPairsContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_sourceAndSelect
+	p.RuleIndex = SQLParserRULE_closedOptionPairs
 
 	return p
 }
 
-func (s *SourceAndSelectContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *SourceAndSelectContext) SelectExpr() ISelectExprContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ISelectExprContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
+func (s *ClosedOptionPairsContext) GetParser() antlr.Parser { return s.parser }
 
-	return t.(ISelectExprContext)
+func (s *ClosedOptionPairsContext) T_OPEN_P() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_OPEN_P, 0)
 }
 
-func (s *SourceAndSelectContext) FromClause() IFromClauseContext {
+func (s *ClosedOptionPairsContext) OptionPairs() IOptionPairsContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFromClauseContext); ok {
+		if _, ok := ctx.(IOptionPairsContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -8922,74 +9593,66 @@ func (s *SourceAndSelectContext) FromClause() IFromClauseContext {
 		return nil
 	}
 
-	return t.(IFromClauseContext)
+	return t.(IOptionPairsContext)
 }
 
-func (s *SourceAndSelectContext) GetRuleContext() antlr.RuleContext {
+func (s *ClosedOptionPairsContext) T_CLOSE_P() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CLOSE_P, 0)
+}
+
+func (s *ClosedOptionPairsContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *SourceAndSelectContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ClosedOptionPairsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *SourceAndSelectContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ClosedOptionPairsContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterSourceAndSelect(s)
+		listenerT.EnterClosedOptionPairs(s)
 	}
 }
 
-func (s *SourceAndSelectContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ClosedOptionPairsContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitSourceAndSelect(s)
+		listenerT.ExitClosedOptionPairs(s)
 	}
 }
 
-func (s *SourceAndSelectContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ClosedOptionPairsContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitSourceAndSelect(s)
+		return t.VisitClosedOptionPairs(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) SourceAndSelect() (localctx ISourceAndSelectContext) {
-	localctx = NewSourceAndSelectContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 92, SQLParserRULE_sourceAndSelect)
-	p.SetState(498)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-
-	switch p.GetTokenStream().LA(1) {
-	case SQLParserT_SELECT:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(492)
-			p.SelectExpr()
-		}
-		{
-			p.SetState(493)
-			p.FromClause()
-		}
-
-	case SQLParserT_FROM:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(495)
-			p.FromClause()
+func (p *SQLParser) ClosedOptionPairs() (localctx IClosedOptionPairsContext) {
+	localctx = NewClosedOptionPairsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 98, SQLParserRULE_closedOptionPairs)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(538)
+		p.Match(SQLParserT_OPEN_P)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
-		{
-			p.SetState(496)
-			p.SelectExpr()
+	}
+	{
+		p.SetState(539)
+		p.OptionPairs()
+	}
+	{
+		p.SetState(540)
+		p.Match(SQLParserT_CLOSE_P)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
-
-	default:
-		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-		goto errorExit
 	}
 
 errorExit:
@@ -9005,61 +9668,78 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ISelectExprContext is an interface to support dynamic dispatch.
-type ISelectExprContext interface {
+// IOptionPairContext is an interface to support dynamic dispatch.
+type IOptionPairContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	T_SELECT() antlr.TerminalNode
-	Fields() IFieldsContext
+	OptionKey() IOptionKeyContext
+	T_COLON() antlr.TerminalNode
+	OptionValue() IOptionValueContext
 
-	// IsSelectExprContext differentiates from other interfaces.
-	IsSelectExprContext()
+	// IsOptionPairContext differentiates from other interfaces.
+	IsOptionPairContext()
 }
 
-type SelectExprContext struct {
+type OptionPairContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptySelectExprContext() *SelectExprContext {
-	var p = new(SelectExprContext)
+func NewEmptyOptionPairContext() *OptionPairContext {
+	var p = new(OptionPairContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_selectExpr
+	p.RuleIndex = SQLParserRULE_optionPair
 	return p
 }
 
-func InitEmptySelectExprContext(p *SelectExprContext) {
+func InitEmptyOptionPairContext(p *OptionPairContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_selectExpr
+	p.RuleIndex = SQLParserRULE_optionPair
 }
 
-func (*SelectExprContext) IsSelectExprContext() {}
+func (*OptionPairContext) IsOptionPairContext() {}
 
-func NewSelectExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SelectExprContext {
-	var p = new(SelectExprContext)
+func NewOptionPairContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *OptionPairContext {
+	var p = new(OptionPairContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_selectExpr
+	p.RuleIndex = SQLParserRULE_optionPair
 
 	return p
 }
 
-func (s *SelectExprContext) GetParser() antlr.Parser { return s.parser }
+func (s *OptionPairContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *SelectExprContext) T_SELECT() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SELECT, 0)
+func (s *OptionPairContext) OptionKey() IOptionKeyContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IOptionKeyContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IOptionKeyContext)
 }
 
-func (s *SelectExprContext) Fields() IFieldsContext {
+func (s *OptionPairContext) T_COLON() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_COLON, 0)
+}
+
+func (s *OptionPairContext) OptionValue() IOptionValueContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFieldsContext); ok {
+		if _, ok := ctx.(IOptionValueContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -9069,54 +9749,58 @@ func (s *SelectExprContext) Fields() IFieldsContext {
 		return nil
 	}
 
-	return t.(IFieldsContext)
+	return t.(IOptionValueContext)
 }
 
-func (s *SelectExprContext) GetRuleContext() antlr.RuleContext {
+func (s *OptionPairContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *SelectExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *OptionPairContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *SelectExprContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *OptionPairContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterSelectExpr(s)
+		listenerT.EnterOptionPair(s)
 	}
 }
 
-func (s *SelectExprContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *OptionPairContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitSelectExpr(s)
+		listenerT.ExitOptionPair(s)
 	}
 }
 
-func (s *SelectExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *OptionPairContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitSelectExpr(s)
+		return t.VisitOptionPair(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) SelectExpr() (localctx ISelectExprContext) {
-	localctx = NewSelectExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 94, SQLParserRULE_selectExpr)
+func (p *SQLParser) OptionPair() (localctx IOptionPairContext) {
+	localctx = NewOptionPairContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 100, SQLParserRULE_optionPair)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(500)
-		p.Match(SQLParserT_SELECT)
+		p.SetState(542)
+		p.OptionKey()
+	}
+	{
+		p.SetState(543)
+		p.Match(SQLParserT_COLON)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
 	{
-		p.SetState(501)
-		p.Fields()
+		p.SetState(544)
+		p.OptionValue()
 	}
 
 errorExit:
@@ -9132,171 +9816,137 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IFieldsContext is an interface to support dynamic dispatch.
-type IFieldsContext interface {
+// IOptionKeyContext is an interface to support dynamic dispatch.
+type IOptionKeyContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	AllField() []IFieldContext
-	Field(i int) IFieldContext
-	AllT_COMMA() []antlr.TerminalNode
-	T_COMMA(i int) antlr.TerminalNode
+	T_STORAGE() antlr.TerminalNode
+	T_NUM_OF_SHARD() antlr.TerminalNode
+	T_REPLICA_FACTOR() antlr.TerminalNode
+	T_AUTO_CREATE_NS() antlr.TerminalNode
+	T_BEHEAD() antlr.TerminalNode
+	T_AHEAD() antlr.TerminalNode
+	T_INTERVAL() antlr.TerminalNode
+	T_RETENTION() antlr.TerminalNode
 
-	// IsFieldsContext differentiates from other interfaces.
-	IsFieldsContext()
+	// IsOptionKeyContext differentiates from other interfaces.
+	IsOptionKeyContext()
 }
 
-type FieldsContext struct {
+type OptionKeyContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyFieldsContext() *FieldsContext {
-	var p = new(FieldsContext)
+func NewEmptyOptionKeyContext() *OptionKeyContext {
+	var p = new(OptionKeyContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_fields
+	p.RuleIndex = SQLParserRULE_optionKey
 	return p
 }
 
-func InitEmptyFieldsContext(p *FieldsContext) {
+func InitEmptyOptionKeyContext(p *OptionKeyContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_fields
+	p.RuleIndex = SQLParserRULE_optionKey
 }
 
-func (*FieldsContext) IsFieldsContext() {}
+func (*OptionKeyContext) IsOptionKeyContext() {}
 
-func NewFieldsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FieldsContext {
-	var p = new(FieldsContext)
+func NewOptionKeyContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *OptionKeyContext {
+	var p = new(OptionKeyContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_fields
+	p.RuleIndex = SQLParserRULE_optionKey
 
 	return p
 }
 
-func (s *FieldsContext) GetParser() antlr.Parser { return s.parser }
+func (s *OptionKeyContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *FieldsContext) AllField() []IFieldContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IFieldContext); ok {
-			len++
-		}
-	}
+func (s *OptionKeyContext) T_STORAGE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_STORAGE, 0)
+}
 
-	tst := make([]IFieldContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IFieldContext); ok {
-			tst[i] = t.(IFieldContext)
-			i++
-		}
-	}
+func (s *OptionKeyContext) T_NUM_OF_SHARD() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_NUM_OF_SHARD, 0)
+}
 
-	return tst
+func (s *OptionKeyContext) T_REPLICA_FACTOR() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_REPLICA_FACTOR, 0)
 }
 
-func (s *FieldsContext) Field(i int) IFieldContext {
-	var t antlr.RuleContext
-	j := 0
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFieldContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
-		}
-	}
+func (s *OptionKeyContext) T_AUTO_CREATE_NS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_AUTO_CREATE_NS, 0)
+}
 
-	if t == nil {
-		return nil
-	}
+func (s *OptionKeyContext) T_BEHEAD() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_BEHEAD, 0)
+}
 
-	return t.(IFieldContext)
+func (s *OptionKeyContext) T_AHEAD() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_AHEAD, 0)
 }
 
-func (s *FieldsContext) AllT_COMMA() []antlr.TerminalNode {
-	return s.GetTokens(SQLParserT_COMMA)
+func (s *OptionKeyContext) T_INTERVAL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_INTERVAL, 0)
 }
 
-func (s *FieldsContext) T_COMMA(i int) antlr.TerminalNode {
-	return s.GetToken(SQLParserT_COMMA, i)
+func (s *OptionKeyContext) T_RETENTION() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_RETENTION, 0)
 }
 
-func (s *FieldsContext) GetRuleContext() antlr.RuleContext {
+func (s *OptionKeyContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *FieldsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *OptionKeyContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *FieldsContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *OptionKeyContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterFields(s)
+		listenerT.EnterOptionKey(s)
 	}
 }
 
-func (s *FieldsContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *OptionKeyContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitFields(s)
+		listenerT.ExitOptionKey(s)
 	}
 }
 
-func (s *FieldsContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *OptionKeyContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitFields(s)
+		return t.VisitOptionKey(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) Fields() (localctx IFieldsContext) {
-	localctx = NewFieldsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 96, SQLParserRULE_fields)
+func (p *SQLParser) OptionKey() (localctx IOptionKeyContext) {
+	localctx = NewOptionKeyContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 102, SQLParserRULE_optionKey)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(503)
-		p.Field()
-	}
-	p.SetState(508)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
+		p.SetState(546)
+		_la = p.GetTokenStream().LA(1)
 
-	for _la == SQLParserT_COMMA {
-		{
-			p.SetState(504)
-			p.Match(SQLParserT_COMMA)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(505)
-			p.Field()
-		}
-
-		p.SetState(510)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
+		if !(_la == SQLParserT_INTERVAL || _la == SQLParserT_STORAGE || ((int64((_la-136)) & ^0x3f) == 0 && ((int64(1)<<(_la-136))&63) != 0)) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
 		}
-		_la = p.GetTokenStream().LA(1)
 	}
 
 errorExit:
@@ -9312,57 +9962,62 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IFieldContext is an interface to support dynamic dispatch.
-type IFieldContext interface {
+// IOptionValueContext is an interface to support dynamic dispatch.
+type IOptionValueContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	FieldExpr() IFieldExprContext
-	Alias() IAliasContext
+	STRING() antlr.TerminalNode
+	DurationLit() IDurationLitContext
+	IntNumber() IIntNumberContext
 
-	// IsFieldContext differentiates from other interfaces.
-	IsFieldContext()
+	// IsOptionValueContext differentiates from other interfaces.
+	IsOptionValueContext()
 }
 
-type FieldContext struct {
+type OptionValueContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyFieldContext() *FieldContext {
-	var p = new(FieldContext)
+func NewEmptyOptionValueContext() *OptionValueContext {
+	var p = new(OptionValueContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_field
+	p.RuleIndex = SQLParserRULE_optionValue
 	return p
 }
 
-func InitEmptyFieldContext(p *FieldContext) {
+func InitEmptyOptionValueContext(p *OptionValueContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_field
+	p.RuleIndex = SQLParserRULE_optionValue
 }
 
-func (*FieldContext) IsFieldContext() {}
+func (*OptionValueContext) IsOptionValueContext() {}
 
-func NewFieldContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FieldContext {
-	var p = new(FieldContext)
+func NewOptionValueContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *OptionValueContext {
+	var p = new(OptionValueContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_field
+	p.RuleIndex = SQLParserRULE_optionValue
 
 	return p
 }
 
-func (s *FieldContext) GetParser() antlr.Parser { return s.parser }
+func (s *OptionValueContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *FieldContext) FieldExpr() IFieldExprContext {
+func (s *OptionValueContext) STRING() antlr.TerminalNode {
+	return s.GetToken(SQLParserSTRING, 0)
+}
+
+func (s *OptionValueContext) DurationLit() IDurationLitContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFieldExprContext); ok {
+		if _, ok := ctx.(IDurationLitContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -9372,13 +10027,13 @@ func (s *FieldContext) FieldExpr() IFieldExprContext {
 		return nil
 	}
 
-	return t.(IFieldExprContext)
+	return t.(IDurationLitContext)
 }
 
-func (s *FieldContext) Alias() IAliasContext {
+func (s *OptionValueContext) IntNumber() IIntNumberContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IAliasContext); ok {
+		if _, ok := ctx.(IIntNumberContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -9388,62 +10043,98 @@ func (s *FieldContext) Alias() IAliasContext {
 		return nil
 	}
 
-	return t.(IAliasContext)
+	return t.(IIntNumberContext)
 }
 
-func (s *FieldContext) GetRuleContext() antlr.RuleContext {
+func (s *OptionValueContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *FieldContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *OptionValueContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *FieldContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *OptionValueContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterField(s)
+		listenerT.EnterOptionValue(s)
 	}
 }
 
-func (s *FieldContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *OptionValueContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitField(s)
+		listenerT.ExitOptionValue(s)
 	}
 }
 
-func (s *FieldContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *OptionValueContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitField(s)
+		return t.VisitOptionValue(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) Field() (localctx IFieldContext) {
-	localctx = NewFieldContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 98, SQLParserRULE_field)
-	var _la int
-
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(511)
-		p.fieldExpr(0)
-	}
-	p.SetState(513)
+func (p *SQLParser) OptionValue() (localctx IOptionValueContext) {
+	localctx = NewOptionValueContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 104, SQLParserRULE_optionValue)
+	p.SetState(553)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_la = p.GetTokenStream().LA(1)
 
-	if _la == SQLParserT_AS {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 13, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(512)
-			p.Alias()
+			p.SetState(548)
+			p.Match(SQLParserSTRING)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(549)
+			p.Match(SQLParserT__0)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(550)
+			p.Match(SQLParserT__1)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 4:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(551)
+			p.DurationLit()
+		}
+
+	case 5:
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(552)
+			p.IntNumber()
 		}
 
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
 	}
 
 errorExit:
@@ -9459,61 +10150,62 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IAliasContext is an interface to support dynamic dispatch.
-type IAliasContext interface {
+// IDeleteStmtContext is an interface to support dynamic dispatch.
+type IDeleteStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	T_AS() antlr.TerminalNode
-	Ident() IIdentContext
+	T_DELETE() antlr.TerminalNode
+	FromClause() IFromClauseContext
+	WhereClause() IWhereClauseContext
 
-	// IsAliasContext differentiates from other interfaces.
-	IsAliasContext()
+	// IsDeleteStmtContext differentiates from other interfaces.
+	IsDeleteStmtContext()
 }
 
-type AliasContext struct {
+type DeleteStmtContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyAliasContext() *AliasContext {
-	var p = new(AliasContext)
+func NewEmptyDeleteStmtContext() *DeleteStmtContext {
+	var p = new(DeleteStmtContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_alias
+	p.RuleIndex = SQLParserRULE_deleteStmt
 	return p
 }
 
-func InitEmptyAliasContext(p *AliasContext) {
+func InitEmptyDeleteStmtContext(p *DeleteStmtContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_alias
+	p.RuleIndex = SQLParserRULE_deleteStmt
 }
 
-func (*AliasContext) IsAliasContext() {}
+func (*DeleteStmtContext) IsDeleteStmtContext() {}
 
-func NewAliasContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AliasContext {
-	var p = new(AliasContext)
+func NewDeleteStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DeleteStmtContext {
+	var p = new(DeleteStmtContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_alias
+	p.RuleIndex = SQLParserRULE_deleteStmt
 
 	return p
 }
 
-func (s *AliasContext) GetParser() antlr.Parser { return s.parser }
+func (s *DeleteStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *AliasContext) T_AS() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_AS, 0)
+func (s *DeleteStmtContext) T_DELETE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DELETE, 0)
 }
 
-func (s *AliasContext) Ident() IIdentContext {
+func (s *DeleteStmtContext) FromClause() IFromClauseContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIdentContext); ok {
+		if _, ok := ctx.(IFromClauseContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -9523,54 +10215,74 @@ func (s *AliasContext) Ident() IIdentContext {
 		return nil
 	}
 
-	return t.(IIdentContext)
+	return t.(IFromClauseContext)
 }
 
-func (s *AliasContext) GetRuleContext() antlr.RuleContext {
+func (s *DeleteStmtContext) WhereClause() IWhereClauseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IWhereClauseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IWhereClauseContext)
+}
+
+func (s *DeleteStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *AliasContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *DeleteStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *AliasContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *DeleteStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterAlias(s)
+		listenerT.EnterDeleteStmt(s)
 	}
 }
 
-func (s *AliasContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *DeleteStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitAlias(s)
+		listenerT.ExitDeleteStmt(s)
 	}
 }
 
-func (s *AliasContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *DeleteStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitAlias(s)
+		return t.VisitDeleteStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) Alias() (localctx IAliasContext) {
-	localctx = NewAliasContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 100, SQLParserRULE_alias)
+func (p *SQLParser) DeleteStmt() (localctx IDeleteStmtContext) {
+	localctx = NewDeleteStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 106, SQLParserRULE_deleteStmt)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(515)
-		p.Match(SQLParserT_AS)
+		p.SetState(555)
+		p.Match(SQLParserT_DELETE)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
 	{
-		p.SetState(516)
-		p.Ident()
+		p.SetState(556)
+		p.FromClause()
+	}
+	{
+		p.SetState(557)
+		p.WhereClause()
 	}
 
 errorExit:
@@ -9586,66 +10298,68 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IBrokerFilterContext is an interface to support dynamic dispatch.
-type IBrokerFilterContext interface {
+// IDropMetricStmtContext is an interface to support dynamic dispatch.
+type IDropMetricStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	T_BROKER() antlr.TerminalNode
-	T_EQUAL() antlr.TerminalNode
-	Ident() IIdentContext
+	T_DROP() antlr.TerminalNode
+	T_METRIC() antlr.TerminalNode
+	MetricName() IMetricNameContext
+	T_ON() antlr.TerminalNode
+	Namespace() INamespaceContext
 
-	// IsBrokerFilterContext differentiates from other interfaces.
-	IsBrokerFilterContext()
+	// IsDropMetricStmtContext differentiates from other interfaces.
+	IsDropMetricStmtContext()
 }
 
-type BrokerFilterContext struct {
+type DropMetricStmtContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyBrokerFilterContext() *BrokerFilterContext {
-	var p = new(BrokerFilterContext)
+func NewEmptyDropMetricStmtContext() *DropMetricStmtContext {
+	var p = new(DropMetricStmtContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_brokerFilter
+	p.RuleIndex = SQLParserRULE_dropMetricStmt
 	return p
 }
 
-func InitEmptyBrokerFilterContext(p *BrokerFilterContext) {
+func InitEmptyDropMetricStmtContext(p *DropMetricStmtContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_brokerFilter
+	p.RuleIndex = SQLParserRULE_dropMetricStmt
 }
 
-func (*BrokerFilterContext) IsBrokerFilterContext() {}
+func (*DropMetricStmtContext) IsDropMetricStmtContext() {}
 
-func NewBrokerFilterContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *BrokerFilterContext {
-	var p = new(BrokerFilterContext)
+func NewDropMetricStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DropMetricStmtContext {
+	var p = new(DropMetricStmtContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_brokerFilter
+	p.RuleIndex = SQLParserRULE_dropMetricStmt
 
 	return p
 }
 
-func (s *BrokerFilterContext) GetParser() antlr.Parser { return s.parser }
+func (s *DropMetricStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *BrokerFilterContext) T_BROKER() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_BROKER, 0)
+func (s *DropMetricStmtContext) T_DROP() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DROP, 0)
 }
 
-func (s *BrokerFilterContext) T_EQUAL() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_EQUAL, 0)
+func (s *DropMetricStmtContext) T_METRIC() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_METRIC, 0)
 }
 
-func (s *BrokerFilterContext) Ident() IIdentContext {
+func (s *DropMetricStmtContext) MetricName() IMetricNameContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIdentContext); ok {
+		if _, ok := ctx.(IMetricNameContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -9655,62 +10369,106 @@ func (s *BrokerFilterContext) Ident() IIdentContext {
 		return nil
 	}
 
-	return t.(IIdentContext)
+	return t.(IMetricNameContext)
 }
 
-func (s *BrokerFilterContext) GetRuleContext() antlr.RuleContext {
-	return s
+func (s *DropMetricStmtContext) T_ON() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ON, 0)
 }
 
-func (s *BrokerFilterContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
-}
+func (s *DropMetricStmtContext) Namespace() INamespaceContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INamespaceContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-func (s *BrokerFilterContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterBrokerFilter(s)
+	if t == nil {
+		return nil
 	}
+
+	return t.(INamespaceContext)
 }
 
-func (s *BrokerFilterContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *DropMetricStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *DropMetricStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *DropMetricStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitBrokerFilter(s)
+		listenerT.EnterDropMetricStmt(s)
 	}
 }
 
-func (s *BrokerFilterContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *DropMetricStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitDropMetricStmt(s)
+	}
+}
+
+func (s *DropMetricStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitBrokerFilter(s)
+		return t.VisitDropMetricStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) BrokerFilter() (localctx IBrokerFilterContext) {
-	localctx = NewBrokerFilterContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 102, SQLParserRULE_brokerFilter)
+func (p *SQLParser) DropMetricStmt() (localctx IDropMetricStmtContext) {
+	localctx = NewDropMetricStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 108, SQLParserRULE_dropMetricStmt)
+	var _la int
+
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(518)
-		p.Match(SQLParserT_BROKER)
+		p.SetState(559)
+		p.Match(SQLParserT_DROP)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
 	{
-		p.SetState(519)
-		p.Match(SQLParserT_EQUAL)
+		p.SetState(560)
+		p.Match(SQLParserT_METRIC)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
 	{
-		p.SetState(520)
-		p.Ident()
+		p.SetState(561)
+		p.MetricName()
+	}
+	p.SetState(564)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_ON {
+		{
+			p.SetState(562)
+			p.Match(SQLParserT_ON)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(563)
+			p.Namespace()
+		}
+
 	}
 
 errorExit:
@@ -9726,66 +10484,66 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IDatabaseFilterContext is an interface to support dynamic dispatch.
-type IDatabaseFilterContext interface {
+// IDropNamespaceStmtContext is an interface to support dynamic dispatch.
+type IDropNamespaceStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	T_DATASBAE() antlr.TerminalNode
-	T_EQUAL() antlr.TerminalNode
-	Ident() IIdentContext
+	T_DROP() antlr.TerminalNode
+	T_NAMESPACE() antlr.TerminalNode
+	Namespace() INamespaceContext
 
-	// IsDatabaseFilterContext differentiates from other interfaces.
-	IsDatabaseFilterContext()
+	// IsDropNamespaceStmtContext differentiates from other interfaces.
+	IsDropNamespaceStmtContext()
 }
 
-type DatabaseFilterContext struct {
+type DropNamespaceStmtContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyDatabaseFilterContext() *DatabaseFilterContext {
-	var p = new(DatabaseFilterContext)
+func NewEmptyDropNamespaceStmtContext() *DropNamespaceStmtContext {
+	var p = new(DropNamespaceStmtContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_databaseFilter
+	p.RuleIndex = SQLParserRULE_dropNamespaceStmt
 	return p
 }
 
-func InitEmptyDatabaseFilterContext(p *DatabaseFilterContext) {
+func InitEmptyDropNamespaceStmtContext(p *DropNamespaceStmtContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_databaseFilter
+	p.RuleIndex = SQLParserRULE_dropNamespaceStmt
 }
 
-func (*DatabaseFilterContext) IsDatabaseFilterContext() {}
+func (*DropNamespaceStmtContext) IsDropNamespaceStmtContext() {}
 
-func NewDatabaseFilterContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DatabaseFilterContext {
-	var p = new(DatabaseFilterContext)
+func NewDropNamespaceStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DropNamespaceStmtContext {
+	var p = new(DropNamespaceStmtContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_databaseFilter
+	p.RuleIndex = SQLParserRULE_dropNamespaceStmt
 
 	return p
 }
 
-func (s *DatabaseFilterContext) GetParser() antlr.Parser { return s.parser }
+func (s *DropNamespaceStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *DatabaseFilterContext) T_DATASBAE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_DATASBAE, 0)
+func (s *DropNamespaceStmtContext) T_DROP() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DROP, 0)
 }
 
-func (s *DatabaseFilterContext) T_EQUAL() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_EQUAL, 0)
+func (s *DropNamespaceStmtContext) T_NAMESPACE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_NAMESPACE, 0)
 }
 
-func (s *DatabaseFilterContext) Ident() IIdentContext {
+func (s *DropNamespaceStmtContext) Namespace() INamespaceContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIdentContext); ok {
+		if _, ok := ctx.(INamespaceContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -9795,62 +10553,62 @@ func (s *DatabaseFilterContext) Ident() IIdentContext {
 		return nil
 	}
 
-	return t.(IIdentContext)
+	return t.(INamespaceContext)
 }
 
-func (s *DatabaseFilterContext) GetRuleContext() antlr.RuleContext {
+func (s *DropNamespaceStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *DatabaseFilterContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *DropNamespaceStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *DatabaseFilterContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *DropNamespaceStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterDatabaseFilter(s)
+		listenerT.EnterDropNamespaceStmt(s)
 	}
 }
 
-func (s *DatabaseFilterContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *DropNamespaceStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitDatabaseFilter(s)
+		listenerT.ExitDropNamespaceStmt(s)
 	}
 }
 
-func (s *DatabaseFilterContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *DropNamespaceStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitDatabaseFilter(s)
+		return t.VisitDropNamespaceStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) DatabaseFilter() (localctx IDatabaseFilterContext) {
-	localctx = NewDatabaseFilterContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 104, SQLParserRULE_databaseFilter)
+func (p *SQLParser) DropNamespaceStmt() (localctx IDropNamespaceStmtContext) {
+	localctx = NewDropNamespaceStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 110, SQLParserRULE_dropNamespaceStmt)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(522)
-		p.Match(SQLParserT_DATASBAE)
+		p.SetState(566)
+		p.Match(SQLParserT_DROP)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
 	{
-		p.SetState(523)
-		p.Match(SQLParserT_EQUAL)
+		p.SetState(567)
+		p.Match(SQLParserT_NAMESPACE)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
 	{
-		p.SetState(524)
-		p.Ident()
+		p.SetState(568)
+		p.Namespace()
 	}
 
 errorExit:
@@ -9866,66 +10624,82 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ITypeFilterContext is an interface to support dynamic dispatch.
-type ITypeFilterContext interface {
+// IQueryStmtContext is an interface to support dynamic dispatch.
+type IQueryStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	T_TYPE() antlr.TerminalNode
-	T_EQUAL() antlr.TerminalNode
-	Ident() IIdentContext
+	SourceAndSelect() ISourceAndSelectContext
+	T_EXPLAIN() antlr.TerminalNode
+	WhereClause() IWhereClauseContext
+	GroupByClause() IGroupByClauseContext
+	OrderByClause() IOrderByClauseContext
+	LimitClause() ILimitClauseContext
+	T_WITH_VALUE() antlr.TerminalNode
 
-	// IsTypeFilterContext differentiates from other interfaces.
-	IsTypeFilterContext()
+	// IsQueryStmtContext differentiates from other interfaces.
+	IsQueryStmtContext()
 }
 
-type TypeFilterContext struct {
+type QueryStmtContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyTypeFilterContext() *TypeFilterContext {
-	var p = new(TypeFilterContext)
+func NewEmptyQueryStmtContext() *QueryStmtContext {
+	var p = new(QueryStmtContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_typeFilter
+	p.RuleIndex = SQLParserRULE_queryStmt
 	return p
 }
 
-func InitEmptyTypeFilterContext(p *TypeFilterContext) {
+func InitEmptyQueryStmtContext(p *QueryStmtContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_typeFilter
+	p.RuleIndex = SQLParserRULE_queryStmt
 }
 
-func (*TypeFilterContext) IsTypeFilterContext() {}
+func (*QueryStmtContext) IsQueryStmtContext() {}
 
-func NewTypeFilterContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeFilterContext {
-	var p = new(TypeFilterContext)
+func NewQueryStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *QueryStmtContext {
+	var p = new(QueryStmtContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_typeFilter
+	p.RuleIndex = SQLParserRULE_queryStmt
 
 	return p
 }
 
-func (s *TypeFilterContext) GetParser() antlr.Parser { return s.parser }
+func (s *QueryStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *TypeFilterContext) T_TYPE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_TYPE, 0)
+func (s *QueryStmtContext) SourceAndSelect() ISourceAndSelectContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISourceAndSelectContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISourceAndSelectContext)
 }
 
-func (s *TypeFilterContext) T_EQUAL() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_EQUAL, 0)
+func (s *QueryStmtContext) T_EXPLAIN() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_EXPLAIN, 0)
 }
 
-func (s *TypeFilterContext) Ident() IIdentContext {
+func (s *QueryStmtContext) WhereClause() IWhereClauseContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIdentContext); ok {
+		if _, ok := ctx.(IWhereClauseContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -9935,62 +10709,192 @@ func (s *TypeFilterContext) Ident() IIdentContext {
 		return nil
 	}
 
-	return t.(IIdentContext)
+	return t.(IWhereClauseContext)
 }
 
-func (s *TypeFilterContext) GetRuleContext() antlr.RuleContext {
+func (s *QueryStmtContext) GroupByClause() IGroupByClauseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IGroupByClauseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IGroupByClauseContext)
+}
+
+func (s *QueryStmtContext) OrderByClause() IOrderByClauseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IOrderByClauseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IOrderByClauseContext)
+}
+
+func (s *QueryStmtContext) LimitClause() ILimitClauseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ILimitClauseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ILimitClauseContext)
+}
+
+func (s *QueryStmtContext) T_WITH_VALUE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WITH_VALUE, 0)
+}
+
+func (s *QueryStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *TypeFilterContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *QueryStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *TypeFilterContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *QueryStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterTypeFilter(s)
+		listenerT.EnterQueryStmt(s)
 	}
 }
 
-func (s *TypeFilterContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *QueryStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitTypeFilter(s)
+		listenerT.ExitQueryStmt(s)
 	}
 }
 
-func (s *TypeFilterContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *QueryStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitTypeFilter(s)
+		return t.VisitQueryStmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) TypeFilter() (localctx ITypeFilterContext) {
-	localctx = NewTypeFilterContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 106, SQLParserRULE_typeFilter)
+func (p *SQLParser) QueryStmt() (localctx IQueryStmtContext) {
+	localctx = NewQueryStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 112, SQLParserRULE_queryStmt)
+	var _la int
+
 	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(526)
-		p.Match(SQLParserT_TYPE)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
+	p.SetState(571)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_EXPLAIN {
+		{
+			p.SetState(570)
+			p.Match(SQLParserT_EXPLAIN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
+
 	}
 	{
-		p.SetState(527)
-		p.Match(SQLParserT_EQUAL)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
+		p.SetState(573)
+		p.SourceAndSelect()
+	}
+	p.SetState(575)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_WHERE {
+		{
+			p.SetState(574)
+			p.WhereClause()
 		}
+
 	}
-	{
-		p.SetState(528)
-		p.Ident()
+	p.SetState(578)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_GROUP {
+		{
+			p.SetState(577)
+			p.GroupByClause()
+		}
+
+	}
+	p.SetState(581)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_ORDER {
+		{
+			p.SetState(580)
+			p.OrderByClause()
+		}
+
+	}
+	p.SetState(584)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_LIMIT {
+		{
+			p.SetState(583)
+			p.LimitClause()
+		}
+
+	}
+	p.SetState(587)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_WITH_VALUE {
+		{
+			p.SetState(586)
+			p.Match(SQLParserT_WITH_VALUE)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
 	}
 
 errorExit:
@@ -10006,63 +10910,75 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IFromClauseContext is an interface to support dynamic dispatch.
-type IFromClauseContext interface {
+// ISourceAndSelectContext is an interface to support dynamic dispatch.
+type ISourceAndSelectContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	T_FROM() antlr.TerminalNode
-	MetricName() IMetricNameContext
-	T_ON() antlr.TerminalNode
-	Namespace() INamespaceContext
+	SelectExpr() ISelectExprContext
+	FromClause() IFromClauseContext
+	JoinClause() IJoinClauseContext
+	SubQueryClause() ISubQueryClauseContext
 
-	// IsFromClauseContext differentiates from other interfaces.
-	IsFromClauseContext()
+	// IsSourceAndSelectContext differentiates from other interfaces.
+	IsSourceAndSelectContext()
 }
 
-type FromClauseContext struct {
+type SourceAndSelectContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyFromClauseContext() *FromClauseContext {
-	var p = new(FromClauseContext)
+func NewEmptySourceAndSelectContext() *SourceAndSelectContext {
+	var p = new(SourceAndSelectContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_fromClause
+	p.RuleIndex = SQLParserRULE_sourceAndSelect
 	return p
 }
 
-func InitEmptyFromClauseContext(p *FromClauseContext) {
+func InitEmptySourceAndSelectContext(p *SourceAndSelectContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_fromClause
+	p.RuleIndex = SQLParserRULE_sourceAndSelect
 }
 
-func (*FromClauseContext) IsFromClauseContext() {}
+func (*SourceAndSelectContext) IsSourceAndSelectContext() {}
 
-func NewFromClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FromClauseContext {
-	var p = new(FromClauseContext)
+func NewSourceAndSelectContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SourceAndSelectContext {
+	var p = new(SourceAndSelectContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_fromClause
+	p.RuleIndex = SQLParserRULE_sourceAndSelect
 
 	return p
 }
 
-func (s *FromClauseContext) GetParser() antlr.Parser { return s.parser }
+func (s *SourceAndSelectContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *FromClauseContext) T_FROM() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_FROM, 0)
+func (s *SourceAndSelectContext) SelectExpr() ISelectExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISelectExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISelectExprContext)
 }
 
-func (s *FromClauseContext) MetricName() IMetricNameContext {
+func (s *SourceAndSelectContext) FromClause() IFromClauseContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IMetricNameContext); ok {
+		if _, ok := ctx.(IFromClauseContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -10072,17 +10988,29 @@ func (s *FromClauseContext) MetricName() IMetricNameContext {
 		return nil
 	}
 
-	return t.(IMetricNameContext)
+	return t.(IFromClauseContext)
 }
 
-func (s *FromClauseContext) T_ON() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_ON, 0)
+func (s *SourceAndSelectContext) JoinClause() IJoinClauseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IJoinClauseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IJoinClauseContext)
 }
 
-func (s *FromClauseContext) Namespace() INamespaceContext {
+func (s *SourceAndSelectContext) SubQueryClause() ISubQueryClauseContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(INamespaceContext); ok {
+		if _, ok := ctx.(ISubQueryClauseContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -10092,78 +11020,116 @@ func (s *FromClauseContext) Namespace() INamespaceContext {
 		return nil
 	}
 
-	return t.(INamespaceContext)
+	return t.(ISubQueryClauseContext)
 }
 
-func (s *FromClauseContext) GetRuleContext() antlr.RuleContext {
+func (s *SourceAndSelectContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *FromClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *SourceAndSelectContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *FromClauseContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *SourceAndSelectContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterFromClause(s)
+		listenerT.EnterSourceAndSelect(s)
 	}
 }
 
-func (s *FromClauseContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *SourceAndSelectContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitFromClause(s)
+		listenerT.ExitSourceAndSelect(s)
 	}
 }
 
-func (s *FromClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *SourceAndSelectContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitFromClause(s)
+		return t.VisitSourceAndSelect(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) FromClause() (localctx IFromClauseContext) {
-	localctx = NewFromClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 108, SQLParserRULE_fromClause)
-	var _la int
-
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(530)
-		p.Match(SQLParserT_FROM)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(531)
-		p.MetricName()
-	}
-	p.SetState(534)
+func (p *SQLParser) SourceAndSelect() (localctx ISourceAndSelectContext) {
+	localctx = NewSourceAndSelectContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 114, SQLParserRULE_sourceAndSelect)
+	p.SetState(601)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_la = p.GetTokenStream().LA(1)
 
-	if _la == SQLParserT_ON {
+	switch p.GetTokenStream().LA(1) {
+	case SQLParserT_SELECT:
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(532)
-			p.Match(SQLParserT_ON)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
+			p.SetState(589)
+			p.SelectExpr()
+		}
+		p.SetState(593)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+
+		switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 21, p.GetParserRuleContext()) {
+		case 1:
+			{
+				p.SetState(590)
+				p.FromClause()
+			}
+
+		case 2:
+			{
+				p.SetState(591)
+				p.JoinClause()
+			}
+
+		case 3:
+			{
+				p.SetState(592)
+				p.SubQueryClause()
+			}
+
+		case antlr.ATNInvalidAltNumber:
+			goto errorExit
+		}
+
+	case SQLParserT_FROM:
+		p.EnterOuterAlt(localctx, 2)
+		p.SetState(597)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+
+		switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 22, p.GetParserRuleContext()) {
+		case 1:
+			{
+				p.SetState(595)
+				p.FromClause()
+			}
+
+		case 2:
+			{
+				p.SetState(596)
+				p.JoinClause()
 			}
+
+		case antlr.ATNInvalidAltNumber:
+			goto errorExit
 		}
 		{
-			p.SetState(533)
-			p.Namespace()
+			p.SetState(599)
+			p.SelectExpr()
 		}
 
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
 	}
 
 errorExit:
@@ -10179,61 +11145,61 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IWhereClauseContext is an interface to support dynamic dispatch.
-type IWhereClauseContext interface {
+// ISelectExprContext is an interface to support dynamic dispatch.
+type ISelectExprContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	T_WHERE() antlr.TerminalNode
-	ConditionExpr() IConditionExprContext
+	T_SELECT() antlr.TerminalNode
+	Fields() IFieldsContext
 
-	// IsWhereClauseContext differentiates from other interfaces.
-	IsWhereClauseContext()
+	// IsSelectExprContext differentiates from other interfaces.
+	IsSelectExprContext()
 }
 
-type WhereClauseContext struct {
+type SelectExprContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyWhereClauseContext() *WhereClauseContext {
-	var p = new(WhereClauseContext)
+func NewEmptySelectExprContext() *SelectExprContext {
+	var p = new(SelectExprContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_whereClause
+	p.RuleIndex = SQLParserRULE_selectExpr
 	return p
 }
 
-func InitEmptyWhereClauseContext(p *WhereClauseContext) {
+func InitEmptySelectExprContext(p *SelectExprContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_whereClause
+	p.RuleIndex = SQLParserRULE_selectExpr
 }
 
-func (*WhereClauseContext) IsWhereClauseContext() {}
+func (*SelectExprContext) IsSelectExprContext() {}
 
-func NewWhereClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *WhereClauseContext {
-	var p = new(WhereClauseContext)
+func NewSelectExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SelectExprContext {
+	var p = new(SelectExprContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_whereClause
+	p.RuleIndex = SQLParserRULE_selectExpr
 
 	return p
 }
 
-func (s *WhereClauseContext) GetParser() antlr.Parser { return s.parser }
+func (s *SelectExprContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *WhereClauseContext) T_WHERE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_WHERE, 0)
+func (s *SelectExprContext) T_SELECT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SELECT, 0)
 }
 
-func (s *WhereClauseContext) ConditionExpr() IConditionExprContext {
+func (s *SelectExprContext) Fields() IFieldsContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IConditionExprContext); ok {
+		if _, ok := ctx.(IFieldsContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -10243,54 +11209,54 @@ func (s *WhereClauseContext) ConditionExpr() IConditionExprContext {
 		return nil
 	}
 
-	return t.(IConditionExprContext)
+	return t.(IFieldsContext)
 }
 
-func (s *WhereClauseContext) GetRuleContext() antlr.RuleContext {
+func (s *SelectExprContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *WhereClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *SelectExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *WhereClauseContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *SelectExprContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterWhereClause(s)
+		listenerT.EnterSelectExpr(s)
 	}
 }
 
-func (s *WhereClauseContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *SelectExprContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitWhereClause(s)
+		listenerT.ExitSelectExpr(s)
 	}
 }
 
-func (s *WhereClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *SelectExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitWhereClause(s)
+		return t.VisitSelectExpr(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) WhereClause() (localctx IWhereClauseContext) {
-	localctx = NewWhereClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 110, SQLParserRULE_whereClause)
+func (p *SQLParser) SelectExpr() (localctx ISelectExprContext) {
+	localctx = NewSelectExprContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 116, SQLParserRULE_selectExpr)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(536)
-		p.Match(SQLParserT_WHERE)
+		p.SetState(603)
+		p.Match(SQLParserT_SELECT)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
 	{
-		p.SetState(537)
-		p.ConditionExpr()
+		p.SetState(604)
+		p.Fields()
 	}
 
 errorExit:
@@ -10306,80 +11272,86 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IConditionExprContext is an interface to support dynamic dispatch.
-type IConditionExprContext interface {
+// IFieldsContext is an interface to support dynamic dispatch.
+type IFieldsContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	TagFilterExpr() ITagFilterExprContext
-	T_AND() antlr.TerminalNode
-	TimeRangeExpr() ITimeRangeExprContext
+	AllField() []IFieldContext
+	Field(i int) IFieldContext
+	AllT_COMMA() []antlr.TerminalNode
+	T_COMMA(i int) antlr.TerminalNode
 
-	// IsConditionExprContext differentiates from other interfaces.
-	IsConditionExprContext()
+	// IsFieldsContext differentiates from other interfaces.
+	IsFieldsContext()
 }
 
-type ConditionExprContext struct {
+type FieldsContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyConditionExprContext() *ConditionExprContext {
-	var p = new(ConditionExprContext)
+func NewEmptyFieldsContext() *FieldsContext {
+	var p = new(FieldsContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_conditionExpr
+	p.RuleIndex = SQLParserRULE_fields
 	return p
 }
 
-func InitEmptyConditionExprContext(p *ConditionExprContext) {
+func InitEmptyFieldsContext(p *FieldsContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_conditionExpr
+	p.RuleIndex = SQLParserRULE_fields
 }
 
-func (*ConditionExprContext) IsConditionExprContext() {}
+func (*FieldsContext) IsFieldsContext() {}
 
-func NewConditionExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ConditionExprContext {
-	var p = new(ConditionExprContext)
+func NewFieldsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FieldsContext {
+	var p = new(FieldsContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_conditionExpr
+	p.RuleIndex = SQLParserRULE_fields
 
 	return p
 }
 
-func (s *ConditionExprContext) GetParser() antlr.Parser { return s.parser }
+func (s *FieldsContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ConditionExprContext) TagFilterExpr() ITagFilterExprContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITagFilterExprContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+func (s *FieldsContext) AllField() []IFieldContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IFieldContext); ok {
+			len++
 		}
 	}
 
-	if t == nil {
-		return nil
+	tst := make([]IFieldContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IFieldContext); ok {
+			tst[i] = t.(IFieldContext)
+			i++
+		}
 	}
 
-	return t.(ITagFilterExprContext)
-}
-
-func (s *ConditionExprContext) T_AND() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_AND, 0)
+	return tst
 }
 
-func (s *ConditionExprContext) TimeRangeExpr() ITimeRangeExprContext {
+func (s *FieldsContext) Field(i int) IFieldContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITimeRangeExprContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		if _, ok := ctx.(IFieldContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -10387,108 +11359,84 @@ func (s *ConditionExprContext) TimeRangeExpr() ITimeRangeExprContext {
 		return nil
 	}
 
-	return t.(ITimeRangeExprContext)
+	return t.(IFieldContext)
 }
 
-func (s *ConditionExprContext) GetRuleContext() antlr.RuleContext {
+func (s *FieldsContext) AllT_COMMA() []antlr.TerminalNode {
+	return s.GetTokens(SQLParserT_COMMA)
+}
+
+func (s *FieldsContext) T_COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(SQLParserT_COMMA, i)
+}
+
+func (s *FieldsContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ConditionExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *FieldsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ConditionExprContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *FieldsContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterConditionExpr(s)
+		listenerT.EnterFields(s)
 	}
 }
 
-func (s *ConditionExprContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *FieldsContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitConditionExpr(s)
+		listenerT.ExitFields(s)
 	}
 }
 
-func (s *ConditionExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *FieldsContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitConditionExpr(s)
+		return t.VisitFields(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) ConditionExpr() (localctx IConditionExprContext) {
-	localctx = NewConditionExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 112, SQLParserRULE_conditionExpr)
+func (p *SQLParser) Fields() (localctx IFieldsContext) {
+	localctx = NewFieldsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 118, SQLParserRULE_fields)
 	var _la int
 
-	p.SetState(549)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(606)
+		p.Field()
+	}
+	p.SetState(611)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
+	_la = p.GetTokenStream().LA(1)
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 25, p.GetParserRuleContext()) {
-	case 1:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(539)
-			p.tagFilterExpr(0)
-		}
-
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(540)
-			p.tagFilterExpr(0)
-		}
+	for _la == SQLParserT_COMMA {
 		{
-			p.SetState(541)
-			p.Match(SQLParserT_AND)
+			p.SetState(607)
+			p.Match(SQLParserT_COMMA)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
 		{
-			p.SetState(542)
-			p.TimeRangeExpr()
+			p.SetState(608)
+			p.Field()
 		}
 
-	case 3:
-		p.EnterOuterAlt(localctx, 3)
-		{
-			p.SetState(544)
-			p.TimeRangeExpr()
-		}
-		p.SetState(547)
+		p.SetState(613)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
 		_la = p.GetTokenStream().LA(1)
-
-		if _la == SQLParserT_AND {
-			{
-				p.SetState(545)
-				p.Match(SQLParserT_AND)
-				if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-				}
-			}
-			{
-				p.SetState(546)
-				p.tagFilterExpr(0)
-			}
-
-		}
-
-	case antlr.ATNInvalidAltNumber:
-		goto errorExit
 	}
 
 errorExit:
@@ -10504,103 +11452,75 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ITagFilterExprContext is an interface to support dynamic dispatch.
-type ITagFilterExprContext interface {
+// IFieldContext is an interface to support dynamic dispatch.
+type IFieldContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	T_OPEN_P() antlr.TerminalNode
-	AllTagFilterExpr() []ITagFilterExprContext
-	TagFilterExpr(i int) ITagFilterExprContext
-	T_CLOSE_P() antlr.TerminalNode
-	TagKey() ITagKeyContext
-	TagValue() ITagValueContext
-	T_EQUAL() antlr.TerminalNode
-	T_LIKE() antlr.TerminalNode
-	T_NOT() antlr.TerminalNode
-	T_REGEXP() antlr.TerminalNode
-	T_NEQREGEXP() antlr.TerminalNode
-	T_NOTEQUAL() antlr.TerminalNode
-	T_NOTEQUAL2() antlr.TerminalNode
-	TagValueList() ITagValueListContext
-	T_IN() antlr.TerminalNode
-	T_AND() antlr.TerminalNode
-	T_OR() antlr.TerminalNode
+	FieldExpr() IFieldExprContext
+	Alias() IAliasContext
 
-	// IsTagFilterExprContext differentiates from other interfaces.
-	IsTagFilterExprContext()
+	// IsFieldContext differentiates from other interfaces.
+	IsFieldContext()
 }
 
-type TagFilterExprContext struct {
+type FieldContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyTagFilterExprContext() *TagFilterExprContext {
-	var p = new(TagFilterExprContext)
+func NewEmptyFieldContext() *FieldContext {
+	var p = new(FieldContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_tagFilterExpr
+	p.RuleIndex = SQLParserRULE_field
 	return p
 }
 
-func InitEmptyTagFilterExprContext(p *TagFilterExprContext) {
+func InitEmptyFieldContext(p *FieldContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_tagFilterExpr
+	p.RuleIndex = SQLParserRULE_field
 }
 
-func (*TagFilterExprContext) IsTagFilterExprContext() {}
+func (*FieldContext) IsFieldContext() {}
 
-func NewTagFilterExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TagFilterExprContext {
-	var p = new(TagFilterExprContext)
+func NewFieldContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FieldContext {
+	var p = new(FieldContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_tagFilterExpr
+	p.RuleIndex = SQLParserRULE_field
 
 	return p
 }
 
-func (s *TagFilterExprContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *TagFilterExprContext) T_OPEN_P() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_OPEN_P, 0)
-}
+func (s *FieldContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *TagFilterExprContext) AllTagFilterExpr() []ITagFilterExprContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(ITagFilterExprContext); ok {
-			len++
+func (s *FieldContext) FieldExpr() IFieldExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFieldExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
-	tst := make([]ITagFilterExprContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(ITagFilterExprContext); ok {
-			tst[i] = t.(ITagFilterExprContext)
-			i++
-		}
+	if t == nil {
+		return nil
 	}
 
-	return tst
+	return t.(IFieldExprContext)
 }
 
-func (s *TagFilterExprContext) TagFilterExpr(i int) ITagFilterExprContext {
+func (s *FieldContext) Alias() IAliasContext {
 	var t antlr.RuleContext
-	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITagFilterExprContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
+		if _, ok := ctx.(IAliasContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
@@ -10608,77 +11528,132 @@ func (s *TagFilterExprContext) TagFilterExpr(i int) ITagFilterExprContext {
 		return nil
 	}
 
-	return t.(ITagFilterExprContext)
+	return t.(IAliasContext)
 }
 
-func (s *TagFilterExprContext) T_CLOSE_P() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_CLOSE_P, 0)
+func (s *FieldContext) GetRuleContext() antlr.RuleContext {
+	return s
 }
 
-func (s *TagFilterExprContext) TagKey() ITagKeyContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITagKeyContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
+func (s *FieldContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *FieldContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterField(s)
 	}
+}
 
-	if t == nil {
-		return nil
+func (s *FieldContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitField(s)
 	}
+}
 
-	return t.(ITagKeyContext)
+func (s *FieldContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitField(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
 }
 
-func (s *TagFilterExprContext) TagValue() ITagValueContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITagValueContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
+func (p *SQLParser) Field() (localctx IFieldContext) {
+	localctx = NewFieldContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 120, SQLParserRULE_field)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(614)
+		p.fieldExpr(0)
+	}
+	p.SetState(616)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
 	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_AS {
+		{
+			p.SetState(615)
+			p.Alias()
+		}
 
-	if t == nil {
-		return nil
 	}
 
-	return t.(ITagValueContext)
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-func (s *TagFilterExprContext) T_EQUAL() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_EQUAL, 0)
-}
+// IAliasContext is an interface to support dynamic dispatch.
+type IAliasContext interface {
+	antlr.ParserRuleContext
 
-func (s *TagFilterExprContext) T_LIKE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_LIKE, 0)
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	T_AS() antlr.TerminalNode
+	Ident() IIdentContext
+
+	// IsAliasContext differentiates from other interfaces.
+	IsAliasContext()
 }
 
-func (s *TagFilterExprContext) T_NOT() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_NOT, 0)
+type AliasContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
 }
 
-func (s *TagFilterExprContext) T_REGEXP() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_REGEXP, 0)
+func NewEmptyAliasContext() *AliasContext {
+	var p = new(AliasContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SQLParserRULE_alias
+	return p
 }
 
-func (s *TagFilterExprContext) T_NEQREGEXP() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_NEQREGEXP, 0)
+func InitEmptyAliasContext(p *AliasContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SQLParserRULE_alias
 }
 
-func (s *TagFilterExprContext) T_NOTEQUAL() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_NOTEQUAL, 0)
+func (*AliasContext) IsAliasContext() {}
+
+func NewAliasContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AliasContext {
+	var p = new(AliasContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_alias
+
+	return p
 }
 
-func (s *TagFilterExprContext) T_NOTEQUAL2() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_NOTEQUAL2, 0)
+func (s *AliasContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *AliasContext) T_AS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_AS, 0)
 }
 
-func (s *TagFilterExprContext) TagValueList() ITagValueListContext {
+func (s *AliasContext) Ident() IIdentContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITagValueListContext); ok {
+		if _, ok := ctx.(IIdentContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -10688,313 +11663,2477 @@ func (s *TagFilterExprContext) TagValueList() ITagValueListContext {
 		return nil
 	}
 
-	return t.(ITagValueListContext)
-}
-
-func (s *TagFilterExprContext) T_IN() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_IN, 0)
-}
-
-func (s *TagFilterExprContext) T_AND() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_AND, 0)
-}
-
-func (s *TagFilterExprContext) T_OR() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_OR, 0)
+	return t.(IIdentContext)
 }
 
-func (s *TagFilterExprContext) GetRuleContext() antlr.RuleContext {
+func (s *AliasContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *TagFilterExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *AliasContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *TagFilterExprContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *AliasContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterTagFilterExpr(s)
+		listenerT.EnterAlias(s)
 	}
 }
 
-func (s *TagFilterExprContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *AliasContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitTagFilterExpr(s)
+		listenerT.ExitAlias(s)
 	}
 }
 
-func (s *TagFilterExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *AliasContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitTagFilterExpr(s)
+		return t.VisitAlias(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) TagFilterExpr() (localctx ITagFilterExprContext) {
-	return p.tagFilterExpr(0)
+func (p *SQLParser) Alias() (localctx IAliasContext) {
+	localctx = NewAliasContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 122, SQLParserRULE_alias)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(618)
+		p.Match(SQLParserT_AS)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(619)
+		p.Ident()
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-func (p *SQLParser) tagFilterExpr(_p int) (localctx ITagFilterExprContext) {
-	var _parentctx antlr.ParserRuleContext = p.GetParserRuleContext()
+// IBrokerFilterContext is an interface to support dynamic dispatch.
+type IBrokerFilterContext interface {
+	antlr.ParserRuleContext
 
-	_parentState := p.GetState()
-	localctx = NewTagFilterExprContext(p, p.GetParserRuleContext(), _parentState)
-	var _prevctx ITagFilterExprContext = localctx
-	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
-	_startState := 114
-	p.EnterRecursionRule(localctx, 114, SQLParserRULE_tagFilterExpr, _p)
-	var _la int
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
 
-	var _alt int
+	// Getter signatures
+	T_BROKER() antlr.TerminalNode
+	T_EQUAL() antlr.TerminalNode
+	Ident() IIdentContext
 
-	p.EnterOuterAlt(localctx, 1)
-	p.SetState(579)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
+	// IsBrokerFilterContext differentiates from other interfaces.
+	IsBrokerFilterContext()
+}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 28, p.GetParserRuleContext()) {
-	case 1:
-		{
-			p.SetState(552)
-			p.Match(SQLParserT_OPEN_P)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(553)
-			p.tagFilterExpr(0)
-		}
-		{
-			p.SetState(554)
-			p.Match(SQLParserT_CLOSE_P)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
+type BrokerFilterContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
 
-	case 2:
+func NewEmptyBrokerFilterContext() *BrokerFilterContext {
+	var p = new(BrokerFilterContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SQLParserRULE_brokerFilter
+	return p
+}
+
+func InitEmptyBrokerFilterContext(p *BrokerFilterContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SQLParserRULE_brokerFilter
+}
+
+func (*BrokerFilterContext) IsBrokerFilterContext() {}
+
+func NewBrokerFilterContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *BrokerFilterContext {
+	var p = new(BrokerFilterContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_brokerFilter
+
+	return p
+}
+
+func (s *BrokerFilterContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *BrokerFilterContext) T_BROKER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_BROKER, 0)
+}
+
+func (s *BrokerFilterContext) T_EQUAL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_EQUAL, 0)
+}
+
+func (s *BrokerFilterContext) Ident() IIdentContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentContext)
+}
+
+func (s *BrokerFilterContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *BrokerFilterContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *BrokerFilterContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterBrokerFilter(s)
+	}
+}
+
+func (s *BrokerFilterContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitBrokerFilter(s)
+	}
+}
+
+func (s *BrokerFilterContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitBrokerFilter(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) BrokerFilter() (localctx IBrokerFilterContext) {
+	localctx = NewBrokerFilterContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 124, SQLParserRULE_brokerFilter)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(621)
+		p.Match(SQLParserT_BROKER)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(622)
+		p.Match(SQLParserT_EQUAL)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(623)
+		p.Ident()
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IDatabaseFilterContext is an interface to support dynamic dispatch.
+type IDatabaseFilterContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	T_DATASBAE() antlr.TerminalNode
+	T_EQUAL() antlr.TerminalNode
+	Ident() IIdentContext
+
+	// IsDatabaseFilterContext differentiates from other interfaces.
+	IsDatabaseFilterContext()
+}
+
+type DatabaseFilterContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyDatabaseFilterContext() *DatabaseFilterContext {
+	var p = new(DatabaseFilterContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SQLParserRULE_databaseFilter
+	return p
+}
+
+func InitEmptyDatabaseFilterContext(p *DatabaseFilterContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SQLParserRULE_databaseFilter
+}
+
+func (*DatabaseFilterContext) IsDatabaseFilterContext() {}
+
+func NewDatabaseFilterContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DatabaseFilterContext {
+	var p = new(DatabaseFilterContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_databaseFilter
+
+	return p
+}
+
+func (s *DatabaseFilterContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *DatabaseFilterContext) T_DATASBAE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DATASBAE, 0)
+}
+
+func (s *DatabaseFilterContext) T_EQUAL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_EQUAL, 0)
+}
+
+func (s *DatabaseFilterContext) Ident() IIdentContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentContext)
+}
+
+func (s *DatabaseFilterContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *DatabaseFilterContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *DatabaseFilterContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterDatabaseFilter(s)
+	}
+}
+
+func (s *DatabaseFilterContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitDatabaseFilter(s)
+	}
+}
+
+func (s *DatabaseFilterContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitDatabaseFilter(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) DatabaseFilter() (localctx IDatabaseFilterContext) {
+	localctx = NewDatabaseFilterContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 126, SQLParserRULE_databaseFilter)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(625)
+		p.Match(SQLParserT_DATASBAE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(626)
+		p.Match(SQLParserT_EQUAL)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(627)
+		p.Ident()
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ITypeFilterContext is an interface to support dynamic dispatch.
+type ITypeFilterContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	T_TYPE() antlr.TerminalNode
+	T_EQUAL() antlr.TerminalNode
+	Ident() IIdentContext
+
+	// IsTypeFilterContext differentiates from other interfaces.
+	IsTypeFilterContext()
+}
+
+type TypeFilterContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyTypeFilterContext() *TypeFilterContext {
+	var p = new(TypeFilterContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SQLParserRULE_typeFilter
+	return p
+}
+
+func InitEmptyTypeFilterContext(p *TypeFilterContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SQLParserRULE_typeFilter
+}
+
+func (*TypeFilterContext) IsTypeFilterContext() {}
+
+func NewTypeFilterContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeFilterContext {
+	var p = new(TypeFilterContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_typeFilter
+
+	return p
+}
+
+func (s *TypeFilterContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TypeFilterContext) T_TYPE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TYPE, 0)
+}
+
+func (s *TypeFilterContext) T_EQUAL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_EQUAL, 0)
+}
+
+func (s *TypeFilterContext) Ident() IIdentContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentContext)
+}
+
+func (s *TypeFilterContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TypeFilterContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *TypeFilterContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterTypeFilter(s)
+	}
+}
+
+func (s *TypeFilterContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitTypeFilter(s)
+	}
+}
+
+func (s *TypeFilterContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitTypeFilter(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) TypeFilter() (localctx ITypeFilterContext) {
+	localctx = NewTypeFilterContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 128, SQLParserRULE_typeFilter)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(629)
+		p.Match(SQLParserT_TYPE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(630)
+		p.Match(SQLParserT_EQUAL)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(631)
+		p.Ident()
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IFromClauseContext is an interface to support dynamic dispatch.
+type IFromClauseContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	T_FROM() antlr.TerminalNode
+	MetricName() IMetricNameContext
+	T_ON() antlr.TerminalNode
+	Namespace() INamespaceContext
+
+	// IsFromClauseContext differentiates from other interfaces.
+	IsFromClauseContext()
+}
+
+type FromClauseContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyFromClauseContext() *FromClauseContext {
+	var p = new(FromClauseContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SQLParserRULE_fromClause
+	return p
+}
+
+func InitEmptyFromClauseContext(p *FromClauseContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SQLParserRULE_fromClause
+}
+
+func (*FromClauseContext) IsFromClauseContext() {}
+
+func NewFromClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FromClauseContext {
+	var p = new(FromClauseContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_fromClause
+
+	return p
+}
+
+func (s *FromClauseContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *FromClauseContext) T_FROM() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FROM, 0)
+}
+
+func (s *FromClauseContext) MetricName() IMetricNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IMetricNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMetricNameContext)
+}
+
+func (s *FromClauseContext) T_ON() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ON, 0)
+}
+
+func (s *FromClauseContext) Namespace() INamespaceContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INamespaceContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INamespaceContext)
+}
+
+func (s *FromClauseContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *FromClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *FromClauseContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterFromClause(s)
+	}
+}
+
+func (s *FromClauseContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitFromClause(s)
+	}
+}
+
+func (s *FromClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitFromClause(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) FromClause() (localctx IFromClauseContext) {
+	localctx = NewFromClauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 130, SQLParserRULE_fromClause)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(633)
+		p.Match(SQLParserT_FROM)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(634)
+		p.MetricName()
+	}
+	p.SetState(637)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_ON {
+		{
+			p.SetState(635)
+			p.Match(SQLParserT_ON)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(636)
+			p.Namespace()
+		}
+
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IJoinClauseContext is an interface to support dynamic dispatch.
+type IJoinClauseContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	T_FROM() antlr.TerminalNode
+	AllJoinMetric() []IJoinMetricContext
+	JoinMetric(i int) IJoinMetricContext
+	AllT_COMMA() []antlr.TerminalNode
+	T_COMMA(i int) antlr.TerminalNode
+	T_ON() antlr.TerminalNode
+	Namespace() INamespaceContext
+
+	// IsJoinClauseContext differentiates from other interfaces.
+	IsJoinClauseContext()
+}
+
+type JoinClauseContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyJoinClauseContext() *JoinClauseContext {
+	var p = new(JoinClauseContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SQLParserRULE_joinClause
+	return p
+}
+
+func InitEmptyJoinClauseContext(p *JoinClauseContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SQLParserRULE_joinClause
+}
+
+func (*JoinClauseContext) IsJoinClauseContext() {}
+
+func NewJoinClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *JoinClauseContext {
+	var p = new(JoinClauseContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_joinClause
+
+	return p
+}
+
+func (s *JoinClauseContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *JoinClauseContext) T_FROM() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FROM, 0)
+}
+
+func (s *JoinClauseContext) AllJoinMetric() []IJoinMetricContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IJoinMetricContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IJoinMetricContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IJoinMetricContext); ok {
+			tst[i] = t.(IJoinMetricContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *JoinClauseContext) JoinMetric(i int) IJoinMetricContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IJoinMetricContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IJoinMetricContext)
+}
+
+func (s *JoinClauseContext) AllT_COMMA() []antlr.TerminalNode {
+	return s.GetTokens(SQLParserT_COMMA)
+}
+
+func (s *JoinClauseContext) T_COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(SQLParserT_COMMA, i)
+}
+
+func (s *JoinClauseContext) T_ON() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ON, 0)
+}
+
+func (s *JoinClauseContext) Namespace() INamespaceContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INamespaceContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INamespaceContext)
+}
+
+func (s *JoinClauseContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *JoinClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *JoinClauseContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterJoinClause(s)
+	}
+}
+
+func (s *JoinClauseContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitJoinClause(s)
+	}
+}
+
+func (s *JoinClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitJoinClause(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) JoinClause() (localctx IJoinClauseContext) {
+	localctx = NewJoinClauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 132, SQLParserRULE_joinClause)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(639)
+		p.Match(SQLParserT_FROM)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(640)
+		p.JoinMetric()
+	}
+	p.SetState(643)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for ok := true; ok; ok = _la == SQLParserT_COMMA {
+		{
+			p.SetState(641)
+			p.Match(SQLParserT_COMMA)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(642)
+			p.JoinMetric()
+		}
+
+		p.SetState(645)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+	}
+	p.SetState(649)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_ON {
+		{
+			p.SetState(647)
+			p.Match(SQLParserT_ON)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(648)
+			p.Namespace()
+		}
+
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IJoinMetricContext is an interface to support dynamic dispatch.
+type IJoinMetricContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	MetricName() IMetricNameContext
+	T_AS() antlr.TerminalNode
+	MetricAlias() IMetricAliasContext
+
+	// IsJoinMetricContext differentiates from other interfaces.
+	IsJoinMetricContext()
+}
+
+type JoinMetricContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyJoinMetricContext() *JoinMetricContext {
+	var p = new(JoinMetricContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SQLParserRULE_joinMetric
+	return p
+}
+
+func InitEmptyJoinMetricContext(p *JoinMetricContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SQLParserRULE_joinMetric
+}
+
+func (*JoinMetricContext) IsJoinMetricContext() {}
+
+func NewJoinMetricContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *JoinMetricContext {
+	var p = new(JoinMetricContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_joinMetric
+
+	return p
+}
+
+func (s *JoinMetricContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *JoinMetricContext) MetricName() IMetricNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IMetricNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMetricNameContext)
+}
+
+func (s *JoinMetricContext) T_AS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_AS, 0)
+}
+
+func (s *JoinMetricContext) MetricAlias() IMetricAliasContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IMetricAliasContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMetricAliasContext)
+}
+
+func (s *JoinMetricContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *JoinMetricContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *JoinMetricContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterJoinMetric(s)
+	}
+}
+
+func (s *JoinMetricContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitJoinMetric(s)
+	}
+}
+
+func (s *JoinMetricContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitJoinMetric(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) JoinMetric() (localctx IJoinMetricContext) {
+	localctx = NewJoinMetricContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 134, SQLParserRULE_joinMetric)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(651)
+		p.MetricName()
+	}
+	{
+		p.SetState(652)
+		p.Match(SQLParserT_AS)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(653)
+		p.MetricAlias()
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IMetricAliasContext is an interface to support dynamic dispatch.
+type IMetricAliasContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	Ident() IIdentContext
+
+	// IsMetricAliasContext differentiates from other interfaces.
+	IsMetricAliasContext()
+}
+
+type MetricAliasContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyMetricAliasContext() *MetricAliasContext {
+	var p = new(MetricAliasContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SQLParserRULE_metricAlias
+	return p
+}
+
+func InitEmptyMetricAliasContext(p *MetricAliasContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SQLParserRULE_metricAlias
+}
+
+func (*MetricAliasContext) IsMetricAliasContext() {}
+
+func NewMetricAliasContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MetricAliasContext {
+	var p = new(MetricAliasContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_metricAlias
+
+	return p
+}
+
+func (s *MetricAliasContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *MetricAliasContext) Ident() IIdentContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentContext)
+}
+
+func (s *MetricAliasContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *MetricAliasContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *MetricAliasContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterMetricAlias(s)
+	}
+}
+
+func (s *MetricAliasContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitMetricAlias(s)
+	}
+}
+
+func (s *MetricAliasContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitMetricAlias(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) MetricAlias() (localctx IMetricAliasContext) {
+	localctx = NewMetricAliasContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 136, SQLParserRULE_metricAlias)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(655)
+		p.Ident()
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ISubQueryClauseContext is an interface to support dynamic dispatch.
+type ISubQueryClauseContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	T_FROM() antlr.TerminalNode
+	T_OPEN_P() antlr.TerminalNode
+	QueryStmt() IQueryStmtContext
+	T_CLOSE_P() antlr.TerminalNode
+
+	// IsSubQueryClauseContext differentiates from other interfaces.
+	IsSubQueryClauseContext()
+}
+
+type SubQueryClauseContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySubQueryClauseContext() *SubQueryClauseContext {
+	var p = new(SubQueryClauseContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SQLParserRULE_subQueryClause
+	return p
+}
+
+func InitEmptySubQueryClauseContext(p *SubQueryClauseContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SQLParserRULE_subQueryClause
+}
+
+func (*SubQueryClauseContext) IsSubQueryClauseContext() {}
+
+func NewSubQueryClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SubQueryClauseContext {
+	var p = new(SubQueryClauseContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_subQueryClause
+
+	return p
+}
+
+func (s *SubQueryClauseContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SubQueryClauseContext) T_FROM() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FROM, 0)
+}
+
+func (s *SubQueryClauseContext) T_OPEN_P() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_OPEN_P, 0)
+}
+
+func (s *SubQueryClauseContext) QueryStmt() IQueryStmtContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IQueryStmtContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IQueryStmtContext)
+}
+
+func (s *SubQueryClauseContext) T_CLOSE_P() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CLOSE_P, 0)
+}
+
+func (s *SubQueryClauseContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SubQueryClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *SubQueryClauseContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterSubQueryClause(s)
+	}
+}
+
+func (s *SubQueryClauseContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitSubQueryClause(s)
+	}
+}
+
+func (s *SubQueryClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitSubQueryClause(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) SubQueryClause() (localctx ISubQueryClauseContext) {
+	localctx = NewSubQueryClauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 138, SQLParserRULE_subQueryClause)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(657)
+		p.Match(SQLParserT_FROM)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(658)
+		p.Match(SQLParserT_OPEN_P)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(659)
+		p.QueryStmt()
+	}
+	{
+		p.SetState(660)
+		p.Match(SQLParserT_CLOSE_P)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IWhereClauseContext is an interface to support dynamic dispatch.
+type IWhereClauseContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	T_WHERE() antlr.TerminalNode
+	ConditionExpr() IConditionExprContext
+
+	// IsWhereClauseContext differentiates from other interfaces.
+	IsWhereClauseContext()
+}
+
+type WhereClauseContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyWhereClauseContext() *WhereClauseContext {
+	var p = new(WhereClauseContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SQLParserRULE_whereClause
+	return p
+}
+
+func InitEmptyWhereClauseContext(p *WhereClauseContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SQLParserRULE_whereClause
+}
+
+func (*WhereClauseContext) IsWhereClauseContext() {}
+
+func NewWhereClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *WhereClauseContext {
+	var p = new(WhereClauseContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_whereClause
+
+	return p
+}
+
+func (s *WhereClauseContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *WhereClauseContext) T_WHERE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WHERE, 0)
+}
+
+func (s *WhereClauseContext) ConditionExpr() IConditionExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IConditionExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IConditionExprContext)
+}
+
+func (s *WhereClauseContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *WhereClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *WhereClauseContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterWhereClause(s)
+	}
+}
+
+func (s *WhereClauseContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitWhereClause(s)
+	}
+}
+
+func (s *WhereClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitWhereClause(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) WhereClause() (localctx IWhereClauseContext) {
+	localctx = NewWhereClauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 140, SQLParserRULE_whereClause)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(662)
+		p.Match(SQLParserT_WHERE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(663)
+		p.ConditionExpr()
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IConditionExprContext is an interface to support dynamic dispatch.
+type IConditionExprContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	TagFilterExpr() ITagFilterExprContext
+	T_AND() antlr.TerminalNode
+	TimeRangeExpr() ITimeRangeExprContext
+
+	// IsConditionExprContext differentiates from other interfaces.
+	IsConditionExprContext()
+}
+
+type ConditionExprContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyConditionExprContext() *ConditionExprContext {
+	var p = new(ConditionExprContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SQLParserRULE_conditionExpr
+	return p
+}
+
+func InitEmptyConditionExprContext(p *ConditionExprContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SQLParserRULE_conditionExpr
+}
+
+func (*ConditionExprContext) IsConditionExprContext() {}
+
+func NewConditionExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ConditionExprContext {
+	var p = new(ConditionExprContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_conditionExpr
+
+	return p
+}
+
+func (s *ConditionExprContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ConditionExprContext) TagFilterExpr() ITagFilterExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITagFilterExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITagFilterExprContext)
+}
+
+func (s *ConditionExprContext) T_AND() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_AND, 0)
+}
+
+func (s *ConditionExprContext) TimeRangeExpr() ITimeRangeExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITimeRangeExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITimeRangeExprContext)
+}
+
+func (s *ConditionExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ConditionExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ConditionExprContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterConditionExpr(s)
+	}
+}
+
+func (s *ConditionExprContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitConditionExpr(s)
+	}
+}
+
+func (s *ConditionExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitConditionExpr(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) ConditionExpr() (localctx IConditionExprContext) {
+	localctx = NewConditionExprContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 142, SQLParserRULE_conditionExpr)
+	var _la int
+
+	p.SetState(675)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 30, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(665)
+			p.tagFilterExpr(0)
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(666)
+			p.tagFilterExpr(0)
+		}
+		{
+			p.SetState(667)
+			p.Match(SQLParserT_AND)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(668)
+			p.TimeRangeExpr()
+		}
+
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(670)
+			p.TimeRangeExpr()
+		}
+		p.SetState(673)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == SQLParserT_AND {
+			{
+				p.SetState(671)
+				p.Match(SQLParserT_AND)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(672)
+				p.tagFilterExpr(0)
+			}
+
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ITagFilterExprContext is an interface to support dynamic dispatch.
+type ITagFilterExprContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	T_OPEN_P() antlr.TerminalNode
+	AllTagFilterExpr() []ITagFilterExprContext
+	TagFilterExpr(i int) ITagFilterExprContext
+	T_CLOSE_P() antlr.TerminalNode
+	TagKey() ITagKeyContext
+	TagValue() ITagValueContext
+	T_EQUAL() antlr.TerminalNode
+	T_LIKE() antlr.TerminalNode
+	T_NOT() antlr.TerminalNode
+	T_REGEXP() antlr.TerminalNode
+	T_NEQREGEXP() antlr.TerminalNode
+	T_NOTEQUAL() antlr.TerminalNode
+	T_NOTEQUAL2() antlr.TerminalNode
+	TagValueList() ITagValueListContext
+	T_IN() antlr.TerminalNode
+	T_AND() antlr.TerminalNode
+	T_OR() antlr.TerminalNode
+
+	// IsTagFilterExprContext differentiates from other interfaces.
+	IsTagFilterExprContext()
+}
+
+type TagFilterExprContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyTagFilterExprContext() *TagFilterExprContext {
+	var p = new(TagFilterExprContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SQLParserRULE_tagFilterExpr
+	return p
+}
+
+func InitEmptyTagFilterExprContext(p *TagFilterExprContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SQLParserRULE_tagFilterExpr
+}
+
+func (*TagFilterExprContext) IsTagFilterExprContext() {}
+
+func NewTagFilterExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TagFilterExprContext {
+	var p = new(TagFilterExprContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_tagFilterExpr
+
+	return p
+}
+
+func (s *TagFilterExprContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TagFilterExprContext) T_OPEN_P() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_OPEN_P, 0)
+}
+
+func (s *TagFilterExprContext) AllTagFilterExpr() []ITagFilterExprContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITagFilterExprContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ITagFilterExprContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITagFilterExprContext); ok {
+			tst[i] = t.(ITagFilterExprContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *TagFilterExprContext) TagFilterExpr(i int) ITagFilterExprContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITagFilterExprContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITagFilterExprContext)
+}
+
+func (s *TagFilterExprContext) T_CLOSE_P() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CLOSE_P, 0)
+}
+
+func (s *TagFilterExprContext) TagKey() ITagKeyContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITagKeyContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITagKeyContext)
+}
+
+func (s *TagFilterExprContext) TagValue() ITagValueContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITagValueContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITagValueContext)
+}
+
+func (s *TagFilterExprContext) T_EQUAL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_EQUAL, 0)
+}
+
+func (s *TagFilterExprContext) T_LIKE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_LIKE, 0)
+}
+
+func (s *TagFilterExprContext) T_NOT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_NOT, 0)
+}
+
+func (s *TagFilterExprContext) T_REGEXP() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_REGEXP, 0)
+}
+
+func (s *TagFilterExprContext) T_NEQREGEXP() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_NEQREGEXP, 0)
+}
+
+func (s *TagFilterExprContext) T_NOTEQUAL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_NOTEQUAL, 0)
+}
+
+func (s *TagFilterExprContext) T_NOTEQUAL2() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_NOTEQUAL2, 0)
+}
+
+func (s *TagFilterExprContext) TagValueList() ITagValueListContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITagValueListContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITagValueListContext)
+}
+
+func (s *TagFilterExprContext) T_IN() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_IN, 0)
+}
+
+func (s *TagFilterExprContext) T_AND() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_AND, 0)
+}
+
+func (s *TagFilterExprContext) T_OR() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_OR, 0)
+}
+
+func (s *TagFilterExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TagFilterExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *TagFilterExprContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterTagFilterExpr(s)
+	}
+}
+
+func (s *TagFilterExprContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitTagFilterExpr(s)
+	}
+}
+
+func (s *TagFilterExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitTagFilterExpr(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) TagFilterExpr() (localctx ITagFilterExprContext) {
+	return p.tagFilterExpr(0)
+}
+
+func (p *SQLParser) tagFilterExpr(_p int) (localctx ITagFilterExprContext) {
+	var _parentctx antlr.ParserRuleContext = p.GetParserRuleContext()
+
+	_parentState := p.GetState()
+	localctx = NewTagFilterExprContext(p, p.GetParserRuleContext(), _parentState)
+	var _prevctx ITagFilterExprContext = localctx
+	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
+	_startState := 144
+	p.EnterRecursionRule(localctx, 144, SQLParserRULE_tagFilterExpr, _p)
+	var _la int
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(705)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 33, p.GetParserRuleContext()) {
+	case 1:
+		{
+			p.SetState(678)
+			p.Match(SQLParserT_OPEN_P)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(679)
+			p.tagFilterExpr(0)
+		}
+		{
+			p.SetState(680)
+			p.Match(SQLParserT_CLOSE_P)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case 2:
+		{
+			p.SetState(682)
+			p.TagKey()
+		}
+		p.SetState(691)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+
+		switch p.GetTokenStream().LA(1) {
+		case SQLParserT_EQUAL:
+			{
+				p.SetState(683)
+				p.Match(SQLParserT_EQUAL)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		case SQLParserT_LIKE:
+			{
+				p.SetState(684)
+				p.Match(SQLParserT_LIKE)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		case SQLParserT_NOT:
+			{
+				p.SetState(685)
+				p.Match(SQLParserT_NOT)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(686)
+				p.Match(SQLParserT_LIKE)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		case SQLParserT_REGEXP:
+			{
+				p.SetState(687)
+				p.Match(SQLParserT_REGEXP)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		case SQLParserT_NEQREGEXP:
+			{
+				p.SetState(688)
+				p.Match(SQLParserT_NEQREGEXP)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		case SQLParserT_NOTEQUAL:
+			{
+				p.SetState(689)
+				p.Match(SQLParserT_NOTEQUAL)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		case SQLParserT_NOTEQUAL2:
+			{
+				p.SetState(690)
+				p.Match(SQLParserT_NOTEQUAL2)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		default:
+			p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			goto errorExit
+		}
+		{
+			p.SetState(693)
+			p.TagValue()
+		}
+
+	case 3:
 		{
-			p.SetState(556)
+			p.SetState(695)
 			p.TagKey()
 		}
-		p.SetState(565)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
+		p.SetState(699)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+
+		switch p.GetTokenStream().LA(1) {
+		case SQLParserT_IN:
+			{
+				p.SetState(696)
+				p.Match(SQLParserT_IN)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		case SQLParserT_NOT:
+			{
+				p.SetState(697)
+				p.Match(SQLParserT_NOT)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(698)
+				p.Match(SQLParserT_IN)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+		default:
+			p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			goto errorExit
+		}
+		{
+			p.SetState(701)
+			p.Match(SQLParserT_OPEN_P)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(702)
+			p.TagValueList()
+		}
+		{
+			p.SetState(703)
+			p.Match(SQLParserT_CLOSE_P)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
+	}
+	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
+	p.SetState(712)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 34, p.GetParserRuleContext())
+	if p.HasError() {
+		goto errorExit
+	}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			if p.GetParseListeners() != nil {
+				p.TriggerExitRuleEvent()
+			}
+			_prevctx = localctx
+			localctx = NewTagFilterExprContext(p, _parentctx, _parentState)
+			p.PushNewRecursionContext(localctx, _startState, SQLParserRULE_tagFilterExpr)
+			p.SetState(707)
+
+			if !(p.Precpred(p.GetParserRuleContext(), 1)) {
+				p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 1)", ""))
+				goto errorExit
+			}
+			{
+				p.SetState(708)
+				_la = p.GetTokenStream().LA(1)
+
+				if !(_la == SQLParserT_AND || _la == SQLParserT_OR) {
+					p.GetErrorHandler().RecoverInline(p)
+				} else {
+					p.GetErrorHandler().ReportMatch(p)
+					p.Consume()
+				}
+			}
+			{
+				p.SetState(709)
+				p.tagFilterExpr(2)
+			}
+
+		}
+		p.SetState(714)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 34, p.GetParserRuleContext())
+		if p.HasError() {
+			goto errorExit
+		}
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.UnrollRecursionContexts(_parentctx)
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ITagValueListContext is an interface to support dynamic dispatch.
+type ITagValueListContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	AllTagValue() []ITagValueContext
+	TagValue(i int) ITagValueContext
+	AllT_COMMA() []antlr.TerminalNode
+	T_COMMA(i int) antlr.TerminalNode
+
+	// IsTagValueListContext differentiates from other interfaces.
+	IsTagValueListContext()
+}
+
+type TagValueListContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyTagValueListContext() *TagValueListContext {
+	var p = new(TagValueListContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SQLParserRULE_tagValueList
+	return p
+}
+
+func InitEmptyTagValueListContext(p *TagValueListContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SQLParserRULE_tagValueList
+}
+
+func (*TagValueListContext) IsTagValueListContext() {}
+
+func NewTagValueListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TagValueListContext {
+	var p = new(TagValueListContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_tagValueList
+
+	return p
+}
+
+func (s *TagValueListContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TagValueListContext) AllTagValue() []ITagValueContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITagValueContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ITagValueContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITagValueContext); ok {
+			tst[i] = t.(ITagValueContext)
+			i++
 		}
+	}
 
-		switch p.GetTokenStream().LA(1) {
-		case SQLParserT_EQUAL:
-			{
-				p.SetState(557)
-				p.Match(SQLParserT_EQUAL)
-				if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-				}
-			}
+	return tst
+}
 
-		case SQLParserT_LIKE:
-			{
-				p.SetState(558)
-				p.Match(SQLParserT_LIKE)
-				if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-				}
+func (s *TagValueListContext) TagValue(i int) ITagValueContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITagValueContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
 			}
+			j++
+		}
+	}
 
-		case SQLParserT_NOT:
-			{
-				p.SetState(559)
-				p.Match(SQLParserT_NOT)
-				if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-				}
-			}
-			{
-				p.SetState(560)
-				p.Match(SQLParserT_LIKE)
-				if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-				}
-			}
+	if t == nil {
+		return nil
+	}
 
-		case SQLParserT_REGEXP:
-			{
-				p.SetState(561)
-				p.Match(SQLParserT_REGEXP)
-				if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-				}
-			}
+	return t.(ITagValueContext)
+}
 
-		case SQLParserT_NEQREGEXP:
-			{
-				p.SetState(562)
-				p.Match(SQLParserT_NEQREGEXP)
-				if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-				}
-			}
+func (s *TagValueListContext) AllT_COMMA() []antlr.TerminalNode {
+	return s.GetTokens(SQLParserT_COMMA)
+}
 
-		case SQLParserT_NOTEQUAL:
-			{
-				p.SetState(563)
-				p.Match(SQLParserT_NOTEQUAL)
-				if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-				}
-			}
+func (s *TagValueListContext) T_COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(SQLParserT_COMMA, i)
+}
 
-		case SQLParserT_NOTEQUAL2:
-			{
-				p.SetState(564)
-				p.Match(SQLParserT_NOTEQUAL2)
-				if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-				}
-			}
+func (s *TagValueListContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
 
-		default:
-			p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-			goto errorExit
+func (s *TagValueListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *TagValueListContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterTagValueList(s)
+	}
+}
+
+func (s *TagValueListContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitTagValueList(s)
+	}
+}
+
+func (s *TagValueListContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitTagValueList(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SQLParser) TagValueList() (localctx ITagValueListContext) {
+	localctx = NewTagValueListContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 146, SQLParserRULE_tagValueList)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(715)
+		p.TagValue()
+	}
+	p.SetState(720)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == SQLParserT_COMMA {
+		{
+			p.SetState(716)
+			p.Match(SQLParserT_COMMA)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
 		{
-			p.SetState(567)
+			p.SetState(717)
 			p.TagValue()
 		}
 
-	case 3:
-		{
-			p.SetState(569)
-			p.TagKey()
-		}
-		p.SetState(573)
+		p.SetState(722)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
+		_la = p.GetTokenStream().LA(1)
+	}
 
-		switch p.GetTokenStream().LA(1) {
-		case SQLParserT_IN:
-			{
-				p.SetState(570)
-				p.Match(SQLParserT_IN)
-				if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-				}
-			}
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IMetricListFilterContext is an interface to support dynamic dispatch.
+type IMetricListFilterContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	T_METRIC() antlr.TerminalNode
+	T_IN() antlr.TerminalNode
+	T_OPEN_P() antlr.TerminalNode
+	MetricList() IMetricListContext
+	T_CLOSE_P() antlr.TerminalNode
+
+	// IsMetricListFilterContext differentiates from other interfaces.
+	IsMetricListFilterContext()
+}
+
+type MetricListFilterContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyMetricListFilterContext() *MetricListFilterContext {
+	var p = new(MetricListFilterContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SQLParserRULE_metricListFilter
+	return p
+}
+
+func InitEmptyMetricListFilterContext(p *MetricListFilterContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = SQLParserRULE_metricListFilter
+}
+
+func (*MetricListFilterContext) IsMetricListFilterContext() {}
+
+func NewMetricListFilterContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MetricListFilterContext {
+	var p = new(MetricListFilterContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_metricListFilter
+
+	return p
+}
+
+func (s *MetricListFilterContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *MetricListFilterContext) T_METRIC() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_METRIC, 0)
+}
+
+func (s *MetricListFilterContext) T_IN() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_IN, 0)
+}
 
-		case SQLParserT_NOT:
-			{
-				p.SetState(571)
-				p.Match(SQLParserT_NOT)
-				if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-				}
-			}
-			{
-				p.SetState(572)
-				p.Match(SQLParserT_IN)
-				if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-				}
-			}
+func (s *MetricListFilterContext) T_OPEN_P() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_OPEN_P, 0)
+}
 
-		default:
-			p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-			goto errorExit
-		}
-		{
-			p.SetState(575)
-			p.Match(SQLParserT_OPEN_P)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(576)
-			p.TagValueList()
-		}
-		{
-			p.SetState(577)
-			p.Match(SQLParserT_CLOSE_P)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
+func (s *MetricListFilterContext) MetricList() IMetricListContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IMetricListContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
+	}
 
-	case antlr.ATNInvalidAltNumber:
-		goto errorExit
+	if t == nil {
+		return nil
 	}
-	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(586)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
+
+	return t.(IMetricListContext)
+}
+
+func (s *MetricListFilterContext) T_CLOSE_P() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CLOSE_P, 0)
+}
+
+func (s *MetricListFilterContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *MetricListFilterContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *MetricListFilterContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterMetricListFilter(s)
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 29, p.GetParserRuleContext())
-	if p.HasError() {
-		goto errorExit
+}
+
+func (s *MetricListFilterContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitMetricListFilter(s)
 	}
-	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-		if _alt == 1 {
-			if p.GetParseListeners() != nil {
-				p.TriggerExitRuleEvent()
-			}
-			_prevctx = localctx
-			localctx = NewTagFilterExprContext(p, _parentctx, _parentState)
-			p.PushNewRecursionContext(localctx, _startState, SQLParserRULE_tagFilterExpr)
-			p.SetState(581)
+}
 
-			if !(p.Precpred(p.GetParserRuleContext(), 1)) {
-				p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 1)", ""))
-				goto errorExit
-			}
-			{
-				p.SetState(582)
-				_la = p.GetTokenStream().LA(1)
+func (s *MetricListFilterContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitMetricListFilter(s)
 
-				if !(_la == SQLParserT_AND || _la == SQLParserT_OR) {
-					p.GetErrorHandler().RecoverInline(p)
-				} else {
-					p.GetErrorHandler().ReportMatch(p)
-					p.Consume()
-				}
-			}
-			{
-				p.SetState(583)
-				p.tagFilterExpr(2)
-			}
+	default:
+		return t.VisitChildren(s)
+	}
+}
 
+func (p *SQLParser) MetricListFilter() (localctx IMetricListFilterContext) {
+	localctx = NewMetricListFilterContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 148, SQLParserRULE_metricListFilter)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(723)
+		p.Match(SQLParserT_METRIC)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
-		p.SetState(588)
-		p.GetErrorHandler().Sync(p)
+	}
+	{
+		p.SetState(724)
+		p.Match(SQLParserT_IN)
 		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+
+	{
+		p.SetState(725)
+		p.Match(SQLParserT_OPEN_P)
+		if p.HasError() {
+			// Recognition error - abort rule
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 29, p.GetParserRuleContext())
+	}
+	{
+		p.SetState(726)
+		p.MetricList()
+	}
+	{
+		p.SetState(727)
+		p.Match(SQLParserT_CLOSE_P)
 		if p.HasError() {
+			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
@@ -11007,74 +14146,74 @@ errorExit:
 		p.GetErrorHandler().Recover(p, v)
 		p.SetError(nil)
 	}
-	p.UnrollRecursionContexts(_parentctx)
+	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ITagValueListContext is an interface to support dynamic dispatch.
-type ITagValueListContext interface {
+// IMetricListContext is an interface to support dynamic dispatch.
+type IMetricListContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	AllTagValue() []ITagValueContext
-	TagValue(i int) ITagValueContext
+	AllIdent() []IIdentContext
+	Ident(i int) IIdentContext
 	AllT_COMMA() []antlr.TerminalNode
 	T_COMMA(i int) antlr.TerminalNode
 
-	// IsTagValueListContext differentiates from other interfaces.
-	IsTagValueListContext()
+	// IsMetricListContext differentiates from other interfaces.
+	IsMetricListContext()
 }
 
-type TagValueListContext struct {
+type MetricListContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyTagValueListContext() *TagValueListContext {
-	var p = new(TagValueListContext)
+func NewEmptyMetricListContext() *MetricListContext {
+	var p = new(MetricListContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_tagValueList
+	p.RuleIndex = SQLParserRULE_metricList
 	return p
 }
 
-func InitEmptyTagValueListContext(p *TagValueListContext) {
+func InitEmptyMetricListContext(p *MetricListContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_tagValueList
+	p.RuleIndex = SQLParserRULE_metricList
 }
 
-func (*TagValueListContext) IsTagValueListContext() {}
+func (*MetricListContext) IsMetricListContext() {}
 
-func NewTagValueListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TagValueListContext {
-	var p = new(TagValueListContext)
+func NewMetricListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MetricListContext {
+	var p = new(MetricListContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_tagValueList
+	p.RuleIndex = SQLParserRULE_metricList
 
 	return p
 }
 
-func (s *TagValueListContext) GetParser() antlr.Parser { return s.parser }
+func (s *MetricListContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *TagValueListContext) AllTagValue() []ITagValueContext {
+func (s *MetricListContext) AllIdent() []IIdentContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
-		if _, ok := ctx.(ITagValueContext); ok {
+		if _, ok := ctx.(IIdentContext); ok {
 			len++
 		}
 	}
 
-	tst := make([]ITagValueContext, len)
+	tst := make([]IIdentContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(ITagValueContext); ok {
-			tst[i] = t.(ITagValueContext)
+		if t, ok := ctx.(IIdentContext); ok {
+			tst[i] = t.(IIdentContext)
 			i++
 		}
 	}
@@ -11082,11 +14221,11 @@ func (s *TagValueListContext) AllTagValue() []ITagValueContext {
 	return tst
 }
 
-func (s *TagValueListContext) TagValue(i int) ITagValueContext {
+func (s *MetricListContext) Ident(i int) IIdentContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITagValueContext); ok {
+		if _, ok := ctx.(IIdentContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext)
 				break
@@ -11099,58 +14238,58 @@ func (s *TagValueListContext) TagValue(i int) ITagValueContext {
 		return nil
 	}
 
-	return t.(ITagValueContext)
+	return t.(IIdentContext)
 }
 
-func (s *TagValueListContext) AllT_COMMA() []antlr.TerminalNode {
+func (s *MetricListContext) AllT_COMMA() []antlr.TerminalNode {
 	return s.GetTokens(SQLParserT_COMMA)
 }
 
-func (s *TagValueListContext) T_COMMA(i int) antlr.TerminalNode {
+func (s *MetricListContext) T_COMMA(i int) antlr.TerminalNode {
 	return s.GetToken(SQLParserT_COMMA, i)
 }
 
-func (s *TagValueListContext) GetRuleContext() antlr.RuleContext {
+func (s *MetricListContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *TagValueListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *MetricListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *TagValueListContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *MetricListContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterTagValueList(s)
+		listenerT.EnterMetricList(s)
 	}
 }
 
-func (s *TagValueListContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *MetricListContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitTagValueList(s)
+		listenerT.ExitMetricList(s)
 	}
 }
 
-func (s *TagValueListContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *MetricListContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitTagValueList(s)
+		return t.VisitMetricList(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) TagValueList() (localctx ITagValueListContext) {
-	localctx = NewTagValueListContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 116, SQLParserRULE_tagValueList)
+func (p *SQLParser) MetricList() (localctx IMetricListContext) {
+	localctx = NewMetricListContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 150, SQLParserRULE_metricList)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(589)
-		p.TagValue()
+		p.SetState(729)
+		p.Ident()
 	}
-	p.SetState(594)
+	p.SetState(734)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -11159,7 +14298,7 @@ func (p *SQLParser) TagValueList() (localctx ITagValueListContext) {
 
 	for _la == SQLParserT_COMMA {
 		{
-			p.SetState(590)
+			p.SetState(730)
 			p.Match(SQLParserT_COMMA)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -11167,11 +14306,11 @@ func (p *SQLParser) TagValueList() (localctx ITagValueListContext) {
 			}
 		}
 		{
-			p.SetState(591)
-			p.TagValue()
+			p.SetState(731)
+			p.Ident()
 		}
 
-		p.SetState(596)
+		p.SetState(736)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -11192,74 +14331,85 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IMetricListFilterContext is an interface to support dynamic dispatch.
-type IMetricListFilterContext interface {
+// ITimeRangeExprContext is an interface to support dynamic dispatch.
+type ITimeRangeExprContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	T_METRIC() antlr.TerminalNode
-	T_IN() antlr.TerminalNode
-	T_OPEN_P() antlr.TerminalNode
-	MetricList() IMetricListContext
-	T_CLOSE_P() antlr.TerminalNode
+	AllTimeExpr() []ITimeExprContext
+	TimeExpr(i int) ITimeExprContext
+	T_AND() antlr.TerminalNode
 
-	// IsMetricListFilterContext differentiates from other interfaces.
-	IsMetricListFilterContext()
+	// IsTimeRangeExprContext differentiates from other interfaces.
+	IsTimeRangeExprContext()
 }
 
-type MetricListFilterContext struct {
+type TimeRangeExprContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyMetricListFilterContext() *MetricListFilterContext {
-	var p = new(MetricListFilterContext)
+func NewEmptyTimeRangeExprContext() *TimeRangeExprContext {
+	var p = new(TimeRangeExprContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_metricListFilter
+	p.RuleIndex = SQLParserRULE_timeRangeExpr
 	return p
 }
 
-func InitEmptyMetricListFilterContext(p *MetricListFilterContext) {
+func InitEmptyTimeRangeExprContext(p *TimeRangeExprContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_metricListFilter
+	p.RuleIndex = SQLParserRULE_timeRangeExpr
 }
 
-func (*MetricListFilterContext) IsMetricListFilterContext() {}
+func (*TimeRangeExprContext) IsTimeRangeExprContext() {}
 
-func NewMetricListFilterContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MetricListFilterContext {
-	var p = new(MetricListFilterContext)
+func NewTimeRangeExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TimeRangeExprContext {
+	var p = new(TimeRangeExprContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_metricListFilter
+	p.RuleIndex = SQLParserRULE_timeRangeExpr
 
 	return p
 }
 
-func (s *MetricListFilterContext) GetParser() antlr.Parser { return s.parser }
+func (s *TimeRangeExprContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *MetricListFilterContext) T_METRIC() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_METRIC, 0)
-}
+func (s *TimeRangeExprContext) AllTimeExpr() []ITimeExprContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITimeExprContext); ok {
+			len++
+		}
+	}
 
-func (s *MetricListFilterContext) T_IN() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_IN, 0)
-}
+	tst := make([]ITimeExprContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITimeExprContext); ok {
+			tst[i] = t.(ITimeExprContext)
+			i++
+		}
+	}
 
-func (s *MetricListFilterContext) T_OPEN_P() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_OPEN_P, 0)
+	return tst
 }
 
-func (s *MetricListFilterContext) MetricList() IMetricListContext {
+func (s *TimeRangeExprContext) TimeExpr(i int) ITimeExprContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IMetricListContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		if _, ok := ctx.(ITimeExprContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -11267,83 +14417,70 @@ func (s *MetricListFilterContext) MetricList() IMetricListContext {
 		return nil
 	}
 
-	return t.(IMetricListContext)
+	return t.(ITimeExprContext)
 }
 
-func (s *MetricListFilterContext) T_CLOSE_P() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_CLOSE_P, 0)
+func (s *TimeRangeExprContext) T_AND() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_AND, 0)
 }
 
-func (s *MetricListFilterContext) GetRuleContext() antlr.RuleContext {
+func (s *TimeRangeExprContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *MetricListFilterContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *TimeRangeExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *MetricListFilterContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TimeRangeExprContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterMetricListFilter(s)
+		listenerT.EnterTimeRangeExpr(s)
 	}
 }
 
-func (s *MetricListFilterContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TimeRangeExprContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitMetricListFilter(s)
+		listenerT.ExitTimeRangeExpr(s)
 	}
 }
 
-func (s *MetricListFilterContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *TimeRangeExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitMetricListFilter(s)
+		return t.VisitTimeRangeExpr(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) MetricListFilter() (localctx IMetricListFilterContext) {
-	localctx = NewMetricListFilterContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 118, SQLParserRULE_metricListFilter)
+func (p *SQLParser) TimeRangeExpr() (localctx ITimeRangeExprContext) {
+	localctx = NewTimeRangeExprContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 152, SQLParserRULE_timeRangeExpr)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(597)
-		p.Match(SQLParserT_METRIC)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(598)
-		p.Match(SQLParserT_IN)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
+		p.SetState(737)
+		p.TimeExpr()
 	}
+	p.SetState(740)
+	p.GetErrorHandler().Sync(p)
 
-	{
-		p.SetState(599)
-		p.Match(SQLParserT_OPEN_P)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 37, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(738)
+			p.Match(SQLParserT_AND)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
-	}
-	{
-		p.SetState(600)
-		p.MetricList()
-	}
-	{
-		p.SetState(601)
-		p.Match(SQLParserT_CLOSE_P)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
+		{
+			p.SetState(739)
+			p.TimeExpr()
 		}
+
+	} else if p.HasError() { // JIM
+		goto errorExit
 	}
 
 errorExit:
@@ -11359,86 +14496,81 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IMetricListContext is an interface to support dynamic dispatch.
-type IMetricListContext interface {
+// ITimeExprContext is an interface to support dynamic dispatch.
+type ITimeExprContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	AllIdent() []IIdentContext
-	Ident(i int) IIdentContext
-	AllT_COMMA() []antlr.TerminalNode
-	T_COMMA(i int) antlr.TerminalNode
+	T_TIME() antlr.TerminalNode
+	BinaryOperator() IBinaryOperatorContext
+	NowExpr() INowExprContext
+	Ident() IIdentContext
 
-	// IsMetricListContext differentiates from other interfaces.
-	IsMetricListContext()
+	// IsTimeExprContext differentiates from other interfaces.
+	IsTimeExprContext()
 }
 
-type MetricListContext struct {
+type TimeExprContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyMetricListContext() *MetricListContext {
-	var p = new(MetricListContext)
+func NewEmptyTimeExprContext() *TimeExprContext {
+	var p = new(TimeExprContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_metricList
+	p.RuleIndex = SQLParserRULE_timeExpr
 	return p
 }
 
-func InitEmptyMetricListContext(p *MetricListContext) {
+func InitEmptyTimeExprContext(p *TimeExprContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_metricList
+	p.RuleIndex = SQLParserRULE_timeExpr
 }
 
-func (*MetricListContext) IsMetricListContext() {}
+func (*TimeExprContext) IsTimeExprContext() {}
 
-func NewMetricListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MetricListContext {
-	var p = new(MetricListContext)
+func NewTimeExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TimeExprContext {
+	var p = new(TimeExprContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_metricList
+	p.RuleIndex = SQLParserRULE_timeExpr
 
 	return p
 }
 
-func (s *MetricListContext) GetParser() antlr.Parser { return s.parser }
+func (s *TimeExprContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *MetricListContext) AllIdent() []IIdentContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IIdentContext); ok {
-			len++
+func (s *TimeExprContext) T_TIME() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TIME, 0)
+}
+
+func (s *TimeExprContext) BinaryOperator() IBinaryOperatorContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBinaryOperatorContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
-	tst := make([]IIdentContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IIdentContext); ok {
-			tst[i] = t.(IIdentContext)
-			i++
-		}
+	if t == nil {
+		return nil
 	}
 
-	return tst
+	return t.(IBinaryOperatorContext)
 }
 
-func (s *MetricListContext) Ident(i int) IIdentContext {
+func (s *TimeExprContext) NowExpr() INowExprContext {
 	var t antlr.RuleContext
-	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIdentContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
+		if _, ok := ctx.(INowExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
@@ -11446,84 +14578,92 @@ func (s *MetricListContext) Ident(i int) IIdentContext {
 		return nil
 	}
 
-	return t.(IIdentContext)
+	return t.(INowExprContext)
 }
 
-func (s *MetricListContext) AllT_COMMA() []antlr.TerminalNode {
-	return s.GetTokens(SQLParserT_COMMA)
-}
+func (s *TimeExprContext) Ident() IIdentContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
 
-func (s *MetricListContext) T_COMMA(i int) antlr.TerminalNode {
-	return s.GetToken(SQLParserT_COMMA, i)
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentContext)
 }
 
-func (s *MetricListContext) GetRuleContext() antlr.RuleContext {
+func (s *TimeExprContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *MetricListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *TimeExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *MetricListContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TimeExprContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterMetricList(s)
+		listenerT.EnterTimeExpr(s)
 	}
 }
 
-func (s *MetricListContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TimeExprContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitMetricList(s)
+		listenerT.ExitTimeExpr(s)
 	}
 }
 
-func (s *MetricListContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *TimeExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitMetricList(s)
+		return t.VisitTimeExpr(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) MetricList() (localctx IMetricListContext) {
-	localctx = NewMetricListContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 120, SQLParserRULE_metricList)
-	var _la int
-
+func (p *SQLParser) TimeExpr() (localctx ITimeExprContext) {
+	localctx = NewTimeExprContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 154, SQLParserRULE_timeExpr)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(603)
-		p.Ident()
+		p.SetState(742)
+		p.Match(SQLParserT_TIME)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(743)
+		p.BinaryOperator()
 	}
-	p.SetState(608)
+	p.SetState(746)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_la = p.GetTokenStream().LA(1)
 
-	for _la == SQLParserT_COMMA {
-		{
-			p.SetState(604)
-			p.Match(SQLParserT_COMMA)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 38, p.GetParserRuleContext()) {
+	case 1:
 		{
-			p.SetState(605)
-			p.Ident()
+			p.SetState(744)
+			p.NowExpr()
 		}
 
-		p.SetState(610)
-		p.GetErrorHandler().Sync(p)
-		if p.HasError() {
-			goto errorExit
+	case 2:
+		{
+			p.SetState(745)
+			p.Ident()
 		}
-		_la = p.GetTokenStream().LA(1)
+
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
 	}
 
 errorExit:
@@ -11539,85 +14679,75 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ITimeRangeExprContext is an interface to support dynamic dispatch.
-type ITimeRangeExprContext interface {
+// INowExprContext is an interface to support dynamic dispatch.
+type INowExprContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	AllTimeExpr() []ITimeExprContext
-	TimeExpr(i int) ITimeExprContext
-	T_AND() antlr.TerminalNode
+	NowFunc() INowFuncContext
+	DurationLit() IDurationLitContext
 
-	// IsTimeRangeExprContext differentiates from other interfaces.
-	IsTimeRangeExprContext()
+	// IsNowExprContext differentiates from other interfaces.
+	IsNowExprContext()
 }
 
-type TimeRangeExprContext struct {
+type NowExprContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyTimeRangeExprContext() *TimeRangeExprContext {
-	var p = new(TimeRangeExprContext)
+func NewEmptyNowExprContext() *NowExprContext {
+	var p = new(NowExprContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_timeRangeExpr
+	p.RuleIndex = SQLParserRULE_nowExpr
 	return p
 }
 
-func InitEmptyTimeRangeExprContext(p *TimeRangeExprContext) {
+func InitEmptyNowExprContext(p *NowExprContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_timeRangeExpr
+	p.RuleIndex = SQLParserRULE_nowExpr
 }
 
-func (*TimeRangeExprContext) IsTimeRangeExprContext() {}
+func (*NowExprContext) IsNowExprContext() {}
 
-func NewTimeRangeExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TimeRangeExprContext {
-	var p = new(TimeRangeExprContext)
+func NewNowExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NowExprContext {
+	var p = new(NowExprContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_timeRangeExpr
+	p.RuleIndex = SQLParserRULE_nowExpr
 
 	return p
 }
 
-func (s *TimeRangeExprContext) GetParser() antlr.Parser { return s.parser }
+func (s *NowExprContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *TimeRangeExprContext) AllTimeExpr() []ITimeExprContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(ITimeExprContext); ok {
-			len++
+func (s *NowExprContext) NowFunc() INowFuncContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INowFuncContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
-	tst := make([]ITimeExprContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(ITimeExprContext); ok {
-			tst[i] = t.(ITimeExprContext)
-			i++
-		}
+	if t == nil {
+		return nil
 	}
 
-	return tst
+	return t.(INowFuncContext)
 }
 
-func (s *TimeRangeExprContext) TimeExpr(i int) ITimeExprContext {
+func (s *NowExprContext) DurationLit() IDurationLitContext {
 	var t antlr.RuleContext
-	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITimeExprContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext)
-				break
-			}
-			j++
+		if _, ok := ctx.(IDurationLitContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
 		}
 	}
 
@@ -11625,70 +14755,62 @@ func (s *TimeRangeExprContext) TimeExpr(i int) ITimeExprContext {
 		return nil
 	}
 
-	return t.(ITimeExprContext)
-}
-
-func (s *TimeRangeExprContext) T_AND() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_AND, 0)
+	return t.(IDurationLitContext)
 }
 
-func (s *TimeRangeExprContext) GetRuleContext() antlr.RuleContext {
+func (s *NowExprContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *TimeRangeExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *NowExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *TimeRangeExprContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *NowExprContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterTimeRangeExpr(s)
+		listenerT.EnterNowExpr(s)
 	}
 }
 
-func (s *TimeRangeExprContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *NowExprContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitTimeRangeExpr(s)
+		listenerT.ExitNowExpr(s)
 	}
 }
 
-func (s *TimeRangeExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *NowExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitTimeRangeExpr(s)
+		return t.VisitNowExpr(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) TimeRangeExpr() (localctx ITimeRangeExprContext) {
-	localctx = NewTimeRangeExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 122, SQLParserRULE_timeRangeExpr)
+func (p *SQLParser) NowExpr() (localctx INowExprContext) {
+	localctx = NewNowExprContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 156, SQLParserRULE_nowExpr)
+	var _la int
+
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(611)
-		p.TimeExpr()
+		p.SetState(748)
+		p.NowFunc()
 	}
-	p.SetState(614)
+	p.SetState(750)
 	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
 
-	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 32, p.GetParserRuleContext()) == 1 {
-		{
-			p.SetState(612)
-			p.Match(SQLParserT_AND)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
+	if (int64((_la-167)) & ^0x3f) == 0 && ((int64(1)<<(_la-167))&131) != 0 {
 		{
-			p.SetState(613)
-			p.TimeExpr()
+			p.SetState(749)
+			p.DurationLit()
 		}
 
-	} else if p.HasError() { // JIM
-		goto errorExit
 	}
 
 errorExit:
@@ -11704,95 +14826,71 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// ITimeExprContext is an interface to support dynamic dispatch.
-type ITimeExprContext interface {
+// INowFuncContext is an interface to support dynamic dispatch.
+type INowFuncContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	T_TIME() antlr.TerminalNode
-	BinaryOperator() IBinaryOperatorContext
-	NowExpr() INowExprContext
-	Ident() IIdentContext
+	T_NOW() antlr.TerminalNode
+	T_OPEN_P() antlr.TerminalNode
+	T_CLOSE_P() antlr.TerminalNode
+	ExprFuncParams() IExprFuncParamsContext
 
-	// IsTimeExprContext differentiates from other interfaces.
-	IsTimeExprContext()
+	// IsNowFuncContext differentiates from other interfaces.
+	IsNowFuncContext()
 }
 
-type TimeExprContext struct {
+type NowFuncContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyTimeExprContext() *TimeExprContext {
-	var p = new(TimeExprContext)
+func NewEmptyNowFuncContext() *NowFuncContext {
+	var p = new(NowFuncContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_timeExpr
+	p.RuleIndex = SQLParserRULE_nowFunc
 	return p
 }
 
-func InitEmptyTimeExprContext(p *TimeExprContext) {
+func InitEmptyNowFuncContext(p *NowFuncContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_timeExpr
+	p.RuleIndex = SQLParserRULE_nowFunc
 }
 
-func (*TimeExprContext) IsTimeExprContext() {}
+func (*NowFuncContext) IsNowFuncContext() {}
 
-func NewTimeExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TimeExprContext {
-	var p = new(TimeExprContext)
+func NewNowFuncContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NowFuncContext {
+	var p = new(NowFuncContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_timeExpr
+	p.RuleIndex = SQLParserRULE_nowFunc
 
 	return p
 }
 
-func (s *TimeExprContext) GetParser() antlr.Parser { return s.parser }
+func (s *NowFuncContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *TimeExprContext) T_TIME() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_TIME, 0)
+func (s *NowFuncContext) T_NOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_NOW, 0)
 }
 
-func (s *TimeExprContext) BinaryOperator() IBinaryOperatorContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IBinaryOperatorContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IBinaryOperatorContext)
+func (s *NowFuncContext) T_OPEN_P() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_OPEN_P, 0)
 }
 
-func (s *TimeExprContext) NowExpr() INowExprContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(INowExprContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(INowExprContext)
+func (s *NowFuncContext) T_CLOSE_P() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CLOSE_P, 0)
 }
 
-func (s *TimeExprContext) Ident() IIdentContext {
+func (s *NowFuncContext) ExprFuncParams() IExprFuncParamsContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIdentContext); ok {
+		if _, ok := ctx.(IExprFuncParamsContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -11802,76 +14900,82 @@ func (s *TimeExprContext) Ident() IIdentContext {
 		return nil
 	}
 
-	return t.(IIdentContext)
+	return t.(IExprFuncParamsContext)
 }
 
-func (s *TimeExprContext) GetRuleContext() antlr.RuleContext {
+func (s *NowFuncContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *TimeExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *NowFuncContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *TimeExprContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *NowFuncContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterTimeExpr(s)
+		listenerT.EnterNowFunc(s)
 	}
 }
 
-func (s *TimeExprContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *NowFuncContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitTimeExpr(s)
+		listenerT.ExitNowFunc(s)
 	}
 }
 
-func (s *TimeExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *NowFuncContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitTimeExpr(s)
+		return t.VisitNowFunc(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) TimeExpr() (localctx ITimeExprContext) {
-	localctx = NewTimeExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 124, SQLParserRULE_timeExpr)
+func (p *SQLParser) NowFunc() (localctx INowFuncContext) {
+	localctx = NewNowFuncContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 158, SQLParserRULE_nowFunc)
+	var _la int
+
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(616)
-		p.Match(SQLParserT_TIME)
+		p.SetState(752)
+		p.Match(SQLParserT_NOW)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
 	{
-		p.SetState(617)
-		p.BinaryOperator()
+		p.SetState(753)
+		p.Match(SQLParserT_OPEN_P)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
 	}
-	p.SetState(620)
+	p.SetState(755)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
+	_la = p.GetTokenStream().LA(1)
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 33, p.GetParserRuleContext()) {
-	case 1:
+	if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-2097184) != 0) || ((int64((_la-64)) & ^0x3f) == 0 && ((int64(1)<<(_la-64))&-1) != 0) || ((int64((_la-128)) & ^0x3f) == 0 && ((int64(1)<<(_la-128))&252475359609087) != 0) {
 		{
-			p.SetState(618)
-			p.NowExpr()
+			p.SetState(754)
+			p.ExprFuncParams()
 		}
 
-	case 2:
-		{
-			p.SetState(619)
-			p.Ident()
+	}
+	{
+		p.SetState(757)
+		p.Match(SQLParserT_CLOSE_P)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
 		}
-
-	case antlr.ATNInvalidAltNumber:
-		goto errorExit
 	}
 
 errorExit:
@@ -11887,57 +14991,71 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// INowExprContext is an interface to support dynamic dispatch.
-type INowExprContext interface {
+// IGroupByClauseContext is an interface to support dynamic dispatch.
+type IGroupByClauseContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	NowFunc() INowFuncContext
-	DurationLit() IDurationLitContext
+	T_GROUP() antlr.TerminalNode
+	T_BY() antlr.TerminalNode
+	GroupByKeys() IGroupByKeysContext
+	T_FILL() antlr.TerminalNode
+	T_OPEN_P() antlr.TerminalNode
+	FillOption() IFillOptionContext
+	T_CLOSE_P() antlr.TerminalNode
+	HavingClause() IHavingClauseContext
 
-	// IsNowExprContext differentiates from other interfaces.
-	IsNowExprContext()
+	// IsGroupByClauseContext differentiates from other interfaces.
+	IsGroupByClauseContext()
 }
 
-type NowExprContext struct {
+type GroupByClauseContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyNowExprContext() *NowExprContext {
-	var p = new(NowExprContext)
+func NewEmptyGroupByClauseContext() *GroupByClauseContext {
+	var p = new(GroupByClauseContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_nowExpr
+	p.RuleIndex = SQLParserRULE_groupByClause
 	return p
 }
 
-func InitEmptyNowExprContext(p *NowExprContext) {
+func InitEmptyGroupByClauseContext(p *GroupByClauseContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_nowExpr
+	p.RuleIndex = SQLParserRULE_groupByClause
 }
 
-func (*NowExprContext) IsNowExprContext() {}
+func (*GroupByClauseContext) IsGroupByClauseContext() {}
 
-func NewNowExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NowExprContext {
-	var p = new(NowExprContext)
+func NewGroupByClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GroupByClauseContext {
+	var p = new(GroupByClauseContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_nowExpr
+	p.RuleIndex = SQLParserRULE_groupByClause
 
 	return p
 }
 
-func (s *NowExprContext) GetParser() antlr.Parser { return s.parser }
+func (s *GroupByClauseContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *NowExprContext) NowFunc() INowFuncContext {
+func (s *GroupByClauseContext) T_GROUP() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_GROUP, 0)
+}
+
+func (s *GroupByClauseContext) T_BY() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_BY, 0)
+}
+
+func (s *GroupByClauseContext) GroupByKeys() IGroupByKeysContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(INowFuncContext); ok {
+		if _, ok := ctx.(IGroupByKeysContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -11947,13 +15065,21 @@ func (s *NowExprContext) NowFunc() INowFuncContext {
 		return nil
 	}
 
-	return t.(INowFuncContext)
+	return t.(IGroupByKeysContext)
 }
 
-func (s *NowExprContext) DurationLit() IDurationLitContext {
+func (s *GroupByClauseContext) T_FILL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FILL, 0)
+}
+
+func (s *GroupByClauseContext) T_OPEN_P() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_OPEN_P, 0)
+}
+
+func (s *GroupByClauseContext) FillOption() IFillOptionContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IDurationLitContext); ok {
+		if _, ok := ctx.(IFillOptionContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -11963,60 +15089,134 @@ func (s *NowExprContext) DurationLit() IDurationLitContext {
 		return nil
 	}
 
-	return t.(IDurationLitContext)
+	return t.(IFillOptionContext)
 }
 
-func (s *NowExprContext) GetRuleContext() antlr.RuleContext {
+func (s *GroupByClauseContext) T_CLOSE_P() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CLOSE_P, 0)
+}
+
+func (s *GroupByClauseContext) HavingClause() IHavingClauseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IHavingClauseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IHavingClauseContext)
+}
+
+func (s *GroupByClauseContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *NowExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *GroupByClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *NowExprContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *GroupByClauseContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterNowExpr(s)
+		listenerT.EnterGroupByClause(s)
 	}
 }
 
-func (s *NowExprContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *GroupByClauseContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitNowExpr(s)
+		listenerT.ExitGroupByClause(s)
 	}
 }
 
-func (s *NowExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *GroupByClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitNowExpr(s)
+		return t.VisitGroupByClause(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) NowExpr() (localctx INowExprContext) {
-	localctx = NewNowExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 126, SQLParserRULE_nowExpr)
+func (p *SQLParser) GroupByClause() (localctx IGroupByClauseContext) {
+	localctx = NewGroupByClauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 160, SQLParserRULE_groupByClause)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(622)
-		p.NowFunc()
+		p.SetState(759)
+		p.Match(SQLParserT_GROUP)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(760)
+		p.Match(SQLParserT_BY)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(761)
+		p.GroupByKeys()
 	}
-	p.SetState(624)
+	p.SetState(767)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	if (int64((_la-129)) & ^0x3f) == 0 && ((int64(1)<<(_la-129))&131) != 0 {
+	if _la == SQLParserT_FILL {
 		{
-			p.SetState(623)
-			p.DurationLit()
+			p.SetState(762)
+			p.Match(SQLParserT_FILL)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(763)
+			p.Match(SQLParserT_OPEN_P)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(764)
+			p.FillOption()
+		}
+		{
+			p.SetState(765)
+			p.Match(SQLParserT_CLOSE_P)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	}
+	p.SetState(770)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SQLParserT_HAVING {
+		{
+			p.SetState(769)
+			p.HavingClause()
 		}
 
 	}
@@ -12034,73 +15234,86 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// INowFuncContext is an interface to support dynamic dispatch.
-type INowFuncContext interface {
+// IGroupByKeysContext is an interface to support dynamic dispatch.
+type IGroupByKeysContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	T_NOW() antlr.TerminalNode
-	T_OPEN_P() antlr.TerminalNode
-	T_CLOSE_P() antlr.TerminalNode
-	ExprFuncParams() IExprFuncParamsContext
+	AllGroupByKey() []IGroupByKeyContext
+	GroupByKey(i int) IGroupByKeyContext
+	AllT_COMMA() []antlr.TerminalNode
+	T_COMMA(i int) antlr.TerminalNode
 
-	// IsNowFuncContext differentiates from other interfaces.
-	IsNowFuncContext()
+	// IsGroupByKeysContext differentiates from other interfaces.
+	IsGroupByKeysContext()
 }
 
-type NowFuncContext struct {
+type GroupByKeysContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyNowFuncContext() *NowFuncContext {
-	var p = new(NowFuncContext)
+func NewEmptyGroupByKeysContext() *GroupByKeysContext {
+	var p = new(GroupByKeysContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_nowFunc
+	p.RuleIndex = SQLParserRULE_groupByKeys
 	return p
 }
 
-func InitEmptyNowFuncContext(p *NowFuncContext) {
+func InitEmptyGroupByKeysContext(p *GroupByKeysContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_nowFunc
+	p.RuleIndex = SQLParserRULE_groupByKeys
 }
 
-func (*NowFuncContext) IsNowFuncContext() {}
+func (*GroupByKeysContext) IsGroupByKeysContext() {}
 
-func NewNowFuncContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NowFuncContext {
-	var p = new(NowFuncContext)
+func NewGroupByKeysContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GroupByKeysContext {
+	var p = new(GroupByKeysContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_nowFunc
+	p.RuleIndex = SQLParserRULE_groupByKeys
 
 	return p
 }
 
-func (s *NowFuncContext) GetParser() antlr.Parser { return s.parser }
+func (s *GroupByKeysContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *NowFuncContext) T_NOW() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_NOW, 0)
-}
+func (s *GroupByKeysContext) AllGroupByKey() []IGroupByKeyContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IGroupByKeyContext); ok {
+			len++
+		}
+	}
 
-func (s *NowFuncContext) T_OPEN_P() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_OPEN_P, 0)
-}
+	tst := make([]IGroupByKeyContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IGroupByKeyContext); ok {
+			tst[i] = t.(IGroupByKeyContext)
+			i++
+		}
+	}
 
-func (s *NowFuncContext) T_CLOSE_P() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_CLOSE_P, 0)
+	return tst
 }
 
-func (s *NowFuncContext) ExprFuncParams() IExprFuncParamsContext {
+func (s *GroupByKeysContext) GroupByKey(i int) IGroupByKeyContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IExprFuncParamsContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		if _, ok := ctx.(IGroupByKeyContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -12108,82 +15321,84 @@ func (s *NowFuncContext) ExprFuncParams() IExprFuncParamsContext {
 		return nil
 	}
 
-	return t.(IExprFuncParamsContext)
+	return t.(IGroupByKeyContext)
 }
 
-func (s *NowFuncContext) GetRuleContext() antlr.RuleContext {
+func (s *GroupByKeysContext) AllT_COMMA() []antlr.TerminalNode {
+	return s.GetTokens(SQLParserT_COMMA)
+}
+
+func (s *GroupByKeysContext) T_COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(SQLParserT_COMMA, i)
+}
+
+func (s *GroupByKeysContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *NowFuncContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *GroupByKeysContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *NowFuncContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *GroupByKeysContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterNowFunc(s)
+		listenerT.EnterGroupByKeys(s)
 	}
 }
 
-func (s *NowFuncContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *GroupByKeysContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitNowFunc(s)
+		listenerT.ExitGroupByKeys(s)
 	}
 }
 
-func (s *NowFuncContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *GroupByKeysContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitNowFunc(s)
+		return t.VisitGroupByKeys(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) NowFunc() (localctx INowFuncContext) {
-	localctx = NewNowFuncContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 128, SQLParserRULE_nowFunc)
+func (p *SQLParser) GroupByKeys() (localctx IGroupByKeysContext) {
+	localctx = NewGroupByKeysContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 162, SQLParserRULE_groupByKeys)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(626)
-		p.Match(SQLParserT_NOW)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(627)
-		p.Match(SQLParserT_OPEN_P)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
+		p.SetState(772)
+		p.GroupByKey()
 	}
-	p.SetState(629)
+	p.SetState(777)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-4194368) != 0) || ((int64((_la-64)) & ^0x3f) == 0 && ((int64(1)<<(_la-64))&-9223232381698179073) != 0) || ((int64((_la-129)) & ^0x3f) == 0 && ((int64(1)<<(_la-129))&459) != 0) {
+	for _la == SQLParserT_COMMA {
 		{
-			p.SetState(628)
-			p.ExprFuncParams()
+			p.SetState(773)
+			p.Match(SQLParserT_COMMA)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(774)
+			p.GroupByKey()
 		}
 
-	}
-	{
-		p.SetState(631)
-		p.Match(SQLParserT_CLOSE_P)
+		p.SetState(779)
+		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
-			// Recognition error - abort rule
 			goto errorExit
 		}
+		_la = p.GetTokenStream().LA(1)
 	}
 
 errorExit:
@@ -12199,71 +15414,61 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IGroupByClauseContext is an interface to support dynamic dispatch.
-type IGroupByClauseContext interface {
+// IGroupByKeyContext is an interface to support dynamic dispatch.
+type IGroupByKeyContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	T_GROUP() antlr.TerminalNode
-	T_BY() antlr.TerminalNode
-	GroupByKeys() IGroupByKeysContext
-	T_FILL() antlr.TerminalNode
+	Ident() IIdentContext
+	GroupByTagExpr() IGroupByTagExprContext
+	T_TIME() antlr.TerminalNode
 	T_OPEN_P() antlr.TerminalNode
-	FillOption() IFillOptionContext
+	DurationLit() IDurationLitContext
 	T_CLOSE_P() antlr.TerminalNode
-	HavingClause() IHavingClauseContext
 
-	// IsGroupByClauseContext differentiates from other interfaces.
-	IsGroupByClauseContext()
+	// IsGroupByKeyContext differentiates from other interfaces.
+	IsGroupByKeyContext()
 }
 
-type GroupByClauseContext struct {
+type GroupByKeyContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyGroupByClauseContext() *GroupByClauseContext {
-	var p = new(GroupByClauseContext)
+func NewEmptyGroupByKeyContext() *GroupByKeyContext {
+	var p = new(GroupByKeyContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_groupByClause
+	p.RuleIndex = SQLParserRULE_groupByKey
 	return p
 }
 
-func InitEmptyGroupByClauseContext(p *GroupByClauseContext) {
+func InitEmptyGroupByKeyContext(p *GroupByKeyContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_groupByClause
+	p.RuleIndex = SQLParserRULE_groupByKey
 }
 
-func (*GroupByClauseContext) IsGroupByClauseContext() {}
+func (*GroupByKeyContext) IsGroupByKeyContext() {}
 
-func NewGroupByClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GroupByClauseContext {
-	var p = new(GroupByClauseContext)
+func NewGroupByKeyContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GroupByKeyContext {
+	var p = new(GroupByKeyContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_groupByClause
+	p.RuleIndex = SQLParserRULE_groupByKey
 
 	return p
 }
 
-func (s *GroupByClauseContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *GroupByClauseContext) T_GROUP() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_GROUP, 0)
-}
-
-func (s *GroupByClauseContext) T_BY() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_BY, 0)
-}
+func (s *GroupByKeyContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *GroupByClauseContext) GroupByKeys() IGroupByKeysContext {
+func (s *GroupByKeyContext) Ident() IIdentContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IGroupByKeysContext); ok {
+		if _, ok := ctx.(IIdentContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -12273,21 +15478,13 @@ func (s *GroupByClauseContext) GroupByKeys() IGroupByKeysContext {
 		return nil
 	}
 
-	return t.(IGroupByKeysContext)
-}
-
-func (s *GroupByClauseContext) T_FILL() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_FILL, 0)
-}
-
-func (s *GroupByClauseContext) T_OPEN_P() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_OPEN_P, 0)
+	return t.(IIdentContext)
 }
 
-func (s *GroupByClauseContext) FillOption() IFillOptionContext {
+func (s *GroupByKeyContext) GroupByTagExpr() IGroupByTagExprContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFillOptionContext); ok {
+		if _, ok := ctx.(IGroupByTagExprContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -12297,17 +15494,21 @@ func (s *GroupByClauseContext) FillOption() IFillOptionContext {
 		return nil
 	}
 
-	return t.(IFillOptionContext)
+	return t.(IGroupByTagExprContext)
 }
 
-func (s *GroupByClauseContext) T_CLOSE_P() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_CLOSE_P, 0)
+func (s *GroupByKeyContext) T_TIME() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TIME, 0)
 }
 
-func (s *GroupByClauseContext) HavingClause() IHavingClauseContext {
+func (s *GroupByKeyContext) T_OPEN_P() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_OPEN_P, 0)
+}
+
+func (s *GroupByKeyContext) DurationLit() IDurationLitContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IHavingClauseContext); ok {
+		if _, ok := ctx.(IDurationLitContext); ok {
 			t = ctx.(antlr.RuleContext)
 			break
 		}
@@ -12317,83 +15518,79 @@ func (s *GroupByClauseContext) HavingClause() IHavingClauseContext {
 		return nil
 	}
 
-	return t.(IHavingClauseContext)
+	return t.(IDurationLitContext)
 }
 
-func (s *GroupByClauseContext) GetRuleContext() antlr.RuleContext {
+func (s *GroupByKeyContext) T_CLOSE_P() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CLOSE_P, 0)
+}
+
+func (s *GroupByKeyContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *GroupByClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *GroupByKeyContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *GroupByClauseContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *GroupByKeyContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterGroupByClause(s)
+		listenerT.EnterGroupByKey(s)
 	}
 }
 
-func (s *GroupByClauseContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *GroupByKeyContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitGroupByClause(s)
+		listenerT.ExitGroupByKey(s)
 	}
 }
 
-func (s *GroupByClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *GroupByKeyContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitGroupByClause(s)
+		return t.VisitGroupByKey(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) GroupByClause() (localctx IGroupByClauseContext) {
-	localctx = NewGroupByClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 130, SQLParserRULE_groupByClause)
-	var _la int
-
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(633)
-		p.Match(SQLParserT_GROUP)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(634)
-		p.Match(SQLParserT_BY)
-		if p.HasError() {
-			// Recognition error - abort rule
-			goto errorExit
-		}
-	}
-	{
-		p.SetState(635)
-		p.GroupByKeys()
-	}
-	p.SetState(641)
+func (p *SQLParser) GroupByKey() (localctx IGroupByKeyContext) {
+	localctx = NewGroupByKeyContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 164, SQLParserRULE_groupByKey)
+	p.SetState(790)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_la = p.GetTokenStream().LA(1)
 
-	if _la == SQLParserT_FILL {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 44, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(636)
-			p.Match(SQLParserT_FILL)
+			p.SetState(780)
+			p.Ident()
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(781)
+			p.GroupByTagExpr()
+		}
+
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(782)
+			p.Match(SQLParserT_TIME)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
 		{
-			p.SetState(637)
+			p.SetState(783)
 			p.Match(SQLParserT_OPEN_P)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -12401,11 +15598,11 @@ func (p *SQLParser) GroupByClause() (localctx IGroupByClauseContext) {
 			}
 		}
 		{
-			p.SetState(638)
-			p.FillOption()
+			p.SetState(784)
+			p.DurationLit()
 		}
 		{
-			p.SetState(639)
+			p.SetState(785)
 			p.Match(SQLParserT_CLOSE_P)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -12413,20 +15610,35 @@ func (p *SQLParser) GroupByClause() (localctx IGroupByClauseContext) {
 			}
 		}
 
-	}
-	p.SetState(644)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == SQLParserT_HAVING {
+	case 4:
+		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(643)
-			p.HavingClause()
+			p.SetState(787)
+			p.Match(SQLParserT_TIME)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(788)
+			p.Match(SQLParserT_OPEN_P)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(789)
+			p.Match(SQLParserT_CLOSE_P)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
 		}
 
+	case antlr.ATNInvalidAltNumber:
+		goto errorExit
 	}
 
 errorExit:
@@ -12442,69 +15654,93 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IGroupByKeysContext is an interface to support dynamic dispatch.
-type IGroupByKeysContext interface {
+// IGroupByTagExprContext is an interface to support dynamic dispatch.
+type IGroupByTagExprContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	AllGroupByKey() []IGroupByKeyContext
-	GroupByKey(i int) IGroupByKeyContext
+	GroupByTagFunc() IGroupByTagFuncContext
+	T_OPEN_P() antlr.TerminalNode
+	AllIdent() []IIdentContext
+	Ident(i int) IIdentContext
+	T_CLOSE_P() antlr.TerminalNode
+	T_AS() antlr.TerminalNode
 	AllT_COMMA() []antlr.TerminalNode
 	T_COMMA(i int) antlr.TerminalNode
 
-	// IsGroupByKeysContext differentiates from other interfaces.
-	IsGroupByKeysContext()
+	// IsGroupByTagExprContext differentiates from other interfaces.
+	IsGroupByTagExprContext()
 }
 
-type GroupByKeysContext struct {
+type GroupByTagExprContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyGroupByKeysContext() *GroupByKeysContext {
-	var p = new(GroupByKeysContext)
+func NewEmptyGroupByTagExprContext() *GroupByTagExprContext {
+	var p = new(GroupByTagExprContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_groupByKeys
+	p.RuleIndex = SQLParserRULE_groupByTagExpr
 	return p
 }
 
-func InitEmptyGroupByKeysContext(p *GroupByKeysContext) {
+func InitEmptyGroupByTagExprContext(p *GroupByTagExprContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_groupByKeys
+	p.RuleIndex = SQLParserRULE_groupByTagExpr
 }
 
-func (*GroupByKeysContext) IsGroupByKeysContext() {}
+func (*GroupByTagExprContext) IsGroupByTagExprContext() {}
 
-func NewGroupByKeysContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GroupByKeysContext {
-	var p = new(GroupByKeysContext)
+func NewGroupByTagExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GroupByTagExprContext {
+	var p = new(GroupByTagExprContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_groupByKeys
+	p.RuleIndex = SQLParserRULE_groupByTagExpr
 
 	return p
 }
 
-func (s *GroupByKeysContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *GroupByKeysContext) AllGroupByKey() []IGroupByKeyContext {
+func (s *GroupByTagExprContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *GroupByTagExprContext) GroupByTagFunc() IGroupByTagFuncContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IGroupByTagFuncContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IGroupByTagFuncContext)
+}
+
+func (s *GroupByTagExprContext) T_OPEN_P() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_OPEN_P, 0)
+}
+
+func (s *GroupByTagExprContext) AllIdent() []IIdentContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
-		if _, ok := ctx.(IGroupByKeyContext); ok {
+		if _, ok := ctx.(IIdentContext); ok {
 			len++
 		}
 	}
 
-	tst := make([]IGroupByKeyContext, len)
+	tst := make([]IIdentContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(IGroupByKeyContext); ok {
-			tst[i] = t.(IGroupByKeyContext)
+		if t, ok := ctx.(IIdentContext); ok {
+			tst[i] = t.(IIdentContext)
 			i++
 		}
 	}
@@ -12512,11 +15748,11 @@ func (s *GroupByKeysContext) AllGroupByKey() []IGroupByKeyContext {
 	return tst
 }
 
-func (s *GroupByKeysContext) GroupByKey(i int) IGroupByKeyContext {
+func (s *GroupByTagExprContext) Ident(i int) IIdentContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IGroupByKeyContext); ok {
+		if _, ok := ctx.(IIdentContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext)
 				break
@@ -12529,58 +15765,78 @@ func (s *GroupByKeysContext) GroupByKey(i int) IGroupByKeyContext {
 		return nil
 	}
 
-	return t.(IGroupByKeyContext)
+	return t.(IIdentContext)
 }
 
-func (s *GroupByKeysContext) AllT_COMMA() []antlr.TerminalNode {
+func (s *GroupByTagExprContext) T_CLOSE_P() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CLOSE_P, 0)
+}
+
+func (s *GroupByTagExprContext) T_AS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_AS, 0)
+}
+
+func (s *GroupByTagExprContext) AllT_COMMA() []antlr.TerminalNode {
 	return s.GetTokens(SQLParserT_COMMA)
 }
 
-func (s *GroupByKeysContext) T_COMMA(i int) antlr.TerminalNode {
+func (s *GroupByTagExprContext) T_COMMA(i int) antlr.TerminalNode {
 	return s.GetToken(SQLParserT_COMMA, i)
 }
 
-func (s *GroupByKeysContext) GetRuleContext() antlr.RuleContext {
+func (s *GroupByTagExprContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *GroupByKeysContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *GroupByTagExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *GroupByKeysContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *GroupByTagExprContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterGroupByKeys(s)
+		listenerT.EnterGroupByTagExpr(s)
 	}
 }
 
-func (s *GroupByKeysContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *GroupByTagExprContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitGroupByKeys(s)
+		listenerT.ExitGroupByTagExpr(s)
 	}
 }
 
-func (s *GroupByKeysContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *GroupByTagExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitGroupByKeys(s)
+		return t.VisitGroupByTagExpr(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) GroupByKeys() (localctx IGroupByKeysContext) {
-	localctx = NewGroupByKeysContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 132, SQLParserRULE_groupByKeys)
+func (p *SQLParser) GroupByTagExpr() (localctx IGroupByTagExprContext) {
+	localctx = NewGroupByTagExprContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 166, SQLParserRULE_groupByTagExpr)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(646)
-		p.GroupByKey()
+		p.SetState(792)
+		p.GroupByTagFunc()
+	}
+	{
+		p.SetState(793)
+		p.Match(SQLParserT_OPEN_P)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(794)
+		p.Ident()
 	}
-	p.SetState(651)
+	p.SetState(799)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -12589,7 +15845,7 @@ func (p *SQLParser) GroupByKeys() (localctx IGroupByKeysContext) {
 
 	for _la == SQLParserT_COMMA {
 		{
-			p.SetState(647)
+			p.SetState(795)
 			p.Match(SQLParserT_COMMA)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -12597,17 +15853,37 @@ func (p *SQLParser) GroupByKeys() (localctx IGroupByKeysContext) {
 			}
 		}
 		{
-			p.SetState(648)
-			p.GroupByKey()
+			p.SetState(796)
+			p.Ident()
 		}
 
-		p.SetState(653)
+		p.SetState(801)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
 		_la = p.GetTokenStream().LA(1)
 	}
+	{
+		p.SetState(802)
+		p.Match(SQLParserT_CLOSE_P)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(803)
+		p.Match(SQLParserT_AS)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(804)
+		p.Ident()
+	}
 
 errorExit:
 	if p.HasError() {
@@ -12622,207 +15898,112 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
-// IGroupByKeyContext is an interface to support dynamic dispatch.
-type IGroupByKeyContext interface {
+// IGroupByTagFuncContext is an interface to support dynamic dispatch.
+type IGroupByTagFuncContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
 	// Getter signatures
-	Ident() IIdentContext
-	T_TIME() antlr.TerminalNode
-	T_OPEN_P() antlr.TerminalNode
-	DurationLit() IDurationLitContext
-	T_CLOSE_P() antlr.TerminalNode
+	T_REGEXP_EXTRACT() antlr.TerminalNode
+	T_LABEL_REPLACE() antlr.TerminalNode
+	T_LABEL_JOIN() antlr.TerminalNode
 
-	// IsGroupByKeyContext differentiates from other interfaces.
-	IsGroupByKeyContext()
+	// IsGroupByTagFuncContext differentiates from other interfaces.
+	IsGroupByTagFuncContext()
 }
 
-type GroupByKeyContext struct {
+type GroupByTagFuncContext struct {
 	antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyGroupByKeyContext() *GroupByKeyContext {
-	var p = new(GroupByKeyContext)
+func NewEmptyGroupByTagFuncContext() *GroupByTagFuncContext {
+	var p = new(GroupByTagFuncContext)
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_groupByKey
+	p.RuleIndex = SQLParserRULE_groupByTagFunc
 	return p
 }
 
-func InitEmptyGroupByKeyContext(p *GroupByKeyContext) {
+func InitEmptyGroupByTagFuncContext(p *GroupByTagFuncContext) {
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
-	p.RuleIndex = SQLParserRULE_groupByKey
+	p.RuleIndex = SQLParserRULE_groupByTagFunc
 }
 
-func (*GroupByKeyContext) IsGroupByKeyContext() {}
+func (*GroupByTagFuncContext) IsGroupByTagFuncContext() {}
 
-func NewGroupByKeyContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GroupByKeyContext {
-	var p = new(GroupByKeyContext)
+func NewGroupByTagFuncContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GroupByTagFuncContext {
+	var p = new(GroupByTagFuncContext)
 
 	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_groupByKey
+	p.RuleIndex = SQLParserRULE_groupByTagFunc
 
 	return p
 }
 
-func (s *GroupByKeyContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *GroupByKeyContext) Ident() IIdentContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIdentContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IIdentContext)
-}
-
-func (s *GroupByKeyContext) T_TIME() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_TIME, 0)
-}
+func (s *GroupByTagFuncContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *GroupByKeyContext) T_OPEN_P() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_OPEN_P, 0)
+func (s *GroupByTagFuncContext) T_REGEXP_EXTRACT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_REGEXP_EXTRACT, 0)
 }
 
-func (s *GroupByKeyContext) DurationLit() IDurationLitContext {
-	var t antlr.RuleContext
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IDurationLitContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IDurationLitContext)
+func (s *GroupByTagFuncContext) T_LABEL_REPLACE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_LABEL_REPLACE, 0)
 }
 
-func (s *GroupByKeyContext) T_CLOSE_P() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_CLOSE_P, 0)
+func (s *GroupByTagFuncContext) T_LABEL_JOIN() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_LABEL_JOIN, 0)
 }
 
-func (s *GroupByKeyContext) GetRuleContext() antlr.RuleContext {
+func (s *GroupByTagFuncContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *GroupByKeyContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *GroupByTagFuncContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *GroupByKeyContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *GroupByTagFuncContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterGroupByKey(s)
+		listenerT.EnterGroupByTagFunc(s)
 	}
 }
 
-func (s *GroupByKeyContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *GroupByTagFuncContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitGroupByKey(s)
+		listenerT.ExitGroupByTagFunc(s)
 	}
 }
 
-func (s *GroupByKeyContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *GroupByTagFuncContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitGroupByKey(s)
+		return t.VisitGroupByTagFunc(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SQLParser) GroupByKey() (localctx IGroupByKeyContext) {
-	localctx = NewGroupByKeyContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 134, SQLParserRULE_groupByKey)
-	p.SetState(663)
-	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 39, p.GetParserRuleContext()) {
-	case 1:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(654)
-			p.Ident()
-		}
+func (p *SQLParser) GroupByTagFunc() (localctx IGroupByTagFuncContext) {
+	localctx = NewGroupByTagFuncContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 168, SQLParserRULE_groupByTagFunc)
+	var _la int
 
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(655)
-			p.Match(SQLParserT_TIME)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(656)
-			p.Match(SQLParserT_OPEN_P)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(657)
-			p.DurationLit()
-		}
-		{
-			p.SetState(658)
-			p.Match(SQLParserT_CLOSE_P)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(806)
+		_la = p.GetTokenStream().LA(1)
 
-	case 3:
-		p.EnterOuterAlt(localctx, 3)
-		{
-			p.SetState(660)
-			p.Match(SQLParserT_TIME)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(661)
-			p.Match(SQLParserT_OPEN_P)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(662)
-			p.Match(SQLParserT_CLOSE_P)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
+		if !((int64((_la-130)) & ^0x3f) == 0 && ((int64(1)<<(_la-130))&7) != 0) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
 		}
-
-	case antlr.ATNInvalidAltNumber:
-		goto errorExit
 	}
 
 errorExit:
@@ -12848,8 +16029,10 @@ type IFillOptionContext interface {
 	// Getter signatures
 	T_NULL() antlr.TerminalNode
 	T_PREVIOUS() antlr.TerminalNode
+	T_LINEAR() antlr.TerminalNode
 	L_INT() antlr.TerminalNode
 	L_DEC() antlr.TerminalNode
+	T_SUB() antlr.TerminalNode
 
 	// IsFillOptionContext differentiates from other interfaces.
 	IsFillOptionContext()
@@ -12895,6 +16078,10 @@ func (s *FillOptionContext) T_PREVIOUS() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_PREVIOUS, 0)
 }
 
+func (s *FillOptionContext) T_LINEAR() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_LINEAR, 0)
+}
+
 func (s *FillOptionContext) L_INT() antlr.TerminalNode {
 	return s.GetToken(SQLParserL_INT, 0)
 }
@@ -12903,6 +16090,10 @@ func (s *FillOptionContext) L_DEC() antlr.TerminalNode {
 	return s.GetToken(SQLParserL_DEC, 0)
 }
 
+func (s *FillOptionContext) T_SUB() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SUB, 0)
+}
+
 func (s *FillOptionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -12933,22 +16124,86 @@ func (s *FillOptionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	}
 }
 
-func (p *SQLParser) FillOption() (localctx IFillOptionContext) {
-	localctx = NewFillOptionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 136, SQLParserRULE_fillOption)
-	var _la int
+func (p *SQLParser) FillOption() (localctx IFillOptionContext) {
+	localctx = NewFillOptionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 170, SQLParserRULE_fillOption)
+	var _la int
+
+	p.SetState(815)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetTokenStream().LA(1) {
+	case SQLParserT_NULL:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(808)
+			p.Match(SQLParserT_NULL)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case SQLParserT_PREVIOUS:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(809)
+			p.Match(SQLParserT_PREVIOUS)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case SQLParserT_LINEAR:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(810)
+			p.Match(SQLParserT_LINEAR)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case SQLParserT_SUB, SQLParserL_INT, SQLParserL_DEC:
+		p.EnterOuterAlt(localctx, 4)
+		p.SetState(812)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == SQLParserT_SUB {
+			{
+				p.SetState(811)
+				p.Match(SQLParserT_SUB)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(665)
-		_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(814)
+			_la = p.GetTokenStream().LA(1)
 
-		if !(_la == SQLParserT_NULL || _la == SQLParserT_PREVIOUS || _la == SQLParserL_INT || _la == SQLParserL_DEC) {
-			p.GetErrorHandler().RecoverInline(p)
-		} else {
-			p.GetErrorHandler().ReportMatch(p)
-			p.Consume()
+			if !(_la == SQLParserL_INT || _la == SQLParserL_DEC) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
 		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
 	}
 
 errorExit:
@@ -13068,10 +16323,10 @@ func (s *OrderByClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{
 
 func (p *SQLParser) OrderByClause() (localctx IOrderByClauseContext) {
 	localctx = NewOrderByClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 138, SQLParserRULE_orderByClause)
+	p.EnterRule(localctx, 172, SQLParserRULE_orderByClause)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(667)
+		p.SetState(817)
 		p.Match(SQLParserT_ORDER)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -13079,7 +16334,7 @@ func (p *SQLParser) OrderByClause() (localctx IOrderByClauseContext) {
 		}
 	}
 	{
-		p.SetState(668)
+		p.SetState(818)
 		p.Match(SQLParserT_BY)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -13087,7 +16342,7 @@ func (p *SQLParser) OrderByClause() (localctx IOrderByClauseContext) {
 		}
 	}
 	{
-		p.SetState(669)
+		p.SetState(819)
 		p.SortFields()
 	}
 
@@ -13218,15 +16473,15 @@ func (s *SortFieldContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SQLParser) SortField() (localctx ISortFieldContext) {
 	localctx = NewSortFieldContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 140, SQLParserRULE_sortField)
+	p.EnterRule(localctx, 174, SQLParserRULE_sortField)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(671)
+		p.SetState(821)
 		p.fieldExpr(0)
 	}
-	p.SetState(675)
+	p.SetState(825)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -13235,7 +16490,7 @@ func (p *SQLParser) SortField() (localctx ISortFieldContext) {
 
 	for _la == SQLParserT_ASC || _la == SQLParserT_DESC {
 		{
-			p.SetState(672)
+			p.SetState(822)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == SQLParserT_ASC || _la == SQLParserT_DESC) {
@@ -13246,7 +16501,7 @@ func (p *SQLParser) SortField() (localctx ISortFieldContext) {
 			}
 		}
 
-		p.SetState(677)
+		p.SetState(827)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -13397,15 +16652,15 @@ func (s *SortFieldsContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SQLParser) SortFields() (localctx ISortFieldsContext) {
 	localctx = NewSortFieldsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 142, SQLParserRULE_sortFields)
+	p.EnterRule(localctx, 176, SQLParserRULE_sortFields)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(678)
+		p.SetState(828)
 		p.SortField()
 	}
-	p.SetState(683)
+	p.SetState(833)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -13414,7 +16669,7 @@ func (p *SQLParser) SortFields() (localctx ISortFieldsContext) {
 
 	for _la == SQLParserT_COMMA {
 		{
-			p.SetState(679)
+			p.SetState(829)
 			p.Match(SQLParserT_COMMA)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -13422,11 +16677,11 @@ func (p *SQLParser) SortFields() (localctx ISortFieldsContext) {
 			}
 		}
 		{
-			p.SetState(680)
+			p.SetState(830)
 			p.SortField()
 		}
 
-		p.SetState(685)
+		p.SetState(835)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -13546,10 +16801,10 @@ func (s *HavingClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *SQLParser) HavingClause() (localctx IHavingClauseContext) {
 	localctx = NewHavingClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 144, SQLParserRULE_havingClause)
+	p.EnterRule(localctx, 178, SQLParserRULE_havingClause)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(686)
+		p.SetState(836)
 		p.Match(SQLParserT_HAVING)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -13557,7 +16812,7 @@ func (p *SQLParser) HavingClause() (localctx IHavingClauseContext) {
 		}
 	}
 	{
-		p.SetState(687)
+		p.SetState(837)
 		p.boolExpr(0)
 	}
 
@@ -13747,21 +17002,21 @@ func (p *SQLParser) boolExpr(_p int) (localctx IBoolExprContext) {
 	localctx = NewBoolExprContext(p, p.GetParserRuleContext(), _parentState)
 	var _prevctx IBoolExprContext = localctx
 	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
-	_startState := 146
-	p.EnterRecursionRule(localctx, 146, SQLParserRULE_boolExpr, _p)
+	_startState := 180
+	p.EnterRecursionRule(localctx, 180, SQLParserRULE_boolExpr, _p)
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(695)
+	p.SetState(845)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 42, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 50, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(690)
+			p.SetState(840)
 			p.Match(SQLParserT_OPEN_P)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -13769,11 +17024,11 @@ func (p *SQLParser) boolExpr(_p int) (localctx IBoolExprContext) {
 			}
 		}
 		{
-			p.SetState(691)
+			p.SetState(841)
 			p.boolExpr(0)
 		}
 		{
-			p.SetState(692)
+			p.SetState(842)
 			p.Match(SQLParserT_CLOSE_P)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -13783,7 +17038,7 @@ func (p *SQLParser) boolExpr(_p int) (localctx IBoolExprContext) {
 
 	case 2:
 		{
-			p.SetState(694)
+			p.SetState(844)
 			p.BoolExprAtom()
 		}
 
@@ -13791,12 +17046,12 @@ func (p *SQLParser) boolExpr(_p int) (localctx IBoolExprContext) {
 		goto errorExit
 	}
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(703)
+	p.SetState(853)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 43, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 51, p.GetParserRuleContext())
 	if p.HasError() {
 		goto errorExit
 	}
@@ -13808,28 +17063,28 @@ func (p *SQLParser) boolExpr(_p int) (localctx IBoolExprContext) {
 			_prevctx = localctx
 			localctx = NewBoolExprContext(p, _parentctx, _parentState)
 			p.PushNewRecursionContext(localctx, _startState, SQLParserRULE_boolExpr)
-			p.SetState(697)
+			p.SetState(847)
 
 			if !(p.Precpred(p.GetParserRuleContext(), 2)) {
 				p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 2)", ""))
 				goto errorExit
 			}
 			{
-				p.SetState(698)
+				p.SetState(848)
 				p.BoolExprLogicalOp()
 			}
 			{
-				p.SetState(699)
+				p.SetState(849)
 				p.boolExpr(3)
 			}
 
 		}
-		p.SetState(705)
+		p.SetState(855)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 43, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 51, p.GetParserRuleContext())
 		if p.HasError() {
 			goto errorExit
 		}
@@ -13935,12 +17190,12 @@ func (s *BoolExprLogicalOpContext) Accept(visitor antlr.ParseTreeVisitor) interf
 
 func (p *SQLParser) BoolExprLogicalOp() (localctx IBoolExprLogicalOpContext) {
 	localctx = NewBoolExprLogicalOpContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 148, SQLParserRULE_boolExprLogicalOp)
+	p.EnterRule(localctx, 182, SQLParserRULE_boolExprLogicalOp)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(706)
+		p.SetState(856)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == SQLParserT_AND || _la == SQLParserT_OR) {
@@ -14058,10 +17313,10 @@ func (s *BoolExprAtomContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *SQLParser) BoolExprAtom() (localctx IBoolExprAtomContext) {
 	localctx = NewBoolExprAtomContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 150, SQLParserRULE_boolExprAtom)
+	p.EnterRule(localctx, 184, SQLParserRULE_boolExprAtom)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(708)
+		p.SetState(858)
 		p.BinaryExpr()
 	}
 
@@ -14215,18 +17470,18 @@ func (s *BinaryExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SQLParser) BinaryExpr() (localctx IBinaryExprContext) {
 	localctx = NewBinaryExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 152, SQLParserRULE_binaryExpr)
+	p.EnterRule(localctx, 186, SQLParserRULE_binaryExpr)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(710)
+		p.SetState(860)
 		p.fieldExpr(0)
 	}
 	{
-		p.SetState(711)
+		p.SetState(861)
 		p.BinaryOperator()
 	}
 	{
-		p.SetState(712)
+		p.SetState(862)
 		p.fieldExpr(0)
 	}
 
@@ -14365,10 +17620,10 @@ func (s *BinaryOperatorContext) Accept(visitor antlr.ParseTreeVisitor) interface
 
 func (p *SQLParser) BinaryOperator() (localctx IBinaryOperatorContext) {
 	localctx = NewBinaryOperatorContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 154, SQLParserRULE_binaryOperator)
+	p.EnterRule(localctx, 188, SQLParserRULE_binaryOperator)
 	var _la int
 
-	p.SetState(722)
+	p.SetState(872)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -14378,7 +17633,7 @@ func (p *SQLParser) BinaryOperator() (localctx IBinaryOperatorContext) {
 	case SQLParserT_EQUAL:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(714)
+			p.SetState(864)
 			p.Match(SQLParserT_EQUAL)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -14389,7 +17644,7 @@ func (p *SQLParser) BinaryOperator() (localctx IBinaryOperatorContext) {
 	case SQLParserT_NOTEQUAL:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(715)
+			p.SetState(865)
 			p.Match(SQLParserT_NOTEQUAL)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -14400,7 +17655,7 @@ func (p *SQLParser) BinaryOperator() (localctx IBinaryOperatorContext) {
 	case SQLParserT_NOTEQUAL2:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(716)
+			p.SetState(866)
 			p.Match(SQLParserT_NOTEQUAL2)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -14411,7 +17666,7 @@ func (p *SQLParser) BinaryOperator() (localctx IBinaryOperatorContext) {
 	case SQLParserT_LESS:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(717)
+			p.SetState(867)
 			p.Match(SQLParserT_LESS)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -14422,7 +17677,7 @@ func (p *SQLParser) BinaryOperator() (localctx IBinaryOperatorContext) {
 	case SQLParserT_LESSEQUAL:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(718)
+			p.SetState(868)
 			p.Match(SQLParserT_LESSEQUAL)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -14433,7 +17688,7 @@ func (p *SQLParser) BinaryOperator() (localctx IBinaryOperatorContext) {
 	case SQLParserT_GREATER:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(719)
+			p.SetState(869)
 			p.Match(SQLParserT_GREATER)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -14444,7 +17699,7 @@ func (p *SQLParser) BinaryOperator() (localctx IBinaryOperatorContext) {
 	case SQLParserT_GREATEREQUAL:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(720)
+			p.SetState(870)
 			p.Match(SQLParserT_GREATEREQUAL)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -14455,7 +17710,7 @@ func (p *SQLParser) BinaryOperator() (localctx IBinaryOperatorContext) {
 	case SQLParserT_LIKE, SQLParserT_REGEXP:
 		p.EnterOuterAlt(localctx, 8)
 		{
-			p.SetState(721)
+			p.SetState(871)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == SQLParserT_LIKE || _la == SQLParserT_REGEXP) {
@@ -14711,21 +17966,21 @@ func (p *SQLParser) fieldExpr(_p int) (localctx IFieldExprContext) {
 	localctx = NewFieldExprContext(p, p.GetParserRuleContext(), _parentState)
 	var _prevctx IFieldExprContext = localctx
 	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
-	_startState := 156
-	p.EnterRecursionRule(localctx, 156, SQLParserRULE_fieldExpr, _p)
+	_startState := 190
+	p.EnterRecursionRule(localctx, 190, SQLParserRULE_fieldExpr, _p)
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(733)
+	p.SetState(883)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 45, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 53, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(725)
+			p.SetState(875)
 			p.Match(SQLParserT_OPEN_P)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -14733,11 +17988,11 @@ func (p *SQLParser) fieldExpr(_p int) (localctx IFieldExprContext) {
 			}
 		}
 		{
-			p.SetState(726)
+			p.SetState(876)
 			p.fieldExpr(0)
 		}
 		{
-			p.SetState(727)
+			p.SetState(877)
 			p.Match(SQLParserT_CLOSE_P)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -14747,25 +18002,25 @@ func (p *SQLParser) fieldExpr(_p int) (localctx IFieldExprContext) {
 
 	case 2:
 		{
-			p.SetState(729)
+			p.SetState(879)
 			p.ExprFunc()
 		}
 
 	case 3:
 		{
-			p.SetState(730)
+			p.SetState(880)
 			p.ExprAtom()
 		}
 
 	case 4:
 		{
-			p.SetState(731)
+			p.SetState(881)
 			p.DurationLit()
 		}
 
 	case 5:
 		{
-			p.SetState(732)
+			p.SetState(882)
 			p.Star()
 		}
 
@@ -14773,12 +18028,12 @@ func (p *SQLParser) fieldExpr(_p int) (localctx IFieldExprContext) {
 		goto errorExit
 	}
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(749)
+	p.SetState(899)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 47, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 55, p.GetParserRuleContext())
 	if p.HasError() {
 		goto errorExit
 	}
@@ -14788,24 +18043,24 @@ func (p *SQLParser) fieldExpr(_p int) (localctx IFieldExprContext) {
 				p.TriggerExitRuleEvent()
 			}
 			_prevctx = localctx
-			p.SetState(747)
+			p.SetState(897)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
 			}
 
-			switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 46, p.GetParserRuleContext()) {
+			switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 54, p.GetParserRuleContext()) {
 			case 1:
 				localctx = NewFieldExprContext(p, _parentctx, _parentState)
 				p.PushNewRecursionContext(localctx, _startState, SQLParserRULE_fieldExpr)
-				p.SetState(735)
+				p.SetState(885)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 9)) {
 					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 9)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(736)
+					p.SetState(886)
 					p.Match(SQLParserT_MUL)
 					if p.HasError() {
 						// Recognition error - abort rule
@@ -14813,21 +18068,21 @@ func (p *SQLParser) fieldExpr(_p int) (localctx IFieldExprContext) {
 					}
 				}
 				{
-					p.SetState(737)
+					p.SetState(887)
 					p.fieldExpr(10)
 				}
 
 			case 2:
 				localctx = NewFieldExprContext(p, _parentctx, _parentState)
 				p.PushNewRecursionContext(localctx, _startState, SQLParserRULE_fieldExpr)
-				p.SetState(738)
+				p.SetState(888)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 8)) {
 					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 8)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(739)
+					p.SetState(889)
 					p.Match(SQLParserT_DIV)
 					if p.HasError() {
 						// Recognition error - abort rule
@@ -14835,21 +18090,21 @@ func (p *SQLParser) fieldExpr(_p int) (localctx IFieldExprContext) {
 					}
 				}
 				{
-					p.SetState(740)
+					p.SetState(890)
 					p.fieldExpr(9)
 				}
 
 			case 3:
 				localctx = NewFieldExprContext(p, _parentctx, _parentState)
 				p.PushNewRecursionContext(localctx, _startState, SQLParserRULE_fieldExpr)
-				p.SetState(741)
+				p.SetState(891)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 7)) {
 					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 7)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(742)
+					p.SetState(892)
 					p.Match(SQLParserT_ADD)
 					if p.HasError() {
 						// Recognition error - abort rule
@@ -14857,21 +18112,21 @@ func (p *SQLParser) fieldExpr(_p int) (localctx IFieldExprContext) {
 					}
 				}
 				{
-					p.SetState(743)
+					p.SetState(893)
 					p.fieldExpr(8)
 				}
 
 			case 4:
 				localctx = NewFieldExprContext(p, _parentctx, _parentState)
 				p.PushNewRecursionContext(localctx, _startState, SQLParserRULE_fieldExpr)
-				p.SetState(744)
+				p.SetState(894)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 6)) {
 					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 6)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(745)
+					p.SetState(895)
 					p.Match(SQLParserT_SUB)
 					if p.HasError() {
 						// Recognition error - abort rule
@@ -14879,7 +18134,7 @@ func (p *SQLParser) fieldExpr(_p int) (localctx IFieldExprContext) {
 					}
 				}
 				{
-					p.SetState(746)
+					p.SetState(896)
 					p.fieldExpr(7)
 				}
 
@@ -14888,12 +18143,12 @@ func (p *SQLParser) fieldExpr(_p int) (localctx IFieldExprContext) {
 			}
 
 		}
-		p.SetState(751)
+		p.SetState(901)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 47, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 55, p.GetParserRuleContext())
 		if p.HasError() {
 			goto errorExit
 		}
@@ -14994,10 +18249,10 @@ func (s *StarContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SQLParser) Star() (localctx IStarContext) {
 	localctx = NewStarContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 158, SQLParserRULE_star)
+	p.EnterRule(localctx, 192, SQLParserRULE_star)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(752)
+		p.SetState(902)
 		p.Match(SQLParserT_MUL)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -15129,14 +18384,14 @@ func (s *DurationLitContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *SQLParser) DurationLit() (localctx IDurationLitContext) {
 	localctx = NewDurationLitContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 160, SQLParserRULE_durationLit)
+	p.EnterRule(localctx, 194, SQLParserRULE_durationLit)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(754)
+		p.SetState(904)
 		p.IntNumber()
 	}
 	{
-		p.SetState(755)
+		p.SetState(905)
 		p.IntervalItem()
 	}
 
@@ -15265,15 +18520,15 @@ func (s *IntervalItemContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *SQLParser) IntervalItem() (localctx IIntervalItemContext) {
 	localctx = NewIntervalItemContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 162, SQLParserRULE_intervalItem)
+	p.EnterRule(localctx, 196, SQLParserRULE_intervalItem)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(757)
+		p.SetState(907)
 		_la = p.GetTokenStream().LA(1)
 
-		if !((int64((_la-104)) & ^0x3f) == 0 && ((int64(1)<<(_la-104))&127) != 0) {
+		if !((int64((_la-142)) & ^0x3f) == 0 && ((int64(1)<<(_la-142))&127) != 0) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -15306,6 +18561,8 @@ type IExprFuncContext interface {
 	T_OPEN_P() antlr.TerminalNode
 	T_CLOSE_P() antlr.TerminalNode
 	ExprFuncParams() IExprFuncParamsContext
+	T_OFFSET() antlr.TerminalNode
+	DurationLit() IDurationLitContext
 
 	// IsExprFuncContext differentiates from other interfaces.
 	IsExprFuncContext()
@@ -15383,6 +18640,26 @@ func (s *ExprFuncContext) ExprFuncParams() IExprFuncParamsContext {
 	return t.(IExprFuncParamsContext)
 }
 
+func (s *ExprFuncContext) T_OFFSET() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_OFFSET, 0)
+}
+
+func (s *ExprFuncContext) DurationLit() IDurationLitContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDurationLitContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDurationLitContext)
+}
+
 func (s *ExprFuncContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -15415,44 +18692,64 @@ func (s *ExprFuncContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SQLParser) ExprFunc() (localctx IExprFuncContext) {
 	localctx = NewExprFuncContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 164, SQLParserRULE_exprFunc)
+	p.EnterRule(localctx, 198, SQLParserRULE_exprFunc)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(759)
+		p.SetState(909)
 		p.FuncName()
 	}
 	{
-		p.SetState(760)
+		p.SetState(910)
 		p.Match(SQLParserT_OPEN_P)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(762)
+	p.SetState(912)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	_la = p.GetTokenStream().LA(1)
 
-	if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-4194368) != 0) || ((int64((_la-64)) & ^0x3f) == 0 && ((int64(1)<<(_la-64))&-9223232381698179073) != 0) || ((int64((_la-129)) & ^0x3f) == 0 && ((int64(1)<<(_la-129))&459) != 0) {
+	if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-2097184) != 0) || ((int64((_la-64)) & ^0x3f) == 0 && ((int64(1)<<(_la-64))&-1) != 0) || ((int64((_la-128)) & ^0x3f) == 0 && ((int64(1)<<(_la-128))&252475359609087) != 0) {
 		{
-			p.SetState(761)
+			p.SetState(911)
 			p.ExprFuncParams()
 		}
 
 	}
 	{
-		p.SetState(764)
+		p.SetState(914)
 		p.Match(SQLParserT_CLOSE_P)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
+	p.SetState(917)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 57, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(915)
+			p.Match(SQLParserT_OFFSET)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(916)
+			p.DurationLit()
+		}
+
+	} else if p.HasError() { // JIM
+		goto errorExit
+	}
 
 errorExit:
 	if p.HasError() {
@@ -15485,6 +18782,33 @@ type IFuncNameContext interface {
 	T_STDDEV() antlr.TerminalNode
 	T_QUANTILE() antlr.TerminalNode
 	T_RATE() antlr.TerminalNode
+	T_HISTOGRAM_COUNT() antlr.TerminalNode
+	T_HISTOGRAM_SUM() antlr.TerminalNode
+	T_MOVING_AVERAGE() antlr.TerminalNode
+	T_DERIVATIVE() antlr.TerminalNode
+	T_NON_NEGATIVE_DIFFERENCE() antlr.TerminalNode
+	T_CUMULATIVE_SUM() antlr.TerminalNode
+	T_DELTA() antlr.TerminalNode
+	T_INCREASE() antlr.TerminalNode
+	T_INTEGRAL() antlr.TerminalNode
+	T_TOP() antlr.TerminalNode
+	T_BOTTOM() antlr.TerminalNode
+	T_ABS() antlr.TerminalNode
+	T_CEIL() antlr.TerminalNode
+	T_FLOOR() antlr.TerminalNode
+	T_ROUND() antlr.TerminalNode
+	T_LOG() antlr.TerminalNode
+	T_LOG2() antlr.TerminalNode
+	T_LOG10() antlr.TerminalNode
+	T_SQRT() antlr.TerminalNode
+	T_POW() antlr.TerminalNode
+	T_CLAMP_MIN() antlr.TerminalNode
+	T_CLAMP_MAX() antlr.TerminalNode
+	T_IF() antlr.TerminalNode
+	T_COALESCE() antlr.TerminalNode
+	T_PERCENTILE() antlr.TerminalNode
+	T_MEDIAN() antlr.TerminalNode
+	T_DISTINCT_COUNT() antlr.TerminalNode
 
 	// IsFuncNameContext differentiates from other interfaces.
 	IsFuncNameContext()
@@ -15562,6 +18886,114 @@ func (s *FuncNameContext) T_RATE() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_RATE, 0)
 }
 
+func (s *FuncNameContext) T_HISTOGRAM_COUNT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_HISTOGRAM_COUNT, 0)
+}
+
+func (s *FuncNameContext) T_HISTOGRAM_SUM() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_HISTOGRAM_SUM, 0)
+}
+
+func (s *FuncNameContext) T_MOVING_AVERAGE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_MOVING_AVERAGE, 0)
+}
+
+func (s *FuncNameContext) T_DERIVATIVE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DERIVATIVE, 0)
+}
+
+func (s *FuncNameContext) T_NON_NEGATIVE_DIFFERENCE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_NON_NEGATIVE_DIFFERENCE, 0)
+}
+
+func (s *FuncNameContext) T_CUMULATIVE_SUM() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CUMULATIVE_SUM, 0)
+}
+
+func (s *FuncNameContext) T_DELTA() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DELTA, 0)
+}
+
+func (s *FuncNameContext) T_INCREASE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_INCREASE, 0)
+}
+
+func (s *FuncNameContext) T_INTEGRAL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_INTEGRAL, 0)
+}
+
+func (s *FuncNameContext) T_TOP() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TOP, 0)
+}
+
+func (s *FuncNameContext) T_BOTTOM() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_BOTTOM, 0)
+}
+
+func (s *FuncNameContext) T_ABS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ABS, 0)
+}
+
+func (s *FuncNameContext) T_CEIL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CEIL, 0)
+}
+
+func (s *FuncNameContext) T_FLOOR() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FLOOR, 0)
+}
+
+func (s *FuncNameContext) T_ROUND() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ROUND, 0)
+}
+
+func (s *FuncNameContext) T_LOG() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_LOG, 0)
+}
+
+func (s *FuncNameContext) T_LOG2() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_LOG2, 0)
+}
+
+func (s *FuncNameContext) T_LOG10() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_LOG10, 0)
+}
+
+func (s *FuncNameContext) T_SQRT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SQRT, 0)
+}
+
+func (s *FuncNameContext) T_POW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_POW, 0)
+}
+
+func (s *FuncNameContext) T_CLAMP_MIN() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CLAMP_MIN, 0)
+}
+
+func (s *FuncNameContext) T_CLAMP_MAX() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CLAMP_MAX, 0)
+}
+
+func (s *FuncNameContext) T_IF() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_IF, 0)
+}
+
+func (s *FuncNameContext) T_COALESCE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_COALESCE, 0)
+}
+
+func (s *FuncNameContext) T_PERCENTILE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_PERCENTILE, 0)
+}
+
+func (s *FuncNameContext) T_MEDIAN() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_MEDIAN, 0)
+}
+
+func (s *FuncNameContext) T_DISTINCT_COUNT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DISTINCT_COUNT, 0)
+}
+
 func (s *FuncNameContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -15594,15 +19026,15 @@ func (s *FuncNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SQLParser) FuncName() (localctx IFuncNameContext) {
 	localctx = NewFuncNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 166, SQLParserRULE_funcName)
+	p.EnterRule(localctx, 200, SQLParserRULE_funcName)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(766)
+		p.SetState(919)
 		_la = p.GetTokenStream().LA(1)
 
-		if !((int64((_la-88)) & ^0x3f) == 0 && ((int64(1)<<(_la-88))&1023) != 0) {
+		if !((int64((_la-92)) & ^0x3f) == 0 && ((int64(1)<<(_la-92))&15668040695777) != 0) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -15753,15 +19185,15 @@ func (s *ExprFuncParamsContext) Accept(visitor antlr.ParseTreeVisitor) interface
 
 func (p *SQLParser) ExprFuncParams() (localctx IExprFuncParamsContext) {
 	localctx = NewExprFuncParamsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 168, SQLParserRULE_exprFuncParams)
+	p.EnterRule(localctx, 202, SQLParserRULE_exprFuncParams)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(768)
+		p.SetState(921)
 		p.FuncParam()
 	}
-	p.SetState(773)
+	p.SetState(926)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -15770,7 +19202,7 @@ func (p *SQLParser) ExprFuncParams() (localctx IExprFuncParamsContext) {
 
 	for _la == SQLParserT_COMMA {
 		{
-			p.SetState(769)
+			p.SetState(922)
 			p.Match(SQLParserT_COMMA)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -15778,11 +19210,11 @@ func (p *SQLParser) ExprFuncParams() (localctx IExprFuncParamsContext) {
 			}
 		}
 		{
-			p.SetState(770)
+			p.SetState(923)
 			p.FuncParam()
 		}
 
-		p.SetState(775)
+		p.SetState(928)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -15813,6 +19245,7 @@ type IFuncParamContext interface {
 	// Getter signatures
 	FieldExpr() IFieldExprContext
 	TagFilterExpr() ITagFilterExprContext
+	BoolExpr() IBoolExprContext
 
 	// IsFuncParamContext differentiates from other interfaces.
 	IsFuncParamContext()
@@ -15882,6 +19315,22 @@ func (s *FuncParamContext) TagFilterExpr() ITagFilterExprContext {
 	return t.(ITagFilterExprContext)
 }
 
+func (s *FuncParamContext) BoolExpr() IBoolExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBoolExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBoolExprContext)
+}
+
 func (s *FuncParamContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -15914,28 +19363,35 @@ func (s *FuncParamContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SQLParser) FuncParam() (localctx IFuncParamContext) {
 	localctx = NewFuncParamContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 170, SQLParserRULE_funcParam)
-	p.SetState(778)
+	p.EnterRule(localctx, 204, SQLParserRULE_funcParam)
+	p.SetState(932)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 50, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 59, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(776)
+			p.SetState(929)
 			p.fieldExpr(0)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(777)
+			p.SetState(930)
 			p.tagFilterExpr(0)
 		}
 
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(931)
+			p.boolExpr(0)
+		}
+
 	case antlr.ATNInvalidAltNumber:
 		goto errorExit
 	}
@@ -16098,26 +19554,26 @@ func (s *ExprAtomContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SQLParser) ExprAtom() (localctx IExprAtomContext) {
 	localctx = NewExprAtomContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 172, SQLParserRULE_exprAtom)
-	p.SetState(786)
+	p.EnterRule(localctx, 206, SQLParserRULE_exprAtom)
+	p.SetState(940)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 52, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 61, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(780)
+			p.SetState(934)
 			p.Ident()
 		}
-		p.SetState(782)
+		p.SetState(936)
 		p.GetErrorHandler().Sync(p)
 
-		if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 51, p.GetParserRuleContext()) == 1 {
+		if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 60, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(781)
+				p.SetState(935)
 				p.IdentFilter()
 			}
 
@@ -16128,14 +19584,14 @@ func (p *SQLParser) ExprAtom() (localctx IExprAtomContext) {
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(784)
+			p.SetState(938)
 			p.DecNumber()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(785)
+			p.SetState(939)
 			p.IntNumber()
 		}
 
@@ -16260,10 +19716,10 @@ func (s *IdentFilterContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *SQLParser) IdentFilter() (localctx IIdentFilterContext) {
 	localctx = NewIdentFilterContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 174, SQLParserRULE_identFilter)
+	p.EnterRule(localctx, 208, SQLParserRULE_identFilter)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(788)
+		p.SetState(942)
 		p.Match(SQLParserT_OPEN_SB)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -16271,11 +19727,11 @@ func (p *SQLParser) IdentFilter() (localctx IIdentFilterContext) {
 		}
 	}
 	{
-		p.SetState(789)
+		p.SetState(943)
 		p.tagFilterExpr(0)
 	}
 	{
-		p.SetState(790)
+		p.SetState(944)
 		p.Match(SQLParserT_CLOSE_SB)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -16390,10 +19846,10 @@ func (s *JsonContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SQLParser) Json() (localctx IJsonContext) {
 	localctx = NewJsonContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 176, SQLParserRULE_json)
+	p.EnterRule(localctx, 210, SQLParserRULE_json)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(792)
+		p.SetState(946)
 		p.Value()
 	}
 
@@ -16504,10 +19960,10 @@ func (s *TomlContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SQLParser) Toml() (localctx ITomlContext) {
 	localctx = NewTomlContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 178, SQLParserRULE_toml)
+	p.EnterRule(localctx, 212, SQLParserRULE_toml)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(794)
+		p.SetState(948)
 		p.Ident()
 	}
 
@@ -16664,20 +20120,20 @@ func (s *ObjContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SQLParser) Obj() (localctx IObjContext) {
 	localctx = NewObjContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 180, SQLParserRULE_obj)
+	p.EnterRule(localctx, 214, SQLParserRULE_obj)
 	var _la int
 
-	p.SetState(809)
+	p.SetState(963)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 54, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 63, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(796)
+			p.SetState(950)
 			p.Match(SQLParserT_OPEN_B)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -16685,10 +20141,10 @@ func (p *SQLParser) Obj() (localctx IObjContext) {
 			}
 		}
 		{
-			p.SetState(797)
+			p.SetState(951)
 			p.Pair()
 		}
-		p.SetState(802)
+		p.SetState(956)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -16697,7 +20153,7 @@ func (p *SQLParser) Obj() (localctx IObjContext) {
 
 		for _la == SQLParserT_COMMA {
 			{
-				p.SetState(798)
+				p.SetState(952)
 				p.Match(SQLParserT_COMMA)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -16705,11 +20161,11 @@ func (p *SQLParser) Obj() (localctx IObjContext) {
 				}
 			}
 			{
-				p.SetState(799)
+				p.SetState(953)
 				p.Pair()
 			}
 
-			p.SetState(804)
+			p.SetState(958)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
@@ -16717,7 +20173,7 @@ func (p *SQLParser) Obj() (localctx IObjContext) {
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(805)
+			p.SetState(959)
 			p.Match(SQLParserT_CLOSE_B)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -16728,7 +20184,7 @@ func (p *SQLParser) Obj() (localctx IObjContext) {
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(807)
+			p.SetState(961)
 			p.Match(SQLParserT_OPEN_B)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -16736,7 +20192,7 @@ func (p *SQLParser) Obj() (localctx IObjContext) {
 			}
 		}
 		{
-			p.SetState(808)
+			p.SetState(962)
 			p.Match(SQLParserT_CLOSE_B)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -16865,10 +20321,10 @@ func (s *PairContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SQLParser) Pair() (localctx IPairContext) {
 	localctx = NewPairContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 182, SQLParserRULE_pair)
+	p.EnterRule(localctx, 216, SQLParserRULE_pair)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(811)
+		p.SetState(965)
 		p.Match(SQLParserSTRING)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -16876,7 +20332,7 @@ func (p *SQLParser) Pair() (localctx IPairContext) {
 		}
 	}
 	{
-		p.SetState(812)
+		p.SetState(966)
 		p.Match(SQLParserT_COLON)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -16884,7 +20340,7 @@ func (p *SQLParser) Pair() (localctx IPairContext) {
 		}
 	}
 	{
-		p.SetState(813)
+		p.SetState(967)
 		p.Value()
 	}
 
@@ -17041,20 +20497,20 @@ func (s *ArrContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SQLParser) Arr() (localctx IArrContext) {
 	localctx = NewArrContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 184, SQLParserRULE_arr)
+	p.EnterRule(localctx, 218, SQLParserRULE_arr)
 	var _la int
 
-	p.SetState(828)
+	p.SetState(982)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 56, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 65, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(815)
+			p.SetState(969)
 			p.Match(SQLParserT_OPEN_SB)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -17062,10 +20518,10 @@ func (p *SQLParser) Arr() (localctx IArrContext) {
 			}
 		}
 		{
-			p.SetState(816)
+			p.SetState(970)
 			p.Value()
 		}
-		p.SetState(821)
+		p.SetState(975)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -17074,7 +20530,7 @@ func (p *SQLParser) Arr() (localctx IArrContext) {
 
 		for _la == SQLParserT_COMMA {
 			{
-				p.SetState(817)
+				p.SetState(971)
 				p.Match(SQLParserT_COMMA)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -17082,11 +20538,11 @@ func (p *SQLParser) Arr() (localctx IArrContext) {
 				}
 			}
 			{
-				p.SetState(818)
+				p.SetState(972)
 				p.Value()
 			}
 
-			p.SetState(823)
+			p.SetState(977)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
@@ -17094,7 +20550,7 @@ func (p *SQLParser) Arr() (localctx IArrContext) {
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(824)
+			p.SetState(978)
 			p.Match(SQLParserT_CLOSE_SB)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -17105,7 +20561,7 @@ func (p *SQLParser) Arr() (localctx IArrContext) {
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(826)
+			p.SetState(980)
 			p.Match(SQLParserT_OPEN_SB)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -17113,7 +20569,7 @@ func (p *SQLParser) Arr() (localctx IArrContext) {
 			}
 		}
 		{
-			p.SetState(827)
+			p.SetState(981)
 			p.Match(SQLParserT_CLOSE_SB)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -17151,6 +20607,7 @@ type IValueContext interface {
 	DecNumber() IDecNumberContext
 	Obj() IObjContext
 	Arr() IArrContext
+	T_NULL() antlr.TerminalNode
 
 	// IsValueContext differentiates from other interfaces.
 	IsValueContext()
@@ -17256,6 +20713,10 @@ func (s *ValueContext) Arr() IArrContext {
 	return t.(IArrContext)
 }
 
+func (s *ValueContext) T_NULL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_NULL, 0)
+}
+
 func (s *ValueContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -17288,18 +20749,18 @@ func (s *ValueContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SQLParser) Value() (localctx IValueContext) {
 	localctx = NewValueContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 186, SQLParserRULE_value)
-	p.SetState(838)
+	p.EnterRule(localctx, 220, SQLParserRULE_value)
+	p.SetState(992)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 57, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 66, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(830)
+			p.SetState(984)
 			p.Match(SQLParserSTRING)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -17310,35 +20771,35 @@ func (p *SQLParser) Value() (localctx IValueContext) {
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(831)
+			p.SetState(985)
 			p.IntNumber()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(832)
+			p.SetState(986)
 			p.DecNumber()
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(833)
+			p.SetState(987)
 			p.Obj()
 		}
 
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(834)
+			p.SetState(988)
 			p.Arr()
 		}
 
 	case 6:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(835)
+			p.SetState(989)
 			p.Match(SQLParserT__0)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -17349,7 +20810,7 @@ func (p *SQLParser) Value() (localctx IValueContext) {
 	case 7:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(836)
+			p.SetState(990)
 			p.Match(SQLParserT__1)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -17360,8 +20821,8 @@ func (p *SQLParser) Value() (localctx IValueContext) {
 	case 8:
 		p.EnterOuterAlt(localctx, 8)
 		{
-			p.SetState(837)
-			p.Match(SQLParserT__2)
+			p.SetState(991)
+			p.Match(SQLParserT_NULL)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
@@ -17477,11 +20938,11 @@ func (s *IntNumberContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SQLParser) IntNumber() (localctx IIntNumberContext) {
 	localctx = NewIntNumberContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 188, SQLParserRULE_intNumber)
+	p.EnterRule(localctx, 222, SQLParserRULE_intNumber)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(841)
+	p.SetState(995)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -17490,7 +20951,7 @@ func (p *SQLParser) IntNumber() (localctx IIntNumberContext) {
 
 	if _la == SQLParserT_ADD || _la == SQLParserT_SUB {
 		{
-			p.SetState(840)
+			p.SetState(994)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == SQLParserT_ADD || _la == SQLParserT_SUB) {
@@ -17503,7 +20964,7 @@ func (p *SQLParser) IntNumber() (localctx IIntNumberContext) {
 
 	}
 	{
-		p.SetState(843)
+		p.SetState(997)
 		p.Match(SQLParserL_INT)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -17616,11 +21077,11 @@ func (s *DecNumberContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SQLParser) DecNumber() (localctx IDecNumberContext) {
 	localctx = NewDecNumberContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 190, SQLParserRULE_decNumber)
+	p.EnterRule(localctx, 224, SQLParserRULE_decNumber)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(846)
+	p.SetState(1000)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -17629,7 +21090,7 @@ func (p *SQLParser) DecNumber() (localctx IDecNumberContext) {
 
 	if _la == SQLParserT_ADD || _la == SQLParserT_SUB {
 		{
-			p.SetState(845)
+			p.SetState(999)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == SQLParserT_ADD || _la == SQLParserT_SUB) {
@@ -17642,7 +21103,7 @@ func (p *SQLParser) DecNumber() (localctx IDecNumberContext) {
 
 	}
 	{
-		p.SetState(848)
+		p.SetState(1002)
 		p.Match(SQLParserL_DEC)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -17750,10 +21211,10 @@ func (s *LimitClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *SQLParser) LimitClause() (localctx ILimitClauseContext) {
 	localctx = NewLimitClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 192, SQLParserRULE_limitClause)
+	p.EnterRule(localctx, 226, SQLParserRULE_limitClause)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(850)
+		p.SetState(1004)
 		p.Match(SQLParserT_LIMIT)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -17761,7 +21222,7 @@ func (p *SQLParser) LimitClause() (localctx ILimitClauseContext) {
 		}
 	}
 	{
-		p.SetState(851)
+		p.SetState(1005)
 		p.Match(SQLParserL_INT)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -17876,10 +21337,10 @@ func (s *MetricNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SQLParser) MetricName() (localctx IMetricNameContext) {
 	localctx = NewMetricNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 194, SQLParserRULE_metricName)
+	p.EnterRule(localctx, 228, SQLParserRULE_metricName)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(853)
+		p.SetState(1007)
 		p.Ident()
 	}
 
@@ -17990,10 +21451,10 @@ func (s *TagKeyContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SQLParser) TagKey() (localctx ITagKeyContext) {
 	localctx = NewTagKeyContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 196, SQLParserRULE_tagKey)
+	p.EnterRule(localctx, 230, SQLParserRULE_tagKey)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(855)
+		p.SetState(1009)
 		p.Ident()
 	}
 
@@ -18104,10 +21565,10 @@ func (s *TagValueContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SQLParser) TagValue() (localctx ITagValueContext) {
 	localctx = NewTagValueContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 198, SQLParserRULE_tagValue)
+	p.EnterRule(localctx, 232, SQLParserRULE_tagValue)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(857)
+		p.SetState(1011)
 		p.Ident()
 	}
 
@@ -18264,11 +21725,11 @@ func (s *IdentContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SQLParser) Ident() (localctx IIdentContext) {
 	localctx = NewIdentContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 200, SQLParserRULE_ident)
+	p.EnterRule(localctx, 234, SQLParserRULE_ident)
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(861)
+	p.SetState(1015)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -18277,7 +21738,7 @@ func (p *SQLParser) Ident() (localctx IIdentContext) {
 	switch p.GetTokenStream().LA(1) {
 	case SQLParserL_ID:
 		{
-			p.SetState(859)
+			p.SetState(1013)
 			p.Match(SQLParserL_ID)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -18285,9 +21746,9 @@ func (p *SQLParser) Ident() (localctx IIdentContext) {
 			}
 		}
 
-	case SQLParserT_CREATE, SQLParserT_UPDATE, SQLParserT_SET, SQLParserT_DROP, SQLParserT_INTERVAL, SQLParserT_INTERVAL_NAME, SQLParserT_SHARD, SQLParserT_REPLICATION, SQLParserT_MEMORY, SQLParserT_TTL, SQLParserT_META_TTL, SQLParserT_PAST_TTL, SQLParserT_FUTURE_TTL, SQLParserT_KILL, SQLParserT_ON, SQLParserT_SHOW, SQLParserT_USE, SQLParserT_STATE_REPO, SQLParserT_STATE_MACHINE, SQLParserT_MASTER, SQLParserT_METADATA, SQLParserT_TYPES, SQLParserT_TYPE, SQLParserT_STORAGES, SQLParserT_STORAGE, SQLParserT_BROKER, SQLParserT_ROOT, SQLParserT_BROKERS, SQLParserT_ALIVE, SQLParserT_SCHEMAS, SQLParserT_DATASBAE, SQLParserT_DATASBAES, SQLParserT_NAMESPACE, SQLParserT_NAMESPACES, SQLParserT_NODE, SQLParserT_METRICS, SQLParserT_METRIC, SQLParserT_FIELD, SQLParserT_FIELDS, SQLParserT_TAG, SQLParserT_INFO, SQLParserT_KEYS, SQLParserT_KEY, SQLParserT_WITH, SQLParserT_VALUES, SQLParserT_VALUE, SQLParserT_FROM, SQLParserT_WHERE, SQLParserT_LIMIT, SQLParserT_QUERIES, SQLParserT_QUERY, SQLParserT_EXPLAIN, SQLParserT_WITH_VALUE, SQLParserT_SELECT, SQLParserT_AS, SQLParserT_AND, SQLParserT_OR, SQLParserT_FILL, SQLParserT_NULL, SQLParserT_PREVIOUS, SQLParserT_ORDER, SQLParserT_ASC, SQLParserT_DESC, SQLParserT_LIKE, SQLParserT_NOT, SQLParserT_BETWEEN, SQLParserT_IS, SQLParserT_GROUP, SQLParserT_HAVING, SQLParserT_BY, SQLParserT_FOR, SQLParserT_STATS, SQLParserT_TIME, SQLParserT_NOW, SQLParserT_IN, SQLParserT_ROLLUP, SQLParserT_LOG, SQLParserT_PROFILE, SQLParserT_REQUESTS, SQLParserT_REQUEST, SQLParserT_ID, SQLParserT_SUM, SQLParserT_MIN, SQLParserT_MAX, SQLParserT_COUNT, SQLParserT_LAST, SQLParserT_FIRST, SQLParserT_AVG, SQLParserT_STDDEV, SQLParserT_QUANTILE, SQLParserT_RATE, SQLParserT_SECOND, SQLParserT_MINUTE, SQLParserT_HOUR, SQLParserT_DAY, SQLParserT_WEEK, SQLParserT_MONTH, SQLParserT_YEAR:
+	case SQLParserT_CREATE, SQLParserT_UPDATE, SQLParserT_SET, SQLParserT_DROP, SQLParserT_INTERVAL, SQLParserT_INTERVAL_NAME, SQLParserT_SHARD, SQLParserT_REPLICATION, SQLParserT_MEMORY, SQLParserT_TTL, SQLParserT_META_TTL, SQLParserT_PAST_TTL, SQLParserT_FUTURE_TTL, SQLParserT_KILL, SQLParserT_ON, SQLParserT_SHOW, SQLParserT_BACKUP, SQLParserT_RESTORE, SQLParserT_TO, SQLParserT_USE, SQLParserT_STATE_REPO, SQLParserT_STATE_MACHINE, SQLParserT_MASTER, SQLParserT_METADATA, SQLParserT_TYPES, SQLParserT_TYPE, SQLParserT_STORAGES, SQLParserT_STORAGE, SQLParserT_BROKER, SQLParserT_ROOT, SQLParserT_BROKERS, SQLParserT_ALIVE, SQLParserT_SCHEMAS, SQLParserT_DATASBAE, SQLParserT_DATASBAES, SQLParserT_NAMESPACE, SQLParserT_NAMESPACES, SQLParserT_NODE, SQLParserT_METRICS, SQLParserT_METRIC, SQLParserT_FIELD, SQLParserT_FIELDS, SQLParserT_TAG, SQLParserT_INFO, SQLParserT_KEYS, SQLParserT_KEY, SQLParserT_WITH, SQLParserT_VALUES, SQLParserT_VALUE, SQLParserT_FROM, SQLParserT_WHERE, SQLParserT_LIMIT, SQLParserT_QUERIES, SQLParserT_QUERY, SQLParserT_EXPLAIN, SQLParserT_WITH_VALUE, SQLParserT_SELECT, SQLParserT_AS, SQLParserT_AND, SQLParserT_OR, SQLParserT_FILL, SQLParserT_NULL, SQLParserT_PREVIOUS, SQLParserT_ORDER, SQLParserT_ASC, SQLParserT_DESC, SQLParserT_LIKE, SQLParserT_NOT, SQLParserT_BETWEEN, SQLParserT_IS, SQLParserT_GROUP, SQLParserT_HAVING, SQLParserT_BY, SQLParserT_FOR, SQLParserT_STATS, SQLParserT_TIME, SQLParserT_NOW, SQLParserT_IN, SQLParserT_ROLLUP, SQLParserT_CONTINUOUS, SQLParserT_EVERY, SQLParserT_INTO, SQLParserT_ALERTS, SQLParserT_DELETE, SQLParserT_LINEAR, SQLParserT_OFFSET, SQLParserT_LOG, SQLParserT_PROFILE, SQLParserT_REQUESTS, SQLParserT_REQUEST, SQLParserT_ID, SQLParserT_SUM, SQLParserT_MIN, SQLParserT_MAX, SQLParserT_COUNT, SQLParserT_LAST, SQLParserT_FIRST, SQLParserT_AVG, SQLParserT_STDDEV, SQLParserT_QUANTILE, SQLParserT_RATE, SQLParserT_HISTOGRAM_COUNT, SQLParserT_HISTOGRAM_SUM, SQLParserT_MOVING_AVERAGE, SQLParserT_DERIVATIVE, SQLParserT_NON_NEGATIVE_DIFFERENCE, SQLParserT_CUMULATIVE_SUM, SQLParserT_DELTA, SQLParserT_INCREASE, SQLParserT_INTEGRAL, SQLParserT_TOP, SQLParserT_BOTTOM, SQLParserT_ABS, SQLParserT_CEIL, SQLParserT_FLOOR, SQLParserT_ROUND, SQLParserT_LOG2, SQLParserT_LOG10, SQLParserT_SQRT, SQLParserT_POW, SQLParserT_CLAMP_MIN, SQLParserT_CLAMP_MAX, SQLParserT_IF, SQLParserT_COALESCE, SQLParserT_REGEXP_EXTRACT, SQLParserT_LABEL_REPLACE, SQLParserT_LABEL_JOIN, SQLParserT_PERCENTILE, SQLParserT_MEDIAN, SQLParserT_DISTINCT_COUNT, SQLParserT_SECOND, SQLParserT_MINUTE, SQLParserT_HOUR, SQLParserT_DAY, SQLParserT_WEEK, SQLParserT_MONTH, SQLParserT_YEAR:
 		{
-			p.SetState(860)
+			p.SetState(1014)
 			p.NonReservedWords()
 		}
 
@@ -18295,26 +21756,26 @@ func (p *SQLParser) Ident() (localctx IIdentContext) {
 		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		goto errorExit
 	}
-	p.SetState(870)
+	p.SetState(1024)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 62, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 71, p.GetParserRuleContext())
 	if p.HasError() {
 		goto errorExit
 	}
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(863)
+				p.SetState(1017)
 				p.Match(SQLParserT_DOT)
 				if p.HasError() {
 					// Recognition error - abort rule
 					goto errorExit
 				}
 			}
-			p.SetState(866)
+			p.SetState(1020)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
@@ -18323,7 +21784,7 @@ func (p *SQLParser) Ident() (localctx IIdentContext) {
 			switch p.GetTokenStream().LA(1) {
 			case SQLParserL_ID:
 				{
-					p.SetState(864)
+					p.SetState(1018)
 					p.Match(SQLParserL_ID)
 					if p.HasError() {
 						// Recognition error - abort rule
@@ -18331,9 +21792,9 @@ func (p *SQLParser) Ident() (localctx IIdentContext) {
 					}
 				}
 
-			case SQLParserT_CREATE, SQLParserT_UPDATE, SQLParserT_SET, SQLParserT_DROP, SQLParserT_INTERVAL, SQLParserT_INTERVAL_NAME, SQLParserT_SHARD, SQLParserT_REPLICATION, SQLParserT_MEMORY, SQLParserT_TTL, SQLParserT_META_TTL, SQLParserT_PAST_TTL, SQLParserT_FUTURE_TTL, SQLParserT_KILL, SQLParserT_ON, SQLParserT_SHOW, SQLParserT_USE, SQLParserT_STATE_REPO, SQLParserT_STATE_MACHINE, SQLParserT_MASTER, SQLParserT_METADATA, SQLParserT_TYPES, SQLParserT_TYPE, SQLParserT_STORAGES, SQLParserT_STORAGE, SQLParserT_BROKER, SQLParserT_ROOT, SQLParserT_BROKERS, SQLParserT_ALIVE, SQLParserT_SCHEMAS, SQLParserT_DATASBAE, SQLParserT_DATASBAES, SQLParserT_NAMESPACE, SQLParserT_NAMESPACES, SQLParserT_NODE, SQLParserT_METRICS, SQLParserT_METRIC, SQLParserT_FIELD, SQLParserT_FIELDS, SQLParserT_TAG, SQLParserT_INFO, SQLParserT_KEYS, SQLParserT_KEY, SQLParserT_WITH, SQLParserT_VALUES, SQLParserT_VALUE, SQLParserT_FROM, SQLParserT_WHERE, SQLParserT_LIMIT, SQLParserT_QUERIES, SQLParserT_QUERY, SQLParserT_EXPLAIN, SQLParserT_WITH_VALUE, SQLParserT_SELECT, SQLParserT_AS, SQLParserT_AND, SQLParserT_OR, SQLParserT_FILL, SQLParserT_NULL, SQLParserT_PREVIOUS, SQLParserT_ORDER, SQLParserT_ASC, SQLParserT_DESC, SQLParserT_LIKE, SQLParserT_NOT, SQLParserT_BETWEEN, SQLParserT_IS, SQLParserT_GROUP, SQLParserT_HAVING, SQLParserT_BY, SQLParserT_FOR, SQLParserT_STATS, SQLParserT_TIME, SQLParserT_NOW, SQLParserT_IN, SQLParserT_ROLLUP, SQLParserT_LOG, SQLParserT_PROFILE, SQLParserT_REQUESTS, SQLParserT_REQUEST, SQLParserT_ID, SQLParserT_SUM, SQLParserT_MIN, SQLParserT_MAX, SQLParserT_COUNT, SQLParserT_LAST, SQLParserT_FIRST, SQLParserT_AVG, SQLParserT_STDDEV, SQLParserT_QUANTILE, SQLParserT_RATE, SQLParserT_SECOND, SQLParserT_MINUTE, SQLParserT_HOUR, SQLParserT_DAY, SQLParserT_WEEK, SQLParserT_MONTH, SQLParserT_YEAR:
+			case SQLParserT_CREATE, SQLParserT_UPDATE, SQLParserT_SET, SQLParserT_DROP, SQLParserT_INTERVAL, SQLParserT_INTERVAL_NAME, SQLParserT_SHARD, SQLParserT_REPLICATION, SQLParserT_MEMORY, SQLParserT_TTL, SQLParserT_META_TTL, SQLParserT_PAST_TTL, SQLParserT_FUTURE_TTL, SQLParserT_KILL, SQLParserT_ON, SQLParserT_SHOW, SQLParserT_BACKUP, SQLParserT_RESTORE, SQLParserT_TO, SQLParserT_USE, SQLParserT_STATE_REPO, SQLParserT_STATE_MACHINE, SQLParserT_MASTER, SQLParserT_METADATA, SQLParserT_TYPES, SQLParserT_TYPE, SQLParserT_STORAGES, SQLParserT_STORAGE, SQLParserT_BROKER, SQLParserT_ROOT, SQLParserT_BROKERS, SQLParserT_ALIVE, SQLParserT_SCHEMAS, SQLParserT_DATASBAE, SQLParserT_DATASBAES, SQLParserT_NAMESPACE, SQLParserT_NAMESPACES, SQLParserT_NODE, SQLParserT_METRICS, SQLParserT_METRIC, SQLParserT_FIELD, SQLParserT_FIELDS, SQLParserT_TAG, SQLParserT_INFO, SQLParserT_KEYS, SQLParserT_KEY, SQLParserT_WITH, SQLParserT_VALUES, SQLParserT_VALUE, SQLParserT_FROM, SQLParserT_WHERE, SQLParserT_LIMIT, SQLParserT_QUERIES, SQLParserT_QUERY, SQLParserT_EXPLAIN, SQLParserT_WITH_VALUE, SQLParserT_SELECT, SQLParserT_AS, SQLParserT_AND, SQLParserT_OR, SQLParserT_FILL, SQLParserT_NULL, SQLParserT_PREVIOUS, SQLParserT_ORDER, SQLParserT_ASC, SQLParserT_DESC, SQLParserT_LIKE, SQLParserT_NOT, SQLParserT_BETWEEN, SQLParserT_IS, SQLParserT_GROUP, SQLParserT_HAVING, SQLParserT_BY, SQLParserT_FOR, SQLParserT_STATS, SQLParserT_TIME, SQLParserT_NOW, SQLParserT_IN, SQLParserT_ROLLUP, SQLParserT_CONTINUOUS, SQLParserT_EVERY, SQLParserT_INTO, SQLParserT_ALERTS, SQLParserT_DELETE, SQLParserT_LINEAR, SQLParserT_OFFSET, SQLParserT_LOG, SQLParserT_PROFILE, SQLParserT_REQUESTS, SQLParserT_REQUEST, SQLParserT_ID, SQLParserT_SUM, SQLParserT_MIN, SQLParserT_MAX, SQLParserT_COUNT, SQLParserT_LAST, SQLParserT_FIRST, SQLParserT_AVG, SQLParserT_STDDEV, SQLParserT_QUANTILE, SQLParserT_RATE, SQLParserT_HISTOGRAM_COUNT, SQLParserT_HISTOGRAM_SUM, SQLParserT_MOVING_AVERAGE, SQLParserT_DERIVATIVE, SQLParserT_NON_NEGATIVE_DIFFERENCE, SQLParserT_CUMULATIVE_SUM, SQLParserT_DELTA, SQLParserT_INCREASE, SQLParserT_INTEGRAL, SQLParserT_TOP, SQLParserT_BOTTOM, SQLParserT_ABS, SQLParserT_CEIL, SQLParserT_FLOOR, SQLParserT_ROUND, SQLParserT_LOG2, SQLParserT_LOG10, SQLParserT_SQRT, SQLParserT_POW, SQLParserT_CLAMP_MIN, SQLParserT_CLAMP_MAX, SQLParserT_IF, SQLParserT_COALESCE, SQLParserT_REGEXP_EXTRACT, SQLParserT_LABEL_REPLACE, SQLParserT_LABEL_JOIN, SQLParserT_PERCENTILE, SQLParserT_MEDIAN, SQLParserT_DISTINCT_COUNT, SQLParserT_SECOND, SQLParserT_MINUTE, SQLParserT_HOUR, SQLParserT_DAY, SQLParserT_WEEK, SQLParserT_MONTH, SQLParserT_YEAR:
 				{
-					p.SetState(865)
+					p.SetState(1019)
 					p.NonReservedWords()
 				}
 
@@ -18343,12 +21804,12 @@ func (p *SQLParser) Ident() (localctx IIdentContext) {
 			}
 
 		}
-		p.SetState(872)
+		p.SetState(1026)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 62, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 71, p.GetParserRuleContext())
 		if p.HasError() {
 			goto errorExit
 		}
@@ -18448,6 +21909,38 @@ type INonReservedWordsContext interface {
 	T_STDDEV() antlr.TerminalNode
 	T_QUANTILE() antlr.TerminalNode
 	T_RATE() antlr.TerminalNode
+	T_HISTOGRAM_COUNT() antlr.TerminalNode
+	T_HISTOGRAM_SUM() antlr.TerminalNode
+	T_MOVING_AVERAGE() antlr.TerminalNode
+	T_DERIVATIVE() antlr.TerminalNode
+	T_NON_NEGATIVE_DIFFERENCE() antlr.TerminalNode
+	T_CUMULATIVE_SUM() antlr.TerminalNode
+	T_DELTA() antlr.TerminalNode
+	T_INCREASE() antlr.TerminalNode
+	T_INTEGRAL() antlr.TerminalNode
+	T_TOP() antlr.TerminalNode
+	T_BOTTOM() antlr.TerminalNode
+	T_ABS() antlr.TerminalNode
+	T_CEIL() antlr.TerminalNode
+	T_FLOOR() antlr.TerminalNode
+	T_ROUND() antlr.TerminalNode
+	T_LOG2() antlr.TerminalNode
+	T_LOG10() antlr.TerminalNode
+	T_SQRT() antlr.TerminalNode
+	T_POW() antlr.TerminalNode
+	T_CLAMP_MIN() antlr.TerminalNode
+	T_CLAMP_MAX() antlr.TerminalNode
+	T_IF() antlr.TerminalNode
+	T_COALESCE() antlr.TerminalNode
+	T_REGEXP_EXTRACT() antlr.TerminalNode
+	T_LABEL_REPLACE() antlr.TerminalNode
+	T_LABEL_JOIN() antlr.TerminalNode
+	T_PERCENTILE() antlr.TerminalNode
+	T_MEDIAN() antlr.TerminalNode
+	T_DISTINCT_COUNT() antlr.TerminalNode
+	T_BACKUP() antlr.TerminalNode
+	T_RESTORE() antlr.TerminalNode
+	T_TO() antlr.TerminalNode
 	T_SECOND() antlr.TerminalNode
 	T_MINUTE() antlr.TerminalNode
 	T_HOUR() antlr.TerminalNode
@@ -18473,6 +21966,13 @@ type INonReservedWordsContext interface {
 	T_REQUEST() antlr.TerminalNode
 	T_ID() antlr.TerminalNode
 	T_ROLLUP() antlr.TerminalNode
+	T_CONTINUOUS() antlr.TerminalNode
+	T_EVERY() antlr.TerminalNode
+	T_INTO() antlr.TerminalNode
+	T_ALERTS() antlr.TerminalNode
+	T_DELETE() antlr.TerminalNode
+	T_LINEAR() antlr.TerminalNode
+	T_OFFSET() antlr.TerminalNode
 
 	// IsNonReservedWordsContext differentiates from other interfaces.
 	IsNonReservedWordsContext()
@@ -18802,6 +22302,134 @@ func (s *NonReservedWordsContext) T_RATE() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_RATE, 0)
 }
 
+func (s *NonReservedWordsContext) T_HISTOGRAM_COUNT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_HISTOGRAM_COUNT, 0)
+}
+
+func (s *NonReservedWordsContext) T_HISTOGRAM_SUM() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_HISTOGRAM_SUM, 0)
+}
+
+func (s *NonReservedWordsContext) T_MOVING_AVERAGE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_MOVING_AVERAGE, 0)
+}
+
+func (s *NonReservedWordsContext) T_DERIVATIVE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DERIVATIVE, 0)
+}
+
+func (s *NonReservedWordsContext) T_NON_NEGATIVE_DIFFERENCE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_NON_NEGATIVE_DIFFERENCE, 0)
+}
+
+func (s *NonReservedWordsContext) T_CUMULATIVE_SUM() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CUMULATIVE_SUM, 0)
+}
+
+func (s *NonReservedWordsContext) T_DELTA() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DELTA, 0)
+}
+
+func (s *NonReservedWordsContext) T_INCREASE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_INCREASE, 0)
+}
+
+func (s *NonReservedWordsContext) T_INTEGRAL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_INTEGRAL, 0)
+}
+
+func (s *NonReservedWordsContext) T_TOP() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TOP, 0)
+}
+
+func (s *NonReservedWordsContext) T_BOTTOM() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_BOTTOM, 0)
+}
+
+func (s *NonReservedWordsContext) T_ABS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ABS, 0)
+}
+
+func (s *NonReservedWordsContext) T_CEIL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CEIL, 0)
+}
+
+func (s *NonReservedWordsContext) T_FLOOR() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FLOOR, 0)
+}
+
+func (s *NonReservedWordsContext) T_ROUND() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ROUND, 0)
+}
+
+func (s *NonReservedWordsContext) T_LOG2() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_LOG2, 0)
+}
+
+func (s *NonReservedWordsContext) T_LOG10() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_LOG10, 0)
+}
+
+func (s *NonReservedWordsContext) T_SQRT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SQRT, 0)
+}
+
+func (s *NonReservedWordsContext) T_POW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_POW, 0)
+}
+
+func (s *NonReservedWordsContext) T_CLAMP_MIN() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CLAMP_MIN, 0)
+}
+
+func (s *NonReservedWordsContext) T_CLAMP_MAX() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CLAMP_MAX, 0)
+}
+
+func (s *NonReservedWordsContext) T_IF() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_IF, 0)
+}
+
+func (s *NonReservedWordsContext) T_COALESCE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_COALESCE, 0)
+}
+
+func (s *NonReservedWordsContext) T_REGEXP_EXTRACT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_REGEXP_EXTRACT, 0)
+}
+
+func (s *NonReservedWordsContext) T_LABEL_REPLACE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_LABEL_REPLACE, 0)
+}
+
+func (s *NonReservedWordsContext) T_LABEL_JOIN() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_LABEL_JOIN, 0)
+}
+
+func (s *NonReservedWordsContext) T_PERCENTILE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_PERCENTILE, 0)
+}
+
+func (s *NonReservedWordsContext) T_MEDIAN() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_MEDIAN, 0)
+}
+
+func (s *NonReservedWordsContext) T_DISTINCT_COUNT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DISTINCT_COUNT, 0)
+}
+
+func (s *NonReservedWordsContext) T_BACKUP() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_BACKUP, 0)
+}
+
+func (s *NonReservedWordsContext) T_RESTORE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_RESTORE, 0)
+}
+
+func (s *NonReservedWordsContext) T_TO() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TO, 0)
+}
+
 func (s *NonReservedWordsContext) T_SECOND() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_SECOND, 0)
 }
@@ -18902,6 +22530,34 @@ func (s *NonReservedWordsContext) T_ROLLUP() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_ROLLUP, 0)
 }
 
+func (s *NonReservedWordsContext) T_CONTINUOUS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CONTINUOUS, 0)
+}
+
+func (s *NonReservedWordsContext) T_EVERY() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_EVERY, 0)
+}
+
+func (s *NonReservedWordsContext) T_INTO() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_INTO, 0)
+}
+
+func (s *NonReservedWordsContext) T_ALERTS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ALERTS, 0)
+}
+
+func (s *NonReservedWordsContext) T_DELETE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DELETE, 0)
+}
+
+func (s *NonReservedWordsContext) T_LINEAR() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_LINEAR, 0)
+}
+
+func (s *NonReservedWordsContext) T_OFFSET() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_OFFSET, 0)
+}
+
 func (s *NonReservedWordsContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -18934,15 +22590,15 @@ func (s *NonReservedWordsContext) Accept(visitor antlr.ParseTreeVisitor) interfa
 
 func (p *SQLParser) NonReservedWords() (localctx INonReservedWordsContext) {
 	localctx = NewNonReservedWordsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 202, SQLParserRULE_nonReservedWords)
+	p.EnterRule(localctx, 236, SQLParserRULE_nonReservedWords)
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(873)
+		p.SetState(1027)
 		_la = p.GetTokenStream().LA(1)
 
-		if !(((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-4194368) != 0) || ((int64((_la-64)) & ^0x3f) == 0 && ((int64(1)<<(_la-64))&139655156596735) != 0)) {
+		if !(((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-2097184) != 0) || ((int64((_la-64)) & ^0x3f) == 0 && ((int64(1)<<(_la-64))&-1) != 0) || ((int64((_la-128)) & ^0x3f) == 0 && ((int64(1)<<(_la-128))&2081023) != 0)) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -18965,21 +22621,21 @@ errorExit:
 
 func (p *SQLParser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex int) bool {
 	switch ruleIndex {
-	case 57:
+	case 72:
 		var t *TagFilterExprContext = nil
 		if localctx != nil {
 			t = localctx.(*TagFilterExprContext)
 		}
 		return p.TagFilterExpr_Sempred(t, predIndex)
 
-	case 73:
+	case 90:
 		var t *BoolExprContext = nil
 		if localctx != nil {
 			t = localctx.(*BoolExprContext)
 		}
 		return p.BoolExpr_Sempred(t, predIndex)
 
-	case 78:
+	case 95:
 		var t *FieldExprContext = nil
 		if localctx != nil {
 			t = localctx.(*FieldExprContext)
@@ -93,6 +93,12 @@ type SQLVisitor interface {
 	// Visit a parse tree produced by SQLParser#recoverStorageStmt.
 	VisitRecoverStorageStmt(ctx *RecoverStorageStmtContext) interface{}
 
+	// Visit a parse tree produced by SQLParser#backupDatabaseStmt.
+	VisitBackupDatabaseStmt(ctx *BackupDatabaseStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#restoreDatabaseStmt.
+	VisitRestoreDatabaseStmt(ctx *RestoreDatabaseStmtContext) interface{}
+
 	// Visit a parse tree produced by SQLParser#showSchemasStmt.
 	VisitShowSchemasStmt(ctx *ShowSchemasStmtContext) interface{}
 
@@ -117,6 +123,21 @@ type SQLVisitor interface {
 	// Visit a parse tree produced by SQLParser#showTagKeysStmt.
 	VisitShowTagKeysStmt(ctx *ShowTagKeysStmtContext) interface{}
 
+	// Visit a parse tree produced by SQLParser#showContinuousQueriesStmt.
+	VisitShowContinuousQueriesStmt(ctx *ShowContinuousQueriesStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#showAlertsStmt.
+	VisitShowAlertsStmt(ctx *ShowAlertsStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#createContinuousQueryStmt.
+	VisitCreateContinuousQueryStmt(ctx *CreateContinuousQueryStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#dropContinuousQueryStmt.
+	VisitDropContinuousQueryStmt(ctx *DropContinuousQueryStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#cqName.
+	VisitCqName(ctx *CqNameContext) interface{}
+
 	// Visit a parse tree produced by SQLParser#showTagValuesStmt.
 	VisitShowTagValuesStmt(ctx *ShowTagValuesStmtContext) interface{}
 
@@ -135,6 +156,9 @@ type SQLVisitor interface {
 	// Visit a parse tree produced by SQLParser#storageName.
 	VisitStorageName(ctx *StorageNameContext) interface{}
 
+	// Visit a parse tree produced by SQLParser#backupPath.
+	VisitBackupPath(ctx *BackupPathContext) interface{}
+
 	// Visit a parse tree produced by SQLParser#requestID.
 	VisitRequestID(ctx *RequestIDContext) interface{}
 
@@ -159,6 +183,15 @@ type SQLVisitor interface {
 	// Visit a parse tree produced by SQLParser#optionValue.
 	VisitOptionValue(ctx *OptionValueContext) interface{}
 
+	// Visit a parse tree produced by SQLParser#deleteStmt.
+	VisitDeleteStmt(ctx *DeleteStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#dropMetricStmt.
+	VisitDropMetricStmt(ctx *DropMetricStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#dropNamespaceStmt.
+	VisitDropNamespaceStmt(ctx *DropNamespaceStmtContext) interface{}
+
 	// Visit a parse tree produced by SQLParser#queryStmt.
 	VisitQueryStmt(ctx *QueryStmtContext) interface{}
 
@@ -189,6 +222,18 @@ type SQLVisitor interface {
 	// Visit a parse tree produced by SQLParser#fromClause.
 	VisitFromClause(ctx *FromClauseContext) interface{}
 
+	// Visit a parse tree produced by SQLParser#joinClause.
+	VisitJoinClause(ctx *JoinClauseContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#joinMetric.
+	VisitJoinMetric(ctx *JoinMetricContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#metricAlias.
+	VisitMetricAlias(ctx *MetricAliasContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#subQueryClause.
+	VisitSubQueryClause(ctx *SubQueryClauseContext) interface{}
+
 	// Visit a parse tree produced by SQLParser#whereClause.
 	VisitWhereClause(ctx *WhereClauseContext) interface{}
 
@@ -228,6 +273,12 @@ type SQLVisitor interface {
 	// Visit a parse tree produced by SQLParser#groupByKey.
 	VisitGroupByKey(ctx *GroupByKeyContext) interface{}
 
+	// Visit a parse tree produced by SQLParser#groupByTagExpr.
+	VisitGroupByTagExpr(ctx *GroupByTagExprContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#groupByTagFunc.
+	VisitGroupByTagFunc(ctx *GroupByTagFuncContext) interface{}
+
 	// Visit a parse tree produced by SQLParser#fillOption.
 	VisitFillOption(ctx *FillOptionContext) interface{}
 
@@ -35,11 +35,83 @@ type listener struct {
 	requestStmt        *requestStmtParser
 	brokerStmt         *brokerStmtParser
 	limitStmt          *limitStmtParser
+	cqStmt             *continuousQueryStmtParser
+	alertStmt          *alertStmtParser
+	deleteStmt         *deleteStmtParser
+	dropMetricStmt     *dropMetricStmtParser
+
+	outerQueryStmts []*queryStmtParser // parsers of outer query when parsing sub query
 }
 
 // EnterQueryStmt is called when production queryStmt is entered.
 func (l *listener) EnterQueryStmt(ctx *grammar.QueryStmtContext) {
 	l.queryStmt = newQueryStmtParse(ctx.T_EXPLAIN() != nil)
+	if l.cqStmt != nil && len(l.outerQueryStmts) == 0 {
+		l.cqStmt.queryStmt = l.queryStmt
+	}
+}
+
+// EnterSubQueryClause is called when production subQueryClause is entered.
+func (l *listener) EnterSubQueryClause(_ *grammar.SubQueryClauseContext) {
+	l.outerQueryStmts = append(l.outerQueryStmts, l.queryStmt)
+}
+
+// ExitSubQueryClause is called when production subQueryClause is exited.
+func (l *listener) ExitSubQueryClause(ctx *grammar.SubQueryClauseContext) {
+	if len(l.outerQueryStmts) == 0 {
+		return
+	}
+	inner := l.queryStmt
+	l.queryStmt = l.outerQueryStmts[len(l.outerQueryStmts)-1]
+	l.outerQueryStmts = l.outerQueryStmts[:len(l.outerQueryStmts)-1]
+	l.queryStmt.visitSubQuery(inner, ctx.QueryStmt().LimitClause() != nil)
+}
+
+// EnterDeleteStmt is called when production deleteStmt is entered.
+func (l *listener) EnterDeleteStmt(_ *grammar.DeleteStmtContext) {
+	l.deleteStmt = newDeleteStmtParse()
+	// reuse query statement parser for from/where clause
+	l.queryStmt = l.deleteStmt.queryStmt
+}
+
+// EnterDropMetricStmt is called when production dropMetricStmt is entered.
+func (l *listener) EnterDropMetricStmt(ctx *grammar.DropMetricStmtContext) {
+	l.dropMetricStmt = newDropMetricStmtParse()
+	l.dropMetricStmt.visitDropMetric(ctx)
+}
+
+// EnterDropNamespaceStmt is called when production dropNamespaceStmt is entered.
+func (l *listener) EnterDropNamespaceStmt(ctx *grammar.DropNamespaceStmtContext) {
+	l.dropMetricStmt = newDropMetricStmtParse()
+	l.dropMetricStmt.visitDropNamespace(ctx)
+}
+
+// EnterCreateContinuousQueryStmt is called when production createContinuousQueryStmt is entered.
+func (l *listener) EnterCreateContinuousQueryStmt(ctx *grammar.CreateContinuousQueryStmtContext) {
+	l.cqStmt = newContinuousQueryStmtParse(stmt.CreateContinuousQuery)
+	l.cqStmt.visitCreate(ctx)
+}
+
+// EnterShowContinuousQueriesStmt is called when production showContinuousQueriesStmt is entered.
+func (l *listener) EnterShowContinuousQueriesStmt(_ *grammar.ShowContinuousQueriesStmtContext) {
+	l.cqStmt = newContinuousQueryStmtParse(stmt.ShowContinuousQueries)
+}
+
+// EnterDropContinuousQueryStmt is called when production dropContinuousQueryStmt is entered.
+func (l *listener) EnterDropContinuousQueryStmt(_ *grammar.DropContinuousQueryStmtContext) {
+	l.cqStmt = newContinuousQueryStmtParse(stmt.DropContinuousQuery)
+}
+
+// EnterShowAlertsStmt is called when production showAlertsStmt is entered.
+func (l *listener) EnterShowAlertsStmt(_ *grammar.ShowAlertsStmtContext) {
+	l.alertStmt = newAlertStmtParse()
+}
+
+// EnterCqName is called when production cqName is entered.
+func (l *listener) EnterCqName(ctx *grammar.CqNameContext) {
+	if l.cqStmt != nil {
+		l.cqStmt.visitName(ctx)
+	}
 }
 
 // EnterShowMetadataTypesStmt is called when production showMetadataTypesStmt is entered.
@@ -144,6 +216,21 @@ func (l *listener) EnterRecoverStorageStmt(_ *grammar.RecoverStorageStmtContext)
 	l.storageStmt = newStorageStmtParse(stmt.StorageOpRecover)
 }
 
+// EnterBackupDatabaseStmt is called when entering the backupDatabaseStmt production.
+func (l *listener) EnterBackupDatabaseStmt(_ *grammar.BackupDatabaseStmtContext) {
+	l.storageStmt = newStorageStmtParse(stmt.StorageOpBackup)
+}
+
+// EnterRestoreDatabaseStmt is called when entering the restoreDatabaseStmt production.
+func (l *listener) EnterRestoreDatabaseStmt(_ *grammar.RestoreDatabaseStmtContext) {
+	l.storageStmt = newStorageStmtParse(stmt.StorageOpRestore)
+}
+
+// EnterBackupPath is called when entering the backupPath production.
+func (l *listener) EnterBackupPath(c *grammar.BackupPathContext) {
+	l.storageStmt.visitBackupPath(c)
+}
+
 // EnterStorageName is called when entering the storageName production.
 func (l *listener) EnterStorageName(c *grammar.StorageNameContext) {
 	l.storageStmt.visitStorageName(c)
@@ -205,6 +292,10 @@ func (l *listener) EnterDropDatabaseStmt(_ *grammar.DropDatabaseStmtContext) {
 
 // EnterDatabaseName is called when production databaseName is entered.
 func (l *listener) EnterDatabaseName(ctx *grammar.DatabaseNameContext) {
+	if l.storageStmt != nil {
+		l.storageStmt.visitDatabaseName(ctx)
+		return
+	}
 	l.schemasStmt.visitDatabaseName(ctx)
 }
 
@@ -289,6 +380,13 @@ func (l *listener) EnterMetricName(ctx *grammar.MetricNameContext) {
 	}
 }
 
+// EnterJoinMetric is called when production joinMetric is entered.
+func (l *listener) EnterJoinMetric(ctx *grammar.JoinMetricContext) {
+	if l.queryStmt != nil {
+		l.queryStmt.visitJoinMetric(ctx)
+	}
+}
+
 // EnterSelectExpr is called when production selectExpr is entered.
 func (l *listener) EnterSelectExpr(_ *grammar.SelectExprContext) {
 	if l.queryStmt != nil {
@@ -325,9 +423,9 @@ func (l *listener) EnterFuncName(ctx *grammar.FuncNameContext) {
 }
 
 // ExitExprFunc is called when production exprFunc is exited.
-func (l *listener) ExitExprFunc(_ *grammar.ExprFuncContext) {
+func (l *listener) ExitExprFunc(ctx *grammar.ExprFuncContext) {
 	if l.queryStmt != nil {
-		l.queryStmt.completeFuncExpr()
+		l.queryStmt.completeFuncExpr(ctx)
 	}
 }
 
@@ -399,6 +497,13 @@ func (l *listener) EnterGroupByKey(ctx *grammar.GroupByKeyContext) {
 	}
 }
 
+// EnterFillOption is called when production fillOption is entered.
+func (l *listener) EnterFillOption(ctx *grammar.FillOptionContext) {
+	if l.queryStmt != nil {
+		l.queryStmt.visitFillOption(ctx)
+	}
+}
+
 // EnterSortField is called when production sortField is entered.
 func (l *listener) EnterSortField(ctx *grammar.SortFieldContext) {
 	if l.queryStmt != nil {
@@ -451,6 +556,14 @@ func (l *listener) ExitBoolExpr(ctx *grammar.BoolExprContext) {
 // statement returns query statement, if failure return error
 func (l *listener) statement() (stmt.Statement, error) {
 	switch {
+	case l.cqStmt != nil:
+		// continuous query statement includes query statement, so check it first.
+		return l.cqStmt.build()
+	case l.deleteStmt != nil:
+		// delete statement reuses query statement parser, so check it before query statement.
+		return l.deleteStmt.build()
+	case l.dropMetricStmt != nil:
+		return l.dropMetricStmt.build()
 	case l.useStmt != nil:
 		return l.useStmt.build()
 	case l.metadataStmt != nil:
@@ -471,6 +584,8 @@ func (l *listener) statement() (stmt.Statement, error) {
 		return l.brokerStmt.build()
 	case l.limitStmt != nil:
 		return l.limitStmt.build()
+	case l.alertStmt != nil:
+		return l.alertStmt.build()
 	default:
 		return nil, nil
 	}
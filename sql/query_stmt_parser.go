@@ -20,7 +20,10 @@ package sql
 import (
 	"errors"
 	"fmt"
+	"math"
+	"slices"
 	"strconv"
+	"strings"
 
 	commonconstants "github.com/lindb/common/constants"
 	commontimeutil "github.com/lindb/common/pkg/timeutil"
@@ -46,6 +49,10 @@ type queryStmtParser struct {
 	endTime   int64
 
 	groupBy         []string
+	groupByTags     []*stmt.GroupByTag // grouping tags of result, includes plain tag key and tag function
+	hasTagFunc      bool               // if group by has tag function, e.g. regexp_extract(host, pattern) as dc
+	fill            stmt.FillType
+	fillValue       float64
 	interval        int64
 	autoGroupByTime bool
 	orderBy         []stmt.Expr
@@ -55,6 +62,9 @@ type queryStmtParser struct {
 
 	having     bool
 	havingStmt stmt.Expr
+
+	joins    []*stmt.JoinMetric // joined metrics of cross metric query
+	subQuery *stmt.Query        // inner query of sub query
 }
 
 // newQueryStmtParse create a query statement parser
@@ -80,8 +90,17 @@ func (q *queryStmtParser) build() (stmt.Statement, error) {
 	query.Explain = q.explain
 	query.Namespace = q.namespace
 	query.MetricName = q.metricName
+	if len(q.joins) > 0 {
+		metricNames := make([]string, 0, len(q.joins))
+		for _, join := range q.joins {
+			metricNames = append(metricNames, join.MetricName)
+		}
+		query.MetricName = strings.Join(metricNames, ",")
+		query.Joins = q.joins
+	}
 	query.SelectItems = q.selectItems
 	query.Condition = q.condition
+	query.SubQuery = q.subQuery
 
 	now := commontimeutil.Now()
 	query.TimeRange = timeutil.TimeRange{Start: q.startTime, End: q.endTime}
@@ -99,9 +118,15 @@ func (q *queryStmtParser) build() (stmt.Statement, error) {
 	query.AutoGroupByTime = q.autoGroupByTime
 	query.AllFields = q.allFields
 	query.GroupBy = q.groupBy
+	if q.hasTagFunc {
+		query.GroupByTags = q.groupByTags
+	}
+	query.Fill = q.fill
+	query.FillValue = q.fillValue
 	query.Having = q.havingStmt
 	query.OrderByItems = q.orderBy
 	query.Limit = q.limit
+	query.ExplicitLimit = q.hasLimit
 	return query, nil
 }
 
@@ -116,6 +141,395 @@ func (q *queryStmtParser) validation() error {
 	if !q.allFields && len(q.selectItems) == 0 {
 		return fmt.Errorf("select fields cannbe be empty")
 	}
+	for _, item := range q.selectItems {
+		if err := checkOffset(item, false); err != nil {
+			return err
+		}
+		if err := checkSelector(item, false); err != nil {
+			return err
+		}
+		if err := checkScalar(item); err != nil {
+			return err
+		}
+		if err := checkDistribution(item); err != nil {
+			return err
+		}
+	}
+	if q.hasTagFunc {
+		tagKeys := make(map[string]struct{})
+		for _, groupByTag := range q.groupByTags {
+			if _, ok := tagKeys[groupByTag.Alias]; ok {
+				return fmt.Errorf("duplicate group by tag key: %s", groupByTag.Alias)
+			}
+			tagKeys[groupByTag.Alias] = struct{}{}
+		}
+	}
+	if len(q.joins) > 0 {
+		if q.hasDistribution() {
+			return fmt.Errorf("distribution function not support in cross metric query")
+		}
+		return q.checkJoin()
+	}
+	if q.subQuery != nil {
+		return q.checkSubQuery()
+	}
+	return nil
+}
+
+// hasDistribution returns if select list has distribution function.
+func (q *queryStmtParser) hasDistribution() bool {
+	var find func(expr stmt.Expr) bool
+	find = func(expr stmt.Expr) bool {
+		switch e := expr.(type) {
+		case *stmt.SelectItem:
+			return find(e.Expr)
+		case *stmt.ParenExpr:
+			return find(e.Expr)
+		case *stmt.BinaryExpr:
+			return find(e.Left) || find(e.Right)
+		case *stmt.CallExpr:
+			if function.IsDistribution(e.FuncType) {
+				return true
+			}
+			for _, param := range e.Params {
+				if find(param) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	for _, item := range q.selectItems {
+		if find(item) {
+			return true
+		}
+	}
+	return false
+}
+
+// visitSubQuery visits when production sub query clause is exited, builds the inner query.
+func (q *queryStmtParser) visitSubQuery(inner *queryStmtParser, hasLimit bool) {
+	if !hasLimit {
+		// result set of inner query is aggregated by outer query, returns all series if no limit
+		inner.limit = math.MaxInt32
+	}
+	subQuery, err := inner.build()
+	if err != nil {
+		q.err = err
+		return
+	}
+	q.subQuery = subQuery.(*stmt.Query)
+	q.namespace = q.subQuery.Namespace
+	q.metricName = q.subQuery.MetricName
+}
+
+// checkSubQuery checks if outer query of sub query is valid,
+// fields of inner query result must be aggregated by function.
+func (q *queryStmtParser) checkSubQuery() error {
+	if q.allFields {
+		return fmt.Errorf("select all fields not support in outer query of sub query")
+	}
+	if q.condition != nil || q.startTime > 0 || q.endTime > 0 {
+		return fmt.Errorf("where clause not support in outer query of sub query")
+	}
+	if q.hasTagFunc {
+		return fmt.Errorf("group by tag function not support in outer query of sub query")
+	}
+	var check func(expr stmt.Expr, inAgg bool) error
+	check = func(expr stmt.Expr, inAgg bool) error {
+		switch e := expr.(type) {
+		case *stmt.SelectItem:
+			return check(e.Expr, inAgg)
+		case *stmt.ParenExpr:
+			return check(e.Expr, inAgg)
+		case *stmt.BinaryExpr:
+			if err := check(e.Left, inAgg); err != nil {
+				return err
+			}
+			return check(e.Right, inAgg)
+		case *stmt.CallExpr:
+			if e.Offset != 0 {
+				return fmt.Errorf("offset not support in outer query of sub query: %s", e.Rewrite())
+			}
+			switch {
+			case function.IsPostAggregation(e.FuncType):
+			case inAgg:
+				return fmt.Errorf("nested function call not support in outer query of sub query: %s", e.Rewrite())
+			case e.FuncType == function.Sum, e.FuncType == function.Min, e.FuncType == function.Max,
+				e.FuncType == function.Count, e.FuncType == function.Avg, e.FuncType == function.Stddev,
+				e.FuncType == function.First, e.FuncType == function.Last, function.IsDistribution(e.FuncType):
+				inAgg = true
+			default:
+				return fmt.Errorf("function not support in outer query of sub query: %s", e.FuncType)
+			}
+			for _, param := range e.Params {
+				if err := check(param, inAgg); err != nil {
+					return err
+				}
+			}
+		case *stmt.FieldExpr:
+			if !inAgg {
+				return fmt.Errorf("field of sub query must be aggregated by function: %s", e.Name)
+			}
+		}
+		return nil
+	}
+	for _, item := range q.selectItems {
+		if err := check(item, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkJoin checks if cross metric query is valid, fields must be qualified by metric alias,
+// and each metric must be used in select list.
+func (q *queryStmtParser) checkJoin() error {
+	if q.allFields {
+		return fmt.Errorf("select all fields not support in cross metric query")
+	}
+	aliases := make(map[string]bool)
+	for _, join := range q.joins {
+		if _, ok := aliases[join.Alias]; ok {
+			return fmt.Errorf("duplicate metric alias: %s", join.Alias)
+		}
+		aliases[join.Alias] = false
+	}
+	useAlias := func(alias, expr string) error {
+		if _, ok := aliases[alias]; !ok {
+			return fmt.Errorf("field must be qualified by metric alias: %s", expr)
+		}
+		aliases[alias] = true
+		return nil
+	}
+	var check func(expr stmt.Expr) error
+	check = func(expr stmt.Expr) error {
+		switch e := expr.(type) {
+		case *stmt.SelectItem:
+			return check(e.Expr)
+		case *stmt.ParenExpr:
+			return check(e.Expr)
+		case *stmt.BinaryExpr:
+			if err := check(e.Left); err != nil {
+				return err
+			}
+			return check(e.Right)
+		case *stmt.CallExpr:
+			if e.Offset != 0 {
+				return fmt.Errorf("offset not support in cross metric query: %s", e.Rewrite())
+			}
+			if function.IsPostAggregation(e.FuncType) {
+				for _, param := range e.Params {
+					if err := check(param); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+			alias, ok := stmt.JoinAlias(e)
+			if !ok {
+				return fmt.Errorf("function params must be qualified by metric alias: %s", e.Rewrite())
+			}
+			var checkParam func(param stmt.Expr) error
+			checkParam = func(param stmt.Expr) error {
+				switch p := param.(type) {
+				case *stmt.FieldExpr:
+					if a, _, _ := stmt.SplitJoinFieldName(p.Name); a != alias {
+						return fmt.Errorf("function params must be fields of same metric: %s", e.Rewrite())
+					}
+				case *stmt.CallExpr:
+					for _, pp := range p.Params {
+						if err := checkParam(pp); err != nil {
+							return err
+						}
+					}
+				}
+				return nil
+			}
+			if err := checkParam(e); err != nil {
+				return err
+			}
+			return useAlias(alias, e.Rewrite())
+		case *stmt.FieldExpr:
+			alias, _, _ := stmt.SplitJoinFieldName(e.Name)
+			return useAlias(alias, e.Name)
+		}
+		return nil
+	}
+	for _, item := range q.selectItems {
+		if err := check(item); err != nil {
+			return err
+		}
+	}
+	for _, join := range q.joins {
+		if !aliases[join.Alias] {
+			return fmt.Errorf("metric alias not used in select list: %s", join.Alias)
+		}
+	}
+	return nil
+}
+
+// visitJoinMetric visits when production join metric expression is entered
+func (q *queryStmtParser) visitJoinMetric(ctx *grammar.JoinMetricContext) {
+	q.joins = append(q.joins, &stmt.JoinMetric{
+		MetricName: strutil.GetStringValue(ctx.MetricName().Ident().GetText()),
+		Alias:      strutil.GetStringValue(ctx.MetricAlias().Ident().GetText()),
+	})
+}
+
+// checkSelector checks if top/bottom function is valid, which only can be used as outermost select expr.
+func checkSelector(expr stmt.Expr, nested bool) error {
+	switch e := expr.(type) {
+	case *stmt.SelectItem:
+		return checkSelector(e.Expr, false)
+	case *stmt.ParenExpr:
+		return checkSelector(e.Expr, true)
+	case *stmt.BinaryExpr:
+		if err := checkSelector(e.Left, true); err != nil {
+			return err
+		}
+		return checkSelector(e.Right, true)
+	case *stmt.CallExpr:
+		if function.IsSelector(e.FuncType) {
+			if nested {
+				return fmt.Errorf("%s function only can be used as outermost select expression: %s", e.FuncType, e.Rewrite())
+			}
+			if len(e.Params) != 2 {
+				return fmt.Errorf("%s function expects params(k, expr[, others]): %s", e.FuncType, e.Rewrite())
+			}
+			k, ok := e.Params[0].(*stmt.NumberLiteral)
+			if !ok || k.Val < 1 || k.Val != math.Trunc(k.Val) {
+				return fmt.Errorf("%s function expects positive integer k: %s", e.FuncType, e.Rewrite())
+			}
+			if e.Offset != 0 {
+				return fmt.Errorf("offset not support for function: %s", e.FuncType)
+			}
+		}
+		for _, param := range e.Params {
+			if err := checkSelector(param, true); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// checkScalar checks if the params of scalar function are valid.
+func checkScalar(expr stmt.Expr) error {
+	switch e := expr.(type) {
+	case *stmt.SelectItem:
+		return checkScalar(e.Expr)
+	case *stmt.ParenExpr:
+		return checkScalar(e.Expr)
+	case *stmt.BinaryExpr:
+		if err := checkScalar(e.Left); err != nil {
+			return err
+		}
+		return checkScalar(e.Right)
+	case *stmt.CallExpr:
+		if function.IsScalar(e.FuncType) {
+			if e.Offset != 0 {
+				return fmt.Errorf("offset not support for function: %s", e.FuncType)
+			}
+			var ok bool
+			switch e.FuncType {
+			case function.Round:
+				ok = len(e.Params) == 1 || len(e.Params) == 2
+			case function.Pow, function.ClampMin, function.ClampMax:
+				ok = len(e.Params) == 2
+			case function.If:
+				ok = len(e.Params) == 3
+			case function.Coalesce:
+				ok = len(e.Params) > 0
+			default:
+				ok = len(e.Params) == 1
+			}
+			if !ok {
+				return fmt.Errorf("wrong number of params for function %s: %s", e.FuncType, e.Rewrite())
+			}
+		}
+		for _, param := range e.Params {
+			if err := checkScalar(param); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// checkDistribution checks if the params of distribution function are valid,
+// percentile(field, p), median(field) and distinct_count(tag).
+func checkDistribution(expr stmt.Expr) error {
+	switch e := expr.(type) {
+	case *stmt.SelectItem:
+		return checkDistribution(e.Expr)
+	case *stmt.ParenExpr:
+		return checkDistribution(e.Expr)
+	case *stmt.BinaryExpr:
+		if err := checkDistribution(e.Left); err != nil {
+			return err
+		}
+		return checkDistribution(e.Right)
+	case *stmt.CallExpr:
+		if function.IsDistribution(e.FuncType) {
+			if e.Offset != 0 {
+				return fmt.Errorf("offset not support for function: %s", e.FuncType)
+			}
+			paramCount := 1
+			if e.FuncType == function.Percentile {
+				paramCount = 2
+			}
+			if len(e.Params) != paramCount {
+				return fmt.Errorf("wrong number of params for function %s: %s", e.FuncType, e.Rewrite())
+			}
+			if _, ok := e.Params[0].(*stmt.FieldExpr); !ok {
+				return fmt.Errorf("first param of function %s must be field/tag key: %s", e.FuncType, e.Rewrite())
+			}
+			if e.FuncType == function.Percentile {
+				p, ok := e.Params[1].(*stmt.NumberLiteral)
+				if !ok || p.Val < 0 || p.Val > 100 {
+					return fmt.Errorf("percentile of function %s must be in [0, 100]: %s", e.FuncType, e.Rewrite())
+				}
+			}
+			return nil
+		}
+		for _, param := range e.Params {
+			if err := checkDistribution(param); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// checkOffset checks if time offset of function call is supported,
+// histogram function/nested function call cannot use time offset.
+func checkOffset(expr stmt.Expr, inCall bool) error {
+	switch e := expr.(type) {
+	case *stmt.SelectItem:
+		return checkOffset(e.Expr, inCall)
+	case *stmt.ParenExpr:
+		return checkOffset(e.Expr, inCall)
+	case *stmt.BinaryExpr:
+		if err := checkOffset(e.Left, inCall); err != nil {
+			return err
+		}
+		return checkOffset(e.Right, inCall)
+	case *stmt.CallExpr:
+		if e.Offset != 0 {
+			switch {
+			case inCall:
+				return fmt.Errorf("offset not support in nested function call: %s", e.Rewrite())
+			case e.FuncType == function.Quantile || e.FuncType == function.HistogramCount || e.FuncType == function.HistogramSum:
+				return fmt.Errorf("offset not support for function: %s", e.FuncType)
+			}
+		}
+		for _, param := range e.Params {
+			if err := checkOffset(param, true); err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
@@ -129,7 +543,12 @@ func (q *queryStmtParser) visitGroupByKey(ctx *grammar.GroupByKeyContext) {
 	switch {
 	case ctx.Ident() != nil:
 		tagKey := strutil.GetStringValue(ctx.Ident().GetText())
-		q.groupBy = append(q.groupBy, tagKey)
+		if !q.hasTagFunc || !slices.Contains(q.groupBy, tagKey) {
+			q.groupBy = append(q.groupBy, tagKey)
+		}
+		q.groupByTags = append(q.groupByTags, &stmt.GroupByTag{TagKeys: []string{tagKey}, Alias: tagKey})
+	case ctx.GroupByTagExpr() != nil:
+		q.visitGroupByTagExpr(ctx.GroupByTagExpr().(*grammar.GroupByTagExprContext))
 	case ctx.DurationLit() != nil:
 		// set group by time interval
 		q.interval = q.parseDuration(ctx.DurationLit())
@@ -141,6 +560,70 @@ func (q *queryStmtParser) visitGroupByKey(ctx *grammar.GroupByKeyContext) {
 	}
 }
 
+// visitGroupByTagExpr visits when production group by tag function expression is entered,
+// storage groups series by source tag keys, then broker derives grouping tag of result from source tag values.
+func (q *queryStmtParser) visitGroupByTagExpr(ctx *grammar.GroupByTagExprContext) {
+	idents := ctx.AllIdent()
+	params := make([]string, len(idents)-1)
+	for idx := range params {
+		params[idx] = strutil.GetStringValue(idents[idx].GetText())
+	}
+	groupByTag := &stmt.GroupByTag{Alias: strutil.GetStringValue(idents[len(idents)-1].GetText())}
+	funcCtx := ctx.GroupByTagFunc()
+	switch {
+	case funcCtx.T_REGEXP_EXTRACT() != nil:
+		groupByTag.FuncType = stmt.RegexpExtract
+	case funcCtx.T_LABEL_REPLACE() != nil:
+		groupByTag.FuncType = stmt.LabelReplace
+	case funcCtx.T_LABEL_JOIN() != nil:
+		groupByTag.FuncType = stmt.LabelJoin
+	}
+	if groupByTag.FuncType == stmt.LabelJoin {
+		// label_join(separator, tag1, tag2, ...)
+		if len(params) < 2 {
+			q.err = fmt.Errorf("%s function expects params(separator, tag[, tag...])", groupByTag.FuncType)
+			return
+		}
+		groupByTag.Args = params[:1]
+		groupByTag.TagKeys = params[1:]
+	} else {
+		// regexp_extract(tag, pattern)/label_replace(tag, pattern, replacement)
+		groupByTag.TagKeys = params[:1]
+		groupByTag.Args = params[1:]
+	}
+	if _, err := groupByTag.Pattern(); err != nil {
+		q.err = fmt.Errorf("invalid group by tag function %s: %w", groupByTag.FuncType, err)
+		return
+	}
+	q.hasTagFunc = true
+	for _, tagKey := range groupByTag.TagKeys {
+		if !slices.Contains(q.groupBy, tagKey) {
+			q.groupBy = append(q.groupBy, tagKey)
+		}
+	}
+	q.groupByTags = append(q.groupByTags, groupByTag)
+}
+
+// visitFillOption visits when production fill option expression is entered.
+func (q *queryStmtParser) visitFillOption(ctx *grammar.FillOptionContext) {
+	switch {
+	case ctx.T_NULL() != nil:
+		q.fill = stmt.NullFill
+	case ctx.T_PREVIOUS() != nil:
+		q.fill = stmt.PreviousFill
+	case ctx.T_LINEAR() != nil:
+		q.fill = stmt.LinearFill
+	default:
+		fillValue, err := strconv.ParseFloat(ctx.GetText(), 64)
+		if err != nil {
+			q.err = err
+			return
+		}
+		q.fill = stmt.ValueFill
+		q.fillValue = fillValue
+	}
+}
+
 // visitSortField visits when production sort field expression is entered.
 func (q *queryStmtParser) visitSortField(ctx *grammar.SortFieldContext) {
 	q.hasOrderBy = true
@@ -225,26 +708,34 @@ func (q *queryStmtParser) visitTimeRangeExpr(ctx *grammar.TimeRangeExprContext)
 
 // parseDuration parses time duration from duration string
 func (q *queryStmtParser) parseDuration(ctx grammar.IDurationLitContext) int64 {
+	result, err := parseDuration(ctx)
+	if err != nil {
+		q.err = err
+	}
+	return result
+}
+
+// parseDuration parses time duration(ms) from duration literal.
+func parseDuration(ctx grammar.IDurationLitContext) (int64, error) {
 	if ctx == nil {
-		return 0
+		return 0, nil
 	}
 	durationCtx, ok := ctx.(*grammar.DurationLitContext)
 	if !ok {
-		return 0
+		return 0, nil
 	}
 
 	duration, err := strconv.ParseInt(durationCtx.IntNumber().GetText(), 10, 64)
 	if err != nil {
-		q.err = err
-		return 0
+		return 0, err
 	}
 	var result int64
 	if durationCtx.IntervalItem() == nil {
-		return result
+		return result, nil
 	}
 	unit, ok := durationCtx.IntervalItem().(*grammar.IntervalItemContext)
 	if !ok {
-		return result
+		return result, nil
 	}
 	switch {
 	case unit.T_SECOND() != nil:
@@ -262,7 +753,7 @@ func (q *queryStmtParser) parseDuration(ctx grammar.IDurationLitContext) int64 {
 	case unit.T_YEAR() != nil:
 		result = duration * commontimeutil.OneYear
 	}
-	return result
+	return result, nil
 }
 
 // visitFieldExpr visits when production field expression is entered
@@ -272,6 +763,9 @@ func (q *queryStmtParser) visitFieldExpr(ctx *grammar.FieldExprContext) {
 		q.allFields = true
 	case ctx.ExprFunc() != nil:
 		q.exprStack.Push(&stmt.CallExpr{})
+	case ctx.DurationLit() != nil:
+		// duration param of function(e.g. derivative(f, 1m)), converts it to millisecond
+		q.setExprParam(&stmt.NumberLiteral{Val: float64(q.parseDuration(ctx.DurationLit()))})
 	case ctx.T_OPEN_P() != nil:
 		q.exprStack.Push(&stmt.ParenExpr{})
 	case ctx.T_MUL() != nil:
@@ -327,12 +821,78 @@ func (q *queryStmtParser) visitFuncName(ctx *grammar.FuncNameContext) {
 		callExpr.FuncType = function.Quantile
 	case ctx.T_RATE() != nil:
 		callExpr.FuncType = function.Rate
+	case ctx.T_HISTOGRAM_COUNT() != nil:
+		callExpr.FuncType = function.HistogramCount
+	case ctx.T_HISTOGRAM_SUM() != nil:
+		callExpr.FuncType = function.HistogramSum
+	case ctx.T_MOVING_AVERAGE() != nil:
+		callExpr.FuncType = function.MovingAverage
+	case ctx.T_DERIVATIVE() != nil:
+		callExpr.FuncType = function.Derivative
+	case ctx.T_NON_NEGATIVE_DIFFERENCE() != nil:
+		callExpr.FuncType = function.NonNegativeDifference
+	case ctx.T_CUMULATIVE_SUM() != nil:
+		callExpr.FuncType = function.CumulativeSum
+	case ctx.T_DELTA() != nil:
+		callExpr.FuncType = function.Delta
+	case ctx.T_INCREASE() != nil:
+		callExpr.FuncType = function.Increase
+	case ctx.T_INTEGRAL() != nil:
+		callExpr.FuncType = function.Integral
+	case ctx.T_TOP() != nil:
+		callExpr.FuncType = function.Top
+	case ctx.T_BOTTOM() != nil:
+		callExpr.FuncType = function.Bottom
+	case ctx.T_ABS() != nil:
+		callExpr.FuncType = function.Abs
+	case ctx.T_CEIL() != nil:
+		callExpr.FuncType = function.Ceil
+	case ctx.T_FLOOR() != nil:
+		callExpr.FuncType = function.Floor
+	case ctx.T_ROUND() != nil:
+		callExpr.FuncType = function.Round
+	case ctx.T_LOG() != nil:
+		callExpr.FuncType = function.Log
+	case ctx.T_LOG2() != nil:
+		callExpr.FuncType = function.Log2
+	case ctx.T_LOG10() != nil:
+		callExpr.FuncType = function.Log10
+	case ctx.T_SQRT() != nil:
+		callExpr.FuncType = function.Sqrt
+	case ctx.T_POW() != nil:
+		callExpr.FuncType = function.Pow
+	case ctx.T_CLAMP_MIN() != nil:
+		callExpr.FuncType = function.ClampMin
+	case ctx.T_CLAMP_MAX() != nil:
+		callExpr.FuncType = function.ClampMax
+	case ctx.T_IF() != nil:
+		callExpr.FuncType = function.If
+	case ctx.T_COALESCE() != nil:
+		callExpr.FuncType = function.Coalesce
+	case ctx.T_PERCENTILE() != nil:
+		callExpr.FuncType = function.Percentile
+	case ctx.T_MEDIAN() != nil:
+		callExpr.FuncType = function.Median
+	case ctx.T_DISTINCT_COUNT() != nil:
+		callExpr.FuncType = function.DistinctCount
 	}
 }
 
 // completeFuncExpr completes a function call expression for select list
-func (q *queryStmtParser) completeFuncExpr() {
+func (q *queryStmtParser) completeFuncExpr(ctx *grammar.ExprFuncContext) {
 	cur := q.exprStack.Pop()
+	if callExpr, ok := cur.(*stmt.CallExpr); ok {
+		if ctx.T_OFFSET() != nil {
+			callExpr.Offset = q.parseDuration(ctx.DurationLit())
+		}
+		if function.IsSelector(callExpr.FuncType) && len(callExpr.Params) == 3 {
+			// top/bottom(k, expr, others)
+			if others, ok := callExpr.Params[2].(*stmt.FieldExpr); ok && strings.EqualFold(others.Name, stmt.OthersOption) {
+				callExpr.Others = true
+				callExpr.Params = callExpr.Params[:2]
+			}
+		}
+	}
 	if cur != nil {
 		expr, ok := cur.(stmt.Expr)
 		if ok {
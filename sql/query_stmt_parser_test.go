@@ -19,6 +19,7 @@ package sql
 
 import (
 	"fmt"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -144,6 +145,18 @@ func TestSelectFuncItem(t *testing.T) {
 		Expr: &stmt.CallExpr{FuncType: function.Quantile, Params: []stmt.Expr{&stmt.NumberLiteral{Val: 0.99}}},
 	}, *selectItem)
 
+	sql = "select histogram_count(), histogram_sum(Histogram) from memory"
+	q, err = Parse(sql)
+	query = q.(*stmt.Query)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(query.SelectItems))
+	assert.Equal(t, stmt.SelectItem{
+		Expr: &stmt.CallExpr{FuncType: function.HistogramCount},
+	}, *(query.SelectItems[0]).(*stmt.SelectItem))
+	assert.Equal(t, stmt.SelectItem{
+		Expr: &stmt.CallExpr{FuncType: function.HistogramSum, Params: []stmt.Expr{&stmt.FieldExpr{Name: "Histogram"}}},
+	}, *(query.SelectItems[1]).(*stmt.SelectItem))
+
 	sql = "select rate(f) from memory"
 	q, err = Parse(sql)
 	query = q.(*stmt.Query)
@@ -340,6 +353,7 @@ func TestLimit(t *testing.T) {
 	query := q.(*stmt.Query)
 	assert.Nil(t, err)
 	assert.Equal(t, 10, query.Limit)
+	assert.True(t, query.ExplicitLimit)
 
 	sql = "select f from cpu limit abc"
 	_, err = Parse(sql)
@@ -351,6 +365,7 @@ func TestLimit(t *testing.T) {
 	query = q.(*stmt.Query)
 	assert.Nil(t, err)
 	assert.Equal(t, 20, query.Limit)
+	assert.False(t, query.ExplicitLimit)
 }
 
 func TestTimeRange(t *testing.T) {
@@ -429,6 +444,393 @@ func TestGroupBy(t *testing.T) {
 	assert.Equal(t, "/data", query.GroupBy[1])
 }
 
+func TestGroupByTagFunc(t *testing.T) {
+	q, err := Parse("select f from cpu group by region, regexp_extract(host, '\\.(\\w+)$') as dc, " +
+		"label_replace(host, 'web-(\\d+).*', 'node-$1') as node, host, label_join('/', region, ip) as location")
+	assert.NoError(t, err)
+	query := q.(*stmt.Query)
+	assert.Equal(t, []string{"region", "host", "ip"}, query.GroupBy)
+	assert.Equal(t, []*stmt.GroupByTag{
+		{TagKeys: []string{"region"}, Alias: "region"},
+		{FuncType: stmt.RegexpExtract, TagKeys: []string{"host"}, Args: []string{"\\.(\\w+)$"}, Alias: "dc"},
+		{FuncType: stmt.LabelReplace, TagKeys: []string{"host"}, Args: []string{"web-(\\d+).*", "node-$1"}, Alias: "node"},
+		{TagKeys: []string{"host"}, Alias: "host"},
+		{FuncType: stmt.LabelJoin, TagKeys: []string{"region", "ip"}, Args: []string{"/"}, Alias: "location"},
+	}, query.GroupByTags)
+	assert.Equal(t, []string{"region", "dc", "node", "host", "location"}, query.GroupByKeys())
+
+	// group by without tag function
+	q, err = Parse("select f from cpu group by host, time(1m)")
+	assert.NoError(t, err)
+	assert.Nil(t, q.(*stmt.Query).GroupByTags)
+
+	for _, sql := range []string{
+		"select f from cpu group by regexp_extract(host) as dc",
+		"select f from cpu group by regexp_extract(host, '(a') as dc",
+		"select f from cpu group by label_replace(host, 'a') as dc",
+		"select f from cpu group by label_join('-') as dc",
+		"select f from cpu group by host, regexp_extract(host, 'a') as host",
+		"select max(v) from (select avg(f) as v from cpu group by host) group by regexp_extract(host, 'a') as dc",
+	} {
+		_, err = Parse(sql)
+		assert.Error(t, err, sql)
+	}
+}
+
+func TestFill(t *testing.T) {
+	q, err := Parse("select f from cpu group by host")
+	assert.NoError(t, err)
+	assert.Equal(t, stmt.NoFill, q.(*stmt.Query).Fill)
+
+	cases := []struct {
+		sql       string
+		fill      stmt.FillType
+		fillValue float64
+	}{
+		{sql: "select f from cpu group by host,time(1m) fill(null)", fill: stmt.NullFill},
+		{sql: "select f from cpu group by time(1m) fill(previous)", fill: stmt.PreviousFill},
+		{sql: "select f from cpu group by time(1m) fill(LINEAR)", fill: stmt.LinearFill},
+		{sql: "select f from cpu group by time(1m) fill(10)", fill: stmt.ValueFill, fillValue: 10},
+		{sql: "select f from cpu group by time(1m) fill(-1.5) having f > 1", fill: stmt.ValueFill, fillValue: -1.5},
+	}
+	for _, tt := range cases {
+		q, err = Parse(tt.sql)
+		assert.NoError(t, err, tt.sql)
+		query := q.(*stmt.Query)
+		assert.Equal(t, tt.fill, query.Fill, tt.sql)
+		assert.Equal(t, tt.fillValue, query.FillValue, tt.sql)
+	}
+
+	_, err = Parse("select f from cpu group by time(1m) fill(abc)")
+	assert.Error(t, err)
+}
+
+func TestTransformFunc(t *testing.T) {
+	cases := []struct {
+		sql      string
+		funcType function.FuncType
+		params   []stmt.Expr
+	}{
+		{
+			sql:      "select moving_average(f, 5) from cpu",
+			funcType: function.MovingAverage,
+			params:   []stmt.Expr{&stmt.FieldExpr{Name: "f"}, &stmt.NumberLiteral{Val: 5}},
+		},
+		{
+			sql:      "select derivative(f, 1m) from cpu",
+			funcType: function.Derivative,
+			params:   []stmt.Expr{&stmt.FieldExpr{Name: "f"}, &stmt.NumberLiteral{Val: float64(commontimeutil.OneMinute)}},
+		},
+		{
+			sql:      "select non_negative_difference(f) from cpu",
+			funcType: function.NonNegativeDifference,
+			params:   []stmt.Expr{&stmt.FieldExpr{Name: "f"}},
+		},
+		{
+			sql:      "select cumulative_sum(f) from cpu",
+			funcType: function.CumulativeSum,
+			params:   []stmt.Expr{&stmt.FieldExpr{Name: "f"}},
+		},
+		{
+			sql:      "select delta(f) from cpu",
+			funcType: function.Delta,
+			params:   []stmt.Expr{&stmt.FieldExpr{Name: "f"}},
+		},
+		{
+			sql:      "select increase(max(f)) from cpu",
+			funcType: function.Increase,
+			params:   []stmt.Expr{&stmt.CallExpr{FuncType: function.Max, Params: []stmt.Expr{&stmt.FieldExpr{Name: "f"}}}},
+		},
+		{
+			sql:      "select integral(f, 1h) from cpu",
+			funcType: function.Integral,
+			params:   []stmt.Expr{&stmt.FieldExpr{Name: "f"}, &stmt.NumberLiteral{Val: float64(commontimeutil.OneHour)}},
+		},
+	}
+	for _, tt := range cases {
+		q, err := Parse(tt.sql)
+		assert.NoError(t, err, tt.sql)
+		query := q.(*stmt.Query)
+		assert.Equal(t, &stmt.CallExpr{FuncType: tt.funcType, Params: tt.params}, query.SelectItems[0].(*stmt.SelectItem).Expr, tt.sql)
+	}
+}
+
+func TestScalarFunc(t *testing.T) {
+	f := &stmt.FieldExpr{Name: "f"}
+	cases := []struct {
+		sql      string
+		funcType function.FuncType
+		params   []stmt.Expr
+	}{
+		{sql: "select abs(f) from cpu", funcType: function.Abs, params: []stmt.Expr{f}},
+		{sql: "select ceil(f) from cpu", funcType: function.Ceil, params: []stmt.Expr{f}},
+		{sql: "select floor(f) from cpu", funcType: function.Floor, params: []stmt.Expr{f}},
+		{sql: "select round(f, 2) from cpu", funcType: function.Round, params: []stmt.Expr{f, &stmt.NumberLiteral{Val: 2}}},
+		{sql: "select log(f) from cpu", funcType: function.Log, params: []stmt.Expr{f}},
+		{sql: "select log2(f) from cpu", funcType: function.Log2, params: []stmt.Expr{f}},
+		{
+			sql:      "select log10(sum(f)) from cpu",
+			funcType: function.Log10,
+			params:   []stmt.Expr{&stmt.CallExpr{FuncType: function.Sum, Params: []stmt.Expr{f}}},
+		},
+		{sql: "select sqrt(f) from cpu", funcType: function.Sqrt, params: []stmt.Expr{f}},
+		{sql: "select pow(f, 2) from cpu", funcType: function.Pow, params: []stmt.Expr{f, &stmt.NumberLiteral{Val: 2}}},
+		{sql: "select clamp_min(f, 0) from cpu", funcType: function.ClampMin, params: []stmt.Expr{f, &stmt.NumberLiteral{Val: 0}}},
+		{sql: "select clamp_max(f, 100) from cpu", funcType: function.ClampMax, params: []stmt.Expr{f, &stmt.NumberLiteral{Val: 100}}},
+		{
+			sql:      "select coalesce(f, g, 0) from cpu",
+			funcType: function.Coalesce,
+			params:   []stmt.Expr{f, &stmt.FieldExpr{Name: "g"}, &stmt.NumberLiteral{Val: 0}},
+		},
+		{
+			sql:      "select if(f > 10, f, 0) from cpu",
+			funcType: function.If,
+			params: []stmt.Expr{
+				&stmt.BinaryExpr{Left: f, Operator: stmt.GREATER, Right: &stmt.NumberLiteral{Val: 10}},
+				f,
+				&stmt.NumberLiteral{Val: 0},
+			},
+		},
+		{
+			sql:      "select if(f >= 1 and (g+1) < 2, max(f), min(g)) from cpu",
+			funcType: function.If,
+			params: []stmt.Expr{
+				&stmt.BinaryExpr{
+					Left:     &stmt.BinaryExpr{Left: f, Operator: stmt.GREATEREQUAL, Right: &stmt.NumberLiteral{Val: 1}},
+					Operator: stmt.AND,
+					Right: &stmt.BinaryExpr{
+						Left:     &stmt.ParenExpr{Expr: &stmt.BinaryExpr{Left: &stmt.FieldExpr{Name: "g"}, Operator: stmt.ADD, Right: &stmt.NumberLiteral{Val: 1}}},
+						Operator: stmt.LESS,
+						Right:    &stmt.NumberLiteral{Val: 2},
+					},
+				},
+				&stmt.CallExpr{FuncType: function.Max, Params: []stmt.Expr{f}},
+				&stmt.CallExpr{FuncType: function.Min, Params: []stmt.Expr{&stmt.FieldExpr{Name: "g"}}},
+			},
+		},
+	}
+	for _, tt := range cases {
+		q, err := Parse(tt.sql)
+		assert.NoError(t, err, tt.sql)
+		query := q.(*stmt.Query)
+		assert.Len(t, query.SelectItems, 1, tt.sql)
+		assert.Equal(t, &stmt.CallExpr{FuncType: tt.funcType, Params: tt.params}, query.SelectItems[0].(*stmt.SelectItem).Expr, tt.sql)
+	}
+
+	q, err := Parse("select log10(f) as l, abs(f)*2 from cpu group by host")
+	assert.NoError(t, err)
+	query := q.(*stmt.Query)
+	assert.Equal(t, "l", query.SelectItems[0].(*stmt.SelectItem).Alias)
+	assert.Equal(t, "abs(f)*2.00", query.SelectItems[1].Rewrite())
+
+	for _, sql := range []string{
+		"select abs(f, 1) from cpu",
+		"select round(f, 1, 2) from cpu",
+		"select pow(f) from cpu",
+		"select if(f > 1, f) from cpu",
+		"select coalesce() from cpu",
+		"select abs(f) offset 1h from cpu",
+	} {
+		_, err = Parse(sql)
+		assert.Error(t, err, sql)
+	}
+}
+
+func TestSelectorFunc(t *testing.T) {
+	q, err := Parse("select top(3, sum(f)/count(f)) as t, bottom(2, f, others) from cpu group by host")
+	assert.NoError(t, err)
+	query := q.(*stmt.Query)
+	assert.Equal(t, &stmt.SelectItem{
+		Expr: &stmt.CallExpr{FuncType: function.Top, Params: []stmt.Expr{
+			&stmt.NumberLiteral{Val: 3},
+			&stmt.BinaryExpr{
+				Left:     &stmt.CallExpr{FuncType: function.Sum, Params: []stmt.Expr{&stmt.FieldExpr{Name: "f"}}},
+				Operator: stmt.DIV,
+				Right:    &stmt.CallExpr{FuncType: function.Count, Params: []stmt.Expr{&stmt.FieldExpr{Name: "f"}}},
+			},
+		}},
+		Alias: "t",
+	}, query.SelectItems[0])
+	assert.Equal(t, &stmt.SelectItem{
+		Expr: &stmt.CallExpr{FuncType: function.Bottom, Params: []stmt.Expr{
+			&stmt.NumberLiteral{Val: 2},
+			&stmt.FieldExpr{Name: "f"},
+		}, Others: true},
+	}, query.SelectItems[1])
+
+	for _, sql := range []string{
+		"select top(3, f)+1 from cpu",
+		"select sum(top(3, f)) from cpu",
+		"select top(f) from cpu",
+		"select top(f, 3) from cpu",
+		"select top(0, f) from cpu",
+		"select bottom(1.5, f) from cpu",
+		"select top(3, f, g) from cpu",
+		"select top(3, f) offset 1d from cpu",
+	} {
+		_, err = Parse(sql)
+		assert.Error(t, err, sql)
+	}
+}
+
+func TestJoinQuery(t *testing.T) {
+	q, err := Parse("select sum(a.count)/sum(b.count) as ratio, b.count from http_errors as a, http_requests as b group by host")
+	assert.NoError(t, err)
+	query := q.(*stmt.Query)
+	assert.Equal(t, "http_errors,http_requests", query.MetricName)
+	assert.Equal(t, []*stmt.JoinMetric{
+		{MetricName: "http_errors", Alias: "a"},
+		{MetricName: "http_requests", Alias: "b"},
+	}, query.Joins)
+	assert.True(t, query.HasJoin())
+	assert.Equal(t, &stmt.SelectItem{
+		Expr: &stmt.BinaryExpr{
+			Left:     &stmt.CallExpr{FuncType: function.Sum, Params: []stmt.Expr{&stmt.FieldExpr{Name: "a.count"}}},
+			Operator: stmt.DIV,
+			Right:    &stmt.CallExpr{FuncType: function.Sum, Params: []stmt.Expr{&stmt.FieldExpr{Name: "b.count"}}},
+		},
+		Alias: "ratio",
+	}, query.SelectItems[0])
+
+	q, err = Parse("select top(3, a.f/b.f) from cpu as a, mem as b on ns group by host")
+	assert.NoError(t, err)
+	assert.Equal(t, "ns", q.(*stmt.Query).Namespace)
+	_, err = Parse("select sum(a.f)+b.f from cpu as a, mem as b where time>now()-1h")
+	assert.NoError(t, err)
+
+	for _, sql := range []string{
+		"select a.f from cpu as a",
+		"select * from cpu as a, mem as b",
+		"select f from cpu as a, mem as b",
+		"select a.f from cpu as a, mem as b",
+		"select a.f, b.f from cpu as a, mem as a",
+		"select a.f, c.f from cpu as a, mem as b",
+		"select sum(a.f) offset 1d, b.f from cpu as a, mem as b",
+		"select count(), b.f from cpu as a, mem as b",
+	} {
+		_, err = Parse(sql)
+		assert.Error(t, err, sql)
+	}
+}
+
+func TestSubQuery(t *testing.T) {
+	q, err := Parse("select max(v) from (select avg(cpu) as v from system group by host, time(1m)) group by time(5m)")
+	assert.NoError(t, err)
+	query := q.(*stmt.Query)
+	assert.True(t, query.HasSubQuery())
+	assert.Equal(t, "system", query.MetricName)
+	assert.Equal(t, timeutil.Interval(5*commontimeutil.OneMinute), query.Interval)
+	assert.Equal(t, []stmt.Expr{&stmt.SelectItem{
+		Expr: &stmt.CallExpr{FuncType: function.Max, Params: []stmt.Expr{&stmt.FieldExpr{Name: "v"}}},
+	}}, query.SelectItems)
+	subQuery := query.SubQuery
+	assert.Equal(t, "system", subQuery.MetricName)
+	assert.Equal(t, []string{"host"}, subQuery.GroupBy)
+	assert.Equal(t, timeutil.Interval(commontimeutil.OneMinute), subQuery.Interval)
+	// return all series of inner query if no limit
+	assert.Equal(t, math.MaxInt32, subQuery.Limit)
+
+	q, err = Parse("select sum(v) from (select max(x) as v from (select avg(cpu) as x from system group by host limit 5))")
+	assert.NoError(t, err)
+	assert.Equal(t, 5, q.(*stmt.Query).SubQuery.SubQuery.Limit)
+	q, err = Parse("select top(3, avg(v)) from (select avg(cpu) as v from system group by host, region) group by region")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"region"}, q.(*stmt.Query).GroupBy)
+
+	for _, sql := range []string{
+		"select v from (select avg(cpu) as v from system)",
+		"select * from (select avg(cpu) as v from system)",
+		"select max(v) from (select avg(cpu) as v from system) where host='a'",
+		"select max(v) from (select avg(cpu) as v from system) where time>now()-1h",
+		"select max(v) offset 1d from (select avg(cpu) as v from system)",
+		"select rate(v) from (select avg(cpu) as v from system)",
+		"select max(sum(v)) from (select avg(cpu) as v from system)",
+		"select max(v) from (select from system)",
+	} {
+		_, err = Parse(sql)
+		assert.Error(t, err, sql)
+	}
+}
+
+func TestDistributionFunc(t *testing.T) {
+	q, err := Parse("select percentile(usage, 95) as p95, median(usage), max(load) from cpu " +
+		"where region='sh' and time>now()-1h group by dc, time(1m) having p95 > 80 limit 5")
+	assert.NoError(t, err)
+	query := q.(*stmt.Query)
+	assert.False(t, query.HasSubQuery())
+	assert.Equal(t, &stmt.EqualsExpr{Key: "region", Value: "sh"}, query.Condition)
+	assert.Equal(t, []string{"dc"}, query.GroupBy)
+	assert.Equal(t, 5, query.Limit)
+	assert.NotNil(t, query.Having)
+	assert.Equal(t, &stmt.SelectItem{
+		Expr: &stmt.CallExpr{FuncType: function.Percentile, Params: []stmt.Expr{
+			&stmt.FieldExpr{Name: "usage"}, &stmt.NumberLiteral{Val: 95},
+		}},
+		Alias: "p95",
+	}, query.SelectItems[0])
+	assert.Equal(t, &stmt.SelectItem{
+		Expr: &stmt.CallExpr{FuncType: function.Median, Params: []stmt.Expr{&stmt.FieldExpr{Name: "usage"}}},
+	}, query.SelectItems[1])
+
+	q, err = Parse("select distinct_count(host), max(load) from cpu group by dc")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.SelectItem{
+		Expr: &stmt.CallExpr{FuncType: function.DistinctCount, Params: []stmt.Expr{&stmt.FieldExpr{Name: "host"}}},
+	}, q.(*stmt.Query).SelectItems[0])
+
+	// distribution function in outer query of sub query
+	_, err = Parse("select percentile(v, 99) from (select max(cpu) as v from system group by host)")
+	assert.NoError(t, err)
+
+	for _, sql := range []string{
+		"select percentile(f) from cpu",
+		"select percentile(f, 101) from cpu",
+		"select percentile(f, g) from cpu",
+		"select percentile(sum(f), 90) from cpu",
+		"select median(f, 1) from cpu",
+		"select distinct_count(1) from cpu",
+		"select median(f) offset 1d from cpu",
+		"select median(a.f), b.f from cpu as a, mem as b",
+	} {
+		_, err = Parse(sql)
+		assert.Error(t, err, sql)
+	}
+}
+
+func TestOffset(t *testing.T) {
+	q, err := Parse("select sum(req) offset 1w from http")
+	assert.NoError(t, err)
+	query := q.(*stmt.Query)
+	assert.Equal(t, &stmt.CallExpr{
+		FuncType: function.Sum,
+		Params:   []stmt.Expr{&stmt.FieldExpr{Name: "req"}},
+		Offset:   commontimeutil.OneWeek,
+	}, query.SelectItems[0].(*stmt.SelectItem).Expr)
+	assert.True(t, query.HasOffset())
+
+	q, err = Parse("select sum(req)/sum(req) offset 1d as ratio from http group by host")
+	assert.NoError(t, err)
+	query = q.(*stmt.Query)
+	assert.Equal(t, "sum(req)/sum(req) offset 1d as ratio", query.SelectItems[0].Rewrite())
+	binaryExpr := query.SelectItems[0].(*stmt.SelectItem).Expr.(*stmt.BinaryExpr)
+	assert.Equal(t, int64(0), binaryExpr.Left.(*stmt.CallExpr).Offset)
+	assert.Equal(t, commontimeutil.OneDay, binaryExpr.Right.(*stmt.CallExpr).Offset)
+
+	// offset as identifier
+	q, err = Parse("select offset from http")
+	assert.NoError(t, err)
+	assert.False(t, q.(*stmt.Query).HasOffset())
+
+	for _, sql := range []string{
+		"select quantile(0.99) offset 1d from http",
+		"select rate(sum(req) offset 1d) from http",
+		"select sum(req) offset from http",
+	} {
+		_, err = Parse(sql)
+		assert.Error(t, err, sql)
+	}
+}
+
 func TestEmptyCondition(t *testing.T) {
 	sql := "select f from cpu"
 	q, err := Parse(sql)
@@ -0,0 +1,46 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package stmt
+
+type ContinuousQueryOpType int
+
+const (
+	CreateContinuousQuery ContinuousQueryOpType = iota + 1
+	ShowContinuousQueries
+	DropContinuousQuery
+)
+
+// ContinuousQuery represents continuous query statement.
+type ContinuousQuery struct {
+	Type ContinuousQueryOpType
+	// Name represents the name of continuous query.
+	Name string
+	// Interval represents the execution interval(ms) of continuous query.
+	Interval int64
+	// TargetMetric represents the metric name which the query results are written into.
+	TargetMetric string
+	// SQL represents the raw sql of query statement.
+	SQL string
+	// Query represents the query statement.
+	Query *Query
+}
+
+// StatementType returns continuous query type.
+func (q *ContinuousQuery) StatementType() StatementType {
+	return ContinuousQueryStatement
+}
@@ -0,0 +1,78 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package stmt
+
+import (
+	"encoding/json"
+
+	"github.com/lindb/common/pkg/encoding"
+
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+// Delete represents delete series data statement,
+// deletes the data of series matched tag filter condition in time range.
+type Delete struct {
+	Namespace  string             // namespace
+	MetricName string             // like table name
+	Condition  Expr               // tag filter condition expression
+	TimeRange  timeutil.TimeRange // delete time range
+}
+
+// StatementType returns delete statement type.
+func (d *Delete) StatementType() StatementType {
+	return DeleteStatement
+}
+
+// innerDelete represents a wrapper of delete for json encoding.
+type innerDelete struct {
+	Namespace  string             `json:"namespace,omitempty"`
+	MetricName string             `json:"metricName,omitempty"`
+	Condition  json.RawMessage    `json:"condition,omitempty"`
+	TimeRange  timeutil.TimeRange `json:"timeRange,omitempty"`
+}
+
+// MarshalJSON returns json data of delete statement.
+func (d *Delete) MarshalJSON() ([]byte, error) {
+	inner := innerDelete{
+		Namespace:  d.Namespace,
+		MetricName: d.MetricName,
+		Condition:  Marshal(d.Condition),
+		TimeRange:  d.TimeRange,
+	}
+	return encoding.JSONMarshal(&inner), nil
+}
+
+// UnmarshalJSON parses json data to delete statement.
+func (d *Delete) UnmarshalJSON(value []byte) error {
+	inner := innerDelete{}
+	if err := encoding.JSONUnmarshal(value, &inner); err != nil {
+		return err
+	}
+	if inner.Condition != nil {
+		condition, err := Unmarshal(inner.Condition)
+		if err != nil {
+			return err
+		}
+		d.Condition = condition
+	}
+	d.Namespace = inner.Namespace
+	d.MetricName = inner.MetricName
+	d.TimeRange = inner.TimeRange
+	return nil
+}
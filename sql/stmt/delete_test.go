@@ -0,0 +1,54 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package stmt
+
+import (
+	"testing"
+
+	"github.com/lindb/common/pkg/encoding"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+func TestDelete_StatementType(t *testing.T) {
+	assert.Equal(t, DeleteStatement, (&Delete{}).StatementType())
+}
+
+func TestDelete_Marshal(t *testing.T) {
+	deleteStmt := Delete{
+		Namespace:  "ns",
+		MetricName: "test",
+		Condition: &BinaryExpr{
+			Left:     &InExpr{Key: "ip", Values: []string{"1.1.1.1", "2.2.2.2"}},
+			Operator: AND,
+			Right:    &EqualsExpr{Key: "region", Value: "sh"},
+		},
+		TimeRange: timeutil.TimeRange{Start: 10, End: 30},
+	}
+	data := encoding.JSONMarshal(&deleteStmt)
+	deleteStmt1 := Delete{}
+	err := encoding.JSONUnmarshal(data, &deleteStmt1)
+	assert.NoError(t, err)
+	assert.Equal(t, deleteStmt, deleteStmt1)
+
+	err = deleteStmt1.UnmarshalJSON([]byte("bad json"))
+	assert.Error(t, err)
+	err = deleteStmt1.UnmarshalJSON([]byte(`{"condition":{"type":"unknown"}}`))
+	assert.Error(t, err)
+}
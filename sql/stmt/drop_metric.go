@@ -0,0 +1,35 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package stmt
+
+// DropMetric represents drop metric/namespace statement,
+// drops the schema, index and data of metric(all metrics under namespace if metric name is empty).
+type DropMetric struct {
+	Namespace  string `json:"namespace"`
+	MetricName string `json:"metricName,omitempty"`
+}
+
+// StatementType returns drop metric statement type.
+func (d *DropMetric) StatementType() StatementType {
+	return DropMetricStatement
+}
+
+// IsDropNamespace returns if drops all metrics under namespace.
+func (d *DropMetric) IsDropNamespace() bool {
+	return d.MetricName == ""
+}
@@ -40,10 +40,14 @@ import (
 	"github.com/lindb/common/pkg/encoding"
 
 	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/pkg/timeutil"
 )
 
 //go:generate mockgen -source ./expr.go -destination=./expr_mock.go -package=stmt
 
+// OthersOption represents the option of top/bottom function which sums the remaining series.
+const OthersOption = "others"
+
 // OrderByExpr represents order by expr item.
 type OrderByExpr struct {
 	Expr Expr // support field name/function for select field item.
@@ -110,6 +114,8 @@ type NumberLiteral struct {
 type CallExpr struct {
 	FuncType function.FuncType
 	Params   []Expr
+	Offset   int64 // time offset(ms), reads data of time range shifted back by offset
+	Others   bool  // sums the remaining series into others series for top/bottom function
 }
 
 // innerCallExpr represents inner wrapper of call expr for json marshal
@@ -117,6 +123,8 @@ type innerCallExpr struct {
 	Type     string            `json:"type"`
 	FuncType function.FuncType `json:"funcType"`
 	Params   []json.RawMessage `json:"params"`
+	Offset   int64             `json:"offset,omitempty"`
+	Others   bool              `json:"others,omitempty"`
 }
 
 // ParenExpr represents a parenthesized expression
@@ -186,6 +194,12 @@ func (e *CallExpr) Rewrite() string {
 	for _, param := range e.Params {
 		params = append(params, param.Rewrite())
 	}
+	if e.Others {
+		params = append(params, OthersOption)
+	}
+	if e.Offset != 0 {
+		return fmt.Sprintf("%s(%s) offset %s", e.FuncType, strings.Join(params, ","), timeutil.Interval(e.Offset))
+	}
 	return fmt.Sprintf("%s(%s)", e.FuncType, strings.Join(params, ","))
 }
 
@@ -270,6 +284,8 @@ func Marshal(expr Expr) []byte {
 		inner := innerCallExpr{
 			Type:     "call",
 			FuncType: e.FuncType,
+			Offset:   e.Offset,
+			Others:   e.Others,
 		}
 		for _, param := range e.Params {
 			inner.Params = append(inner.Params, Marshal(param))
@@ -340,7 +356,7 @@ func unmarshalCall(value []byte) (Expr, error) {
 	if err != nil {
 		return nil, err
 	}
-	expr := &CallExpr{FuncType: innerExpr.FuncType}
+	expr := &CallExpr{FuncType: innerExpr.FuncType, Offset: innerExpr.Offset, Others: innerExpr.Others}
 	for _, param := range innerExpr.Params {
 		e, err := Unmarshal(param)
 		if err != nil {
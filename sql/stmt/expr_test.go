@@ -34,6 +34,10 @@ func TestExpr_Rewrite(t *testing.T) {
 
 	assert.Equal(t, "sum(f)", (&CallExpr{FuncType: function.Sum, Params: []Expr{&FieldExpr{Name: "f"}}}).Rewrite())
 	assert.Equal(t, "sum()", (&CallExpr{FuncType: function.Sum}).Rewrite())
+	assert.Equal(t, "sum(f) offset 1d",
+		(&CallExpr{FuncType: function.Sum, Params: []Expr{&FieldExpr{Name: "f"}}, Offset: 24 * 60 * 60 * 1000}).Rewrite())
+	assert.Equal(t, "top(3.00,f,others)",
+		(&CallExpr{FuncType: function.Top, Params: []Expr{&NumberLiteral{Val: 3}, &FieldExpr{Name: "f"}}, Others: true}).Rewrite())
 
 	assert.Equal(t, "(sum(f)+a)", (&ParenExpr{
 		Expr: &BinaryExpr{
@@ -205,6 +209,16 @@ func TestCallExpr_Marshal(t *testing.T) {
 	assert.NoError(t, err)
 	e := exprData.(*CallExpr)
 	assert.Equal(t, *expr, *e)
+
+	expr.Offset = 60 * 1000
+	exprData, err = Unmarshal(Marshal(expr))
+	assert.NoError(t, err)
+	assert.Equal(t, *expr, *exprData.(*CallExpr))
+
+	expr = &CallExpr{FuncType: function.Top, Params: []Expr{&NumberLiteral{Val: 3}, &FieldExpr{Name: "f"}}, Others: true}
+	exprData, err = Unmarshal(Marshal(expr))
+	assert.NoError(t, err)
+	assert.Equal(t, *expr, *exprData.(*CallExpr))
 }
 
 func TestParenExpr_Marshal(t *testing.T) {
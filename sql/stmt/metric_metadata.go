@@ -62,6 +62,7 @@ type MetricMetadata struct {
 	Prefix     string
 	Condition  Expr // tag filter condition expression
 	Limit      int  // result set limit
+	All        bool // returns all values without max suggestions limit, used by internal task(e.g. data export)
 }
 
 // StatementType returns metadata query type.
@@ -78,6 +79,7 @@ type innerMetadata struct {
 	Condition  json.RawMessage    `json:"condition,omitempty"`
 	Prefix     string             `json:"prefix,omitempty"`
 	Limit      int                `json:"limit,omitempty"`
+	All        bool               `json:"all,omitempty"`
 }
 
 // MarshalJSON returns json data of query
@@ -90,6 +92,7 @@ func (q *MetricMetadata) MarshalJSON() ([]byte, error) {
 		Type:       q.Type,
 		Prefix:     q.Prefix,
 		Limit:      q.Limit,
+		All:        q.All,
 	}
 	return encoding.JSONMarshal(&inner), nil
 }
@@ -113,5 +116,6 @@ func (q *MetricMetadata) UnmarshalJSON(value []byte) error {
 	q.TagKey = inner.TagKey
 	q.Prefix = inner.Prefix
 	q.Limit = inner.Limit
+	q.All = inner.All
 	return nil
 }
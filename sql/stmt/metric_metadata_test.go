@@ -55,6 +55,7 @@ func TestMetadata_MarshalJSON(t *testing.T) {
 		TagKey: "tagKey",
 		Prefix: "prefix",
 		Limit:  100,
+		All:    true,
 	}
 
 	data := encoding.JSONMarshal(&query)
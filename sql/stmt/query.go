@@ -19,20 +19,104 @@ package stmt
 
 import (
 	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
 
 	"github.com/lindb/common/pkg/encoding"
 
+	"github.com/lindb/lindb/aggregation/function"
 	"github.com/lindb/lindb/pkg/timeutil"
 )
 
+// FillType represents how to fill the empty slots of time series.
+type FillType int
+
+const (
+	// NoFill omits the empty slots.
+	NoFill FillType = iota
+	// NullFill keeps the empty slots as null, null value will be omitted in result set.
+	NullFill
+	// PreviousFill fills the empty slots with previous value.
+	PreviousFill
+	// LinearFill fills the empty slots with linear interpolation of previous and next value.
+	LinearFill
+	// ValueFill fills the empty slots with a constant value.
+	ValueFill
+)
+
+// JoinMetric represents the metric with alias in cross metric query.
+type JoinMetric struct {
+	MetricName string `json:"metricName"`
+	Alias      string `json:"alias"`
+}
+
+// TagFuncType represents the function type which derives grouping tag from source tag values.
+type TagFuncType int
+
+const (
+	// PlainTag uses the value of source tag as grouping tag value.
+	PlainTag TagFuncType = iota
+	// RegexpExtract extracts the first sub match(or whole match if no sub expression) of pattern from tag value.
+	RegexpExtract
+	// LabelReplace replaces tag value with replacement if pattern matches whole tag value, else keeps tag value.
+	LabelReplace
+	// LabelJoin joins the values of source tags with separator.
+	LabelJoin
+)
+
+// String returns the function name of tag function.
+func (t TagFuncType) String() string {
+	switch t {
+	case RegexpExtract:
+		return "regexp_extract"
+	case LabelReplace:
+		return "label_replace"
+	case LabelJoin:
+		return "label_join"
+	default:
+		return "tag"
+	}
+}
+
+// GroupByTag represents the grouping tag of result which derived from source tag values at query time,
+// e.g. group by regexp_extract(host, '^[^.]+\.(.+)$') as dc.
+type GroupByTag struct {
+	FuncType TagFuncType `json:"funcType,omitempty"`
+	TagKeys  []string    `json:"tagKeys"`        // source tag keys
+	Args     []string    `json:"args,omitempty"` // pattern/replacement of regexp, separator of join
+	Alias    string      `json:"alias"`
+}
+
+// Pattern compiles the regexp pattern of tag function, returns nil if tag function has not pattern.
+// Pattern of label_replace is anchored to match the whole tag value.
+func (t *GroupByTag) Pattern() (*regexp.Regexp, error) {
+	switch t.FuncType {
+	case RegexpExtract:
+		if len(t.Args) != 1 {
+			return nil, fmt.Errorf("%s function expects params(tag, pattern)", t.FuncType)
+		}
+		return regexp.Compile(t.Args[0])
+	case LabelReplace:
+		if len(t.Args) != 2 {
+			return nil, fmt.Errorf("%s function expects params(tag, pattern, replacement)", t.FuncType)
+		}
+		return regexp.Compile("^(?:" + t.Args[0] + ")$")
+	default:
+		return nil, nil
+	}
+}
+
 // Query represents search statement
 type Query struct {
-	Explain     bool   // need explain query execute stat
-	Namespace   string // namespace
-	MetricName  string // like table name
-	SelectItems []Expr // select list, such as field, function call, math expression etc.
-	AllFields   bool   // select all fields under metric
-	Condition   Expr   // tag filter condition expression
+	Explain     bool          // need explain query execute stat
+	Namespace   string        // namespace
+	MetricName  string        // like table name
+	Joins       []*JoinMetric // joined metrics of cross metric query, fields are qualified by metric alias
+	SelectItems []Expr        // select list, such as field, function call, math expression etc.
+	AllFields   bool          // select all fields under metric
+	Condition   Expr          // tag filter condition expression
+	SubQuery    *Query        // inner query, select list is evaluated on the result set of inner query
 
 	// broker plan maybe reset
 	TimeRange       timeutil.TimeRange // query time range
@@ -40,11 +124,17 @@ type Query struct {
 	StorageInterval timeutil.Interval  // down sampling storage interval, data find
 	IntervalRatio   int                // down sampling interval ratio(query interval/storage Interval)
 	AutoGroupByTime bool               // auto fix group by interval based on query time range
+	FixedInterval   bool               // keep query interval(smallest storage interval if not set), no down sampling by time range
 
-	GroupBy      []string // group by tag keys
-	Having       Expr     // having clause
-	OrderByItems []Expr   // order by field expr list
-	Limit        int      // num. of time series list for result
+	GroupBy       []string      // group by tag keys, storage groups series by these tag keys
+	GroupByTags   []*GroupByTag // grouping tags of result derived from group by tag values, nil if no tag function
+	RawSeries     bool          // returns each series without merging, series which hasn't some group by tag keys are kept
+	Fill          FillType      // fill policy of empty slots
+	FillValue     float64       // fill value if fill type is ValueFill
+	Having        Expr          // having clause
+	OrderByItems  []Expr        // order by field expr list
+	Limit         int           // num. of time series list for result
+	ExplicitLimit bool          // limit is set by limit clause, else default limit
 }
 
 // StatementType returns metric query type.
@@ -57,25 +147,233 @@ func (q *Query) HasGroupBy() bool {
 	return len(q.GroupBy) > 0
 }
 
+// GroupByKeys returns the grouping tag keys of result.
+func (q *Query) GroupByKeys() []string {
+	if len(q.GroupByTags) == 0 {
+		return q.GroupBy
+	}
+	keys := make([]string, len(q.GroupByTags))
+	for idx, groupByTag := range q.GroupByTags {
+		keys[idx] = groupByTag.Alias
+	}
+	return keys
+}
+
+// HasOffset returns whether query has time offset function call in select list.
+func (q *Query) HasOffset() bool {
+	selectItems := make(map[int64][]Expr)
+	for _, item := range q.SelectItems {
+		collectOffsetItems(item, selectItems)
+	}
+	for offset := range selectItems {
+		if offset != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// OffsetQueries splits the select list by time offset of function call,
+// returns time offset => sub query which time range shifted back by offset.
+// NOTE: having/order by/fill of sub query are removed, they are applied on final result.
+func (q *Query) OffsetQueries() map[int64]*Query {
+	selectItems := make(map[int64][]Expr)
+	for _, item := range q.SelectItems {
+		collectOffsetItems(item, selectItems)
+	}
+	queries := make(map[int64]*Query, len(selectItems))
+	for offset, items := range selectItems {
+		query := *q
+		query.SelectItems = items
+		query.TimeRange = timeutil.TimeRange{Start: q.TimeRange.Start - offset, End: q.TimeRange.End - offset}
+		query.Fill = NoFill
+		query.FillValue = 0
+		query.Having = nil
+		query.OrderByItems = nil
+		queries[offset] = &query
+	}
+	return queries
+}
+
+// collectOffsetItems collects the field/function call of select item group by time offset,
+// time offset of function call is removed for sub query.
+func collectOffsetItems(expr Expr, selectItems map[int64][]Expr) {
+	switch e := expr.(type) {
+	case *SelectItem:
+		collectOffsetItems(e.Expr, selectItems)
+	case *ParenExpr:
+		collectOffsetItems(e.Expr, selectItems)
+	case *BinaryExpr:
+		collectOffsetItems(e.Left, selectItems)
+		collectOffsetItems(e.Right, selectItems)
+	case *CallExpr:
+		selectItems[e.Offset] = append(selectItems[e.Offset], &SelectItem{Expr: &CallExpr{FuncType: e.FuncType, Params: e.Params}})
+	case *FieldExpr:
+		selectItems[0] = append(selectItems[0], &SelectItem{Expr: e})
+	}
+}
+
+// OffsetFieldName returns the field name of time offset function call in final aggregation,
+// which distinguishes the shifted field data from current field data.
+func OffsetFieldName(fieldName string, offset int64) string {
+	if offset == 0 {
+		return fieldName
+	}
+	return fmt.Sprintf("%s@%d", fieldName, offset)
+}
+
+// DistinctFieldName returns the field name of distinct_count(tag) which counts distinct tag values of series.
+func DistinctFieldName(tagKey string) string {
+	return fmt.Sprintf("distinct_count(%s)", tagKey)
+}
+
+// HasSubQuery returns whether query is evaluated on the result set of inner query.
+func (q *Query) HasSubQuery() bool {
+	return q.SubQuery != nil
+}
+
+// HasJoin returns whether query selects metrics qualified by alias(cross metric query),
+// even if only one aliased metric, whose fields(e.g. a.f) must be unqualified before querying storage.
+func (q *Query) HasJoin() bool {
+	return len(q.Joins) > 0
+}
+
+// JoinQueries splits the select list by metric alias, returns metric alias => sub query of the metric,
+// fields of sub query are unqualified(e.g. a.f => f).
+// NOTE: having/order by/fill of sub query are removed, they are applied on final joined result.
+func (q *Query) JoinQueries() map[string]*Query {
+	selectItems := make(map[string][]Expr)
+	for _, item := range q.SelectItems {
+		collectJoinItems(item, selectItems)
+	}
+	queries := make(map[string]*Query, len(q.Joins))
+	for _, join := range q.Joins {
+		query := *q
+		query.MetricName = join.MetricName
+		query.Joins = nil
+		query.SelectItems = selectItems[join.Alias]
+		query.Fill = NoFill
+		query.FillValue = 0
+		query.Having = nil
+		query.OrderByItems = nil
+		queries[join.Alias] = &query
+	}
+	return queries
+}
+
+// collectJoinItems collects the field/aggregation function call of select item group by metric alias,
+// transform/selector/scalar function evaluates on final joined result, so collects its params.
+func collectJoinItems(expr Expr, selectItems map[string][]Expr) {
+	switch e := expr.(type) {
+	case *SelectItem:
+		collectJoinItems(e.Expr, selectItems)
+	case *ParenExpr:
+		collectJoinItems(e.Expr, selectItems)
+	case *BinaryExpr:
+		collectJoinItems(e.Left, selectItems)
+		collectJoinItems(e.Right, selectItems)
+	case *CallExpr:
+		if function.IsPostAggregation(e.FuncType) {
+			for _, param := range e.Params {
+				collectJoinItems(param, selectItems)
+			}
+			return
+		}
+		if alias, ok := JoinAlias(e); ok {
+			selectItems[alias] = append(selectItems[alias], &SelectItem{Expr: unqualify(e)})
+		}
+	case *FieldExpr:
+		if alias, _, ok := SplitJoinFieldName(e.Name); ok {
+			selectItems[alias] = append(selectItems[alias], &SelectItem{Expr: unqualify(e)})
+		}
+	}
+}
+
+// JoinAlias returns the metric alias of first qualified field in expr.
+func JoinAlias(expr Expr) (string, bool) {
+	switch e := expr.(type) {
+	case *FieldExpr:
+		alias, _, ok := SplitJoinFieldName(e.Name)
+		return alias, ok
+	case *CallExpr:
+		for _, param := range e.Params {
+			if alias, ok := JoinAlias(param); ok {
+				return alias, true
+			}
+		}
+	case *ParenExpr:
+		return JoinAlias(e.Expr)
+	case *BinaryExpr:
+		if alias, ok := JoinAlias(e.Left); ok {
+			return alias, true
+		}
+		return JoinAlias(e.Right)
+	}
+	return "", false
+}
+
+// unqualify returns a copy of expr which field names are unqualified by metric alias.
+func unqualify(expr Expr) Expr {
+	switch e := expr.(type) {
+	case *FieldExpr:
+		_, name, _ := SplitJoinFieldName(e.Name)
+		return &FieldExpr{Name: name}
+	case *CallExpr:
+		call := &CallExpr{FuncType: e.FuncType, Offset: e.Offset, Others: e.Others}
+		for _, param := range e.Params {
+			call.Params = append(call.Params, unqualify(param))
+		}
+		return call
+	case *ParenExpr:
+		return &ParenExpr{Expr: unqualify(e.Expr)}
+	case *BinaryExpr:
+		return &BinaryExpr{Left: unqualify(e.Left), Operator: e.Operator, Right: unqualify(e.Right)}
+	default:
+		return expr
+	}
+}
+
+// JoinFieldName returns the field name qualified by metric alias in cross metric query(e.g. a.f).
+func JoinFieldName(alias, fieldName string) string {
+	return alias + "." + fieldName
+}
+
+// SplitJoinFieldName splits the qualified field name to metric alias and field name.
+func SplitJoinFieldName(name string) (alias, fieldName string, ok bool) {
+	alias, fieldName, ok = strings.Cut(name, ".")
+	if !ok || alias == "" || fieldName == "" {
+		return "", name, false
+	}
+	return alias, fieldName, true
+}
+
 // innerQuery represents a wrapper of query for json encoding
 type innerQuery struct {
 	Explain     bool              `json:"explain,omitempty"`
 	Namespace   string            `json:"namespace,omitempty"`
 	MetricName  string            `json:"metricName,omitempty"`
+	Joins       []*JoinMetric     `json:"joins,omitempty"`
 	SelectItems []json.RawMessage `json:"selectItems,omitempty"`
 	AllFields   bool              `json:"allFields,omitempty"`
 	Condition   json.RawMessage   `json:"condition,omitempty"`
+	SubQuery    *Query            `json:"subQuery,omitempty"`
 
 	TimeRange       timeutil.TimeRange `json:"timeRange,omitempty"`
 	Interval        timeutil.Interval  `json:"interval,omitempty"`
 	StorageInterval timeutil.Interval  `json:"storageInterval,omitempty"`
 	IntervalRatio   int                `json:"intervalRatio,omitempty"`
 	AutoGroupByTime bool               `json:"autoGroupByTime,omitempty"`
+	FixedInterval   bool               `json:"fixedInterval,omitempty"`
 
-	GroupBy      []string          `json:"groupBy,omitempty"`
-	Having       json.RawMessage   `json:"having,omitempty"`
-	OrderByItems []json.RawMessage `json:"orderByItems,omitempty"`
-	Limit        int               `json:"limit,omitempty"`
+	GroupBy       []string          `json:"groupBy,omitempty"`
+	GroupByTags   []*GroupByTag     `json:"groupByTags,omitempty"`
+	RawSeries     bool              `json:"rawSeries,omitempty"`
+	Fill          FillType          `json:"fill,omitempty"`
+	FillValue     float64           `json:"fillValue,omitempty"`
+	Having        json.RawMessage   `json:"having,omitempty"`
+	OrderByItems  []json.RawMessage `json:"orderByItems,omitempty"`
+	Limit         int               `json:"limit,omitempty"`
+	ExplicitLimit bool              `json:"explicitLimit,omitempty"`
 }
 
 // MarshalJSON returns json data of query
@@ -83,17 +381,25 @@ func (q *Query) MarshalJSON() ([]byte, error) {
 	inner := innerQuery{
 		Explain:         q.Explain,
 		MetricName:      q.MetricName,
+		Joins:           q.Joins,
 		AllFields:       q.AllFields,
 		Namespace:       q.Namespace,
 		Condition:       Marshal(q.Condition),
+		SubQuery:        q.SubQuery,
 		TimeRange:       q.TimeRange,
 		Interval:        q.Interval,
 		IntervalRatio:   q.IntervalRatio,
 		AutoGroupByTime: q.AutoGroupByTime,
+		FixedInterval:   q.FixedInterval,
 		StorageInterval: q.StorageInterval,
 		GroupBy:         q.GroupBy,
+		GroupByTags:     q.GroupByTags,
+		RawSeries:       q.RawSeries,
+		Fill:            q.Fill,
+		FillValue:       q.FillValue,
 		Having:          Marshal(q.Having),
 		Limit:           q.Limit,
+		ExplicitLimit:   q.ExplicitLimit,
 	}
 	for _, item := range q.SelectItems {
 		inner.SelectItems = append(inner.SelectItems, Marshal(item))
@@ -148,6 +454,8 @@ func (q *Query) UnmarshalJSON(value []byte) error {
 
 	q.Explain = inner.Explain
 	q.MetricName = inner.MetricName
+	q.Joins = inner.Joins
+	q.SubQuery = inner.SubQuery
 	q.Namespace = inner.Namespace
 	q.SelectItems = selectItems
 	q.AllFields = inner.AllFields
@@ -155,9 +463,15 @@ func (q *Query) UnmarshalJSON(value []byte) error {
 	q.Interval = inner.Interval
 	q.IntervalRatio = inner.IntervalRatio
 	q.AutoGroupByTime = inner.AutoGroupByTime
+	q.FixedInterval = inner.FixedInterval
 	q.StorageInterval = inner.StorageInterval
 	q.GroupBy = inner.GroupBy
+	q.GroupByTags = inner.GroupByTags
+	q.RawSeries = inner.RawSeries
+	q.Fill = inner.Fill
+	q.FillValue = inner.FillValue
 	q.OrderByItems = orderByItems
 	q.Limit = inner.Limit
+	q.ExplicitLimit = inner.ExplicitLimit
 	return nil
 }
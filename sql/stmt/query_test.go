@@ -64,9 +64,18 @@ func TestQuery_Marshal(t *testing.T) {
 				Right:    &EqualsExpr{Key: "path", Value: "/home"},
 			}},
 		},
-		TimeRange: timeutil.TimeRange{Start: 10, End: 30},
-		Interval:  1000,
-		GroupBy:   []string{"a", "b", "c"},
+		TimeRange:     timeutil.TimeRange{Start: 10, End: 30},
+		Interval:      1000,
+		FixedInterval: true,
+		GroupBy:       []string{"a", "b", "c"},
+		GroupByTags: []*GroupByTag{
+			{TagKeys: []string{"a"}, Alias: "a"},
+			{FuncType: RegexpExtract, TagKeys: []string{"b"}, Args: []string{"^(\\w+)"}, Alias: "bb"},
+			{FuncType: LabelJoin, TagKeys: []string{"b", "c"}, Args: []string{"-"}, Alias: "bc"},
+		},
+		RawSeries: true,
+		Fill:      ValueFill,
+		FillValue: 1.5,
 		OrderByItems: []Expr{
 			&FieldExpr{Name: "b"},
 			&CallExpr{
@@ -74,7 +83,8 @@ func TestQuery_Marshal(t *testing.T) {
 				Params:   []Expr{&FieldExpr{Name: "c"}},
 			},
 		},
-		Limit: 100,
+		Limit:         100,
+		ExplicitLimit: true,
 	}
 
 	data := encoding.JSONMarshal(&query)
@@ -83,7 +93,24 @@ func TestQuery_Marshal(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, query, query1)
 	assert.True(t, query.HasGroupBy())
+	assert.Equal(t, []string{"a", "bb", "bc"}, query.GroupByKeys())
 	assert.True(t, query.AllFields)
+	assert.False(t, query.HasSubQuery())
+
+	outer := Query{
+		MetricName: "test",
+		SelectItems: []Expr{&SelectItem{
+			Expr: &CallExpr{FuncType: function.Max, Params: []Expr{&FieldExpr{Name: "v"}}},
+		}},
+		SubQuery: &query,
+		Limit:    10,
+	}
+	data = encoding.JSONMarshal(&outer)
+	outer1 := Query{}
+	err = encoding.JSONUnmarshal(data, &outer1)
+	assert.NoError(t, err)
+	assert.Equal(t, outer, outer1)
+	assert.True(t, outer1.HasSubQuery())
 }
 
 func TestQuery_Marshal_Fail(t *testing.T) {
@@ -98,6 +125,152 @@ func TestQuery_Marshal_Fail(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestQuery_GroupByKeys(t *testing.T) {
+	assert.Nil(t, (&Query{}).GroupByKeys())
+	assert.Equal(t, []string{"host"}, (&Query{GroupBy: []string{"host"}}).GroupByKeys())
+}
+
+func TestGroupByTag_Pattern(t *testing.T) {
+	assert.Equal(t, "tag", PlainTag.String())
+	assert.Equal(t, "regexp_extract", RegexpExtract.String())
+	assert.Equal(t, "label_replace", LabelReplace.String())
+	assert.Equal(t, "label_join", LabelJoin.String())
+
+	pattern, err := (&GroupByTag{TagKeys: []string{"host"}}).Pattern()
+	assert.NoError(t, err)
+	assert.Nil(t, pattern)
+	pattern, err = (&GroupByTag{FuncType: LabelJoin, Args: []string{"-"}}).Pattern()
+	assert.NoError(t, err)
+	assert.Nil(t, pattern)
+
+	pattern, err = (&GroupByTag{FuncType: RegexpExtract, Args: []string{"\\.(\\w+)"}}).Pattern()
+	assert.NoError(t, err)
+	assert.Equal(t, "dc1", pattern.FindStringSubmatch("web-12.dc1")[1])
+	_, err = (&GroupByTag{FuncType: RegexpExtract}).Pattern()
+	assert.Error(t, err)
+	_, err = (&GroupByTag{FuncType: RegexpExtract, Args: []string{"(a"}}).Pattern()
+	assert.Error(t, err)
+
+	// pattern of label_replace matches whole tag value
+	pattern, err = (&GroupByTag{FuncType: LabelReplace, Args: []string{"web-(.*)", "$1"}}).Pattern()
+	assert.NoError(t, err)
+	assert.True(t, pattern.MatchString("web-12"))
+	assert.False(t, pattern.MatchString("x-web-12"))
+	_, err = (&GroupByTag{FuncType: LabelReplace, Args: []string{"web-(.*)"}}).Pattern()
+	assert.Error(t, err)
+}
+
 func TestQuery_StatementType(t *testing.T) {
 	assert.Equal(t, QueryStatement, (&Query{}).StatementType())
 }
+
+func TestQuery_OffsetQueries(t *testing.T) {
+	sumReq := func(offset int64) *CallExpr {
+		return &CallExpr{FuncType: function.Sum, Params: []Expr{&FieldExpr{Name: "req"}}, Offset: offset}
+	}
+	query := &Query{
+		MetricName: "http",
+		SelectItems: []Expr{
+			&SelectItem{Expr: &FieldExpr{Name: "cost"}},
+			&SelectItem{Expr: &BinaryExpr{Left: sumReq(0), Right: &ParenExpr{Expr: sumReq(100)}, Operator: DIV}},
+		},
+		TimeRange: timeutil.TimeRange{Start: 1000, End: 2000},
+		Fill:      ValueFill,
+		Having:    &FieldExpr{Name: "cost"},
+		Limit:     10,
+	}
+	assert.True(t, query.HasOffset())
+	assert.False(t, (&Query{SelectItems: []Expr{&SelectItem{Expr: sumReq(0)}}}).HasOffset())
+	assert.False(t, (&Query{AllFields: true}).HasOffset())
+
+	queries := query.OffsetQueries()
+	assert.Len(t, queries, 2)
+	assert.Equal(t, []Expr{
+		&SelectItem{Expr: &FieldExpr{Name: "cost"}},
+		&SelectItem{Expr: sumReq(0)},
+	}, queries[0].SelectItems)
+	assert.Equal(t, timeutil.TimeRange{Start: 1000, End: 2000}, queries[0].TimeRange)
+	assert.Equal(t, []Expr{&SelectItem{Expr: sumReq(0)}}, queries[100].SelectItems)
+	assert.Equal(t, timeutil.TimeRange{Start: 900, End: 1900}, queries[100].TimeRange)
+	assert.Equal(t, NoFill, queries[100].Fill)
+	assert.Nil(t, queries[100].Having)
+	assert.Equal(t, 10, queries[100].Limit)
+	// original query not changed
+	assert.Equal(t, int64(100), query.SelectItems[1].(*SelectItem).Expr.(*BinaryExpr).Right.(*ParenExpr).Expr.(*CallExpr).Offset)
+}
+
+func TestQuery_JoinQueries(t *testing.T) {
+	sum := func(name string) *CallExpr {
+		return &CallExpr{FuncType: function.Sum, Params: []Expr{&FieldExpr{Name: name}}}
+	}
+	query := &Query{
+		MetricName: "http_errors,http_requests",
+		Joins: []*JoinMetric{
+			{MetricName: "http_errors", Alias: "a"},
+			{MetricName: "http_requests", Alias: "b"},
+		},
+		SelectItems: []Expr{
+			&SelectItem{Expr: &FieldExpr{Name: "a.cost"}},
+			&SelectItem{Expr: &BinaryExpr{Left: sum("a.count"), Right: &ParenExpr{Expr: sum("b.count")}, Operator: DIV}},
+			&SelectItem{Expr: &CallExpr{FuncType: function.Top, Params: []Expr{&NumberLiteral{Val: 3}, sum("b.cost")}}},
+		},
+		Fill:   ValueFill,
+		Having: &FieldExpr{Name: "a.cost"},
+		Limit:  10,
+	}
+	assert.True(t, query.HasJoin())
+	assert.False(t, (&Query{MetricName: "cpu"}).HasJoin())
+	assert.True(t, (&Query{MetricName: "cpu", Joins: []*JoinMetric{{MetricName: "cpu", Alias: "a"}}}).HasJoin())
+
+	queries := query.JoinQueries()
+	assert.Len(t, queries, 2)
+	assert.Equal(t, "http_errors", queries["a"].MetricName)
+	assert.Nil(t, queries["a"].Joins)
+	assert.Equal(t, []Expr{
+		&SelectItem{Expr: &FieldExpr{Name: "cost"}},
+		&SelectItem{Expr: sum("count")},
+	}, queries["a"].SelectItems)
+	assert.Equal(t, "http_requests", queries["b"].MetricName)
+	assert.Equal(t, []Expr{
+		&SelectItem{Expr: sum("count")},
+		&SelectItem{Expr: sum("cost")},
+	}, queries["b"].SelectItems)
+	assert.Equal(t, NoFill, queries["b"].Fill)
+	assert.Nil(t, queries["b"].Having)
+	assert.Equal(t, 10, queries["b"].Limit)
+	// original query not changed
+	assert.Equal(t, "a.cost", query.SelectItems[0].(*SelectItem).Expr.(*FieldExpr).Name)
+
+	data, err := query.MarshalJSON()
+	assert.NoError(t, err)
+	query1 := &Query{}
+	assert.NoError(t, query1.UnmarshalJSON(data))
+	assert.Equal(t, query.Joins, query1.Joins)
+}
+
+func TestJoinFieldName(t *testing.T) {
+	assert.Equal(t, "a.f", JoinFieldName("a", "f"))
+	alias, name, ok := SplitJoinFieldName("a.f.g")
+	assert.True(t, ok)
+	assert.Equal(t, "a", alias)
+	assert.Equal(t, "f.g", name)
+	for _, name := range []string{"f", ".f", "a."} {
+		_, fieldName, ok := SplitJoinFieldName(name)
+		assert.False(t, ok)
+		assert.Equal(t, name, fieldName)
+	}
+	alias, ok = JoinAlias(&ParenExpr{Expr: &BinaryExpr{Left: &NumberLiteral{Val: 1}, Right: &FieldExpr{Name: "b.f"}}})
+	assert.True(t, ok)
+	assert.Equal(t, "b", alias)
+	_, ok = JoinAlias(&CallExpr{FuncType: function.Count})
+	assert.False(t, ok)
+}
+
+func TestOffsetFieldName(t *testing.T) {
+	assert.Equal(t, "f", OffsetFieldName("f", 0))
+	assert.Equal(t, "f@1000", OffsetFieldName("f", 1000))
+}
+
+func TestDistinctFieldName(t *testing.T) {
+	assert.Equal(t, "distinct_count(host)", DistinctFieldName("host"))
+}
@@ -30,6 +30,10 @@ const (
 	RequestStatement
 	BrokerStatement
 	LimitStatement
+	ContinuousQueryStatement
+	AlertStatement
+	DeleteStatement
+	DropMetricStatement
 )
 
 // Statement represents LinDB query language statement
@@ -25,12 +25,19 @@ const (
 	StorageOpUnknown StorageOpType = iota
 	// StorageOpRecover represents recover storage metadata.
 	StorageOpRecover
+	// StorageOpBackup represents backup database data of storage.
+	StorageOpBackup
+	// StorageOpRestore represents restore database data of storage from backup.
+	StorageOpRestore
 )
 
 // Storage represent storage statement.
 type Storage struct {
 	Type  StorageOpType
 	Value string
+
+	Database string // database name for backup/restore
+	Path     string // backup path for backup/restore
 }
 
 // StatementType returns storage query type.
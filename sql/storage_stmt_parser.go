@@ -45,6 +45,16 @@ func (s *storageStmtParser) visitCfg(ctx *grammar.JsonContext) {
 	s.storage.Value = ctx.GetText()
 }
 
+// visitDatabaseName visits when production database name expression is entered.
+func (s *storageStmtParser) visitDatabaseName(ctx *grammar.DatabaseNameContext) {
+	s.storage.Database = strutil.GetStringValue(ctx.GetText())
+}
+
+// visitBackupPath visits when production backup path expression is entered.
+func (s *storageStmtParser) visitBackupPath(ctx *grammar.BackupPathContext) {
+	s.storage.Path = strutil.GetStringValue(ctx.GetText())
+}
+
 // build returns the state statement.
 func (s *storageStmtParser) build() (stmt.Statement, error) {
 	return s.storage, nil
@@ -34,3 +34,25 @@ func TestRecoverStorage(t *testing.T) {
 		Value: "test",
 	}, q)
 }
+
+func TestBackupDatabase(t *testing.T) {
+	q, err := Parse(`backup database test to '/tmp/backup'`)
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.Storage{
+		Type:     stmt.StorageOpBackup,
+		Database: "test",
+		Path:     "/tmp/backup",
+	}, q)
+}
+
+func TestRestoreDatabase(t *testing.T) {
+	q, err := Parse(`restore database test from '/tmp/backup'`)
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.Storage{
+		Type:     stmt.StorageOpRestore,
+		Database: "test",
+		Path:     "/tmp/backup",
+	}, q)
+	_, err = Parse(`restore database test`)
+	assert.Error(t, err)
+}
@@ -0,0 +1,191 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tsdb
+
+import (
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"sort"
+
+	"github.com/lindb/common/pkg/encoding"
+	"github.com/lindb/common/pkg/logger"
+	commontimeutil "github.com/lindb/common/pkg/timeutil"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/kv"
+	"github.com/lindb/lindb/kv/version"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/option"
+)
+
+// backupManifest is the manifest file name under backup path.
+const backupManifest = "BACKUP"
+
+// BackupDatabase backups the data of database into backup path:
+// 1) flushes memory data(data family/index/metadata) into kv store;
+// 2) kv store(meta/index/segment) pins current version, then links the live files of it;
+// 3) other files(config/sequence/dropped ids etc.) are copied;
+// 4) writes the manifest of backup.
+// NOTE: data written during backup maybe not included, backup path must not exist.
+func (e *engine) BackupDatabase(databaseName, backupPath string) (*models.BackupManifest, error) {
+	db, ok := e.GetDatabase(databaseName)
+	if !ok {
+		return nil, fmt.Errorf("database[%s] not found", databaseName)
+	}
+	if fileExist(backupPath) {
+		return nil, fmt.Errorf("backup path[%s] already exist", backupPath)
+	}
+	// flush data/index before metadata, make sure backup includes the latest data and all metadata referenced by it
+	if err := db.FlushData(); err != nil {
+		return nil, err
+	}
+	db.WaitFlushMetaCompleted()
+	if err := db.FlushMeta(); err != nil {
+		return nil, err
+	}
+	dbPath := filepath.Clean(filepath.Join(config.GlobalStorageConfig().TSDB.Dir, databaseName))
+	stores := make(map[string]kv.Store)
+	for _, store := range kv.GetStoreManager().GetStores() {
+		stores[filepath.Clean(store.Path())] = store
+	}
+	manifest := &models.BackupManifest{
+		Database:  databaseName,
+		Path:      backupPath,
+		Timestamp: commontimeutil.Now(),
+	}
+	err := walkDirFunc(dbPath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(dbPath, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(backupPath, relPath)
+		if d.IsDir() {
+			if store, ok := stores[path]; ok {
+				if err := store.Backup(target); err != nil {
+					return err
+				}
+				manifest.Stores = append(manifest.Stores, relPath)
+				return filepath.SkipDir
+			}
+			if d.Name() == bufferDir {
+				// temp buffer for writing, skip it
+				return filepath.SkipDir
+			}
+			return mkDirIfNotExist(target)
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		return copyFileFunc(path, target)
+	})
+	if err != nil {
+		engineLogger.Error("backup database failure",
+			logger.String("database", databaseName), logger.String("path", backupPath), logger.Error(err))
+		if removeErr := removeDir(backupPath); removeErr != nil {
+			engineLogger.Warn("remove backup path failure", logger.String("path", backupPath), logger.Error(removeErr))
+		}
+		return nil, err
+	}
+	sort.Strings(manifest.Stores)
+	if err := writeFileFunc(filepath.Join(backupPath, backupManifest), encoding.JSONMarshal(manifest), 0644); err != nil {
+		return nil, err
+	}
+	engineLogger.Info("backup database successfully",
+		logger.String("database", databaseName), logger.String("path", backupPath))
+	return manifest, nil
+}
+
+// RestoreDatabase rebuilds the database from backup path, then loads it,
+// database must not exist on current node, sst files are linked(copied if link not supported),
+// other files are copied.
+func (e *engine) RestoreDatabase(databaseName, backupPath string) (Database, error) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if _, ok := e.GetDatabase(databaseName); ok {
+		return nil, fmt.Errorf("database[%s] already exist", databaseName)
+	}
+	dbPath := filepath.Join(config.GlobalStorageConfig().TSDB.Dir, databaseName)
+	if fileExist(dbPath) {
+		return nil, fmt.Errorf("database path[%s] already exist", dbPath)
+	}
+	manifest := &models.BackupManifest{}
+	data, err := readFileFunc(filepath.Join(backupPath, backupManifest))
+	if err != nil {
+		return nil, fmt.Errorf("read backup manifest from path[%s] failure: %s", backupPath, err)
+	}
+	if err = encoding.JSONUnmarshal(data, manifest); err != nil {
+		return nil, fmt.Errorf("decode backup manifest from path[%s] failure: %s", backupPath, err)
+	}
+	db, err := e.restoreDatabase(databaseName, backupPath)
+	if err != nil {
+		if removeErr := removeDir(dbPath); removeErr != nil {
+			engineLogger.Warn("remove database path failure when restore database failure",
+				logger.String("path", dbPath), logger.Error(removeErr))
+		}
+		return nil, err
+	}
+	engineLogger.Info("restore database successfully",
+		logger.String("database", databaseName),
+		logger.String("source", manifest.Database), logger.String("path", backupPath))
+	return db, nil
+}
+
+// restoreDatabase copies the files of backup into database path, then creates database.
+func (e *engine) restoreDatabase(databaseName, backupPath string) (Database, error) {
+	dbPath := filepath.Join(config.GlobalStorageConfig().TSDB.Dir, databaseName)
+	err := walkDirFunc(backupPath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(backupPath, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dbPath, relPath)
+		switch {
+		case d.IsDir():
+			return mkDirIfNotExist(target)
+		case !d.Type().IsRegular() || relPath == backupManifest:
+			return nil
+		case version.ParseFileName(d.Name()) != nil:
+			// sst file is immutable, link it
+			return linkFileFunc(path, target)
+		default:
+			return copyFileFunc(path, target)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	// database maybe restored with new name, reset the name of config
+	cfgPath := optionsPath(databaseName)
+	cfg := &models.DatabaseConfig{}
+	if err := decodeToml(cfgPath, cfg); err != nil {
+		return nil, fmt.Errorf("load database config from file[%s] with error: %s", cfgPath, err)
+	}
+	cfg.Name = databaseName
+	if err := encodeToml(cfgPath, cfg); err != nil {
+		return nil, fmt.Errorf("write database config to file[%s] with error: %s", cfgPath, err)
+	}
+	return e.createDatabase(databaseName, &option.DatabaseOption{})
+}
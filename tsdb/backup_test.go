@@ -0,0 +1,221 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tsdb
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lindb/common/pkg/fileutil"
+	"github.com/lindb/common/pkg/ltoml"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/kv"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/option"
+)
+
+func TestEngine_BackupDatabase(t *testing.T) {
+	writeConfigTestLock.Lock()
+	ctrl := gomock.NewController(t)
+	dir := t.TempDir()
+	cfg := config.GlobalStorageConfig()
+	cfg.TSDB.Dir = filepath.Join(dir, "data")
+	defer func() {
+		walkDirFunc = filepath.WalkDir
+		writeFileFunc = os.WriteFile
+		writeConfigTestLock.Unlock()
+		ctrl.Finish()
+	}()
+	dbPath := filepath.Join(cfg.TSDB.Dir, "db")
+	assert.NoError(t, fileutil.MkDirIfNotExist(filepath.Join(dbPath, shardDir, "1", bufferDir)))
+	assert.NoError(t, os.WriteFile(filepath.Join(dbPath, shardDir, "1", bufferDir, "1"), []byte("buf"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(dbPath, options), []byte("name = \"db\""), 0644))
+	assert.NoError(t, fileutil.MkDirIfNotExist(filepath.Join(dbPath, metaDir)))
+	assert.NoError(t, os.WriteFile(filepath.Join(dbPath, metaDir, "sequence"), []byte("seq"), 0644))
+	storePath := filepath.Join(dbPath, metaDir, "kv")
+	store, err := kv.GetStoreManager().CreateStore(storePath, kv.DefaultStoreOption())
+	assert.NoError(t, err)
+	defer func() {
+		assert.NoError(t, kv.GetStoreManager().CloseStore(store.Name()))
+	}()
+
+	db := NewMockDatabase(ctrl)
+	e := &engine{dbSet: *newDatabaseSet()}
+	e.dbSet.PutDatabase("db", db)
+	backupPath := filepath.Join(dir, "backup")
+
+	// case 1: database not found
+	manifest, err := e.BackupDatabase("db_not_exist", backupPath)
+	assert.Error(t, err)
+	assert.Nil(t, manifest)
+	// case 2: backup path exist
+	manifest, err = e.BackupDatabase("db", dir)
+	assert.Error(t, err)
+	assert.Nil(t, manifest)
+	// case 3: flush data failure
+	db.EXPECT().FlushData().Return(fmt.Errorf("err"))
+	manifest, err = e.BackupDatabase("db", backupPath)
+	assert.Error(t, err)
+	assert.Nil(t, manifest)
+	// case 4: flush metadata failure
+	db.EXPECT().FlushData().Return(nil).AnyTimes()
+	db.EXPECT().WaitFlushMetaCompleted().AnyTimes()
+	db.EXPECT().FlushMeta().Return(fmt.Errorf("err"))
+	manifest, err = e.BackupDatabase("db", backupPath)
+	assert.Error(t, err)
+	assert.Nil(t, manifest)
+	// case 5: walk dir failure
+	db.EXPECT().FlushMeta().Return(nil).AnyTimes()
+	walkDirFunc = func(root string, fn fs.WalkDirFunc) error {
+		assert.NoError(t, fileutil.MkDirIfNotExist(backupPath))
+		return fn(root, nil, fmt.Errorf("err"))
+	}
+	manifest, err = e.BackupDatabase("db", backupPath)
+	assert.Error(t, err)
+	assert.Nil(t, manifest)
+	assert.False(t, fileutil.Exist(backupPath))
+	// case 6: write manifest failure
+	walkDirFunc = filepath.WalkDir
+	writeFileFunc = func(_ string, _ []byte, _ os.FileMode) error {
+		return fmt.Errorf("err")
+	}
+	manifest, err = e.BackupDatabase("db", backupPath)
+	assert.Error(t, err)
+	assert.Nil(t, manifest)
+	// case 7: backup successfully
+	writeFileFunc = os.WriteFile
+	assert.NoError(t, os.RemoveAll(backupPath))
+	manifest, err = e.BackupDatabase("db", backupPath)
+	assert.NoError(t, err)
+	assert.Equal(t, "db", manifest.Database)
+	assert.Equal(t, backupPath, manifest.Path)
+	assert.Equal(t, []string{filepath.Join(metaDir, "kv")}, manifest.Stores)
+	assert.True(t, fileutil.Exist(filepath.Join(backupPath, backupManifest)))
+	assert.True(t, fileutil.Exist(filepath.Join(backupPath, options)))
+	assert.True(t, fileutil.Exist(filepath.Join(backupPath, metaDir, "sequence")))
+	assert.True(t, fileutil.Exist(filepath.Join(backupPath, metaDir, "kv", "CURRENT")))
+	assert.True(t, fileutil.Exist(filepath.Join(backupPath, shardDir, "1")))
+	assert.False(t, fileutil.Exist(filepath.Join(backupPath, shardDir, "1", bufferDir)))
+}
+
+func TestEngine_RestoreDatabase(t *testing.T) {
+	writeConfigTestLock.Lock()
+	ctrl := gomock.NewController(t)
+	dir := t.TempDir()
+	cfg := config.GlobalStorageConfig()
+	cfg.TSDB.Dir = filepath.Join(dir, "data")
+	defer func() {
+		walkDirFunc = filepath.WalkDir
+		decodeToml = ltoml.DecodeToml
+		encodeToml = ltoml.EncodeToml
+		newDatabaseFunc = newDatabase
+		writeConfigTestLock.Unlock()
+		ctrl.Finish()
+	}()
+	backupPath := filepath.Join(dir, "backup")
+	assert.NoError(t, fileutil.MkDirIfNotExist(filepath.Join(backupPath, metaDir, "kv", "f")))
+	assert.NoError(t, ltoml.EncodeToml(filepath.Join(backupPath, options), &models.DatabaseConfig{
+		Name:     "db",
+		Option:   &option.DatabaseOption{},
+		ShardIDs: []models.ShardID{1},
+	}))
+	assert.NoError(t, os.WriteFile(filepath.Join(backupPath, metaDir, "kv", "f", "000001.sst"), []byte("sst"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(backupPath, metaDir, "sequence"), []byte("seq"), 0644))
+
+	db := NewMockDatabase(ctrl)
+	db.EXPECT().SetLimits(gomock.Any()).AnyTimes()
+	e := &engine{dbSet: *newDatabaseSet()}
+	e.dbSet.PutDatabase("db", db)
+
+	// case 1: database exist
+	restored, err := e.RestoreDatabase("db", backupPath)
+	assert.Error(t, err)
+	assert.Nil(t, restored)
+	// case 2: database path exist
+	assert.NoError(t, fileutil.MkDirIfNotExist(filepath.Join(cfg.TSDB.Dir, "db2")))
+	restored, err = e.RestoreDatabase("db2", backupPath)
+	assert.Error(t, err)
+	assert.Nil(t, restored)
+	// case 3: manifest not exist
+	restored, err = e.RestoreDatabase("new_db", backupPath)
+	assert.Error(t, err)
+	assert.Nil(t, restored)
+	// case 4: decode manifest failure
+	assert.NoError(t, os.WriteFile(filepath.Join(backupPath, backupManifest), []byte("abc"), 0644))
+	restored, err = e.RestoreDatabase("new_db", backupPath)
+	assert.Error(t, err)
+	assert.Nil(t, restored)
+	assert.NoError(t, os.WriteFile(filepath.Join(backupPath, backupManifest), []byte(`{"database":"db"}`), 0644))
+	// case 5: walk dir failure
+	walkDirFunc = func(root string, fn fs.WalkDirFunc) error {
+		assert.NoError(t, fileutil.MkDirIfNotExist(filepath.Join(cfg.TSDB.Dir, "new_db")))
+		return fn(root, nil, fmt.Errorf("err"))
+	}
+	restored, err = e.RestoreDatabase("new_db", backupPath)
+	assert.Error(t, err)
+	assert.Nil(t, restored)
+	assert.False(t, fileutil.Exist(filepath.Join(cfg.TSDB.Dir, "new_db")))
+	walkDirFunc = filepath.WalkDir
+	// case 6: decode config failure
+	decodeToml = func(_ string, _ interface{}) error {
+		return fmt.Errorf("err")
+	}
+	restored, err = e.RestoreDatabase("new_db", backupPath)
+	assert.Error(t, err)
+	assert.Nil(t, restored)
+	decodeToml = ltoml.DecodeToml
+	// case 7: encode config failure
+	encodeToml = func(_ string, _ interface{}) error {
+		return fmt.Errorf("err")
+	}
+	restored, err = e.RestoreDatabase("new_db", backupPath)
+	assert.Error(t, err)
+	assert.Nil(t, restored)
+	encodeToml = ltoml.EncodeToml
+	// case 8: create database failure
+	newDatabaseFunc = func(_ string, _ *models.DatabaseConfig, _ *models.Limits, _ DataFlushChecker) (Database, error) {
+		return nil, fmt.Errorf("err")
+	}
+	restored, err = e.RestoreDatabase("new_db", backupPath)
+	assert.Error(t, err)
+	assert.Nil(t, restored)
+	assert.False(t, fileutil.Exist(filepath.Join(cfg.TSDB.Dir, "new_db")))
+	// case 9: restore successfully
+	var dbCfg *models.DatabaseConfig
+	newDatabaseFunc = func(_ string, cfg *models.DatabaseConfig, _ *models.Limits, _ DataFlushChecker) (Database, error) {
+		dbCfg = cfg
+		return db, nil
+	}
+	restored, err = e.RestoreDatabase("new_db", backupPath)
+	assert.NoError(t, err)
+	assert.Equal(t, db, restored)
+	assert.Equal(t, "new_db", dbCfg.Name)
+	assert.Equal(t, []models.ShardID{1}, dbCfg.ShardIDs)
+	dbPath := filepath.Join(cfg.TSDB.Dir, "new_db")
+	assert.True(t, fileutil.Exist(filepath.Join(dbPath, metaDir, "sequence")))
+	assert.True(t, fileutil.Exist(filepath.Join(dbPath, metaDir, "kv", "f", "000001.sst")))
+	assert.False(t, fileutil.Exist(filepath.Join(dbPath, backupManifest)))
+	_, ok := e.GetDatabase("new_db")
+	assert.True(t, ok)
+}
@@ -28,17 +28,20 @@ import (
 	"github.com/lindb/common/pkg/logger"
 	"github.com/lindb/common/pkg/ltoml"
 	commontimeutil "github.com/lindb/common/pkg/timeutil"
+	"github.com/lindb/roaring"
 	"go.uber.org/atomic"
 
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/kv"
+	"github.com/lindb/lindb/kv/version"
 	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/series/metric"
 	"github.com/lindb/lindb/tsdb/memdb"
 	"github.com/lindb/lindb/tsdb/tblstore/metricsdata"
+	"github.com/lindb/lindb/tsdb/tombstone"
 )
 
 //go:generate mockgen -source=./data_family.go -destination=./data_family_mock.go -package=tsdb
@@ -59,6 +62,9 @@ type DataFamily interface {
 	Family() kv.Family
 	// WriteRows writes metric rows with same family in batch.
 	WriteRows(rows []*metric.StorageRow) error
+	// DeleteSeries marks the data of series deleted in time range,
+	// deleted data is skipped when query and purged when doing compaction.
+	DeleteSeries(metricID metric.ID, seriesIDs *roaring.Bitmap, timeRange timeutil.TimeRange) error
 	// ValidateSequence validates replica sequence if valid.
 	ValidateSequence(leader int32, seq int64) bool
 	// CommitSequence commits written sequence after write data.
@@ -72,6 +78,8 @@ type DataFamily interface {
 	IsFlushing() bool
 	// Flush flushes memory database.
 	Flush() error
+	// WaitFlushCompleted waits flush memory database job completed.
+	WaitFlushCompleted()
 	// MemDBSize returns memory database heap size.
 	MemDBSize() int64
 
@@ -101,6 +109,8 @@ type dataFamily struct {
 	intervalCalc   timeutil.IntervalCalculator
 	immutableMemDB memdb.MemoryDatabase
 	mutableMemDB   memdb.MemoryDatabase
+	tombstones     tombstone.Store
+	tombstoneErr   error
 	statistics     *metrics.FamilyStatistics
 	seq            map[int32]atomic.Int64
 	immutableSeq   map[int32]int64
@@ -160,6 +170,15 @@ func newDataFamily(
 	f.indicator = fmt.Sprintf("%s/%s/%s", dbName, shardIDStr,
 		commontimeutil.FormatTimestamp(familyTime, commontimeutil.DataTimeFormat4))
 
+	// load tombstones of deleted series, and purge deleted data when doing compaction
+	f.tombstones, f.tombstoneErr = newTombstoneStoreFunc(family.Path())
+	if f.tombstoneErr != nil {
+		f.logger.Error("load tombstones of data family failure",
+			logger.String("family", f.indicator), logger.Error(f.tombstoneErr))
+	} else {
+		family.SetMergeContext(kv.TombstoneContext, f)
+	}
+
 	// add data family into global family manager
 	GetFamilyManager().AddFamily(f)
 	f.statistics.ActiveFamilies.Incr()
@@ -253,6 +272,11 @@ func (f *dataFamily) IsFlushing() bool {
 	return f.isFlushing.Load()
 }
 
+// WaitFlushCompleted waits flush memory database job completed.
+func (f *dataFamily) WaitFlushCompleted() {
+	f.flushCondition.Wait()
+}
+
 // Flush flushes memory database.
 func (f *dataFamily) Flush() error {
 	if f.isFlushing.CompareAndSwap(false, true) {
@@ -274,6 +298,8 @@ func (f *dataFamily) Flush() error {
 			f.mutex.Unlock()
 			return nil
 		}
+		// tombstones added before switching, deleted data flushed into files after flushing this memory database
+		unsealed := f.unsealedTombstones()
 		waitingFlushMemDB := f.mutableMemDB
 		f.immutableMemDB = waitingFlushMemDB
 		f.mutableMemDB = nil
@@ -301,6 +327,9 @@ func (f *dataFamily) Flush() error {
 
 		f.mutex.Unlock()
 
+		f.sealTombstones(unsealed)
+		f.pruneTombstones()
+
 		endTime := time.Now()
 		f.lastFlushTime = endTime.UnixMilli()
 		f.logger.Info("flush memory database successfully",
@@ -321,6 +350,9 @@ func (f *dataFamily) Compact() {
 	if f.mutableMemDB != nil || f.immutableMemDB != nil {
 		return
 	}
+	// no memory database, deleted data all in files
+	f.sealTombstones(f.unsealedTombstones())
+	f.pruneTombstones()
 
 	diff := fasttime.UnixMilliseconds() - f.lastFlushTime - 2*commontimeutil.OneHour
 	if diff >= 0 {
@@ -391,6 +423,9 @@ func (f *dataFamily) MemDBSize() int64 {
 // Filter filters the data based on metric/version/seriesIDs,
 // if it finds data then returns the FilterResultSet, else returns nil
 func (f *dataFamily) Filter(executeCtx *flow.ShardExecuteContext) (resultSet []flow.FilterResultSet, err error) {
+	if f.tombstoneErr != nil {
+		return nil, f.tombstoneErr
+	}
 	f.lastReadTime.Store(fasttime.UnixMilliseconds())
 	memRS, err := f.memoryFilter(executeCtx)
 	if err != nil {
@@ -402,7 +437,140 @@ func (f *dataFamily) Filter(executeCtx *flow.ShardExecuteContext) (resultSet []f
 	}
 	resultSet = append(resultSet, memRS...)
 	resultSet = append(resultSet, fileRS...)
-	return
+	return f.maskDeletedSeries(executeCtx.StorageExecuteCtx.MetricID, resultSet), nil
+}
+
+// maskDeletedSeries wraps the filter result set if metric has deleted series under data family,
+// then skips the data of deleted series when loading data.
+func (f *dataFamily) maskDeletedSeries(metricID metric.ID, resultSet []flow.FilterResultSet) []flow.FilterResultSet {
+	if f.tombstones == nil || len(resultSet) == 0 {
+		return resultSet
+	}
+	tombstones := f.tombstones.Get(metricID, f.timeRange)
+	if len(tombstones) == 0 {
+		return resultSet
+	}
+	slotTombstones := make([]tombstone.SlotTombstone, len(tombstones))
+	for idx, t := range tombstones {
+		slotTombstones[idx] = tombstone.SlotTombstone{
+			SeriesIDs: t.SeriesIDs,
+			SlotRange: f.interval.CalcSlotRange(f.familyTime, t.TimeRange),
+		}
+	}
+	for idx := range resultSet {
+		resultSet[idx] = tombstone.NewFilterResultSet(resultSet[idx], slotTombstones)
+	}
+	return resultSet
+}
+
+// DeleteSeries marks the data of series deleted in time range,
+// deleted data is skipped when query and purged when doing compaction.
+func (f *dataFamily) DeleteSeries(metricID metric.ID, seriesIDs *roaring.Bitmap, timeRange timeutil.TimeRange) error {
+	if f.tombstoneErr != nil {
+		return f.tombstoneErr
+	}
+	if !timeRange.Overlap(f.timeRange) {
+		return nil
+	}
+	return f.tombstones.Add(&tombstone.Tombstone{
+		MetricID:  metricID,
+		SeriesIDs: seriesIDs,
+		TimeRange: timeRange.Intersect(f.timeRange),
+	})
+}
+
+// GetPurgeSeriesIDs returns the series ids which data need to be purged when doing compaction,
+// only purges the series which are deleted in the whole time range of data family.
+func (f *dataFamily) GetPurgeSeriesIDs(metricID uint32) *roaring.Bitmap {
+	if f.tombstones == nil {
+		return nil
+	}
+	return f.tombstones.GetDeletedSeriesIDs(metric.ID(metricID), f.timeRange)
+}
+
+// GetPurgeRanges returns the deleted series of metric in slot range of data family,
+// data of series in those slots are purged when doing compaction.
+func (f *dataFamily) GetPurgeRanges(metricID uint32) []metricsdata.PurgeRange {
+	if f.tombstones == nil {
+		return nil
+	}
+	tombstones := f.tombstones.Get(metric.ID(metricID), f.timeRange)
+	if len(tombstones) == 0 {
+		return nil
+	}
+	ranges := make([]metricsdata.PurgeRange, len(tombstones))
+	for idx, t := range tombstones {
+		ranges[idx] = metricsdata.PurgeRange{
+			SeriesIDs: t.SeriesIDs,
+			SlotRange: f.interval.CalcSlotRange(f.familyTime, t.TimeRange),
+		}
+	}
+	return ranges
+}
+
+// unsealedTombstones returns the tombstones whose deleted data maybe in memory database.
+func (f *dataFamily) unsealedTombstones() []*tombstone.Tombstone {
+	if f.tombstones == nil {
+		return nil
+	}
+	return f.tombstones.Unsealed()
+}
+
+// sealTombstones seals the tombstones after memory database which maybe contains deleted data flushed,
+// deleted data only exists in files whose number less than the sealed file number.
+func (f *dataFamily) sealTombstones(tombstones []*tombstone.Tombstone) {
+	if len(tombstones) == 0 {
+		return
+	}
+	if err := f.tombstones.Seal(tombstones, f.family.NextFileNumber()); err != nil {
+		f.logger.Warn("seal tombstones failure", logger.String("family", f.indicator), logger.Error(err))
+	}
+}
+
+// pruneTombstones removes the sealed tombstones whose deleted data are purged by compaction,
+// which means no live file(file number less than sealed file number) may contain the data of metric.
+// NOTE: tombstones of rollup family are kept until family expired, because data of source family
+// maybe rollup into it later.
+func (f *dataFamily) pruneTombstones() {
+	if f.tombstones == nil {
+		return
+	}
+	var (
+		loaded   bool
+		isRollup bool
+		files    []*version.FileMeta
+	)
+	err := f.tombstones.Prune(func(t *tombstone.Tombstone) bool {
+		if !loaded {
+			// loads live files only if it has sealed tombstones
+			loaded = true
+			isRollup = f.isRollupFamily()
+			if !isRollup {
+				snapshot := f.family.GetSnapshot()
+				files = snapshot.GetCurrent().GetAllFiles()
+				snapshot.Close()
+			}
+		}
+		if isRollup {
+			return false
+		}
+		key := uint32(t.MetricID)
+		for _, file := range files {
+			if file.GetFileNumber() < t.FileNumber && key >= file.GetMinKey() && key <= file.GetMaxKey() {
+				return false
+			}
+		}
+		return true
+	})
+	if err != nil {
+		f.logger.Warn("prune tombstones failure", logger.String("family", f.indicator), logger.Error(err))
+	}
+}
+
+// isRollupFamily checks if data family stores the rollup data of source interval.
+func (f *dataFamily) isRollupFamily() bool {
+	intervals := f.shard.Database().GetOption().Intervals
+	return len(intervals) > 0 && intervals[0].Interval != f.interval
 }
 
 // GetState returns the current state include memory database state.
@@ -601,6 +769,8 @@ func (f *dataFamily) GetOrCreateMemoryDatabase(familyTime int64) (memdb.MemoryDa
 			Name:          f.shard.Database().Name(),
 			IndexDatabase: f.shard.MemIndexDB(),
 			BufferMgr:     f.shard.BufferManager(),
+			// native histogram is opt-in, keeps legacy histogram buckets compatible
+			NativeHistogram: f.shard.Database().GetOption().NativeHistogram,
 		})
 		if err != nil {
 			return nil, err
@@ -621,6 +791,7 @@ func (f *dataFamily) Close() error {
 
 	f.flushCondition.Wait()
 
+	unsealed := f.unsealedTombstones()
 	if f.immutableMemDB != nil {
 		if err := f.flushMemoryDatabase(f.immutableSeq, f.immutableMemDB); err != nil {
 			return err
@@ -635,6 +806,7 @@ func (f *dataFamily) Close() error {
 			return err
 		}
 	}
+	f.sealTombstones(unsealed)
 
 	GetFamilyManager().RemoveFamily(f)
 	f.statistics.ActiveFamilies.Decr()
@@ -27,6 +27,7 @@ import (
 	"github.com/lindb/common/pkg/ltoml"
 	commontimeutil "github.com/lindb/common/pkg/timeutil"
 	protoMetricsV1 "github.com/lindb/common/proto/gen/v1/linmetrics"
+	"github.com/lindb/roaring"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/atomic"
 	"go.uber.org/mock/gomock"
@@ -45,6 +46,7 @@ import (
 	stmtpkg "github.com/lindb/lindb/sql/stmt"
 	"github.com/lindb/lindb/tsdb/memdb"
 	"github.com/lindb/lindb/tsdb/tblstore/metricsdata"
+	"github.com/lindb/lindb/tsdb/tombstone"
 )
 
 func TestDataFamily_BaseTime(t *testing.T) {
@@ -67,6 +69,8 @@ func TestDataFamily_BaseTime(t *testing.T) {
 	shard := NewMockShard(ctrl)
 	shard.EXPECT().Database().Return(database)
 	shard.EXPECT().ShardID().Return(models.ShardID(1))
+	family.EXPECT().Path().Return(t.TempDir())
+	family.EXPECT().SetMergeContext(kv.TombstoneContext, gomock.Any())
 	dataFamily := newDataFamily(shard, nil, timeutil.Interval(commontimeutil.OneSecond*10), timeRange, 10, family)
 	assert.Equal(t, timeRange, dataFamily.TimeRange())
 	assert.Equal(t, timeutil.Interval(10000), dataFamily.Interval())
@@ -78,6 +82,140 @@ func TestDataFamily_BaseTime(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestDataFamily_LoadTombstones(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newTombstoneStoreFunc = tombstone.NewStore
+		ctrl.Finish()
+	}()
+
+	family := kv.NewMockFamily(ctrl)
+	database := NewMockDatabase(ctrl)
+	database.EXPECT().Name().Return("test").AnyTimes()
+	snapshot := version.NewMockSnapshot(ctrl)
+	v := version.NewMockVersion(ctrl)
+	v.EXPECT().GetSequences().Return(nil)
+	snapshot.EXPECT().GetCurrent().Return(v)
+	snapshot.EXPECT().Close()
+	family.EXPECT().GetSnapshot().Return(snapshot)
+	family.EXPECT().Path().Return(t.TempDir())
+	shard := NewMockShard(ctrl)
+	shard.EXPECT().Database().Return(database)
+	shard.EXPECT().ShardID().Return(models.ShardID(1))
+	newTombstoneStoreFunc = func(_ string) (tombstone.Store, error) {
+		return nil, fmt.Errorf("err")
+	}
+	f := newDataFamily(shard, nil, timeutil.Interval(commontimeutil.OneSecond*10),
+		timeutil.TimeRange{Start: 10, End: 50}, 10, family)
+	rs, err := f.Filter(&flow.ShardExecuteContext{})
+	assert.Error(t, err)
+	assert.Empty(t, rs)
+	assert.Error(t, f.DeleteSeries(1, roaring.BitmapOf(1), timeutil.TimeRange{Start: 10, End: 50}))
+	assert.Nil(t, f.(*dataFamily).GetPurgeSeriesIDs(1))
+	assert.NoError(t, f.Close())
+}
+
+func TestDataFamily_DeleteSeries(t *testing.T) {
+	store, err := tombstone.NewStore(t.TempDir())
+	assert.NoError(t, err)
+	f := &dataFamily{
+		timeRange:  timeutil.TimeRange{Start: 1000, End: 2000},
+		tombstones: store,
+	}
+	// not overlap
+	assert.NoError(t, f.DeleteSeries(1, roaring.BitmapOf(1), timeutil.TimeRange{Start: 10, End: 50}))
+	assert.Nil(t, f.GetPurgeSeriesIDs(1))
+	// delete partial data
+	assert.NoError(t, f.DeleteSeries(1, roaring.BitmapOf(1), timeutil.TimeRange{Start: 1500, End: 3000}))
+	assert.Nil(t, f.GetPurgeSeriesIDs(1))
+	// delete all data
+	assert.NoError(t, f.DeleteSeries(1, roaring.BitmapOf(2), timeutil.TimeRange{Start: 500, End: 3000}))
+	assert.Equal(t, []uint32{2}, f.GetPurgeSeriesIDs(1).ToArray())
+	assert.Equal(t, []*tombstone.Tombstone{
+		{MetricID: 1, SeriesIDs: roaring.BitmapOf(1), TimeRange: timeutil.TimeRange{Start: 1500, End: 2000}},
+		{MetricID: 1, SeriesIDs: roaring.BitmapOf(2), TimeRange: timeutil.TimeRange{Start: 1000, End: 2000}},
+	}, store.Get(1, f.timeRange))
+
+	// no tombstones
+	f.tombstones = nil
+	assert.Nil(t, f.GetPurgeSeriesIDs(1))
+}
+
+func TestDataFamily_PurgeRanges(t *testing.T) {
+	store, err := tombstone.NewStore(t.TempDir())
+	assert.NoError(t, err)
+	f := &dataFamily{
+		interval:   timeutil.Interval(commontimeutil.OneSecond * 10),
+		timeRange:  timeutil.TimeRange{Start: 0, End: commontimeutil.OneHour - 1},
+		tombstones: store,
+	}
+	assert.Nil(t, f.GetPurgeRanges(1))
+	assert.NoError(t, f.DeleteSeries(1, roaring.BitmapOf(1), timeutil.TimeRange{Start: 10000, End: 50000}))
+	assert.Equal(t, []metricsdata.PurgeRange{
+		{SeriesIDs: roaring.BitmapOf(1), SlotRange: timeutil.SlotRange{Start: 1, End: 5}},
+	}, f.GetPurgeRanges(1))
+	// no tombstones
+	f.tombstones = nil
+	assert.Nil(t, f.GetPurgeRanges(1))
+}
+
+func TestDataFamily_SealAndPruneTombstones(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	interval := timeutil.Interval(commontimeutil.OneSecond * 10)
+	store, err := tombstone.NewStore(t.TempDir())
+	assert.NoError(t, err)
+	family := kv.NewMockFamily(ctrl)
+	shard := NewMockShard(ctrl)
+	database := NewMockDatabase(ctrl)
+	shard.EXPECT().Database().Return(database).AnyTimes()
+	opt := &option.DatabaseOption{Intervals: option.Intervals{{Interval: interval}}}
+	database.EXPECT().GetOption().Return(opt).AnyTimes()
+	f := &dataFamily{
+		shard:      shard,
+		family:     family,
+		interval:   interval,
+		timeRange:  timeutil.TimeRange{Start: 0, End: commontimeutil.OneHour - 1},
+		tombstones: store,
+		logger:     logger.GetLogger("TSDB", "Test"),
+	}
+	// nothing to seal/prune
+	f.sealTombstones(f.unsealedTombstones())
+	f.pruneTombstones()
+
+	assert.NoError(t, f.DeleteSeries(1, roaring.BitmapOf(1), f.timeRange))
+	assert.NoError(t, f.DeleteSeries(10, roaring.BitmapOf(1), f.timeRange))
+	unsealed := f.unsealedTombstones()
+	assert.Len(t, unsealed, 2)
+	// unsealed tombstones cannot be pruned
+	f.pruneTombstones()
+	assert.Len(t, store.Get(1, f.timeRange), 1)
+
+	family.EXPECT().NextFileNumber().Return(table.FileNumber(5))
+	f.sealTombstones(unsealed)
+	assert.Empty(t, f.unsealedTombstones())
+
+	snapshot := version.NewMockSnapshot(ctrl)
+	v := version.NewMockVersion(ctrl)
+	family.EXPECT().GetSnapshot().Return(snapshot)
+	snapshot.EXPECT().GetCurrent().Return(v)
+	snapshot.EXPECT().Close()
+	v.EXPECT().GetAllFiles().Return([]*version.FileMeta{
+		version.NewFileMeta(3, 5, 20, 100), // old file contains metric 10
+		version.NewFileMeta(6, 1, 1, 100),  // new file after sealed
+	})
+	f.pruneTombstones()
+	assert.Empty(t, store.Get(1, f.timeRange))
+	assert.Len(t, store.Get(10, f.timeRange), 1)
+
+	// rollup family keeps tombstones
+	opt.Intervals = option.Intervals{{Interval: timeutil.Interval(commontimeutil.OneSecond)}, {Interval: interval}}
+	assert.True(t, f.isRollupFamily())
+	f.pruneTombstones()
+	assert.Len(t, store.Get(10, f.timeRange), 1)
+}
+
 func TestDataFamily_Filter(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -124,6 +262,37 @@ func TestDataFamily_Filter(t *testing.T) {
 			wantErr: false,
 			len:     1,
 		},
+		{
+			name: "mask deleted series",
+			prepare: func(f *dataFamily) {
+				f.interval = timeutil.Interval(commontimeutil.OneSecond * 10)
+				memDB := memdb.NewMockMemoryDatabase(ctrl)
+				f.immutableMemDB = memDB
+				memDB.EXPECT().Filter(gomock.Any()).Return([]flow.FilterResultSet{flow.NewMockFilterResultSet(ctrl)}, nil)
+				snapshot.EXPECT().FindReaders(gomock.Any()).Return(nil, nil)
+				store := tombstone.NewMockStore(ctrl)
+				f.tombstones = store
+				store.EXPECT().Get(metric.ID(1), gomock.Any()).Return([]*tombstone.Tombstone{
+					{MetricID: 1, SeriesIDs: roaring.BitmapOf(1), TimeRange: timeutil.TimeRange{Start: now, End: now + 60000}},
+				})
+			},
+			wantErr: false,
+			len:     1,
+		},
+		{
+			name: "no deleted series",
+			prepare: func(f *dataFamily) {
+				memDB := memdb.NewMockMemoryDatabase(ctrl)
+				f.immutableMemDB = memDB
+				memDB.EXPECT().Filter(gomock.Any()).Return([]flow.FilterResultSet{flow.NewMockFilterResultSet(ctrl)}, nil)
+				snapshot.EXPECT().FindReaders(gomock.Any()).Return(nil, nil)
+				store := tombstone.NewMockStore(ctrl)
+				f.tombstones = store
+				store.EXPECT().Get(metric.ID(1), gomock.Any()).Return(nil)
+			},
+			wantErr: false,
+			len:     1,
+		},
 		{
 			name: "get file reader failure",
 			prepare: func(_ *dataFamily) {
@@ -456,6 +625,7 @@ func TestDataFamily_Flush(t *testing.T) {
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Flush() error = %v, wantErr %v", err, tt.wantErr)
 			}
+			f.WaitFlushCompleted()
 		})
 	}
 }
@@ -556,6 +726,7 @@ func TestDataFamily_GetOrCreateMemoryDatabase(t *testing.T) {
 	shard.EXPECT().IndexDB().Return(indexDB).AnyTimes()
 	shard.EXPECT().Database().Return(db).AnyTimes()
 	db.EXPECT().Name().Return("db").AnyTimes()
+	db.EXPECT().GetOption().Return(&option.DatabaseOption{NativeHistogram: true}).AnyTimes()
 	metaDB := index.NewMockMetricMetaDatabase(ctrl)
 	db.EXPECT().MetaDB().Return(metaDB).AnyTimes()
 	shard.EXPECT().BufferManager().Return(memdb.NewMockBufferManager(ctrl)).AnyTimes()
@@ -573,6 +744,7 @@ func TestDataFamily_GetOrCreateMemoryDatabase(t *testing.T) {
 	assert.Nil(t, memDB)
 	memDB2 := memdb.NewMockMemoryDatabase(ctrl)
 	newMemoryDBFunc = func(cfg *memdb.MemoryDatabaseCfg) (memdb.MemoryDatabase, error) {
+		assert.True(t, cfg.NativeHistogram)
 		return memDB2, nil
 	}
 
@@ -625,6 +797,7 @@ func TestDataFamily_WriteRows(t *testing.T) {
 	metaDB := index.NewMockMetricMetaDatabase(ctrl)
 	db.EXPECT().MetaDB().Return(metaDB).AnyTimes()
 	db.EXPECT().Name().Return("db").AnyTimes()
+	db.EXPECT().GetOption().Return(&option.DatabaseOption{}).AnyTimes()
 	shard.EXPECT().BufferManager().Return(memdb.NewMockBufferManager(ctrl)).AnyTimes()
 	shard.EXPECT().MemIndexDB().Return(nil).AnyTimes()
 
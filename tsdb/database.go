@@ -66,6 +66,8 @@ type Database interface {
 	WaitFlushMetaCompleted()
 	// Flush flushes memory data of all families to disk
 	Flush() error
+	// FlushData flushes memory data of all families and index to disk synchronously.
+	FlushData() error
 	// Drop drops current database include all data.
 	Drop() error
 	// TTL expires the data of each shard base on time to live.
@@ -387,6 +389,28 @@ func (db *database) Flush() error {
 	return nil
 }
 
+// FlushData flushes memory data of all families and index to disk synchronously,
+// waits running flush job completed, make sure all data written before is persisted.
+func (db *database) FlushData() error {
+	for _, shardEntry := range db.shardSet.Entries() {
+		shard := shardEntry.shard
+		for _, family := range GetFamilyManager().GetFamiliesByShard(shard) {
+			family.WaitFlushCompleted()
+			if err := family.Flush(); err != nil {
+				return err
+			}
+			// flush job maybe started by flush checker after waiting
+			family.WaitFlushCompleted()
+		}
+		shard.WaitFlushIndexCompleted()
+		if err := shard.FlushIndex(); err != nil {
+			return err
+		}
+		shard.WaitFlushIndexCompleted()
+	}
+	return nil
+}
+
 func (db *database) flushMeta() error {
 	ch := make(chan error, 1)
 	db.memMetaDB.Notify(&memdb.FlushEvent{
@@ -406,6 +430,9 @@ func (db *database) Drop() error {
 	if err := db.Close(); err != nil {
 		return err
 	}
+	if err := removeRemoteFilesFunc(db.dir); err != nil {
+		return err
+	}
 	if err := removeDir(db.dir); err != nil {
 		return err
 	}
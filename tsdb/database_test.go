@@ -414,6 +414,44 @@ func TestDatabase_Flush(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestDatabase_FlushData(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := &database{
+		shardSet: *newShardSet(),
+	}
+	shard := NewMockShard(ctrl)
+	shard.EXPECT().Indicator().Return("db/flush_data/1").AnyTimes()
+	db.shardSet.InsertShard(1, shard)
+	family := NewMockDataFamily(ctrl)
+	family.EXPECT().Indicator().Return("db/flush_data/1/family").AnyTimes()
+	family.EXPECT().Shard().Return(shard).AnyTimes()
+	GetFamilyManager().AddFamily(family)
+	defer GetFamilyManager().RemoveFamily(family)
+
+	// case 1: flush family failure
+	family.EXPECT().WaitFlushCompleted()
+	family.EXPECT().Flush().Return(fmt.Errorf("err"))
+	assert.Error(t, db.FlushData())
+	// case 2: flush index failure
+	family.EXPECT().WaitFlushCompleted().Times(2)
+	family.EXPECT().Flush().Return(nil)
+	shard.EXPECT().WaitFlushIndexCompleted()
+	shard.EXPECT().FlushIndex().Return(fmt.Errorf("err"))
+	assert.Error(t, db.FlushData())
+	// case 3: flush successfully
+	gomock.InOrder(
+		family.EXPECT().WaitFlushCompleted(),
+		family.EXPECT().Flush().Return(nil),
+		family.EXPECT().WaitFlushCompleted(),
+		shard.EXPECT().WaitFlushIndexCompleted(),
+		shard.EXPECT().FlushIndex().Return(nil),
+		shard.EXPECT().WaitFlushIndexCompleted(),
+	)
+	assert.NoError(t, db.FlushData())
+}
+
 func Test_ShardSet_multi(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -476,6 +514,7 @@ func TestDatabase_Drop(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer func() {
 		removeDir = fileutil.RemoveDir
+		removeRemoteFilesFunc = kv.RemoveRemoteFiles
 		ctrl.Finish()
 	}()
 	metaDB := memdb.NewMockMetadataDatabase(ctrl)
@@ -500,13 +539,19 @@ func TestDatabase_Drop(t *testing.T) {
 	metaDB.EXPECT().Notify(gomock.Any()).DoAndReturn(func(event any) {
 		mn := event.(*memdb.FlushEvent)
 		mn.Callback(nil)
-	}).MaxTimes(2)
-	mDB.EXPECT().Close().Return(nil).MaxTimes(2)
-	metaDB.EXPECT().Close().MaxTimes(2)
+	}).MaxTimes(3)
+	mDB.EXPECT().Close().Return(nil).MaxTimes(3)
+	metaDB.EXPECT().Close().MaxTimes(3)
 	assert.Error(t, db.Drop())
 	removeDir = func(path string) error {
 		return nil
 	}
+	// remove remote files failure
+	removeRemoteFilesFunc = func(_ string) error {
+		return fmt.Errorf("err")
+	}
+	assert.Error(t, db.Drop())
+	removeRemoteFilesFunc = kv.RemoveRemoteFiles
 	assert.NoError(t, db.Drop())
 }
 
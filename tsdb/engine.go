@@ -64,6 +64,10 @@ type Engine interface {
 	TTL()
 	// EvictSegment evicts segment which long term no read operation.
 	EvictSegment()
+	// BackupDatabase backups the data of database into backup path.
+	BackupDatabase(databaseName, backupPath string) (*models.BackupManifest, error)
+	// RestoreDatabase rebuilds the database from backup path, then loads it.
+	RestoreDatabase(databaseName, backupPath string) (Database, error)
 	// Close closes the cached time series databases
 	Close()
 }
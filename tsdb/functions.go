@@ -18,12 +18,19 @@
 package tsdb
 
 import (
+	"os"
+	"path/filepath"
+
 	"github.com/lindb/common/pkg/fileutil"
 	"github.com/lindb/common/pkg/ltoml"
 
 	"github.com/lindb/lindb/index"
+	"github.com/lindb/lindb/kv"
+	"github.com/lindb/lindb/kv/table"
+	lindbfileutil "github.com/lindb/lindb/pkg/fileutil"
 	"github.com/lindb/lindb/tsdb/memdb"
 	"github.com/lindb/lindb/tsdb/tblstore/metricsdata"
+	"github.com/lindb/lindb/tsdb/tombstone"
 )
 
 // for testing
@@ -45,6 +52,14 @@ var (
 	newMetricDataFlusher   = metricsdata.NewFlusher
 	closeFamilyFunc        = closeFamily
 	writeConfigFn          = ltoml.WriteConfig
+	newTombstoneStoreFunc  = tombstone.NewStore
+	walkDirFunc            = filepath.WalkDir
+	readFileFunc           = os.ReadFile
+	writeFileFunc          = os.WriteFile
+	copyFileFunc           = lindbfileutil.CopyFile
+	linkFileFunc           = lindbfileutil.LinkOrCopyFile
+	getRemoteTierFunc      = table.GetRemoteTier
+	removeRemoteFilesFunc  = kv.RemoveRemoteFiles
 
 	newMetaDBFunc  = index.NewMetricMetaDatabase
 	newIndexDBFunc = index.NewMetricIndexDatabase
@@ -167,14 +167,35 @@ func (s *intervalSegment) TTL() error {
 	now := commontimeutil.Now()
 	expireInterval := s.interval.Retention.Int64()
 
+	localRetention := s.interval.LocalRetention.Int64()
+	tiering := localRetention > 0 && getRemoteTierFunc() != nil
+
 	return s.walkSegment(func(segmentName string, segmentTime int64) {
 		// add 2 hours buffer, for some cases stop write.
 		if now-segmentTime > expireInterval+2*commontimeutil.OneHour {
 			s.dropSegment(segmentName)
+			return
+		}
+		if tiering && now-segmentTime > localRetention {
+			s.offloadSegment(segmentName, now-localRetention)
 		}
 	})
 }
 
+// offloadSegment offloads the data families of segment which end before given timestamp into remote tier.
+func (s *intervalSegment) offloadSegment(segmentName string, timestamp int64) {
+	segment, err := s.getOrLoadSegment(segmentName)
+	if err != nil {
+		s.logger.Warn("get or load segment failure when offload segment",
+			logger.String("path", s.dir), logger.String("segment", segmentName), logger.Error(err))
+		return
+	}
+	if err := segment.Offload(timestamp); err != nil {
+		s.logger.Warn("offload segment failure",
+			logger.String("path", s.dir), logger.String("segment", segmentName), logger.Error(err))
+	}
+}
+
 // EvictSegment evicts segment which long term no read operation.
 func (s *intervalSegment) EvictSegment() {
 	s.mutex.Lock()
@@ -223,7 +244,14 @@ func (s *intervalSegment) dropSegment(segmentName string) {
 	}
 	delete(s.segments, segmentName)
 
-	if err := removeDir(path.Join(s.dir, segmentName)); err != nil {
+	segmentPath := path.Join(s.dir, segmentName)
+	// remove the data offloaded into remote tier, remote tier keeps data until retention too.
+	if err := removeRemoteFilesFunc(segmentPath); err != nil {
+		s.logger.Warn("remove remote files of segment failure",
+			logger.String("path", s.dir), logger.String("segment", segmentName),
+			logger.Error(err))
+	}
+	if err := removeDir(segmentPath); err != nil {
 		s.logger.Warn("remove segment dir failure",
 			logger.String("path", s.dir), logger.String("segment", segmentName),
 			logger.Error(err))
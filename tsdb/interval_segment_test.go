@@ -27,6 +27,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
+	"github.com/lindb/lindb/kv"
+	"github.com/lindb/lindb/kv/table"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/option"
 	"github.com/lindb/lindb/pkg/timeutil"
@@ -357,6 +359,22 @@ func TestIntervalSegment_TTL(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "remove remote files failure",
+			prepare: func() {
+				listDir = func(path string) ([]string, error) {
+					return []string{segmentDir}, nil
+				}
+				segment.EXPECT().Close()
+				removeRemoteFilesFunc = func(_ string) error {
+					return fmt.Errorf("err")
+				}
+				removeDir = func(path string) error {
+					return nil
+				}
+			},
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range cases {
@@ -364,6 +382,8 @@ func TestIntervalSegment_TTL(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			defer func() {
 				listDir = fileutil.ListDir
+				removeDir = fileutil.RemoveDir
+				removeRemoteFilesFunc = kv.RemoveRemoteFiles
 			}()
 			s := &intervalSegment{
 				interval: option.Interval{
@@ -386,6 +406,53 @@ func TestIntervalSegment_TTL(t *testing.T) {
 	}
 }
 
+func TestIntervalSegment_TTL_Offload(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		listDir = fileutil.ListDir
+		getRemoteTierFunc = table.GetRemoteTier
+		newSegmentFunc = newSegment
+		ctrl.Finish()
+	}()
+
+	now := commontimeutil.Now()
+	offloadDir := commontimeutil.FormatTimestamp(now-10*commontimeutil.OneDay, "20060102")
+	localDir := commontimeutil.FormatTimestamp(now, "20060102")
+	listDir = func(path string) ([]string, error) {
+		return []string{offloadDir, localDir}, nil
+	}
+	segment := NewMockSegment(ctrl)
+	s := &intervalSegment{
+		interval: option.Interval{
+			Interval:       timeutil.Interval(10 * commontimeutil.OneSecond),
+			Retention:      timeutil.Interval(30 * commontimeutil.OneDay),
+			LocalRetention: timeutil.Interval(7 * commontimeutil.OneDay),
+		},
+		segments: map[string]Segment{offloadDir: segment},
+		logger:   logger.GetLogger("TSDB", "segment"),
+	}
+	// tiered storage disabled
+	assert.NoError(t, s.TTL())
+
+	getRemoteTierFunc = func() *table.RemoteTier {
+		return &table.RemoteTier{}
+	}
+	segment.EXPECT().Offload(gomock.Any()).DoAndReturn(func(timestamp int64) error {
+		assert.True(t, timestamp >= now-7*commontimeutil.OneDay)
+		return nil
+	})
+	assert.NoError(t, s.TTL())
+	// offload failure
+	segment.EXPECT().Offload(gomock.Any()).Return(fmt.Errorf("err"))
+	assert.NoError(t, s.TTL())
+	// load segment failure
+	delete(s.segments, offloadDir)
+	newSegmentFunc = func(_ Shard, _ string, _ timeutil.Interval) (Segment, error) {
+		return nil, fmt.Errorf("err")
+	}
+	assert.NoError(t, s.TTL())
+}
+
 func TestIntervalSegment_EvictSegment(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
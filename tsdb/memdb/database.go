@@ -18,6 +18,7 @@
 package memdb
 
 import (
+	"fmt"
 	"io"
 	"math"
 	"sync"
@@ -32,7 +33,9 @@ import (
 	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series"
 	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/series/histogram"
 	"github.com/lindb/lindb/series/metric"
 	"github.com/lindb/lindb/tsdb/tblstore/metricsdata"
 )
@@ -104,6 +107,8 @@ type MemoryDatabaseCfg struct {
 	Name          string
 	Interval      timeutil.Interval
 	FamilyTime    int64
+	// NativeHistogram writes histogram buckets as one native histogram value instead of __bucket_ fields
+	NativeHistogram bool
 }
 
 // memoryDatabase implements MemoryDatabase.
@@ -115,6 +120,7 @@ type memoryDatabase struct {
 	// time series store: time series id(memory unique) => field write buffer(temp mmap)
 	fieldWriteStores   sync.Map // field index => (memory time series id => data point write buffer)
 	fieldCompressStore sync.Map // field index => (memory time series id => compact buffer)
+	histogramStores    sync.Map // field index => (memory time series id => native histogram values)
 	timeSeriesIDs      *roaring.Bitmap
 
 	statistics *metrics.MemDBStatistics
@@ -263,6 +269,15 @@ func (md *memoryDatabase) writeCompoundField(row *metric.StorageRow,
 		return err
 	}
 
+	if md.cfg.NativeHistogram {
+		// write histogram buckets as one native histogram value,
+		// assume that length of ExplicitBounds equals to Values,
+		// data must be valid before write
+		return md.writeHistogramField(
+			mStore, memSeriesID, row, slotIndex, compoundFieldItr.HistogramFieldName(),
+			compoundFieldItr.Histogram())
+	}
+
 	// write __bucket_${boundary}
 	// assume that length of ExplicitBounds equals to Values
 	// data must be valid before write
@@ -346,6 +361,34 @@ func (md *memoryDatabase) writeLinField(
 	return nil
 }
 
+func (md *memoryDatabase) getHistogramStore(fieldIndex uint8) HistogramStore {
+	store, ok := md.histogramStores.Load(fieldIndex)
+	if ok {
+		return store.(HistogramStore)
+	}
+	store, _ = md.histogramStores.LoadOrStore(fieldIndex, NewHistogramStore())
+	return store.(HistogramStore)
+}
+
+func (md *memoryDatabase) writeHistogramField(
+	mStore mStoreINTF,
+	memSeriesID uint32, row *metric.StorageRow, slotIndex uint16,
+	fName field.Name, value *histogram.Histogram,
+) error {
+	fm, isNew := mStore.GenField(fName, field.NativeHistogramField)
+	if isNew {
+		row.Fields = append(row.Fields, fm)
+	}
+	if fm.Type != field.NativeHistogramField {
+		return fmt.Errorf("%w, field: %s", series.ErrWrongFieldType, fName)
+	}
+	md.getHistogramStore(fm.Index).Write(memSeriesID, slotIndex, value)
+
+	// record write metric field statistics
+	row.WrittenFields++
+	return nil
+}
+
 // FlushFamilyTo flushes all data related to the family from metric-stores to builder.
 func (md *memoryDatabase) FlushFamilyTo(flusher metricsdata.Flusher) error {
 	// waiting current writing complete
@@ -380,6 +423,7 @@ func (md *memoryDatabase) FlushFamilyTo(flusher metricsdata.Flusher) error {
 		}
 		var needFlushFields field.Metas // current memory database's fields
 		var buffers []DataPointBuffer
+		var histogramStores []HistogramStore
 		allFields := mStore.GetFields()
 		for idx := range allFields {
 			f := allFields[idx]
@@ -387,10 +431,17 @@ func (md *memoryDatabase) FlushFamilyTo(flusher metricsdata.Flusher) error {
 				// ignore if field meta not persist
 				continue
 			}
+			if store, ok := md.histogramStores.Load(f.Index); ok {
+				buffers = append(buffers, nil)
+				histogramStores = append(histogramStores, store.(HistogramStore))
+				needFlushFields = append(needFlushFields, f)
+				continue
+			}
 			buf, ok := md.fieldWriteStores.Load(f.Index)
 			if ok {
 				buffer := buf.(DataPointBuffer)
 				buffers = append(buffers, buffer)
+				histogramStores = append(histogramStores, nil)
 				needFlushFields = append(needFlushFields, f)
 			}
 		}
@@ -402,6 +453,13 @@ func (md *memoryDatabase) FlushFamilyTo(flusher metricsdata.Flusher) error {
 		// flush time series of metric
 		if err := timeSeriesIndex.FlushMetricsDataTo(flusher, func(memSeriesID uint32) error {
 			for idx, buf := range buffers {
+				if histogramStores[idx] != nil {
+					// flush native histogram field data
+					if err := flushHistogramFieldTo(histogramStores[idx], memSeriesID, flusher); err != nil {
+						return err
+					}
+					continue
+				}
 				buf, ok := buf.GetPage(memSeriesID)
 				if ok {
 					// flush field data
@@ -463,6 +521,10 @@ func (md *memoryDatabase) MemSize() (memSize int64) {
 		memSize += (value.(CompressStore)).MemSize()
 		return true
 	})
+	md.histogramStores.Range(func(key, value any) bool {
+		memSize += (value.(HistogramStore)).MemSize()
+		return true
+	})
 	return memSize
 }
 
@@ -32,6 +32,7 @@ import (
 	protoMetricsV1 "github.com/lindb/common/proto/gen/v1/linmetrics"
 	"github.com/lindb/roaring"
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/atomic"
 	"go.uber.org/mock/gomock"
 
 	"github.com/lindb/lindb/flow"
@@ -41,6 +42,7 @@ import (
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/series/histogram"
 	"github.com/lindb/lindb/series/metric"
 	"github.com/lindb/lindb/sql/stmt"
 	"github.com/lindb/lindb/tsdb/tblstore/metricsdata"
@@ -244,6 +246,111 @@ func TestDatabase_Write(t *testing.T) {
 	assert.NoError(t, db.Close())
 }
 
+func TestDatabase_Write_AfterDropMetric(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	name := "./db_write_after_drop"
+	defer func() {
+		_ = os.RemoveAll(name)
+		ctrl.Finish()
+	}()
+	metricID := atomic.NewUint32(1)
+	metaDB := index.NewMockMetricMetaDatabase(ctrl)
+	metaDB.EXPECT().GenMetricID(gomock.Any(), gomock.Any()).DoAndReturn(func(_, _ []byte) (metric.ID, error) {
+		return metric.ID(metricID.Load()), nil
+	}).AnyTimes()
+	metaDB.EXPECT().GenFieldID(gomock.Any(), gomock.Any()).Return(field.ID(2), nil).AnyTimes()
+	indexDB := index.NewMockMetricIndexDatabase(ctrl)
+	indexDB.EXPECT().GenSeriesID(gomock.Any(), gomock.Any()).DoAndReturn(func(mID metric.ID, _ *metric.StorageRow) (uint32, error) {
+		return uint32(mID) * 100, nil
+	}).AnyTimes()
+	memMetaDB := NewMetadataDatabase(&models.DatabaseConfig{}, metaDB)
+	memIndexDB := NewIndexDatabase(memMetaDB, indexDB)
+	interval := timeutil.Interval(10_000)
+	now, _ := commontimeutil.ParseTimestamp("2023-01-01 22:23:00", commontimeutil.DataTimeFormat2)
+	cfg := &MemoryDatabaseCfg{
+		FamilyTime:    interval.Calculator().CalcFamilyTime(now),
+		BufferMgr:     NewBufferManager(path.Join(name, "buf")),
+		IndexDatabase: memIndexDB,
+		Interval:      interval,
+		IntervalCalc:  interval.Calculator(),
+	}
+	db, err := NewMemoryDatabase(cfg)
+	assert.NoError(t, err)
+	m := &protoMetricsV1.Metric{
+		Name:      "test1",
+		Namespace: "ns",
+		Timestamp: now,
+		Tags:      []*protoMetricsV1.KeyValue{{Key: "key1", Value: "value1"}},
+		SimpleFields: []*protoMetricsV1.SimpleField{
+			{Name: "f1", Type: protoMetricsV1.SimpleFieldType_DELTA_SUM, Value: 10},
+		},
+	}
+	assert.NoError(t, db.WriteRow(protoToStorageRow(m)))
+	// wait meta/index update
+	time.Sleep(500 * time.Millisecond)
+
+	// drop metric, then write same metric name again with new metric id
+	memMetricID, ok := memMetaDB.GetMemMetricID(1)
+	assert.True(t, ok)
+	memIndexDB.DropTimeSeriesIndex(memMetricID)
+	memMetaDB.DropMetricMeta(1)
+	metricID.Store(2)
+	assert.NoError(t, db.WriteRow(protoToStorageRow(m)))
+	time.Sleep(500 * time.Millisecond)
+	assert.Equal(t, []uint32{2}, memMetaDB.GetMetricIDs().ToArray())
+
+	ctx := &flow.ShardExecuteContext{
+		StorageExecuteCtx: &flow.StorageExecuteContext{
+			MetricID: 1,
+			Fields:   field.Metas{{Name: "f1", Type: field.SumField}},
+			Query: &stmt.Query{
+				TimeRange:       timeutil.TimeRange{Start: now - 200, End: now + 200},
+				StorageInterval: interval,
+			},
+		},
+		SeriesIDsAfterFiltering: roaring.BitmapOf(100, 200),
+	}
+	// dropped metric not found
+	rs, err := db.Filter(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, rs)
+	// new metric only contains series written after drop
+	ctx.StorageExecuteCtx.MetricID = 2
+	rs, err = db.Filter(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, rs, 1)
+	assert.Equal(t, []uint32{200}, rs[0].SeriesIDs().ToArray())
+	rs[0].Close()
+
+	kvStore, err := kv.GetStoreManager().CreateStore(path.Join(name, "data"), kv.StoreOption{Levels: 2})
+	assert.NoError(t, err)
+	family, err := kvStore.CreateFamily("10", kv.FamilyOption{
+		Merger: string(metricsdata.MetricDataMerger),
+	})
+	assert.NoError(t, err)
+	kvFlusher := family.NewFlusher()
+	defer kvFlusher.Release()
+	flusher, err := metricsdata.NewFlusher(kvFlusher)
+	assert.NoError(t, err)
+	assert.NoError(t, db.FlushFamilyTo(flusher))
+
+	snapshot := family.GetSnapshot()
+	defer snapshot.Close()
+	// unflushed data before drop not flushed
+	for mID, expect := range map[uint32]int{1: 0, 2: 1} {
+		c := 0
+		assert.NoError(t, snapshot.Load(mID, func(value []byte) error {
+			c++
+			r, err := metricsdata.NewReader("dd", value)
+			assert.NoError(t, err)
+			assert.Equal(t, []uint32{200}, r.GetSeriesIDs().ToArray())
+			return nil
+		}))
+		assert.Equal(t, expect, c)
+	}
+	assert.NoError(t, db.Close())
+}
+
 func TestMemoryDatabase_AcquireWrite(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -548,3 +655,104 @@ func protoToStorageRow(m *protoMetricsV1.Metric) *metric.StorageRow {
 	br.UnmarshalRows(buf.Bytes())
 	return br.Rows()[0]
 }
+
+func TestDatabase_Write_NativeHistogram(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	name := "./db_write_histogram"
+	defer func() {
+		_ = os.RemoveAll(name)
+		ctrl.Finish()
+	}()
+	metaDB := index.NewMockMetricMetaDatabase(ctrl)
+	metaDB.EXPECT().GenMetricID(gomock.Any(), gomock.Any()).Return(metric.ID(1), nil).AnyTimes()
+	metaDB.EXPECT().GenFieldID(gomock.Any(), gomock.Any()).Return(field.ID(2), nil).AnyTimes()
+	indexDB := index.NewMockMetricIndexDatabase(ctrl)
+	indexDB.EXPECT().GenSeriesID(gomock.Any(), gomock.Any()).Return(uint32(100), nil).AnyTimes()
+	memMetaDB := NewMetadataDatabase(&models.DatabaseConfig{}, metaDB)
+	memIndexDB := NewIndexDatabase(memMetaDB, indexDB)
+	interval := timeutil.Interval(10_000)
+	now, _ := commontimeutil.ParseTimestamp("2023-01-01 22:23:00", commontimeutil.DataTimeFormat2)
+	cfg := &MemoryDatabaseCfg{
+		FamilyTime:    interval.Calculator().CalcFamilyTime(now),
+		BufferMgr:     NewBufferManager(path.Join(name, "buf")),
+		IndexDatabase: memIndexDB,
+		Interval:      interval,
+		IntervalCalc:  interval.Calculator(),
+		// native histogram is opt-in
+		NativeHistogram: true,
+	}
+	db, err := NewMemoryDatabase(cfg)
+	assert.NoError(t, err)
+	m := &protoMetricsV1.Metric{
+		Name:      "test1",
+		Namespace: "ns",
+		Timestamp: now,
+		CompoundField: &protoMetricsV1.CompoundField{
+			Min:            1,
+			Max:            10,
+			Sum:            20,
+			Count:          4,
+			ExplicitBounds: []float64{1, 5, 10, math.Inf(1)},
+			Values:         []float64{1, 2, 1, 0},
+		},
+	}
+	// write same time slot twice, histogram values will be merged
+	assert.NoError(t, db.WriteRow(protoToStorageRow(m)))
+	assert.NoError(t, db.WriteRow(protoToStorageRow(m)))
+	assert.NotZero(t, db.MemSize())
+
+	// wait meta/index update
+	time.Sleep(500 * time.Millisecond)
+	ctx := &flow.ShardExecuteContext{
+		StorageExecuteCtx: &flow.StorageExecuteContext{
+			MetricID: 1,
+			Fields:   field.Metas{{Name: "Histogram", Type: field.NativeHistogramField}},
+			Query: &stmt.Query{
+				TimeRange:       timeutil.TimeRange{Start: now - 200, End: now + 200},
+				StorageInterval: interval,
+			},
+		},
+		SeriesIDsAfterFiltering: roaring.BitmapOf(100),
+	}
+	rs, err := db.Filter(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, rs, 1)
+	loader := rs[0].Load(&flow.DataLoadContext{
+		SeriesIDHighKey:       0,
+		LowSeriesIDsContainer: roaring.BitmapOf(100).GetContainer(0),
+	})
+	assert.NotNil(t, loader)
+	var values []*histogram.Histogram
+	loader.Load(&flow.DataLoadContext{
+		SeriesIDHighKey:       0,
+		LowSeriesIDsContainer: roaring.BitmapOf(100).GetContainer(0),
+		LowSeriesIDs:          []uint16{100},
+		MinSeriesID:           100,
+		MaxSeriesID:           100,
+		DownSampling: func(slotRange timeutil.SlotRange, _ uint16, _ int, getter encoding.TSDValueGetter) {
+			hGetter, ok := getter.(histogram.Getter)
+			assert.True(t, ok)
+			for slot := slotRange.Start; slot <= slotRange.End; slot++ {
+				if h, ok := hGetter.GetHistogram(slot); ok {
+					values = append(values, h)
+				}
+			}
+		},
+	})
+	assert.Len(t, values, 1)
+	assert.Equal(t, 8.0, values[0].Count)
+	assert.Equal(t, 40.0, values[0].Sum)
+
+	kvStore, err := kv.GetStoreManager().CreateStore(path.Join(name, "data"), kv.StoreOption{Levels: 2})
+	assert.NoError(t, err)
+	family, err := kvStore.CreateFamily("10", kv.FamilyOption{
+		Merger: string(metricsdata.MetricDataMerger),
+	})
+	assert.NoError(t, err)
+	kvFlusher := family.NewFlusher()
+	defer kvFlusher.Release()
+	flusher, err := metricsdata.NewFlusher(kvFlusher)
+	assert.NoError(t, err)
+	assert.NoError(t, db.FlushFamilyTo(flusher))
+	assert.NoError(t, db.Close())
+}
@@ -252,6 +252,16 @@ func flushFieldTo(md *memoryDatabase, memTimeSeries uint32, buf []byte,
 	return tableFlusher.FlushField(data)
 }
 
+// flushHistogramFieldTo flushes native histogram field data into kv store.
+func flushHistogramFieldTo(store HistogramStore, memTimeSeries uint32, tableFlusher metricsdata.Flusher) error {
+	data, err := store.Encode(memTimeSeries)
+	if err != nil {
+		memDBLogger.Error("flush histogram field store err, data lost", logger.Error(err))
+		return nil
+	}
+	return tableFlusher.FlushField(data)
+}
+
 // getOldFloatValue returns the value in compress buffer
 func getOldFloatValue(tsd *encoding.TSDDecoder, timeSlot uint16) (value float64, hasValue bool) {
 	if tsd == nil {
@@ -0,0 +1,168 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package memdb
+
+import (
+	"sort"
+	"sync"
+	"unsafe"
+
+	"go.uber.org/atomic"
+
+	"github.com/lindb/lindb/series/histogram"
+)
+
+//go:generate mockgen -source ./histogram_store.go -destination=./histogram_store_mock.go -package memdb
+
+var (
+	histogramSize = int64(unsafe.Sizeof(histogram.Histogram{}))
+	bucketSize    = int64(unsafe.Sizeof(histogram.Bucket{}))
+)
+
+// HistogramStore represents memory store of native histogram field,
+// stores one histogram value per time slot for each time series.
+type HistogramStore interface {
+	// Write merges histogram value into the time slot of time series.
+	Write(memSeriesID uint32, slot uint16, value *histogram.Histogram)
+	// GetGetter returns the value getter of time series, if not exist return false.
+	GetGetter(memSeriesID uint32) (*HistogramGetter, bool)
+	// Encode encodes histogram values of time series into histogram block.
+	Encode(memSeriesID uint32) ([]byte, error)
+	// MemSize returns histogram store memory approximate size.
+	MemSize() int64
+}
+
+// histogramStore implements HistogramStore interface.
+type histogramStore struct {
+	store sync.Map // memory series id => histogram values
+
+	memSize atomic.Int64
+}
+
+// NewHistogramStore creates HistogramStore instance.
+func NewHistogramStore() HistogramStore {
+	return &histogramStore{}
+}
+
+// Write merges histogram value into the time slot of time series.
+func (s *histogramStore) Write(memSeriesID uint32, slot uint16, value *histogram.Histogram) {
+	values, ok := s.store.Load(memSeriesID)
+	if !ok {
+		values, _ = s.store.LoadOrStore(memSeriesID, &histogramValues{})
+	}
+	diff := values.(*histogramValues).write(slot, value)
+	s.memSize.Add(diff)
+}
+
+// GetGetter returns the value getter of time series, if not exist return false.
+func (s *histogramStore) GetGetter(memSeriesID uint32) (*HistogramGetter, bool) {
+	values, ok := s.store.Load(memSeriesID)
+	if !ok {
+		return nil, false
+	}
+	return &HistogramGetter{values: values.(*histogramValues)}, true
+}
+
+// Encode encodes histogram values of time series into histogram block.
+func (s *histogramStore) Encode(memSeriesID uint32) ([]byte, error) {
+	values, ok := s.store.Load(memSeriesID)
+	if !ok {
+		return nil, nil
+	}
+	return values.(*histogramValues).encode()
+}
+
+// MemSize returns histogram store memory approximate size.
+func (s *histogramStore) MemSize() int64 {
+	return int64(unsafe.Sizeof(s)) + s.memSize.Load()
+}
+
+// histogramValues represents histogram values of time series in order by time slot.
+type histogramValues struct {
+	slots  []uint16
+	values []*histogram.Histogram
+
+	lock sync.RWMutex
+}
+
+// write merges histogram value into time slot, returns the memory size changed.
+func (v *histogramValues) write(slot uint16, value *histogram.Histogram) int64 {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	idx := sort.Search(len(v.slots), func(i int) bool { return v.slots[i] >= slot })
+	if idx < len(v.slots) && v.slots[idx] == slot {
+		// same time slot, merge histogram
+		old := v.values[idx]
+		numOfBuckets := len(old.Buckets) + len(old.CustomBounds)
+		old.Merge(value)
+		return int64(len(old.Buckets)+len(old.CustomBounds)-numOfBuckets) * bucketSize
+	}
+	h := value.Clone()
+	v.slots = append(v.slots, 0)
+	v.values = append(v.values, nil)
+	copy(v.slots[idx+1:], v.slots[idx:])
+	copy(v.values[idx+1:], v.values[idx:])
+	v.slots[idx] = slot
+	v.values[idx] = h
+	return 2 + histogramSize + int64(len(h.Buckets)+len(h.CustomBounds))*bucketSize
+}
+
+// get returns the copy of histogram value by time slot.
+func (v *histogramValues) get(slot uint16) (*histogram.Histogram, bool) {
+	v.lock.RLock()
+	defer v.lock.RUnlock()
+
+	idx := sort.Search(len(v.slots), func(i int) bool { return v.slots[i] >= slot })
+	if idx < len(v.slots) && v.slots[idx] == slot {
+		return v.values[idx].Clone(), true
+	}
+	return nil, false
+}
+
+// encode encodes histogram values into histogram block.
+func (v *histogramValues) encode() ([]byte, error) {
+	v.lock.RLock()
+	defer v.lock.RUnlock()
+
+	encoder := histogram.NewBlockEncoder()
+	for idx, slot := range v.slots {
+		encoder.Append(slot, v.values[idx])
+	}
+	return encoder.Bytes()
+}
+
+// HistogramGetter implements encoding.TSDValueGetter and histogram.Getter interface,
+// reads histogram values of time series from memory store.
+type HistogramGetter struct {
+	values *histogramValues
+}
+
+// GetHistogram returns histogram value by time slot, if it hasn't, return false.
+func (g *HistogramGetter) GetHistogram(slot uint16) (*histogram.Histogram, bool) {
+	return g.values.get(slot)
+}
+
+// GetValue returns count of histogram by time slot, if it hasn't, return false.
+func (g *HistogramGetter) GetValue(slot uint16) (float64, bool) {
+	h, ok := g.values.get(slot)
+	if !ok {
+		return 0, false
+	}
+	return h.Count, true
+}
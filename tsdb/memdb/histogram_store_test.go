@@ -0,0 +1,64 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package memdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/series/histogram"
+)
+
+func TestHistogramStore(t *testing.T) {
+	store := NewHistogramStore()
+	getter, ok := store.GetGetter(1)
+	assert.False(t, ok)
+	assert.Nil(t, getter)
+	data, err := store.Encode(1)
+	assert.NoError(t, err)
+	assert.Nil(t, data)
+
+	h := &histogram.Histogram{Schema: 0, Count: 1, Sum: 2, Buckets: []histogram.Bucket{{Index: 1, Count: 1}}}
+	store.Write(1, 10, h)
+	store.Write(1, 5, h)
+	store.Write(1, 10, &histogram.Histogram{Schema: 0, Count: 2, Sum: 6, Buckets: []histogram.Bucket{{Index: 2, Count: 2}}})
+	assert.NotZero(t, store.MemSize())
+	// write value is copied
+	h.Count = 100
+
+	getter, ok = store.GetGetter(1)
+	assert.True(t, ok)
+	v, ok := getter.GetHistogram(10)
+	assert.True(t, ok)
+	assert.Equal(t, 3.0, v.Count)
+	assert.Equal(t, []histogram.Bucket{{Index: 1, Count: 1}, {Index: 2, Count: 2}}, v.Buckets)
+	count, ok := getter.GetValue(5)
+	assert.True(t, ok)
+	assert.Equal(t, 1.0, count)
+	_, ok = getter.GetValue(6)
+	assert.False(t, ok)
+
+	data, err = store.Encode(1)
+	assert.NoError(t, err)
+	decoder := histogram.NewBlockDecoder()
+	assert.NoError(t, decoder.Reset(data))
+	assert.Equal(t, 2, decoder.Len())
+	assert.Equal(t, uint16(5), decoder.SlotAt(0))
+	assert.Equal(t, uint16(10), decoder.SlotAt(1))
+}
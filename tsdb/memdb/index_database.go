@@ -42,6 +42,8 @@ type IndexDatabase interface {
 	GetMetadataDatabase() MetadataDatabase
 	// GetTimeSeriesIndex returns memory time series index by memory metric id.
 	GetTimeSeriesIndex(memMetricID uint64) (TimeSeriesIndex, bool)
+	// DropTimeSeriesIndex removes memory time series index of dropped metric by memory metric id.
+	DropTimeSeriesIndex(memMetricID uint64)
 	// Cleanup cleanups index data for inactive memory database.
 	Cleanup(db MemoryDatabase)
 	// Notify notifies update or flush metric index.
@@ -129,6 +131,15 @@ func (idb *indexDatabase) GetTimeSeriesIndex(memMetricID uint64) (TimeSeriesInde
 	return nil, false
 }
 
+// DropTimeSeriesIndex removes memory time series index of dropped metric by memory metric id,
+// data written before drop cannot be found/flushed because new series use new memory time series ids.
+func (idb *indexDatabase) DropTimeSeriesIndex(memMetricID uint64) {
+	idb.lock.Lock()
+	defer idb.lock.Unlock()
+
+	idb.timeSeriesIndexes.Delete(memMetricID)
+}
+
 // Cleanup cleanups index data for inactive memory database.
 func (idb *indexDatabase) Cleanup(db MemoryDatabase) {
 	familyCreateTime := db.CreatedTime()
@@ -156,7 +167,11 @@ func (idb *indexDatabase) Close() {
 
 func (idb *indexDatabase) indexTimeSeries(row *metric.StorageRow, seriesID uint32) {
 	nameHash := row.NameHash()
-	timeSeriesIndexObj, _ := idb.timeSeriesIndexes.Load(nameHash)
+	timeSeriesIndexObj, ok := idb.timeSeriesIndexes.Load(nameHash)
+	if !ok {
+		// time series index dropped
+		return
+	}
 	timeSeriesIndex := timeSeriesIndexObj.(TimeSeriesIndex)
 	idb.lock.Lock()
 	timeSeriesIndex.IndexTimeSeries(seriesID, row.MemSeriesID)
@@ -50,6 +50,9 @@ type MetadataDatabase interface {
 	GetMetricIDs() *roaring.Bitmap
 	// GetMemMetricID returns memory metric id by metric id.
 	GetMemMetricID(metricID uint32) (uint64, bool)
+	// DropMetricMeta removes the memory metric meta store of dropped metric,
+	// so that the metric with same name re-written after drop uses a new store.
+	DropMetricMeta(metricID uint32)
 	// Notify notifies update or flush metric metadata.
 	Notify(event any)
 	// Close closed metadata database.
@@ -149,6 +152,28 @@ func (mdb *metadataDatabase) GetMetricMeta(memMetricID uint64) (mStoreINTF, bool
 	return store.(mStoreINTF), true
 }
 
+// DropMetricMeta removes the memory metric meta store of dropped metric,
+// so that the metric with same name re-written after drop uses a new store.
+func (mdb *metadataDatabase) DropMetricMeta(metricID uint32) {
+	mdb.lock.Lock()
+	defer mdb.lock.Unlock()
+
+	memMetricID, ok := mdb.metricIndexStore.Get(metricID)
+	if !ok {
+		return
+	}
+	mdb.metricMetadatas.Delete(memMetricID)
+	// rebuild metric store index without dropped metric id
+	newIds := imap.NewIntMap[uint64]()
+	_ = mdb.metricIndexStore.WalkEntry(func(key uint32, value uint64) error {
+		if key != metricID {
+			newIds.Put(key, value)
+		}
+		return nil
+	})
+	mdb.metricIndexStore = newIds
+}
+
 // Close closed metadata database.
 func (mdb *metadataDatabase) Close() {
 	close(mdb.ch)
@@ -33,7 +33,8 @@ import (
 // fieldEntry represents field context for searching.
 type fieldEntry struct {
 	pageBuf     DataPointBuffer
-	compressBuf CompressStore // time series compress buffer
+	compressBuf CompressStore  // time series compress buffer
+	histograms  HistogramStore // native histogram values
 	field       field.Meta
 
 	buf []byte // time series current write buffer
@@ -69,6 +70,14 @@ func (fe *fieldEntry) getPage(memTimeSeriesID uint32) ([]byte, bool) {
 	return fe.pageBuf.GetPage(memTimeSeriesID)
 }
 
+// getHistogramGetter returns native histogram value getter by memory time series id.
+func (fe *fieldEntry) getHistogramGetter(memTimeSeriesID uint32) (*HistogramGetter, bool) {
+	if fe.histograms == nil {
+		return nil, false
+	}
+	return fe.histograms.GetGetter(memTimeSeriesID)
+}
+
 // Filter filters the data based on fields/seriesIDs/family time,
 // if it finds data then returns the FilterResultSet, else returns constants.ErrFieldNotFound
 func (md *memoryDatabase) filter(shardExecuteContext *flow.ShardExecuteContext,
@@ -92,6 +101,14 @@ func (md *memoryDatabase) filter(shardExecuteContext *flow.ShardExecuteContext,
 
 	var fieldEntries []*fieldEntry
 	for _, fm := range foundFields {
+		if hStore, ok := md.histogramStores.Load(fm.Index); ok {
+			queryField, _ := fields.GetFromName(fm.Name)
+			fieldEntries = append(fieldEntries, &fieldEntry{
+				histograms: hStore.(HistogramStore),
+				field:      queryField,
+			})
+			continue
+		}
 		fStore, ok := md.fieldWriteStores.Load(fm.Index)
 		fcStore, fcOK := md.fieldCompressStore.Load(fm.Index)
 		if ok || fcOK {
@@ -256,6 +256,11 @@ func (idx *timeSeriesIndex) Load(
 	ctx.IterateLowSeriesIDs(lowContainer, func(seriesIdxFromQuery uint16, seriesIdxFromStorage int) {
 		memTimeSeriesID := memTimeSeriesIDs[seriesIdxFromStorage]
 		for _, fm := range fields {
+			// read native histogram values
+			if getter, ok := fm.getHistogramGetter(memTimeSeriesID); ok {
+				ctx.DownSampling(slotRange, seriesIdxFromQuery, int(fm.field.Index), getter)
+				continue
+			}
 			// read field compress data
 			compress := fm.getCompressBuf(memTimeSeriesID)
 			var tsd *encoding.TSDDecoder
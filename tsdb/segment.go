@@ -46,6 +46,8 @@ type Segment interface {
 	NeedEvict() bool
 	// EvictFamily evicts data family.
 	EvictFamily(familyTime int64)
+	// Offload offloads the data families which end before given timestamp into remote tier.
+	Offload(timestamp int64) error
 	// Close closes segment, include kv store.
 	Close()
 }
@@ -73,7 +75,9 @@ func newSegment(shard Shard, segmentName string, interval timeutil.Interval) (Se
 	}
 
 	storeOption := kv.DefaultStoreOption()
-	intervals := shard.Database().GetOption().Intervals
+	databaseOption := shard.Database().GetOption()
+	storeOption.Compression = databaseOption.GetCompression(interval)
+	intervals := databaseOption.Intervals
 	if shard.CurrentInterval() == interval && len(intervals) > 1 {
 		// if interval == writeable interval and database set auto rollup intervals
 		sort.Sort(intervals) // need sort interval
@@ -149,6 +153,30 @@ func (s *segment) EvictFamily(familyTime int64) {
 	delete(s.families, family)
 }
 
+// Offload offloads the data families which end before given timestamp into remote tier.
+func (s *segment) Offload(timestamp int64) error {
+	calc := s.interval.Calculator()
+	for _, familyName := range s.kvStore.ListFamilyNames() {
+		familyTime, err := strconv.Atoi(familyName)
+		if err != nil {
+			continue
+		}
+		familyStartTime := calc.CalcFamilyStartTime(s.baseTime, familyTime)
+		if calc.CalcFamilyEndTime(familyStartTime) >= timestamp {
+			// data family maybe still writable
+			continue
+		}
+		family := s.kvStore.GetFamily(familyName)
+		if family == nil {
+			continue
+		}
+		if err := family.Offload(); err != nil {
+			return fmt.Errorf("offload data family[%s] of segment[%s] error:%s", familyName, s.indicator, err)
+		}
+	}
+	return nil
+}
+
 // GetOrCreateDataFamily returns the data family based on timestamp.
 func (s *segment) GetOrCreateDataFamily(timestamp int64) (DataFamily, error) {
 	calc := s.interval.Calculator()
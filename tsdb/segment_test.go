@@ -28,6 +28,7 @@ import (
 
 	"github.com/lindb/lindb/kv"
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/compress"
 	"github.com/lindb/lindb/pkg/option"
 	"github.com/lindb/lindb/pkg/timeutil"
 )
@@ -78,6 +79,23 @@ func TestSegment_New(t *testing.T) {
 				storeMgr.EXPECT().CreateStore(gomock.Any(), gomock.Any()).Return(store, nil)
 			},
 		},
+		{
+			name:        "create segment with compression of interval",
+			segmentName: segmentName,
+			prepare: func() {
+				database.EXPECT().GetOption().Return(&option.DatabaseOption{
+					Intervals: option.Intervals{
+						{Interval: interval, Compression: &compress.BlockOption{Type: "lz4"}},
+					},
+					Compression: compress.BlockOption{Type: "zstd"},
+				})
+				storeMgr.EXPECT().CreateStore(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ string, storeOption kv.StoreOption) (kv.Store, error) {
+						assert.Equal(t, compress.BlockOption{Type: "lz4"}, storeOption.Compression)
+						return store, nil
+					})
+			},
+		},
 		{
 			name:        "parse segment name err",
 			segmentName: "xx",
@@ -285,6 +303,31 @@ func TestSegment_GetDataFamilies(t *testing.T) {
 	}
 }
 
+func TestSegment_Offload(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := kv.NewMockStore(ctrl)
+	family := kv.NewMockFamily(ctrl)
+	baseTime, _ := commontimeutil.ParseTimestamp("20220326 00:00:00", "20060102 15:04:05")
+	s := &segment{
+		kvStore:   store,
+		indicator: "db/shard/1/segment/day/20220326",
+		baseTime:  baseTime,
+		interval:  timeutil.Interval(10 * 1000),
+		families:  make(map[int]DataFamily),
+	}
+	now := baseTime + 11*commontimeutil.OneHour + 30*commontimeutil.OneMinute
+	store.EXPECT().ListFamilyNames().Return([]string{"a", "9", "10", "11", "12"}).AnyTimes()
+	// family 10 not exist in store, family 11/12 are still writable
+	store.EXPECT().GetFamily("9").Return(family).AnyTimes()
+	store.EXPECT().GetFamily("10").Return(nil).AnyTimes()
+	family.EXPECT().Offload().Return(nil)
+	assert.NoError(t, s.Offload(now))
+	family.EXPECT().Offload().Return(fmt.Errorf("err"))
+	assert.Error(t, s.Offload(now))
+}
+
 func TestSegment_Close(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer func() {
@@ -26,6 +26,8 @@ import (
 	"time"
 
 	"github.com/lindb/common/pkg/logger"
+	commontimeutil "github.com/lindb/common/pkg/timeutil"
+	"github.com/lindb/roaring"
 	"go.uber.org/atomic"
 
 	"github.com/lindb/lindb/index"
@@ -33,6 +35,7 @@ import (
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/option"
 	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series/metric"
 	"github.com/lindb/lindb/tsdb/memdb"
 )
 
@@ -54,6 +57,11 @@ type Shard interface {
 	GetDataFamilies(intervalType timeutil.IntervalType, timeRange timeutil.TimeRange) []DataFamily
 	// IndexDB returns the metric index database, include inverted/forward index.
 	IndexDB() index.MetricIndexDatabase
+	// DeleteSeries marks the data of series deleted in time range for all intervals,
+	// deleted data is purged when doing compaction.
+	DeleteSeries(metricID metric.ID, seriesIDs *roaring.Bitmap, timeRange timeutil.TimeRange) error
+	// DropMetric marks the data of all series under metric deleted, returns the number of dropped series.
+	DropMetric(metricID metric.ID) (uint64, error)
 	// MemIndexDB returns memory index database.
 	MemIndexDB() memdb.IndexDatabase
 	// BufferManager returns write temp memory manager.
@@ -228,6 +236,46 @@ func (s *shard) GetDataFamilies(intervalType timeutil.IntervalType, timeRange ti
 	return nil
 }
 
+// DeleteSeries marks the data of series deleted in time range for all intervals,
+// deleted data is purged when doing compaction.
+func (s *shard) DeleteSeries(metricID metric.ID, seriesIDs *roaring.Bitmap, timeRange timeutil.TimeRange) error {
+	if err := s.indexDB.DeleteSeries(metricID, seriesIDs, timeRange); err != nil {
+		return err
+	}
+	for _, rollupSegment := range s.rollupTargets {
+		for _, family := range rollupSegment.GetDataFamilies(timeRange) {
+			if err := family.DeleteSeries(metricID, seriesIDs, timeRange); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// DropMetric marks the data of all series under metric deleted,
+// time range includes all history data and the data written ahead.
+func (s *shard) DropMetric(metricID metric.ID) (uint64, error) {
+	if s.memIndexDB != nil {
+		// evict memory time series index, avoid new metric with same name reusing unflushed data
+		if memMetricID, ok := s.memIndexDB.GetMetadataDatabase().GetMemMetricID(uint32(metricID)); ok {
+			s.memIndexDB.DropTimeSeriesIndex(memMetricID)
+		}
+	}
+	seriesIDs, err := s.indexDB.GetSeriesIDsForMetric(metricID)
+	if err != nil {
+		return 0, err
+	}
+	if seriesIDs == nil || seriesIDs.IsEmpty() {
+		return 0, nil
+	}
+	ahead, _ := s.option.GetAcceptWritableRange()
+	timeRange := timeutil.TimeRange{Start: 0, End: commontimeutil.Now() + ahead}
+	if err := s.DeleteSeries(metricID, seriesIDs, timeRange); err != nil {
+		return 0, err
+	}
+	return seriesIDs.GetCardinality(), nil
+}
+
 func (s *shard) Close() error {
 	// finally, cleanup temp buffer.
 	defer s.bufferMgr.Cleanup()
@@ -322,6 +370,25 @@ func (s *shard) TTL() {
 			)
 		}
 	}
+	// expire the series tombstones of index after deleted data expired in all intervals
+	var retention int64
+	for _, interval := range s.option.Intervals {
+		if interval.Retention.Int64() > retention {
+			retention = interval.Retention.Int64()
+		}
+	}
+	if retention <= 0 {
+		return
+	}
+	// add 2 hours buffer, same as segment ttl.
+	expireTime := commontimeutil.Now() - retention - 2*commontimeutil.OneHour
+	if err := s.indexDB.ExpireDeletedSeries(expireTime); err != nil {
+		s.logger.Warn("expire deleted series of index failure",
+			logger.String("database", s.db.Name()),
+			logger.Any("shardID", s.id),
+			logger.Error(err),
+		)
+	}
 }
 
 // EvictSegment evicts segment which long term no read operation.
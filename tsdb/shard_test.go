@@ -28,6 +28,7 @@ import (
 	"github.com/lindb/common/pkg/logger"
 	commontimeutil "github.com/lindb/common/pkg/timeutil"
 	protoMetricsV1 "github.com/lindb/common/proto/gen/v1/linmetrics"
+	"github.com/lindb/roaring"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
@@ -413,14 +414,31 @@ func TestShard_TTL(t *testing.T) {
 	db := NewMockDatabase(ctrl)
 	db.EXPECT().Name().Return("test").AnyTimes()
 	segment := NewMockIntervalSegment(ctrl)
+	indexDB := index.NewMockMetricIndexDatabase(ctrl)
 	s := &shard{
+		option: &option.DatabaseOption{},
 		rollupTargets: map[timeutil.Interval]IntervalSegment{
 			10: segment,
 		},
-		db:     db,
-		logger: logger.GetLogger("TSDB", "Test"),
+		indexDB: indexDB,
+		db:      db,
+		logger:  logger.GetLogger("TSDB", "Test"),
+	}
+	segment.EXPECT().TTL().Return(fmt.Errorf("err")).AnyTimes()
+	// no retention
+	s.TTL()
+	s.option.Intervals = option.Intervals{
+		{Interval: timeutil.Interval(10 * commontimeutil.OneSecond), Retention: timeutil.Interval(commontimeutil.OneDay)},
+		{Interval: timeutil.Interval(commontimeutil.OneMinute), Retention: timeutil.Interval(30 * commontimeutil.OneDay)},
 	}
-	segment.EXPECT().TTL().Return(fmt.Errorf("err"))
+	// expire deleted series after data of all intervals expired
+	now := commontimeutil.Now()
+	indexDB.EXPECT().ExpireDeletedSeries(gomock.Any()).DoAndReturn(func(timestamp int64) error {
+		assert.True(t, timestamp >= now-30*commontimeutil.OneDay-2*commontimeutil.OneHour)
+		return nil
+	})
+	s.TTL()
+	indexDB.EXPECT().ExpireDeletedSeries(gomock.Any()).Return(fmt.Errorf("err"))
 	s.TTL()
 }
 
@@ -441,6 +459,92 @@ func TestShard_EvictSegment(t *testing.T) {
 	s.EvictSegment()
 }
 
+func TestShard_DeleteSeries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	indexDB := index.NewMockMetricIndexDatabase(ctrl)
+	segment := NewMockIntervalSegment(ctrl)
+	family := NewMockDataFamily(ctrl)
+	s := &shard{
+		rollupTargets: map[timeutil.Interval]IntervalSegment{
+			10: segment,
+		},
+		indexDB: indexDB,
+	}
+	seriesIDs := roaring.BitmapOf(1, 2)
+	timeRange := timeutil.TimeRange{Start: 10, End: 100}
+	// case 1: delete index failure
+	indexDB.EXPECT().DeleteSeries(metric.ID(1), seriesIDs, timeRange).Return(fmt.Errorf("err"))
+	assert.Error(t, s.DeleteSeries(1, seriesIDs, timeRange))
+	// case 2: delete data family failure
+	indexDB.EXPECT().DeleteSeries(metric.ID(1), seriesIDs, timeRange).Return(nil).AnyTimes()
+	segment.EXPECT().GetDataFamilies(timeRange).Return([]DataFamily{family}).AnyTimes()
+	family.EXPECT().DeleteSeries(metric.ID(1), seriesIDs, timeRange).Return(fmt.Errorf("err"))
+	assert.Error(t, s.DeleteSeries(1, seriesIDs, timeRange))
+	// case 3: delete series successfully
+	family.EXPECT().DeleteSeries(metric.ID(1), seriesIDs, timeRange).Return(nil)
+	assert.NoError(t, s.DeleteSeries(1, seriesIDs, timeRange))
+}
+
+func TestShard_DropMetric(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	indexDB := index.NewMockMetricIndexDatabase(ctrl)
+	segment := NewMockIntervalSegment(ctrl)
+	family := NewMockDataFamily(ctrl)
+	s := &shard{
+		option: &option.DatabaseOption{Ahead: "1h"},
+		rollupTargets: map[timeutil.Interval]IntervalSegment{
+			10: segment,
+		},
+		indexDB: indexDB,
+	}
+	// case 1: get series ids failure
+	indexDB.EXPECT().GetSeriesIDsForMetric(metric.ID(1)).Return(nil, fmt.Errorf("err"))
+	n, err := s.DropMetric(1)
+	assert.Error(t, err)
+	assert.Zero(t, n)
+	// case 2: no series
+	indexDB.EXPECT().GetSeriesIDsForMetric(metric.ID(1)).Return(roaring.New(), nil)
+	n, err = s.DropMetric(1)
+	assert.NoError(t, err)
+	assert.Zero(t, n)
+	// case 3: delete series failure
+	indexDB.EXPECT().GetSeriesIDsForMetric(metric.ID(1)).Return(roaring.BitmapOf(1, 2), nil).AnyTimes()
+	indexDB.EXPECT().DeleteSeries(metric.ID(1), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+	n, err = s.DropMetric(1)
+	assert.Error(t, err)
+	assert.Zero(t, n)
+	// case 4: drop metric successfully
+	indexDB.EXPECT().DeleteSeries(metric.ID(1), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ metric.ID, _ *roaring.Bitmap, timeRange timeutil.TimeRange) error {
+			assert.Equal(t, int64(0), timeRange.Start)
+			assert.True(t, timeRange.End > commontimeutil.Now())
+			return nil
+		})
+	segment.EXPECT().GetDataFamilies(gomock.Any()).Return([]DataFamily{family})
+	family.EXPECT().DeleteSeries(metric.ID(1), gomock.Any(), gomock.Any()).Return(nil)
+	n, err = s.DropMetric(1)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), n)
+	// case 5: evict memory time series index of dropped metric
+	memIndexDB := memdb.NewMockIndexDatabase(ctrl)
+	memMetaDB := memdb.NewMockMetadataDatabase(ctrl)
+	memIndexDB.EXPECT().GetMetadataDatabase().Return(memMetaDB).AnyTimes()
+	s.memIndexDB = memIndexDB
+	memMetaDB.EXPECT().GetMemMetricID(uint32(2)).Return(uint64(0), false)
+	indexDB.EXPECT().GetSeriesIDsForMetric(metric.ID(2)).Return(roaring.New(), nil)
+	n, err = s.DropMetric(2)
+	assert.NoError(t, err)
+	assert.Zero(t, n)
+	memMetaDB.EXPECT().GetMemMetricID(uint32(2)).Return(uint64(100), true)
+	memIndexDB.EXPECT().DropTimeSeriesIndex(uint64(100))
+	indexDB.EXPECT().GetSeriesIDsForMetric(metric.ID(2)).Return(roaring.New(), nil)
+	n, err = s.DropMetric(2)
+	assert.NoError(t, err)
+	assert.Zero(t, n)
+}
+
 func TestShard_GetOrCreateDataFamily(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -0,0 +1,154 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package metricsdata
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"strings"
+
+	"github.com/lindb/lindb/kv"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/stream"
+	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/series/histogram"
+)
+
+// init registers metric data block inspector for offline tools
+func init() {
+	kv.RegisterBlockInspector(MetricDataMerger, &blockInspector{})
+}
+
+// blockInspector implements kv.BlockInspector for metric block.
+type blockInspector struct{}
+
+// Verify verifies the crc32 checksum written by stream writer and the layout of metric block.
+func (i *blockInspector) Verify(_ uint32, block []byte) error {
+	if len(block) <= dataFooterSize {
+		return fmt.Errorf("metric block's length too small: %d <= %d", len(block), dataFooterSize)
+	}
+	footerPos := len(block) - dataFooterSize
+	expect := binary.LittleEndian.Uint32(block[len(block)-4:])
+	if actual := crc32.ChecksumIEEE(block[:footerPos]); actual != expect {
+		return fmt.Errorf("crc32 checksum not match, expect: %d, actual: %d", expect, actual)
+	}
+	r, err := NewReader("", block)
+	if err != nil {
+		return err
+	}
+	scanner, err := newDataScanner(r)
+	if err != nil {
+		return err
+	}
+	for scanner.highContainerIdx < len(scanner.highKeys) {
+		if err := scanner.nextContainer(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Dump writes the time range/fields of metric block, then decoded field values of each series.
+func (i *blockInspector) Dump(w io.Writer, metricID uint32, block []byte) error {
+	mr, err := NewReader("", block)
+	if err != nil {
+		return err
+	}
+	r := mr.(*metricReader)
+	fields := make([]string, len(r.fields))
+	for idx, f := range r.fields {
+		fields[idx] = fmt.Sprintf("%d(%s)", f.ID, f.Type)
+	}
+	_, _ = fmt.Fprintf(w, "  metric: %d, time range: [%d,%d], fields: [%s], series: %d\n",
+		metricID, r.timeRange.Start, r.timeRange.End, strings.Join(fields, ","), r.seriesIDs.GetCardinality())
+	scanner, err := newDataScanner(r)
+	if err != nil {
+		return err
+	}
+	tsdDecoder := encoding.GetTSDDecoder()
+	defer encoding.ReleaseTSDDecoder(tsdDecoder)
+	histogramDecoder := histogram.NewBlockDecoder()
+
+	it := r.seriesIDs.Iterator()
+	for it.HasNext() {
+		seriesID := it.Next()
+		entry := scanner.scan(uint16(seriesID>>16), uint16(seriesID))
+		if entry == nil {
+			_, _ = fmt.Fprintf(w, "    series: %d, data not found\n", seriesID)
+			continue
+		}
+		fieldBlocks, err := splitFieldBlocks(entry, len(r.fields))
+		if err != nil {
+			_, _ = fmt.Fprintf(w, "    series: %d, error: %s\n", seriesID, err)
+			continue
+		}
+		for idx, fieldBlock := range fieldBlocks {
+			if len(fieldBlock) == 0 {
+				// series hasn't data of this field
+				continue
+			}
+			var getter encoding.TSDValueGetter = tsdDecoder
+			if r.fields[idx].Type == field.NativeHistogramField {
+				if err := histogramDecoder.Reset(fieldBlock); err != nil {
+					_, _ = fmt.Fprintf(w, "    series: %d, field: %d, error: %s\n", seriesID, r.fields[idx].ID, err)
+					continue
+				}
+				getter = histogramDecoder
+			} else {
+				tsdDecoder.ResetWithTimeRange(fieldBlock, r.timeRange.Start, r.timeRange.End)
+			}
+			var values []string
+			for slot := r.timeRange.Start; slot <= r.timeRange.End; slot++ {
+				if value, ok := getter.GetValue(slot); ok {
+					values = append(values, fmt.Sprintf("%d=%v", slot, value))
+				}
+			}
+			_, _ = fmt.Fprintf(w, "    series: %d, field: %d, values: [%s]\n",
+				seriesID, r.fields[idx].ID, strings.Join(values, ","))
+		}
+	}
+	return nil
+}
+
+// splitFieldBlocks splits the series entry into data block of each field.
+func splitFieldBlocks(entry []byte, fieldCount int) ([][]byte, error) {
+	if fieldCount == 1 {
+		// metric has one field, series entry is the field data
+		return [][]byte{entry}, nil
+	}
+	fieldOffsetsBlockLen, uVariantEncodingLen := stream.UvarintLittleEndian(entry)
+	fieldOffsetsAt := len(entry) - int(fieldOffsetsBlockLen) - uVariantEncodingLen
+	if uVariantEncodingLen <= 0 || fieldOffsetsAt <= 0 || fieldOffsetsAt >= len(entry) {
+		return nil, fmt.Errorf("bad field offsets of series entry")
+	}
+	fieldOffsets := encoding.NewFixedOffsetDecoder()
+	if _, err := fieldOffsets.Unmarshal(entry[fieldOffsetsAt:]); err != nil {
+		return nil, err
+	}
+	blocks := make([][]byte, fieldCount)
+	for idx := range blocks {
+		block, err := fieldOffsets.GetBlock(idx, entry[:fieldOffsetsAt])
+		if err != nil {
+			return nil, err
+		}
+		blocks[idx] = block
+	}
+	return blocks, nil
+}
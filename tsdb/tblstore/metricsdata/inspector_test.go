@@ -0,0 +1,68 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package metricsdata
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/kv"
+)
+
+func TestBlockInspector_Verify(t *testing.T) {
+	inspector := kv.GetBlockInspector(MetricDataMerger)
+	assert.NotNil(t, inspector)
+
+	assert.NoError(t, inspector.Verify(10, mockMetricBlock()))
+	assert.NoError(t, inspector.Verify(10, mockMetricBlockForOneField()))
+	// block too small
+	assert.Error(t, inspector.Verify(10, []byte{1, 2, 3}))
+	// crc32 not match
+	block := mockMetricBlock()
+	block[0]++
+	assert.Error(t, inspector.Verify(10, block))
+}
+
+func TestBlockInspector_Dump(t *testing.T) {
+	inspector := kv.GetBlockInspector(MetricDataMerger)
+	w := &bytes.Buffer{}
+	assert.NoError(t, inspector.Dump(w, 10, mockMetricBlock()))
+	out := w.String()
+	assert.Contains(t, out, "metric: 10, time range: [5,5], fields: [2(sum),10(min),30(sum),100(max)], series: 11")
+	assert.Contains(t, out, "series: 4096, field: 30, values: [5=0]")
+	assert.Contains(t, out, "series: 65546, field: 2, values: [5=10]")
+
+	w.Reset()
+	assert.NoError(t, inspector.Dump(w, 10, mockMetricBlockForOneField()))
+	assert.Contains(t, w.String(), "series: 0, field: 2, values: [5=0]")
+
+	assert.Error(t, inspector.Dump(w, 10, []byte{1, 2, 3}))
+}
+
+func TestSplitFieldBlocks(t *testing.T) {
+	blocks, err := splitFieldBlocks([]byte{1, 2, 3}, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, [][]byte{{1, 2, 3}}, blocks)
+
+	_, err = splitFieldBlocks(nil, 2)
+	assert.Error(t, err)
+	_, err = splitFieldBlocks([]byte{1, 2, 3, 100}, 2)
+	assert.Error(t, err)
+}
@@ -30,6 +30,21 @@ import (
 
 var MetricDataMerger kv.MergerType = "MetricDataMerger"
 
+// PurgeRange represents the deleted series in slot range which need purge when merging metric data.
+type PurgeRange struct {
+	SeriesIDs *roaring.Bitmap
+	SlotRange timeutil.SlotRange
+}
+
+// Tombstone represents the deleted series which need purge when merging metric data.
+type Tombstone interface {
+	// GetPurgeSeriesIDs returns the series ids of metric whose data are all deleted, returns nil if not found.
+	GetPurgeSeriesIDs(metricID uint32) *roaring.Bitmap
+	// GetPurgeRanges returns the deleted series of metric in slot range(based on target slot),
+	// data of series in those slots are purged.
+	GetPurgeRanges(metricID uint32) []PurgeRange
+}
+
 // init registers metric data merger create function
 func init() {
 	kv.RegisterMerger(MetricDataMerger, NewMerger)
@@ -43,6 +58,29 @@ type mergerContext struct {
 	targetRange, sourceRange timeutil.SlotRange
 	ratio                    uint16
 	baseSlot                 uint16
+
+	purgeRanges []PurgeRange         // deleted series in slot range of metric
+	purgeSlots  []timeutil.SlotRange // deleted slot ranges of current merging series
+}
+
+// setPurgeSlots sets the deleted slot ranges of current merging series.
+func (ctx *mergerContext) setPurgeSlots(seriesID uint32) {
+	ctx.purgeSlots = ctx.purgeSlots[:0]
+	for idx := range ctx.purgeRanges {
+		if ctx.purgeRanges[idx].SeriesIDs.Contains(seriesID) {
+			ctx.purgeSlots = append(ctx.purgeSlots, ctx.purgeRanges[idx].SlotRange)
+		}
+	}
+}
+
+// isPurged checks if the target slot of current merging series is deleted.
+func (ctx *mergerContext) isPurged(slot uint16) bool {
+	for idx := range ctx.purgeSlots {
+		if ctx.purgeSlots[idx].Contains(slot) {
+			return true
+		}
+	}
+	return false
 }
 
 // merger implements kv.Merger for merging series data for each metric
@@ -50,6 +88,7 @@ type merger struct {
 	dataFlusher  Flusher
 	seriesMerger SeriesMerger
 	rollup       kv.Rollup
+	tombstone    Tombstone
 }
 
 // NewMerger creates a metric data merger
@@ -64,11 +103,15 @@ func NewMerger(flusher kv.Flusher) (kv.Merger, error) {
 	}, nil
 }
 
-// Init initializes metric data merger, if rollup context exist do rollup job, else do compact job
+// Init initializes metric data merger, if rollup context exist do rollup job, else do compact job,
+// if tombstone context exist purge the deleted series.
 func (m *merger) Init(params map[string]interface{}) {
 	if rollupCtx, ok := params[kv.RollupContext]; ok {
 		m.rollup = rollupCtx.(kv.Rollup)
 	}
+	if tombstoneCtx, ok := params[kv.TombstoneContext]; ok {
+		m.tombstone = tombstoneCtx.(Tombstone)
+	}
 }
 
 // Merge merges the multi metric data into one target metric data for same metric id
@@ -79,6 +122,18 @@ func (m *merger) Merge(key uint32, metricBlocks [][]byte) error {
 	if err != nil {
 		return err
 	}
+	if m.tombstone != nil {
+		// purge the deleted series
+		if purgeSeriesIDs := m.tombstone.GetPurgeSeriesIDs(key); purgeSeriesIDs != nil {
+			mergeCtx.seriesIDs.AndNot(purgeSeriesIDs)
+		}
+		if mergeCtx.seriesIDs.IsEmpty() {
+			// all series of metric deleted, drop metric data
+			return nil
+		}
+		// purge the deleted data of series in slot range
+		mergeCtx.purgeRanges = m.tombstone.GetPurgeRanges(key)
+	}
 	// 2. Prepare metric
 	m.dataFlusher.PrepareMetric(key, mergeCtx.targetFields)
 	// 3. merge series data by roaring container
@@ -95,6 +150,9 @@ func (m *merger) Merge(key uint32, metricBlocks [][]byte) error {
 		it := container.PeekableIterator()
 		for it.HasNext() {
 			lowSeriesID := it.Next()
+			if len(mergeCtx.purgeRanges) > 0 {
+				mergeCtx.setPurgeSlots(encoding.ValueWithHighLowBits(uint32(highKey)<<16, lowSeriesID))
+			}
 			// maybe series id not exist in some values block
 			for blockIdx, scanner := range mergeCtx.scanners {
 				seriesEntry := scanner.scan(highKey, lowSeriesID)
@@ -107,6 +107,84 @@ func assertMergeReentrant(t *testing.T, flusher kv.Flusher, mergerIntf kv.Merger
 	assert.Equal(t, 2, found)
 }
 
+type mockTombstone struct {
+	seriesIDs *roaring.Bitmap
+	ranges    []PurgeRange
+}
+
+func (t *mockTombstone) GetPurgeSeriesIDs(_ uint32) *roaring.Bitmap {
+	return t.seriesIDs
+}
+
+func (t *mockTombstone) GetPurgeRanges(_ uint32) []PurgeRange {
+	return t.ranges
+}
+
+func TestMerger_Compact_Purge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	flusher := kv.NewNopFlusher()
+	mergerIntf, err := NewMerger(flusher)
+	assert.NoError(t, err)
+	mergerIntf.Init(map[string]interface{}{kv.TombstoneContext: &mockTombstone{seriesIDs: roaring.BitmapOf(2, 20)}})
+	err = mergerIntf.Merge(
+		1,
+		[][]byte{
+			mockRealMetricBlock([]uint32{1, 2, 4}, 11, 15),
+			mockRealMetricBlock([]uint32{2, 20}, 16, 20),
+			mockRealMetricBlock([]uint32{2, 30}, 5, 10),
+		})
+	assert.NoError(t, err)
+	r, err := NewReader("test", flusher.Bytes())
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{1, 4, 30}, r.GetSeriesIDs().ToArray())
+
+	// all series deleted, drop metric data
+	mergerIntf.Init(map[string]interface{}{kv.TombstoneContext: &mockTombstone{seriesIDs: roaring.BitmapOf(2, 20)}})
+	m := mergerIntf.(*merger)
+	m.dataFlusher = NewMockFlusher(ctrl)
+	err = mergerIntf.Merge(1, [][]byte{mockRealMetricBlock([]uint32{2, 20}, 16, 20)})
+	assert.NoError(t, err)
+	// no deleted series
+	mergerIntf, err = NewMerger(flusher)
+	assert.NoError(t, err)
+	mergerIntf.Init(map[string]interface{}{kv.TombstoneContext: &mockTombstone{}})
+	err = mergerIntf.Merge(1, [][]byte{mockRealMetricBlock([]uint32{2, 20}, 16, 20)})
+	assert.NoError(t, err)
+	r, err = NewReader("test", flusher.Bytes())
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{2, 20}, r.GetSeriesIDs().ToArray())
+	// purge deleted data of series in slot range
+	mergerIntf, err = NewMerger(flusher)
+	assert.NoError(t, err)
+	mergerIntf.Init(map[string]interface{}{kv.TombstoneContext: &mockTombstone{
+		ranges: []PurgeRange{{SeriesIDs: roaring.BitmapOf(2), SlotRange: timeutil.SlotRange{Start: 16, End: 18}}},
+	}})
+	err = mergerIntf.Merge(1, [][]byte{mockRealMetricBlock([]uint32{2, 20}, 16, 20)})
+	assert.NoError(t, err)
+	r, err = NewReader("test", flusher.Bytes())
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{2, 20}, r.GetSeriesIDs().ToArray())
+	scanner, err := newDataScanner(r)
+	assert.NoError(t, err)
+	slotsOf := func(seriesID uint32) (slots []uint16) {
+		fieldReader := newFieldReader(scanner.fieldIndexes(), scanner.scan(0, uint16(seriesID)), scanner.slotRange())
+		tsd := encoding.GetTSDDecoder()
+		defer encoding.ReleaseTSDDecoder(tsd)
+		tsd.ResetWithTimeRange(fieldReader.GetFieldData(2), 16, 20)
+		for i := uint16(16); i <= 20; i++ {
+			if tsd.HasValueWithSlot(i) {
+				slots = append(slots, i)
+				assert.Equal(t, float64(i), math.Float64frombits(tsd.Value()))
+			}
+		}
+		return slots
+	}
+	assert.Equal(t, []uint16{19, 20}, slotsOf(2))
+	assert.Equal(t, []uint16{16, 17, 18, 19, 20}, slotsOf(20))
+}
+
 func mockRealMetricBlock(seriesIDs []uint32, start, end uint16) []byte {
 	nopKVFlusher := kv.NewNopFlusher()
 	flusher, _ := NewFlusher(nopKVFlusher)
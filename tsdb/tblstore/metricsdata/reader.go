@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"sort"
 
+	"github.com/lindb/common/pkg/logger"
 	"github.com/lindb/roaring"
 
 	"github.com/lindb/lindb/flow"
@@ -29,6 +30,7 @@ import (
 	"github.com/lindb/lindb/pkg/stream"
 	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/series/histogram"
 )
 
 //go:generate mockgen -source ./reader.go -destination=./reader_mock.go -package metricsdata
@@ -44,6 +46,8 @@ const (
 	fieldNotFound = -1
 )
 
+var readerLogger = logger.GetLogger("TSDB", "MetricReader")
+
 // MetricReader represents the metric block metricReader
 type MetricReader interface {
 	// Path returns file path
@@ -168,12 +172,10 @@ func (r *metricReader) Load(ctx *flow.DataLoadContext) flow.DataLoader {
 
 // readSeriesData reads series data from file by given position.
 func (r *metricReader) readSeriesData(ctx *flow.DataLoadContext, seriesIdx uint16, seriesEntryBlock []byte) {
-	decoder := ctx.Decoder
 	fieldCount := r.fields.Len()
 	if fieldCount == 1 {
-		decoder.ResetWithTimeRange(seriesEntryBlock, r.timeRange.Start, r.timeRange.End)
 		// metric has one field, just read the data
-		ctx.DownSampling(r.timeRange, seriesIdx, 0, decoder)
+		r.readFieldData(ctx, seriesIdx, 0, 0, seriesEntryBlock)
 		return
 	}
 
@@ -193,14 +195,42 @@ func (r *metricReader) readSeriesData(ctx *flow.DataLoadContext, seriesIdx uint1
 		}
 		fieldBlock, err := fieldOffsetsDecoder.GetBlock(readIdx, seriesEntryBlock[:fieldOffsetsAt])
 		if err == nil {
-			decoder.ResetWithTimeRange(fieldBlock, r.timeRange.Start, r.timeRange.End)
 			// read field data
-			ctx.DownSampling(r.timeRange, seriesIdx, queryIdx, decoder)
+			r.readFieldData(ctx, seriesIdx, queryIdx, readIdx, fieldBlock)
 		}
 	}
 	encoding.ReleaseFixedOffsetDecoder(fieldOffsetsDecoder)
 }
 
+// readFieldData reads the field data of series, skips the field data which cannot be decoded.
+func (r *metricReader) readFieldData(ctx *flow.DataLoadContext, seriesIdx uint16, queryIdx, fieldIdx int, fieldBlock []byte) {
+	getter, err := r.fieldValueGetter(ctx, fieldIdx, fieldBlock)
+	if err != nil {
+		readerLogger.Warn("decode field data failure, ignore it",
+			logger.String("path", r.path),
+			logger.Any("field", r.fields[fieldIdx].ID),
+			logger.Error(err))
+		return
+	}
+	ctx.DownSampling(r.timeRange, seriesIdx, queryIdx, getter)
+}
+
+// fieldValueGetter returns the value getter of field data based on field type,
+// native histogram field uses histogram block decoder, others use tsd decoder.
+func (r *metricReader) fieldValueGetter(ctx *flow.DataLoadContext, fieldIdx int, fieldBlock []byte) (encoding.TSDValueGetter, error) {
+	if r.fields[fieldIdx].Type == field.NativeHistogramField {
+		if ctx.HistogramDecoder == nil {
+			ctx.HistogramDecoder = histogram.NewBlockDecoder()
+		}
+		if err := ctx.HistogramDecoder.Reset(fieldBlock); err != nil {
+			return nil, err
+		}
+		return ctx.HistogramDecoder, nil
+	}
+	ctx.Decoder.ResetWithTimeRange(fieldBlock, r.timeRange.Start, r.timeRange.End)
+	return ctx.Decoder, nil
+}
+
 // initReader initializes the metricReader context includes tag value ids/high offsets
 func (r *metricReader) initReader() error {
 	if len(r.metricBlock) <= dataFooterSize {
@@ -33,6 +33,7 @@ import (
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/series/histogram"
 	"github.com/lindb/lindb/sql/stmt"
 )
 
@@ -192,6 +193,37 @@ func TestReader_scan(t *testing.T) {
 	assert.Empty(t, seriesEntry)
 }
 
+func TestReader_readSeriesData_histogram(t *testing.T) {
+	encoder := histogram.NewBlockEncoder()
+	encoder.Append(5, histogram.FromExplicitBounds([]float64{1, 2}, []float64{1, 2}, 3, 3))
+	block, err := encoder.Bytes()
+	assert.NoError(t, err)
+
+	r := &metricReader{
+		path:      "1.sst",
+		fields:    field.Metas{{ID: 1, Type: field.NativeHistogramField}},
+		timeRange: timeutil.SlotRange{Start: 5, End: 5},
+	}
+	var values []float64
+	ctx := &flow.DataLoadContext{
+		DownSampling: func(_ timeutil.SlotRange, _ uint16, _ int, getter encoding.TSDValueGetter) {
+			value, ok := getter.GetValue(5)
+			assert.True(t, ok)
+			values = append(values, value)
+		},
+	}
+	r.readSeriesData(ctx, 0, block)
+	assert.Equal(t, []float64{3}, values)
+
+	// corrupt block
+	corrupt := block[:len(block)-1]
+	getter, err := r.fieldValueGetter(ctx, 0, corrupt)
+	assert.Error(t, err)
+	assert.Nil(t, getter)
+	r.readSeriesData(ctx, 0, corrupt)
+	assert.Equal(t, []float64{3}, values)
+}
+
 func mockMetricBlock() []byte {
 	nopKVFlusher := kv.NewNopFlusher()
 	flusher, _ := NewFlusher(nopKVFlusher)
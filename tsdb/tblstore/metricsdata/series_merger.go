@@ -18,8 +18,12 @@
 package metricsdata
 
 import (
+	"math"
+
 	"github.com/lindb/lindb/aggregation"
 	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/series/histogram"
 )
 
 //go:generate mockgen -source ./series_merger.go -destination=./series_merger_mock.go -package metricsdata
@@ -52,6 +56,12 @@ func (sm *seriesMerger) merge(
 	fieldReaders []FieldReader,
 ) error {
 	for idx, f := range mergeCtx.targetFields {
+		if f.Type == field.NativeHistogramField {
+			if err := sm.mergeHistogram(mergeCtx, f.ID, fieldReaders); err != nil {
+				return err
+			}
+			continue
+		}
 		fieldID := f.ID
 		encodeStream := sm.flusher.GetEncoder(idx)
 		encodeStream.RestWithStartTime(mergeCtx.targetRange.Start)
@@ -72,13 +82,23 @@ func (sm *seriesMerger) merge(
 				streams[idx].ResetWithTimeRange(fieldData, oldSlotRange.Start, oldSlotRange.End)
 			}
 		}
+		emitValue := encodeStream.EmitDownSamplingValue
+		if len(mergeCtx.purgeSlots) > 0 {
+			// purge the deleted data, inf value is emitted as no value
+			emitValue = func(targetPos int, value float64) {
+				if mergeCtx.isPurged(mergeCtx.targetRange.Start + uint16(targetPos)) {
+					value = math.Inf(1)
+				}
+				encodeStream.EmitDownSamplingValue(targetPos, value)
+			}
+		}
 		// merges field data from source time range => target time range,
 		// compact merge: source range = target range and ratio = 1
 		// rollup merge: source range[5,182]=>target range[0,6], ratio:30, source interval:10s, target interval:5min
 		aggregation.DownSamplingMultiSeriesInto(
 			mergeCtx.targetRange, mergeCtx.ratio, mergeCtx.baseSlot,
 			f.Type, streams,
-			encodeStream.EmitDownSamplingValue,
+			emitValue,
 		)
 
 		data, err := encodeStream.BytesWithoutTime()
@@ -102,3 +122,43 @@ func (sm *seriesMerger) merge(
 	}
 	return nil
 }
+
+// mergeHistogram merges the native histogram field data with same series id,
+// histogram values are merged into target slot for compact/rollup.
+func (sm *seriesMerger) mergeHistogram(
+	mergeCtx *mergerContext,
+	fieldID field.ID,
+	fieldReaders []FieldReader,
+) error {
+	decoders := make([]*histogram.BlockDecoder, len(fieldReaders))
+	for idx, reader := range fieldReaders {
+		if reader == nil {
+			continue
+		}
+		fieldData := reader.GetFieldData(fieldID)
+		if len(fieldData) == 0 {
+			continue
+		}
+		decoder := histogram.NewBlockDecoder()
+		if err := decoder.Reset(fieldData); err != nil {
+			return err
+		}
+		decoders[idx] = decoder
+	}
+	encoder := histogram.NewBlockEncoder()
+	aggregation.DownSamplingMultiHistogramInto(
+		mergeCtx.targetRange, mergeCtx.ratio, mergeCtx.baseSlot,
+		decoders,
+		func(targetSlot uint16, value *histogram.Histogram) {
+			if !mergeCtx.isPurged(targetSlot) {
+				encoder.Append(targetSlot, value)
+			}
+		},
+	)
+	data, err := encoder.Bytes()
+	if err != nil {
+		return err
+	}
+	// flush field data
+	return sm.flusher.FlushField(data)
+}
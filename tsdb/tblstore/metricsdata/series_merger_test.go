@@ -28,6 +28,7 @@ import (
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/series/histogram"
 )
 
 func TestSeriesMerger_compact_merge(t *testing.T) {
@@ -98,6 +99,33 @@ func TestSeriesMerger_compact_merge(t *testing.T) {
 		}
 	}
 	assert.Equal(t, 2, c)
+	// case 3: purge deleted slot
+	reader1.EXPECT().GetFieldData(gomock.Any()).Return(mockField(10))
+	reader1.EXPECT().SlotRange().Return(timeutil.SlotRange{Start: 10, End: 10})
+	reader2.EXPECT().GetFieldData(gomock.Any()).Return(mockField(12))
+	reader2.EXPECT().SlotRange().Return(timeutil.SlotRange{Start: 12, End: 12})
+	flusher.EXPECT().FlushField(gomock.Any()).DoAndReturn(func(data []byte) error {
+		result = data
+		return nil
+	})
+	err = merger.merge(
+		&mergerContext{
+			targetFields: field.Metas{{ID: 1, Type: field.SumField}},
+			sourceRange:  timeutil.SlotRange{Start: 5, End: 15},
+			targetRange:  timeutil.SlotRange{Start: 5, End: 15},
+			ratio:        1,
+			purgeSlots:   []timeutil.SlotRange{{Start: 8, End: 10}},
+		}, decodeStreams, readers)
+	assert.NoError(t, err)
+	tsd.ResetWithTimeRange(result, 5, 15)
+	var slots []uint16
+	for i := uint16(5); i <= 15; i++ {
+		if tsd.HasValueWithSlot(i) {
+			slots = append(slots, i)
+			assert.Equal(t, 10.0, math.Float64frombits(tsd.Value()))
+		}
+	}
+	assert.Equal(t, []uint16{12}, slots)
 }
 
 func TestSeriesMerger_rollup_merge(t *testing.T) {
@@ -180,3 +208,65 @@ func mockField(start uint16) []byte {
 	data, _ := encoder.BytesWithoutTime()
 	return data
 }
+
+func TestSeriesMerger_histogram_merge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	flusher := NewMockFlusher(ctrl)
+	merger := newSeriesMerger(flusher)
+	decodeStreams := make([]*encoding.TSDDecoder, 3)
+	reader1 := NewMockFieldReader(ctrl)
+	reader2 := NewMockFieldReader(ctrl)
+	reader1.EXPECT().Close().AnyTimes()
+	reader2.EXPECT().Close().AnyTimes()
+	readers := []FieldReader{reader1, nil, reader2}
+	mockHistogramField := func(slot uint16) []byte {
+		encoder := histogram.NewBlockEncoder()
+		encoder.Append(slot, &histogram.Histogram{Schema: 0, Count: 1, Sum: 2, Buckets: []histogram.Bucket{{Index: 1, Count: 1}}})
+		data, _ := encoder.Bytes()
+		return data
+	}
+	mergeCtx := &mergerContext{
+		targetFields: field.Metas{{ID: 1, Type: field.NativeHistogramField}},
+		sourceRange:  timeutil.SlotRange{Start: 5, End: 182},
+		targetRange:  timeutil.SlotRange{Start: 0, End: 6},
+		ratio:        30,
+	}
+	// case 1: rollup histogram values
+	reader1.EXPECT().GetFieldData(gomock.Any()).Return(mockHistogramField(10))
+	reader2.EXPECT().GetFieldData(gomock.Any()).Return(mockHistogramField(20))
+	var result []byte
+	flusher.EXPECT().FlushField(gomock.Any()).DoAndReturn(func(data []byte) error {
+		result = data
+		return nil
+	})
+	assert.NoError(t, merger.merge(mergeCtx, decodeStreams, readers))
+	decoder := histogram.NewBlockDecoder()
+	assert.NoError(t, decoder.Reset(result))
+	assert.Equal(t, 1, decoder.Len())
+	assert.Equal(t, uint16(0), decoder.SlotAt(0))
+	assert.Equal(t, 2.0, decoder.HistogramAt(0).Count)
+	assert.Equal(t, []histogram.Bucket{{Index: 1, Count: 2}}, decoder.HistogramAt(0).Buckets)
+	// case 2: invalid histogram data
+	reader1.EXPECT().GetFieldData(gomock.Any()).Return([]byte{1, 2})
+	assert.Error(t, merger.merge(mergeCtx, decodeStreams, readers))
+	// case 3: purge deleted slot
+	reader1.EXPECT().GetFieldData(gomock.Any()).Return(mockHistogramField(10))
+	reader2.EXPECT().GetFieldData(gomock.Any()).Return(mockHistogramField(182))
+	flusher.EXPECT().FlushField(gomock.Any()).DoAndReturn(func(data []byte) error {
+		result = data
+		return nil
+	})
+	mergeCtx.purgeSlots = []timeutil.SlotRange{{Start: 0, End: 0}}
+	assert.NoError(t, merger.merge(mergeCtx, decodeStreams, readers))
+	assert.NoError(t, decoder.Reset(result))
+	assert.Equal(t, 1, decoder.Len())
+	assert.Equal(t, uint16(6), decoder.SlotAt(0))
+	mergeCtx.purgeSlots = nil
+	// case 4: no data
+	reader1.EXPECT().GetFieldData(gomock.Any()).Return(nil)
+	reader2.EXPECT().GetFieldData(gomock.Any()).Return(nil)
+	flusher.EXPECT().FlushField(nil).Return(nil)
+	assert.NoError(t, merger.merge(mergeCtx, decodeStreams, readers))
+}
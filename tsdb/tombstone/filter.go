@@ -0,0 +1,128 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tombstone
+
+import (
+	"github.com/lindb/roaring"
+
+	"github.com/lindb/lindb/flow"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series/histogram"
+)
+
+// SlotTombstone represents the deleted series in slot range of data family.
+type SlotTombstone struct {
+	SeriesIDs *roaring.Bitmap
+	SlotRange timeutil.SlotRange
+}
+
+// filterResultSet wraps the filter result set, masks the data of deleted series when loading data.
+type filterResultSet struct {
+	flow.FilterResultSet
+	tombstones []SlotTombstone
+}
+
+// NewFilterResultSet creates the filter result set which masks the data of deleted series.
+func NewFilterResultSet(rs flow.FilterResultSet, tombstones []SlotTombstone) flow.FilterResultSet {
+	return &filterResultSet{
+		FilterResultSet: rs,
+		tombstones:      tombstones,
+	}
+}
+
+// Load loads the data from storage, then returns the data loader which masks the data of deleted series.
+func (rs *filterResultSet) Load(ctx *flow.DataLoadContext) flow.DataLoader {
+	loader := rs.FilterResultSet.Load(ctx)
+	if loader == nil {
+		return nil
+	}
+	return &dataLoader{
+		loader:     loader,
+		tombstones: rs.tombstones,
+	}
+}
+
+// dataLoader wraps the data loader, skips the deleted slots of series.
+type dataLoader struct {
+	loader     flow.DataLoader
+	tombstones []SlotTombstone
+	deleted    []timeutil.SlotRange
+}
+
+// Load loads the metric data by given low series id, masks the deleted slots of series.
+func (l *dataLoader) Load(ctx *flow.DataLoadContext) {
+	downSampling := ctx.DownSampling
+	defer func() {
+		ctx.DownSampling = downSampling
+	}()
+	ctx.DownSampling = func(slotRange timeutil.SlotRange, seriesIdx uint16, fieldIdx int, getter encoding.TSDValueGetter) {
+		seriesID := uint32(ctx.SeriesIDHighKey)<<16 | uint32(seriesIdx+ctx.MinSeriesID)
+		l.deleted = l.deleted[:0]
+		for _, t := range l.tombstones {
+			if t.SlotRange.Overlap(slotRange) && t.SeriesIDs.Contains(seriesID) {
+				l.deleted = append(l.deleted, t.SlotRange)
+			}
+		}
+		if len(l.deleted) == 0 {
+			downSampling(slotRange, seriesIdx, fieldIdx, getter)
+			return
+		}
+		downSampling(slotRange, seriesIdx, fieldIdx, &valueGetter{getter: getter, deleted: l.deleted})
+	}
+	l.loader.Load(ctx)
+}
+
+// valueGetter wraps the value getter, returns no value for deleted slots.
+type valueGetter struct {
+	getter  encoding.TSDValueGetter
+	deleted []timeutil.SlotRange
+}
+
+// GetValue returns value by time slot, if it hasn't or is deleted, return false.
+func (g *valueGetter) GetValue(slot uint16) (float64, bool) {
+	// NOTE: must read value from getter, because tsd decoder reads value in order.
+	value, ok := g.getter.GetValue(slot)
+	if !ok || g.isDeleted(slot) {
+		return 0, false
+	}
+	return value, true
+}
+
+// GetHistogram returns histogram value by time slot, if it hasn't or is deleted, return false.
+func (g *valueGetter) GetHistogram(slot uint16) (*histogram.Histogram, bool) {
+	getter, ok := g.getter.(histogram.Getter)
+	if !ok {
+		return nil, false
+	}
+	value, ok := getter.GetHistogram(slot)
+	if !ok || g.isDeleted(slot) {
+		return nil, false
+	}
+	return value, true
+}
+
+// isDeleted checks if the slot is deleted.
+func (g *valueGetter) isDeleted(slot uint16) bool {
+	for idx := range g.deleted {
+		if g.deleted[idx].Contains(slot) {
+			return true
+		}
+	}
+	return false
+}
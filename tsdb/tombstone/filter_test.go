@@ -0,0 +1,112 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tombstone
+
+import (
+	"testing"
+
+	"github.com/lindb/roaring"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/lindb/lindb/flow"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series/histogram"
+)
+
+// mockGetter returns slot as value for all slots.
+type mockGetter struct {
+	reads []uint16
+}
+
+func (g *mockGetter) GetValue(slot uint16) (float64, bool) {
+	g.reads = append(g.reads, slot)
+	return float64(slot), true
+}
+
+func (g *mockGetter) GetHistogram(slot uint16) (*histogram.Histogram, bool) {
+	g.reads = append(g.reads, slot)
+	return &histogram.Histogram{Count: float64(slot)}, true
+}
+
+func TestFilterResultSet_Load(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rs := flow.NewMockFilterResultSet(ctrl)
+	deletedRS := NewFilterResultSet(rs, []SlotTombstone{
+		{SeriesIDs: roaring.BitmapOf(65536 + 1), SlotRange: timeutil.SlotRange{Start: 2, End: 3}},
+		{SeriesIDs: roaring.BitmapOf(65536 + 1), SlotRange: timeutil.SlotRange{Start: 20, End: 30}},
+	})
+	ctx := &flow.DataLoadContext{SeriesIDHighKey: 1}
+	// no data
+	rs.EXPECT().Load(ctx).Return(nil)
+	assert.Nil(t, deletedRS.Load(ctx))
+
+	loader := flow.NewMockDataLoader(ctrl)
+	rs.EXPECT().Load(ctx).Return(loader)
+	deletedLoader := deletedRS.Load(ctx)
+	assert.NotNil(t, deletedLoader)
+
+	values := make(map[uint16][]float64)
+	ctx.DownSampling = func(slotRange timeutil.SlotRange, seriesIdx uint16, _ int, getter encoding.TSDValueGetter) {
+		for slot := slotRange.Start; slot <= slotRange.End; slot++ {
+			if value, ok := getter.GetValue(slot); ok {
+				values[seriesIdx] = append(values[seriesIdx], value)
+			}
+		}
+	}
+	getter := &mockGetter{}
+	loader.EXPECT().Load(ctx).DoAndReturn(func(ctx *flow.DataLoadContext) {
+		// series 65536
+		ctx.DownSampling(timeutil.SlotRange{Start: 1, End: 4}, 0, 0, &mockGetter{})
+		// series 65537, deleted
+		ctx.DownSampling(timeutil.SlotRange{Start: 1, End: 4}, 1, 0, getter)
+	})
+	deletedLoader.Load(ctx)
+	assert.Equal(t, []float64{1, 2, 3, 4}, values[0])
+	assert.Equal(t, []float64{1, 4}, values[1])
+	// read all values from getter, keep the state of decoder
+	assert.Equal(t, []uint16{1, 2, 3, 4}, getter.reads)
+	// restore down sampling function after loading
+	values = make(map[uint16][]float64)
+	ctx.DownSampling(timeutil.SlotRange{Start: 1, End: 4}, 1, 0, &mockGetter{})
+	assert.Equal(t, []float64{1, 2, 3, 4}, values[1])
+}
+
+func TestValueGetter_GetHistogram(t *testing.T) {
+	g := &valueGetter{
+		getter:  &mockGetter{},
+		deleted: []timeutil.SlotRange{{Start: 2, End: 3}},
+	}
+	h, ok := g.GetHistogram(1)
+	assert.True(t, ok)
+	assert.Equal(t, float64(1), h.Count)
+	h, ok = g.GetHistogram(2)
+	assert.False(t, ok)
+	assert.Nil(t, h)
+	_, ok = g.GetValue(3)
+	assert.False(t, ok)
+
+	// getter not support histogram
+	g = &valueGetter{getter: encoding.NewTSDDecoder(nil)}
+	h, ok = g.GetHistogram(1)
+	assert.False(t, ok)
+	assert.Nil(t, h)
+}
@@ -0,0 +1,415 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tombstone
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/lindb/roaring"
+
+	"github.com/lindb/lindb/kv/table"
+	"github.com/lindb/lindb/pkg/stream"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series/metric"
+)
+
+//go:generate mockgen -source=./tombstone.go -destination=./tombstone_mock.go -package=tombstone
+
+// for testing
+var (
+	writeFileFunc = os.WriteFile
+	renameFunc    = os.Rename
+	readFileFunc  = os.ReadFile
+	openFileFunc  = os.OpenFile
+)
+
+const (
+	// FileName represents the file name of tombstones.
+	FileName = "TOMBSTONE"
+	// version represents the version of tombstone file format.
+	version = uint8(1)
+)
+
+// record types of tombstone file, file is a log of records which replayed in order when loading.
+const (
+	// recordAdd represents the record of deleted series in time range.
+	recordAdd = uint8(1)
+	// recordSeal represents the record of tombstone sealed with file number.
+	recordSeal = uint8(2)
+)
+
+// errInvalidFile represents tombstone file is corrupted.
+var errInvalidFile = errors.New("invalid tombstone file")
+
+// Tombstone represents the deleted series of metric in time range.
+type Tombstone struct {
+	MetricID  metric.ID
+	SeriesIDs *roaring.Bitmap
+	TimeRange timeutil.TimeRange
+	// FileNumber represents the sealed file number, the deleted data only exists in files whose number less than it,
+	// 0 means not sealed(deleted data maybe in memory database).
+	FileNumber table.FileNumber
+}
+
+// Store represents the tombstone store which persists the tombstones into file under dir.
+type Store interface {
+	// Add adds the tombstone, then appends the record of it into file.
+	Add(tombstone *Tombstone) error
+	// Get returns the tombstones of metric which overlap with time range.
+	Get(metricID metric.ID, timeRange timeutil.TimeRange) []*Tombstone
+	// GetDeletedSeriesIDs returns the series ids of metric whose tombstone covers the whole time range,
+	// returns nil if not found.
+	GetDeletedSeriesIDs(metricID metric.ID, timeRange timeutil.TimeRange) *roaring.Bitmap
+	// Unsealed returns the tombstones which are not sealed.
+	Unsealed() []*Tombstone
+	// Seal seals the tombstones with file number after the deleted data flushed into files,
+	// then appends the records of them into file.
+	Seal(tombstones []*Tombstone, fileNumber table.FileNumber) error
+	// Prune removes the sealed tombstones whose deleted data are purged, then rewrites all tombstones into file.
+	Prune(purged func(tombstone *Tombstone) bool) error
+	// Expire removes the tombstones whose time range ends before timestamp(deleted data expired by retention),
+	// then rewrites all tombstones into file.
+	Expire(timestamp int64) error
+}
+
+// store implements Store interface.
+type store struct {
+	fileName   string
+	tombstones map[metric.ID][]*Tombstone
+	exist      bool // if file exists with header
+
+	lock sync.RWMutex
+}
+
+// NewStore creates the tombstone store, loads the tombstones from file if exist.
+func NewStore(dir string) (Store, error) {
+	s := &store{
+		fileName:   filepath.Join(dir, FileName),
+		tombstones: make(map[metric.ID][]*Tombstone),
+	}
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Add adds the tombstone, then appends the record of it into file.
+func (s *store) Add(tombstone *Tombstone) error {
+	if tombstone == nil || tombstone.SeriesIDs == nil || tombstone.SeriesIDs.IsEmpty() {
+		return nil
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.add(tombstone.MetricID, tombstone.SeriesIDs, tombstone.TimeRange)
+	return s.append(func(writer *stream.BufferWriter) error {
+		return putAddRecord(writer, tombstone)
+	})
+}
+
+// add adds the deleted series, merges into new unsealed tombstone if time range is same.
+func (s *store) add(metricID metric.ID, seriesIDs *roaring.Bitmap, timeRange timeutil.TimeRange) {
+	tombstones := s.tombstones[metricID]
+	for idx, t := range tombstones {
+		if t.TimeRange == timeRange {
+			// same time range, merge deleted series into new unsealed tombstone
+			tombstones[idx] = &Tombstone{
+				MetricID:  t.MetricID,
+				SeriesIDs: roaring.Or(t.SeriesIDs, seriesIDs),
+				TimeRange: t.TimeRange,
+			}
+			return
+		}
+	}
+	s.tombstones[metricID] = append(tombstones, &Tombstone{
+		MetricID:  metricID,
+		SeriesIDs: seriesIDs.Clone(),
+		TimeRange: timeRange,
+	})
+}
+
+// Get returns the tombstones of metric which overlap with time range.
+func (s *store) Get(metricID metric.ID, timeRange timeutil.TimeRange) (rs []*Tombstone) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	for _, t := range s.tombstones[metricID] {
+		if t.TimeRange.Overlap(timeRange) {
+			rs = append(rs, t)
+		}
+	}
+	return
+}
+
+// GetDeletedSeriesIDs returns the series ids of metric whose tombstone covers the whole time range,
+// returns nil if not found.
+func (s *store) GetDeletedSeriesIDs(metricID metric.ID, timeRange timeutil.TimeRange) *roaring.Bitmap {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	var seriesIDs *roaring.Bitmap
+	for _, t := range s.tombstones[metricID] {
+		if t.TimeRange.Start > timeRange.Start || t.TimeRange.End < timeRange.End {
+			continue
+		}
+		if seriesIDs == nil {
+			seriesIDs = t.SeriesIDs.Clone()
+		} else {
+			seriesIDs.Or(t.SeriesIDs)
+		}
+	}
+	return seriesIDs
+}
+
+// Unsealed returns the tombstones which are not sealed.
+func (s *store) Unsealed() (rs []*Tombstone) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	for _, tombstones := range s.tombstones {
+		for _, t := range tombstones {
+			if t.FileNumber == 0 {
+				rs = append(rs, t)
+			}
+		}
+	}
+	return
+}
+
+// Seal seals the tombstones with file number after the deleted data flushed into files,
+// then appends the records of them into file.
+func (s *store) Seal(tombstones []*Tombstone, fileNumber table.FileNumber) error {
+	if len(tombstones) == 0 {
+		return nil
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var sealed []*Tombstone
+	for _, t := range tombstones {
+		// tombstone maybe replaced by merging new deleted series
+		if t.FileNumber == 0 && s.contains(t) {
+			t.FileNumber = fileNumber
+			sealed = append(sealed, t)
+		}
+	}
+	if len(sealed) == 0 {
+		return nil
+	}
+	return s.append(func(writer *stream.BufferWriter) error {
+		for _, t := range sealed {
+			putSealRecord(writer, t)
+		}
+		return nil
+	})
+}
+
+// seal seals the unsealed tombstone of metric in time range with file number.
+func (s *store) seal(metricID metric.ID, timeRange timeutil.TimeRange, fileNumber table.FileNumber) {
+	for _, t := range s.tombstones[metricID] {
+		if t.TimeRange == timeRange && t.FileNumber == 0 {
+			t.FileNumber = fileNumber
+			return
+		}
+	}
+}
+
+// Prune removes the sealed tombstones whose deleted data are purged, then rewrites all tombstones into file.
+func (s *store) Prune(purged func(tombstone *Tombstone) bool) error {
+	return s.remove(func(t *Tombstone) bool {
+		return t.FileNumber > 0 && purged(t)
+	})
+}
+
+// Expire removes the tombstones whose time range ends before timestamp(deleted data expired by retention),
+// then rewrites all tombstones into file.
+func (s *store) Expire(timestamp int64) error {
+	return s.remove(func(t *Tombstone) bool {
+		return t.TimeRange.End < timestamp
+	})
+}
+
+// remove removes the tombstones which match the filter, then rewrites all tombstones into file.
+func (s *store) remove(filter func(tombstone *Tombstone) bool) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	removed := false
+	for metricID, tombstones := range s.tombstones {
+		rs := tombstones[:0]
+		for _, t := range tombstones {
+			if filter(t) {
+				removed = true
+				continue
+			}
+			rs = append(rs, t)
+		}
+		if len(rs) == 0 {
+			delete(s.tombstones, metricID)
+		} else {
+			s.tombstones[metricID] = rs
+		}
+	}
+	if !removed {
+		return nil
+	}
+	return s.persist()
+}
+
+// contains checks if tombstone exists in store.
+func (s *store) contains(tombstone *Tombstone) bool {
+	for _, t := range s.tombstones[tombstone.MetricID] {
+		if t == tombstone {
+			return true
+		}
+	}
+	return false
+}
+
+// append appends the records into file, writes file header first if file not exist.
+func (s *store) append(fn func(writer *stream.BufferWriter) error) error {
+	writer := stream.NewBufferWriter(nil)
+	if !s.exist {
+		writer.PutByte(version)
+	}
+	if err := fn(writer); err != nil {
+		return err
+	}
+	data, err := writer.Bytes()
+	if err != nil {
+		return err
+	}
+	f, err := openFileFunc(s.fileName, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(data)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	s.exist = true
+	return nil
+}
+
+// persist writes all tombstones into temp file, then renames it to target file.
+func (s *store) persist() error {
+	writer := stream.NewBufferWriter(nil)
+	writer.PutByte(version)
+	for _, tombstones := range s.tombstones {
+		for _, t := range tombstones {
+			if err := putAddRecord(writer, t); err != nil {
+				return err
+			}
+			if t.FileNumber > 0 {
+				putSealRecord(writer, t)
+			}
+		}
+	}
+	data, err := writer.Bytes()
+	if err != nil {
+		return err
+	}
+	tmp := s.fileName + ".tmp"
+	if err := writeFileFunc(tmp, data, 0o644); err != nil {
+		return err
+	}
+	if err := renameFunc(tmp, s.fileName); err != nil {
+		return fmt.Errorf("rename tmp file[%s] name error:%s", tmp, err)
+	}
+	s.exist = true
+	return nil
+}
+
+// load reads the tombstones from file if exist, replays the records in order.
+func (s *store) load() error {
+	data, err := readFileFunc(s.fileName)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	if len(data) == 0 {
+		return nil
+	}
+	reader := stream.NewReader(data)
+	if reader.ReadByte() != version {
+		return errInvalidFile
+	}
+	s.exist = true
+	for !reader.Empty() {
+		recordType := reader.ReadByte()
+		metricID := metric.ID(reader.ReadUint32())
+		timeRange := timeutil.TimeRange{Start: reader.ReadInt64(), End: reader.ReadInt64()}
+		switch recordType {
+		case recordAdd:
+			length := reader.ReadUvarint32()
+			bitmapData := reader.ReadSlice(int(length))
+			if reader.Error() != nil {
+				// last record not completed(crash when appending), drop it
+				return s.persist()
+			}
+			seriesIDs := roaring.New()
+			if err := seriesIDs.UnmarshalBinary(bitmapData); err != nil {
+				return err
+			}
+			s.add(metricID, seriesIDs, timeRange)
+		case recordSeal:
+			fileNumber := reader.ReadInt64()
+			if reader.Error() != nil {
+				// last record not completed(crash when appending), drop it
+				return s.persist()
+			}
+			s.seal(metricID, timeRange, table.FileNumber(fileNumber))
+		default:
+			return errInvalidFile
+		}
+	}
+	return nil
+}
+
+// putAddRecord writes the record of deleted series in time range.
+func putAddRecord(writer *stream.BufferWriter, tombstone *Tombstone) error {
+	data, err := tombstone.SeriesIDs.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	writer.PutByte(recordAdd)
+	writer.PutUint32(uint32(tombstone.MetricID))
+	writer.PutInt64(tombstone.TimeRange.Start)
+	writer.PutInt64(tombstone.TimeRange.End)
+	writer.PutUvarint32(uint32(len(data)))
+	writer.PutBytes(data)
+	return nil
+}
+
+// putSealRecord writes the record of tombstone sealed with file number.
+func putSealRecord(writer *stream.BufferWriter, tombstone *Tombstone) {
+	writer.PutByte(recordSeal)
+	writer.PutUint32(uint32(tombstone.MetricID))
+	writer.PutInt64(tombstone.TimeRange.Start)
+	writer.PutInt64(tombstone.TimeRange.End)
+	writer.PutInt64(int64(tombstone.FileNumber))
+}
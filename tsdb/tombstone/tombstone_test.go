@@ -0,0 +1,243 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tombstone
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lindb/roaring"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/kv/table"
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+func TestStore_Add(t *testing.T) {
+	defer func() {
+		writeFileFunc = os.WriteFile
+	}()
+	// add appends record into file instead of rewriting whole file
+	writeFileFunc = func(_ string, _ []byte, _ os.FileMode) error {
+		return fmt.Errorf("err")
+	}
+	dir := t.TempDir()
+	s, err := NewStore(dir)
+	assert.NoError(t, err)
+	// ignore empty tombstone
+	assert.NoError(t, s.Add(nil))
+	assert.NoError(t, s.Add(&Tombstone{MetricID: 1, SeriesIDs: roaring.New()}))
+	assert.NoFileExists(t, filepath.Join(dir, FileName))
+
+	assert.NoError(t, s.Add(&Tombstone{
+		MetricID:  1,
+		SeriesIDs: roaring.BitmapOf(1, 2),
+		TimeRange: timeutil.TimeRange{Start: 10, End: 100},
+	}))
+	// merge series with same time range
+	assert.NoError(t, s.Add(&Tombstone{
+		MetricID:  1,
+		SeriesIDs: roaring.BitmapOf(3),
+		TimeRange: timeutil.TimeRange{Start: 10, End: 100},
+	}))
+	assert.NoError(t, s.Add(&Tombstone{
+		MetricID:  1,
+		SeriesIDs: roaring.BitmapOf(4),
+		TimeRange: timeutil.TimeRange{Start: 50, End: 60},
+	}))
+	assert.NoError(t, s.Add(&Tombstone{
+		MetricID:  2,
+		SeriesIDs: roaring.BitmapOf(5),
+		TimeRange: timeutil.TimeRange{Start: 0, End: 100},
+	}))
+	check := func(s Store) {
+		assert.Len(t, s.Get(1, timeutil.TimeRange{Start: 0, End: 5}), 0)
+		assert.Len(t, s.Get(1, timeutil.TimeRange{Start: 0, End: 20}), 1)
+		assert.Len(t, s.Get(1, timeutil.TimeRange{Start: 55, End: 200}), 2)
+		assert.Len(t, s.Get(3, timeutil.TimeRange{Start: 0, End: 200}), 0)
+
+		assert.Nil(t, s.GetDeletedSeriesIDs(1, timeutil.TimeRange{Start: 0, End: 20}))
+		assert.Equal(t, roaring.BitmapOf(1, 2, 3), s.GetDeletedSeriesIDs(1, timeutil.TimeRange{Start: 20, End: 30}))
+		assert.Equal(t, roaring.BitmapOf(1, 2, 3, 4), s.GetDeletedSeriesIDs(1, timeutil.TimeRange{Start: 50, End: 60}))
+		assert.Equal(t, roaring.BitmapOf(5), s.GetDeletedSeriesIDs(2, timeutil.TimeRange{Start: 0, End: 100}))
+	}
+	check(s)
+	// reload from file
+	s, err = NewStore(dir)
+	assert.NoError(t, err)
+	check(s)
+	// append after reload
+	assert.NoError(t, s.Add(&Tombstone{
+		MetricID:  4,
+		SeriesIDs: roaring.BitmapOf(6),
+		TimeRange: timeutil.TimeRange{Start: 0, End: 100},
+	}))
+	s, err = NewStore(dir)
+	assert.NoError(t, err)
+	check(s)
+	assert.Equal(t, roaring.BitmapOf(6), s.GetDeletedSeriesIDs(4, timeutil.TimeRange{Start: 0, End: 100}))
+}
+
+func TestStore_Seal_Prune(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewStore(dir)
+	assert.NoError(t, err)
+	assert.NoError(t, s.Seal(nil, 10))
+	assert.NoError(t, s.Add(&Tombstone{MetricID: 1, SeriesIDs: roaring.BitmapOf(1), TimeRange: timeutil.TimeRange{Start: 10, End: 100}}))
+	assert.NoError(t, s.Add(&Tombstone{MetricID: 2, SeriesIDs: roaring.BitmapOf(2), TimeRange: timeutil.TimeRange{Start: 10, End: 100}}))
+	unsealed := s.Unsealed()
+	assert.Len(t, unsealed, 2)
+	// merge new deleted series, tombstone replaced
+	assert.NoError(t, s.Add(&Tombstone{MetricID: 2, SeriesIDs: roaring.BitmapOf(3), TimeRange: timeutil.TimeRange{Start: 10, End: 100}}))
+	assert.NoError(t, s.Seal(unsealed, 10))
+	unsealed = s.Unsealed()
+	assert.Len(t, unsealed, 1)
+	assert.Equal(t, roaring.BitmapOf(2, 3), unsealed[0].SeriesIDs)
+	// sealed already
+	assert.NoError(t, s.Seal(s.Get(1, timeutil.TimeRange{Start: 10, End: 100}), 20))
+	assert.Equal(t, table.FileNumber(10), s.Get(1, timeutil.TimeRange{Start: 10, End: 100})[0].FileNumber)
+	// reload from file
+	s, err = NewStore(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, table.FileNumber(10), s.Get(1, timeutil.TimeRange{Start: 10, End: 100})[0].FileNumber)
+	assert.Len(t, s.Unsealed(), 1)
+
+	// nothing purged
+	assert.NoError(t, s.Prune(func(_ *Tombstone) bool { return false }))
+	assert.Len(t, s.Get(1, timeutil.TimeRange{Start: 10, End: 100}), 1)
+	// only prune sealed tombstones
+	assert.NoError(t, s.Prune(func(_ *Tombstone) bool { return true }))
+	assert.Empty(t, s.Get(1, timeutil.TimeRange{Start: 10, End: 100}))
+	assert.Len(t, s.Get(2, timeutil.TimeRange{Start: 10, End: 100}), 1)
+	s, err = NewStore(dir)
+	assert.NoError(t, err)
+	assert.Empty(t, s.Get(1, timeutil.TimeRange{Start: 10, End: 100}))
+	assert.Len(t, s.Get(2, timeutil.TimeRange{Start: 10, End: 100}), 1)
+}
+
+func TestStore_Expire(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewStore(dir)
+	assert.NoError(t, err)
+	assert.NoError(t, s.Add(&Tombstone{MetricID: 1, SeriesIDs: roaring.BitmapOf(1), TimeRange: timeutil.TimeRange{Start: 10, End: 100}}))
+	assert.NoError(t, s.Add(&Tombstone{MetricID: 1, SeriesIDs: roaring.BitmapOf(2), TimeRange: timeutil.TimeRange{Start: 10, End: 200}}))
+	assert.NoError(t, s.Seal(s.Unsealed(), 10))
+	// nothing expired
+	assert.NoError(t, s.Expire(100))
+	assert.Len(t, s.Get(1, timeutil.TimeRange{Start: 0, End: 300}), 2)
+	// expire sealed/unsealed tombstones
+	assert.NoError(t, s.Add(&Tombstone{MetricID: 2, SeriesIDs: roaring.BitmapOf(3), TimeRange: timeutil.TimeRange{Start: 10, End: 100}}))
+	assert.NoError(t, s.Expire(150))
+	assert.Len(t, s.Get(1, timeutil.TimeRange{Start: 0, End: 300}), 1)
+	assert.Empty(t, s.Get(2, timeutil.TimeRange{Start: 0, End: 300}))
+	s, err = NewStore(dir)
+	assert.NoError(t, err)
+	rs := s.Get(1, timeutil.TimeRange{Start: 0, End: 300})
+	assert.Len(t, rs, 1)
+	assert.Equal(t, table.FileNumber(10), rs[0].FileNumber)
+	assert.Empty(t, s.Get(2, timeutil.TimeRange{Start: 0, End: 300}))
+}
+
+func TestStore_Persist_Fail(t *testing.T) {
+	defer func() {
+		writeFileFunc = os.WriteFile
+		renameFunc = os.Rename
+		openFileFunc = os.OpenFile
+	}()
+	s, err := NewStore(t.TempDir())
+	assert.NoError(t, err)
+	tombstone := &Tombstone{MetricID: 1, SeriesIDs: roaring.BitmapOf(1)}
+	openFileFunc = func(_ string, _ int, _ os.FileMode) (*os.File, error) {
+		return nil, fmt.Errorf("err")
+	}
+	assert.Error(t, s.Add(tombstone))
+	openFileFunc = os.OpenFile
+	assert.NoError(t, s.Add(tombstone))
+	writeFileFunc = func(_ string, _ []byte, _ os.FileMode) error {
+		return fmt.Errorf("err")
+	}
+	assert.Error(t, s.Expire(10))
+	writeFileFunc = os.WriteFile
+	assert.NoError(t, s.Add(tombstone))
+	renameFunc = func(_, _ string) error {
+		return fmt.Errorf("err")
+	}
+	assert.Error(t, s.Expire(10))
+}
+
+func TestStore_Load_Fail(t *testing.T) {
+	defer func() {
+		readFileFunc = os.ReadFile
+	}()
+	dir := t.TempDir()
+	fileName := filepath.Join(dir, FileName)
+	// empty file
+	assert.NoError(t, os.WriteFile(fileName, nil, 0o644))
+	_, err := NewStore(dir)
+	assert.NoError(t, err)
+	// invalid version
+	assert.NoError(t, os.WriteFile(fileName, []byte{10}, 0o644))
+	_, err = NewStore(dir)
+	assert.Equal(t, errInvalidFile, err)
+	// invalid record type
+	assert.NoError(t, os.WriteFile(fileName, append([]byte{version, 10}, make([]byte, 20)...), 0o644))
+	_, err = NewStore(dir)
+	assert.Equal(t, errInvalidFile, err)
+	// invalid bitmap
+	data := []byte{version, recordAdd, 1, 0, 0, 0}
+	data = append(data, make([]byte, 16)...)
+	data = append(data, 2, 1, 2)
+	assert.NoError(t, os.WriteFile(fileName, data, 0o644))
+	_, err = NewStore(dir)
+	assert.Error(t, err)
+	// last record not completed
+	s, err := NewStore(t.TempDir())
+	assert.NoError(t, err)
+	assert.NoError(t, s.Add(&Tombstone{MetricID: 1, SeriesIDs: roaring.BitmapOf(1), TimeRange: timeutil.TimeRange{Start: 10, End: 100}}))
+	assert.NoError(t, s.Seal(s.Unsealed(), 10))
+	dir = t.TempDir()
+	fileName = filepath.Join(dir, FileName)
+	data, err = os.ReadFile(s.(*store).fileName)
+	assert.NoError(t, err)
+	for _, n := range []int{1, 10} {
+		assert.NoError(t, os.WriteFile(fileName, data[:len(data)-n], 0o644))
+		s, err = NewStore(dir)
+		assert.NoError(t, err)
+		rs := s.Get(1, timeutil.TimeRange{Start: 10, End: 100})
+		assert.Len(t, rs, 1)
+		assert.Equal(t, table.FileNumber(0), rs[0].FileNumber)
+		// incomplete record dropped
+		assert.NoError(t, s.Seal(rs, 20))
+		s, err = NewStore(dir)
+		assert.NoError(t, err)
+		assert.Equal(t, table.FileNumber(20), s.Get(1, timeutil.TimeRange{Start: 10, End: 100})[0].FileNumber)
+	}
+	// incomplete add record
+	assert.NoError(t, os.WriteFile(fileName, data[:10], 0o644))
+	s, err = NewStore(dir)
+	assert.NoError(t, err)
+	assert.Empty(t, s.Get(1, timeutil.TimeRange{Start: 10, End: 100}))
+	// read file failure
+	readFileFunc = func(_ string) ([]byte, error) {
+		return nil, fmt.Errorf("err")
+	}
+	_, err = NewStore(dir)
+	assert.Error(t, err)
+}